@@ -0,0 +1,71 @@
+#!/bin/bash
+# =============================================================================
+# weAlist - Development Seed Script
+# =============================================================================
+# 실행 중인 개발 환경(dev.sh up)의 DB에 데모 데이터를 채웁니다.
+# 각 서비스의 cmd/seed를 호스트에서 실행하며, 이미 있는 데이터는 건너뜁니다.
+#
+# 사용법:
+#   ./docker/scripts/seed.sh [--migrate]
+#
+# Options:
+#   --migrate  - 시드 전에 각 서비스의 AutoMigrate 실행 (서비스가 아직 안 떠 있을 때)
+#
+# 시드 사용자: alice@example.com, bob@example.com, carol@example.com,
+#              dave@example.com, erin@example.com (매직 링크로 로그인)
+# =============================================================================
+
+set -e
+
+# 색상 정의
+RED='\033[0;31m'
+GREEN='\033[0;32m'
+BLUE='\033[0;34m'
+NC='\033[0m' # No Color
+
+# 프로젝트 루트 디렉토리로 이동
+cd "$(dirname "$0")/../.."
+ROOT_DIR=$(pwd)
+
+ENV_FILE="docker/env/.env.dev"
+if [ ! -f "$ENV_FILE" ]; then
+    echo -e "${RED}❌ $ENV_FILE 파일이 없습니다. 먼저 ./docker/scripts/dev.sh up 을 실행하세요.${NC}"
+    exit 1
+fi
+
+set -a
+# shellcheck disable=SC1090
+source "$ENV_FILE"
+set +a
+
+SEED_ARGS=()
+if [ "$1" = "--migrate" ]; then
+    SEED_ARGS+=("-migrate")
+fi
+
+# 호스트에서 실행하므로 compose 내부 호스트명 대신 노출된 포트를 사용합니다.
+DB_HOST_ADDR="localhost:${POSTGRES_HOST_PORT:-5432}"
+export S3_ENDPOINT="http://localhost:${MINIO_PORT:-9000}"
+export ENV="${ENV:-dev}"
+
+# 사용자/워크스페이스를 다른 서비스가 참조하므로 user-service가 먼저 실행되어야 합니다.
+seed_service() {
+    local service=$1
+    local db_user=$2
+    local db_password=$3
+    local db_name=$4
+
+    echo -e "${BLUE}🌱 Seeding $service...${NC}"
+    (
+        cd "$ROOT_DIR/services/$service"
+        DATABASE_URL="postgresql://${db_user}:${db_password}@${DB_HOST_ADDR}/${db_name}?sslmode=disable" \
+            go run ./cmd/seed "${SEED_ARGS[@]}"
+    )
+}
+
+seed_service user-service "$USER_DB_USER" "$USER_DB_PASSWORD" "$USER_DB_NAME"
+seed_service board-service "$BOARD_DB_USER" "$BOARD_DB_PASSWORD" "$BOARD_DB_NAME"
+seed_service chat-service "$CHAT_DB_USER" "$CHAT_DB_PASSWORD" "$CHAT_DB_NAME"
+seed_service storage-service "$STORAGE_DB_USER" "$STORAGE_DB_PASSWORD" "$STORAGE_DB_NAME"
+
+echo -e "${GREEN}✅ Seed 완료! alice@example.com 으로 로그인해 보세요.${NC}"
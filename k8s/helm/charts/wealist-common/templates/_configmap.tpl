@@ -32,6 +32,10 @@ data:
   {{ $key }}: {{ $value | quote }}
   {{- end }}
   {{- end }}
+  {{- /* Runtime settings file mounted from the -runtime ConfigMap (Go services) */}}
+  {{- if .Values.runtimeConfig }}
+  RUNTIME_CONFIG_PATH: "/etc/wealist/runtime/runtime.yaml"
+  {{- end }}
   {{- /* Auto-generate DB_USER for Go services if DB_NAME is set and no DB_USER in shared or service config */}}
   {{- if .Values.config.DB_NAME }}
   {{- $dbName := .Values.config.DB_NAME }}
@@ -46,3 +50,26 @@ data:
   {{- end }}
   {{- end }}
 {{- end }}
+
+{{/*
+Runtime settings configmap for weAlist Go services
+Usage in service chart:
+  {{- include "wealist-common.runtimeConfigmap" . }}
+
+Renders .Values.runtimeConfig as runtime.yaml. The services watch the mounted file and
+re-apply log_level, rate_limit, cors_origins and features without a restart, so this
+ConfigMap is intentionally NOT part of wealist-common.configChecksum.
+*/}}
+{{- define "wealist-common.runtimeConfigmap" -}}
+{{- if .Values.runtimeConfig }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "wealist-common.fullname" . }}-runtime
+  labels:
+    {{- include "wealist-common.labels" . | nindent 4 }}
+data:
+  runtime.yaml: |
+    {{- toYaml .Values.runtimeConfig | nindent 4 }}
+{{- end }}
+{{- end }}
@@ -129,7 +129,7 @@ spec:
           {{- if ne (toString .Values.healthCheck.liveness.enabled) "false" }}
           livenessProbe:
             httpGet:
-              path: {{ .Values.healthCheck.liveness.path | default "/health/live" }}
+              path: {{ .Values.healthCheck.liveness.path | default "/healthz" }}
               port: {{ .Values.healthCheck.liveness.port | default .Values.service.targetPort }}
             initialDelaySeconds: {{ .Values.healthCheck.liveness.initialDelaySeconds | default 10 }}
             periodSeconds: {{ .Values.healthCheck.liveness.periodSeconds | default 10 }}
@@ -142,7 +142,7 @@ spec:
           {{- if ne (toString .Values.healthCheck.readiness.enabled) "false" }}
           readinessProbe:
             httpGet:
-              path: {{ .Values.healthCheck.readiness.path | default "/health/ready" }}
+              path: {{ .Values.healthCheck.readiness.path | default "/readyz" }}
               port: {{ .Values.healthCheck.readiness.port | default .Values.service.targetPort }}
             initialDelaySeconds: {{ .Values.healthCheck.readiness.initialDelaySeconds | default 5 }}
             periodSeconds: {{ .Values.healthCheck.readiness.periodSeconds | default 5 }}
@@ -177,13 +177,28 @@ spec:
           env:
             {{- toYaml .Values.env | nindent 12 }}
           {{- end }}
-          {{- if .Values.volumeMounts }}
+          {{- if or .Values.volumeMounts .Values.runtimeConfig }}
           volumeMounts:
+            {{- if .Values.volumeMounts }}
             {{- toYaml .Values.volumeMounts | nindent 12 }}
+            {{- end }}
+            {{- if .Values.runtimeConfig }}
+            - name: runtime-config
+              mountPath: /etc/wealist/runtime
+              readOnly: true
+            {{- end }}
           {{- end }}
-      {{- if .Values.volumes }}
+      {{- if or .Values.volumes .Values.runtimeConfig }}
       volumes:
+        {{- if .Values.volumes }}
         {{- toYaml .Values.volumes | nindent 8 }}
+        {{- end }}
+        {{- if .Values.runtimeConfig }}
+        {{- /* 런타임 설정은 subPath 없이 마운트해야 ConfigMap 변경이 재시작 없이 반영됨 */}}
+        - name: runtime-config
+          configMap:
+            name: {{ include "wealist-common.fullname" . }}-runtime
+        {{- end }}
       {{- end }}
       {{- if .Values.nodeSelector }}
       nodeSelector:
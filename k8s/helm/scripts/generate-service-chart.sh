@@ -171,14 +171,14 @@ externalSecrets:
 # -----------------------------------------------------------------------------
 healthCheck:
   liveness:
-    path: /health/live
+    path: /healthz
     initialDelaySeconds: 30
     periodSeconds: 10
     timeoutSeconds: 5
     successThreshold: 1
     failureThreshold: 3
   readiness:
-    path: /health/ready
+    path: /readyz
     initialDelaySeconds: 20
     periodSeconds: 5
     timeoutSeconds: 3
@@ -0,0 +1,210 @@
+#!/bin/bash
+
+# Go 서비스 프로파일 수집 스크립트
+# 파드의 내부 프로파일링 포트(PROFILING_ADDR, 기본 127.0.0.1:6060)에 port-forward로 접속해
+# CPU/힙/고루틴 프로파일과 런타임 통계를 한 번에 받아 압축하고, 필요하면 S3에 업로드합니다.
+
+set -e
+
+# 색상 정의
+RED='\033[0;31m'
+GREEN='\033[0;32m'
+YELLOW='\033[1;33m'
+BLUE='\033[0;34m'
+NC='\033[0m' # No Color
+
+log_info() {
+    echo -e "${BLUE}ℹ️  $1${NC}"
+}
+
+log_success() {
+    echo -e "${GREEN}✅ $1${NC}"
+}
+
+log_warning() {
+    echo -e "${YELLOW}⚠️  $1${NC}"
+}
+
+log_error() {
+    echo -e "${RED}❌ $1${NC}"
+}
+
+# 도움말
+show_help() {
+    echo "Go 서비스 프로파일 수집 스크립트"
+    echo ""
+    echo "사용법: $0 SERVICE [OPTIONS]"
+    echo ""
+    echo "SERVICE: user-service, board-service, chat-service, noti-service, storage-service, ops-service"
+    echo ""
+    echo "Options:"
+    echo "  --namespace, -n NAMESPACE    대상 네임스페이스 (기본: wealist-prod)"
+    echo "  --pod, -p POD                대상 파드 (기본: 서비스의 첫 번째 Running 파드)"
+    echo "  --seconds, -s SECONDS        CPU 프로파일 시간 (기본: 30)"
+    echo "  --trace SECONDS              실행 트레이스도 수집 (기본: 수집 안 함)"
+    echo "  --port PORT                  파드의 프로파일링 포트 (기본: 6060)"
+    echo "  --token TOKEN                PROFILING_TOKEN (설정된 경우, 기본: \$PROFILING_TOKEN)"
+    echo "  --output, -o DIR             결과 저장 디렉터리 (기본: ./profiles)"
+    echo "  --upload S3_URI              압축 파일을 업로드할 위치 (예: s3://wealist-incidents/profiles)"
+    echo "  --help, -h                   도움말 표시"
+    echo ""
+    echo "예시:"
+    echo "  $0 board-service                                  # prod board-service 파드 하나 수집"
+    echo "  $0 chat-service -n wealist-dev -s 60 --trace 5   # dev, CPU 60초 + 트레이스 5초"
+    echo "  $0 user-service --upload s3://wealist-incidents/profiles"
+    echo ""
+    echo "분석:"
+    echo "  go tool pprof -http=:8080 profiles/<archive>/cpu.pb.gz"
+    echo "  go tool trace profiles/<archive>/trace.out"
+}
+
+# 기본값
+SERVICE=""
+NAMESPACE="wealist-prod"
+POD=""
+SECONDS_CPU=30
+TRACE_SECONDS=""
+REMOTE_PORT=6060
+TOKEN="${PROFILING_TOKEN:-}"
+OUTPUT_DIR="./profiles"
+UPLOAD_URI=""
+
+# 파라미터 파싱
+while [[ $# -gt 0 ]]; do
+    case $1 in
+        -n|--namespace)
+            NAMESPACE="$2"
+            shift 2
+            ;;
+        -p|--pod)
+            POD="$2"
+            shift 2
+            ;;
+        -s|--seconds)
+            SECONDS_CPU="$2"
+            shift 2
+            ;;
+        --trace)
+            TRACE_SECONDS="$2"
+            shift 2
+            ;;
+        --port)
+            REMOTE_PORT="$2"
+            shift 2
+            ;;
+        --token)
+            TOKEN="$2"
+            shift 2
+            ;;
+        -o|--output)
+            OUTPUT_DIR="$2"
+            shift 2
+            ;;
+        --upload)
+            UPLOAD_URI="$2"
+            shift 2
+            ;;
+        -h|--help)
+            show_help
+            exit 0
+            ;;
+        -*)
+            log_error "알 수 없는 옵션: $1"
+            show_help
+            exit 1
+            ;;
+        *)
+            SERVICE="$1"
+            shift
+            ;;
+    esac
+done
+
+if [ -z "$SERVICE" ]; then
+    log_error "SERVICE를 지정하세요"
+    show_help
+    exit 1
+fi
+
+for tool in kubectl curl tar; do
+    if ! command -v "$tool" &> /dev/null; then
+        log_error "$tool CLI가 필요합니다"
+        exit 1
+    fi
+done
+if [ -n "$UPLOAD_URI" ] && ! command -v aws &> /dev/null; then
+    log_error "--upload에는 aws CLI가 필요합니다"
+    exit 1
+fi
+
+# 대상 파드 선택
+if [ -z "$POD" ]; then
+    POD=$(kubectl get pods -n "$NAMESPACE" -l "app.kubernetes.io/name=$SERVICE" \
+        --field-selector=status.phase=Running -o jsonpath='{.items[0].metadata.name}' 2>/dev/null || true)
+    if [ -z "$POD" ]; then
+        log_error "$NAMESPACE 네임스페이스에서 Running 상태인 $SERVICE 파드를 찾을 수 없습니다"
+        exit 1
+    fi
+fi
+log_info "대상 파드: $NAMESPACE/$POD"
+
+# 로컬 포트로 port-forward (파드의 127.0.0.1에 바인딩된 포트도 접근 가능)
+LOCAL_PORT=$(( 16060 + RANDOM % 1000 ))
+kubectl port-forward -n "$NAMESPACE" "pod/$POD" "$LOCAL_PORT:$REMOTE_PORT" > /dev/null 2>&1 &
+PF_PID=$!
+trap 'kill $PF_PID 2>/dev/null || true' EXIT
+
+BASE_URL="http://127.0.0.1:$LOCAL_PORT"
+CURL_ARGS=(-sS --fail)
+if [ -n "$TOKEN" ]; then
+    CURL_ARGS+=(-H "Authorization: Bearer $TOKEN")
+fi
+
+for _ in $(seq 1 20); do
+    if curl "${CURL_ARGS[@]}" -o /dev/null "$BASE_URL/debug/runtime" 2>/dev/null; then
+        break
+    fi
+    sleep 0.5
+done
+if ! curl "${CURL_ARGS[@]}" -o /dev/null "$BASE_URL/debug/runtime"; then
+    log_error "프로파일링 포트에 연결할 수 없습니다 (PROFILING_ADDR 설정과 --port, --token을 확인하세요)"
+    exit 1
+fi
+
+TIMESTAMP=$(date -u +%Y%m%dT%H%M%SZ)
+ARCHIVE_NAME="$SERVICE-$POD-$TIMESTAMP"
+WORK_DIR="$OUTPUT_DIR/$ARCHIVE_NAME"
+mkdir -p "$WORK_DIR"
+
+capture() {
+    local name=$1
+    local path=$2
+    if curl "${CURL_ARGS[@]}" -o "$WORK_DIR/$name" "$BASE_URL$path"; then
+        log_success "$name"
+    else
+        log_warning "$name 수집 실패"
+    fi
+}
+
+# 장애 시점 상태를 먼저 남기고 오래 걸리는 CPU 프로파일은 마지막에 수집
+capture runtime.json "/debug/runtime"
+capture goroutines.txt "/debug/pprof/goroutine?debug=2"
+capture goroutine.pb.gz "/debug/pprof/goroutine"
+capture heap.pb.gz "/debug/pprof/heap"
+capture allocs.pb.gz "/debug/pprof/allocs"
+capture mutex.pb.gz "/debug/pprof/mutex"
+log_info "CPU 프로파일 수집 중 (${SECONDS_CPU}초)..."
+capture cpu.pb.gz "/debug/pprof/profile?seconds=$SECONDS_CPU"
+if [ -n "$TRACE_SECONDS" ]; then
+    log_info "실행 트레이스 수집 중 (${TRACE_SECONDS}초)..."
+    capture trace.out "/debug/pprof/trace?seconds=$TRACE_SECONDS"
+fi
+
+ARCHIVE="$OUTPUT_DIR/$ARCHIVE_NAME.tar.gz"
+tar -czf "$ARCHIVE" -C "$OUTPUT_DIR" "$ARCHIVE_NAME"
+log_success "저장 완료: $ARCHIVE"
+
+if [ -n "$UPLOAD_URI" ]; then
+    aws s3 cp "$ARCHIVE" "${UPLOAD_URI%/}/$ARCHIVE_NAME.tar.gz"
+    log_success "업로드 완료: ${UPLOAD_URI%/}/$ARCHIVE_NAME.tar.gz"
+fi
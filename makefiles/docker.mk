@@ -4,7 +4,7 @@
 
 ##@ Development (Docker Compose)
 
-.PHONY: dev-up dev-down dev-restart dev-logs dev-build dev-clean
+.PHONY: dev-up dev-down dev-restart dev-logs dev-build dev-clean dev-seed
 
 dev-up: ## Start all services
 	./docker/scripts/dev.sh up
@@ -24,6 +24,9 @@ dev-build: ## Rebuild all Docker images
 dev-clean: ## Stop and remove all containers, volumes (destructive)
 	./docker/scripts/dev.sh clean
 
+dev-seed: ## Fill local databases with demo users, workspaces, boards, chats, files
+	./docker/scripts/seed.sh
+
 ##@ Monorepo Build (BuildKit Cache - Fast)
 
 .PHONY: dev-mono-up dev-mono-down dev-mono-build dev-mono-build-parallel
@@ -11,7 +11,7 @@
 .PHONY: $(addsuffix -load,$(SERVICES))
 .PHONY: $(addsuffix -redeploy,$(SERVICES))
 .PHONY: $(addsuffix -all,$(SERVICES))
-.PHONY: redeploy-all status clean
+.PHONY: redeploy-all status clean test-integration test-contract api-clients
 
 # -----------------------------------------------------------------------------
 # Build targets for ROOT context services (use shared package from project root)
@@ -177,6 +177,28 @@ status-all: ## Show all wealist namespaces
 clean: ## Clean up
 	./docker/scripts/clean.sh
 
+# testcontainers로 Postgres/Redis/MinIO를 띄우므로 Docker가 실행 중이어야 합니다.
+INTEGRATION_TEST_MODULES = packages/wealist-advanced-go-pkg services/board-service services/chat-service services/noti-service services/storage-service services/user-service
+
+test-integration: ## Run integration tests against real Postgres/Redis/MinIO (requires Docker)
+	@for dir in $(INTEGRATION_TEST_MODULES); do \
+		echo "🧪 $$dir"; \
+		(cd $$dir && go test -tags integration -count=1 ./...) || exit 1; \
+	done
+
+# 계약 파일: packages/wealist-advanced-go-pkg/testutil/contract/contracts/<consumer>_<provider>.json
+# consumer 테스트는 일반 go test에, provider 검증(실제 라우터 + DB)은 integration 태그에 포함됩니다.
+test-contract: ## Run consumer-driven contract tests between services (provider checks require Docker)
+	@for dir in $(INTEGRATION_TEST_MODULES); do \
+		echo "🤝 $$dir"; \
+		(cd $$dir && go test -tags integration -count=1 -run 'Contract' ./...) || exit 1; \
+	done
+
+# swagger 명세(각 서비스의 docs/swagger.json)에서 Go 클라이언트(apiclient/userapi, notiapi)와
+# 프론트엔드 TS SDK(src/api/generated)를 다시 생성합니다. 핸들러 주석을 바꾸면 swag init 후 실행하세요.
+api-clients: ## Regenerate typed Go/TS API clients from the services' swagger specs
+	cd packages/wealist-advanced-go-pkg && go generate ./apiclient/...
+
 ##@ ECR Push (Cloud Deployment)
 
 # AWS ECR 레지스트리 (환경 변수 또는 aws sts에서 가져옴)
@@ -0,0 +1,9 @@
+package apiclient
+
+// 서비스 swagger 명세에서 클라이언트를 다시 생성합니다 (make api-clients).
+// 명세는 각 서비스에서 `make swagger`로 핸들러 주석으로부터 만들어지며, 생성 결과는 커밋합니다.
+// TestGeneratedClientsUpToDate가 커밋된 결과와 명세가 어긋나면 실패합니다.
+
+//go:generate go run ../cmd/apigen -spec ../../../services/user-service/docs/swagger.json -source services/user-service/docs/swagger.json -lang go -package userapi -out userapi/client.gen.go
+//go:generate go run ../cmd/apigen -spec ../../../services/noti-service/docs/swagger.json -source services/noti-service/docs/swagger.json -lang go -package notiapi -out notiapi/client.gen.go
+//go:generate go run ../cmd/apigen -spec ../../../services/user-service/docs/swagger.json -source services/user-service/docs/swagger.json -lang ts -name user -exclude /internal/ -out ../../../services/frontend/src/api/generated/userApi.ts
@@ -0,0 +1,64 @@
+package apiclient
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apigen"
+)
+
+// TestGeneratedClientsUpToDate는 커밋된 생성 클라이언트가 서비스 swagger 명세와 일치하는지 테스트합니다.
+// 핸들러를 바꾸고 `make swagger`만 실행한 경우 실패하며, `go generate ./apiclient/...`로 해결합니다.
+func TestGeneratedClientsUpToDate(t *testing.T) {
+	tests := []struct {
+		spec, source, out string
+		generate          func(*apigen.Spec, string) ([]byte, error)
+	}{
+		{
+			spec: "../../../services/user-service/docs/swagger.json", source: "services/user-service/docs/swagger.json",
+			out: "userapi/client.gen.go",
+			generate: func(s *apigen.Spec, source string) ([]byte, error) {
+				return apigen.GenerateGo(s, apigen.GoOptions{Package: "userapi", Source: source})
+			},
+		},
+		{
+			spec: "../../../services/noti-service/docs/swagger.json", source: "services/noti-service/docs/swagger.json",
+			out: "notiapi/client.gen.go",
+			generate: func(s *apigen.Spec, source string) ([]byte, error) {
+				return apigen.GenerateGo(s, apigen.GoOptions{Package: "notiapi", Source: source})
+			},
+		},
+		{
+			spec: "../../../services/user-service/docs/swagger.json", source: "services/user-service/docs/swagger.json",
+			out: "../../../services/frontend/src/api/generated/userApi.ts",
+			generate: func(s *apigen.Spec, source string) ([]byte, error) {
+				return apigen.GenerateTS(s, apigen.TSOptions{Name: "user", Source: source, Exclude: []string{"/internal/"}})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.out, func(t *testing.T) {
+			spec, err := apigen.Load(tt.spec)
+			if os.IsNotExist(err) {
+				// pkg만 따로 체크아웃한 경우
+				t.Skipf("서비스 명세 없음: %s", tt.spec)
+			}
+			if err != nil {
+				t.Fatalf("명세 로드 실패: %v", err)
+			}
+			want, err := tt.generate(spec, tt.source)
+			if err != nil {
+				t.Fatalf("생성 실패: %v", err)
+			}
+			got, err := os.ReadFile(tt.out)
+			if err != nil {
+				t.Fatalf("생성 결과 읽기 실패: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s가 %s와 다름; go generate ./apiclient/... 실행 필요", tt.out, tt.source)
+			}
+		})
+	}
+}
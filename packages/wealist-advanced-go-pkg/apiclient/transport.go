@@ -0,0 +1,197 @@
+// Package apiclient는 apigen으로 생성한 서비스 클라이언트(userapi, notiapi 등)가 사용하는 HTTP 런타임입니다.
+//
+// 생성된 클라이언트는 경로, 파라미터, 요청/응답 타입만 알고, 실제 전송은 Transport가 맡습니다.
+// 인증 헤더처럼 호출마다 다른 값은 RequestEditor로 넘깁니다.
+//
+//	users := userapi.New(apiclient.NewTransport("http://user-service:8081", httpClient))
+//	user, err := users.GetUser(ctx, userID.String(), apiclient.WithBearerToken(token))
+//
+// 클라이언트는 swagger 명세가 바뀌면 `go generate ./apiclient/...`로 다시 생성합니다.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// HTTPDoer sends HTTP requests; *http.Client implements it.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RequestEditor modifies a request before it is sent.
+type RequestEditor func(ctx context.Context, req *http.Request) error
+
+// WithBearerToken sets the Authorization header. An empty token sends no header.
+func WithBearerToken(token string) RequestEditor {
+	return func(_ context.Context, req *http.Request) error {
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return nil
+	}
+}
+
+// WithHeader sets a request header.
+func WithHeader(key, value string) RequestEditor {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// Request is an API request built by a generated client.
+type Request struct {
+	Method string
+	Path   string // Includes the spec base path, e.g. /api/users/{id} with the parameter filled in
+	Query  url.Values
+	Header http.Header
+	Body   any // Encoded as JSON when not nil
+}
+
+// AddQuery adds a query parameter. Slices add one value per element.
+func (r *Request) AddQuery(key string, value any) {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	for _, v := range paramValues(value) {
+		r.Query.Add(key, v)
+	}
+}
+
+// AddHeader adds a request header.
+func (r *Request) AddHeader(key string, value any) {
+	if r.Header == nil {
+		r.Header = http.Header{}
+	}
+	for _, v := range paramValues(value) {
+		r.Header.Add(key, v)
+	}
+}
+
+// PathParam formats and escapes a path parameter.
+func PathParam(value any) string {
+	return url.PathEscape(fmt.Sprint(value))
+}
+
+func paramValues(value any) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []int:
+		values := make([]string, len(v))
+		for i, n := range v {
+			values[i] = fmt.Sprint(n)
+		}
+		return values
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+// Transport sends the requests of generated clients.
+type Transport struct {
+	// BaseURL is the service host without the API base path (e.g. http://user-service:8081).
+	BaseURL string
+	// HTTPClient sends the requests; use the service client with the circuit breaker (client.NewBaseHTTPClient).
+	HTTPClient HTTPDoer
+	// Editors are applied to every request before the per-call editors.
+	Editors []RequestEditor
+	// Observe, when set, is called after every request, e.g. to record external API metrics.
+	// status is 0 when no response was received.
+	Observe func(method, url string, status int, duration time.Duration, err error)
+}
+
+// NewTransport returns a Transport for baseURL. A nil httpClient uses http.DefaultClient.
+func NewTransport(baseURL string, httpClient HTTPDoer, editors ...RequestEditor) *Transport {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Transport{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		HTTPClient: httpClient,
+		Editors:    editors,
+	}
+}
+
+// Do sends r and decodes a successful response body into result.
+// 2xx가 아닌 응답은 *client.StatusError로 반환하므로 client.Retry와 서킷 브레이커 판정을 그대로 쓸 수 있습니다.
+// 응답이 공통 envelope({"success": true, "data": ...})면 data만 디코딩하고, 본문이 비어 있으면 디코딩하지 않습니다.
+func (t *Transport) Do(ctx context.Context, r Request, result any, editors ...RequestEditor) error {
+	target := t.BaseURL + r.Path
+	if len(r.Query) > 0 {
+		target += "?" + r.Query.Encode()
+	}
+
+	var body io.Reader
+	if r.Body != nil {
+		data, err := json.Marshal(r.Body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.Method, target, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range r.Header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if r.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	commonotel.InjectTraceHeaders(ctx, req)
+
+	for _, edit := range append(append([]RequestEditor{}, t.Editors...), editors...) {
+		if err := edit(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.HTTPClient.Do(req)
+	if t.Observe != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		t.Observe(r.Method, target, status, time.Since(start), err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &client.StatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("%s %s returned status %d: %s", r.Method, r.Path, resp.StatusCode, string(data)),
+		}
+	}
+
+	if result == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := client.DecodeResponse(data, result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+type item struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestTransport_Do는 요청 구성과 envelope 응답 디코딩을 테스트합니다.
+func TestTransport_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/items/a%2Fb" && r.URL.RawPath != "/api/items/a%2Fb" {
+			t.Errorf("경로 파라미터가 이스케이프되어야 함, 실제: %s", r.URL.RawPath)
+		}
+		if got := r.URL.Query()["tag"]; len(got) != 2 {
+			t.Errorf("슬라이스 쿼리는 값마다 추가되어야 함, 실제: %v", got)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Workspace-Id") != "ws" || r.Header.Get("x-internal-api-key") != "key" {
+			t.Errorf("헤더 누락: %v", r.Header)
+		}
+		var body item
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Name != "new" {
+			t.Errorf("JSON 본문 예상, 실제: %+v, %v", body, err)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("본문이 있으면 Content-Type이 application/json이어야 함")
+		}
+		_, _ = w.Write([]byte(`{"success":true,"data":{"id":"1","name":"new"},"requestId":"r"}`))
+	}))
+	defer server.Close()
+
+	var observed int
+	transport := NewTransport(server.URL+"/", server.Client(), WithHeader("x-internal-api-key", "key"))
+	transport.Observe = func(method, url string, status int, _ time.Duration, err error) {
+		observed = status
+	}
+
+	req := Request{Method: http.MethodPost, Path: "/api/items/" + PathParam("a/b"), Body: item{Name: "new"}}
+	req.AddQuery("tag", []string{"x", "y"})
+	req.AddHeader("X-Workspace-Id", "ws")
+
+	var result item
+	if err := transport.Do(context.Background(), req, &result, WithBearerToken("token")); err != nil {
+		t.Fatalf("Do 실패: %v", err)
+	}
+	if result.ID != "1" || result.Name != "new" {
+		t.Errorf("envelope의 data만 디코딩되어야 함, 실제: %+v", result)
+	}
+	if observed != http.StatusOK {
+		t.Errorf("Observe가 상태 코드를 받아야 함, 실제: %d", observed)
+	}
+}
+
+// TestTransport_Do_StatusError는 2xx가 아닌 응답을 client.StatusError로 반환하는지 테스트합니다.
+func TestTransport_Do_StatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("빈 토큰이면 Authorization 헤더를 보내지 않아야 함")
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewTransport(server.URL, server.Client()).Do(context.Background(), Request{Method: http.MethodGet, Path: "/api/items"}, &item{}, WithBearerToken(""))
+	var statusErr *client.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("StatusError(503) 예상, 실제: %v", err)
+	}
+	if !client.IsRetryable(err) {
+		t.Errorf("503은 재시도 대상이어야 함")
+	}
+}
+
+// TestTransport_Do_EmptyBody는 본문 없는 성공 응답을 디코딩하지 않는지 테스트합니다.
+func TestTransport_Do_EmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	result := item{ID: "kept"}
+	if err := NewTransport(server.URL, nil).Do(context.Background(), Request{Method: http.MethodPost, Path: "/"}, &result); err != nil {
+		t.Fatalf("빈 본문은 에러가 아니어야 함: %v", err)
+	}
+	if result.ID != "kept" {
+		t.Errorf("결과를 덮어쓰지 않아야 함, 실제: %+v", result)
+	}
+}
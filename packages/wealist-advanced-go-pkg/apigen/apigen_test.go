@@ -0,0 +1,174 @@
+package apigen
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "Test API"},
+  "basePath": "/api",
+  "paths": {
+    "/items/{itemId}": {
+      "get": {
+        "operationId": "getItem",
+        "summary": "Get item",
+        "parameters": [
+          {"name": "itemId", "in": "path", "required": true, "type": "string"},
+          {"name": "X-Workspace-Id", "in": "header", "required": true, "type": "string"},
+          {"name": "fields", "in": "query", "type": "array", "items": {"type": "string"}},
+          {"name": "limit", "in": "query", "type": "integer"}
+        ],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/svc_internal_domain.Item"}}}
+      },
+      "delete": {
+        "parameters": [{"name": "itemId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"204": {"description": "No Content"}}
+      }
+    },
+    "/internal/items": {
+      "post": {
+        "operationId": "createItem",
+        "parameters": [{"name": "request", "in": "body", "required": true, "schema": {"$ref": "#/definitions/svc_internal_domain.Item"}}],
+        "responses": {"201": {"description": "Created", "schema": {"type": "object", "additionalProperties": {"type": "boolean"}}}}
+      }
+    }
+  },
+  "definitions": {
+    "svc_internal_domain.Item": {
+      "type": "object",
+      "required": ["itemId"],
+      "properties": {
+        "itemId": {"type": "string"},
+        "status": {"description": "Current status", "allOf": [{"$ref": "#/definitions/svc_internal_domain.Status"}]},
+        "tags": {"type": "array", "items": {"type": "string"}},
+        "metadata": {"type": "object", "additionalProperties": true}
+      }
+    },
+    "svc_internal_domain.Status": {
+      "type": "string",
+      "enum": ["OPEN", "DONE"],
+      "x-enum-varnames": ["StatusOpen", "StatusDone"]
+    }
+  }
+}`
+
+func mustParse(t *testing.T, doc string) *Spec {
+	t.Helper()
+	spec, err := Parse([]byte(doc))
+	if err != nil {
+		t.Fatalf("Parse 실패: %v", err)
+	}
+	return spec
+}
+
+// TestGenerateGo는 정의와 오퍼레이션에서 타입과 Client 메서드를 생성하는지 테스트합니다.
+func TestGenerateGo(t *testing.T) {
+	src, err := GenerateGo(mustParse(t, testSpec), GoOptions{Package: "testapi", Source: "svc/swagger.json"})
+	if err != nil {
+		t.Fatalf("GenerateGo 실패: %v", err)
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"// Code generated by apigen from svc/swagger.json. DO NOT EDIT.",
+		"package testapi",
+		"ItemID   string         `json:\"itemId\"`",
+		"// Current status\n\tStatus Status   `json:\"status,omitempty\"`",
+		"Metadata map[string]any `json:\"metadata,omitempty\"`",
+		"StatusOpen Status = \"OPEN\"",
+		"func (c *Client) GetItem(ctx context.Context, itemID string, xWorkspaceID string, params *GetItemParams, editors ...apiclient.RequestEditor) (*Item, error)",
+		"Path:   \"/api/items/\" + apiclient.PathParam(itemID),",
+		"req.AddHeader(\"X-Workspace-Id\", xWorkspaceID)",
+		"if len(params.Fields) > 0 {",
+		"if params.Limit != 0 {",
+		// operationId가 없으면 메서드와 경로로 이름을 만듦
+		"func (c *Client) DeleteItemsByItemID(ctx context.Context, itemID string, editors ...apiclient.RequestEditor) error",
+		"func (c *Client) CreateItem(ctx context.Context, body *Item, editors ...apiclient.RequestEditor) (map[string]bool, error)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("생성 결과에 %q 없음\n%s", want, out)
+		}
+	}
+}
+
+// TestGenerateTS는 TypeScript SDK 생성과 경로 제외를 테스트합니다.
+func TestGenerateTS(t *testing.T) {
+	src, err := GenerateTS(mustParse(t, testSpec), TSOptions{Name: "test", Source: "svc/swagger.json", Exclude: []string{"/internal/"}})
+	if err != nil {
+		t.Fatalf("GenerateTS 실패: %v", err)
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"import type { AxiosInstance, AxiosRequestConfig, RawAxiosRequestHeaders } from 'axios';",
+		"export interface Item {\n  itemId: string;",
+		"  /** Current status */\n  status?: Status;",
+		"export type Status = 'OPEN' | 'DONE';",
+		"getItem: async (itemId: string, xWorkspaceId: string, params?: { fields?: string[]; limit?: number }, config?: AxiosRequestConfig): Promise<Item> => {",
+		"url: `/api/items/${encodeURIComponent(itemId)}`,",
+		"params: { fields: params?.fields, limit: params?.limit },",
+		"'X-Workspace-Id': xWorkspaceId",
+		"deleteItemsByItemID: async (itemId: string, config?: AxiosRequestConfig): Promise<void> => {",
+		"export const createTestApi = (client: AxiosInstance) => ({",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("생성 결과에 %q 없음\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "createItem") {
+		t.Errorf("/internal/ 경로는 제외되어야 함")
+	}
+}
+
+// TestGenerate_NameCollision은 이름이 겹치는 정의와 오퍼레이션을 에러로 알리는지 테스트합니다.
+func TestGenerate_NameCollision(t *testing.T) {
+	definitions := mustParse(t, `{"swagger": "2.0", "definitions": {
+		"a_domain.Item": {"type": "object"},
+		"b_domain.Item": {"type": "object"}
+	}}`)
+	if _, err := GenerateGo(definitions, GoOptions{Package: "x"}); err == nil || !strings.Contains(err.Error(), "both map to Item") {
+		t.Errorf("정의 이름 충돌 에러 예상, 실제: %v", err)
+	}
+
+	operations := mustParse(t, `{"swagger": "2.0", "paths": {
+		"/a": {"get": {"operationId": "list", "responses": {}}},
+		"/b": {"get": {"operationId": "list", "responses": {}}}
+	}}`)
+	if _, err := GenerateTS(operations, TSOptions{Name: "x"}); err == nil || !strings.Contains(err.Error(), "@ID") {
+		t.Errorf("operationId 충돌 에러 예상, 실제: %v", err)
+	}
+}
+
+// TestParse_Version은 Swagger 2.0이 아닌 문서를 거부하는지 테스트합니다.
+func TestParse_Version(t *testing.T) {
+	if _, err := Parse([]byte(`{"openapi": "3.0.0"}`)); err == nil {
+		t.Error("OpenAPI 3 문서는 거부되어야 함")
+	}
+}
+
+// TestExportedName은 JSON/헤더 이름을 Go 식별자로 바꾸는 규칙을 테스트합니다.
+func TestExportedName(t *testing.T) {
+	tests := map[string]string{
+		"userId":                            "UserID",
+		"profileImageUrl":                   "ProfileImageURL",
+		"X-Workspace-Id":                    "XWorkspaceID",
+		"generatePresignedURL":              "GeneratePresignedURL",
+		"oAuthLogin":                        "OAuthLogin",
+		"attachmentIds":                     "AttachmentIDs",
+		"user-service_internal_domain.Role": "UserServiceInternalDomainRole",
+		"2fa":                               "N2fa",
+	}
+	for in, want := range tests {
+		if got := exportedName(in); got != want {
+			t.Errorf("exportedName(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if got := unexportedName("X-Workspace-Id"); got != "xWorkspaceID" {
+		t.Errorf("unexportedName = %q, want xWorkspaceID", got)
+	}
+	if got := unexportedName("type"); got != "typeParam" {
+		t.Errorf("Go 키워드는 피해야 함, 실제: %q", got)
+	}
+}
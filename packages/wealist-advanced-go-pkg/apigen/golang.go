@@ -0,0 +1,367 @@
+package apigen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// GoOptions configures GenerateGo.
+type GoOptions struct {
+	// Package is the name of the generated package (e.g. userapi).
+	Package string
+	// Source is the spec path written in the generated header.
+	Source string
+	// Exclude skips paths with any of these prefixes.
+	Exclude []string
+}
+
+// GenerateGo generates a Go client package for spec.
+// 생성된 패키지는 정의마다 타입을, 오퍼레이션마다 Client 메서드를 가지며 apiclient.Transport로 요청을 보냅니다.
+func GenerateGo(spec *Spec, opts GoOptions) ([]byte, error) {
+	if opts.Package == "" {
+		return nil, fmt.Errorf("apigen: package name is required")
+	}
+	names, keys, err := spec.definitionNames()
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := spec.endpoints(opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &goGen{names: names}
+	for _, key := range keys {
+		g.definition(names[key], spec.Definitions[key])
+	}
+	for _, ep := range endpoints {
+		if err := g.method(spec.BasePath, ep); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by apigen from %s. DO NOT EDIT.\n\n", opts.Source)
+	fmt.Fprintf(&out, "// Package %s is a typed client for the %s.\n", opts.Package, titleOr(spec.Info.Title, "API"))
+	fmt.Fprintf(&out, "package %s\n\n", opts.Package)
+
+	out.WriteString("import (\n\t\"context\"\n\n\t\"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient\"\n)\n\n")
+
+	fmt.Fprintf(&out, "// BasePath is the path prefix of every operation.\nconst BasePath = %q\n\n", spec.BasePath)
+	fmt.Fprintf(&out, "// Client calls the %s.\n", titleOr(spec.Info.Title, "API"))
+	out.WriteString("type Client struct {\n\tt *apiclient.Transport\n}\n\n")
+	out.WriteString("// New returns a Client that sends requests through t.\n")
+	out.WriteString("func New(t *apiclient.Transport) *Client {\n\treturn &Client{t: t}\n}\n\n")
+	out.Write(g.types.Bytes())
+	out.Write(g.methods.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("apigen: generated invalid Go source: %w", err)
+	}
+	return src, nil
+}
+
+type goGen struct {
+	names   map[string]string
+	types   bytes.Buffer
+	methods bytes.Buffer
+}
+
+// definition writes the type declaration of one definition.
+func (g *goGen) definition(name string, s *Schema) {
+	switch {
+	case s.Type == "string" && len(s.Enum) > 0:
+		writeComment(&g.types, name, s.Description)
+		fmt.Fprintf(&g.types, "type %s string\n\n", name)
+		fmt.Fprintf(&g.types, "// %s values.\nconst (\n", name)
+		for i, value := range s.Enum {
+			constName := name + exportedName(fmt.Sprint(value))
+			if i < len(s.EnumVarNames) {
+				constName = exportedName(s.EnumVarNames[i])
+			}
+			fmt.Fprintf(&g.types, "\t%s %s = %q", constName, name, fmt.Sprint(value))
+			if comment := s.EnumComments[constName]; comment != "" {
+				fmt.Fprintf(&g.types, " // %s", comment)
+			}
+			g.types.WriteString("\n")
+		}
+		g.types.WriteString(")\n\n")
+	case len(s.Properties) > 0 || (s.Type == "object" && s.AdditionalProperties == nil):
+		writeComment(&g.types, name, s.Description)
+		fmt.Fprintf(&g.types, "type %s %s\n\n", name, g.structType(s))
+	default:
+		writeComment(&g.types, name, s.Description)
+		fmt.Fprintf(&g.types, "type %s %s\n\n", name, g.typeOf(s))
+	}
+}
+
+// structType returns a struct type with one field per property, sorted by JSON name.
+func (g *goGen) structType(s *Schema) string {
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, prop := range props {
+		field := s.Properties[prop]
+		if desc := strings.TrimSpace(field.Description); desc != "" {
+			for _, line := range strings.Split(desc, "\n") {
+				fmt.Fprintf(&b, "\t// %s\n", line)
+			}
+		}
+		tag := prop
+		if !required[prop] {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", exportedName(prop), g.typeOf(field), tag)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// typeOf returns the Go type of a schema.
+func (g *goGen) typeOf(s *Schema) string {
+	if s == nil {
+		return "any"
+	}
+	if s.Ref != "" {
+		return g.names[refName(s.Ref)]
+	}
+	if len(s.AllOf) == 1 {
+		return g.typeOf(s.AllOf[0])
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + g.typeOf(s.Items)
+	case "object":
+		if value, ok := s.Additional(); ok {
+			return "map[string]" + g.typeOf(value)
+		}
+		if len(s.Properties) > 0 {
+			return g.structType(s)
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// paramType returns the Go type of a non-body parameter.
+func (g *goGen) paramType(p *Parameter) string {
+	if p.Type == "array" {
+		return "[]" + g.typeOf(p.Items)
+	}
+	return g.typeOf(&Schema{Type: p.Type, Format: p.Format})
+}
+
+// method writes the Client method and the optional parameter struct of one operation.
+func (g *goGen) method(basePath string, ep endpoint) error {
+	op := ep.Op
+	var args, pathArgs []string
+	argNames := map[string]string{}
+	argName := func(p *Parameter) string {
+		name := unexportedName(p.Name)
+		for _, taken := range argNames {
+			if taken == name {
+				name += exportedName(p.In)
+			}
+		}
+		if name == "ctx" || name == "editors" || name == "result" || name == "params" {
+			name += "Param"
+		}
+		argNames[p.In+":"+p.Name] = name
+		return name
+	}
+
+	for _, p := range pathParameters(ep.Path, op) {
+		name := argName(p)
+		args = append(args, name+" "+g.paramType(p))
+		pathArgs = append(pathArgs, name)
+	}
+
+	var required, optional []*Parameter
+	for _, in := range []string{"query", "header"} {
+		for _, p := range op.parameters(in) {
+			if p.Required {
+				required = append(required, p)
+				args = append(args, argName(p)+" "+g.paramType(p))
+			} else {
+				optional = append(optional, p)
+			}
+		}
+	}
+
+	var body *Parameter
+	if params := op.parameters("body"); len(params) > 0 {
+		body = params[0]
+		t := g.typeOf(body.Schema)
+		if body.Schema.Ref != "" {
+			t = "*" + t
+		}
+		args = append(args, "body "+t)
+	}
+
+	paramsType := ep.Name + "Params"
+	if len(optional) > 0 {
+		args = append(args, "params *"+paramsType)
+		fmt.Fprintf(&g.types, "// %s holds the optional parameters of Client.%s.\ntype %s struct {\n", paramsType, ep.Name, paramsType)
+		for _, p := range optional {
+			if p.Description != "" {
+				fmt.Fprintf(&g.types, "\t// %s\n", p.Description)
+			}
+			fmt.Fprintf(&g.types, "\t%s %s\n", exportedName(p.Name), g.paramType(p))
+		}
+		g.types.WriteString("}\n\n")
+	}
+	args = append(args, "editors ...apiclient.RequestEditor")
+
+	result := op.successResponse()
+	resultType, zero := "", ""
+	if result != nil {
+		resultType = g.typeOf(result)
+		zero = "nil"
+		if result.Ref != "" {
+			resultType = "*" + resultType
+		} else if !strings.HasPrefix(resultType, "[]") && !strings.HasPrefix(resultType, "map[") {
+			zero = zeroValue(resultType)
+		}
+	}
+
+	w := &g.methods
+	fmt.Fprintf(w, "// %s calls %s %s%s.\n", ep.Name, ep.Method, basePath, ep.Path)
+	if op.Summary != "" {
+		fmt.Fprintf(w, "// %s\n", op.Summary)
+	}
+	returns := "error"
+	if resultType != "" {
+		returns = "(" + resultType + ", error)"
+	}
+	fmt.Fprintf(w, "func (c *Client) %s(ctx context.Context, %s) %s {\n", ep.Name, strings.Join(args, ", "), returns)
+
+	fmt.Fprintf(w, "\treq := apiclient.Request{\n\t\tMethod: %q,\n\t\tPath: %s,\n", ep.Method, g.pathExpr(basePath+ep.Path, pathArgs))
+	if body != nil {
+		w.WriteString("\t\tBody: body,\n")
+	}
+	w.WriteString("\t}\n")
+
+	for _, p := range required {
+		g.setParam(w, p, argNames[p.In+":"+p.Name], "\t")
+	}
+	if len(optional) > 0 {
+		w.WriteString("\tif params != nil {\n")
+		for _, p := range optional {
+			g.setParam(w, p, "params."+exportedName(p.Name), "\t\t")
+		}
+		w.WriteString("\t}\n")
+	}
+
+	switch {
+	case resultType == "":
+		w.WriteString("\treturn c.t.Do(ctx, req, nil, editors...)\n")
+	case result.Ref != "":
+		fmt.Fprintf(w, "\tvar result %s\n", strings.TrimPrefix(resultType, "*"))
+		w.WriteString("\tif err := c.t.Do(ctx, req, &result, editors...); err != nil {\n\t\treturn nil, err\n\t}\n\treturn &result, nil\n")
+	default:
+		fmt.Fprintf(w, "\tvar result %s\n", resultType)
+		fmt.Fprintf(w, "\tif err := c.t.Do(ctx, req, &result, editors...); err != nil {\n\t\treturn %s, err\n\t}\n\treturn result, nil\n", zero)
+	}
+	w.WriteString("}\n\n")
+	return nil
+}
+
+// pathExpr returns a Go expression building path with escaped path parameters.
+func (g *goGen) pathExpr(path string, args []string) string {
+	var parts []string
+	i := 0
+	for {
+		start := strings.Index(path, "{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(path[start:], "}") + start
+		parts = append(parts, fmt.Sprintf("%q", path[:start]), "apiclient.PathParam("+args[i]+")")
+		path = path[end+1:]
+		i++
+	}
+	if path != "" {
+		parts = append(parts, fmt.Sprintf("%q", path))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// setParam writes the statements adding a query or header parameter to req.
+// 선택 파라미터는 값이 비어 있으면 보내지 않습니다.
+func (g *goGen) setParam(w *bytes.Buffer, p *Parameter, value, indent string) {
+	field := "Query"
+	if p.In == "header" {
+		field = "Header"
+	}
+	add := fmt.Sprintf("req.Add%s(%q, %s)", field, p.Name, value)
+	if p.Required {
+		fmt.Fprintf(w, "%s%s\n", indent, add)
+		return
+	}
+	var cond string
+	switch t := g.paramType(p); {
+	case strings.HasPrefix(t, "[]"):
+		cond = "len(" + value + ") > 0"
+	case t == "bool":
+		cond = value
+	case t == "string":
+		cond = value + ` != ""`
+	default:
+		cond = value + " != 0"
+	}
+	fmt.Fprintf(w, "%sif %s {\n%s\t%s\n%s}\n", indent, cond, indent, add, indent)
+}
+
+func zeroValue(t string) string {
+	switch t {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	case "int", "int64", "float64":
+		return "0"
+	}
+	return t + "{}"
+}
+
+func writeComment(w *bytes.Buffer, name, description string) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		fmt.Fprintf(w, "// %s is generated from the API definition of the same name.\n", name)
+		return
+	}
+	for _, line := range strings.Split(description, "\n") {
+		fmt.Fprintf(w, "// %s\n", line)
+	}
+}
+
+func titleOr(title, fallback string) string {
+	if title == "" {
+		return fallback
+	}
+	return title
+}
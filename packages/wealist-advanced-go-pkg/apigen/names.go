@@ -0,0 +1,122 @@
+package apigen
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// initialisms are written in upper case in Go identifiers (userId -> UserID).
+var initialisms = map[string]bool{
+	"API": true, "HTTP": true, "ID": true, "IDS": true, "JSON": true, "SSO": true,
+	"URL": true, "URI": true, "UUID": true, "MFA": true, "SMS": true, "TOTP": true,
+}
+
+// words splits an identifier into words at case changes and separators.
+// "profileImageUrl" -> [profile Image Url], "X-Workspace-Id" -> [X Workspace Id]
+func words(s string) []string {
+	var result []string
+	var current []rune
+	runes := []rune(s)
+	flush := func() {
+		if len(current) > 0 {
+			result = append(result, string(current))
+			current = nil
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(current) > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// "userId"의 I, "URLPath"의 P처럼 새 단어가 시작되는 대문자
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+	return result
+}
+
+// exportedName converts s to an exported Go identifier ("userId" -> "UserID").
+func exportedName(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			if upper == "IDS" {
+				upper = "IDs"
+			}
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(w)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	name := b.String()
+	if name != "" && unicode.IsDigit([]rune(name)[0]) {
+		name = "N" + name
+	}
+	return name
+}
+
+// unexportedName converts s to an unexported Go identifier ("X-Workspace-Id" -> "xWorkspaceID").
+func unexportedName(s string) string {
+	name := exportedName(s)
+	if name == "" {
+		return name
+	}
+	ws := words(name)
+	first := ws[0]
+	rest := strings.TrimPrefix(name, first)
+	if initialisms[strings.ToUpper(first)] {
+		first = strings.ToLower(first)
+	} else {
+		runes := []rune(first)
+		first = string(unicode.ToLower(runes[0])) + string(runes[1:])
+	}
+	name = first + rest
+	if token.IsKeyword(name) {
+		name += "Param"
+	}
+	return name
+}
+
+// lowerCamel converts an exported name to lower camel case for TypeScript ("GetUser" -> "getUser").
+func lowerCamel(name string) string {
+	if name == "" {
+		return name
+	}
+	ws := words(name)
+	first := ws[0]
+	if initialisms[strings.ToUpper(first)] {
+		return strings.ToLower(first) + strings.TrimPrefix(name, first)
+	}
+	runes := []rune(name)
+	return string(unicode.ToLower(runes[0])) + string(runes[1:])
+}
+
+// operationName builds a method name from the method and path when the operation has no operationId.
+// GET /workspaces/{workspaceId}/members -> GetWorkspacesMembersByWorkspaceID
+func operationName(method, path string) string {
+	var parts, params []string
+	for _, segment := range strings.Split(path, "/") {
+		switch {
+		case segment == "":
+		case strings.HasPrefix(segment, "{"):
+			params = append(params, exportedName(strings.Trim(segment, "{}")))
+		default:
+			parts = append(parts, exportedName(segment))
+		}
+	}
+	name := exportedName(strings.ToLower(method)) + strings.Join(parts, "")
+	if len(params) > 0 {
+		name += "By" + strings.Join(params, "And")
+	}
+	return name
+}
@@ -0,0 +1,255 @@
+// Package apigen은 swag가 만든 각 서비스의 swagger(OpenAPI 2.0) 명세에서
+// 타입이 있는 Go 클라이언트 패키지와 TypeScript SDK를 생성합니다.
+//
+// 핸들러의 swag 주석 → swagger.json → 클라이언트 순서로 만들어지므로, 핸들러의 요청/응답이 바뀌면
+// `make swagger`와 `go generate ./apiclient/...`로 클라이언트도 함께 바뀝니다.
+// 메서드 이름은 operationId(swag의 @ID)를 사용하고, 없으면 메서드와 경로로 만듭니다.
+package apigen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Spec is the subset of a Swagger 2.0 document that swag emits.
+type Spec struct {
+	Swagger     string               `json:"swagger"`
+	Info        Info                 `json:"info"`
+	BasePath    string               `json:"basePath"`
+	Paths       map[string]*PathItem `json:"paths"`
+	Definitions map[string]*Schema   `json:"definitions"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// PathItem holds the operations of one path.
+type PathItem struct {
+	Get    *Operation `json:"get"`
+	Put    *Operation `json:"put"`
+	Post   *Operation `json:"post"`
+	Delete *Operation `json:"delete"`
+	Patch  *Operation `json:"patch"`
+}
+
+// Operation is a single API operation.
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Tags        []string            `json:"tags"`
+	Parameters  []*Parameter        `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is an operation parameter (path, query, header or body).
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Type        string  `json:"type"`
+	Format      string  `json:"format"`
+	Items       *Schema `json:"items"`
+	Enum        []any   `json:"enum"`
+	Schema      *Schema `json:"schema"` // body parameters only
+}
+
+// Response is an operation response.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema as used by Swagger 2.0.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Enum                 []any              `json:"enum"`
+	EnumVarNames         []string           `json:"x-enum-varnames"`
+	EnumComments         map[string]string  `json:"x-enum-comments"`
+	AllOf                []*Schema          `json:"allOf"`
+}
+
+// Additional returns the value schema of a map schema.
+// additionalProperties가 true면 값 타입을 알 수 없으므로 nil, true를 반환합니다.
+func (s *Schema) Additional() (*Schema, bool) {
+	raw := strings.TrimSpace(string(s.AdditionalProperties))
+	switch raw {
+	case "", "false":
+		return nil, false
+	case "true":
+		return nil, true
+	}
+	var value Schema
+	if err := json.Unmarshal(s.AdditionalProperties, &value); err != nil {
+		return nil, true
+	}
+	return &value, true
+}
+
+// Load reads a swagger.json file.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse decodes a swagger.json document.
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("apigen: invalid swagger document: %w", err)
+	}
+	if spec.Swagger != "2.0" {
+		return nil, fmt.Errorf("apigen: unsupported swagger version %q (want 2.0)", spec.Swagger)
+	}
+	return &spec, nil
+}
+
+// endpoint is an operation with its method and path.
+type endpoint struct {
+	Method string // GET, POST, ...
+	Path   string
+	Op     *Operation
+	Name   string // exported Go name (TypeScript uses it in lower camel case)
+}
+
+// endpoints returns the operations of spec sorted by path and method.
+// exclude에 있는 접두사로 시작하는 경로는 제외합니다 (예: 브라우저에서 호출할 수 없는 /internal/).
+func (s *Spec) endpoints(exclude []string) ([]endpoint, error) {
+	paths := make([]string, 0, len(s.Paths))
+	for path := range s.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var endpoints []endpoint
+	seen := map[string]string{}
+	for _, path := range paths {
+		if hasAnyPrefix(path, exclude) {
+			continue
+		}
+		item := s.Paths[path]
+		for _, m := range []struct {
+			method string
+			op     *Operation
+		}{
+			{"GET", item.Get}, {"POST", item.Post}, {"PUT", item.Put}, {"PATCH", item.Patch}, {"DELETE", item.Delete},
+		} {
+			if m.op == nil {
+				continue
+			}
+			name := exportedName(m.op.OperationID)
+			if name == "" {
+				name = operationName(m.method, path)
+			}
+			if other, ok := seen[name]; ok {
+				return nil, fmt.Errorf("apigen: %s %s and %s both map to %s; set distinct @ID annotations", m.method, path, other, name)
+			}
+			seen[name] = m.method + " " + path
+			endpoints = append(endpoints, endpoint{Method: m.method, Path: path, Op: m.op, Name: name})
+		}
+	}
+	return endpoints, nil
+}
+
+// definitionNames maps definition keys (e.g. user-service_internal_domain.UserResponse)
+// to type names (UserResponse), sorted by type name.
+func (s *Spec) definitionNames() (map[string]string, []string, error) {
+	names := make(map[string]string, len(s.Definitions))
+	owners := map[string]string{}
+	for key := range s.Definitions {
+		name := exportedName(key[strings.LastIndex(key, ".")+1:])
+		if other, ok := owners[name]; ok {
+			return nil, nil, fmt.Errorf("apigen: definitions %s and %s both map to %s", key, other, name)
+		}
+		owners[name] = key
+		names[key] = name
+	}
+	keys := make([]string, 0, len(names))
+	for key := range names {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return names[keys[i]] < names[keys[j]] })
+	return names, keys, nil
+}
+
+// refName returns the definition key of a $ref.
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+// successResponse returns the schema of the lowest 2xx response, or nil when it has no body.
+func (op *Operation) successResponse() *Schema {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if schema := op.Responses[code].Schema; schema != nil {
+			return schema
+		}
+	}
+	return nil
+}
+
+// parameters returns the parameters of op located in in, in declaration order.
+func (op *Operation) parameters(in string) []*Parameter {
+	var params []*Parameter
+	for _, p := range op.Parameters {
+		if p.In == in {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// pathParameters returns the path parameters in the order they appear in path.
+func pathParameters(path string, op *Operation) []*Parameter {
+	declared := map[string]*Parameter{}
+	for _, p := range op.parameters("path") {
+		declared[p.Name] = p
+	}
+	var params []*Parameter
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+		name := segment[1 : len(segment)-1]
+		p, ok := declared[name]
+		if !ok {
+			// swag 주석에 @Param이 빠진 경로 변수도 문자열로 받습니다.
+			p = &Parameter{Name: name, In: "path", Required: true, Type: "string"}
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package apigen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TSOptions configures GenerateTS.
+type TSOptions struct {
+	// Name is the SDK name; the module exports create<Name>Api (e.g. User -> createUserApi).
+	Name string
+	// Source is the spec path written in the generated header.
+	Source string
+	// Exclude skips paths with any of these prefixes (e.g. /internal/, which browsers cannot call).
+	Exclude []string
+}
+
+// GenerateTS generates a TypeScript SDK for spec.
+// 생성된 모듈은 정의마다 타입을, 오퍼레이션마다 axios 인스턴스로 요청하는 함수를 가집니다.
+// 인증 헤더와 토큰 갱신은 apiConfig.ts의 axios 인스턴스 인터셉터가 처리합니다.
+func GenerateTS(spec *Spec, opts TSOptions) ([]byte, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("apigen: SDK name is required")
+	}
+	names, keys, err := spec.definitionNames()
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := spec.endpoints(opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &tsGen{names: names}
+	var types bytes.Buffer
+	for _, key := range keys {
+		g.definition(&types, names[key], spec.Definitions[key])
+	}
+	var methods bytes.Buffer
+	for _, ep := range endpoints {
+		g.method(&methods, spec.BasePath, ep)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by apigen from %s. DO NOT EDIT.\n\n", opts.Source)
+	axiosTypes := []string{"AxiosInstance", "AxiosRequestConfig"}
+	if g.usesHeaders {
+		axiosTypes = append(axiosTypes, "RawAxiosRequestHeaders")
+	}
+	fmt.Fprintf(&out, "import type { %s } from 'axios';\n\n", strings.Join(axiosTypes, ", "))
+	out.Write(types.Bytes())
+	if g.usesUnwrap {
+		out.WriteString("// 공통 응답 envelope({ success, data })면 data만 반환합니다.\n")
+		out.WriteString("const unwrap = <T>(body: unknown): T =>\n")
+		out.WriteString("  (body !== null && typeof body === 'object' && 'success' in body && 'data' in body\n")
+		out.WriteString("    ? (body as { data: unknown }).data\n")
+		out.WriteString("    : body) as T;\n\n")
+	}
+	factory := "create" + exportedName(opts.Name) + "Api"
+	fmt.Fprintf(&out, "/** %s client. Pass the axios instance of the service (e.g. userRepoClient). */\n", titleOr(spec.Info.Title, "API"))
+	fmt.Fprintf(&out, "export const %s = (client: AxiosInstance) => ({\n", factory)
+	out.Write(methods.Bytes())
+	out.WriteString("});\n\n")
+	fmt.Fprintf(&out, "export type %sApi = ReturnType<typeof %s>;\n", exportedName(opts.Name), factory)
+	return out.Bytes(), nil
+}
+
+type tsGen struct {
+	names       map[string]string
+	usesUnwrap  bool
+	usesHeaders bool
+}
+
+func (g *tsGen) definition(w *bytes.Buffer, name string, s *Schema) {
+	if desc := strings.TrimSpace(s.Description); desc != "" {
+		fmt.Fprintf(w, "/** %s */\n", strings.ReplaceAll(desc, "\n", " "))
+	}
+	if len(s.Properties) > 0 || (s.Type == "object" && s.AdditionalProperties == nil) {
+		fmt.Fprintf(w, "export interface %s %s\n\n", name, g.objectType(s, ""))
+		return
+	}
+	fmt.Fprintf(w, "export type %s = %s;\n\n", name, g.typeOf(s, ""))
+}
+
+// objectType returns an object type literal with one member per property, sorted by name.
+func (g *tsGen) objectType(s *Schema, indent string) string {
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, prop := range props {
+		field := s.Properties[prop]
+		if desc := strings.TrimSpace(field.Description); desc != "" {
+			fmt.Fprintf(&b, "%s  /** %s */\n", indent, strings.ReplaceAll(desc, "\n", " "))
+		}
+		optional := "?"
+		if required[prop] {
+			optional = ""
+		}
+		fmt.Fprintf(&b, "%s  %s%s: %s;\n", indent, tsKey(prop), optional, g.typeOf(field, indent+"  "))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+func (g *tsGen) typeOf(s *Schema, indent string) string {
+	if s == nil {
+		return "unknown"
+	}
+	if s.Ref != "" {
+		return g.names[refName(s.Ref)]
+	}
+	if len(s.AllOf) == 1 {
+		return g.typeOf(s.AllOf[0], indent)
+	}
+	if len(s.Enum) > 0 {
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = tsLiteral(v)
+		}
+		return strings.Join(values, " | ")
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		item := g.typeOf(s.Items, indent)
+		if strings.Contains(item, " ") {
+			return "(" + item + ")[]"
+		}
+		return item + "[]"
+	case "object":
+		if value, ok := s.Additional(); ok {
+			return "Record<string, " + g.typeOf(value, indent) + ">"
+		}
+		if len(s.Properties) > 0 {
+			return g.objectType(s, indent)
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+func (g *tsGen) paramType(p *Parameter) string {
+	if p.Type == "array" {
+		return g.typeOf(&Schema{Type: "array", Items: p.Items}, "")
+	}
+	return g.typeOf(&Schema{Type: p.Type, Enum: p.Enum}, "")
+}
+
+func (g *tsGen) method(w *bytes.Buffer, basePath string, ep endpoint) {
+	op := ep.Op
+	var args []string
+	taken := map[string]bool{"client": true, "config": true, "body": true, "params": true}
+	argName := func(p *Parameter) string {
+		name := tsIdent(p.Name)
+		for taken[name] {
+			name += exportedName(p.In)
+		}
+		taken[name] = true
+		return name
+	}
+
+	url, template := basePath, false
+	for _, segment := range strings.Split(strings.TrimPrefix(ep.Path, "/"), "/") {
+		url += "/"
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := argName(&Parameter{Name: segment[1 : len(segment)-1], In: "path"})
+			args = append(args, name+": string")
+			url += "${encodeURIComponent(" + name + ")}"
+			template = true
+			continue
+		}
+		url += segment
+	}
+	if template {
+		url = "`" + url + "`"
+	} else {
+		url = "'" + url + "'"
+	}
+
+	var query, headers, optional []string
+	for _, in := range []string{"query", "header"} {
+		for _, p := range op.parameters(in) {
+			if !p.Required {
+				key := tsKey(p.Name)
+				optional = append(optional, fmt.Sprintf("%s?: %s", key, g.paramType(p)))
+				value := "params?." + p.Name
+				if key != p.Name {
+					value = "params?.[" + key + "]"
+				}
+				entry := key + ": " + value
+				if in == "query" {
+					query = append(query, entry)
+				} else {
+					headers = append(headers, entry)
+				}
+				continue
+			}
+			name := argName(p)
+			args = append(args, name+": "+g.paramType(p))
+			entry := tsKey(p.Name) + ": " + name
+			if in == "query" {
+				query = append(query, entry)
+			} else {
+				headers = append(headers, entry)
+			}
+		}
+	}
+
+	var body bool
+	if params := op.parameters("body"); len(params) > 0 {
+		body = true
+		args = append(args, "body: "+g.typeOf(params[0].Schema, "  "))
+	}
+	if len(optional) > 0 {
+		args = append(args, "params?: { "+strings.Join(optional, "; ")+" }")
+	}
+	args = append(args, "config?: AxiosRequestConfig")
+
+	resultType := "void"
+	if result := op.successResponse(); result != nil {
+		resultType = g.typeOf(result, "  ")
+	}
+
+	fmt.Fprintf(w, "  /** %s%s %s%s */\n", summaryPrefix(op.Summary), ep.Method, basePath, ep.Path)
+	name := ep.Op.OperationID
+	if tsKey(name) != name || name == "" {
+		name = lowerCamel(ep.Name)
+	}
+	fmt.Fprintf(w, "  %s: async (%s): Promise<%s> => {\n", name, strings.Join(args, ", "), resultType)
+	call := "await client.request"
+	if resultType != "void" {
+		call = "const res = " + call
+	}
+	fmt.Fprintf(w, "    %s({\n      ...config,\n      method: '%s',\n      url: %s,\n", call, ep.Method, url)
+	if len(query) > 0 {
+		fmt.Fprintf(w, "      params: { %s },\n", strings.Join(query, ", "))
+	}
+	if len(headers) > 0 {
+		g.usesHeaders = true
+		fmt.Fprintf(w, "      headers: { ...(config?.headers as RawAxiosRequestHeaders), %s },\n", strings.Join(headers, ", "))
+	}
+	if body {
+		w.WriteString("      data: body,\n")
+	}
+	w.WriteString("    });\n")
+	if resultType != "void" {
+		g.usesUnwrap = true
+		fmt.Fprintf(w, "    return unwrap<%s>(res.data);\n", resultType)
+	}
+	w.WriteString("  },\n")
+}
+
+func summaryPrefix(summary string) string {
+	if summary == "" {
+		return ""
+	}
+	return summary + " — "
+}
+
+// tsIdent converts a parameter name to a TypeScript identifier ("X-Workspace-Id" -> "xWorkspaceId").
+func tsIdent(name string) string {
+	var b strings.Builder
+	for i, w := range words(name) {
+		if i == 0 {
+			b.WriteString(strings.ToLower(w[:1]) + w[1:])
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+// tsKey quotes property names that are not valid identifiers (e.g. X-Workspace-Id).
+func tsKey(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || (i > 0 && '0' <= r && r <= '9') {
+			continue
+		}
+		return "'" + name + "'"
+	}
+	return name
+}
+
+func tsLiteral(v any) string {
+	if s, ok := v.(string); ok {
+		return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,145 @@
+// Package apiversion은 /api/v1, /api/v2 같은 버전별 라우트 그룹과 폐기(deprecation) 헤더를 제공합니다.
+//
+// 기존 경로(/api/...)는 v1의 별칭으로 계속 동작하고, 호환되지 않는 DTO 변경은 v2 그룹에 새 핸들러로 추가합니다.
+// 이전 버전은 Deprecation/Sunset/Link 헤더로 폐기를 알린 뒤 정해진 날짜에 제거합니다.
+//
+//	api := apiversion.WithLegacy(r, "/api", apiversion.V1) // /api/... 와 /api/v1/...
+//	api.GET("/boards/:boardId", boardHandler.GetBoard)
+//
+//	v2 := apiversion.New(r, "/api", apiversion.Version{Name: "v2"}) // /api/v2/...
+//	v2.GET("/boards/:boardId", boardHandlerV2.GetBoard)
+package apiversion
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderAPIVersion은 요청을 처리한 API 버전을 알리는 응답 헤더입니다.
+	HeaderAPIVersion = "X-API-Version"
+
+	// HeaderDeprecation은 폐기 시점을 알리는 헤더입니다 (RFC 9745, "@<unix seconds>").
+	HeaderDeprecation = "Deprecation"
+
+	// HeaderSunset은 제거 예정 시점을 알리는 헤더입니다 (RFC 8594).
+	HeaderSunset = "Sunset"
+
+	// ContextKey는 gin 컨텍스트에 요청의 API 버전을 저장하는 키입니다.
+	ContextKey = "api_version"
+)
+
+// Version은 API 버전입니다.
+type Version struct {
+	// Name은 경로에 쓰이는 버전 이름입니다 (예: "v1").
+	Name string
+
+	// Deprecation이 있으면 이 버전의 모든 응답에 폐기 헤더를 붙입니다.
+	Deprecation *Deprecation
+}
+
+// V1은 현재 API 버전입니다. 버전 없는 기존 경로도 v1으로 처리됩니다.
+var V1 = Version{Name: "v1"}
+
+// Deprecation은 폐기된 버전이나 라우트의 안내 정보입니다.
+type Deprecation struct {
+	// Since는 폐기된 시점입니다.
+	Since time.Time
+
+	// Sunset은 제거 예정 시점입니다 (zero이면 헤더 생략).
+	Sunset time.Time
+
+	// Successor는 대체 경로입니다 (예: "/api/v2/boards"). Link 헤더의 successor-version으로 전달됩니다.
+	Successor string
+}
+
+// Deprecated는 응답에 폐기 헤더를 붙이는 미들웨어를 반환합니다.
+// 버전 전체가 아니라 일부 라우트만 바뀔 때 해당 라우트에 붙여 사용합니다.
+//
+//	api.GET("/boards/:boardId", apiversion.Deprecated(apiversion.Deprecation{...}), boardHandler.GetBoard)
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setDeprecationHeaders(c, d)
+		c.Next()
+	}
+}
+
+// FromContext는 요청의 API 버전 이름을 반환합니다. 버전 그룹 밖의 요청이면 빈 문자열입니다.
+func FromContext(c *gin.Context) string {
+	return c.GetString(ContextKey)
+}
+
+// Path는 prefix에 버전을 넣은 경로를 반환합니다.
+// 선두의 /api 바로 뒤에 버전을 넣으므로 서비스 base path와 관계없이 /api/v1/... 형태가 됩니다.
+//
+//	Path("/api", "v1")       // "/api/v1"
+//	Path("/api/chats", "v1") // "/api/v1/chats"
+//	Path("", "v1")           // "/v1"
+func Path(prefix, version string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "/api" || strings.HasPrefix(prefix, "/api/") {
+		return "/api/" + version + strings.TrimPrefix(prefix, "/api")
+	}
+	return prefix + "/" + version
+}
+
+// Unversioned는 경로에서 /api 뒤의 버전 세그먼트를 제거합니다 ("/api/v1/boards" → "/api/boards").
+// 라우트별 설정(rate limit 등)을 버전과 관계없이 한 번만 선언할 때 사용합니다.
+func Unversioned(path string) string {
+	rest, ok := strings.CutPrefix(path, "/api/v")
+	if !ok {
+		return path
+	}
+	i := 0
+	for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+		i++
+	}
+	if i == 0 || (i < len(rest) && rest[i] != '/') {
+		return path
+	}
+	return "/api" + rest[i:]
+}
+
+// New는 Path(prefix, v.Name)에 버전 그룹을 만듭니다.
+func New(parent gin.IRouter, prefix string, v Version) *Group {
+	return &Group{groups: []*gin.RouterGroup{parent.Group(Path(prefix, v.Name), tag(v))}}
+}
+
+// WithLegacy는 버전 없는 기존 경로(prefix)와 버전 경로에 같은 라우트를 등록하는 그룹을 만듭니다.
+// 기존 클라이언트를 깨지 않고 버전 경로를 도입할 때 사용합니다.
+func WithLegacy(parent gin.IRouter, prefix string, v Version) *Group {
+	return &Group{groups: []*gin.RouterGroup{
+		parent.Group(prefix, tag(v)),
+		parent.Group(Path(prefix, v.Name), tag(v)),
+	}}
+}
+
+// tag는 요청에 버전을 기록하고 버전 헤더(폐기된 버전이면 폐기 헤더도)를 붙입니다.
+func tag(v Version) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(ContextKey, v.Name)
+		c.Header(HeaderAPIVersion, v.Name)
+		if v.Deprecation != nil {
+			setDeprecationHeaders(c, *v.Deprecation)
+		}
+		c.Next()
+	}
+}
+
+func setDeprecationHeaders(c *gin.Context, d Deprecation) {
+	if d.Since.IsZero() {
+		c.Header(HeaderDeprecation, "true")
+	} else {
+		c.Header(HeaderDeprecation, fmt.Sprintf("@%d", d.Since.Unix()))
+	}
+	if !d.Sunset.IsZero() {
+		c.Header(HeaderSunset, d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Successor != "" {
+		c.Writer.Header().Add("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, d.Successor))
+	}
+}
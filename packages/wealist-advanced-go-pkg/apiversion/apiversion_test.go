@@ -0,0 +1,126 @@
+package apiversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPath(t *testing.T) {
+	cases := map[string]string{
+		"/api":           "/api/v1",
+		"/api/":          "/api/v1",
+		"/api/chats":     "/api/v1/chats",
+		"/api/storage":   "/api/v1/storage",
+		"/apix":          "/apix/v1",
+		"":               "/v1",
+		"/notifications": "/notifications/v1",
+	}
+	for prefix, want := range cases {
+		if got := Path(prefix, "v1"); got != want {
+			t.Errorf("Path(%q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestUnversioned(t *testing.T) {
+	cases := map[string]string{
+		"/api/v1/boards":  "/api/boards",
+		"/api/v12/chats":  "/api/chats",
+		"/api/v1":         "/api",
+		"/api/boards":     "/api/boards",
+		"/api/videos/v1":  "/api/videos/v1",
+		"/api/vote/1":     "/api/vote/1",
+		"/api/v1beta/abc": "/api/v1beta/abc",
+	}
+	for path, want := range cases {
+		if got := Unversioned(path); got != want {
+			t.Errorf("Unversioned(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestWithLegacy_RegistersBothPaths(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	api := WithLegacy(r, "/api/chats", V1)
+	api.Group("/messages").GET("/:chatId", func(c *gin.Context) {
+		c.String(http.StatusOK, FromContext(c)+":"+c.Param("chatId"))
+	})
+
+	for _, path := range []string{"/api/chats/messages/1", "/api/v1/chats/messages/1"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusOK || w.Body.String() != "v1:1" {
+			t.Errorf("%s: got %d %q", path, w.Code, w.Body.String())
+		}
+		if w.Header().Get(HeaderAPIVersion) != "v1" {
+			t.Errorf("%s: expected %s header, got %q", path, HeaderAPIVersion, w.Header().Get(HeaderAPIVersion))
+		}
+		if w.Header().Get(HeaderDeprecation) != "" {
+			t.Errorf("%s: unexpected deprecation header", path)
+		}
+	}
+}
+
+func TestNew_DeprecatedVersionHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	r := gin.New()
+	v1 := New(r, "/api", Version{Name: "v1", Deprecation: &Deprecation{
+		Since:     since,
+		Sunset:    sunset,
+		Successor: "/api/v2/boards",
+	}})
+	v1.GET("/boards", func(c *gin.Context) { c.Status(http.StatusOK) })
+	New(r, "/api", Version{Name: "v2"}).GET("/boards", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/boards", nil))
+	if got := w.Header().Get(HeaderDeprecation); got != "@1767225600" {
+		t.Errorf("unexpected Deprecation header %q", got)
+	}
+	if got := w.Header().Get(HeaderSunset); got != "Tue, 30 Jun 2026 00:00:00 GMT" {
+		t.Errorf("unexpected Sunset header %q", got)
+	}
+	if got := w.Header().Get("Link"); got != `</api/v2/boards>; rel="successor-version"` {
+		t.Errorf("unexpected Link header %q", got)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/boards", nil))
+	if w.Code != http.StatusOK || w.Header().Get(HeaderAPIVersion) != "v2" || w.Header().Get(HeaderDeprecation) != "" {
+		t.Errorf("unexpected v2 response: %d %v", w.Code, w.Header())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/boards", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected unversioned path to be absent, got %d", w.Code)
+	}
+}
+
+func TestDeprecated_PerRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	api := WithLegacy(r, "/api", V1)
+	api.GET("/old", Deprecated(Deprecation{}), func(c *gin.Context) { c.Status(http.StatusOK) })
+	api.GET("/new", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/old", nil))
+	if got := w.Header().Get(HeaderDeprecation); got != "true" {
+		t.Errorf("expected Deprecation: true, got %q", got)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/new", nil))
+	if got := w.Header().Get(HeaderDeprecation); got != "" {
+		t.Errorf("unexpected Deprecation header %q", got)
+	}
+}
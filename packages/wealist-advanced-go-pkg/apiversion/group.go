@@ -0,0 +1,73 @@
+package apiversion
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Group은 여러 gin.RouterGroup(버전 없는 경로와 버전 경로)에 같은 라우트를 등록합니다.
+// *gin.RouterGroup과 같은 메서드를 제공하므로 기존 라우트 등록 코드를 그대로 사용할 수 있습니다.
+type Group struct {
+	groups []*gin.RouterGroup
+}
+
+// Use는 모든 그룹에 미들웨어를 추가합니다.
+func (g *Group) Use(middleware ...gin.HandlerFunc) *Group {
+	for _, group := range g.groups {
+		group.Use(middleware...)
+	}
+	return g
+}
+
+// Group은 모든 그룹 아래에 하위 그룹을 만듭니다.
+func (g *Group) Group(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	sub := &Group{groups: make([]*gin.RouterGroup, 0, len(g.groups))}
+	for _, group := range g.groups {
+		sub.groups = append(sub.groups, group.Group(relativePath, handlers...))
+	}
+	return sub
+}
+
+// Handle은 모든 그룹에 라우트를 등록합니다.
+func (g *Group) Handle(method, relativePath string, handlers ...gin.HandlerFunc) *Group {
+	for _, group := range g.groups {
+		group.Handle(method, relativePath, handlers...)
+	}
+	return g
+}
+
+// GET은 Handle(http.MethodGet, ...)의 축약입니다.
+func (g *Group) GET(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodGet, relativePath, handlers...)
+}
+
+// POST는 Handle(http.MethodPost, ...)의 축약입니다.
+func (g *Group) POST(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodPost, relativePath, handlers...)
+}
+
+// PUT은 Handle(http.MethodPut, ...)의 축약입니다.
+func (g *Group) PUT(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodPut, relativePath, handlers...)
+}
+
+// PATCH는 Handle(http.MethodPatch, ...)의 축약입니다.
+func (g *Group) PATCH(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodPatch, relativePath, handlers...)
+}
+
+// DELETE는 Handle(http.MethodDelete, ...)의 축약입니다.
+func (g *Group) DELETE(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodDelete, relativePath, handlers...)
+}
+
+// OPTIONS는 Handle(http.MethodOptions, ...)의 축약입니다.
+func (g *Group) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodOptions, relativePath, handlers...)
+}
+
+// HEAD는 Handle(http.MethodHead, ...)의 축약입니다.
+func (g *Group) HEAD(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return g.Handle(http.MethodHead, relativePath, handlers...)
+}
@@ -0,0 +1,104 @@
+// Package auth는 JWT 인증 미들웨어를 제공합니다.
+// 이 파일은 서비스 간 호출에 사용할 client-credentials 토큰 발급 클라이언트를 구현합니다.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// tokenRefreshMargin은 만료 전에 미리 토큰을 갱신하는 여유 시간입니다.
+const tokenRefreshMargin = 30 * time.Second
+
+// ClientCredentialsSource는 auth-service(/api/auth/token)에서 서비스 토큰을 발급받아 캐시합니다.
+// 여러 goroutine에서 동시에 사용할 수 있습니다.
+type ClientCredentialsSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewClientCredentialsSource는 새 ClientCredentialsSource를 생성합니다.
+// authServiceURL: auth-service URL (예: http://auth-service:8080)
+// scopes가 비어 있으면 클라이언트에 등록된 모든 scope로 발급됩니다.
+func NewClientCredentialsSource(authServiceURL, clientID, clientSecret string, scopes []string) *ClientCredentialsSource {
+	return &ClientCredentialsSource{
+		tokenURL:     strings.TrimSuffix(authServiceURL, "/") + "/api/auth/token",
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       scopes,
+		httpClient: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: commonotel.NewTransport(nil), // 호출한 요청의 trace context 전파
+		},
+	}
+}
+
+// Token은 유효한 서비스 토큰을 반환합니다. 만료가 가까우면 새로 발급받습니다.
+func (s *ClientCredentialsSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenRefreshMargin).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request service token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint returned no access token")
+	}
+
+	s.token = result.AccessToken
+	s.expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	return s.token, nil
+}
+
+// Invalidate는 캐시된 토큰을 버립니다 (401 응답을 받은 경우 등).
+func (s *ClientCredentialsSource) Invalidate() {
+	s.mu.Lock()
+	s.token = ""
+	s.mu.Unlock()
+}
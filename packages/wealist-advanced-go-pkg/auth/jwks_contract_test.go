@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/contract"
+)
+
+// TestSmartValidatorContract_JWKS는 ops-service가 쓰는 SmartValidator가
+// 계약(ops-service -> auth-service)의 JWKS 응답에서 서명 키를 읽는지 확인합니다.
+// auth-service(Spring)는 Go 테스트로 검증할 수 없으므로 provider 쪽 검증은 없습니다.
+func TestSmartValidatorContract_JWKS(t *testing.T) {
+	c := contract.Load(t, "ops-service", "auth-service")
+	provider := contract.NewMockProvider(t, c, "fetch the token signing keys")
+
+	v := NewSmartValidator(provider.URL, "wealist-auth-service", zap.NewNop())
+	if err := v.jwksValidator.refreshKeys(context.Background()); err != nil {
+		t.Fatalf("refreshKeys() error = %v", err)
+	}
+
+	key, ok := v.jwksValidator.keys["wealist-contract-key"]
+	if !ok {
+		t.Fatalf("signing key from the contract was not loaded, got %d keys", len(v.jwksValidator.keys))
+	}
+	if key.N.BitLen() != 2048 || key.E != 65537 {
+		t.Errorf("parsed key = %d bits, e=%d; want 2048 bits, e=65537", key.N.BitLen(), key.E)
+	}
+}
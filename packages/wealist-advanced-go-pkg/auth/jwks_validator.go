@@ -45,6 +45,11 @@ type JWKSValidator struct {
 	keys       map[string]*rsa.PublicKey
 	lastFetch  time.Time
 	cacheTTL   time.Duration
+
+	// 키 로테이션 대응: 모르는 kid는 캐시 TTL과 관계없이 다시 가져옴
+	refreshMu   sync.Mutex    // 동시 새로고침 방지
+	lastAttempt time.Time     // 마지막 새로고침 시도 (실패 포함)
+	minRefresh  time.Duration // 모르는 kid로 인한 새로고침 최소 간격
 }
 
 // NewJWKSValidator는 새 JWKSValidator를 생성합니다.
@@ -63,27 +68,67 @@ func NewJWKSValidator(jwksURL, issuer string, logger *zap.Logger) *JWKSValidator
 		logger:   logger,
 		keys:     make(map[string]*rsa.PublicKey),
 		cacheTTL: 5 * time.Minute, // 키 캐시 5분
+		minRefresh: 10 * time.Second,
 	}
 }
 
 // ValidateToken은 JWKS를 사용하여 JWT 토큰을 검증합니다.
+// 서비스 간 호출용 토큰(type: service)은 사용자 토큰으로 인정하지 않습니다.
 func (v *JWKSValidator) ValidateToken(ctx context.Context, tokenString string) (uuid.UUID, error) {
+	claims, err := v.verify(ctx, tokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if isServiceClaims(claims) {
+		return uuid.Nil, ErrServiceToken
+	}
+
+	// 사용자 ID 추출
+	var userIDStr string
+	for _, key := range []string{"sub", "userId", "user_id", "uid"} {
+		if val, exists := claims[key]; exists {
+			if str, ok := val.(string); ok {
+				userIDStr = str
+				break
+			}
+		}
+	}
+
+	if userIDStr == "" {
+		return uuid.Nil, jwt.ErrTokenInvalidClaims
+	}
+
+	return uuid.Parse(userIDStr)
+}
+
+// ValidateServiceToken은 JWKS를 사용하여 서비스 간 호출용 토큰을 검증하고 클라이언트 정보를 반환합니다.
+func (v *JWKSValidator) ValidateServiceToken(ctx context.Context, tokenString string) (*ServiceClaims, error) {
+	claims, err := v.verify(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	return serviceClaimsFrom(claims)
+}
+
+// verify는 서명(kid로 찾은 공개키), 만료, issuer를 검증하고 클레임을 반환합니다.
+func (v *JWKSValidator) verify(ctx context.Context, tokenString string) (jwt.MapClaims, error) {
 	// 토큰 파싱 (검증 없이 헤더만 확인)
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to parse token: %w", err)
+		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
 
 	// kid (Key ID) 추출
 	kid, ok := token.Header["kid"].(string)
 	if !ok {
-		return uuid.Nil, fmt.Errorf("token missing kid header")
+		return nil, fmt.Errorf("token missing kid header")
 	}
 
 	// 공개키 가져오기 (캐시 또는 JWKS에서)
 	publicKey, err := v.getPublicKey(ctx, kid)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to get public key: %w", err)
+		return nil, fmt.Errorf("failed to get public key: %w", err)
 	}
 
 	// 토큰 검증
@@ -96,42 +141,27 @@ func (v *JWKSValidator) ValidateToken(ctx context.Context, tokenString string) (
 	})
 
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("token validation failed: %w", err)
+		return nil, fmt.Errorf("token validation failed: %w", err)
 	}
 
 	if !parsedToken.Valid {
-		return uuid.Nil, jwt.ErrTokenInvalidClaims
+		return nil, jwt.ErrTokenInvalidClaims
 	}
 
 	// 클레임 추출
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
-		return uuid.Nil, jwt.ErrTokenInvalidClaims
+		return nil, jwt.ErrTokenInvalidClaims
 	}
 
 	// issuer 검증
 	if v.issuer != "" {
 		if iss, ok := claims["iss"].(string); !ok || iss != v.issuer {
-			return uuid.Nil, fmt.Errorf("invalid issuer: expected %s, got %s", v.issuer, iss)
+			return nil, fmt.Errorf("invalid issuer: expected %s, got %s", v.issuer, iss)
 		}
 	}
 
-	// 사용자 ID 추출
-	var userIDStr string
-	for _, key := range []string{"sub", "userId", "user_id", "uid"} {
-		if val, exists := claims[key]; exists {
-			if str, ok := val.(string); ok {
-				userIDStr = str
-				break
-			}
-		}
-	}
-
-	if userIDStr == "" {
-		return uuid.Nil, jwt.ErrTokenInvalidClaims
-	}
-
-	return uuid.Parse(userIDStr)
+	return claims, nil
 }
 
 // getPublicKey는 캐시에서 키를 가져오거나 JWKS에서 새로 가져옵니다.
@@ -139,22 +169,39 @@ func (v *JWKSValidator) getPublicKey(ctx context.Context, kid string) (*rsa.Publ
 	v.mu.RLock()
 	key, exists := v.keys[kid]
 	needsRefresh := time.Since(v.lastFetch) > v.cacheTTL
+	lastAttempt := v.lastAttempt
 	v.mu.RUnlock()
 
 	if exists && !needsRefresh {
 		return key, nil
 	}
 
-	// JWKS 새로고침
-	if err := v.refreshKeys(ctx); err != nil {
-		// 캐시된 키가 있으면 사용
-		if exists {
-			v.logger.Warn("Failed to refresh JWKS, using cached key",
-				zap.Error(err),
-				zap.String("kid", kid))
-			return key, nil
+	// 모르는 kid는 auth-service가 새 키로 로테이션한 경우이므로 캐시를 무시하고 새로고침
+	// 단, 위조된 kid로 JWKS를 반복 호출하지 않도록 최소 간격을 둡니다.
+	if !exists && !needsRefresh && time.Since(lastAttempt) < v.minRefresh {
+		return nil, fmt.Errorf("key not found: %s", kid)
+	}
+
+	v.refreshMu.Lock()
+	defer v.refreshMu.Unlock()
+
+	// 대기하는 동안 다른 요청이 이미 새로고침했으면 다시 가져오지 않음
+	v.mu.RLock()
+	alreadyRefreshed := v.lastAttempt.After(lastAttempt)
+	v.mu.RUnlock()
+
+	if !alreadyRefreshed {
+		// JWKS 새로고침
+		if err := v.refreshKeys(ctx); err != nil {
+			// 캐시된 키가 있으면 사용
+			if exists {
+				v.logger.Warn("Failed to refresh JWKS, using cached key",
+					zap.Error(err),
+					zap.String("kid", kid))
+				return key, nil
+			}
+			return nil, err
 		}
-		return nil, err
 	}
 
 	v.mu.RLock()
@@ -170,6 +217,10 @@ func (v *JWKSValidator) getPublicKey(ctx context.Context, kid string) (*rsa.Publ
 
 // refreshKeys는 JWKS 엔드포인트에서 키를 새로 가져옵니다.
 func (v *JWKSValidator) refreshKeys(ctx context.Context) error {
+	v.mu.Lock()
+	v.lastAttempt = time.Now()
+	v.mu.Unlock()
+
 	req, err := http.NewRequestWithContext(ctx, "GET", v.jwksURL, nil)
 	if err != nil {
 		return err
@@ -239,6 +290,13 @@ func jwkToRSAPublicKey(jwk JWK) (*rsa.PublicKey, error) {
 	}, nil
 }
 
+// SetMinRefreshInterval은 모르는 kid로 인한 JWKS 새로고침의 최소 간격을 설정합니다.
+func (v *JWKSValidator) SetMinRefreshInterval(interval time.Duration) {
+	v.mu.Lock()
+	v.minRefresh = interval
+	v.mu.Unlock()
+}
+
 // SetCacheTTL은 키 캐시 TTL을 설정합니다.
 func (v *JWKSValidator) SetCacheTTL(ttl time.Duration) {
 	v.mu.Lock()
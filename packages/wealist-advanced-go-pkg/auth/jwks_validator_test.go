@@ -208,3 +208,79 @@ func TestJWKSValidator_KeyCaching(t *testing.T) {
 		t.Errorf("expected 1 JWKS fetch, got %d", fetchCount)
 	}
 }
+
+func TestJWKSValidator_KeyRotation(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	toJWK := func(kid string, key *rsa.PrivateKey) JWK {
+		return JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+		}
+	}
+
+	// 첫 요청에는 기존 키만, 이후에는 새 키도 함께 게시 (overlapping validity)
+	fetchCount := 0
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetchCount++
+		jwks := JWKS{Keys: []JWK{toJWK("old-key", oldKey)}}
+		if fetchCount > 1 {
+			jwks.Keys = append(jwks.Keys, toJWK("new-key", newKey))
+		}
+		json.NewEncoder(w).Encode(jwks)
+	}))
+	defer jwksServer.Close()
+
+	validator := NewJWKSValidator(jwksServer.URL, "test-issuer", nil)
+	validator.SetCacheTTL(time.Hour) // 캐시 TTL이 길어도 새 kid는 바로 반영되어야 함
+	validator.SetMinRefreshInterval(0)
+
+	createToken := func(kid string, key *rsa.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": uuid.New().String(),
+			"iss": "test-issuer",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = kid
+		tokenString, _ := token.SignedString(key)
+		return tokenString
+	}
+
+	if _, err := validator.ValidateToken(context.Background(), createToken("old-key", oldKey)); err != nil {
+		t.Fatalf("old key validation failed: %v", err)
+	}
+
+	// 로테이션 후 새 kid로 서명된 토큰 - 캐시를 무시하고 JWKS를 다시 가져옴
+	if _, err := validator.ValidateToken(context.Background(), createToken("new-key", newKey)); err != nil {
+		t.Fatalf("new key validation failed: %v", err)
+	}
+	if fetchCount != 2 {
+		t.Errorf("expected 2 JWKS fetches, got %d", fetchCount)
+	}
+
+	// 기존 키로 서명된 토큰도 만료 전까지 계속 유효
+	if _, err := validator.ValidateToken(context.Background(), createToken("old-key", oldKey)); err != nil {
+		t.Errorf("old key validation after rotation failed: %v", err)
+	}
+
+	t.Run("unknown kid is rate limited", func(t *testing.T) {
+		validator.SetMinRefreshInterval(time.Hour)
+		before := fetchCount
+
+		for i := 0; i < 3; i++ {
+			if _, err := validator.ValidateToken(context.Background(), createToken("forged-key", newKey)); err == nil {
+				t.Error("expected error for unknown kid")
+			}
+		}
+
+		// 마지막 새로고침 직후이므로 추가 fetch 없음
+		if fetchCount != before {
+			t.Errorf("expected no additional JWKS fetch, got %d", fetchCount-before)
+		}
+	})
+}
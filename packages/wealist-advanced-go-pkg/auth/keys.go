@@ -11,4 +11,7 @@ const (
 	// TokenContextKey is the key for storing JWT token string in context.
 	// Note: "jwtToken" is used for backward compatibility.
 	TokenContextKey = "jwtToken"
+
+	// ServiceClientContextKey is the key for storing service token claims (*ServiceClaims) in context.
+	ServiceClientContextKey = "service_client"
 )
@@ -0,0 +1,108 @@
+// Package auth는 JWT 인증 미들웨어를 제공합니다.
+// 이 파일은 Redis 기반 토큰 폐기 목록(revocation list)을 구현합니다.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis 키 접두사 - auth-service(TokenRevocationService)와 동일해야 합니다.
+const (
+	// RevokedTokenKeyPrefix는 폐기된 토큰(jti) 키 접두사입니다.
+	// 값은 사용하지 않으며 TTL은 토큰의 남은 만료 시간입니다.
+	RevokedTokenKeyPrefix = "wealist:auth:revoked:jti:"
+
+	// RevokedUserKeyPrefix는 사용자 단위 폐기 키 접두사입니다.
+	// 값은 폐기 시각(Unix 초)이며 그 시각 이전에 발급된 토큰은 모두 폐기됩니다.
+	RevokedUserKeyPrefix = "wealist:auth:revoked:user:"
+)
+
+// ErrTokenRevoked는 폐기 목록에 있는 토큰을 나타냅니다.
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// RevocationChecker는 검증된 토큰의 폐기 여부를 확인하는 인터페이스입니다.
+type RevocationChecker interface {
+	// IsRevoked는 jti 또는 사용자 단위 폐기 여부를 반환합니다.
+	// jti가 비어 있으면(jti 도입 이전 토큰) 사용자 단위 폐기만 확인합니다.
+	IsRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// RedisRevocationStore는 Redis에 저장된 폐기 목록을 조회하고 기록합니다.
+// 폐기 기록은 주로 auth-service(로그아웃, 비밀번호 변경, 계정 탈취 대응)가 담당합니다.
+type RedisRevocationStore struct {
+	client *redis.Client
+}
+
+// NewRedisRevocationStore는 새 RedisRevocationStore를 생성합니다.
+func NewRedisRevocationStore(client *redis.Client) *RedisRevocationStore {
+	return &RedisRevocationStore{client: client}
+}
+
+// IsRevoked는 토큰이 폐기되었는지 확인합니다.
+// 사용자 단위 폐기는 초 단위로 비교하며, 폐기 시각과 같은 초에 발급된 토큰도 폐기로 봅니다.
+func (s *RedisRevocationStore) IsRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	pipe := s.client.Pipeline()
+	var jtiCmd *redis.IntCmd
+	if jti != "" {
+		jtiCmd = pipe.Exists(ctx, RevokedTokenKeyPrefix+jti)
+	}
+	userCmd := pipe.Get(ctx, RevokedUserKeyPrefix+userID.String())
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	if jtiCmd != nil && jtiCmd.Val() > 0 {
+		return true, nil
+	}
+
+	revokedAt, err := userCmd.Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	revokedAtUnix, err := strconv.ParseInt(revokedAt, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	return issuedAt.Unix() <= revokedAtUnix, nil
+}
+
+// RevokeToken은 단일 토큰(jti)을 남은 만료 시간 동안 폐기합니다.
+func (s *RedisRevocationStore) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return s.client.Set(ctx, RevokedTokenKeyPrefix+jti, "1", ttl).Err()
+}
+
+// RevokeUser는 사용자에게 지금까지 발급된 모든 토큰을 폐기합니다.
+// ttl은 가장 긴 토큰(refresh token) 수명 이상이어야 합니다.
+func (s *RedisRevocationStore) RevokeUser(ctx context.Context, userID uuid.UUID, ttl time.Duration) error {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	return s.client.Set(ctx, RevokedUserKeyPrefix+userID.String(), now, ttl).Err()
+}
+
+// revocationClaims는 서명 검증이 끝난 토큰에서 jti와 iat를 추출합니다.
+func revocationClaims(tokenString string) (string, time.Time, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(tokenString, claims); err != nil {
+		return "", time.Time{}, err
+	}
+
+	jti, _ := claims["jti"].(string)
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		// iat가 없으면 사용자 단위 폐기에 항상 걸리도록 zero time 사용
+		return jti, time.Time{}, nil
+	}
+	return jti, issuedAt.Time, nil
+}
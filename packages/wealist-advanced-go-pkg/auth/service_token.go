@@ -0,0 +1,198 @@
+// Package auth는 JWT 인증 미들웨어를 제공합니다.
+// 이 파일은 서비스 간 호출용 토큰(OAuth2 client-credentials) 검증과 scope 확인을 구현합니다.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// ServiceTokenType은 auth-service가 client-credentials 토큰에 넣는 type 클레임 값입니다.
+const ServiceTokenType = "service"
+
+var (
+	// ErrServiceToken은 사용자 토큰 자리에 서비스 토큰이 사용된 경우입니다.
+	ErrServiceToken = errors.New("service token cannot be used as a user token")
+
+	// ErrNotServiceToken은 서비스 토큰 자리에 사용자 토큰이 사용된 경우입니다.
+	ErrNotServiceToken = errors.New("not a service token")
+)
+
+// ServiceClaims는 서비스 토큰에서 추출한 클라이언트 정보입니다.
+type ServiceClaims struct {
+	ClientID string   // 등록된 클라이언트 ID (예: board-service)
+	Scopes   []string // 허용된 scope (예: users:read)
+}
+
+// HasScopes는 모든 scope가 허용되어 있는지 확인합니다.
+func (c *ServiceClaims) HasScopes(scopes ...string) bool {
+	for _, required := range scopes {
+		found := false
+		for _, granted := range c.Scopes {
+			if granted == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ServiceTokenValidator는 서비스 토큰을 검증하는 인터페이스입니다.
+// JWKSValidator, SmartValidator, JWTParser(Istio 모드)가 구현합니다.
+type ServiceTokenValidator interface {
+	ValidateServiceToken(ctx context.Context, token string) (*ServiceClaims, error)
+}
+
+// ValidateServiceToken은 JWKS로 서비스 토큰을 검증합니다.
+// /api/auth/validate는 사용자 토큰 전용이므로 HTTP 검증은 사용하지 않습니다.
+func (v *SmartValidator) ValidateServiceToken(ctx context.Context, tokenString string) (*ServiceClaims, error) {
+	return v.jwksValidator.ValidateServiceToken(ctx, tokenString)
+}
+
+// ValidateServiceToken은 검증 없이 서비스 토큰의 클레임을 추출합니다 (Istio가 서명을 검증한 경우).
+func (p *JWTParser) ValidateServiceToken(ctx context.Context, tokenString string) (*ServiceClaims, error) {
+	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return serviceClaimsFrom(claims)
+}
+
+// IsServiceToken은 서명 검증 없이 서비스 토큰 형식인지 확인합니다.
+// 미들웨어가 검증 전략을 고르는 데만 사용하며, 인가 판단에 사용하면 안 됩니다.
+func IsServiceToken(tokenString string) bool {
+	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	return ok && isServiceClaims(claims)
+}
+
+func isServiceClaims(claims jwt.MapClaims) bool {
+	tokenType, _ := claims["type"].(string)
+	return tokenType == ServiceTokenType
+}
+
+// serviceClaimsFrom은 type, client_id, scope(공백 구분) 클레임을 확인합니다.
+func serviceClaimsFrom(claims jwt.MapClaims) (*ServiceClaims, error) {
+	if !isServiceClaims(claims) {
+		return nil, ErrNotServiceToken
+	}
+	clientID, _ := claims["client_id"].(string)
+	if clientID == "" {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	scope, _ := claims["scope"].(string)
+	return &ServiceClaims{ClientID: clientID, Scopes: strings.Fields(scope)}, nil
+}
+
+// ServiceAuthMiddleware는 서비스 토큰과 scope를 요구하는 Gin 미들웨어입니다.
+// 검증 실패는 401, scope 부족은 403을 반환하고, 성공 시 클라이언트 정보를 컨텍스트에 저장합니다.
+// validator가 nil이면(로컬 JWT 모드 등) 서비스 토큰을 검증할 수 없으므로 모든 요청을 401로 거부합니다.
+func ServiceAuthMiddleware(validator ServiceTokenValidator, logger *zap.Logger, scopes ...string) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if validator == nil {
+		return func(c *gin.Context) {
+			logger.Warn("Rejected service request: no service token validator configured",
+				zap.String("path", c.Request.URL.Path))
+			abortUnauthorized(c, "Service token validation is not configured")
+		}
+	}
+	return func(c *gin.Context) {
+		tokenString, ok := bearerToken(c)
+		if !ok {
+			abortUnauthorized(c, "Service token is required")
+			return
+		}
+		authorizeService(c, validator, logger, tokenString, scopes)
+	}
+}
+
+// UserOrServiceAuthMiddleware는 사용자 토큰과 서비스 토큰을 모두 허용하는 Gin 미들웨어입니다.
+// 서비스 토큰이면 scope를 확인하고, 그 외에는 userAuth(기존 사용자 인증 미들웨어)에 위임합니다.
+func UserOrServiceAuthMiddleware(userAuth gin.HandlerFunc, validator ServiceTokenValidator, logger *zap.Logger, scopes ...string) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return func(c *gin.Context) {
+		tokenString, ok := bearerToken(c)
+		if !ok || !IsServiceToken(tokenString) {
+			userAuth(c)
+			return
+		}
+		authorizeService(c, validator, logger, tokenString, scopes)
+	}
+}
+
+// GetServiceClient는 컨텍스트에서 서비스 토큰 클라이언트 정보를 추출합니다.
+func GetServiceClient(c *gin.Context) (*ServiceClaims, bool) {
+	value, exists := c.Get(ServiceClientContextKey)
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*ServiceClaims)
+	return claims, ok
+}
+
+func authorizeService(c *gin.Context, validator ServiceTokenValidator, logger *zap.Logger, tokenString string, scopes []string) {
+	claims, err := validator.ValidateServiceToken(c.Request.Context(), tokenString)
+	if err != nil {
+		logger.Debug("Service token validation failed", zap.Error(err))
+		abortUnauthorized(c, "Invalid or expired service token")
+		return
+	}
+
+	if !claims.HasScopes(scopes...) {
+		logger.Warn("Service token missing required scope",
+			zap.String("client_id", claims.ClientID),
+			zap.Strings("required_scopes", scopes))
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": gin.H{
+				"code":    "INSUFFICIENT_SCOPE",
+				"message": "Service token does not have the required scope",
+			},
+		})
+		c.Abort()
+		return
+	}
+
+	c.Set(ServiceClientContextKey, claims)
+	c.Set(TokenContextKey, tokenString)
+	c.Next()
+}
+
+func bearerToken(c *gin.Context) (string, bool) {
+	parts := strings.Split(c.GetHeader("Authorization"), " ")
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+func abortUnauthorized(c *gin.Context, message string) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": gin.H{
+			"code":    "UNAUTHORIZED",
+			"message": message,
+		},
+	})
+	c.Abort()
+}
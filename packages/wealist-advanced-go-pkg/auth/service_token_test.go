@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// newTestJWKS는 테스트용 RSA 키와 JWKS 서버를 생성합니다.
+func newTestJWKS(t *testing.T, keyID string) (*rsa.PrivateKey, *httptest.Server) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(JWKS{Keys: []JWK{{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: keyID,
+			N:   base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+		}}})
+	}))
+	t.Cleanup(server.Close)
+	return privateKey, server
+}
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, keyID string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func serviceTokenClaims(clientID, scope string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":       clientID,
+		"client_id": clientID,
+		"scope":     scope,
+		"type":      ServiceTokenType,
+		"iss":       "test-issuer",
+		"exp":       time.Now().Add(5 * time.Minute).Unix(),
+		"iat":       time.Now().Unix(),
+	}
+}
+
+func TestJWKSValidator_ValidateServiceToken(t *testing.T) {
+	key, server := newTestJWKS(t, "test-key-1")
+	validator := NewJWKSValidator(server.URL, "test-issuer", nil)
+	ctx := context.Background()
+
+	serviceToken := signTestToken(t, key, "test-key-1", serviceTokenClaims("board-service", "users:read users:internal"))
+	claims, err := validator.ValidateServiceToken(ctx, serviceToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if claims.ClientID != "board-service" || !claims.HasScopes("users:read", "users:internal") {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+	if claims.HasScopes("users:write") {
+		t.Error("expected users:write to be missing")
+	}
+
+	// 서비스 토큰은 사용자 토큰으로 사용할 수 없음
+	if _, err := validator.ValidateToken(ctx, serviceToken); !errors.Is(err, ErrServiceToken) {
+		t.Errorf("expected ErrServiceToken, got %v", err)
+	}
+
+	// 사용자 토큰은 서비스 토큰으로 사용할 수 없음
+	userToken := signTestToken(t, key, "test-key-1", jwt.MapClaims{
+		"sub": uuid.New().String(),
+		"iss": "test-issuer",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if _, err := validator.ValidateServiceToken(ctx, userToken); !errors.Is(err, ErrNotServiceToken) {
+		t.Errorf("expected ErrNotServiceToken, got %v", err)
+	}
+
+	// 다른 issuer는 거부
+	otherIssuer := serviceTokenClaims("board-service", "users:read")
+	otherIssuer["iss"] = "other-issuer"
+	if _, err := validator.ValidateServiceToken(ctx, signTestToken(t, key, "test-key-1", otherIssuer)); err == nil {
+		t.Error("expected error for wrong issuer")
+	}
+}
+
+func TestServiceAuthMiddleware(t *testing.T) {
+	key, server := newTestJWKS(t, "test-key-1")
+	validator := NewJWKSValidator(server.URL, "test-issuer", nil)
+
+	router := gin.New()
+	router.GET("/internal", ServiceAuthMiddleware(validator, nil, "users:internal"), func(c *gin.Context) {
+		claims, _ := GetServiceClient(c)
+		c.String(http.StatusOK, claims.ClientID)
+	})
+	userAuth := func(c *gin.Context) {
+		c.Set(UserIDContextKey, uuid.New())
+		c.Next()
+	}
+	router.GET("/users", UserOrServiceAuthMiddleware(userAuth, validator, nil, "users:read"), func(c *gin.Context) {
+		if _, ok := GetUserID(c); ok {
+			c.String(http.StatusOK, "user")
+			return
+		}
+		c.String(http.StatusOK, "service")
+	})
+
+	do := func(path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	internalToken := signTestToken(t, key, "test-key-1", serviceTokenClaims("ops-service", "users:internal users:read"))
+	readOnlyToken := signTestToken(t, key, "test-key-1", serviceTokenClaims("board-service", "users:read"))
+
+	if w := do("/internal", internalToken); w.Code != http.StatusOK || w.Body.String() != "ops-service" {
+		t.Errorf("expected 200 ops-service, got %d %s", w.Code, w.Body.String())
+	}
+	if w := do("/internal", readOnlyToken); w.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for missing scope, got %d", w.Code)
+	}
+	if w := do("/internal", ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without token, got %d", w.Code)
+	}
+
+	// validator가 없으면 유효한 토큰이어도 거부
+	router.GET("/unconfigured", ServiceAuthMiddleware(nil, nil, "users:internal"), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	if w := do("/unconfigured", internalToken); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without validator, got %d", w.Code)
+	}
+
+	// 서비스 토큰은 scope 확인, 그 외는 사용자 인증으로 위임
+	if w := do("/users", readOnlyToken); w.Code != http.StatusOK || w.Body.String() != "service" {
+		t.Errorf("expected 200 service, got %d %s", w.Code, w.Body.String())
+	}
+	if w := do("/users", "user-token"); w.Code != http.StatusOK || w.Body.String() != "user" {
+		t.Errorf("expected 200 user, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestClientCredentialsSource_Token(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		clientID, secret, ok := r.BasicAuth()
+		if r.URL.Path != "/api/auth/token" || !ok || clientID != "board-service" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("scope") != "users:read" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "token-" + string(rune('0'+atomic.LoadInt32(&calls))),
+			"token_type":   "Bearer",
+			"expires_in":   300,
+		})
+	}))
+	defer server.Close()
+
+	source := NewClientCredentialsSource(server.URL, "board-service", "s3cret", []string{"users:read"})
+	ctx := context.Background()
+
+	first, err := source.Token(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, _ := source.Token(ctx)
+	if first != second || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("expected cached token, got %s/%s after %d calls", first, second, calls)
+	}
+
+	source.Invalidate()
+	third, _ := source.Token(ctx)
+	if third == first || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected new token after invalidate, got %s after %d calls", third, calls)
+	}
+
+	wrong := NewClientCredentialsSource(server.URL, "board-service", "wrong", []string{"users:read"})
+	if _, err := wrong.Token(ctx); err == nil {
+		t.Error("expected error for wrong secret")
+	}
+}
@@ -0,0 +1,134 @@
+// Package auth는 JWT 인증 미들웨어를 제공합니다.
+// 이 파일은 민감한 작업에 최근 인증을 요구하는 step-up 확인을 구현합니다.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// auth-service가 access token의 acr 클레임에 넣는 인증 수준입니다.
+const (
+	// ACRSingleFactor는 1차 인증(소셜, SAML, 매직 링크, 패스키)만 거친 세션입니다.
+	ACRSingleFactor = "sfa"
+
+	// ACRMultiFactor는 두 번째 인증(TOTP, 패스키 MFA)까지 거친 세션입니다.
+	ACRMultiFactor = "mfa"
+)
+
+// StepUpRequiredCode는 재인증이 필요할 때 응답하는 error.code입니다.
+const StepUpRequiredCode = "STEP_UP_REQUIRED"
+
+// ErrNoAuthTime은 auth_time 클레임이 없는 토큰(도입 이전 토큰, 서비스 토큰)입니다.
+var ErrNoAuthTime = errors.New("auth_time claim not found")
+
+// AuthContext는 access token의 인증 시각과 수준입니다.
+type AuthContext struct {
+	AuthTime time.Time // 사용자가 로그인한 시각 (refresh해도 유지, auth_time 클레임)
+	ACR      string    // 인증 수준 (acr 클레임, ACRSingleFactor 또는 ACRMultiFactor)
+}
+
+// StepUpRequirement는 작업에 필요한 최근 인증 조건입니다.
+type StepUpRequirement struct {
+	MaxAge     time.Duration // 로그인 후 허용 시간 (0이면 확인하지 않음)
+	RequireMFA bool          // 두 번째 인증까지 거친 세션만 허용
+}
+
+// IsZero는 확인할 조건이 없는지 반환합니다.
+func (r StepUpRequirement) IsZero() bool {
+	return r.MaxAge <= 0 && !r.RequireMFA
+}
+
+// Satisfies는 인증 시각과 수준이 조건을 만족하는지 확인합니다.
+func (a *AuthContext) Satisfies(req StepUpRequirement, now time.Time) bool {
+	if req.RequireMFA && a.ACR != ACRMultiFactor {
+		return false
+	}
+	return req.MaxAge <= 0 || now.Sub(a.AuthTime) <= req.MaxAge
+}
+
+// ParseAuthContext는 서명 검증 없이 auth_time, acr 클레임을 추출합니다.
+// 인증 미들웨어가 이미 검증한 토큰(TokenContextKey)에만 사용해야 합니다.
+func ParseAuthContext(tokenString string) (*AuthContext, error) {
+	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	authTime, ok := claims["auth_time"].(float64)
+	if !ok {
+		return nil, ErrNoAuthTime
+	}
+	acr, _ := claims["acr"].(string)
+	return &AuthContext{AuthTime: time.Unix(int64(authTime), 0), ACR: acr}, nil
+}
+
+// CheckStepUp은 현재 요청의 토큰이 조건을 만족하는지 확인합니다.
+// 만족하지 않으면 401 STEP_UP_REQUIRED와 WWW-Authenticate(RFC 9470) 헤더로 응답하고 false를 반환합니다.
+// 클라이언트는 다시 로그인(MFA 포함)해 새 토큰을 받은 뒤 요청을 재시도합니다.
+func CheckStepUp(c *gin.Context, req StepUpRequirement) bool {
+	if req.IsZero() {
+		return true
+	}
+	tokenString, _ := GetJWTToken(c)
+	authCtx, err := ParseAuthContext(tokenString)
+	if err == nil && authCtx.Satisfies(req, time.Now()) {
+		return true
+	}
+
+	challenge := `Bearer error="insufficient_user_authentication", error_description="A more recent authentication is required"`
+	if req.MaxAge > 0 {
+		challenge += fmt.Sprintf(", max_age=%d", int64(req.MaxAge.Seconds()))
+	}
+	if req.RequireMFA {
+		challenge += fmt.Sprintf(`, acr_values="%s"`, ACRMultiFactor)
+	}
+	c.Header("WWW-Authenticate", challenge)
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": gin.H{
+			"code":       StepUpRequiredCode,
+			"message":    "Re-authentication is required for this operation",
+			"maxAge":     int64(req.MaxAge.Seconds()),
+			"requireMfa": req.RequireMFA,
+		},
+	})
+	c.Abort()
+	return false
+}
+
+// StepUpPolicyFunc는 요청에 적용할 재인증 조건을 반환합니다 (예: 워크스페이스 설정 조회).
+type StepUpPolicyFunc func(c *gin.Context) (StepUpRequirement, error)
+
+// StepUpMiddleware는 policy가 반환한 조건으로 재인증을 요구하는 Gin 미들웨어입니다.
+// 인증 미들웨어 뒤에 사용해야 합니다. 조건을 조회하지 못하면 요청을 거부합니다.
+func StepUpMiddleware(policy StepUpPolicyFunc, logger *zap.Logger) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return func(c *gin.Context) {
+		req, err := policy(c)
+		if err != nil {
+			logger.Error("Failed to resolve step-up policy", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "Failed to resolve re-authentication policy",
+				},
+			})
+			c.Abort()
+			return
+		}
+		if CheckStepUp(c, req) {
+			c.Next()
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func unsignedToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestParseAuthContext(t *testing.T) {
+	authTime := time.Now().Add(-3 * time.Minute).Truncate(time.Second)
+	token := unsignedToken(t, jwt.MapClaims{"sub": "user", "auth_time": authTime.Unix(), "acr": ACRMultiFactor})
+
+	authCtx, err := ParseAuthContext(token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !authCtx.AuthTime.Equal(authTime) || authCtx.ACR != ACRMultiFactor {
+		t.Errorf("unexpected auth context: %+v", authCtx)
+	}
+
+	_, err = ParseAuthContext(unsignedToken(t, jwt.MapClaims{"sub": "user"}))
+	if !errors.Is(err, ErrNoAuthTime) {
+		t.Errorf("expected ErrNoAuthTime, got %v", err)
+	}
+}
+
+func TestAuthContext_Satisfies(t *testing.T) {
+	now := time.Now()
+	recent := &AuthContext{AuthTime: now.Add(-2 * time.Minute), ACR: ACRSingleFactor}
+	stale := &AuthContext{AuthTime: now.Add(-20 * time.Minute), ACR: ACRMultiFactor}
+
+	tests := []struct {
+		name    string
+		authCtx *AuthContext
+		req     StepUpRequirement
+		want    bool
+	}{
+		{"no requirement", stale, StepUpRequirement{}, true},
+		{"recent within max age", recent, StepUpRequirement{MaxAge: 5 * time.Minute}, true},
+		{"stale beyond max age", stale, StepUpRequirement{MaxAge: 5 * time.Minute}, false},
+		{"mfa required but single factor", recent, StepUpRequirement{RequireMFA: true}, false},
+		{"mfa required and multi factor", stale, StepUpRequirement{RequireMFA: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.authCtx.Satisfies(tt.req, now); got != tt.want {
+				t.Errorf("Satisfies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStepUpMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	policy := func(c *gin.Context) (StepUpRequirement, error) {
+		return StepUpRequirement{MaxAge: 10 * time.Minute}, nil
+	}
+
+	serve := func(token string) *httptest.ResponseRecorder {
+		r := gin.New()
+		r.GET("/sensitive", func(c *gin.Context) {
+			c.Set(TokenContextKey, token)
+		}, StepUpMiddleware(policy, nil), func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/sensitive", nil))
+		return w
+	}
+
+	fresh := unsignedToken(t, jwt.MapClaims{"auth_time": time.Now().Add(-time.Minute).Unix()})
+	if w := serve(fresh); w.Code != http.StatusNoContent {
+		t.Errorf("expected 204 for recent auth, got %d", w.Code)
+	}
+
+	stale := unsignedToken(t, jwt.MapClaims{"auth_time": time.Now().Add(-time.Hour).Unix()})
+	w := serve(stale)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for stale auth, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), StepUpRequiredCode) {
+		t.Errorf("expected %s in body, got %s", StepUpRequiredCode, w.Body.String())
+	}
+	if challenge := w.Header().Get("WWW-Authenticate"); !strings.Contains(challenge, "max_age=600") {
+		t.Errorf("unexpected WWW-Authenticate header: %s", challenge)
+	}
+
+	legacy := unsignedToken(t, jwt.MapClaims{"sub": "user"})
+	if w := serve(legacy); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for token without auth_time, got %d", w.Code)
+	}
+}
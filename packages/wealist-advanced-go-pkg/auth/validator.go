@@ -14,6 +14,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 )
 
 // TokenValidator는 JWT 토큰 검증을 위한 인터페이스입니다.
@@ -44,7 +46,8 @@ func NewAuthServiceValidator(authServiceURL, secretKey string, logger *zap.Logge
 		authServiceURL: authServiceURL,
 		secretKey:      secretKey,
 		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
+			Timeout:   5 * time.Second,
+			Transport: commonotel.NewTransport(nil), // 호출한 요청의 trace context 전파
 		},
 		logger: logger,
 	}
@@ -242,13 +245,15 @@ func (v *LocalValidator) ValidateToken(ctx context.Context, tokenString string)
 // SmartValidator는 여러 검증 전략을 체이닝합니다.
 // 1. auth-service HTTP 검증 (/api/auth/validate)
 // 2. JWKS (RSA) 검증 fallback (/.well-known/jwks.json)
+// 3. 폐기 목록 확인 (WithRevocation으로 설정한 경우)
 // auth-service가 RS256으로 JWT를 서명하므로 JWKS 검증이 필수입니다.
 type SmartValidator struct {
-	authServiceURL string         // auth-service URL (예: http://auth-service:8080)
-	issuer         string         // JWT issuer (예: wealist-auth-service)
-	httpClient     *http.Client   // HTTP 클라이언트
-	logger         *zap.Logger    // 로거
-	jwksValidator  *JWKSValidator // JWKS 검증기 (RSA)
+	authServiceURL string            // auth-service URL (예: http://auth-service:8080)
+	issuer         string            // JWT issuer (예: wealist-auth-service)
+	httpClient     *http.Client      // HTTP 클라이언트
+	logger         *zap.Logger       // 로거
+	jwksValidator  *JWKSValidator    // JWKS 검증기 (RSA)
+	revocation     RevocationChecker // 폐기 목록 (nil이면 확인 생략)
 }
 
 // NewSmartValidator는 새 SmartValidator를 생성합니다.
@@ -265,17 +270,39 @@ func NewSmartValidator(authServiceURL, issuer string, logger *zap.Logger) *Smart
 		authServiceURL: authServiceURL,
 		issuer:         issuer,
 		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
+			Timeout:   5 * time.Second,
+			Transport: commonotel.NewTransport(nil), // 호출한 요청의 trace context 전파
 		},
 		logger:        logger,
 		jwksValidator: NewJWKSValidator(jwksURL, issuer, logger),
 	}
 }
 
+// WithRevocation은 폐기 목록 확인을 활성화하고 validator를 반환합니다.
+// 로그아웃 등으로 폐기된 access token은 만료 전이라도 즉시 거부됩니다.
+func (v *SmartValidator) WithRevocation(checker RevocationChecker) *SmartValidator {
+	v.revocation = checker
+	return v
+}
+
 // ValidateToken은 JWT 토큰을 검증합니다.
 // 1. auth-service HTTP 검증 시도
 // 2. JWKS (RSA) 검증 fallback
+// 3. 폐기 목록 확인
 func (v *SmartValidator) ValidateToken(ctx context.Context, tokenString string) (uuid.UUID, error) {
+	userID, err := v.validateSignature(ctx, tokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := v.checkRevocation(ctx, tokenString, userID); err != nil {
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
+
+// validateSignature는 auth-service HTTP 검증 후 JWKS 검증으로 fallback합니다.
+func (v *SmartValidator) validateSignature(ctx context.Context, tokenString string) (uuid.UUID, error) {
 	// 1. auth-service HTTP 검증 시도
 	if v.authServiceURL != "" {
 		userID, err := v.validateWithAuthService(ctx, tokenString)
@@ -291,6 +318,30 @@ func (v *SmartValidator) ValidateToken(ctx context.Context, tokenString string)
 	return v.jwksValidator.ValidateToken(ctx, tokenString)
 }
 
+// checkRevocation은 폐기 목록에서 토큰을 확인합니다.
+// Redis 장애 시에는 가용성을 위해 허용하고 경고만 남깁니다 (fail-open).
+func (v *SmartValidator) checkRevocation(ctx context.Context, tokenString string, userID uuid.UUID) error {
+	if v.revocation == nil {
+		return nil
+	}
+
+	jti, issuedAt, err := revocationClaims(tokenString)
+	if err != nil {
+		return jwt.ErrTokenInvalidClaims
+	}
+
+	revoked, err := v.revocation.IsRevoked(ctx, jti, userID, issuedAt)
+	if err != nil {
+		v.logger.Warn("Token revocation check failed, allowing token", zap.Error(err))
+		return nil
+	}
+	if revoked {
+		v.logger.Debug("Rejected revoked token", zap.String("enduser.id", userID.String()))
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
 // validateWithAuthService는 auth-service의 /api/auth/validate 엔드포인트를 호출하여
 // 토큰을 검증합니다.
 func (v *SmartValidator) validateWithAuthService(ctx context.Context, token string) (uuid.UUID, error) {
@@ -2,6 +2,9 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -267,3 +270,123 @@ func TestAuthMiddlewareWithValidator(t *testing.T) {
 		})
 	}
 }
+
+// 테스트용 폐기 목록
+type fakeRevocationChecker struct {
+	revokedJTIs  map[string]bool
+	revokedUsers map[uuid.UUID]time.Time
+	err          error
+}
+
+func (f *fakeRevocationChecker) IsRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	if jti != "" && f.revokedJTIs[jti] {
+		return true, nil
+	}
+	if revokedAt, ok := f.revokedUsers[userID]; ok && issuedAt.Unix() <= revokedAt.Unix() {
+		return true, nil
+	}
+	return false, nil
+}
+
+func TestSmartValidator_Revocation(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	// auth-service HTTP 검증은 실패시키고 JWKS fallback으로 검증
+	keyID := "test-key-1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/jwks.json" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jwks := JWKS{Keys: []JWK{{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: keyID,
+			N:   base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+		}}}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jwks)
+	}))
+	defer server.Close()
+
+	signToken := func(userID uuid.UUID, jti string, issuedAt time.Time) string {
+		claims := jwt.MapClaims{
+			"sub": userID.String(),
+			"iss": "test-issuer",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"iat": issuedAt.Unix(),
+		}
+		if jti != "" {
+			claims["jti"] = jti
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = keyID
+		tokenString, err := token.SignedString(privateKey)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return tokenString
+	}
+
+	userID := uuid.New()
+	compromisedUserID := uuid.New()
+	checker := &fakeRevocationChecker{
+		revokedJTIs:  map[string]bool{"revoked-jti": true},
+		revokedUsers: map[uuid.UUID]time.Time{compromisedUserID: time.Now()},
+	}
+	validator := NewSmartValidator(server.URL, "test-issuer", zap.NewNop()).WithRevocation(checker)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "active token",
+			token: signToken(userID, "active-jti", time.Now()),
+		},
+		{
+			name:    "revoked jti",
+			token:   signToken(userID, "revoked-jti", time.Now()),
+			wantErr: ErrTokenRevoked,
+		},
+		{
+			name:    "issued before user revocation",
+			token:   signToken(compromisedUserID, "old-jti", time.Now().Add(-time.Minute)),
+			wantErr: ErrTokenRevoked,
+		},
+		{
+			name:  "issued after user revocation",
+			token: signToken(compromisedUserID, "new-jti", time.Now().Add(time.Minute)),
+		},
+		{
+			name:  "legacy token without jti",
+			token: signToken(userID, "", time.Now()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateToken(context.Background(), tt.token)
+			if err != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("checker error fails open", func(t *testing.T) {
+		failing := NewSmartValidator(server.URL, "test-issuer", zap.NewNop()).
+			WithRevocation(&fakeRevocationChecker{err: context.DeadlineExceeded})
+		if _, err := failing.ValidateToken(context.Background(), signToken(userID, "revoked-jti", time.Now())); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
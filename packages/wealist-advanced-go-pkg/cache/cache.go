@@ -0,0 +1,201 @@
+// Package cache는 자주 읽고 드물게 바뀌는 데이터(멤버십, 프로필, 프로젝트 메타데이터 등)를 위한
+// Redis cache-aside 헬퍼를 제공합니다.
+//
+// Cache[T]는 키의 값을 Redis에서 읽고, 없으면 load 함수로 읽어 TTL(±jitter) 동안 저장합니다.
+// 같은 프로세스에서 같은 키를 동시에 요청하면 load는 한 번만 실행됩니다 (singleflight).
+// Redis 오류는 캐시 미스로 처리하므로 Redis가 없거나 장애가 나도 원본에서 읽어 동작합니다.
+//
+// 값이 바뀌면 쓰기 경로에서 Invalidate로 바로 지우고, 다른 서비스가 소유한 데이터는
+// Invalidator로 도메인 이벤트를 받아 지웁니다. load와 Invalidate가 겹치면 이전 값이
+// 최대 TTL 동안 남을 수 있으므로 TTL은 허용 가능한 지연 이내로 둡니다.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// keyPrefix는 모든 캐시 키의 접두사입니다 (cache:<name>:<key>).
+const keyPrefix = "cache:"
+
+// Options는 캐시 설정입니다.
+type Options struct {
+	// TTL은 값을 보관하는 시간입니다 (기본 5분).
+	TTL time.Duration
+
+	// Jitter는 TTL에 무작위로 더하거나 빼는 비율입니다 (0~1, 기본 0.1, 음수면 사용 안 함).
+	// 한꺼번에 채워진 키가 동시에 만료되어 원본에 요청이 몰리는 것을 막습니다.
+	Jitter float64
+
+	// Logger는 Redis 오류를 기록합니다 (nil이면 기록하지 않음).
+	Logger *zap.Logger
+}
+
+// DefaultOptions는 기본 캐시 설정을 반환합니다.
+func DefaultOptions() Options {
+	return Options{
+		TTL:    5 * time.Minute,
+		Jitter: 0.1,
+	}
+}
+
+// store는 캐시 저장소입니다. 테스트에서는 메모리 구현으로 바꿉니다.
+type store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+}
+
+// redisStore는 Redis 기반 store입니다.
+type redisStore struct {
+	client *redis.Client
+}
+
+func (s redisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s.client.Get(ctx, key).Bytes()
+}
+
+func (s redisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s redisStore) Del(ctx context.Context, keys ...string) error {
+	return s.client.Del(ctx, keys...).Err()
+}
+
+// Cache는 T 타입 값을 JSON으로 저장하는 cache-aside 캐시입니다.
+// nil *Cache도 사용할 수 있으며, 이 경우 항상 load를 호출합니다.
+type Cache[T any] struct {
+	store  store
+	name   string
+	ttl    time.Duration
+	jitter float64
+	logger *zap.Logger
+	group  singleflight.Group
+}
+
+// New는 name 네임스페이스의 캐시를 생성합니다 (키: cache:<name>:<key>).
+// 값의 JSON 형태가 바뀌면 name에 버전을 붙여(예: "board:project:v2") 이전 값을 읽지 않게 합니다.
+// client가 nil이면 캐시하지 않고 load만 호출합니다.
+func New[T any](client *redis.Client, name string, opts Options) *Cache[T] {
+	var s store
+	if client != nil {
+		s = redisStore{client: client}
+	}
+	return newCache[T](s, name, opts)
+}
+
+func newCache[T any](s store, name string, opts Options) *Cache[T] {
+	defaults := DefaultOptions()
+	if opts.TTL <= 0 {
+		opts.TTL = defaults.TTL
+	}
+	if opts.Jitter == 0 {
+		opts.Jitter = defaults.Jitter
+	}
+	if opts.Jitter < 0 {
+		opts.Jitter = 0
+	}
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	return &Cache[T]{
+		store:  s,
+		name:   name,
+		ttl:    opts.TTL,
+		jitter: min(opts.Jitter, 1),
+		logger: opts.Logger,
+	}
+}
+
+// GetOrLoad는 key의 캐시 값을 반환하고, 없으면 load로 읽어 저장한 뒤 반환합니다.
+// load 오류는 캐시하지 않고 그대로 반환합니다 (없는 리소스도 매번 원본에서 확인).
+// 동시에 같은 키를 기다린 호출자는 각자 디코딩한 값을 받으므로 반환값을 수정해도 서로 영향이 없습니다.
+func (c *Cache[T]) GetOrLoad(ctx context.Context, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if c == nil || c.store == nil {
+		return load(ctx)
+	}
+
+	fullKey := c.key(key)
+	if value, ok := c.get(ctx, fullKey); ok {
+		return value, nil
+	}
+
+	encoded, err, _ := c.group.Do(fullKey, func() (any, error) {
+		value, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.store.Set(ctx, fullKey, data, c.jitteredTTL()); err != nil {
+			c.logger.Warn("Failed to write cache", zap.String("key", fullKey), zap.Error(err))
+		}
+		return data, nil
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	var value T
+	if err := json.Unmarshal(encoded.([]byte), &value); err != nil {
+		return zero, err
+	}
+	return value, nil
+}
+
+// Invalidate는 keys의 캐시 값을 지웁니다. 다음 GetOrLoad는 원본에서 다시 읽습니다.
+func (c *Cache[T]) Invalidate(ctx context.Context, keys ...string) error {
+	if c == nil || c.store == nil || len(keys) == 0 {
+		return nil
+	}
+	fullKeys := make([]string, len(keys))
+	for i, key := range keys {
+		fullKeys[i] = c.key(key)
+	}
+	if err := c.store.Del(ctx, fullKeys...); err != nil {
+		c.logger.Warn("Failed to invalidate cache", zap.Strings("keys", fullKeys), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// get은 캐시 값을 읽습니다. 없거나 Redis 오류, 디코딩 실패는 모두 미스로 처리합니다.
+func (c *Cache[T]) get(ctx context.Context, fullKey string) (T, bool) {
+	var value T
+	data, err := c.store.Get(ctx, fullKey)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.logger.Warn("Failed to read cache", zap.String("key", fullKey), zap.Error(err))
+		}
+		return value, false
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		c.logger.Warn("Discarding undecodable cache value", zap.String("key", fullKey), zap.Error(err))
+		return value, false
+	}
+	return value, true
+}
+
+func (c *Cache[T]) key(key string) string {
+	return keyPrefix + c.name + ":" + key
+}
+
+// jitteredTTL은 TTL에 ±jitter 비율의 무작위 값을 더한 만료 시간을 반환합니다.
+func (c *Cache[T]) jitteredTTL() time.Duration {
+	if c.jitter == 0 {
+		return c.ttl
+	}
+	delta := (rand.Float64()*2 - 1) * c.jitter * float64(c.ttl)
+	return c.ttl + time.Duration(delta)
+}
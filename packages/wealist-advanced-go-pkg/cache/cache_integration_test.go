@@ -0,0 +1,43 @@
+//go:build integration
+
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// TestCache_Redis는 실제 Redis에 jitter가 적용된 TTL로 저장하고 Invalidate로 지우는지 확인합니다.
+func TestCache_Redis(t *testing.T) {
+	client := integration.Redis(t)
+	c := New[*project](client, "project", Options{TTL: time.Minute, Jitter: 0.1})
+	ctx := context.Background()
+
+	load := func(context.Context) (*project, error) { return &project{ID: "p1", Name: "Alpha"}, nil }
+	if _, err := c.GetOrLoad(ctx, "p1", load); err != nil {
+		t.Fatalf("GetOrLoad() error = %v", err)
+	}
+
+	ttl, err := client.TTL(ctx, "cache:project:p1").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl < 50*time.Second || ttl > 66*time.Second {
+		t.Errorf("TTL이 54s~66s 범위여야 함, 실제: %s", ttl)
+	}
+
+	if err := c.Invalidate(ctx, "p1"); err != nil {
+		t.Fatalf("Invalidate() error = %v", err)
+	}
+	if n, _ := client.Exists(ctx, "cache:project:p1").Result(); n != 0 {
+		t.Error("Invalidate 후 키가 남아 있음")
+	}
+}
@@ -0,0 +1,234 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// memoryStore는 테스트용 store입니다.
+type memoryStore struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	ttls    map[string]time.Duration
+	err     error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{entries: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	data, ok := s.entries[key]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return data, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.entries[key] = value
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *memoryStore) Del(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+type project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func countingLoader(calls *int32, value *project) func(context.Context) (*project, error) {
+	return func(context.Context) (*project, error) {
+		atomic.AddInt32(calls, 1)
+		return value, nil
+	}
+}
+
+// TestGetOrLoad_CachesValue는 처음에만 load하고 이후에는 캐시 값을 반환하는지 테스트합니다.
+func TestGetOrLoad_CachesValue(t *testing.T) {
+	store := newMemoryStore()
+	c := newCache[*project](store, "project", Options{})
+	ctx := context.Background()
+
+	var calls int32
+	load := countingLoader(&calls, &project{ID: "p1", Name: "Alpha"})
+	for i := 0; i < 3; i++ {
+		got, err := c.GetOrLoad(ctx, "p1", load)
+		if err != nil {
+			t.Fatalf("GetOrLoad() error = %v", err)
+		}
+		if got.Name != "Alpha" {
+			t.Errorf("GetOrLoad() = %+v", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("load 호출 횟수: 1 예상, 실제: %d", calls)
+	}
+	if _, ok := store.entries["cache:project:p1"]; !ok {
+		t.Errorf("cache:project:p1 키에 저장되어야 함, 실제 키: %v", store.entries)
+	}
+}
+
+// TestGetOrLoad_LoadErrorNotCached는 load 오류를 캐시하지 않는지 테스트합니다.
+func TestGetOrLoad_LoadErrorNotCached(t *testing.T) {
+	c := newCache[*project](newMemoryStore(), "project", Options{})
+	ctx := context.Background()
+	errNotFound := errors.New("not found")
+
+	var calls int32
+	load := func(context.Context) (*project, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errNotFound
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetOrLoad(ctx, "missing", load); !errors.Is(err, errNotFound) {
+			t.Errorf("예상 오류: %v, 실제: %v", errNotFound, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("load 호출 횟수: 2 예상, 실제: %d", calls)
+	}
+}
+
+// TestGetOrLoad_StoreUnavailable은 Redis 오류와 nil 캐시에서도 load 결과를 반환하는지 테스트합니다.
+func TestGetOrLoad_StoreUnavailable(t *testing.T) {
+	store := newMemoryStore()
+	store.err = errors.New("connection refused")
+	ctx := context.Background()
+
+	var calls int32
+	load := countingLoader(&calls, &project{ID: "p1"})
+	caches := map[string]*Cache[*project]{
+		"store error": newCache[*project](store, "project", Options{}),
+		"no client":   New[*project](nil, "project", Options{}),
+		"nil cache":   nil,
+	}
+	for name, c := range caches {
+		got, err := c.GetOrLoad(ctx, "p1", load)
+		if err != nil || got.ID != "p1" {
+			t.Errorf("%s: GetOrLoad() = %+v, %v", name, got, err)
+		}
+		if err := c.Invalidate(ctx, "p1"); name != "store error" && err != nil {
+			t.Errorf("%s: Invalidate() error = %v", name, err)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("load 호출 횟수: 3 예상, 실제: %d", calls)
+	}
+}
+
+// TestGetOrLoad_Singleflight는 동시에 같은 키를 요청하면 load가 한 번만 실행되고
+// 호출자마다 별도의 값을 받는지 테스트합니다.
+func TestGetOrLoad_Singleflight(t *testing.T) {
+	c := newCache[*project](newMemoryStore(), "project", Options{})
+	ctx := context.Background()
+
+	var calls int32
+	release := make(chan struct{})
+	load := func(context.Context) (*project, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &project{ID: "p1"}, nil
+	}
+
+	const callers = 10
+	results := make([]*project, callers)
+	var started, done sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		started.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			started.Done()
+			got, err := c.GetOrLoad(ctx, "p1", load)
+			if err != nil {
+				t.Errorf("GetOrLoad() error = %v", err)
+			}
+			results[i] = got
+		}()
+	}
+	started.Wait()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	if calls != 1 {
+		t.Errorf("load 호출 횟수: 1 예상, 실제: %d", calls)
+	}
+	for i := 1; i < callers; i++ {
+		if results[i] == nil || results[i] == results[0] {
+			t.Fatalf("호출자마다 별도로 디코딩한 값을 받아야 함")
+		}
+	}
+}
+
+// TestInvalidate는 Invalidate 이후 다시 load하는지 테스트합니다.
+func TestInvalidate(t *testing.T) {
+	c := newCache[*project](newMemoryStore(), "project", Options{})
+	ctx := context.Background()
+
+	name := "Alpha"
+	var calls int32
+	load := func(context.Context) (*project, error) {
+		atomic.AddInt32(&calls, 1)
+		return &project{ID: "p1", Name: name}, nil
+	}
+	if _, err := c.GetOrLoad(ctx, "p1", load); err != nil {
+		t.Fatal(err)
+	}
+
+	name = "Beta"
+	if err := c.Invalidate(ctx, "p1"); err != nil {
+		t.Fatalf("Invalidate() error = %v", err)
+	}
+	got, err := c.GetOrLoad(ctx, "p1", load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Beta" || calls != 2 {
+		t.Errorf("무효화 후 새 값을 읽어야 함, 값: %q, load 호출 횟수: %d", got.Name, calls)
+	}
+}
+
+// TestJitteredTTL은 만료 시간이 TTL ± jitter 범위 안에 있는지 테스트합니다.
+func TestJitteredTTL(t *testing.T) {
+	c := newCache[int](newMemoryStore(), "n", Options{TTL: time.Minute, Jitter: 0.2})
+	for i := 0; i < 100; i++ {
+		ttl := c.jitteredTTL()
+		if ttl < 48*time.Second || ttl > 72*time.Second {
+			t.Fatalf("TTL이 48s~72s 범위를 벗어남: %s", ttl)
+		}
+	}
+
+	fixed := newCache[int](newMemoryStore(), "n", Options{TTL: time.Minute, Jitter: -1})
+	if ttl := fixed.jitteredTTL(); ttl != time.Minute {
+		t.Errorf("jitter를 끄면 TTL 그대로 사용해야 함, 실제: %s", ttl)
+	}
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+)
+
+// Invalidatable은 키로 캐시 값을 지울 수 있는 캐시입니다 (*Cache[T]).
+type Invalidatable interface {
+	Invalidate(ctx context.Context, keys ...string) error
+}
+
+// KeyFunc는 도메인 이벤트에서 지울 캐시 키를 계산합니다.
+type KeyFunc func(event *messaging.Event) ([]string, error)
+
+// Invalidator는 도메인 이벤트를 받아 관련 캐시를 지웁니다.
+// 다른 서비스가 소유한 데이터(예: user-service의 워크스페이스 멤버십)를 캐시할 때
+// Handle을 messaging.Subscriber의 EventHandler로 등록합니다.
+//
+//	invalidator := cache.NewInvalidator()
+//	invalidator.Evict(messaging.EventMemberRemoved, membershipCache, memberKey)
+//	go messaging.NewSubscriber(messaging.SubscriberConfig{
+//		Config:        messaging.Config{URL: natsURL, Name: "board-service"},
+//		Durable:       "board-service-cache",
+//		FilterSubject: "events.user.member.>",
+//	}, invalidator.Handle, logger).Run(ctx)
+//
+// Redis는 레플리카가 공유하므로 같은 Durable 이름으로 한 레플리카만 이벤트를 처리해도 충분합니다.
+type Invalidator struct {
+	mu       sync.RWMutex
+	handlers map[string][]messaging.EventHandler
+}
+
+// NewInvalidator는 새 Invalidator를 생성합니다.
+func NewInvalidator() *Invalidator {
+	return &Invalidator{handlers: make(map[string][]messaging.EventHandler)}
+}
+
+// Evict는 eventType 이벤트를 받으면 keys가 반환한 키를 target에서 지우도록 등록합니다.
+func (i *Invalidator) Evict(eventType string, target Invalidatable, keys KeyFunc) {
+	i.On(eventType, func(ctx context.Context, event *messaging.Event) error {
+		evicted, err := keys(event)
+		if err != nil {
+			// 페이로드가 잘못된 이벤트는 재전달해도 같은 결과이므로 버림
+			return messaging.Permanent(err)
+		}
+		return target.Invalidate(ctx, evicted...)
+	})
+}
+
+// On은 eventType 이벤트를 받으면 handler를 실행하도록 등록합니다 (프로세스 내 캐시 정리 등).
+func (i *Invalidator) On(eventType string, handler messaging.EventHandler) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.handlers[eventType] = append(i.handlers[eventType], handler)
+}
+
+// Handle은 event에 등록된 모든 무효화를 실행합니다. 등록되지 않은 이벤트는 무시합니다.
+// 하나라도 실패하면 이벤트가 재전달되므로, 이미 지운 키를 다시 지워도 문제없어야 합니다.
+func (i *Invalidator) Handle(ctx context.Context, event *messaging.Event) error {
+	i.mu.RLock()
+	handlers := i.handlers[event.Type]
+	i.mu.RUnlock()
+
+	var retryable, permanent []error
+	for _, handler := range handlers {
+		err := handler(ctx, event)
+		switch {
+		case err == nil:
+		case errors.Is(err, messaging.ErrPermanent):
+			permanent = append(permanent, err)
+		default:
+			retryable = append(retryable, err)
+		}
+	}
+
+	// 재시도할 오류가 하나라도 있으면 재전달 (Permanent 오류와 합치면 이벤트가 버려짐)
+	if len(retryable) > 0 {
+		return errors.Join(retryable...)
+	}
+	return errors.Join(permanent...)
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+)
+
+func memberKey(event *messaging.Event) ([]string, error) {
+	var data messaging.MemberEventData
+	if err := event.Decode(&data); err != nil {
+		return nil, err
+	}
+	return []string{event.WorkspaceID + ":" + data.UserID}, nil
+}
+
+// TestInvalidator_Evict는 등록한 이벤트를 받으면 해당 키가 지워지는지 테스트합니다.
+func TestInvalidator_Evict(t *testing.T) {
+	store := newMemoryStore()
+	membership := newCache[bool](store, "membership", Options{})
+	ctx := context.Background()
+
+	load := func(context.Context) (bool, error) { return true, nil }
+	if _, err := membership.GetOrLoad(ctx, "ws1:u1", load); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := membership.GetOrLoad(ctx, "ws1:u2", load); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidator := NewInvalidator()
+	invalidator.Evict(messaging.EventMemberRemoved, membership, memberKey)
+
+	event, err := messaging.NewEvent(messaging.EventMemberRemoved, "user-service", "ws1", "admin", messaging.MemberEventData{UserID: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := invalidator.Handle(ctx, event); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if _, ok := store.entries["cache:membership:ws1:u1"]; ok {
+		t.Error("ws1:u1 키가 지워져야 함")
+	}
+	if _, ok := store.entries["cache:membership:ws1:u2"]; !ok {
+		t.Error("ws1:u2 키는 남아 있어야 함")
+	}
+
+	// 등록하지 않은 이벤트는 무시
+	other, _ := messaging.NewEvent(messaging.EventBoardCreated, "board-service", "ws1", "", messaging.BoardEventData{})
+	if err := invalidator.Handle(ctx, other); err != nil {
+		t.Errorf("Handle() error = %v", err)
+	}
+}
+
+// TestInvalidator_Errors는 잘못된 페이로드는 Permanent, 저장소 오류는 재시도 오류로 반환하는지 테스트합니다.
+func TestInvalidator_Errors(t *testing.T) {
+	store := newMemoryStore()
+	membership := newCache[bool](store, "membership", Options{})
+	ctx := context.Background()
+
+	invalidator := NewInvalidator()
+	invalidator.Evict(messaging.EventMemberRemoved, membership, memberKey)
+
+	malformed := &messaging.Event{ID: "e1", Type: messaging.EventMemberRemoved}
+	if err := invalidator.Handle(ctx, malformed); !errors.Is(err, messaging.ErrPermanent) {
+		t.Errorf("페이로드가 없으면 Permanent 오류 예상, 실제: %v", err)
+	}
+
+	store.err = errors.New("connection refused")
+	event, _ := messaging.NewEvent(messaging.EventMemberRemoved, "user-service", "ws1", "", messaging.MemberEventData{UserID: "u1"})
+	err := invalidator.Handle(ctx, event)
+	if err == nil || errors.Is(err, messaging.ErrPermanent) {
+		t.Errorf("저장소 오류는 재시도해야 함, 실제: %v", err)
+	}
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrCircuitOpen is returned (wrapped) when a request is rejected because the target host's breaker is open.
+// Callers can detect it with errors.Is / IsCircuitOpen to apply a fallback instead of logging a hard failure.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// IsCircuitOpen reports whether err was caused by an open circuit breaker.
+func IsCircuitOpen(err error) bool {
+	return errors.Is(err, ErrCircuitOpen)
+}
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState int
+
+const (
+	// StateClosed lets all requests through and counts consecutive failures.
+	StateClosed BreakerState = iota
+	// StateHalfOpen lets a limited number of probe requests through after OpenTimeout.
+	StateHalfOpen
+	// StateOpen rejects all requests until OpenTimeout has elapsed.
+	StateOpen
+)
+
+// String returns the state name used in logs and metric labels.
+func (s BreakerState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker metrics (default registry, exposed by each service's /metrics endpoint)
+var (
+	breakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "http_client_circuit_breaker_state",
+		Help: "Circuit breaker state per target host (0=closed, 1=half_open, 2=open)",
+	}, []string{"host"})
+
+	breakerTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_client_circuit_breaker_transitions_total",
+		Help: "Total number of circuit breaker state transitions per target host",
+	}, []string{"host", "state"})
+
+	breakerRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_client_circuit_breaker_rejected_total",
+		Help: "Total number of requests rejected by an open circuit breaker per target host",
+	}, []string{"host"})
+)
+
+// BreakerConfig configures a circuit breaker.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker.
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before allowing probe requests.
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests is the number of concurrent probe requests allowed in half-open state.
+	HalfOpenMaxRequests int
+	// IsFailure decides whether a response counts as a failure.
+	// Default: transport errors, 5xx and 429 (caller cancellation is ignored).
+	IsFailure func(resp *http.Response, err error) bool
+}
+
+// DefaultBreakerConfig returns the breaker configuration used by the shared HTTP clients.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold:    5,
+		OpenTimeout:         30 * time.Second,
+		HalfOpenMaxRequests: 1,
+		IsFailure:           defaultIsFailure,
+	}
+}
+
+func defaultIsFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// CircuitBreaker is a consecutive-failure circuit breaker with half-open probing.
+// It is safe for concurrent use.
+type CircuitBreaker struct {
+	name   string
+	config BreakerConfig
+	now    func() time.Time
+
+	mu               sync.Mutex
+	state            BreakerState
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+// NewCircuitBreaker creates a breaker. name is used as the metric label (usually the target host).
+func NewCircuitBreaker(name string, config BreakerConfig) *CircuitBreaker {
+	defaults := DefaultBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = defaults.OpenTimeout
+	}
+	if config.HalfOpenMaxRequests <= 0 {
+		config.HalfOpenMaxRequests = defaults.HalfOpenMaxRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = defaults.IsFailure
+	}
+
+	b := &CircuitBreaker{name: name, config: config, now: time.Now}
+	breakerState.WithLabelValues(name).Set(float64(StateClosed))
+	return b
+}
+
+// State returns the current state (an open breaker past OpenTimeout is reported as half-open).
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refreshLocked()
+	return b.state
+}
+
+// Allow reserves a slot for a request. It returns ErrCircuitOpen if the request must not be sent.
+// Every successful Allow must be followed by exactly one Done.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refreshLocked()
+
+	switch b.state {
+	case StateOpen:
+		breakerRejected.WithLabelValues(b.name).Inc()
+		return fmt.Errorf("%w: %s", ErrCircuitOpen, b.name)
+	case StateHalfOpen:
+		if b.halfOpenInFlight >= b.config.HalfOpenMaxRequests {
+			breakerRejected.WithLabelValues(b.name).Inc()
+			return fmt.Errorf("%w: %s", ErrCircuitOpen, b.name)
+		}
+		b.halfOpenInFlight++
+	}
+	return nil
+}
+
+// Done records the outcome of a request admitted by Allow.
+// ignored is true when the outcome says nothing about the target's health (e.g. caller cancellation).
+func (b *CircuitBreaker) Done(failed, ignored bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.state == StateHalfOpen
+	if probe && b.halfOpenInFlight > 0 {
+		b.halfOpenInFlight--
+	}
+	if ignored {
+		return
+	}
+
+	switch {
+	case failed && probe:
+		// Probe failed: back to open for another OpenTimeout
+		b.setStateLocked(StateOpen)
+	case failed:
+		b.failures++
+		if b.state == StateClosed && b.failures >= b.config.FailureThreshold {
+			b.setStateLocked(StateOpen)
+		}
+	case probe:
+		b.setStateLocked(StateClosed)
+	default:
+		b.failures = 0
+	}
+}
+
+// refreshLocked moves an open breaker to half-open once OpenTimeout has elapsed.
+func (b *CircuitBreaker) refreshLocked() {
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.config.OpenTimeout {
+		b.setStateLocked(StateHalfOpen)
+	}
+}
+
+func (b *CircuitBreaker) setStateLocked(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	b.failures = 0
+	b.halfOpenInFlight = 0
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+	breakerState.WithLabelValues(b.name).Set(float64(state))
+	breakerTransitions.WithLabelValues(b.name, state.String()).Inc()
+}
+
+// breakers holds one breaker per target host, shared by every BreakerTransport in the process
+// so that all clients calling the same host (and its metric series) see the same state.
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[string]*CircuitBreaker)
+)
+
+// BreakerFor returns the shared breaker for a host (host:port as in URL.Host), creating it with config if needed.
+// The configuration of the first caller for a host wins.
+func BreakerFor(host string, config BreakerConfig) *CircuitBreaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	b, ok := breakers[host]
+	if !ok {
+		b = NewCircuitBreaker(host, config)
+		breakers[host] = b
+	}
+	return b
+}
+
+// BreakerTransport is an http.RoundTripper with a circuit breaker per target host.
+// When a host's breaker is open, requests fail fast with ErrCircuitOpen instead of waiting for timeouts.
+type BreakerTransport struct {
+	base   http.RoundTripper
+	config BreakerConfig
+}
+
+// NewBreakerTransport wraps base (http.DefaultTransport if nil) with per-host circuit breakers.
+func NewBreakerTransport(base http.RoundTripper, config BreakerConfig) *BreakerTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &BreakerTransport{base: base, config: config}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *BreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b := BreakerFor(req.URL.Host, t.config)
+	if err := b.Allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	// The caller giving up says nothing about the target's health
+	ignored := err != nil && errors.Is(req.Context().Err(), context.Canceled)
+	b.Done(b.config.IsFailure(resp, err), ignored)
+	return resp, err
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b := NewCircuitBreaker("test-open", BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("unexpected rejection: %v", err)
+		}
+		b.Done(true, false)
+	}
+	// A success resets the consecutive failure count
+	_ = b.Allow()
+	b.Done(false, false)
+	for i := 0; i < 3; i++ {
+		_ = b.Allow()
+		b.Done(true, false)
+	}
+
+	if b.State() != StateOpen {
+		t.Fatalf("expected open, got %s", b.State())
+	}
+	if err := b.Allow(); !IsCircuitOpen(err) {
+		t.Errorf("expected ErrCircuitOpen, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker("test-half-open", BreakerConfig{FailureThreshold: 1, OpenTimeout: 10 * time.Second})
+	b.now = func() time.Time { return now }
+
+	_ = b.Allow()
+	b.Done(true, false)
+	now = now.Add(10 * time.Second)
+
+	if b.State() != StateHalfOpen {
+		t.Fatalf("expected half_open after timeout, got %s", b.State())
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected probe to be allowed: %v", err)
+	}
+	if err := b.Allow(); !IsCircuitOpen(err) {
+		t.Errorf("expected second concurrent probe to be rejected, got %v", err)
+	}
+
+	// Failed probe reopens
+	b.Done(true, false)
+	if b.State() != StateOpen {
+		t.Fatalf("expected open after failed probe, got %s", b.State())
+	}
+
+	// Successful probe closes
+	now = now.Add(10 * time.Second)
+	_ = b.Allow()
+	b.Done(false, false)
+	if b.State() != StateClosed {
+		t.Errorf("expected closed after successful probe, got %s", b.State())
+	}
+}
+
+func TestBreakerTransport_FailsFast(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewBreakerTransport(nil, BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	_, err := client.Get(server.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected rejected request not to reach the server, got %d calls", calls.Load())
+	}
+}
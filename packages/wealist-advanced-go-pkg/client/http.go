@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 )
 
 // BaseHTTPClient provides common HTTP client functionality for service-to-service communication.
@@ -19,6 +21,8 @@ type BaseHTTPClient struct {
 	HTTPClient *http.Client
 	Timeout    time.Duration
 	Logger     *zap.Logger
+	// Retry is used by DoRequestWithRetry (idempotent calls only).
+	Retry RetryConfig
 }
 
 // NewBaseHTTPClient creates a new base HTTP client.
@@ -27,9 +31,13 @@ func NewBaseHTTPClient(baseURL string, timeout time.Duration, logger *zap.Logger
 		BaseURL: baseURL,
 		HTTPClient: &http.Client{
 			Timeout: timeout,
+			// Fail fast while the target host is down (per-host circuit breaker),
+			// and propagate trace context (traceparent) so downstream spans join the caller's trace
+			Transport: NewBreakerTransport(commonotel.NewTransport(nil), DefaultBreakerConfig()),
 		},
 		Timeout: timeout,
 		Logger:  logger,
+		Retry:   DefaultRetryConfig(),
 	}
 }
 
@@ -123,7 +131,10 @@ func (c *BaseHTTPClient) DoRequest(ctx context.Context, method, url, token strin
 			zap.String("response_body", string(body)),
 			zap.Duration("processing_time", processingTime),
 		)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return &StatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("API returned status %d: %s", resp.StatusCode, string(body)),
+		}
 	}
 
 	c.Logger.Debug("Received successful response",
@@ -135,7 +146,7 @@ func (c *BaseHTTPClient) DoRequest(ctx context.Context, method, url, token strin
 
 	// Parse response
 	if result != nil && len(body) > 0 {
-		if err := json.Unmarshal(body, result); err != nil {
+		if err := DecodeResponse(body, result); err != nil {
 			c.Logger.Error("Failed to parse response JSON",
 				zap.Error(err),
 				zap.String("url", url),
@@ -148,6 +159,24 @@ func (c *BaseHTTPClient) DoRequest(ctx context.Context, method, url, token strin
 	return nil
 }
 
+// DoRequestWithRetry performs DoRequest with retries on transient failures (see Retry and IsRetryable).
+// Use only for idempotent requests such as GET lookups and validations.
+func (c *BaseHTTPClient) DoRequestWithRetry(ctx context.Context, method, url, token string, result interface{}) error {
+	config := c.Retry
+	config.OnRetry = func(attempt int, err error, delay time.Duration) {
+		c.Logger.Warn("Retrying HTTP request",
+			zap.String("method", method),
+			zap.String("url", url),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", delay),
+			zap.Error(err),
+		)
+	}
+	return Retry(ctx, config, func(ctx context.Context) error {
+		return c.DoRequest(ctx, method, url, token, result)
+	})
+}
+
 // DoRequestWithBody performs an HTTP request with a JSON body.
 func (c *BaseHTTPClient) DoRequestWithBody(ctx context.Context, method, url, token string, body interface{}, result interface{}) error {
 	startTime := time.Now()
@@ -207,12 +236,15 @@ func (c *BaseHTTPClient) DoRequestWithBody(ctx context.Context, method, url, tok
 			zap.String("url", url),
 			zap.String("response_body", string(respBody)),
 		)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
+		return &StatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("API returned status %d: %s", resp.StatusCode, string(respBody)),
+		}
 	}
 
 	// Parse response
 	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
+		if err := DecodeResponse(respBody, result); err != nil {
 			return fmt.Errorf("failed to parse response: %w", err)
 		}
 	}
@@ -220,6 +252,20 @@ func (c *BaseHTTPClient) DoRequestWithBody(ctx context.Context, method, url, tok
 	return nil
 }
 
+// DecodeResponse decodes a service response body into result.
+// Bodies in the common response envelope ({"success": true, "data": ...}) decode only data;
+// any other body is decoded as a whole, so callers keep working against services that predate the envelope.
+func DecodeResponse(body []byte, result interface{}) error {
+	var envelope struct {
+		Success *bool           `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Success != nil && envelope.Data != nil {
+		return json.Unmarshal(envelope.Data, result)
+	}
+	return json.Unmarshal(body, result)
+}
+
 // GetStatusCode makes a request and returns the status code (useful for validation endpoints).
 func (c *BaseHTTPClient) GetStatusCode(ctx context.Context, method, url, token string) (int, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, nil)
@@ -0,0 +1,35 @@
+package client
+
+import "testing"
+
+func TestDecodeResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"envelope", `{"success":true,"data":{"name":"ws"},"requestId":"r1"}`},
+		{"raw body", `{"name":"ws"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var workspace Workspace
+			if err := DecodeResponse([]byte(tt.body), &workspace); err != nil {
+				t.Fatalf("DecodeResponse: %v", err)
+			}
+			if workspace.Name != "ws" {
+				t.Errorf("expected name ws, got %q", workspace.Name)
+			}
+		})
+	}
+
+	var members []Workspace
+	if err := DecodeResponse([]byte(`[{"name":"a"},{"name":"b"}]`), &members); err != nil || len(members) != 2 {
+		t.Errorf("expected raw array to decode, got %v (%d)", err, len(members))
+	}
+
+	var validation WorkspaceValidationResponse
+	if err := DecodeResponse([]byte(`{"success":true,"data":{"isMember":true}}`), &validation); err != nil || !validation.IsWorkspaceMember() {
+		t.Errorf("expected enveloped membership, got %v %+v", err, validation)
+	}
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// StatusError is returned when the target service responds with a non-success status.
+// Retry uses StatusCode to tell transient failures (5xx, 429) from permanent ones (4xx).
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements error.
+func (e *StatusError) Error() string {
+	return e.Message
+}
+
+// RetryConfig configures retries with exponential backoff and jitter.
+// Only use it for idempotent requests (GET lookups, validations, queries).
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts including the first one (1 disables retries).
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each attempt.
+	Multiplier float64
+	// Jitter is the fraction of the delay that is randomized (0.5 = delay * [0.5, 1.0]),
+	// so replicas retrying after the same blip do not hit the target in lockstep.
+	Jitter float64
+	// PerAttemptTimeout bounds each attempt (0 = only the caller's context and the HTTP client timeout).
+	PerAttemptTimeout time.Duration
+	// IsRetryable decides whether an attempt error is worth retrying. Default: IsRetryable.
+	IsRetryable func(err error) bool
+	// OnRetry is called before waiting for the next attempt (optional, e.g. for logging).
+	OnRetry func(attempt int, err error, delay time.Duration)
+}
+
+// DefaultRetryConfig returns the retry configuration used by the shared HTTP clients.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+		IsRetryable:    IsRetryable,
+	}
+}
+
+// IsRetryable reports whether err looks transient: network errors, attempt timeouts,
+// connections closed mid-response, and 5xx/408/429 responses.
+// An open circuit breaker is not retried; the breaker already knows the target is down.
+func IsRetryable(err error) bool {
+	if err == nil || IsCircuitOpen(err) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		case http.StatusNotImplemented:
+			return false
+		}
+		return statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Retry calls fn until it succeeds, returns a non-retryable error, attempts run out,
+// or ctx is done. Each attempt gets its own context bounded by PerAttemptTimeout.
+// The last attempt's error is returned.
+//
+// Example:
+//
+//	err := client.Retry(ctx, client.DefaultRetryConfig(), func(ctx context.Context) error {
+//		return c.DoRequest(ctx, http.MethodGet, url, token, &result)
+//	})
+func Retry(ctx context.Context, config RetryConfig, fn func(ctx context.Context) error) error {
+	defaults := DefaultRetryConfig()
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaults.MaxBackoff
+	}
+	if config.Multiplier < 1 {
+		config.Multiplier = defaults.Multiplier
+	}
+	if config.IsRetryable == nil {
+		config.IsRetryable = defaults.IsRetryable
+	}
+
+	backoff := config.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := runAttempt(ctx, config.PerAttemptTimeout, fn)
+		if err == nil {
+			return nil
+		}
+		// The caller's deadline or cancellation ends retries; only attempt timeouts are retried
+		if attempt >= config.MaxAttempts || ctx.Err() != nil || !config.IsRetryable(err) {
+			return err
+		}
+
+		delay := withJitter(backoff, config.Jitter)
+		if config.OnRetry != nil {
+			config.OnRetry(attempt, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * config.Multiplier)
+		if backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+}
+
+func runAttempt(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return fn(attemptCtx)
+}
+
+// withJitter returns d reduced by a random amount of up to jitter * d.
+func withJitter(d time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 {
+		return d
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return d - time.Duration(rand.Float64()*jitter*float64(d))
+}
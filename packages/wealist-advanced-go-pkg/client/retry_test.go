@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func fastRetryConfig() RetryConfig {
+	config := DefaultRetryConfig()
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = 5 * time.Millisecond
+	return config
+}
+
+func TestRetry_RetriesTransientErrors(t *testing.T) {
+	var calls int
+	err := Retry(context.Background(), fastRetryConfig(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return &StatusError{StatusCode: http.StatusServiceUnavailable, Message: "unavailable"}
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_DoesNotRetryPermanentErrors(t *testing.T) {
+	cases := map[string]error{
+		"client error": &StatusError{StatusCode: http.StatusForbidden, Message: "forbidden"},
+		"circuit open": ErrCircuitOpen,
+		"decode error": errors.New("failed to parse response"),
+	}
+	for name, attemptErr := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			err := Retry(context.Background(), fastRetryConfig(), func(ctx context.Context) error {
+				calls++
+				return attemptErr
+			})
+			if !errors.Is(err, attemptErr) {
+				t.Errorf("expected %v, got %v", attemptErr, err)
+			}
+			if calls != 1 {
+				t.Errorf("expected 1 attempt, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestRetry_PerAttemptTimeout(t *testing.T) {
+	config := fastRetryConfig()
+	config.PerAttemptTimeout = 10 * time.Millisecond
+
+	var calls int
+	err := Retry(context.Background(), config, func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			// First attempt hangs until its own deadline
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected success after attempt timeout, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_StopsWhenCallerContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	config := fastRetryConfig()
+	config.InitialBackoff = time.Hour
+
+	var calls int
+	err := Retry(ctx, config, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return &StatusError{StatusCode: http.StatusBadGateway, Message: "bad gateway"}
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestBaseHTTPClient_DoRequestWithRetry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"valid":true}`))
+	}))
+	defer server.Close()
+
+	c := NewBaseHTTPClient(server.URL, time.Second, zap.NewNop())
+	c.Retry = fastRetryConfig()
+
+	var result struct {
+		Valid bool `json:"valid"`
+	}
+	if err := c.DoRequestWithRetry(context.Background(), http.MethodGet, server.URL, "token", &result); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if !result.Valid || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected valid result after 2 calls, got valid=%v calls=%d", result.Valid, calls)
+	}
+}
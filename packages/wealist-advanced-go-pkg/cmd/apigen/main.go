@@ -0,0 +1,72 @@
+// Command apigen은 서비스의 swagger.json에서 Go 클라이언트 패키지나 TypeScript SDK를 생성합니다.
+//
+//	go run ./cmd/apigen -spec ../../services/user-service/docs/swagger.json -lang go -package userapi -out apiclient/userapi/client.gen.go
+//	go run ./cmd/apigen -spec ../../services/user-service/docs/swagger.json -lang ts -name user -exclude /internal/ -out ../../services/frontend/src/api/generated/userApi.ts
+//
+// 보통은 apiclient/generate.go의 go:generate 지시문으로 실행합니다.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apigen"
+)
+
+func main() {
+	specPath := flag.String("spec", "", "path to swagger.json")
+	lang := flag.String("lang", "go", "output language: go or ts")
+	pkg := flag.String("package", "", "Go package name (-lang go)")
+	name := flag.String("name", "", "SDK name, exported as create<Name>Api (-lang ts)")
+	out := flag.String("out", "", "output file (stdout when empty)")
+	exclude := flag.String("exclude", "", "comma-separated path prefixes to skip")
+	source := flag.String("source", "", "spec path written in the generated header (defaults to -spec)")
+	flag.Parse()
+
+	if err := run(*specPath, *lang, *pkg, *name, *out, *exclude, *source); err != nil {
+		fmt.Fprintln(os.Stderr, "apigen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, lang, pkg, name, out, exclude, source string) error {
+	if specPath == "" {
+		return fmt.Errorf("-spec is required")
+	}
+	spec, err := apigen.Load(specPath)
+	if err != nil {
+		return err
+	}
+	if source == "" {
+		source = filepath.ToSlash(specPath)
+	}
+	var prefixes []string
+	if exclude != "" {
+		prefixes = strings.Split(exclude, ",")
+	}
+
+	var src []byte
+	switch lang {
+	case "go":
+		src, err = apigen.GenerateGo(spec, apigen.GoOptions{Package: pkg, Source: source, Exclude: prefixes})
+	case "ts":
+		src, err = apigen.GenerateTS(spec, apigen.TSOptions{Name: name, Source: source, Exclude: prefixes})
+	default:
+		return fmt.Errorf("unknown -lang %q (want go or ts)", lang)
+	}
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
@@ -36,6 +36,11 @@ type DatabaseConfig struct {
 	DBName      string `yaml:"dbname"`
 	SSLMode     string `yaml:"sslmode"`
 	AutoMigrate bool   `yaml:"auto_migrate"`
+
+	// Connection pool settings (DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS, DB_CONN_MAX_LIFETIME)
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 }
 
 // RedisConfig contains Redis connection configuration.
@@ -69,6 +74,11 @@ func DefaultBaseConfig() BaseConfig {
 			Env:      "dev",
 			LogLevel: "debug",
 		},
+		Database: DatabaseConfig{
+			MaxOpenConns:    100,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: time.Hour,
+		},
 		Redis: RedisConfig{
 			Host: "localhost",
 			Port: 6379,
@@ -130,6 +140,9 @@ func (c *BaseConfig) LoadFromEnv() {
 	if autoMigrate := os.Getenv("DB_AUTO_MIGRATE"); autoMigrate != "" {
 		c.Database.AutoMigrate = autoMigrate == "true"
 	}
+	c.Database.MaxOpenConns = GetEnvInt("DB_MAX_OPEN_CONNS", c.Database.MaxOpenConns)
+	c.Database.MaxIdleConns = GetEnvInt("DB_MAX_IDLE_CONNS", c.Database.MaxIdleConns)
+	c.Database.ConnMaxLifetime = GetEnvDuration("DB_CONN_MAX_LIFETIME", c.Database.ConnMaxLifetime)
 
 	// Redis config
 	if redisHost := os.Getenv("REDIS_HOST"); redisHost != "" {
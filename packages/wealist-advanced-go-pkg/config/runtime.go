@@ -0,0 +1,223 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// RuntimeConfigPathEnv is the environment variable holding the path of the runtime settings file
+// (typically a mounted ConfigMap key).
+const RuntimeConfigPathEnv = "RUNTIME_CONFIG_PATH"
+
+// DefaultReloadInterval is how often the runtime settings file is checked for changes.
+// ConfigMap 볼륨은 kubelet 동기화 주기(기본 약 1분)마다 갱신되므로 짧은 폴링으로 충분합니다.
+const DefaultReloadInterval = 10 * time.Second
+
+// RuntimeSettings contains the settings that can change without a restart.
+// Zero values mean "keep the value the service started with".
+//
+//	log_level: info
+//	cors_origins: "https://wealist.co.kr,https://ops.wealist.co.kr"
+//	rate_limit:
+//	  enabled: true
+//	  requests_per_minute: 120
+//	  burst_size: 20
+//	features:
+//	  board-ai-summary: true
+//	chaos:
+//	  enabled: true
+//	  rules:
+//	    - route: /api/boards/*
+//	      latency: 800ms
+//	      latency_percent: 50
+//	      error_percent: 10
+type RuntimeSettings struct {
+	LogLevel    string            `yaml:"log_level"`
+	CORSOrigins string            `yaml:"cors_origins"` // comma separated, "*" allows all
+	RateLimit   RateLimitSettings `yaml:"rate_limit"`
+	Features    map[string]bool   `yaml:"features"`
+	Chaos       ChaosSettings     `yaml:"chaos"`
+}
+
+// RateLimitSettings contains hot-reloadable rate limit settings.
+type RateLimitSettings struct {
+	Enabled           *bool `yaml:"enabled"`
+	RequestsPerMinute int   `yaml:"requests_per_minute"`
+	BurstSize         int   `yaml:"burst_size"`
+}
+
+// ChaosSettings contains fault-injection rules for resilience testing (see middleware.Chaos).
+// 운영 환경(ENV=production)에서는 CHAOS_ALLOW_PRODUCTION=true가 아니면 무시됩니다.
+type ChaosSettings struct {
+	Enabled bool        `yaml:"enabled"`
+	Rules   []ChaosRule `yaml:"rules"`
+}
+
+// ChaosRule injects faults into requests whose route matches Route (and Method, when set).
+// Percentages are 0-100 and rolled independently for each request.
+type ChaosRule struct {
+	Route          string        `yaml:"route"`  // gin route (e.g. /api/boards/:boardId), trailing * matches a prefix, "*" matches all
+	Method         string        `yaml:"method"` // empty matches all methods
+	Latency        time.Duration `yaml:"latency"`
+	LatencyPercent float64       `yaml:"latency_percent"`
+	ErrorPercent   float64       `yaml:"error_percent"`
+	ErrorStatus    int           `yaml:"error_status"` // default 503
+	DropPercent    float64       `yaml:"drop_percent"` // handler runs, then the connection is closed without a response
+}
+
+// Watcher reloads RuntimeSettings from a file when it changes or the process receives SIGHUP,
+// and notifies the registered appliers.
+// nil Watcher의 메서드는 아무 동작도 하지 않으므로 설정 파일이 없는 서비스도 그대로 호출할 수 있습니다.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	logger   *zap.Logger
+
+	mu        sync.RWMutex
+	settings  RuntimeSettings
+	raw       []byte
+	listeners []func(RuntimeSettings)
+}
+
+// NewWatcher creates a watcher for the runtime settings file at path and loads it once.
+// A missing or invalid file is logged and treated as empty settings.
+func NewWatcher(path string, logger *zap.Logger) *Watcher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	w := &Watcher{path: path, interval: DefaultReloadInterval, logger: logger}
+	if err := w.Reload(); err != nil {
+		logger.Warn("Failed to load runtime settings", zap.String("path", path), zap.Error(err))
+	}
+	return w
+}
+
+// WatcherFromEnv creates a watcher for RUNTIME_CONFIG_PATH, or returns nil when it is not set.
+func WatcherFromEnv(logger *zap.Logger) *Watcher {
+	path := os.Getenv(RuntimeConfigPathEnv)
+	if path == "" {
+		return nil
+	}
+	return NewWatcher(path, logger)
+}
+
+// Settings returns the current runtime settings.
+func (w *Watcher) Settings() RuntimeSettings {
+	if w == nil {
+		return RuntimeSettings{}
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.settings
+}
+
+// OnChange registers fn to be called with the new settings after every change.
+// fn is also called immediately with the current settings.
+func (w *Watcher) OnChange(fn func(RuntimeSettings)) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	w.listeners = append(w.listeners, fn)
+	settings := w.settings
+	w.mu.Unlock()
+
+	fn(settings)
+}
+
+// Feature reports whether the feature flag name is enabled, or defaultValue when it is not set.
+func (w *Watcher) Feature(name string, defaultValue bool) bool {
+	if w == nil {
+		return defaultValue
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if enabled, ok := w.settings.Features[name]; ok {
+		return enabled
+	}
+	return defaultValue
+}
+
+// WatchLogLevel applies log_level to level on every change.
+func (w *Watcher) WatchLogLevel(level zap.AtomicLevel) {
+	w.OnChange(func(s RuntimeSettings) {
+		if s.LogLevel == "" {
+			return
+		}
+		if err := level.UnmarshalText([]byte(s.LogLevel)); err != nil {
+			w.logger.Warn("Ignoring invalid runtime log level", zap.String("log_level", s.LogLevel))
+		}
+	})
+}
+
+// Reload reads the settings file and notifies the appliers when its content changed.
+// 파싱에 실패하면 기존 설정을 유지합니다.
+func (w *Watcher) Reload() error {
+	if w == nil {
+		return nil
+	}
+	raw, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	w.mu.RLock()
+	unchanged := w.raw != nil && bytes.Equal(raw, w.raw)
+	w.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	var settings RuntimeSettings
+	if err := yaml.Unmarshal(raw, &settings); err != nil {
+		return fmt.Errorf("invalid runtime settings: %w", err)
+	}
+
+	w.mu.Lock()
+	w.raw = raw
+	w.settings = settings
+	listeners := make([]func(RuntimeSettings), len(w.listeners))
+	copy(listeners, w.listeners)
+	w.mu.Unlock()
+
+	for _, fn := range listeners {
+		fn(settings)
+	}
+	w.logger.Info("Runtime settings applied", zap.String("path", w.path))
+	return nil
+}
+
+// Run reloads the settings periodically and on SIGHUP until ctx is cancelled.
+func (w *Watcher) Run(ctx context.Context) {
+	if w == nil {
+		return
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			w.logger.Info("SIGHUP received, reloading runtime settings")
+		case <-ticker.C:
+		}
+		if err := w.Reload(); err != nil {
+			w.logger.Warn("Failed to reload runtime settings", zap.String("path", w.path), zap.Error(err))
+		}
+	}
+}
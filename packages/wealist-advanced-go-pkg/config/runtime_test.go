@@ -0,0 +1,128 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func writeRuntimeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write runtime settings: %v", err)
+	}
+}
+
+func TestWatcher_ReloadNotifiesOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	writeRuntimeFile(t, path, "log_level: info\nfeatures:\n  new-editor: false\n")
+
+	w := NewWatcher(path, nil)
+
+	var calls int
+	var last RuntimeSettings
+	w.OnChange(func(s RuntimeSettings) {
+		calls++
+		last = s
+	})
+	if calls != 1 || last.LogLevel != "info" {
+		t.Fatalf("expected immediate call with current settings, got %d calls, %+v", calls, last)
+	}
+
+	// 내용이 같으면 다시 적용하지 않는다
+	if err := w.Reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected no notification for unchanged file, got %d calls", calls)
+	}
+
+	writeRuntimeFile(t, path, "log_level: debug\ncors_origins: https://wealist.co.kr\nrate_limit:\n  enabled: false\nfeatures:\n  new-editor: true\n")
+	if err := w.Reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected notification after change, got %d calls", calls)
+	}
+	if last.LogLevel != "debug" || last.CORSOrigins != "https://wealist.co.kr" {
+		t.Errorf("unexpected settings: %+v", last)
+	}
+	if last.RateLimit.Enabled == nil || *last.RateLimit.Enabled {
+		t.Errorf("expected rate_limit.enabled=false, got %v", last.RateLimit.Enabled)
+	}
+	if !w.Feature("new-editor", false) || w.Feature("unknown", true) != true {
+		t.Error("unexpected feature flag values")
+	}
+}
+
+func TestWatcher_InvalidFileKeepsPreviousSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	writeRuntimeFile(t, path, "log_level: warn\n")
+	w := NewWatcher(path, nil)
+
+	writeRuntimeFile(t, path, "log_level: [broken\n")
+	if err := w.Reload(); err == nil {
+		t.Fatal("expected parse error")
+	}
+	if got := w.Settings().LogLevel; got != "warn" {
+		t.Errorf("expected previous log level to be kept, got %q", got)
+	}
+}
+
+func TestWatcher_ChaosSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	writeRuntimeFile(t, path, `chaos:
+  enabled: true
+  rules:
+    - route: /api/boards/*
+      method: GET
+      latency: 800ms
+      latency_percent: 50
+      error_percent: 10
+      error_status: 502
+`)
+	w := NewWatcher(path, nil)
+
+	chaos := w.Settings().Chaos
+	if !chaos.Enabled || len(chaos.Rules) != 1 {
+		t.Fatalf("unexpected chaos settings: %+v", chaos)
+	}
+	rule := chaos.Rules[0]
+	if rule.Route != "/api/boards/*" || rule.Method != "GET" || rule.Latency != 800*time.Millisecond ||
+		rule.LatencyPercent != 50 || rule.ErrorPercent != 10 || rule.ErrorStatus != 502 {
+		t.Errorf("unexpected chaos rule: %+v", rule)
+	}
+}
+
+func TestWatcher_WatchLogLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	writeRuntimeFile(t, path, "log_level: error\n")
+	w := NewWatcher(path, nil)
+
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	w.WatchLogLevel(level)
+	if level.Level() != zapcore.ErrorLevel {
+		t.Errorf("expected error level, got %s", level.Level())
+	}
+
+	writeRuntimeFile(t, path, "log_level: nonsense\n")
+	_ = w.Reload()
+	if level.Level() != zapcore.ErrorLevel {
+		t.Errorf("invalid level should be ignored, got %s", level.Level())
+	}
+}
+
+func TestWatcher_NilIsNoop(t *testing.T) {
+	var w *Watcher
+	w.OnChange(func(RuntimeSettings) { t.Error("nil watcher must not call appliers") })
+	if err := w.Reload(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !w.Feature("any", true) {
+		t.Error("nil watcher should return the default")
+	}
+}
@@ -0,0 +1,45 @@
+// Package dbreplica는 GORM 읽기 쿼리를 PostgreSQL 읽기 전용 복제본(read replica)으로 보내는 플러그인을 제공합니다.
+//
+// gorm.io/plugin/dbresolver와 같은 방식(Query/Row 콜백에서 Statement.ConnPool 교체)이지만,
+// 복제 지연으로 방금 쓴 데이터가 보이지 않는 문제를 피하기 위해 모든 읽기가 아니라
+// 복제본 읽기를 허용한 요청(ReadFromReplica 미들웨어 또는 WithReplicaReads)만 라우팅합니다.
+// 트랜잭션 안의 쿼리, SELECT ... FOR UPDATE, 쓰기는 항상 primary를 사용합니다.
+//
+//	resolver, err := dbreplica.Open(dbreplica.Config{DSNs: cfg.Database.ReplicaDSNs}, logger)
+//	if err == nil && db.Use(resolver) == nil {
+//		resolver.SetMetrics(m)
+//		resolver.Start()
+//	}
+//	boards.GET("", dbreplica.ReadFromReplica(), boardHandler.GetBoardsByProjectQuery)
+package dbreplica
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type replicaReadsKey struct{}
+
+// WithReplicaReads는 ctx로 실행하는 읽기 쿼리를 복제본으로 보낼 수 있게 표시합니다.
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsKey{}, true)
+}
+
+// ReplicaReadsEnabled는 ctx가 복제본 읽기를 허용하는지 반환합니다.
+func ReplicaReadsEnabled(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	enabled, _ := ctx.Value(replicaReadsKey{}).(bool)
+	return enabled
+}
+
+// ReadFromReplica는 요청의 읽기 쿼리를 복제본으로 보내는 Gin 미들웨어입니다.
+// 수 초의 복제 지연을 허용하는 목록/검색 같은 무거운 GET 라우트에만 사용하세요.
+func ReadFromReplica() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(WithReplicaReads(c.Request.Context()))
+		c.Next()
+	}
+}
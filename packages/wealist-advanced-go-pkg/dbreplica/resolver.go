@@ -0,0 +1,261 @@
+package dbreplica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
+)
+
+// Config는 복제본 연결 설정입니다.
+type Config struct {
+	// DSNs는 복제본 DSN 목록입니다 (postgres URL 또는 key=value 형식). 비어 있으면 복제본을 쓰지 않습니다.
+	DSNs []string
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+
+	// HealthCheckInterval은 복제본 ping 주기입니다 (기본 10초).
+	HealthCheckInterval time.Duration
+
+	// HealthCheckTimeout은 ping 한 번의 제한 시간입니다 (기본 2초).
+	HealthCheckTimeout time.Duration
+}
+
+// Pool은 복제본 연결 풀입니다 (*sql.DB).
+type Pool interface {
+	gorm.ConnPool
+	PingContext(ctx context.Context) error
+	Close() error
+}
+
+// Replica는 이름이 붙은 복제본 연결 풀입니다.
+type Replica struct {
+	Name string
+	Pool Pool
+}
+
+type replica struct {
+	Replica
+	healthy atomic.Bool
+}
+
+// Resolver는 복제본 읽기를 허용한 쿼리를 정상 복제본에 round-robin으로 보내는 GORM 플러그인입니다.
+// 정상 복제본이 없으면 primary를 사용합니다.
+type Resolver struct {
+	replicas []*replica
+	next     atomic.Uint64
+	config   Config
+	logger   *zap.Logger
+	metrics  atomic.Pointer[commonmetrics.Metrics]
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// Open은 cfg.DSNs의 복제본에 연결하고 Resolver를 생성합니다.
+// 시작 시 연결할 수 없는 복제본은 비정상으로 표시되고 health check가 회복을 감지합니다.
+func Open(cfg Config, logger *zap.Logger) (*Resolver, error) {
+	if len(cfg.DSNs) == 0 {
+		return nil, errors.New("no replica DSNs configured")
+	}
+
+	replicas := make([]Replica, 0, len(cfg.DSNs))
+	for i, dsn := range cfg.DSNs {
+		// 연결 실패는 health check가 처리하도록 ping 없이 풀만 생성
+		gormDB, err := gorm.Open(postgres.Open(dsn), &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			closeReplicas(replicas)
+			return nil, fmt.Errorf("failed to open replica %d: %w", i+1, err)
+		}
+		sqlDB, err := gormDB.DB()
+		if err != nil {
+			closeReplicas(replicas)
+			return nil, fmt.Errorf("failed to get replica %d pool: %w", i+1, err)
+		}
+		if cfg.MaxOpenConns > 0 {
+			sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+		}
+		if cfg.MaxIdleConns > 0 {
+			sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+		}
+		if cfg.ConnMaxLifetime > 0 {
+			sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		}
+		replicas = append(replicas, Replica{Name: fmt.Sprintf("replica-%d", i+1), Pool: sqlDB})
+	}
+
+	r := New(cfg, logger, replicas...)
+	r.checkAll(context.Background())
+	return r, nil
+}
+
+// New는 이미 연결된 복제본으로 Resolver를 생성합니다. 복제본은 health check 전까지 정상으로 간주합니다.
+func New(cfg Config, logger *zap.Logger, replicas ...Replica) *Resolver {
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = 10 * time.Second
+	}
+	if cfg.HealthCheckTimeout <= 0 {
+		cfg.HealthCheckTimeout = 2 * time.Second
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	r := &Resolver{
+		config: cfg,
+		logger: logger,
+		stop:   make(chan struct{}),
+	}
+	for _, rep := range replicas {
+		entry := &replica{Replica: rep}
+		entry.healthy.Store(true)
+		r.replicas = append(r.replicas, entry)
+	}
+	return r
+}
+
+// SetMetrics는 복제본 상태(db_replica_up)와 읽기 라우팅(db_reads_routed_total) 메트릭을 기록할 대상을 설정합니다.
+func (r *Resolver) SetMetrics(m *commonmetrics.Metrics) {
+	r.metrics.Store(m)
+}
+
+// Name은 gorm.Plugin 이름입니다.
+func (r *Resolver) Name() string {
+	return "wealist:dbreplica"
+}
+
+// Initialize는 쿼리 콜백을 등록합니다 (db.Use(resolver)에서 호출됨).
+func (r *Resolver) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Query().Before("gorm:query").Register("wealist:dbreplica:query", r.route); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("wealist:dbreplica:row", r.route)
+}
+
+// route는 복제본 읽기가 허용된 쿼리의 연결 풀을 복제본으로 바꿉니다.
+func (r *Resolver) route(db *gorm.DB) {
+	stmt := db.Statement
+	if !ReplicaReadsEnabled(stmt.Context) {
+		return
+	}
+	if _, inTx := stmt.ConnPool.(gorm.TxCommitter); inTx {
+		return
+	}
+	if _, locking := stmt.Clauses["FOR"]; locking {
+		return
+	}
+
+	if rep := r.pick(); rep != nil {
+		stmt.ConnPool = rep.Pool
+		r.recordRoute(commonmetrics.DBTargetReplica)
+		return
+	}
+	r.recordRoute(commonmetrics.DBTargetPrimaryFallback)
+}
+
+// pick은 정상 복제본을 round-robin으로 고릅니다.
+func (r *Resolver) pick() *replica {
+	n := len(r.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := range n {
+		rep := r.replicas[(start+uint64(i))%uint64(n)]
+		if rep.healthy.Load() {
+			return rep
+		}
+	}
+	return nil
+}
+
+// Start는 주기적인 복제본 health check를 시작합니다.
+func (r *Resolver) Start() {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.checkAll(context.Background())
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Check는 복제본을 ping하고 정상 복제본이 하나도 없으면 에러를 반환합니다.
+// readiness에는 health.NonCritical()로 등록합니다 (복제본이 없어도 primary로 서비스 가능).
+func (r *Resolver) Check(ctx context.Context) error {
+	if healthy := r.checkAll(ctx); healthy == 0 {
+		return fmt.Errorf("all %d read replicas are unavailable, reads fall back to primary", len(r.replicas))
+	}
+	return nil
+}
+
+// Close는 health check를 멈추고 복제본 연결을 닫습니다.
+func (r *Resolver) Close() error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	r.wg.Wait()
+
+	var errs []error
+	for _, rep := range r.replicas {
+		if err := rep.Pool.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", rep.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkAll은 모든 복제본을 ping해 상태를 갱신하고 정상 복제본 수를 반환합니다.
+func (r *Resolver) checkAll(ctx context.Context) int {
+	healthy := 0
+	for _, rep := range r.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, r.config.HealthCheckTimeout)
+		err := rep.Pool.PingContext(pingCtx)
+		cancel()
+
+		up := err == nil
+		if was := rep.healthy.Swap(up); was != up {
+			if up {
+				r.logger.Info("Read replica recovered", zap.String("replica", rep.Name))
+			} else {
+				r.logger.Warn("Read replica unavailable, routing its reads elsewhere",
+					zap.String("replica", rep.Name), zap.Error(err))
+			}
+		}
+		if m := r.metrics.Load(); m != nil {
+			m.SetDBReplicaUp(rep.Name, up)
+		}
+		if up {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+func (r *Resolver) recordRoute(target string) {
+	if m := r.metrics.Load(); m != nil {
+		m.RecordDBReadRoute(target)
+	}
+}
+
+func closeReplicas(replicas []Replica) {
+	for _, rep := range replicas {
+		_ = rep.Pool.Close()
+	}
+}
@@ -0,0 +1,169 @@
+package dbreplica
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
+)
+
+// fakePool은 ping 결과만 제어하는 복제본 풀입니다 (쿼리는 실행하지 않음).
+type fakePool struct {
+	*sql.DB
+	pingErr error
+	closed  bool
+}
+
+func (p *fakePool) PingContext(context.Context) error { return p.pingErr }
+func (p *fakePool) Close() error                      { p.closed = true; return nil }
+
+type fakeTx struct{ fakePool }
+
+func (*fakeTx) Commit() error   { return nil }
+func (*fakeTx) Rollback() error { return nil }
+
+func statement(ctx context.Context, pool gorm.ConnPool) *gorm.DB {
+	return &gorm.DB{Statement: &gorm.Statement{Context: ctx, ConnPool: pool, Clauses: map[string]clause.Clause{}}}
+}
+
+func TestRoute(t *testing.T) {
+	primary := &fakePool{}
+	replica1, replica2 := &fakePool{}, &fakePool{}
+	r := New(Config{}, nil, Replica{Name: "replica-1", Pool: replica1}, Replica{Name: "replica-2", Pool: replica2})
+	replicaCtx := WithReplicaReads(context.Background())
+
+	t.Run("not enabled uses primary", func(t *testing.T) {
+		db := statement(context.Background(), primary)
+		r.route(db)
+		if db.Statement.ConnPool != primary {
+			t.Error("expected primary without WithReplicaReads")
+		}
+	})
+
+	t.Run("enabled uses replicas round-robin", func(t *testing.T) {
+		seen := map[gorm.ConnPool]int{}
+		for range 4 {
+			db := statement(replicaCtx, primary)
+			r.route(db)
+			seen[db.Statement.ConnPool]++
+		}
+		if seen[replica1] != 2 || seen[replica2] != 2 {
+			t.Errorf("expected reads spread over replicas, got replica1=%d replica2=%d primary=%d",
+				seen[replica1], seen[replica2], seen[primary])
+		}
+	})
+
+	t.Run("transaction stays on primary", func(t *testing.T) {
+		tx := &fakeTx{}
+		db := statement(replicaCtx, tx)
+		r.route(db)
+		if db.Statement.ConnPool != tx {
+			t.Error("expected query inside a transaction to stay on the transaction")
+		}
+	})
+
+	t.Run("locking read stays on primary", func(t *testing.T) {
+		db := statement(replicaCtx, primary)
+		db.Statement.Clauses["FOR"] = clause.Clause{Name: "FOR"}
+		r.route(db)
+		if db.Statement.ConnPool != primary {
+			t.Error("expected SELECT ... FOR UPDATE to stay on primary")
+		}
+	})
+}
+
+func TestHealthCheck(t *testing.T) {
+	primary := &fakePool{}
+	healthy, broken := &fakePool{}, &fakePool{pingErr: errors.New("connection refused")}
+	r := New(Config{}, nil, Replica{Name: "replica-1", Pool: healthy}, Replica{Name: "replica-2", Pool: broken})
+	ctx := WithReplicaReads(context.Background())
+
+	if err := r.Check(context.Background()); err != nil {
+		t.Fatalf("expected check to pass with one healthy replica: %v", err)
+	}
+	for range 3 {
+		db := statement(ctx, primary)
+		r.route(db)
+		if db.Statement.ConnPool != healthy {
+			t.Fatal("expected unhealthy replica to be skipped")
+		}
+	}
+
+	healthy.pingErr = errors.New("timeout")
+	if err := r.Check(context.Background()); err == nil {
+		t.Fatal("expected check to fail when all replicas are down")
+	}
+	db := statement(ctx, primary)
+	r.route(db)
+	if db.Statement.ConnPool != primary {
+		t.Error("expected fallback to primary when no replica is healthy")
+	}
+
+	r.Start()
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !healthy.closed || !broken.closed {
+		t.Error("expected Close to close replica pools")
+	}
+}
+
+func TestReadFromReplica(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	var enabled bool
+	r.GET("/boards", ReadFromReplica(), func(c *gin.Context) {
+		enabled = ReplicaReadsEnabled(c.Request.Context())
+	})
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/boards", nil))
+	if !enabled {
+		t.Error("expected middleware to enable replica reads")
+	}
+	if ReplicaReadsEnabled(context.Background()) {
+		t.Error("expected replica reads to be off by default")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	m := commonmetrics.NewForTest("test")
+	r := New(Config{}, nil, Replica{Name: "replica-1", Pool: &fakePool{pingErr: errors.New("down")}})
+	r.SetMetrics(m)
+
+	_ = r.Check(context.Background())
+	r.route(statement(WithReplicaReads(context.Background()), &fakePool{}))
+
+	if got := gaugeValue(t, m.DBReplicaUp.WithLabelValues("replica-1")); got != 0 {
+		t.Errorf("db_replica_up = %v, want 0", got)
+	}
+	if got := counterValue(t, m.DBReadsRouted.WithLabelValues(commonmetrics.DBTargetPrimaryFallback)); got != 1 {
+		t.Errorf("primary fallback reads = %v, want 1", got)
+	}
+}
+
+func gaugeValue(t *testing.T, g prometheus.Gauge) float64 {
+	t.Helper()
+	var out dto.Metric
+	if err := g.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	return out.GetGauge().GetValue()
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	t.Helper()
+	var out dto.Metric
+	if err := c.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	return out.GetCounter().GetValue()
+}
@@ -146,6 +146,51 @@ func ServiceUnavailable(message string, details string) *AppError {
 	}
 }
 
+// RateLimited creates a new rate limited error
+func RateLimited(message string, details string) *AppError {
+	return &AppError{
+		Code:    ErrCodeRateLimited,
+		Message: message,
+		Details: details,
+	}
+}
+
+// AlreadyMember creates a new already member error
+func AlreadyMember(message string, details string) *AppError {
+	return &AppError{
+		Code:    ErrCodeAlreadyMember,
+		Message: message,
+		Details: details,
+	}
+}
+
+// PendingRequestExists creates a new pending join request error
+func PendingRequestExists(message string, details string) *AppError {
+	return &AppError{
+		Code:    ErrCodePendingRequestExists,
+		Message: message,
+		Details: details,
+	}
+}
+
+// FileTooLarge creates a new file too large error
+func FileTooLarge(message string, details string) *AppError {
+	return &AppError{
+		Code:    ErrCodeFileTooLarge,
+		Message: message,
+		Details: details,
+	}
+}
+
+// InvalidFileType creates a new invalid file type error
+func InvalidFileType(message string, details string) *AppError {
+	return &AppError{
+		Code:    ErrCodeInvalidFileType,
+		Message: message,
+		Details: details,
+	}
+}
+
 // IsAppError checks if an error is an AppError
 func IsAppError(err error) bool {
 	_, ok := err.(*AppError)
@@ -0,0 +1,131 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// Category groups error codes so clients can handle related errors the same way
+// (e.g. show a form error for every validation code, re-login for every auth code).
+type Category string
+
+const (
+	// CategoryValidation covers malformed or invalid input (4xx, fix the request)
+	CategoryValidation Category = "validation"
+
+	// CategoryAuth covers missing or invalid authentication (re-authenticate)
+	CategoryAuth Category = "auth"
+
+	// CategoryPermission covers authenticated requests that are not allowed
+	CategoryPermission Category = "permission"
+
+	// CategoryNotFound covers resources that do not exist
+	CategoryNotFound Category = "not_found"
+
+	// CategoryConflict covers requests that conflict with the current state
+	CategoryConflict Category = "conflict"
+
+	// CategoryRateLimit covers requests rejected by quotas (retry later)
+	CategoryRateLimit Category = "rate_limit"
+
+	// CategoryUnavailable covers temporary failures of the service or its dependencies (retry later)
+	CategoryUnavailable Category = "unavailable"
+
+	// CategoryInternal covers unexpected server errors
+	CategoryInternal Category = "internal"
+)
+
+// CodeInfo describes a registered error code.
+type CodeInfo struct {
+	Code        string   `json:"code"`
+	Category    Category `json:"category"`
+	HTTPStatus  int      `json:"httpStatus"`
+	Description string   `json:"description"`
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = map[string]CodeInfo{}
+)
+
+func init() {
+	for _, info := range []CodeInfo{
+		{ErrCodeValidation, CategoryValidation, http.StatusBadRequest, "Request failed validation"},
+		{ErrCodeBadRequest, CategoryValidation, http.StatusBadRequest, "Request is malformed or has invalid parameters"},
+		{ErrCodeFileTooLarge, CategoryValidation, http.StatusBadRequest, "Uploaded file exceeds the size limit"},
+		{ErrCodeInvalidFileType, CategoryValidation, http.StatusBadRequest, "Uploaded file type is not allowed"},
+		{ErrCodeRequestTooLarge, CategoryValidation, http.StatusRequestEntityTooLarge, "Request body exceeds the size limit"},
+		{ErrCodeUnauthorized, CategoryAuth, http.StatusUnauthorized, "Authentication is missing or invalid"},
+		{ErrCodeForbidden, CategoryPermission, http.StatusForbidden, "Caller is not allowed to perform the action"},
+		{ErrCodeCSRFTokenInvalid, CategoryPermission, http.StatusForbidden, "CSRF token is missing or does not match, fetch a new token and retry"},
+		{ErrCodeNotWorkspaceMember, CategoryPermission, http.StatusForbidden, "Caller is not a member of the workspace"},
+		{ErrCodeWorkspaceMismatch, CategoryPermission, http.StatusForbidden, "Request targets a workspace other than the active one"},
+		{ErrCodeNotFound, CategoryNotFound, http.StatusNotFound, "Resource was not found"},
+		{ErrCodeAlreadyExists, CategoryConflict, http.StatusConflict, "Resource already exists"},
+		{ErrCodeConflict, CategoryConflict, http.StatusConflict, "Request conflicts with the current state"},
+		{ErrCodeAlreadyMember, CategoryConflict, http.StatusConflict, "User is already a member"},
+		{ErrCodePendingRequestExists, CategoryConflict, http.StatusConflict, "A join request is already pending"},
+		{ErrCodeRateLimited, CategoryRateLimit, http.StatusTooManyRequests, "Too many requests, retry after the Retry-After interval"},
+		{ErrCodeTimeout, CategoryUnavailable, http.StatusRequestTimeout, "Operation timed out"},
+		{ErrCodeServiceUnavailable, CategoryUnavailable, http.StatusServiceUnavailable, "Service or one of its dependencies is unavailable"},
+		{ErrCodeInternal, CategoryInternal, http.StatusInternalServerError, "Unexpected server error"},
+	} {
+		MustRegister(info)
+	}
+}
+
+// Register adds a service-specific error code to the catalog.
+// Registering the same code again with a different category or status is an error.
+// 서비스 전용 코드는 패키지 init에서 등록합니다.
+func Register(info CodeInfo) error {
+	if info.Code == "" || info.Category == "" || info.HTTPStatus == 0 {
+		return fmt.Errorf("errors: incomplete code info %+v", info)
+	}
+
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	if existing, ok := catalog[info.Code]; ok &&
+		(existing.Category != info.Category || existing.HTTPStatus != info.HTTPStatus) {
+		return fmt.Errorf("errors: code %s already registered as %s/%d", info.Code, existing.Category, existing.HTTPStatus)
+	}
+	catalog[info.Code] = info
+	ErrorCodeToHTTPStatus[info.Code] = info.HTTPStatus
+	return nil
+}
+
+// MustRegister is like Register but panics on error.
+func MustRegister(info CodeInfo) {
+	if err := Register(info); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the catalog entry for code.
+func Lookup(code string) (CodeInfo, bool) {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	info, ok := catalog[code]
+	return info, ok
+}
+
+// CategoryOf returns the category of code, or CategoryInternal for unknown codes.
+func CategoryOf(code string) Category {
+	if info, ok := Lookup(code); ok {
+		return info.Category
+	}
+	return CategoryInternal
+}
+
+// Catalog returns every registered code sorted by code, e.g. for publishing API documentation.
+func Catalog() []CodeInfo {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	infos := make([]CodeInfo, 0, len(catalog))
+	for _, info := range catalog {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Code < infos[j].Code })
+	return infos
+}
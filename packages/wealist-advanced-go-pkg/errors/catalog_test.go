@@ -0,0 +1,66 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCatalog_BuiltinCodesRegistered(t *testing.T) {
+	for _, info := range Catalog() {
+		if info.Category == "" || info.HTTPStatus == 0 || info.Description == "" {
+			t.Errorf("incomplete catalog entry: %+v", info)
+		}
+		if got := GetHTTPStatus(info.Code); got != info.HTTPStatus {
+			t.Errorf("GetHTTPStatus(%s) = %d, want %d", info.Code, got, info.HTTPStatus)
+		}
+	}
+
+	tests := []struct {
+		code     string
+		category Category
+		status   int
+	}{
+		{ErrCodeAlreadyMember, CategoryConflict, http.StatusConflict},
+		{ErrCodeFileTooLarge, CategoryValidation, http.StatusBadRequest},
+		{ErrCodeRateLimited, CategoryRateLimit, http.StatusTooManyRequests},
+		{ErrCodeUnauthorized, CategoryAuth, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		info, ok := Lookup(tt.code)
+		if !ok {
+			t.Fatalf("%s not registered", tt.code)
+		}
+		if info.Category != tt.category || info.HTTPStatus != tt.status {
+			t.Errorf("%s = %s/%d, want %s/%d", tt.code, info.Category, info.HTTPStatus, tt.category, tt.status)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	info := CodeInfo{Code: "TEST_QUOTA_EXCEEDED", Category: CategoryRateLimit, HTTPStatus: http.StatusTooManyRequests, Description: "test"}
+	if err := Register(info); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	// 같은 정의로 다시 등록하는 것은 허용
+	if err := Register(info); err != nil {
+		t.Errorf("re-register with same definition: %v", err)
+	}
+	if GetHTTPStatus(info.Code) != http.StatusTooManyRequests || CategoryOf(info.Code) != CategoryRateLimit {
+		t.Error("registered code not resolved")
+	}
+
+	conflicting := info
+	conflicting.HTTPStatus = http.StatusBadRequest
+	if err := Register(conflicting); err == nil {
+		t.Error("expected error for conflicting definition")
+	}
+	if err := Register(CodeInfo{Code: "TEST_INCOMPLETE"}); err == nil {
+		t.Error("expected error for incomplete definition")
+	}
+}
+
+func TestCategoryOf_Unknown(t *testing.T) {
+	if got := CategoryOf("UNKNOWN_CODE"); got != CategoryInternal {
+		t.Errorf("CategoryOf(unknown) = %s, want %s", got, CategoryInternal)
+	}
+}
@@ -32,27 +32,48 @@ const (
 
 	// ErrCodeServiceUnavailable indicates the service is temporarily unavailable
 	ErrCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+
+	// ErrCodeRateLimited indicates the client exceeded its request quota
+	ErrCodeRateLimited = "RATE_LIMITED"
 )
 
-// HTTP status code mapping for error codes
-var ErrorCodeToHTTPStatus = map[string]int{
-	ErrCodeNotFound:           404,
-	ErrCodeAlreadyExists:      409,
-	ErrCodeValidation:         400,
-	ErrCodeInternal:           500,
-	ErrCodeUnauthorized:       401,
-	ErrCodeForbidden:          403,
-	ErrCodeBadRequest:         400,
-	ErrCodeConflict:           409,
-	ErrCodeTimeout:            408,
-	ErrCodeServiceUnavailable: 503,
-}
+// Domain error codes shared by several services
+const (
+	// ErrCodeAlreadyMember indicates the user is already a member of the workspace or project
+	ErrCodeAlreadyMember = "ALREADY_MEMBER"
+
+	// ErrCodePendingRequestExists indicates a join request is already waiting for approval
+	ErrCodePendingRequestExists = "PENDING_REQUEST_EXISTS"
+
+	// ErrCodeFileTooLarge indicates the uploaded file exceeds the size limit
+	ErrCodeFileTooLarge = "FILE_TOO_LARGE"
+
+	// ErrCodeInvalidFileType indicates the uploaded file type is not allowed
+	ErrCodeInvalidFileType = "INVALID_FILE_TYPE"
+
+	// ErrCodeRequestTooLarge indicates the request body exceeds the size limit of the route
+	ErrCodeRequestTooLarge = "REQUEST_TOO_LARGE"
+
+	// ErrCodeCSRFTokenInvalid indicates a cookie-authenticated request without a matching CSRF token
+	ErrCodeCSRFTokenInvalid = "CSRF_TOKEN_INVALID"
+
+	// ErrCodeNotWorkspaceMember indicates the caller is not a member of the requested workspace
+	ErrCodeNotWorkspaceMember = "NOT_WORKSPACE_MEMBER"
+
+	// ErrCodeWorkspaceMismatch indicates the request names more than one workspace, or a resource outside the active one
+	ErrCodeWorkspaceMismatch = "WORKSPACE_MISMATCH"
+)
+
+// HTTP status code mapping for error codes.
+//
+// Deprecated: use Lookup; codes added with Register are also reflected here.
+var ErrorCodeToHTTPStatus = map[string]int{}
 
 // GetHTTPStatus returns the HTTP status code for the given error code.
 // Returns 500 (Internal Server Error) if the code is not recognized.
 func GetHTTPStatus(code string) int {
-	if status, ok := ErrorCodeToHTTPStatus[code]; ok {
-		return status
+	if info, ok := Lookup(code); ok {
+		return info.HTTPStatus
 	}
 	return 500
 }
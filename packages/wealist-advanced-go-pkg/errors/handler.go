@@ -12,6 +12,8 @@ import (
 )
 
 // ErrorResponse는 에러 API 응답의 JSON 구조체입니다.
+//
+// Deprecated: 서비스 응답은 response 패키지의 공통 봉투({success, error, requestId})를 사용합니다.
 type ErrorResponse struct {
 	Code    string `json:"code"`              // 에러 코드 (예: NOT_FOUND, VALIDATION_ERROR)
 	Message string `json:"message"`           // 사용자에게 보여줄 메시지
@@ -26,6 +28,8 @@ type Handler struct {
 
 // NewHandler는 주어진 로거로 새 에러 핸들러를 생성합니다.
 // logger가 nil이면 zap.NewNop()을 사용합니다.
+//
+// Deprecated: response.HandleError를 사용하세요.
 func NewHandler(logger *zap.Logger) *Handler {
 	if logger == nil {
 		logger = zap.NewNop()
@@ -86,6 +90,8 @@ func (h *Handler) sendError(c *gin.Context, statusCode int, code, message, detai
 }
 
 // SendError는 Handler 인스턴스 없이 에러 응답을 전송하는 헬퍼 함수입니다.
+//
+// Deprecated: response.Error를 사용하세요.
 func SendError(c *gin.Context, statusCode int, code, message string) {
 	c.JSON(statusCode, ErrorResponse{
 		Code:    code,
@@ -94,6 +100,8 @@ func SendError(c *gin.Context, statusCode int, code, message string) {
 }
 
 // SendErrorWithDetails는 상세 정보와 함께 에러 응답을 전송합니다.
+//
+// Deprecated: response.ErrorWithDetails를 사용하세요.
 func SendErrorWithDetails(c *gin.Context, statusCode int, code, message, details string) {
 	c.JSON(statusCode, ErrorResponse{
 		Code:    code,
@@ -104,6 +112,8 @@ func SendErrorWithDetails(c *gin.Context, statusCode int, code, message, details
 
 // HandleAppError는 AppError를 직접 처리하는 편의 함수입니다.
 // nil인 경우 아무것도 하지 않습니다.
+//
+// Deprecated: response.ErrorFrom을 사용하세요.
 func HandleAppError(c *gin.Context, err *AppError) {
 	if err == nil {
 		return
@@ -129,6 +139,8 @@ func GetLoggerFromContext(c *gin.Context) *zap.Logger {
 
 // HandleServiceError는 서비스 레이어의 에러를 처리하는 편의 함수입니다.
 // 컨텍스트에서 로거를 가져와서 에러를 적절히 처리합니다.
+//
+// Deprecated: response.HandleError를 사용하세요.
 func HandleServiceError(c *gin.Context, err error) {
 	logger := GetLoggerFromContext(c)
 	handler := NewHandler(logger)
@@ -0,0 +1,189 @@
+// Package health는 K8s 헬스체크 엔드포인트를 제공합니다.
+// 이 파일은 readiness에서 확인하는 의존성(컴포넌트) 체크를 정의합니다.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// 컴포넌트 상태 값
+const (
+	StatusUp            = "up"
+	StatusDown          = "down"
+	StatusNotConfigured = "not_configured"
+)
+
+// 전체 readiness 상태 값
+const (
+	StatusReady    = "ready"
+	StatusDegraded = "degraded" // 필수가 아닌 의존성만 실패 (트래픽은 계속 받음)
+	StatusNotReady = "not_ready"
+)
+
+// 다운스트림 liveness 경로 (AddDownstream에 사용)
+const (
+	LivenessPath            = "/healthz"                  // Go 서비스 (RegisterRoutes)
+	AuthServiceLivenessPath = "/actuator/health/liveness" // auth-service (Spring Boot Actuator)
+)
+
+// DefaultCheckTimeout은 체크별 타임아웃을 지정하지 않았을 때 사용하는 값입니다.
+const DefaultCheckTimeout = 2 * time.Second
+
+// CheckFunc는 의존성 하나의 상태를 확인합니다. 정상이면 nil을 반환합니다.
+type CheckFunc func(ctx context.Context) error
+
+// CheckOption은 AddCheck로 등록하는 체크의 동작을 설정합니다.
+type CheckOption func(*check)
+
+// NonCritical은 실패해도 readiness를 503으로 만들지 않는 체크로 등록합니다.
+// 다운스트림 서비스처럼 장애가 연쇄적으로 전파되면 안 되는 의존성에 사용합니다.
+func NonCritical() CheckOption {
+	return func(c *check) {
+		c.critical = false
+	}
+}
+
+// WithTimeout은 체크의 타임아웃을 설정합니다 (기본값 DefaultCheckTimeout).
+func WithTimeout(timeout time.Duration) CheckOption {
+	return func(c *check) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+type check struct {
+	name     string
+	fn       CheckFunc // nil이면 not_configured
+	critical bool
+	timeout  time.Duration
+}
+
+// Component는 readiness 응답에 포함되는 의존성 하나의 상태입니다.
+type Component struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report는 readiness 확인 결과입니다. K8s probe는 상태 코드만, ops 대시보드는 본문을 사용합니다.
+type Report struct {
+	Status     string            `json:"status"`
+	Checks     map[string]string `json:"checks"` // 하위 호환성: 컴포넌트 이름 → ok/disconnected/not_configured
+	Components []Component       `json:"components"`
+	CheckedAt  time.Time         `json:"checkedAt"`
+}
+
+// Ready는 트래픽을 받을 수 있는 상태(ready 또는 degraded)인지 반환합니다.
+func (r Report) Ready() bool {
+	return r.Status != StatusNotReady
+}
+
+// HTTPCheck는 url에 GET 요청을 보내 다운스트림 서비스 상태를 확인하는 CheckFunc를 반환합니다.
+// 5xx 응답이나 연결 실패를 장애로 판단합니다. client가 nil이면 http.DefaultClient를 사용합니다.
+func HTTPCheck(client *http.Client, url string) CheckFunc {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// PingCheck는 Pinger를 CheckFunc로 변환합니다.
+func PingCheck(p Pinger) CheckFunc {
+	return p.Ping
+}
+
+// runChecks는 모든 체크를 동시에 실행하고 결과를 등록 순서대로 반환합니다.
+func runChecks(ctx context.Context, checks []check) []Component {
+	components := make([]Component, len(checks))
+
+	var wg sync.WaitGroup
+	for i, chk := range checks {
+		components[i] = Component{Name: chk.name, Critical: chk.critical}
+		if chk.fn == nil {
+			components[i].Status = StatusNotConfigured
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, chk check) {
+			defer wg.Done()
+			components[i].Status, components[i].LatencyMs, components[i].Error = runCheck(ctx, chk)
+		}(i, chk)
+	}
+	wg.Wait()
+
+	return components
+}
+
+func runCheck(ctx context.Context, chk check) (status string, latencyMs int64, errMsg string) {
+	ctx, cancel := context.WithTimeout(ctx, chk.timeout)
+	defer cancel()
+
+	// 체크 함수가 컨텍스트를 무시하더라도 타임아웃에 결과를 돌려주도록 별도 고루틴에서 실행합니다.
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- chk.fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	latencyMs = time.Since(start).Milliseconds()
+
+	if err != nil {
+		return StatusDown, latencyMs, err.Error()
+	}
+	return StatusUp, latencyMs, ""
+}
+
+// summarize는 컴포넌트 결과로 전체 상태를 계산합니다.
+func summarize(components []Component) Report {
+	report := Report{
+		Status:     StatusReady,
+		Checks:     make(map[string]string, len(components)),
+		Components: components,
+		CheckedAt:  time.Now().UTC(),
+	}
+
+	for _, comp := range components {
+		switch comp.Status {
+		case StatusUp:
+			report.Checks[comp.Name] = "ok"
+		case StatusNotConfigured:
+			report.Checks[comp.Name] = StatusNotConfigured
+		default:
+			report.Checks[comp.Name] = "disconnected"
+			if comp.Critical {
+				report.Status = StatusNotReady
+			} else if report.Status == StatusReady {
+				report.Status = StatusDegraded
+			}
+		}
+	}
+	return report
+}
@@ -4,6 +4,7 @@ package health
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,43 +14,92 @@ import (
 
 // HealthChecker는 K8s 표준 헬스체크 엔드포인트를 제공하는 구조체입니다.
 type HealthChecker struct {
-	db    *gorm.DB       // 데이터베이스 연결 (nil 가능)
-	redis *redis.Client  // Redis 연결 (nil 가능, 선택적)
+	checks []check // readiness에서 확인하는 의존성 (등록 순서 유지)
 }
 
 // NewHealthChecker는 새 HealthChecker 인스턴스를 생성합니다.
+// DB와 Redis는 필수(critical) 의존성으로 등록되며, 다운스트림 서비스 등은 AddCheck로 추가합니다.
 // db: 데이터베이스 연결 (nil 가능 - DB가 없는 서비스용)
 // redis: Redis 연결 (nil 가능 - Redis가 없는 서비스용)
 func NewHealthChecker(db *gorm.DB, redis *redis.Client) *HealthChecker {
-	return &HealthChecker{
-		db:    db,
-		redis: redis,
+	h := &HealthChecker{}
+
+	var dbCheck CheckFunc
+	if db != nil {
+		dbCheck = func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}
+	}
+	h.addCheck("database", dbCheck)
+
+	var redisCheck CheckFunc
+	if redis != nil {
+		redisCheck = func(ctx context.Context) error {
+			return redis.Ping(ctx).Err()
+		}
 	}
+	h.addCheck("redis", redisCheck)
+
+	return h
+}
+
+// AddCheck는 readiness에서 확인할 의존성을 등록합니다.
+// 기본적으로 필수(critical) 의존성이며 실패하면 readiness가 503을 반환합니다.
+// 라우트 등록 전(서비스 시작 시)에 호출해야 합니다.
+//
+//	checker.AddCheck("user-service", health.HTTPCheck(nil, userURL+"/healthz"), health.NonCritical())
+func (h *HealthChecker) AddCheck(name string, fn CheckFunc, opts ...CheckOption) *HealthChecker {
+	return h.addCheck(name, fn, opts...)
+}
+
+// AddDownstream은 다운스트림 서비스를 필수가 아닌 의존성으로 등록합니다 (baseURL이 비어 있으면 무시).
+// 다운스트림의 readiness 대신 liveness 경로(path)를 확인해 서비스 간 readiness 실패가 연쇄되지 않게 합니다.
+func (h *HealthChecker) AddDownstream(name, baseURL, path string) *HealthChecker {
+	if baseURL == "" {
+		return h
+	}
+	return h.addCheck(name, HTTPCheck(nil, strings.TrimSuffix(baseURL, "/")+path), NonCritical())
+}
+
+func (h *HealthChecker) addCheck(name string, fn CheckFunc, opts ...CheckOption) *HealthChecker {
+	chk := check{name: name, fn: fn, critical: true, timeout: DefaultCheckTimeout}
+	for _, opt := range opts {
+		opt(&chk)
+	}
+	h.checks = append(h.checks, chk)
+	return h
 }
 
 // RegisterRoutes는 헬스체크 엔드포인트를 루트 레벨과 basePath 아래에 등록합니다.
 // Pod 직접 접근과 Ingress를 통한 접근 모두에서 헬스체크가 동작하도록 합니다.
 //
 // 등록되는 엔드포인트:
-//   - /health/live: liveness probe
-//   - /health/ready: readiness probe
-//   - /health: readiness probe (하위 호환성)
-//   - {basePath}/health/live, {basePath}/health/ready, {basePath}/health (basePath가 있는 경우)
+//   - /healthz: liveness probe
+//   - /readyz: readiness probe (컴포넌트별 상태 포함)
+//   - /health/live, /health/ready, /health: 하위 호환성
+//   - basePath가 있으면 같은 경로를 {basePath} 아래에도 등록
 func (h *HealthChecker) RegisterRoutes(router gin.IRouter, basePath string) {
 	// 루트 레벨 엔드포인트 (K8s probe의 기본 경로)
-	router.GET("/health/live", h.Liveness)
-	router.GET("/health/ready", h.Readiness)
-	router.GET("/health", h.Readiness) // 하위 호환성
+	h.register(router)
 
 	// basePath 아래 엔드포인트 (Ingress 라우팅용)
 	if basePath != "" {
-		group := router.Group(basePath)
-		group.GET("/health/live", h.Liveness)
-		group.GET("/health/ready", h.Readiness)
-		group.GET("/health", h.Readiness)
+		h.register(router.Group(basePath))
 	}
 }
 
+func (h *HealthChecker) register(router gin.IRouter) {
+	router.GET("/healthz", h.Liveness)
+	router.GET("/readyz", h.Readiness)
+	router.GET("/health/live", h.Liveness)
+	router.GET("/health/ready", h.Readiness)
+	router.GET("/health", h.Readiness) // 하위 호환성
+}
+
 // Liveness는 애플리케이션 프로세스가 실행 중인지 확인합니다.
 // K8s liveness probe용 - 의존성(DB, Redis)은 확인하지 않습니다.
 // 프로세스가 살아있으면 200을 반환합니다 (항상 200을 반환해야 함).
@@ -59,54 +109,24 @@ func (h *HealthChecker) Liveness(c *gin.Context) {
 	})
 }
 
-// Readiness는 서비스가 트래픽을 받을 준비가 되었는지 확인합니다.
-// K8s readiness probe용 - DB와 Redis 연결을 확인합니다.
-// 준비되면 200, 준비 안됨이면 503을 반환합니다.
-func (h *HealthChecker) Readiness(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+// Check는 등록된 모든 의존성을 동시에 확인하고 결과를 반환합니다.
+// 각 체크는 자신의 타임아웃을, 전체는 5초 타임아웃을 가집니다.
+func (h *HealthChecker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	checks := gin.H{}
-	isReady := true
-
-	// 데이터베이스 연결 확인
-	if h.db != nil {
-		sqlDB, err := h.db.DB()
-		if err != nil {
-			checks["database"] = "error"
-			isReady = false
-		} else if err := sqlDB.PingContext(ctx); err != nil {
-			checks["database"] = "disconnected"
-			isReady = false
-		} else {
-			checks["database"] = "ok"
-		}
-	} else {
-		checks["database"] = "not_configured"
-	}
+	return summarize(runChecks(ctx, h.checks))
+}
 
-	// Redis 연결 확인 (선택적)
-	if h.redis != nil {
-		if err := h.redis.Ping(ctx).Err(); err != nil {
-			checks["redis"] = "disconnected"
-			isReady = false
-		} else {
-			checks["redis"] = "ok"
-		}
-	} else {
-		checks["redis"] = "not_configured"
-	}
+// Readiness는 서비스가 트래픽을 받을 준비가 되었는지 확인합니다.
+// K8s readiness probe용 - 등록된 의존성을 확인합니다.
+// 준비되면 200 (필수가 아닌 의존성만 실패하면 status가 degraded), 필수 의존성이 실패하면 503을 반환합니다.
+func (h *HealthChecker) Readiness(c *gin.Context) {
+	report := h.Check(c.Request.Context())
 
-	// 결과 반환
-	if isReady {
-		c.JSON(200, gin.H{
-			"status": "ready",
-			"checks": checks,
-		})
+	if report.Ready() {
+		c.JSON(200, report)
 	} else {
-		c.JSON(503, gin.H{
-			"status": "not_ready",
-			"checks": checks,
-		})
+		c.JSON(503, report)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -87,6 +88,10 @@ func TestRegisterRoutes_WithBasePath(t *testing.T) {
 		{"basePath liveness", "/api/health/live"},
 		{"basePath readiness", "/api/health/ready"},
 		{"basePath health", "/api/health"},
+		{"루트 레벨 healthz", "/healthz"},
+		{"루트 레벨 readyz", "/readyz"},
+		{"basePath healthz", "/api/healthz"},
+		{"basePath readyz", "/api/readyz"},
 	}
 
 	for _, tt := range tests {
@@ -155,6 +160,123 @@ func TestHealthResponse(t *testing.T) {
 	}
 }
 
+// readyz는 /readyz를 호출하고 상태 코드와 응답을 반환합니다.
+func readyz(t *testing.T, checker *HealthChecker) (int, Report) {
+	t.Helper()
+	router := gin.New()
+	checker.RegisterRoutes(router, "")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+	router.ServeHTTP(w, req)
+
+	var report Report
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+	return w.Code, report
+}
+
+// TestReadyz_CriticalFailure는 필수 의존성이 실패하면 503을 반환하는지 테스트합니다.
+func TestReadyz_CriticalFailure(t *testing.T) {
+	checker := NewHealthChecker(nil, nil).
+		AddCheck("nats", PingCheck(&MockPinger{Err: errors.New("connection refused")}))
+
+	code, report := readyz(t, checker)
+
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("예상 상태 코드: %d, 실제: %d", http.StatusServiceUnavailable, code)
+	}
+	if report.Status != StatusNotReady {
+		t.Errorf("예상 status: %q, 실제: %q", StatusNotReady, report.Status)
+	}
+	if report.Checks["nats"] != "disconnected" {
+		t.Errorf("예상 checks.nats: 'disconnected', 실제: %q", report.Checks["nats"])
+	}
+
+	last := report.Components[len(report.Components)-1]
+	if last.Name != "nats" || last.Status != StatusDown || !last.Critical || last.Error == "" {
+		t.Errorf("nats 컴포넌트 상태가 올바르지 않습니다: %+v", last)
+	}
+}
+
+// TestReadyz_NonCriticalFailure는 필수가 아닌 의존성만 실패하면 200과 degraded를 반환하는지 테스트합니다.
+func TestReadyz_NonCriticalFailure(t *testing.T) {
+	checker := NewHealthChecker(nil, nil).
+		AddCheck("cache", PingCheck(&MockPinger{})).
+		AddCheck("user-service", PingCheck(&MockPinger{Err: errors.New("timeout")}), NonCritical())
+
+	code, report := readyz(t, checker)
+
+	if code != http.StatusOK {
+		t.Errorf("예상 상태 코드: %d, 실제: %d", http.StatusOK, code)
+	}
+	if report.Status != StatusDegraded {
+		t.Errorf("예상 status: %q, 실제: %q", StatusDegraded, report.Status)
+	}
+	if report.Checks["cache"] != "ok" {
+		t.Errorf("예상 checks.cache: 'ok', 실제: %q", report.Checks["cache"])
+	}
+	if len(report.Components) != 4 {
+		t.Fatalf("예상 컴포넌트 수: 4, 실제: %d", len(report.Components))
+	}
+	if report.Components[0].Status != StatusNotConfigured {
+		t.Errorf("DB 없이 생성하면 database는 not_configured여야 합니다: %+v", report.Components[0])
+	}
+}
+
+// TestReadyz_CheckTimeout은 응답하지 않는 체크가 타임아웃으로 실패하는지 테스트합니다.
+func TestReadyz_CheckTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	checker := NewHealthChecker(nil, nil).
+		AddCheck("slow", func(ctx context.Context) error {
+			<-block // 컨텍스트를 무시하는 체크
+			return nil
+		}, WithTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	code, report := readyz(t, checker)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("타임아웃이 적용되지 않았습니다: %v", elapsed)
+	}
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("예상 상태 코드: %d, 실제: %d", http.StatusServiceUnavailable, code)
+	}
+	if report.Checks["slow"] != "disconnected" {
+		t.Errorf("예상 checks.slow: 'disconnected', 실제: %q", report.Checks["slow"])
+	}
+}
+
+// TestHTTPCheck는 다운스트림 응답 코드에 따라 상태를 판단하는지 테스트합니다.
+func TestHTTPCheck(t *testing.T) {
+	tests := []struct {
+		name    string // 테스트 케이스 이름
+		status  int    // 다운스트림 응답 코드
+		wantErr bool   // 장애로 판단해야 하는지
+	}{
+		{"정상", http.StatusOK, false},
+		{"4xx는 도달 가능", http.StatusNotFound, false},
+		{"준비 안됨", http.StatusServiceUnavailable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := HTTPCheck(nil, server.URL+"/healthz")(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("예상 에러 여부: %v, 실제 에러: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 // TestMockPinger는 MockPinger가 올바르게 동작하는지 테스트합니다.
 func TestMockPinger(t *testing.T) {
 	t.Run("성공하는 pinger", func(t *testing.T) {
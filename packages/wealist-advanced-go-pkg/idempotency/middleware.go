@@ -0,0 +1,229 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderKey는 클라이언트가 요청마다 생성해 보내는 키 헤더입니다 (재시도에는 같은 값).
+	HeaderKey = "Idempotency-Key"
+
+	// HeaderReplayed는 저장된 응답을 돌려줄 때 붙이는 헤더입니다.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	// MaxKeyLength는 허용하는 키의 최대 길이입니다.
+	MaxKeyLength = 255
+)
+
+// Config는 Idempotency-Key 미들웨어 설정입니다.
+type Config struct {
+	// TTL은 처리한 요청의 응답을 보관하는 시간입니다 (기본 24시간).
+	TTL time.Duration
+
+	// LockTTL은 처리 중 기록의 최대 유지 시간입니다 (기본 1분).
+	// 핸들러가 응답 없이 죽어도 이 시간이 지나면 같은 키로 다시 시도할 수 있습니다.
+	LockTTL time.Duration
+
+	// KeyPrefix는 Redis 키 접두사입니다 (기본 "wealist:idempotency:").
+	KeyPrefix string
+
+	// Required가 true이면 헤더가 없는 변경 요청을 400으로 거부합니다.
+	Required bool
+
+	// FailOpen이 true이면 저장소 오류 시 중복 확인 없이 요청을 처리합니다 (기본 true).
+	FailOpen bool
+}
+
+// DefaultConfig는 기본 설정을 반환합니다.
+func DefaultConfig() Config {
+	return Config{
+		TTL:       24 * time.Hour,
+		LockTTL:   time.Minute,
+		KeyPrefix: "wealist:idempotency:",
+		FailOpen:  true,
+	}
+}
+
+// Middleware는 POST/PUT/PATCH/DELETE 요청에 Idempotency-Key를 적용하는 Gin 미들웨어입니다.
+// 인증 미들웨어 뒤에 사용하면 키가 사용자별로 분리됩니다.
+//
+//   - 처음 보는 키: 핸들러를 실행하고 응답을 TTL 동안 저장 (5xx, 401, 408, 429는 저장하지 않음)
+//   - 같은 키, 같은 요청: 저장된 응답을 Idempotent-Replayed 헤더와 함께 반환
+//   - 같은 키, 다른 요청(메서드, 경로, 본문): 422 IDEMPOTENCY_KEY_MISMATCH
+//   - 같은 키의 첫 요청이 처리 중: 409 IDEMPOTENCY_REQUEST_IN_PROGRESS
+func Middleware(store Store, config Config, logger *zap.Logger) gin.HandlerFunc {
+	defaults := DefaultConfig()
+	if config.TTL <= 0 {
+		config.TTL = defaults.TTL
+	}
+	if config.LockTTL <= 0 {
+		config.LockTTL = defaults.LockTTL
+	}
+	if config.KeyPrefix == "" {
+		config.KeyPrefix = defaults.KeyPrefix
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return func(c *gin.Context) {
+		if !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		key := c.GetHeader(HeaderKey)
+		if key == "" {
+			if config.Required {
+				abortWithError(c, http.StatusBadRequest, "IDEMPOTENCY_KEY_REQUIRED", "Idempotency-Key header is required")
+				return
+			}
+			c.Next()
+			return
+		}
+		if len(key) > MaxKeyLength {
+			abortWithError(c, http.StatusBadRequest, "INVALID_IDEMPOTENCY_KEY", "Idempotency-Key is too long")
+			return
+		}
+
+		fingerprint, err := requestFingerprint(c)
+		if err != nil {
+			abortWithError(c, http.StatusBadRequest, "INVALID_REQUEST", "Failed to read request body")
+			return
+		}
+
+		storeKey := config.KeyPrefix + scope(c) + ":" + key
+		// 클라이언트가 연결을 끊어도 기록은 마무리되도록 취소를 전파하지 않음
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		existing, acquired, err := store.Begin(ctx, storeKey, fingerprint, config.LockTTL)
+		if err != nil {
+			logger.Warn("Idempotency store error", zap.String("path", c.Request.URL.Path), zap.Error(err))
+			if config.FailOpen {
+				c.Next()
+				return
+			}
+			abortWithError(c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Idempotency store unavailable")
+			return
+		}
+
+		if !acquired {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				abortWithError(c, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_MISMATCH",
+					"Idempotency-Key was already used for a different request")
+			case existing.InProgress():
+				c.Header("Retry-After", "1")
+				abortWithError(c, http.StatusConflict, "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+					"A request with this Idempotency-Key is still being processed")
+			default:
+				c.Header(HeaderReplayed, "true")
+				c.Data(existing.Status, existing.ContentType, existing.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		status := recorder.Status()
+		if retryable(status) {
+			// 서버 오류, 재인증(step-up) 요구 등은 저장하지 않고 같은 키로 재시도할 수 있게 함
+			if err := store.Release(ctx, storeKey); err != nil {
+				logger.Warn("Failed to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+
+		record := &Record{
+			Fingerprint: fingerprint,
+			Status:      status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if err := store.Complete(ctx, storeKey, record, config.TTL); err != nil {
+			logger.Warn("Failed to store idempotent response", zap.Error(err))
+		}
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable은 같은 키로 다시 시도하면 결과가 달라질 수 있는 응답입니다.
+func retryable(status int) bool {
+	switch status {
+	case http.StatusUnauthorized, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+	return status >= http.StatusInternalServerError
+}
+
+// scope는 키를 나누는 주체입니다 - 사용자, 서비스 클라이언트, 그 외에는 IP
+func scope(c *gin.Context) string {
+	if userID, ok := auth.GetUserID(c); ok {
+		return "user:" + userID.String()
+	}
+	if claims, ok := auth.GetServiceClient(c); ok {
+		return "client:" + claims.ClientID
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// requestFingerprint는 메서드, 경로, 본문의 해시입니다. 읽은 본문은 핸들러를 위해 되돌려 놓습니다.
+func requestFingerprint(c *gin.Context) (string, error) {
+	var body []byte
+	if c.Request.Body != nil {
+		var err error
+		body, err = io.ReadAll(c.Request.Body)
+		if err != nil {
+			return "", err
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.Request.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// abortWithError는 공통 에러 envelope(requestId 포함)로 응답하고 체인을 중단합니다.
+func abortWithError(c *gin.Context, status int, code, message string) {
+	response.Error(c, status, code, message)
+	c.Abort()
+}
+
+// responseRecorder는 응답 본문을 함께 기록하는 ResponseWriter입니다.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,146 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// memoryStore는 테스트용 Store입니다 (TTL은 무시).
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[string]*Record{}}
+}
+
+func (s *memoryStore) Begin(_ context.Context, key, fingerprint string, _ time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[key]; ok {
+		return existing, false, nil
+	}
+	s.records[key] = &Record{Fingerprint: fingerprint}
+	return nil, true, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key string, record *Record, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[key] = record
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func setupRouter(store Store, config Config, status *int) (*gin.Engine, *int) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+	r := gin.New()
+	r.Use(Middleware(store, config, nil))
+	r.POST("/boards", func(c *gin.Context) {
+		calls++
+		c.JSON(*status, gin.H{"call": calls})
+	})
+	return r, &calls
+}
+
+func post(r *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/boards", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(HeaderKey, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddleware_ReplaysResponse(t *testing.T) {
+	status := http.StatusCreated
+	r, calls := setupRouter(newMemoryStore(), DefaultConfig(), &status)
+
+	first := post(r, "key-1", `{"title":"a"}`)
+	second := post(r, "key-1", `{"title":"a"}`)
+
+	if *calls != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", *calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("expected replayed response %d %s, got %d %s", first.Code, first.Body, second.Code, second.Body)
+	}
+	if second.Header().Get(HeaderReplayed) != "true" {
+		t.Errorf("expected %s header on replay", HeaderReplayed)
+	}
+}
+
+func TestMiddleware_KeyReusedForDifferentRequest(t *testing.T) {
+	status := http.StatusCreated
+	r, _ := setupRouter(newMemoryStore(), DefaultConfig(), &status)
+
+	post(r, "key-1", `{"title":"a"}`)
+	w := post(r, "key-1", `{"title":"b"}`)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422, got %d", w.Code)
+	}
+}
+
+func TestMiddleware_InProgress(t *testing.T) {
+	store := newMemoryStore()
+	status := http.StatusCreated
+	r, calls := setupRouter(store, DefaultConfig(), &status)
+
+	fingerprint, _ := requestFingerprint(&gin.Context{Request: httptest.NewRequest(http.MethodPost, "/boards", strings.NewReader("{}"))})
+	store.records["wealist:idempotency:ip:192.0.2.1:key-1"] = &Record{Fingerprint: fingerprint}
+
+	w := post(r, "key-1", "{}")
+	if w.Code != http.StatusConflict || *calls != 0 {
+		t.Errorf("expected 409 without running handler, got %d (calls=%d)", w.Code, *calls)
+	}
+}
+
+func TestMiddleware_RetryableNotStored(t *testing.T) {
+	for _, failed := range []int{http.StatusInternalServerError, http.StatusUnauthorized} {
+		status := failed
+		r, calls := setupRouter(newMemoryStore(), DefaultConfig(), &status)
+
+		post(r, "key-1", "{}")
+		status = http.StatusCreated
+		w := post(r, "key-1", "{}")
+
+		if w.Code != http.StatusCreated || *calls != 2 {
+			t.Errorf("expected retry after %d to run handler again, got %d (calls=%d)", failed, w.Code, *calls)
+		}
+	}
+}
+
+func TestMiddleware_WithoutKey(t *testing.T) {
+	status := http.StatusCreated
+	r, calls := setupRouter(newMemoryStore(), DefaultConfig(), &status)
+
+	post(r, "", "{}")
+	post(r, "", "{}")
+	if *calls != 2 {
+		t.Errorf("expected requests without key to pass through, calls=%d", *calls)
+	}
+
+	config := DefaultConfig()
+	config.Required = true
+	r, _ = setupRouter(newMemoryStore(), config, &status)
+	if w := post(r, "", "{}"); w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 when key is required, got %d", w.Code)
+	}
+}
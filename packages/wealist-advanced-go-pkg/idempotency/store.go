@@ -0,0 +1,95 @@
+// Package idempotency는 Idempotency-Key 헤더 기반 중복 요청 방지 미들웨어를 제공합니다.
+// 같은 키로 재시도한 요청은 핸들러를 다시 실행하지 않고 처음 응답을 그대로 돌려줍니다.
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Record는 키에 저장되는 요청 지문과 응답입니다.
+// Status가 0이면 아직 처리 중인 요청입니다.
+type Record struct {
+	Fingerprint string `json:"fingerprint"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// InProgress는 첫 요청이 아직 처리 중인지 반환합니다.
+func (r *Record) InProgress() bool {
+	return r.Status == 0
+}
+
+// Store는 Idempotency-Key 기록 저장소입니다.
+type Store interface {
+	// Begin은 키를 선점합니다. 이미 기록이 있으면 기존 기록과 false를 반환합니다.
+	Begin(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (*Record, bool, error)
+
+	// Complete는 처리한 요청의 응답을 ttl 동안 저장합니다.
+	Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error
+
+	// Release는 선점을 해제해 같은 키로 다시 시도할 수 있게 합니다 (5xx 응답 등).
+	Release(ctx context.Context, key string) error
+}
+
+// RedisStore는 Redis 기반 Store입니다. 여러 replica가 같은 기록을 공유합니다.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore는 새 RedisStore를 생성합니다.
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+// Begin은 SETNX로 처리 중 기록을 만들어 키를 선점합니다.
+func (s *RedisStore) Begin(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (*Record, bool, error) {
+	pending, err := json.Marshal(&Record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 기존 기록이 GET 직전에 만료되면 한 번 더 선점을 시도
+	for attempt := 0; attempt < 2; attempt++ {
+		acquired, err := s.client.SetNX(ctx, key, pending, lockTTL).Result()
+		if err != nil {
+			return nil, false, err
+		}
+		if acquired {
+			return nil, true, nil
+		}
+
+		data, err := s.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		var existing Record
+		if err := json.Unmarshal(data, &existing); err != nil {
+			return nil, false, err
+		}
+		return &existing, false, nil
+	}
+	return nil, false, errors.New("idempotency key could not be acquired")
+}
+
+// Complete는 응답을 저장합니다.
+func (s *RedisStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, key, data, ttl).Err()
+}
+
+// Release는 키를 삭제합니다.
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key).Err()
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package idempotency
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// TestRedisStore_BeginIsExclusive는 동시에 같은 키로 들어온 요청 중 하나만 선점하는지 확인합니다.
+func TestRedisStore_BeginIsExclusive(t *testing.T) {
+	store := NewRedisStore(integration.Redis(t))
+	ctx := context.Background()
+
+	const workers = 20
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		acquired int
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok, err := store.Begin(ctx, "idem:race", "fp", time.Minute)
+			if err != nil {
+				t.Errorf("Begin() error = %v", err)
+				return
+			}
+			if ok {
+				mu.Lock()
+				acquired++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if acquired != 1 {
+		t.Fatalf("acquired = %d, want exactly 1", acquired)
+	}
+}
+
+func TestRedisStore_CompleteAndRelease(t *testing.T) {
+	store := NewRedisStore(integration.Redis(t))
+	ctx := context.Background()
+
+	if _, ok, err := store.Begin(ctx, "idem:key", "fp", time.Minute); err != nil || !ok {
+		t.Fatalf("Begin() = %v, %v, want acquired", ok, err)
+	}
+
+	existing, ok, err := store.Begin(ctx, "idem:key", "fp", time.Minute)
+	if err != nil || ok {
+		t.Fatalf("second Begin() = %v, %v, want existing record", ok, err)
+	}
+	if !existing.InProgress() {
+		t.Fatal("record should be in progress before Complete")
+	}
+
+	record := &Record{Fingerprint: "fp", Status: 201, ContentType: "application/json", Body: []byte(`{"id":1}`)}
+	if err := store.Complete(ctx, "idem:key", record, time.Minute); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	existing, _, err = store.Begin(ctx, "idem:key", "fp", time.Minute)
+	if err != nil {
+		t.Fatalf("Begin() after Complete error = %v", err)
+	}
+	if existing.Status != 201 || string(existing.Body) != `{"id":1}` {
+		t.Fatalf("stored record = %+v, want completed response", existing)
+	}
+
+	if err := store.Release(ctx, "idem:key"); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, ok, err := store.Begin(ctx, "idem:key", "fp", time.Minute); err != nil || !ok {
+		t.Fatalf("Begin() after Release = %v, %v, want acquired", ok, err)
+	}
+}
+
+// TestRedisStore_LockExpires는 처리 중 기록이 lockTTL 이후 만료되는지 확인합니다.
+func TestRedisStore_LockExpires(t *testing.T) {
+	store := NewRedisStore(integration.Redis(t))
+	ctx := context.Background()
+
+	if _, ok, err := store.Begin(ctx, "idem:ttl", "fp", time.Second); err != nil || !ok {
+		t.Fatalf("Begin() = %v, %v, want acquired", ok, err)
+	}
+	time.Sleep(1500 * time.Millisecond)
+
+	if _, ok, err := store.Begin(ctx, "idem:ttl", "fp", time.Second); err != nil || !ok {
+		t.Fatalf("Begin() after lock TTL = %v, %v, want acquired", ok, err)
+	}
+}
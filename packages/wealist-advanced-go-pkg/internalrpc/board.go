@@ -0,0 +1,83 @@
+package internalrpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// BoardService 메서드 이름 (proto: wealist.internal.v1.BoardService)
+const (
+	BoardServiceName                 = "wealist.internal.v1.BoardService"
+	BoardServiceBatchGetBoardsMethod = "/" + BoardServiceName + "/BatchGetBoards"
+)
+
+// MaxBatchGetBoards는 BatchGetBoards 한 번에 조회할 수 있는 최대 보드 수입니다.
+const MaxBatchGetBoards = 100
+
+// BatchGetBoardsRequest는 보드 일괄 조회 요청입니다.
+type BatchGetBoardsRequest struct {
+	BoardIDs []string `json:"boardIds"`
+}
+
+// BatchGetBoardsResponse는 보드 일괄 조회 결과입니다.
+type BatchGetBoardsResponse struct {
+	Boards     []*Board `json:"boards"`
+	MissingIDs []string `json:"missingIds,omitempty"`
+}
+
+// Board는 보드 요약 정보입니다 (본문, 댓글, 첨부 제외).
+type Board struct {
+	ID         string     `json:"id"`
+	ProjectID  string     `json:"projectId"`
+	AuthorID   string     `json:"authorId"`
+	AssigneeID string     `json:"assigneeId,omitempty"`
+	Title      string     `json:"title"`
+	StartDate  *time.Time `json:"startDate,omitempty"`
+	DueDate    *time.Time `json:"dueDate,omitempty"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+// BoardServiceServer는 board-service가 구현하는 내부 API입니다.
+type BoardServiceServer interface {
+	BatchGetBoards(ctx context.Context, req *BatchGetBoardsRequest) (*BatchGetBoardsResponse, error)
+}
+
+// RegisterBoardServiceServer는 gRPC 서버에 BoardService를 등록합니다.
+func RegisterBoardServiceServer(s grpc.ServiceRegistrar, srv BoardServiceServer) {
+	s.RegisterService(&boardServiceDesc, srv)
+}
+
+var boardServiceDesc = grpc.ServiceDesc{
+	ServiceName: BoardServiceName,
+	HandlerType: (*BoardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchGetBoards",
+			Handler: unaryHandler(BoardServiceBatchGetBoardsMethod, func(srv any, ctx context.Context, req *BatchGetBoardsRequest) (any, error) {
+				return srv.(BoardServiceServer).BatchGetBoards(ctx, req)
+			}),
+		},
+	},
+	Metadata: "wealist/internal/v1/board.proto",
+}
+
+// BoardServiceClient는 BoardService 클라이언트입니다.
+type BoardServiceClient interface {
+	BatchGetBoards(ctx context.Context, in *BatchGetBoardsRequest, opts ...grpc.CallOption) (*BatchGetBoardsResponse, error)
+}
+
+type boardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewBoardServiceClient는 연결(Dial 참고)로 BoardService 클라이언트를 만듭니다.
+func NewBoardServiceClient(cc grpc.ClientConnInterface) BoardServiceClient {
+	return &boardServiceClient{cc: cc}
+}
+
+func (c *boardServiceClient) BatchGetBoards(ctx context.Context, in *BatchGetBoardsRequest, opts ...grpc.CallOption) (*BatchGetBoardsResponse, error) {
+	return invoke[BatchGetBoardsResponse](ctx, c.cc, BoardServiceBatchGetBoardsMethod, in, opts...)
+}
@@ -0,0 +1,35 @@
+// Package internalrpc는 서비스 간 내부 gRPC API를 제공합니다.
+// 호출이 잦은 내부 REST 호출(멤버십 검증, 프로필 조회, 알림 전송, 보드 일괄 조회)을 대체합니다.
+//
+// 계약은 proto/wealist/internal/v1/*.proto에 정의되어 있고, 이 패키지의 메시지와 서비스 타입은
+// 그 정의를 옮긴 것입니다. protoc 없이 모든 서비스를 빌드할 수 있도록 메시지는 protojson과 같은
+// 필드 이름(lowerCamelCase)의 JSON 코덱(content-subtype "json")으로 인코딩합니다.
+// proto를 바꾸면 이 패키지의 타입도 같이 바꿔야 합니다.
+package internalrpc
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// CodecName은 내부 gRPC 메시지 코덱 이름입니다 (content-type: application/grpc+json).
+const CodecName = "json"
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
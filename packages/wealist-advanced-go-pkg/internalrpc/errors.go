@@ -0,0 +1,48 @@
+package internalrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+)
+
+// appErrorCodes는 AppError 코드와 gRPC 상태 코드의 대응입니다 (REST의 HTTP 상태 코드와 같은 의미).
+var appErrorCodes = map[string]codes.Code{
+	apperrors.ErrCodeNotFound:           codes.NotFound,
+	apperrors.ErrCodeAlreadyExists:      codes.AlreadyExists,
+	apperrors.ErrCodeValidation:         codes.InvalidArgument,
+	apperrors.ErrCodeBadRequest:         codes.InvalidArgument,
+	apperrors.ErrCodeUnauthorized:       codes.Unauthenticated,
+	apperrors.ErrCodeForbidden:          codes.PermissionDenied,
+	apperrors.ErrCodeConflict:           codes.FailedPrecondition,
+	apperrors.ErrCodeTimeout:            codes.DeadlineExceeded,
+	apperrors.ErrCodeServiceUnavailable: codes.Unavailable,
+}
+
+// Error는 서비스 계층 오류를 gRPC 상태 오류로 변환합니다.
+// AppError는 코드에 맞는 상태로, 그 외 오류는 내부 정보를 숨기고 Internal로 반환합니다.
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		if code, ok := appErrorCodes[appErr.Code]; ok {
+			return status.Error(code, appErr.Message)
+		}
+	}
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,120 @@
+package internalrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+)
+
+type fakeUserServer struct {
+	lastClient *auth.ServiceClaims
+}
+
+func (s *fakeUserServer) ValidateMember(ctx context.Context, req *ValidateMemberRequest) (*ValidateMemberResponse, error) {
+	s.lastClient, _ = ServiceClientFromContext(ctx)
+	return &ValidateMemberResponse{IsMember: req.UserID == "member"}, nil
+}
+
+func (s *fakeUserServer) GetProfile(ctx context.Context, req *GetProfileRequest) (*Profile, error) {
+	return nil, status.Error(codes.NotFound, "profile not found")
+}
+
+type fakeValidator struct{}
+
+func (fakeValidator) ValidateServiceToken(ctx context.Context, token string) (*auth.ServiceClaims, error) {
+	switch token {
+	case "reader":
+		return &auth.ServiceClaims{ClientID: "board-service", Scopes: []string{"users:read"}}, nil
+	case "other":
+		return &auth.ServiceClaims{ClientID: "chat-service", Scopes: []string{"chat:write"}}, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+type staticTokens string
+
+func (t staticTokens) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// startUserServer는 메모리 연결(bufconn)로 UserService 서버를 띄우고 연결 생성 함수를 반환합니다.
+func startUserServer(t *testing.T, config ServerConfig, srv UserServiceServer) func(opts ...DialOption) UserServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(config)
+	RegisterUserServiceServer(server, srv)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return func(opts ...DialOption) UserServiceClient {
+		opts = append(opts, WithGRPCOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})))
+		conn, err := Dial("passthrough:///bufnet", opts...)
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		t.Cleanup(func() { _ = conn.Close() })
+		return NewUserServiceClient(conn)
+	}
+}
+
+func TestUserService_RoundTrip(t *testing.T) {
+	connect := startUserServer(t, ServerConfig{}, &fakeUserServer{})
+	client := connect()
+
+	resp, err := client.ValidateMember(context.Background(), &ValidateMemberRequest{WorkspaceID: "ws", UserID: "member"})
+	if err != nil {
+		t.Fatalf("ValidateMember: %v", err)
+	}
+	if !resp.IsMember {
+		t.Error("expected member")
+	}
+
+	_, err = client.GetProfile(context.Background(), &GetProfileRequest{WorkspaceID: "ws", UserID: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestServer_ServiceTokenAuth(t *testing.T) {
+	srv := &fakeUserServer{}
+	connect := startUserServer(t, ServerConfig{
+		ServiceValidator: fakeValidator{},
+		Scopes:           map[string][]string{UserServiceValidateMemberMethod: {"users:read"}},
+	}, srv)
+	req := &ValidateMemberRequest{WorkspaceID: "ws", UserID: "member"}
+
+	if _, err := connect().ValidateMember(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("without token: expected Unauthenticated, got %v", err)
+	}
+	if _, err := connect(WithServiceTokens(staticTokens("other"))).ValidateMember(context.Background(), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("missing scope: expected PermissionDenied, got %v", err)
+	}
+	if _, err := connect(WithServiceTokens(staticTokens("reader"))).ValidateMember(context.Background(), req); err != nil {
+		t.Fatalf("with scope: %v", err)
+	}
+	if srv.lastClient == nil || srv.lastClient.ClientID != "board-service" {
+		t.Errorf("expected service client in context, got %+v", srv.lastClient)
+	}
+}
+
+func TestServer_APIKeyAuth(t *testing.T) {
+	connect := startUserServer(t, ServerConfig{APIKey: "secret"}, &fakeUserServer{})
+	req := &ValidateMemberRequest{WorkspaceID: "ws", UserID: "member"}
+
+	if _, err := connect(WithAPIKey("wrong")).ValidateMember(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("wrong key: expected Unauthenticated, got %v", err)
+	}
+	if _, err := connect(WithAPIKey("secret")).ValidateMember(context.Background(), req); err != nil {
+		t.Errorf("valid key: %v", err)
+	}
+}
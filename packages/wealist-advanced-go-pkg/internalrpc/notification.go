@@ -0,0 +1,76 @@
+package internalrpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// NotificationService 메서드 이름 (proto: wealist.internal.v1.NotificationService)
+const (
+	NotificationServiceName                     = "wealist.internal.v1.NotificationService"
+	NotificationServiceSubmitNotificationMethod = "/" + NotificationServiceName + "/SubmitNotification"
+)
+
+// SubmitNotificationRequest는 알림 이벤트입니다 (REST의 NotificationEvent와 같은 필드).
+type SubmitNotificationRequest struct {
+	Type         string            `json:"type"`
+	ActorID      string            `json:"actorId"`
+	TargetUserID string            `json:"targetUserId"`
+	WorkspaceID  string            `json:"workspaceId,omitempty"`
+	ResourceType string            `json:"resourceType"`
+	ResourceID   string            `json:"resourceId"`
+	ResourceName string            `json:"resourceName,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	OccurredAt   *time.Time        `json:"occurredAt,omitempty"`
+	Critical     bool              `json:"critical,omitempty"`
+}
+
+// SubmitNotificationResponse는 알림 전송 결과입니다.
+type SubmitNotificationResponse struct {
+	NotificationID string `json:"notificationId,omitempty"`
+	Suppressed     bool   `json:"suppressed,omitempty"`
+}
+
+// NotificationServiceServer는 noti-service가 구현하는 내부 API입니다.
+type NotificationServiceServer interface {
+	SubmitNotification(ctx context.Context, req *SubmitNotificationRequest) (*SubmitNotificationResponse, error)
+}
+
+// RegisterNotificationServiceServer는 gRPC 서버에 NotificationService를 등록합니다.
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	s.RegisterService(&notificationServiceDesc, srv)
+}
+
+var notificationServiceDesc = grpc.ServiceDesc{
+	ServiceName: NotificationServiceName,
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitNotification",
+			Handler: unaryHandler(NotificationServiceSubmitNotificationMethod, func(srv any, ctx context.Context, req *SubmitNotificationRequest) (any, error) {
+				return srv.(NotificationServiceServer).SubmitNotification(ctx, req)
+			}),
+		},
+	},
+	Metadata: "wealist/internal/v1/notification.proto",
+}
+
+// NotificationServiceClient는 NotificationService 클라이언트입니다.
+type NotificationServiceClient interface {
+	SubmitNotification(ctx context.Context, in *SubmitNotificationRequest, opts ...grpc.CallOption) (*SubmitNotificationResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewNotificationServiceClient는 연결(Dial 참고)로 NotificationService 클라이언트를 만듭니다.
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc: cc}
+}
+
+func (c *notificationServiceClient) SubmitNotification(ctx context.Context, in *SubmitNotificationRequest, opts ...grpc.CallOption) (*SubmitNotificationResponse, error) {
+	return invoke[SubmitNotificationResponse](ctx, c.cc, NotificationServiceSubmitNotificationMethod, in, opts...)
+}
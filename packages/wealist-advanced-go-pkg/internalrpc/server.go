@@ -0,0 +1,151 @@
+package internalrpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
+)
+
+// APIKeyMetadata는 내부 API 키를 담는 메타데이터 키입니다 (REST의 x-internal-api-key 헤더와 같음).
+const APIKeyMetadata = "x-internal-api-key"
+
+// ServerConfig는 내부 gRPC 서버 설정입니다.
+type ServerConfig struct {
+	Logger *zap.Logger
+
+	// ServiceValidator가 있으면 모든 호출에 서비스 토큰(authorization: Bearer ...)을 요구합니다.
+	ServiceValidator auth.ServiceTokenValidator
+
+	// Scopes는 메서드(예: UserServiceValidateMemberMethod)별로 서비스 토큰에 필요한 scope입니다.
+	Scopes map[string][]string
+
+	// APIKey가 있으면 모든 호출에 x-internal-api-key 메타데이터를 요구합니다.
+	APIKey string
+}
+
+type serviceClientKey struct{}
+
+// ServiceClientFromContext는 서비스 토큰 인증을 통과한 호출의 클라이언트 정보를 반환합니다.
+func ServiceClientFromContext(ctx context.Context) (*auth.ServiceClaims, bool) {
+	claims, ok := ctx.Value(serviceClientKey{}).(*auth.ServiceClaims)
+	return claims, ok
+}
+
+// NewServer는 복구, 트레이싱, 로깅, 인증 인터셉터가 적용된 gRPC 서버를 만듭니다.
+// REST 서버와 별도 포트에서 Serve하고, 종료 시 GracefulStop을 호출하세요.
+func NewServer(config ServerConfig, opts ...grpc.ServerOption) *grpc.Server {
+	logger := config.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		recoveryInterceptor(logger),
+		serverTracingInterceptor,
+		loggingInterceptor(logger),
+	}
+	if config.APIKey != "" {
+		interceptors = append(interceptors, apiKeyInterceptor(config.APIKey))
+	}
+	if config.ServiceValidator != nil {
+		interceptors = append(interceptors, serviceAuthInterceptor(config.ServiceValidator, config.Scopes, logger))
+	}
+
+	opts = append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}, opts...)
+	return grpc.NewServer(opts...)
+}
+
+func recoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("Panic recovered in gRPC handler",
+					zap.String("rpc.method", info.FullMethod),
+					zap.Any("panic", r))
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func loggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		fields := []zap.Field{
+			zap.String("rpc.method", info.FullMethod),
+			zap.String("rpc.grpc.status_code", code.String()),
+			zap.Duration("duration", time.Since(start)),
+		}
+		if id := requestid.FromContext(ctx); id != "" {
+			fields = append(fields, zap.String(commonlogger.FieldHTTPRequestID, id))
+		}
+		if isServerError(code) {
+			logger.Error("gRPC request failed", append(fields, zap.Error(err))...)
+		} else {
+			logger.Debug("gRPC request completed", fields...)
+		}
+		return resp, err
+	}
+}
+
+func apiKeyInterceptor(apiKey string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		provided := firstMetadata(ctx, APIKeyMetadata)
+		if provided == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid internal API key")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func serviceAuthInterceptor(validator auth.ServiceTokenValidator, scopes map[string][]string, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		token, ok := strings.CutPrefix(firstMetadata(ctx, "authorization"), "Bearer ")
+		if !ok || token == "" {
+			return nil, status.Error(codes.Unauthenticated, "service token required")
+		}
+
+		claims, err := validator.ValidateServiceToken(ctx, token)
+		if err != nil {
+			logger.Debug("Service token validation failed", zap.Error(err))
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired service token")
+		}
+		if required := scopes[info.FullMethod]; !claims.HasScopes(required...) {
+			logger.Warn("Service token missing required scope",
+				zap.String("client_id", claims.ClientID),
+				zap.String("rpc.method", info.FullMethod),
+				zap.Strings("required_scopes", required))
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("scope %s required", strings.Join(required, " ")))
+		}
+
+		return handler(context.WithValue(ctx, serviceClientKey{}, claims), req)
+	}
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
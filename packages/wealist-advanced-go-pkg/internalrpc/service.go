@@ -0,0 +1,34 @@
+package internalrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// unaryHandler는 요청 타입 Req를 디코딩해 call을 호출하는 grpc.MethodDesc 핸들러를 만듭니다.
+// protoc-gen-go-grpc가 메서드마다 생성하는 핸들러와 같은 역할입니다.
+func unaryHandler[Req any](fullMethod string, call func(srv any, ctx context.Context, req *Req) (any, error)) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		req := new(Req)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv, ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return call(srv, ctx, req.(*Req))
+		})
+	}
+}
+
+// invoke는 단일 요청 RPC를 호출합니다.
+func invoke[Resp any](ctx context.Context, cc grpc.ClientConnInterface, fullMethod string, in any, opts ...grpc.CallOption) (*Resp, error) {
+	out := new(Resp)
+	if err := cc.Invoke(ctx, fullMethod, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
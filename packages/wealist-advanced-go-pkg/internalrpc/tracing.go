@@ -0,0 +1,127 @@
+package internalrpc
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
+)
+
+const tracerName = "github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+
+// metadataCarrier는 gRPC 메타데이터를 OpenTelemetry propagator에 연결합니다 (traceparent, baggage).
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// clientTracingInterceptor는 호출마다 client span을 만들고 trace context와 요청 ID를 메타데이터로 전파합니다.
+func clientTracingInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tracer := otel.GetTracerProvider().Tracer(tracerName)
+	ctx, span := tracer.Start(ctx, spanName(method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(rpcAttributes(method, cc.Target())...),
+	)
+	defer span.End()
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	if id := requestid.FromContext(ctx); id != "" && len(md.Get(requestid.MetadataKey)) == 0 {
+		md.Set(requestid.MetadataKey, id)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	recordStatus(span, err, false)
+	return err
+}
+
+// serverTracingInterceptor는 호출한 서비스의 trace를 이어 server span을 만들고, 요청 ID를 context에 넣습니다.
+func serverTracingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		if values := md.Get(requestid.MetadataKey); len(values) > 0 {
+			ctx = requestid.NewContext(ctx, requestid.Sanitize(values[0]))
+		}
+	}
+
+	tracer := otel.GetTracerProvider().Tracer(tracerName)
+	ctx, span := tracer.Start(ctx, spanName(info.FullMethod),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcAttributes(info.FullMethod, "")...),
+	)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	recordStatus(span, err, true)
+	return resp, err
+}
+
+// spanName은 "/pkg.Service/Method"를 "pkg.Service/Method"로 바꿉니다.
+func spanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+func rpcAttributes(fullMethod, target string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{semconv.RPCSystemGRPC}
+	if service, method, ok := strings.Cut(spanName(fullMethod), "/"); ok {
+		attrs = append(attrs, semconv.RPCService(service), semconv.RPCMethod(method))
+	}
+	if target != "" {
+		attrs = append(attrs, semconv.ServerAddress(target))
+	}
+	return attrs
+}
+
+// recordStatus는 gRPC 상태 코드를 span에 기록합니다.
+// 서버 span은 서버 오류만, client span은 모든 오류를 Error로 표시합니다.
+func recordStatus(span trace.Span, err error, server bool) {
+	code := status.Code(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(code)))
+	if err == nil {
+		return
+	}
+	if !server || isServerError(code) {
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+}
+
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
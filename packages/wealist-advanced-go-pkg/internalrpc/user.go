@@ -0,0 +1,94 @@
+package internalrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UserService 메서드 이름 (proto: wealist.internal.v1.UserService)
+const (
+	UserServiceName                 = "wealist.internal.v1.UserService"
+	UserServiceValidateMemberMethod = "/" + UserServiceName + "/ValidateMember"
+	UserServiceGetProfileMethod     = "/" + UserServiceName + "/GetProfile"
+)
+
+// ValidateMemberRequest는 워크스페이스 멤버 확인 요청입니다.
+type ValidateMemberRequest struct {
+	WorkspaceID string `json:"workspaceId"`
+	UserID      string `json:"userId"`
+}
+
+// ValidateMemberResponse는 워크스페이스 멤버 확인 결과입니다.
+type ValidateMemberResponse struct {
+	IsMember bool `json:"isMember"`
+}
+
+// GetProfileRequest는 워크스페이스별 프로필 조회 요청입니다.
+type GetProfileRequest struct {
+	WorkspaceID string `json:"workspaceId"`
+	UserID      string `json:"userId"`
+}
+
+// Profile은 워크스페이스별 사용자 프로필입니다.
+type Profile struct {
+	UserID          string `json:"userId"`
+	WorkspaceID     string `json:"workspaceId"`
+	NickName        string `json:"nickName"`
+	Email           string `json:"email"`
+	ProfileImageURL string `json:"profileImageUrl,omitempty"`
+}
+
+// UserServiceServer는 user-service가 구현하는 내부 API입니다.
+type UserServiceServer interface {
+	ValidateMember(ctx context.Context, req *ValidateMemberRequest) (*ValidateMemberResponse, error)
+	GetProfile(ctx context.Context, req *GetProfileRequest) (*Profile, error)
+}
+
+// RegisterUserServiceServer는 gRPC 서버에 UserService를 등록합니다.
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&userServiceDesc, srv)
+}
+
+var userServiceDesc = grpc.ServiceDesc{
+	ServiceName: UserServiceName,
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateMember",
+			Handler: unaryHandler(UserServiceValidateMemberMethod, func(srv any, ctx context.Context, req *ValidateMemberRequest) (any, error) {
+				return srv.(UserServiceServer).ValidateMember(ctx, req)
+			}),
+		},
+		{
+			MethodName: "GetProfile",
+			Handler: unaryHandler(UserServiceGetProfileMethod, func(srv any, ctx context.Context, req *GetProfileRequest) (any, error) {
+				return srv.(UserServiceServer).GetProfile(ctx, req)
+			}),
+		},
+	},
+	Metadata: "wealist/internal/v1/user.proto",
+}
+
+// UserServiceClient는 UserService 클라이언트입니다.
+type UserServiceClient interface {
+	ValidateMember(ctx context.Context, in *ValidateMemberRequest, opts ...grpc.CallOption) (*ValidateMemberResponse, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewUserServiceClient는 연결(Dial 참고)로 UserService 클라이언트를 만듭니다.
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc: cc}
+}
+
+func (c *userServiceClient) ValidateMember(ctx context.Context, in *ValidateMemberRequest, opts ...grpc.CallOption) (*ValidateMemberResponse, error) {
+	return invoke[ValidateMemberResponse](ctx, c.cc, UserServiceValidateMemberMethod, in, opts...)
+}
+
+func (c *userServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error) {
+	return invoke[Profile](ctx, c.cc, UserServiceGetProfileMethod, in, opts...)
+}
@@ -0,0 +1,69 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShutdown_RunsStepsInOrderDespiteErrors(t *testing.T) {
+	s := NewShutdown(nil)
+
+	var order []string
+	s.Add("websocket", func(ctx context.Context) error {
+		order = append(order, "websocket")
+		return errors.New("clients still connected")
+	})
+	s.Add("workers", func(ctx context.Context) error {
+		order = append(order, "workers")
+		return nil
+	})
+
+	s.Run(context.Background())
+
+	if want := []string{"websocket", "workers"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("expected %v, got %v", want, order)
+	}
+}
+
+func TestShutdown_NilIsNoop(t *testing.T) {
+	var s *Shutdown
+	s.Add("noop", func(ctx context.Context) error { return nil })
+	s.Run(context.Background())
+}
+
+func TestWorkers_WaitForTrackedGoroutines(t *testing.T) {
+	w := NewWorkers()
+
+	var finished int32
+	for i := 0; i < 3; i++ {
+		w.Go(func() {
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&finished, 1)
+		})
+	}
+
+	if err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if got := atomic.LoadInt32(&finished); got != 3 {
+		t.Errorf("expected 3 finished workers, got %d", got)
+	}
+}
+
+func TestWorkers_WaitHonorsDeadline(t *testing.T) {
+	w := NewWorkers()
+	block := make(chan struct{})
+	defer close(block)
+	w.Go(func() { <-block })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := w.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+// Package lifecycle는 graceful shutdown에서 HTTP 서버가 다루지 않는 작업을 정리하는 도구를 제공합니다.
+// srv.Shutdown은 진행 중인 HTTP 요청만 기다리므로, 하이재킹된 WebSocket 연결이나 응답 후에도 실행되는
+// 백그라운드 고루틴, 아웃박스에 남은 이벤트는 여기에 등록한 단계로 순서대로 정리합니다.
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type step struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Shutdown runs registered drain steps in order after the HTTP server has stopped.
+type Shutdown struct {
+	mu     sync.Mutex
+	steps  []step
+	logger *zap.Logger
+}
+
+// NewShutdown creates an empty shutdown sequence.
+func NewShutdown(logger *zap.Logger) *Shutdown {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Shutdown{logger: logger}
+}
+
+// Add registers a drain step. Steps run in registration order and share the deadline passed to Run.
+// A nil Shutdown ignores the step.
+func (s *Shutdown) Add(name string, fn func(ctx context.Context) error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.steps = append(s.steps, step{name: name, fn: fn})
+}
+
+// Run executes every step, even when an earlier one fails or the deadline has passed,
+// so that each step can still release its resources (e.g. force-close connections).
+func (s *Shutdown) Run(ctx context.Context) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	steps := append([]step(nil), s.steps...)
+	s.mu.Unlock()
+
+	for _, st := range steps {
+		start := time.Now()
+		if err := st.fn(ctx); err != nil {
+			s.logger.Warn("Shutdown step did not complete",
+				zap.String("step", st.name),
+				zap.Duration("elapsed", time.Since(start)),
+				zap.Error(err))
+			continue
+		}
+		s.logger.Info("Shutdown step completed",
+			zap.String("step", st.name),
+			zap.Duration("elapsed", time.Since(start)))
+	}
+}
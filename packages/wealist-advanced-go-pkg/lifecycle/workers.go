@@ -0,0 +1,49 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+)
+
+// Workers tracks background goroutines that outlive the request that started them
+// (e.g. notifications sent after the response) so shutdown can wait for them.
+type Workers struct {
+	wg sync.WaitGroup
+}
+
+// NewWorkers creates an empty worker group.
+func NewWorkers() *Workers {
+	return &Workers{}
+}
+
+// Go runs fn in a tracked goroutine. A nil Workers runs fn in an untracked goroutine.
+func (w *Workers) Go(fn func()) {
+	if w == nil {
+		go fn()
+		return
+	}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		fn()
+	}()
+}
+
+// Wait blocks until every tracked goroutine has returned or ctx is done.
+func (w *Workers) Wait(ctx context.Context) error {
+	if w == nil {
+		return nil
+	}
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -11,6 +11,7 @@ import (
 // Logger는 zap.Logger를 래핑합니다.
 type Logger struct {
 	*zap.Logger
+	level zap.AtomicLevel
 }
 
 // Config는 로거 설정입니다.
@@ -72,8 +73,9 @@ func NewWithConfig(cfg *Config) (*Logger, error) {
 		encoding = "json"
 	}
 
+	atomicLevel := zap.NewAtomicLevelAt(zapLevel)
 	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapLevel),
+		Level:            atomicLevel,
 		Development:      false,
 		Encoding:         encoding,
 		EncoderConfig:    encoderConfig,
@@ -87,7 +89,13 @@ func NewWithConfig(cfg *Config) (*Logger, error) {
 		return nil, fmt.Errorf("로거 빌드 실패: %w", err)
 	}
 
-	return &Logger{Logger: zapLogger}, nil
+	return &Logger{Logger: zapLogger, level: atomicLevel}, nil
+}
+
+// Level은 런타임에 변경할 수 있는 로그 레벨을 반환합니다.
+// 반환된 레벨을 바꾸면 이 로거와 파생된 모든 로거에 즉시 적용됩니다.
+func (l *Logger) Level() zap.AtomicLevel {
+	return l.level
 }
 
 // parseLevel은 문자열 로그 레벨을 파싱합니다.
@@ -108,7 +116,7 @@ func parseLevel(level string) (zapcore.Level, error) {
 
 // WithFields는 추가 필드가 있는 로거를 반환합니다.
 func (l *Logger) WithFields(fields ...zap.Field) *Logger {
-	return &Logger{Logger: l.With(fields...)}
+	return &Logger{Logger: l.With(fields...), level: l.level}
 }
 
 // WithRequestID는 요청 ID 필드가 있는 로거를 반환합니다.
@@ -0,0 +1,65 @@
+// Package messaging provides durable event publishing and consumption over NATS JetStream.
+//
+// 서비스 간 이벤트(알림 등)를 동기 HTTP 대신 스트림으로 전달하여, 수신 서비스가
+// 일시적으로 내려가 있어도 이벤트가 유실되지 않고 생산자와 소비자가 분리됩니다.
+// 연결, 재연결, JetStream API는 공식 클라이언트(nats.go, nats.go/jetstream)를 사용하며,
+// 이 패키지는 서비스 공통 설정(연결 옵션, 스트림 보장, 메시지 ID, trace context 전파)만 더합니다.
+//
+// 도메인 이벤트(멤버, 보드, 파일, 알림 변경)의 토픽 규칙과 Emitter/Subscriber는 events.go를 참고하세요.
+package messaging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	defaultConnectTimeout = 5 * time.Second
+	defaultRequestTimeout = 5 * time.Second
+)
+
+// Config holds connection configuration.
+type Config struct {
+	URL            string        // nats://[user:password@]host[:port], comma separated for clusters
+	Name           string        // Client name shown in server monitoring
+	Token          string        // Auth token, used when the URL has no credentials
+	ConnectTimeout time.Duration // 0 uses 5s
+	RequestTimeout time.Duration // Timeout of JetStream API requests and publishes, 0 uses 5s
+}
+
+func (c Config) connectTimeout() time.Duration {
+	if c.ConnectTimeout > 0 {
+		return c.ConnectTimeout
+	}
+	return defaultConnectTimeout
+}
+
+func (c Config) requestTimeout() time.Duration {
+	if c.RequestTimeout > 0 {
+		return c.RequestTimeout
+	}
+	return defaultRequestTimeout
+}
+
+// Connect connects to the server.
+// 연결된 뒤 끊기면 클라이언트가 무기한 재연결하므로, 호출자는 연결을 다시 만들 필요가 없습니다.
+func Connect(cfg Config) (*nats.Conn, error) {
+	opts := []nats.Option{
+		nats.Timeout(cfg.connectTimeout()),
+		nats.MaxReconnects(-1),
+	}
+	if cfg.Name != "" {
+		opts = append(opts, nats.Name(cfg.Name))
+	}
+	if cfg.Token != "" {
+		opts = append(opts, nats.Token(cfg.Token))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("messaging: failed to connect to %s: %w", cfg.URL, err)
+	}
+	return conn, nil
+}
@@ -0,0 +1,344 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+// 도메인 이벤트 토픽 규칙
+//
+// 모든 도메인 이벤트는 하나의 스트림(EventsStream)에 저장되며 subject는
+// events.<발행 서비스>.<엔티티>.<동작> 형식입니다 (예: events.user.member.added).
+// 소비자는 필요한 subject만 필터링한 durable 컨슈머로 구독하므로, 새 소비자를
+// 추가해도 발행 서비스는 변경할 필요가 없습니다.
+const (
+	// EventsStream is the stream that stores every domain event
+	EventsStream = "WEALIST_EVENTS"
+	// EventsSubjects matches every domain event subject
+	EventsSubjects = "events.>"
+)
+
+// Domain event types (also used as subjects)
+const (
+	// user-service: workspace membership
+	EventMemberAdded       = "events.user.member.added"
+	EventMemberRemoved     = "events.user.member.removed"
+	EventMemberRoleChanged = "events.user.member.role_changed"
+
+	// board-service: boards
+	EventBoardCreated = "events.board.board.created"
+	EventBoardUpdated = "events.board.board.updated"
+	EventBoardDeleted = "events.board.board.deleted"
+
+	// storage-service: files
+	EventFileUploaded = "events.storage.file.uploaded"
+	EventFileUpdated  = "events.storage.file.updated"
+	EventFileDeleted  = "events.storage.file.deleted"
+	EventFileRestored = "events.storage.file.restored"
+
+	// noti-service: notifications
+	EventNotificationCreated = "events.noti.notification.created"
+)
+
+// Event is the envelope of every domain event.
+// Data는 이벤트 타입별 페이로드(아래 *Data 타입)이며, 소비자는 Decode로 읽습니다.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Source      string          `json:"source"`
+	WorkspaceID string          `json:"workspaceId,omitempty"`
+	ActorID     string          `json:"actorId,omitempty"`
+	OccurredAt  time.Time       `json:"occurredAt"`
+	Data        json.RawMessage `json:"data,omitempty"`
+}
+
+// Decode decodes the event payload into v.
+func (e *Event) Decode(v interface{}) error {
+	if len(e.Data) == 0 {
+		return fmt.Errorf("messaging: event %s has no data", e.ID)
+	}
+	return json.Unmarshal(e.Data, v)
+}
+
+// MemberEventData is the payload of member events.
+type MemberEventData struct {
+	MemberID string `json:"memberId"`
+	UserID   string `json:"userId"`
+	Role     string `json:"role,omitempty"`
+}
+
+// BoardEventData is the payload of board events.
+type BoardEventData struct {
+	BoardID    string   `json:"boardId"`
+	ProjectID  string   `json:"projectId"`
+	Title      string   `json:"title,omitempty"`
+	AssigneeID string   `json:"assigneeId,omitempty"`
+	Changed    []string `json:"changed,omitempty"` // updated only: changed fields
+}
+
+// FileEventData is the payload of file events.
+type FileEventData struct {
+	FileID      string `json:"fileId"`
+	ProjectID   string `json:"projectId,omitempty"`
+	FolderID    string `json:"folderId,omitempty"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+}
+
+// NotificationEventData is the payload of notification events.
+type NotificationEventData struct {
+	NotificationID string `json:"notificationId"`
+	Type           string `json:"type"`
+	TargetUserID   string `json:"targetUserId"`
+	ResourceType   string `json:"resourceType,omitempty"`
+	ResourceID     string `json:"resourceId,omitempty"`
+}
+
+// NewEvent creates the envelope of a domain event of eventType with data as its payload.
+func NewEvent(eventType, source, workspaceID, actorID string, data interface{}) (*Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("messaging: failed to encode %s data: %w", eventType, err)
+	}
+	return &Event{
+		ID:          uuid.NewString(),
+		Type:        eventType,
+		Source:      source,
+		WorkspaceID: workspaceID,
+		ActorID:     actorID,
+		OccurredAt:  time.Now().UTC(),
+		Data:        payload,
+	}, nil
+}
+
+// EventPublisher publishes JSON events (Publisher).
+type EventPublisher interface {
+	Publish(ctx context.Context, subject string, event interface{}) error
+}
+
+// Emitter publishes domain events of one service.
+// 이벤트 발행 실패는 요청을 실패시키지 않도록 호출자가 로그만 남기는 것을 권장합니다.
+type Emitter struct {
+	publisher EventPublisher
+	source    string
+	logger    *zap.Logger
+}
+
+// NewEmitter creates an emitter that publishes events with source as their origin.
+func NewEmitter(publisher EventPublisher, source string, logger *zap.Logger) *Emitter {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Emitter{publisher: publisher, source: source, logger: logger}
+}
+
+// Emit publishes a domain event of eventType with data as its payload.
+// A nil emitter is a no-op, so services can emit without checking whether the event bus is configured.
+func (e *Emitter) Emit(ctx context.Context, eventType, workspaceID, actorID string, data interface{}) error {
+	if e == nil {
+		return nil
+	}
+	event, err := NewEvent(eventType, e.source, workspaceID, actorID, data)
+	if err != nil {
+		return err
+	}
+	if err := e.publisher.Publish(ctx, eventType, event); err != nil {
+		e.logger.Warn("Failed to emit domain event",
+			zap.String("event.type", eventType),
+			zap.String("event.id", event.ID),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// eventsStreamConfig is the configuration of the domain events stream.
+var eventsStreamConfig = jetstream.StreamConfig{
+	Name:       EventsStream,
+	Subjects:   []string{EventsSubjects},
+	MaxAge:     7 * 24 * time.Hour,
+	Duplicates: 2 * time.Minute,
+}
+
+// NewEventPublisher creates a publisher that ensures the domain events stream exists.
+func NewEventPublisher(cfg Config, logger *zap.Logger) *Publisher {
+	return NewPublisher(cfg, logger).WithStream(eventsStreamConfig)
+}
+
+// EnsureEventsStream creates the domain events stream if it does not exist yet.
+func EnsureEventsStream(ctx context.Context, js jetstream.JetStream) error {
+	return EnsureStream(ctx, js, eventsStreamConfig)
+}
+
+// ErrPermanent marks handler errors that must not be retried.
+var ErrPermanent = errors.New("messaging: permanent failure")
+
+// Permanent wraps err so the subscriber terminates the event instead of redelivering it.
+func Permanent(err error) error {
+	return fmt.Errorf("%w: %w", ErrPermanent, err)
+}
+
+// EventHandler processes one domain event.
+// nil을 반환하면 ACK, Permanent 오류는 TERM, 그 외 오류는 지연 후 재전달됩니다.
+type EventHandler func(ctx context.Context, event *Event) error
+
+// SubscriberConfig configures a durable domain event subscriber.
+type SubscriberConfig struct {
+	Config                      // Connection
+	Durable       string        // Durable consumer name shared by every replica (e.g. "noti-service-members")
+	FilterSubject string        // Subject filter, e.g. "events.user.member.>" (empty = every event)
+	BatchSize     int           // Events per fetch, 0 uses 10
+	MaxDeliver    int           // Delivery attempts before an event is dropped, 0 uses 5
+	RetryDelay    time.Duration // Redelivery delay after a handler error, 0 uses 10s
+}
+
+// Subscriber consumes domain events from the events stream with a durable pull consumer.
+// 같은 Durable 이름을 쓰는 레플리카끼리 이벤트를 나눠 처리하며, 연결이 끊기면 백오프 후 재연결합니다.
+type Subscriber struct {
+	cfg     SubscriberConfig
+	handler EventHandler
+	logger  *zap.Logger
+}
+
+// NewSubscriber creates a new subscriber.
+func NewSubscriber(cfg SubscriberConfig, handler EventHandler, logger *zap.Logger) *Subscriber {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 10
+	}
+	if cfg.MaxDeliver <= 0 {
+		cfg.MaxDeliver = 5
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = 10 * time.Second
+	}
+	return &Subscriber{cfg: cfg, handler: handler, logger: logger}
+}
+
+const (
+	subscriberFetchWait         = 5 * time.Second
+	subscriberAckWait           = 30 * time.Second
+	subscriberReconnectMaxDelay = 30 * time.Second
+)
+
+// Run consumes events until the context is cancelled, reconnecting with backoff.
+func (s *Subscriber) Run(ctx context.Context) {
+	delay := time.Second
+	for {
+		connected, err := s.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = time.Second
+		}
+		s.logger.Warn("Event subscriber disconnected, reconnecting",
+			zap.String("durable", s.cfg.Durable),
+			zap.Error(err),
+			zap.Duration("retry_in", delay))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, subscriberReconnectMaxDelay)
+	}
+}
+
+// run connects, ensures the stream and consumer exist and handles events until fetching fails.
+func (s *Subscriber) run(ctx context.Context) (bool, error) {
+	conn, err := Connect(s.cfg.Config)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	consumer, err := s.consumer(ctx, conn)
+	if err != nil {
+		return false, err
+	}
+
+	s.logger.Info("Event subscriber started",
+		zap.String("stream", EventsStream),
+		zap.String("durable", s.cfg.Durable),
+		zap.String("filter", s.cfg.FilterSubject))
+
+	for {
+		msgs, err := Fetch(consumer, s.cfg.BatchSize, subscriberFetchWait)
+		for _, msg := range msgs {
+			s.handle(ctx, msg)
+		}
+		if ctx.Err() != nil {
+			return true, ctx.Err()
+		}
+		if err != nil {
+			return true, err
+		}
+	}
+}
+
+// consumer ensures the events stream and the durable pull consumer of the subscriber exist.
+func (s *Subscriber) consumer(ctx context.Context, conn *nats.Conn) (jetstream.Consumer, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.requestTimeout())
+	defer cancel()
+	if err := EnsureEventsStream(ctx, js); err != nil {
+		return nil, err
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, EventsStream, jetstream.ConsumerConfig{
+		Durable:       s.cfg.Durable,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       subscriberAckWait,
+		MaxDeliver:    s.cfg.MaxDeliver,
+		FilterSubject: s.cfg.FilterSubject,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure consumer %s: %w", s.cfg.Durable, err)
+	}
+	return consumer, nil
+}
+
+// handle decodes one message, runs the handler and acknowledges the result.
+func (s *Subscriber) handle(ctx context.Context, msg *Msg) {
+	ctx = TraceContext(ctx, msg)
+
+	var event Event
+	var ackErr error
+	if err := json.Unmarshal(msg.Data, &event); err != nil || event.Type == "" {
+		s.logger.Warn("Dropping malformed domain event", zap.String("subject", msg.Subject), zap.Error(err))
+		ackErr = msg.Term()
+	} else if err := s.handler(ctx, &event); err == nil {
+		ackErr = msg.Ack()
+	} else if errors.Is(err, ErrPermanent) {
+		s.logger.Warn("Dropping domain event after permanent failure",
+			zap.String("event.type", event.Type),
+			zap.String("event.id", event.ID),
+			zap.Error(err))
+		ackErr = msg.Term()
+	} else {
+		s.logger.Warn("Domain event handler failed, will retry",
+			zap.String("event.type", event.Type),
+			zap.String("event.id", event.ID),
+			zap.Error(err))
+		ackErr = msg.Nak(s.cfg.RetryDelay)
+	}
+	if ackErr != nil {
+		s.logger.Warn("Failed to acknowledge domain event", zap.String("subject", msg.Subject), zap.Error(ackErr))
+	}
+}
@@ -0,0 +1,127 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// MsgIDHeader deduplicates publishes within the stream's duplicate window
+const MsgIDHeader = "Nats-Msg-Id"
+
+// errNotAcknowledgeable is returned when acknowledging a message that was not consumed from a stream.
+var errNotAcknowledgeable = errors.New("messaging: message is not acknowledgeable")
+
+// EnsureStream creates a stream if it does not exist yet.
+// 이미 있는 스트림의 설정은 변경하지 않습니다 (보존 기간 등은 운영 중 nats CLI로 변경).
+func EnsureStream(ctx context.Context, js jetstream.JetStream, cfg jetstream.StreamConfig) error {
+	_, err := js.Stream(ctx, cfg.Name)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrStreamNotFound) {
+		return fmt.Errorf("messaging: failed to look up stream %s: %w", cfg.Name, err)
+	}
+
+	// 다른 레플리카가 동시에 만든 경우도 성공으로 처리
+	if _, err := js.CreateStream(ctx, cfg); err != nil && !errors.Is(err, jetstream.ErrStreamNameAlreadyInUse) {
+		return fmt.Errorf("messaging: failed to create stream %s: %w", cfg.Name, err)
+	}
+	return nil
+}
+
+// Fetch pulls up to batch messages from a pull consumer, waiting at most wait for the first one.
+// 대기 시간 안에 메시지가 없으면 에러 없이 빈 결과를 반환합니다.
+func Fetch(consumer jetstream.Consumer, batch int, wait time.Duration) ([]*Msg, error) {
+	result, err := consumer.Fetch(batch, jetstream.FetchMaxWait(wait))
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*Msg
+	for msg := range result.Messages() {
+		msgs = append(msgs, NewMsg(msg))
+	}
+	if err := result.Error(); err != nil && !errors.Is(err, nats.ErrTimeout) && !errors.Is(err, jetstream.ErrNoMessages) {
+		return msgs, err
+	}
+	return msgs, nil
+}
+
+// Msg is a message consumed from a stream.
+// 핸들러가 nats 타입에 의존하지 않도록 jetstream.Msg를 감쌉니다.
+// 테스트처럼 NewMsg 없이 만든 Msg는 ACK할 수 없습니다.
+type Msg struct {
+	Subject string
+	Header  http.Header
+	Data    []byte
+
+	msg jetstream.Msg
+}
+
+// MsgMetadata is the delivery information of a stream message.
+type MsgMetadata struct {
+	Stream       string
+	Consumer     string
+	NumDelivered uint64
+	StreamSeq    uint64
+}
+
+// NewMsg wraps a message fetched from a consumer.
+func NewMsg(msg jetstream.Msg) *Msg {
+	return &Msg{
+		Subject: msg.Subject(),
+		Header:  http.Header(msg.Headers()),
+		Data:    msg.Data(),
+		msg:     msg,
+	}
+}
+
+// Ack acknowledges the message.
+func (m *Msg) Ack() error {
+	if m.msg == nil {
+		return errNotAcknowledgeable
+	}
+	return m.msg.Ack()
+}
+
+// Nak asks the server to redeliver the message after delay.
+func (m *Msg) Nak(delay time.Duration) error {
+	if m.msg == nil {
+		return errNotAcknowledgeable
+	}
+	if delay <= 0 {
+		return m.msg.Nak()
+	}
+	return m.msg.NakWithDelay(delay)
+}
+
+// Term tells the server to never redeliver the message.
+func (m *Msg) Term() error {
+	if m.msg == nil {
+		return errNotAcknowledgeable
+	}
+	return m.msg.Term()
+}
+
+// Metadata returns the delivery information of the message.
+func (m *Msg) Metadata() (*MsgMetadata, error) {
+	if m.msg == nil {
+		return nil, errNotAcknowledgeable
+	}
+	md, err := m.msg.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	return &MsgMetadata{
+		Stream:       md.Stream,
+		Consumer:     md.Consumer,
+		NumDelivered: md.NumDelivered,
+		StreamSeq:    md.Sequence.Stream,
+	}, nil
+}
@@ -0,0 +1,103 @@
+//go:build integration
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// TestPublisherSubscriber_JetStream은 실제 JetStream에 발행한 이벤트가
+// 메시지 ID로 중복 제거되고, 핸들러 결과에 따라 ACK/재전달/종료되는지 확인합니다.
+func TestPublisherSubscriber_JetStream(t *testing.T) {
+	url := integration.NATS(t)
+	subject := "events.test." + strings.ReplaceAll(uuid.NewString(), "-", "") + ".created"
+	ctx := context.Background()
+
+	pub := NewEventPublisher(Config{URL: url, Name: "publisher-test"}, nil)
+	defer pub.Close()
+
+	for _, eventType := range []string{"ok", "retry", "drop"} {
+		event, err := NewEvent(eventType, "test", "ws1", "", map[string]string{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := json.Marshal(event)
+
+		// 같은 메시지 ID로 두 번 발행해도 한 번만 저장
+		for i := 0; i < 2; i++ {
+			header := http.Header{}
+			header.Set(MsgIDHeader, event.ID)
+			if err := pub.PublishRaw(ctx, subject, header, data); err != nil {
+				t.Fatalf("PublishRaw() error = %v", err)
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	handled := map[string]int{}
+	handler := func(ctx context.Context, event *Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled[event.Type]++
+		switch {
+		case event.Type == "retry" && handled[event.Type] == 1:
+			return errors.New("temporary")
+		case event.Type == "drop":
+			return Permanent(errors.New("unknown resource"))
+		}
+		return nil
+	}
+	sub := NewSubscriber(SubscriberConfig{
+		Config:        Config{URL: url, Name: "subscriber-test"},
+		Durable:       "worker-" + uuid.NewString()[:8],
+		FilterSubject: subject,
+		RetryDelay:    100 * time.Millisecond,
+	}, handler, nil)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		sub.Run(runCtx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(15 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		finished := handled["ok"] >= 1 && handled["retry"] >= 2 && handled["drop"] >= 1
+		mu.Unlock()
+		if finished {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	// 종료(TERM)된 이벤트가 재전달되지 않는지 확인할 시간
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := map[string]int{"ok": 1, "retry": 2, "drop": 1}
+	for eventType, count := range want {
+		if handled[eventType] != count {
+			t.Errorf("%s 처리 횟수: 예상 %d, 실제 %d", eventType, count, handled[eventType])
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Package messaging 테스트
+// 서버 없이 확인할 수 있는 동작(이벤트 봉투, ACK 결과, 메시지 변환)을 가짜 jetstream 타입으로 테스트합니다.
+// 실제 NATS 서버에 대한 발행/소비는 messaging_integration_test.go를 참고하세요.
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// fakeMsg는 ACK 응답을 기록하는 jetstream.Msg입니다.
+// 사용하지 않는 메서드는 임베딩한 인터페이스(nil)에 맡깁니다.
+type fakeMsg struct {
+	jetstream.Msg
+
+	subject string
+	header  nats.Header
+	data    []byte
+	meta    *jetstream.MsgMetadata
+	acks    *[]string
+}
+
+func (m *fakeMsg) Subject() string      { return m.subject }
+func (m *fakeMsg) Headers() nats.Header { return m.header }
+func (m *fakeMsg) Data() []byte         { return m.data }
+
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	if m.meta == nil {
+		return nil, errors.New("no metadata")
+	}
+	return m.meta, nil
+}
+
+func (m *fakeMsg) Ack() error  { return m.record("+ACK") }
+func (m *fakeMsg) Nak() error  { return m.record("-NAK") }
+func (m *fakeMsg) Term() error { return m.record("+TERM") }
+
+func (m *fakeMsg) NakWithDelay(delay time.Duration) error {
+	return m.record(fmt.Sprintf("-NAK %s", delay))
+}
+
+func (m *fakeMsg) record(ack string) error {
+	*m.acks = append(*m.acks, ack)
+	return nil
+}
+
+// fakeBatch는 미리 정한 메시지와 에러를 반환하는 jetstream.MessageBatch입니다.
+type fakeBatch struct {
+	msgs []jetstream.Msg
+	err  error
+}
+
+func (b *fakeBatch) Messages() <-chan jetstream.Msg {
+	ch := make(chan jetstream.Msg, len(b.msgs))
+	for _, msg := range b.msgs {
+		ch <- msg
+	}
+	close(ch)
+	return ch
+}
+
+func (b *fakeBatch) Error() error { return b.err }
+
+type fakeConsumer struct {
+	jetstream.Consumer
+	batch *fakeBatch
+}
+
+func (c *fakeConsumer) Fetch(batch int, opts ...jetstream.FetchOpt) (jetstream.MessageBatch, error) {
+	return c.batch, nil
+}
+
+// recordingPublisher는 발행된 이벤트를 기록하는 EventPublisher입니다.
+type recordingPublisher struct {
+	subjects []string
+	events   []interface{}
+	err      error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, subject string, event interface{}) error {
+	p.subjects = append(p.subjects, subject)
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func TestEmitter_Emit(t *testing.T) {
+	pub := &recordingPublisher{}
+	emitter := NewEmitter(pub, "user-service", nil)
+
+	data := MemberEventData{MemberID: "m1", UserID: "u1", Role: "MEMBER"}
+	if err := emitter.Emit(context.Background(), EventMemberAdded, "ws1", "actor", data); err != nil {
+		t.Fatalf("이벤트 발행 실패: %v", err)
+	}
+
+	// 이벤트 타입 subject로 발행
+	if len(pub.subjects) != 1 || pub.subjects[0] != EventMemberAdded {
+		t.Fatalf("이벤트 타입 subject로 발행되어야 합니다: %v", pub.subjects)
+	}
+	event, ok := pub.events[0].(*Event)
+	if !ok {
+		t.Fatalf("이벤트 봉투로 발행되어야 합니다: %T", pub.events[0])
+	}
+	if event.ID == "" || event.Type != EventMemberAdded || event.Source != "user-service" || event.WorkspaceID != "ws1" {
+		t.Errorf("예상하지 못한 이벤트: %+v", event)
+	}
+	var decoded MemberEventData
+	if err := event.Decode(&decoded); err != nil || decoded != data {
+		t.Errorf("페이로드가 보존되어야 합니다: %+v, %v", decoded, err)
+	}
+
+	// 발행 실패는 호출자에게 전달
+	pub.err = errors.New("unavailable")
+	if err := emitter.Emit(context.Background(), EventMemberAdded, "ws1", "actor", data); err == nil {
+		t.Error("발행 실패 시 에러를 반환해야 합니다")
+	}
+
+	// 이벤트 버스 미설정(nil)은 no-op
+	var disabled *Emitter
+	if err := disabled.Emit(context.Background(), EventMemberAdded, "", "", data); err != nil {
+		t.Errorf("nil emitter는 에러 없이 무시해야 합니다: %v", err)
+	}
+}
+
+func TestSubscriber_AckResults(t *testing.T) {
+	handler := func(ctx context.Context, event *Event) error {
+		switch event.Type {
+		case "retry":
+			return fmt.Errorf("temporary")
+		case "drop":
+			return Permanent(fmt.Errorf("unknown resource"))
+		}
+		return nil
+	}
+	sub := NewSubscriber(SubscriberConfig{Durable: "worker"}, handler, nil)
+
+	event := func(eventType string) []byte {
+		data, _ := json.Marshal(Event{ID: eventType, Type: eventType})
+		return data
+	}
+	var acks []string
+	for _, data := range [][]byte{event("ok"), event("retry"), event("drop"), []byte(`not json`)} {
+		sub.handle(context.Background(), NewMsg(&fakeMsg{subject: "events.test", data: data, acks: &acks}))
+	}
+
+	want := []string{"+ACK", "-NAK 10s", "+TERM", "+TERM"}
+	if strings.Join(acks, ",") != strings.Join(want, ",") {
+		t.Errorf("예상 ACK: %v, 실제: %v", want, acks)
+	}
+}
+
+func TestNewMsg(t *testing.T) {
+	var acks []string
+	header := nats.Header{}
+	header.Set(MsgIDHeader, "abc")
+	msg := NewMsg(&fakeMsg{
+		subject: EventMemberAdded,
+		header:  header,
+		data:    []byte(`{}`),
+		meta: &jetstream.MsgMetadata{
+			Stream:       EventsStream,
+			Consumer:     "worker",
+			NumDelivered: 3,
+			Sequence:     jetstream.SequencePair{Stream: 42, Consumer: 40},
+		},
+		acks: &acks,
+	})
+
+	if msg.Subject != EventMemberAdded || msg.Header.Get(MsgIDHeader) != "abc" || string(msg.Data) != `{}` {
+		t.Errorf("메시지 내용이 보존되어야 합니다: %+v", msg)
+	}
+	meta, err := msg.Metadata()
+	if err != nil {
+		t.Fatalf("메타데이터 조회 실패: %v", err)
+	}
+	if meta.Stream != EventsStream || meta.Consumer != "worker" || meta.NumDelivered != 3 || meta.StreamSeq != 42 {
+		t.Errorf("예상하지 못한 메타데이터: %+v", meta)
+	}
+
+	if err := msg.Nak(0); err != nil || len(acks) != 1 || acks[0] != "-NAK" {
+		t.Errorf("지연 없는 NAK이어야 합니다: %v, %v", acks, err)
+	}
+}
+
+func TestMsg_NotAcknowledgeable(t *testing.T) {
+	msg := &Msg{Subject: EventMemberAdded, Data: []byte(`{}`)}
+
+	if err := msg.Ack(); err == nil {
+		t.Error("스트림에서 받지 않은 메시지는 ACK할 수 없어야 합니다")
+	}
+	if _, err := msg.Metadata(); err == nil {
+		t.Error("스트림에서 받지 않은 메시지는 메타데이터가 없어야 합니다")
+	}
+}
+
+func TestFetch(t *testing.T) {
+	var acks []string
+	msgs := []jetstream.Msg{&fakeMsg{subject: "events.a", acks: &acks}, &fakeMsg{subject: "events.b", acks: &acks}}
+
+	// 대기 시간 안에 다 채우지 못한 배치는 에러가 아님
+	got, err := Fetch(&fakeConsumer{batch: &fakeBatch{msgs: msgs, err: nats.ErrTimeout}}, 10, time.Second)
+	if err != nil || len(got) != 2 || got[1].Subject != "events.b" {
+		t.Errorf("받은 메시지를 반환해야 합니다: %v, %v", got, err)
+	}
+
+	// 그 외 에러는 받은 메시지와 함께 반환
+	got, err = Fetch(&fakeConsumer{batch: &fakeBatch{msgs: msgs[:1], err: nats.ErrConnectionClosed}}, 10, time.Second)
+	if !errors.Is(err, nats.ErrConnectionClosed) || len(got) != 1 {
+		t.Errorf("연결 에러를 반환해야 합니다: %v, %v", got, err)
+	}
+}
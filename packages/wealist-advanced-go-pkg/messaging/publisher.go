@@ -0,0 +1,138 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+)
+
+// Publisher publishes JSON events to JetStream for producer services.
+// 최초 발행 시 연결하며, 이후 연결이 끊기면 클라이언트가 재연결합니다.
+// 재시도 시 동일한 메시지 ID를 사용하므로 스트림에 중복 저장되지 않습니다.
+type Publisher struct {
+	cfg    Config
+	logger *zap.Logger
+
+	streams []jetstream.StreamConfig // ensured when connecting
+
+	mu   sync.Mutex
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// NewPublisher creates a new publisher.
+func NewPublisher(cfg Config, logger *zap.Logger) *Publisher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Publisher{cfg: cfg, logger: logger}
+}
+
+// WithStream makes the publisher create the stream, if missing, when it connects.
+// 스트림을 소비자가 만들기 전에 발행해도 실패하지 않도록 생산자 쪽에서도 보장합니다.
+func (p *Publisher) WithStream(cfg jetstream.StreamConfig) *Publisher {
+	p.streams = append(p.streams, cfg)
+	return p
+}
+
+// Publish encodes an event as JSON and stores it on the stream bound to subject.
+// The trace context of ctx is propagated in the message headers.
+func (p *Publisher) Publish(ctx context.Context, subject string, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("messaging: failed to encode event: %w", err)
+	}
+
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	return p.PublishRaw(ctx, subject, header, data)
+}
+
+// PublishRaw stores already encoded data on the stream bound to subject with the given headers.
+// header에 메시지 ID가 없으면 새로 생성합니다. 같은 ID로 다시 발행하면 스트림의 중복 제거 구간 안에서는 한 번만 저장됩니다.
+func (p *Publisher) PublishRaw(ctx context.Context, subject string, header http.Header, data []byte) error {
+	if header == nil {
+		header = http.Header{}
+	}
+	if header.Get(MsgIDHeader) == "" {
+		header.Set(MsgIDHeader, uuid.NewString())
+	}
+
+	js, err := p.jetStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.requestTimeout())
+	defer cancel()
+	ack, err := js.PublishMsg(ctx, &nats.Msg{Subject: subject, Header: nats.Header(header), Data: data})
+	if err != nil {
+		return fmt.Errorf("messaging: failed to publish to %s: %w", subject, err)
+	}
+	p.logger.Debug("Event published",
+		zap.String("subject", subject),
+		zap.String("stream", ack.Stream),
+		zap.Uint64("seq", ack.Sequence),
+		zap.Bool("duplicate", ack.Duplicate))
+	return nil
+}
+
+// Close closes the underlying connection.
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn != nil {
+		p.conn.Close()
+		p.conn, p.js = nil, nil
+	}
+	return nil
+}
+
+// jetStream returns the JetStream context, connecting and ensuring the streams on first use.
+func (p *Publisher) jetStream(ctx context.Context) (jetstream.JetStream, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn != nil && !p.conn.IsClosed() {
+		return p.js, nil
+	}
+
+	conn, err := Connect(p.cfg)
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("messaging: failed to create jetstream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.requestTimeout())
+	defer cancel()
+	for _, stream := range p.streams {
+		if err := EnsureStream(ctx, js, stream); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	p.conn = conn
+	p.js = js
+	return p.js, nil
+}
+
+// TraceContext returns ctx with the trace context propagated in the message headers.
+func TraceContext(ctx context.Context, msg *Msg) context.Context {
+	if len(msg.Header) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(msg.Header))
+}
@@ -3,10 +3,15 @@ package metrics
 import (
 	"database/sql"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
-// UpdateDBStats updates database connection pool metrics
+// UpdateDBStats updates database connection pool metrics.
+// WaitCount and WaitDuration are cumulative in sql.DBStats, so only the increase
+// since the previous call is added to the counters.
 func (m *Metrics) UpdateDBStats(statsInterface interface{}) {
 	m.safeExecute("UpdateDBStats", func() {
 		stats, ok := statsInterface.(sql.DBStats)
@@ -17,23 +22,97 @@ func (m *Metrics) UpdateDBStats(statsInterface interface{}) {
 		m.DBConnectionsInUse.Set(float64(stats.InUse))
 		m.DBConnectionsIdle.Set(float64(stats.Idle))
 		m.DBConnectionsMax.Set(float64(stats.MaxOpenConnections))
-		m.DBConnectionWaitTotal.Add(float64(stats.WaitCount))
-		m.DBConnectionWaitDuration.Add(stats.WaitDuration.Seconds())
+
+		m.dbStatsMu.Lock()
+		defer m.dbStatsMu.Unlock()
+		last := m.lastDBStats
+		if stats.WaitCount < last.WaitCount || stats.WaitDuration < last.WaitDuration {
+			// A new pool (e.g. reconnect) restarts its counters
+			last = sql.DBStats{}
+		}
+		m.DBConnectionWaitTotal.Add(float64(stats.WaitCount - last.WaitCount))
+		m.DBConnectionWaitDuration.Add((stats.WaitDuration - last.WaitDuration).Seconds())
+		m.lastDBStats = stats
 	})
 }
 
+// DBStatser provides connection pool statistics (implemented by *sql.DB).
+type DBStatser interface {
+	Stats() sql.DBStats
+}
+
+// DefaultDBStatsInterval is the pool stats sampling interval used by StartDBStatsCollector.
+const DefaultDBStatsInterval = 15 * time.Second
+
+// StartDBStatsCollector records db's pool stats every interval (DefaultDBStatsInterval if <= 0)
+// until the returned stop function is called.
+func (m *Metrics) StartDBStatsCollector(db DBStatser, interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = DefaultDBStatsInterval
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	m.UpdateDBStats(db.Stats())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.UpdateDBStats(db.Stats())
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() { once.Do(func() { close(done) }) }
+}
+
 // RecordDBQuery records database query metrics
 func (m *Metrics) RecordDBQuery(operation, table string, duration time.Duration, err error) {
+	m.RecordDBQueryWithExemplar(operation, table, duration, err, nil)
+}
+
+// RecordDBQueryWithExemplar records database query metrics with an exemplar attached.
+func (m *Metrics) RecordDBQueryWithExemplar(operation, table string, duration time.Duration, err error, exemplar prometheus.Labels) {
 	m.safeExecute("RecordDBQuery", func() {
 		operation = NormalizeOperation(operation)
-		m.DBQueryDuration.WithLabelValues(operation, table).Observe(duration.Seconds())
+		observe(m.DBQueryDuration.WithLabelValues(operation, table), duration.Seconds(), exemplar)
 
 		if err != nil {
-			m.DBQueryErrors.WithLabelValues(operation, table).Inc()
+			inc(m.DBQueryErrors.WithLabelValues(operation, table), exemplar)
+		}
+	})
+}
+
+// SetDBReplicaUp records the health check result of a read replica
+func (m *Metrics) SetDBReplicaUp(replica string, up bool) {
+	m.safeExecute("SetDBReplicaUp", func() {
+		value := 0.0
+		if up {
+			value = 1
 		}
+		m.DBReplicaUp.WithLabelValues(replica).Set(value)
 	})
 }
 
+// RecordDBReadRoute counts a replica-eligible read routed to target
+// (DBTargetReplica, or DBTargetPrimaryFallback when no replica is healthy)
+func (m *Metrics) RecordDBReadRoute(target string) {
+	m.safeExecute("RecordDBReadRoute", func() {
+		m.DBReadsRouted.WithLabelValues(target).Inc()
+	})
+}
+
+// Read routing targets for RecordDBReadRoute
+const (
+	DBTargetReplica         = "replica"
+	DBTargetPrimaryFallback = "primary_fallback"
+)
+
 // NormalizeOperation converts operation to lowercase
 func NormalizeOperation(op string) string {
 	return strings.ToLower(op)
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 func TestRecordDBQuery(t *testing.T) {
@@ -33,6 +35,48 @@ func TestUpdateDBStats(t *testing.T) {
 	m.UpdateDBStats(stats)
 }
 
+func TestUpdateDBStatsAddsWaitIncrease(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := New(&Config{Namespace: "test_service", Registry: registry})
+
+	m.UpdateDBStats(sql.DBStats{WaitCount: 10, WaitDuration: 2 * time.Second})
+	m.UpdateDBStats(sql.DBStats{WaitCount: 15, WaitDuration: 3 * time.Second})
+
+	if got := gatherFamily(t, registry, "test_service_db_connection_wait_total").GetMetric()[0].GetCounter().GetValue(); got != 15 {
+		t.Errorf("db_connection_wait_total = %v, want 15", got)
+	}
+	if got := gatherFamily(t, registry, "test_service_db_connection_wait_duration_seconds_total").GetMetric()[0].GetCounter().GetValue(); got != 3 {
+		t.Errorf("db_connection_wait_duration_seconds_total = %v, want 3", got)
+	}
+
+	// A new pool restarts its cumulative stats
+	m.UpdateDBStats(sql.DBStats{WaitCount: 2, WaitDuration: time.Second})
+	if got := gatherFamily(t, registry, "test_service_db_connection_wait_total").GetMetric()[0].GetCounter().GetValue(); got != 17 {
+		t.Errorf("db_connection_wait_total after reset = %v, want 17", got)
+	}
+}
+
+type fakeStatser struct{ stats sql.DBStats }
+
+func (f fakeStatser) Stats() sql.DBStats { return f.stats }
+
+func TestStartDBStatsCollector(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := New(&Config{Namespace: "test_service", Registry: registry})
+
+	stop := m.StartDBStatsCollector(fakeStatser{sql.DBStats{OpenConnections: 7, InUse: 3}}, time.Hour)
+	defer stop()
+
+	// The first sample is recorded immediately
+	if got := gatherFamily(t, registry, "test_service_db_connections_open").GetMetric()[0].GetGauge().GetValue(); got != 7 {
+		t.Errorf("db_connections_open = %v, want 7", got)
+	}
+	if got := gatherFamily(t, registry, "test_service_db_connections_in_use").GetMetric()[0].GetGauge().GetValue(); got != 3 {
+		t.Errorf("db_connections_in_use = %v, want 3", got)
+	}
+	stop()
+}
+
 func TestUpdateDBStatsWithInvalidType(t *testing.T) {
 	m := NewForTest("test_service")
 
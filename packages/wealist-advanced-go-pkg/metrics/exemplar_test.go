@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func gatherFamily(t *testing.T, registry *prometheus.Registry, name string) *dto.MetricFamily {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() == name {
+			return family
+		}
+	}
+	t.Fatalf("metric %s not found", name)
+	return nil
+}
+
+func TestRecordHTTPRequestWithExemplar(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := New(&Config{Namespace: "test_service", Registry: registry})
+
+	exemplar := prometheus.Labels{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736"}
+	m.RecordHTTPRequestWithExemplar("GET", "/api/boards/:id", 200, 120*time.Millisecond, exemplar)
+
+	counter := gatherFamily(t, registry, "test_service_http_requests_total").GetMetric()[0].GetCounter()
+	if got := counter.GetExemplar().GetLabel(); len(got) != 1 || got[0].GetValue() != exemplar["trace_id"] {
+		t.Errorf("expected counter exemplar with trace_id, got %v", got)
+	}
+
+	var found bool
+	for _, bucket := range gatherFamily(t, registry, "test_service_http_request_duration_seconds").GetMetric()[0].GetHistogram().GetBucket() {
+		if bucket.GetExemplar() != nil {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected histogram bucket exemplar")
+	}
+}
+
+func TestRecordRedisCommand(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := New(&Config{Namespace: "test_service", Registry: registry})
+
+	m.RecordRedisCommand("GET", time.Millisecond, nil)
+	m.RecordRedisCommand("SET", time.Millisecond, errors.New("connection refused"))
+
+	errs := gatherFamily(t, registry, "test_service_redis_command_errors_total").GetMetric()
+	if len(errs) != 1 || errs[0].GetLabel()[0].GetValue() != "set" {
+		t.Errorf("expected one error series for set, got %v", errs)
+	}
+	if got := len(gatherFamily(t, registry, "test_service_redis_command_duration_seconds").GetMetric()); got != 2 {
+		t.Errorf("expected duration series for get and set, got %d", got)
+	}
+}
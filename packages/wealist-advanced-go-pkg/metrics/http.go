@@ -3,17 +3,39 @@ package metrics
 import (
 	"strings"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // RecordHTTPRequest records HTTP request metrics
 func (m *Metrics) RecordHTTPRequest(method, endpoint string, statusCode int, duration time.Duration) {
+	m.RecordHTTPRequestWithExemplar(method, endpoint, statusCode, duration, nil)
+}
+
+// RecordHTTPRequestWithExemplar records HTTP request metrics and attaches exemplar
+// (e.g. the trace_id of the request) to the samples. A nil exemplar records plain samples.
+func (m *Metrics) RecordHTTPRequestWithExemplar(method, endpoint string, statusCode int, duration time.Duration, exemplar prometheus.Labels) {
 	m.safeExecute("RecordHTTPRequest", func() {
 		status := CategorizeStatus(statusCode)
-		m.HTTPRequestsTotal.WithLabelValues(method, endpoint, status).Inc()
-		m.HTTPRequestDuration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+		inc(m.HTTPRequestsTotal.WithLabelValues(method, endpoint, status), exemplar)
+		observe(m.HTTPRequestDuration.WithLabelValues(method, endpoint), duration.Seconds(), exemplar)
+	})
+}
+
+// RecordHTTPResponseSize records the HTTP response body size
+func (m *Metrics) RecordHTTPResponseSize(method, endpoint string, size int) {
+	m.safeExecute("RecordHTTPResponseSize", func() {
+		if size < 0 {
+			size = 0
+		}
+		m.HTTPResponseSize.WithLabelValues(method, endpoint).Observe(float64(size))
 	})
 }
 
+// UnmatchedEndpoint is the endpoint label for requests that matched no route.
+// Using the raw path would create a series per scanned URL.
+const UnmatchedEndpoint = "unmatched"
+
 // CategorizeStatus converts status code to category (2xx, 3xx, 4xx, 5xx)
 func CategorizeStatus(code int) string {
 	switch {
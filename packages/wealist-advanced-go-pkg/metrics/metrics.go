@@ -2,6 +2,9 @@
 package metrics
 
 import (
+	"database/sql"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
@@ -33,8 +36,10 @@ type Metrics struct {
 	logger    *zap.Logger
 
 	// HTTP metrics
-	HTTPRequestsTotal   *prometheus.CounterVec
-	HTTPRequestDuration *prometheus.HistogramVec
+	HTTPRequestsTotal    *prometheus.CounterVec
+	HTTPRequestDuration  *prometheus.HistogramVec
+	HTTPRequestsInFlight prometheus.Gauge
+	HTTPResponseSize     *prometheus.HistogramVec
 
 	// Database metrics
 	DBConnectionsOpen        prometheus.Gauge
@@ -45,15 +50,25 @@ type Metrics struct {
 	DBConnectionWaitDuration prometheus.Counter
 	DBQueryDuration          *prometheus.HistogramVec
 	DBQueryErrors            *prometheus.CounterVec
+	DBReplicaUp              *prometheus.GaugeVec
+	DBReadsRouted            *prometheus.CounterVec
 
 	// External API metrics
 	ExternalAPIRequestDuration *prometheus.HistogramVec
 	ExternalAPIRequestsTotal   *prometheus.CounterVec
 	ExternalAPIErrors          *prometheus.CounterVec
 
+	// Redis metrics
+	RedisCommandDuration *prometheus.HistogramVec
+	RedisCommandErrors   *prometheus.CounterVec
+
 	// Custom metrics storage for service-specific metrics
 	customGauges   map[string]prometheus.Gauge
 	customCounters map[string]prometheus.Counter
+
+	// Last cumulative pool stats, so UpdateDBStats only adds the increase
+	dbStatsMu   sync.Mutex
+	lastDBStats sql.DBStats
 }
 
 // New creates and registers all metrics with the given configuration
@@ -97,6 +112,22 @@ func New(cfg *Config) *Metrics {
 			},
 			[]string{"method", "endpoint"},
 		),
+		HTTPRequestsInFlight: factory.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: cfg.Namespace,
+				Name:      "http_requests_in_flight",
+				Help:      "Current number of HTTP requests being served",
+			},
+		),
+		HTTPResponseSize: factory.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: cfg.Namespace,
+				Name:      "http_response_size_bytes",
+				Help:      "HTTP response body size in bytes",
+				Buckets:   prometheus.ExponentialBuckets(100, 10, 6), // 100B ~ 10MB
+			},
+			[]string{"method", "endpoint"},
+		),
 
 		// Database connection pool metrics
 		DBConnectionsOpen: factory.NewGauge(
@@ -161,6 +192,24 @@ func New(cfg *Config) *Metrics {
 			[]string{"operation", "table"},
 		),
 
+		// Read replica metrics
+		DBReplicaUp: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: cfg.Namespace,
+				Name:      "db_replica_up",
+				Help:      "Whether the read replica passed its last health check (1) or not (0)",
+			},
+			[]string{"replica"},
+		),
+		DBReadsRouted: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: cfg.Namespace,
+				Name:      "db_reads_routed_total",
+				Help:      "Total number of replica-eligible reads by target (replica, primary_fallback)",
+			},
+			[]string{"target"},
+		),
+
 		// External API metrics
 		ExternalAPIRequestDuration: factory.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -188,6 +237,25 @@ func New(cfg *Config) *Metrics {
 			[]string{"endpoint", "error_type"},
 		),
 
+		// Redis command metrics
+		RedisCommandDuration: factory.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: cfg.Namespace,
+				Name:      "redis_command_duration_seconds",
+				Help:      "Redis command duration in seconds",
+				Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5},
+			},
+			[]string{"command"},
+		),
+		RedisCommandErrors: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: cfg.Namespace,
+				Name:      "redis_command_errors_total",
+				Help:      "Total number of Redis command errors",
+			},
+			[]string{"command"},
+		),
+
 		customGauges:   make(map[string]prometheus.Gauge),
 		customCounters: make(map[string]prometheus.Counter),
 	}
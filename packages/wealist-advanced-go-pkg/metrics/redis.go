@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RecordRedisCommand records Redis command metrics
+func (m *Metrics) RecordRedisCommand(command string, duration time.Duration, err error) {
+	m.RecordRedisCommandWithExemplar(command, duration, err, nil)
+}
+
+// RecordRedisCommandWithExemplar records Redis command metrics with an exemplar attached.
+// Pipelines should be recorded with the command "pipeline".
+func (m *Metrics) RecordRedisCommandWithExemplar(command string, duration time.Duration, err error, exemplar prometheus.Labels) {
+	m.safeExecute("RecordRedisCommand", func() {
+		command = strings.ToLower(command)
+		observe(m.RedisCommandDuration.WithLabelValues(command), duration.Seconds(), exemplar)
+
+		if err != nil {
+			inc(m.RedisCommandErrors.WithLabelValues(command), exemplar)
+		}
+	})
+}
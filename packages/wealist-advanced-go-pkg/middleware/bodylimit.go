@@ -0,0 +1,67 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 요청 본문 크기 제한 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultMaxBodyBytes는 JSON API의 기본 요청 본문 크기 제한입니다 (1MB).
+const DefaultMaxBodyBytes int64 = 1 << 20
+
+// BodyLimit는 요청 본문을 maxBytes로 제한하는 미들웨어를 반환합니다.
+//
+// 라우터 전체에 기본값을 걸고, 큰 본문이 필요한(또는 더 작게 막을) 라우트에 다시 걸면 마지막 값이 적용됩니다.
+//
+//	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes))
+//	api.POST("/boards/import", commonmw.BodyLimit(10<<20), handler.Import)
+//
+// 본문을 읽을 때 제한을 넘으면 *http.MaxBytesError를 반환하므로,
+// validation.BindJSON은 413 REQUEST_TOO_LARGE로 응답합니다.
+func BodyLimit(maxBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body != nil && c.Request.Body != http.NoBody {
+			if body, ok := c.Request.Body.(*limitedBody); ok {
+				body.limit = maxBytes
+			} else {
+				c.Request.Body = &limitedBody{
+					ReadCloser:    c.Request.Body,
+					limit:         maxBytes,
+					contentLength: c.Request.ContentLength,
+				}
+			}
+		}
+		c.Next()
+	}
+}
+
+// limitedBody는 제한을 나중에(라우트 미들웨어에서) 바꿀 수 있는 http.MaxBytesReader입니다.
+type limitedBody struct {
+	io.ReadCloser
+	limit         int64
+	read          int64
+	contentLength int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	// Content-Length가 제한을 넘으면 읽기 전에 거부
+	if b.read == 0 && b.contentLength > b.limit {
+		return 0, &http.MaxBytesError{Limit: b.limit}
+	}
+	if b.read > b.limit {
+		return 0, &http.MaxBytesError{Limit: b.limit}
+	}
+	// 제한을 넘었는지 알 수 있도록 1바이트 더 읽음
+	if remaining := b.limit - b.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	if b.read > b.limit {
+		return n - int(b.read-b.limit), &http.MaxBytesError{Limit: b.limit}
+	}
+	return n, err
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func readWithLimit(t *testing.T, body string, contentLength int64, limits ...int64) (int, error) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(BodyLimit(limits[0]))
+
+	handlers := []gin.HandlerFunc{}
+	for _, limit := range limits[1:] {
+		handlers = append(handlers, BodyLimit(limit))
+	}
+	var (
+		n       int
+		readErr error
+	)
+	handlers = append(handlers, func(c *gin.Context) {
+		data, err := io.ReadAll(c.Request.Body)
+		n, readErr = len(data), err
+		c.Status(http.StatusNoContent)
+	})
+	r.POST("/", handlers...)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.ContentLength = contentLength
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	return n, readErr
+}
+
+func TestBodyLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		limits        []int64
+		wantN         int
+		wantErr       bool
+	}{
+		{name: "under limit", body: "12345", contentLength: 5, limits: []int64{10}, wantN: 5},
+		{name: "exactly limit", body: "1234567890", contentLength: 10, limits: []int64{10}, wantN: 10},
+		{name: "over limit while streaming", body: "12345678901", contentLength: -1, limits: []int64{10}, wantN: 10, wantErr: true},
+		{name: "content-length over limit", body: "12345678901", contentLength: 11, limits: []int64{10}, wantErr: true},
+		{name: "route raises limit", body: "12345678901", contentLength: 11, limits: []int64{10, 20}, wantN: 11},
+		{name: "route lowers limit", body: "123456", contentLength: 6, limits: []int64{10, 5}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := readWithLimit(t, tt.body, tt.contentLength, tt.limits...)
+			var maxBytesErr *http.MaxBytesError
+			if tt.wantErr != errors.As(err, &maxBytesErr) {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != tt.wantN {
+				t.Errorf("read %d bytes, want %d", n, tt.wantN)
+			}
+		})
+	}
+}
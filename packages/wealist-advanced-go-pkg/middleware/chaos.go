@@ -0,0 +1,234 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 복원력 테스트를 위한 장애 주입(chaos) 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// ChaosInjectedHeader는 주입된 장애 종류를 알려 주는 응답 헤더입니다 (예: "latency,error").
+const ChaosInjectedHeader = "X-Chaos-Injected"
+
+// chaosExemptSuffixes는 장애를 주입하지 않는 경로입니다. 프로브가 실패하면 파드가 재시작되거나 트래픽에서 빠지므로
+// 재시도/서킷 브레이커 검증과 관계없는 결과가 나옵니다.
+var chaosExemptSuffixes = []string{"/healthz", "/readyz", "/health", "/health/live", "/health/ready", "/metrics"}
+
+// ChaosConfig는 장애 주입 미들웨어 설정입니다.
+type ChaosConfig struct {
+	Environment     string // 실행 환경 (ENV)
+	AllowProduction bool   // 운영 환경에서도 장애 주입 허용
+}
+
+// DefaultChaosConfig는 ENV와 CHAOS_ALLOW_PRODUCTION 환경 변수에서 설정을 읽습니다.
+func DefaultChaosConfig() ChaosConfig {
+	return ChaosConfig{
+		Environment:     os.Getenv("ENV"),
+		AllowProduction: os.Getenv("CHAOS_ALLOW_PRODUCTION") == "true",
+	}
+}
+
+// Chaos는 런타임 설정(chaos)의 규칙에 따라 지연, 에러, 응답 유실을 주입하는 미들웨어를 반환합니다.
+// 재시도, 서킷 브레이커, 타임아웃이 실제로 동작하는지 확인하기 위한 것으로, 규칙은 ConfigMap 변경이나
+// SIGHUP으로 재시작 없이 켜고 끌 수 있습니다.
+//
+// 요청 경로와 일치하는 첫 번째 규칙만 적용되며, 주입 순서는 다음과 같습니다.
+//  1. 지연 (latency_percent 확률로 latency만큼 대기, 클라이언트가 끊으면 중단)
+//  2. 에러 (error_percent 확률로 error_status 응답, 핸들러는 실행하지 않음)
+//  3. 응답 유실 (drop_percent 확률로 핸들러 실행 후 응답 없이 연결 종료, HTTP/2에서는 502)
+//
+// watcher가 nil이거나 운영 환경(ENV=production)에서 AllowProduction이 false이면 아무 것도 하지 않습니다.
+// 헬스체크와 /metrics에는 주입하지 않습니다.
+func Chaos(cfg ChaosConfig, watcher *config.Watcher, logger *zap.Logger) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if watcher == nil {
+		return func(c *gin.Context) { c.Next() }
+	}
+	if isProductionEnv(cfg.Environment) && !cfg.AllowProduction {
+		logger.Info("Chaos middleware disabled in production", zap.String("env", cfg.Environment))
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	var current atomic.Pointer[config.ChaosSettings]
+	current.Store(&config.ChaosSettings{})
+	watcher.OnChange(func(s config.RuntimeSettings) {
+		settings := s.Chaos
+		current.Store(&settings)
+		if settings.Enabled {
+			logger.Warn("Chaos fault injection enabled", zap.Int("rules", len(settings.Rules)))
+		}
+	})
+
+	return func(c *gin.Context) {
+		settings := current.Load()
+		if !settings.Enabled || isChaosExempt(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+		rule := matchChaosRule(settings.Rules, c.Request.Method, chaosRoute(c))
+		if rule == nil {
+			c.Next()
+			return
+		}
+
+		var injected []string
+		if rule.Latency > 0 && roll(rule.LatencyPercent) {
+			injected = append(injected, "latency")
+			timer := time.NewTimer(rule.Latency)
+			select {
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				timer.Stop()
+				c.Abort()
+				return
+			}
+		}
+
+		if roll(rule.ErrorPercent) {
+			injected = append(injected, "error")
+			logger.Debug("Chaos error injected",
+				zap.String("path", c.Request.URL.Path), zap.String("route", rule.Route))
+			c.Header(ChaosInjectedHeader, strings.Join(injected, ","))
+			status := rule.ErrorStatus
+			if status == 0 {
+				status = http.StatusServiceUnavailable
+			}
+			response.Error(c, status, chaosErrorCode(status), "Injected fault (chaos testing)")
+			c.Abort()
+			return
+		}
+
+		if roll(rule.DropPercent) {
+			logger.Debug("Chaos response drop injected",
+				zap.String("path", c.Request.URL.Path), zap.String("route", rule.Route))
+			dropResponse(c)
+			return
+		}
+
+		if len(injected) > 0 {
+			c.Header(ChaosInjectedHeader, strings.Join(injected, ","))
+		}
+		c.Next()
+	}
+}
+
+// dropResponse는 핸들러를 실행한 뒤 응답을 버리고 연결을 끊습니다.
+// 요청은 처리됐지만 클라이언트는 결과를 받지 못하는 상황(재시도 시 중복 처리)을 재현합니다.
+func dropResponse(c *gin.Context) {
+	original := c.Writer
+	c.Writer = &discardWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Next()
+	c.Writer = original
+
+	conn, _, err := original.Hijack()
+	if err != nil {
+		// HTTP/2 등 hijack을 지원하지 않으면 연결을 끊을 수 없으므로 게이트웨이 오류로 대신합니다
+		c.Header(ChaosInjectedHeader, "drop")
+		response.Error(c, http.StatusBadGateway, apperrors.ErrCodeServiceUnavailable, "Injected fault (chaos testing)")
+		c.Abort()
+		return
+	}
+	_ = conn.Close()
+	c.Abort()
+}
+
+// discardWriter는 상태 코드만 기록하고 본문은 버리는 ResponseWriter입니다.
+type discardWriter struct {
+	gin.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *discardWriter) WriteHeader(code int) { w.status = code }
+func (w *discardWriter) WriteHeaderNow()      {}
+func (w *discardWriter) Flush()               {}
+func (w *discardWriter) Status() int          { return w.status }
+func (w *discardWriter) Size() int            { return w.size }
+func (w *discardWriter) Written() bool        { return w.size > 0 }
+
+func (w *discardWriter) Write(b []byte) (int, error) {
+	w.size += len(b)
+	return len(b), nil
+}
+
+func (w *discardWriter) WriteString(s string) (int, error) {
+	w.size += len(s)
+	return len(s), nil
+}
+
+// chaosRoute는 규칙과 비교할 경로를 반환합니다. 등록된 라우트 템플릿(/api/boards/:boardId)을 우선 사용합니다.
+func chaosRoute(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return c.Request.URL.Path
+}
+
+// matchChaosRule은 method와 route에 일치하는 첫 번째 규칙을 반환합니다.
+func matchChaosRule(rules []config.ChaosRule, method, route string) *config.ChaosRule {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Method != "" && !strings.EqualFold(rule.Method, method) {
+			continue
+		}
+		if rule.Route == "*" {
+			return rule
+		}
+		if prefix, ok := strings.CutSuffix(rule.Route, "*"); ok {
+			if strings.HasPrefix(route, prefix) {
+				return rule
+			}
+		} else if route == rule.Route {
+			return rule
+		}
+	}
+	return nil
+}
+
+func isChaosExempt(path string) bool {
+	for _, suffix := range chaosExemptSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isProductionEnv(env string) bool {
+	switch strings.ToLower(env) {
+	case "prod", "production":
+		return true
+	}
+	return false
+}
+
+// roll은 percent(0-100) 확률로 true를 반환합니다.
+func roll(percent float64) bool {
+	return percent > 0 && rand.Float64()*100 < percent
+}
+
+// chaosErrorCode는 주입할 상태 코드에 맞는 에러 코드를 반환합니다.
+func chaosErrorCode(status int) string {
+	switch status {
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return apperrors.ErrCodeServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return apperrors.ErrCodeTimeout
+	case http.StatusTooManyRequests:
+		return apperrors.ErrCodeRateLimited
+	}
+	return apperrors.ErrCodeInternal
+}
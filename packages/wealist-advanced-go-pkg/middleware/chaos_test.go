@@ -0,0 +1,182 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 chaos.go의 테스트를 포함합니다.
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/gin-gonic/gin"
+)
+
+func newChaosWatcher(t *testing.T, content string) (*config.Watcher, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return config.NewWatcher(path, nil), path
+}
+
+func newChaosRouter(cfg ChaosConfig, watcher *config.Watcher, handled *int32) *gin.Engine {
+	router := gin.New()
+	router.Use(Chaos(cfg, watcher, nil))
+	handler := func(c *gin.Context) {
+		atomic.AddInt32(handled, 1)
+		c.String(http.StatusOK, "ok")
+	}
+	router.GET("/api/boards/:boardId", handler)
+	router.POST("/api/boards/:boardId", handler)
+	router.GET("/api/users/me", handler)
+	router.GET("/healthz", handler)
+	return router
+}
+
+func serveChaos(router *gin.Engine, method, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w
+}
+
+// TestChaos_ErrorInjection은 일치하는 라우트에만 에러가 주입되고 핸들러는 실행되지 않는지 테스트합니다.
+func TestChaos_ErrorInjection(t *testing.T) {
+	watcher, _ := newChaosWatcher(t, `chaos:
+  enabled: true
+  rules:
+    - route: /api/boards/*
+      method: GET
+      error_percent: 100
+      error_status: 504
+`)
+	var handled int32
+	router := newChaosRouter(ChaosConfig{Environment: "dev"}, watcher, &handled)
+
+	w := serveChaos(router, http.MethodGet, "/api/boards/b1")
+	if w.Code != http.StatusGatewayTimeout {
+		t.Errorf("예상 상태 코드: 504, 실제: %d", w.Code)
+	}
+	if got := w.Header().Get(ChaosInjectedHeader); got != "error" {
+		t.Errorf("예상 %s: error, 실제: %q", ChaosInjectedHeader, got)
+	}
+	if handled != 0 {
+		t.Errorf("에러 주입 시 핸들러가 실행되면 안 됨, 실행 횟수: %d", handled)
+	}
+
+	// method와 route가 다르면 주입하지 않음
+	for _, req := range []struct{ method, path string }{
+		{http.MethodPost, "/api/boards/b1"},
+		{http.MethodGet, "/api/users/me"},
+	} {
+		if w := serveChaos(router, req.method, req.path); w.Code != http.StatusOK {
+			t.Errorf("%s %s: 예상 상태 코드: 200, 실제: %d", req.method, req.path, w.Code)
+		}
+	}
+}
+
+// TestChaos_Latency는 지연이 주입된 뒤 핸들러가 정상 실행되는지 테스트합니다.
+func TestChaos_Latency(t *testing.T) {
+	watcher, _ := newChaosWatcher(t, `chaos:
+  enabled: true
+  rules:
+    - route: /api/boards/:boardId
+      latency: 50ms
+      latency_percent: 100
+`)
+	var handled int32
+	router := newChaosRouter(ChaosConfig{}, watcher, &handled)
+
+	start := time.Now()
+	w := serveChaos(router, http.MethodGet, "/api/boards/b1")
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("최소 50ms 지연 예상, 실제: %s", elapsed)
+	}
+	if w.Code != http.StatusOK || handled != 1 {
+		t.Errorf("지연 후 핸들러가 실행되어야 함, 상태: %d, 실행 횟수: %d", w.Code, handled)
+	}
+	if got := w.Header().Get(ChaosInjectedHeader); got != "latency" {
+		t.Errorf("예상 %s: latency, 실제: %q", ChaosInjectedHeader, got)
+	}
+}
+
+// TestChaos_DropResponse는 핸들러가 실행된 뒤 응답 없이 연결이 끊기는지 테스트합니다.
+func TestChaos_DropResponse(t *testing.T) {
+	watcher, _ := newChaosWatcher(t, `chaos:
+  enabled: true
+  rules:
+    - route: "*"
+      drop_percent: 100
+`)
+	var handled int32
+	server := httptest.NewServer(newChaosRouter(ChaosConfig{}, watcher, &handled))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/api/boards/b1", "application/json", nil)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("응답이 유실되어야 함, 실제 상태: %d", resp.StatusCode)
+	}
+	if atomic.LoadInt32(&handled) != 1 {
+		t.Errorf("응답 유실 전에 핸들러가 실행되어야 함, 실행 횟수: %d", handled)
+	}
+}
+
+// TestChaos_Disabled는 비활성화, 운영 환경, 헬스체크 경로에서 주입하지 않는지 테스트합니다.
+func TestChaos_Disabled(t *testing.T) {
+	rules := `
+  rules:
+    - route: "*"
+      error_percent: 100
+`
+	tests := []struct {
+		name     string
+		settings string
+		cfg      ChaosConfig
+		path     string
+		want     int
+	}{
+		{name: "enabled=false", settings: "chaos:\n  enabled: false" + rules, path: "/api/users/me", want: http.StatusOK},
+		{name: "production", settings: "chaos:\n  enabled: true" + rules, cfg: ChaosConfig{Environment: "production"}, path: "/api/users/me", want: http.StatusOK},
+		{name: "production allowed", settings: "chaos:\n  enabled: true" + rules, cfg: ChaosConfig{Environment: "production", AllowProduction: true}, path: "/api/users/me", want: http.StatusServiceUnavailable},
+		{name: "health check", settings: "chaos:\n  enabled: true" + rules, path: "/healthz", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher, _ := newChaosWatcher(t, tt.settings)
+			var handled int32
+			router := newChaosRouter(tt.cfg, watcher, &handled)
+			if w := serveChaos(router, http.MethodGet, tt.path); w.Code != tt.want {
+				t.Errorf("예상 상태 코드: %d, 실제: %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+// TestChaos_Reload는 런타임 설정 변경으로 재시작 없이 장애 주입을 켜고 끄는지 테스트합니다.
+func TestChaos_Reload(t *testing.T) {
+	watcher, path := newChaosWatcher(t, "chaos:\n  enabled: false\n")
+	var handled int32
+	router := newChaosRouter(ChaosConfig{}, watcher, &handled)
+
+	if w := serveChaos(router, http.MethodGet, "/api/users/me"); w.Code != http.StatusOK {
+		t.Fatalf("예상 상태 코드: 200, 실제: %d", w.Code)
+	}
+
+	content := "chaos:\n  enabled: true\n  rules:\n    - route: /api/users/me\n      error_percent: 100\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if w := serveChaos(router, http.MethodGet, "/api/users/me"); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("예상 상태 코드: 503, 실제: %d", w.Code)
+	}
+}
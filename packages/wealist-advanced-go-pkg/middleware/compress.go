@@ -0,0 +1,259 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 응답 압축(br, gzip) 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// 지원하는 Content-Encoding
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// incompressibleTypes는 이미 압축되어 있거나 스트리밍되어 압축하지 않는 Content-Type 접두사입니다.
+var incompressibleTypes = []string{
+	"image/", "video/", "audio/",
+	"application/zip", "application/gzip", "application/octet-stream", "application/grpc",
+	"text/event-stream",
+}
+
+// CompressConfig는 응답 압축 설정입니다.
+type CompressConfig struct {
+	// MinLength보다 작은 응답은 압축하지 않습니다 (기본 1KB). 작은 응답은 압축해도 이득이 거의 없습니다.
+	MinLength int
+}
+
+// DefaultCompressConfig는 기본 압축 설정을 반환합니다.
+func DefaultCompressConfig() CompressConfig {
+	return CompressConfig{MinLength: 1024}
+}
+
+var (
+	gzipWriters = sync.Pool{New: func() any {
+		w, _ := gzip.NewWriterLevel(io.Discard, gzip.DefaultCompression)
+		return w
+	}}
+	brotliWriters = sync.Pool{New: func() any {
+		// 기본값(6)보다 낮은 4: API 응답은 매번 새로 만들어지므로 압축률보다 CPU 시간이 중요
+		return brotli.NewWriterLevel(io.Discard, 4)
+	}}
+)
+
+// Compress는 Accept-Encoding에 따라 응답을 br 또는 gzip으로 압축하는 미들웨어를 반환합니다.
+//
+// 응답이 MinLength를 넘을 때만 압축하며, 이미 Content-Encoding이 있는 응답(/metrics 등),
+// WebSocket 업그레이드, SSE, 이미지/바이너리 응답은 그대로 보냅니다.
+// ETag 미들웨어보다 바깥(먼저)에 등록해야 304 응답과 ETag 계산에 영향을 주지 않습니다.
+func Compress(cfg CompressConfig) gin.HandlerFunc {
+	if cfg.MinLength <= 0 {
+		cfg.MinLength = DefaultCompressConfig().MinLength
+	}
+
+	return func(c *gin.Context) {
+		if !compressibleRequest(c.Request) {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, minLength: cfg.MinLength}
+		c.Writer = w
+		defer func() {
+			w.close()
+			c.Writer = w.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+func compressibleRequest(r *http.Request) bool {
+	if r.Method == http.MethodHead {
+		return false
+	}
+	if r.Header.Get("Upgrade") != "" {
+		return false
+	}
+	return !strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// negotiateEncoding은 Accept-Encoding에서 q 값이 가장 높은 지원 인코딩을 고릅니다. 같으면 br을 우선합니다.
+func negotiateEncoding(header string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		var candidates []string
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case encodingBrotli:
+			candidates = []string{encodingBrotli}
+		case encodingGzip:
+			candidates = []string{encodingGzip}
+		case "*":
+			candidates = []string{encodingBrotli, encodingGzip}
+		}
+		for _, candidate := range candidates {
+			if q > bestQ || (q == bestQ && q > 0 && candidate == encodingBrotli) {
+				best, bestQ = candidate, q
+			}
+		}
+	}
+	return best
+}
+
+// compressWriter는 응답 앞부분을 MinLength까지 버퍼링한 뒤 압축할지 결정하는 ResponseWriter입니다.
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding  string
+	minLength int
+
+	buf     []byte
+	decided bool
+	encoder io.WriteCloser
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		if w.encoder != nil {
+			return w.encoder.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+	if !w.compressible() {
+		if err := w.passthrough(); err != nil {
+			return 0, err
+		}
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf = append(w.buf, b...)
+	if len(w.buf) >= w.minLength {
+		if err := w.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow는 헤더를 바로 보내므로 더 이상 Content-Encoding을 붙일 수 없습니다.
+func (w *compressWriter) WriteHeaderNow() {
+	if !w.decided {
+		_ = w.passthrough()
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Flush는 스트리밍 응답을 위해 지금까지의 데이터를 보냅니다.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.passthrough()
+	}
+	if flusher, ok := w.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Written은 버퍼에만 있는 응답도 쓴 것으로 봅니다 (gin이 이후 기본 응답을 덧쓰지 않도록).
+func (w *compressWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// compressible은 응답 상태와 헤더로 압축 대상인지 판단합니다.
+func (w *compressWriter) compressible() bool {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	switch status := w.Status(); {
+	case status < http.StatusOK, status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, prefix := range incompressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// passthrough는 압축하지 않기로 하고 버퍼링한 데이터를 그대로 보냅니다.
+func (w *compressWriter) passthrough() error {
+	w.decided = true
+	if len(w.buf) == 0 {
+		return nil
+	}
+	buf := w.buf
+	w.buf = nil
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+// startCompression은 압축 헤더를 설정하고 버퍼링한 데이터부터 압축해 보냅니다.
+func (w *compressWriter) startCompression() error {
+	w.decided = true
+	header := w.Header()
+	header.Del("Content-Length")
+	header.Set("Content-Encoding", w.encoding)
+
+	switch w.encoding {
+	case encodingBrotli:
+		bw := brotliWriters.Get().(*brotli.Writer)
+		bw.Reset(w.ResponseWriter)
+		w.encoder = bw
+	default:
+		gw := gzipWriters.Get().(*gzip.Writer)
+		gw.Reset(w.ResponseWriter)
+		w.encoder = gw
+	}
+
+	buf := w.buf
+	w.buf = nil
+	_, err := w.encoder.Write(buf)
+	return err
+}
+
+// close는 압축을 마무리하거나, MinLength보다 작은 응답을 그대로 보냅니다.
+func (w *compressWriter) close() {
+	if w.encoder == nil {
+		_ = w.passthrough()
+		return
+	}
+	_ = w.encoder.Close()
+	switch encoder := w.encoder.(type) {
+	case *brotli.Writer:
+		encoder.Reset(io.Discard)
+		brotliWriters.Put(encoder)
+	case *gzip.Writer:
+		encoder.Reset(io.Discard)
+		gzipWriters.Put(encoder)
+	}
+	w.encoder = nil
+}
@@ -0,0 +1,144 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 compress.go의 테스트를 포함합니다.
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+var largeBody = strings.Repeat(`{"id":"b1","title":"Roadmap"},`, 200)
+
+func newCompressRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(Compress(DefaultCompressConfig()))
+	router.GET("/large", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", []byte(largeBody))
+	})
+	router.GET("/small", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	router.GET("/image", func(c *gin.Context) {
+		c.Data(http.StatusOK, "image/png", []byte(largeBody))
+	})
+	router.GET("/encoded", func(c *gin.Context) {
+		c.Header("Content-Encoding", "gzip")
+		c.Data(http.StatusOK, "text/plain", []byte(largeBody))
+	})
+	return router
+}
+
+func serveCompress(router *gin.Engine, path, acceptEncoding string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func decodeBody(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var reader io.Reader = w.Body
+	switch w.Header().Get("Content-Encoding") {
+	case "gzip":
+		gr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatalf("gzip 디코딩 실패: %v", err)
+		}
+		reader = gr
+	case "br":
+		reader = brotli.NewReader(w.Body)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("본문 읽기 실패: %v", err)
+	}
+	return string(body)
+}
+
+// TestCompress_Negotiation은 Accept-Encoding에 따라 br/gzip으로 압축하는지 테스트합니다.
+func TestCompress_Negotiation(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "gzip, deflate, br", want: "br"},
+		{acceptEncoding: "gzip", want: "gzip"},
+		{acceptEncoding: "br;q=0.5, gzip;q=0.8", want: "gzip"},
+		{acceptEncoding: "br;q=0, gzip", want: "gzip"},
+		{acceptEncoding: "*", want: "br"},
+		{acceptEncoding: "deflate", want: ""},
+		{acceptEncoding: "", want: ""},
+	}
+
+	router := newCompressRouter()
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			w := serveCompress(router, "/large", tt.acceptEncoding)
+			if got := w.Header().Get("Content-Encoding"); got != tt.want {
+				t.Errorf("예상 Content-Encoding: %q, 실제: %q", tt.want, got)
+			}
+			if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+				t.Errorf("예상 Vary: Accept-Encoding, 실제: %q", got)
+			}
+			if body := decodeBody(t, w); body != largeBody {
+				t.Errorf("압축 해제한 본문이 원본과 다름 (길이 %d)", len(body))
+			}
+			if tt.want != "" && w.Body.Len() >= len(largeBody) {
+				t.Errorf("압축 후 크기가 줄어야 함: %d >= %d", w.Body.Len(), len(largeBody))
+			}
+		})
+	}
+}
+
+// TestCompress_Skipped는 작은 응답, 이미지, 이미 인코딩된 응답은 압축하지 않는지 테스트합니다.
+func TestCompress_Skipped(t *testing.T) {
+	router := newCompressRouter()
+	for _, path := range []string{"/small", "/image", "/encoded"} {
+		t.Run(path, func(t *testing.T) {
+			w := serveCompress(router, path, "br, gzip")
+			if w.Code != http.StatusOK {
+				t.Fatalf("예상 상태 코드: 200, 실제: %d", w.Code)
+			}
+			if path != "/encoded" && w.Header().Get("Content-Encoding") != "" {
+				t.Errorf("압축하지 않아야 함, Content-Encoding: %q", w.Header().Get("Content-Encoding"))
+			}
+			if path == "/small" && w.Body.String() != `{"ok":true}` {
+				t.Errorf("작은 응답은 그대로 보내야 함, 실제: %q", w.Body.String())
+			}
+			if path == "/encoded" && w.Body.String() != largeBody {
+				t.Error("이미 인코딩된 응답을 다시 압축하면 안 됨")
+			}
+		})
+	}
+}
+
+// TestCompress_EventStream은 SSE 요청은 압축하지 않는지 테스트합니다.
+func TestCompress_EventStream(t *testing.T) {
+	router := gin.New()
+	router.Use(Compress(CompressConfig{MinLength: 1}))
+	router.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.String(http.StatusOK, "data: hello\n\n")
+		c.Writer.Flush()
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Accept-Encoding", "gzip")
+	router.ServeHTTP(w, req)
+
+	if w.Header().Get("Content-Encoding") != "" || w.Body.String() != "data: hello\n\n" {
+		t.Errorf("SSE는 압축하지 않아야 함, Content-Encoding: %q", w.Header().Get("Content-Encoding"))
+	}
+}
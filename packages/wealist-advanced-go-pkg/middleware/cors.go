@@ -4,7 +4,9 @@ package middleware
 
 import (
 	"strings"
+	"sync/atomic"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,8 +26,8 @@ func DefaultCORSConfig() CORSConfig {
 	return CORSConfig{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID", "X-Workspace-Id"},
-		ExposedHeaders:   []string{"X-Request-ID"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID", "X-Workspace-Id", "Idempotency-Key", "X-CSRF-Token", "If-None-Match", "If-Match"},
+		ExposedHeaders:   []string{"X-Request-ID", "Idempotent-Replayed", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           86400, // 24시간
 	}
@@ -35,39 +37,63 @@ func DefaultCORSConfig() CORSConfig {
 // config에 따라 CORS 헤더를 설정하고 preflight 요청을 처리합니다.
 func CORS(config CORSConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		origin := c.Request.Header.Get("Origin")
-
-		// origin이 허용 목록에 있는지 확인
-		allowOrigin := "*"
-		if len(config.AllowedOrigins) > 0 && config.AllowedOrigins[0] != "*" {
-			for _, allowed := range config.AllowedOrigins {
-				if allowed == origin {
-					allowOrigin = origin
-					break
-				}
-			}
-		} else if origin != "" {
-			allowOrigin = origin
-		}
+		handleCORS(c, config)
+	}
+}
 
-		// CORS 응답 헤더 설정
-		c.Header("Access-Control-Allow-Origin", allowOrigin)
-		c.Header("Access-Control-Allow-Methods", strings.Join(config.AllowedMethods, ", "))
-		c.Header("Access-Control-Allow-Headers", strings.Join(config.AllowedHeaders, ", "))
-		c.Header("Access-Control-Expose-Headers", strings.Join(config.ExposedHeaders, ", "))
+// ReloadableCORS는 watcher의 cors_origins가 바뀌면 허용 origin 목록을 재시작 없이 교체하는 CORS 미들웨어를 반환합니다.
+// cors_origins가 비어 있으면 base의 AllowedOrigins를 사용합니다. watcher가 nil이면 CORS(base)와 같습니다.
+func ReloadableCORS(base CORSConfig, watcher *config.Watcher) gin.HandlerFunc {
+	var current atomic.Pointer[CORSConfig]
+	current.Store(&base)
 
-		if config.AllowCredentials {
-			c.Header("Access-Control-Allow-Credentials", "true")
+	watcher.OnChange(func(s config.RuntimeSettings) {
+		next := base
+		if s.CORSOrigins != "" {
+			next.AllowedOrigins = splitOrigins(s.CORSOrigins)
 		}
+		current.Store(&next)
+	})
+
+	return func(c *gin.Context) {
+		handleCORS(c, *current.Load())
+	}
+}
 
-		// preflight 요청 처리 (OPTIONS 메서드)
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
-			return
+// handleCORS는 config에 따라 CORS 헤더를 설정하고 preflight 요청을 처리합니다.
+func handleCORS(c *gin.Context, config CORSConfig) {
+	origin := c.Request.Header.Get("Origin")
+
+	// origin이 허용 목록에 있는지 확인
+	allowOrigin := "*"
+	if len(config.AllowedOrigins) > 0 && config.AllowedOrigins[0] != "*" {
+		for _, allowed := range config.AllowedOrigins {
+			if allowed == origin {
+				allowOrigin = origin
+				break
+			}
 		}
+	} else if origin != "" {
+		allowOrigin = origin
+	}
+
+	// CORS 응답 헤더 설정
+	c.Header("Access-Control-Allow-Origin", allowOrigin)
+	c.Header("Access-Control-Allow-Methods", strings.Join(config.AllowedMethods, ", "))
+	c.Header("Access-Control-Allow-Headers", strings.Join(config.AllowedHeaders, ", "))
+	c.Header("Access-Control-Expose-Headers", strings.Join(config.ExposedHeaders, ", "))
 
-		c.Next()
+	if config.AllowCredentials {
+		c.Header("Access-Control-Allow-Credentials", "true")
 	}
+
+	// preflight 요청 처리 (OPTIONS 메서드)
+	if c.Request.Method == "OPTIONS" {
+		c.AbortWithStatus(204)
+		return
+	}
+
+	c.Next()
 }
 
 // DefaultCORS는 기본 설정으로 CORS 미들웨어를 반환합니다.
@@ -80,11 +106,16 @@ func DefaultCORS() gin.HandlerFunc {
 func CORSWithOrigins(origins string) gin.HandlerFunc {
 	config := DefaultCORSConfig()
 	if origins != "" && origins != "*" {
-		config.AllowedOrigins = strings.Split(origins, ",")
-		// 각 origin의 공백 제거
-		for i := range config.AllowedOrigins {
-			config.AllowedOrigins[i] = strings.TrimSpace(config.AllowedOrigins[i])
-		}
+		config.AllowedOrigins = splitOrigins(origins)
 	}
 	return CORS(config)
 }
+
+// splitOrigins는 쉼표로 구분된 origin 목록을 공백을 제거한 슬라이스로 변환합니다.
+func splitOrigins(origins string) []string {
+	list := strings.Split(origins, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+	return list
+}
@@ -5,8 +5,11 @@ package middleware
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"github.com/gin-gonic/gin"
 )
 
@@ -318,3 +321,39 @@ func TestCORS_PreflightAbort(t *testing.T) {
 		t.Error("preflight 요청에서 핸들러가 호출되면 안됨")
 	}
 }
+
+// TestReloadableCORS는 런타임 설정의 cors_origins 변경이 재시작 없이 적용되는지 테스트합니다.
+func TestReloadableCORS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	if err := os.WriteFile(path, []byte("cors_origins: http://a.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	watcher := config.NewWatcher(path, nil)
+
+	router := gin.New()
+	router.Use(ReloadableCORS(DefaultCORSConfig(), watcher))
+	router.GET("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	allowedOrigin := func(origin string) string {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Header().Get("Access-Control-Allow-Origin")
+	}
+
+	if got := allowedOrigin("http://b.com"); got == "http://b.com" {
+		t.Errorf("http://b.com은 허용되지 않아야 함, 실제: %s", got)
+	}
+
+	if err := os.WriteFile(path, []byte("cors_origins: http://a.com, http://b.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.Reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := allowedOrigin("http://b.com"); got != "http://b.com" {
+		t.Errorf("예상 Access-Control-Allow-Origin: http://b.com, 실제: %s", got)
+	}
+}
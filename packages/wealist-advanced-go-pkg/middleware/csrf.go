@@ -0,0 +1,218 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 쿠키 인증 요청을 위한 CSRF 보호 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// CSRFTokenKey는 gin 컨텍스트에 현재 CSRF 토큰을 저장하는 키입니다.
+const CSRFTokenKey = "csrf_token"
+
+// csrfTokenBytes는 CSRF 토큰의 난수 길이입니다 (base64url 인코딩 시 43자).
+const csrfTokenBytes = 32
+
+// CSRFConfig는 CSRF 보호 설정을 담는 구조체입니다.
+//
+// double-submit cookie 방식입니다. 안전한 메서드(GET 등) 응답에 토큰 쿠키를 발급하고,
+// 상태를 바꾸는 요청은 같은 값을 헤더로 보내야 합니다. 다른 사이트는 쿠키 값을 읽을 수 없으므로 헤더를 채울 수 없습니다.
+type CSRFConfig struct {
+	CookieName     string        // 토큰 쿠키 이름
+	HeaderName     string        // 토큰을 보내는 요청 헤더 이름
+	SessionCookies []string      // 쿠키 인증에 쓰이는 쿠키 이름. 이 쿠키가 없는 요청은 검사하지 않음 (비어 있으면 토큰 쿠키 외 모든 쿠키)
+	TrustedOrigins []string      // 요청 Host 외에 허용할 Origin (예: "https://ops.wealist.co.kr")
+	ExemptPaths    []string      // 검사 제외 경로 (끝의 *는 prefix 매칭, 예: "/api/auth/callback")
+	CookiePath     string        // 토큰 쿠키 Path
+	CookieDomain   string        // 토큰 쿠키 Domain (비어 있으면 host-only)
+	Secure         bool          // HTTPS에서만 쿠키 전송
+	SameSite       http.SameSite // 토큰 쿠키 SameSite
+	MaxAge         int           // 토큰 쿠키 수명(초)
+}
+
+// DefaultCSRFConfig는 기본 CSRF 설정을 반환합니다.
+// Authorization: Bearer 헤더로 인증하는 요청은 브라우저가 자동으로 붙이지 않으므로 검사하지 않습니다.
+func DefaultCSRFConfig() CSRFConfig {
+	return CSRFConfig{
+		CookieName: "wealist_csrf",
+		HeaderName: "X-CSRF-Token",
+		CookiePath: "/",
+		Secure:     true,
+		SameSite:   http.SameSiteLaxMode,
+		MaxAge:     12 * 60 * 60, // 12시간
+	}
+}
+
+// WithSessionCookies는 쿠키 인증에 쓰이는 쿠키 이름을 설정합니다.
+func (c CSRFConfig) WithSessionCookies(names ...string) CSRFConfig {
+	c.SessionCookies = names
+	return c
+}
+
+// WithTrustedOrigins는 허용할 Origin을 설정합니다.
+func (c CSRFConfig) WithTrustedOrigins(origins ...string) CSRFConfig {
+	c.TrustedOrigins = origins
+	return c
+}
+
+// WithExemptPaths는 검사 제외 경로를 설정합니다 (예: 외부 IdP가 POST하는 OAuth/SAML 콜백).
+func (c CSRFConfig) WithExemptPaths(paths ...string) CSRFConfig {
+	c.ExemptPaths = paths
+	return c
+}
+
+// CSRF는 쿠키로 인증되는 상태 변경 요청(POST, PUT, PATCH, DELETE)에 CSRF 토큰을 요구하는 미들웨어를 반환합니다.
+//
+// 다음 요청은 검사하지 않습니다.
+//   - 안전한 메서드 (GET, HEAD, OPTIONS, TRACE): 토큰 쿠키를 발급하고 X-CSRF-Token 응답 헤더로도 알려 줍니다
+//   - Authorization: Bearer 헤더가 있는 요청 (순수 Bearer 토큰 API)
+//   - SessionCookies가 없는 요청 (쿠키 인증이 아님)
+//   - ExemptPaths에 해당하는 경로
+//
+// 검사 대상 요청은 Origin(없으면 Referer)이 요청 Host 또는 TrustedOrigins여야 하고,
+// 헤더의 토큰이 쿠키의 토큰과 같아야 합니다. 실패하면 403 CSRF_TOKEN_INVALID를 반환합니다.
+func CSRF(config CSRFConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := ensureCSRFToken(c, config)
+
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+			c.Header(config.HeaderName, token)
+			c.Next()
+			return
+		}
+
+		if isBearerRequest(c) || !hasSessionCookie(c, config) || isCSRFExempt(c.Request.URL.Path, config.ExemptPaths) {
+			c.Next()
+			return
+		}
+
+		if !isTrustedOrigin(c, config.TrustedOrigins) {
+			response.Error(c, http.StatusForbidden, apperrors.ErrCodeCSRFTokenInvalid, "Cross-site request origin is not allowed")
+			c.Abort()
+			return
+		}
+
+		cookie, err := c.Cookie(config.CookieName)
+		header := c.GetHeader(config.HeaderName)
+		if err != nil || cookie == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+			response.Error(c, http.StatusForbidden, apperrors.ErrCodeCSRFTokenInvalid, "CSRF token is missing or invalid")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetCSRFToken은 CSRF 미들웨어가 설정한 현재 요청의 토큰을 반환합니다.
+func GetCSRFToken(c *gin.Context) string {
+	return c.GetString(CSRFTokenKey)
+}
+
+// ensureCSRFToken은 요청의 토큰 쿠키를 재사용하거나 새 토큰을 발급합니다.
+func ensureCSRFToken(c *gin.Context, config CSRFConfig) string {
+	token, err := c.Cookie(config.CookieName)
+	if err != nil || !validCSRFToken(token) {
+		token = newCSRFToken()
+		http.SetCookie(c.Writer, &http.Cookie{
+			Name:     config.CookieName,
+			Value:    token,
+			Path:     config.CookiePath,
+			Domain:   config.CookieDomain,
+			MaxAge:   config.MaxAge,
+			Secure:   config.Secure,
+			HttpOnly: false, // SPA가 읽어서 헤더로 보내야 함
+			SameSite: config.SameSite,
+		})
+	}
+	c.Set(CSRFTokenKey, token)
+	return token
+}
+
+func newCSRFToken() string {
+	b := make([]byte, csrfTokenBytes)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func validCSRFToken(token string) bool {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(b) == csrfTokenBytes
+}
+
+func isBearerRequest(c *gin.Context) bool {
+	auth := c.GetHeader("Authorization")
+	return len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ")
+}
+
+// hasSessionCookie는 요청이 쿠키로 인증될 수 있는지 확인합니다.
+func hasSessionCookie(c *gin.Context, config CSRFConfig) bool {
+	for _, cookie := range c.Request.Cookies() {
+		if len(config.SessionCookies) == 0 {
+			if cookie.Name != config.CookieName {
+				return true
+			}
+			continue
+		}
+		for _, name := range config.SessionCookies {
+			if cookie.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isCSRFExempt(path string, exemptPaths []string) bool {
+	for _, pattern := range exemptPaths {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		} else if path == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// isTrustedOrigin은 Origin(없으면 Referer)이 요청 Host 또는 허용 목록과 같은지 확인합니다.
+// 둘 다 없으면 브라우저가 아닌 클라이언트로 보고 토큰 검사만 수행합니다.
+func isTrustedOrigin(c *gin.Context, trusted []string) bool {
+	origin := c.GetHeader("Origin")
+	if origin == "" {
+		referer := c.GetHeader("Referer")
+		if referer == "" {
+			return true
+		}
+		u, err := url.Parse(referer)
+		if err != nil || u.Host == "" {
+			return false
+		}
+		origin = u.Scheme + "://" + u.Host
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false // "null" 등
+	}
+	if strings.EqualFold(u.Host, c.Request.Host) {
+		return true
+	}
+	for _, allowed := range trusted {
+		if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
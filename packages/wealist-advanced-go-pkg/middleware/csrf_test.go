@@ -0,0 +1,152 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 csrf.go의 테스트를 포함합니다.
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newCSRFRouter(config CSRFConfig) *gin.Engine {
+	r := gin.New()
+	r.Use(CSRF(config))
+	r.GET("/api/me", func(c *gin.Context) { c.String(http.StatusOK, GetCSRFToken(c)) })
+	r.POST("/api/items", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	r.POST("/api/auth/callback", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+// issueCSRFToken은 GET 요청으로 토큰 쿠키를 발급받습니다.
+func issueCSRFToken(t *testing.T, r *gin.Engine) *http.Cookie {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/me", nil))
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == "wealist_csrf" {
+			if w.Header().Get("X-CSRF-Token") != cookie.Value || w.Body.String() != cookie.Value {
+				t.Fatalf("토큰 헤더/컨텍스트는 쿠키와 같아야 함: %q %q %q", w.Header().Get("X-CSRF-Token"), w.Body.String(), cookie.Value)
+			}
+			if cookie.HttpOnly || !cookie.Secure || cookie.SameSite != http.SameSiteLaxMode {
+				t.Errorf("예상하지 않은 쿠키 속성: %+v", cookie)
+			}
+			return cookie
+		}
+	}
+	t.Fatal("CSRF 토큰 쿠키가 발급되지 않음")
+	return nil
+}
+
+// TestCSRF는 쿠키 인증 요청의 CSRF 검사와 예외를 테스트합니다.
+func TestCSRF(t *testing.T) {
+	config := DefaultCSRFConfig().
+		WithSessionCookies("SESSION").
+		WithTrustedOrigins("https://ops.wealist.co.kr").
+		WithExemptPaths("/api/auth/*")
+	r := newCSRFRouter(config)
+	token := issueCSRFToken(t, r)
+
+	tests := []struct {
+		name           string
+		path           string
+		cookies        []*http.Cookie
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			name:           "세션 쿠키와 일치하는 토큰",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}, token},
+			headers:        map[string]string{"X-CSRF-Token": token.Value, "Origin": "https://ops.wealist.co.kr"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "토큰 헤더 없음",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}, token},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "토큰 불일치",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}, token},
+			headers:        map[string]string{"X-CSRF-Token": strings.Repeat("a", 43)},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "다른 사이트 Origin",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}, token},
+			headers:        map[string]string{"X-CSRF-Token": token.Value, "Origin": "https://evil.example.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "같은 Host의 Referer",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}, token},
+			headers:        map[string]string{"X-CSRF-Token": token.Value, "Referer": "https://example.com/admin"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Bearer 토큰 API는 검사하지 않음",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}},
+			headers:        map[string]string{"Authorization": "Bearer abc"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "세션 쿠키가 없으면 검사하지 않음",
+			cookies:        []*http.Cookie{{Name: "theme", Value: "dark"}},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "제외 경로",
+			path:           "/api/auth/callback",
+			cookies:        []*http.Cookie{{Name: "SESSION", Value: "s"}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if path == "" {
+				path = "/api/items"
+			}
+			req := httptest.NewRequest(http.MethodPost, path, nil) // Host: example.com
+			for _, cookie := range tt.cookies {
+				req.AddCookie(cookie)
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("예상 상태 코드: %d, 실제: %d (%s)", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code == http.StatusForbidden && !strings.Contains(w.Body.String(), "CSRF_TOKEN_INVALID") {
+				t.Errorf("CSRF_TOKEN_INVALID 에러 코드가 필요함: %s", w.Body.String())
+			}
+		})
+	}
+}
+
+// TestCSRF_AnyCookieWithoutSessionCookies는 SessionCookies가 비어 있으면 토큰 쿠키 외 모든 쿠키를 세션으로 보는지 테스트합니다.
+func TestCSRF_AnyCookieWithoutSessionCookies(t *testing.T) {
+	r := newCSRFRouter(DefaultCSRFConfig())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/items", nil)
+	req.AddCookie(&http.Cookie{Name: "wealist_csrf", Value: newCSRFToken()})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Errorf("토큰 쿠키만 있으면 쿠키 인증이 아님: %d", w.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/items", nil)
+	req.AddCookie(&http.Cookie{Name: "JSESSIONID", Value: "s"})
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("다른 쿠키가 있으면 토큰이 필요함: %d", w.Code)
+	}
+}
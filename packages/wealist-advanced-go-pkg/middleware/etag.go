@@ -0,0 +1,109 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 ETag/If-None-Match 조건부 요청 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag는 GET 응답 본문의 해시로 약한 ETag를 붙이고, If-None-Match가 일치하면
+// 본문 없이 304 Not Modified로 응답하는 미들웨어를 반환합니다.
+// 클라이언트는 목록을 주기적으로 다시 읽을 때 변경이 없으면 본문을 받지 않습니다.
+//
+// 응답을 모두 버퍼링한 뒤 보내므로 스트리밍이 아닌 목록 조회 라우트에만 겁니다.
+//
+//	boards.GET("/project/:projectId", commonmw.ETag(), boardHandler.GetBoardsByProject)
+//
+// 핸들러는 그대로 실행되므로 DB 조회 비용은 줄지 않고 전송량만 줄어듭니다.
+// 압축된 표현도 같은 ETag를 쓰도록 약한 ETag(W/"...")를 사용하며,
+// 요청마다 다른 응답 본문의 requestId는 해시에서 제외합니다.
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		requestID := ensureRequestID(c)
+		recorder := &etagRecorder{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = recorder.ResponseWriter
+
+		header := c.Writer.Header()
+		if recorder.status != http.StatusOK || header.Get("ETag") != "" {
+			recorder.flush()
+			return
+		}
+
+		sum := sha256.Sum256(bytes.ReplaceAll(recorder.body, []byte(requestID), nil))
+		etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+		header.Set("ETag", etag)
+		if header.Get("Cache-Control") == "" {
+			// 인증된 응답이므로 공유 캐시에는 저장하지 않고, 매번 ETag로 재검증
+			header.Set("Cache-Control", "private, no-cache")
+		}
+
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			header.Del("Content-Length")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		recorder.flush()
+	}
+}
+
+// etagMatches는 If-None-Match 목록에 etag가 있는지 약한 비교로 확인합니다.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	target := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == target {
+			return true
+		}
+	}
+	return false
+}
+
+// etagRecorder는 응답 상태와 본문을 기록만 하고 보내지 않는 ResponseWriter입니다.
+type etagRecorder struct {
+	gin.ResponseWriter
+	status int
+	body   []byte
+}
+
+func (r *etagRecorder) WriteHeader(code int) { r.status = code }
+func (r *etagRecorder) WriteHeaderNow()      {}
+func (r *etagRecorder) Status() int          { return r.status }
+func (r *etagRecorder) Size() int            { return len(r.body) }
+func (r *etagRecorder) Written() bool        { return len(r.body) > 0 }
+
+func (r *etagRecorder) Write(b []byte) (int, error) {
+	r.body = append(r.body, b...)
+	return len(b), nil
+}
+
+func (r *etagRecorder) WriteString(s string) (int, error) {
+	r.body = append(r.body, s...)
+	return len(s), nil
+}
+
+// flush는 기록한 응답을 그대로 보냅니다.
+func (r *etagRecorder) flush() {
+	r.ResponseWriter.WriteHeader(r.status)
+	if len(r.body) == 0 {
+		r.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	_, _ = r.ResponseWriter.Write(r.body)
+}
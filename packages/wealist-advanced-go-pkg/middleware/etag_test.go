@@ -0,0 +1,106 @@
+// Package middleware는 HTTP 미들웨어를 제공합니다.
+// 이 파일은 etag.go의 테스트를 포함합니다.
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+func newETagRouter(boards *string) *gin.Engine {
+	router := gin.New()
+	router.Use(Compress(DefaultCompressConfig()))
+	router.GET("/boards", ETag(), func(c *gin.Context) {
+		response.OK(c, json.RawMessage(*boards))
+	})
+	router.GET("/missing", ETag(), func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+	return router
+}
+
+func serveETag(router *gin.Engine, path, ifNoneMatch string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestETag_NotModified는 If-None-Match가 일치하면 본문 없이 304로 응답하는지 테스트합니다.
+func TestETag_NotModified(t *testing.T) {
+	boards := "[" + strings.TrimSuffix(largeBody, ",") + "]"
+	router := newETagRouter(&boards)
+
+	first := serveETag(router, "/boards", "")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" {
+		t.Fatalf("ETag가 있는 200 응답 예상, 상태: %d, ETag: %q", first.Code, etag)
+	}
+	if first.Header().Get("Content-Encoding") != "gzip" {
+		t.Errorf("ETag 응답도 압축되어야 함")
+	}
+	if got := first.Header().Get("Cache-Control"); got != "private, no-cache" {
+		t.Errorf("예상 Cache-Control: private, no-cache, 실제: %q", got)
+	}
+
+	second := serveETag(router, "/boards", etag)
+	if second.Code != http.StatusNotModified {
+		t.Fatalf("예상 상태 코드: 304, 실제: %d", second.Code)
+	}
+	if second.Body.Len() != 0 || second.Header().Get("Content-Encoding") != "" {
+		t.Errorf("304 응답에는 본문이 없어야 함, 길이: %d", second.Body.Len())
+	}
+
+	// 목록이 바뀌면 새 ETag와 본문을 보냄
+	boards = `[{"id":"b2"}]`
+	third := serveETag(router, "/boards", etag)
+	if third.Code != http.StatusOK || third.Header().Get("ETag") == etag {
+		t.Errorf("변경된 목록은 새 ETag로 200 응답 예상, 상태: %d", third.Code)
+	}
+	if !strings.Contains(third.Body.String(), boards) {
+		t.Errorf("새 목록이 본문에 있어야 함, 실제: %s", third.Body.String())
+	}
+}
+
+// TestETag_ErrorResponse는 200이 아닌 응답에는 ETag를 붙이지 않는지 테스트합니다.
+func TestETag_ErrorResponse(t *testing.T) {
+	boards := ""
+	w := serveETag(newETagRouter(&boards), "/missing", "*")
+	if w.Code != http.StatusNotFound || w.Header().Get("ETag") != "" {
+		t.Errorf("ETag 없는 404 응답 예상, 상태: %d, ETag: %q", w.Code, w.Header().Get("ETag"))
+	}
+	if w.Body.String() != `{"error":"not found"}` {
+		t.Errorf("에러 본문이 그대로 전달되어야 함, 실제: %q", w.Body.String())
+	}
+}
+
+// TestETagMatches는 If-None-Match 약한 비교를 테스트합니다.
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{header: `W/"abc"`, want: true},
+		{header: `"abc"`, want: true},
+		{header: `"xyz", W/"abc"`, want: true},
+		{header: `*`, want: true},
+		{header: `"xyz"`, want: false},
+		{header: ``, want: false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, `W/"abc"`); got != tt.want {
+			t.Errorf("etagMatches(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
@@ -17,18 +17,58 @@ import (
 // RequestIDKey는 컨텍스트에서 요청 ID를 저장/조회하기 위한 키입니다.
 const RequestIDKey = "request_id"
 
+// LoggerKey는 컨텍스트에 요청 로거를 저장하는 키입니다.
+// 서비스 핸들러의 getLogger가 이 로거에 trace context와 요청 ID를 붙여 사용합니다.
+const LoggerKey = "logger"
+
+// RequestID는 요청 ID를 정하고 전파하는 미들웨어를 반환합니다.
+// 클라이언트나 상위 서비스가 보낸 X-Request-ID가 유효하면 그대로 사용하고, 없으면 새로 생성합니다.
+// ID는 응답 헤더, gin 컨텍스트(RequestIDKey), request context(requestid.FromContext)에 설정되어
+// 에러 응답과 내부 서비스 호출에 같은 값이 실립니다.
+// Logger와 LoggerWithTracing도 같은 처리를 하므로 로깅 미들웨어를 쓰지 않는 라우터에서만 필요합니다.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ensureRequestID(c)
+		c.Next()
+	}
+}
+
+// ensureRequestID는 요청 ID가 아직 정해지지 않았으면 정하고, 정해진 ID를 반환합니다.
+func ensureRequestID(c *gin.Context) string {
+	if value, exists := c.Get(RequestIDKey); exists {
+		if id, ok := value.(string); ok && id != "" {
+			return id
+		}
+	}
+
+	id := requestid.Sanitize(c.GetHeader(requestid.Header))
+	if id == "" {
+		id = requestid.New()
+	}
+	c.Set(RequestIDKey, id)
+	c.Header(requestid.Header, id)
+	c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), id))
+	return id
+}
+
+// setRequestLogger는 핸들러가 getLogger로 꺼내 쓸 로거를 설정합니다. 이미 설정되어 있으면 유지합니다.
+func setRequestLogger(c *gin.Context, zapLogger *zap.Logger) {
+	if _, exists := c.Get(LoggerKey); !exists {
+		c.Set(LoggerKey, zapLogger)
+	}
+}
+
 // Logger는 HTTP 요청을 구조화된 로그로 기록하는 미들웨어를 반환합니다.
-// 각 요청에 대해 UUID 기반 request_id를 생성하고, 요청/응답 정보를 로깅합니다.
+// 각 요청의 request_id를 정하고(RequestID 참고), 요청/응답 정보를 로깅합니다.
 // 상태 코드에 따라 로그 레벨이 결정됩니다:
 //   - 5xx: Error
 //   - 4xx: Warn
 //   - 그 외: Info
 func Logger(logger *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 요청 ID 생성 및 설정
-		requestID := uuid.New().String()
-		c.Set(RequestIDKey, requestID)
-		c.Header("X-Request-ID", requestID)
+		// 요청 ID 설정 (수신한 X-Request-ID가 있으면 재사용)
+		requestID := ensureRequestID(c)
+		setRequestLogger(c, logger)
 
 		// 타이머 시작
 		start := time.Now()
@@ -100,10 +140,9 @@ func LoggerWithTracing(zapLogger *zap.Logger, serviceName string) gin.HandlerFun
 		// context를 request에 설정
 		c.Request = c.Request.WithContext(ctx)
 
-		// 요청 ID 생성 및 설정
-		requestID := uuid.New().String()
-		c.Set(RequestIDKey, requestID)
-		c.Header("X-Request-ID", requestID)
+		// 요청 ID 설정 (수신한 X-Request-ID가 있으면 재사용)
+		requestID := ensureRequestID(c)
+		setRequestLogger(c, zapLogger)
 
 		// 타이머 시작
 		start := time.Now()
@@ -217,5 +256,5 @@ func GetRequestID(c *gin.Context) string {
 			return id
 		}
 	}
-	return uuid.New().String()
+	return requestid.New()
 }
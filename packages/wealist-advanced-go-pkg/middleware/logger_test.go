@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -296,3 +297,57 @@ func TestRequestIDKey(t *testing.T) {
 		t.Errorf("RequestIDKey가 'request_id'여야 함, 실제: '%s'", RequestIDKey)
 	}
 }
+
+// TestLoggerWithTracing_PropagatesIncomingRequestID는 수신한 X-Request-ID를 재사용하고
+// request context와 요청 로거에 전달하는지 테스트합니다.
+func TestLoggerWithTracing_PropagatesIncomingRequestID(t *testing.T) {
+	core, recorded := observer.New(zapcore.InfoLevel)
+	zapLogger := zap.New(core)
+
+	var ctxRequestID string
+	var requestLogger any
+
+	router := gin.New()
+	router.Use(LoggerWithTracing(zapLogger, "test-service"))
+	router.GET("/test", func(c *gin.Context) {
+		ctxRequestID = requestid.FromContext(c.Request.Context())
+		requestLogger, _ = c.Get(LoggerKey)
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/test", nil)
+	req.Header.Set(requestid.Header, "upstream-request-id")
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get(requestid.Header); got != "upstream-request-id" {
+		t.Errorf("응답 헤더에 수신한 request_id가 있어야 함, 실제: '%s'", got)
+	}
+	if ctxRequestID != "upstream-request-id" {
+		t.Errorf("request context에 request_id가 있어야 함, 실제: '%s'", ctxRequestID)
+	}
+	if requestLogger != zapLogger {
+		t.Error("컨텍스트에 요청 로거가 설정되어야 함")
+	}
+	if got := recorded.All()[0].ContextMap()["http.request_id"]; got != "upstream-request-id" {
+		t.Errorf("로그에 수신한 request_id가 기록되어야 함, 실제: '%v'", got)
+	}
+}
+
+// TestRequestID_RejectsInvalidHeader는 유효하지 않은 X-Request-ID 대신 새 ID를 생성하는지 테스트합니다.
+func TestRequestID_RejectsInvalidHeader(t *testing.T) {
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/test", nil)
+	req.Header.Set(requestid.Header, "bad id\twith spaces")
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get(requestid.Header); len(got) != 36 {
+		t.Errorf("새 UUID request_id가 생성되어야 함, 실제: '%s'", got)
+	}
+}
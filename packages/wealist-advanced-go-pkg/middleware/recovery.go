@@ -17,8 +17,8 @@ func Recovery(logger *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				// 요청 ID 가져오기 (없으면 새로 생성)
-				requestID := GetRequestID(c)
+				// 요청 ID 가져오기 (로깅 미들웨어 전에 panic이 나도 응답 헤더와 본문의 ID가 같도록 설정)
+				requestID := ensureRequestID(c)
 
 				// panic 로깅 (스택 트레이스 포함)
 				logger.Error("Panic recovered",
@@ -1,11 +1,16 @@
 // Package otel provides OpenTelemetry integration utilities.
-// This file contains GORM database tracing helpers.
+// This file contains GORM database tracing and metrics helpers.
 package otel
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 	"gorm.io/plugin/opentelemetry/tracing"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 )
 
 // GORMTracingConfig holds configuration options for GORM tracing.
@@ -60,3 +65,92 @@ func EnableGORMTracingWithConfig(db *gorm.DB, cfg *GORMTracingConfig) error {
 
 	return db.Use(tracing.NewPlugin(opts...))
 }
+
+// gormMetricsStartKey is the statement instance key holding the query start time.
+const gormMetricsStartKey = "wealist:metrics:start"
+
+// EnableGORMMetrics records the duration and errors of every GORM operation
+// in m's db_query_duration_seconds and db_query_errors_total metrics, labelled by
+// operation and table, with the trace of the calling request attached as an exemplar.
+// gorm.ErrRecordNotFound is not counted as an error.
+//
+// Example:
+//
+//	if err := otel.EnableGORMMetrics(db, m.Metrics); err != nil {
+//	    log.Warn("Failed to enable GORM metrics", zap.Error(err))
+//	}
+func EnableGORMMetrics(db *gorm.DB, m *commonmetrics.Metrics) error {
+	if db == nil || m == nil {
+		return nil
+	}
+
+	before := func(tx *gorm.DB) {
+		tx.InstanceSet(gormMetricsStartKey, time.Now())
+	}
+	after := func(operation func(tx *gorm.DB) string) func(tx *gorm.DB) {
+		return func(tx *gorm.DB) {
+			value, ok := tx.InstanceGet(gormMetricsStartKey)
+			if !ok {
+				return
+			}
+			start, ok := value.(time.Time)
+			if !ok {
+				return
+			}
+
+			err := tx.Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				err = nil
+			}
+			table := tx.Statement.Table
+			if table == "" {
+				table = "unknown"
+			}
+			m.RecordDBQueryWithExemplar(operation(tx), table, time.Since(start), err, ExemplarFromContext(tx.Statement.Context))
+		}
+	}
+	fixed := func(operation string) func(tx *gorm.DB) string {
+		return func(*gorm.DB) string { return operation }
+	}
+	inferred := func(tx *gorm.DB) string {
+		return commonmetrics.InferOperationFromSQL(tx.Statement.SQL.String())
+	}
+
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("metrics:before_create", before),
+		cb.Create().After("gorm:create").Register("metrics:after_create", after(fixed(commonmetrics.DBOpInsert))),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", before),
+		cb.Query().After("gorm:query").Register("metrics:after_query", after(fixed(commonmetrics.DBOpSelect))),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", before),
+		cb.Update().After("gorm:update").Register("metrics:after_update", after(fixed(commonmetrics.DBOpUpdate))),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", before),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", after(fixed(commonmetrics.DBOpDelete))),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", before),
+		cb.Row().After("gorm:row").Register("metrics:after_row", after(inferred)),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", before),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", after(inferred)),
+	)
+}
+
+// EnableGORMPoolMetrics samples db's connection pool (sql.DBStats: open, in use, idle,
+// wait count and wait duration) into m's db_connections_* and db_connection_wait_* metrics
+// every commonmetrics.DefaultDBStatsInterval for the life of the process.
+//
+// Example:
+//
+//	if err := otel.EnableGORMPoolMetrics(db, m.Metrics); err != nil {
+//	    log.Warn("Failed to enable DB pool metrics", zap.Error(err))
+//	}
+func EnableGORMPoolMetrics(db *gorm.DB, m *commonmetrics.Metrics) error {
+	if db == nil || m == nil {
+		return nil
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	m.StartDBStatsCollector(sqlDB, 0)
+	return nil
+}
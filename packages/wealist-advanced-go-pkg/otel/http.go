@@ -0,0 +1,91 @@
+// Package otel provides OpenTelemetry integration utilities.
+// This file contains HTTP client tracing helpers.
+package otel
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const httpClientTracerName = "github.com/OrangesCloud/wealist-advanced-go-pkg/otel/http"
+
+// Transport is an http.RoundTripper that creates a client span for each request
+// and injects the W3C trace context (traceparent, baggage) into the request headers,
+// so the receiving service's otelgin middleware joins the same trace.
+// The request ID in the request context is forwarded as X-Request-ID.
+type Transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base with client tracing. If base is nil, http.DefaultTransport is used.
+// Use only for internal service-to-service calls; external endpoints should not receive trace headers.
+//
+// Example:
+//
+//	client := &http.Client{Timeout: 5 * time.Second, Transport: otel.NewTransport(nil)}
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{base: base}
+}
+
+// NewHTTPClient returns an http.Client with the given timeout and a tracing transport.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: NewTransport(nil),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+// The global tracer provider and propagator are resolved per request,
+// so clients created before InitProvider are still traced.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tracer := otel.GetTracerProvider().Tracer(httpClientTracerName)
+	ctx, span := tracer.Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(redactedURL(req)),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+	defer span.End()
+
+	// RoundTripper must not modify the caller's request
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	if id := requestid.FromContext(ctx); id != "" && req.Header.Get(requestid.Header) == "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// redactedURL removes the query string and credentials, which may contain tokens or personal data.
+func redactedURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
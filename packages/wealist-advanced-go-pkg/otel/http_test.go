@@ -0,0 +1,88 @@
+package otel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTransport_PropagatesTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(newPropagator())
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+
+	var received trace.SpanContext
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		received = trace.SpanContextFromContext(ctx)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx, parent := provider.Tracer("test").Start(t.Context(), "parent")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/users/1?token=secret", nil)
+	resp, err := NewHTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	parent.End()
+
+	if req.Header.Get("traceparent") != "" {
+		t.Error("expected caller's request headers to be left unchanged")
+	}
+	if received.TraceID() != parent.SpanContext().TraceID() {
+		t.Errorf("expected trace %s to be propagated, got %s", parent.SpanContext().TraceID(), received.TraceID())
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected client and parent spans, got %d", len(spans))
+	}
+	client := spans[0]
+	if client.SpanKind() != trace.SpanKindClient || client.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("unexpected client span: kind=%v parent=%s", client.SpanKind(), client.Parent().SpanID())
+	}
+	if received.SpanID() != client.SpanContext().SpanID() {
+		t.Errorf("expected server to see client span as parent")
+	}
+	for _, attr := range client.Attributes() {
+		if attr.Key == "url.full" && attr.Value.AsString() != server.URL+"/api/users/1" {
+			t.Errorf("expected query string to be redacted, got %s", attr.Value.AsString())
+		}
+	}
+}
+
+func TestTransport_ForwardsRequestID(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(requestid.Header)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx := requestid.NewContext(t.Context(), "req-123")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := NewHTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if received != "req-123" {
+		t.Errorf("expected X-Request-ID req-123, got %q", received)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
@@ -28,7 +30,8 @@ func TraceFields(ctx context.Context) []zap.Field {
 	}
 }
 
-// WithTraceContext creates a new logger with trace context fields.
+// WithTraceContext creates a new logger with trace context fields
+// and the request ID (http.request_id) when ctx carries one.
 // This is the primary way to add trace correlation to logs.
 //
 // Example:
@@ -37,12 +40,15 @@ func TraceFields(ctx context.Context) []zap.Field {
 //	    logger := otel.WithTraceContext(c.Request.Context(), h.logger)
 //	    logger.Info("Creating board")
 //	}
-func WithTraceContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
+func WithTraceContext(ctx context.Context, log *zap.Logger) *zap.Logger {
 	fields := TraceFields(ctx)
+	if id := requestid.FromContext(ctx); id != "" {
+		fields = append(fields, zap.String(logger.FieldHTTPRequestID, id))
+	}
 	if len(fields) == 0 {
-		return logger
+		return log
 	}
-	return logger.With(fields...)
+	return log.With(fields...)
 }
 
 // InjectTraceHeaders injects trace context headers into an HTTP request.
@@ -0,0 +1,70 @@
+// Package otel provides OpenTelemetry integration utilities.
+// This file contains Prometheus RED metrics helpers correlated with traces.
+package otel
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
+)
+
+// ExemplarTraceIDLabel is the exemplar label holding the trace ID.
+// Grafana links exemplars to Tempo through this label.
+const ExemplarTraceIDLabel = "trace_id"
+
+// ExemplarFromContext returns exemplar labels linking a metric sample to the current trace,
+// or nil when ctx has no sampled span (unsampled trace IDs would link to missing traces).
+func ExemplarFromContext(ctx context.Context) prometheus.Labels {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() || !spanCtx.IsSampled() {
+		return nil
+	}
+	return prometheus.Labels{ExemplarTraceIDLabel: spanCtx.TraceID().String()}
+}
+
+// MetricsMiddleware records RED metrics (request rate, errors by status class, duration),
+// in-flight requests and response sizes for every request, labelled by route pattern
+// and linked to the request trace via exemplars.
+// Register it after the tracing middleware so the span is already in the request context.
+// basePath is the service base path (e.g. "/api/boards"); health and metrics endpoints are skipped.
+//
+// Example:
+//
+//	router.Use(middleware.OTELTracing("board-service"))
+//	router.Use(otel.MetricsMiddleware(m.Metrics, "/api/boards"))
+func MetricsMiddleware(m *commonmetrics.Metrics, basePath string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if m == nil || commonmetrics.ShouldSkipEndpointWithBasePath(c.Request.URL.Path, basePath) {
+			c.Next()
+			return
+		}
+
+		// WebSocket 연결은 연결이 끝날 때까지 핸들러가 반환되지 않으므로 in-flight에서 제외합니다.
+		if !c.IsWebsocket() {
+			m.HTTPRequestsInFlight.Inc()
+			defer m.HTTPRequestsInFlight.Dec()
+		}
+
+		start := time.Now()
+		c.Next()
+
+		// 실제 경로가 아닌 라우트 패턴 사용 (카디널리티 제한)
+		endpoint := c.FullPath()
+		if endpoint == "" {
+			endpoint = commonmetrics.UnmatchedEndpoint
+		}
+		m.RecordHTTPRequestWithExemplar(
+			c.Request.Method,
+			endpoint,
+			c.Writer.Status(),
+			time.Since(start),
+			ExemplarFromContext(c.Request.Context()),
+		)
+		m.RecordHTTPResponseSize(c.Request.Method, endpoint, c.Writer.Size())
+	}
+}
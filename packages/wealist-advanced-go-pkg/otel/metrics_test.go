@@ -0,0 +1,126 @@
+package otel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
+)
+
+func TestExemplarFromContext(t *testing.T) {
+	if got := ExemplarFromContext(t.Context()); got != nil {
+		t.Errorf("expected no exemplar without span, got %v", got)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	ctx, span := provider.Tracer("test").Start(t.Context(), "request")
+	defer span.End()
+
+	got := ExemplarFromContext(ctx)
+	if got[ExemplarTraceIDLabel] != span.SpanContext().TraceID().String() {
+		t.Errorf("expected trace_id exemplar, got %v", got)
+	}
+
+	unsampled := trace.ContextWithSpanContext(t.Context(), span.SpanContext().WithTraceFlags(0))
+	if got := ExemplarFromContext(unsampled); got != nil {
+		t.Errorf("expected no exemplar for unsampled span, got %v", got)
+	}
+}
+
+func TestMetricsMiddleware_RecordsRouteAndSkipsHealth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	registry := prometheus.NewRegistry()
+	m := commonmetrics.New(&commonmetrics.Config{Namespace: "test_service", Registry: registry})
+
+	router := gin.New()
+	router.Use(MetricsMiddleware(m, "/api/boards"))
+	router.GET("/api/boards/:id", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	router.GET("/api/boards/health/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for _, path := range []string{"/api/boards/123", "/api/boards/health/live"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "test_service_http_requests_total" {
+			continue
+		}
+		if len(family.GetMetric()) != 1 {
+			t.Fatalf("expected health endpoint to be skipped, got %d series", len(family.GetMetric()))
+		}
+		labels := map[string]string{}
+		for _, label := range family.GetMetric()[0].GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		if labels["endpoint"] != "/api/boards/:id" || labels["status"] != "4xx" {
+			t.Errorf("expected route pattern and 4xx status, got %v", labels)
+		}
+		return
+	}
+	t.Fatal("expected http_requests_total to be recorded")
+}
+
+func TestMetricsMiddleware_ResponseSizeInFlightAndUnmatched(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	registry := prometheus.NewRegistry()
+	m := commonmetrics.New(&commonmetrics.Config{Namespace: "test_service", Registry: registry})
+
+	var inFlight float64
+	router := gin.New()
+	router.Use(MetricsMiddleware(m, ""))
+	router.GET("/api/chats/:chatId", func(c *gin.Context) {
+		inFlight = gaugeValue(t, m.HTTPRequestsInFlight)
+		c.String(http.StatusOK, "hello")
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/chats/42", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/wp-login.php", nil))
+
+	if inFlight != 1 {
+		t.Errorf("expected one in-flight request during handling, got %v", inFlight)
+	}
+	if got := gaugeValue(t, m.HTTPRequestsInFlight); got != 0 {
+		t.Errorf("expected no in-flight requests after handling, got %v", got)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	endpoints := map[string]uint64{}
+	for _, family := range families {
+		if family.GetName() != "test_service_http_response_size_bytes" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "endpoint" {
+					endpoints[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	if endpoints["/api/chats/:chatId"] != 1 || endpoints[commonmetrics.UnmatchedEndpoint] != 1 || len(endpoints) != 2 {
+		t.Errorf("expected response sizes for route pattern and unmatched only, got %v", endpoints)
+	}
+}
+
+func gaugeValue(t *testing.T, gauge prometheus.Gauge) float64 {
+	t.Helper()
+	var metric dto.Metric
+	if err := gauge.Write(&metric); err != nil {
+		t.Fatalf("write gauge: %v", err)
+	}
+	return metric.GetGauge().GetValue()
+}
@@ -1,10 +1,17 @@
 // Package otel provides OpenTelemetry integration utilities.
-// This file contains Redis tracing helpers.
+// This file contains Redis tracing and metrics helpers.
 package otel
 
 import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
 	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 )
 
 // RedisTracingConfig holds configuration options for Redis tracing.
@@ -51,3 +58,50 @@ func EnableRedisTracingWithConfig(client *redis.Client, cfg *RedisTracingConfig)
 func EnableRedisClusterTracing(client *redis.ClusterClient) error {
 	return redisotel.InstrumentTracing(client)
 }
+
+// EnableRedisMetrics records the duration and errors of every Redis command
+// in m's redis_command_duration_seconds and redis_command_errors_total metrics,
+// with the trace of the calling request attached as an exemplar.
+// redis.Nil (key not found) is not counted as an error.
+func EnableRedisMetrics(client *redis.Client, m *commonmetrics.Metrics) {
+	if client == nil || m == nil {
+		return
+	}
+	client.AddHook(redisMetricsHook{metrics: m})
+}
+
+// redisMetricsHook implements redis.Hook.
+type redisMetricsHook struct {
+	metrics *commonmetrics.Metrics
+}
+
+func (h redisMetricsHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (h redisMetricsHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		h.metrics.RecordRedisCommandWithExemplar(cmd.Name(), time.Since(start), redisError(err), ExemplarFromContext(ctx))
+		return err
+	}
+}
+
+func (h redisMetricsHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		h.metrics.RecordRedisCommandWithExemplar("pipeline", time.Since(start), redisError(err), ExemplarFromContext(ctx))
+		return err
+	}
+}
+
+func redisError(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
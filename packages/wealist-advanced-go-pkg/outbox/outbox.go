@@ -0,0 +1,116 @@
+// Package outbox는 트랜잭셔널 아웃박스 패턴을 제공합니다.
+// 이벤트를 비즈니스 데이터와 같은 DB 트랜잭션에서 outbox_messages 테이블에 기록하고,
+// Relay가 이를 이벤트 버스(JetStream)로 발행합니다. 커밋된 변경의 이벤트는 유실되지 않으며,
+// 롤백된 변경의 이벤트는 발행되지 않습니다.
+//
+// 발행 후 완료 표시 전에 프로세스가 죽으면 같은 메시지가 다시 발행될 수 있지만,
+// 메시지 ID(Nats-Msg-Id)가 행 ID로 고정되어 있어 스트림의 중복 제거 구간 안에서는 한 번만 저장됩니다.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+)
+
+// Message is a pending or published outbox entry.
+type Message struct {
+	ID            string     `gorm:"type:varchar(36);primaryKey"`
+	Subject       string     `gorm:"type:varchar(255);not null"`
+	Payload       []byte     `gorm:"not null"`
+	Headers       string     `gorm:"type:text"` // JSON encoded trace headers of the enqueuing request
+	Attempts      int        `gorm:"not null;default:0"`
+	LastError     string     `gorm:"type:text"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_outbox_messages_pending,priority:2"`
+	PublishedAt   *time.Time `gorm:"index:idx_outbox_messages_pending,priority:1"`
+}
+
+// TableName specifies the table name for Message
+func (Message) TableName() string {
+	return "outbox_messages"
+}
+
+// Migrate creates or updates the outbox table.
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&Message{})
+}
+
+// Transaction runs fn in a database transaction carried by the context passed to fn (see uow.Transaction).
+// fn 안에서 DB(ctx, db)로 얻은 연결과 Publish는 모두 같은 트랜잭션을 사용합니다.
+// 이미 트랜잭션 안이면 새로 시작하지 않고 바깥 트랜잭션에 참여합니다.
+func Transaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	return uow.Transaction(ctx, db, fn)
+}
+
+// DB returns the transaction of ctx, or db when ctx carries none (see uow.DB).
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return uow.DB(ctx, db)
+}
+
+// Outbox writes events of one service to the outbox table.
+type Outbox struct {
+	db     *gorm.DB
+	source string
+}
+
+// New creates an outbox that records events with source as their origin.
+func New(db *gorm.DB, source string) *Outbox {
+	return &Outbox{db: db, source: source}
+}
+
+// Publish stores event, encoded as JSON, for the relay to publish to subject (messaging.EventPublisher).
+// ctx가 Transaction 안이면 그 트랜잭션에 기록되어 커밋될 때만 발행됩니다.
+func (o *Outbox) Publish(ctx context.Context, subject string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("outbox: failed to encode event: %w", err)
+	}
+
+	msg := &Message{
+		ID:            uuid.NewString(),
+		Subject:       subject,
+		Payload:       payload,
+		CreatedAt:     time.Now().UTC(),
+		NextAttemptAt: time.Now().UTC(),
+	}
+
+	// 요청의 트레이스를 저장해 두었다가 발행 시 이어 붙입니다.
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	if len(header) > 0 {
+		encoded, err := json.Marshal(header)
+		if err != nil {
+			return fmt.Errorf("outbox: failed to encode headers: %w", err)
+		}
+		msg.Headers = string(encoded)
+	}
+
+	if err := DB(ctx, o.db).Create(msg).Error; err != nil {
+		return fmt.Errorf("outbox: failed to store event for %s: %w", subject, err)
+	}
+	return nil
+}
+
+// Emit stores a domain event of eventType with data as its payload (see messaging.Emitter.Emit).
+// A nil outbox is a no-op.
+func (o *Outbox) Emit(ctx context.Context, eventType, workspaceID, actorID string, data interface{}) error {
+	if o == nil {
+		return nil
+	}
+	event, err := messaging.NewEvent(eventType, o.source, workspaceID, actorID, data)
+	if err != nil {
+		return err
+	}
+	return o.Publish(ctx, eventType, event)
+}
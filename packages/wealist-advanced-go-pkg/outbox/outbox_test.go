@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+)
+
+type fakePublisher struct {
+	subject string
+	header  http.Header
+	data    []byte
+	err     error
+}
+
+func (p *fakePublisher) PublishRaw(ctx context.Context, subject string, header http.Header, data []byte) error {
+	p.subject, p.header, p.data = subject, header, data
+	return p.err
+}
+
+// dryRunDB는 쿼리를 실행하지 않고 SQL만 생성하는 PostgreSQL 연결입니다.
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=test"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return db
+}
+
+func TestPendingQuery_LocksWithSkipLocked(t *testing.T) {
+	db := dryRunDB(t)
+
+	var msgs []Message
+	stmt := pendingQuery(db, time.Now(), 20, 100).Find(&msgs).Statement
+	sql := stmt.SQL.String()
+
+	for _, want := range []string{"published_at IS NULL", "NOT EXISTS", "waiting.created_at < outbox_messages.created_at", "ORDER BY created_at", "LIMIT $", "FOR UPDATE SKIP LOCKED"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("expected %q in %s", want, sql)
+		}
+	}
+}
+
+func TestRelay_PublishUsesRowIDAsMessageID(t *testing.T) {
+	publisher := &fakePublisher{}
+	relay := NewRelay(nil, publisher, RelayConfig{}, nil)
+
+	msg := &Message{
+		ID:      "0b9f8c7e-1111-2222-3333-444455556666",
+		Subject: messaging.EventBoardCreated,
+		Payload: []byte(`{"id":"evt"}`),
+		Headers: `{"Traceparent":["00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"]}`,
+	}
+	if err := relay.publish(context.Background(), msg); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	if publisher.subject != msg.Subject || string(publisher.data) != string(msg.Payload) {
+		t.Errorf("unexpected message %s %s", publisher.subject, publisher.data)
+	}
+	if got := publisher.header.Get(messaging.MsgIDHeader); got != msg.ID {
+		t.Errorf("expected message ID %s, got %s", msg.ID, got)
+	}
+	if publisher.header.Get("Traceparent") == "" {
+		t.Error("expected stored trace headers to be propagated")
+	}
+
+	publisher.err = errors.New("nats down")
+	if err := relay.publish(context.Background(), msg); err == nil {
+		t.Error("expected publish error")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		5:  16 * time.Second,
+		20: relayMaxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := backoff(attempts); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestOutbox_NilEmitIsNoop(t *testing.T) {
+	var o *Outbox
+	if err := o.Emit(context.Background(), messaging.EventBoardCreated, "ws", "actor", nil); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+)
+
+// Publisher publishes encoded messages (messaging.Publisher).
+type Publisher interface {
+	PublishRaw(ctx context.Context, subject string, header http.Header, data []byte) error
+}
+
+// RelayConfig configures the outbox relay.
+type RelayConfig struct {
+	Interval    time.Duration // Poll interval, 0 uses 1s
+	BatchSize   int           // Messages per poll, 0 uses 100
+	MaxAttempts int           // Publish attempts before a message is abandoned, 0 uses 20
+	Retention   time.Duration // How long published messages are kept, 0 uses 24h
+}
+
+// Relay publishes pending outbox messages to the event bus in creation order.
+// 레플리카마다 실행해도 SELECT ... FOR UPDATE SKIP LOCKED로 서로 다른 메시지를 가져가므로 중복 발행되지 않습니다.
+// 발행에 실패해 재시도를 기다리는 메시지가 있으면 그보다 나중에 기록된 메시지는 그 메시지가 발행되거나
+// MaxAttempts로 포기될 때까지 가져가지 않습니다. 단, 레플리카들이 동시에 가져간 배치끼리의 순서는 보장하지 않습니다.
+type Relay struct {
+	db        *gorm.DB
+	publisher Publisher
+	cfg       RelayConfig
+	logger    *zap.Logger
+}
+
+// NewRelay creates a new relay.
+func NewRelay(db *gorm.DB, publisher Publisher, cfg RelayConfig, logger *zap.Logger) *Relay {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 20
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 24 * time.Hour
+	}
+	return &Relay{db: db, publisher: publisher, cfg: cfg, logger: logger}
+}
+
+const (
+	relayMaxBackoff      = 5 * time.Minute
+	relayCleanupInterval = time.Hour
+)
+
+// Run publishes pending messages until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+	lastCleanup := time.Now()
+
+	r.logger.Info("Outbox relay started", zap.Duration("interval", r.cfg.Interval))
+	for {
+		// 가득 찬 배치면 밀린 메시지가 있으므로 바로 다음 배치를 처리합니다.
+		for {
+			n, err := r.DispatchOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					r.logger.Warn("Outbox dispatch failed", zap.Error(err))
+				}
+				break
+			}
+			if n < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastCleanup) >= relayCleanupInterval {
+			lastCleanup = time.Now()
+			if err := r.Cleanup(ctx); err != nil {
+				r.logger.Warn("Outbox cleanup failed", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes every due message once more, e.g. during shutdown after the last requests committed.
+// 발행에 실패해 재시도를 기다리는 메시지는 남겨 두며, 다른 레플리카나 다음 기동 시 릴레이가 발행합니다.
+func (r *Relay) Flush(ctx context.Context) error {
+	for {
+		n, err := r.DispatchOnce(ctx)
+		if err != nil {
+			return err
+		}
+		if n < r.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// DispatchOnce publishes one batch of due messages and returns how many were claimed.
+func (r *Relay) DispatchOnce(ctx context.Context) (int, error) {
+	var claimed int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []Message
+		if err := pendingQuery(tx, time.Now().UTC(), r.cfg.MaxAttempts, r.cfg.BatchSize).Find(&msgs).Error; err != nil {
+			return err
+		}
+		claimed = len(msgs)
+
+		for i := range msgs {
+			msg := &msgs[i]
+			now := time.Now().UTC()
+			if err := r.publish(ctx, msg); err != nil {
+				attempts := msg.Attempts + 1
+				r.logger.Warn("Failed to publish outbox message",
+					zap.String("outbox.id", msg.ID),
+					zap.String("subject", msg.Subject),
+					zap.Int("attempts", attempts),
+					zap.Error(err))
+				if attempts >= r.cfg.MaxAttempts {
+					r.logger.Error("Abandoning outbox message after max attempts",
+						zap.String("outbox.id", msg.ID),
+						zap.String("subject", msg.Subject))
+				}
+				// 배치를 여기서 끝내며, 이후 메시지는 pendingQuery가 이 메시지의 재시도를 기다리게 합니다.
+				return tx.Model(&Message{}).Where("id = ?", msg.ID).Updates(map[string]interface{}{
+					"attempts":        attempts,
+					"last_error":      err.Error(),
+					"next_attempt_at": now.Add(backoff(attempts)),
+				}).Error
+			}
+			if err := tx.Model(&Message{}).Where("id = ?", msg.ID).Update("published_at", now).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return claimed, err
+}
+
+// Cleanup deletes messages published longer than the retention ago.
+func (r *Relay) Cleanup(ctx context.Context) error {
+	cutoff := time.Now().UTC().Add(-r.cfg.Retention)
+	return r.db.WithContext(ctx).
+		Where("published_at IS NOT NULL AND published_at < ?", cutoff).
+		Delete(&Message{}).Error
+}
+
+// publish sends msg with its row ID as the message ID and its stored trace context.
+func (r *Relay) publish(ctx context.Context, msg *Message) error {
+	header := http.Header{}
+	if msg.Headers != "" {
+		if err := json.Unmarshal([]byte(msg.Headers), &header); err != nil {
+			r.logger.Warn("Ignoring malformed outbox headers", zap.String("outbox.id", msg.ID), zap.Error(err))
+			header = http.Header{}
+		}
+	}
+	header.Set(messaging.MsgIDHeader, msg.ID)
+	return r.publisher.PublishRaw(ctx, msg.Subject, header, msg.Payload)
+}
+
+// pendingQuery selects due, unpublished messages in creation order and locks them for this relay.
+// 먼저 기록된 메시지가 재시도를 기다리는 중이면(backoff) 그 뒤의 메시지는 고르지 않습니다.
+func pendingQuery(tx *gorm.DB, now time.Time, maxAttempts, limit int) *gorm.DB {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("published_at IS NULL AND next_attempt_at <= ? AND attempts < ?", now, maxAttempts).
+		Where(`NOT EXISTS (SELECT 1 FROM outbox_messages AS waiting
+			WHERE waiting.published_at IS NULL AND waiting.next_attempt_at > ? AND waiting.attempts < ?
+			AND waiting.created_at < outbox_messages.created_at)`, now, maxAttempts).
+		Order("created_at").
+		Limit(limit)
+}
+
+// backoff returns the delay before the next attempt after attempts failures (1s, 2s, 4s ... 5m).
+func backoff(attempts int) time.Duration {
+	delay := time.Second
+	for i := 1; i < attempts && delay < relayMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, relayMaxBackoff)
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// orderedPublisher records the IDs of published messages and fails the listed ones once.
+type orderedPublisher struct {
+	published []string
+	failOnce  map[string]bool
+}
+
+func (p *orderedPublisher) PublishRaw(ctx context.Context, subject string, header http.Header, data []byte) error {
+	id := header.Get(messaging.MsgIDHeader)
+	if p.failOnce[id] {
+		delete(p.failOnce, id)
+		return errors.New("nats timeout")
+	}
+	p.published = append(p.published, id)
+	return nil
+}
+
+func TestRelay_FailureMidBatchKeepsOrder(t *testing.T) {
+	db := integration.Postgres(t)
+	if err := Migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	ctx := context.Background()
+
+	box := New(db, "board-service")
+	for i := 0; i < 4; i++ {
+		if err := box.Publish(ctx, messaging.EventBoardUpdated, map[string]int{"n": i}); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	var ids []string
+	if err := db.Model(&Message{}).Order("created_at").Pluck("id", &ids).Error; err != nil {
+		t.Fatalf("list messages: %v", err)
+	}
+
+	publisher := &orderedPublisher{failOnce: map[string]bool{ids[1]: true}}
+	relay := NewRelay(db, publisher, RelayConfig{BatchSize: 2}, nil)
+
+	// 첫 배치는 가득 차서 Flush가 다음 배치를 바로 가져가지만, 두 번째 메시지가 재시도를 기다리는 동안 뒤의 메시지는 발행하지 않음
+	if err := relay.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if !slices.Equal(publisher.published, ids[:1]) {
+		t.Fatalf("published %v, want %v", publisher.published, ids[:1])
+	}
+
+	// 재시도 시각이 되면 실패했던 메시지부터 순서대로 발행
+	if err := db.Model(&Message{}).Where("id = ?", ids[1]).
+		Update("next_attempt_at", time.Now().UTC().Add(-time.Second)).Error; err != nil {
+		t.Fatalf("update next attempt: %v", err)
+	}
+	if err := relay.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if !slices.Equal(publisher.published, ids) {
+		t.Errorf("published %v, want %v", publisher.published, ids)
+	}
+}
@@ -0,0 +1,111 @@
+// Package profiling은 장애 대응 시 프로파일을 수집할 수 있도록
+// net/http/pprof와 런타임 통계를 API와 분리된 내부 포트로 노출합니다.
+//
+// 엔드포인트:
+//
+//	/debug/pprof/            pprof 인덱스 (heap, goroutine, allocs, mutex, threadcreate)
+//	/debug/pprof/profile     CPU 프로파일 (?seconds=30)
+//	/debug/pprof/trace       실행 트레이스 (?seconds=5)
+//	/debug/runtime           고루틴 수, 힙, GC pause 요약 (JSON)
+//
+// PROFILING_ADDR가 비어 있으면 시작하지 않습니다. K8s에서는 127.0.0.1:6060에 바인딩해
+// 다른 파드나 Ingress에서는 접근할 수 없고 kubectl port-forward로만 접근합니다.
+// PROFILING_TOKEN을 설정하면 모든 요청에 Authorization: Bearer <token>이 필요합니다
+// (Docker Compose처럼 포트를 외부에 바인딩하는 경우).
+//
+// 같은 런타임 지표는 /metrics의 go_goroutines, go_memstats_*, go_gc_duration_seconds로도 수집됩니다.
+package profiling
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+)
+
+// mutexProfileFraction은 mutex 프로파일 샘플링 비율입니다 (1/n).
+const mutexProfileFraction = 100
+
+// Config는 프로파일링 서버 설정입니다.
+type Config struct {
+	// Addr는 프로파일링 서버 주소입니다 (예: 127.0.0.1:6060). 비어 있으면 비활성화됩니다.
+	Addr string
+	// Token이 있으면 Bearer 토큰이 일치하는 요청만 허용합니다.
+	Token string
+}
+
+// ConfigFromEnv는 PROFILING_ADDR, PROFILING_TOKEN 환경 변수에서 설정을 읽습니다.
+func ConfigFromEnv() Config {
+	return Config{
+		Addr:  config.GetEnvString("PROFILING_ADDR", ""),
+		Token: config.GetEnvString("PROFILING_TOKEN", ""),
+	}
+}
+
+// Handler는 pprof와 런타임 통계 엔드포인트를 제공하는 핸들러를 반환합니다.
+func Handler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/runtime", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ReadRuntimeStats())
+	})
+
+	if token == "" {
+		return mux
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// Start는 cfg.Addr에서 프로파일링 서버를 시작하고 종료 함수를 반환합니다.
+// 비활성화된 경우 아무것도 하지 않는 종료 함수를 반환합니다.
+func Start(cfg Config, logger *zap.Logger) func(ctx context.Context) error {
+	if cfg.Addr == "" {
+		return func(context.Context) error { return nil }
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	// 락 경합은 100번 중 1번만 기록 (block 프로파일은 오버헤드가 커서 켜지 않음)
+	runtime.SetMutexProfileFraction(mutexProfileFraction)
+
+	srv := &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           Handler(cfg.Token),
+		ReadHeaderTimeout: 5 * time.Second,
+		// CPU 프로파일과 트레이스는 요청한 시간 동안 응답을 씁니다
+		WriteTimeout: 2 * time.Minute,
+	}
+
+	go func() {
+		logger.Info("Profiling server started",
+			zap.String("address", cfg.Addr),
+			zap.Bool("token_required", cfg.Token != ""))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			// 프로파일링 실패로 서비스를 멈추지는 않습니다
+			logger.Error("Profiling server stopped", zap.Error(err))
+		}
+	}()
+
+	return srv.Shutdown
+}
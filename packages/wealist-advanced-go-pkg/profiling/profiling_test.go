@@ -0,0 +1,147 @@
+package profiling
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_Endpoints(t *testing.T) {
+	h := Handler("")
+
+	tests := []struct {
+		path        string
+		contentType string
+	}{
+		{path: "/debug/pprof/", contentType: "text/html"},
+		{path: "/debug/pprof/goroutine?debug=1", contentType: "text/plain"},
+		{path: "/debug/pprof/heap", contentType: "application/octet-stream"},
+		{path: "/debug/runtime", contentType: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", w.Code)
+			}
+			if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, tt.contentType) {
+				t.Errorf("Content-Type = %q, want %s", got, tt.contentType)
+			}
+		})
+	}
+}
+
+func TestHandler_Token(t *testing.T) {
+	h := Handler("secret")
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{name: "no token", want: http.StatusUnauthorized},
+		{name: "wrong token", header: "Bearer nope", want: http.StatusUnauthorized},
+		{name: "raw token without Bearer", header: "secret", want: http.StatusUnauthorized},
+		{name: "bearer token", header: "Bearer secret", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/runtime", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadRuntimeStats(t *testing.T) {
+	runtime.GC()
+	runtime.GC()
+
+	stats := ReadRuntimeStats()
+	if stats.Goroutines < 1 || stats.GOMAXPROCS < 1 {
+		t.Errorf("unexpected goroutines/gomaxprocs: %+v", stats)
+	}
+	if stats.HeapAllocBytes == 0 || stats.SysBytes == 0 {
+		t.Errorf("heap stats are empty: %+v", stats)
+	}
+	if stats.NumGC < 2 || stats.GCPause.Count < 2 || stats.LastGC == nil {
+		t.Errorf("GC stats missing after runtime.GC(): %+v", stats)
+	}
+
+	// JSON 필드명은 capture 스크립트와 운영 문서에서 사용합니다
+	data, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatalf("failed to encode stats: %v", err)
+	}
+	for _, field := range []string{`"goroutines"`, `"heapAllocBytes"`, `"gcPause"`, `"p99"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("encoded stats missing %s: %s", field, data)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got := percentile(sorted, 0.5); got != 5 {
+		t.Errorf("p50 = %v, want 5", got)
+	}
+	if got := percentile(sorted, 0.99); got != 9 {
+		t.Errorf("p99 = %v, want 9", got)
+	}
+}
+
+func TestStart_Disabled(t *testing.T) {
+	stop := Start(Config{}, nil)
+	if err := stop(context.Background()); err != nil {
+		t.Errorf("stop() error = %v", err)
+	}
+}
+
+func TestStart_ServesAndStops(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a port: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	stop := Start(Config{Addr: addr, Token: "secret"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://"+addr+"/debug/runtime", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+
+	var resp *http.Response
+	for i := 0; i < 50; i++ {
+		if resp, err = http.DefaultClient.Do(req); err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("profiling server did not start: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+
+	if err := stop(context.Background()); err != nil {
+		t.Errorf("stop() error = %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package profiling
+
+import (
+	"runtime"
+	"sort"
+	"time"
+)
+
+// startedAt은 프로세스 시작 시각입니다 (uptime 계산용).
+var startedAt = time.Now()
+
+// RuntimeStats는 현재 프로세스의 런타임 상태 요약입니다.
+type RuntimeStats struct {
+	Goroutines int    `json:"goroutines"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	NumCPU     int    `json:"numCpu"`
+	GoVersion  string `json:"goVersion"`
+	Uptime     string `json:"uptime"`
+
+	HeapAllocBytes  uint64  `json:"heapAllocBytes"`
+	HeapInuseBytes  uint64  `json:"heapInuseBytes"`
+	HeapObjects     uint64  `json:"heapObjects"`
+	SysBytes        uint64  `json:"sysBytes"`
+	TotalAllocBytes uint64  `json:"totalAllocBytes"`
+	NextGCBytes     uint64  `json:"nextGcBytes"`
+	GCCPUFraction   float64 `json:"gcCpuFraction"`
+
+	NumGC uint32 `json:"numGc"`
+	// LastGC는 마지막 GC 시각입니다 (GC가 없었으면 null).
+	LastGC *time.Time `json:"lastGc"`
+	// GCPause는 최근 GC pause(최대 256회)의 분포입니다.
+	GCPause PauseStats `json:"gcPause"`
+}
+
+// PauseStats는 GC pause 시간 분포입니다.
+type PauseStats struct {
+	Count int    `json:"count"`
+	Last  string `json:"last"`
+	P50   string `json:"p50"`
+	P99   string `json:"p99"`
+	Max   string `json:"max"`
+	Total string `json:"total"`
+}
+
+// ReadRuntimeStats는 현재 런타임 통계를 읽습니다.
+// runtime.ReadMemStats는 짧게 stop-the-world를 일으키므로 요청 시에만 호출합니다.
+func ReadRuntimeStats() RuntimeStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	stats := RuntimeStats{
+		Goroutines:      runtime.NumGoroutine(),
+		GOMAXPROCS:      runtime.GOMAXPROCS(0),
+		NumCPU:          runtime.NumCPU(),
+		GoVersion:       runtime.Version(),
+		Uptime:          time.Since(startedAt).Round(time.Second).String(),
+		HeapAllocBytes:  m.HeapAlloc,
+		HeapInuseBytes:  m.HeapInuse,
+		HeapObjects:     m.HeapObjects,
+		SysBytes:        m.Sys,
+		TotalAllocBytes: m.TotalAlloc,
+		NextGCBytes:     m.NextGC,
+		GCCPUFraction:   m.GCCPUFraction,
+		NumGC:           m.NumGC,
+		GCPause:         pauseStats(&m),
+	}
+	if m.LastGC > 0 {
+		last := time.Unix(0, int64(m.LastGC))
+		stats.LastGC = &last
+	}
+	return stats
+}
+
+// pauseStats는 MemStats의 순환 버퍼(PauseNs)에서 최근 pause 분포를 계산합니다.
+func pauseStats(m *runtime.MemStats) PauseStats {
+	n := int(m.NumGC)
+	if n > len(m.PauseNs) {
+		n = len(m.PauseNs)
+	}
+	if n == 0 {
+		return PauseStats{}
+	}
+
+	pauses := make([]time.Duration, n)
+	for i := 0; i < n; i++ {
+		// 가장 최근 pause는 PauseNs[(NumGC+255)%256]
+		idx := (int(m.NumGC) - 1 - i + len(m.PauseNs)) % len(m.PauseNs)
+		pauses[i] = time.Duration(m.PauseNs[idx])
+	}
+	last := pauses[0]
+	sort.Slice(pauses, func(i, j int) bool { return pauses[i] < pauses[j] })
+
+	return PauseStats{
+		Count: n,
+		Last:  last.String(),
+		P50:   percentile(pauses, 0.50).String(),
+		P99:   percentile(pauses, 0.99).String(),
+		Max:   pauses[n-1].String(),
+		Total: time.Duration(m.PauseTotalNs).String(),
+	}
+}
+
+// percentile은 정렬된 sorted에서 p 분위 값을 반환합니다.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	idx := int(float64(len(sorted)-1) * p)
+	return sorted[idx]
+}
@@ -1,11 +1,12 @@
 package ratelimit
 
 import (
-	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
 // KeyFunc is a function that extracts the rate limit key from a request.
@@ -108,10 +109,8 @@ func Middleware(limiter RateLimiter, keyFunc KeyFunc, config Config) gin.Handler
 				return
 			}
 			c.Header("Retry-After", strconv.FormatInt(resetAfterMs/1000, 10))
-			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
-				"error":   "Service Unavailable",
-				"message": "Rate limiter unavailable. Please try again later.",
-			})
+			response.ServiceUnavailable(c, "Rate limiter unavailable. Please try again later.")
+			c.Abort()
 			return
 		}
 
@@ -122,11 +121,8 @@ func Middleware(limiter RateLimiter, keyFunc KeyFunc, config Config) gin.Handler
 				retryAfter = 1
 			}
 			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"error":   "Too Many Requests",
-				"message": "Rate limit exceeded. Please try again later.",
-				"retryAfter": retryAfter,
-			})
+			response.TooManyRequests(c, "Rate limit exceeded. Please try again later.")
+			c.Abort()
 			return
 		}
 
@@ -194,10 +190,8 @@ func MiddlewareWithLogger(limiter RateLimiter, keyFunc KeyFunc, config Config, l
 				return
 			}
 			c.Header("Retry-After", strconv.FormatInt(resetAfterMs/1000, 10))
-			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
-				"error":   "Service Unavailable",
-				"message": "Rate limiter unavailable. Please try again later.",
-			})
+			response.ServiceUnavailable(c, "Rate limiter unavailable. Please try again later.")
+			c.Abort()
 			return
 		}
 
@@ -213,11 +207,8 @@ func MiddlewareWithLogger(limiter RateLimiter, keyFunc KeyFunc, config Config, l
 				zap.String("clientIP", c.ClientIP()),
 			)
 			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"error":   "Too Many Requests",
-				"message": "Rate limit exceeded. Please try again later.",
-				"retryAfter": retryAfter,
-			})
+			response.TooManyRequests(c, "Rate limit exceeded. Please try again later.")
+			c.Abort()
 			return
 		}
 
@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+)
+
+// Policy is a declarative rate limit: each key may make Limit (+ Burst) requests per sliding Window.
+// Policies with different names use separate buckets, so a route override does not consume
+// the default budget of the same user.
+type Policy struct {
+	// Name identifies the bucket (e.g. "default", "upload"). It is part of the Redis key.
+	Name string
+
+	// Limit is the number of requests allowed per Window.
+	Limit int
+
+	// Burst is added to Limit to tolerate short spikes.
+	Burst int
+
+	// Window is the sliding window duration. Default is 1 minute.
+	Window time.Duration
+}
+
+// PerMinute returns a policy allowing limit requests per minute.
+func PerMinute(name string, limit int) Policy {
+	return Policy{Name: name, Limit: limit, Window: time.Minute}
+}
+
+// WithBurst returns the policy with the given burst size.
+func (p Policy) WithBurst(burst int) Policy {
+	p.Burst = burst
+	return p
+}
+
+// Quota returns the total number of requests allowed per window (Limit + Burst).
+func (p Policy) Quota() int {
+	return p.Limit + p.Burst
+}
+
+// String formats the policy for the RateLimit-Policy header (e.g. "60;w=60").
+func (p Policy) String() string {
+	return fmt.Sprintf("%d;w=%d", p.Quota(), int(p.window().Seconds()))
+}
+
+func (p Policy) window() time.Duration {
+	if p.Window <= 0 {
+		return time.Minute
+	}
+	return p.Window
+}
+
+// Rule overrides the policy (and optionally the key) for matching routes.
+type Rule struct {
+	// Method is the HTTP method to match. Empty matches any method.
+	Method string
+
+	// Path is the Gin route pattern (e.g. "/api/storage/files/:fileId/download").
+	// A trailing * matches by prefix. Version segments (/api/v1) are ignored when matching.
+	Path string
+
+	// Policy is applied instead of the default policy.
+	Policy Policy
+
+	// Key overrides Policies.Key for this rule (e.g. IPKey for login endpoints).
+	Key KeyFunc
+}
+
+// Policies declares how requests are rate limited: a default policy, per-route overrides and
+// the identity used to separate buckets.
+//
+//	policies := ratelimit.NewPolicies().
+//		WithRoute(http.MethodPost, "/api/storage/files/upload-url", ratelimit.PerMinute("upload", 20)).
+//		WithRouteKey("", "/api/public/*", ratelimit.PerMinute("public", 30), ratelimit.IPKey)
+//	r.Use(ratelimit.PolicyMiddleware(limiter, policies, logger))
+type Policies struct {
+	// Default applies to routes without a rule. If Limit is 0, the limiter's Config
+	// (RequestsPerMinute, BurstSize, WindowSize) is used, so runtime reloads still apply.
+	Default Policy
+
+	// Key extracts the bucket identity. Default is IdentityKey.
+	Key KeyFunc
+
+	// Rules are checked in order; the first match wins.
+	Rules []Rule
+}
+
+// NewPolicies returns Policies that use the limiter's configuration and IdentityKey.
+func NewPolicies() Policies {
+	return Policies{Key: IdentityKey}
+}
+
+// WithDefault sets the default policy.
+func (p Policies) WithDefault(policy Policy) Policies {
+	p.Default = policy
+	return p
+}
+
+// WithKey sets the default key function.
+func (p Policies) WithKey(keyFunc KeyFunc) Policies {
+	p.Key = keyFunc
+	return p
+}
+
+// WithRoute adds a per-route policy.
+func (p Policies) WithRoute(method, path string, policy Policy) Policies {
+	return p.WithRouteKey(method, path, policy, nil)
+}
+
+// WithRouteKey adds a per-route policy with its own key function.
+func (p Policies) WithRouteKey(method, path string, policy Policy, keyFunc KeyFunc) Policies {
+	p.Rules = append(append([]Rule(nil), p.Rules...), Rule{
+		Method: method,
+		Path:   path,
+		Policy: policy,
+		Key:    keyFunc,
+	})
+	return p
+}
+
+// match returns the rule for the request's method and route pattern.
+func (p Policies) match(method, route string) (Rule, bool) {
+	route = apiversion.Unversioned(route)
+	for _, rule := range p.Rules {
+		if rule.Method != "" && !strings.EqualFold(rule.Method, method) {
+			continue
+		}
+		if matchPath(apiversion.Unversioned(rule.Path), route) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// resolve returns the policy and key function for a request.
+// Config.EndpointLimits is honored for routes without a rule.
+func (p Policies) resolve(method, route string, config Config) (Policy, KeyFunc) {
+	keyFunc := p.Key
+	if keyFunc == nil {
+		keyFunc = IdentityKey
+	}
+	if rule, ok := p.match(method, route); ok {
+		if rule.Key != nil {
+			keyFunc = rule.Key
+		}
+		return rule.Policy, keyFunc
+	}
+	if limit, ok := config.EndpointLimits[route]; ok {
+		return Policy{Name: route, Limit: limit, Window: config.WindowSize}, keyFunc
+	}
+	if p.Default.Limit > 0 {
+		return p.Default, keyFunc
+	}
+	return Policy{
+		Name:   "default",
+		Limit:  config.RequestsPerMinute,
+		Burst:  config.BurstSize,
+		Window: config.WindowSize,
+	}, keyFunc
+}
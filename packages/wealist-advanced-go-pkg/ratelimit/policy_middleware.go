@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// APIKeyHeader is the header carrying an API key for programmatic clients.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyKey extracts a hash of the API key as the rate limit key.
+// Falls back to IP if no API key is sent. The key itself is never stored in Redis.
+// Use it only on routes that reject unknown keys before the limiter; otherwise a client
+// could get a fresh bucket by sending a random key.
+func APIKeyKey(c *gin.Context) string {
+	apiKey := c.GetHeader(APIKeyHeader)
+	if apiKey == "" {
+		return IPKey(c)
+	}
+	sum := sha256.Sum256([]byte(apiKey))
+	return "key:" + hex.EncodeToString(sum[:8])
+}
+
+// IdentityKey separates buckets by the most specific identity available:
+// authenticated user, then service client (client credentials token), then client IP.
+// Install the middleware after authentication for per-user buckets.
+func IdentityKey(c *gin.Context) string {
+	if userID, ok := auth.GetUserID(c); ok {
+		return "user:" + userID.String()
+	}
+	if claims, ok := auth.GetServiceClient(c); ok {
+		return "client:" + claims.ClientID
+	}
+	return IPKey(c)
+}
+
+// PolicyMiddleware creates a Gin middleware that applies the declared policies with
+// sliding window limits. The limiter's configuration (Enabled, FailOpen, ExcludePaths,
+// default limits) is read on every request, so runtime reloads apply without a restart.
+//
+// Responses carry the standard RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset and
+// RateLimit-Policy headers, plus the X-RateLimit-* headers for existing clients.
+func PolicyMiddleware(limiter PolicyLimiter, policies Policies, logger *zap.Logger) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return func(c *gin.Context) {
+		config := limiter.GetConfig()
+		if !config.Enabled || config.IsExcluded(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		policy, keyFunc := policies.resolve(c.Request.Method, route, config)
+		key := keyFunc(c)
+
+		result, err := limiter.AllowPolicy(c.Request.Context(), key, policy)
+		if result == nil {
+			result = &Result{Allowed: config.FailOpen, Remaining: -1, ResetAfterMs: policy.window().Milliseconds()}
+		}
+		writeHeaders(c, policy, result)
+
+		if err != nil {
+			logger.Warn("rate limiter error",
+				zap.String("key", key),
+				zap.String("policy", policy.Name),
+				zap.String("path", c.Request.URL.Path),
+				zap.Error(err),
+			)
+			if config.FailOpen {
+				c.Next()
+				return
+			}
+			c.Header("Retry-After", strconv.FormatInt(ceilSeconds(result.ResetAfterMs), 10))
+			response.ServiceUnavailable(c, "Rate limiter unavailable. Please try again later.")
+			c.Abort()
+			return
+		}
+
+		if !result.Allowed {
+			logger.Info("rate limit exceeded",
+				zap.String("key", key),
+				zap.String("policy", policy.Name),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("clientIP", c.ClientIP()),
+			)
+			c.Header("Retry-After", strconv.FormatInt(max(ceilSeconds(result.RetryAfterMs), 1), 10))
+			response.TooManyRequests(c, "Rate limit exceeded. Please try again later.")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// writeHeaders sets the RateLimit-* (IETF draft) and legacy X-RateLimit-* headers.
+func writeHeaders(c *gin.Context, policy Policy, result *Result) {
+	limit := strconv.Itoa(policy.Quota())
+	reset := strconv.FormatInt(ceilSeconds(result.ResetAfterMs), 10)
+
+	c.Header("RateLimit-Policy", policy.String())
+	c.Header("RateLimit-Limit", limit)
+	c.Header("RateLimit-Reset", reset)
+	c.Header("X-RateLimit-Limit", limit)
+	c.Header("X-RateLimit-Reset", reset)
+	if result.Remaining >= 0 {
+		remaining := strconv.Itoa(result.Remaining)
+		c.Header("RateLimit-Remaining", remaining)
+		c.Header("X-RateLimit-Remaining", remaining)
+	}
+}
+
+func ceilSeconds(ms int64) int64 {
+	if ms <= 0 {
+		return 0
+	}
+	return (ms + 999) / 1000
+}
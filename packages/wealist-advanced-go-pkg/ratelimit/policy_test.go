@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+)
+
+// fakeLimiter counts requests per policy and key in memory.
+type fakeLimiter struct {
+	config Config
+	counts map[string]int
+	keys   []string
+}
+
+func newFakeLimiter(config Config) *fakeLimiter {
+	return &fakeLimiter{config: config, counts: make(map[string]int)}
+}
+
+func (f *fakeLimiter) AllowPolicy(_ context.Context, key string, policy Policy) (*Result, error) {
+	bucket := policy.Name + ":" + key
+	f.keys = append(f.keys, bucket)
+	if f.counts[bucket] >= policy.Quota() {
+		return &Result{Allowed: false, Remaining: 0, ResetAfterMs: 30_000, RetryAfterMs: 1_500}, nil
+	}
+	f.counts[bucket]++
+	return &Result{Allowed: true, Remaining: policy.Quota() - f.counts[bucket], ResetAfterMs: 30_000}, nil
+}
+
+func (f *fakeLimiter) GetConfig() Config { return f.config }
+
+func TestPolicies_Resolve(t *testing.T) {
+	config := DefaultConfig().WithRequestsPerMinute(100).WithBurstSize(10).WithEndpointLimit("/api/search", 5)
+	policies := NewPolicies().
+		WithRoute(http.MethodPost, "/api/storage/files/upload-url", PerMinute("upload", 20)).
+		WithRouteKey("", "/api/public/*", PerMinute("public", 30), IPKey)
+
+	policy, _ := policies.resolve(http.MethodPost, "/api/v1/storage/files/upload-url", config)
+	if policy.Name != "upload" || policy.Quota() != 20 {
+		t.Errorf("expected upload policy for versioned route, got %+v", policy)
+	}
+	policy, _ = policies.resolve(http.MethodGet, "/api/storage/files/upload-url", config)
+	if policy.Name != "default" || policy.Quota() != 110 || policy.Window != time.Minute {
+		t.Errorf("expected default policy from config for other method, got %+v", policy)
+	}
+	policy, _ = policies.resolve(http.MethodGet, "/api/public/storage/shares/link/:link", config)
+	if policy.Name != "public" {
+		t.Errorf("expected prefix rule to match, got %+v", policy)
+	}
+	policy, _ = policies.resolve(http.MethodGet, "/api/search", config)
+	if policy.Quota() != 5 {
+		t.Errorf("expected endpoint limit from config, got %+v", policy)
+	}
+	policy, _ = policies.WithDefault(PerMinute("api", 7)).resolve(http.MethodGet, "/api/boards", config)
+	if policy.Name != "api" || policy.Quota() != 7 {
+		t.Errorf("expected declared default policy, got %+v", policy)
+	}
+}
+
+func TestPolicy_String(t *testing.T) {
+	if got := PerMinute("default", 60).WithBurst(10).String(); got != "70;w=60" {
+		t.Errorf("unexpected policy header %q", got)
+	}
+}
+
+func TestSlidingWindow_WeightsPreviousWindow(t *testing.T) {
+	// 15s into a 60s window: 75% of the previous window still overlaps.
+	w := newSlidingWindow(time.UnixMilli(60_000*100+15_000), time.Minute)
+	if w.index != 100 || w.previousWeight() != 0.75 {
+		t.Fatalf("unexpected window %+v weight %v", w, w.previousWeight())
+	}
+
+	result := w.result(true, 10, 40, 60)
+	if result.Remaining != 20 || result.ResetAfterMs != 45_000 {
+		t.Errorf("expected 60-(30+10)=20 remaining, got %+v", result)
+	}
+
+	// Denied with the previous window dominating: its weight drops to (60-1-30)/40 at 16.5s.
+	result = w.result(false, 30, 40, 60)
+	if result.Remaining != 0 || result.RetryAfterMs != 16_500-15_000 {
+		t.Errorf("unexpected denied result %+v", result)
+	}
+
+	// Denied with the current window full: wait past the next window boundary.
+	result = w.result(false, 60, 0, 60)
+	if result.RetryAfterMs <= result.ResetAfterMs {
+		t.Errorf("expected retry after the window reset, got %+v", result)
+	}
+}
+
+func TestPolicyMiddleware_PerRouteAndPerIdentityBuckets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := newFakeLimiter(DefaultConfig().WithRequestsPerMinute(2))
+	policies := NewPolicies().WithRoute(http.MethodPost, "/api/upload", PerMinute("upload", 1))
+
+	userA, userB := uuid.New(), uuid.New()
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		switch c.GetHeader("X-Test-User") {
+		case "a":
+			c.Set(auth.UserIDContextKey, userA)
+		case "b":
+			c.Set(auth.UserIDContextKey, userB)
+		}
+		c.Next()
+	})
+	r.Use(PolicyMiddleware(limiter, policies, nil))
+	api := apiversion.WithLegacy(r, "/api", apiversion.V1)
+	api.GET("/boards", func(c *gin.Context) { c.Status(http.StatusOK) })
+	api.POST("/upload", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/health/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method, path, user string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("X-Test-User", user)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodGet, "/api/boards", "a")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	for header, want := range map[string]string{
+		"RateLimit-Limit":     "2",
+		"RateLimit-Remaining": "1",
+		"RateLimit-Reset":     "30",
+		"RateLimit-Policy":    "2;w=60",
+		"X-RateLimit-Limit":   "2",
+	} {
+		if got := w.Header().Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+
+	// The upload override has its own bucket and does not consume the default budget.
+	if w := do(http.MethodPost, "/api/v1/upload", "a"); w.Code != http.StatusOK {
+		t.Errorf("expected first upload to pass, got %d", w.Code)
+	}
+	if w := do(http.MethodPost, "/api/upload", "a"); w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "2" {
+		t.Errorf("expected second upload to be limited with Retry-After 2, got %d %q", w.Code, w.Header().Get("Retry-After"))
+	}
+	if w := do(http.MethodGet, "/api/v1/boards", "a"); w.Code != http.StatusOK {
+		t.Errorf("expected default bucket to be unaffected by upload, got %d", w.Code)
+	}
+	if w := do(http.MethodGet, "/api/boards", "a"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected third board request to be limited, got %d", w.Code)
+	}
+
+	// Other users and anonymous clients have separate buckets.
+	if w := do(http.MethodGet, "/api/boards", "b"); w.Code != http.StatusOK {
+		t.Errorf("expected user b to have own bucket, got %d", w.Code)
+	}
+	if w := do(http.MethodGet, "/api/boards", ""); w.Code != http.StatusOK {
+		t.Errorf("expected anonymous IP bucket, got %d", w.Code)
+	}
+
+	// Excluded paths bypass the limiter.
+	before := len(limiter.keys)
+	if w := do(http.MethodGet, "/health/live", "a"); w.Code != http.StatusOK || len(limiter.keys) != before {
+		t.Errorf("expected health check to bypass rate limiting")
+	}
+	if limiter.keys[0] != "default:user:"+userA.String() || limiter.keys[len(limiter.keys)-1] != "default:ip:192.0.2.1" {
+		t.Errorf("unexpected buckets %v", limiter.keys)
+	}
+}
@@ -3,6 +3,7 @@ package ratelimit
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -10,8 +11,10 @@ import (
 )
 
 // RedisRateLimiter implements RateLimiter using Redis with sliding window algorithm.
+// The configuration can be replaced at runtime with UpdateConfig.
 type RedisRateLimiter struct {
 	client *redis.Client
+	mu     sync.RWMutex
 	config Config
 	logger *zap.Logger
 }
@@ -44,17 +47,18 @@ func (r *RedisRateLimiter) Allow(ctx context.Context, key string) (bool, error)
 func (r *RedisRateLimiter) AllowN(ctx context.Context, key string, n int) (bool, error) {
 	result, err := r.AllowWithInfo(ctx, key)
 	if err != nil {
-		return r.config.FailOpen, err
+		return r.GetConfig().FailOpen, err
 	}
 	return result.Allowed, nil
 }
 
 // AllowWithInfo checks if a request is allowed and returns detailed rate limit info.
 func (r *RedisRateLimiter) AllowWithInfo(ctx context.Context, key string) (*Result, error) {
-	fullKey := r.config.KeyPrefix + key
+	config := r.GetConfig()
+	fullKey := config.KeyPrefix + key
 	now := time.Now()
 	nowMs := now.UnixMilli()
-	windowMs := r.config.WindowSize.Milliseconds()
+	windowMs := config.WindowSize.Milliseconds()
 	windowStart := nowMs - windowMs
 
 	// Use Redis pipeline for atomic operations
@@ -73,7 +77,7 @@ func (r *RedisRateLimiter) AllowWithInfo(ctx context.Context, key string) (*Resu
 	countCmd := pipe.ZCard(ctx, fullKey)
 
 	// Set expiration on the key
-	pipe.Expire(ctx, fullKey, r.config.WindowSize+time.Second)
+	pipe.Expire(ctx, fullKey, config.WindowSize+time.Second)
 
 	_, err := pipe.Exec(ctx)
 	if err != nil {
@@ -81,7 +85,7 @@ func (r *RedisRateLimiter) AllowWithInfo(ctx context.Context, key string) (*Resu
 			zap.String("key", key),
 			zap.Error(err),
 		)
-		if r.config.FailOpen {
+		if config.FailOpen {
 			return &Result{
 				Allowed:      true,
 				Remaining:    -1,
@@ -98,7 +102,7 @@ func (r *RedisRateLimiter) AllowWithInfo(ctx context.Context, key string) (*Resu
 	}
 
 	count := countCmd.Val()
-	limit := int64(r.config.RequestsPerMinute + r.config.BurstSize)
+	limit := int64(config.RequestsPerMinute + config.BurstSize)
 	allowed := count <= limit
 	remaining := int(limit - count)
 	if remaining < 0 {
@@ -130,20 +134,21 @@ func (r *RedisRateLimiter) AllowWithInfo(ctx context.Context, key string) (*Resu
 
 // GetRemaining returns the remaining requests allowed for the given key.
 func (r *RedisRateLimiter) GetRemaining(ctx context.Context, key string) (int, error) {
-	fullKey := r.config.KeyPrefix + key
+	config := r.GetConfig()
+	fullKey := config.KeyPrefix + key
 	now := time.Now()
-	windowStart := now.Add(-r.config.WindowSize).UnixMilli()
+	windowStart := now.Add(-config.WindowSize).UnixMilli()
 
 	// Count entries in current window
 	count, err := r.client.ZCount(ctx, fullKey, fmt.Sprintf("%d", windowStart), "+inf").Result()
 	if err != nil {
 		if err == redis.Nil {
-			return r.config.RequestsPerMinute, nil
+			return config.RequestsPerMinute, nil
 		}
 		return -1, err
 	}
 
-	remaining := r.config.RequestsPerMinute + r.config.BurstSize - int(count)
+	remaining := config.RequestsPerMinute + config.BurstSize - int(count)
 	if remaining < 0 {
 		remaining = 0
 	}
@@ -152,18 +157,23 @@ func (r *RedisRateLimiter) GetRemaining(ctx context.Context, key string) (int, e
 
 // Reset clears the rate limit for the given key.
 func (r *RedisRateLimiter) Reset(ctx context.Context, key string) error {
-	fullKey := r.config.KeyPrefix + key
+	config := r.GetConfig()
+	fullKey := config.KeyPrefix + key
 	return r.client.Del(ctx, fullKey).Err()
 }
 
 // GetConfig returns the current configuration.
 func (r *RedisRateLimiter) GetConfig() Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	return r.config
 }
 
 // UpdateConfig updates the rate limiter configuration.
 // Note: This only affects new requests, existing windows are not modified.
 func (r *RedisRateLimiter) UpdateConfig(config Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.config = config
 }
 
@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ReloadableMiddleware creates a Gin middleware that reads the limiter's configuration on every
+// request, so changes made with UpdateConfig (e.g. by WatchSettings) apply without a restart.
+func ReloadableMiddleware(limiter *RedisRateLimiter, keyFunc KeyFunc, logger *zap.Logger) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return func(c *gin.Context) {
+		MiddlewareWithLogger(limiter, keyFunc, limiter.GetConfig(), logger)(c)
+	}
+}
+
+// WatchSettings applies the watcher's rate_limit settings to limiter on every change.
+// Settings left unset fall back to the limiter's configuration at the time of the call.
+func WatchSettings(limiter *RedisRateLimiter, watcher *config.Watcher) {
+	base := limiter.GetConfig()
+	watcher.OnChange(func(s config.RuntimeSettings) {
+		limiter.UpdateConfig(ApplySettings(base, s.RateLimit))
+	})
+}
+
+// ApplySettings returns base overridden by the non-zero runtime rate limit settings.
+func ApplySettings(base Config, s config.RateLimitSettings) Config {
+	if s.Enabled != nil {
+		base.Enabled = *s.Enabled
+	}
+	if s.RequestsPerMinute > 0 {
+		base.RequestsPerMinute = s.RequestsPerMinute
+	}
+	if s.BurstSize > 0 {
+		base.BurstSize = s.BurstSize
+	}
+	return base
+}
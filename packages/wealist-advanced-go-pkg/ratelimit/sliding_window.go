@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// PolicyLimiter checks requests against a Policy.
+type PolicyLimiter interface {
+	// AllowPolicy checks if a request with the given key is allowed by the policy.
+	// The error is returned only for infrastructure failures (e.g., Redis down).
+	AllowPolicy(ctx context.Context, key string, policy Policy) (*Result, error)
+
+	// GetConfig returns the limiter configuration used for defaults (FailOpen, ExcludePaths, ...).
+	GetConfig() Config
+}
+
+// slidingWindowScript implements the sliding window counter algorithm.
+// The count of the previous fixed window is weighted by how much of it still overlaps the
+// sliding window, so memory is O(1) per key (unlike a sorted-set log) and bursts at window
+// boundaries are smoothed out. Denied requests are not counted.
+//
+// KEYS[1] = current window counter, KEYS[2] = previous window counter
+// ARGV[1] = quota, ARGV[2] = previous window weight (0..1), ARGV[3] = counter TTL (ms)
+// Returns {allowed (0|1), current count, previous count}.
+var slidingWindowScript = redis.NewScript(`
+local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+local previous = tonumber(redis.call('GET', KEYS[2]) or '0')
+local quota = tonumber(ARGV[1])
+if math.floor(previous * tonumber(ARGV[2])) + current >= quota then
+	return {0, current, previous}
+end
+current = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return {1, current, previous}
+`)
+
+// AllowPolicy checks a request against the policy using a sliding window counter.
+// Buckets are separated by policy name and key: {KeyPrefix}{policy}:{key}:{window index}.
+func (r *RedisRateLimiter) AllowPolicy(ctx context.Context, key string, policy Policy) (*Result, error) {
+	config := r.GetConfig()
+	window := policy.window()
+	w := newSlidingWindow(time.Now(), window)
+	base := config.KeyPrefix + policy.Name + ":" + key + ":"
+
+	res, err := slidingWindowScript.Run(ctx, r.client,
+		[]string{base + strconv.FormatInt(w.index, 10), base + strconv.FormatInt(w.index-1, 10)},
+		policy.Quota(), w.previousWeight(), (2 * window).Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		r.logger.Warn("rate limiter redis error",
+			zap.String("key", key),
+			zap.String("policy", policy.Name),
+			zap.Error(err),
+		)
+		windowMs := window.Milliseconds()
+		if config.FailOpen {
+			return &Result{Allowed: true, Remaining: -1, ResetAfterMs: windowMs}, err
+		}
+		return &Result{Allowed: false, Remaining: 0, ResetAfterMs: windowMs, RetryAfterMs: windowMs}, err
+	}
+
+	result := w.result(res[0] == 1, res[1], res[2], int64(policy.Quota()))
+	if !result.Allowed {
+		r.logger.Debug("rate limit exceeded",
+			zap.String("key", key),
+			zap.String("policy", policy.Name),
+			zap.Int("limit", policy.Quota()),
+		)
+	}
+	return result, nil
+}
+
+// slidingWindow is the position of a request within fixed windows of the given size.
+type slidingWindow struct {
+	index     int64 // current fixed window index (now / window)
+	elapsedMs int64 // time elapsed in the current fixed window
+	windowMs  int64
+}
+
+func newSlidingWindow(now time.Time, window time.Duration) slidingWindow {
+	windowMs := max(window.Milliseconds(), 1)
+	nowMs := now.UnixMilli()
+	return slidingWindow{
+		index:     nowMs / windowMs,
+		elapsedMs: nowMs % windowMs,
+		windowMs:  windowMs,
+	}
+}
+
+// previousWeight is the fraction of the previous fixed window still inside the sliding window.
+func (w slidingWindow) previousWeight() float64 {
+	return float64(w.windowMs-w.elapsedMs) / float64(w.windowMs)
+}
+
+// result builds the Result from the counters returned by the script.
+func (w slidingWindow) result(allowed bool, current, previous, quota int64) *Result {
+	used := int64(float64(previous)*w.previousWeight()) + current
+	remaining := quota - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	result := &Result{
+		Allowed:      allowed,
+		Remaining:    int(remaining),
+		ResetAfterMs: w.windowMs - w.elapsedMs,
+	}
+	if !allowed {
+		result.RetryAfterMs = w.retryAfterMs(current, previous, quota)
+	}
+	return result
+}
+
+// retryAfterMs estimates when the next request will be allowed.
+func (w slidingWindow) retryAfterMs(current, previous, quota int64) int64 {
+	untilNextWindow := w.windowMs - w.elapsedMs
+	if current >= quota || previous == 0 {
+		// The current window is full: wait for the next window, until this window's weight drops enough.
+		if current == 0 {
+			return untilNextWindow
+		}
+		weight := float64(quota-1) / float64(current)
+		if weight > 1 {
+			weight = 1
+		}
+		return untilNextWindow + int64((1-weight)*float64(w.windowMs))
+	}
+	// Wait until the previous window's weight drops to (quota-1-current)/previous.
+	weight := float64(quota-1-current) / float64(previous)
+	elapsed := int64((1 - weight) * float64(w.windowMs))
+	if elapsed <= w.elapsedMs {
+		return 0
+	}
+	return elapsed - w.elapsedMs
+}
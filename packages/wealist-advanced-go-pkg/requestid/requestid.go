@@ -0,0 +1,57 @@
+// Package requestid는 X-Request-ID를 context로 전달하는 도구를 제공합니다.
+// HTTP 미들웨어가 요청마다 ID를 정하면(수신 헤더 재사용 또는 새로 생성) 로거, 내부 HTTP 클라이언트,
+// gRPC 클라이언트가 같은 ID를 이어 받아 board→user→noti 호출을 하나의 ID로 추적할 수 있습니다.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header is the HTTP header carrying the request ID.
+const Header = "X-Request-ID"
+
+// MetadataKey is the gRPC metadata key carrying the request ID (metadata keys are lowercase).
+const MetadataKey = "x-request-id"
+
+// maxLength는 외부에서 받은 ID의 최대 길이입니다. 로그 폭증을 막기 위해 더 긴 값은 버립니다.
+const maxLength = 128
+
+type contextKey struct{}
+
+// New generates a new request ID.
+func New() string {
+	return uuid.New().String()
+}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Sanitize returns id if it is safe to reuse as a request ID, or "" otherwise.
+// 클라이언트가 보낸 값은 로그와 응답 헤더에 그대로 쓰이므로 출력 가능한 ASCII만 허용합니다.
+func Sanitize(id string) string {
+	if id == "" || len(id) > maxLength {
+		return ""
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return ""
+		}
+	}
+	return id
+}
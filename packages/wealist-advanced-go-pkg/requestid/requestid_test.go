@@ -0,0 +1,39 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestContextRoundTrip(t *testing.T) {
+	ctx := NewContext(context.Background(), "req-123")
+	if got := FromContext(ctx); got != "req-123" {
+		t.Errorf("expected req-123, got %q", got)
+	}
+	if got := FromContext(context.Background()); got != "" {
+		t.Errorf("expected empty request ID, got %q", got)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{"uuid", "3f1c2a9e-7b4d-4c1e-9a57-0f3b2d1e8c6a", "3f1c2a9e-7b4d-4c1e-9a57-0f3b2d1e8c6a"},
+		{"empty", "", ""},
+		{"too long", strings.Repeat("a", maxLength+1), ""},
+		{"newline", "abc\ninjected", ""},
+		{"space", "abc def", ""},
+		{"non ascii", "요청", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sanitize(tt.id); got != tt.want {
+				t.Errorf("Sanitize(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
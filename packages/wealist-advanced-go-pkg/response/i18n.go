@@ -0,0 +1,183 @@
+package response
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// SourceLanguage는 핸들러가 에러 메시지를 작성하는 언어입니다.
+// 클라이언트가 이 언어를 원하거나 Accept-Language가 없으면 핸들러 메시지를 그대로 응답합니다.
+const SourceLanguage = "en"
+
+// Bundle은 한 언어의 에러 메시지 모음입니다.
+//
+//	codes:                     # 에러 코드별 기본 메시지
+//	  NOT_FOUND: 요청한 리소스를 찾을 수 없습니다
+//	messages:                  # 핸들러 메시지(영어 원문)별 번역, 코드 메시지보다 우선
+//	  Board not found: 보드를 찾을 수 없습니다
+type Bundle struct {
+	Codes    map[string]string `yaml:"codes"`
+	Messages map[string]string `yaml:"messages"`
+}
+
+//go:embed locales/*.yaml
+var defaultLocales embed.FS
+
+var (
+	bundlesMu sync.RWMutex
+	bundles   = map[string]*Bundle{}
+	matcher   language.Matcher
+	languages []string // matcher의 태그 순서와 같은 언어 목록 (첫 번째는 SourceLanguage)
+)
+
+func init() {
+	MustLoadBundles(defaultLocales, "locales")
+}
+
+// RegisterBundle은 lang 언어의 메시지를 추가합니다. 같은 키는 나중에 등록한 값으로 덮어씁니다.
+// 서비스 전용 번역은 서비스의 response 패키지 init에서 등록합니다.
+func RegisterBundle(lang string, b Bundle) {
+	lang = baseLanguage(lang)
+
+	bundlesMu.Lock()
+	defer bundlesMu.Unlock()
+	existing, ok := bundles[lang]
+	if !ok {
+		existing = &Bundle{Codes: map[string]string{}, Messages: map[string]string{}}
+		bundles[lang] = existing
+	}
+	for code, msg := range b.Codes {
+		existing.Codes[code] = msg
+	}
+	for source, msg := range b.Messages {
+		existing.Messages[source] = msg
+	}
+	rebuildMatcher()
+}
+
+// LoadBundles는 dir의 <lang>.yaml 파일(예: ko.yaml, en.yaml)을 읽어 등록합니다.
+func LoadBundles(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.yaml"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		raw, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		var b Bundle
+		if err := yaml.Unmarshal(raw, &b); err != nil {
+			return fmt.Errorf("response: invalid message bundle %s: %w", file, err)
+		}
+		RegisterBundle(strings.TrimSuffix(path.Base(file), ".yaml"), b)
+	}
+	return nil
+}
+
+// MustLoadBundles는 LoadBundles와 같지만 실패하면 panic합니다.
+func MustLoadBundles(fsys fs.FS, dir string) {
+	if err := LoadBundles(fsys, dir); err != nil {
+		panic(err)
+	}
+}
+
+// rebuildMatcher는 등록된 언어로 Accept-Language matcher를 다시 만듭니다. bundlesMu를 잡은 상태에서 호출합니다.
+func rebuildMatcher() {
+	languages = []string{SourceLanguage}
+	for lang := range bundles {
+		if lang != SourceLanguage {
+			languages = append(languages, lang)
+		}
+	}
+	tags := make([]language.Tag, len(languages))
+	for i, lang := range languages {
+		tags[i] = language.Make(lang)
+	}
+	matcher = language.NewMatcher(tags)
+}
+
+// Language는 요청의 Accept-Language에 가장 잘 맞는 지원 언어를 반환합니다.
+// 헤더가 없거나 맞는 언어가 없으면 SourceLanguage를 반환합니다.
+func Language(c *gin.Context) string {
+	accept := acceptLanguage(c)
+	if accept == "" {
+		return SourceLanguage
+	}
+
+	bundlesMu.RLock()
+	defer bundlesMu.RUnlock()
+	_, index, confidence := matcher.Match(parseAcceptLanguage(accept)...)
+	if confidence == language.No {
+		return SourceLanguage
+	}
+	return languages[index]
+}
+
+// Localize는 code 에러의 message를 요청 언어로 바꿔 반환합니다.
+//
+// 찾는 순서:
+//  1. 요청 언어 번들의 messages[message] (서비스별 원문 번역)
+//  2. 요청 언어 번들의 codes[code]
+//  3. message (요청 언어가 SourceLanguage이거나 번역이 없을 때)
+//
+// message가 비어 있으면 SourceLanguage 번들의 코드 메시지를 사용합니다.
+func Localize(c *gin.Context, code, message string) string {
+	lang := Language(c)
+
+	bundlesMu.RLock()
+	defer bundlesMu.RUnlock()
+	if lang != SourceLanguage {
+		if b, ok := bundles[lang]; ok {
+			if msg, ok := b.Messages[message]; ok && message != "" {
+				return msg
+			}
+			if msg, ok := b.Codes[code]; ok {
+				return msg
+			}
+		}
+	}
+	if message == "" {
+		if b, ok := bundles[SourceLanguage]; ok {
+			return b.Codes[code]
+		}
+	}
+	return message
+}
+
+// setContentLanguage는 응답 메시지의 언어를 Content-Language 헤더로 알려 줍니다.
+func setContentLanguage(c *gin.Context) {
+	if acceptLanguage(c) != "" {
+		c.Header("Content-Language", Language(c))
+	}
+}
+
+func acceptLanguage(c *gin.Context) string {
+	if c == nil || c.Request == nil {
+		return ""
+	}
+	return c.GetHeader("Accept-Language")
+}
+
+// parseAcceptLanguage는 Accept-Language 헤더를 선호 순서대로 파싱합니다. 잘못된 항목은 무시합니다.
+func parseAcceptLanguage(header string) []language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+	return tags
+}
+
+// baseLanguage는 ko-KR 같은 태그를 번들 이름(ko)으로 정규화합니다.
+func baseLanguage(lang string) string {
+	base, _ := language.Make(lang).Base()
+	return base.String()
+}
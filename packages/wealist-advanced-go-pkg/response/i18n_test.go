@@ -0,0 +1,130 @@
+// Package response는 HTTP 응답 유틸리티를 제공합니다.
+// 이 파일은 i18n.go의 테스트를 포함합니다.
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+)
+
+func newLocalizedContext(acceptLanguage string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	if acceptLanguage != "" {
+		c.Request.Header.Set("Accept-Language", acceptLanguage)
+	}
+	return c, w
+}
+
+// TestLanguage는 Accept-Language 협상 결과를 테스트합니다.
+func TestLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: "en"},
+		{header: "ko", want: "ko"},
+		{header: "ko-KR,ko;q=0.9,en-US;q=0.8", want: "ko"},
+		{header: "en-US,en;q=0.9,ko;q=0.8", want: "en"},
+		{header: "fr-FR", want: "en"},
+		{header: "fr;q=0.9,ko;q=0.5", want: "ko"},
+		{header: "not a language;;", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			c, _ := newLocalizedContext(tt.header)
+			if got := Language(c); got != tt.want {
+				t.Errorf("Language(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLocalize는 메시지 번역 우선순위(원문 번역 → 코드 메시지 → 원문)를 테스트합니다.
+func TestLocalize(t *testing.T) {
+	RegisterBundle("ko", Bundle{Messages: map[string]string{"Widget not found": "위젯을 찾을 수 없습니다"}})
+
+	tests := []struct {
+		name    string
+		header  string
+		code    string
+		message string
+		want    string
+	}{
+		{name: "source language keeps message", header: "en", code: apperrors.ErrCodeNotFound, message: "Widget not found", want: "Widget not found"},
+		{name: "no header keeps message", code: apperrors.ErrCodeNotFound, message: "Widget not found", want: "Widget not found"},
+		{name: "message translation", header: "ko-KR", code: apperrors.ErrCodeNotFound, message: "Widget not found", want: "위젯을 찾을 수 없습니다"},
+		{name: "code fallback", header: "ko", code: apperrors.ErrCodeNotFound, message: "Gadget not found", want: "요청한 리소스를 찾을 수 없습니다"},
+		{name: "unknown code keeps message", header: "ko", code: "SOMETHING_ELSE", message: "Something failed", want: "Something failed"},
+		{name: "empty message uses code message", header: "en", code: apperrors.ErrCodeForbidden, want: "You do not have permission to perform this action"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newLocalizedContext(tt.header)
+			if got := Localize(c, tt.code, tt.message); got != tt.want {
+				t.Errorf("Localize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestError_Localized는 에러 응답의 message와 Content-Language 헤더를 테스트합니다.
+func TestError_Localized(t *testing.T) {
+	c, w := newLocalizedContext("ko-KR,ko;q=0.9")
+
+	NotFound(c, "Resource not found")
+
+	if got := w.Header().Get("Content-Language"); got != "ko" {
+		t.Errorf("Content-Language = %q, want ko", got)
+	}
+	var body struct {
+		Error ErrorDetail `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.Error.Code != apperrors.ErrCodeNotFound || body.Error.Message != "요청한 리소스를 찾을 수 없습니다" {
+		t.Errorf("unexpected error detail: %+v", body.Error)
+	}
+}
+
+// TestDefaultBundles_CoverCatalog는 공통 카탈로그의 모든 코드에 ko/en 메시지가 있는지 확인합니다.
+func TestDefaultBundles_CoverCatalog(t *testing.T) {
+	for _, lang := range []string{"en", "ko"} {
+		for _, info := range apperrors.Catalog() {
+			if _, ok := bundles[lang].Codes[info.Code]; !ok {
+				t.Errorf("%s bundle has no message for %s", lang, info.Code)
+			}
+		}
+	}
+}
+
+// TestLoadBundles는 <lang>.yaml 파일에서 번들을 읽는지 테스트합니다.
+func TestLoadBundles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/ko.yaml": {Data: []byte("codes:\n  GADGET_BROKEN: 가젯이 고장났습니다\n")},
+	}
+	if err := LoadBundles(fsys, "locales"); err != nil {
+		t.Fatalf("LoadBundles() error = %v", err)
+	}
+
+	c, _ := newLocalizedContext("ko")
+	if got := Localize(c, "GADGET_BROKEN", "Gadget is broken"); got != "가젯이 고장났습니다" {
+		t.Errorf("Localize() = %q", got)
+	}
+
+	invalid := fstest.MapFS{"locales/ko.yaml": {Data: []byte("codes: [broken\n")}}
+	if err := LoadBundles(invalid, "locales"); err == nil {
+		t.Error("expected error for invalid bundle")
+	}
+}
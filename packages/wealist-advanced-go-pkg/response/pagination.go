@@ -0,0 +1,147 @@
+package response
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 페이지 크기 기본값과 상한입니다.
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// ErrInvalidCursor는 커서를 디코딩할 수 없을 때 반환됩니다.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PageRequest는 오프셋 페이지네이션 요청입니다 (?page=1&limit=20).
+type PageRequest struct {
+	Page  int
+	Limit int
+}
+
+// Offset은 조회를 건너뛸 행 수를 반환합니다.
+func (p PageRequest) Offset() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// ParsePageRequest는 page, limit 쿼리를 읽습니다.
+// 잘못된 값은 기본값(page 1, defaultLimit)을 사용하고 limit은 MaxPageLimit으로 제한합니다.
+// defaultLimit이 0 이하이면 DefaultPageLimit을 사용합니다.
+func ParsePageRequest(c *gin.Context, defaultLimit int) PageRequest {
+	return PageRequest{
+		Page:  queryInt(c, "page", 1),
+		Limit: clampLimit(queryInt(c, "limit", defaultLimit), defaultLimit),
+	}
+}
+
+// Pagination은 오프셋 페이지네이션 응답 메타입니다.
+type Pagination struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"totalPages"`
+	HasNext    bool  `json:"hasNext"`
+}
+
+// NewPagination은 요청과 전체 개수로 페이지 메타를 만듭니다.
+func NewPagination(page, limit int, total int64) *Pagination {
+	totalPages := 0
+	if limit > 0 {
+		totalPages = int((total + int64(limit) - 1) / int64(limit))
+	}
+	return &Pagination{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+	}
+}
+
+// Paginated는 오프셋 페이지 정보와 함께 200 응답을 전송합니다.
+func Paginated(c *gin.Context, data interface{}, pagination *Pagination) {
+	SuccessWithMeta(c, http.StatusOK, data, &Meta{Pagination: pagination})
+}
+
+// CursorRequest는 커서 페이지네이션 요청입니다 (?cursor=...&limit=20).
+type CursorRequest struct {
+	Cursor string
+	Limit  int
+}
+
+// ParseCursorRequest는 cursor, limit 쿼리를 읽습니다. limit 처리는 ParsePageRequest와 같습니다.
+func ParseCursorRequest(c *gin.Context, defaultLimit int) CursorRequest {
+	return CursorRequest{
+		Cursor: c.Query("cursor"),
+		Limit:  clampLimit(queryInt(c, "limit", defaultLimit), defaultLimit),
+	}
+}
+
+// CursorPagination은 커서 페이지네이션 응답 메타입니다.
+// NextCursor를 다음 요청의 cursor로 그대로 전달하면 다음 페이지를 조회합니다.
+type CursorPagination struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasMore    bool   `json:"hasMore"`
+}
+
+// NewCursorPagination은 다음 커서로 페이지 메타를 만듭니다. 다음 커서가 비어 있으면 마지막 페이지입니다.
+func NewCursorPagination(limit int, nextCursor string) *CursorPagination {
+	return &CursorPagination{
+		Limit:      limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}
+}
+
+// CursorPaginated는 커서 페이지 정보와 함께 200 응답을 전송합니다.
+func CursorPaginated(c *gin.Context, data interface{}, cursor *CursorPagination) {
+	SuccessWithMeta(c, http.StatusOK, data, &Meta{Cursor: cursor})
+}
+
+// EncodeCursor는 커서 위치(예: 마지막 항목의 생성 시각과 ID)를 불투명한 문자열로 인코딩합니다.
+func EncodeCursor(position interface{}) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor는 EncodeCursor로 만든 커서를 position으로 디코딩합니다.
+// 클라이언트가 변조한 커서는 ErrInvalidCursor를 반환합니다.
+func DecodeCursor(cursor string, position interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, position); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// queryInt는 양의 정수 쿼리 값을 읽고, 없거나 잘못되면 fallback을 반환합니다.
+func queryInt(c *gin.Context, key string, fallback int) int {
+	n, err := strconv.Atoi(c.Query(key))
+	if err != nil || n < 1 {
+		return fallback
+	}
+	return n
+}
+
+func clampLimit(limit, defaultLimit int) int {
+	if limit < 1 {
+		limit = defaultLimit
+	}
+	if limit < 1 {
+		limit = DefaultPageLimit
+	}
+	return min(limit, MaxPageLimit)
+}
@@ -0,0 +1,136 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newQueryContext(query string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/items?"+query, nil)
+	return c, w
+}
+
+// TestParsePageRequest는 page, limit 쿼리의 기본값과 제한을 테스트합니다.
+func TestParsePageRequest(t *testing.T) {
+	tests := []struct {
+		query     string
+		wantPage  int
+		wantLimit int
+	}{
+		{"", 1, 20},
+		{"page=3&limit=10", 3, 10},
+		{"page=0&limit=-5", 1, 20},
+		{"page=abc&limit=xyz", 1, 20},
+		{"limit=1000", 1, MaxPageLimit},
+	}
+
+	for _, tt := range tests {
+		c, _ := newQueryContext(tt.query)
+		req := ParsePageRequest(c, 0)
+		if req.Page != tt.wantPage || req.Limit != tt.wantLimit {
+			t.Errorf("%q: 예상 page=%d limit=%d, 실제 page=%d limit=%d", tt.query, tt.wantPage, tt.wantLimit, req.Page, req.Limit)
+		}
+	}
+
+	c, _ := newQueryContext("page=2")
+	req := ParsePageRequest(c, 50)
+	if req.Limit != 50 || req.Offset() != 50 {
+		t.Errorf("기본 limit 50, offset 50이어야 함, 실제 limit=%d offset=%d", req.Limit, req.Offset())
+	}
+}
+
+// TestNewPagination은 전체 페이지 수와 다음 페이지 여부 계산을 테스트합니다.
+func TestNewPagination(t *testing.T) {
+	p := NewPagination(1, 20, 41)
+	if p.TotalPages != 3 || !p.HasNext {
+		t.Errorf("예상 totalPages=3 hasNext=true, 실제 %+v", p)
+	}
+
+	p = NewPagination(3, 20, 41)
+	if p.HasNext {
+		t.Error("마지막 페이지는 hasNext가 false여야 함")
+	}
+
+	p = NewPagination(1, 20, 0)
+	if p.TotalPages != 0 || p.HasNext {
+		t.Errorf("빈 결과는 totalPages=0 hasNext=false여야 함, 실제 %+v", p)
+	}
+}
+
+// TestPaginated는 페이지 정보가 meta.pagination에 담기는지 테스트합니다.
+func TestPaginated(t *testing.T) {
+	c, w := newQueryContext("")
+
+	Paginated(c, []string{"a", "b"}, NewPagination(1, 2, 5))
+
+	var body struct {
+		Success bool     `json:"success"`
+		Data    []string `json:"data"`
+		Meta    Meta     `json:"meta"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+	if !body.Success || len(body.Data) != 2 {
+		t.Errorf("success와 data가 설정되어야 함, 실제: %s", w.Body.String())
+	}
+	if body.Meta.Pagination == nil || body.Meta.Pagination.Total != 5 || body.Meta.Pagination.TotalPages != 3 {
+		t.Errorf("meta.pagination이 설정되어야 함, 실제: %s", w.Body.String())
+	}
+	if body.Meta.Cursor != nil {
+		t.Error("오프셋 페이지 응답에 cursor가 있으면 안됨")
+	}
+}
+
+// TestCursorRoundTrip은 커서 인코딩/디코딩과 변조된 커서 처리를 테스트합니다.
+func TestCursorRoundTrip(t *testing.T) {
+	type position struct {
+		CreatedAt string `json:"createdAt"`
+		ID        string `json:"id"`
+	}
+
+	cursor, err := EncodeCursor(position{CreatedAt: "2026-01-02T03:04:05Z", ID: "abc"})
+	if err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+
+	var decoded position
+	if err := DecodeCursor(cursor, &decoded); err != nil {
+		t.Fatalf("디코딩 실패: %v", err)
+	}
+	if decoded.ID != "abc" || decoded.CreatedAt != "2026-01-02T03:04:05Z" {
+		t.Errorf("디코딩 결과가 원본과 같아야 함, 실제: %+v", decoded)
+	}
+
+	for _, bad := range []string{"!!!", "bm90LWpzb24"} {
+		if err := DecodeCursor(bad, &decoded); err != ErrInvalidCursor {
+			t.Errorf("%q: ErrInvalidCursor를 반환해야 함, 실제: %v", bad, err)
+		}
+	}
+}
+
+// TestCursorPaginated는 커서 정보가 meta.cursor에 담기는지 테스트합니다.
+func TestCursorPaginated(t *testing.T) {
+	c, _ := newQueryContext("cursor=abc&limit=5")
+	req := ParseCursorRequest(c, 0)
+	if req.Cursor != "abc" || req.Limit != 5 {
+		t.Errorf("예상 cursor=abc limit=5, 실제 %+v", req)
+	}
+
+	c, w := newQueryContext("")
+	CursorPaginated(c, []int{1}, NewCursorPagination(5, ""))
+
+	var body SuccessResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+	if body.Meta == nil || body.Meta.Cursor == nil || body.Meta.Cursor.HasMore {
+		t.Errorf("마지막 페이지는 hasMore가 false여야 함, 실제: %s", w.Body.String())
+	}
+}
@@ -1,50 +1,124 @@
 // Package response는 HTTP 응답 유틸리티를 제공합니다.
+//
+// 모든 서비스는 같은 응답 봉투(envelope)를 사용합니다.
+//
+//	성공: {"success": true, "data": ..., "meta": {"pagination": ...}, "requestId": "..."}
+//	실패: {"success": false, "error": {"code": "...", "category": "...", "message": "...", "details": "..."}, "requestId": "..."}
+//
+// 에러 코드는 errors 패키지의 ErrCode* 상수(카탈로그에 등록된 코드)를 사용합니다.
+// category는 코드의 분류(validation, auth, conflict 등)로, 클라이언트가 코드를 모두 알지 못해도 일관되게 처리할 수 있게 합니다.
+//
+// 에러 message는 Accept-Language에 따라 번역됩니다. 핸들러는 영어로 작성하고, 번역은 언어별 번들(locales/<lang>.yaml)에
+// 에러 코드별로 두며 서비스는 자체 번들을 RegisterBundle/LoadBundles로 추가합니다.
 package response
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/requestid"
 )
 
 // SuccessResponse는 성공 API 응답 구조체입니다.
 type SuccessResponse struct {
+	Success   bool        `json:"success"`
 	Data      interface{} `json:"data"`
+	Meta      *Meta       `json:"meta,omitempty"`
 	RequestID string      `json:"requestId"`
 }
 
 // ErrorResponse는 에러 API 응답 구조체입니다.
 type ErrorResponse struct {
+	Success   bool        `json:"success"`
 	Error     interface{} `json:"error"`
 	RequestID string      `json:"requestId"`
 }
 
+// Meta는 응답 데이터의 부가 정보입니다. 목록 응답의 페이지 정보를 담습니다.
+type Meta struct {
+	Pagination *Pagination       `json:"pagination,omitempty"`
+	Cursor     *CursorPagination `json:"cursor,omitempty"`
+}
+
 // ErrorDetail은 에러 상세 정보 구조체입니다.
 type ErrorDetail struct {
-	Code    string `json:"code"`
+	Code     string             `json:"code"`
+	Category apperrors.Category `json:"category,omitempty"`
+	Message  string             `json:"message"`
+	Details  string             `json:"details,omitempty"`
+	Fields   []FieldError       `json:"fields,omitempty"`
+	// Current는 동시 수정 충돌(409) 시 서버에 저장된 리소스의 현재 상태입니다.
+	Current interface{} `json:"current,omitempty"`
+}
+
+// FieldError는 요청 필드 하나의 유효성 검증 실패입니다.
+// Field는 JSON 경로(예: "title", "items[0].name"), Tag는 실패한 규칙(예: "required", "max", "unknown")입니다.
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
 	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
 }
 
 // getRequestID는 컨텍스트에서 요청 ID를 가져오거나 생성합니다.
 func getRequestID(c *gin.Context) string {
-	// 미들웨어에서 설정한 요청 ID 확인
-	if requestID, exists := c.Get("requestId"); exists {
-		if id, ok := requestID.(string); ok {
+	// 미들웨어에서 설정한 요청 ID 확인 (middleware.RequestIDKey와 이전 키 "requestId")
+	for _, key := range []string{"request_id", "requestId"} {
+		if requestID, exists := c.Get(key); exists {
+			if id, ok := requestID.(string); ok && id != "" {
+				return id
+			}
+		}
+	}
+	if c.Request != nil {
+		if id := requestid.FromContext(c.Request.Context()); id != "" {
+			return id
+		}
+		if id := requestid.Sanitize(c.GetHeader(requestid.Header)); id != "" {
 			return id
 		}
 	}
 	// 없으면 새로 생성
-	return uuid.New().String()
+	return requestid.New()
 }
 
 // Success는 성공 응답을 전송합니다.
 func Success(c *gin.Context, statusCode int, data interface{}) {
 	c.JSON(statusCode, SuccessResponse{
+		Success:   true,
+		Data:      data,
+		RequestID: getRequestID(c),
+	})
+}
+
+// SuccessWithMeta는 메타 정보(페이지 정보 등)와 함께 성공 응답을 전송합니다.
+func SuccessWithMeta(c *gin.Context, statusCode int, data interface{}, meta *Meta) {
+	c.JSON(statusCode, SuccessResponse{
+		Success:   true,
 		Data:      data,
+		Meta:      meta,
 		RequestID: getRequestID(c),
 	})
 }
 
+// OK는 200 성공 응답을 전송합니다.
+func OK(c *gin.Context, data interface{}) {
+	Success(c, http.StatusOK, data)
+}
+
+// Created는 201 성공 응답을 전송합니다.
+func Created(c *gin.Context, data interface{}) {
+	Success(c, http.StatusCreated, data)
+}
+
+// NoContent는 본문 없는 204 응답을 전송합니다.
+func NoContent(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
+
 // SuccessWithMessage는 메시지와 함께 성공 응답을 전송합니다. (하위 호환성)
 func SuccessWithMessage(c *gin.Context, statusCode int, data interface{}, message string) {
 	responseData := data
@@ -52,17 +126,22 @@ func SuccessWithMessage(c *gin.Context, statusCode int, data interface{}, messag
 		responseData = map[string]string{"message": message}
 	}
 	c.JSON(statusCode, SuccessResponse{
+		Success:   true,
 		Data:      responseData,
 		RequestID: getRequestID(c),
 	})
 }
 
 // Error는 에러 응답을 전송합니다.
+// message는 Accept-Language에 맞게 번역됩니다 (Localize 참고).
 func Error(c *gin.Context, statusCode int, code string, message string) {
+	setContentLanguage(c)
 	c.JSON(statusCode, ErrorResponse{
+		Success: false,
 		Error: ErrorDetail{
-			Code:    code,
-			Message: message,
+			Code:     code,
+			Category: apperrors.CategoryOf(code),
+			Message:  Localize(c, code, message),
 		},
 		RequestID: getRequestID(c),
 	})
@@ -70,11 +149,14 @@ func Error(c *gin.Context, statusCode int, code string, message string) {
 
 // ErrorWithDetails는 상세 정보와 함께 에러 응답을 전송합니다.
 func ErrorWithDetails(c *gin.Context, statusCode int, code string, message string, details string) {
+	setContentLanguage(c)
 	c.JSON(statusCode, ErrorResponse{
+		Success: false,
 		Error: ErrorDetail{
-			Code:    code,
-			Message: message,
-			Details: details,
+			Code:     code,
+			Category: apperrors.CategoryOf(code),
+			Message:  Localize(c, code, message),
+			Details:  details,
 		},
 		RequestID: getRequestID(c),
 	})
@@ -82,30 +164,110 @@ func ErrorWithDetails(c *gin.Context, statusCode int, code string, message strin
 
 // BadRequest는 400 에러 응답을 전송합니다.
 func BadRequest(c *gin.Context, message string) {
-	Error(c, 400, "BAD_REQUEST", message)
+	Error(c, http.StatusBadRequest, apperrors.ErrCodeBadRequest, message)
+}
+
+// ValidationError는 유효성 검증 실패 400 에러 응답을 전송합니다.
+func ValidationError(c *gin.Context, message string) {
+	Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, message)
+}
+
+// ValidationFailed는 필드별 유효성 검증 실패 400 에러 응답을 전송합니다.
+func ValidationFailed(c *gin.Context, fields []FieldError) {
+	setContentLanguage(c)
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Success: false,
+		Error: ErrorDetail{
+			Code:     apperrors.ErrCodeValidation,
+			Category: apperrors.CategoryOf(apperrors.ErrCodeValidation),
+			Message:  Localize(c, apperrors.ErrCodeValidation, "Request validation failed"),
+			Fields:   fields,
+		},
+		RequestID: getRequestID(c),
+	})
 }
 
 // Unauthorized는 401 에러 응답을 전송합니다.
 func Unauthorized(c *gin.Context, message string) {
-	Error(c, 401, "UNAUTHORIZED", message)
+	Error(c, http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, message)
 }
 
 // Forbidden는 403 에러 응답을 전송합니다.
 func Forbidden(c *gin.Context, message string) {
-	Error(c, 403, "FORBIDDEN", message)
+	Error(c, http.StatusForbidden, apperrors.ErrCodeForbidden, message)
 }
 
 // NotFound는 404 에러 응답을 전송합니다.
 func NotFound(c *gin.Context, message string) {
-	Error(c, 404, "NOT_FOUND", message)
+	Error(c, http.StatusNotFound, apperrors.ErrCodeNotFound, message)
 }
 
 // Conflict는 409 에러 응답을 전송합니다.
 func Conflict(c *gin.Context, message string) {
-	Error(c, 409, "CONFLICT", message)
+	Error(c, http.StatusConflict, apperrors.ErrCodeConflict, message)
+}
+
+// ConflictWithCurrent는 리소스의 현재 상태를 담은 409 에러 응답을 전송합니다.
+// 낙관적 동시성 제어에서 클라이언트가 다시 받지 않고 최신 상태로 맞출 수 있게 합니다.
+func ConflictWithCurrent(c *gin.Context, message string, current interface{}) {
+	setContentLanguage(c)
+	c.JSON(http.StatusConflict, ErrorResponse{
+		Success: false,
+		Error: ErrorDetail{
+			Code:     apperrors.ErrCodeConflict,
+			Category: apperrors.CategoryOf(apperrors.ErrCodeConflict),
+			Message:  Localize(c, apperrors.ErrCodeConflict, message),
+			Current:  current,
+		},
+		RequestID: getRequestID(c),
+	})
 }
 
 // InternalError는 500 에러 응답을 전송합니다.
 func InternalError(c *gin.Context, message string) {
-	Error(c, 500, "INTERNAL_ERROR", message)
+	Error(c, http.StatusInternalServerError, apperrors.ErrCodeInternal, message)
+}
+
+// ServiceUnavailable는 503 에러 응답을 전송합니다.
+func ServiceUnavailable(c *gin.Context, message string) {
+	Error(c, http.StatusServiceUnavailable, apperrors.ErrCodeServiceUnavailable, message)
+}
+
+// TooManyRequests는 429 에러 응답을 전송합니다.
+func TooManyRequests(c *gin.Context, message string) {
+	Error(c, http.StatusTooManyRequests, apperrors.ErrCodeRateLimited, message)
+}
+
+// ErrorFrom은 AppError를 에러 코드에 맞는 HTTP 상태로 전송합니다.
+// 5xx 에러의 상세 정보는 내부 정보가 노출되지 않도록 응답에서 제외합니다.
+func ErrorFrom(c *gin.Context, appErr *apperrors.AppError) {
+	status := apperrors.GetHTTPStatus(appErr.Code)
+	details := appErr.Details
+	if status >= http.StatusInternalServerError {
+		details = ""
+	}
+	ErrorWithDetails(c, status, appErr.Code, appErr.Message, details)
+}
+
+// HandleError는 서비스 레이어 에러를 공통 에러 응답으로 변환해 전송합니다.
+// 처리 순서:
+//  1. AppError → 에러 코드에 따른 상태 코드
+//  2. GORM ErrRecordNotFound → 404
+//  3. 기타 에러 → 500
+//
+// 서비스별 sentinel 에러는 각 서비스에서 먼저 매핑한 뒤 이 함수로 넘깁니다.
+func HandleError(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var appErr *apperrors.AppError
+	switch {
+	case errors.As(err, &appErr):
+		ErrorFrom(c, appErr)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		NotFound(c, "Resource not found")
+	default:
+		InternalError(c, "An internal error occurred")
+	}
 }
@@ -4,11 +4,16 @@ package response
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 )
 
 func init() {
@@ -348,6 +353,32 @@ func TestConflict(t *testing.T) {
 	}
 }
 
+// TestConflictWithCurrent는 ConflictWithCurrent 함수가 현재 상태를 담은 409 응답을 반환하는지 테스트합니다.
+func TestConflictWithCurrent(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	ConflictWithCurrent(c, "Resource was modified", map[string]interface{}{"version": 3})
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("예상 상태 코드: %d, 실제: %d", http.StatusConflict, w.Code)
+	}
+
+	var response ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+
+	errorDetail, ok := response.Error.(map[string]interface{})
+	if !ok {
+		t.Fatal("error가 map이어야 함")
+	}
+	current, ok := errorDetail["current"].(map[string]interface{})
+	if errorDetail["code"] != "CONFLICT" || !ok || current["version"] != float64(3) {
+		t.Errorf("error에 code와 current가 설정되어야 함, 실제: %v", errorDetail)
+	}
+}
+
 // TestInternalError는 InternalError 함수가 500 응답을 반환하는지 테스트합니다.
 func TestInternalError(t *testing.T) {
 	w := httptest.NewRecorder()
@@ -513,3 +544,98 @@ func TestContentType(t *testing.T) {
 		t.Errorf("예상 Content-Type: 'application/json; charset=utf-8', 실제: '%s'", contentType)
 	}
 }
+
+// TestEnvelopeSuccessFlag는 성공/실패 응답에 success 플래그가 설정되는지 테스트합니다.
+func TestEnvelopeSuccessFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		send        func(c *gin.Context)
+		wantStatus  int
+		wantSuccess bool
+	}{
+		{"OK", func(c *gin.Context) { OK(c, "data") }, http.StatusOK, true},
+		{"Created", func(c *gin.Context) { Created(c, "data") }, http.StatusCreated, true},
+		{"NotFound", func(c *gin.Context) { NotFound(c, "missing") }, http.StatusNotFound, false},
+		{"ValidationError", func(c *gin.Context) { ValidationError(c, "invalid") }, http.StatusBadRequest, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			tt.send(c)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("예상 상태 코드: %d, 실제: %d", tt.wantStatus, w.Code)
+			}
+			var parsed map[string]interface{}
+			if err := json.Unmarshal(w.Body.Bytes(), &parsed); err != nil {
+				t.Fatalf("JSON 파싱 실패: %v", err)
+			}
+			if parsed["success"] != tt.wantSuccess {
+				t.Errorf("예상 success: %v, 실제: %v", tt.wantSuccess, parsed["success"])
+			}
+		})
+	}
+}
+
+// TestHandleError는 서비스 에러가 에러 코드와 상태 코드로 매핑되는지 테스트합니다.
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantDetails string
+	}{
+		{"AppError", apperrors.NotFound("Board not found", "id=1"), http.StatusNotFound, apperrors.ErrCodeNotFound, "id=1"},
+		{"domain AppError", apperrors.AlreadyMember("Already a member", ""), http.StatusConflict, apperrors.ErrCodeAlreadyMember, ""},
+		{"wrapped AppError", fmt.Errorf("wrap: %w", apperrors.Conflict("Duplicate", "")), http.StatusConflict, apperrors.ErrCodeConflict, ""},
+		{"internal AppError hides details", apperrors.Internal("Failed", "dial tcp 10.0.0.1"), http.StatusInternalServerError, apperrors.ErrCodeInternal, ""},
+		{"record not found", gorm.ErrRecordNotFound, http.StatusNotFound, apperrors.ErrCodeNotFound, ""},
+		{"unknown error", errors.New("boom"), http.StatusInternalServerError, apperrors.ErrCodeInternal, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			HandleError(c, tt.err)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("예상 상태 코드: %d, 실제: %d", tt.wantStatus, w.Code)
+			}
+			var body struct {
+				Success bool        `json:"success"`
+				Error   ErrorDetail `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("JSON 파싱 실패: %v", err)
+			}
+			if body.Success || body.Error.Code != tt.wantCode || body.Error.Details != tt.wantDetails {
+				t.Errorf("예상 code=%s details=%q, 실제: %s", tt.wantCode, tt.wantDetails, w.Body.String())
+			}
+			if body.Error.Category != apperrors.CategoryOf(tt.wantCode) {
+				t.Errorf("예상 category=%s, 실제: %s", apperrors.CategoryOf(tt.wantCode), body.Error.Category)
+			}
+		})
+	}
+}
+
+// TestGetRequestID_FromMiddleware는 로깅 미들웨어의 request_id 키와 X-Request-ID 헤더를 사용하는지 테스트합니다.
+func TestGetRequestID_FromMiddleware(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Set("request_id", "from-middleware")
+	if id := getRequestID(c); id != "from-middleware" {
+		t.Errorf("예상 requestId: 'from-middleware', 실제: '%s'", id)
+	}
+
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("X-Request-ID", "from-header")
+	if id := getRequestID(c); id != "from-header" {
+		t.Errorf("예상 requestId: 'from-header', 실제: '%s'", id)
+	}
+}
@@ -0,0 +1,320 @@
+package seed
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// 워크스페이스·프로젝트 멤버 역할 (user-service, board-service 공통 값)
+const (
+	RoleOwner  = "OWNER"
+	RoleAdmin  = "ADMIN"
+	RoleMember = "MEMBER"
+)
+
+// 채팅방 유형 (chat-service ChatType 값)
+const (
+	ChannelGroup   = "GROUP"
+	ChannelProject = "PROJECT"
+	ChannelDM      = "DM"
+)
+
+// Dataset은 서비스들이 나눠 저장하는 연결된 개발 데이터입니다.
+type Dataset struct {
+	Users      []User
+	Workspaces []Workspace
+	Projects   []Project
+	Channels   []Channel
+	Folders    []Folder
+	Files      []File
+}
+
+// User는 user-service 사용자입니다. 로컬에서는 매직 링크로 로그인합니다.
+type User struct {
+	ID    uuid.UUID
+	Email string
+	Name  string
+}
+
+// Member는 워크스페이스 또는 프로젝트 멤버입니다.
+type Member struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+// Workspace는 user-service 워크스페이스와 멤버입니다.
+type Workspace struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	Description string
+	Members     []Member
+}
+
+// Project는 board-service 프로젝트입니다. storage-service 프로젝트도 같은 ID로 만듭니다.
+type Project struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	Description string
+	Members     []Member
+	Boards      []Board
+}
+
+// Board는 board-service 보드(카드)입니다. Stage, Importance, Role은 기본 필드 옵션 값입니다.
+type Board struct {
+	ID         uuid.UUID
+	AuthorID   uuid.UUID
+	AssigneeID *uuid.UUID
+	Title      string
+	Content    string
+	Stage      string
+	Importance string
+	Role       string
+	DueInDays  int // 시드 실행일 기준 마감일 (0이면 없음)
+}
+
+// Channel은 chat-service 채팅방입니다.
+type Channel struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	ProjectID   *uuid.UUID
+	Type        string
+	Name        string
+	CreatedBy   uuid.UUID
+	Members     []uuid.UUID
+	Messages    []Message // 오래된 순
+}
+
+// Message는 채팅 메시지입니다.
+type Message struct {
+	ID      uuid.UUID
+	UserID  uuid.UUID
+	Content string
+}
+
+// Folder는 storage-service 폴더입니다.
+type Folder struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	ProjectID   *uuid.UUID
+	ParentID    *uuid.UUID
+	Name        string
+	Path        string
+	CreatedBy   uuid.UUID
+}
+
+// File은 storage-service 파일입니다. Content는 S3가 설정된 경우 업로드됩니다.
+type File struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	ProjectID   *uuid.UUID
+	FolderID    *uuid.UUID
+	Name        string
+	ContentType string
+	Content     []byte
+	UploadedBy  uuid.UUID
+}
+
+// User는 ID로 사용자를 찾습니다.
+func (d *Dataset) User(id uuid.UUID) (User, bool) {
+	for _, u := range d.Users {
+		if u.ID == id {
+			return u, true
+		}
+	}
+	return User{}, false
+}
+
+// Default는 기본 개발 데이터셋을 반환합니다:
+// 사용자 5명, 워크스페이스 2개, 프로젝트 3개(보드 13개), 채팅방 5개, 폴더 4개, 파일 5개.
+func Default() *Dataset {
+	alice := user("alice", "김민지")
+	bob := user("bob", "이준호")
+	carol := user("carol", "박서연")
+	dave := user("dave", "최현우")
+	erin := user("erin", "정하은")
+
+	demo := Workspace{
+		ID:          ID("workspace", "demo"),
+		OwnerID:     alice.ID,
+		Name:        "weAlist 데모",
+		Description: "시드 데이터로 만든 데모 워크스페이스",
+		Members: []Member{
+			{alice.ID, RoleOwner}, {bob.ID, RoleAdmin}, {carol.ID, RoleMember}, {dave.ID, RoleMember}, {erin.ID, RoleMember},
+		},
+	}
+	side := Workspace{
+		ID:          ID("workspace", "side"),
+		OwnerID:     bob.ID,
+		Name:        "사이드 프로젝트",
+		Description: "작은 팀 워크스페이스",
+		Members:     []Member{{bob.ID, RoleOwner}, {carol.ID, RoleMember}, {erin.ID, RoleMember}},
+	}
+
+	web := Project{
+		ID:          ID("project", "web-renewal"),
+		WorkspaceID: demo.ID,
+		OwnerID:     alice.ID,
+		Name:        "웹 리뉴얼",
+		Description: "랜딩 페이지와 대시보드 개편",
+		Members:     []Member{{alice.ID, RoleOwner}, {bob.ID, RoleAdmin}, {carol.ID, RoleMember}, {dave.ID, RoleMember}},
+		Boards: []Board{
+			board("web-renewal", 1, alice.ID, &carol.ID, "랜딩 페이지 시안", "새 브랜드 가이드에 맞춘 랜딩 페이지 시안 3종", "review", "high", "designer", 3),
+			board("web-renewal", 2, bob.ID, &dave.ID, "대시보드 API 페이지네이션", "보드 목록 API에 커서 기반 페이지네이션 적용", "in_progress", "urgent", "developer", 2),
+			board("web-renewal", 3, alice.ID, &bob.ID, "요구사항 정리", "리뉴얼 범위와 일정 확정", "approved", "normal", "planner", 0),
+			board("web-renewal", 4, carol.ID, &carol.ID, "아이콘 세트 교체", "", "pending", "low", "designer", 14),
+			board("web-renewal", 5, dave.ID, &dave.ID, "E2E 테스트 시나리오", "로그인, 보드 생성, 파일 업로드 흐름", "pending", "normal", "qa", 10),
+			board("web-renewal", 6, bob.ID, nil, "성능 측정 대시보드", "Grafana 패널 추가", "in_progress", "high", "developer", 7),
+		},
+	}
+	mobile := Project{
+		ID:          ID("project", "mobile-app"),
+		WorkspaceID: demo.ID,
+		OwnerID:     bob.ID,
+		Name:        "모바일 앱",
+		Description: "iOS/Android 앱 MVP",
+		Members:     []Member{{bob.ID, RoleOwner}, {dave.ID, RoleMember}, {erin.ID, RoleMember}},
+		Boards: []Board{
+			board("mobile-app", 1, bob.ID, &erin.ID, "푸시 알림 연동", "FCM/APNs 토큰 등록", "in_progress", "high", "developer", 5),
+			board("mobile-app", 2, erin.ID, &erin.ID, "온보딩 화면", "", "review", "normal", "designer", 4),
+			board("mobile-app", 3, bob.ID, &dave.ID, "오프라인 캐시", "최근 보드 목록 로컬 저장", "pending", "normal", "developer", 21),
+			board("mobile-app", 4, dave.ID, nil, "스토어 출시 체크리스트", "", "pending", "low", "planner", 30),
+		},
+	}
+	sideProject := Project{
+		ID:          ID("project", "side-blog"),
+		WorkspaceID: side.ID,
+		OwnerID:     bob.ID,
+		Name:        "팀 블로그",
+		Description: "기술 블로그 운영",
+		Members:     []Member{{bob.ID, RoleOwner}, {carol.ID, RoleMember}, {erin.ID, RoleMember}},
+		Boards: []Board{
+			board("side-blog", 1, bob.ID, &carol.ID, "쿠버네티스 배포 회고", "", "in_progress", "normal", "developer", 6),
+			board("side-blog", 2, carol.ID, &erin.ID, "블로그 테마 정리", "", "pending", "low", "designer", 12),
+			board("side-blog", 3, erin.ID, &erin.ID, "발행 일정", "", "approved", "normal", "planner", 0),
+		},
+	}
+
+	channels := []Channel{
+		channel(demo.ID, nil, ChannelGroup, "general", alice.ID, []uuid.UUID{alice.ID, bob.ID, carol.ID, dave.ID, erin.ID},
+			say(alice.ID, "weAlist 데모 워크스페이스에 오신 것을 환영합니다!"),
+			say(bob.ID, "이번 주 목표는 웹 리뉴얼 시안 확정입니다."),
+			say(erin.ID, "모바일 온보딩 화면도 리뷰 부탁드려요.")),
+		channel(demo.ID, &web.ID, ChannelProject, web.Name, alice.ID, memberIDs(web.Members),
+			say(carol.ID, "랜딩 페이지 시안 올렸습니다. 파일함의 디자인 폴더 확인해주세요."),
+			say(dave.ID, "페이지네이션 API는 내일까지 PR 올릴게요."),
+			say(alice.ID, "좋아요, 금요일에 같이 리뷰해요.")),
+		channel(demo.ID, &mobile.ID, ChannelProject, mobile.Name, bob.ID, memberIDs(mobile.Members),
+			say(bob.ID, "푸시 알림 테스트 기기 준비됐나요?"),
+			say(erin.ID, "iOS는 준비됐고 안드로이드는 오늘 세팅합니다.")),
+		channel(demo.ID, nil, ChannelDM, "김민지, 이준호", alice.ID, []uuid.UUID{alice.ID, bob.ID},
+			say(alice.ID, "내일 회의 10시 괜찮으세요?"),
+			say(bob.ID, "네 좋습니다.")),
+		channel(side.ID, nil, ChannelGroup, "general", bob.ID, memberIDs(side.Members),
+			say(bob.ID, "다음 글 주제 정해봐요."),
+			say(carol.ID, "배포 회고 어떠세요?")),
+	}
+
+	design := folder(demo.ID, nil, nil, "디자인", "/디자인", carol.ID)
+	docs := folder(demo.ID, nil, nil, "문서", "/문서", alice.ID)
+	meeting := folder(demo.ID, nil, &docs.ID, "회의록", "/문서/회의록", bob.ID)
+	webAssets := folder(demo.ID, &web.ID, nil, "리소스", "/리소스", alice.ID)
+
+	files := []File{
+		file(demo.ID, nil, &design.ID, "landing-wireframe.svg", "image/svg+xml", carol.ID,
+			`<svg xmlns="http://www.w3.org/2000/svg" width="320" height="200"><rect width="320" height="200" fill="#F3F4F6"/><text x="20" y="40" font-size="20">Landing wireframe</text></svg>`),
+		file(demo.ID, nil, &docs.ID, "onboarding-guide.md", "text/markdown", alice.ID,
+			"# 온보딩 가이드\n\n1. 워크스페이스에 가입합니다.\n2. 프로젝트 보드를 확인합니다.\n3. general 채널에 인사합니다.\n"),
+		file(demo.ID, nil, &meeting.ID, "kickoff.md", "text/markdown", bob.ID,
+			"# 킥오프 회의\n\n- 일정: 4주\n- 담당: 웹 리뉴얼(김민지), 모바일 앱(이준호)\n"),
+		file(demo.ID, &web.ID, &webAssets.ID, "requirements.csv", "text/csv", alice.ID,
+			"id,title,priority\n1,랜딩 페이지,high\n2,대시보드,urgent\n3,아이콘,low\n"),
+		file(demo.ID, nil, nil, "README.txt", "text/plain", alice.ID,
+			"weAlist 데모 워크스페이스 파일함입니다.\n"),
+	}
+
+	return &Dataset{
+		Users:      []User{alice, bob, carol, dave, erin},
+		Workspaces: []Workspace{demo, side},
+		Projects:   []Project{web, mobile, sideProject},
+		Channels:   channels,
+		Folders:    []Folder{design, docs, meeting, webAssets},
+		Files:      files,
+	}
+}
+
+func user(key, name string) User {
+	return User{ID: ID("user", key), Email: key + "@example.com", Name: name}
+}
+
+func board(project string, n int, author uuid.UUID, assignee *uuid.UUID, title, content, stage, importance, role string, dueInDays int) Board {
+	return Board{
+		ID:         ID("board", fmt.Sprintf("%s-%d", project, n)),
+		AuthorID:   author,
+		AssigneeID: assignee,
+		Title:      title,
+		Content:    content,
+		Stage:      stage,
+		Importance: importance,
+		Role:       role,
+		DueInDays:  dueInDays,
+	}
+}
+
+func channel(workspaceID uuid.UUID, projectID *uuid.UUID, chatType, name string, createdBy uuid.UUID, members []uuid.UUID, messages ...Message) Channel {
+	key := fmt.Sprintf("%s/%s/%s", workspaceID, chatType, name)
+	c := Channel{
+		ID:          ID("channel", key),
+		WorkspaceID: workspaceID,
+		ProjectID:   projectID,
+		Type:        chatType,
+		Name:        name,
+		CreatedBy:   createdBy,
+		Members:     members,
+	}
+	for i, m := range messages {
+		m.ID = ID("message", fmt.Sprintf("%s/%d", key, i))
+		c.Messages = append(c.Messages, m)
+	}
+	return c
+}
+
+func say(userID uuid.UUID, content string) Message {
+	return Message{UserID: userID, Content: content}
+}
+
+func folder(workspaceID uuid.UUID, projectID, parentID *uuid.UUID, name, path string, createdBy uuid.UUID) Folder {
+	return Folder{
+		ID:          ID("folder", workspaceID.String()+path),
+		WorkspaceID: workspaceID,
+		ProjectID:   projectID,
+		ParentID:    parentID,
+		Name:        name,
+		Path:        path,
+		CreatedBy:   createdBy,
+	}
+}
+
+func file(workspaceID uuid.UUID, projectID, folderID *uuid.UUID, name, contentType string, uploadedBy uuid.UUID, content string) File {
+	return File{
+		ID:          ID("file", workspaceID.String()+"/"+name),
+		WorkspaceID: workspaceID,
+		ProjectID:   projectID,
+		FolderID:    folderID,
+		Name:        name,
+		ContentType: contentType,
+		Content:     []byte(content),
+		UploadedBy:  uploadedBy,
+	}
+}
+
+func memberIDs(members []Member) []uuid.UUID {
+	ids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		ids[i] = m.UserID
+	}
+	return ids
+}
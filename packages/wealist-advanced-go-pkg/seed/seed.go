@@ -0,0 +1,43 @@
+// Package seed는 로컬/프리뷰 환경용 개발 데이터셋을 제공합니다.
+//
+// 모든 ID는 이름에서 결정적으로 만들어지므로(ID) 서비스마다 따로 실행해도
+// user-service의 사용자·워크스페이스와 board/chat/storage의 데이터가 서로 연결됩니다.
+// 각 서비스의 cmd/seed는 Default() 데이터셋 중 자신이 소유한 테이블만 Insert로 채우며,
+// 이미 있는 행은 건너뛰므로 여러 번 실행해도 안전합니다.
+//
+//	data := seed.Default()
+//	err := db.Transaction(func(tx *gorm.DB) error {
+//		return seed.Insert(tx, &domain.User{ID: data.Users[0].ID, ...})
+//	})
+package seed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// namespace는 시드 ID 생성용 UUID 네임스페이스입니다. 바꾸면 기존 시드 데이터와 연결이 끊깁니다.
+var namespace = uuid.MustParse("6f1c9a52-3d0e-4c7b-9a8e-2b5f0d4e7a11")
+
+// ID는 kind와 key로 결정적인 UUID를 만듭니다 (예: ID("user", "alice")).
+func ID(kind, key string) uuid.UUID {
+	return uuid.NewSHA1(namespace, []byte(kind+":"+key))
+}
+
+// CheckEnvironment는 운영 환경에서 시드 실행을 막습니다.
+func CheckEnvironment(env string) error {
+	switch strings.ToLower(env) {
+	case "prod", "production", "release":
+		return fmt.Errorf("refusing to seed %q environment", env)
+	}
+	return nil
+}
+
+// Insert는 value를 삽입하고, 기본 키 등 유니크 제약이 겹치는 행은 건너뜁니다.
+func Insert(tx *gorm.DB, value interface{}) error {
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(value).Error
+}
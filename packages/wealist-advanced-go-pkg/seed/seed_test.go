@@ -0,0 +1,130 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestIDIsDeterministic(t *testing.T) {
+	if ID("user", "alice") != ID("user", "alice") {
+		t.Fatal("ID must be stable across calls")
+	}
+	if ID("user", "alice") == ID("workspace", "alice") {
+		t.Fatal("ID must differ by kind")
+	}
+	if Default().Users[0].ID != Default().Users[0].ID {
+		t.Fatal("Default must produce the same IDs every time")
+	}
+}
+
+func TestCheckEnvironment(t *testing.T) {
+	for _, env := range []string{"prod", "PRODUCTION", "release"} {
+		if err := CheckEnvironment(env); err == nil {
+			t.Errorf("CheckEnvironment(%q) = nil, want error", env)
+		}
+	}
+	for _, env := range []string{"", "dev", "localhost", "staging"} {
+		if err := CheckEnvironment(env); err != nil {
+			t.Errorf("CheckEnvironment(%q) = %v, want nil", env, err)
+		}
+	}
+}
+
+// TestDefaultIsLinked는 서비스별로 나눠 저장해도 참조가 끊기지 않는지 확인합니다.
+func TestDefaultIsLinked(t *testing.T) {
+	data := Default()
+
+	ids := map[uuid.UUID]string{}
+	unique := func(id uuid.UUID, what string) {
+		t.Helper()
+		if prev, ok := ids[id]; ok {
+			t.Errorf("duplicate ID %s for %s and %s", id, prev, what)
+		}
+		ids[id] = what
+	}
+
+	users := map[uuid.UUID]bool{}
+	for _, u := range data.Users {
+		unique(u.ID, "user "+u.Email)
+		users[u.ID] = true
+	}
+
+	members := map[uuid.UUID]map[uuid.UUID]bool{}
+	for _, ws := range data.Workspaces {
+		unique(ws.ID, "workspace "+ws.Name)
+		members[ws.ID] = map[uuid.UUID]bool{}
+		for _, m := range ws.Members {
+			if !users[m.UserID] {
+				t.Errorf("workspace %s member %s is not a seeded user", ws.Name, m.UserID)
+			}
+			members[ws.ID][m.UserID] = true
+		}
+		if !members[ws.ID][ws.OwnerID] {
+			t.Errorf("workspace %s owner is not a member", ws.Name)
+		}
+	}
+	isMember := func(workspaceID, userID uuid.UUID, what string) {
+		t.Helper()
+		if !members[workspaceID][userID] {
+			t.Errorf("%s: user %s is not a member of workspace %s", what, userID, workspaceID)
+		}
+	}
+
+	projects := map[uuid.UUID]uuid.UUID{}
+	for _, p := range data.Projects {
+		unique(p.ID, "project "+p.Name)
+		projects[p.ID] = p.WorkspaceID
+		isMember(p.WorkspaceID, p.OwnerID, "project "+p.Name)
+		for _, m := range p.Members {
+			isMember(p.WorkspaceID, m.UserID, "project "+p.Name)
+		}
+		for _, b := range p.Boards {
+			unique(b.ID, "board "+b.Title)
+			isMember(p.WorkspaceID, b.AuthorID, "board "+b.Title)
+			if b.AssigneeID != nil {
+				isMember(p.WorkspaceID, *b.AssigneeID, "board "+b.Title)
+			}
+		}
+	}
+
+	for _, c := range data.Channels {
+		unique(c.ID, "channel "+c.Name)
+		if c.ProjectID != nil && projects[*c.ProjectID] != c.WorkspaceID {
+			t.Errorf("channel %s project is not in its workspace", c.Name)
+		}
+		inChannel := map[uuid.UUID]bool{}
+		for _, id := range c.Members {
+			isMember(c.WorkspaceID, id, "channel "+c.Name)
+			inChannel[id] = true
+		}
+		for _, m := range c.Messages {
+			unique(m.ID, "message in "+c.Name)
+			if !inChannel[m.UserID] {
+				t.Errorf("channel %s message author %s is not a participant", c.Name, m.UserID)
+			}
+		}
+	}
+
+	folders := map[uuid.UUID]uuid.UUID{}
+	for _, f := range data.Folders {
+		unique(f.ID, "folder "+f.Path)
+		folders[f.ID] = f.WorkspaceID
+		isMember(f.WorkspaceID, f.CreatedBy, "folder "+f.Path)
+	}
+	for _, f := range data.Folders {
+		if f.ParentID != nil && folders[*f.ParentID] != f.WorkspaceID {
+			t.Errorf("folder %s parent is not in its workspace", f.Path)
+		}
+	}
+	for _, f := range data.Files {
+		unique(f.ID, "file "+f.Name)
+		isMember(f.WorkspaceID, f.UploadedBy, "file "+f.Name)
+		if f.FolderID != nil && folders[*f.FolderID] != f.WorkspaceID {
+			t.Errorf("file %s folder is not in its workspace", f.Name)
+		}
+		if len(f.Content) == 0 {
+			t.Errorf("file %s has no content", f.Name)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package tenancy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CacheConfig는 멤버십 캐시 설정입니다.
+type CacheConfig struct {
+	// MemberTTL은 멤버 확인 결과를 보관하는 시간입니다 (기본 1분).
+	// 멤버에서 제외된 사용자는 최대 이 시간 동안 접근할 수 있으므로 Invalidate로 바로 지울 수 있습니다.
+	MemberTTL time.Duration
+
+	// NonMemberTTL은 비멤버 결과를 보관하는 시간입니다 (기본 10초).
+	// 초대 수락 직후 접근이 막히지 않도록 짧게 둡니다.
+	NonMemberTTL time.Duration
+
+	// MaxEntries는 캐시 항목 수 상한입니다 (기본 10000).
+	MaxEntries int
+}
+
+// DefaultCacheConfig는 기본 캐시 설정을 반환합니다.
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		MemberTTL:    time.Minute,
+		NonMemberTTL: 10 * time.Second,
+		MaxEntries:   10000,
+	}
+}
+
+// CachedChecker는 멤버십 확인 결과를 프로세스 메모리에 캐시하는 MembershipChecker입니다.
+//
+// ctx에 요청의 워크스페이스(WithWorkspaceID)가 있으면 다른 워크스페이스에 대한 확인은
+// user-service를 호출하지 않고 false를 반환합니다. 서비스 레이어의 기존 멤버십 확인이
+// 이 checker를 거치면 리소스가 속한 워크스페이스가 요청 컨텍스트와 다를 때 일관되게 거부됩니다.
+// user-service 오류는 캐시하지 않습니다.
+type CachedChecker struct {
+	next   MembershipChecker
+	config CacheConfig
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[membershipKey]membershipEntry
+}
+
+type membershipKey struct {
+	workspaceID uuid.UUID
+	userID      uuid.UUID
+}
+
+type membershipEntry struct {
+	member    bool
+	expiresAt time.Time
+}
+
+// NewCachedChecker는 next의 결과를 캐시하는 CachedChecker를 생성합니다.
+func NewCachedChecker(next MembershipChecker, config CacheConfig) *CachedChecker {
+	defaults := DefaultCacheConfig()
+	if config.MemberTTL <= 0 {
+		config.MemberTTL = defaults.MemberTTL
+	}
+	if config.NonMemberTTL <= 0 {
+		config.NonMemberTTL = defaults.NonMemberTTL
+	}
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaults.MaxEntries
+	}
+	return &CachedChecker{
+		next:    next,
+		config:  config,
+		now:     time.Now,
+		entries: make(map[membershipKey]membershipEntry),
+	}
+}
+
+// ValidateWorkspaceMember는 userID가 workspaceID의 멤버인지 확인합니다.
+func (c *CachedChecker) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	if scope, ok := WorkspaceIDFromContext(ctx); ok && scope != workspaceID {
+		return false, nil
+	}
+
+	key := membershipKey{workspaceID: workspaceID, userID: userID}
+	if member, ok := c.lookup(key); ok {
+		return member, nil
+	}
+
+	member, err := c.next.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+	if err != nil {
+		return false, err
+	}
+	c.store(key, member)
+	return member, nil
+}
+
+// Invalidate는 사용자의 멤버십 캐시를 지웁니다 (멤버 제외, 탈퇴 이벤트 처리 시).
+func (c *CachedChecker) Invalidate(workspaceID, userID uuid.UUID) {
+	c.mu.Lock()
+	delete(c.entries, membershipKey{workspaceID: workspaceID, userID: userID})
+	c.mu.Unlock()
+}
+
+func (c *CachedChecker) lookup(key membershipKey) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return false, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return false, false
+	}
+	return entry.member, true
+}
+
+func (c *CachedChecker) store(key membershipKey, member bool) {
+	ttl := c.config.NonMemberTTL
+	if member {
+		ttl = c.config.MemberTTL
+	}
+	now := c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= c.config.MaxEntries {
+		// 만료 항목을 먼저 정리하고, 그래도 가득 차면 전체를 비움
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= c.config.MaxEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = membershipEntry{member: member, expiresAt: now.Add(ttl)}
+}
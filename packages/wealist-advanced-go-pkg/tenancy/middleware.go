@@ -0,0 +1,93 @@
+package tenancy
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// Middleware는 요청의 워크스페이스를 결정하고 호출자의 멤버십을 확인하는 Gin 미들웨어입니다.
+// 인증 미들웨어 뒤에 사용해야 합니다.
+//
+// 워크스페이스는 :workspaceId 라우트 파라미터, ?workspaceId 쿼리, X-Workspace-Id 헤더에서 찾습니다.
+//   - 아무 곳에도 없으면 워크스페이스 컨텍스트 없이 통과합니다 (리소스 ID로 접근하는 라우트)
+//   - 서로 다른 값이면 403 WORKSPACE_MISMATCH
+//   - 멤버가 아니면 403 NOT_WORKSPACE_MEMBER, 멤버십을 확인할 수 없으면 503
+//   - 서비스 토큰 요청(내부 호출)은 멤버십 확인 없이 워크스페이스만 기록합니다
+//
+// 확인된 워크스페이스는 gin 컨텍스트(GetWorkspaceID)와 요청 context(WorkspaceIDFromContext)에 저장됩니다.
+// checker가 CachedChecker이면 이후 서비스 레이어의 다른 워크스페이스 확인은 모두 거부됩니다.
+func Middleware(checker MembershipChecker, logger *zap.Logger) gin.HandlerFunc {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return func(c *gin.Context) {
+		workspaceID, found, ok := resolveWorkspaceID(c)
+		if !ok {
+			c.Abort()
+			return
+		}
+		if !found {
+			c.Next()
+			return
+		}
+
+		if _, isService := auth.GetServiceClient(c); !isService {
+			userID, exists := auth.GetUserID(c)
+			if !exists {
+				response.Unauthorized(c, "User not authenticated")
+				c.Abort()
+				return
+			}
+
+			token, _ := auth.GetJWTToken(c)
+			member, err := checker.ValidateWorkspaceMember(c.Request.Context(), workspaceID, userID, token)
+			if err != nil {
+				logger.Warn("Workspace membership check failed",
+					zap.String("workspace_id", workspaceID.String()),
+					zap.String("user_id", userID.String()),
+					zap.Error(err))
+				response.ServiceUnavailable(c, "Unable to verify workspace membership")
+				c.Abort()
+				return
+			}
+			if !member {
+				response.Error(c, http.StatusForbidden, apperrors.ErrCodeNotWorkspaceMember, "You are not a member of this workspace")
+				c.Abort()
+				return
+			}
+		}
+
+		c.Set(ContextKey, workspaceID)
+		c.Request = c.Request.WithContext(WithWorkspaceID(c.Request.Context(), workspaceID))
+		c.Next()
+	}
+}
+
+// resolveWorkspaceID는 요청이 지정한 워크스페이스를 찾습니다.
+// found는 워크스페이스가 지정되었는지, ok는 값이 유효한지(아니면 에러 응답을 보냄)를 나타냅니다.
+func resolveWorkspaceID(c *gin.Context) (workspaceID uuid.UUID, found, ok bool) {
+	for _, raw := range []string{c.Param(ParamWorkspaceID), c.Query(ParamWorkspaceID), c.GetHeader(HeaderWorkspaceID)} {
+		if raw == "" {
+			continue
+		}
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace ID")
+			return uuid.Nil, true, false
+		}
+		if found && id != workspaceID {
+			response.Error(c, http.StatusForbidden, apperrors.ErrCodeWorkspaceMismatch, "Request refers to more than one workspace")
+			return uuid.Nil, true, false
+		}
+		workspaceID, found = id, true
+	}
+	return workspaceID, found, true
+}
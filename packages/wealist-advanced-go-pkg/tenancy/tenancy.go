@@ -0,0 +1,58 @@
+// Package tenancy는 요청의 워크스페이스 컨텍스트를 한 번 확인하고 워크스페이스 간 접근을 막습니다.
+//
+// 핸들러가 클라이언트가 보낸 워크스페이스 ID를 그대로 믿지 않도록, Middleware가 인증 뒤에서
+// 라우트 파라미터, 쿼리, X-Workspace-Id 헤더로 워크스페이스를 결정하고 멤버십을 확인합니다.
+// 멤버십 결과는 CachedChecker가 짧게 캐시하며, 서비스 레이어의 멤버십 확인도 같은 캐시를 쓰면
+// 요청 컨텍스트의 워크스페이스와 다른 워크스페이스의 리소스는 어디서 확인하든 거부됩니다.
+//
+//	membership := tenancy.NewCachedChecker(userClient, tenancy.DefaultCacheConfig())
+//	api.Use(authMiddleware, tenancy.Middleware(membership, logger))
+package tenancy
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderWorkspaceID는 프론트엔드가 현재 선택된 워크스페이스를 보내는 헤더입니다.
+	HeaderWorkspaceID = "X-Workspace-Id"
+
+	// ParamWorkspaceID는 라우트 파라미터와 쿼리 파라미터의 워크스페이스 ID 이름입니다.
+	ParamWorkspaceID = "workspaceId"
+
+	// ContextKey는 확인된 워크스페이스 ID(uuid.UUID)를 gin 컨텍스트에 저장하는 키입니다.
+	ContextKey = "workspace_id"
+)
+
+// MembershipChecker는 워크스페이스 멤버십을 확인합니다.
+// 각 서비스의 user-service 클라이언트(client.UserClient)가 이 메서드를 구현합니다.
+type MembershipChecker interface {
+	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+}
+
+type workspaceKey struct{}
+
+// WithWorkspaceID는 ctx에 요청의 워크스페이스를 기록합니다.
+func WithWorkspaceID(ctx context.Context, workspaceID uuid.UUID) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, workspaceID)
+}
+
+// WorkspaceIDFromContext는 Middleware가 확인한 요청의 워크스페이스를 반환합니다.
+func WorkspaceIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(workspaceKey{}).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
+
+// GetWorkspaceID는 gin 컨텍스트에서 확인된 워크스페이스 ID를 반환합니다.
+// 워크스페이스를 지정하지 않은 요청이면 false입니다.
+func GetWorkspaceID(c *gin.Context) (uuid.UUID, bool) {
+	value, exists := c.Get(ContextKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := value.(uuid.UUID)
+	return id, ok
+}
@@ -0,0 +1,208 @@
+package tenancy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+)
+
+type fakeChecker struct {
+	members map[uuid.UUID]bool
+	err     error
+	calls   int
+}
+
+func (f *fakeChecker) ValidateWorkspaceMember(_ context.Context, workspaceID, _ uuid.UUID, _ string) (bool, error) {
+	f.calls++
+	if f.err != nil {
+		return false, f.err
+	}
+	return f.members[workspaceID], nil
+}
+
+var (
+	memberWorkspace = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	otherWorkspace  = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	testUser        = uuid.MustParse("33333333-3333-3333-3333-333333333333")
+)
+
+func newRouter(checker MembershipChecker, authenticated bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	if authenticated {
+		r.Use(func(c *gin.Context) { c.Set(auth.UserIDContextKey, testUser) })
+	}
+	r.Use(Middleware(checker, nil))
+	handler := func(c *gin.Context) {
+		id, ok := GetWorkspaceID(c)
+		ctxID, _ := WorkspaceIDFromContext(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{"workspaceId": id, "scoped": ok, "contextId": ctxID})
+	}
+	r.GET("/workspaces/:workspaceId/files", handler)
+	r.GET("/files", handler)
+	return r
+}
+
+func serve(r *gin.Engine, target string, headers map[string]string) (*httptest.ResponseRecorder, map[string]any) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var body map[string]any
+	_ = json.Unmarshal(w.Body.Bytes(), &body)
+	return w, body
+}
+
+func errorCode(body map[string]any) string {
+	if e, ok := body["error"].(map[string]any); ok {
+		code, _ := e["code"].(string)
+		return code
+	}
+	return ""
+}
+
+func TestMiddleware(t *testing.T) {
+	checker := &fakeChecker{members: map[uuid.UUID]bool{memberWorkspace: true}}
+	r := newRouter(checker, true)
+
+	tests := []struct {
+		name     string
+		target   string
+		header   string
+		status   int
+		code     string
+		scopedTo uuid.UUID
+	}{
+		{name: "no workspace", target: "/files", status: http.StatusOK},
+		{name: "path param member", target: "/workspaces/" + memberWorkspace.String() + "/files", status: http.StatusOK, scopedTo: memberWorkspace},
+		{name: "query member", target: "/files?workspaceId=" + memberWorkspace.String(), status: http.StatusOK, scopedTo: memberWorkspace},
+		{name: "header member", target: "/files", header: memberWorkspace.String(), status: http.StatusOK, scopedTo: memberWorkspace},
+		{name: "non-member", target: "/workspaces/" + otherWorkspace.String() + "/files", status: http.StatusForbidden, code: "NOT_WORKSPACE_MEMBER"},
+		{name: "header and path differ", target: "/workspaces/" + otherWorkspace.String() + "/files", header: memberWorkspace.String(), status: http.StatusForbidden, code: "WORKSPACE_MISMATCH"},
+		{name: "invalid id", target: "/files", header: "not-a-uuid", status: http.StatusBadRequest, code: "VALIDATION_ERROR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := map[string]string{}
+			if tt.header != "" {
+				headers[HeaderWorkspaceID] = tt.header
+			}
+			w, body := serve(r, tt.target, headers)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.code != "" && errorCode(body) != tt.code {
+				t.Errorf("code = %q, want %q", errorCode(body), tt.code)
+			}
+			if tt.status == http.StatusOK {
+				wantScoped := tt.scopedTo != uuid.Nil
+				if body["scoped"] != wantScoped {
+					t.Errorf("scoped = %v, want %v", body["scoped"], wantScoped)
+				}
+				if wantScoped && body["contextId"] != tt.scopedTo.String() {
+					t.Errorf("request context workspace = %v, want %s", body["contextId"], tt.scopedTo)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleware_CheckerErrorAndAuth(t *testing.T) {
+	target := "/workspaces/" + memberWorkspace.String() + "/files"
+
+	w, _ := serve(newRouter(&fakeChecker{err: errors.New("user-service down")}, true), target, nil)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("checker error: status = %d, want 503", w.Code)
+	}
+
+	w, _ = serve(newRouter(&fakeChecker{}, false), target, nil)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("no user: status = %d, want 401", w.Code)
+	}
+}
+
+func TestMiddleware_ServiceClientSkipsMembership(t *testing.T) {
+	checker := &fakeChecker{}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) { c.Set(auth.ServiceClientContextKey, &auth.ServiceClaims{}) })
+	r.Use(Middleware(checker, nil))
+	r.GET("/workspaces/:workspaceId/files", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	w, _ := serve(r, "/workspaces/"+otherWorkspace.String()+"/files", nil)
+	if w.Code != http.StatusNoContent || checker.calls != 0 {
+		t.Errorf("status = %d, calls = %d; want 204 without membership check", w.Code, checker.calls)
+	}
+}
+
+func TestCachedChecker(t *testing.T) {
+	next := &fakeChecker{members: map[uuid.UUID]bool{memberWorkspace: true}}
+	cached := NewCachedChecker(next, CacheConfig{MemberTTL: time.Minute, NonMemberTTL: 10 * time.Second})
+	now := time.Unix(1_700_000_000, 0)
+	cached.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 3 {
+		if ok, _ := cached.ValidateWorkspaceMember(ctx, memberWorkspace, testUser, ""); !ok {
+			t.Fatal("expected member")
+		}
+	}
+	if next.calls != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", next.calls)
+	}
+
+	// 비멤버 결과는 짧게 캐시
+	_, _ = cached.ValidateWorkspaceMember(ctx, otherWorkspace, testUser, "")
+	now = now.Add(11 * time.Second)
+	_, _ = cached.ValidateWorkspaceMember(ctx, otherWorkspace, testUser, "")
+	if next.calls != 3 {
+		t.Errorf("expected non-member result to expire, upstream calls = %d", next.calls)
+	}
+
+	cached.Invalidate(memberWorkspace, testUser)
+	_, _ = cached.ValidateWorkspaceMember(ctx, memberWorkspace, testUser, "")
+	if next.calls != 4 {
+		t.Errorf("expected invalidated entry to be refetched, upstream calls = %d", next.calls)
+	}
+}
+
+func TestCachedChecker_ErrorsAreNotCached(t *testing.T) {
+	next := &fakeChecker{err: errors.New("timeout")}
+	cached := NewCachedChecker(next, DefaultCacheConfig())
+
+	for range 2 {
+		if _, err := cached.ValidateWorkspaceMember(context.Background(), memberWorkspace, testUser, ""); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+	if next.calls != 2 {
+		t.Errorf("expected errors to reach upstream every time, calls = %d", next.calls)
+	}
+}
+
+func TestCachedChecker_RejectsOtherWorkspaceInScope(t *testing.T) {
+	next := &fakeChecker{members: map[uuid.UUID]bool{memberWorkspace: true, otherWorkspace: true}}
+	cached := NewCachedChecker(next, DefaultCacheConfig())
+	ctx := WithWorkspaceID(context.Background(), memberWorkspace)
+
+	if ok, _ := cached.ValidateWorkspaceMember(ctx, otherWorkspace, testUser, ""); ok {
+		t.Error("expected resource in another workspace to be rejected within a workspace scope")
+	}
+	if ok, _ := cached.ValidateWorkspaceMember(ctx, memberWorkspace, testUser, ""); !ok {
+		t.Error("expected active workspace to be allowed")
+	}
+	if next.calls != 1 {
+		t.Errorf("expected only the active workspace to reach upstream, calls = %d", next.calls)
+	}
+}
@@ -0,0 +1,168 @@
+// Package contract는 서비스 간 호출에 대한 consumer-driven contract 테스트를 제공합니다.
+//
+// 계약(contract)은 consumer가 provider에게 기대하는 요청/응답과 이벤트 메시지를
+// contracts/<consumer>_<provider>.json 파일로 기록한 것입니다. 같은 파일을 양쪽에서 검증하므로
+// provider의 API 변경이 consumer를 깨뜨리면 운영 배포 전에 go test에서 실패합니다.
+//
+//   - consumer 테스트: NewMockProvider가 계약대로 응답하는 서버를 띄우고,
+//     consumer의 실제 클라이언트가 계약에 맞는 요청을 보내는지 확인합니다.
+//   - provider 테스트: Verify가 계약의 요청을 provider의 실제 라우터에 재생하고,
+//     응답이 계약의 예시와 같은 구조(필드와 JSON 타입)인지 확인합니다.
+//   - 이벤트 메시지: MatchMessage로 발행하는 쪽의 payload를 확인하고,
+//     받는 쪽은 Message.Body를 그대로 처리해 봅니다.
+//
+// 응답 본문은 예시 값이 아니라 구조로 비교합니다. 예시에 있는 필드는 반드시 같은 JSON 타입으로
+// 있어야 하고, 예시에 없는 필드는 자유롭게 추가할 수 있습니다. 값까지 같아야 하는 필드는
+// "exact"에 JSON 경로($.data.isMember, 배열 요소는 $.keys[*].kty)로 적습니다.
+//
+// 사용 예 (consumer):
+//
+//	c := contract.Load(t, "board-service", "user-service")
+//	provider := contract.NewMockProvider(t, c, "validate a workspace member")
+//	client := NewUserClient(provider.URL, ...)
+//
+// 사용 예 (provider):
+//
+//	contract.Verify(t, contract.Load(t, "board-service", "user-service"), router, contract.States{
+//		"user is a member of the workspace": func(t *testing.T, params map[string]string) { ... },
+//	})
+package contract
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+//go:embed contracts/*.json
+var files embed.FS
+
+// Contract는 한 consumer와 provider 사이의 계약입니다.
+type Contract struct {
+	Consumer     string        `json:"consumer"`
+	Provider     string        `json:"provider"`
+	Interactions []Interaction `json:"interactions,omitempty"`
+	Messages     []Message     `json:"messages,omitempty"`
+}
+
+// Interaction은 HTTP 요청 하나와 그에 대한 기대 응답입니다.
+type Interaction struct {
+	Description string `json:"description"`
+	// ProviderState는 provider 테스트가 요청 전에 준비해야 하는 데이터 상태입니다.
+	ProviderState string `json:"providerState,omitempty"`
+	// Params는 Request.Path의 {name} 자리에 들어가는 예시 값입니다.
+	// provider 테스트는 같은 값으로 ProviderState의 데이터를 만듭니다.
+	Params   map[string]string `json:"params,omitempty"`
+	Request  Request           `json:"request"`
+	Response Response          `json:"response"`
+}
+
+// Request는 consumer가 보내는 요청입니다.
+// consumer 쪽에서는 Headers의 헤더가 있는지만 확인하고, provider에 재생할 때는 값을 그대로 보냅니다.
+type Request struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Response는 consumer가 의존하는 응답입니다.
+// Headers 값은 접두사로 비교합니다 (application/json은 application/json; charset=utf-8과 일치).
+type Response struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+	Exact   []string          `json:"exact,omitempty"`
+}
+
+// Message는 consumer가 메시지 브로커에 발행하고 provider가 처리하는 이벤트 하나입니다.
+// HTTP 호출을 이벤트 발행으로 바꾼 경우(board-service -> noti-service 알림)처럼 방향은 호출과 같습니다.
+type Message struct {
+	Description string          `json:"description"`
+	Subject     string          `json:"subject"`
+	Body        json.RawMessage `json:"body"`
+	Exact       []string        `json:"exact,omitempty"`
+}
+
+// Load는 consumer와 provider 사이의 계약 파일을 읽습니다.
+func Load(t testing.TB, consumer, provider string) *Contract {
+	t.Helper()
+	c, err := Parse(consumer, provider)
+	if err != nil {
+		t.Fatalf("contract: %v", err)
+	}
+	return c
+}
+
+// Parse는 내장된 계약 파일을 읽고 검사합니다.
+func Parse(consumer, provider string) (*Contract, error) {
+	name := fmt.Sprintf("contracts/%s_%s.json", consumer, provider)
+	data, err := files.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("no contract between %s and %s: %w", consumer, provider, err)
+	}
+
+	var c Contract
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	if c.Consumer != consumer || c.Provider != provider {
+		return nil, fmt.Errorf("%s declares %s -> %s", name, c.Consumer, c.Provider)
+	}
+
+	seen := make(map[string]bool)
+	for _, i := range c.Interactions {
+		if i.Description == "" || i.Request.Method == "" || i.Request.Path == "" || i.Response.Status == 0 {
+			return nil, fmt.Errorf("%s: interaction %q needs description, method, path and status", name, i.Description)
+		}
+		if seen[i.Description] {
+			return nil, fmt.Errorf("%s: duplicate description %q", name, i.Description)
+		}
+		seen[i.Description] = true
+	}
+	for _, m := range c.Messages {
+		if m.Description == "" || m.Subject == "" || len(m.Body) == 0 {
+			return nil, fmt.Errorf("%s: message %q needs description, subject and body", name, m.Description)
+		}
+		if seen[m.Description] {
+			return nil, fmt.Errorf("%s: duplicate description %q", name, m.Description)
+		}
+		seen[m.Description] = true
+	}
+	return &c, nil
+}
+
+// Interaction은 설명으로 interaction을 찾습니다.
+func (c *Contract) Interaction(t testing.TB, description string) Interaction {
+	t.Helper()
+	for _, i := range c.Interactions {
+		if i.Description == description {
+			return i
+		}
+	}
+	t.Fatalf("contract %s -> %s has no interaction %q", c.Consumer, c.Provider, description)
+	return Interaction{}
+}
+
+// Message는 설명으로 이벤트 메시지를 찾습니다.
+func (c *Contract) Message(t testing.TB, description string) Message {
+	t.Helper()
+	for _, m := range c.Messages {
+		if m.Description == description {
+			return m
+		}
+	}
+	t.Fatalf("contract %s -> %s has no message %q", c.Consumer, c.Provider, description)
+	return Message{}
+}
+
+// Path는 Params를 채운 요청 경로를 반환합니다.
+func (i Interaction) Path() string {
+	path := i.Request.Path
+	for name, value := range i.Params {
+		path = strings.ReplaceAll(path, "{"+name+"}", value)
+	}
+	return path
+}
@@ -0,0 +1,160 @@
+package contract
+
+import (
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+// TestParse_AllContracts는 내장된 모든 계약 파일이 올바른지 확인합니다.
+func TestParse_AllContracts(t *testing.T) {
+	names, err := fs.Glob(files, "contracts/*.json")
+	if err != nil || len(names) == 0 {
+		t.Fatalf("no contract files: %v", err)
+	}
+	for _, name := range names {
+		parties := strings.SplitN(strings.TrimSuffix(path.Base(name), ".json"), "_", 2)
+		if len(parties) != 2 {
+			t.Errorf("%s: file name must be <consumer>_<provider>.json", name)
+			continue
+		}
+		c, err := Parse(parties[0], parties[1])
+		if err != nil {
+			t.Errorf("Parse(%s) error = %v", name, err)
+			continue
+		}
+		for _, i := range c.Interactions {
+			if strings.Contains(i.Path(), "{") {
+				t.Errorf("%s: %q has unfilled path params: %s", name, i.Description, i.Path())
+			}
+		}
+	}
+}
+
+func TestParse_UnknownContract(t *testing.T) {
+	if _, err := Parse("board-service", "unknown-service"); err == nil {
+		t.Error("Parse() should fail for a missing contract")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	expected := `{"success":true,"data":{"isMember":true,"roles":["OWNER"]}}`
+
+	tests := []struct {
+		name    string
+		actual  string
+		exact   []string
+		wantErr string
+	}{
+		{name: "same shape, extra fields", actual: `{"success":true,"requestId":"r1","data":{"isMember":false,"roles":["MEMBER","ADMIN"]}}`},
+		{name: "missing field", actual: `{"success":true,"data":{"roles":["OWNER"]}}`, wantErr: "$.data.isMember: missing"},
+		{name: "wrong type", actual: `{"success":true,"data":{"isMember":"yes","roles":["OWNER"]}}`, wantErr: "$.data.isMember: expected boolean, got string"},
+		{name: "empty array", actual: `{"success":true,"data":{"isMember":true,"roles":[]}}`, wantErr: "$.data.roles: expected at least one element"},
+		{name: "array element type", actual: `{"success":true,"data":{"isMember":true,"roles":[1]}}`, wantErr: "$.data.roles[*]: expected string, got number"},
+		{name: "exact value", actual: `{"success":true,"data":{"isMember":false,"roles":["OWNER"]}}`, exact: []string{"$.data.isMember"}, wantErr: "$.data.isMember: expected true, got false"},
+		{name: "not JSON", actual: `<html>`, wantErr: "body is not JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := Match([]byte(expected), []byte(tt.actual), tt.exact)
+			if tt.wantErr == "" {
+				if len(problems) > 0 {
+					t.Errorf("Match() = %v, want no mismatches", problems)
+				}
+				return
+			}
+			if len(problems) != 1 || !strings.HasPrefix(problems[0], tt.wantErr) {
+				t.Errorf("Match() = %v, want %q", problems, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatch_EmptyExpectedSkipsBody(t *testing.T) {
+	if problems := Match(nil, []byte(`anything`), nil); len(problems) > 0 {
+		t.Errorf("Match() = %v, want no mismatches", problems)
+	}
+}
+
+func TestMockProvider_ServesInteraction(t *testing.T) {
+	c := Load(t, "ops-service", "auth-service")
+	provider := NewMockProvider(t, c)
+
+	resp, err := http.Get(provider.URL + "/.well-known/jwks.json")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+}
+
+func TestRequestMismatches(t *testing.T) {
+	c := Load(t, "board-service", "noti-service")
+	want := c.Interaction(t, "create a board assigned notification").Request
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	problems := requestMismatches(want, header, []byte(`{"type":"BOARD_ASSIGNED"}`))
+
+	joined := strings.Join(problems, "\n")
+	if !strings.Contains(joined, "missing header x-internal-api-key") {
+		t.Errorf("expected missing header, got %v", problems)
+	}
+	if !strings.Contains(joined, "$.actorId: missing") {
+		t.Errorf("expected missing body field, got %v", problems)
+	}
+}
+
+// TestVerify_ReplaysInteractions는 provider state 준비 후 요청을 재생하는지 확인합니다.
+func TestVerify_ReplaysInteractions(t *testing.T) {
+	c := Load(t, "board-service", "user-service")
+	members := make(map[string]bool)
+
+	router := http.NewServeMux()
+	router.HandleFunc("/api/workspaces/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		userID := path.Base(r.URL.Path)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if members[userID] {
+			_, _ = w.Write([]byte(`{"success":true,"data":{"isMember":true},"requestId":"r1"}`))
+		} else {
+			_, _ = w.Write([]byte(`{"success":true,"data":{"isMember":false},"requestId":"r2"}`))
+		}
+	})
+
+	Verify(t, c, router, States{
+		"user is a member of the workspace": func(t *testing.T, params map[string]string) {
+			members[params["userId"]] = true
+		},
+		"user is not a member of the private workspace": func(t *testing.T, params map[string]string) {},
+	})
+}
+
+func TestMatchMessage(t *testing.T) {
+	c := Load(t, "board-service", "noti-service")
+	message := c.Message(t, "board assigned event")
+
+	payload := map[string]interface{}{
+		"type":         "BOARD_ASSIGNED",
+		"actorId":      "a",
+		"targetUserId": "b",
+		"workspaceId":  "c",
+		"resourceType": "board",
+		"resourceId":   "d",
+		"resourceName": "Roadmap",
+		"metadata":     map[string]interface{}{"projectId": "e"},
+	}
+	MatchMessage(t, message, "notifications.events.board", payload)
+}
@@ -0,0 +1,103 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Match는 actual JSON이 expected 예시와 같은 구조인지 비교하고 차이를 반환합니다.
+//   - 객체: 예시의 모든 필드가 있어야 하며, 추가 필드는 허용합니다.
+//   - 배열: 예시에 요소가 있으면 actual도 비어 있지 않아야 하고, 모든 요소가 예시의 첫 요소와 같은 구조여야 합니다.
+//   - 값: 같은 JSON 타입이어야 합니다. exact에 있는 경로만 값까지 비교합니다.
+//
+// expected가 비어 있으면 본문을 확인하지 않습니다.
+func Match(expected, actual []byte, exact []string) []string {
+	if len(expected) == 0 {
+		return nil
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal(expected, &want); err != nil {
+		return []string{fmt.Sprintf("invalid contract body: %v", err)}
+	}
+	if err := json.Unmarshal(actual, &got); err != nil {
+		return []string{fmt.Sprintf("body is not JSON: %v", err)}
+	}
+
+	m := matcher{exact: make(map[string]bool, len(exact))}
+	for _, path := range exact {
+		m.exact[path] = true
+	}
+	m.match("$", want, got)
+	return m.mismatches
+}
+
+type matcher struct {
+	exact      map[string]bool
+	mismatches []string
+}
+
+func (m *matcher) fail(path, format string, args ...interface{}) {
+	m.mismatches = append(m.mismatches, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (m *matcher) match(path string, want, got interface{}) {
+	if kind(want) != kind(got) {
+		m.fail(path, "expected %s, got %s", kind(want), kind(got))
+		return
+	}
+	if m.exact[path] && !reflect.DeepEqual(want, got) {
+		m.fail(path, "expected %v, got %v", want, got)
+		return
+	}
+
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got := got.(map[string]interface{})
+		keys := make([]string, 0, len(want))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := got[key]
+			if !ok {
+				m.fail(path+"."+key, "missing")
+				continue
+			}
+			m.match(path+"."+key, want[key], value)
+		}
+	case []interface{}:
+		got := got.([]interface{})
+		if len(want) == 0 {
+			return
+		}
+		if len(got) == 0 {
+			m.fail(path, "expected at least one element")
+			return
+		}
+		for _, element := range got {
+			m.match(path+"[*]", want[0], element)
+		}
+	}
+}
+
+// kind는 JSON 값의 타입 이름을 반환합니다.
+func kind(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
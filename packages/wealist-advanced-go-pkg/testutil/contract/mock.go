@@ -0,0 +1,95 @@
+package contract
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// MockProvider는 계약의 응답을 돌려주는 테스트 서버입니다.
+// 계약에 없는 요청은 500으로 응답하고 테스트를 실패시킵니다.
+type MockProvider struct {
+	*httptest.Server
+
+	t            testing.TB
+	contract     *Contract
+	interactions []Interaction
+
+	mu        sync.Mutex
+	exercised map[string]bool
+}
+
+// NewMockProvider는 descriptions의 interaction을 제공하는 서버를 시작합니다 (비어 있으면 전체).
+// 테스트가 끝날 때 한 번도 호출되지 않은 interaction이 있으면 실패합니다.
+func NewMockProvider(t testing.TB, c *Contract, descriptions ...string) *MockProvider {
+	t.Helper()
+
+	p := &MockProvider{t: t, contract: c, exercised: make(map[string]bool)}
+	if len(descriptions) == 0 {
+		p.interactions = c.Interactions
+	}
+	for _, description := range descriptions {
+		p.interactions = append(p.interactions, c.Interaction(t, description))
+	}
+
+	p.Server = httptest.NewServer(http.HandlerFunc(p.serve))
+	t.Cleanup(func() {
+		p.Close()
+		for _, i := range p.interactions {
+			if !p.exercised[i.Description] {
+				t.Errorf("contract %s -> %s: interaction %q was not exercised", c.Consumer, c.Provider, i.Description)
+			}
+		}
+	})
+	return p
+}
+
+func (p *MockProvider) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	var mismatches []string
+	for _, i := range p.interactions {
+		if i.Request.Method != r.Method || i.Path() != r.URL.Path {
+			continue
+		}
+		problems := requestMismatches(i.Request, r.Header, body)
+		if len(problems) > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("%q: %s", i.Description, strings.Join(problems, "; ")))
+			continue
+		}
+
+		p.mu.Lock()
+		p.exercised[i.Description] = true
+		p.mu.Unlock()
+
+		for name, value := range i.Response.Headers {
+			w.Header().Set(name, value)
+		}
+		w.WriteHeader(i.Response.Status)
+		_, _ = w.Write(i.Response.Body)
+		return
+	}
+
+	if len(mismatches) == 0 {
+		p.t.Errorf("contract %s -> %s: unexpected request %s %s", p.contract.Consumer, p.contract.Provider, r.Method, r.URL.Path)
+	} else {
+		p.t.Errorf("contract %s -> %s: request %s %s does not match %s",
+			p.contract.Consumer, p.contract.Provider, r.Method, r.URL.Path, strings.Join(mismatches, ", "))
+	}
+	http.Error(w, "no matching contract interaction", http.StatusInternalServerError)
+}
+
+// requestMismatches는 consumer의 요청이 계약의 요청과 다른 점을 반환합니다.
+func requestMismatches(want Request, header http.Header, body []byte) []string {
+	var problems []string
+	for name := range want.Headers {
+		if header.Get(name) == "" {
+			problems = append(problems, "missing header "+name)
+		}
+	}
+	return append(problems, Match(want.Body, body, nil)...)
+}
@@ -0,0 +1,74 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// States는 provider state 이름별로 데이터를 준비하는 함수입니다.
+// params는 interaction의 Params입니다.
+type States map[string]func(t *testing.T, params map[string]string)
+
+// Verify는 계약의 모든 interaction을 handler에 재생하고 응답을 확인합니다.
+// interaction마다 하위 테스트로 실행되며, 요청 전에 ProviderState의 준비 함수를 호출합니다.
+func Verify(t *testing.T, c *Contract, handler http.Handler, states States) {
+	t.Helper()
+	if len(c.Interactions) == 0 {
+		t.Fatalf("contract %s -> %s has no interactions", c.Consumer, c.Provider)
+	}
+
+	for _, i := range c.Interactions {
+		i := i
+		t.Run(i.Description, func(t *testing.T) {
+			if i.ProviderState != "" {
+				setup, ok := states[i.ProviderState]
+				if !ok {
+					t.Fatalf("no setup for provider state %q", i.ProviderState)
+				}
+				setup(t, i.Params)
+			}
+
+			req := httptest.NewRequest(i.Request.Method, i.Path(), bytes.NewReader(i.Request.Body))
+			for name, value := range i.Request.Headers {
+				req.Header.Set(name, value)
+			}
+			if len(i.Request.Body) > 0 && req.Header.Get("Content-Type") == "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != i.Response.Status {
+				t.Fatalf("%s %s: status = %d, want %d (body: %s)", i.Request.Method, i.Path(), w.Code, i.Response.Status, w.Body.String())
+			}
+			for name, value := range i.Response.Headers {
+				if got := w.Header().Get(name); !strings.HasPrefix(got, value) {
+					t.Errorf("header %s = %q, want %q", name, got, value)
+				}
+			}
+			for _, problem := range Match(i.Response.Body, w.Body.Bytes(), i.Response.Exact) {
+				t.Errorf("response body %s", problem)
+			}
+		})
+	}
+}
+
+// MatchMessage는 발행하는 이벤트 payload가 계약의 메시지와 같은 구조인지 확인합니다.
+func MatchMessage(t testing.TB, m Message, subject string, payload interface{}) {
+	t.Helper()
+	if subject != m.Subject {
+		t.Errorf("message %q: subject = %q, want %q", m.Description, subject, m.Subject)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("message %q: failed to encode payload: %v", m.Description, err)
+	}
+	for _, problem := range Match(m.Body, data, m.Exact) {
+		t.Errorf("message %q: %s", m.Description, problem)
+	}
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+// Package integration은 testcontainers로 Postgres, Redis, MinIO, NATS를 띄워
+// 리포지토리/서비스 통합 테스트를 실행하는 하네스를 제공합니다.
+//
+// SQLite나 mock으로는 확인할 수 없는 동작(JSONB 연산자, deleted_at 기반 소프트 삭제,
+// presigned URL 업로드/다운로드 등)을 실제 인프라에 대해 검증할 때 사용합니다.
+// 컨테이너는 테스트 바이너리당 한 번만 시작되고, 각 테스트는 독립된 DB와 버킷을 받습니다.
+//
+// 모든 파일은 integration 빌드 태그로 분리되어 있어 기본 go test에는 포함되지 않습니다.
+//
+//	go test -tags integration ./...
+//
+// 사용 예:
+//
+//	func TestMain(m *testing.M) { os.Exit(integration.Run(m)) }
+//
+//	func TestBoardRepository_CustomFieldFilter(t *testing.T) {
+//		db := integration.Postgres(t)
+//		if err := database.AutoMigrate(db); err != nil { ... }
+//	}
+//
+// Docker를 사용할 수 없는 환경에서는 테스트가 실패하지 않고 건너뜁니다.
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// 컨테이너 이미지. 로컬 docker-compose와 같은 메이저 버전을 사용합니다.
+const (
+	PostgresImage = "postgres:16-alpine"
+	RedisImage    = "redis:7-alpine"
+	MinIOImage    = "minio/minio:RELEASE.2024-11-07T00-52-20Z"
+	NATSImage     = "nats:2.10-alpine"
+)
+
+// startTimeout은 컨테이너 하나가 준비될 때까지 기다리는 최대 시간입니다.
+const startTimeout = 2 * time.Minute
+
+var (
+	mu         sync.Mutex
+	containers []testcontainers.Container
+)
+
+// Run은 테스트를 실행한 뒤 이 패키지가 시작한 컨테이너를 모두 정리합니다.
+// TestMain에서 os.Exit(integration.Run(m))으로 호출합니다.
+func Run(m *testing.M) int {
+	code := m.Run()
+
+	mu.Lock()
+	defer mu.Unlock()
+	ctx := context.Background()
+	for _, c := range containers {
+		_ = c.Terminate(ctx)
+	}
+	containers = nil
+
+	return code
+}
+
+// track은 Run이 종료 시 정리할 컨테이너를 등록합니다.
+func track(c testcontainers.Container) {
+	mu.Lock()
+	defer mu.Unlock()
+	containers = append(containers, c)
+}
+
+// shared는 컨테이너를 한 번만 시작하고, 시작 실패는 이후 호출에도 그대로 반환합니다.
+type shared[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func (s *shared[T]) get(t testing.TB, start func(ctx context.Context) (T, error)) T {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	s.once.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+		defer cancel()
+		s.value, s.err = start(ctx)
+	})
+	if s.err != nil {
+		t.Fatalf("failed to start container: %v", s.err)
+	}
+	return s.value
+}
@@ -0,0 +1,56 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/testcontainers/testcontainers-go/modules/minio"
+)
+
+// S3는 MinIO 컨테이너의 S3 호환 접속 정보입니다.
+type S3 struct {
+	Endpoint  string // http://host:port
+	Region    string
+	AccessKey string
+	SecretKey string
+	// Bucket은 테스트마다 새로 만든 이름입니다. 버킷 생성은 각 서비스의 S3 클라이언트로 합니다.
+	Bucket string
+}
+
+var minioContainer shared[S3]
+
+func startMinIO(ctx context.Context) (S3, error) {
+	c, err := minio.Run(ctx, MinIOImage,
+		minio.WithUsername("minioadmin"),
+		minio.WithPassword("minioadmin"),
+	)
+	if c != nil {
+		track(c)
+	}
+	if err != nil {
+		return S3{}, err
+	}
+
+	address, err := c.ConnectionString(ctx)
+	if err != nil {
+		return S3{}, err
+	}
+	return S3{
+		Endpoint:  "http://" + address,
+		Region:    "us-east-1",
+		AccessKey: c.Username,
+		SecretKey: c.Password,
+	}, nil
+}
+
+// MinIO는 공유 MinIO 컨테이너 접속 정보와 테스트 전용 버킷 이름을 반환합니다.
+func MinIO(t testing.TB) S3 {
+	t.Helper()
+	s3 := minioContainer.get(t, startMinIO)
+	s3.Bucket = "it-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+	return s3
+}
@@ -0,0 +1,30 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	tcnats "github.com/testcontainers/testcontainers-go/modules/nats"
+)
+
+var natsContainer shared[string]
+
+func startNATS(ctx context.Context) (string, error) {
+	c, err := tcnats.Run(ctx, NATSImage)
+	if c != nil {
+		track(c)
+	}
+	if err != nil {
+		return "", err
+	}
+	return c.ConnectionString(ctx)
+}
+
+// NATS는 JetStream이 활성화된 공유 NATS 컨테이너의 URL을 반환합니다.
+// 스트림은 테스트 간에 공유되므로 테스트마다 다른 subject와 durable 이름을 사용해야 합니다.
+func NATS(t testing.TB) string {
+	t.Helper()
+	return natsContainer.get(t, startNATS)
+}
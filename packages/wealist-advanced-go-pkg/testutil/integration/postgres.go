@@ -0,0 +1,114 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	postgresUser     = "wealist"
+	postgresPassword = "wealist"
+)
+
+// postgresServer는 공유 Postgres 컨테이너의 접속 정보입니다.
+type postgresServer struct {
+	host string
+	port string
+	// admin은 테스트용 데이터베이스를 만들고 지우는 연결입니다.
+	admin *gorm.DB
+}
+
+var postgresContainer shared[*postgresServer]
+
+func startPostgres(ctx context.Context) (*postgresServer, error) {
+	c, err := postgres.Run(ctx, PostgresImage,
+		postgres.WithDatabase("postgres"),
+		postgres.WithUsername(postgresUser),
+		postgres.WithPassword(postgresPassword),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(startTimeout),
+		),
+	)
+	if c != nil {
+		track(c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := c.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+	port, err := c.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	server := &postgresServer{host: host, port: port.Port()}
+	server.admin, err = gorm.Open(gormpostgres.Open(server.dsn("postgres")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+func (s *postgresServer) dsn(dbname string) string {
+	return fmt.Sprintf("postgresql://%s:%s@%s/%s?sslmode=disable",
+		postgresUser, postgresPassword, net.JoinHostPort(s.host, s.port), dbname)
+}
+
+// PostgresDSN은 테스트 전용 빈 데이터베이스를 만들고 DSN을 반환합니다.
+// 데이터베이스는 테스트가 끝나면 삭제됩니다.
+// DSN으로 직접 연결하는 서비스(예: database.NewDB(cfg))에서 사용합니다.
+func PostgresDSN(t testing.TB) string {
+	t.Helper()
+	server := postgresContainer.get(t, startPostgres)
+
+	dbname := "it_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err := server.admin.Exec(fmt.Sprintf(`CREATE DATABASE %q`, dbname)).Error; err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = server.admin.Exec(fmt.Sprintf(`DROP DATABASE IF EXISTS %q WITH (FORCE)`, dbname)).Error
+	})
+
+	return server.dsn(dbname)
+}
+
+// Postgres는 테스트 전용 빈 데이터베이스에 연결된 *gorm.DB를 반환합니다.
+// 스키마는 각 서비스의 마이그레이션(database.AutoMigrate 등)으로 만들어야 합니다.
+func Postgres(t testing.TB) *gorm.DB {
+	t.Helper()
+	dsn := PostgresDSN(t)
+
+	db, err := gorm.Open(gormpostgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+var redisContainer shared[string]
+
+func startRedis(ctx context.Context) (string, error) {
+	c, err := tcredis.Run(ctx, RedisImage)
+	if c != nil {
+		track(c)
+	}
+	if err != nil {
+		return "", err
+	}
+	return c.ConnectionString(ctx)
+}
+
+// Redis는 공유 Redis 컨테이너에 연결된 클라이언트를 반환합니다.
+// 테스트 시작과 끝에 DB를 비우므로 Redis를 쓰는 테스트는 t.Parallel()을 사용하면 안 됩니다.
+func Redis(t testing.TB) *redis.Client {
+	t.Helper()
+	url := redisContainer.get(t, startRedis)
+
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		t.Fatalf("failed to parse redis url: %v", err)
+	}
+	client := redis.NewClient(opts)
+
+	ctx := context.Background()
+	if err := client.FlushDB(ctx).Err(); err != nil {
+		t.Fatalf("failed to flush redis: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.FlushDB(context.Background()).Err()
+		_ = client.Close()
+	})
+	return client
+}
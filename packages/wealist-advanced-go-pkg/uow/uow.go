@@ -0,0 +1,76 @@
+// Package uow는 여러 저장소에 걸친 변경을 하나의 DB 트랜잭션으로 묶는 작업 단위(unit of work)를 제공합니다.
+//
+// 트랜잭션은 context로 전달되므로 저장소 시그니처를 바꾸지 않고도 같은 트랜잭션에 참여할 수 있습니다.
+//
+//	err := uow.Transaction(ctx, db, func(ctx context.Context) error {
+//		if err := boardRepo.Create(ctx, board); err != nil {
+//			return err
+//		}
+//		return attachmentRepo.ConfirmAttachments(ctx, attachmentIDs, board.ID)
+//	})
+//
+// 저장소는 r.db.WithContext(ctx) 대신 uow.DB(ctx, r.db)를 사용해야 트랜잭션에 참여합니다.
+// outbox.Transaction도 같은 트랜잭션을 사용하므로 아웃박스 이벤트도 변경과 함께 커밋됩니다.
+package uow
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// unit은 진행 중인 트랜잭션과 커밋 후 실행할 작업입니다.
+type unit struct {
+	tx          *gorm.DB
+	afterCommit []func()
+}
+
+// Transaction runs fn in a database transaction carried by the context passed to fn.
+// fn이 에러를 반환하거나 패닉이 나면 fn 안의 모든 변경이 롤백됩니다.
+// 이미 트랜잭션 안이면 새로 시작하지 않고 바깥 트랜잭션에 참여하며, 커밋은 가장 바깥에서 한 번만 일어납니다.
+func Transaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*unit); ok {
+		return fn(ctx)
+	}
+
+	u := &unit{}
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		u.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, u))
+	}); err != nil {
+		return err
+	}
+
+	for _, f := range u.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// DB returns the transaction of ctx, or db when ctx carries none.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if u, ok := ctx.Value(txKey{}).(*unit); ok {
+		return u.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// InTransaction reports whether ctx carries a transaction started by Transaction.
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*unit)
+	return ok
+}
+
+// AfterCommit runs fn once the transaction of ctx commits, or right away when ctx carries none.
+// 롤백되면 실행하지 않습니다. 이벤트 직접 발행, 캐시 무효화, 알림처럼
+// 커밋되지 않은 변경을 밖에 알리면 안 되는 작업에 사용합니다.
+// 트랜잭션과 마찬가지로 여러 고루틴에서 동시에 호출하면 안 됩니다.
+func AfterCommit(ctx context.Context, fn func()) {
+	if u, ok := ctx.Value(txKey{}).(*unit); ok {
+		u.afterCommit = append(u.afterCommit, fn)
+		return
+	}
+	fn()
+}
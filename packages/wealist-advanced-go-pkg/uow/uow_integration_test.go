@@ -0,0 +1,80 @@
+//go:build integration
+
+package uow
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+type item struct {
+	ID   uint
+	Name string
+}
+
+func TestTransaction_CommitsAllOrNothing(t *testing.T) {
+	db := integration.Postgres(t)
+	if err := db.AutoMigrate(&item{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	ctx := context.Background()
+	create := func(ctx context.Context, name string) error {
+		return DB(ctx, db).Create(&item{Name: name}).Error
+	}
+	count := func() int64 {
+		var n int64
+		if err := db.Model(&item{}).Count(&n).Error; err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		return n
+	}
+
+	committed := 0
+	err := Transaction(ctx, db, func(ctx context.Context) error {
+		if err := create(ctx, "board"); err != nil {
+			return err
+		}
+		AfterCommit(ctx, func() { committed++ })
+		// 중첩 호출은 같은 트랜잭션에 참여
+		return Transaction(ctx, db, func(ctx context.Context) error {
+			return create(ctx, "participant")
+		})
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	if got := count(); got != 2 {
+		t.Errorf("expected 2 committed rows, got %d", got)
+	}
+	if committed != 1 {
+		t.Errorf("expected AfterCommit to run once, ran %d times", committed)
+	}
+
+	errConfirm := errors.New("attachment already used")
+	err = Transaction(ctx, db, func(ctx context.Context) error {
+		if err := create(ctx, "board"); err != nil {
+			return err
+		}
+		AfterCommit(ctx, func() { committed++ })
+		return Transaction(ctx, db, func(ctx context.Context) error {
+			return errConfirm
+		})
+	})
+	if !errors.Is(err, errConfirm) {
+		t.Fatalf("Transaction() error = %v, want %v", err, errConfirm)
+	}
+	if got := count(); got != 2 {
+		t.Errorf("expected the failed unit to be rolled back, got %d rows", got)
+	}
+	if committed != 1 {
+		t.Error("AfterCommit must not run after a rollback")
+	}
+}
@@ -0,0 +1,89 @@
+package uow
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB는 쿼리를 실행하지 않고 SQL만 생성하는 PostgreSQL 연결입니다.
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=test"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return db
+}
+
+func TestDB_WithoutTransaction(t *testing.T) {
+	db := dryRunDB(t)
+	ctx := context.WithValue(context.Background(), struct{}{}, "request")
+
+	got := DB(ctx, db)
+	if got.Statement.ConnPool != db.Statement.ConnPool {
+		t.Error("expected the given connection outside a transaction")
+	}
+	if got.Statement.Context != ctx {
+		t.Error("expected the request context to be attached")
+	}
+	if InTransaction(ctx) {
+		t.Error("InTransaction() = true, want false")
+	}
+}
+
+func TestDB_JoinsTransactionOfContext(t *testing.T) {
+	db := dryRunDB(t)
+	tx := db.Session(&gorm.Session{NewDB: true})
+	ctx := context.WithValue(context.Background(), txKey{}, &unit{tx: tx})
+
+	if got := DB(ctx, db); got.Statement.ConnPool != tx.Statement.ConnPool || got.Statement.Context != ctx {
+		t.Error("expected the transaction carried by the context")
+	}
+	if !InTransaction(ctx) {
+		t.Error("InTransaction() = false, want true")
+	}
+}
+
+func TestAfterCommit_RunsImmediatelyWithoutTransaction(t *testing.T) {
+	ran := false
+	AfterCommit(context.Background(), func() { ran = true })
+	if !ran {
+		t.Error("expected fn to run right away outside a transaction")
+	}
+}
+
+func TestAfterCommit_DeferredInTransaction(t *testing.T) {
+	u := &unit{}
+	ctx := context.WithValue(context.Background(), txKey{}, u)
+
+	ran := false
+	AfterCommit(ctx, func() { ran = true })
+	if ran {
+		t.Fatal("expected fn to wait for the commit")
+	}
+	if len(u.afterCommit) != 1 {
+		t.Fatalf("expected 1 deferred func, got %d", len(u.afterCommit))
+	}
+}
+
+func TestTransaction_JoinsOuterTransaction(t *testing.T) {
+	outer := &unit{tx: dryRunDB(t)}
+	ctx := context.WithValue(context.Background(), txKey{}, outer)
+
+	// 바깥 트랜잭션이 있으면 db로 새 트랜잭션을 시작하지 않으므로 nil이어도 됩니다.
+	err := Transaction(ctx, nil, func(inner context.Context) error {
+		if inner.Value(txKey{}) != outer {
+			t.Error("expected the outer transaction to be reused")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
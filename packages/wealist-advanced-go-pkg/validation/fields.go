@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// FieldErrors는 validator 에러를 JSON 필드 이름과 태그별 메시지로 변환합니다.
+// obj는 검증한 구조체(또는 포인터)로, Go 필드 이름을 json 태그 이름으로 바꾸는 데 사용합니다.
+func FieldErrors(errs validator.ValidationErrors, obj any) []response.FieldError {
+	fields := make([]response.FieldError, 0, len(errs))
+	for _, fe := range errs {
+		field := jsonPath(reflect.TypeOf(obj), fe.StructNamespace())
+		fields = append(fields, response.FieldError{
+			Field:   field,
+			Tag:     fe.Tag(),
+			Message: field + " " + message(fe),
+		})
+	}
+	return fields
+}
+
+// message는 binding 태그에 맞는 메시지입니다 (필드 이름 제외).
+func message(fe validator.FieldError) string {
+	param := fe.Param()
+	switch fe.Tag() {
+	case "required", "required_if", "required_unless", "required_with", "required_without":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url", "http_url":
+		return "must be a valid URL"
+	case "uuid", "uuid4":
+		return "must be a valid UUID"
+	case "oneof":
+		return "must be one of [" + strings.Join(strings.Fields(param), ", ") + "]"
+	case "min", "gte":
+		return "must be at least " + sized(fe, param)
+	case "max", "lte":
+		return "must be at most " + sized(fe, param)
+	case "gt":
+		return "must be greater than " + sized(fe, param)
+	case "lt":
+		return "must be less than " + sized(fe, param)
+	case "len":
+		return "must be exactly " + sized(fe, param)
+	case "hexcolor":
+		return "must be a hex color (e.g. #1a2b3c)"
+	case "datetime":
+		return "must be a date/time in the format " + param
+	default:
+		if param != "" {
+			return fmt.Sprintf("failed the %s=%s rule", fe.Tag(), param)
+		}
+		return fmt.Sprintf("failed the %s rule", fe.Tag())
+	}
+}
+
+// sized는 필드 종류에 맞게 길이/개수/값 단위를 붙입니다.
+func sized(fe validator.FieldError, param string) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return param + " characters long"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return param + " items"
+	default:
+		return param
+	}
+}
+
+// jsonPath는 "CreateBoardRequest.Items[0].Name" 같은 구조체 경로를 "items[0].name"으로 바꿉니다.
+func jsonPath(t reflect.Type, namespace string) string {
+	parts := strings.Split(namespace, ".")
+	if len(parts) > 1 {
+		parts = parts[1:] // 최상위 타입 이름 제외
+	}
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		name, index, _ := strings.Cut(part, "[")
+		if index != "" {
+			index = "[" + index
+		}
+		t = deref(t)
+		if t != nil && t.Kind() == reflect.Struct {
+			if sf, ok := t.FieldByName(name); ok {
+				name = jsonName(sf)
+				t = sf.Type
+				if index != "" {
+					t = elem(t, strings.Count(index, "["))
+				}
+			} else {
+				t = nil
+			}
+		}
+		out = append(out, name+index)
+	}
+	return strings.Join(out, ".")
+}
+
+func jsonName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+	return name
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// elem은 인덱스 접근(배열, 슬라이스, 맵) depth만큼 요소 타입을 따라갑니다.
+func elem(t reflect.Type, depth int) reflect.Type {
+	for i := 0; i < depth && t != nil; i++ {
+		t = deref(t)
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return nil
+		}
+	}
+	return t
+}
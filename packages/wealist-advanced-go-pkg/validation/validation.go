@@ -0,0 +1,133 @@
+// Package validation은 엄격한 JSON 요청 바인딩과 필드별 유효성 검증 에러 응답을 제공합니다.
+//
+// 핸들러는 c.ShouldBindJSON 대신 BindJSON을 사용합니다. 실패하면 에러 응답을 이미 보냈으므로 바로 반환합니다.
+//
+//	var req dto.CreateBoardRequest
+//	if err := validation.BindJSON(c, &req); err != nil {
+//		return
+//	}
+//
+// 실패 응답은 binding 태그별 메시지를 담은 필드 목록입니다.
+//
+//	{"success": false, "error": {"code": "VALIDATION_ERROR", "message": "Request validation failed",
+//	  "fields": [{"field": "title", "tag": "required", "message": "title is required"}]}, "requestId": "..."}
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// BindJSON은 요청 본문을 obj로 디코딩하고 binding 태그로 검증합니다.
+// 정의되지 않은 필드, 잘못된 타입, 뒤에 남은 데이터는 거부합니다.
+// 실패하면 에러 응답(400 VALIDATION_ERROR 또는 413 REQUEST_TOO_LARGE)을 보낸 뒤 에러를 반환합니다.
+func BindJSON(c *gin.Context, obj any) error {
+	err := decodeStrict(c.Request.Body, obj)
+	if err == nil {
+		err = binding.Validator.ValidateStruct(obj)
+	}
+	if err != nil {
+		respond(c, err, obj)
+	}
+	return err
+}
+
+// decodeStrict는 알 수 없는 필드를 거부하며 JSON 값 하나를 디코딩합니다.
+func decodeStrict(body io.Reader, obj any) error {
+	if body == nil {
+		return io.EOF
+	}
+	dec := json.NewDecoder(body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(obj); err != nil {
+		return err
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return err
+		}
+		return errTrailingData
+	}
+	return nil
+}
+
+var errTrailingData = errors.New("request body must contain a single JSON object")
+
+// respond는 바인딩 에러를 공통 에러 응답으로 변환합니다.
+func respond(c *gin.Context, err error, obj any) {
+	var (
+		maxBytesErr    *http.MaxBytesError
+		syntaxErr      *json.SyntaxError
+		typeErr        *json.UnmarshalTypeError
+		validationErrs validator.ValidationErrors
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		response.Error(c, http.StatusRequestEntityTooLarge, apperrors.ErrCodeRequestTooLarge,
+			fmt.Sprintf("Request body exceeds %d bytes", maxBytesErr.Limit))
+	case errors.Is(err, io.EOF):
+		response.Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Request body is required")
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		response.Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Request body is not valid JSON")
+	case errors.Is(err, errTrailingData):
+		response.Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Request body must contain a single JSON object")
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			field = "$"
+		}
+		response.ValidationFailed(c, []response.FieldError{{
+			Field:   field,
+			Tag:     "type",
+			Message: fmt.Sprintf("%s must be %s", field, jsonTypeName(typeErr.Type.Kind().String())),
+		}})
+	case errors.As(err, &validationErrs):
+		response.ValidationFailed(c, FieldErrors(validationErrs, obj))
+	default:
+		if field, ok := unknownField(err); ok {
+			response.ValidationFailed(c, []response.FieldError{{
+				Field:   field,
+				Tag:     "unknown",
+				Message: field + " is not a known field",
+			}})
+			return
+		}
+		response.Error(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Request body is invalid")
+	}
+}
+
+// unknownField는 DisallowUnknownFields 에러에서 필드 이름을 꺼냅니다 (encoding/json은 타입이 있는 에러를 주지 않음).
+func unknownField(err error) (string, bool) {
+	name, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+	if !ok {
+		return "", false
+	}
+	return strings.Trim(name, `"`), true
+}
+
+func jsonTypeName(kind string) string {
+	switch {
+	case strings.HasPrefix(kind, "int"), strings.HasPrefix(kind, "uint"), strings.HasPrefix(kind, "float"):
+		return "a number"
+	case kind == "string":
+		return "a string"
+	case kind == "bool":
+		return "a boolean"
+	case kind == "slice", kind == "array":
+		return "an array"
+	default:
+		return "an object"
+	}
+}
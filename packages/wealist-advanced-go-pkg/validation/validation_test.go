@@ -0,0 +1,137 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+type itemRequest struct {
+	Name string `json:"name" binding:"required,max=5"`
+}
+
+type createRequest struct {
+	Title    string        `json:"title" binding:"required,min=2"`
+	Priority string        `json:"priority" binding:"omitempty,oneof=LOW MEDIUM HIGH"`
+	Email    string        `json:"email" binding:"omitempty,email"`
+	Tags     []string      `json:"tags" binding:"max=2"`
+	Items    []itemRequest `json:"items" binding:"dive"`
+	Count    int           `json:"count"`
+}
+
+type errorBody struct {
+	Error response.ErrorDetail `json:"error"`
+}
+
+func bind(t *testing.T, body string, limit int64) (*httptest.ResponseRecorder, errorBody) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	if limit > 0 {
+		r.Use(commonmw.BodyLimit(limit))
+	}
+	r.POST("/", func(c *gin.Context) {
+		var req createRequest
+		if err := BindJSON(c, &req); err != nil {
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	var resp errorBody
+	if w.Code != http.StatusNoContent {
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("invalid error body %q: %v", w.Body.String(), err)
+		}
+	}
+	return w, resp
+}
+
+func TestBindJSON_Valid(t *testing.T) {
+	w, _ := bind(t, `{"title":"hello","priority":"HIGH","items":[{"name":"a"}]}`, 0)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestBindJSON_FieldErrors(t *testing.T) {
+	w, resp := bind(t, `{"title":"h","priority":"URGENT","email":"nope","tags":["a","b","c"],"items":[{"name":"ok"},{"name":"toolong"}]}`, 0)
+	if w.Code != http.StatusBadRequest || resp.Error.Code != "VALIDATION_ERROR" {
+		t.Fatalf("expected 400 VALIDATION_ERROR, got %d %+v", w.Code, resp.Error)
+	}
+
+	want := map[string]string{
+		"title":         "title must be at least 2 characters long",
+		"priority":      "priority must be one of [LOW, MEDIUM, HIGH]",
+		"email":         "email must be a valid email address",
+		"tags":          "tags must be at most 2 items",
+		"items[1].name": "items[1].name must be at most 5 characters long",
+	}
+	if len(resp.Error.Fields) != len(want) {
+		t.Fatalf("expected %d field errors, got %+v", len(want), resp.Error.Fields)
+	}
+	for _, f := range resp.Error.Fields {
+		if want[f.Field] != f.Message {
+			t.Errorf("%s: got %q, want %q", f.Field, f.Message, want[f.Field])
+		}
+	}
+}
+
+func TestBindJSON_RequiredField(t *testing.T) {
+	_, resp := bind(t, `{}`, 0)
+	if len(resp.Error.Fields) != 1 || resp.Error.Fields[0].Field != "title" || resp.Error.Fields[0].Tag != "required" {
+		t.Errorf("expected title required, got %+v", resp.Error.Fields)
+	}
+}
+
+func TestBindJSON_RejectsUnknownFieldsAndBadJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		field   string
+		tag     string
+		message string
+	}{
+		{name: "unknown field", body: `{"title":"hello","customFields":{}}`, field: "customFields", tag: "unknown"},
+		{name: "wrong type", body: `{"title":"hello","count":"3"}`, field: "count", tag: "type"},
+		{name: "malformed", body: `{"title":`, message: "Request body is not valid JSON"},
+		{name: "empty", body: ``, message: "Request body is required"},
+		{name: "trailing data", body: `{"title":"hello"} {}`, message: "Request body must contain a single JSON object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, resp := bind(t, tt.body, 0)
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d", w.Code)
+			}
+			if tt.field != "" {
+				if len(resp.Error.Fields) != 1 || resp.Error.Fields[0].Field != tt.field || resp.Error.Fields[0].Tag != tt.tag {
+					t.Errorf("unexpected fields %+v", resp.Error.Fields)
+				}
+			} else if resp.Error.Message != tt.message {
+				t.Errorf("got message %q, want %q", resp.Error.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestBindJSON_BodyLimit(t *testing.T) {
+	body := `{"title":"` + strings.Repeat("a", 100) + `"}`
+	w, resp := bind(t, body, 64)
+	if w.Code != http.StatusRequestEntityTooLarge || resp.Error.Code != "REQUEST_TOO_LARGE" {
+		t.Fatalf("expected 413 REQUEST_TOO_LARGE, got %d %+v", w.Code, resp.Error)
+	}
+
+	if w, _ := bind(t, body, 1024); w.Code != http.StatusNoContent {
+		t.Errorf("expected body under the limit to pass, got %d", w.Code)
+	}
+}
@@ -2,8 +2,10 @@ package OrangeCloud.AuthService;
 
 import org.springframework.boot.SpringApplication;
 import org.springframework.boot.autoconfigure.SpringBootApplication;
+import org.springframework.scheduling.annotation.EnableScheduling;
 
 @SpringBootApplication
+@EnableScheduling   // JWT 서명 키 로테이션 (SigningKeyService)
 public class AuthServiceApplication {
 
     public static void main(String[] args) {
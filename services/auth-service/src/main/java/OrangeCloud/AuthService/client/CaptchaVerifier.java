@@ -0,0 +1,77 @@
+package OrangeCloud.AuthService.client;
+
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.HttpEntity;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.MediaType;
+import org.springframework.http.ResponseEntity;
+import org.springframework.stereotype.Component;
+import org.springframework.util.LinkedMultiValueMap;
+import org.springframework.util.MultiValueMap;
+import org.springframework.util.StringUtils;
+import org.springframework.web.client.RestClientException;
+import org.springframework.web.client.RestTemplate;
+
+import java.util.Map;
+
+/**
+ * CAPTCHA 응답 토큰 검증 (Cloudflare Turnstile / reCAPTCHA siteverify 호환)
+ * secret이 없으면 비활성화되어 모든 요청을 통과시킨다 (잠금은 그대로 적용).
+ */
+@Component
+@RequiredArgsConstructor
+@Slf4j
+public class CaptchaVerifier {
+
+    private final RestTemplate restTemplate;
+
+    @Value("${login-protection.captcha.verify-url:https://challenges.cloudflare.com/turnstile/v0/siteverify}")
+    private String verifyUrl;
+
+    @Value("${login-protection.captcha.secret:}")
+    private String secret;
+
+    public boolean isEnabled() {
+        return StringUtils.hasText(secret);
+    }
+
+    /**
+     * 토큰 검증 - 검증 서버 오류도 실패로 처리한다.
+     *
+     * @param token    프론트엔드 위젯이 발급한 응답 토큰 (1회용)
+     * @param remoteIp 클라이언트 IP
+     */
+    public boolean verify(String token, String remoteIp) {
+        if (!isEnabled()) {
+            return true;
+        }
+        if (!StringUtils.hasText(token)) {
+            return false;
+        }
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_FORM_URLENCODED);
+
+        MultiValueMap<String, String> form = new LinkedMultiValueMap<>();
+        form.add("secret", secret);
+        form.add("response", token);
+        if (StringUtils.hasText(remoteIp)) {
+            form.add("remoteip", remoteIp);
+        }
+
+        try {
+            ResponseEntity<Map> response = restTemplate.postForEntity(verifyUrl, new HttpEntity<>(form, headers), Map.class);
+            Map body = response.getBody();
+            boolean success = body != null && Boolean.TRUE.equals(body.get("success"));
+            if (!success) {
+                log.warn("CAPTCHA verification rejected: errors={}", body != null ? body.get("error-codes") : null);
+            }
+            return success;
+        } catch (RestClientException e) {
+            log.error("CAPTCHA verification request failed: {}", e.getMessage());
+            return false;
+        }
+    }
+}
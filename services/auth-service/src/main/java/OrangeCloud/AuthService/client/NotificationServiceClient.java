@@ -0,0 +1,73 @@
+package OrangeCloud.AuthService.client;
+
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.HttpEntity;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.MediaType;
+import org.springframework.stereotype.Component;
+import org.springframework.util.StringUtils;
+import org.springframework.web.client.RestClientException;
+import org.springframework.web.client.RestTemplate;
+
+import java.time.Instant;
+import java.util.HashMap;
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * Notification Service HTTP Client
+ * 계정 보안 알림을 noti-service 내부 API(/api/internal/notifications)로 전송한다.
+ * 알림 전송 실패는 로그만 남기고 로그인 흐름을 막지 않는다.
+ */
+@Component
+@RequiredArgsConstructor
+@Slf4j
+public class NotificationServiceClient {
+
+    private static final String TYPE_SECURITY_NEW_LOGIN = "SECURITY_NEW_LOGIN";
+    private static final String RESOURCE_TYPE_ACCOUNT = "account";
+
+    private final RestTemplate restTemplate;
+
+    @Value("${notification-service.url:}")
+    private String notificationServiceUrl;
+
+    @Value("${notification-service.internal-api-key:}")
+    private String internalApiKey;
+
+    /**
+     * 새 기기/국가 로그인 알림 (워크스페이스와 무관한 계정 알림, 방해 금지 시간에도 전송)
+     *
+     * @param metadata 알림 문구에 쓰이는 device, country, ip 등
+     */
+    public void sendNewLoginAlert(UUID userId, Map<String, Object> metadata) {
+        if (!StringUtils.hasText(notificationServiceUrl)) {
+            log.debug("notification-service.url not configured, new login alert skipped: userId={}", userId);
+            return;
+        }
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+        headers.set("x-internal-api-key", internalApiKey);
+
+        Map<String, Object> requestBody = new HashMap<>();
+        requestBody.put("type", TYPE_SECURITY_NEW_LOGIN);
+        requestBody.put("actorId", userId);
+        requestBody.put("targetUserId", userId);
+        requestBody.put("resourceType", RESOURCE_TYPE_ACCOUNT);
+        requestBody.put("resourceId", userId);
+        requestBody.put("metadata", metadata);
+        requestBody.put("occurredAt", Instant.now().toString());
+        requestBody.put("critical", true);
+
+        try {
+            restTemplate.postForEntity(notificationServiceUrl + "/api/internal/notifications",
+                    new HttpEntity<>(requestBody, headers), Map.class);
+            log.info("New login alert sent: userId={}", userId);
+        } catch (RestClientException e) {
+            log.error("Failed to send new login alert: userId={}, error={}", userId, e.getMessage());
+        }
+    }
+}
@@ -1,18 +1,38 @@
 package OrangeCloud.AuthService.client;
 
+import OrangeCloud.AuthService.dto.PasskeyRecord;
+import OrangeCloud.AuthService.dto.SsoConnection;
+import OrangeCloud.AuthService.dto.SsoDiscovery;
+import OrangeCloud.AuthService.dto.SsoLoginResponse;
+import OrangeCloud.AuthService.dto.TokenPolicy;
+import OrangeCloud.AuthService.dto.UserMfaStatus;
+import OrangeCloud.AuthService.dto.UserPasskeys;
+import OrangeCloud.AuthService.dto.UserSummary;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.oauth.OAuth2UserInfo;
 import lombok.RequiredArgsConstructor;
 import lombok.extern.slf4j.Slf4j;
 import org.springframework.beans.factory.annotation.Value;
 import org.springframework.http.*;
+import org.springframework.security.oauth2.core.OAuth2AuthenticationException;
+import org.springframework.security.oauth2.core.OAuth2Error;
+import org.springframework.security.saml2.core.Saml2Error;
+import org.springframework.security.saml2.provider.service.authentication.Saml2AuthenticationException;
 import org.springframework.stereotype.Component;
+import org.springframework.web.client.HttpClientErrorException;
 import org.springframework.web.client.RestTemplate;
+import org.springframework.web.util.UriComponentsBuilder;
 
+import java.util.HashMap;
+import java.util.List;
 import java.util.Map;
 import java.util.UUID;
 
 /**
  * User Service HTTP Client
- * OAuth 로그인 시 사용자 조회/생성을 위해 user-service를 호출
+ * OAuth/SAML 로그인 시 사용자 조회/생성을 위해 user-service를 호출
+ * 패스키(WebAuthn) 자격 증명도 user-service에 저장한다.
  */
 @Component
 @RequiredArgsConstructor
@@ -25,29 +45,33 @@ public class UserServiceClient {
     private String userServiceUrl;
 
     /**
-     * OAuth 로그인 시 사용자 조회 또는 생성
+     * OAuth 로그인 시 사용자 조회, 계정 연결 또는 생성
      * user-service의 /api/internal/oauth/login 엔드포인트 호출
      *
-     * @param email    사용자 이메일
-     * @param name     사용자 이름
-     * @param provider OAuth 제공자 (google 등)
+     * user-service는 (provider, providerUserId)로 연결된 계정을 먼저 찾고,
+     * 없으면 검증된 이메일이 같은 기존 사용자에 연결하거나 새 사용자를 생성한다.
+     *
+     * @param userInfo 제공자 사용자 정보
+     * @param provider OAuth 제공자 (google, github, kakao)
      * @return 사용자 ID (UUID)
      */
-    public UUID findOrCreateOAuthUser(String email, String name, String provider) {
-        log.debug("Calling user-service to find or create OAuth user: email={}, provider={}", email, provider);
+    public UUID findOrCreateOAuthUser(OAuth2UserInfo userInfo, String provider) {
+        log.debug("Calling user-service to find or create OAuth user: email={}, provider={}", userInfo.getEmail(), provider);
 
         String url = userServiceUrl + "/api/internal/oauth/login";
 
         HttpHeaders headers = new HttpHeaders();
         headers.setContentType(MediaType.APPLICATION_JSON);
 
-        Map<String, String> requestBody = Map.of(
-                "email", email,
-                "name", name,
-                "provider", provider
+        Map<String, Object> requestBody = Map.of(
+                "email", userInfo.getEmail(),
+                "name", userInfo.getName(),
+                "provider", provider,
+                "providerUserId", userInfo.getProviderUserId(),
+                "emailVerified", userInfo.isEmailVerified()
         );
 
-        HttpEntity<Map<String, String>> request = new HttpEntity<>(requestBody, headers);
+        HttpEntity<Map<String, Object>> request = new HttpEntity<>(requestBody, headers);
 
         try {
             ResponseEntity<Map> response = restTemplate.exchange(
@@ -65,12 +89,401 @@ public class UserServiceClient {
             }
 
             throw new RuntimeException("Failed to get user from user-service");
+        } catch (HttpClientErrorException.Conflict e) {
+            // 워크스페이스가 SSO를 강제하는 이메일 도메인 - SAML 로그인으로 안내
+            log.warn("user-service requires SSO for OAuth login: provider={}", provider);
+            throw new OAuth2AuthenticationException(new OAuth2Error("sso_required",
+                    "워크스페이스 SSO로 로그인해야 합니다.", null), e);
+        } catch (HttpClientErrorException.Forbidden e) {
+            // 비활성 사용자이거나 검증되지 않은 이메일 - 로그인 실패로 처리
+            log.warn("user-service rejected OAuth login: provider={}, body={}", provider, e.getResponseBodyAsString());
+            throw new OAuth2AuthenticationException(new OAuth2Error("account_link_denied",
+                    "계정을 연결할 수 없습니다.", null), e);
+        } catch (Exception e) {
+            log.error("Error calling user-service: {}", e.getMessage(), e);
+            throw new RuntimeException("User service communication error", e);
+        }
+    }
+
+    /**
+     * 워크스페이스 IdP 설정 조회 (SAML RelyingPartyRegistration 생성용)
+     * user-service의 /api/internal/sso/workspaces/{workspaceId} 엔드포인트 호출
+     *
+     * @param workspaceId 워크스페이스 ID
+     * @return IdP 설정, SSO가 비활성화되어 있거나 없으면 null
+     */
+    public SsoConnection getSsoConnection(UUID workspaceId) {
+        String url = userServiceUrl + "/api/internal/sso/workspaces/" + workspaceId;
+
+        try {
+            return restTemplate.getForObject(url, SsoConnection.class);
+        } catch (HttpClientErrorException.NotFound e) {
+            log.debug("No enabled SSO connection: workspaceId={}", workspaceId);
+            return null;
+        } catch (Exception e) {
+            log.error("Error fetching SSO connection: workspaceId={}, error={}", workspaceId, e.getMessage());
+            return null;
+        }
+    }
+
+    /**
+     * 이메일 도메인으로 워크스페이스 SSO 조회 (로그인 화면의 SSO 안내용)
+     *
+     * @param email 사용자 이메일
+     * @return SSO 정보, 해당 도메인에 SSO가 없으면 null
+     */
+    public SsoDiscovery discoverSso(String email) {
+        String url = UriComponentsBuilder.fromHttpUrl(userServiceUrl + "/api/internal/sso/discover")
+                .queryParam("email", email)
+                .toUriString();
+
+        try {
+            return restTemplate.getForObject(url, SsoDiscovery.class);
+        } catch (HttpClientErrorException.NotFound e) {
+            return null;
+        } catch (Exception e) {
+            log.error("Error discovering SSO: {}", e.getMessage());
+            return null;
+        }
+    }
+
+    /**
+     * SAML 로그인 시 사용자 조회 또는 JIT 생성
+     * user-service의 /api/internal/sso/login 엔드포인트 호출
+     *
+     * 서명 검증이 끝난 assertion의 NameID와 속성만 전달하고,
+     * 이메일 도메인 검증과 속성 매핑은 user-service가 워크스페이스 설정으로 처리한다.
+     *
+     * @param workspaceId 워크스페이스 ID (registrationId)
+     * @param nameId      assertion의 NameID
+     * @param attributes  assertion 속성
+     * @return 사용자 ID와 이메일
+     */
+    public SsoLoginResponse ssoLogin(UUID workspaceId, String nameId, Map<String, List<String>> attributes) {
+        log.debug("Calling user-service for SSO login: workspaceId={}", workspaceId);
+
+        String url = userServiceUrl + "/api/internal/sso/login";
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+
+        Map<String, Object> requestBody = Map.of(
+                "workspaceId", workspaceId.toString(),
+                "nameId", nameId,
+                "attributes", attributes
+        );
+
+        try {
+            ResponseEntity<SsoLoginResponse> response = restTemplate.exchange(
+                    url,
+                    HttpMethod.POST,
+                    new HttpEntity<>(requestBody, headers),
+                    SsoLoginResponse.class
+            );
+
+            if (response.getStatusCode().is2xxSuccessful() && response.getBody() != null) {
+                log.info("SSO user found/created successfully: userId={}, workspaceId={}",
+                        response.getBody().getUserId(), workspaceId);
+                return response.getBody();
+            }
+
+            throw new RuntimeException("Failed to get user from user-service");
+        } catch (HttpClientErrorException.Forbidden | HttpClientErrorException.NotFound e) {
+            // 허용되지 않은 이메일 도메인, JIT 비활성화, SSO 비활성화 등
+            log.warn("user-service rejected SSO login: workspaceId={}, body={}", workspaceId, e.getResponseBodyAsString());
+            throw new Saml2AuthenticationException(new Saml2Error("sso_login_denied",
+                    "SSO 로그인이 허용되지 않습니다."), e);
         } catch (Exception e) {
             log.error("Error calling user-service: {}", e.getMessage(), e);
             throw new RuntimeException("User service communication error", e);
         }
     }
 
+    /**
+     * 매직 링크 로그인 대상 사용자 조회
+     * user-service의 /api/internal/magic-link/login 엔드포인트 호출
+     *
+     * 매직 링크는 기존 사용자만 로그인할 수 있으며 사용자를 새로 만들지 않는다.
+     *
+     * @param email 사용자 이메일
+     * @return 사용자 ID, 없는 사용자이거나 SSO를 강제하는 이메일 도메인이면 null
+     */
+    public UUID findMagicLinkUser(String email) {
+        String url = userServiceUrl + "/api/internal/magic-link/login";
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+
+        try {
+            ResponseEntity<Map> response = restTemplate.exchange(
+                    url,
+                    HttpMethod.POST,
+                    new HttpEntity<>(Map.of("email", email), headers),
+                    Map.class
+            );
+
+            if (response.getStatusCode().is2xxSuccessful() && response.getBody() != null) {
+                return UUID.fromString((String) response.getBody().get("userId"));
+            }
+
+            throw new RuntimeException("Failed to get user from user-service");
+        } catch (HttpClientErrorException.NotFound e) {
+            log.debug("No user for magic link login");
+            return null;
+        } catch (HttpClientErrorException.Conflict e) {
+            // 워크스페이스가 SSO를 강제하는 이메일 도메인 - 매직 링크를 보내지 않음
+            log.info("Magic link login skipped: SSO is required for the email domain");
+            return null;
+        } catch (Exception e) {
+            log.error("Error calling user-service: {}", e.getMessage(), e);
+            throw new RuntimeException("User service communication error", e);
+        }
+    }
+
+    /**
+     * 사용자의 패스키 목록과 패스키 MFA 설정 조회
+     * 로그인 완료 시마다 호출되므로 실패하면 예외 (MFA 우회 방지)
+     *
+     * @param userId 사용자 ID
+     * @return 패스키 목록과 MFA 설정
+     */
+    public UserPasskeys getUserPasskeys(UUID userId) {
+        String url = userServiceUrl + "/api/internal/passkeys/users/" + userId;
+
+        try {
+            UserPasskeys passkeys = restTemplate.getForObject(url, UserPasskeys.class);
+            if (passkeys == null) {
+                throw new RuntimeException("Empty passkey response from user-service");
+            }
+            return passkeys;
+        } catch (Exception e) {
+            log.error("Error fetching passkeys: userId={}, error={}", userId, e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * auth-service가 검증한 패스키 저장
+     * user-service의 /api/internal/passkeys 엔드포인트 호출
+     */
+    public void createPasskey(UUID userId, String credentialId, String publicKey, long signCount,
+                              List<String> transports, String aaguid, String name) {
+        String url = userServiceUrl + "/api/internal/passkeys";
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+
+        Map<String, Object> requestBody = new HashMap<>();
+        requestBody.put("userId", userId.toString());
+        requestBody.put("credentialId", credentialId);
+        requestBody.put("publicKey", publicKey);
+        requestBody.put("signCount", signCount);
+        requestBody.put("transports", transports);
+        requestBody.put("aaguid", aaguid);
+        requestBody.put("name", name != null ? name : "");
+
+        try {
+            restTemplate.exchange(url, HttpMethod.POST, new HttpEntity<>(requestBody, headers), Map.class);
+            log.info("Passkey stored: userId={}", userId);
+        } catch (HttpClientErrorException.Conflict | HttpClientErrorException.BadRequest
+                 | HttpClientErrorException.NotFound e) {
+            // 이미 등록된 자격 증명, 패스키 개수 초과, 비활성 사용자
+            log.warn("user-service rejected passkey: userId={}, body={}", userId, e.getResponseBodyAsString());
+            throw new CustomJwtException(ErrorCode.PASSKEY_REGISTRATION_REJECTED);
+        } catch (Exception e) {
+            log.error("Error storing passkey: {}", e.getMessage(), e);
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * credential ID로 패스키 조회 (assertion 검증용)
+     *
+     * @param credentialId base64url credential ID
+     * @return 패스키, 없거나 비활성 사용자의 패스키면 null
+     */
+    public PasskeyRecord getPasskey(String credentialId) {
+        String url = userServiceUrl + "/api/internal/passkeys/credentials/{credentialId}";
+
+        try {
+            return restTemplate.getForObject(url, PasskeyRecord.class, credentialId);
+        } catch (HttpClientErrorException.NotFound e) {
+            return null;
+        } catch (Exception e) {
+            log.error("Error fetching passkey: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 인증 성공 후 서명 카운터 갱신 (실패해도 로그인은 진행)
+     */
+    public void recordPasskeyUse(String credentialId, long signCount) {
+        String url = userServiceUrl + "/api/internal/passkeys/credentials/{credentialId}/use";
+
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+
+        try {
+            restTemplate.exchange(url, HttpMethod.POST,
+                    new HttpEntity<>(Map.of("signCount", signCount), headers), Void.class, credentialId);
+        } catch (Exception e) {
+            log.warn("Failed to record passkey use: {}", e.getMessage());
+        }
+    }
+
+    /**
+     * 사용자의 MFA 수단(TOTP, 패스키)과 워크스페이스 MFA 정책 조회
+     * 로그인 완료 시마다 호출되므로 실패하면 예외 (MFA 우회 방지)
+     */
+    public UserMfaStatus getUserMfa(UUID userId) {
+        String url = userServiceUrl + "/api/internal/mfa/users/" + userId;
+
+        try {
+            UserMfaStatus status = restTemplate.getForObject(url, UserMfaStatus.class);
+            if (status == null) {
+                throw new RuntimeException("Empty MFA response from user-service");
+            }
+            return status;
+        } catch (Exception e) {
+            log.error("Error fetching MFA status: userId={}, error={}", userId, e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 사용자가 속한 워크스페이스의 토큰 수명 정책 조회
+     * 로그인과 refresh마다 호출되므로 실패하면 예외 (짧게 설정한 정책 우회 방지)
+     */
+    public TokenPolicy getTokenPolicy(UUID userId) {
+        String url = userServiceUrl + "/api/internal/mfa/users/" + userId + "/token-policy";
+
+        try {
+            TokenPolicy policy = restTemplate.getForObject(url, TokenPolicy.class);
+            if (policy == null) {
+                throw new RuntimeException("Empty token policy response from user-service");
+            }
+            return policy;
+        } catch (Exception e) {
+            log.error("Error fetching token policy: userId={}, error={}", userId, e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 확인 코드로 검증된 TOTP secret(암호화)과 복구 코드 해시 저장
+     *
+     * @param step 등록을 확인한 코드의 시간 구간 (같은 코드로 바로 로그인하는 것 방지)
+     */
+    public void enableTotp(UUID userId, String encryptedSecret, long step, List<String> recoveryCodeHashes) {
+        String url = userServiceUrl + "/api/internal/mfa/users/{userId}/totp";
+
+        Map<String, Object> requestBody = new HashMap<>();
+        requestBody.put("secret", encryptedSecret);
+        requestBody.put("step", step);
+        requestBody.put("recoveryCodeHashes", recoveryCodeHashes);
+
+        try {
+            restTemplate.exchange(url, HttpMethod.PUT, new HttpEntity<>(requestBody, jsonHeaders()), Void.class, userId);
+            log.info("TOTP stored: userId={}", userId);
+        } catch (HttpClientErrorException.NotFound e) {
+            throw new CustomJwtException(ErrorCode.USER_NOT_FOUND);
+        } catch (Exception e) {
+            log.error("Error storing TOTP: {}", e.getMessage(), e);
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * TOTP와 복구 코드 삭제
+     */
+    public void disableTotp(UUID userId) {
+        String url = userServiceUrl + "/api/internal/mfa/users/{userId}/totp";
+
+        try {
+            restTemplate.exchange(url, HttpMethod.DELETE, null, Void.class, userId);
+            log.info("TOTP removed: userId={}", userId);
+        } catch (Exception e) {
+            log.error("Error removing TOTP: {}", e.getMessage(), e);
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 사용한 코드의 시간 구간 기록
+     *
+     * @return 같거나 이전 구간이 이미 사용되었으면 false (코드 재사용)
+     */
+    public boolean recordTotpUse(UUID userId, long step) {
+        String url = userServiceUrl + "/api/internal/mfa/users/{userId}/totp/use";
+
+        try {
+            restTemplate.exchange(url, HttpMethod.POST,
+                    new HttpEntity<>(Map.of("step", step), jsonHeaders()), Void.class, userId);
+            return true;
+        } catch (HttpClientErrorException.Conflict | HttpClientErrorException.NotFound e) {
+            return false;
+        } catch (Exception e) {
+            log.error("Error recording TOTP use: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 복구 코드를 새로 발급한 코드의 해시로 교체
+     */
+    public void replaceRecoveryCodes(UUID userId, List<String> codeHashes) {
+        String url = userServiceUrl + "/api/internal/mfa/users/{userId}/recovery-codes";
+
+        try {
+            restTemplate.exchange(url, HttpMethod.PUT,
+                    new HttpEntity<>(Map.of("codeHashes", codeHashes), jsonHeaders()), Void.class, userId);
+        } catch (HttpClientErrorException.BadRequest e) {
+            throw new CustomJwtException(ErrorCode.TOTP_NOT_ENABLED);
+        } catch (Exception e) {
+            log.error("Error replacing recovery codes: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 복구 코드 사용 처리
+     *
+     * @return 코드가 없거나 이미 사용되었으면 false
+     */
+    public boolean consumeRecoveryCode(UUID userId, String codeHash) {
+        String url = userServiceUrl + "/api/internal/mfa/users/{userId}/recovery-codes/consume";
+
+        try {
+            restTemplate.exchange(url, HttpMethod.POST,
+                    new HttpEntity<>(Map.of("codeHash", codeHash), jsonHeaders()), Void.class, userId);
+            return true;
+        } catch (HttpClientErrorException.NotFound e) {
+            return false;
+        } catch (Exception e) {
+            log.error("Error consuming recovery code: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
+    /**
+     * 사용자 기본 정보 조회 (OIDC ID token/userinfo 클레임용)
+     *
+     * @return 사용자 정보, 없는 사용자이면 null
+     */
+    public UserSummary getUser(UUID userId) {
+        String url = userServiceUrl + "/api/internal/users/" + userId;
+
+        try {
+            return restTemplate.getForObject(url, UserSummary.class);
+        } catch (HttpClientErrorException.NotFound e) {
+            return null;
+        } catch (Exception e) {
+            log.error("Error fetching user: userId={}, error={}", userId, e.getMessage());
+            throw new CustomJwtException(ErrorCode.USER_SERVICE_ERROR);
+        }
+    }
+
     /**
      * 사용자 존재 여부 확인
      *
@@ -95,4 +508,10 @@ public class UserServiceClient {
             return false;
         }
     }
+
+    private static HttpHeaders jsonHeaders() {
+        HttpHeaders headers = new HttpHeaders();
+        headers.setContentType(MediaType.APPLICATION_JSON);
+        return headers;
+    }
 }
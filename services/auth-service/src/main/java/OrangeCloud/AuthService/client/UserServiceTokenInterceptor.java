@@ -0,0 +1,57 @@
+package OrangeCloud.AuthService.client;
+
+import OrangeCloud.AuthService.service.ServiceClientService;
+import lombok.RequiredArgsConstructor;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpRequest;
+import org.springframework.http.client.ClientHttpRequestExecution;
+import org.springframework.http.client.ClientHttpRequestInterceptor;
+import org.springframework.http.client.ClientHttpResponse;
+import org.springframework.stereotype.Component;
+
+import java.io.IOException;
+import java.time.Duration;
+import java.time.Instant;
+import java.util.List;
+
+/**
+ * user-service 내부 API(/api/internal/**) 호출에 auth-service 서비스 토큰을 붙이는 인터셉터
+ * user-service는 내부 API에 항상 users:internal scope를 요구한다.
+ * 토큰은 만료 1분 전까지 재사용한다.
+ */
+@Component
+@RequiredArgsConstructor
+public class UserServiceTokenInterceptor implements ClientHttpRequestInterceptor {
+
+    private static final String CLIENT_ID = "auth-service";
+    private static final List<String> SCOPES = List.of("users:internal");
+    private static final Duration REFRESH_MARGIN = Duration.ofMinutes(1);
+
+    private final ServiceClientService serviceClientService;
+
+    @Value("${user-service.url}")
+    private String userServiceUrl;
+
+    private String token;
+    private Instant expiresAt = Instant.EPOCH;
+
+    @Override
+    public ClientHttpResponse intercept(HttpRequest request, byte[] body, ClientHttpRequestExecution execution)
+            throws IOException {
+        if (request.getURI().toString().startsWith(userServiceUrl + "/api/internal/")
+                && !request.getHeaders().containsKey(HttpHeaders.AUTHORIZATION)) {
+            request.getHeaders().setBearerAuth(currentToken());
+        }
+        return execution.execute(request, body);
+    }
+
+    private synchronized String currentToken() {
+        Instant now = Instant.now();
+        if (token == null || now.plus(REFRESH_MARGIN).isAfter(expiresAt)) {
+            token = serviceClientService.issueSelfToken(CLIENT_ID, SCOPES);
+            expiresAt = now.plus(serviceClientService.getTokenTtl());
+        }
+        return token;
+    }
+}
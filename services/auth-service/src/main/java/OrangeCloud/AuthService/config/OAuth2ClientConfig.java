@@ -0,0 +1,103 @@
+package OrangeCloud.AuthService.config;
+
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+import org.springframework.security.config.oauth2.client.CommonOAuth2Provider;
+import org.springframework.security.oauth2.client.registration.ClientRegistration;
+import org.springframework.security.oauth2.client.registration.InMemoryClientRegistrationRepository;
+import org.springframework.security.oauth2.core.AuthorizationGrantType;
+import org.springframework.security.oauth2.core.ClientAuthenticationMethod;
+import org.springframework.util.StringUtils;
+
+import java.util.LinkedHashMap;
+import java.util.Map;
+
+/**
+ * 소셜 로그인 제공자 등록 (Google, GitHub, Kakao)
+ *
+ * spring.security.oauth2.client 설정은 client-id가 비어 있으면 기동에 실패하므로,
+ * 환경마다 client-id가 설정된 제공자만 등록한다.
+ * (예: dev는 Google만, prod는 Google/GitHub/Kakao)
+ */
+@Configuration
+@Slf4j
+public class OAuth2ClientConfig {
+
+    @Value("${oauth2.providers.google.client-id:}")
+    private String googleClientId;
+
+    @Value("${oauth2.providers.google.client-secret:}")
+    private String googleClientSecret;
+
+    @Value("${oauth2.providers.google.redirect-uri:{baseUrl}/oauth2/callback/{registrationId}}")
+    private String googleRedirectUri;
+
+    @Value("${oauth2.providers.github.client-id:}")
+    private String githubClientId;
+
+    @Value("${oauth2.providers.github.client-secret:}")
+    private String githubClientSecret;
+
+    @Value("${oauth2.providers.github.redirect-uri:{baseUrl}/oauth2/callback/{registrationId}}")
+    private String githubRedirectUri;
+
+    @Value("${oauth2.providers.kakao.client-id:}")
+    private String kakaoClientId;
+
+    @Value("${oauth2.providers.kakao.client-secret:}")
+    private String kakaoClientSecret;
+
+    @Value("${oauth2.providers.kakao.redirect-uri:{baseUrl}/oauth2/callback/{registrationId}}")
+    private String kakaoRedirectUri;
+
+    @Bean
+    public InMemoryClientRegistrationRepository clientRegistrationRepository() {
+        Map<String, ClientRegistration> registrations = new LinkedHashMap<>();
+
+        if (StringUtils.hasText(googleClientId)) {
+            // openid scope를 빼야 OIDC가 아닌 CustomOAuth2UserService를 거침
+            registrations.put("google", CommonOAuth2Provider.GOOGLE.getBuilder("google")
+                    .clientId(googleClientId)
+                    .clientSecret(googleClientSecret)
+                    .redirectUri(googleRedirectUri)
+                    .scope("email", "profile")
+                    .build());
+        }
+
+        if (StringUtils.hasText(githubClientId)) {
+            // user:email - 검증된 기본 이메일 조회 (/user/emails)
+            registrations.put("github", CommonOAuth2Provider.GITHUB.getBuilder("github")
+                    .clientId(githubClientId)
+                    .clientSecret(githubClientSecret)
+                    .redirectUri(githubRedirectUri)
+                    .scope("read:user", "user:email")
+                    .build());
+        }
+
+        if (StringUtils.hasText(kakaoClientId)) {
+            registrations.put("kakao", ClientRegistration.withRegistrationId("kakao")
+                    .clientId(kakaoClientId)
+                    .clientSecret(kakaoClientSecret)
+                    .clientAuthenticationMethod(ClientAuthenticationMethod.CLIENT_SECRET_POST)
+                    .authorizationGrantType(AuthorizationGrantType.AUTHORIZATION_CODE)
+                    .redirectUri(kakaoRedirectUri)
+                    .scope("profile_nickname", "account_email")
+                    .authorizationUri("https://kauth.kakao.com/oauth/authorize")
+                    .tokenUri("https://kauth.kakao.com/oauth/token")
+                    .userInfoUri("https://kapi.kakao.com/v2/user/me")
+                    .userNameAttributeName("id")
+                    .clientName("Kakao")
+                    .build());
+        }
+
+        if (registrations.isEmpty()) {
+            log.warn("No OAuth2 providers configured - social login is disabled");
+        } else {
+            log.info("OAuth2 providers enabled: {}", registrations.keySet());
+        }
+
+        return new InMemoryClientRegistrationRepository(registrations);
+    }
+}
@@ -1,5 +1,7 @@
 package OrangeCloud.AuthService.config;
 
+import OrangeCloud.AuthService.client.UserServiceTokenInterceptor;
+import org.springframework.boot.web.client.RestTemplateBuilder;
 import org.springframework.context.annotation.Bean;
 import org.springframework.context.annotation.Configuration;
 import org.springframework.web.client.RestTemplate;
@@ -7,8 +9,16 @@ import org.springframework.web.client.RestTemplate;
 @Configuration
 public class RestTemplateConfig {
 
+    /**
+     * RestTemplateBuilder로 생성해야 Spring Boot의 observation(micrometer-tracing-bridge-otel)이 적용되어
+     * user-service, noti-service 호출에 traceparent 헤더가 전파된다. (new RestTemplate()은 추적되지 않음)
+     */
     @Bean
-    public RestTemplate restTemplate() {
-        return new RestTemplate();
+    public RestTemplate restTemplate(RestTemplateBuilder restTemplateBuilder,
+                                     UserServiceTokenInterceptor userServiceTokenInterceptor) {
+        return restTemplateBuilder
+                // user-service 내부 API 호출에만 서비스 토큰 추가
+                .additionalInterceptors(userServiceTokenInterceptor)
+                .build();
     }
 }
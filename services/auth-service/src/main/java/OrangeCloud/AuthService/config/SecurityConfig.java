@@ -4,6 +4,8 @@ import OrangeCloud.AuthService.oauth.CustomOAuth2UserService;
 import OrangeCloud.AuthService.oauth.OAuth2FailureHandler;
 import OrangeCloud.AuthService.oauth.OAuth2RedirectUriFilter;
 import OrangeCloud.AuthService.oauth.OAuth2SuccessHandler;
+import OrangeCloud.AuthService.saml.Saml2SuccessHandler;
+import OrangeCloud.AuthService.saml.WorkspaceRelyingPartyRegistrationRepository;
 import lombok.RequiredArgsConstructor;
 import org.springframework.context.annotation.Bean;
 import org.springframework.context.annotation.Configuration;
@@ -30,6 +32,8 @@ public class SecurityConfig {
     private final OAuth2SuccessHandler oAuth2SuccessHandler;
     private final OAuth2FailureHandler oAuth2FailureHandler;
     private final OAuth2RedirectUriFilter oAuth2RedirectUriFilter;
+    private final WorkspaceRelyingPartyRegistrationRepository relyingPartyRegistrationRepository;
+    private final Saml2SuccessHandler saml2SuccessHandler;
 
     @Bean
     public SecurityFilterChain securityFilterChain(HttpSecurity http) throws Exception {
@@ -49,6 +53,8 @@ public class SecurityConfig {
                                 "/api/test/**",     // 부하 테스트용 (ENABLE_TEST_AUTH=true 시만 활성화)
                                 "/oauth2/**",
                                 "/login/oauth2/**",
+                                "/saml2/**",        // 워크스페이스 SSO 시작 + SP 메타데이터
+                                "/login/saml2/**",  // SAML ACS
                                 "/actuator/**",
                                 "/health",
                                 "/ready",
@@ -68,6 +74,15 @@ public class SecurityConfig {
                         )
                         .successHandler(oAuth2SuccessHandler)
                         .failureHandler(oAuth2FailureHandler)
+                )
+                // 워크스페이스 SAML SSO (registrationId = workspaceId)
+                .saml2Login(saml2 -> saml2
+                        .relyingPartyRegistrationRepository(relyingPartyRegistrationRepository)
+                        .successHandler(saml2SuccessHandler)
+                        .failureHandler(oAuth2FailureHandler)
+                )
+                .saml2Metadata(metadata -> metadata
+                        .metadataUrl("/saml2/service-provider-metadata/{registrationId}")
                 );
 
         return http.build();
@@ -11,6 +11,7 @@ import org.springframework.session.web.http.DefaultCookieSerializer;
  * OAuth2 flow uses session to store authorization request (state parameter).
  * In multi-pod deployment with reverse proxy (CloudFront/nginx),
  * proper cookie configuration is essential for session to work across requests.
+ * SAML SSO also keeps the AuthnRequest in the session until the IdP posts back.
  */
 @Configuration
 public class SessionConfig {
@@ -25,9 +26,10 @@ public class SessionConfig {
         // Cookie path - must be / to work across all endpoints
         serializer.setCookiePath("/");
 
-        // SameSite=Lax allows cookie to be sent on top-level navigation (OAuth redirect)
-        // SameSite=None would require Secure=true
-        serializer.setSameSite("Lax");
+        // SameSite=None: SAML IdP는 ACS(/login/saml2/sso/*)로 cross-site POST를 보내므로
+        // Lax면 세션 쿠키가 빠져 AuthnRequest와 redirect_uri를 찾을 수 없음
+        // SameSite=None은 Secure=true가 필수 (아래 설정)
+        serializer.setSameSite("None");
 
         // Use secure cookie in production (HTTPS)
         // This is determined by X-Forwarded-Proto header from reverse proxy
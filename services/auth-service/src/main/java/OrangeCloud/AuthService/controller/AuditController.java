@@ -0,0 +1,93 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.AuthEventResponse;
+import OrangeCloud.AuthService.dto.AuthEventType;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.exception.InvalidTokenException;
+import OrangeCloud.AuthService.service.AuthAuditService;
+import OrangeCloud.AuthService.service.AuthService;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.servlet.http.HttpServletRequest;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.format.annotation.DateTimeFormat;
+import org.springframework.http.ResponseEntity;
+import org.springframework.util.StringUtils;
+import org.springframework.web.bind.annotation.*;
+
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.time.Instant;
+import java.util.List;
+import java.util.UUID;
+
+/**
+ * 인증 감사 로그 API
+ *
+ * - GET /api/auth/activity: 현재 사용자의 최근 활동 (로그인, 토큰 갱신/폐기, MFA)
+ * - GET /api/auth/audit/events: 관리자 조회 (x-internal-api-key 헤더 필요)
+ */
+@RestController
+@RequestMapping("/api/auth")
+@Tag(name = "Audit", description = "로그인/토큰 감사 로그 API")
+@RequiredArgsConstructor
+@Slf4j
+public class AuditController {
+
+    private static final String INTERNAL_API_KEY_HEADER = "x-internal-api-key";
+    private static final int MAX_ACTIVITY_LIMIT = 100;
+    private static final int MAX_QUERY_LIMIT = 1000;
+
+    private final AuthAuditService auditService;
+    private final AuthService authService;
+
+    @Value("${service-auth.admin-api-key:}")
+    private String adminApiKey;
+
+    @GetMapping("/activity")
+    @Operation(summary = "최근 활동", description = "현재 사용자의 로그인, 토큰 갱신/폐기, MFA 이벤트를 최신순으로 반환합니다.")
+    public ResponseEntity<List<AuthEventResponse>> recentActivity(
+            @RequestParam(defaultValue = "50") int limit,
+            HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        return ResponseEntity.ok(auditService.recentActivity(userId, clamp(limit, MAX_ACTIVITY_LIMIT)));
+    }
+
+    @GetMapping("/audit/events")
+    @Operation(summary = "감사 이벤트 조회", description = "사용자, 이벤트 종류, 기간으로 인증 이벤트를 조회합니다 (최신순).")
+    public ResponseEntity<List<AuthEventResponse>> queryEvents(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @RequestParam(required = false) UUID userId,
+            @RequestParam(required = false) AuthEventType type,
+            @RequestParam(required = false) @DateTimeFormat(iso = DateTimeFormat.ISO.DATE_TIME) Instant from,
+            @RequestParam(required = false) @DateTimeFormat(iso = DateTimeFormat.ISO.DATE_TIME) Instant to,
+            @RequestParam(defaultValue = "100") int limit) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.ok(auditService.query(userId, type, from, to, clamp(limit, MAX_QUERY_LIMIT)));
+    }
+
+    private static int clamp(int limit, int max) {
+        return Math.max(1, Math.min(limit, max));
+    }
+
+    /**
+     * 관리 API 키 확인 - 키가 설정되지 않았으면 관리 API 비활성화
+     */
+    private void checkAdminApiKey(String apiKey) {
+        if (!StringUtils.hasText(adminApiKey) || apiKey == null || !MessageDigest.isEqual(
+                adminApiKey.getBytes(StandardCharsets.UTF_8), apiKey.getBytes(StandardCharsets.UTF_8))) {
+            throw new CustomJwtException(ErrorCode.INTERNAL_API_KEY_INVALID);
+        }
+    }
+
+    private String extractTokenFromRequest(HttpServletRequest request) {
+        String bearerToken = request.getHeader("Authorization");
+        if (bearerToken != null && bearerToken.startsWith("Bearer ")) {
+            return bearerToken.substring(7);
+        }
+        throw new InvalidTokenException("Authorization 헤더에서 토큰을 찾을 수 없습니다.");
+    }
+}
@@ -1,17 +1,27 @@
 package OrangeCloud.AuthService.controller;
 
+import OrangeCloud.AuthService.client.UserServiceClient;
 import OrangeCloud.AuthService.dto.*;
 import OrangeCloud.AuthService.exception.InvalidTokenException;
 import OrangeCloud.AuthService.service.AuthService;
+import OrangeCloud.AuthService.service.LoginAttemptService;
+import OrangeCloud.AuthService.service.MagicLinkService;
 import io.swagger.v3.oas.annotations.Operation;
 import io.swagger.v3.oas.annotations.tags.Tag;
 import jakarta.servlet.http.HttpServletRequest;
+import jakarta.servlet.http.HttpServletResponse;
 import jakarta.validation.Valid;
 import lombok.RequiredArgsConstructor;
 import lombok.extern.slf4j.Slf4j;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpStatus;
+import org.springframework.http.ResponseCookie;
 import org.springframework.http.ResponseEntity;
+import org.springframework.security.oauth2.client.registration.InMemoryClientRegistrationRepository;
 import org.springframework.web.bind.annotation.*;
 
+import java.util.ArrayList;
+import java.util.List;
 import java.util.Map;
 import java.util.UUID;
 
@@ -24,6 +34,82 @@ import java.util.UUID;
 public class AuthController {
 
     private final AuthService authService;
+    private final InMemoryClientRegistrationRepository clientRegistrationRepository;
+    private final UserServiceClient userServiceClient;
+    private final MagicLinkService magicLinkService;
+
+    /**
+     * 현재 환경에서 사용 가능한 소셜 로그인 제공자 목록
+     * 프론트엔드는 이 목록으로 로그인 버튼을 표시하고 /oauth2/authorization/{provider}로 이동한다.
+     */
+    @GetMapping("/providers")
+    @Operation(summary = "소셜 로그인 제공자 목록", description = "설정된 OAuth2 제공자(google, github, kakao)를 반환합니다.")
+    public ResponseEntity<Map<String, List<String>>> providers() {
+        List<String> providers = new ArrayList<>();
+        clientRegistrationRepository.forEach(registration -> providers.add(registration.getRegistrationId()));
+        return ResponseEntity.ok(Map.of("providers", providers));
+    }
+
+    /**
+     * 이메일 도메인의 워크스페이스 SSO 조회
+     * 로그인 화면은 enforced=true면 소셜 로그인 대신 loginUrl(/saml2/authenticate/{workspaceId})로 이동한다.
+     */
+    @GetMapping("/sso/discover")
+    @Operation(summary = "워크스페이스 SSO 조회", description = "이메일 도메인에 연결된 SAML SSO 여부와 로그인 URL을 반환합니다.")
+    public ResponseEntity<Map<String, Object>> discoverSso(@RequestParam String email) {
+        SsoDiscovery discovery = userServiceClient.discoverSso(email);
+        if (discovery == null) {
+            return ResponseEntity.ok(Map.of("sso", false));
+        }
+        return ResponseEntity.ok(Map.of(
+                "sso", true,
+                "workspaceId", discovery.getWorkspaceId(),
+                "enforced", discovery.isEnforced(),
+                "loginUrl", "/saml2/authenticate/" + discovery.getWorkspaceId()
+        ));
+    }
+
+    /**
+     * 매직 링크 로그인 요청
+     * 사용자 존재 여부와 관계없이 같은 응답을 반환하고, 링크를 연 기기 확인용 쿠키를 설정한다.
+     */
+    @PostMapping("/magic-link")
+    @Operation(summary = "매직 링크 요청", description = "이메일로 1회용 로그인 링크를 보냅니다.")
+    public ResponseEntity<MessageApiResponse> requestMagicLink(
+            @Valid @RequestBody MagicLinkRequest magicLinkRequest,
+            @CookieValue(name = MagicLinkService.DEVICE_COOKIE_NAME, required = false) String deviceNonce,
+            HttpServletRequest request,
+            HttpServletResponse response) {
+        if (deviceNonce == null || deviceNonce.isBlank()) {
+            deviceNonce = magicLinkService.newDeviceNonce();
+        }
+        magicLinkService.requestLink(magicLinkRequest.getEmail(), request.getRemoteAddr(), deviceNonce);
+
+        response.addHeader(HttpHeaders.SET_COOKIE, deviceCookie(deviceNonce,
+                magicLinkService.getTokenTtl().toSeconds()).toString());
+        return ResponseEntity.status(HttpStatus.ACCEPTED)
+                .body(new MessageApiResponse(true, "가입된 이메일이면 로그인 링크가 발송됩니다."));
+    }
+
+    /**
+     * 매직 링크 사용 - 링크를 요청한 브라우저에서만 토큰 쌍을 발급
+     */
+    @PostMapping("/magic-link/verify")
+    @Operation(summary = "매직 링크 로그인", description = "로그인 링크 토큰을 검증하고 Access/Refresh Token을 발급합니다. 패스키 MFA 사용자는 mfaToken을 반환합니다.")
+    public ResponseEntity<AuthResponse> verifyMagicLink(
+            @Valid @RequestBody MagicLinkVerifyRequest verifyRequest,
+            @CookieValue(name = MagicLinkService.DEVICE_COOKIE_NAME, required = false) String deviceNonce,
+            @RequestHeader(name = LoginAttemptService.CAPTCHA_HEADER, required = false) String captchaToken,
+            HttpServletRequest request,
+            HttpServletResponse response) {
+        AuthResponse authResponse = magicLinkService.redeem(verifyRequest.getToken(), deviceNonce,
+                LoginContext.from(request), captchaToken);
+
+        // 사용이 끝난 기기 쿠키 삭제
+        response.addHeader(HttpHeaders.SET_COOKIE, deviceCookie("", 0).toString());
+        log.info("매직 링크 로그인 성공");
+        return ResponseEntity.ok(authResponse);
+    }
 
     /**
      * 로그아웃
@@ -33,19 +119,34 @@ public class AuthController {
     public ResponseEntity<MessageApiResponse> logout(HttpServletRequest request) {
         log.debug("Received logout request.");
         String token = extractTokenFromRequest(request);
-        authService.logout(token);
+        authService.logout(token, LoginContext.from(request));
         log.info("로그아웃 성공");
         return ResponseEntity.ok(new MessageApiResponse(true, "로그아웃 성공"));
     }
 
+    /**
+     * 모든 기기에서 로그아웃
+     * 계정 탈취가 의심될 때 현재 사용자에게 발급된 모든 access/refresh token을 즉시 폐기한다.
+     */
+    @PostMapping("/revoke-all")
+    @Operation(summary = "전체 토큰 폐기", description = "현재 사용자에게 발급된 모든 토큰을 폐기합니다.")
+    public ResponseEntity<MessageApiResponse> revokeAll(HttpServletRequest request) {
+        String token = extractTokenFromRequest(request);
+        authService.revokeAllTokens(token, LoginContext.from(request));
+        log.info("전체 토큰 폐기 성공");
+        return ResponseEntity.ok(new MessageApiResponse(true, "모든 기기에서 로그아웃되었습니다."));
+    }
+
     /**
      * 토큰 갱신
      */
     @PostMapping("/refresh")
     @Operation(summary = "토큰 갱신", description = "Refresh Token을 사용하여 Access Token을 갱신합니다.")
-    public ResponseEntity<AuthResponse> refresh(@Valid @RequestBody RefreshTokenRequest refreshRequest) {
+    public ResponseEntity<AuthResponse> refresh(@Valid @RequestBody RefreshTokenRequest refreshRequest,
+                                                HttpServletRequest request) {
         log.debug("Received refresh token request.");
-        AuthResponse authResponse = authService.refreshToken(refreshRequest.getRefreshToken());
+        AuthResponse authResponse = authService.refreshToken(refreshRequest.getRefreshToken(),
+                LoginContext.from(request));
         log.info("토큰 갱신 성공");
         return ResponseEntity.ok(authResponse);
     }
@@ -79,6 +180,16 @@ public class AuthController {
         }
     }
 
+    private ResponseCookie deviceCookie(String value, long maxAgeSeconds) {
+        return ResponseCookie.from(MagicLinkService.DEVICE_COOKIE_NAME, value)
+                .httpOnly(true)
+                .secure(true)
+                .sameSite("Lax")
+                .path("/")   // 게이트웨이 경로 prefix(/api/svc/auth)와 관계없이 전송
+                .maxAge(maxAgeSeconds)
+                .build();
+    }
+
     /**
      * Request에서 Bearer 토큰 추출
      */
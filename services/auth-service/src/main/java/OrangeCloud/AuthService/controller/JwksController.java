@@ -1,14 +1,16 @@
 package OrangeCloud.AuthService.controller;
 
-import OrangeCloud.AuthService.util.JwtTokenProvider;
+import OrangeCloud.AuthService.service.SigningKeyService;
 import io.swagger.v3.oas.annotations.Operation;
 import io.swagger.v3.oas.annotations.tags.Tag;
+import org.springframework.http.CacheControl;
 import org.springframework.http.MediaType;
+import org.springframework.http.ResponseEntity;
 import org.springframework.web.bind.annotation.GetMapping;
 import org.springframework.web.bind.annotation.RestController;
 
 import java.math.BigInteger;
-import java.security.interfaces.RSAPublicKey;
+import java.time.Duration;
 import java.util.Base64;
 import java.util.List;
 import java.util.Map;
@@ -21,58 +23,38 @@ import java.util.Map;
 @RestController
 public class JwksController {
 
-    private final JwtTokenProvider jwtTokenProvider;
+    private static final Duration JWKS_MAX_AGE = Duration.ofMinutes(5);
 
-    public JwksController(JwtTokenProvider jwtTokenProvider) {
-        this.jwtTokenProvider = jwtTokenProvider;
+    private final SigningKeyService signingKeyService;
+
+    public JwksController(SigningKeyService signingKeyService) {
+        this.signingKeyService = signingKeyService;
     }
 
     /**
      * JWKS 엔드포인트 - RFC 7517 형식
      * URL: /.well-known/jwks.json
+     *
+     * 로테이션 중에는 예정 키, 현재 키, 아직 만료되지 않은 이전 키를 함께 반환한다.
+     * 검증 측 캐시가 새 키를 활성화 전에 받아가도록 max-age는 publish-ahead보다 짧게 유지한다.
      */
     @Operation(summary = "JWKS 조회", description = "JWT 검증용 공개키 세트 반환")
     @GetMapping(value = "/.well-known/jwks.json", produces = MediaType.APPLICATION_JSON_VALUE)
-    public Map<String, Object> getJwks() {
-        RSAPublicKey publicKey = jwtTokenProvider.getPublicKey();
-        String keyId = jwtTokenProvider.getKeyId();
-
-        // RSA 공개키를 JWK 형식으로 변환
-        Map<String, Object> jwk = Map.of(
-            "kty", "RSA",
-            "use", "sig",
-            "alg", "RS256",
-            "kid", keyId,
-            "n", base64UrlEncode(publicKey.getModulus()),
-            "e", base64UrlEncode(publicKey.getPublicExponent())
-        );
-
-        return Map.of("keys", List.of(jwk));
-    }
-
-    /**
-     * OpenID Connect Discovery 엔드포인트 (선택적)
-     * URL: /.well-known/openid-configuration
-     */
-    @Operation(summary = "OpenID Configuration", description = "OpenID Connect Discovery 메타데이터")
-    @GetMapping(value = "/.well-known/openid-configuration", produces = MediaType.APPLICATION_JSON_VALUE)
-    public Map<String, Object> getOpenIdConfiguration() {
-        String issuer = jwtTokenProvider.getIssuer();
-
-        // 기본 issuer URL 구성 (실제 환경에서는 환경변수로 설정 권장)
-        String issuerUrl = "http://auth-service:8080";
+    public ResponseEntity<Map<String, Object>> getJwks() {
+        List<Map<String, Object>> jwks = signingKeyService.publishedKeys().stream()
+                .map(key -> Map.<String, Object>of(
+                        "kty", "RSA",
+                        "use", "sig",
+                        "alg", "RS256",
+                        "kid", key.kid(),
+                        "n", base64UrlEncode(key.publicKey().getModulus()),
+                        "e", base64UrlEncode(key.publicKey().getPublicExponent())
+                ))
+                .toList();
 
-        return Map.of(
-            "issuer", issuer,
-            "jwks_uri", issuerUrl + "/.well-known/jwks.json",
-            "authorization_endpoint", issuerUrl + "/oauth2/authorization/google",
-            "token_endpoint", issuerUrl + "/api/auth/refresh",
-            "userinfo_endpoint", issuerUrl + "/api/auth/me",
-            "response_types_supported", List.of("code"),
-            "subject_types_supported", List.of("public"),
-            "id_token_signing_alg_values_supported", List.of("RS256"),
-            "scopes_supported", List.of("openid", "email", "profile")
-        );
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.maxAge(JWKS_MAX_AGE))
+                .body(Map.of("keys", jwks));
     }
 
     /**
@@ -0,0 +1,140 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.*;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.exception.InvalidTokenException;
+import OrangeCloud.AuthService.service.AuthService;
+import OrangeCloud.AuthService.service.LoginAttemptService;
+import OrangeCloud.AuthService.service.TotpService;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.validation.Valid;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.http.CacheControl;
+import org.springframework.http.ResponseEntity;
+import org.springframework.util.StringUtils;
+import org.springframework.web.bind.annotation.*;
+
+import java.util.List;
+import java.util.UUID;
+
+/**
+ * TOTP(인증 앱) MFA API
+ *
+ * - 등록/해제/복구 코드 재발급은 로그인한 사용자(Bearer)가 현재 코드로 본인 확인 후 수행한다.
+ * - 워크스페이스 정책으로 MFA 등록이 필요한 로그인(mfaEnrollmentRequired)은 mfaToken으로 등록하고 바로 토큰을 받는다.
+ * - 로그인 MFA 단계는 mfaToken과 TOTP 코드 또는 복구 코드로 완료한다.
+ * MFA 상태 조회는 user-service(/api/users/me/mfa)가 제공한다.
+ */
+@RestController
+@RequestMapping("/api/auth/mfa")
+@CrossOrigin(origins = "*", maxAge = 3600)
+@Tag(name = "MFA", description = "TOTP 인증 앱 등록 및 MFA 로그인 API")
+@RequiredArgsConstructor
+@Slf4j
+public class MfaController {
+
+    private final TotpService totpService;
+    private final AuthService authService;
+    private final JwtTokenProvider tokenProvider;
+
+    /**
+     * TOTP 등록 시작 - Bearer 토큰 또는 등록이 필요한 로그인의 mfaToken
+     */
+    @PostMapping("/totp/setup")
+    @Operation(summary = "TOTP 등록 시작", description = "인증 앱에 등록할 secret과 QR 코드를 반환합니다.")
+    public ResponseEntity<TotpSetupResponse> setup(@RequestBody(required = false) TotpRequest totpRequest,
+                                                   HttpServletRequest request) {
+        TotpSetupResponse response;
+        if (totpRequest != null && StringUtils.hasText(totpRequest.getMfaToken())) {
+            AuthService.MfaPending pending = authService.getMfaEnrollment(totpRequest.getMfaToken());
+            response = totpService.setup(pending.userId(), pending.email());
+        } else {
+            String token = extractTokenFromRequest(request);
+            UUID userId = authService.validateTokenAndGetUserId(token);
+            response = totpService.setup(userId, tokenProvider.getEmailFromToken(token));
+        }
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(response);
+    }
+
+    /**
+     * TOTP 등록 완료 - 복구 코드는 응답에서 한 번만 반환
+     * mfaToken으로 등록하면 로그인을 완료하고 토큰도 함께 반환한다.
+     */
+    @PostMapping("/totp/enable")
+    @Operation(summary = "TOTP 등록 완료", description = "인증 앱의 코드로 등록을 확인하고 복구 코드를 발급합니다.")
+    public ResponseEntity<RecoveryCodesResponse> enable(@Valid @RequestBody TotpRequest totpRequest,
+                                                        HttpServletRequest request) {
+        RecoveryCodesResponse response;
+        if (StringUtils.hasText(totpRequest.getMfaToken())) {
+            TotpService.EnrollmentResult result = totpService.enableDuringLogin(
+                    totpRequest.getMfaToken(), totpRequest.getCode(), LoginContext.from(request));
+            response = new RecoveryCodesResponse(result.recoveryCodes(), result.auth());
+        } else {
+            UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+            response = new RecoveryCodesResponse(
+                    totpService.enable(userId, totpRequest.getCode(), LoginContext.from(request)), null);
+        }
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(response);
+    }
+
+    /**
+     * 로그인 MFA 단계 - TOTP 코드 또는 복구 코드로 토큰 발급
+     */
+    @PostMapping("/totp/verify")
+    @Operation(summary = "TOTP 로그인", description = "mfaToken과 인증 앱 코드(또는 복구 코드)로 로그인을 완료합니다.")
+    public ResponseEntity<AuthResponse> verify(
+            @Valid @RequestBody TotpRequest totpRequest,
+            @RequestHeader(name = LoginAttemptService.CAPTCHA_HEADER, required = false) String captchaToken,
+            HttpServletRequest request) {
+        if (!StringUtils.hasText(totpRequest.getMfaToken())) {
+            throw new CustomJwtException(ErrorCode.MFA_TOKEN_INVALID);
+        }
+        AuthResponse authResponse = totpService.authenticate(totpRequest.getMfaToken(), totpRequest.getCode(),
+                totpRequest.getRecoveryCode(), LoginContext.from(request), captchaToken);
+        return ResponseEntity.ok(authResponse);
+    }
+
+    /**
+     * TOTP 해제 - 현재 코드 또는 복구 코드로 본인 확인
+     */
+    @PostMapping("/totp/disable")
+    @Operation(summary = "TOTP 해제", description = "인증 앱 등록과 복구 코드를 삭제합니다.")
+    public ResponseEntity<MessageApiResponse> disable(@Valid @RequestBody TotpRequest totpRequest,
+                                                      HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        totpService.disable(userId, totpRequest.getCode(), totpRequest.getRecoveryCode(), LoginContext.from(request));
+        return ResponseEntity.ok(new MessageApiResponse(true, "인증 앱 등록이 해제되었습니다."));
+    }
+
+    /**
+     * 복구 코드 재발급 - 기존 복구 코드는 모두 무효화
+     */
+    @PostMapping("/recovery-codes")
+    @Operation(summary = "복구 코드 재발급", description = "인증 앱 코드로 본인 확인 후 새 복구 코드를 발급합니다.")
+    public ResponseEntity<RecoveryCodesResponse> regenerateRecoveryCodes(@Valid @RequestBody TotpRequest totpRequest,
+                                                                         HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        List<String> recoveryCodes = totpService.regenerateRecoveryCodes(userId, totpRequest.getCode(),
+                LoginContext.from(request));
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(new RecoveryCodesResponse(recoveryCodes, null));
+    }
+
+    private String extractTokenFromRequest(HttpServletRequest request) {
+        String bearerToken = request.getHeader("Authorization");
+        if (bearerToken != null && bearerToken.startsWith("Bearer ")) {
+            return bearerToken.substring(7);
+        }
+        throw new InvalidTokenException("Authorization 헤더에서 토큰을 찾을 수 없습니다.");
+    }
+}
@@ -0,0 +1,231 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.OidcAuthorizationRequest;
+import OrangeCloud.AuthService.dto.OidcClientRequest;
+import OrangeCloud.AuthService.dto.OidcClientResponse;
+import OrangeCloud.AuthService.dto.OidcRedirectResponse;
+import OrangeCloud.AuthService.dto.OidcTokenResponse;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.exception.InvalidTokenException;
+import OrangeCloud.AuthService.service.AuthService;
+import OrangeCloud.AuthService.service.OidcService;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.validation.Valid;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.CacheControl;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpStatus;
+import org.springframework.http.MediaType;
+import org.springframework.http.ResponseEntity;
+import org.springframework.util.StringUtils;
+import org.springframework.web.bind.annotation.*;
+
+import java.net.URLDecoder;
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.util.Base64;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * OIDC provider API (authorization code + PKCE)
+ *
+ * - GET /.well-known/openid-configuration: discovery 문서
+ * - GET /api/auth/oidc/authorize: 인가 요청 시작 (프론트엔드 로그인 화면으로 redirect)
+ * - /api/auth/oidc/requests/{requestId}: 로그인한 사용자(Bearer)의 요청 조회/승인/거부
+ * - POST /api/auth/oidc/token, GET /api/auth/oidc/userinfo: RP용 토큰 교환과 사용자 정보
+ * - /api/auth/oidc/clients: RP 등록/조회/삭제 (x-internal-api-key 헤더 필요)
+ */
+@RestController
+@Tag(name = "OIDC", description = "OpenID Connect provider API")
+@RequiredArgsConstructor
+@Slf4j
+public class OidcController {
+
+    private static final String INTERNAL_API_KEY_HEADER = "x-internal-api-key";
+    private static final String OIDC_PATH = "/api/auth/oidc";
+
+    private final OidcService oidcService;
+    private final AuthService authService;
+    private final JwtTokenProvider jwtTokenProvider;
+
+    @Value("${service-auth.admin-api-key:}")
+    private String adminApiKey;
+
+    /**
+     * OpenID Connect Discovery 엔드포인트
+     * URL: /.well-known/openid-configuration
+     */
+    @Operation(summary = "OpenID Configuration", description = "OpenID Connect Discovery 메타데이터")
+    @GetMapping(value = "/.well-known/openid-configuration", produces = MediaType.APPLICATION_JSON_VALUE)
+    public Map<String, Object> getOpenIdConfiguration() {
+        String issuer = jwtTokenProvider.getOidcIssuer();
+
+        Map<String, Object> configuration = new LinkedHashMap<>();
+        configuration.put("issuer", issuer);
+        configuration.put("authorization_endpoint", issuer + OIDC_PATH + "/authorize");
+        configuration.put("token_endpoint", issuer + OIDC_PATH + "/token");
+        configuration.put("userinfo_endpoint", issuer + OIDC_PATH + "/userinfo");
+        configuration.put("jwks_uri", issuer + "/.well-known/jwks.json");
+        configuration.put("response_types_supported", List.of("code"));
+        configuration.put("grant_types_supported", List.of(OidcService.GRANT_TYPE_AUTHORIZATION_CODE));
+        configuration.put("subject_types_supported", List.of("public"));
+        configuration.put("id_token_signing_alg_values_supported", List.of("RS256"));
+        configuration.put("scopes_supported", OidcService.SUPPORTED_SCOPES);
+        configuration.put("claims_supported", List.of("sub", "iss", "aud", "exp", "iat", "nonce",
+                "email", "email_verified", "name"));
+        configuration.put("token_endpoint_auth_methods_supported",
+                List.of("client_secret_basic", "client_secret_post", "none"));
+        configuration.put("code_challenge_methods_supported", List.of("S256"));
+        return configuration;
+    }
+
+    @GetMapping(OIDC_PATH + "/authorize")
+    @Operation(summary = "인가 요청", description = "RP의 인가 요청을 확인하고 로그인 화면으로 이동합니다.")
+    public ResponseEntity<Void> authorize(
+            @RequestParam(name = "response_type", required = false) String responseType,
+            @RequestParam(name = "client_id", required = false) String clientId,
+            @RequestParam(name = "redirect_uri", required = false) String redirectUri,
+            @RequestParam(name = "scope", required = false) String scope,
+            @RequestParam(name = "state", required = false) String state,
+            @RequestParam(name = "nonce", required = false) String nonce,
+            @RequestParam(name = "code_challenge", required = false) String codeChallenge,
+            @RequestParam(name = "code_challenge_method", required = false) String codeChallengeMethod) {
+        return ResponseEntity.status(HttpStatus.FOUND)
+                .location(oidcService.authorize(responseType, clientId, redirectUri, scope, state, nonce,
+                        codeChallenge, codeChallengeMethod))
+                .build();
+    }
+
+    @GetMapping(OIDC_PATH + "/requests/{requestId}")
+    @Operation(summary = "인가 요청 조회", description = "로그인/동의 화면에 표시할 클라이언트와 scope를 반환합니다.")
+    public ResponseEntity<OidcAuthorizationRequest> getAuthorizationRequest(@PathVariable String requestId,
+                                                                            HttpServletRequest request) {
+        authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        return ResponseEntity.ok(oidcService.getAuthorizationRequest(requestId));
+    }
+
+    @PostMapping(OIDC_PATH + "/requests/{requestId}/approve")
+    @Operation(summary = "인가 요청 승인", description = "인가 코드를 발급하고 RP로 돌아갈 redirect URI를 반환합니다.")
+    public ResponseEntity<OidcRedirectResponse> approve(@PathVariable String requestId, HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(new OidcRedirectResponse(oidcService.approve(requestId, userId)));
+    }
+
+    @PostMapping(OIDC_PATH + "/requests/{requestId}/deny")
+    @Operation(summary = "인가 요청 거부", description = "access_denied 오류와 함께 RP로 돌아갈 redirect URI를 반환합니다.")
+    public ResponseEntity<OidcRedirectResponse> deny(@PathVariable String requestId, HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        return ResponseEntity.ok(new OidcRedirectResponse(oidcService.deny(requestId, userId)));
+    }
+
+    /**
+     * 인가 코드 교환 (grant_type=authorization_code)
+     * confidential 클라이언트 인증은 HTTP Basic 또는 client_id/client_secret 폼 파라미터
+     */
+    @PostMapping(value = OIDC_PATH + "/token", consumes = MediaType.APPLICATION_FORM_URLENCODED_VALUE)
+    @Operation(summary = "OIDC 토큰 발급", description = "인가 코드와 code_verifier로 ID token과 access token을 발급합니다.")
+    public ResponseEntity<OidcTokenResponse> token(
+            @RequestHeader(name = HttpHeaders.AUTHORIZATION, required = false) String authorization,
+            @RequestParam(name = "grant_type", required = false) String grantType,
+            @RequestParam(name = "code", required = false) String code,
+            @RequestParam(name = "redirect_uri", required = false) String redirectUri,
+            @RequestParam(name = "code_verifier", required = false) String codeVerifier,
+            @RequestParam(name = "client_id", required = false) String clientId,
+            @RequestParam(name = "client_secret", required = false) String clientSecret) {
+
+        if (authorization != null && authorization.regionMatches(true, 0, "Basic ", 0, 6)) {
+            String[] credentials = decodeBasic(authorization.substring(6));
+            clientId = credentials[0];
+            clientSecret = credentials[1];
+        }
+
+        OidcTokenResponse response = oidcService.exchangeCode(grantType, code, redirectUri, clientId, clientSecret,
+                codeVerifier);
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(response);
+    }
+
+    @RequestMapping(value = OIDC_PATH + "/userinfo", method = {RequestMethod.GET, RequestMethod.POST})
+    @Operation(summary = "UserInfo", description = "OIDC access token의 scope에 따른 사용자 클레임을 반환합니다.")
+    public ResponseEntity<Map<String, Object>> userInfo(HttpServletRequest request) {
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(oidcService.userInfo(extractTokenFromRequest(request)));
+    }
+
+    @PostMapping(OIDC_PATH + "/clients")
+    @Operation(summary = "OIDC 클라이언트 등록", description = "confidential 클라이언트의 clientSecret은 응답에서 한 번만 반환됩니다.")
+    public ResponseEntity<OidcClientResponse> registerClient(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @Valid @RequestBody OidcClientRequest request) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.status(HttpStatus.CREATED).body(oidcService.registerClient(request));
+    }
+
+    @GetMapping(OIDC_PATH + "/clients")
+    @Operation(summary = "OIDC 클라이언트 목록")
+    public ResponseEntity<List<OidcClientResponse>> listClients(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.ok(oidcService.listClients());
+    }
+
+    @DeleteMapping(OIDC_PATH + "/clients/{clientId}")
+    @Operation(summary = "OIDC 클라이언트 삭제", description = "이미 발급된 토큰은 만료될 때까지 유효합니다.")
+    public ResponseEntity<Void> deleteClient(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @PathVariable String clientId) {
+        checkAdminApiKey(apiKey);
+        oidcService.deleteClient(clientId);
+        return ResponseEntity.noContent().build();
+    }
+
+    /**
+     * 관리 API 키 확인 - 키가 설정되지 않았으면 관리 API 비활성화
+     */
+    private void checkAdminApiKey(String apiKey) {
+        if (!StringUtils.hasText(adminApiKey) || apiKey == null || !MessageDigest.isEqual(
+                adminApiKey.getBytes(StandardCharsets.UTF_8), apiKey.getBytes(StandardCharsets.UTF_8))) {
+            throw new CustomJwtException(ErrorCode.INTERNAL_API_KEY_INVALID);
+        }
+    }
+
+    /**
+     * Basic 자격 증명 디코딩 - client_id와 secret은 form-urlencoded 후 인코딩됨 (RFC 6749 2.3.1)
+     */
+    private static String[] decodeBasic(String encoded) {
+        try {
+            String decoded = new String(Base64.getDecoder().decode(encoded.trim()), StandardCharsets.UTF_8);
+            int separator = decoded.indexOf(':');
+            if (separator < 0) {
+                throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+            }
+            return new String[]{
+                    URLDecoder.decode(decoded.substring(0, separator), StandardCharsets.UTF_8),
+                    URLDecoder.decode(decoded.substring(separator + 1), StandardCharsets.UTF_8)
+            };
+        } catch (IllegalArgumentException e) {
+            throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+        }
+    }
+
+    private String extractTokenFromRequest(HttpServletRequest request) {
+        String bearerToken = request.getHeader("Authorization");
+        if (bearerToken != null && bearerToken.startsWith("Bearer ")) {
+            return bearerToken.substring(7);
+        }
+        throw new InvalidTokenException("Authorization 헤더에서 토큰을 찾을 수 없습니다.");
+    }
+}
@@ -0,0 +1,96 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.*;
+import OrangeCloud.AuthService.exception.InvalidTokenException;
+import OrangeCloud.AuthService.service.AuthService;
+import OrangeCloud.AuthService.service.LoginAttemptService;
+import OrangeCloud.AuthService.service.PasskeyService;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.validation.Valid;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.http.ResponseEntity;
+import org.springframework.web.bind.annotation.*;
+
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * 패스키(WebAuthn) 등록/로그인 API
+ * 등록된 패스키 목록/이름 변경/삭제와 MFA 설정은 user-service(/api/users/me/passkeys)가 제공한다.
+ */
+@RestController
+@RequestMapping("/api/auth/passkeys")
+@CrossOrigin(origins = "*", maxAge = 3600)
+@Tag(name = "Passkeys", description = "패스키(WebAuthn) 등록 및 로그인 API")
+@RequiredArgsConstructor
+@Slf4j
+public class PasskeyController {
+
+    private final PasskeyService passkeyService;
+    private final AuthService authService;
+    private final JwtTokenProvider tokenProvider;
+
+    /**
+     * 패스키 등록 옵션 (로그인한 사용자)
+     */
+    @PostMapping("/register/options")
+    @Operation(summary = "패스키 등록 옵션", description = "navigator.credentials.create()에 사용할 옵션을 반환합니다.")
+    public ResponseEntity<Map<String, Object>> registrationOptions(HttpServletRequest request) {
+        String token = extractTokenFromRequest(request);
+        UUID userId = authService.validateTokenAndGetUserId(token);
+        return ResponseEntity.ok(passkeyService.registrationOptions(userId, tokenProvider.getEmailFromToken(token)));
+    }
+
+    /**
+     * 패스키 등록 완료 - attestation 검증 후 저장
+     */
+    @PostMapping("/register")
+    @Operation(summary = "패스키 등록", description = "인증기가 생성한 자격 증명을 검증하고 저장합니다.")
+    public ResponseEntity<MessageApiResponse> register(@Valid @RequestBody PasskeyRegistrationRequest registrationRequest,
+                                                       HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        passkeyService.register(userId, registrationRequest.getCeremonyId(),
+                registrationRequest.getName(), registrationRequest.getCredential());
+        log.info("패스키 등록 성공: userId={}", userId);
+        return ResponseEntity.ok(new MessageApiResponse(true, "패스키가 등록되었습니다."));
+    }
+
+    /**
+     * 패스키 로그인 옵션
+     * mfaToken이 있으면 1차 로그인 후 추가 인증, 없으면 패스키 단독 로그인
+     */
+    @PostMapping("/login/options")
+    @Operation(summary = "패스키 로그인 옵션", description = "navigator.credentials.get()에 사용할 옵션을 반환합니다.")
+    public ResponseEntity<Map<String, Object>> authenticationOptions(
+            @RequestBody(required = false) PasskeyLoginOptionsRequest optionsRequest) {
+        String mfaToken = optionsRequest != null ? optionsRequest.getMfaToken() : null;
+        return ResponseEntity.ok(passkeyService.authenticationOptions(mfaToken));
+    }
+
+    /**
+     * 패스키 로그인 - assertion 검증 후 Access/Refresh Token 발급
+     */
+    @PostMapping("/login")
+    @Operation(summary = "패스키 로그인", description = "패스키 서명을 검증하고 Access/Refresh Token을 발급합니다.")
+    public ResponseEntity<AuthResponse> login(
+            @Valid @RequestBody PasskeyLoginRequest loginRequest,
+            @RequestHeader(name = LoginAttemptService.CAPTCHA_HEADER, required = false) String captchaToken,
+            HttpServletRequest request) {
+        AuthResponse authResponse = passkeyService.authenticate(loginRequest.getCeremonyId(),
+                loginRequest.getCredential(), LoginContext.from(request), captchaToken);
+        log.info("패스키 로그인 성공");
+        return ResponseEntity.ok(authResponse);
+    }
+
+    private String extractTokenFromRequest(HttpServletRequest request) {
+        String bearerToken = request.getHeader("Authorization");
+        if (bearerToken != null && bearerToken.startsWith("Bearer ")) {
+            return bearerToken.substring(7);
+        }
+        throw new InvalidTokenException("Authorization 헤더에서 토큰을 찾을 수 없습니다.");
+    }
+}
@@ -0,0 +1,138 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.ServiceClientRequest;
+import OrangeCloud.AuthService.dto.ServiceClientResponse;
+import OrangeCloud.AuthService.dto.ServiceTokenResponse;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.service.ServiceClientService;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.validation.Valid;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.http.CacheControl;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpStatus;
+import org.springframework.http.MediaType;
+import org.springframework.http.ResponseEntity;
+import org.springframework.util.StringUtils;
+import org.springframework.web.bind.annotation.*;
+
+import java.net.URLDecoder;
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.util.Base64;
+import java.util.List;
+
+/**
+ * 서비스 간 인증 API (OAuth2 client-credentials)
+ *
+ * - POST /api/auth/token: 등록된 클라이언트에 짧은 수명의 scope 토큰 발급
+ * - /api/auth/clients: 클라이언트 등록/조회/삭제/secret 재발급 (x-internal-api-key 헤더 필요)
+ */
+@RestController
+@RequestMapping("/api/auth")
+@Tag(name = "Service Clients", description = "서비스 간 인증(client-credentials) API")
+@RequiredArgsConstructor
+@Slf4j
+public class ServiceClientController {
+
+    private static final String INTERNAL_API_KEY_HEADER = "x-internal-api-key";
+
+    private final ServiceClientService serviceClientService;
+
+    @Value("${service-auth.admin-api-key:}")
+    private String adminApiKey;
+
+    /**
+     * client-credentials 토큰 발급 (RFC 6749 4.4)
+     * 클라이언트 인증은 HTTP Basic(권장) 또는 client_id/client_secret 폼 파라미터
+     */
+    @PostMapping(value = "/token", consumes = MediaType.APPLICATION_FORM_URLENCODED_VALUE)
+    @Operation(summary = "서비스 토큰 발급", description = "grant_type=client_credentials로 scope가 제한된 서비스 토큰을 발급합니다.")
+    public ResponseEntity<ServiceTokenResponse> token(
+            @RequestHeader(name = HttpHeaders.AUTHORIZATION, required = false) String authorization,
+            @RequestParam(name = "grant_type", required = false) String grantType,
+            @RequestParam(name = "scope", required = false) String scope,
+            @RequestParam(name = "client_id", required = false) String clientId,
+            @RequestParam(name = "client_secret", required = false) String clientSecret) {
+
+        if (authorization != null && authorization.regionMatches(true, 0, "Basic ", 0, 6)) {
+            String[] credentials = decodeBasic(authorization.substring(6));
+            clientId = credentials[0];
+            clientSecret = credentials[1];
+        }
+
+        ServiceTokenResponse response = serviceClientService.issueToken(grantType, clientId, clientSecret, scope);
+        return ResponseEntity.ok()
+                .cacheControl(CacheControl.noStore())
+                .body(response);
+    }
+
+    @PostMapping("/clients")
+    @Operation(summary = "서비스 클라이언트 등록", description = "clientSecret은 응답에서 한 번만 반환됩니다.")
+    public ResponseEntity<ServiceClientResponse> registerClient(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @Valid @RequestBody ServiceClientRequest request) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.status(HttpStatus.CREATED).body(serviceClientService.register(request));
+    }
+
+    @GetMapping("/clients")
+    @Operation(summary = "서비스 클라이언트 목록")
+    public ResponseEntity<List<ServiceClientResponse>> listClients(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.ok(serviceClientService.list());
+    }
+
+    @DeleteMapping("/clients/{clientId}")
+    @Operation(summary = "서비스 클라이언트 삭제", description = "이미 발급된 토큰은 만료될 때까지 유효합니다.")
+    public ResponseEntity<Void> deleteClient(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @PathVariable String clientId) {
+        checkAdminApiKey(apiKey);
+        serviceClientService.delete(clientId);
+        return ResponseEntity.noContent().build();
+    }
+
+    @PostMapping("/clients/{clientId}/secrets")
+    @Operation(summary = "클라이언트 secret 재발급", description = "이전 secret은 grace period 동안 계속 사용할 수 있습니다.")
+    public ResponseEntity<ServiceClientResponse> rotateSecret(
+            @RequestHeader(name = INTERNAL_API_KEY_HEADER, required = false) String apiKey,
+            @PathVariable String clientId) {
+        checkAdminApiKey(apiKey);
+        return ResponseEntity.ok(serviceClientService.rotateSecret(clientId));
+    }
+
+    /**
+     * 관리 API 키 확인 - 키가 설정되지 않았으면 관리 API 비활성화
+     */
+    private void checkAdminApiKey(String apiKey) {
+        if (!StringUtils.hasText(adminApiKey) || apiKey == null || !MessageDigest.isEqual(
+                adminApiKey.getBytes(StandardCharsets.UTF_8), apiKey.getBytes(StandardCharsets.UTF_8))) {
+            throw new CustomJwtException(ErrorCode.INTERNAL_API_KEY_INVALID);
+        }
+    }
+
+    /**
+     * Basic 자격 증명 디코딩 - client_id와 secret은 form-urlencoded 후 인코딩됨 (RFC 6749 2.3.1)
+     */
+    private static String[] decodeBasic(String encoded) {
+        try {
+            String decoded = new String(Base64.getDecoder().decode(encoded.trim()), StandardCharsets.UTF_8);
+            int separator = decoded.indexOf(':');
+            if (separator < 0) {
+                throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+            }
+            return new String[]{
+                    URLDecoder.decode(decoded.substring(0, separator), StandardCharsets.UTF_8),
+                    URLDecoder.decode(decoded.substring(separator + 1), StandardCharsets.UTF_8)
+            };
+        } catch (IllegalArgumentException e) {
+            throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+        }
+    }
+}
@@ -0,0 +1,73 @@
+package OrangeCloud.AuthService.controller;
+
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.MessageApiResponse;
+import OrangeCloud.AuthService.dto.SessionResponse;
+import OrangeCloud.AuthService.exception.InvalidTokenException;
+import OrangeCloud.AuthService.service.AuthService;
+import OrangeCloud.AuthService.service.SessionService;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import jakarta.servlet.http.HttpServletRequest;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.http.ResponseEntity;
+import org.springframework.web.bind.annotation.*;
+
+import java.util.List;
+import java.util.UUID;
+
+/**
+ * 로그인 세션(기기) 조회/폐기 API
+ * 세션을 폐기하면 해당 기기의 access/refresh token이 즉시 무효화된다.
+ */
+@RestController
+@RequestMapping("/api/auth/sessions")
+@CrossOrigin(origins = "*", maxAge = 3600)
+@Tag(name = "Sessions", description = "로그인 세션(기기) 관리 API")
+@RequiredArgsConstructor
+@Slf4j
+public class SessionController {
+
+    private final SessionService sessionService;
+    private final AuthService authService;
+    private final JwtTokenProvider tokenProvider;
+
+    @GetMapping
+    @Operation(summary = "세션 목록", description = "현재 사용자의 로그인 세션(기기) 목록을 최근 사용 순으로 반환합니다.")
+    public ResponseEntity<List<SessionResponse>> listSessions(HttpServletRequest request) {
+        String token = extractTokenFromRequest(request);
+        UUID userId = authService.validateTokenAndGetUserId(token);
+        return ResponseEntity.ok(sessionService.list(userId, tokenProvider.getSessionIdFromToken(token)));
+    }
+
+    @DeleteMapping("/{sessionId}")
+    @Operation(summary = "세션 폐기", description = "선택한 기기에서 로그아웃합니다.")
+    public ResponseEntity<MessageApiResponse> revokeSession(@PathVariable String sessionId,
+                                                            HttpServletRequest request) {
+        UUID userId = authService.validateTokenAndGetUserId(extractTokenFromRequest(request));
+        sessionService.revoke(userId, sessionId, LoginContext.from(request));
+        log.info("세션 폐기 성공: userId={}, sessionId={}", userId, sessionId);
+        return ResponseEntity.ok(new MessageApiResponse(true, "세션이 종료되었습니다."));
+    }
+
+    @DeleteMapping
+    @Operation(summary = "다른 세션 모두 폐기", description = "현재 기기를 제외한 모든 기기에서 로그아웃합니다.")
+    public ResponseEntity<MessageApiResponse> revokeOtherSessions(HttpServletRequest request) {
+        String token = extractTokenFromRequest(request);
+        UUID userId = authService.validateTokenAndGetUserId(token);
+        int revoked = sessionService.revokeOthers(userId, tokenProvider.getSessionIdFromToken(token),
+                LoginContext.from(request));
+        log.info("다른 세션 폐기 성공: userId={}, count={}", userId, revoked);
+        return ResponseEntity.ok(new MessageApiResponse(true, revoked + "개의 세션이 종료되었습니다."));
+    }
+
+    private String extractTokenFromRequest(HttpServletRequest request) {
+        String bearerToken = request.getHeader("Authorization");
+        if (bearerToken != null && bearerToken.startsWith("Bearer ")) {
+            return bearerToken.substring(7);
+        }
+        throw new InvalidTokenException("Authorization 헤더에서 토큰을 찾을 수 없습니다.");
+    }
+}
@@ -0,0 +1,29 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+
+import java.time.Instant;
+import java.util.UUID;
+
+/**
+ * 인증 감사 이벤트 (최근 활동, 관리자 조회)
+ *
+ * @param method    로그인/MFA 이벤트의 인증 수단 (그 외 null)
+ * @param sessionId 관련 로그인 세션 (없으면 null)
+ * @param reason    실패/폐기 사유 - 실패는 ErrorCode 코드(AUTH0xx)
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record AuthEventResponse(
+        String eventId,
+        AuthEventType type,
+        UUID userId,
+        LoginMethod method,
+        String ipAddress,
+        String userAgent,
+        String device,
+        String country,
+        String sessionId,
+        String reason,
+        Instant occurredAt
+) {
+}
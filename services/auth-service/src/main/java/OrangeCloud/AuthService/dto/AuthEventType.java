@@ -0,0 +1,18 @@
+package OrangeCloud.AuthService.dto;
+
+/**
+ * 인증 감사 이벤트 종류 (AuthAuditService)
+ */
+public enum AuthEventType {
+    LOGIN_SUCCESS,
+    LOGIN_FAILURE,
+    MFA_CHALLENGE,
+    MFA_ENROLLED,
+    MFA_DISABLED,
+    RECOVERY_CODES_REGENERATED,
+    TOKEN_REFRESHED,
+    TOKEN_REUSE_DETECTED,
+    LOGOUT,
+    SESSION_REVOKED,
+    ALL_TOKENS_REVOKED
+}
@@ -1,20 +1,56 @@
 package OrangeCloud.AuthService.dto;
 
-import lombok.AllArgsConstructor;
 import lombok.Getter;
 import lombok.NoArgsConstructor;
 
+import java.util.List;
 import java.util.UUID;
 
 /**
  * 인증 응답 DTO - 토큰 정보만 반환
  * nickName, email 등 사용자 정보는 user-service에서 별도 조회
+ *
+ * MFA가 필요한 사용자는 토큰 대신 mfaToken과 사용 가능한 인증 수단(mfaMethods)을 반환하며,
+ * 클라이언트는 패스키(/api/auth/passkeys/login) 또는 TOTP(/api/auth/mfa/totp/verify) 인증을 마친 뒤 토큰을 받는다.
+ * 워크스페이스 정책으로 MFA가 필요하지만 등록된 수단이 없으면 mfaEnrollmentRequired가 true이며,
+ * mfaToken으로 TOTP를 등록(/api/auth/mfa/totp/setup → /enable)하면 토큰을 받는다.
  */
 @Getter
-@AllArgsConstructor
 @NoArgsConstructor
 public class AuthResponse {
     private String accessToken;
     private String refreshToken;
     private UUID userId;
+    private String mfaToken;
+    private List<String> mfaMethods;
+    private boolean mfaEnrollmentRequired;
+
+    public AuthResponse(String accessToken, String refreshToken, UUID userId) {
+        this.accessToken = accessToken;
+        this.refreshToken = refreshToken;
+        this.userId = userId;
+    }
+
+    /**
+     * 두 번째 인증(패스키, TOTP, 복구 코드)이 필요한 로그인 응답
+     */
+    public static AuthResponse mfaRequired(UUID userId, String mfaToken, List<String> methods) {
+        AuthResponse response = new AuthResponse(null, null, userId);
+        response.mfaToken = mfaToken;
+        response.mfaMethods = methods;
+        return response;
+    }
+
+    /**
+     * 정책상 MFA가 필요하지만 등록된 인증 수단이 없는 로그인 응답
+     */
+    public static AuthResponse mfaEnrollmentRequired(UUID userId, String mfaToken) {
+        AuthResponse response = mfaRequired(userId, mfaToken, List.of());
+        response.mfaEnrollmentRequired = true;
+        return response;
+    }
+
+    public boolean isMfaRequired() {
+        return mfaToken != null;
+    }
 }
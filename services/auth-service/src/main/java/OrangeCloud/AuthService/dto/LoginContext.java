@@ -0,0 +1,80 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.servlet.http.HttpServletRequest;
+import org.springframework.http.HttpHeaders;
+import org.springframework.util.StringUtils;
+
+import java.util.List;
+import java.util.Locale;
+
+/**
+ * 로그인 요청 정보 (실패 횟수 제한, 새 기기/국가 로그인 감지, 세션 기기 정보용)
+ *
+ * @param clientIp  클라이언트 IP (ForwardedHeaderFilter가 X-Forwarded-For를 반영한 값)
+ * @param userAgent User-Agent 헤더 (없으면 null)
+ * @param country   CDN이 전달한 ISO 3166 국가 코드 (없으면 null)
+ */
+public record LoginContext(String clientIp, String userAgent, String country) {
+
+    // CloudFront / Cloudflare가 추가하는 접속 국가 헤더 (XX: 알 수 없음)
+    private static final List<String> COUNTRY_HEADERS = List.of("CloudFront-Viewer-Country", "CF-IPCountry");
+    private static final String UNKNOWN_COUNTRY = "XX";
+
+    public static LoginContext from(HttpServletRequest request) {
+        String country = null;
+        for (String header : COUNTRY_HEADERS) {
+            String value = request.getHeader(header);
+            if (StringUtils.hasText(value) && !UNKNOWN_COUNTRY.equalsIgnoreCase(value.trim())) {
+                country = value.trim().toUpperCase(Locale.ROOT);
+                break;
+            }
+        }
+        return new LoginContext(request.getRemoteAddr(), request.getHeader(HttpHeaders.USER_AGENT), country);
+    }
+
+    /**
+     * User-Agent에서 "브라우저 on OS" 형태의 기기 이름 추출
+     * 브라우저 버전은 제외한다 (업데이트마다 새 기기로 보이지 않도록).
+     */
+    public String device() {
+        if (!StringUtils.hasText(userAgent)) {
+            return "Unknown device";
+        }
+
+        String browser;
+        if (userAgent.contains("Edg/")) {
+            browser = "Edge";
+        } else if (userAgent.contains("OPR/")) {
+            browser = "Opera";
+        } else if (userAgent.contains("SamsungBrowser/")) {
+            browser = "Samsung Internet";
+        } else if (userAgent.contains("Firefox/") || userAgent.contains("FxiOS/")) {
+            browser = "Firefox";
+        } else if (userAgent.contains("Chrome/") || userAgent.contains("CriOS/")) {
+            browser = "Chrome";
+        } else if (userAgent.contains("Safari/")) {
+            browser = "Safari";
+        } else {
+            browser = "Unknown browser";
+        }
+
+        String os;
+        if (userAgent.contains("iPhone") || userAgent.contains("iPad")) {
+            os = "iOS";
+        } else if (userAgent.contains("Android")) {
+            os = "Android";
+        } else if (userAgent.contains("Windows")) {
+            os = "Windows";
+        } else if (userAgent.contains("Mac OS X")) {
+            os = "macOS";
+        } else if (userAgent.contains("CrOS")) {
+            os = "ChromeOS";
+        } else if (userAgent.contains("Linux")) {
+            os = "Linux";
+        } else {
+            os = "Unknown OS";
+        }
+
+        return browser + " on " + os;
+    }
+}
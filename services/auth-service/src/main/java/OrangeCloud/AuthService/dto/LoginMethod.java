@@ -0,0 +1,22 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonValue;
+
+import java.util.Locale;
+
+/**
+ * 로그인(인증) 수단 - 감사 로그와 최근 활동에 표시
+ */
+public enum LoginMethod {
+    OAUTH2,
+    SAML,
+    MAGIC_LINK,
+    PASSKEY,
+    TOTP,
+    RECOVERY_CODE;
+
+    @JsonValue
+    public String value() {
+        return name().toLowerCase(Locale.ROOT);
+    }
+}
@@ -0,0 +1,16 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.constraints.Email;
+import jakarta.validation.constraints.NotBlank;
+import lombok.AllArgsConstructor;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+@Getter
+@AllArgsConstructor
+@NoArgsConstructor
+public class MagicLinkRequest {
+    @NotBlank(message = "Email is required")
+    @Email(message = "Invalid email")
+    private String email;
+}
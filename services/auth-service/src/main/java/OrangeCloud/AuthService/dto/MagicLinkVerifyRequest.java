@@ -0,0 +1,14 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.constraints.NotBlank;
+import lombok.AllArgsConstructor;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+@Getter
+@AllArgsConstructor
+@NoArgsConstructor
+public class MagicLinkVerifyRequest {
+    @NotBlank(message = "Token is required")
+    private String token;
+}
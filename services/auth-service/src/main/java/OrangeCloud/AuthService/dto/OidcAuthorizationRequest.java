@@ -0,0 +1,14 @@
+package OrangeCloud.AuthService.dto;
+
+import java.util.List;
+
+/**
+ * 진행 중인 OIDC 로그인 요청 (프론트엔드 로그인/동의 화면 표시용)
+ */
+public record OidcAuthorizationRequest(
+        String requestId,
+        String clientId,
+        String clientName,
+        List<String> scopes
+) {
+}
@@ -0,0 +1,31 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.constraints.NotBlank;
+import jakarta.validation.constraints.NotEmpty;
+import jakarta.validation.constraints.Pattern;
+import lombok.AllArgsConstructor;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.List;
+
+/**
+ * OIDC 클라이언트(RP) 등록 요청
+ * redirectUris는 정확히 일치해야 하며, confidential=false인 공개 클라이언트(SPA, CLI)는 secret 없이 PKCE만 사용한다.
+ */
+@Getter
+@AllArgsConstructor
+@NoArgsConstructor
+public class OidcClientRequest {
+    @NotBlank(message = "clientId is required")
+    @Pattern(regexp = "^[a-z0-9][a-z0-9-]{1,62}$", message = "clientId must be lowercase letters, digits or '-'")
+    private String clientId;
+
+    @NotBlank(message = "name is required")
+    private String name;
+
+    @NotEmpty(message = "redirectUris is required")
+    private List<@Pattern(regexp = "^https?://[^#\\s]+$", message = "Invalid redirect URI") String> redirectUris;
+
+    private boolean confidential;
+}
@@ -0,0 +1,18 @@
+package OrangeCloud.AuthService.dto;
+
+import java.time.Instant;
+import java.util.List;
+
+/**
+ * OIDC 클라이언트(RP) 정보
+ * clientSecret은 confidential 클라이언트 등록 응답에만 포함되며 다시 조회할 수 없다.
+ */
+public record OidcClientResponse(
+        String clientId,
+        String name,
+        List<String> redirectUris,
+        boolean confidential,
+        String clientSecret,
+        Instant createdAt
+) {
+}
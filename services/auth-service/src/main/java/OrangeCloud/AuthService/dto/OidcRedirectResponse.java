@@ -0,0 +1,7 @@
+package OrangeCloud.AuthService.dto;
+
+/**
+ * OIDC 로그인 요청 승인/거부 결과 - 프론트엔드는 redirectUri(code 또는 error 포함)로 이동한다.
+ */
+public record OidcRedirectResponse(String redirectUri) {
+}
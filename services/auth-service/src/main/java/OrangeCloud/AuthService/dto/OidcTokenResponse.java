@@ -0,0 +1,15 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * authorization_code 토큰 응답 (OIDC Core 3.1.3.3)
+ */
+public record OidcTokenResponse(
+        @JsonProperty("access_token") String accessToken,
+        @JsonProperty("token_type") String tokenType,
+        @JsonProperty("expires_in") long expiresIn,
+        @JsonProperty("id_token") String idToken,
+        @JsonProperty("scope") String scope
+) {
+}
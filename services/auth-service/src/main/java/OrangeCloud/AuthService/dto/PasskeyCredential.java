@@ -0,0 +1,39 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.Valid;
+import jakarta.validation.constraints.NotBlank;
+import jakarta.validation.constraints.NotNull;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.List;
+import java.util.Map;
+
+/**
+ * 브라우저의 PublicKeyCredential.toJSON() 결과 (WebAuthn Level 3 JSON, 바이너리는 base64url)
+ * 등록은 attestationObject, 인증은 authenticatorData/signature/userHandle을 사용한다.
+ */
+@Getter
+@NoArgsConstructor
+public class PasskeyCredential {
+    @NotBlank
+    private String id;
+    private String rawId;
+    private String type;
+    @NotNull
+    @Valid
+    private Response response;
+    private Map<String, Object> clientExtensionResults;
+
+    @Getter
+    @NoArgsConstructor
+    public static class Response {
+        @NotBlank
+        private String clientDataJSON;
+        private String attestationObject;
+        private List<String> transports;
+        private String authenticatorData;
+        private String signature;
+        private String userHandle;
+    }
+}
@@ -0,0 +1,14 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+/**
+ * 패스키 로그인 옵션 요청
+ * mfaToken이 없으면 패스키 단독 로그인(discoverable credential), 있으면 MFA 단계
+ */
+@Getter
+@NoArgsConstructor
+public class PasskeyLoginOptionsRequest {
+    private String mfaToken;
+}
@@ -0,0 +1,17 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.Valid;
+import jakarta.validation.constraints.NotBlank;
+import jakarta.validation.constraints.NotNull;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+@Getter
+@NoArgsConstructor
+public class PasskeyLoginRequest {
+    @NotBlank(message = "Ceremony ID is required")
+    private String ceremonyId;
+    @NotNull(message = "Credential is required")
+    @Valid
+    private PasskeyCredential credential;
+}
@@ -0,0 +1,23 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.List;
+import java.util.UUID;
+
+/**
+ * user-service에 저장된 패스키 자격 증명 (user-service 내부 API 응답)
+ * publicKey는 webauthn4j AttestedCredentialData 직렬화 값(base64url)
+ */
+@Getter
+@NoArgsConstructor
+public class PasskeyRecord {
+    private UUID passkeyId;
+    private UUID userId;
+    private String email;
+    private String credentialId;
+    private String publicKey;
+    private long signCount;
+    private List<String> transports;
+}
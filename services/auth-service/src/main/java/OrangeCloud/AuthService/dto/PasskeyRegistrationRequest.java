@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.Valid;
+import jakarta.validation.constraints.NotBlank;
+import jakarta.validation.constraints.NotNull;
+import jakarta.validation.constraints.Size;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+@Getter
+@NoArgsConstructor
+public class PasskeyRegistrationRequest {
+    @NotBlank(message = "Ceremony ID is required")
+    private String ceremonyId;
+    @Size(max = 100)
+    private String name;
+    @NotNull(message = "Credential is required")
+    @Valid
+    private PasskeyCredential credential;
+}
@@ -0,0 +1,16 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+
+import java.util.List;
+
+/**
+ * 새로 발급한 복구 코드 (응답에서 한 번만 반환)
+ * 로그인 중 TOTP를 등록한 경우 발급된 토큰도 함께 반환한다.
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record RecoveryCodesResponse(
+        List<String> recoveryCodes,
+        AuthResponse auth
+) {
+}
@@ -0,0 +1,26 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.constraints.NotBlank;
+import jakarta.validation.constraints.NotEmpty;
+import jakarta.validation.constraints.Pattern;
+import lombok.AllArgsConstructor;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.List;
+
+/**
+ * 서비스 클라이언트 등록 요청 (client-credentials)
+ * scopes는 이 클라이언트가 발급받을 수 있는 최대 scope (예: users:read, users:internal)
+ */
+@Getter
+@AllArgsConstructor
+@NoArgsConstructor
+public class ServiceClientRequest {
+    @NotBlank(message = "clientId is required")
+    @Pattern(regexp = "^[a-z0-9][a-z0-9-]{1,62}$", message = "clientId must be lowercase letters, digits or '-'")
+    private String clientId;
+
+    @NotEmpty(message = "scopes is required")
+    private List<@Pattern(regexp = "^[a-z0-9:_.-]+$", message = "Invalid scope") String> scopes;
+}
@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import java.time.Instant;
+import java.util.List;
+
+/**
+ * 서비스 클라이언트 정보
+ * clientSecret은 등록/재발급 응답에만 포함되며 다시 조회할 수 없다 (저장소에는 해시만 보관).
+ *
+ * @param previousSecretExpiresAt 재발급 전 secret이 계속 허용되는 시각 (없으면 null)
+ */
+public record ServiceClientResponse(
+        String clientId,
+        List<String> scopes,
+        String clientSecret,
+        Instant createdAt,
+        Instant secretRotatedAt,
+        Instant previousSecretExpiresAt
+) {
+}
@@ -0,0 +1,14 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * client-credentials 토큰 응답 (RFC 6749 5.1)
+ */
+public record ServiceTokenResponse(
+        @JsonProperty("access_token") String accessToken,
+        @JsonProperty("token_type") String tokenType,
+        @JsonProperty("expires_in") long expiresIn,
+        @JsonProperty("scope") String scope
+) {
+}
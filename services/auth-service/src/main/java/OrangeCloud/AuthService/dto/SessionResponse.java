@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import java.time.Instant;
+
+/**
+ * 로그인 세션(기기) 정보
+ *
+ * @param device  "브라우저 on OS" 형태의 기기 이름
+ * @param current 요청한 토큰의 세션이면 true
+ */
+public record SessionResponse(
+        String sessionId,
+        String device,
+        String ipAddress,
+        String country,
+        Instant createdAt,
+        Instant lastUsedAt,
+        boolean current
+) {
+}
@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.UUID;
+
+/**
+ * 워크스페이스 IdP 설정 (user-service 내부 API 응답)
+ * idpCertificate는 PEM 형식의 서명 검증용 인증서
+ */
+@Getter
+@NoArgsConstructor
+public class SsoConnection {
+    private UUID workspaceId;
+    private String idpEntityId;
+    private String idpSsoUrl;
+    private String idpSsoBinding;
+    private String idpCertificate;
+}
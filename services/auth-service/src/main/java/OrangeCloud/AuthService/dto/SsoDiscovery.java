@@ -0,0 +1,16 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.UUID;
+
+/**
+ * 이메일 도메인에 연결된 워크스페이스 SSO (user-service 내부 API 응답)
+ */
+@Getter
+@NoArgsConstructor
+public class SsoDiscovery {
+    private UUID workspaceId;
+    private boolean enforced;
+}
@@ -0,0 +1,16 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.UUID;
+
+/**
+ * SAML 로그인으로 조회/생성된 사용자 (user-service 내부 API 응답)
+ */
+@Getter
+@NoArgsConstructor
+public class SsoLoginResponse {
+    private UUID userId;
+    private String email;
+}
@@ -0,0 +1,15 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+/**
+ * 사용자가 속한 워크스페이스의 토큰 수명 정책 (user-service 내부 API 응답)
+ * 여러 워크스페이스에 속하면 가장 짧은 값이며, 0이면 auth-service 기본값을 사용한다.
+ */
+@Getter
+@NoArgsConstructor
+public class TokenPolicy {
+    private int accessTokenTtlMinutes;
+    private int refreshTokenTtlMinutes;
+}
@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import jakarta.validation.constraints.Pattern;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+/**
+ * TOTP 요청 - 인증 앱의 6자리 코드 또는 복구 코드
+ * mfaToken은 로그인 중(1차 인증 후) 요청에서만 사용한다.
+ */
+@Getter
+@NoArgsConstructor
+public class TotpRequest {
+    private String mfaToken;
+
+    @Pattern(regexp = "^\\d{6}$", message = "인증 코드는 6자리 숫자입니다.")
+    private String code;
+
+    private String recoveryCode;
+}
@@ -0,0 +1,17 @@
+package OrangeCloud.AuthService.dto;
+
+/**
+ * TOTP 등록 시작 응답 - 인증 앱에 secret을 등록한 뒤 코드로 등록을 완료한다.
+ *
+ * @param secret     수동 입력용 Base32 secret
+ * @param otpauthUri otpauth://totp/... 프로비저닝 URI
+ * @param qrCode     otpauthUri의 QR 코드 (PNG data URL)
+ * @param expiresIn  등록을 완료해야 하는 시간 (초)
+ */
+public record TotpSetupResponse(
+        String secret,
+        String otpauthUri,
+        String qrCode,
+        long expiresIn
+) {
+}
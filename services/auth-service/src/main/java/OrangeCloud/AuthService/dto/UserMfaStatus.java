@@ -0,0 +1,49 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.ArrayList;
+import java.util.List;
+import java.util.UUID;
+
+/**
+ * 사용자의 두 번째 인증 수단과 워크스페이스 MFA 정책 (user-service 내부 API 응답)
+ * totpSecret은 auth-service가 암호화해 저장한 값이다.
+ */
+@Getter
+@NoArgsConstructor
+public class UserMfaStatus {
+    private boolean totpEnabled;
+    private boolean passkeyRequired;
+    private int passkeyCount;
+    private int recoveryCodesRemaining;
+    private boolean policyRequired;
+    private String totpSecret;
+    private long totpLastUsedStep;
+    private List<UUID> policyWorkspaces = List.of();
+
+    /**
+     * 로그인 시 두 번째 인증이 필요한지 (본인 설정 또는 워크스페이스 정책)
+     */
+    public boolean isMfaRequired() {
+        return passkeyRequired || totpEnabled || policyRequired;
+    }
+
+    /**
+     * MFA 단계에서 사용할 수 있는 인증 수단 - 비어 있으면 정책상 등록이 필요한 상태
+     */
+    public List<String> availableMethods() {
+        List<String> methods = new ArrayList<>();
+        if (totpEnabled) {
+            methods.add("totp");
+        }
+        if (passkeyCount > 0) {
+            methods.add("passkey");
+        }
+        if (totpEnabled && recoveryCodesRemaining > 0) {
+            methods.add("recovery_code");
+        }
+        return methods;
+    }
+}
@@ -0,0 +1,16 @@
+package OrangeCloud.AuthService.dto;
+
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.List;
+
+/**
+ * 사용자의 패스키 목록과 패스키 MFA 설정 (user-service 내부 API 응답)
+ */
+@Getter
+@NoArgsConstructor
+public class UserPasskeys {
+    private List<PasskeyRecord> credentials = List.of();
+    private boolean mfaRequired;
+}
@@ -0,0 +1,20 @@
+package OrangeCloud.AuthService.dto;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import lombok.Getter;
+import lombok.NoArgsConstructor;
+
+import java.util.UUID;
+
+/**
+ * 사용자 기본 정보 (user-service 내부 API 응답, OIDC userinfo/ID token 클레임용)
+ */
+@Getter
+@NoArgsConstructor
+public class UserSummary {
+    private UUID userId;
+    private String email;
+    private String name;
+    @JsonProperty("isActive")
+    private boolean active;
+}
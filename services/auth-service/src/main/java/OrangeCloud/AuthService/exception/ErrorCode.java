@@ -15,6 +15,47 @@ public enum ErrorCode {
     UNSUPPORTED_TOKEN(HttpStatus.UNAUTHORIZED, "AUTH005", "지원하지 않는 토큰입니다."),
     TOKEN_BLACKLISTED(HttpStatus.UNAUTHORIZED, "AUTH006", "이미 로그아웃된 토큰입니다."),
     TOKEN_NOT_FOUND(HttpStatus.UNAUTHORIZED, "AUTH007", "토큰이 없습니다."),
+    TOKEN_REVOKED(HttpStatus.UNAUTHORIZED, "AUTH010", "폐기된 토큰입니다."),
+
+    // Magic link errors
+    MAGIC_LINK_RATE_LIMITED(HttpStatus.TOO_MANY_REQUESTS, "AUTH011", "로그인 링크 요청이 너무 많습니다. 잠시 후 다시 시도해주세요."),
+    MAGIC_LINK_INVALID(HttpStatus.UNAUTHORIZED, "AUTH012", "유효하지 않거나 만료된 로그인 링크입니다."),
+    MAGIC_LINK_USED(HttpStatus.UNAUTHORIZED, "AUTH013", "이미 사용된 로그인 링크입니다."),
+    MAGIC_LINK_DEVICE_MISMATCH(HttpStatus.FORBIDDEN, "AUTH014", "로그인 링크를 요청한 기기와 브라우저에서 열어주세요."),
+
+    // Passkey (WebAuthn) / MFA errors
+    PASSKEY_CEREMONY_INVALID(HttpStatus.BAD_REQUEST, "AUTH015", "패스키 요청이 만료되었거나 유효하지 않습니다."),
+    PASSKEY_VERIFICATION_FAILED(HttpStatus.UNAUTHORIZED, "AUTH016", "패스키 인증에 실패했습니다."),
+    PASSKEY_NOT_FOUND(HttpStatus.UNAUTHORIZED, "AUTH017", "등록되지 않은 패스키입니다."),
+    PASSKEY_REGISTRATION_REJECTED(HttpStatus.CONFLICT, "AUTH018", "패스키를 등록할 수 없습니다."),
+    MFA_TOKEN_INVALID(HttpStatus.UNAUTHORIZED, "AUTH019", "추가 인증 요청이 만료되었거나 유효하지 않습니다."),
+
+    // Login protection errors
+    LOGIN_LOCKED(HttpStatus.TOO_MANY_REQUESTS, "AUTH020", "로그인 실패가 많아 잠시 잠겼습니다. 잠시 후 다시 시도해주세요."),
+    CAPTCHA_REQUIRED(HttpStatus.BAD_REQUEST, "AUTH021", "보안 확인(CAPTCHA)이 필요합니다."),
+
+    // Service client (client-credentials) errors
+    INVALID_CLIENT(HttpStatus.UNAUTHORIZED, "AUTH022", "클라이언트 인증에 실패했습니다."),
+    INVALID_SCOPE(HttpStatus.BAD_REQUEST, "AUTH023", "허용되지 않은 scope입니다."),
+    UNSUPPORTED_GRANT_TYPE(HttpStatus.BAD_REQUEST, "AUTH024", "지원하지 않는 grant_type입니다."),
+    CLIENT_ALREADY_EXISTS(HttpStatus.CONFLICT, "AUTH025", "이미 등록된 클라이언트입니다."),
+    CLIENT_NOT_FOUND(HttpStatus.NOT_FOUND, "AUTH026", "등록되지 않은 클라이언트입니다."),
+    INTERNAL_API_KEY_INVALID(HttpStatus.UNAUTHORIZED, "AUTH027", "내부 API 키가 유효하지 않습니다."),
+
+    // Session errors
+    SESSION_NOT_FOUND(HttpStatus.NOT_FOUND, "AUTH028", "세션을 찾을 수 없습니다."),
+
+    // TOTP MFA errors
+    TOTP_CODE_INVALID(HttpStatus.UNAUTHORIZED, "AUTH029", "인증 코드가 올바르지 않습니다."),
+    TOTP_NOT_ENABLED(HttpStatus.BAD_REQUEST, "AUTH030", "인증 앱(TOTP)이 등록되어 있지 않습니다."),
+    TOTP_ENROLLMENT_INVALID(HttpStatus.BAD_REQUEST, "AUTH031", "인증 앱 등록 요청이 만료되었거나 유효하지 않습니다."),
+    MFA_ENROLLMENT_REQUIRED(HttpStatus.FORBIDDEN, "AUTH032", "워크스페이스 정책에 따라 추가 인증 수단이 필요합니다."),
+    MFA_REAUTHENTICATION_REQUIRED(HttpStatus.UNAUTHORIZED, "AUTH033", "워크스페이스 정책에 따라 다시 로그인해 추가 인증을 완료해야 합니다."),
+
+    // OIDC provider errors
+    INVALID_GRANT(HttpStatus.BAD_REQUEST, "AUTH034", "인가 코드가 유효하지 않거나 만료되었습니다."),
+    INVALID_REDIRECT_URI(HttpStatus.BAD_REQUEST, "AUTH035", "등록되지 않은 redirect_uri입니다."),
+    OIDC_REQUEST_INVALID(HttpStatus.BAD_REQUEST, "AUTH036", "로그인 요청이 만료되었거나 유효하지 않습니다."),
 
     // User service errors
     USER_SERVICE_ERROR(HttpStatus.SERVICE_UNAVAILABLE, "AUTH008", "사용자 서비스 연결 오류입니다."),
@@ -3,12 +3,22 @@ package OrangeCloud.AuthService.oauth;
 import OrangeCloud.AuthService.client.UserServiceClient;
 import lombok.RequiredArgsConstructor;
 import lombok.extern.slf4j.Slf4j;
+import org.springframework.core.ParameterizedTypeReference;
+import org.springframework.http.HttpEntity;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpMethod;
+import org.springframework.http.ResponseEntity;
 import org.springframework.security.oauth2.client.userinfo.DefaultOAuth2UserService;
 import org.springframework.security.oauth2.client.userinfo.OAuth2UserRequest;
 import org.springframework.security.oauth2.core.OAuth2AuthenticationException;
+import org.springframework.security.oauth2.core.OAuth2Error;
 import org.springframework.security.oauth2.core.user.OAuth2User;
 import org.springframework.stereotype.Service;
+import org.springframework.web.client.RestClientException;
+import org.springframework.web.client.RestTemplate;
 
+import java.util.List;
+import java.util.Map;
 import java.util.UUID;
 
 @Service
@@ -16,23 +26,80 @@ import java.util.UUID;
 @Slf4j
 public class CustomOAuth2UserService extends DefaultOAuth2UserService {
 
+    private static final String GITHUB_EMAILS_URL = "https://api.github.com/user/emails";
+
     private final UserServiceClient userServiceClient;
+    private final RestTemplate restTemplate;
 
     @Override
     public OAuth2User loadUser(OAuth2UserRequest userRequest) throws OAuth2AuthenticationException {
         OAuth2User oAuth2User = super.loadUser(userRequest);
 
-        String email = oAuth2User.getAttribute("email");
-        String name = oAuth2User.getAttribute("name");
         String provider = userRequest.getClientRegistration().getRegistrationId();
+        OAuth2UserInfo userInfo = toUserInfo(provider, userRequest, oAuth2User);
+
+        log.info("OAuth2 로그인 시도: email={}, provider={}", userInfo.getEmail(), provider);
+
+        // 검증되지 않은 이메일로는 기존 계정 연결/신규 생성을 하지 않음 (계정 탈취 방지)
+        if (userInfo.getEmail() == null || !userInfo.isEmailVerified()) {
+            log.warn("OAuth2 로그인 거부 - 검증된 이메일 없음: provider={}, providerUserId={}",
+                    provider, userInfo.getProviderUserId());
+            throw new OAuth2AuthenticationException(new OAuth2Error("email_not_verified",
+                    provider + " 계정에 검증된 이메일이 없습니다.", null));
+        }
+
+        // user-service에 유저 조회/연결/생성 요청
+        UUID userId = userServiceClient.findOrCreateOAuthUser(userInfo, provider);
 
-        log.info("OAuth2 로그인 시도: email={}, provider={}", email, provider);
+        log.info("OAuth2 로그인 성공: userId={}, email={}, provider={}", userId, userInfo.getEmail(), provider);
+
+        return new CustomOAuth2User(oAuth2User, userId, userInfo.getEmail(), userInfo.getName());
+    }
+
+    private OAuth2UserInfo toUserInfo(String provider, OAuth2UserRequest userRequest, OAuth2User oAuth2User) {
+        Map<String, Object> attributes = oAuth2User.getAttributes();
+        return switch (provider) {
+            case "google" -> new GoogleOAuth2UserInfo(attributes);
+            case "github" -> new GitHubOAuth2UserInfo(attributes,
+                    fetchGitHubVerifiedEmail(userRequest.getAccessToken().getTokenValue()));
+            case "kakao" -> new KakaoOAuth2UserInfo(attributes);
+            default -> throw new OAuth2AuthenticationException(new OAuth2Error("unsupported_provider",
+                    "지원하지 않는 OAuth2 제공자입니다: " + provider, null));
+        };
+    }
 
-        // user-service에 유저 조회/생성 요청
-        UUID userId = userServiceClient.findOrCreateOAuthUser(email, name, provider);
+    /**
+     * GitHub 계정의 검증된 기본(primary) 이메일 조회 (user:email scope 필요)
+     *
+     * @return 검증된 기본 이메일, 없으면 null
+     */
+    private String fetchGitHubVerifiedEmail(String accessToken) {
+        HttpHeaders headers = new HttpHeaders();
+        headers.setBearerAuth(accessToken);
+        headers.set(HttpHeaders.ACCEPT, "application/vnd.github+json");
 
-        log.info("OAuth2 로그인 성공: userId={}, email={}", userId, email);
+        try {
+            ResponseEntity<List<Map<String, Object>>> response = restTemplate.exchange(
+                    GITHUB_EMAILS_URL,
+                    HttpMethod.GET,
+                    new HttpEntity<>(headers),
+                    new ParameterizedTypeReference<>() {}
+            );
 
-        return new CustomOAuth2User(oAuth2User, userId, email, name);
+            List<Map<String, Object>> emails = response.getBody();
+            if (emails == null) {
+                return null;
+            }
+            return emails.stream()
+                    .filter(email -> Boolean.TRUE.equals(email.get("primary")))
+                    .filter(email -> Boolean.TRUE.equals(email.get("verified")))
+                    .map(email -> (String) email.get("email"))
+                    .findFirst()
+                    .orElse(null);
+        } catch (RestClientException e) {
+            log.error("GitHub 이메일 조회 실패: {}", e.getMessage());
+            throw new OAuth2AuthenticationException(new OAuth2Error("github_email_lookup_failed",
+                    "GitHub 이메일을 조회할 수 없습니다.", null), e);
+        }
     }
 }
@@ -0,0 +1,43 @@
+package OrangeCloud.AuthService.oauth;
+
+import java.util.Map;
+
+/**
+ * GitHub /user 응답: id, login, name
+ *
+ * /user의 email은 공개 이메일이며 검증 여부를 알 수 없으므로 사용하지 않는다.
+ * 검증된 기본 이메일은 CustomOAuth2UserService가 /user/emails에서 조회하여 전달한다.
+ */
+public class GitHubOAuth2UserInfo extends OAuth2UserInfo {
+
+    private final String verifiedEmail;
+
+    public GitHubOAuth2UserInfo(Map<String, Object> attributes, String verifiedEmail) {
+        super(attributes);
+        this.verifiedEmail = verifiedEmail;
+    }
+
+    @Override
+    public String getProviderUserId() {
+        return stringValue(attributes.get("id"));
+    }
+
+    @Override
+    public String getEmail() {
+        return verifiedEmail;
+    }
+
+    @Override
+    public boolean isEmailVerified() {
+        return verifiedEmail != null;
+    }
+
+    @Override
+    public String getName() {
+        String name = stringValue(attributes.get("name"));
+        if (name == null || name.isBlank()) {
+            name = stringValue(attributes.get("login"));
+        }
+        return nameOrEmailPrefix(name);
+    }
+}
@@ -0,0 +1,33 @@
+package OrangeCloud.AuthService.oauth;
+
+import java.util.Map;
+
+/**
+ * Google userinfo (v3) 응답: sub, email, email_verified, name
+ */
+public class GoogleOAuth2UserInfo extends OAuth2UserInfo {
+
+    public GoogleOAuth2UserInfo(Map<String, Object> attributes) {
+        super(attributes);
+    }
+
+    @Override
+    public String getProviderUserId() {
+        return stringValue(attributes.get("sub"));
+    }
+
+    @Override
+    public String getEmail() {
+        return stringValue(attributes.get("email"));
+    }
+
+    @Override
+    public boolean isEmailVerified() {
+        return Boolean.TRUE.equals(attributes.get("email_verified"));
+    }
+
+    @Override
+    public String getName() {
+        return nameOrEmailPrefix(stringValue(attributes.get("name")));
+    }
+}
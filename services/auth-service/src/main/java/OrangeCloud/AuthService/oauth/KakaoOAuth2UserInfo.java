@@ -0,0 +1,44 @@
+package OrangeCloud.AuthService.oauth;
+
+import java.util.Map;
+
+/**
+ * Kakao /v2/user/me 응답: id, kakao_account.{email, is_email_valid, is_email_verified, profile.nickname}
+ */
+public class KakaoOAuth2UserInfo extends OAuth2UserInfo {
+
+    public KakaoOAuth2UserInfo(Map<String, Object> attributes) {
+        super(attributes);
+    }
+
+    @Override
+    public String getProviderUserId() {
+        return stringValue(attributes.get("id"));
+    }
+
+    @Override
+    public String getEmail() {
+        return stringValue(account().get("email"));
+    }
+
+    @Override
+    public boolean isEmailVerified() {
+        // 만료/변경된 이메일(is_email_valid=false)은 검증된 이메일로 보지 않음
+        Map<String, Object> account = account();
+        return Boolean.TRUE.equals(account.get("is_email_valid"))
+                && Boolean.TRUE.equals(account.get("is_email_verified"));
+    }
+
+    @Override
+    public String getName() {
+        Object profile = account().get("profile");
+        String nickname = profile instanceof Map<?, ?> map ? stringValue(map.get("nickname")) : null;
+        return nameOrEmailPrefix(nickname);
+    }
+
+    @SuppressWarnings("unchecked")
+    private Map<String, Object> account() {
+        Object account = attributes.get("kakao_account");
+        return account instanceof Map<?, ?> ? (Map<String, Object>) account : Map.of();
+    }
+}
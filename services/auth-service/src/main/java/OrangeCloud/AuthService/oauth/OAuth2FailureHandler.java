@@ -6,13 +6,14 @@ import jakarta.servlet.http.HttpServletResponse;
 import lombok.extern.slf4j.Slf4j;
 import org.springframework.security.core.AuthenticationException;
 import org.springframework.security.oauth2.core.OAuth2AuthenticationException;
+import org.springframework.security.saml2.provider.service.authentication.Saml2AuthenticationException;
 import org.springframework.security.web.authentication.SimpleUrlAuthenticationFailureHandler;
 import org.springframework.stereotype.Component;
 
 import java.io.IOException;
 
 /**
- * OAuth2 authentication failure handler for detailed error logging.
+ * OAuth2/SAML2 authentication failure handler for detailed error logging.
  */
 @Component
 @Slf4j
@@ -33,6 +34,10 @@ public class OAuth2FailureHandler extends SimpleUrlAuthenticationFailureHandler
             log.error("OAuth2 error description: {}", oauth2Exception.getError().getDescription());
             log.error("OAuth2 error URI: {}", oauth2Exception.getError().getUri());
         }
+        if (exception instanceof Saml2AuthenticationException saml2Exception) {
+            log.error("SAML2 error code: {}", saml2Exception.getSaml2Error().getErrorCode());
+            log.error("SAML2 error description: {}", saml2Exception.getSaml2Error().getDescription());
+        }
 
         // Log the full stack trace for debugging
         log.error("Full exception details:", exception);
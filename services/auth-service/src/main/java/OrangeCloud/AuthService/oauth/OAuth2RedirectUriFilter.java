@@ -14,7 +14,7 @@ import java.io.IOException;
 import java.util.List;
 
 /**
- * Filter to capture and store client's redirect_uri for OAuth2 flow.
+ * Filter to capture and store client's redirect_uri for OAuth2 and SAML2 flows.
  * This enables different frontends (main app, ops-portal) to use the same auth-service.
  */
 @Component
@@ -33,9 +33,11 @@ public class OAuth2RedirectUriFilter extends OncePerRequestFilter {
                                     FilterChain filterChain) throws ServletException, IOException {
         String requestUri = request.getRequestURI();
 
-        // Check for OAuth2 authorization requests (with or without /api prefix)
+        // Check for OAuth2/SAML2 authorization requests (with or without /api prefix)
         boolean isAuthorizationRequest = requestUri.startsWith("/oauth2/authorization/")
-                || requestUri.startsWith("/api/oauth2/authorization/");
+                || requestUri.startsWith("/api/oauth2/authorization/")
+                || requestUri.startsWith("/saml2/authenticate/")
+                || requestUri.startsWith("/api/saml2/authenticate/");
 
         if (isAuthorizationRequest) {
             log.info("OAuth2 authorization request detected: {}", requestUri);
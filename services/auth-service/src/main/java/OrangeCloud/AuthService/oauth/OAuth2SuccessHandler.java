@@ -1,6 +1,8 @@
 package OrangeCloud.AuthService.oauth;
 
 import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
 import OrangeCloud.AuthService.service.AuthService;
 import jakarta.servlet.http.HttpServletRequest;
 import jakarta.servlet.http.HttpServletResponse;
@@ -14,6 +16,7 @@ import org.springframework.stereotype.Component;
 import org.springframework.web.util.UriComponentsBuilder;
 
 import java.io.IOException;
+import java.util.UUID;
 
 @Component
 @RequiredArgsConstructor
@@ -32,17 +35,38 @@ public class OAuth2SuccessHandler extends SimpleUrlAuthenticationSuccessHandler
 
         log.info("OAuth2 인증 성공: userId={}, email={}", oAuth2User.getUserId(), oAuth2User.getEmail());
 
+        redirectWithTokens(request, response, oAuth2User.getUserId(), oAuth2User.getEmail(), LoginMethod.OAUTH2);
+    }
+
+    /**
+     * 토큰을 발행하고 클라이언트 redirect_uri로 리다이렉트
+     * SAML 로그인(Saml2SuccessHandler)도 같은 방식으로 토큰을 전달한다.
+     */
+    public void redirectWithTokens(HttpServletRequest request, HttpServletResponse response,
+                                   UUID userId, String email, LoginMethod method) throws IOException {
         // 토큰 발행 (email claim 포함 - ops-portal 등에서 필요)
-        AuthResponse authResponse = authService.generateTokens(oAuth2User.getUserId(), oAuth2User.getEmail());
+        // MFA 사용자(또는 MFA를 요구하는 워크스페이스 멤버)는 토큰 대신 mfaToken 발급
+        AuthResponse authResponse = authService.completeLogin(userId, email, method, LoginContext.from(request));
 
         // 클라이언트가 지정한 redirect_uri 확인 (세션에서)
         String redirectUrl = getClientRedirectUri(request);
 
         // 프론트엔드로 리다이렉트 (토큰 정보를 쿼리 파라미터로 전달)
         // nickName, email은 더 이상 전달하지 않음 - 프론트에서 user-service 호출
-        String targetUrl = UriComponentsBuilder.fromUriString(redirectUrl)
-                .queryParam("accessToken", authResponse.getAccessToken())
-                .queryParam("refreshToken", authResponse.getRefreshToken())
+        UriComponentsBuilder builder = UriComponentsBuilder.fromUriString(redirectUrl);
+        if (authResponse.isMfaRequired()) {
+            // 프론트엔드는 mfaToken으로 패스키(/api/auth/passkeys/login) 또는 TOTP(/api/auth/mfa/totp/verify) 인증,
+            // mfaEnrollmentRequired면 TOTP 등록(/api/auth/mfa/totp/setup → /enable)을 진행
+            builder.queryParam("mfaToken", authResponse.getMfaToken())
+                    .queryParam("mfaMethods", String.join(",", authResponse.getMfaMethods()));
+            if (authResponse.isMfaEnrollmentRequired()) {
+                builder.queryParam("mfaEnrollmentRequired", true);
+            }
+        } else {
+            builder.queryParam("accessToken", authResponse.getAccessToken())
+                    .queryParam("refreshToken", authResponse.getRefreshToken());
+        }
+        String targetUrl = builder
                 .queryParam("userId", authResponse.getUserId().toString())
                 .build()
                 .toUriString();
@@ -0,0 +1,46 @@
+package OrangeCloud.AuthService.oauth;
+
+import java.util.Map;
+
+/**
+ * OAuth2UserInfo - 소셜 로그인 제공자별 사용자 정보 응답을 공통 형태로 변환
+ *
+ * 제공자마다 사용자 정보 응답 구조가 다르므로 (Google: sub, GitHub: id, Kakao: kakao_account)
+ * 계정 연결에 필요한 값(제공자 계정 ID, 이메일, 이메일 검증 여부, 이름)만 꺼내어 사용한다.
+ */
+public abstract class OAuth2UserInfo {
+
+    protected final Map<String, Object> attributes;
+
+    protected OAuth2UserInfo(Map<String, Object> attributes) {
+        this.attributes = attributes;
+    }
+
+    /** 제공자 내 고유 계정 ID */
+    public abstract String getProviderUserId();
+
+    public abstract String getEmail();
+
+    /** 제공자가 이메일 소유를 검증했는지 여부 - false이면 기존 계정 연결/신규 생성을 거부 */
+    public abstract boolean isEmailVerified();
+
+    public abstract String getName();
+
+    /**
+     * 이름이 없는 계정은 이메일 앞부분을 이름으로 사용
+     */
+    protected String nameOrEmailPrefix(String name) {
+        if (name != null && !name.isBlank()) {
+            return name;
+        }
+        String email = getEmail();
+        if (email != null && email.contains("@")) {
+            return email.substring(0, email.indexOf('@'));
+        }
+        return getProviderUserId();
+    }
+
+    protected static String stringValue(Object value) {
+        return value == null ? null : String.valueOf(value);
+    }
+}
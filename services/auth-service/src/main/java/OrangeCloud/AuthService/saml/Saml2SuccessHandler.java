@@ -0,0 +1,69 @@
+package OrangeCloud.AuthService.saml;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import OrangeCloud.AuthService.dto.SsoLoginResponse;
+import OrangeCloud.AuthService.oauth.OAuth2FailureHandler;
+import OrangeCloud.AuthService.oauth.OAuth2SuccessHandler;
+import jakarta.servlet.ServletException;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.servlet.http.HttpServletResponse;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.security.core.Authentication;
+import org.springframework.security.core.context.SecurityContextHolder;
+import org.springframework.security.saml2.provider.service.authentication.Saml2AuthenticatedPrincipal;
+import org.springframework.security.saml2.provider.service.authentication.Saml2AuthenticationException;
+import org.springframework.security.web.authentication.AuthenticationSuccessHandler;
+import org.springframework.stereotype.Component;
+
+import java.io.IOException;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.UUID;
+
+/**
+ * SAML 로그인 성공 처리
+ *
+ * 서명 검증이 끝난 assertion을 user-service에 전달해 사용자를 조회/JIT 생성하고,
+ * OAuth2 로그인과 같은 방식으로 토큰을 발행해 프론트엔드로 리다이렉트한다.
+ */
+@Component
+@RequiredArgsConstructor
+@Slf4j
+public class Saml2SuccessHandler implements AuthenticationSuccessHandler {
+
+    private final UserServiceClient userServiceClient;
+    private final OAuth2SuccessHandler oAuth2SuccessHandler;
+    private final OAuth2FailureHandler oAuth2FailureHandler;
+
+    @Override
+    public void onAuthenticationSuccess(HttpServletRequest request, HttpServletResponse response,
+                                        Authentication authentication) throws IOException, ServletException {
+        Saml2AuthenticatedPrincipal principal = (Saml2AuthenticatedPrincipal) authentication.getPrincipal();
+        UUID workspaceId = UUID.fromString(principal.getRelyingPartyRegistrationId());
+
+        log.info("SAML 인증 성공: workspaceId={}", workspaceId);
+
+        SsoLoginResponse user;
+        try {
+            user = userServiceClient.ssoLogin(workspaceId, principal.getName(), toStringAttributes(principal));
+        } catch (Saml2AuthenticationException e) {
+            // IdP 인증은 성공했지만 워크스페이스 정책상 거부 - 인증 정보를 남기지 않음
+            SecurityContextHolder.clearContext();
+            oAuth2FailureHandler.onAuthenticationFailure(request, response, e);
+            return;
+        }
+
+        oAuth2SuccessHandler.redirectWithTokens(request, response, user.getUserId(), user.getEmail(), LoginMethod.SAML);
+    }
+
+    private Map<String, List<String>> toStringAttributes(Saml2AuthenticatedPrincipal principal) {
+        Map<String, List<String>> attributes = new LinkedHashMap<>();
+        principal.getAttributes().forEach((name, values) -> attributes.put(name,
+                values.stream().filter(Objects::nonNull).map(Object::toString).toList()));
+        return attributes;
+    }
+}
@@ -0,0 +1,89 @@
+package OrangeCloud.AuthService.saml;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.SsoConnection;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.security.saml2.core.Saml2X509Credential;
+import org.springframework.security.saml2.provider.service.registration.RelyingPartyRegistration;
+import org.springframework.security.saml2.provider.service.registration.RelyingPartyRegistrationRepository;
+import org.springframework.security.saml2.provider.service.registration.Saml2MessageBinding;
+import org.springframework.stereotype.Component;
+import org.springframework.util.StringUtils;
+
+import java.io.ByteArrayInputStream;
+import java.nio.charset.StandardCharsets;
+import java.security.cert.CertificateException;
+import java.security.cert.CertificateFactory;
+import java.security.cert.X509Certificate;
+import java.util.UUID;
+
+/**
+ * 워크스페이스별 SAML RelyingPartyRegistration 조회
+ *
+ * registrationId는 워크스페이스 ID이고, IdP 설정은 user-service가 관리한다.
+ * - 로그인 시작: /saml2/authenticate/{workspaceId}
+ * - ACS:        /login/saml2/sso/{workspaceId}
+ * - SP 메타데이터: /saml2/service-provider-metadata/{workspaceId}
+ */
+@Component
+@RequiredArgsConstructor
+@Slf4j
+public class WorkspaceRelyingPartyRegistrationRepository implements RelyingPartyRegistrationRepository {
+
+    private static final String HTTP_POST_BINDING = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST";
+
+    private final UserServiceClient userServiceClient;
+
+    @Override
+    public RelyingPartyRegistration findByRegistrationId(String registrationId) {
+        UUID workspaceId;
+        try {
+            workspaceId = UUID.fromString(registrationId);
+        } catch (IllegalArgumentException e) {
+            return null;
+        }
+
+        SsoConnection connection = userServiceClient.getSsoConnection(workspaceId);
+        if (connection == null) {
+            return null;
+        }
+
+        X509Certificate certificate = parseCertificate(connection.getIdpCertificate());
+        if (certificate == null) {
+            log.warn("Invalid IdP certificate: workspaceId={}", workspaceId);
+            return null;
+        }
+
+        Saml2MessageBinding binding = HTTP_POST_BINDING.equals(connection.getIdpSsoBinding())
+                ? Saml2MessageBinding.POST
+                : Saml2MessageBinding.REDIRECT;
+
+        // {baseUrl}/{registrationId}는 요청 기준으로 치환됨 (ForwardedHeaderFilter 적용)
+        return RelyingPartyRegistration.withRegistrationId(registrationId)
+                .entityId("{baseUrl}/saml2/service-provider-metadata/{registrationId}")
+                .assertionConsumerServiceLocation("{baseUrl}/login/saml2/sso/{registrationId}")
+                .assertingPartyMetadata(party -> party
+                        .entityId(connection.getIdpEntityId())
+                        .singleSignOnServiceLocation(connection.getIdpSsoUrl())
+                        .singleSignOnServiceBinding(binding)
+                        // SP 서명 키가 없으므로 AuthnRequest는 서명하지 않음
+                        .wantAuthnRequestsSigned(false)
+                        .verificationX509Credentials(credentials ->
+                                credentials.add(Saml2X509Credential.verification(certificate))))
+                .build();
+    }
+
+    private X509Certificate parseCertificate(String pem) {
+        if (!StringUtils.hasText(pem)) {
+            return null;
+        }
+        try {
+            CertificateFactory factory = CertificateFactory.getInstance("X.509");
+            return (X509Certificate) factory.generateCertificate(
+                    new ByteArrayInputStream(pem.getBytes(StandardCharsets.US_ASCII)));
+        } catch (CertificateException e) {
+            return null;
+        }
+    }
+}
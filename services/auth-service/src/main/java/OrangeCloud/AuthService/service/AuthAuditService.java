@@ -0,0 +1,146 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.dto.AuthEventResponse;
+import OrangeCloud.AuthService.dto.AuthEventType;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.dao.DataAccessException;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+
+import java.time.Duration;
+import java.time.Instant;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Set;
+import java.util.UUID;
+
+/**
+ * 인증 감사 로그 (로그인 성공/실패, 토큰 갱신/폐기, MFA 이벤트)
+ *
+ * 이벤트는 IP, User-Agent와 함께 Redis sorted set(score = 발생 시각)에 저장한다.
+ * - 사용자별(wealist:auth:audit:user:{userId}): 최근 활동 조회용, max-events-per-user개까지 보관
+ * - 전체(wealist:auth:audit:events): 관리자 조회용, 계정을 알 수 없는 로그인 실패 포함, max-events개까지 보관
+ * 두 목록 모두 retention이 지난 이벤트는 기록할 때 정리한다.
+ * 감사 로그 저장 실패가 로그인/토큰 발급을 막지 않는다.
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class AuthAuditService {
+
+    private static final String USER_EVENTS_KEY_PREFIX = "wealist:auth:audit:user:";
+    private static final String EVENTS_KEY = "wealist:auth:audit:events";
+    private static final int QUERY_PAGE_SIZE = 500;
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+
+    @Value("${audit.retention:90d}")
+    private Duration retention;
+
+    @Value("${audit.max-events-per-user:200}")
+    private int maxEventsPerUser;
+
+    @Value("${audit.max-events:100000}")
+    private int maxEvents;
+
+    /**
+     * 이벤트 기록
+     *
+     * @param userId  관련 사용자 (로그인 실패에서 계정을 알 수 없으면 null)
+     * @param method  인증 수단 (로그인/MFA 이벤트가 아니면 null)
+     * @param reason  실패/폐기 사유 (없으면 null)
+     * @param context 요청 정보 (세션 수 제한처럼 요청과 무관한 이벤트는 null)
+     */
+    public void record(AuthEventType type, UUID userId, LoginMethod method, String sessionId, String reason,
+                       LoginContext context) {
+        Instant now = Instant.now();
+        AuthEventResponse event = new AuthEventResponse(UUID.randomUUID().toString(), type, userId, method,
+                context != null ? context.clientIp() : null,
+                context != null ? context.userAgent() : null,
+                context != null ? context.device() : null,
+                context != null ? context.country() : null,
+                sessionId, reason, now);
+
+        try {
+            String json = objectMapper.writeValueAsString(event);
+            if (userId != null) {
+                append(USER_EVENTS_KEY_PREFIX + userId, json, now, maxEventsPerUser);
+            }
+            append(EVENTS_KEY, json, now, maxEvents);
+        } catch (JsonProcessingException | DataAccessException e) {
+            log.error("Failed to record auth event: type={}, userId={}, error={}", type, userId, e.getMessage());
+        }
+    }
+
+    public void record(AuthEventType type, UUID userId, String sessionId, LoginContext context) {
+        record(type, userId, null, sessionId, null, context);
+    }
+
+    /**
+     * 로그인 실패 기록
+     *
+     * @param reason ErrorCode 코드 (AUTH0xx)
+     */
+    public void loginFailed(UUID userId, LoginMethod method, String reason, LoginContext context) {
+        record(AuthEventType.LOGIN_FAILURE, userId, method, null, reason, context);
+    }
+
+    /**
+     * 사용자의 최근 활동 (최신순)
+     */
+    public List<AuthEventResponse> recentActivity(UUID userId, int limit) {
+        return query(userId, null, null, null, limit);
+    }
+
+    /**
+     * 이벤트 조회 (최신순) - 조건이 없는 항목은 null
+     */
+    public List<AuthEventResponse> query(UUID userId, AuthEventType type, Instant from, Instant to, int limit) {
+        String key = userId != null ? USER_EVENTS_KEY_PREFIX + userId : EVENTS_KEY;
+        double min = from != null ? from.toEpochMilli() : Double.NEGATIVE_INFINITY;
+        double max = to != null ? to.toEpochMilli() : Double.POSITIVE_INFINITY;
+
+        List<AuthEventResponse> events = new ArrayList<>();
+        for (long offset = 0; events.size() < limit; offset += QUERY_PAGE_SIZE) {
+            Set<Object> page = redisTemplate.opsForZSet().reverseRangeByScore(key, min, max, offset, QUERY_PAGE_SIZE);
+            if (page == null || page.isEmpty()) {
+                break;
+            }
+            for (Object value : page) {
+                AuthEventResponse event = parse(value);
+                if (event != null && (type == null || event.type() == type)) {
+                    events.add(event);
+                    if (events.size() >= limit) {
+                        break;
+                    }
+                }
+            }
+        }
+        return events;
+    }
+
+    private void append(String key, String json, Instant now, int maxSize) {
+        redisTemplate.opsForZSet().add(key, json, now.toEpochMilli());
+        redisTemplate.opsForZSet().removeRangeByScore(key, Double.NEGATIVE_INFINITY,
+                now.minus(retention).toEpochMilli());
+        // 오래된 이벤트부터 제거해 최근 maxSize개만 유지
+        redisTemplate.opsForZSet().removeRange(key, 0, -(maxSize + 1L));
+        redisTemplate.expire(key, retention);
+    }
+
+    private AuthEventResponse parse(Object value) {
+        try {
+            return objectMapper.readValue(value.toString(), AuthEventResponse.class);
+        } catch (JsonProcessingException e) {
+            log.error("Failed to read auth event: {}", e.getMessage());
+            return null;
+        }
+    }
+}
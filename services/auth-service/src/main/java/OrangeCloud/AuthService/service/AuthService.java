@@ -1,6 +1,11 @@
 package OrangeCloud.AuthService.service;
 
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.AuthEventType;
 import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import OrangeCloud.AuthService.dto.UserMfaStatus;
 import OrangeCloud.AuthService.exception.CustomJwtException;
 import OrangeCloud.AuthService.exception.ErrorCode;
 import OrangeCloud.AuthService.util.JwtTokenProvider;
@@ -9,8 +14,11 @@ import lombok.extern.slf4j.Slf4j;
 import org.springframework.data.redis.core.RedisTemplate;
 import org.springframework.stereotype.Service;
 
+import java.security.SecureRandom;
 import java.time.Duration;
+import java.util.Base64;
 import java.util.Date;
+import java.util.List;
 import java.util.UUID;
 
 @Service
@@ -20,6 +28,27 @@ public class AuthService {
 
     private final JwtTokenProvider tokenProvider;
     private final RedisTemplate<String, Object> redisTemplate;
+    private final TokenRevocationService tokenRevocationService;
+    private final UserServiceClient userServiceClient;
+    private final LoginAttemptService loginAttemptService;
+    private final LoginAlertService loginAlertService;
+    private final SessionService sessionService;
+    private final AuthAuditService auditService;
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    // 1차 로그인 후 두 번째 인증(패스키, TOTP)을 기다리는 상태 (값: "{userId} {email}")
+    private static final String MFA_PENDING_KEY_PREFIX = "wealist:auth:mfa:pending:";
+    // 정책상 MFA가 필요하지만 등록된 수단이 없어 TOTP 등록을 기다리는 mfaToken 표시
+    private static final String MFA_ENROLLMENT_KEY_PREFIX = "wealist:auth:mfa:enrollment:";
+    private static final Duration MFA_PENDING_TTL = Duration.ofMinutes(5);
+    // TOTP 등록(인증 앱 설치, QR 스캔)을 위해 더 긴 시간 허용
+    private static final Duration MFA_ENROLLMENT_TTL = Duration.ofMinutes(15);
+
+    /**
+     * MFA 대기 중인 로그인
+     */
+    public record MfaPending(UUID userId, String email) {
+    }
 
     // ============================================================================
     // 토큰 발행
@@ -45,21 +74,140 @@ public class AuthService {
         return new AuthResponse(accessToken, refreshToken, userId);
     }
 
+    /**
+     * 로그인 성공 후 새 세션을 만들고 토큰 발행
+     * 계정 실패 횟수를 초기화하고, 처음 보는 기기/국가면 사용자에게 보안 알림을 보낸다.
+     *
+     * @param method      로그인을 완료한 인증 수단 (감사 로그용)
+     * @param mfaVerified 두 번째 인증(패스키 포함)을 거친 로그인이면 true - 워크스페이스 MFA 정책 확인에 사용
+     */
+    public AuthResponse issueLoginTokens(UUID userId, String email, LoginMethod method, LoginContext context,
+                                         boolean mfaVerified) {
+        loginAttemptService.recordSuccess(userId);
+        loginAlertService.checkLogin(userId, context);
+        AuthResponse tokens = sessionService.createSession(userId, email, context, mfaVerified);
+        auditService.record(AuthEventType.LOGIN_SUCCESS, userId, method,
+                tokenProvider.getSessionIdFromToken(tokens.getAccessToken()), null, context);
+        return tokens;
+    }
+
+    /**
+     * 1차 인증(소셜, SAML, 매직 링크)을 마친 로그인 완료
+     * MFA를 켠 사용자나 MFA를 요구하는 워크스페이스의 멤버는 토큰 대신 mfaToken을 받고,
+     * 패스키/TOTP 인증 후 completeMfa로 토큰을 받는다.
+     * 정책상 MFA가 필요하지만 등록된 수단이 없으면 mfaToken으로 TOTP를 먼저 등록해야 한다.
+     */
+    public AuthResponse completeLogin(UUID userId, String email, LoginMethod method, LoginContext context) {
+        UserMfaStatus mfa = userServiceClient.getUserMfa(userId);
+        if (!mfa.isMfaRequired()) {
+            return issueLoginTokens(userId, email, method, context, false);
+        }
+
+        byte[] random = new byte[32];
+        secureRandom.nextBytes(random);
+        String mfaToken = Base64.getUrlEncoder().withoutPadding().encodeToString(random);
+
+        List<String> methods = mfa.availableMethods();
+        if (methods.isEmpty()) {
+            redisTemplate.opsForValue().set(MFA_PENDING_KEY_PREFIX + mfaToken,
+                    userId + " " + (email != null ? email : ""), MFA_ENROLLMENT_TTL);
+            redisTemplate.opsForValue().set(MFA_ENROLLMENT_KEY_PREFIX + mfaToken, userId.toString(), MFA_ENROLLMENT_TTL);
+            log.info("MFA enrollment required by workspace policy: userId={}, workspaces={}",
+                    userId, mfa.getPolicyWorkspaces());
+            auditService.record(AuthEventType.MFA_CHALLENGE, userId, method, null, "enrollment_required", context);
+            return AuthResponse.mfaEnrollmentRequired(userId, mfaToken);
+        }
+
+        redisTemplate.opsForValue().set(MFA_PENDING_KEY_PREFIX + mfaToken,
+                userId + " " + (email != null ? email : ""), MFA_PENDING_TTL);
+        log.info("MFA required: userId={}, methods={}", userId, methods);
+        auditService.record(AuthEventType.MFA_CHALLENGE, userId, method, null, null, context);
+        return AuthResponse.mfaRequired(userId, mfaToken, methods);
+    }
+
+    /**
+     * MFA 대기 중인 사용자 ID 조회 (패스키 allowCredentials 구성, TOTP 검증용)
+     */
+    public UUID getMfaPendingUserId(String mfaToken) {
+        return getMfaPending(mfaToken).userId();
+    }
+
+    /**
+     * TOTP 등록을 기다리는 로그인 조회
+     * 등록된 수단이 있는 사용자의 mfaToken으로는 새 수단을 등록할 수 없다 (기존 MFA 우회 방지).
+     */
+    public MfaPending getMfaEnrollment(String mfaToken) {
+        if (!Boolean.TRUE.equals(redisTemplate.hasKey(MFA_ENROLLMENT_KEY_PREFIX + mfaToken))) {
+            throw new CustomJwtException(ErrorCode.MFA_TOKEN_INVALID);
+        }
+        return getMfaPending(mfaToken);
+    }
+
+    /**
+     * 두 번째 인증으로 MFA 완료 - mfaToken은 1회만 사용 가능
+     *
+     * @param verifiedUserId 패스키 assertion 또는 TOTP 코드로 확인된 사용자
+     * @param method         두 번째 인증 수단
+     */
+    public AuthResponse completeMfa(String mfaToken, UUID verifiedUserId, LoginMethod method, LoginContext context) {
+        Object pending = redisTemplate.opsForValue().getAndDelete(MFA_PENDING_KEY_PREFIX + mfaToken);
+        redisTemplate.delete(MFA_ENROLLMENT_KEY_PREFIX + mfaToken);
+        if (pending == null) {
+            throw new CustomJwtException(ErrorCode.MFA_TOKEN_INVALID);
+        }
+
+        MfaPending login = parseMfaPending(pending);
+        if (!login.userId().equals(verifiedUserId)) {
+            log.warn("Second factor belongs to another user during MFA: userId={}", login.userId());
+            throw new CustomJwtException(ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        return issueLoginTokens(login.userId(), login.email(), method, context, true);
+    }
+
+    private MfaPending getMfaPending(String mfaToken) {
+        Object pending = redisTemplate.opsForValue().get(MFA_PENDING_KEY_PREFIX + mfaToken);
+        if (pending == null) {
+            throw new CustomJwtException(ErrorCode.MFA_TOKEN_INVALID);
+        }
+        return parseMfaPending(pending);
+    }
+
+    private static MfaPending parseMfaPending(Object pending) {
+        String[] parts = pending.toString().split(" ", 2);
+        String email = parts.length > 1 && !parts[1].isBlank() ? parts[1] : null;
+        return new MfaPending(UUID.fromString(parts[0]), email);
+    }
+
     // ============================================================================
     // 로그아웃
     // ============================================================================
 
     /**
-     * 로그아웃 - 토큰을 Redis 블랙리스트에 추가
+     * 로그아웃 - 토큰을 폐기 목록(jti)에 추가하고, 세션이 있으면 세션의 refresh token도 폐기
+     * Go 서비스의 SmartValidator도 같은 목록을 확인하므로 즉시 모든 서비스에서 거부된다.
      */
-    public void logout(String token) {
+    public void logout(String token, LoginContext context) {
         log.debug("Attempting to log out token");
 
         tokenProvider.validateToken(token);
 
         Date expirationDate = tokenProvider.getExpirationDateFromToken(token);
-        long ttl = expirationDate.getTime() - System.currentTimeMillis();
+        String jti = tokenProvider.getJtiFromToken(token);
+        UUID userId = tokenProvider.getUserIdFromToken(token);
+        String sessionId = tokenProvider.getSessionIdFromToken(token);
+        if (sessionId != null) {
+            sessionService.end(userId, sessionId, null, context);
+        }
+        auditService.record(AuthEventType.LOGOUT, userId, sessionId, context);
+
+        if (jti != null) {
+            tokenRevocationService.revokeToken(jti, expirationDate);
+            return;
+        }
 
+        // jti 도입 이전 토큰은 기존 블랙리스트(토큰 문자열 키) 사용
+        long ttl = expirationDate.getTime() - System.currentTimeMillis();
         if (ttl > 0) {
             redisTemplate.opsForValue().set(token, "blacklisted", Duration.ofMillis(ttl));
             log.debug("Token blacklisted successfully in Redis with TTL: {}ms", ttl);
@@ -68,15 +216,30 @@ public class AuthService {
         }
     }
 
+    /**
+     * 사용자의 모든 토큰 폐기 (모든 기기에서 로그아웃)
+     * 계정 탈취가 의심될 때 사용하며, 비밀번호 변경 시에도 같은 방식으로 폐기한다.
+     */
+    public void revokeAllTokens(String token, LoginContext context) {
+        tokenProvider.validateToken(token);
+        checkNotRevoked(token);
+
+        UUID userId = tokenProvider.getUserIdFromToken(token);
+        tokenRevocationService.revokeAllForUser(userId,
+                Duration.ofMillis(tokenProvider.getRefreshTokenExpirationMs()));
+        sessionService.removeAll(userId);
+        auditService.record(AuthEventType.ALL_TOKENS_REVOKED, userId, tokenProvider.getSessionIdFromToken(token), context);
+    }
+
     // ============================================================================
     // 토큰 갱신
     // ============================================================================
 
     /**
      * Refresh Token을 사용하여 새로운 Access Token 발급
-     * email claim이 있으면 새 토큰에도 포함
+     * email claim이 있으면 새 토큰에도 포함하고, 세션(sid)이 있으면 세션의 토큰을 교체
      */
-    public AuthResponse refreshToken(String refreshToken) {
+    public AuthResponse refreshToken(String refreshToken, LoginContext context) {
         log.debug("Attempting to refresh token");
 
         tokenProvider.validateToken(refreshToken);
@@ -88,19 +251,52 @@ public class AuthService {
 
         UUID userId = tokenProvider.getUserIdFromToken(refreshToken);
         String email = tokenProvider.getEmailFromToken(refreshToken);
+        String sessionId = tokenProvider.getSessionIdFromToken(refreshToken);
         log.debug("Extracted user ID: {}, email: {} from refresh token", userId, email);
 
-        // 기존 refresh token 블랙리스트 추가
+        try {
+            checkNotRevoked(refreshToken);
+        } catch (CustomJwtException e) {
+            // 이미 교체된 refresh token 재사용 - 세션이 남아 있으면 탈취로 보고 세션 폐기
+            auditService.record(AuthEventType.TOKEN_REUSE_DETECTED, userId, sessionId, context);
+            if (sessionId != null) {
+                sessionService.end(userId, sessionId, "refresh_token_reuse", context);
+            }
+            throw e;
+        }
+
+        // 기존 refresh token 폐기 (재사용 방지)
         Date expirationDate = tokenProvider.getExpirationDateFromToken(refreshToken);
-        long ttl = expirationDate.getTime() - System.currentTimeMillis();
-        if (ttl > 0) {
-            redisTemplate.opsForValue().set(refreshToken, "blacklisted", Duration.ofMillis(ttl));
-            log.debug("Old refresh token blacklisted with TTL: {}ms", ttl);
+        String jti = tokenProvider.getJtiFromToken(refreshToken);
+        if (sessionId != null) {
+            // MFA 없이 시작된 세션은 워크스페이스가 MFA를 요구하면 더 이상 갱신하지 않음 (다시 로그인해 MFA 완료)
+            if (!sessionService.isMfaVerified(userId, sessionId)
+                    && userServiceClient.getUserMfa(userId).isPolicyRequired()) {
+                log.info("Session without MFA ended by workspace policy: userId={}, sessionId={}", userId, sessionId);
+                sessionService.end(userId, sessionId, "mfa_policy", context);
+                throw new CustomJwtException(ErrorCode.MFA_REAUTHENTICATION_REQUIRED);
+            }
+
+            // 세션이 폐기되었거나 교체된 토큰이면 여기서 거부
+            AuthResponse tokens = sessionService.rotate(userId, email, sessionId, jti, context);
+            tokenRevocationService.revokeToken(jti, expirationDate);
+            auditService.record(AuthEventType.TOKEN_REFRESHED, userId, sessionId, context);
+            return tokens;
+        }
+        if (jti != null) {
+            tokenRevocationService.revokeToken(jti, expirationDate);
+        } else {
+            long ttl = expirationDate.getTime() - System.currentTimeMillis();
+            if (ttl > 0) {
+                redisTemplate.opsForValue().set(refreshToken, "blacklisted", Duration.ofMillis(ttl));
+                log.debug("Old refresh token blacklisted with TTL: {}ms", ttl);
+            }
         }
 
         // 새로운 토큰 생성 (email 포함)
         String newAccessToken = tokenProvider.generateToken(userId, email);
         String newRefreshToken = tokenProvider.generateRefreshToken(userId, email);
+        auditService.record(AuthEventType.TOKEN_REFRESHED, userId, null, context);
 
         return new AuthResponse(newAccessToken, newRefreshToken, userId);
     }
@@ -123,6 +319,7 @@ public class AuthService {
             log.warn("Attempted to use a blacklisted token.");
             throw new CustomJwtException(ErrorCode.TOKEN_BLACKLISTED);
         }
+        checkNotRevoked(token);
 
         // 3. 토큰에서 User ID 추출
         UUID userId = tokenProvider.getUserIdFromToken(token);
@@ -143,4 +340,16 @@ public class AuthService {
         Boolean isBlacklisted = redisTemplate.hasKey(token);
         return Boolean.TRUE.equals(isBlacklisted);
     }
+
+    /**
+     * 폐기 목록(jti, 사용자 단위)에 있는 토큰이면 예외
+     */
+    private void checkNotRevoked(String token) {
+        String jti = tokenProvider.getJtiFromToken(token);
+        UUID userId = tokenProvider.getUserIdFromToken(token);
+        if (tokenRevocationService.isRevoked(jti, userId, tokenProvider.getIssuedAtFromToken(token))) {
+            log.warn("Attempted to use a revoked token.");
+            throw new CustomJwtException(ErrorCode.TOKEN_REVOKED);
+        }
+    }
 }
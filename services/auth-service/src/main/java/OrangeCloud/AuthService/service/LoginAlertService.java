@@ -0,0 +1,95 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.NotificationServiceClient;
+import OrangeCloud.AuthService.dto.LoginContext;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.dao.DataAccessException;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+
+import java.time.Duration;
+import java.util.LinkedHashMap;
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * 의심스러운 로그인 감지
+ *
+ * 로그인에 성공한 기기(브라우저 + OS)와 접속 국가를 사용자별로 Redis에 기록하고,
+ * 처음 보는 기기나 국가에서 로그인하면 noti-service로 보안 알림을 보낸다.
+ * 기록이 전혀 없는 첫 로그인은 기록만 한다.
+ * 브라우저 버전 업데이트마다 알림이 가지 않도록 User-Agent 전체 대신 브라우저/OS 이름만 비교한다.
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class LoginAlertService {
+
+    private static final String DEVICE_KEY_PREFIX = "wealist:auth:login:devices:";
+    private static final String COUNTRY_KEY_PREFIX = "wealist:auth:login:countries:";
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final NotificationServiceClient notificationServiceClient;
+
+    @Value("${login-protection.alert.enabled:true}")
+    private boolean enabled;
+
+    // 마지막 로그인 후 이 기간이 지나면 기기/국가 기록 만료
+    @Value("${login-protection.alert.history-ttl:180d}")
+    private Duration historyTtl;
+
+    /**
+     * 로그인 성공 시 호출 - 알림 실패가 로그인을 막지 않는다.
+     */
+    public void checkLogin(UUID userId, LoginContext context) {
+        if (!enabled || context == null) {
+            return;
+        }
+
+        try {
+            String deviceKey = DEVICE_KEY_PREFIX + userId;
+            String countryKey = COUNTRY_KEY_PREFIX + userId;
+            String device = context.device();
+
+            boolean firstLogin = !Boolean.TRUE.equals(redisTemplate.hasKey(deviceKey));
+            boolean newDevice = added(redisTemplate.opsForSet().add(deviceKey, device));
+            redisTemplate.expire(deviceKey, historyTtl);
+
+            boolean newCountry = false;
+            if (context.country() != null) {
+                // 국가 헤더를 나중에 켠 경우에도 첫 국가는 기록만 함
+                boolean firstCountry = !Boolean.TRUE.equals(redisTemplate.hasKey(countryKey));
+                newCountry = added(redisTemplate.opsForSet().add(countryKey, context.country())) && !firstCountry;
+                redisTemplate.expire(countryKey, historyTtl);
+            }
+
+            if (firstLogin || (!newDevice && !newCountry)) {
+                return;
+            }
+
+            log.info("Login from new {}: userId={}, device={}, country={}",
+                    newDevice ? "device" : "country", userId, device, context.country());
+
+            Map<String, Object> metadata = new LinkedHashMap<>();
+            metadata.put("device", device);
+            if (context.country() != null) {
+                metadata.put("country", context.country());
+            }
+            if (StringUtils.hasText(context.clientIp())) {
+                metadata.put("ip", context.clientIp());
+            }
+            metadata.put("newDevice", newDevice);
+            metadata.put("newCountry", newCountry);
+            notificationServiceClient.sendNewLoginAlert(userId, metadata);
+        } catch (DataAccessException e) {
+            log.error("Failed to check login history: userId={}, error={}", userId, e.getMessage());
+        }
+    }
+
+    private static boolean added(Long count) {
+        return count != null && count > 0;
+    }
+}
@@ -0,0 +1,132 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.CaptchaVerifier;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+
+import java.time.Duration;
+import java.util.UUID;
+
+/**
+ * 로그인 실패 횟수 제한 (brute-force 방어)
+ *
+ * - 실패 횟수를 계정(userId)과 IP 단위로 Redis에 집계한다.
+ *   계정은 서명된 매직 링크나 등록된 패스키로 식별된 경우에만 집계하므로
+ *   임의의 이메일로 다른 사용자를 잠글 수 없다.
+ * - 임계값마다 점진적으로 잠근다 (기본 계정 5회마다 1분 → 2분 → 4분 … 최대 1시간).
+ * - 잠금 전이라도 실패가 쌓이면 CAPTCHA 토큰(X-Captcha-Token 헤더)을 요구한다.
+ * - 로그인에 성공하면 계정 실패 횟수를 초기화한다 (IP 실패 횟수는 유지).
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class LoginAttemptService {
+
+    public static final String CAPTCHA_HEADER = "X-Captcha-Token";
+
+    private static final String ACCOUNT_FAILURE_KEY_PREFIX = "wealist:auth:login:failures:account:";
+    private static final String IP_FAILURE_KEY_PREFIX = "wealist:auth:login:failures:ip:";
+    private static final String ACCOUNT_LOCK_KEY_PREFIX = "wealist:auth:login:lock:account:";
+    private static final String IP_LOCK_KEY_PREFIX = "wealist:auth:login:lock:ip:";
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final CaptchaVerifier captchaVerifier;
+
+    // 마지막 실패 후 이 시간 동안 실패가 없으면 횟수 초기화
+    @Value("${login-protection.failure-window:24h}")
+    private Duration failureWindow;
+
+    @Value("${login-protection.lockout.account-threshold:5}")
+    private int accountLockThreshold;
+
+    @Value("${login-protection.lockout.ip-threshold:20}")
+    private int ipLockThreshold;
+
+    @Value("${login-protection.lockout.base-duration:1m}")
+    private Duration baseLockDuration;
+
+    @Value("${login-protection.lockout.max-duration:1h}")
+    private Duration maxLockDuration;
+
+    @Value("${login-protection.captcha.account-threshold:3}")
+    private int accountCaptchaThreshold;
+
+    @Value("${login-protection.captcha.ip-threshold:10}")
+    private int ipCaptchaThreshold;
+
+    /**
+     * 로그인 검증 전 확인 - 잠긴 계정/IP는 거부하고, 실패가 쌓였으면 CAPTCHA를 검증한다.
+     * CAPTCHA 토큰은 1회용이므로 요청당 한 번만 호출한다.
+     *
+     * @param userId 요청에서 식별된 계정 (알 수 없으면 null)
+     */
+    public void checkAllowed(UUID userId, LoginContext context, String captchaToken) {
+        if (isLocked(IP_LOCK_KEY_PREFIX + context.clientIp())
+                || (userId != null && isLocked(ACCOUNT_LOCK_KEY_PREFIX + userId))) {
+            log.warn("Login attempt while locked: userId={}, ip={}", userId, context.clientIp());
+            throw new CustomJwtException(ErrorCode.LOGIN_LOCKED);
+        }
+
+        boolean captchaRequired = failures(IP_FAILURE_KEY_PREFIX + context.clientIp()) >= ipCaptchaThreshold
+                || (userId != null && failures(ACCOUNT_FAILURE_KEY_PREFIX + userId) >= accountCaptchaThreshold);
+        if (captchaRequired && !captchaVerifier.verify(captchaToken, context.clientIp())) {
+            throw new CustomJwtException(ErrorCode.CAPTCHA_REQUIRED);
+        }
+    }
+
+    /**
+     * 로그인 실패 기록 - 임계값에 도달하면 잠근다.
+     *
+     * @param userId 요청에서 식별된 계정 (알 수 없으면 null, IP만 집계)
+     */
+    public void recordFailure(UUID userId, LoginContext context) {
+        recordFailure(IP_FAILURE_KEY_PREFIX + context.clientIp(), IP_LOCK_KEY_PREFIX + context.clientIp(),
+                ipLockThreshold);
+        if (userId != null) {
+            recordFailure(ACCOUNT_FAILURE_KEY_PREFIX + userId, ACCOUNT_LOCK_KEY_PREFIX + userId,
+                    accountLockThreshold);
+        }
+    }
+
+    /**
+     * 로그인 성공 - 계정 실패 횟수 초기화
+     */
+    public void recordSuccess(UUID userId) {
+        redisTemplate.delete(ACCOUNT_FAILURE_KEY_PREFIX + userId);
+    }
+
+    private void recordFailure(String failureKey, String lockKey, int threshold) {
+        Long count = redisTemplate.opsForValue().increment(failureKey);
+        redisTemplate.expire(failureKey, failureWindow);
+        if (count == null || count % threshold != 0) {
+            return;
+        }
+
+        Duration lockDuration = lockDuration(count / threshold);
+        redisTemplate.opsForValue().set(lockKey, count.toString(), lockDuration);
+        log.warn("Login locked: key={}, failures={}, duration={}", lockKey, count, lockDuration);
+    }
+
+    /**
+     * n번째 잠금 시간: base * 2^(n-1), 최대 max-duration
+     */
+    private Duration lockDuration(long step) {
+        Duration duration = baseLockDuration.multipliedBy(1L << Math.min(step - 1, 20));
+        return duration.compareTo(maxLockDuration) > 0 ? maxLockDuration : duration;
+    }
+
+    private boolean isLocked(String key) {
+        return Boolean.TRUE.equals(redisTemplate.hasKey(key));
+    }
+
+    private long failures(String key) {
+        Object value = redisTemplate.opsForValue().get(key);
+        return value != null ? Long.parseLong(value.toString()) : 0;
+    }
+}
@@ -0,0 +1,225 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import io.jsonwebtoken.Claims;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.ObjectProvider;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.mail.SimpleMailMessage;
+import org.springframework.mail.javamail.JavaMailSender;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+import org.springframework.web.util.UriComponentsBuilder;
+
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.security.NoSuchAlgorithmException;
+import java.security.SecureRandom;
+import java.time.Duration;
+import java.util.Base64;
+import java.util.HexFormat;
+import java.util.Locale;
+import java.util.UUID;
+
+/**
+ * 이메일 매직 링크 로그인 (비밀번호 없는 로그인)
+ *
+ * - 요청: 이메일/IP 단위 rate limit 후 기존 사용자에게만 로그인 링크 메일 발송
+ *   (사용자 존재 여부가 드러나지 않도록 응답은 항상 동일)
+ * - 토큰: 짧은 만료의 RS256 JWT, 별도 issuer라 access token으로 사용할 수 없음
+ * - 기기 바인딩: 링크를 요청한 브라우저의 쿠키(nonce) 해시를 토큰의 dvc claim에 넣고 사용 시 비교
+ * - 1회 사용: 발급 시 Redis에 jti를 저장하고 사용 시 삭제에 성공한 요청만 토큰 쌍을 발급
+ *   (패스키 MFA 사용자는 토큰 대신 mfaToken)
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class MagicLinkService {
+
+    public static final String DEVICE_COOKIE_NAME = "wealist_magic_link_device";
+
+    private static final String PENDING_KEY_PREFIX = "wealist:auth:magic-link:jti:";
+    private static final String EMAIL_RATE_KEY_PREFIX = "wealist:auth:magic-link:rate:email:";
+    private static final String IP_RATE_KEY_PREFIX = "wealist:auth:magic-link:rate:ip:";
+
+    private final JwtTokenProvider tokenProvider;
+    private final AuthService authService;
+    private final LoginAttemptService loginAttemptService;
+    private final UserServiceClient userServiceClient;
+    private final AuthAuditService auditService;
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectProvider<JavaMailSender> mailSenderProvider;
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    @Value("${magic-link.token-ttl:15m}")
+    private Duration tokenTtl;
+
+    @Value("${magic-link.redirect-url:http://localhost:3000/auth/magic-link}")
+    private String redirectUrl;
+
+    @Value("${magic-link.mail-from:no-reply@wealist.co.kr}")
+    private String mailFrom;
+
+    // 메일 서버가 없는 개발 환경용 - 운영에서는 반드시 false
+    @Value("${magic-link.log-links:false}")
+    private boolean logLinks;
+
+    @Value("${magic-link.rate-limit.per-email:3}")
+    private int perEmailLimit;
+
+    @Value("${magic-link.rate-limit.email-window:15m}")
+    private Duration emailWindow;
+
+    @Value("${magic-link.rate-limit.per-ip:20}")
+    private int perIpLimit;
+
+    @Value("${magic-link.rate-limit.ip-window:1h}")
+    private Duration ipWindow;
+
+    /**
+     * 기기 바인딩용 nonce 생성 (쿠키 값)
+     */
+    public String newDeviceNonce() {
+        byte[] nonce = new byte[32];
+        secureRandom.nextBytes(nonce);
+        return Base64.getUrlEncoder().withoutPadding().encodeToString(nonce);
+    }
+
+    public Duration getTokenTtl() {
+        return tokenTtl;
+    }
+
+    /**
+     * 로그인 링크 요청
+     * rate limit을 넘으면 예외, 그 외에는 사용자가 없어도 조용히 끝낸다.
+     *
+     * @param deviceNonce 요청한 브라우저의 기기 쿠키 값
+     */
+    public void requestLink(String email, String clientIp, String deviceNonce) {
+        String normalizedEmail = email.trim().toLowerCase(Locale.ROOT);
+
+        checkRateLimit(EMAIL_RATE_KEY_PREFIX + sha256Hex(normalizedEmail), perEmailLimit, emailWindow);
+        checkRateLimit(IP_RATE_KEY_PREFIX + clientIp, perIpLimit, ipWindow);
+
+        UUID userId = userServiceClient.findMagicLinkUser(normalizedEmail);
+        if (userId == null) {
+            return;
+        }
+
+        String jti = UUID.randomUUID().toString();
+        String token = tokenProvider.generateMagicLinkToken(userId, normalizedEmail, jti,
+                sha256Hex(deviceNonce), tokenTtl.toMillis());
+        redisTemplate.opsForValue().set(PENDING_KEY_PREFIX + jti, userId.toString(), tokenTtl);
+
+        String link = UriComponentsBuilder.fromHttpUrl(redirectUrl)
+                .queryParam("token", token)
+                .toUriString();
+        sendLink(normalizedEmail, link);
+        log.info("Magic link issued: userId={}", userId);
+    }
+
+    /**
+     * 로그인 링크 사용 - 검증 후 일반 로그인과 같은 access/refresh token 쌍 발급
+     * 다른 기기에서 연 링크는 소비하지 않으므로 요청한 기기에서 다시 열 수 있다.
+     * 검증 실패는 로그인 실패로 집계한다 (LoginAttemptService).
+     *
+     * @param deviceNonce  현재 브라우저의 기기 쿠키 값 (없으면 null)
+     * @param captchaToken 실패가 쌓였을 때 요구되는 CAPTCHA 토큰 (없으면 null)
+     */
+    public AuthResponse redeem(String token, String deviceNonce, LoginContext context, String captchaToken) {
+        Claims claims = null;
+        CustomJwtException invalid = null;
+        try {
+            claims = tokenProvider.parseMagicLinkToken(token);
+        } catch (CustomJwtException e) {
+            invalid = e;
+        }
+
+        // 서명이 검증된 링크만 계정 단위로 집계 (위조 토큰으로 다른 계정을 잠글 수 없음)
+        UUID userId = claims != null ? UUID.fromString(claims.getSubject()) : null;
+        loginAttemptService.checkAllowed(userId, context, captchaToken);
+        if (invalid != null) {
+            loginAttemptService.recordFailure(null, context);
+            auditService.loginFailed(null, LoginMethod.MAGIC_LINK, invalid.getErrorCode().getCode(), context);
+            throw invalid;
+        }
+
+        String expectedDevice = claims.get("dvc", String.class);
+        if (deviceNonce == null || expectedDevice == null || !MessageDigest.isEqual(
+                expectedDevice.getBytes(StandardCharsets.UTF_8),
+                sha256Hex(deviceNonce).getBytes(StandardCharsets.UTF_8))) {
+            log.warn("Magic link opened on a different device: jti={}", claims.getId());
+            loginAttemptService.recordFailure(userId, context);
+            auditService.loginFailed(userId, LoginMethod.MAGIC_LINK, ErrorCode.MAGIC_LINK_DEVICE_MISMATCH.getCode(), context);
+            throw new CustomJwtException(ErrorCode.MAGIC_LINK_DEVICE_MISMATCH);
+        }
+
+        // 삭제에 성공한 요청 하나만 통과 (동시 요청 포함 1회 사용 보장)
+        if (!Boolean.TRUE.equals(redisTemplate.delete(PENDING_KEY_PREFIX + claims.getId()))) {
+            log.warn("Magic link already used: jti={}", claims.getId());
+            loginAttemptService.recordFailure(userId, context);
+            auditService.loginFailed(userId, LoginMethod.MAGIC_LINK, ErrorCode.MAGIC_LINK_USED.getCode(), context);
+            throw new CustomJwtException(ErrorCode.MAGIC_LINK_USED);
+        }
+
+        if (!userServiceClient.userExists(userId)) {
+            throw new CustomJwtException(ErrorCode.USER_NOT_FOUND);
+        }
+
+        log.info("Magic link login successful: userId={}", userId);
+        return authService.completeLogin(userId, claims.get("email", String.class), LoginMethod.MAGIC_LINK, context);
+    }
+
+    private void checkRateLimit(String key, int limit, Duration window) {
+        Long count = redisTemplate.opsForValue().increment(key);
+        if (count != null && count == 1) {
+            redisTemplate.expire(key, window);
+        }
+        if (count != null && count > limit) {
+            log.warn("Magic link rate limit exceeded: key={}", key);
+            throw new CustomJwtException(ErrorCode.MAGIC_LINK_RATE_LIMITED);
+        }
+    }
+
+    private void sendLink(String email, String link) {
+        JavaMailSender mailSender = mailSenderProvider.getIfAvailable();
+        if (mailSender == null) {
+            if (logLinks) {
+                log.info("SMTP not configured, magic link for {}: {}", email, link);
+            } else {
+                log.warn("SMTP not configured (spring.mail.host), magic link not sent");
+            }
+            return;
+        }
+
+        SimpleMailMessage message = new SimpleMailMessage();
+        message.setFrom(mailFrom);
+        message.setTo(email);
+        message.setSubject("[weAlist] 로그인 링크");
+        message.setText("아래 링크를 눌러 weAlist에 로그인하세요. 링크는 "
+                + tokenTtl.toMinutes() + "분 동안 한 번만 사용할 수 있으며, 요청한 기기와 브라우저에서만 열 수 있습니다.\n\n"
+                + link + "\n\n"
+                + "로그인을 요청하지 않았다면 이 메일을 무시하세요.");
+        mailSender.send(message);
+    }
+
+    private static String sha256Hex(String value) {
+        if (!StringUtils.hasText(value)) {
+            return "";
+        }
+        try {
+            MessageDigest digest = MessageDigest.getInstance("SHA-256");
+            return HexFormat.of().formatHex(digest.digest(value.getBytes(StandardCharsets.UTF_8)));
+        } catch (NoSuchAlgorithmException e) {
+            throw new IllegalStateException("SHA-256 not available", e);
+        }
+    }
+}
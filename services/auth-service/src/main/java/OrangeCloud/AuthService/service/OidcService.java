@@ -0,0 +1,400 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.OidcAuthorizationRequest;
+import OrangeCloud.AuthService.dto.OidcClientRequest;
+import OrangeCloud.AuthService.dto.OidcClientResponse;
+import OrangeCloud.AuthService.dto.OidcTokenResponse;
+import OrangeCloud.AuthService.dto.UserSummary;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import io.jsonwebtoken.Claims;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+import org.springframework.web.util.UriComponentsBuilder;
+
+import java.net.URI;
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.security.NoSuchAlgorithmException;
+import java.security.SecureRandom;
+import java.time.Duration;
+import java.time.Instant;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Base64;
+import java.util.HexFormat;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import java.util.UUID;
+
+/**
+ * OIDC provider (authorization code + PKCE)
+ *
+ * ops-portal 같은 내부 도구와 외부 연동(RP)이 weAlist 계정으로 로그인할 수 있게 한다.
+ * 1. RP가 /api/auth/oidc/authorize로 보내면 요청을 Redis에 저장하고 프론트엔드 로그인 화면(login-url)으로 보낸다.
+ * 2. 로그인한 사용자가 요청을 승인하면 1회용 인가 코드를 발급해 RP의 redirect_uri로 돌려보낸다.
+ * 3. RP는 코드와 code_verifier로 ID token과 userinfo 조회용 access token을 받는다.
+ *
+ * - PKCE(S256)는 모든 클라이언트에 필수이고, confidential 클라이언트는 secret도 확인한다.
+ * - 발급하는 토큰의 issuer는 OIDC issuer(URL)라 플랫폼 API access token으로는 쓸 수 없다.
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class OidcService {
+
+    public static final String GRANT_TYPE_AUTHORIZATION_CODE = "authorization_code";
+    public static final List<String> SUPPORTED_SCOPES = List.of("openid", "email", "profile");
+
+    private static final String CLIENT_KEY_PREFIX = "wealist:auth:oidc:clients:";
+    private static final String CLIENT_INDEX_KEY = "wealist:auth:oidc:clients";
+    private static final String REQUEST_KEY_PREFIX = "wealist:auth:oidc:request:";
+    private static final String CODE_KEY_PREFIX = "wealist:auth:oidc:code:";
+    private static final String CODE_CHALLENGE_METHOD_S256 = "S256";
+    private static final int SECRET_BYTES = 32;
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+    private final JwtTokenProvider jwtTokenProvider;
+    private final UserServiceClient userServiceClient;
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    @Value("${oidc.login-url:http://localhost:3000/auth/authorize}")
+    private String loginUrl;
+
+    @Value("${oidc.request-ttl:10m}")
+    private Duration requestTtl;
+
+    @Value("${oidc.code-ttl:1m}")
+    private Duration codeTtl;
+
+    @Value("${oidc.token-ttl:30m}")
+    private Duration tokenTtl;
+
+    /**
+     * 저장되는 클라이언트 정보 (secret은 SHA-256 해시, 공개 클라이언트는 null)
+     */
+    private record StoredClient(String clientId, String name, List<String> redirectUris, String secretHash,
+                                Instant createdAt) {
+    }
+
+    /**
+     * 사용자 승인을 기다리는 인가 요청
+     */
+    private record PendingAuthorization(String clientId, String redirectUri, List<String> scopes, String state,
+                                        String nonce, String codeChallenge) {
+    }
+
+    /**
+     * 발급된 인가 코드 (code-ttl 동안 1회 교환 가능)
+     */
+    private record AuthorizationCode(String clientId, String redirectUri, UUID userId, List<String> scopes,
+                                     String nonce, String codeChallenge) {
+    }
+
+    /**
+     * 클라이언트 등록 - confidential 클라이언트의 secret은 응답에서 한 번만 반환
+     */
+    public OidcClientResponse registerClient(OidcClientRequest request) {
+        String secret = request.isConfidential() ? generateToken() : null;
+        StoredClient client = new StoredClient(request.getClientId(), request.getName(),
+                List.copyOf(request.getRedirectUris()), secret != null ? sha256Hex(secret) : null, Instant.now());
+
+        Boolean created = redisTemplate.opsForValue().setIfAbsent(CLIENT_KEY_PREFIX + client.clientId(), toJson(client));
+        if (!Boolean.TRUE.equals(created)) {
+            throw new CustomJwtException(ErrorCode.CLIENT_ALREADY_EXISTS);
+        }
+        redisTemplate.opsForSet().add(CLIENT_INDEX_KEY, client.clientId());
+
+        log.info("OIDC client registered: clientId={}, confidential={}", client.clientId(), secret != null);
+        return toResponse(client, secret);
+    }
+
+    /**
+     * 등록된 클라이언트 목록 (secret 제외)
+     */
+    public List<OidcClientResponse> listClients() {
+        Set<Object> clientIds = redisTemplate.opsForSet().members(CLIENT_INDEX_KEY);
+        List<OidcClientResponse> clients = new ArrayList<>();
+        if (clientIds == null) {
+            return clients;
+        }
+        for (Object clientId : clientIds) {
+            StoredClient client = findClient(clientId.toString());
+            if (client != null) {
+                clients.add(toResponse(client, null));
+            }
+        }
+        return clients;
+    }
+
+    /**
+     * 클라이언트 삭제 - 이미 발급된 토큰은 만료(token-ttl)까지 유효
+     */
+    public void deleteClient(String clientId) {
+        if (!Boolean.TRUE.equals(redisTemplate.delete(CLIENT_KEY_PREFIX + clientId))) {
+            throw new CustomJwtException(ErrorCode.CLIENT_NOT_FOUND);
+        }
+        redisTemplate.opsForSet().remove(CLIENT_INDEX_KEY, clientId);
+        log.info("OIDC client deleted: clientId={}", clientId);
+    }
+
+    /**
+     * 인가 요청 시작 (OIDC Core 3.1.2.1)
+     *
+     * client_id나 redirect_uri가 잘못되면 RP로 돌려보내지 않고 예외를 던진다.
+     * 그 밖의 요청 오류는 redirect_uri에 error를 붙여 돌려보낸다 (RFC 6749 4.1.2.1).
+     *
+     * @return 브라우저를 보낼 URI (프론트엔드 로그인 화면 또는 RP의 오류 redirect)
+     */
+    public URI authorize(String responseType, String clientId, String redirectUri, String scope, String state,
+                         String nonce, String codeChallenge, String codeChallengeMethod) {
+        StoredClient client = StringUtils.hasText(clientId) ? findClient(clientId) : null;
+        if (client == null) {
+            throw new CustomJwtException(ErrorCode.CLIENT_NOT_FOUND);
+        }
+        if (redirectUri == null || !client.redirectUris().contains(redirectUri)) {
+            log.warn("OIDC authorize with unregistered redirect_uri: clientId={}, redirectUri={}", clientId, redirectUri);
+            throw new CustomJwtException(ErrorCode.INVALID_REDIRECT_URI);
+        }
+
+        if (!"code".equals(responseType)) {
+            return errorRedirect(redirectUri, "unsupported_response_type", state);
+        }
+        List<String> scopes = StringUtils.hasText(scope)
+                ? Arrays.stream(scope.trim().split("\\s+")).distinct().filter(SUPPORTED_SCOPES::contains).toList()
+                : List.of();
+        if (!scopes.contains("openid")) {
+            return errorRedirect(redirectUri, "invalid_scope", state);
+        }
+        if (!StringUtils.hasText(codeChallenge) || !CODE_CHALLENGE_METHOD_S256.equals(codeChallengeMethod)) {
+            return errorRedirect(redirectUri, "invalid_request", state);
+        }
+
+        String requestId = generateToken();
+        PendingAuthorization pending = new PendingAuthorization(clientId, redirectUri, scopes, state, nonce,
+                codeChallenge);
+        redisTemplate.opsForValue().set(REQUEST_KEY_PREFIX + requestId, toJson(pending), requestTtl);
+
+        log.debug("OIDC authorization requested: clientId={}, scopes={}", clientId, scopes);
+        return UriComponentsBuilder.fromUriString(loginUrl)
+                .queryParam("request", requestId)
+                .build()
+                .encode()
+                .toUri();
+    }
+
+    /**
+     * 진행 중인 인가 요청 조회 (로그인/동의 화면 표시용)
+     */
+    public OidcAuthorizationRequest getAuthorizationRequest(String requestId) {
+        PendingAuthorization pending = read(redisTemplate.opsForValue().get(REQUEST_KEY_PREFIX + requestId),
+                PendingAuthorization.class);
+        if (pending == null) {
+            throw new CustomJwtException(ErrorCode.OIDC_REQUEST_INVALID);
+        }
+        StoredClient client = findClient(pending.clientId());
+        if (client == null) {
+            throw new CustomJwtException(ErrorCode.CLIENT_NOT_FOUND);
+        }
+        return new OidcAuthorizationRequest(requestId, client.clientId(), client.name(), pending.scopes());
+    }
+
+    /**
+     * 인가 요청 승인 - 1회용 인가 코드를 발급해 RP redirect URI를 반환
+     */
+    public String approve(String requestId, UUID userId) {
+        PendingAuthorization pending = takeRequest(requestId);
+
+        String code = generateToken();
+        AuthorizationCode authorizationCode = new AuthorizationCode(pending.clientId(), pending.redirectUri(), userId,
+                pending.scopes(), pending.nonce(), pending.codeChallenge());
+        redisTemplate.opsForValue().set(CODE_KEY_PREFIX + code, toJson(authorizationCode), codeTtl);
+
+        log.info("OIDC authorization approved: clientId={}, userId={}", pending.clientId(), userId);
+        UriComponentsBuilder redirect = UriComponentsBuilder.fromUriString(pending.redirectUri())
+                .queryParam("code", code);
+        if (pending.state() != null) {
+            redirect.queryParam("state", pending.state());
+        }
+        return redirect.build().encode().toUriString();
+    }
+
+    /**
+     * 인가 요청 거부 - access_denied 오류와 함께 RP redirect URI를 반환
+     */
+    public String deny(String requestId, UUID userId) {
+        PendingAuthorization pending = takeRequest(requestId);
+        log.info("OIDC authorization denied: clientId={}, userId={}", pending.clientId(), userId);
+        return errorRedirect(pending.redirectUri(), "access_denied", pending.state()).toString();
+    }
+
+    /**
+     * 인가 코드 교환 (OIDC Core 3.1.3)
+     */
+    public OidcTokenResponse exchangeCode(String grantType, String code, String redirectUri, String clientId,
+                                          String clientSecret, String codeVerifier) {
+        if (!GRANT_TYPE_AUTHORIZATION_CODE.equals(grantType)) {
+            throw new CustomJwtException(ErrorCode.UNSUPPORTED_GRANT_TYPE);
+        }
+
+        StoredClient client = StringUtils.hasText(clientId) ? findClient(clientId) : null;
+        if (client == null || (client.secretHash() != null && !secretMatches(client, clientSecret))) {
+            log.warn("OIDC client authentication failed: clientId={}", clientId);
+            throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+        }
+
+        // 코드는 검증 결과와 관계없이 한 번만 사용할 수 있다
+        AuthorizationCode authorizationCode = StringUtils.hasText(code)
+                ? read(redisTemplate.opsForValue().getAndDelete(CODE_KEY_PREFIX + code), AuthorizationCode.class)
+                : null;
+        if (authorizationCode == null
+                || !authorizationCode.clientId().equals(clientId)
+                || !authorizationCode.redirectUri().equals(redirectUri)
+                || !pkceMatches(authorizationCode.codeChallenge(), codeVerifier)) {
+            log.warn("OIDC authorization code rejected: clientId={}", clientId);
+            throw new CustomJwtException(ErrorCode.INVALID_GRANT);
+        }
+
+        UserSummary user = userServiceClient.getUser(authorizationCode.userId());
+        if (user == null || !user.isActive()) {
+            throw new CustomJwtException(ErrorCode.INVALID_GRANT);
+        }
+
+        List<String> scopes = authorizationCode.scopes();
+        String idToken = jwtTokenProvider.generateIdToken(user.getUserId(), clientId, authorizationCode.nonce(),
+                userClaims(user, scopes), tokenTtl.toMillis());
+        String accessToken = jwtTokenProvider.generateOidcAccessToken(user.getUserId(), clientId, scopes,
+                tokenTtl.toMillis());
+
+        log.debug("OIDC tokens issued: clientId={}, userId={}", clientId, user.getUserId());
+        return new OidcTokenResponse(accessToken, "Bearer", tokenTtl.toSeconds(), idToken, String.join(" ", scopes));
+    }
+
+    /**
+     * userinfo 응답 (OIDC Core 5.3) - access token의 scope에 따른 클레임
+     */
+    public Map<String, Object> userInfo(String accessToken) {
+        Claims claims = jwtTokenProvider.parseOidcAccessToken(accessToken);
+        UserSummary user = userServiceClient.getUser(UUID.fromString(claims.getSubject()));
+        if (user == null || !user.isActive()) {
+            throw new CustomJwtException(ErrorCode.USER_NOT_FOUND);
+        }
+
+        String scope = claims.get("scope", String.class);
+        List<String> scopes = scope != null ? List.of(scope.split(" ")) : List.of();
+        Map<String, Object> userInfo = new LinkedHashMap<>();
+        userInfo.put("sub", user.getUserId().toString());
+        userInfo.putAll(userClaims(user, scopes));
+        return userInfo;
+    }
+
+    /**
+     * scope별 사용자 클레임 (email: email/email_verified, profile: name)
+     * 로그인은 검증된 이메일로만 가능하므로 email_verified는 항상 true
+     */
+    private static Map<String, Object> userClaims(UserSummary user, List<String> scopes) {
+        Map<String, Object> claims = new LinkedHashMap<>();
+        if (scopes.contains("email") && user.getEmail() != null) {
+            claims.put("email", user.getEmail());
+            claims.put("email_verified", true);
+        }
+        if (scopes.contains("profile") && user.getName() != null) {
+            claims.put("name", user.getName());
+        }
+        return claims;
+    }
+
+    private PendingAuthorization takeRequest(String requestId) {
+        PendingAuthorization pending = read(redisTemplate.opsForValue().getAndDelete(REQUEST_KEY_PREFIX + requestId),
+                PendingAuthorization.class);
+        if (pending == null) {
+            throw new CustomJwtException(ErrorCode.OIDC_REQUEST_INVALID);
+        }
+        return pending;
+    }
+
+    private static URI errorRedirect(String redirectUri, String error, String state) {
+        UriComponentsBuilder redirect = UriComponentsBuilder.fromUriString(redirectUri)
+                .queryParam("error", error);
+        if (state != null) {
+            redirect.queryParam("state", state);
+        }
+        return redirect.build().encode().toUri();
+    }
+
+    /**
+     * PKCE 확인 (RFC 7636 4.6) - BASE64URL(SHA256(code_verifier)) == code_challenge
+     */
+    private static boolean pkceMatches(String codeChallenge, String codeVerifier) {
+        if (!StringUtils.hasText(codeVerifier) || !codeVerifier.matches("^[A-Za-z0-9._~-]{43,128}$")) {
+            return false;
+        }
+        String computed = Base64.getUrlEncoder().withoutPadding().encodeToString(sha256(codeVerifier));
+        return MessageDigest.isEqual(computed.getBytes(StandardCharsets.US_ASCII),
+                codeChallenge.getBytes(StandardCharsets.US_ASCII));
+    }
+
+    private boolean secretMatches(StoredClient client, String secret) {
+        return StringUtils.hasText(secret) && MessageDigest.isEqual(
+                sha256Hex(secret).getBytes(StandardCharsets.UTF_8),
+                client.secretHash().getBytes(StandardCharsets.UTF_8));
+    }
+
+    private StoredClient findClient(String clientId) {
+        return read(redisTemplate.opsForValue().get(CLIENT_KEY_PREFIX + clientId), StoredClient.class);
+    }
+
+    private <T> T read(Object value, Class<T> type) {
+        if (value == null) {
+            return null;
+        }
+        try {
+            return objectMapper.readValue(value.toString(), type);
+        } catch (JsonProcessingException e) {
+            log.error("Failed to read OIDC {}: {}", type.getSimpleName(), e.getMessage());
+            return null;
+        }
+    }
+
+    private String toJson(Object value) {
+        try {
+            return objectMapper.writeValueAsString(value);
+        } catch (JsonProcessingException e) {
+            throw new IllegalStateException("Failed to serialize OIDC " + value.getClass().getSimpleName(), e);
+        }
+    }
+
+    private static OidcClientResponse toResponse(StoredClient client, String secret) {
+        return new OidcClientResponse(client.clientId(), client.name(), client.redirectUris(),
+                client.secretHash() != null, secret, client.createdAt());
+    }
+
+    private String generateToken() {
+        byte[] bytes = new byte[SECRET_BYTES];
+        secureRandom.nextBytes(bytes);
+        return Base64.getUrlEncoder().withoutPadding().encodeToString(bytes);
+    }
+
+    private static byte[] sha256(String value) {
+        try {
+            return MessageDigest.getInstance("SHA-256").digest(value.getBytes(StandardCharsets.UTF_8));
+        } catch (NoSuchAlgorithmException e) {
+            throw new IllegalStateException("SHA-256 not available", e);
+        }
+    }
+
+    private static String sha256Hex(String value) {
+        return HexFormat.of().formatHex(sha256(value));
+    }
+}
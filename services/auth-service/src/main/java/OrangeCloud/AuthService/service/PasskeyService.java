@@ -0,0 +1,397 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import OrangeCloud.AuthService.dto.PasskeyCredential;
+import OrangeCloud.AuthService.dto.PasskeyRecord;
+import OrangeCloud.AuthService.dto.UserPasskeys;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.webauthn4j.WebAuthnManager;
+import com.webauthn4j.converter.AttestedCredentialDataConverter;
+import com.webauthn4j.converter.exception.DataConversionException;
+import com.webauthn4j.converter.util.ObjectConverter;
+import com.webauthn4j.credential.CredentialRecord;
+import com.webauthn4j.credential.CredentialRecordImpl;
+import com.webauthn4j.data.*;
+import com.webauthn4j.data.attestation.authenticator.AttestedCredentialData;
+import com.webauthn4j.data.attestation.statement.COSEAlgorithmIdentifier;
+import com.webauthn4j.data.attestation.statement.NoneAttestationStatement;
+import com.webauthn4j.data.client.Origin;
+import com.webauthn4j.data.client.challenge.Challenge;
+import com.webauthn4j.data.client.challenge.DefaultChallenge;
+import com.webauthn4j.data.extension.authenticator.AuthenticationExtensionsAuthenticatorOutputs;
+import com.webauthn4j.server.ServerProperty;
+import com.webauthn4j.verifier.exception.VerificationException;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+
+import java.nio.ByteBuffer;
+import java.security.SecureRandom;
+import java.time.Duration;
+import java.util.*;
+
+/**
+ * 패스키(WebAuthn) 등록/인증 ceremony
+ *
+ * - challenge는 Redis에 ceremonyId로 저장하고 완료 요청에서 한 번만 꺼내 쓴다.
+ * - 검증된 자격 증명(AttestedCredentialData 직렬화 값)과 서명 카운터는 user-service에 저장한다.
+ * - 패스키 단독 로그인은 discoverable credential + user verification 필수,
+ *   MFA 단계(mfaToken)는 1차 로그인한 사용자의 패스키만 허용한다.
+ * - 옵션/응답 형식은 WebAuthn Level 3 JSON (PublicKeyCredential.parseCreationOptionsFromJSON, toJSON)
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class PasskeyService {
+
+    private static final String CEREMONY_KEY_PREFIX = "wealist:auth:webauthn:ceremony:";
+    private static final String TYPE_REGISTRATION = "registration";
+    private static final String TYPE_AUTHENTICATION = "authentication";
+
+    private static final List<PublicKeyCredentialParameters> PUB_KEY_CRED_PARAMS = List.of(
+            new PublicKeyCredentialParameters(PublicKeyCredentialType.PUBLIC_KEY, COSEAlgorithmIdentifier.ES256),
+            new PublicKeyCredentialParameters(PublicKeyCredentialType.PUBLIC_KEY, COSEAlgorithmIdentifier.EdDSA),
+            new PublicKeyCredentialParameters(PublicKeyCredentialType.PUBLIC_KEY, COSEAlgorithmIdentifier.RS256)
+    );
+
+    private final AuthService authService;
+    private final LoginAttemptService loginAttemptService;
+    private final AuthAuditService auditService;
+    private final UserServiceClient userServiceClient;
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+    private final WebAuthnManager webAuthnManager = WebAuthnManager.createNonStrictWebAuthnManager();
+    private final AttestedCredentialDataConverter credentialDataConverter =
+            new AttestedCredentialDataConverter(new ObjectConverter());
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    @Value("${webauthn.rp-id:localhost}")
+    private String rpId;
+
+    @Value("${webauthn.rp-name:weAlist}")
+    private String rpName;
+
+    @Value("${webauthn.origins:http://localhost:3000}")
+    private List<String> origins;
+
+    @Value("${webauthn.ceremony-ttl:5m}")
+    private Duration ceremonyTtl;
+
+    // ============================================================================
+    // 등록
+    // ============================================================================
+
+    /**
+     * 등록 옵션 생성 (PublicKeyCredentialCreationOptions JSON)
+     * 이미 등록된 패스키는 excludeCredentials로 전달해 같은 인증기에 중복 등록을 막는다.
+     */
+    public Map<String, Object> registrationOptions(UUID userId, String email) {
+        byte[] challenge = randomBytes();
+        String ceremonyId = saveCeremony(TYPE_REGISTRATION, challenge, userId, null);
+
+        UserPasskeys passkeys = userServiceClient.getUserPasskeys(userId);
+        String userName = StringUtils.hasText(email) ? email : userId.toString();
+
+        Map<String, Object> publicKey = new LinkedHashMap<>();
+        publicKey.put("rp", Map.of("id", rpId, "name", rpName));
+        publicKey.put("user", Map.of(
+                "id", encode(userHandle(userId)),
+                "name", userName,
+                "displayName", userName));
+        publicKey.put("challenge", encode(challenge));
+        publicKey.put("pubKeyCredParams", PUB_KEY_CRED_PARAMS.stream()
+                .map(p -> Map.of("type", "public-key", "alg", p.getAlg().getValue()))
+                .toList());
+        publicKey.put("timeout", ceremonyTtl.toMillis());
+        publicKey.put("excludeCredentials", credentialDescriptors(passkeys.getCredentials()));
+        publicKey.put("authenticatorSelection", Map.of(
+                "residentKey", "preferred",
+                "userVerification", "preferred"));
+        publicKey.put("attestation", "none");
+
+        return Map.of("ceremonyId", ceremonyId, "publicKey", publicKey);
+    }
+
+    /**
+     * attestation 검증 후 패스키 저장
+     */
+    public void register(UUID userId, String ceremonyId, String name, PasskeyCredential credential) {
+        Ceremony ceremony = takeCeremony(ceremonyId, TYPE_REGISTRATION);
+        if (!userId.toString().equals(ceremony.userId())) {
+            throw new CustomJwtException(ErrorCode.PASSKEY_CEREMONY_INVALID);
+        }
+
+        PasskeyCredential.Response response = credential.getResponse();
+        if (response.getAttestationObject() == null) {
+            throw new CustomJwtException(ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        Set<String> transports = response.getTransports() != null
+                ? new HashSet<>(response.getTransports()) : Set.of();
+        RegistrationRequest registrationRequest = new RegistrationRequest(
+                decode(response.getAttestationObject()),
+                decode(response.getClientDataJSON()),
+                null,
+                transports);
+        RegistrationParameters registrationParameters = new RegistrationParameters(
+                serverProperty(ceremony.challenge()),
+                PUB_KEY_CRED_PARAMS,
+                false,
+                true);
+
+        RegistrationData registrationData;
+        try {
+            registrationData = webAuthnManager.parse(registrationRequest);
+            webAuthnManager.verify(registrationData, registrationParameters);
+        } catch (VerificationException | DataConversionException e) {
+            log.warn("Passkey registration verification failed: userId={}, error={}", userId, e.getMessage());
+            throw new CustomJwtException(ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        var authenticatorData = registrationData.getAttestationObject().getAuthenticatorData();
+        AttestedCredentialData credentialData = authenticatorData.getAttestedCredentialData();
+
+        userServiceClient.createPasskey(
+                userId,
+                encode(credentialData.getCredentialId()),
+                encode(credentialDataConverter.convert(credentialData)),
+                authenticatorData.getSignCount(),
+                new ArrayList<>(transports),
+                credentialData.getAaguid().toString(),
+                name);
+    }
+
+    // ============================================================================
+    // 인증
+    // ============================================================================
+
+    /**
+     * 인증 옵션 생성 (PublicKeyCredentialRequestOptions JSON)
+     *
+     * @param mfaToken null이면 패스키 단독 로그인 (allowCredentials 없이 discoverable credential 사용)
+     */
+    public Map<String, Object> authenticationOptions(String mfaToken) {
+        byte[] challenge = randomBytes();
+
+        Map<String, Object> publicKey = new LinkedHashMap<>();
+        publicKey.put("challenge", encode(challenge));
+        publicKey.put("rpId", rpId);
+        publicKey.put("timeout", ceremonyTtl.toMillis());
+
+        String ceremonyId;
+        if (StringUtils.hasText(mfaToken)) {
+            UUID userId = authService.getMfaPendingUserId(mfaToken);
+            ceremonyId = saveCeremony(TYPE_AUTHENTICATION, challenge, userId, mfaToken);
+            publicKey.put("allowCredentials",
+                    credentialDescriptors(userServiceClient.getUserPasskeys(userId).getCredentials()));
+            publicKey.put("userVerification", "preferred");
+        } else {
+            ceremonyId = saveCeremony(TYPE_AUTHENTICATION, challenge, null, null);
+            publicKey.put("allowCredentials", List.of());
+            publicKey.put("userVerification", "required");
+        }
+
+        return Map.of("ceremonyId", ceremonyId, "publicKey", publicKey);
+    }
+
+    /**
+     * assertion 검증 후 토큰 발급
+     * 패스키 단독 로그인은 일반 로그인과 같은 토큰 쌍, MFA 단계는 대기 중인 로그인을 완료한다.
+     * 검증 실패는 로그인 실패로 집계한다 (LoginAttemptService).
+     *
+     * @param captchaToken 실패가 쌓였을 때 요구되는 CAPTCHA 토큰 (없으면 null)
+     */
+    public AuthResponse authenticate(String ceremonyId, PasskeyCredential credential,
+                                     LoginContext context, String captchaToken) {
+        Ceremony ceremony = takeCeremony(ceremonyId, TYPE_AUTHENTICATION);
+        boolean mfa = ceremony.mfaToken() != null;
+
+        // 집계 대상 계정: MFA 단계는 1차 로그인한 사용자, 단독 로그인은 등록된 패스키의 소유자
+        PasskeyRecord passkey = userServiceClient.getPasskey(credential.getId());
+        UUID accountId = mfa ? UUID.fromString(ceremony.userId())
+                : passkey != null ? passkey.getUserId() : null;
+        loginAttemptService.checkAllowed(accountId, context, captchaToken);
+
+        if (passkey == null) {
+            log.warn("Unknown passkey used for login");
+            throw loginFailure(accountId, context, ErrorCode.PASSKEY_NOT_FOUND);
+        }
+        if (mfa && !passkey.getUserId().equals(accountId)) {
+            log.warn("Passkey of another user used for MFA: userId={}", accountId);
+            throw loginFailure(accountId, context, ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        PasskeyCredential.Response response = credential.getResponse();
+        if (response.getAuthenticatorData() == null || response.getSignature() == null) {
+            throw loginFailure(accountId, context, ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        // discoverable credential은 userHandle을 반환하므로 저장된 소유자와 일치해야 함
+        if (StringUtils.hasText(response.getUserHandle())
+                && !Arrays.equals(decode(response.getUserHandle()), userHandle(passkey.getUserId()))) {
+            log.warn("Passkey user handle mismatch: userId={}", passkey.getUserId());
+            throw loginFailure(accountId, context, ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        AuthenticationRequest authenticationRequest = new AuthenticationRequest(
+                decode(credential.getId()),
+                StringUtils.hasText(response.getUserHandle()) ? decode(response.getUserHandle()) : null,
+                decode(response.getAuthenticatorData()),
+                decode(response.getClientDataJSON()),
+                null,
+                decode(response.getSignature()));
+        AuthenticationParameters authenticationParameters = new AuthenticationParameters(
+                serverProperty(ceremony.challenge()),
+                credentialRecord(passkey),
+                List.of(decode(passkey.getCredentialId())),
+                !mfa,
+                true);
+
+        AuthenticationData authenticationData;
+        try {
+            authenticationData = webAuthnManager.parse(authenticationRequest);
+            webAuthnManager.verify(authenticationData, authenticationParameters);
+        } catch (VerificationException | DataConversionException e) {
+            // 서명 카운터 역행(복제된 인증기 의심)도 여기서 거부됨
+            log.warn("Passkey assertion verification failed: userId={}, error={}", passkey.getUserId(), e.getMessage());
+            throw loginFailure(accountId, context, ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+
+        userServiceClient.recordPasskeyUse(passkey.getCredentialId(),
+                authenticationData.getAuthenticatorData().getSignCount());
+
+        log.info("Passkey authentication successful: userId={}, mfa={}", passkey.getUserId(), mfa);
+        if (mfa) {
+            return authService.completeMfa(ceremony.mfaToken(), passkey.getUserId(), LoginMethod.PASSKEY, context);
+        }
+        // 사용자 확인(userVerification)을 거친 패스키 로그인은 그 자체로 다중 인증으로 본다
+        return authService.issueLoginTokens(passkey.getUserId(), passkey.getEmail(), LoginMethod.PASSKEY, context, true);
+    }
+
+    // ============================================================================
+    // 내부 유틸
+    // ============================================================================
+
+    /**
+     * ceremony 상태 (challenge는 base64url)
+     */
+    private record Ceremony(String type, String challenge, String userId, String mfaToken) {
+    }
+
+    private String saveCeremony(String type, byte[] challenge, UUID userId, String mfaToken) {
+        String ceremonyId = UUID.randomUUID().toString();
+        Ceremony ceremony = new Ceremony(type, encode(challenge),
+                userId != null ? userId.toString() : null, mfaToken);
+        try {
+            redisTemplate.opsForValue().set(CEREMONY_KEY_PREFIX + ceremonyId,
+                    objectMapper.writeValueAsString(ceremony), ceremonyTtl);
+        } catch (JsonProcessingException e) {
+            throw new IllegalStateException("Failed to serialize WebAuthn ceremony", e);
+        }
+        return ceremonyId;
+    }
+
+    /**
+     * ceremony를 꺼내면서 삭제 (challenge 재사용 방지)
+     */
+    private Ceremony takeCeremony(String ceremonyId, String expectedType) {
+        Object value = redisTemplate.opsForValue().getAndDelete(CEREMONY_KEY_PREFIX + ceremonyId);
+        if (value == null) {
+            throw new CustomJwtException(ErrorCode.PASSKEY_CEREMONY_INVALID);
+        }
+        try {
+            Ceremony ceremony = objectMapper.readValue(value.toString(), Ceremony.class);
+            if (!expectedType.equals(ceremony.type())) {
+                throw new CustomJwtException(ErrorCode.PASSKEY_CEREMONY_INVALID);
+            }
+            return ceremony;
+        } catch (JsonProcessingException e) {
+            throw new CustomJwtException(ErrorCode.PASSKEY_CEREMONY_INVALID);
+        }
+    }
+
+    /**
+     * 로그인 실패를 기록하고 던질 예외 반환
+     */
+    private CustomJwtException loginFailure(UUID accountId, LoginContext context, ErrorCode errorCode) {
+        loginAttemptService.recordFailure(accountId, context);
+        auditService.loginFailed(accountId, LoginMethod.PASSKEY, errorCode.getCode(), context);
+        return new CustomJwtException(errorCode);
+    }
+
+    private ServerProperty serverProperty(String challenge) {
+        Set<Origin> allowedOrigins = new HashSet<>();
+        origins.forEach(origin -> allowedOrigins.add(new Origin(origin.trim())));
+        Challenge decoded = new DefaultChallenge(decode(challenge));
+        return new ServerProperty(allowedOrigins, rpId, decoded, null);
+    }
+
+    private CredentialRecord credentialRecord(PasskeyRecord passkey) {
+        AttestedCredentialData credentialData = credentialDataConverter.convert(decode(passkey.getPublicKey()));
+        return new CredentialRecordImpl(
+                new NoneAttestationStatement(),
+                null,
+                null,
+                null,
+                passkey.getSignCount(),
+                credentialData,
+                new AuthenticationExtensionsAuthenticatorOutputs<>(),
+                null,
+                null,
+                null);
+    }
+
+    private List<Map<String, Object>> credentialDescriptors(List<PasskeyRecord> credentials) {
+        if (credentials == null) {
+            return List.of();
+        }
+        return credentials.stream()
+                .map(c -> {
+                    Map<String, Object> descriptor = new LinkedHashMap<>();
+                    descriptor.put("type", "public-key");
+                    descriptor.put("id", c.getCredentialId());
+                    if (c.getTransports() != null && !c.getTransports().isEmpty()) {
+                        descriptor.put("transports", c.getTransports());
+                    }
+                    return descriptor;
+                })
+                .toList();
+    }
+
+    /**
+     * WebAuthn user.id - 사용자 UUID 16바이트 (이메일 등 개인정보를 인증기에 남기지 않음)
+     */
+    private static byte[] userHandle(UUID userId) {
+        return ByteBuffer.allocate(16)
+                .putLong(userId.getMostSignificantBits())
+                .putLong(userId.getLeastSignificantBits())
+                .array();
+    }
+
+    private byte[] randomBytes() {
+        byte[] bytes = new byte[32];
+        secureRandom.nextBytes(bytes);
+        return bytes;
+    }
+
+    private static String encode(byte[] bytes) {
+        return Base64.getUrlEncoder().withoutPadding().encodeToString(bytes);
+    }
+
+    private static byte[] decode(String value) {
+        try {
+            return Base64.getUrlDecoder().decode(value);
+        } catch (IllegalArgumentException e) {
+            throw new CustomJwtException(ErrorCode.PASSKEY_VERIFICATION_FAILED);
+        }
+    }
+}
@@ -0,0 +1,237 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.dto.ServiceClientRequest;
+import OrangeCloud.AuthService.dto.ServiceClientResponse;
+import OrangeCloud.AuthService.dto.ServiceTokenResponse;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.security.NoSuchAlgorithmException;
+import java.security.SecureRandom;
+import java.time.Duration;
+import java.time.Instant;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Base64;
+import java.util.HexFormat;
+import java.util.List;
+import java.util.Set;
+
+/**
+ * 서비스 간 호출용 클라이언트 관리와 토큰 발급 (OAuth2 client-credentials)
+ *
+ * - 클라이언트(clientId, 허용 scope, secret 해시)는 Redis에 저장한다 (secret 원문은 저장하지 않음).
+ * - 토큰은 요청한 scope가 등록된 scope의 부분집합일 때만 짧은 수명으로 발급한다.
+ * - secret을 재발급하면 이전 secret도 grace period 동안 허용해 배포 중인 서비스가 끊기지 않게 한다.
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class ServiceClientService {
+
+    public static final String GRANT_TYPE_CLIENT_CREDENTIALS = "client_credentials";
+
+    private static final String CLIENT_KEY_PREFIX = "wealist:auth:clients:";
+    private static final String CLIENT_INDEX_KEY = "wealist:auth:clients";
+    private static final int SECRET_BYTES = 32;
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+    private final JwtTokenProvider jwtTokenProvider;
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    @Value("${service-auth.token-ttl:10m}")
+    private Duration tokenTtl;
+
+    @Value("${service-auth.rotation-grace-period:24h}")
+    private Duration rotationGracePeriod;
+
+    /**
+     * 저장되는 클라이언트 정보 (secret은 SHA-256 해시)
+     */
+    private record StoredClient(String clientId, List<String> scopes, String secretHash,
+                                String previousSecretHash, Instant previousSecretExpiresAt,
+                                Instant createdAt, Instant secretRotatedAt) {
+    }
+
+    /**
+     * 클라이언트 등록 - 생성된 secret은 응답에서 한 번만 반환
+     */
+    public ServiceClientResponse register(ServiceClientRequest request) {
+        String secret = generateSecret();
+        Instant now = Instant.now();
+        StoredClient client = new StoredClient(request.getClientId(), List.copyOf(request.getScopes()),
+                sha256Hex(secret), null, null, now, now);
+
+        Boolean created = redisTemplate.opsForValue().setIfAbsent(CLIENT_KEY_PREFIX + client.clientId(), toJson(client));
+        if (!Boolean.TRUE.equals(created)) {
+            throw new CustomJwtException(ErrorCode.CLIENT_ALREADY_EXISTS);
+        }
+        redisTemplate.opsForSet().add(CLIENT_INDEX_KEY, client.clientId());
+
+        log.info("Service client registered: clientId={}, scopes={}", client.clientId(), client.scopes());
+        return toResponse(client, secret);
+    }
+
+    /**
+     * 등록된 클라이언트 목록 (secret 제외)
+     */
+    public List<ServiceClientResponse> list() {
+        Set<Object> clientIds = redisTemplate.opsForSet().members(CLIENT_INDEX_KEY);
+        List<ServiceClientResponse> clients = new ArrayList<>();
+        if (clientIds == null) {
+            return clients;
+        }
+        for (Object clientId : clientIds) {
+            StoredClient client = find(clientId.toString());
+            if (client != null) {
+                clients.add(toResponse(client, null));
+            }
+        }
+        return clients;
+    }
+
+    /**
+     * 클라이언트 삭제 - 이미 발급된 토큰은 만료(token-ttl)까지 유효
+     */
+    public void delete(String clientId) {
+        if (!Boolean.TRUE.equals(redisTemplate.delete(CLIENT_KEY_PREFIX + clientId))) {
+            throw new CustomJwtException(ErrorCode.CLIENT_NOT_FOUND);
+        }
+        redisTemplate.opsForSet().remove(CLIENT_INDEX_KEY, clientId);
+        log.info("Service client deleted: clientId={}", clientId);
+    }
+
+    /**
+     * secret 재발급 - 이전 secret은 rotation-grace-period 동안 계속 허용
+     */
+    public ServiceClientResponse rotateSecret(String clientId) {
+        StoredClient client = find(clientId);
+        if (client == null) {
+            throw new CustomJwtException(ErrorCode.CLIENT_NOT_FOUND);
+        }
+
+        String secret = generateSecret();
+        Instant now = Instant.now();
+        StoredClient rotated = new StoredClient(client.clientId(), client.scopes(), sha256Hex(secret),
+                client.secretHash(), now.plus(rotationGracePeriod), client.createdAt(), now);
+        redisTemplate.opsForValue().set(CLIENT_KEY_PREFIX + clientId, toJson(rotated));
+
+        log.info("Service client secret rotated: clientId={}, previousSecretExpiresAt={}",
+                clientId, rotated.previousSecretExpiresAt());
+        return toResponse(rotated, secret);
+    }
+
+    /**
+     * client-credentials 토큰 발급
+     *
+     * @param scope 요청 scope (공백 구분, 없으면 등록된 모든 scope)
+     */
+    public ServiceTokenResponse issueToken(String grantType, String clientId, String clientSecret, String scope) {
+        if (!GRANT_TYPE_CLIENT_CREDENTIALS.equals(grantType)) {
+            throw new CustomJwtException(ErrorCode.UNSUPPORTED_GRANT_TYPE);
+        }
+
+        StoredClient client = StringUtils.hasText(clientId) ? find(clientId) : null;
+        if (client == null || !secretMatches(client, clientSecret)) {
+            log.warn("Service client authentication failed: clientId={}", clientId);
+            throw new CustomJwtException(ErrorCode.INVALID_CLIENT);
+        }
+
+        List<String> scopes = StringUtils.hasText(scope)
+                ? Arrays.stream(scope.trim().split("\\s+")).distinct().toList()
+                : client.scopes();
+        if (!client.scopes().containsAll(scopes)) {
+            log.warn("Service client requested unregistered scope: clientId={}, scope={}", clientId, scope);
+            throw new CustomJwtException(ErrorCode.INVALID_SCOPE);
+        }
+
+        log.debug("Service token issued: clientId={}, scopes={}", clientId, scopes);
+        return new ServiceTokenResponse(
+                jwtTokenProvider.generateServiceToken(clientId, scopes, tokenTtl.toMillis()),
+                "Bearer",
+                tokenTtl.toSeconds(),
+                String.join(" ", scopes));
+    }
+
+    /**
+     * auth-service 자신의 서비스 토큰 (user-service 내부 API 호출용)
+     * 자기 자신이 발급자이므로 클라이언트 등록 없이 직접 서명한다.
+     */
+    public String issueSelfToken(String clientId, List<String> scopes) {
+        return jwtTokenProvider.generateServiceToken(clientId, scopes, tokenTtl.toMillis());
+    }
+
+    public Duration getTokenTtl() {
+        return tokenTtl;
+    }
+
+    private boolean secretMatches(StoredClient client, String secret) {
+        if (!StringUtils.hasText(secret)) {
+            return false;
+        }
+        byte[] hash = sha256Hex(secret).getBytes(StandardCharsets.UTF_8);
+        if (MessageDigest.isEqual(hash, client.secretHash().getBytes(StandardCharsets.UTF_8))) {
+            return true;
+        }
+        return client.previousSecretHash() != null
+                && client.previousSecretExpiresAt() != null
+                && Instant.now().isBefore(client.previousSecretExpiresAt())
+                && MessageDigest.isEqual(hash, client.previousSecretHash().getBytes(StandardCharsets.UTF_8));
+    }
+
+    private StoredClient find(String clientId) {
+        Object value = redisTemplate.opsForValue().get(CLIENT_KEY_PREFIX + clientId);
+        if (value == null) {
+            return null;
+        }
+        try {
+            return objectMapper.readValue(value.toString(), StoredClient.class);
+        } catch (JsonProcessingException e) {
+            log.error("Failed to read service client: clientId={}, error={}", clientId, e.getMessage());
+            return null;
+        }
+    }
+
+    private String toJson(StoredClient client) {
+        try {
+            return objectMapper.writeValueAsString(client);
+        } catch (JsonProcessingException e) {
+            throw new IllegalStateException("Failed to serialize service client", e);
+        }
+    }
+
+    private ServiceClientResponse toResponse(StoredClient client, String secret) {
+        Instant previousExpiresAt = client.previousSecretExpiresAt() != null
+                && Instant.now().isBefore(client.previousSecretExpiresAt())
+                ? client.previousSecretExpiresAt() : null;
+        return new ServiceClientResponse(client.clientId(), client.scopes(), secret,
+                client.createdAt(), client.secretRotatedAt(), previousExpiresAt);
+    }
+
+    private String generateSecret() {
+        byte[] bytes = new byte[SECRET_BYTES];
+        secureRandom.nextBytes(bytes);
+        return Base64.getUrlEncoder().withoutPadding().encodeToString(bytes);
+    }
+
+    private static String sha256Hex(String value) {
+        try {
+            MessageDigest digest = MessageDigest.getInstance("SHA-256");
+            return HexFormat.of().formatHex(digest.digest(value.getBytes(StandardCharsets.UTF_8)));
+        } catch (NoSuchAlgorithmException e) {
+            throw new IllegalStateException("SHA-256 not available", e);
+        }
+    }
+}
@@ -0,0 +1,294 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.AuthEventType;
+import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.SessionResponse;
+import OrangeCloud.AuthService.dto.TokenPolicy;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.util.JwtTokenProvider;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+
+import java.time.Duration;
+import java.time.Instant;
+import java.util.ArrayList;
+import java.util.Comparator;
+import java.util.Date;
+import java.util.List;
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * 로그인 세션(기기) 관리
+ *
+ * 로그인할 때마다 세션(sid)을 만들고, 세션의 현재 refresh token(jti)과 기기 정보를
+ * 사용자별 Redis 해시(wealist:auth:sessions:{userId})에 저장한다.
+ * - access/refresh token에는 sid claim이 들어가며, refresh할 때마다 세션의 jti를 교체한다.
+ * - 이미 교체된 refresh token이 다시 사용되면 탈취로 보고 세션 전체를 폐기한다.
+ * - 세션을 폐기하면 현재 access/refresh token을 폐기 목록에 올려 모든 서비스에서 즉시 거부된다.
+ * - 동시 세션 수가 max-concurrent를 넘으면 가장 오래 사용하지 않은 세션부터 폐기한다.
+ * - 두 번째 인증을 거친 세션인지 기록해 워크스페이스 MFA 정책을 refresh 시에도 적용한다.
+ * - access token에는 로그인 시각(auth_time)과 인증 수준(acr)을 넣어 다른 서비스가 step-up 재인증을 요구할 수 있다.
+ * - 토큰 수명은 사용자가 속한 워크스페이스의 토큰 정책을 따른다 (refresh할 때마다 다시 적용).
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class SessionService {
+
+    private static final String SESSIONS_KEY_PREFIX = "wealist:auth:sessions:";
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+    private final JwtTokenProvider tokenProvider;
+    private final TokenRevocationService tokenRevocationService;
+    private final AuthAuditService auditService;
+    private final UserServiceClient userServiceClient;
+
+    // 0 이하면 제한 없음
+    @Value("${session.max-concurrent:10}")
+    private int maxConcurrentSessions;
+
+    /**
+     * 저장되는 세션 정보 (현재 발급된 access/refresh token의 jti와 만료 시각 포함)
+     */
+    private record StoredSession(String sessionId, String device, String ipAddress, String country,
+                                 Instant createdAt, Instant lastUsedAt,
+                                 String refreshJti, Instant refreshExpiresAt,
+                                 String accessJti, Instant accessExpiresAt,
+                                 boolean mfaVerified) {
+    }
+
+    /**
+     * 새 세션을 만들고 토큰 발급
+     *
+     * @param mfaVerified 두 번째 인증을 거친 로그인이면 true
+     */
+    public AuthResponse createSession(UUID userId, String email, LoginContext context, boolean mfaVerified) {
+        String sessionId = UUID.randomUUID().toString();
+        Instant now = Instant.now();
+        AuthResponse tokens = issueTokens(userId, email, sessionId, now, mfaVerified);
+
+        StoredSession session = withTokens(new StoredSession(sessionId, device(context),
+                context != null ? context.clientIp() : null, context != null ? context.country() : null,
+                now, now, null, null, null, null, mfaVerified), tokens);
+        save(userId, session);
+        log.info("Session created: userId={}, sessionId={}, device={}", userId, sessionId, session.device());
+
+        enforceLimit(userId, sessionId, context);
+        return tokens;
+    }
+
+    /**
+     * refresh token으로 세션의 토큰 교체
+     * 세션이 없거나(폐기됨) 이미 교체된 refresh token이면 TOKEN_REVOKED
+     *
+     * @param refreshJti 제시된 refresh token의 jti
+     */
+    public AuthResponse rotate(UUID userId, String email, String sessionId, String refreshJti, LoginContext context) {
+        StoredSession session = find(userId, sessionId);
+        if (session == null) {
+            log.warn("Refresh token for a revoked session: userId={}, sessionId={}", userId, sessionId);
+            throw new CustomJwtException(ErrorCode.TOKEN_REVOKED);
+        }
+        if (!session.refreshJti().equals(refreshJti)) {
+            // 이미 교체된 refresh token 재사용 - 토큰 탈취로 보고 세션 폐기
+            log.warn("Refresh token reuse detected, revoking session: userId={}, sessionId={}", userId, sessionId);
+            auditService.record(AuthEventType.TOKEN_REUSE_DETECTED, userId, sessionId, context);
+            revoke(userId, session, "refresh_token_reuse", context);
+            throw new CustomJwtException(ErrorCode.TOKEN_REVOKED);
+        }
+
+        AuthResponse tokens = issueTokens(userId, email, sessionId, session.createdAt(), session.mfaVerified());
+        StoredSession rotated = new StoredSession(session.sessionId(), session.device(),
+                context != null ? context.clientIp() : session.ipAddress(),
+                context != null && context.country() != null ? context.country() : session.country(),
+                session.createdAt(), Instant.now(), null, null, null, null, session.mfaVerified());
+        save(userId, withTokens(rotated, tokens));
+        return tokens;
+    }
+
+    /**
+     * 두 번째 인증을 거쳐 시작된 세션인지 (세션이 없으면 false)
+     */
+    public boolean isMfaVerified(UUID userId, String sessionId) {
+        StoredSession session = find(userId, sessionId);
+        return session != null && session.mfaVerified();
+    }
+
+    /**
+     * 사용자의 세션 목록 (최근 사용 순)
+     *
+     * @param currentSessionId 요청한 토큰의 세션 (current 표시용, 없으면 null)
+     */
+    public List<SessionResponse> list(UUID userId, String currentSessionId) {
+        return findAll(userId).stream()
+                .sorted(Comparator.comparing(StoredSession::lastUsedAt).reversed())
+                .map(session -> new SessionResponse(session.sessionId(), session.device(), session.ipAddress(),
+                        session.country(), session.createdAt(), session.lastUsedAt(),
+                        session.sessionId().equals(currentSessionId)))
+                .toList();
+    }
+
+    /**
+     * 세션 하나 폐기 (다른 기기 로그아웃)
+     */
+    public void revoke(UUID userId, String sessionId, LoginContext context) {
+        StoredSession session = find(userId, sessionId);
+        if (session == null) {
+            throw new CustomJwtException(ErrorCode.SESSION_NOT_FOUND);
+        }
+        revoke(userId, session, "user_revoked", context);
+    }
+
+    /**
+     * 현재 세션을 제외한 모든 세션 폐기
+     *
+     * @return 폐기한 세션 수
+     */
+    public int revokeOthers(UUID userId, String currentSessionId, LoginContext context) {
+        int revoked = 0;
+        for (StoredSession session : findAll(userId)) {
+            if (!session.sessionId().equals(currentSessionId)) {
+                revoke(userId, session, "user_revoked", context);
+                revoked++;
+            }
+        }
+        return revoked;
+    }
+
+    /**
+     * 세션 종료 - 세션이 있으면 refresh token까지 폐기
+     *
+     * @param reason 감사 로그에 남길 폐기 사유 (로그아웃처럼 호출한 쪽이 따로 기록하면 null)
+     */
+    public void end(UUID userId, String sessionId, String reason, LoginContext context) {
+        StoredSession session = find(userId, sessionId);
+        if (session != null) {
+            revoke(userId, session, reason, context);
+        }
+    }
+
+    /**
+     * 모든 세션 기록 삭제 (토큰은 사용자 단위 폐기로 이미 거부됨)
+     */
+    public void removeAll(UUID userId) {
+        redisTemplate.delete(SESSIONS_KEY_PREFIX + userId);
+    }
+
+    private void revoke(UUID userId, StoredSession session, String reason, LoginContext context) {
+        tokenRevocationService.revokeToken(session.refreshJti(), Date.from(session.refreshExpiresAt()));
+        tokenRevocationService.revokeToken(session.accessJti(), Date.from(session.accessExpiresAt()));
+        redisTemplate.opsForHash().delete(SESSIONS_KEY_PREFIX + userId, session.sessionId());
+        log.info("Session revoked: userId={}, sessionId={}, reason={}", userId, session.sessionId(), reason);
+        if (reason != null) {
+            auditService.record(AuthEventType.SESSION_REVOKED, userId, null, session.sessionId(), reason, context);
+        }
+    }
+
+    /**
+     * 동시 세션 제한 - 새 세션을 제외하고 가장 오래 사용하지 않은 세션부터 폐기
+     */
+    private void enforceLimit(UUID userId, String newSessionId, LoginContext context) {
+        if (maxConcurrentSessions <= 0) {
+            return;
+        }
+        List<StoredSession> sessions = new ArrayList<>(findAll(userId));
+        if (sessions.size() <= maxConcurrentSessions) {
+            return;
+        }
+        sessions.removeIf(session -> session.sessionId().equals(newSessionId));
+        sessions.sort(Comparator.comparing(StoredSession::lastUsedAt));
+        for (int i = 0; i < sessions.size() + 1 - maxConcurrentSessions; i++) {
+            log.info("Session limit exceeded: userId={}, limit={}", userId, maxConcurrentSessions);
+            revoke(userId, sessions.get(i), "session_limit", context);
+        }
+    }
+
+    /**
+     * 세션 토큰 발급 - auth_time은 세션을 만든 로그인 시각이라 refresh해도 바뀌지 않는다
+     */
+    private AuthResponse issueTokens(UUID userId, String email, String sessionId, Instant authTime,
+                                     boolean mfaVerified) {
+        TokenPolicy policy = userServiceClient.getTokenPolicy(userId);
+        long accessTtlMs = ttlMs(policy.getAccessTokenTtlMinutes(), tokenProvider.getAccessTokenExpirationMs());
+        long refreshTtlMs = ttlMs(policy.getRefreshTokenTtlMinutes(), tokenProvider.getRefreshTokenExpirationMs());
+        String acr = mfaVerified ? JwtTokenProvider.ACR_MULTI_FACTOR : JwtTokenProvider.ACR_SINGLE_FACTOR;
+
+        return new AuthResponse(
+                tokenProvider.generateToken(userId, email, sessionId, authTime, acr, accessTtlMs),
+                tokenProvider.generateRefreshToken(userId, email, sessionId, refreshTtlMs), userId);
+    }
+
+    private static long ttlMs(int minutes, long defaultMs) {
+        return minutes > 0 ? Duration.ofMinutes(minutes).toMillis() : defaultMs;
+    }
+
+    private StoredSession withTokens(StoredSession session, AuthResponse tokens) {
+        return new StoredSession(session.sessionId(), session.device(), session.ipAddress(), session.country(),
+                session.createdAt(), session.lastUsedAt(),
+                tokenProvider.getJtiFromToken(tokens.getRefreshToken()),
+                tokenProvider.getExpirationDateFromToken(tokens.getRefreshToken()).toInstant(),
+                tokenProvider.getJtiFromToken(tokens.getAccessToken()),
+                tokenProvider.getExpirationDateFromToken(tokens.getAccessToken()).toInstant(),
+                session.mfaVerified());
+    }
+
+    private void save(UUID userId, StoredSession session) {
+        String key = SESSIONS_KEY_PREFIX + userId;
+        try {
+            redisTemplate.opsForHash().put(key, session.sessionId(), objectMapper.writeValueAsString(session));
+        } catch (JsonProcessingException e) {
+            throw new IllegalStateException("Failed to serialize session", e);
+        }
+        // 마지막 세션의 refresh token이 만료되면 함께 만료 (워크스페이스 정책은 기본값보다 짧을 수도 있음)
+        Duration ttl = Duration.between(Instant.now(), session.refreshExpiresAt());
+        Duration defaultTtl = Duration.ofMillis(tokenProvider.getRefreshTokenExpirationMs());
+        redisTemplate.expire(key, ttl.compareTo(defaultTtl) > 0 ? ttl : defaultTtl);
+    }
+
+    private StoredSession find(UUID userId, String sessionId) {
+        Object value = redisTemplate.opsForHash().get(SESSIONS_KEY_PREFIX + userId, sessionId);
+        return value != null ? parse(value) : null;
+    }
+
+    /**
+     * 만료된 세션은 정리하고 유효한 세션만 반환
+     */
+    private List<StoredSession> findAll(UUID userId) {
+        String key = SESSIONS_KEY_PREFIX + userId;
+        Map<Object, Object> entries = redisTemplate.opsForHash().entries(key);
+        List<StoredSession> sessions = new ArrayList<>();
+        Instant now = Instant.now();
+        for (Map.Entry<Object, Object> entry : entries.entrySet()) {
+            StoredSession session = parse(entry.getValue());
+            if (session == null || session.refreshExpiresAt().isBefore(now)) {
+                redisTemplate.opsForHash().delete(key, entry.getKey());
+                continue;
+            }
+            sessions.add(session);
+        }
+        return sessions;
+    }
+
+    private StoredSession parse(Object value) {
+        try {
+            return objectMapper.readValue(value.toString(), StoredSession.class);
+        } catch (JsonProcessingException e) {
+            log.error("Failed to read session: {}", e.getMessage());
+            return null;
+        }
+    }
+
+    private static String device(LoginContext context) {
+        return context != null ? context.device() : "Unknown device";
+    }
+}
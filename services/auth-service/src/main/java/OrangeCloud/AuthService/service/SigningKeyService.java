@@ -0,0 +1,340 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.util.SigningKey;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import jakarta.annotation.PostConstruct;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.scheduling.annotation.Scheduled;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+
+import javax.crypto.Cipher;
+import javax.crypto.spec.GCMParameterSpec;
+import javax.crypto.spec.SecretKeySpec;
+import java.nio.charset.StandardCharsets;
+import java.security.*;
+import java.security.interfaces.RSAPrivateKey;
+import java.security.interfaces.RSAPublicKey;
+import java.security.spec.PKCS8EncodedKeySpec;
+import java.security.spec.X509EncodedKeySpec;
+import java.time.Duration;
+import java.time.Instant;
+import java.util.*;
+
+/**
+ * JWT 서명 키 관리 (RS256 키 로테이션)
+ *
+ * 로테이션이 꺼져 있으면 RsaKeyConfig의 고정 키 하나만 사용한다.
+ * 로테이션이 켜져 있으면 모든 Pod이 Redis에 저장된 키 목록을 공유한다.
+ * - 새 키는 publish-ahead 동안 JWKS에만 게시된 뒤 서명에 사용 (검증 측 JWKS 캐시 갱신 시간 확보)
+ * - 이전 키는 마지막 서명 시각 + 최대 토큰 수명(refresh token)까지 JWKS에 게시
+ * - 개인키는 jwt.rotation.encryption-key로 암호화(AES-GCM)해 저장
+ */
+@Service
+@Slf4j
+public class SigningKeyService {
+
+    private static final String KEYS_KEY = "wealist:auth:jwks:keys";
+    private static final String LOCK_KEY = "wealist:auth:jwks:rotation-lock";
+    private static final Duration LOCK_TTL = Duration.ofSeconds(30);
+    // 모르는 kid로 Redis를 반복 조회하지 않도록 최소 간격 유지
+    private static final Duration MIN_RELOAD_INTERVAL = Duration.ofSeconds(10);
+
+    private final KeyPair staticKeyPair;
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final ObjectMapper objectMapper;
+    private final SecureRandom secureRandom = new SecureRandom();
+    private final String instanceId = UUID.randomUUID().toString();
+
+    @Value("${jwt.rsa.key-id:wealist-auth-key-1}")
+    private String staticKeyId;
+
+    @Value("${jwt.rotation.enabled:false}")
+    private boolean rotationEnabled;
+
+    @Value("${jwt.rotation.interval:30d}")
+    private Duration rotationInterval;
+
+    @Value("${jwt.rotation.publish-ahead:30m}")
+    private Duration publishAhead;
+
+    @Value("${jwt.rotation.encryption-key:}")
+    private String encryptionKey;
+
+    @Value("${jwt.refresh-token-expiration-ms:604800000}")
+    private long maxTokenLifetimeMs;
+
+    private volatile List<SigningKey> keys = List.of();
+    private volatile Instant lastLoad = Instant.EPOCH;
+
+    public SigningKeyService(KeyPair rsaKeyPair, RedisTemplate<String, Object> redisTemplate, ObjectMapper objectMapper) {
+        this.staticKeyPair = rsaKeyPair;
+        this.redisTemplate = redisTemplate;
+        this.objectMapper = objectMapper;
+    }
+
+    @PostConstruct
+    void init() {
+        if (!rotationEnabled) {
+            keys = List.of(new SigningKey(staticKeyId,
+                    (RSAPublicKey) staticKeyPair.getPublic(),
+                    (RSAPrivateKey) staticKeyPair.getPrivate(),
+                    Instant.EPOCH, null));
+            log.info("JWT key rotation disabled, using static key: kid={}", staticKeyId);
+            return;
+        }
+
+        if (!StringUtils.hasText(encryptionKey)) {
+            throw new IllegalStateException("jwt.rotation.encryption-key is required when key rotation is enabled");
+        }
+
+        loadKeys();
+        if (keys.isEmpty()) {
+            // 첫 기동: 설정된(또는 생성된) 키를 첫 서명 키로 등록
+            withRotationLock(() -> {
+                loadKeys();
+                if (keys.isEmpty()) {
+                    saveKey(new SigningKey(staticKeyId,
+                            (RSAPublicKey) staticKeyPair.getPublic(),
+                            (RSAPrivateKey) staticKeyPair.getPrivate(),
+                            Instant.now(), null));
+                    loadKeys();
+                }
+            });
+        }
+        log.info("JWT key rotation enabled: interval={}, publishAhead={}, keys={}",
+                rotationInterval, publishAhead, keys.stream().map(SigningKey::kid).toList());
+    }
+
+    /**
+     * 현재 서명에 사용할 키 - 활성 키 중 가장 최근에 활성화된 키
+     */
+    public SigningKey currentSigningKey() {
+        Optional<SigningKey> current = findActive();
+        if (current.isEmpty() && rotationEnabled) {
+            // 다른 Pod이 첫 키를 등록하는 중에 기동한 경우
+            loadKeys();
+            current = findActive();
+        }
+        return current.orElseThrow(() -> new IllegalStateException("No active JWT signing key"));
+    }
+
+    private Optional<SigningKey> findActive() {
+        Instant now = Instant.now();
+        return keys.stream()
+                .filter(key -> key.isActive(now))
+                .max(Comparator.comparing(SigningKey::activatesAt));
+    }
+
+    /**
+     * kid로 검증 키 조회
+     * 다른 Pod이 방금 로테이션했을 수 있으므로 모르는 kid면 Redis에서 다시 읽는다.
+     *
+     * @return 공개키, 게시되지 않은 kid면 null
+     */
+    public RSAPublicKey findVerificationKey(String kid) {
+        if (kid == null) {
+            return currentSigningKey().publicKey();
+        }
+
+        RSAPublicKey key = findPublished(kid);
+        if (key == null && rotationEnabled
+                && Duration.between(lastLoad, Instant.now()).compareTo(MIN_RELOAD_INTERVAL) > 0) {
+            loadKeys();
+            key = findPublished(kid);
+        }
+        return key;
+    }
+
+    /**
+     * JWKS에 게시할 키 (예정 키 + 활성 키 + 만료 전 이전 키)
+     */
+    public List<SigningKey> publishedKeys() {
+        Instant now = Instant.now();
+        return keys.stream().filter(key -> key.isPublished(now)).toList();
+    }
+
+    /**
+     * 로테이션 주기 확인 (모든 Pod에서 실행, 실제 로테이션은 락을 잡은 Pod 하나만 수행)
+     * 다른 Pod이 추가한 키도 이 주기로 반영된다.
+     */
+    @Scheduled(fixedDelayString = "${jwt.rotation.check-interval-ms:60000}",
+            initialDelayString = "${jwt.rotation.check-interval-ms:60000}")
+    public void rotateIfDue() {
+        if (!rotationEnabled) {
+            return;
+        }
+
+        try {
+            loadKeys();
+            if (!isRotationDue(Instant.now())) {
+                pruneExpiredKeys();
+                return;
+            }
+            withRotationLock(() -> {
+                loadKeys();
+                Instant now = Instant.now();
+                if (isRotationDue(now)) {
+                    rotate(now);
+                    loadKeys();
+                }
+            });
+        } catch (Exception e) {
+            // Redis 장애 시 기존 키로 계속 서명 (다음 주기에 재시도)
+            log.error("JWT key rotation check failed: {}", e.getMessage(), e);
+        }
+    }
+
+    // 가장 최근 키가 활성화된 지 interval이 지나기 전에 다음 키를 미리 게시
+    private boolean isRotationDue(Instant now) {
+        return keys.stream()
+                .map(SigningKey::activatesAt)
+                .max(Comparator.naturalOrder())
+                .map(latest -> !latest.plus(rotationInterval).isAfter(now.plus(publishAhead)))
+                .orElse(true);
+    }
+
+    private void rotate(Instant now) throws NoSuchAlgorithmException {
+        KeyPairGenerator generator = KeyPairGenerator.getInstance("RSA");
+        generator.initialize(2048);
+        KeyPair pair = generator.generateKeyPair();
+
+        Instant activatesAt = now.plus(publishAhead);
+        SigningKey next = new SigningKey("wealist-auth-" + activatesAt.getEpochSecond(),
+                (RSAPublicKey) pair.getPublic(), (RSAPrivateKey) pair.getPrivate(), activatesAt, null);
+
+        // 기존 키는 새 키 활성화 시점까지 서명하므로 그때 발급된 토큰이 만료될 때까지 게시
+        Instant retiredUntil = activatesAt.plusMillis(maxTokenLifetimeMs);
+        for (SigningKey key : keys) {
+            if (key.expiresAt() == null) {
+                saveKey(key.retire(retiredUntil));
+            }
+        }
+        saveKey(next);
+
+        log.info("JWT signing key rotated: kid={}, activatesAt={}", next.kid(), activatesAt);
+    }
+
+    private void pruneExpiredKeys() {
+        Instant now = Instant.now();
+        for (SigningKey key : keys) {
+            if (!key.isPublished(now)) {
+                redisTemplate.opsForHash().delete(KEYS_KEY, key.kid());
+                log.info("Expired JWT signing key removed: kid={}", key.kid());
+            }
+        }
+    }
+
+    private RSAPublicKey findPublished(String kid) {
+        Instant now = Instant.now();
+        return keys.stream()
+                .filter(key -> key.kid().equals(kid) && key.isPublished(now))
+                .map(SigningKey::publicKey)
+                .findFirst()
+                .orElse(null);
+    }
+
+    // ============================================================================
+    // Redis 저장
+    // ============================================================================
+
+    private void loadKeys() {
+        List<SigningKey> loaded = new ArrayList<>();
+        for (Object value : redisTemplate.opsForHash().values(KEYS_KEY)) {
+            try {
+                loaded.add(deserialize(value.toString()));
+            } catch (Exception e) {
+                log.error("Failed to load JWT signing key: {}", e.getMessage());
+            }
+        }
+        keys = List.copyOf(loaded);
+        lastLoad = Instant.now();
+    }
+
+    private void saveKey(SigningKey key) throws Exception {
+        redisTemplate.opsForHash().put(KEYS_KEY, key.kid(), serialize(key));
+    }
+
+    private void withRotationLock(ThrowingRunnable action) {
+        Boolean acquired = redisTemplate.opsForValue().setIfAbsent(LOCK_KEY, instanceId, LOCK_TTL);
+        if (!Boolean.TRUE.equals(acquired)) {
+            log.debug("JWT key rotation lock held by another instance");
+            return;
+        }
+        try {
+            action.run();
+        } catch (Exception e) {
+            throw new IllegalStateException("JWT key rotation failed", e);
+        } finally {
+            if (instanceId.equals(redisTemplate.opsForValue().get(LOCK_KEY))) {
+                redisTemplate.delete(LOCK_KEY);
+            }
+        }
+    }
+
+    private String serialize(SigningKey key) throws Exception {
+        Map<String, Object> stored = new LinkedHashMap<>();
+        stored.put("kid", key.kid());
+        stored.put("publicKey", Base64.getEncoder().encodeToString(key.publicKey().getEncoded()));
+        stored.put("privateKey", encrypt(key.privateKey().getEncoded()));
+        stored.put("activatesAt", key.activatesAt().toEpochMilli());
+        stored.put("expiresAt", key.expiresAt() != null ? key.expiresAt().toEpochMilli() : null);
+        return objectMapper.writeValueAsString(stored);
+    }
+
+    private SigningKey deserialize(String json) throws Exception {
+        Map<?, ?> stored = objectMapper.readValue(json, Map.class);
+        KeyFactory factory = KeyFactory.getInstance("RSA");
+
+        RSAPublicKey publicKey = (RSAPublicKey) factory.generatePublic(
+                new X509EncodedKeySpec(Base64.getDecoder().decode((String) stored.get("publicKey"))));
+        RSAPrivateKey privateKey = (RSAPrivateKey) factory.generatePrivate(
+                new PKCS8EncodedKeySpec(decrypt((String) stored.get("privateKey"))));
+        Object expiresAt = stored.get("expiresAt");
+
+        return new SigningKey(
+                (String) stored.get("kid"),
+                publicKey,
+                privateKey,
+                Instant.ofEpochMilli(((Number) stored.get("activatesAt")).longValue()),
+                expiresAt != null ? Instant.ofEpochMilli(((Number) expiresAt).longValue()) : null);
+    }
+
+    // ============================================================================
+    // 개인키 암호화 (AES-256-GCM, 키 = SHA-256(encryption-key))
+    // ============================================================================
+
+    private String encrypt(byte[] plain) throws Exception {
+        byte[] iv = new byte[12];
+        secureRandom.nextBytes(iv);
+
+        Cipher cipher = Cipher.getInstance("AES/GCM/NoPadding");
+        cipher.init(Cipher.ENCRYPT_MODE, aesKey(), new GCMParameterSpec(128, iv));
+        byte[] encrypted = cipher.doFinal(plain);
+
+        byte[] out = new byte[iv.length + encrypted.length];
+        System.arraycopy(iv, 0, out, 0, iv.length);
+        System.arraycopy(encrypted, 0, out, iv.length, encrypted.length);
+        return Base64.getEncoder().encodeToString(out);
+    }
+
+    private byte[] decrypt(String encoded) throws Exception {
+        byte[] in = Base64.getDecoder().decode(encoded);
+
+        Cipher cipher = Cipher.getInstance("AES/GCM/NoPadding");
+        cipher.init(Cipher.DECRYPT_MODE, aesKey(), new GCMParameterSpec(128, in, 0, 12));
+        return cipher.doFinal(in, 12, in.length - 12);
+    }
+
+    private SecretKeySpec aesKey() throws NoSuchAlgorithmException {
+        byte[] digest = MessageDigest.getInstance("SHA-256").digest(encryptionKey.getBytes(StandardCharsets.UTF_8));
+        return new SecretKeySpec(digest, "AES");
+    }
+
+    @FunctionalInterface
+    private interface ThrowingRunnable {
+        void run() throws Exception;
+    }
+}
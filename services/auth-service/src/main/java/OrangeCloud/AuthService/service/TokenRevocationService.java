@@ -0,0 +1,69 @@
+package OrangeCloud.AuthService.service;
+
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+
+import java.time.Duration;
+import java.util.Date;
+import java.util.UUID;
+
+/**
+ * jti 기반 토큰 폐기 목록 (Redis)
+ *
+ * Go 서비스의 SmartValidator(wealist-advanced-go-pkg/auth/revocation.go)가 같은 키를 조회하므로
+ * 키 형식과 값(사용자 폐기 시각 = Unix 초)을 바꾸면 양쪽을 함께 수정해야 한다.
+ * - wealist:auth:revoked:jti:{jti}      단일 토큰 폐기 (로그아웃, refresh 회전)
+ * - wealist:auth:revoked:user:{userId}  그 시각 이전 발급 토큰 전체 폐기 (비밀번호 변경, 계정 탈취 대응)
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class TokenRevocationService {
+
+    static final String REVOKED_TOKEN_KEY_PREFIX = "wealist:auth:revoked:jti:";
+    static final String REVOKED_USER_KEY_PREFIX = "wealist:auth:revoked:user:";
+
+    private final RedisTemplate<String, Object> redisTemplate;
+
+    /**
+     * 단일 토큰 폐기 - 토큰이 만료될 때까지만 보관
+     */
+    public void revokeToken(String jti, Date expiration) {
+        long ttl = expiration.getTime() - System.currentTimeMillis();
+        if (jti == null || ttl <= 0) {
+            return;
+        }
+        redisTemplate.opsForValue().set(REVOKED_TOKEN_KEY_PREFIX + jti, "1", Duration.ofMillis(ttl));
+        log.debug("Token revoked: jti={}, ttl={}ms", jti, ttl);
+    }
+
+    /**
+     * 사용자에게 지금까지 발급된 모든 토큰 폐기
+     *
+     * @param ttl 가장 긴 토큰(refresh token) 수명 - 이후에는 폐기할 토큰이 남아 있지 않음
+     */
+    public void revokeAllForUser(UUID userId, Duration ttl) {
+        long now = System.currentTimeMillis() / 1000;
+        redisTemplate.opsForValue().set(REVOKED_USER_KEY_PREFIX + userId, String.valueOf(now), ttl);
+        log.info("All tokens revoked for user: userId={}", userId);
+    }
+
+    /**
+     * 토큰 폐기 여부 확인
+     * 사용자 단위 폐기는 초 단위로 비교하며, 폐기 시각과 같은 초에 발급된 토큰도 폐기로 본다.
+     */
+    public boolean isRevoked(String jti, UUID userId, Date issuedAt) {
+        if (jti != null && Boolean.TRUE.equals(redisTemplate.hasKey(REVOKED_TOKEN_KEY_PREFIX + jti))) {
+            return true;
+        }
+
+        Object revokedAt = redisTemplate.opsForValue().get(REVOKED_USER_KEY_PREFIX + userId);
+        if (revokedAt == null) {
+            return false;
+        }
+        long issuedAtSeconds = issuedAt != null ? issuedAt.getTime() / 1000 : 0;
+        return issuedAtSeconds <= Long.parseLong(revokedAt.toString());
+    }
+}
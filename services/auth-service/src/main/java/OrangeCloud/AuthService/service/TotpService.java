@@ -0,0 +1,444 @@
+package OrangeCloud.AuthService.service;
+
+import OrangeCloud.AuthService.client.UserServiceClient;
+import OrangeCloud.AuthService.dto.AuthEventType;
+import OrangeCloud.AuthService.dto.AuthResponse;
+import OrangeCloud.AuthService.dto.LoginContext;
+import OrangeCloud.AuthService.dto.LoginMethod;
+import OrangeCloud.AuthService.dto.TotpSetupResponse;
+import OrangeCloud.AuthService.dto.UserMfaStatus;
+import OrangeCloud.AuthService.exception.CustomJwtException;
+import OrangeCloud.AuthService.exception.ErrorCode;
+import com.google.zxing.BarcodeFormat;
+import com.google.zxing.EncodeHintType;
+import com.google.zxing.WriterException;
+import com.google.zxing.common.BitMatrix;
+import com.google.zxing.qrcode.QRCodeWriter;
+import jakarta.annotation.PostConstruct;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.data.redis.core.RedisTemplate;
+import org.springframework.stereotype.Service;
+import org.springframework.util.StringUtils;
+
+import javax.crypto.Cipher;
+import javax.crypto.Mac;
+import javax.crypto.spec.GCMParameterSpec;
+import javax.crypto.spec.SecretKeySpec;
+import javax.imageio.ImageIO;
+import java.awt.image.BufferedImage;
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.net.URLEncoder;
+import java.nio.ByteBuffer;
+import java.nio.charset.StandardCharsets;
+import java.security.GeneralSecurityException;
+import java.security.MessageDigest;
+import java.security.NoSuchAlgorithmException;
+import java.security.SecureRandom;
+import java.time.Duration;
+import java.time.Instant;
+import java.util.ArrayList;
+import java.util.Base64;
+import java.util.HexFormat;
+import java.util.List;
+import java.util.Locale;
+import java.util.Map;
+import java.util.UUID;
+
+/**
+ * TOTP(인증 앱) 두 번째 인증 (RFC 6238, HMAC-SHA1, 30초, 6자리)
+ *
+ * - 등록: secret을 생성해 Redis에 잠시 보관하고 QR 코드(otpauth URI)를 반환한다.
+ *   사용자가 인증 앱의 코드로 확인하면 secret을 암호화(AES-GCM)해 user-service에 저장하고 복구 코드를 발급한다.
+ * - 검증: 현재 구간 ±1(시계 오차 허용) 코드를 받되, 이미 사용한 구간의 코드는 거부한다 (재사용 방지).
+ * - 복구 코드: 1회용, user-service에는 SHA-256 해시만 저장하고 원문은 발급 응답에서 한 번만 반환한다.
+ * - 로그인 중 검증 실패는 LoginAttemptService에 집계해 계정을 잠근다 (코드 대입 방지).
+ */
+@Service
+@RequiredArgsConstructor
+@Slf4j
+public class TotpService {
+
+    private static final String ENROLLMENT_KEY_PREFIX = "wealist:auth:mfa:totp:enrollment:";
+    private static final String BASE32_ALPHABET = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567";
+    private static final String RECOVERY_CODE_ALPHABET = "abcdefghjkmnpqrstuvwxyz23456789";
+    private static final int SECRET_BYTES = 20;
+    private static final int PERIOD_SECONDS = 30;
+    private static final int DIGITS = 6;
+    private static final int ALLOWED_DRIFT_STEPS = 1;
+    private static final int QR_SIZE = 240;
+
+    private final RedisTemplate<String, Object> redisTemplate;
+    private final UserServiceClient userServiceClient;
+    private final AuthService authService;
+    private final LoginAttemptService loginAttemptService;
+    private final AuthAuditService auditService;
+    private final SecureRandom secureRandom = new SecureRandom();
+
+    @Value("${mfa.totp.issuer:weAlist}")
+    private String issuer;
+
+    // user-service에 저장하는 secret 암호화 키 - 바꾸면 기존 등록을 복호화할 수 없음
+    @Value("${mfa.totp.encryption-key:}")
+    private String encryptionKey;
+
+    @Value("${mfa.totp.enrollment-ttl:10m}")
+    private Duration enrollmentTtl;
+
+    @Value("${mfa.recovery-codes:10}")
+    private int recoveryCodeCount;
+
+    @PostConstruct
+    void checkConfiguration() {
+        if (!StringUtils.hasText(encryptionKey)) {
+            log.warn("mfa.totp.encryption-key is not set - TOTP enrollment and verification are disabled");
+        }
+    }
+
+    // ============================================================================
+    // 등록
+    // ============================================================================
+
+    /**
+     * 등록 시작 - 새 secret과 QR 코드 반환 (enable로 확인할 때까지 저장하지 않음)
+     *
+     * @param accountName 인증 앱에 표시할 계정 이름 (보통 이메일)
+     */
+    public TotpSetupResponse setup(UUID userId, String accountName) {
+        requireEncryptionKey();
+
+        byte[] random = new byte[SECRET_BYTES];
+        secureRandom.nextBytes(random);
+        String secret = base32Encode(random);
+        redisTemplate.opsForValue().set(ENROLLMENT_KEY_PREFIX + userId, secret, enrollmentTtl);
+
+        String label = StringUtils.hasText(accountName) ? accountName : userId.toString();
+        String otpauthUri = "otpauth://totp/" + urlEncode(issuer + ":" + label)
+                + "?secret=" + secret
+                + "&issuer=" + urlEncode(issuer)
+                + "&algorithm=SHA1&digits=" + DIGITS + "&period=" + PERIOD_SECONDS;
+
+        log.info("TOTP enrollment started: userId={}", userId);
+        return new TotpSetupResponse(secret, otpauthUri, qrCode(otpauthUri), enrollmentTtl.toSeconds());
+    }
+
+    /**
+     * 등록 완료 - 인증 앱의 코드로 secret을 확인하고 저장, 새 복구 코드 반환
+     * 이미 TOTP를 사용 중이면 새 인증 앱으로 교체된다.
+     */
+    public List<String> enable(UUID userId, String code, LoginContext context) {
+        requireEncryptionKey();
+
+        Object pending = redisTemplate.opsForValue().get(ENROLLMENT_KEY_PREFIX + userId);
+        if (pending == null) {
+            throw new CustomJwtException(ErrorCode.TOTP_ENROLLMENT_INVALID);
+        }
+
+        long step = matchStep(base32Decode(pending.toString()), code, 0);
+        if (step < 0) {
+            log.warn("TOTP enrollment code mismatch: userId={}", userId);
+            throw new CustomJwtException(ErrorCode.TOTP_CODE_INVALID);
+        }
+
+        List<String> recoveryCodes = generateRecoveryCodes();
+        userServiceClient.enableTotp(userId, encrypt(pending.toString()), step, hashAll(recoveryCodes));
+        redisTemplate.delete(ENROLLMENT_KEY_PREFIX + userId);
+
+        log.info("TOTP enabled: userId={}", userId);
+        auditService.record(AuthEventType.MFA_ENROLLED, userId, LoginMethod.TOTP, null, null, context);
+        return recoveryCodes;
+    }
+
+    /**
+     * 로그인 중 등록 완료 (워크스페이스 정책으로 MFA 등록이 필요한 경우)
+     * 등록을 확인한 코드가 두 번째 인증이 되므로 바로 토큰을 발급한다.
+     */
+    public EnrollmentResult enableDuringLogin(String mfaToken, String code, LoginContext context) {
+        UUID userId = authService.getMfaEnrollment(mfaToken).userId();
+        List<String> recoveryCodes = enable(userId, code, context);
+        return new EnrollmentResult(recoveryCodes,
+                authService.completeMfa(mfaToken, userId, LoginMethod.TOTP, context));
+    }
+
+    /**
+     * 로그인 중 등록 결과 - 복구 코드와 발급된 토큰
+     */
+    public record EnrollmentResult(List<String> recoveryCodes, AuthResponse auth) {
+    }
+
+    /**
+     * TOTP 해제 - 현재 코드(또는 복구 코드)로 본인 확인
+     * MFA를 요구하는 워크스페이스의 멤버는 패스키가 없으면 해제할 수 없다.
+     */
+    public void disable(UUID userId, String code, String recoveryCode, LoginContext context) {
+        UserMfaStatus status = verify(userId, code, recoveryCode);
+        if (status.isPolicyRequired() && status.getPasskeyCount() == 0) {
+            throw new CustomJwtException(ErrorCode.MFA_ENROLLMENT_REQUIRED);
+        }
+        userServiceClient.disableTotp(userId);
+        log.info("TOTP disabled: userId={}", userId);
+        auditService.record(AuthEventType.MFA_DISABLED, userId, LoginMethod.TOTP, null, null, context);
+    }
+
+    /**
+     * 복구 코드 재발급 - 현재 코드로 본인 확인 후 기존 코드를 모두 교체
+     */
+    public List<String> regenerateRecoveryCodes(UUID userId, String code, LoginContext context) {
+        if (!StringUtils.hasText(code)) {
+            throw new CustomJwtException(ErrorCode.TOTP_CODE_INVALID);
+        }
+        verify(userId, code, null);
+
+        List<String> recoveryCodes = generateRecoveryCodes();
+        userServiceClient.replaceRecoveryCodes(userId, hashAll(recoveryCodes));
+        log.info("Recovery codes regenerated: userId={}", userId);
+        auditService.record(AuthEventType.RECOVERY_CODES_REGENERATED, userId, context);
+        return recoveryCodes;
+    }
+
+    // ============================================================================
+    // 로그인 (MFA 단계)
+    // ============================================================================
+
+    /**
+     * TOTP 코드 또는 복구 코드로 MFA 완료 후 토큰 발급
+     */
+    public AuthResponse authenticate(String mfaToken, String code, String recoveryCode,
+                                     LoginContext context, String captchaToken) {
+        UUID userId = authService.getMfaPendingUserId(mfaToken);
+        loginAttemptService.checkAllowed(userId, context, captchaToken);
+        LoginMethod method = StringUtils.hasText(code) ? LoginMethod.TOTP : LoginMethod.RECOVERY_CODE;
+
+        try {
+            verify(userId, code, recoveryCode);
+        } catch (CustomJwtException e) {
+            if (e.getErrorCode() == ErrorCode.TOTP_CODE_INVALID) {
+                loginAttemptService.recordFailure(userId, context);
+            }
+            auditService.loginFailed(userId, method, e.getErrorCode().getCode(), context);
+            throw e;
+        }
+
+        log.info("TOTP authentication successful: userId={}, method={}", userId, method);
+        return authService.completeMfa(mfaToken, userId, method, context);
+    }
+
+    /**
+     * 코드 확인 - 성공하면 사용한 구간(또는 복구 코드)을 기록해 재사용을 막는다.
+     *
+     * @return 확인에 사용한 MFA 상태
+     */
+    private UserMfaStatus verify(UUID userId, String code, String recoveryCode) {
+        UserMfaStatus status = userServiceClient.getUserMfa(userId);
+        if (!status.isTotpEnabled()) {
+            throw new CustomJwtException(ErrorCode.TOTP_NOT_ENABLED);
+        }
+
+        if (StringUtils.hasText(code)) {
+            requireEncryptionKey();
+            long step = matchStep(base32Decode(decrypt(status.getTotpSecret())), code, status.getTotpLastUsedStep());
+            if (step < 0 || !userServiceClient.recordTotpUse(userId, step)) {
+                log.warn("TOTP code rejected: userId={}", userId);
+                throw new CustomJwtException(ErrorCode.TOTP_CODE_INVALID);
+            }
+            return status;
+        }
+
+        if (StringUtils.hasText(recoveryCode)
+                && userServiceClient.consumeRecoveryCode(userId, sha256Hex(normalizeRecoveryCode(recoveryCode)))) {
+            log.info("Recovery code used: userId={}, remaining={}", userId, status.getRecoveryCodesRemaining() - 1);
+            return status;
+        }
+
+        log.warn("Recovery code rejected: userId={}", userId);
+        throw new CustomJwtException(ErrorCode.TOTP_CODE_INVALID);
+    }
+
+    // ============================================================================
+    // TOTP 계산 (RFC 6238)
+    // ============================================================================
+
+    /**
+     * 허용 구간에서 코드가 일치하는 구간 찾기
+     *
+     * @param lastUsedStep 이 구간 이하의 코드는 이미 사용된 것으로 보고 거부
+     * @return 일치한 구간, 없으면 -1
+     */
+    private long matchStep(byte[] secret, String code, long lastUsedStep) {
+        if (code == null || code.length() != DIGITS) {
+            return -1;
+        }
+        long currentStep = Instant.now().getEpochSecond() / PERIOD_SECONDS;
+        byte[] expected = code.getBytes(StandardCharsets.US_ASCII);
+        for (long step = currentStep - ALLOWED_DRIFT_STEPS; step <= currentStep + ALLOWED_DRIFT_STEPS; step++) {
+            if (step > lastUsedStep
+                    && MessageDigest.isEqual(generateCode(secret, step).getBytes(StandardCharsets.US_ASCII), expected)) {
+                return step;
+            }
+        }
+        return -1;
+    }
+
+    private static String generateCode(byte[] secret, long step) {
+        try {
+            Mac mac = Mac.getInstance("HmacSHA1");
+            mac.init(new SecretKeySpec(secret, "HmacSHA1"));
+            byte[] hash = mac.doFinal(ByteBuffer.allocate(Long.BYTES).putLong(step).array());
+
+            // dynamic truncation (RFC 4226 5.3)
+            int offset = hash[hash.length - 1] & 0x0f;
+            int binary = ((hash[offset] & 0x7f) << 24)
+                    | ((hash[offset + 1] & 0xff) << 16)
+                    | ((hash[offset + 2] & 0xff) << 8)
+                    | (hash[offset + 3] & 0xff);
+            return String.format("%0" + DIGITS + "d", binary % (int) Math.pow(10, DIGITS));
+        } catch (GeneralSecurityException e) {
+            throw new IllegalStateException("HmacSHA1 not available", e);
+        }
+    }
+
+    // ============================================================================
+    // 내부 유틸
+    // ============================================================================
+
+    private List<String> generateRecoveryCodes() {
+        List<String> codes = new ArrayList<>(recoveryCodeCount);
+        for (int i = 0; i < recoveryCodeCount; i++) {
+            StringBuilder code = new StringBuilder(11);
+            for (int j = 0; j < 10; j++) {
+                if (j == 5) {
+                    code.append('-');
+                }
+                code.append(RECOVERY_CODE_ALPHABET.charAt(secureRandom.nextInt(RECOVERY_CODE_ALPHABET.length())));
+            }
+            codes.add(code.toString());
+        }
+        return codes;
+    }
+
+    private static List<String> hashAll(List<String> recoveryCodes) {
+        return recoveryCodes.stream().map(code -> sha256Hex(normalizeRecoveryCode(code))).toList();
+    }
+
+    /**
+     * 복구 코드 정규화 - 대소문자, 하이픈, 공백 무시
+     */
+    private static String normalizeRecoveryCode(String code) {
+        return code.replaceAll("[\\s-]", "").toLowerCase(Locale.ROOT);
+    }
+
+    private String qrCode(String content) {
+        try {
+            BitMatrix matrix = new QRCodeWriter().encode(content, BarcodeFormat.QR_CODE, QR_SIZE, QR_SIZE,
+                    Map.of(EncodeHintType.MARGIN, 1));
+            BufferedImage image = new BufferedImage(matrix.getWidth(), matrix.getHeight(), BufferedImage.TYPE_BYTE_BINARY);
+            for (int x = 0; x < matrix.getWidth(); x++) {
+                for (int y = 0; y < matrix.getHeight(); y++) {
+                    image.setRGB(x, y, matrix.get(x, y) ? 0x000000 : 0xFFFFFF);
+                }
+            }
+            ByteArrayOutputStream out = new ByteArrayOutputStream();
+            ImageIO.write(image, "png", out);
+            return "data:image/png;base64," + Base64.getEncoder().encodeToString(out.toByteArray());
+        } catch (WriterException | IOException e) {
+            throw new IllegalStateException("Failed to render TOTP QR code", e);
+        }
+    }
+
+    private static String urlEncode(String value) {
+        return URLEncoder.encode(value, StandardCharsets.UTF_8).replace("+", "%20");
+    }
+
+    private static String base32Encode(byte[] data) {
+        StringBuilder out = new StringBuilder((data.length * 8 + 4) / 5);
+        int buffer = 0;
+        int bits = 0;
+        for (byte b : data) {
+            buffer = (buffer << 8) | (b & 0xff);
+            bits += 8;
+            while (bits >= 5) {
+                out.append(BASE32_ALPHABET.charAt((buffer >> (bits - 5)) & 0x1f));
+                bits -= 5;
+            }
+        }
+        if (bits > 0) {
+            out.append(BASE32_ALPHABET.charAt((buffer << (5 - bits)) & 0x1f));
+        }
+        return out.toString();
+    }
+
+    private static byte[] base32Decode(String encoded) {
+        ByteArrayOutputStream out = new ByteArrayOutputStream();
+        int buffer = 0;
+        int bits = 0;
+        for (char c : encoded.toUpperCase(Locale.ROOT).toCharArray()) {
+            int value = BASE32_ALPHABET.indexOf(c);
+            if (value < 0) {
+                continue;
+            }
+            buffer = (buffer << 5) | value;
+            bits += 5;
+            if (bits >= 8) {
+                out.write((buffer >> (bits - 8)) & 0xff);
+                bits -= 8;
+            }
+        }
+        return out.toByteArray();
+    }
+
+    private static String sha256Hex(String value) {
+        try {
+            MessageDigest digest = MessageDigest.getInstance("SHA-256");
+            return HexFormat.of().formatHex(digest.digest(value.getBytes(StandardCharsets.UTF_8)));
+        } catch (NoSuchAlgorithmException e) {
+            throw new IllegalStateException("SHA-256 not available", e);
+        }
+    }
+
+    // ============================================================================
+    // secret 암호화 (AES-256-GCM, 키 = SHA-256(encryption-key))
+    // ============================================================================
+
+    private void requireEncryptionKey() {
+        if (!StringUtils.hasText(encryptionKey)) {
+            throw new IllegalStateException("mfa.totp.encryption-key is required for TOTP");
+        }
+    }
+
+    private String encrypt(String secret) {
+        try {
+            byte[] iv = new byte[12];
+            secureRandom.nextBytes(iv);
+
+            Cipher cipher = Cipher.getInstance("AES/GCM/NoPadding");
+            cipher.init(Cipher.ENCRYPT_MODE, aesKey(), new GCMParameterSpec(128, iv));
+            byte[] encrypted = cipher.doFinal(secret.getBytes(StandardCharsets.US_ASCII));
+
+            byte[] out = new byte[iv.length + encrypted.length];
+            System.arraycopy(iv, 0, out, 0, iv.length);
+            System.arraycopy(encrypted, 0, out, iv.length, encrypted.length);
+            return Base64.getEncoder().encodeToString(out);
+        } catch (GeneralSecurityException e) {
+            throw new IllegalStateException("Failed to encrypt TOTP secret", e);
+        }
+    }
+
+    private String decrypt(String encoded) {
+        try {
+            byte[] in = Base64.getDecoder().decode(encoded);
+
+            Cipher cipher = Cipher.getInstance("AES/GCM/NoPadding");
+            cipher.init(Cipher.DECRYPT_MODE, aesKey(), new GCMParameterSpec(128, in, 0, 12));
+            return new String(cipher.doFinal(in, 12, in.length - 12), StandardCharsets.US_ASCII);
+        } catch (GeneralSecurityException | IllegalArgumentException e) {
+            throw new IllegalStateException("Failed to decrypt TOTP secret (encryption key changed?)", e);
+        }
+    }
+
+    private SecretKeySpec aesKey() throws NoSuchAlgorithmException {
+        byte[] digest = MessageDigest.getInstance("SHA-256").digest(encryptionKey.getBytes(StandardCharsets.UTF_8));
+        return new SecretKeySpec(digest, "AES");
+    }
+}
@@ -2,51 +2,70 @@ package OrangeCloud.AuthService.util;
 
 import OrangeCloud.AuthService.exception.CustomJwtException;
 import OrangeCloud.AuthService.exception.ErrorCode;
+import OrangeCloud.AuthService.service.SigningKeyService;
 import io.jsonwebtoken.*;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
 import org.springframework.beans.factory.annotation.Value;
 import org.springframework.stereotype.Component;
 
-import java.security.interfaces.RSAPrivateKey;
+import java.security.Key;
 import java.security.interfaces.RSAPublicKey;
+import java.time.Instant;
 import java.util.Date;
 import java.util.HashMap;
+import java.util.List;
 import java.util.Map;
 import java.util.UUID;
 
 /**
  * JWT 토큰 생성 및 검증
  * RS256 (RSA + SHA-256) 알고리즘 사용
+ * 서명 키는 SigningKeyService가 관리 (kid 기반 선택, 로테이션)
  */
 @Component
 public class JwtTokenProvider {
 
     private static final Logger logger = LoggerFactory.getLogger(JwtTokenProvider.class);
 
-    private final RSAPublicKey publicKey;
-    private final RSAPrivateKey privateKey;
-    private final String keyId;
+    // acr 클레임 값 - 1차 인증만 거친 세션 / 두 번째 인증까지 거친 세션
+    public static final String ACR_SINGLE_FACTOR = "sfa";
+    public static final String ACR_MULTI_FACTOR = "mfa";
+
+    private final SigningKeyService signingKeyService;
+    private final SigningKeyResolver signingKeyResolver;
     private final String issuer;
+    private final String oidcIssuer;
     private final long accessTokenExpirationMs;
     private final long refreshTokenExpirationMs;
 
     public JwtTokenProvider(
-            RSAPublicKey rsaPublicKey,
-            RSAPrivateKey rsaPrivateKey,
-            @Value("${jwt.rsa.key-id:wealist-auth-key-1}") String keyId,
+            SigningKeyService signingKeyService,
             @Value("${jwt.issuer:wealist-auth-service}") String issuer,
+            @Value("${oidc.issuer:http://localhost:8080}") String oidcIssuer,
             @Value("${jwt.access-token-expiration-ms:1800000}") long accessTokenExpirationMs,
             @Value("${jwt.refresh-token-expiration-ms:604800000}") long refreshTokenExpirationMs) {
 
-        this.publicKey = rsaPublicKey;
-        this.privateKey = rsaPrivateKey;
-        this.keyId = keyId;
+        this.signingKeyService = signingKeyService;
         this.issuer = issuer;
+        this.oidcIssuer = oidcIssuer;
         this.accessTokenExpirationMs = accessTokenExpirationMs;
         this.refreshTokenExpirationMs = refreshTokenExpirationMs;
 
-        logger.info("JwtTokenProvider initialized with RS256 algorithm, issuer: {}, keyId: {}", issuer, keyId);
+        // 토큰 헤더의 kid로 검증 키 선택 (로테이션된 이전 키로 서명된 토큰도 검증)
+        this.signingKeyResolver = new SigningKeyResolverAdapter() {
+            @Override
+            public Key resolveSigningKey(JwsHeader header, Claims claims) {
+                RSAPublicKey key = signingKeyService.findVerificationKey(header.getKeyId());
+                if (key == null) {
+                    throw new io.jsonwebtoken.security.SignatureException("Unknown signing key: " + header.getKeyId());
+                }
+                return key;
+            }
+        };
+
+        logger.info("JwtTokenProvider initialized with RS256 algorithm, issuer: {}, keyId: {}",
+                issuer, signingKeyService.currentSigningKey().kid());
     }
 
     /**
@@ -61,16 +80,38 @@ public class JwtTokenProvider {
      * ops-portal 등 email 기반 인증이 필요한 서비스용
      */
     public String generateToken(UUID userId, String email) {
+        return generateToken(userId, email, null);
+    }
+
+    /**
+     * Access Token 생성 with session ID (sid claim, 세션 관리용)
+     */
+    public String generateToken(UUID userId, String email, String sessionId) {
+        return generateToken(userId, email, sessionId, null, null, accessTokenExpirationMs);
+    }
+
+    /**
+     * 세션 Access Token 생성 - 로그인 시각(auth_time)과 인증 수준(acr) 포함
+     * 다른 서비스는 auth_time으로 민감한 작업에 최근 인증(step-up)을 요구한다.
+     *
+     * @param authTime     로그인 시각 (refresh해도 유지, null이면 생략)
+     * @param acr          인증 수준 (ACR_SINGLE_FACTOR 또는 ACR_MULTI_FACTOR, null이면 생략)
+     * @param expirationMs 워크스페이스 토큰 정책에 따른 만료 시간
+     */
+    public String generateToken(UUID userId, String email, String sessionId, Instant authTime, String acr,
+                                long expirationMs) {
         Date now = new Date();
-        Date expiryDate = new Date(now.getTime() + accessTokenExpirationMs);
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
 
         Map<String, Object> header = new HashMap<>();
         header.put("typ", "JWT");
         header.put("alg", "RS256");
-        header.put("kid", keyId);
+        header.put("kid", signingKey.kid());
 
         var builder = Jwts.builder()
                 .setHeader(header)
+                .setId(UUID.randomUUID().toString())   // jti - 토큰 폐기 목록 키
                 .setSubject(userId.toString())
                 .setIssuer(issuer)
                 .setIssuedAt(now)
@@ -80,8 +121,17 @@ public class JwtTokenProvider {
         if (email != null && !email.isBlank()) {
             builder.claim("email", email);
         }
+        if (sessionId != null) {
+            builder.claim("sid", sessionId);
+        }
+        if (authTime != null) {
+            builder.claim("auth_time", authTime.getEpochSecond());
+        }
+        if (acr != null) {
+            builder.claim("acr", acr);
+        }
 
-        return builder.signWith(privateKey, SignatureAlgorithm.RS256)
+        return builder.signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
                 .compact();
     }
 
@@ -96,16 +146,32 @@ public class JwtTokenProvider {
      * Refresh Token 생성 with email claim (RS256)
      */
     public String generateRefreshToken(UUID userId, String email) {
+        return generateRefreshToken(userId, email, null);
+    }
+
+    /**
+     * Refresh Token 생성 with session ID (sid claim, 세션 관리용)
+     */
+    public String generateRefreshToken(UUID userId, String email, String sessionId) {
+        return generateRefreshToken(userId, email, sessionId, refreshTokenExpirationMs);
+    }
+
+    /**
+     * Refresh Token 생성 - 워크스페이스 토큰 정책에 따른 만료 시간
+     */
+    public String generateRefreshToken(UUID userId, String email, String sessionId, long expirationMs) {
         Date now = new Date();
-        Date expiryDate = new Date(now.getTime() + refreshTokenExpirationMs);
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
 
         Map<String, Object> header = new HashMap<>();
         header.put("typ", "JWT");
         header.put("alg", "RS256");
-        header.put("kid", keyId);
+        header.put("kid", signingKey.kid());
 
         var builder = Jwts.builder()
                 .setHeader(header)
+                .setId(UUID.randomUUID().toString())   // jti - 토큰 폐기 목록 키
                 .setSubject(userId.toString())
                 .setIssuer(issuer)
                 .setIssuedAt(now)
@@ -115,18 +181,192 @@ public class JwtTokenProvider {
         if (email != null && !email.isBlank()) {
             builder.claim("email", email);
         }
+        if (sessionId != null) {
+            builder.claim("sid", sessionId);
+        }
+
+        return builder.signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
+                .compact();
+    }
+
+    /**
+     * 서비스 토큰 생성 (RS256, client-credentials)
+     * access token과 같은 issuer로 발급해 Istio와 Go 서비스(JWKS)가 그대로 검증하고,
+     * type=service와 사용자 UUID가 아닌 sub로 사용자 토큰과 구분한다.
+     *
+     * @param clientId 등록된 클라이언트 ID (sub, client_id claim)
+     * @param scopes   허용된 scope (공백으로 구분한 scope claim)
+     */
+    public String generateServiceToken(String clientId, List<String> scopes, long expirationMs) {
+        Date now = new Date();
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
+
+        Map<String, Object> header = new HashMap<>();
+        header.put("typ", "JWT");
+        header.put("alg", "RS256");
+        header.put("kid", signingKey.kid());
+
+        return Jwts.builder()
+                .setHeader(header)
+                .setId(UUID.randomUUID().toString())   // jti - 토큰 폐기 목록 키
+                .setSubject(clientId)
+                .setIssuer(issuer)
+                .setIssuedAt(now)
+                .setExpiration(expiryDate)
+                .claim("type", "service")
+                .claim("client_id", clientId)
+                .claim("scope", String.join(" ", scopes))
+                .signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
+                .compact();
+    }
+
+    /**
+     * 매직 링크 로그인 토큰 생성 (RS256)
+     * issuer를 access/refresh 토큰과 다르게 두어 Istio와 Go 서비스(SmartValidator)가
+     * 이 토큰을 access token으로 받아들이지 않도록 한다.
+     *
+     * @param jti        1회 사용 확인용 ID (Redis 키)
+     * @param deviceHash 링크를 요청한 기기의 쿠키 해시 (dvc claim)
+     */
+    public String generateMagicLinkToken(UUID userId, String email, String jti, String deviceHash, long expirationMs) {
+        Date now = new Date();
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
+
+        Map<String, Object> header = new HashMap<>();
+        header.put("typ", "JWT");
+        header.put("alg", "RS256");
+        header.put("kid", signingKey.kid());
+
+        return Jwts.builder()
+                .setHeader(header)
+                .setId(jti)
+                .setSubject(userId.toString())
+                .setIssuer(getMagicLinkIssuer())
+                .setIssuedAt(now)
+                .setExpiration(expiryDate)
+                .claim("type", "magic_link")
+                .claim("email", email)
+                .claim("dvc", deviceHash)
+                .signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
+                .compact();
+    }
+
+    /**
+     * 매직 링크 토큰 검증 후 claims 반환
+     * 서명/만료/issuer/type 중 하나라도 맞지 않으면 MAGIC_LINK_INVALID
+     */
+    public Claims parseMagicLinkToken(String token) {
+        try {
+            Claims claims = Jwts.parserBuilder()
+                    .setSigningKeyResolver(signingKeyResolver)
+                    .requireIssuer(getMagicLinkIssuer())
+                    .require("type", "magic_link")
+                    .build()
+                    .parseClaimsJws(token)
+                    .getBody();
+            if (claims.getId() == null) {
+                throw new CustomJwtException(ErrorCode.MAGIC_LINK_INVALID);
+            }
+            return claims;
+        } catch (JwtException | IllegalArgumentException e) {
+            logger.warn("Invalid magic link token: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.MAGIC_LINK_INVALID);
+        }
+    }
+
+    /**
+     * OIDC access token 생성 (RS256, authorization code를 교환한 RP용)
+     * issuer를 OIDC issuer로 두어 Istio와 Go 서비스가 플랫폼 API access token으로 받아들이지 않고,
+     * userinfo 조회에만 사용된다.
+     */
+    public String generateOidcAccessToken(UUID userId, String clientId, List<String> scopes, long expirationMs) {
+        Date now = new Date();
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
+
+        Map<String, Object> header = new HashMap<>();
+        header.put("typ", "JWT");
+        header.put("alg", "RS256");
+        header.put("kid", signingKey.kid());
+
+        return Jwts.builder()
+                .setHeader(header)
+                .setId(UUID.randomUUID().toString())
+                .setSubject(userId.toString())
+                .setIssuer(oidcIssuer)
+                .setAudience(clientId)
+                .setIssuedAt(now)
+                .setExpiration(expiryDate)
+                .claim("type", "oidc_access")
+                .claim("client_id", clientId)
+                .claim("scope", String.join(" ", scopes))
+                .signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
+                .compact();
+    }
+
+    /**
+     * ID token 생성 (RS256, OIDC Core 2)
+     *
+     * @param nonce  인가 요청의 nonce (없으면 null)
+     * @param claims scope에 따른 사용자 클레임 (email, name 등)
+     */
+    public String generateIdToken(UUID userId, String clientId, String nonce, Map<String, Object> claims,
+                                  long expirationMs) {
+        Date now = new Date();
+        Date expiryDate = new Date(now.getTime() + expirationMs);
+        SigningKey signingKey = signingKeyService.currentSigningKey();
+
+        Map<String, Object> header = new HashMap<>();
+        header.put("typ", "JWT");
+        header.put("alg", "RS256");
+        header.put("kid", signingKey.kid());
+
+        var builder = Jwts.builder()
+                .setHeader(header)
+                .addClaims(claims)
+                .setSubject(userId.toString())
+                .setIssuer(oidcIssuer)
+                .setAudience(clientId)
+                .setIssuedAt(now)
+                .setExpiration(expiryDate)
+                .claim("azp", clientId);
+
+        if (nonce != null) {
+            builder.claim("nonce", nonce);
+        }
 
-        return builder.signWith(privateKey, SignatureAlgorithm.RS256)
+        return builder.signWith(signingKey.privateKey(), SignatureAlgorithm.RS256)
                 .compact();
     }
 
+    /**
+     * OIDC access token 검증 후 claims 반환
+     * 서명/만료/issuer/type 중 하나라도 맞지 않으면 INVALID_TOKEN
+     */
+    public Claims parseOidcAccessToken(String token) {
+        try {
+            return Jwts.parserBuilder()
+                    .setSigningKeyResolver(signingKeyResolver)
+                    .requireIssuer(oidcIssuer)
+                    .require("type", "oidc_access")
+                    .build()
+                    .parseClaimsJws(token)
+                    .getBody();
+        } catch (JwtException | IllegalArgumentException e) {
+            logger.warn("Invalid OIDC access token: {}", e.getMessage());
+            throw new CustomJwtException(ErrorCode.INVALID_TOKEN);
+        }
+    }
+
     /**
      * Token 유효성 검사
      */
     public void validateToken(String token) {
         try {
             Jwts.parserBuilder()
-                .setSigningKey(publicKey)
+                .setSigningKeyResolver(signingKeyResolver)
                 .build()
                 .parseClaimsJws(token);
         } catch (io.jsonwebtoken.security.SignatureException e) {
@@ -153,7 +393,7 @@ public class JwtTokenProvider {
     public UUID getUserIdFromToken(String token) {
         try {
             Claims claims = Jwts.parserBuilder()
-                    .setSigningKey(publicKey)
+                    .setSigningKeyResolver(signingKeyResolver)
                     .build()
                     .parseClaimsJws(token)
                     .getBody();
@@ -169,7 +409,7 @@ public class JwtTokenProvider {
     public Date getExpirationDateFromToken(String token) {
         try {
             Claims claims = Jwts.parserBuilder()
-                    .setSigningKey(publicKey)
+                    .setSigningKeyResolver(signingKeyResolver)
                     .build()
                     .parseClaimsJws(token)
                     .getBody();
@@ -187,7 +427,7 @@ public class JwtTokenProvider {
     public String getTokenType(String token) {
         try {
             Claims claims = Jwts.parserBuilder()
-                    .setSigningKey(publicKey)
+                    .setSigningKeyResolver(signingKeyResolver)
                     .build()
                     .parseClaimsJws(token)
                     .getBody();
@@ -203,7 +443,7 @@ public class JwtTokenProvider {
     public String getEmailFromToken(String token) {
         try {
             Claims claims = Jwts.parserBuilder()
-                    .setSigningKey(publicKey)
+                    .setSigningKeyResolver(signingKeyResolver)
                     .build()
                     .parseClaimsJws(token)
                     .getBody();
@@ -214,17 +454,65 @@ public class JwtTokenProvider {
     }
 
     /**
-     * RSA 공개키 반환 (JWKS 엔드포인트용)
+     * Token에서 jti 추출 (jti 도입 이전 토큰은 null)
+     */
+    public String getJtiFromToken(String token) {
+        try {
+            Claims claims = Jwts.parserBuilder()
+                    .setSigningKeyResolver(signingKeyResolver)
+                    .build()
+                    .parseClaimsJws(token)
+                    .getBody();
+            return claims.getId();
+        } catch (Exception e) {
+            return null;
+        }
+    }
+
+    /**
+     * Token에서 세션 ID 추출 (세션 관리 도입 이전 토큰은 null)
      */
-    public RSAPublicKey getPublicKey() {
-        return publicKey;
+    public String getSessionIdFromToken(String token) {
+        try {
+            Claims claims = Jwts.parserBuilder()
+                    .setSigningKeyResolver(signingKeyResolver)
+                    .build()
+                    .parseClaimsJws(token)
+                    .getBody();
+            return claims.get("sid", String.class);
+        } catch (Exception e) {
+            return null;
+        }
     }
 
     /**
-     * Key ID 반환
+     * Token 발급 시간 가져오기
      */
-    public String getKeyId() {
-        return keyId;
+    public Date getIssuedAtFromToken(String token) {
+        try {
+            Claims claims = Jwts.parserBuilder()
+                    .setSigningKeyResolver(signingKeyResolver)
+                    .build()
+                    .parseClaimsJws(token)
+                    .getBody();
+            return claims.getIssuedAt();
+        } catch (Exception e) {
+            throw new CustomJwtException(ErrorCode.INVALID_TOKEN);
+        }
+    }
+
+    /**
+     * 기본 Access Token 수명 (워크스페이스 토큰 정책이 없을 때)
+     */
+    public long getAccessTokenExpirationMs() {
+        return accessTokenExpirationMs;
+    }
+
+    /**
+     * Refresh Token 수명 (사용자 단위 폐기 TTL용)
+     */
+    public long getRefreshTokenExpirationMs() {
+        return refreshTokenExpirationMs;
     }
 
     /**
@@ -233,4 +521,15 @@ public class JwtTokenProvider {
     public String getIssuer() {
         return issuer;
     }
+
+    /**
+     * OIDC issuer 반환 (discovery 문서의 issuer, 외부 URL)
+     */
+    public String getOidcIssuer() {
+        return oidcIssuer;
+    }
+
+    private String getMagicLinkIssuer() {
+        return issuer + "/magic-link";
+    }
 }
@@ -0,0 +1,33 @@
+package OrangeCloud.AuthService.util;
+
+import java.security.interfaces.RSAPrivateKey;
+import java.security.interfaces.RSAPublicKey;
+import java.time.Instant;
+
+/**
+ * JWT 서명 키 (RS256)
+ *
+ * @param kid         JWKS kid
+ * @param activatesAt 이 시각부터 서명에 사용 (그 전에는 JWKS에만 게시)
+ * @param expiresAt   JWKS 게시 종료 시각 - 다음 키로 교체되기 전에는 null
+ */
+public record SigningKey(
+        String kid,
+        RSAPublicKey publicKey,
+        RSAPrivateKey privateKey,
+        Instant activatesAt,
+        Instant expiresAt
+) {
+
+    public boolean isActive(Instant now) {
+        return !activatesAt.isAfter(now) && (expiresAt == null || expiresAt.isAfter(now));
+    }
+
+    public boolean isPublished(Instant now) {
+        return expiresAt == null || expiresAt.isAfter(now);
+    }
+
+    public SigningKey retire(Instant expiresAt) {
+        return new SigningKey(kid, publicKey, privateKey, activatesAt, expiresAt);
+    }
+}
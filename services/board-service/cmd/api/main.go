@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,11 +12,21 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/robfig/cron/v3"
 
+	commonauth "github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/config"
@@ -89,6 +100,14 @@ func main() {
 	}
 	defer log.Sync()
 
+	// Runtime settings (RUNTIME_CONFIG_PATH): 로그 레벨, rate limit, CORS origin, feature flag를
+	// ConfigMap 변경 또는 SIGHUP 시 재시작 없이 다시 적용합니다.
+	runtimeCfg := commonconfig.WatcherFromEnv(log.Logger)
+	runtimeCfg.WatchLogLevel(log.Level())
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("board-service")
@@ -129,6 +148,7 @@ func main() {
 		MaxOpenConns:    cfg.Database.MaxOpenConns,
 		MaxIdleConns:    cfg.Database.MaxIdleConns,
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		ReplicaDSNs:     cfg.Database.ReplicaDSNs,
 	}
 
 	db, err := database.New(dbConfig)
@@ -153,6 +173,14 @@ func main() {
 		)
 	}
 
+	// Route heavy list reads to read replicas (optional)
+	replicas, err := database.EnableReadReplicas(db, dbConfig, log.Logger)
+	if err != nil {
+		log.Warn("Failed to enable read replicas, all reads use primary", zap.Error(err))
+	} else if replicas != nil {
+		log.Info("Read replica routing enabled", zap.Int("replicas", len(dbConfig.ReplicaDSNs)))
+	}
+
 	// Initialize metrics with logger
 	log.Info("Initializing Prometheus metrics")
 	m := metrics.NewWithLogger(log.Logger)
@@ -208,6 +236,44 @@ func main() {
 		zap.String("config_source", "Loaded from config.yaml and environment variables"),
 	)
 
+	// Service token for user-service calls without a user token (client-credentials, users:read)
+	var userClientOpts []client.UserClientOption
+	if cfg.AuthAPI.ServiceClientID != "" && cfg.AuthAPI.ServiceClientSecret != "" {
+		userClientOpts = append(userClientOpts, client.WithServiceTokens(commonauth.NewClientCredentialsSource(
+			cfg.AuthAPI.BaseURL,
+			cfg.AuthAPI.ServiceClientID,
+			cfg.AuthAPI.ServiceClientSecret,
+			[]string{"users:read"},
+		)))
+		log.Info("Service client credentials configured", zap.String("client_id", cfg.AuthAPI.ServiceClientID))
+	}
+
+	// Retry idempotent user-service lookups on transient failures (backoff + jitter)
+	userRetry := commonclient.DefaultRetryConfig()
+	userRetry.MaxAttempts = cfg.UserAPI.RetryMaxAttempts
+	userRetry.InitialBackoff = cfg.UserAPI.RetryInitialBackoff
+	userClientOpts = append(userClientOpts, client.WithRetry(userRetry))
+
+	// Internal gRPC API for validate-member / workspace profile (REST fallback)
+	if cfg.UserAPI.GRPCAddr != "" {
+		var dialOpts []internalrpc.DialOption
+		if cfg.AuthAPI.ServiceClientID != "" && cfg.AuthAPI.ServiceClientSecret != "" {
+			dialOpts = append(dialOpts, internalrpc.WithServiceTokens(commonauth.NewClientCredentialsSource(
+				cfg.AuthAPI.BaseURL,
+				cfg.AuthAPI.ServiceClientID,
+				cfg.AuthAPI.ServiceClientSecret,
+				[]string{"users:read"},
+			)))
+		}
+		userConn, err := internalrpc.Dial(cfg.UserAPI.GRPCAddr, dialOpts...)
+		if err != nil {
+			log.Fatal("Failed to create user-service gRPC client", zap.Error(err))
+		}
+		defer userConn.Close()
+		userClientOpts = append(userClientOpts, client.WithGRPC(internalrpc.NewUserServiceClient(userConn)))
+		log.Info("User gRPC client configured", zap.String("address", cfg.UserAPI.GRPCAddr))
+	}
+
 	// Initialize User API client (with Auth service URL for token validation)
 	userClient := client.NewUserClient(
 		cfg.UserAPI.BaseURL,
@@ -215,6 +281,7 @@ func main() {
 		cfg.UserAPI.Timeout,
 		log.Logger,
 		m,
+		userClientOpts...,
 	)
 
 	log.Info("User API client initialized successfully",
@@ -237,7 +304,13 @@ func main() {
 
 	// Initialize Noti API client (optional - for sending notifications)
 	var notiClient client.NotiClient
-	if cfg.NotiAPI.BaseURL != "" {
+	if cfg.NotiAPI.NATSURL != "" {
+		// 이벤트 스트림으로 발행 (noti-service 장애 시에도 유실 없음)
+		notiClient = client.NewNotiEventClient(cfg.NotiAPI.NATSURL, cfg.NotiAPI.EventSubject, log.Logger)
+		log.Info("Noti event publisher initialized successfully",
+			zap.String("subject", cfg.NotiAPI.EventSubject),
+		)
+	} else if cfg.NotiAPI.BaseURL != "" {
 		notiClient = client.NewNotiClient(
 			cfg.NotiAPI.BaseURL,
 			cfg.NotiAPI.InternalAPIKey,
@@ -250,7 +323,7 @@ func main() {
 			zap.Duration("timeout", cfg.NotiAPI.Timeout),
 		)
 	} else {
-		log.Warn("Noti API client not initialized - NOTI_SERVICE_URL not configured")
+		log.Warn("Noti API client not initialized - NATS_URL or NOTI_SERVICE_URL not configured")
 	}
 
 	// Initialize attachment repository for cleanup job
@@ -284,8 +357,14 @@ func main() {
 	)
 
 	// Setup router with dependency injection
+	// srv.Shutdown 이후 실행할 정리 단계 (WebSocket, 백그라운드 작업, 아웃박스)
+	shutdown := lifecycle.NewShutdown(log.Logger)
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	shutdown.Add("profiling", profiling.Start(profiling.ConfigFromEnv(), log.Logger))
+	var relay *outbox.Relay
 	routerConfig := router.Config{
 		DB:              db,
+		Replicas:        replicas,
 		Logger:          log.Logger,
 		JWTSecret:       cfg.JWT.Secret, // Deprecated: kept for backward compatibility
 		AuthServiceURL:  cfg.AuthAPI.BaseURL,
@@ -298,10 +377,91 @@ func main() {
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
 		ServiceName:     "board-service",
+		Runtime:         runtimeCfg,
+		Shutdown:        shutdown,
+		SearchClient: client.NewSearchClient(client.SearchClientConfig{
+			UserBaseURL:    cfg.UserAPI.BaseURL,
+			ChatBaseURL:    cfg.Search.ChatBaseURL,
+			StorageBaseURL: cfg.Search.StorageBaseURL,
+			Timeout:        cfg.Search.Timeout,
+		}, log.Logger, m),
+		InternalAPIKey: cfg.NotiAPI.InternalAPIKey, // INTERNAL_API_KEY (서비스 간 공유 키)
+	}
+
+	// Domain events (board changes) on the platform event bus
+	if cfg.Events.NATSURL != "" {
+		eventPublisher := messaging.NewEventPublisher(messaging.Config{URL: cfg.Events.NATSURL, Name: "board-service"}, log.Logger)
+		defer eventPublisher.Close()
+
+		if cfg.Events.Outbox {
+			// 보드 변경과 같은 트랜잭션에 이벤트를 기록하고 릴레이가 발행 (커밋 후 유실 없음)
+			if err := outbox.Migrate(db); err != nil {
+				log.Fatal("Failed to migrate outbox table", zap.Error(err))
+			}
+			boardOutbox := outbox.New(db, "board-service")
+			routerConfig.Outbox = boardOutbox
+			if cfg.NotiAPI.NATSURL != "" {
+				routerConfig.NotiClient = client.NewNotiOutboxClient(boardOutbox, cfg.NotiAPI.EventSubject, log.Logger)
+			}
+
+			relayCtx, stopRelay := context.WithCancel(context.Background())
+			defer stopRelay()
+			relay = outbox.NewRelay(db, eventPublisher, outbox.RelayConfig{}, log.Logger)
+			go relay.Run(relayCtx)
+			log.Info("Domain event publishing enabled (transactional outbox)")
+		} else {
+			routerConfig.Events = messaging.NewEmitter(eventPublisher, "board-service", log.Logger)
+			log.Info("Domain event publishing enabled")
+		}
 	}
 
+	// user-service 멤버 변경 이벤트로 워크스페이스 멤버십 Redis 캐시를 무효화
+	var cacheInvalidator *cache.Invalidator
+	if cfg.Events.NATSURL != "" && routerConfig.RedisClient != nil {
+		cacheInvalidator = cache.NewInvalidator()
+		routerConfig.CacheInvalidator = cacheInvalidator
+	}
+
+	// Internal gRPC API (alongside REST, same repositories)
+	// 항상 boards:read scope의 서비스 토큰 필요 (검증할 auth-service가 없으면 gRPC 서버를 열지 않음)
+	var grpcServer *grpc.Server
+	if cfg.Server.GRPCPort != "" {
+		if cfg.AuthAPI.BaseURL != "" {
+			grpcServer = internalrpc.NewServer(internalrpc.ServerConfig{
+				Logger:           log.Logger,
+				ServiceValidator: commonauth.NewSmartValidator(cfg.AuthAPI.BaseURL, cfg.AuthAPI.JWTIssuer, log.Logger),
+				Scopes: map[string][]string{
+					internalrpc.BoardServiceBatchGetBoardsMethod: {"boards:read"},
+				},
+			})
+			routerConfig.GRPCServer = grpcServer
+		} else {
+			log.Warn("gRPC server disabled: service token validation requires AUTH_SERVICE_URL")
+		}
+	}
+	// 반복 보드 생성과 마감 임박 알림 작업은 라우터가 만든 board service로 같은 스케줄러에 등록
+	routerConfig.Scheduler = c
+	routerConfig.DueReminderWindows = cfg.Reminder.DueSoonWindows
+	routerConfig.TrashRetention = cfg.Trash.Retention
+
 	r := router.Setup(routerConfig)
 
+	// 라우터가 무효화 대상을 모두 등록한 뒤 구독 시작 (Redis를 공유하므로 한 레플리카만 처리)
+	if cacheInvalidator != nil {
+		invalidationCtx, stopInvalidation := context.WithCancel(context.Background())
+		defer stopInvalidation()
+		go messaging.NewSubscriber(messaging.SubscriberConfig{
+			Config:        messaging.Config{URL: cfg.Events.NATSURL, Name: "board-service"},
+			Durable:       "board-service-cache",
+			FilterSubject: "events.user.member.>",
+		}, cacheInvalidator.Handle, log.Logger).Run(invalidationCtx)
+	}
+
+	// 마지막 요청과 알림까지 커밋된 아웃박스 이벤트를 종료 전에 한 번 더 발행합니다.
+	if relay != nil {
+		shutdown.Add("outbox", relay.Flush)
+	}
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
@@ -320,6 +480,19 @@ func main() {
 
 	log.Info("Server started successfully", zap.String("port", cfg.Server.Port))
 
+	if grpcServer != nil {
+		lis, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
+		if err != nil {
+			log.Fatal("Failed to listen for gRPC", zap.Error(err))
+		}
+		go func() {
+			log.Info("gRPC server starting", zap.String("address", lis.Addr().String()))
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Fatal("Failed to start gRPC server", zap.Error(err))
+			}
+		}()
+	}
+
 	// Wait for interrupt signal for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	// SIGINT (Ctrl+C) and SIGTERM (kill) signals
@@ -346,6 +519,12 @@ func main() {
 	} else {
 		log.Info("Server shutdown completed, all in-flight requests completed")
 	}
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
+
+	// srv.Shutdown이 기다리지 않는 WebSocket 연결, 알림 고루틴, 아웃박스 이벤트 정리
+	shutdown.Run(ctx)
 
 	// Stop business metrics collector
 	log.Info("Stopping business metrics collector")
@@ -358,6 +537,12 @@ func main() {
 	<-cronCtx.Done()
 	log.Info("Cleanup job scheduler stopped")
 
+	if replicas != nil {
+		if err := replicas.Close(); err != nil {
+			log.Error("Failed to close read replicas", zap.Error(err))
+		}
+	}
+
 	// Close database connection.
 	log.Info("Closing database connection")
 	if err := database.Close(db); err != nil {
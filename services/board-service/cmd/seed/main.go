@@ -0,0 +1,73 @@
+// Command seed fills the board-service database with the shared development dataset
+// (projects, members, field options, boards). Run it after the user-service seed command.
+//
+//	go run ./cmd/seed [-migrate]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"project-board-api/internal/config"
+	"project-board-api/internal/database"
+	"project-board-api/internal/seed"
+)
+
+func main() {
+	migrate := flag.Bool("migrate", false, "run auto migration before seeding")
+	flag.Parse()
+
+	if err := commonseed.CheckEnvironment(os.Getenv("ENV")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load("configs/config.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	log, err := commonlogger.NewWithConfig(&commonlogger.Config{Level: "info", OutputPath: "stdout", Encoding: "console"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = log.Sync() }()
+
+	db, err := database.New(database.Config{
+		DSN:             cfg.Database.GetDSN(),
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+	})
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer func() { _ = database.Close(db) }()
+
+	if *migrate {
+		if err := database.SafeAutoMigrate(db, log.Logger); err != nil {
+			log.Fatal("Failed to run database migrations", zap.Error(err))
+		}
+	}
+
+	data := commonseed.Default()
+	if err := seed.Run(context.Background(), db, data); err != nil {
+		log.Fatal("Failed to seed board-service", zap.Error(err))
+	}
+
+	boards := 0
+	for _, p := range data.Projects {
+		boards += len(p.Boards)
+	}
+	log.Info("Seeded board-service",
+		zap.Int("projects", len(data.Projects)),
+		zap.Int("boards", boards))
+}
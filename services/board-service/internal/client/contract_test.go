@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/contract"
+)
+
+// 계약 파일(board-service -> user-service, noti-service)의 요청을 실제 클라이언트가 보내는지 확인합니다.
+// provider 쪽 검증은 user-service, noti-service의 contract 테스트에 있습니다.
+
+func TestUserClientContract_ValidateWorkspaceMember(t *testing.T) {
+	c := contract.Load(t, "board-service", "user-service")
+	provider := contract.NewMockProvider(t, c)
+	client := NewUserClient(provider.URL, provider.URL, 5*time.Second, zap.NewNop(), nil)
+
+	for _, i := range c.Interactions {
+		workspaceID := uuid.MustParse(i.Params["workspaceId"])
+		userID := uuid.MustParse(i.Params["userId"])
+
+		isMember, err := client.ValidateWorkspaceMember(context.Background(), workspaceID, userID, "token")
+		if err != nil {
+			t.Fatalf("%s: ValidateWorkspaceMember() error = %v", i.Description, err)
+		}
+		want := i.ProviderState == "user is a member of the workspace"
+		if isMember != want {
+			t.Errorf("%s: ValidateWorkspaceMember() = %v, want %v", i.Description, isMember, want)
+		}
+	}
+}
+
+func TestNotiClientContract(t *testing.T) {
+	c := contract.Load(t, "board-service", "noti-service")
+	provider := contract.NewMockProvider(t, c,
+		"create a board assigned notification",
+		"create bulk board notifications",
+	)
+	client := NewNotiClient(provider.URL, "internal-key", 5*time.Second, zap.NewNop(), nil)
+	ctx := context.Background()
+
+	event := NewBoardAssignedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "Roadmap")
+	if err := client.SendNotification(ctx, event); err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+
+	events := []*NotificationEvent{
+		NewBoardParticipantAddedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "Roadmap"),
+	}
+	if err := client.SendBulkNotifications(ctx, events); err != nil {
+		t.Fatalf("SendBulkNotifications() error = %v", err)
+	}
+}
+
+func TestNotiEventClientContract(t *testing.T) {
+	message := contract.Load(t, "board-service", "noti-service").Message(t, "board assigned event")
+	publisher := &fakePublisher{}
+	client := newNotiEventClient(publisher, "notifications.events.board", zap.NewNop())
+
+	event := NewBoardAssignedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "Roadmap")
+	if err := client.SendNotification(context.Background(), event); err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("expected one published event, got %d", len(publisher.events))
+	}
+	contract.MatchMessage(t, message, publisher.subjects[0], publisher.events[0])
+}
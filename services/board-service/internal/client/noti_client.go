@@ -1,23 +1,22 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient/notiapi"
 	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"project-board-api/internal/metrics"
 )
 
-// NotificationType defines notification types matching noti-service
+// NotificationType defines notification types matching noti-service (see notiapi.NotificationType)
 type NotificationType string
 
 const (
@@ -30,6 +29,10 @@ const (
 	NotificationTypeBoardCommentAdded    NotificationType = "BOARD_COMMENT_ADDED"
 	NotificationTypeBoardDueSoon         NotificationType = "BOARD_DUE_SOON"
 	NotificationTypeBoardOverdue         NotificationType = "BOARD_OVERDUE"
+
+	// Comment notification types
+	NotificationTypeCommentMention NotificationType = "COMMENT_MENTION"
+	NotificationTypeCommentReply   NotificationType = "COMMENT_REPLY"
 )
 
 // ResourceType defines resource types matching noti-service
@@ -58,37 +61,37 @@ type NotiClient interface {
 	SendBulkNotifications(ctx context.Context, events []*NotificationEvent) error
 }
 
-// notiClient implements NotiClient interface
+// notiClient implements NotiClient interface on top of the client generated from noti-service's swagger spec
 type notiClient struct {
 	*commonclient.BaseHTTPClient
-	internalAPIKey string
-	metrics        *metrics.Metrics
+	noti    *notiapi.Client
+	metrics *metrics.Metrics
 }
 
 // NewNotiClient creates a new Notification API client
 func NewNotiClient(baseURL string, internalAPIKey string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics) NotiClient {
-	return &notiClient{
+	c := &notiClient{
 		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
-		internalAPIKey: internalAPIKey,
 		metrics:        m,
 	}
+	transport := apiclient.NewTransport(baseURL, c.HTTPClient, apiclient.WithHeader("x-internal-api-key", internalAPIKey))
+	transport.Observe = c.observe
+	c.noti = notiapi.New(transport)
+	return c
 }
 
 // SendNotification sends a notification to noti-service
 // This is designed to be called asynchronously (in a goroutine) so notification
 // failures don't affect the main business logic
 func (c *notiClient) SendNotification(ctx context.Context, event *NotificationEvent) error {
-	log := c.log(ctx)
-	url := c.BuildURL("/internal/notifications")
-
-	log.Debug("Sending notification",
+	c.log(ctx).Debug("Sending notification",
 		zap.String("peer.service", "noti-service"),
-		zap.String("http.url", url),
 		zap.String("notification.type", string(event.Type)),
 		zap.String("target.user.id", event.TargetUserID.String()),
 	)
 
-	return c.doRequest(ctx, url, event)
+	_, err := c.noti.CreateNotification(ctx, event.toAPI())
+	return c.handleError(ctx, "createNotification", err)
 }
 
 // SendBulkNotifications sends multiple notifications to noti-service
@@ -97,20 +100,17 @@ func (c *notiClient) SendBulkNotifications(ctx context.Context, events []*Notifi
 		return nil
 	}
 
-	log := c.log(ctx)
-	url := c.BuildURL("/internal/notifications/bulk")
-
-	payload := map[string]interface{}{
-		"notifications": events,
-	}
-
-	log.Debug("Sending bulk notifications",
+	c.log(ctx).Debug("Sending bulk notifications",
 		zap.String("peer.service", "noti-service"),
-		zap.String("http.url", url),
 		zap.Int("count", len(events)),
 	)
 
-	return c.doRequest(ctx, url, payload)
+	request := &notiapi.CreateBulkNotificationsRequest{Notifications: make([]notiapi.NotificationEvent, len(events))}
+	for i, event := range events {
+		request.Notifications[i] = *event.toAPI()
+	}
+	_, err := c.noti.CreateBulkNotifications(ctx, request)
+	return c.handleError(ctx, "createBulkNotifications", err)
 }
 
 // log returns a trace-context aware logger
@@ -118,68 +118,57 @@ func (c *notiClient) log(ctx context.Context) *zap.Logger {
 	return commnotel.WithTraceContext(ctx, c.Logger)
 }
 
-// doRequest performs the HTTP POST request to noti-service
-func (c *notiClient) doRequest(ctx context.Context, url string, payload interface{}) error {
-	startTime := time.Now()
-	log := c.log(ctx)
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		log.Error("Failed to marshal notification payload", zap.Error(err))
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		log.Error("Failed to create notification request", zap.Error(err))
-		return fmt.Errorf("failed to create request: %w", err)
+// observe records every noti-service request in the external API metrics
+func (c *notiClient) observe(method, url string, status int, duration time.Duration, err error) {
+	if c.metrics != nil {
+		c.metrics.RecordExternalAPICall(url, method, status, duration, err)
 	}
+}
 
-	// Inject W3C Trace Context headers for distributed tracing
-	commnotel.InjectTraceHeaders(ctx, req)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-internal-api-key", c.internalAPIKey)
-
-	resp, err := c.HTTPClient.Do(req)
-	duration := time.Since(startTime)
-
-	// Record metrics
-	statusCode := 0
-	if resp != nil {
-		statusCode = resp.StatusCode
-	}
-	if c.metrics != nil {
-		c.metrics.RecordExternalAPICall(url, "POST", statusCode, duration, err)
+// handleError applies the notification failure policy to the result of a noti-service call
+func (c *notiClient) handleError(ctx context.Context, operation string, err error) error {
+	log := c.log(ctx)
+	if err == nil {
+		log.Debug("Notification sent successfully", zap.String("operation", operation))
+		return nil
 	}
 
-	if err != nil {
-		log.Error("Failed to send notification",
-			zap.Error(err),
-			zap.String("http.url", url),
-			zap.Duration("http.duration", duration),
-		)
-		return fmt.Errorf("failed to send notification: %w", err)
+	if commonclient.IsCircuitOpen(err) {
+		// noti-service 장애 중 알림은 버림 (알림 실패는 치명적이지 않음)
+		log.Warn("Noti service circuit open, notification dropped", zap.String("operation", operation))
+		return nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		respBody, _ := io.ReadAll(resp.Body)
+	var statusErr *commonclient.StatusError
+	if errors.As(err, &statusErr) {
 		log.Warn("Noti service returned error status",
-			zap.Int("http.status_code", resp.StatusCode),
-			zap.String("http.url", url),
-			zap.String("response.body", string(respBody)),
-			zap.Duration("http.duration", duration),
+			zap.Int("http.status_code", statusErr.StatusCode),
+			zap.String("operation", operation),
+			zap.String("error", statusErr.Message),
 		)
 		// 알림 전송 실패는 치명적이지 않으므로 로그만 남기고 에러 반환하지 않음
 		return nil
 	}
 
-	log.Debug("Notification sent successfully",
-		zap.Int("http.status_code", resp.StatusCode),
-		zap.Duration("http.duration", duration),
-	)
+	log.Error("Failed to send notification", zap.Error(err), zap.String("operation", operation))
+	return fmt.Errorf("failed to send notification: %w", err)
+}
 
-	return nil
+// toAPI converts the event to the noti-service request type
+func (e *NotificationEvent) toAPI() *notiapi.NotificationEvent {
+	event := &notiapi.NotificationEvent{
+		Type:         notiapi.NotificationType(e.Type),
+		ActorID:      e.ActorID.String(),
+		TargetUserID: e.TargetUserID.String(),
+		WorkspaceID:  e.WorkspaceID.String(),
+		ResourceType: notiapi.ResourceType(e.ResourceType),
+		ResourceID:   e.ResourceID.String(),
+		Metadata:     e.Metadata,
+	}
+	if e.ResourceName != nil {
+		event.ResourceName = *e.ResourceName
+	}
+	return event
 }
 
 // NewBoardAssignedNotification creates a notification for board assignment
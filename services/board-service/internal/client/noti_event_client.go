@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+)
+
+// EventPublisher publishes JSON events to the event stream (messaging.Publisher)
+type EventPublisher interface {
+	Publish(ctx context.Context, subject string, event interface{}) error
+}
+
+// notiEventClient implements NotiClient by publishing to the notification event stream
+// noti-service가 내려가 있어도 이벤트는 스트림에 보관되었다가 전달됩니다.
+type notiEventClient struct {
+	publisher EventPublisher
+	subject   string
+	logger    *zap.Logger
+	queued    bool // publisher only records events locally (outbox)
+}
+
+// NewNotiEventClient creates a NotiClient that publishes notification events to NATS JetStream
+func NewNotiEventClient(natsURL, subject string, logger *zap.Logger) NotiClient {
+	publisher := messaging.NewPublisher(messaging.Config{URL: natsURL, Name: "board-service"}, logger)
+	return newNotiEventClient(publisher, subject, logger)
+}
+
+// NewNotiOutboxClient creates a NotiClient that records notification events in the transactional outbox
+// 아웃박스 릴레이가 스트림으로 발행하므로 NATS가 잠시 내려가 있어도 알림이 유실되지 않습니다.
+func NewNotiOutboxClient(ob *outbox.Outbox, subject string, logger *zap.Logger) NotiClient {
+	c := newNotiEventClient(ob, subject, logger)
+	c.queued = true
+	return c
+}
+
+// IsQueued reports whether c only records notifications locally, so sending is cheap enough to do inline
+func IsQueued(c NotiClient) bool {
+	ec, ok := c.(*notiEventClient)
+	return ok && ec.queued
+}
+
+func newNotiEventClient(publisher EventPublisher, subject string, logger *zap.Logger) *notiEventClient {
+	return &notiEventClient{
+		publisher: publisher,
+		subject:   subject,
+		logger:    logger,
+	}
+}
+
+// SendNotification publishes a notification event
+func (c *notiEventClient) SendNotification(ctx context.Context, event *NotificationEvent) error {
+	log := commnotel.WithTraceContext(ctx, c.logger)
+
+	if err := c.publisher.Publish(ctx, c.subject, event); err != nil {
+		log.Error("Failed to publish notification event",
+			zap.String("messaging.destination", c.subject),
+			zap.String("notification.type", string(event.Type)),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	log.Debug("Notification event published",
+		zap.String("messaging.destination", c.subject),
+		zap.String("notification.type", string(event.Type)),
+		zap.String("target.user.id", event.TargetUserID.String()),
+	)
+	return nil
+}
+
+// SendBulkNotifications publishes each notification as its own event
+// 이벤트마다 별도로 발행하므로 일부 실패 시에도 나머지는 전달됩니다.
+func (c *notiEventClient) SendBulkNotifications(ctx context.Context, events []*NotificationEvent) error {
+	var errs []error
+	for _, event := range events {
+		if err := c.SendNotification(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// fakePublisher records published events
+type fakePublisher struct {
+	subjects []string
+	events   []interface{}
+	err      error
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, subject string, event interface{}) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.subjects = append(p.subjects, subject)
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestNotiEventClient_SendNotification(t *testing.T) {
+	publisher := &fakePublisher{}
+	c := newNotiEventClient(publisher, "notifications.events.board", zap.NewNop())
+
+	event := NewBoardAssignedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "Roadmap")
+	if err := c.SendNotification(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(publisher.events) != 1 || publisher.events[0] != event {
+		t.Fatalf("expected the event to be published once, got %d", len(publisher.events))
+	}
+	if publisher.subjects[0] != "notifications.events.board" {
+		t.Errorf("unexpected subject: %s", publisher.subjects[0])
+	}
+}
+
+func TestNotiEventClient_SendBulkNotifications(t *testing.T) {
+	publisher := &fakePublisher{}
+	c := newNotiEventClient(publisher, "notifications.events.board", zap.NewNop())
+
+	events := []*NotificationEvent{
+		NewBoardAssignedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "A"),
+		NewBoardAssignedNotification(uuid.New(), uuid.New(), uuid.New(), uuid.New(), "B"),
+	}
+	if err := c.SendBulkNotifications(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(publisher.events) != 2 {
+		t.Errorf("expected each event to be published, got %d", len(publisher.events))
+	}
+
+	// 발행 실패는 호출자에게 전달
+	publisher.err = errors.New("stream unavailable")
+	if err := c.SendBulkNotifications(context.Background(), events); err == nil {
+		t.Error("expected an error when publishing fails")
+	}
+}
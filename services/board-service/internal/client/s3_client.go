@@ -3,6 +3,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -15,6 +16,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/google/uuid"
 )
 
@@ -24,9 +27,23 @@ type S3ClientInterface interface {
 	GeneratePresignedURL(ctx context.Context, entityType, workspaceID, fileName, contentType string) (string, string, error)
 	UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFile(ctx context.Context, key string) error
+	HeadObject(ctx context.Context, key string) (*ObjectInfo, error)
 	GetFileURL(key string) string
 }
 
+var (
+	// ErrObjectNotFound is returned when an S3 object does not exist
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrObjectTooLarge is returned by GetObject when the object exceeds the byte limit
+	ErrObjectTooLarge = errors.New("object too large")
+)
+
+// ObjectInfo holds metadata of an S3 object
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
 // S3Client wraps AWS S3 client and implements S3ClientInterface
 type S3Client struct {
 	client         *s3.Client
@@ -242,6 +259,57 @@ func (c *S3Client) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
+// HeadObject gets object metadata without downloading it
+// presigned URL로 업로드된 객체가 실제로 존재하는지 확인할 때 사용합니다.
+func (c *S3Client) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	out, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		var apiErr smithy.APIError
+		if errors.As(err, &notFound) || (errors.As(err, &apiErr) && apiErr.ErrorCode() == "NotFound") {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+
+	return &ObjectInfo{
+		Size:        aws.ToInt64(out.ContentLength),
+		ContentType: aws.ToString(out.ContentType),
+	}, nil
+}
+
+// GetObject downloads an object into memory
+// maxBytes보다 큰 객체는 읽지 않고 에러를 반환합니다.
+func (c *S3Client) GetObject(ctx context.Context, key string, maxBytes int64) ([]byte, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer out.Body.Close()
+
+	if aws.ToInt64(out.ContentLength) > maxBytes {
+		return nil, ErrObjectTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(out.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, ErrObjectTooLarge
+	}
+	return data, nil
+}
+
 // GetFileURL returns the public URL for a file
 // S3 Key를 기반으로 다운로드 가능한 URL을 생성합니다.
 func (c *S3Client) GetFileURL(key string) string {
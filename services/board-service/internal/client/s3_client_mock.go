@@ -22,6 +22,7 @@ type MockS3Client struct {
 	GeneratePresignedURLFunc func(ctx context.Context, entityType, workspaceID, fileName, contentType string) (string, string, error)
 	UploadFileFunc           func(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFileFunc           func(ctx context.Context, key string) error
+	HeadObjectFunc           func(ctx context.Context, key string) (*ObjectInfo, error)
 	GetFileURLFunc           func(key string) string
 }
 
@@ -123,6 +124,16 @@ func (m *MockS3Client) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
+// HeadObject simulates reading object metadata
+func (m *MockS3Client) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	if m.HeadObjectFunc != nil {
+		return m.HeadObjectFunc(ctx, key)
+	}
+
+	// Default implementation - object does not exist
+	return nil, ErrObjectNotFound
+}
+
 // GetFileURL returns the public URL for a file
 func (m *MockS3Client) GetFileURL(key string) string {
 	if m.GetFileURLFunc != nil {
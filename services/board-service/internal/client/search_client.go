@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient/userapi"
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"project-board-api/internal/metrics"
+)
+
+// ErrSearchSourceDisabled is returned by SearchClient when the owning service URL is not configured
+var ErrSearchSourceDisabled = errors.New("search source not configured")
+
+// MessageHit is a chat message returned by chat-service message search
+type MessageHit struct {
+	MessageID uuid.UUID `json:"messageId"`
+	ChatID    uuid.UUID `json:"chatId"`
+	ChatName  string    `json:"chatName"`
+	UserID    uuid.UUID `json:"userId"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// FileHit is a file returned by storage-service file search
+type FileHit struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	OriginalName string    `json:"originalName"`
+	FileURL      string    `json:"fileUrl"`
+	FileSize     int64     `json:"fileSize"`
+	ContentType  string    `json:"contentType"`
+	Tags         []string  `json:"tags"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// MemberHit is a workspace member whose nickname or email matched the query
+type MemberHit struct {
+	UserID          uuid.UUID
+	NickName        string
+	Email           string
+	ProfileImageURL string
+	RoleName        string
+}
+
+// SearchClient fetches global search hits owned by other services.
+// Every call uses the requesting user's token, so each service applies its own access rules.
+type SearchClient interface {
+	SearchMessages(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]MessageHit, error)
+	SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]FileHit, error)
+	SearchMembers(ctx context.Context, workspaceID uuid.UUID, query string, token string) ([]MemberHit, error)
+}
+
+// SearchClientConfig holds the base URLs of the services searched by SearchClient (empty = source disabled)
+type SearchClientConfig struct {
+	UserBaseURL    string
+	ChatBaseURL    string
+	StorageBaseURL string
+	Timeout        time.Duration
+}
+
+// searchClient implements SearchClient on top of apiclient transports.
+// user-service goes through the generated userapi client; chat/storage have no spec yet.
+type searchClient struct {
+	*commonclient.BaseHTTPClient
+	users   *userapi.Client
+	chat    *apiclient.Transport
+	storage *apiclient.Transport
+	metrics *metrics.Metrics
+}
+
+// NewSearchClient creates a new SearchClient
+func NewSearchClient(config SearchClientConfig, logger *zap.Logger, m *metrics.Metrics) SearchClient {
+	c := &searchClient{
+		BaseHTTPClient: commonclient.NewBaseHTTPClient("", config.Timeout, logger),
+		metrics:        m,
+	}
+	if config.UserBaseURL != "" {
+		c.users = userapi.New(c.transport(config.UserBaseURL))
+	}
+	if config.ChatBaseURL != "" {
+		c.chat = c.transport(config.ChatBaseURL)
+	}
+	if config.StorageBaseURL != "" {
+		c.storage = c.transport(config.StorageBaseURL)
+	}
+	return c
+}
+
+// SearchMessages searches messages in the chats the user participates in (GET /api/chats/messages/search)
+func (c *searchClient) SearchMessages(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]MessageHit, error) {
+	if c.chat == nil {
+		return nil, ErrSearchSourceDisabled
+	}
+	req := apiclient.Request{Method: "GET", Path: "/api/chats/messages/search"}
+	req.AddQuery("workspaceId", workspaceID.String())
+	req.AddQuery("q", query)
+	req.AddQuery("limit", strconv.Itoa(limit))
+
+	var hits []MessageHit
+	if err := c.chat.Do(ctx, req, &hits, apiclient.WithBearerToken(token)); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// SearchFiles searches workspace files by name and tags (GET /api/storage/workspaces/{workspaceId}/files/search)
+func (c *searchClient) SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]FileHit, error) {
+	if c.storage == nil {
+		return nil, ErrSearchSourceDisabled
+	}
+	req := apiclient.Request{Method: "GET", Path: "/api/storage/workspaces/" + apiclient.PathParam(workspaceID.String()) + "/files/search"}
+	req.AddQuery("q", query)
+	req.AddQuery("pageSize", strconv.Itoa(limit))
+
+	var result struct {
+		Files []FileHit `json:"files"`
+	}
+	if err := c.storage.Do(ctx, req, &result, apiclient.WithBearerToken(token)); err != nil {
+		return nil, err
+	}
+	return result.Files, nil
+}
+
+// SearchMembers returns the active workspace members whose nickname or email contains the query.
+// user-service has no member search, so the member list is filtered here.
+func (c *searchClient) SearchMembers(ctx context.Context, workspaceID uuid.UUID, query string, token string) ([]MemberHit, error) {
+	if c.users == nil {
+		return nil, ErrSearchSourceDisabled
+	}
+	members, err := c.users.GetMembers(ctx, workspaceID.String(), apiclient.WithBearerToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	var hits []MemberHit
+	for _, member := range members {
+		if !member.IsActive {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(member.NickName), query) && !strings.Contains(strings.ToLower(member.UserEmail), query) {
+			continue
+		}
+		hits = append(hits, MemberHit{
+			UserID:          parseUUID(member.UserID, uuid.Nil),
+			NickName:        member.NickName,
+			Email:           member.UserEmail,
+			ProfileImageURL: member.ProfileImageURL,
+			RoleName:        string(member.RoleName),
+		})
+	}
+	return hits, nil
+}
+
+// transport creates a transport sharing the client's HTTP client and metrics
+func (c *searchClient) transport(baseURL string) *apiclient.Transport {
+	transport := apiclient.NewTransport(baseURL, c.HTTPClient)
+	transport.Observe = c.observe
+	return transport
+}
+
+// observe records every search source request in the external API metrics
+func (c *searchClient) observe(method, url string, status int, duration time.Duration, err error) {
+	if c.metrics != nil {
+		c.metrics.RecordExternalAPICall(url, method, status, duration, err)
+	}
+}
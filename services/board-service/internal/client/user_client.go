@@ -6,14 +6,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient/userapi"
 	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"project-board-api/internal/metrics"
 )
@@ -36,21 +38,64 @@ type UserClient interface {
 	ValidateToken(ctx context.Context, tokenStr string) (uuid.UUID, error)
 }
 
-// userClient implements UserClient interface with metrics support
+// ServiceTokenSource issues client-credentials service tokens (see commonauth.ClientCredentialsSource)
+type ServiceTokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// UserClientOption configures optional userClient behavior
+type UserClientOption func(*userClient)
+
+// WithServiceTokens makes GetUserProfile fall back to a service token (users:read scope)
+// when called without a user token, e.g. from background jobs
+func WithServiceTokens(source ServiceTokenSource) UserClientOption {
+	return func(c *userClient) {
+		c.serviceTokens = source
+	}
+}
+
+// WithRetry overrides the retry policy for idempotent lookups (validate-member, profiles, workspace)
+func WithRetry(config commonclient.RetryConfig) UserClientOption {
+	return func(c *userClient) {
+		c.Retry = config
+	}
+}
+
+// WithGRPC routes membership validation and workspace profile lookups through
+// user-service's internal gRPC API; REST is used as a fallback when the call fails
+func WithGRPC(rpc internalrpc.UserServiceClient) UserClientOption {
+	return func(c *userClient) {
+		c.rpc = rpc
+	}
+}
+
+// userClient implements UserClient interface with metrics support.
+// User Service REST calls go through the client generated from its swagger spec (userapi);
+// auth-service has no spec, so ValidateToken stays hand-written.
 type userClient struct {
 	*commonclient.BaseHTTPClient
-	authBaseURL string // Auth service URL for token validation
-	metrics     *metrics.Metrics
+	users         *userapi.Client
+	authBaseURL   string // Auth service URL for token validation
+	metrics       *metrics.Metrics
+	serviceTokens ServiceTokenSource            // optional, used when no user token is available
+	rpc           internalrpc.UserServiceClient // optional, internal gRPC API (REST fallback)
 }
 
 // NewUserClient creates a new User API client
 // authBaseURL is used for ValidateToken, baseURL is used for user-related APIs
-func NewUserClient(baseURL string, authBaseURL string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics) UserClient {
-	return &userClient{
+func NewUserClient(baseURL string, authBaseURL string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics, opts ...UserClientOption) UserClient {
+	c := &userClient{
 		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
 		authBaseURL:    authBaseURL,
 		metrics:        m,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	transport := apiclient.NewTransport(baseURL, c.HTTPClient)
+	transport.Observe = c.observe
+	c.users = userapi.New(transport)
+	return c
 }
 
 // ValidateToken validates a token via auth-service (POST /api/auth/validate)
@@ -117,16 +162,28 @@ func (c *userClient) ValidateToken(ctx context.Context, tokenStr string) (uuid.U
 
 // ValidateWorkspaceMember validates if a user is a member of a workspace
 func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
-	url := c.BuildURL(fmt.Sprintf("/workspaces/%s/validate-member/%s", workspaceID.String(), userID.String()))
-
 	c.Logger.Debug("Validating workspace member",
-		zap.String("url", url),
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("user_id", userID.String()),
 	)
 
-	var response commonclient.WorkspaceValidationResponse
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &response); err != nil {
+	if c.rpc != nil {
+		resp, err := c.rpc.ValidateMember(ctx, &internalrpc.ValidateMemberRequest{
+			WorkspaceID: workspaceID.String(),
+			UserID:      userID.String(),
+		})
+		if err == nil {
+			return resp.IsMember, nil
+		}
+		c.log(ctx).Warn("gRPC ValidateMember failed, falling back to REST", zap.Error(err))
+	}
+
+	var response map[string]bool
+	err := c.withRetry(ctx, "validateMember", func(ctx context.Context) (err error) {
+		response, err = c.users.ValidateMember(ctx, workspaceID.String(), userID.String(), apiclient.WithBearerToken(token))
+		return err
+	})
+	if err != nil {
 		c.Logger.Error("Failed to validate workspace member",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -135,7 +192,7 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 		return false, err
 	}
 
-	isValid := response.IsWorkspaceMember()
+	isValid := response["isMember"]
 
 	c.Logger.Debug("Workspace member validation result",
 		zap.Bool("is_valid", isValid),
@@ -148,15 +205,25 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 
 // GetUserProfile retrieves user profile information
 func (c *userClient) GetUserProfile(ctx context.Context, userID uuid.UUID, token string) (*commonclient.UserProfile, error) {
-	url := c.BuildURL(fmt.Sprintf("/users/%s", userID.String()))
-
 	c.Logger.Debug("Getting user profile",
-		zap.String("url", url),
 		zap.String("user_id", userID.String()),
 	)
 
-	var profile commonclient.UserProfile
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &profile); err != nil {
+	// No user token (background jobs): authenticate as board-service instead
+	if token == "" && c.serviceTokens != nil {
+		serviceToken, err := c.serviceTokens.Token(ctx)
+		if err != nil {
+			c.Logger.Warn("Failed to get service token", zap.Error(err))
+		}
+		token = serviceToken
+	}
+
+	var user *userapi.UserResponse
+	err := c.withRetry(ctx, "getUser", func(ctx context.Context) (err error) {
+		user, err = c.users.GetUser(ctx, userID.String(), apiclient.WithBearerToken(token))
+		return err
+	})
+	if err != nil {
 		c.Logger.Error("Failed to get user profile",
 			zap.Error(err),
 			zap.String("user_id", userID.String()),
@@ -167,24 +234,46 @@ func (c *userClient) GetUserProfile(ctx context.Context, userID uuid.UUID, token
 
 	c.Logger.Debug("User profile retrieved",
 		zap.String("user_id", userID.String()),
-		zap.String("email", profile.Email),
+		zap.String("email", user.Email),
 	)
 
-	return &profile, nil
+	return &commonclient.UserProfile{
+		UserID:   parseUUID(user.UserID, userID),
+		Email:    user.Email,
+		Provider: user.Provider,
+	}, nil
 }
 
 // GetWorkspaceProfile retrieves workspace-specific user profile
 func (c *userClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceProfile, error) {
-	url := c.BuildURL(fmt.Sprintf("/profiles/workspace/%s/user/%s", workspaceID.String(), userID.String()))
-
 	c.Logger.Debug("Getting workspace profile",
-		zap.String("url", url),
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("user_id", userID.String()),
 	)
 
-	var profile commonclient.WorkspaceProfile
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &profile); err != nil {
+	if c.rpc != nil {
+		resp, err := c.rpc.GetProfile(ctx, &internalrpc.GetProfileRequest{
+			WorkspaceID: workspaceID.String(),
+			UserID:      userID.String(),
+		})
+		if err == nil {
+			return &commonclient.WorkspaceProfile{
+				WorkspaceID:     workspaceID,
+				UserID:          userID,
+				NickName:        resp.NickName,
+				Email:           resp.Email,
+				ProfileImageURL: resp.ProfileImageURL,
+			}, nil
+		}
+		c.log(ctx).Warn("gRPC GetProfile failed, falling back to REST", zap.Error(err))
+	}
+
+	var profile *userapi.UserProfileResponse
+	err := c.withRetry(ctx, "getUserProfile", func(ctx context.Context) (err error) {
+		profile, err = c.users.GetUserProfile(ctx, workspaceID.String(), userID.String(), apiclient.WithBearerToken(token))
+		return err
+	})
+	if err != nil {
 		c.Logger.Error("Failed to get workspace profile",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -205,20 +294,28 @@ func (c *userClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userI
 		zap.String("nickname", profile.NickName),
 	)
 
-	return &profile, nil
+	return &commonclient.WorkspaceProfile{
+		ProfileID:       parseUUID(profile.ProfileID, uuid.Nil),
+		WorkspaceID:     parseUUID(profile.WorkspaceID, workspaceID),
+		UserID:          parseUUID(profile.UserID, userID),
+		NickName:        profile.NickName,
+		Email:           profile.Email,
+		ProfileImageURL: profile.ProfileImageURL,
+	}, nil
 }
 
 // GetWorkspace retrieves workspace information
 func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*commonclient.Workspace, error) {
-	url := c.BuildURL(fmt.Sprintf("/workspaces/%s", workspaceID.String()))
-
 	c.Logger.Debug("Getting workspace",
-		zap.String("url", url),
 		zap.String("workspace_id", workspaceID.String()),
 	)
 
-	var workspace commonclient.Workspace
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &workspace); err != nil {
+	var workspace *userapi.WorkspaceResponse
+	err := c.withRetry(ctx, "getWorkspace", func(ctx context.Context) (err error) {
+		workspace, err = c.users.GetWorkspace(ctx, workspaceID.String(), apiclient.WithBearerToken(token))
+		return err
+	})
+	if err != nil {
 		c.Logger.Error("Failed to get workspace",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -229,10 +326,18 @@ func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, to
 
 	c.Logger.Debug("Workspace retrieved",
 		zap.String("workspace_id", workspaceID.String()),
-		zap.String("name", workspace.Name),
+		zap.String("name", workspace.WorkspaceName),
 	)
 
-	return &workspace, nil
+	return &commonclient.Workspace{
+		ID:          parseUUID(workspace.WorkspaceID, workspaceID),
+		Name:        workspace.WorkspaceName,
+		Description: workspace.WorkspaceDescription,
+		OwnerID:     parseUUID(workspace.OwnerID, uuid.Nil),
+		OwnerName:   workspace.OwnerNickName,
+		OwnerEmail:  workspace.OwnerEmail,
+		CreatedAt:   workspace.CreatedAt,
+	}, nil
 }
 
 // log returns a trace-context aware logger
@@ -240,92 +345,38 @@ func (c *userClient) log(ctx context.Context) *zap.Logger {
 	return commnotel.WithTraceContext(ctx, c.Logger)
 }
 
-// doRequestWithMetrics performs an HTTP request with metrics recording and trace propagation
-func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, token string, result interface{}) error {
-	startTime := time.Now()
-	log := c.log(ctx)
-
-	log.Debug("Making request to User Service",
-		zap.String("peer.service", "user-service"),
-		zap.String("http.method", method),
-		zap.String("http.url", url),
-	)
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		log.Error("Failed to create HTTP request",
+// withRetry runs an idempotent User Service call, retrying transient failures with backoff and jitter.
+// Each attempt is bounded by the client timeout and recorded separately in metrics (see observe).
+func (c *userClient) withRetry(ctx context.Context, operation string, call func(ctx context.Context) error) error {
+	config := c.Retry
+	config.OnRetry = func(attempt int, err error, delay time.Duration) {
+		c.log(ctx).Warn("Retrying User Service request",
+			zap.String("operation", operation),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", delay),
 			zap.Error(err),
-			zap.String("http.method", method),
-			zap.String("http.url", url),
 		)
-		return fmt.Errorf("failed to create request: %w", err)
 	}
-
-	// Inject W3C Trace Context headers for distributed tracing
-	commnotel.InjectTraceHeaders(ctx, req)
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	duration := time.Since(startTime)
-
-	// Record metrics
-	statusCode := 0
-	if resp != nil {
-		statusCode = resp.StatusCode
-	}
-	if c.metrics != nil {
-		c.metrics.RecordExternalAPICall(url, method, statusCode, duration, err)
+	err := commonclient.Retry(ctx, config, call)
+	if commonclient.IsCircuitOpen(err) {
+		// user-service 장애 중에는 요청하지 않고 호출한 쪽의 fallback(빈 프로필 등)을 사용
+		c.log(ctx).Warn("User service circuit open, skipping request", zap.String("operation", operation))
 	}
+	return err
+}
 
-	if err != nil {
-		log.Error("Failed to execute HTTP request",
-			zap.Error(err),
-			zap.String("http.method", method),
-			zap.String("http.url", url),
-			zap.Duration("http.duration", duration),
-		)
-		return fmt.Errorf("failed to execute request: %w", err)
+// observe records every User Service request in the external API metrics
+func (c *userClient) observe(method, url string, status int, duration time.Duration, err error) {
+	if c.metrics != nil {
+		c.metrics.RecordExternalAPICall(url, method, status, duration, err)
 	}
-	defer resp.Body.Close()
+}
 
-	body, err := io.ReadAll(resp.Body)
+// parseUUID parses an ID from a generated response, returning fallback when it is missing or malformed
+func parseUUID(s string, fallback uuid.UUID) uuid.UUID {
+	id, err := uuid.Parse(s)
 	if err != nil {
-		log.Error("Failed to read response body",
-			zap.Error(err),
-			zap.String("http.url", url),
-			zap.Int("http.status_code", resp.StatusCode),
-		)
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	processingTime := time.Since(startTime)
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		log.Error("User service returned non-success status",
-			zap.Int("http.status_code", resp.StatusCode),
-			zap.String("http.url", url),
-			zap.String("http.method", method),
-			zap.Duration("http.duration", processingTime),
-		)
-		return fmt.Errorf("user API returned status %d: %s", resp.StatusCode, string(body))
+		return fallback
 	}
-
-	log.Debug("User service response received",
-		zap.Int("http.status_code", resp.StatusCode),
-		zap.String("http.url", url),
-		zap.Int("http.response_content_length", len(body)),
-		zap.Duration("http.duration", processingTime),
-	)
-
-	if err := json.Unmarshal(body, result); err != nil {
-		log.Error("Failed to parse response JSON",
-			zap.Error(err),
-			zap.String("http.url", url),
-		)
-		return fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return nil
+	return id
 }
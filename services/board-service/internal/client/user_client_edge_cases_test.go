@@ -127,11 +127,7 @@ func TestUserClient_AuthorizationHeader(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		receivedAuthHeader = r.Header.Get("Authorization")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(WorkspaceValidationResponse{
-			WorkspaceID: workspaceID,
-			UserID:      userID,
-			Valid:       true,
-		})
+		json.NewEncoder(w).Encode(map[string]bool{"isMember": true})
 	}))
 	defer server.Close()
 
@@ -298,3 +294,39 @@ func TestProperty_ExternalAPIMetricsWithNilMetrics(t *testing.T) {
 		t.Errorf("GetUserProfile() UserID = %v, want %v", profile.UserID, userID)
 	}
 }
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+func TestUserClient_GetUserProfile_ServiceTokenFallback(t *testing.T) {
+	userID := uuid.New()
+
+	// Given: Mock server that records the Authorization header
+	var receivedAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(UserProfile{UserID: userID})
+	}))
+	defer server.Close()
+
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, zap.NewNop(), nil,
+		WithServiceTokens(staticTokenSource("service-token")))
+
+	// When: No user token is available
+	client.GetUserProfile(context.Background(), userID, "")
+
+	// Then: Service token is used
+	if receivedAuth != "Bearer service-token" {
+		t.Errorf("Authorization = %q, want %q", receivedAuth, "Bearer service-token")
+	}
+
+	// When: User token is available, it takes precedence
+	client.GetUserProfile(context.Background(), userID, "user-token")
+	if receivedAuth != "Bearer user-token" {
+		t.Errorf("Authorization = %q, want %q", receivedAuth, "Bearer user-token")
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/tenancy"
+)
+
+// membershipCachedUserClient answers ValidateWorkspaceMember from the shared tenancy cache
+// and delegates every other call to the wrapped client
+type membershipCachedUserClient struct {
+	UserClient
+	membership *tenancy.CachedChecker
+}
+
+// WithMembershipCache wraps c so that workspace membership checks go through membership.
+// membership must be built on the unwrapped client (tenancy.NewCachedChecker(c, ...)).
+// Checks run inside a request scoped to another workspace are rejected (see tenancy.CachedChecker).
+func WithMembershipCache(c UserClient, membership *tenancy.CachedChecker) UserClient {
+	return &membershipCachedUserClient{UserClient: c, membership: membership}
+}
+
+// ValidateWorkspaceMember validates membership through the cache
+func (c *membershipCachedUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	return c.membership.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+}
+
+// sharedMembershipUserClient caches ValidateWorkspaceMember results in Redis so that every replica
+// shares them, and delegates every other call to the wrapped client
+type sharedMembershipUserClient struct {
+	UserClient
+	membership *cache.Cache[bool]
+}
+
+// WithSharedMembershipCache wraps c so that workspace membership checks go through the Redis cache.
+// user-service의 멤버 변경 이벤트로 지워지므로(MembershipEventKeys) 이벤트 구독이 있을 때만 사용합니다.
+func WithSharedMembershipCache(c UserClient, membership *cache.Cache[bool]) UserClient {
+	return &sharedMembershipUserClient{UserClient: c, membership: membership}
+}
+
+// ValidateWorkspaceMember validates membership through the Redis cache
+func (c *sharedMembershipUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	return c.membership.GetOrLoad(ctx, MembershipCacheKey(workspaceID, userID), func(ctx context.Context) (bool, error) {
+		return c.UserClient.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+	})
+}
+
+// MembershipCacheKey returns the membership cache key of userID in workspaceID
+func MembershipCacheKey(workspaceID, userID uuid.UUID) string {
+	return workspaceID.String() + ":" + userID.String()
+}
+
+// MembershipEventKeys returns the membership cache keys affected by a workspace member event
+func MembershipEventKeys(event *messaging.Event) ([]string, error) {
+	workspaceID, userID, err := MemberEventIDs(event)
+	if err != nil {
+		return nil, err
+	}
+	return []string{MembershipCacheKey(workspaceID, userID)}, nil
+}
+
+// MemberEventIDs extracts the workspace and user of a workspace member event
+func MemberEventIDs(event *messaging.Event) (uuid.UUID, uuid.UUID, error) {
+	var data messaging.MemberEventData
+	if err := event.Decode(&data); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	workspaceID, err := uuid.Parse(event.WorkspaceID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid workspace id in %s event: %w", event.Type, err)
+	}
+	userID, err := uuid.Parse(data.UserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid user id in %s event: %w", event.Type, err)
+	}
+	return workspaceID, userID, nil
+}
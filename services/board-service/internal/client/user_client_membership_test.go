@@ -0,0 +1,43 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+)
+
+func TestMembershipEventKeys(t *testing.T) {
+	workspaceID, userID := uuid.New(), uuid.New()
+	event, err := messaging.NewEvent(messaging.EventMemberRemoved, "user-service", workspaceID.String(), "", messaging.MemberEventData{
+		MemberID: uuid.NewString(),
+		UserID:   userID.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := MembershipEventKeys(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != MembershipCacheKey(workspaceID, userID) {
+		t.Errorf("expected the member's cache key, got %v", keys)
+	}
+}
+
+func TestMembershipEventKeys_InvalidEvent(t *testing.T) {
+	noWorkspace, _ := messaging.NewEvent(messaging.EventMemberAdded, "user-service", "", "", messaging.MemberEventData{UserID: uuid.NewString()})
+	noUser, _ := messaging.NewEvent(messaging.EventMemberAdded, "user-service", uuid.NewString(), "", messaging.MemberEventData{})
+
+	for name, event := range map[string]*messaging.Event{
+		"no data":      {ID: "e1", Type: messaging.EventMemberAdded, WorkspaceID: uuid.NewString()},
+		"no workspace": noWorkspace,
+		"no user":      noUser,
+	} {
+		if _, err := MembershipEventKeys(event); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient/userapi"
 	"project-board-api/internal/metrics"
 )
 
@@ -32,27 +34,19 @@ func TestUserClient_ValidateWorkspaceMember(t *testing.T) {
 		wantErr        bool
 	}{
 		{
-			name: "성공: 유효한 멤버 (Valid 필드)",
+			name: "성공: 유효한 멤버",
 			serverResponse: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
-				json.NewEncoder(w).Encode(WorkspaceValidationResponse{
-					WorkspaceID: workspaceID,
-					UserID:      userID,
-					Valid:       true,
-				})
+				json.NewEncoder(w).Encode(map[string]bool{"isMember": true})
 			},
 			wantValid: true,
 			wantErr:   false,
 		},
 		{
-			name: "성공: 유효한 멤버 (IsValid 필드)",
+			name: "성공: 공통 응답 envelope",
 			serverResponse: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
-				json.NewEncoder(w).Encode(WorkspaceValidationResponse{
-					WorkspaceID: workspaceID,
-					UserID:      userID,
-					IsValid:     true,
-				})
+				w.Write([]byte(`{"success": true, "data": {"isMember": true}, "requestId": "req-1"}`))
 			},
 			wantValid: true,
 			wantErr:   false,
@@ -61,12 +55,7 @@ func TestUserClient_ValidateWorkspaceMember(t *testing.T) {
 			name: "성공: 유효하지 않은 멤버",
 			serverResponse: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
-				json.NewEncoder(w).Encode(WorkspaceValidationResponse{
-					WorkspaceID: workspaceID,
-					UserID:      userID,
-					Valid:       false,
-					IsValid:     false,
-				})
+				json.NewEncoder(w).Encode(map[string]bool{"isMember": false})
 			},
 			wantValid: false,
 			wantErr:   false,
@@ -336,15 +325,15 @@ func TestUserClient_GetWorkspace(t *testing.T) {
 			name: "성공: 워크스페이스 조회",
 			serverResponse: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
-				json.NewEncoder(w).Encode(Workspace{
-					ID:          workspaceID,
-					Name:        "Test Workspace",
-					Description: "Test Description",
-					OwnerID:     ownerID,
-					OwnerName:   "Owner Name",
-					OwnerEmail:  "owner@example.com",
-					CreatedAt:   "2024-01-01T00:00:00Z",
-					UpdatedAt:   "2024-01-01T00:00:00Z",
+				// user-service WorkspaceResponse 형식
+				json.NewEncoder(w).Encode(userapi.WorkspaceResponse{
+					WorkspaceID:          workspaceID.String(),
+					WorkspaceName:        "Test Workspace",
+					WorkspaceDescription: "Test Description",
+					OwnerID:              ownerID.String(),
+					OwnerNickName:        "Owner Name",
+					OwnerEmail:           "owner@example.com",
+					CreatedAt:            "2024-01-01T00:00:00Z",
 				})
 			},
 			wantWorkspace: &Workspace{
@@ -355,7 +344,6 @@ func TestUserClient_GetWorkspace(t *testing.T) {
 				OwnerName:   "Owner Name",
 				OwnerEmail:  "owner@example.com",
 				CreatedAt:   "2024-01-01T00:00:00Z",
-				UpdatedAt:   "2024-01-01T00:00:00Z",
 			},
 			wantErr: false,
 		},
@@ -420,6 +408,10 @@ func TestUserClient_GetWorkspace(t *testing.T) {
 			if workspace.Name != tt.wantWorkspace.Name {
 				t.Errorf("GetWorkspace() Name = %v, want %v", workspace.Name, tt.wantWorkspace.Name)
 			}
+
+			if workspace.OwnerID != tt.wantWorkspace.OwnerID || workspace.OwnerName != tt.wantWorkspace.OwnerName {
+				t.Errorf("GetWorkspace() owner = %v/%v, want %v/%v", workspace.OwnerID, workspace.OwnerName, tt.wantWorkspace.OwnerID, tt.wantWorkspace.OwnerName)
+			}
 		})
 	}
 }
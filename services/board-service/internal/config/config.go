@@ -22,16 +22,21 @@ type Config struct {
 	AuthAPI   AuthAPIConfig   `yaml:"auth_api"` // ← Auth API 추가 (토큰 검증용)
 	UserAPI   UserAPIConfig   `yaml:"user_api"`
 	NotiAPI   NotiAPIConfig   `yaml:"noti_api"` // ← Noti API 추가 (알림 전송용)
+	Search    SearchConfig    `yaml:"search"`   // Global search sources (chat, storage)
 	CORS      CORSConfig      `yaml:"cors"`
 	Redis     RedisConfig     `mapstructure:"redis" yaml:"redis"` // ← Redis 추가
 	S3        S3Config        `yaml:"s3"`                         // ← S3 추가
 	RateLimit RateLimitConfig `yaml:"rate_limit"`                 // Rate limiting configuration
+	Events    EventsConfig    `yaml:"events"`                     // Domain event bus
+	Reminder  ReminderConfig  `yaml:"reminder"`                   // Board due-date reminders
+	Trash     TrashConfig     `yaml:"trash"`                      // Deleted board retention
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port            string        `yaml:"port"`
-	Mode            string        `yaml:"mode"` // debug, release
+	GRPCPort        string        `yaml:"grpc_port"` // Internal gRPC API (empty = disabled)
+	Mode            string        `yaml:"mode"`      // debug, release
 	BasePath        string        `yaml:"base_path"`
 	ReadTimeout     time.Duration `yaml:"read_timeout"`
 	WriteTimeout    time.Duration `yaml:"write_timeout"`
@@ -50,6 +55,8 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	AutoMigrate     bool          `yaml:"auto_migrate"`
+	// ReplicaDSNs are read replica DSNs for heavy list endpoints (empty = primary only)
+	ReplicaDSNs []string `yaml:"replica_dsns"`
 }
 
 // LoggerConfig holds logger configuration
@@ -69,12 +76,20 @@ type AuthAPIConfig struct {
 	BaseURL   string        `yaml:"base_url"`
 	Timeout   time.Duration `yaml:"timeout"`
 	JWTIssuer string        `yaml:"jwt_issuer"` // JWT issuer for JWKS validation
+	// ServiceClientID/Secret obtain client-credentials service tokens (board-service → user-service)
+	ServiceClientID     string `yaml:"service_client_id"`
+	ServiceClientSecret string `yaml:"service_client_secret"`
 }
 
 // UserAPIConfig holds User API configuration
 type UserAPIConfig struct {
 	BaseURL string        `yaml:"base_url"`
-	Timeout time.Duration `yaml:"timeout"`
+	Timeout time.Duration `yaml:"timeout"` // per attempt
+	// Idempotent lookups (validate-member, profiles) are retried on transient failures
+	RetryMaxAttempts    int           `yaml:"retry_max_attempts"`
+	RetryInitialBackoff time.Duration `yaml:"retry_initial_backoff"`
+	// GRPCAddr routes validate-member and workspace profile lookups over gRPC when set (e.g. user-service:50051)
+	GRPCAddr string `yaml:"grpc_addr"`
 }
 
 // NotiAPIConfig holds Notification API configuration
@@ -82,6 +97,38 @@ type NotiAPIConfig struct {
 	BaseURL        string        `yaml:"base_url"`
 	Timeout        time.Duration `yaml:"timeout"`
 	InternalAPIKey string        `yaml:"internal_api_key"`
+	// NATSURL publishes notifications to the NATS JetStream event stream instead of HTTP when set.
+	NATSURL      string `yaml:"nats_url"`
+	EventSubject string `yaml:"event_subject"`
+}
+
+// SearchConfig holds the services queried by global search besides user-service.
+// A source with an empty base URL is left out of the results.
+type SearchConfig struct {
+	ChatBaseURL    string        `yaml:"chat_base_url"`
+	StorageBaseURL string        `yaml:"storage_base_url"`
+	Timeout        time.Duration `yaml:"timeout"` // per source request
+}
+
+// EventsConfig holds domain event bus configuration
+type EventsConfig struct {
+	NATSURL string `yaml:"nats_url"` // Publishes board events when set (empty = disabled)
+	// Outbox records board events and notifications in the outbox_messages table within the
+	// board transaction and publishes them from a background relay (default: true)
+	Outbox bool `yaml:"outbox"`
+}
+
+// ReminderConfig holds board due-date reminder configuration
+type ReminderConfig struct {
+	// DueSoonWindows sends one BOARD_DUE_SOON notification per board and window once the due date
+	// comes within the window (default: 24h, 1h; an empty list disables reminders)
+	DueSoonWindows []time.Duration `yaml:"due_soon_windows"`
+}
+
+// TrashConfig holds deleted board (trash) configuration
+type TrashConfig struct {
+	// Retention is how long deleted boards stay restorable before the purge job removes them (default: 720h)
+	Retention time.Duration `yaml:"retention"`
 }
 
 // CORSConfig holds CORS configuration
@@ -147,6 +194,7 @@ func getDefaultConfig() Config {
 	return Config{
 		Server: ServerConfig{
 			Port:            "8000",
+			GRPCPort:        "50051",
 			Mode:            "debug",
 			BasePath:        "",
 			ReadTimeout:     10 * time.Second,
@@ -177,16 +225,31 @@ func getDefaultConfig() Config {
 			Timeout: 5 * time.Second,
 		},
 		UserAPI: UserAPIConfig{
-			BaseURL: "http://localhost:8081",
-			Timeout: 5 * time.Second,
+			BaseURL:             "http://localhost:8081",
+			Timeout:             5 * time.Second,
+			RetryMaxAttempts:    3,
+			RetryInitialBackoff: 100 * time.Millisecond,
 		},
 		NotiAPI: NotiAPIConfig{
-			BaseURL: "", // Not required - notifications disabled if empty
-			Timeout: 5 * time.Second,
+			BaseURL:      "", // Not required - notifications disabled if empty
+			Timeout:      5 * time.Second,
+			EventSubject: "notifications.events.board",
+		},
+		Search: SearchConfig{
+			Timeout: 3 * time.Second,
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: "*",
 		},
+		Events: EventsConfig{
+			Outbox: true,
+		},
+		Reminder: ReminderConfig{
+			DueSoonWindows: []time.Duration{24 * time.Hour, time.Hour},
+		},
+		Trash: TrashConfig{
+			Retention: 30 * 24 * time.Hour,
+		},
 	}
 }
 
@@ -198,6 +261,9 @@ func (c *Config) overrideFromEnv() {
 	if port := os.Getenv("SERVER_PORT"); port != "" {
 		c.Server.Port = port
 	}
+	if port := os.Getenv("GRPC_PORT"); port != "" {
+		c.Server.GRPCPort = port
+	}
 
 	// ENV alias for SERVER_MODE (original format takes precedence)
 	// Maps: dev→debug, prod→release
@@ -264,6 +330,33 @@ func (c *Config) overrideFromEnv() {
 		c.Database.AutoMigrate = autoMigrate == "true"
 	}
 
+	// Connection pool tuning
+	if maxOpen := os.Getenv("DB_MAX_OPEN_CONNS"); maxOpen != "" {
+		if v, err := strconv.Atoi(maxOpen); err == nil {
+			c.Database.MaxOpenConns = v
+		}
+	}
+	if maxIdle := os.Getenv("DB_MAX_IDLE_CONNS"); maxIdle != "" {
+		if v, err := strconv.Atoi(maxIdle); err == nil {
+			c.Database.MaxIdleConns = v
+		}
+	}
+	if lifetime := os.Getenv("DB_CONN_MAX_LIFETIME"); lifetime != "" {
+		if d, err := time.ParseDuration(lifetime); err == nil {
+			c.Database.ConnMaxLifetime = d
+		}
+	}
+
+	// Read replicas (comma-separated DSNs)
+	if replicas := os.Getenv("DB_REPLICA_DSNS"); replicas != "" {
+		c.Database.ReplicaDSNs = nil
+		for _, dsn := range strings.Split(replicas, ",") {
+			if dsn = strings.TrimSpace(dsn); dsn != "" {
+				c.Database.ReplicaDSNs = append(c.Database.ReplicaDSNs, dsn)
+			}
+		}
+	}
+
 	// Logger
 	if level := os.Getenv("LOG_LEVEL"); level != "" {
 		c.Logger.Level = level
@@ -300,6 +393,13 @@ func (c *Config) overrideFromEnv() {
 	if c.AuthAPI.JWTIssuer == "" {
 		c.AuthAPI.JWTIssuer = "wealist-auth-service" // default issuer
 	}
+	// Service client credentials (auth-service /api/auth/clients로 등록)
+	if clientID := os.Getenv("SERVICE_CLIENT_ID"); clientID != "" {
+		c.AuthAPI.ServiceClientID = clientID
+	}
+	if clientSecret := os.Getenv("SERVICE_CLIENT_SECRET"); clientSecret != "" {
+		c.AuthAPI.ServiceClientSecret = clientSecret
+	}
 
 	// User API - USER_SERVICE_URL alias (original format takes precedence)
 	if baseURL := os.Getenv("USER_SERVICE_URL"); baseURL != "" {
@@ -314,6 +414,19 @@ func (c *Config) overrideFromEnv() {
 			c.UserAPI.Timeout = d
 		}
 	}
+	if attempts := os.Getenv("USER_API_RETRY_MAX_ATTEMPTS"); attempts != "" {
+		if n, err := strconv.Atoi(attempts); err == nil {
+			c.UserAPI.RetryMaxAttempts = n
+		}
+	}
+	if backoff := os.Getenv("USER_API_RETRY_INITIAL_BACKOFF"); backoff != "" {
+		if d, err := time.ParseDuration(backoff); err == nil {
+			c.UserAPI.RetryInitialBackoff = d
+		}
+	}
+	if addr := os.Getenv("USER_GRPC_ADDR"); addr != "" {
+		c.UserAPI.GRPCAddr = addr
+	}
 
 	// Noti API - NOTI_SERVICE_URL (알림 전송용)
 	if baseURL := os.Getenv("NOTI_SERVICE_URL"); baseURL != "" {
@@ -331,6 +444,58 @@ func (c *Config) overrideFromEnv() {
 	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
 		c.NotiAPI.InternalAPIKey = apiKey
 	}
+	// NATS_URL - 알림 이벤트를 스트림으로 발행 (설정 시 HTTP 대신 사용)
+	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
+		c.NotiAPI.NATSURL = natsURL
+	}
+	if subject := os.Getenv("NOTI_EVENT_SUBJECT"); subject != "" {
+		c.NotiAPI.EventSubject = subject
+	}
+	// Global search sources (CHAT_SERVICE_URL, STORAGE_SERVICE_URL)
+	if baseURL := os.Getenv("CHAT_SERVICE_URL"); baseURL != "" {
+		c.Search.ChatBaseURL = baseURL
+	}
+	if baseURL := os.Getenv("STORAGE_SERVICE_URL"); baseURL != "" {
+		c.Search.StorageBaseURL = baseURL
+	}
+	if timeout := os.Getenv("SEARCH_TIMEOUT"); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			c.Search.Timeout = d
+		}
+	}
+
+	// NATS_URL - 도메인 이벤트(보드 변경) 발행
+	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
+		c.Events.NATSURL = natsURL
+	}
+	if outboxEnabled := os.Getenv("EVENTS_OUTBOX"); outboxEnabled != "" {
+		c.Events.Outbox = outboxEnabled == "true"
+	}
+
+	// Due reminders (comma-separated durations, e.g. "24h,1h"; "off" disables)
+	if windows := os.Getenv("BOARD_DUE_REMINDER_WINDOWS"); windows != "" {
+		c.Reminder.DueSoonWindows = []time.Duration{}
+		if windows != "off" {
+			for _, window := range strings.Split(windows, ",") {
+				if d, err := time.ParseDuration(strings.TrimSpace(window)); err == nil && d >= time.Minute {
+					c.Reminder.DueSoonWindows = append(c.Reminder.DueSoonWindows, d)
+				}
+			}
+		}
+	}
+	if c.Reminder.DueSoonWindows == nil {
+		c.Reminder.DueSoonWindows = []time.Duration{24 * time.Hour, time.Hour}
+	}
+
+	// Trash retention (duration, e.g. "720h"; at least 1h)
+	if retention := os.Getenv("BOARD_TRASH_RETENTION"); retention != "" {
+		if d, err := time.ParseDuration(retention); err == nil && d >= time.Hour {
+			c.Trash.Retention = d
+		}
+	}
+	if c.Trash.Retention <= 0 {
+		c.Trash.Retention = 30 * 24 * time.Hour
+	}
 
 	// CORS - CORS_ORIGINS alias (original format takes precedence)
 	if origins := os.Getenv("CORS_ORIGINS"); origins != "" {
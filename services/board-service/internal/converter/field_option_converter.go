@@ -5,6 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
@@ -17,6 +20,7 @@ type FieldOptionConverter interface {
 	// ConvertValuesToIDs converts customFields from value strings to UUIDs
 	// Input: {"importance": "high", "stage": "in_progress"}
 	// Output: {"importance": "uuid-1", "stage": "uuid-2"}
+	// Project custom fields are validated against their definitions (multi_select becomes a list of UUIDs)
 	ConvertValuesToIDs(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error)
 
 	// ConvertIDsToValues converts customFields from UUIDs to value strings
@@ -33,16 +37,38 @@ type FieldOptionConverter interface {
 	ConvertIDsToValuesBatch(ctx context.Context, boards []*domain.Board) error
 }
 
+// MaxCustomFieldTextLength is the maximum length (in characters) of a text custom field value
+const MaxCustomFieldTextLength = 1000
+
+// CustomFieldDateLayout is the format of date custom field values
+const CustomFieldDateLayout = "2006-01-02"
+
 // fieldOptionConverterImpl is the implementation of FieldOptionConverter
 type fieldOptionConverterImpl struct {
 	fieldOptionRepo repository.FieldOptionRepository
+	customFieldRepo repository.CustomFieldRepository // optional, project-defined custom fields
+}
+
+// FieldOptionConverterOption configures optional FieldOptionConverter dependencies
+type FieldOptionConverterOption func(*fieldOptionConverterImpl)
+
+// WithCustomFields validates the project-defined custom fields of customFields against their definitions
+// (없으면 모든 필드를 기존처럼 field option value로 취급)
+func WithCustomFields(customFieldRepo repository.CustomFieldRepository) FieldOptionConverterOption {
+	return func(c *fieldOptionConverterImpl) {
+		c.customFieldRepo = customFieldRepo
+	}
 }
 
 // NewFieldOptionConverter creates a new instance of FieldOptionConverter
-func NewFieldOptionConverter(fieldOptionRepo repository.FieldOptionRepository) FieldOptionConverter {
-	return &fieldOptionConverterImpl{
+func NewFieldOptionConverter(fieldOptionRepo repository.FieldOptionRepository, opts ...FieldOptionConverterOption) FieldOptionConverter {
+	c := &fieldOptionConverterImpl{
 		fieldOptionRepo: fieldOptionRepo,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // ConvertValuesToIDs converts customFields from value strings to UUIDs
@@ -57,150 +83,217 @@ func (c *fieldOptionConverterImpl) ConvertValuesToIDs(
 
 	result := make(map[string]interface{})
 
+	// 프로젝트 커스텀 필드 정의는 기본 필드가 아닌 키가 있을 때 한 번만 조회
+	var definitions map[string]*domain.CustomFieldDefinition
+
 	for fieldType, value := range customFields {
+		if c.customFieldRepo != nil && !IsBuiltinFieldType(fieldType) {
+			if definitions == nil {
+				loaded, err := c.customFieldDefinitions(ctx, projectID)
+				if err != nil {
+					return nil, err
+				}
+				definitions = loaded
+			}
+			definition, ok := definitions[fieldType]
+			if !ok {
+				return nil, fmt.Errorf("unknown custom field '%s'", fieldType)
+			}
+			converted, err := c.convertCustomFieldValue(ctx, projectID, definition, value)
+			if err != nil {
+				return nil, err
+			}
+			result[fieldType] = converted
+			continue
+		}
+
 		valueStr, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid value type for field '%s': expected string, got %T", fieldType, value)
 		}
 
-		// Query field option by project, field type, and value
-		option, err := c.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(
-			ctx,
-			projectID,
-			domain.FieldType(fieldType),
-			valueStr,
-		)
+		optionID, err := c.findOptionID(ctx, projectID, fieldType, valueStr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to find field option for field '%s': %w", fieldType, err)
-		}
-		if option == nil {
-			return nil, fmt.Errorf("invalid field option value '%s' for field type '%s'", valueStr, fieldType)
+			return nil, err
 		}
-
-		result[fieldType] = option.ID.String()
+		result[fieldType] = optionID
 	}
 
 	return result, nil
 }
 
-// ConvertIDsToValues converts customFields from UUIDs to value strings
-func (c *fieldOptionConverterImpl) ConvertIDsToValues(
-	ctx context.Context,
-	customFields map[string]interface{},
-) (map[string]interface{}, error) {
-	if customFields == nil || len(customFields) == 0 {
-		return customFields, nil
+// IsBuiltinFieldType reports whether a customFields key is one of the built-in field types (stage, role, importance)
+func IsBuiltinFieldType(fieldType string) bool {
+	switch domain.FieldType(fieldType) {
+	case domain.FieldTypeStage, domain.FieldTypeRole, domain.FieldTypeImportance:
+		return true
+	default:
+		return false
 	}
+}
 
-	result := make(map[string]interface{})
+// customFieldDefinitions loads the custom field definitions of a project keyed by field key
+func (c *fieldOptionConverterImpl) customFieldDefinitions(ctx context.Context, projectID uuid.UUID) (map[string]*domain.CustomFieldDefinition, error) {
+	fields, err := c.customFieldRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find custom fields: %w", err)
+	}
+	definitions := make(map[string]*domain.CustomFieldDefinition, len(fields))
+	for _, field := range fields {
+		definitions[field.Key] = field
+	}
+	return definitions, nil
+}
 
-	// Collect all UUIDs
-	var ids []uuid.UUID
-	idToFieldType := make(map[string]string)
+// convertCustomFieldValue validates a value against its custom field definition.
+// select/multi_select는 옵션 ID로 변환하고, number/date/text는 검증 후 그대로 저장합니다.
+func (c *fieldOptionConverterImpl) convertCustomFieldValue(
+	ctx context.Context,
+	projectID uuid.UUID,
+	definition *domain.CustomFieldDefinition,
+	value interface{},
+) (interface{}, error) {
+	switch definition.Type {
+	case domain.CustomFieldTypeSelect:
+		valueStr, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value type for field '%s': expected string, got %T", definition.Key, value)
+		}
+		return c.findOptionID(ctx, projectID, definition.Key, valueStr)
 
-	for fieldType, value := range customFields {
-		idStr, ok := value.(string)
+	case domain.CustomFieldTypeMultiSelect:
+		values, ok := value.([]interface{})
 		if !ok {
-			// If not a string, keep as is
-			result[fieldType] = value
-			continue
+			return nil, fmt.Errorf("invalid value type for field '%s': expected array of strings, got %T", definition.Key, value)
 		}
+		optionIDs := make([]interface{}, 0, len(values))
+		seen := make(map[string]bool, len(values))
+		for _, v := range values {
+			valueStr, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid value type in field '%s': expected string, got %T", definition.Key, v)
+			}
+			optionID, err := c.findOptionID(ctx, projectID, definition.Key, valueStr)
+			if err != nil {
+				return nil, err
+			}
+			if !seen[optionID] {
+				seen[optionID] = true
+				optionIDs = append(optionIDs, optionID)
+			}
+		}
+		return optionIDs, nil
+
+	case domain.CustomFieldTypeNumber:
+		var number float64
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case int:
+			number = float64(v)
+		default:
+			return nil, fmt.Errorf("invalid value type for field '%s': expected number, got %T", definition.Key, value)
+		}
+		if math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("invalid number for field '%s'", definition.Key)
+		}
+		return number, nil
 
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			// If not a valid UUID, keep as is
-			result[fieldType] = value
-			continue
+	case domain.CustomFieldTypeDate:
+		valueStr, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value type for field '%s': expected date string, got %T", definition.Key, value)
 		}
+		if _, err := time.Parse(CustomFieldDateLayout, valueStr); err != nil {
+			return nil, fmt.Errorf("invalid date '%s' for field '%s': expected YYYY-MM-DD", valueStr, definition.Key)
+		}
+		return valueStr, nil
 
-		ids = append(ids, id)
-		idToFieldType[id.String()] = fieldType
-	}
+	case domain.CustomFieldTypeText:
+		valueStr, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value type for field '%s': expected string, got %T", definition.Key, value)
+		}
+		if utf8.RuneCountInString(valueStr) > MaxCustomFieldTextLength {
+			return nil, fmt.Errorf("value of field '%s' exceeds %d characters", definition.Key, MaxCustomFieldTextLength)
+		}
+		return valueStr, nil
 
-	if len(ids) == 0 {
-		return customFields, nil
+	default:
+		return nil, fmt.Errorf("unsupported type '%s' of custom field '%s'", definition.Type, definition.Key)
 	}
+}
 
-	// Batch query: SELECT * FROM field_options WHERE id IN (...)
-	options, err := c.fieldOptionRepo.FindByIDs(ctx, ids)
+// findOptionID finds the ID of the project's field option with the given field type and value
+func (c *fieldOptionConverterImpl) findOptionID(ctx context.Context, projectID uuid.UUID, fieldType, value string) (string, error) {
+	option, err := c.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(
+		ctx,
+		projectID,
+		domain.FieldType(fieldType),
+		value,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find field options by IDs: %w", err)
+		return "", fmt.Errorf("failed to find field option for field '%s': %w", fieldType, err)
 	}
-
-	// Create ID → value mapping
-	idToValue := make(map[string]string)
-	for _, option := range options {
-		idToValue[option.ID.String()] = option.Value
-	}
-
-	// Convert
-	for idStr, fieldType := range idToFieldType {
-		if val, exists := idToValue[idStr]; exists {
-			result[fieldType] = val
-		} else {
-			// Field option not found, return empty string
-			result[fieldType] = ""
-		}
+	if option == nil {
+		return "", fmt.Errorf("invalid field option value '%s' for field type '%s'", value, fieldType)
 	}
+	return option.ID.String(), nil
+}
 
-	return result, nil
+// ConvertIDsToValues converts customFields from UUIDs to value strings
+func (c *fieldOptionConverterImpl) ConvertIDsToValues(
+	ctx context.Context,
+	customFields map[string]interface{},
+) (map[string]interface{}, error) {
+	return c.convertIDs(ctx, customFields, func(option *domain.FieldOption) string { return option.Value })
 }
 
 // ConvertIDsToLabels converts customFields from UUIDs to display labels (Korean)
 func (c *fieldOptionConverterImpl) ConvertIDsToLabels(
 	ctx context.Context,
 	customFields map[string]interface{},
+) (map[string]interface{}, error) {
+	// Use Label instead of Value
+	return c.convertIDs(ctx, customFields, func(option *domain.FieldOption) string { return option.Label })
+}
+
+// convertIDs replaces the field option IDs of customFields with the picked option attribute
+func (c *fieldOptionConverterImpl) convertIDs(
+	ctx context.Context,
+	customFields map[string]interface{},
+	pick func(option *domain.FieldOption) string,
 ) (map[string]interface{}, error) {
 	if customFields == nil || len(customFields) == 0 {
 		return customFields, nil
 	}
 
-	result := make(map[string]interface{})
-
 	// Collect all UUIDs
-	var ids []uuid.UUID
-	idToFieldType := make(map[string]string)
-
-	for fieldType, value := range customFields {
-		idStr, ok := value.(string)
-		if !ok {
-			result[fieldType] = value
-			continue
-		}
-
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			result[fieldType] = value
-			continue
-		}
-
-		ids = append(ids, id)
-		idToFieldType[id.String()] = fieldType
+	idSet := make(map[uuid.UUID]bool)
+	for _, value := range customFields {
+		collectOptionIDs(value, idSet)
 	}
 
-	if len(ids) == 0 {
+	if len(idSet) == 0 {
 		return customFields, nil
 	}
 
 	// Batch query: SELECT * FROM field_options WHERE id IN (...)
-	options, err := c.fieldOptionRepo.FindByIDs(ctx, ids)
+	options, err := c.fieldOptionRepo.FindByIDs(ctx, setToSlice(idSet))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find field options by IDs: %w", err)
 	}
 
-	// Create ID → label mapping (use Label instead of Value)
-	idToLabel := make(map[string]string)
+	// Create ID → value mapping
+	idToValue := make(map[string]string)
 	for _, option := range options {
-		idToLabel[option.ID.String()] = option.Label
+		idToValue[option.ID.String()] = pick(option)
 	}
 
 	// Convert
-	for idStr, fieldType := range idToFieldType {
-		if label, exists := idToLabel[idStr]; exists {
-			result[fieldType] = label
-		} else {
-			result[fieldType] = ""
-		}
+	result := make(map[string]interface{}, len(customFields))
+	for fieldType, value := range customFields {
+		result[fieldType] = replaceOptionIDs(value, idToValue)
 	}
 
 	return result, nil
@@ -230,17 +323,7 @@ func (c *fieldOptionConverterImpl) ConvertIDsToValuesBatch(
 		}
 
 		for _, value := range customFields {
-			idStr, ok := value.(string)
-			if !ok {
-				continue
-			}
-
-			id, err := uuid.Parse(idStr)
-			if err != nil {
-				continue
-			}
-
-			idSet[id] = true
+			collectOptionIDs(value, idSet)
 		}
 	}
 
@@ -248,14 +331,8 @@ func (c *fieldOptionConverterImpl) ConvertIDsToValuesBatch(
 		return nil
 	}
 
-	// Convert set to slice
-	ids := make([]uuid.UUID, 0, len(idSet))
-	for id := range idSet {
-		ids = append(ids, id)
-	}
-
 	// Single batch query
-	options, err := c.fieldOptionRepo.FindByIDs(ctx, ids)
+	options, err := c.fieldOptionRepo.FindByIDs(ctx, setToSlice(idSet))
 	if err != nil {
 		return fmt.Errorf("failed to find field options by IDs: %w", err)
 	}
@@ -279,17 +356,7 @@ func (c *fieldOptionConverterImpl) ConvertIDsToValuesBatch(
 
 		converted := make(map[string]interface{})
 		for fieldType, value := range customFields {
-			idStr, ok := value.(string)
-			if !ok {
-				converted[fieldType] = value
-				continue
-			}
-
-			if val, exists := idToValue[idStr]; exists {
-				converted[fieldType] = val
-			} else {
-				converted[fieldType] = ""
-			}
+			converted[fieldType] = replaceOptionIDs(value, idToValue)
 		}
 
 		// Update board's customFields in memory
@@ -302,3 +369,50 @@ func (c *fieldOptionConverterImpl) ConvertIDsToValuesBatch(
 
 	return nil
 }
+
+// collectOptionIDs adds the field option IDs referenced by a customFields value
+// (a single ID or the ID list of a multi_select field) to ids
+func collectOptionIDs(value interface{}, ids map[uuid.UUID]bool) {
+	switch v := value.(type) {
+	case string:
+		if id, err := uuid.Parse(v); err == nil {
+			ids[id] = true
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectOptionIDs(item, ids)
+		}
+	}
+}
+
+// replaceOptionIDs replaces the field option IDs of a customFields value using idToValue.
+// 찾을 수 없는 옵션 ID는 빈 문자열이 되고(multi_select에서는 제외), ID가 아닌 값(number, date, text)은 그대로 둡니다.
+func replaceOptionIDs(value interface{}, idToValue map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return v
+		}
+		return idToValue[id.String()]
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if converted := replaceOptionIDs(item, idToValue); converted != "" {
+				values = append(values, converted)
+			}
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// setToSlice converts a set of UUIDs to a slice
+func setToSlice(idSet map[uuid.UUID]bool) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(idSet))
+	for id := range idSet {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,151 @@
+package converter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// fakeFieldOptionRepository serves field options from memory
+type fakeFieldOptionRepository struct {
+	repository.FieldOptionRepository
+	options []*domain.FieldOption
+}
+
+func (r *fakeFieldOptionRepository) FindByProjectAndFieldTypeAndValue(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
+	for _, option := range r.options {
+		if option.ProjectID != nil && *option.ProjectID == projectID && option.FieldType == fieldType && option.Value == value {
+			return option, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeFieldOptionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.FieldOption, error) {
+	var found []*domain.FieldOption
+	for _, option := range r.options {
+		for _, id := range ids {
+			if option.ID == id {
+				found = append(found, option)
+			}
+		}
+	}
+	return found, nil
+}
+
+// fakeCustomFieldRepository serves custom field definitions from memory
+type fakeCustomFieldRepository struct {
+	repository.CustomFieldRepository
+	fields []*domain.CustomFieldDefinition
+}
+
+func (r *fakeCustomFieldRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+	return r.fields, nil
+}
+
+func newTestConverter(projectID uuid.UUID) (FieldOptionConverter, map[string]*domain.FieldOption) {
+	option := func(fieldType, value, label string) *domain.FieldOption {
+		return &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: &projectID,
+			FieldType: domain.FieldType(fieldType), Value: value, Label: label}
+	}
+	options := map[string]*domain.FieldOption{
+		"stage":     option("stage", "in_progress", "진행중"),
+		"component": option("component", "backend", "백엔드"),
+		"tag:api":   option("tags", "api", "API"),
+		"tag:ui":    option("tags", "ui", "UI"),
+	}
+	optionRepo := &fakeFieldOptionRepository{}
+	for _, o := range options {
+		optionRepo.options = append(optionRepo.options, o)
+	}
+	customFieldRepo := &fakeCustomFieldRepository{fields: []*domain.CustomFieldDefinition{
+		{ProjectID: projectID, Key: "component", Type: domain.CustomFieldTypeSelect},
+		{ProjectID: projectID, Key: "tags", Type: domain.CustomFieldTypeMultiSelect},
+		{ProjectID: projectID, Key: "story_points", Type: domain.CustomFieldTypeNumber},
+		{ProjectID: projectID, Key: "release_date", Type: domain.CustomFieldTypeDate},
+		{ProjectID: projectID, Key: "memo", Type: domain.CustomFieldTypeText},
+	}}
+	return NewFieldOptionConverter(optionRepo, WithCustomFields(customFieldRepo)), options
+}
+
+func TestConvertValuesToIDs_CustomFields(t *testing.T) {
+	projectID := uuid.New()
+	c, options := newTestConverter(projectID)
+
+	got, err := c.ConvertValuesToIDs(context.Background(), projectID, map[string]interface{}{
+		"stage":        "in_progress",
+		"component":    "backend",
+		"tags":         []interface{}{"api", "ui", "api"},
+		"story_points": float64(5),
+		"release_date": "2026-10-15",
+		"memo":         "배포 전 확인",
+	})
+	if err != nil {
+		t.Fatalf("ConvertValuesToIDs() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"stage":        options["stage"].ID.String(),
+		"component":    options["component"].ID.String(),
+		"tags":         []interface{}{options["tag:api"].ID.String(), options["tag:ui"].ID.String()},
+		"story_points": float64(5),
+		"release_date": "2026-10-15",
+		"memo":         "배포 전 확인",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertValuesToIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestConvertValuesToIDs_RejectsInvalidCustomFieldValues(t *testing.T) {
+	projectID := uuid.New()
+	c, _ := newTestConverter(projectID)
+
+	tests := map[string]map[string]interface{}{
+		"정의되지 않은 필드":         {"severity": "high"},
+		"없는 select 옵션":       {"component": "mobile"},
+		"multi_select에 문자열":  {"tags": "api"},
+		"없는 multi_select 옵션": {"tags": []interface{}{"api", "db"}},
+		"number에 문자열":        {"story_points": "5"},
+		"잘못된 날짜 형식":          {"release_date": "15/10/2026"},
+		"text에 숫자":           {"memo": float64(1)},
+		"기본 필드의 없는 옵션":       {"stage": "archived"},
+	}
+	for name, fields := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := c.ConvertValuesToIDs(context.Background(), projectID, fields); err == nil {
+				t.Errorf("ConvertValuesToIDs(%v) should fail", fields)
+			}
+		})
+	}
+}
+
+func TestConvertIDsToValues_KeepsNonOptionValues(t *testing.T) {
+	projectID := uuid.New()
+	c, options := newTestConverter(projectID)
+
+	got, err := c.ConvertIDsToLabels(context.Background(), map[string]interface{}{
+		"component":    options["component"].ID.String(),
+		"tags":         []interface{}{options["tag:api"].ID.String(), uuid.New().String()},
+		"story_points": float64(3),
+		"release_date": "2026-10-15",
+	})
+	if err != nil {
+		t.Fatalf("ConvertIDsToLabels() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"component":    "백엔드",
+		"tags":         []interface{}{"API"},
+		"story_points": float64(3),
+		"release_date": "2026-10-15",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertIDsToLabels() = %v, want %v", got, want)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"project-board-api/internal/domain"
+	"project-board-api/internal/position"
 )
 
 // modelInfo holds information about a domain model and its table name
@@ -30,6 +32,15 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.Comment{},
 		&domain.FieldOption{},
 		&domain.Attachment{},
+		&domain.ActivityLog{},
+		&domain.Subtask{},
+		&domain.BoardTemplate{},
+		&domain.Watcher{},
+		&domain.CommentReaction{},
+		&domain.BoardLink{},
+		&domain.CustomFieldDefinition{},
+		&domain.ProjectPermissionMatrix{},
+		&domain.BoardDueReminder{},
 	}
 
 	// Run auto-migration for all models
@@ -37,6 +48,14 @@ func AutoMigrate(db *gorm.DB) error {
 		return fmt.Errorf("failed to run auto-migration: %w", err)
 	}
 
+	if _, err := backfillBoardPositions(db); err != nil {
+		return fmt.Errorf("failed to backfill board positions: %w", err)
+	}
+
+	if err := createSearchIndexes(db); err != nil {
+		return fmt.Errorf("failed to create search indexes: %w", err)
+	}
+
 	return nil
 }
 
@@ -57,6 +76,15 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 		{&domain.Comment{}, "comments"},
 		{&domain.FieldOption{}, "field_options"},
 		{&domain.Attachment{}, "attachments"},
+		{&domain.ActivityLog{}, "activity_logs"},
+		{&domain.Subtask{}, "subtasks"},
+		{&domain.BoardTemplate{}, "board_templates"},
+		{&domain.Watcher{}, "watchers"},
+		{&domain.CommentReaction{}, "comment_reactions"},
+		{&domain.BoardLink{}, "board_links"},
+		{&domain.CustomFieldDefinition{}, "custom_field_definitions"},
+		{&domain.ProjectPermissionMatrix{}, "project_permission_matrices"},
+		{&domain.BoardDueReminder{}, "board_due_reminders"},
 	}
 
 	logger.Info("Starting safe auto-migration",
@@ -94,6 +122,22 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 		)
 	}
 
+	backfilled, err := backfillBoardPositions(db)
+	if err != nil {
+		logger.Error("Failed to backfill board positions", zap.Error(err))
+		return fmt.Errorf("failed to backfill board positions: %w", err)
+	}
+	if backfilled > 0 {
+		logger.Info("Backfilled board positions",
+			zap.Int("boards", backfilled),
+		)
+	}
+
+	if err := createSearchIndexes(db); err != nil {
+		logger.Error("Failed to create search indexes", zap.Error(err))
+		return fmt.Errorf("failed to create search indexes: %w", err)
+	}
+
 	logger.Info("Safe auto-migration completed successfully",
 		zap.Int("tables_migrated", len(models)),
 	)
@@ -101,6 +145,75 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 	return nil
 }
 
+// backfillBoardPositions assigns kanban positions to boards created before the position column existed
+// 프로젝트별로 생성 순서대로 기존 카드 뒤에 배치하며, 이미 위치가 있는 보드는 건드리지 않습니다.
+func backfillBoardPositions(db *gorm.DB) (int, error) {
+	var projectIDs []uuid.UUID
+	if err := db.Unscoped().Model(&domain.Board{}).
+		Where("position = ''").
+		Distinct("project_id").
+		Pluck("project_id", &projectIDs).Error; err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, projectID := range projectIDs {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var last string
+			if err := tx.Unscoped().Model(&domain.Board{}).
+				Where("project_id = ?", projectID).
+				Select("COALESCE(MAX(position), '')").
+				Scan(&last).Error; err != nil {
+				return err
+			}
+
+			var boardIDs []uuid.UUID
+			if err := tx.Unscoped().Model(&domain.Board{}).
+				Where("project_id = ? AND position = ''", projectID).
+				Order("created_at ASC, id ASC").
+				Pluck("id", &boardIDs).Error; err != nil {
+				return err
+			}
+
+			// UpdateColumn: updated_at은 변경하지 않음
+			for i, key := range position.Sequence(last, len(boardIDs)) {
+				if err := tx.Unscoped().Model(&domain.Board{}).
+					Where("id = ?", boardIDs[i]).
+					UpdateColumn("position", key).Error; err != nil {
+					return err
+				}
+			}
+			total += len(boardIDs)
+			return nil
+		})
+		if err != nil {
+			return total, fmt.Errorf("project %s: %w", projectID, err)
+		}
+	}
+	return total, nil
+}
+
+// searchIndexes are the GIN indexes behind board full-text search (repository.FullTextSearchBoards).
+// 'simple' 설정은 형태소 분석 없이 공백 기준으로 나누므로 한국어와 영어를 함께 검색할 수 있습니다.
+var searchIndexes = []string{
+	`CREATE INDEX IF NOT EXISTS idx_boards_search ON boards USING GIN (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(content, '')))`,
+	`CREATE INDEX IF NOT EXISTS idx_comments_search ON comments USING GIN (to_tsvector('simple', content))`,
+}
+
+// createSearchIndexes creates the full-text search expression indexes, which GORM tags cannot describe
+// (Postgres 전용이므로 다른 DB에서는 건너뜀)
+func createSearchIndexes(db *gorm.DB) error {
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	for _, statement := range searchIndexes {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SafeAutoMigrateWithRetry runs SafeAutoMigrate with retry logic
 // It attempts migration up to maxRetries times with exponential backoff
 func SafeAutoMigrateWithRetry(db *gorm.DB, logger *zap.Logger, maxRetries int) error {
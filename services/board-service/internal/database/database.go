@@ -16,6 +16,8 @@ type Config struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	// ReplicaDSNs are read replica DSNs used by EnableReadReplicas (optional)
+	ReplicaDSNs []string
 }
 
 // New creates a new database connection
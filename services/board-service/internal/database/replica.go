@@ -0,0 +1,36 @@
+package database
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/dbreplica"
+)
+
+// EnableReadReplicas connects to cfg.ReplicaDSNs and registers the replica resolver on db.
+// Only requests marked with dbreplica.ReadFromReplica are routed to replicas.
+// Returns nil when no replicas are configured.
+func EnableReadReplicas(db *gorm.DB, cfg Config, logger *zap.Logger) (*dbreplica.Resolver, error) {
+	if len(cfg.ReplicaDSNs) == 0 {
+		return nil, nil
+	}
+
+	resolver, err := dbreplica.Open(dbreplica.Config{
+		DSNs:            cfg.ReplicaDSNs,
+		MaxOpenConns:    cfg.MaxOpenConns,
+		MaxIdleConns:    cfg.MaxIdleConns,
+		ConnMaxLifetime: cfg.ConnMaxLifetime,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Use(resolver); err != nil {
+		_ = resolver.Close()
+		return nil, fmt.Errorf("failed to register replica resolver: %w", err)
+	}
+
+	resolver.Start()
+	return resolver, nil
+}
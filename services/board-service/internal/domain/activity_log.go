@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityAction is the kind of board change an ActivityLog records
+type ActivityAction string
+
+const (
+	ActivityActionCreated    ActivityAction = "created"
+	ActivityActionUpdated    ActivityAction = "updated"
+	ActivityActionDeleted    ActivityAction = "deleted"
+	ActivityActionArchived   ActivityAction = "archived"
+	ActivityActionUnarchived ActivityAction = "unarchived"
+	ActivityActionRestored   ActivityAction = "restored"
+)
+
+// ActivityLog is one entry of a board's change history (append-only, never updated or deleted).
+// updated 항목은 변경된 필드 하나당 한 행이며, 값은 알림과 같은 사람이 읽을 수 있는 형태(라벨 등)로 저장합니다.
+type ActivityLog struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	BoardID   uuid.UUID      `gorm:"type:uuid;not null;index:idx_activity_logs_board_created,priority:1" json:"board_id"`
+	ProjectID uuid.UUID      `gorm:"type:uuid;not null;index:idx_activity_logs_project_id" json:"project_id"`
+	ActorID   uuid.UUID      `gorm:"type:uuid;not null" json:"actor_id"`
+	Action    ActivityAction `gorm:"type:varchar(20);not null" json:"action"`
+	Field     string         `gorm:"type:varchar(100)" json:"field"`
+	OldValue  string         `gorm:"type:text" json:"old_value"`
+	NewValue  string         `gorm:"type:text" json:"new_value"`
+	CreatedAt time.Time      `gorm:"not null;index:idx_activity_logs_board_created,priority:2" json:"created_at"`
+	Board     Board          `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for ActivityLog
+func (ActivityLog) TableName() string {
+	return "activity_logs"
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ArchiveState is whether a board or project is in use or archived.
+// 보관된 항목은 삭제와 달리 그대로 남아 있으며 기본 목록에서만 제외됩니다.
+type ArchiveState string
+
+// ArchiveState constants
+const (
+	ArchiveStateActive   ArchiveState = "ACTIVE"
+	ArchiveStateArchived ArchiveState = "ARCHIVED"
+	ArchiveStateAll      ArchiveState = "ALL" // 목록 필터 전용 (보관 여부와 관계없이 모두)
+)
+
+// ParseArchiveState parses the state filter of a list request (대소문자 무시, 빈 값은 ACTIVE)
+func ParseArchiveState(value string) (ArchiveState, bool) {
+	switch state := ArchiveState(strings.ToUpper(strings.TrimSpace(value))); state {
+	case "":
+		return ArchiveStateActive, true
+	case ArchiveStateActive, ArchiveStateArchived, ArchiveStateAll:
+		return state, true
+	default:
+		return "", false
+	}
+}
+
+// ArchiveStateOf returns the state of an entity archived at archivedAt (nil이면 ACTIVE)
+func ArchiveStateOf(archivedAt *time.Time) ArchiveState {
+	if archivedAt != nil {
+		return ArchiveStateArchived
+	}
+	return ArchiveStateActive
+}
@@ -23,6 +23,15 @@ const (
 	AttachmentStatusConfirmed AttachmentStatus = "CONFIRMED" // Confirmed status
 )
 
+// ThumbnailStatus represents the thumbnail generation state of an image attachment
+type ThumbnailStatus string
+
+const (
+	ThumbnailStatusNone   ThumbnailStatus = ""       // Not generated yet (or not an image)
+	ThumbnailStatusReady  ThumbnailStatus = "READY"  // Thumbnails stored in S3
+	ThumbnailStatusFailed ThumbnailStatus = "FAILED" // Image could not be decoded; not retried
+)
+
 // Attachment represents a file attachment associated with a board or project
 // This is a polymorphic relationship - EntityID can reference Board, Project, or Comment
 // ⚠️ IMPORTANT: Do not add foreign key constraints on EntityID as it references multiple tables
@@ -37,6 +46,21 @@ type Attachment struct {
 	ContentType string           `gorm:"type:varchar(100);not null" json:"content_type"`
 	UploadedBy  uuid.UUID        `gorm:"type:uuid;not null;index:idx_attachments_uploaded_by" json:"uploaded_by"`
 	ExpiresAt   *time.Time       `gorm:"type:timestamp;index:idx_attachments_expires_at" json:"expires_at"`
+	// 이미지 썸네일 S3 key (CONFIRMED 이후 썸네일 작업이 비동기로 채움)
+	ThumbnailKey       *string         `gorm:"type:text" json:"thumbnail_key"`
+	MediumThumbnailKey *string         `gorm:"type:text" json:"medium_thumbnail_key"`
+	ThumbnailStatus    ThumbnailStatus `gorm:"type:varchar(20);not null;default:'';index:idx_attachments_thumbnail_status" json:"thumbnail_status"`
+}
+
+// ThumbnailKeys returns the S3 keys of the attachment's generated thumbnails
+func (a *Attachment) ThumbnailKeys() []string {
+	var keys []string
+	for _, key := range []*string{a.ThumbnailKey, a.MediumThumbnailKey} {
+		if key != nil && *key != "" {
+			keys = append(keys, *key)
+		}
+	}
+	return keys
 }
 
 // TableName specifies the table name for Attachment
@@ -5,22 +5,29 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 // Board represents a work board entity within a project
 type Board struct {
 	BaseModel
-	ProjectID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_project_id" json:"project_id"`
-	AuthorID     uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_author_id" json:"author_id"`
-	AssigneeID   *uuid.UUID     `gorm:"type:uuid;index:idx_boards_assignee_id" json:"assignee_id"`
-	Title        string         `gorm:"type:varchar(255);not null" json:"title"`
-	Content      string         `gorm:"type:text" json:"content"`
-	CustomFields datatypes.JSON `gorm:"type:jsonb" json:"custom_fields"`
-	StartDate    *time.Time     `gorm:"type:timestamp;index:idx_boards_start_date" json:"start_date"`
-	DueDate      *time.Time     `gorm:"type:timestamp;index:idx_boards_due_date" json:"due_date"`
-	Project      Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
-	Participants []Participant  `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"participants,omitempty"`
-	Comments     []Comment      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"comments,omitempty"`
+	ProjectID        uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_project_id" json:"project_id"`
+	AuthorID         uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_author_id" json:"author_id"`
+	AssigneeID       *uuid.UUID     `gorm:"type:uuid;index:idx_boards_assignee_id" json:"assignee_id"`
+	Title            string         `gorm:"type:varchar(255);not null" json:"title"`
+	Content          string         `gorm:"type:text" json:"content"`
+	CustomFields     datatypes.JSON `gorm:"type:jsonb" json:"custom_fields"`
+	StartDate        *time.Time     `gorm:"type:timestamp;index:idx_boards_start_date" json:"start_date"`
+	DueDate          *time.Time     `gorm:"type:timestamp;index:idx_boards_due_date" json:"due_date"`
+	Position         string         `gorm:"type:varchar(255);not null;default:''" json:"position"`        // 칸반 컬럼 내 카드 순서 (internal/position)
+	RecurrenceRule   string         `gorm:"type:varchar(100);not null;default:''" json:"recurrence_rule"` // cron 표현식, 비어 있으면 반복 없음
+	NextRecurrenceAt *time.Time     `gorm:"index:idx_boards_next_recurrence_at" json:"next_recurrence_at"`
+	ArchivedAt       *time.Time     `gorm:"index:idx_boards_archived_at" json:"archived_at"` // nil이 아니면 보관됨 (기본 목록에서 제외)
+	Version          int            `gorm:"not null;default:1" json:"version"`               // 저장할 때마다 1씩 증가 (낙관적 동시성 제어)
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`               // BaseModel.DeletedAt을 가려 GORM soft delete 사용 (휴지통)
+	Project          Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	Participants     []Participant  `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"participants,omitempty"`
+	Comments         []Comment      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"comments,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
 }
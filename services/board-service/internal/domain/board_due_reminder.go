@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardDueReminder records that a board's BOARD_DUE_SOON reminder for one window was sent.
+// 마감일(DueDate)까지 키에 포함하므로 마감일을 바꾸면 같은 윈도우의 알림이 다시 발송됩니다.
+type BoardDueReminder struct {
+	BoardID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"board_id"`
+	WindowMinutes int       `gorm:"primaryKey" json:"window_minutes"`
+	DueDate       time.Time `gorm:"type:timestamp;primaryKey" json:"due_date"`
+	SentAt        time.Time `gorm:"not null" json:"sent_at"`
+	Board         Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for BoardDueReminder
+func (BoardDueReminder) TableName() string {
+	return "board_due_reminders"
+}
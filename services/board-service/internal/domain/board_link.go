@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardLinkType is the relation between two boards of a link
+type BoardLinkType string
+
+const (
+	// BoardLinkTypeBlocks means the source board must be finished before the target board (의존 관계, 순환 불가)
+	BoardLinkTypeBlocks BoardLinkType = "BLOCKS"
+	// BoardLinkTypeRelatesTo is an undirected reference between two boards
+	BoardLinkTypeRelatesTo BoardLinkType = "RELATES_TO"
+)
+
+// BoardLink is a typed relation between two boards of the same project.
+// "blocked by" 관계는 방향을 뒤집어 BLOCKS로 저장하므로 저장되는 타입은 두 가지뿐입니다.
+type BoardLink struct {
+	ID            uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ProjectID     uuid.UUID     `gorm:"type:uuid;not null;index:idx_board_links_project_id" json:"project_id"`
+	SourceBoardID uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:uq_board_links_source_target_type" json:"source_board_id"`
+	TargetBoardID uuid.UUID     `gorm:"type:uuid;not null;index:idx_board_links_target_board_id;uniqueIndex:uq_board_links_source_target_type" json:"target_board_id"`
+	Type          BoardLinkType `gorm:"type:varchar(20);not null;uniqueIndex:uq_board_links_source_target_type" json:"type"`
+	CreatedBy     uuid.UUID     `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt     time.Time     `gorm:"not null" json:"created_at"`
+	SourceBoard   Board         `gorm:"foreignKey:SourceBoardID;constraint:OnDelete:CASCADE" json:"-"`
+	TargetBoard   Board         `gorm:"foreignKey:TargetBoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for BoardLink
+func (BoardLink) TableName() string {
+	return "board_links"
+}
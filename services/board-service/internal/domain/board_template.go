@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// BoardTemplate is a predefined board of a project that new boards can be created from
+type BoardTemplate struct {
+	BaseModel
+	ProjectID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_board_templates_project_id" json:"project_id"`
+	CreatedBy    uuid.UUID      `gorm:"type:uuid;not null" json:"created_by"`
+	Name         string         `gorm:"type:varchar(100);not null" json:"name"`
+	Title        string         `gorm:"type:varchar(255)" json:"title"`
+	Content      string         `gorm:"type:text" json:"content"`
+	CustomFields datatypes.JSON `gorm:"type:jsonb" json:"custom_fields"` // value 기반 (예: stage="in_progress"), 보드 생성 시 ID로 변환
+	Participants datatypes.JSON `gorm:"type:jsonb" json:"participants"`  // 참여자 user ID 배열
+	Project      Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for BoardTemplate
+func (BoardTemplate) TableName() string {
+	return "board_templates"
+}
@@ -1,16 +1,25 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
 
 // Comment represents a comment on a board
 type Comment struct {
 	BaseModel
 	BoardID uuid.UUID `gorm:"type:uuid;not null;index:idx_comments_board_id" json:"board_id"`
 	UserID  uuid.UUID `gorm:"type:uuid;not null;index:idx_comments_user_id" json:"user_id"`
-	Content string    `gorm:"type:text;not null" json:"content"`
-	Board   Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+	// ParentCommentID는 답글인 경우 상위 댓글 ID (같은 보드의 댓글만 가능)
+	ParentCommentID *uuid.UUID `gorm:"type:uuid;index:idx_comments_parent_comment_id" json:"parent_comment_id,omitempty"`
+	Content         string     `gorm:"type:text;not null" json:"content"`
+	// Mentions는 본문의 @멘션 중 프로젝트 멤버로 확인된 user ID 배열
+	Mentions datatypes.JSON `gorm:"type:jsonb" json:"mentions"`
+	Board    Board          `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
+	// Reactions도 Repository에서 별도 조회 (응답에서는 이모지별로 집계)
+	Reactions []CommentReaction `gorm:"-" json:"reactions,omitempty"`
 }
 
 // TableName specifies the table name for Comment
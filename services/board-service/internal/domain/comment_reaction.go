@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentReaction is an emoji reaction of a user to a comment.
+// 한 사용자가 같은 댓글에 여러 이모지를 남길 수 있지만 같은 이모지는 한 번만 남습니다.
+type CommentReaction struct {
+	CommentID uuid.UUID `gorm:"type:uuid;primaryKey" json:"comment_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	Emoji     string    `gorm:"type:varchar(32);primaryKey" json:"emoji"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	Comment   Comment   `gorm:"foreignKey:CommentID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for CommentReaction
+func (CommentReaction) TableName() string {
+	return "comment_reactions"
+}
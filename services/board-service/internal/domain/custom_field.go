@@ -0,0 +1,39 @@
+package domain
+
+import "github.com/google/uuid"
+
+// CustomFieldType is the value type of a project-defined custom field
+type CustomFieldType string
+
+// CustomFieldType constants
+const (
+	CustomFieldTypeSelect      CustomFieldType = "select"
+	CustomFieldTypeMultiSelect CustomFieldType = "multi_select"
+	CustomFieldTypeNumber      CustomFieldType = "number"
+	CustomFieldTypeDate        CustomFieldType = "date"
+	CustomFieldTypeText        CustomFieldType = "text"
+)
+
+// HasOptions reports whether values of the type are picked from an option list
+func (t CustomFieldType) HasOptions() bool {
+	return t == CustomFieldTypeSelect || t == CustomFieldTypeMultiSelect
+}
+
+// CustomFieldDefinition is a custom field defined by a project in addition to stage, role and importance.
+// select/multi_select 필드의 옵션은 field_options에 field_type = Key로 저장하므로
+// 보드의 customFields에는 기본 필드와 같이 옵션 ID가 저장됩니다.
+type CustomFieldDefinition struct {
+	BaseModel
+	ProjectID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uq_custom_field_definitions_project_key,priority:1" json:"project_id"`
+	Key          string          `gorm:"type:varchar(50);not null;uniqueIndex:uq_custom_field_definitions_project_key,priority:2" json:"key"`
+	Name         string          `gorm:"type:varchar(100);not null" json:"name"`
+	Type         CustomFieldType `gorm:"type:varchar(20);not null" json:"type"`
+	DisplayOrder int             `gorm:"type:int;not null;default:0" json:"display_order"`
+	CreatedBy    uuid.UUID       `gorm:"type:uuid;not null" json:"created_by"`
+	Project      *Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for CustomFieldDefinition
+func (CustomFieldDefinition) TableName() string {
+	return "custom_field_definitions"
+}
@@ -17,6 +17,7 @@ type Project struct {
 	DueDate      *time.Time           `gorm:"type:timestamp" json:"due_date,omitempty"`
 	IsDefault    bool                 `gorm:"default:false;index:idx_projects_is_default" json:"is_default"`
 	IsPublic     bool                 `gorm:"default:false" json:"is_public"`
+	ArchivedAt   *time.Time           `gorm:"index:idx_projects_archived_at" json:"archived_at"` // nil이 아니면 보관됨 (기본 목록에서 제외)
 	Boards       []Board              `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"boards,omitempty"`
 	Members      []ProjectMember      `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"members,omitempty"`
 	JoinRequests []ProjectJoinRequest `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"join_requests,omitempty"`
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// ProjectPermission is an action whose allowed project roles are looked up in the project's permission matrix
+type ProjectPermission string
+
+// Customizable permissions; 프로젝트 소유자가 역할별 허용 여부를 바꿀 수 있음
+const (
+	PermissionUpdateProject   ProjectPermission = "can_update_project"
+	PermissionDeleteBoard     ProjectPermission = "can_delete_board"
+	PermissionManageFields    ProjectPermission = "can_manage_fields"
+	PermissionManageTemplates ProjectPermission = "can_manage_templates"
+	PermissionInvite          ProjectPermission = "can_invite"
+	PermissionRemoveMembers   ProjectPermission = "can_remove_members"
+)
+
+// Owner-only permissions; 매트릭스로 바꿀 수 없음 (소유자가 스스로를 잠그지 않도록)
+const (
+	PermissionDeleteProject ProjectPermission = "can_delete_project"
+	PermissionManageRoles   ProjectPermission = "can_manage_roles"
+)
+
+// ProjectPermissions lists every permission in display order
+var ProjectPermissions = []ProjectPermission{
+	PermissionUpdateProject,
+	PermissionDeleteBoard,
+	PermissionManageFields,
+	PermissionManageTemplates,
+	PermissionInvite,
+	PermissionRemoveMembers,
+	PermissionDeleteProject,
+	PermissionManageRoles,
+}
+
+// DefaultProjectPermissions is the matrix of a project that has not customized it.
+// OWNER는 항상 모든 권한을 가지므로 여기에는 OWNER 외에 허용되는 역할만 적습니다.
+var DefaultProjectPermissions = map[ProjectPermission][]ProjectRole{
+	PermissionUpdateProject:   {},
+	PermissionDeleteBoard:     {ProjectRoleAdmin, ProjectRoleMember},
+	PermissionManageFields:    {ProjectRoleAdmin},
+	PermissionManageTemplates: {},
+	PermissionInvite:          {ProjectRoleAdmin},
+	PermissionRemoveMembers:   {ProjectRoleAdmin},
+}
+
+// IsCustomizable reports whether owners can change which roles hold the permission
+func (p ProjectPermission) IsCustomizable() bool {
+	_, ok := DefaultProjectPermissions[p]
+	return ok
+}
+
+// ProjectPermissionMatrix stores the permissions a project changed from DefaultProjectPermissions.
+// Permissions는 {"can_delete_board": ["ADMIN"]} 형태의 jsonb이며, 없는 키는 기본값을 따릅니다.
+type ProjectPermissionMatrix struct {
+	ProjectID   uuid.UUID      `gorm:"type:uuid;primaryKey" json:"project_id"`
+	Permissions datatypes.JSON `gorm:"type:jsonb;not null" json:"permissions"`
+	UpdatedBy   uuid.UUID      `gorm:"type:uuid;not null" json:"updated_by"`
+	UpdatedAt   time.Time      `gorm:"not null;autoUpdateTime" json:"updated_at"`
+	Project     *Project       `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for ProjectPermissionMatrix
+func (ProjectPermissionMatrix) TableName() string {
+	return "project_permission_matrices"
+}
+
+// Overrides decodes the stored permissions (nil matrix는 재정의가 없음)
+func (m *ProjectPermissionMatrix) Overrides() (map[ProjectPermission][]ProjectRole, error) {
+	if m == nil || len(m.Permissions) == 0 {
+		return nil, nil
+	}
+	var overrides map[ProjectPermission][]ProjectRole
+	if err := json.Unmarshal(m.Permissions, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// RoleHasPermission reports whether role holds permission, given the project's overrides (nil이면 기본값)
+func RoleHasPermission(overrides map[ProjectPermission][]ProjectRole, role ProjectRole, permission ProjectPermission) bool {
+	if role == ProjectRoleOwner {
+		return true
+	}
+	roles, ok := overrides[permission]
+	if !ok {
+		roles, ok = DefaultProjectPermissions[permission]
+		if !ok {
+			return false // 소유자 전용 권한
+		}
+	}
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Subtask represents a checklist item of a board
+type Subtask struct {
+	BaseModel
+	BoardID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_subtasks_board_id" json:"board_id"`
+	Title      string     `gorm:"type:varchar(255);not null" json:"title"`
+	Done       bool       `gorm:"not null;default:false" json:"done"`
+	AssigneeID *uuid.UUID `gorm:"type:uuid;index:idx_subtasks_assignee_id" json:"assignee_id"`
+	Position   string     `gorm:"type:varchar(255);not null;default:''" json:"position"` // 보드 안 체크리스트 순서 (internal/position)
+	Board      Board      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for Subtask
+func (Subtask) TableName() string {
+	return "subtasks"
+}
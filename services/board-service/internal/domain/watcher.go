@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Watcher is a user who receives a board's update notifications without being assigned to it.
+// 참여자(Participant)와 달리 작업 배정의 의미가 없으며, 구독 해제 시 행을 바로 삭제합니다.
+type Watcher struct {
+	BoardID   uuid.UUID `gorm:"type:uuid;primaryKey" json:"board_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_watchers_user_id" json:"user_id"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	Board     Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for Watcher
+func (Watcher) TableName() string {
+	return "watchers"
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Activity list page size limits
+const (
+	DefaultActivityPageLimit = 50
+	MaxActivityPageLimit     = 200
+)
+
+// ActivityResponse represents one entry of a board's change history
+// @Description action is created, updated or deleted; field, oldValue and newValue are set for updated entries.
+// @Description Custom field values are labels (e.g. "진행중"), as shown in notifications.
+type ActivityResponse struct {
+	ID        uuid.UUID `json:"activityId" example:"6a1f0f3e-2b7c-4d1a-9f5e-3c2b1a0d9e8f"`
+	BoardID   uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ActorID   uuid.UUID `json:"actorId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Action    string    `json:"action" example:"updated"`
+	Field     string    `json:"field,omitempty" example:"stage"`
+	OldValue  string    `json:"oldValue,omitempty" example:"대기"`
+	NewValue  string    `json:"newValue,omitempty" example:"진행중"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T14:20:00Z"`
+}
+
+// PaginatedActivitiesResponse represents a cursor-paginated board history.
+// @Description Activities are ordered from newest to oldest.
+// @Description Pass nextCursor as the cursor query parameter to fetch the next page; it is omitted on the last page.
+type PaginatedActivitiesResponse struct {
+	Activities []ActivityResponse `json:"activities"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+	HasMore    bool               `json:"hasMore" example:"false"`
+	Limit      int                `json:"limit" example:"50"`
+}
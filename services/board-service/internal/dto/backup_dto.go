@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackupFormatVersion is the version of the workspace backup section written by this service.
+// Import rejects other versions so an old service never half-restores a newer archive.
+const BackupFormatVersion = 1
+
+// WorkspaceBackup is the board-service section of a workspace export archive.
+// Soft-deleted rows are not exported; attachments stay in the bucket and are not included.
+type WorkspaceBackup struct {
+	FormatVersion  int                   `json:"formatVersion"`
+	WorkspaceID    uuid.UUID             `json:"workspaceId"`
+	Projects       []ProjectBackup       `json:"projects"`
+	ProjectMembers []ProjectMemberBackup `json:"projectMembers"`
+	FieldOptions   []FieldOptionBackup   `json:"fieldOptions"`
+	Boards         []BoardBackup         `json:"boards"`
+	Participants   []ParticipantBackup   `json:"participants"`
+	Comments       []CommentBackup       `json:"comments"`
+}
+
+// ProjectBackup is an exported project row
+type ProjectBackup struct {
+	ID          uuid.UUID  `json:"id"`
+	OwnerID     uuid.UUID  `json:"ownerId"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	StartDate   *time.Time `json:"startDate,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	IsDefault   bool       `json:"isDefault"`
+	IsPublic    bool       `json:"isPublic"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// ProjectMemberBackup is an exported project member row
+type ProjectMemberBackup struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectId"`
+	UserID    uuid.UUID `json:"userId"`
+	RoleName  string    `json:"roleName"`
+	JoinedAt  time.Time `json:"joinedAt"`
+}
+
+// FieldOptionBackup is an exported project field option row (system defaults are not exported)
+type FieldOptionBackup struct {
+	ID           uuid.UUID `json:"id"`
+	ProjectID    uuid.UUID `json:"projectId"`
+	FieldType    string    `json:"fieldType"`
+	Value        string    `json:"value"`
+	Label        string    `json:"label"`
+	Color        string    `json:"color"`
+	DisplayOrder int       `json:"displayOrder"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// BoardBackup is an exported board row
+type BoardBackup struct {
+	ID           uuid.UUID       `json:"id"`
+	ProjectID    uuid.UUID       `json:"projectId"`
+	AuthorID     uuid.UUID       `json:"authorId"`
+	AssigneeID   *uuid.UUID      `json:"assigneeId,omitempty"`
+	Title        string          `json:"title"`
+	Content      string          `json:"content"`
+	CustomFields json.RawMessage `json:"customFields,omitempty"`
+	StartDate    *time.Time      `json:"startDate,omitempty"`
+	DueDate      *time.Time      `json:"dueDate,omitempty"`
+	Position     string          `json:"position,omitempty"` // 이전 형식의 archive에는 없음 (다음 마이그레이션 때 채워짐)
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// ParticipantBackup is an exported board participant row
+type ParticipantBackup struct {
+	ID        uuid.UUID `json:"id"`
+	BoardID   uuid.UUID `json:"boardId"`
+	UserID    uuid.UUID `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CommentBackup is an exported comment row
+type CommentBackup struct {
+	ID        uuid.UUID `json:"id"`
+	BoardID   uuid.UUID `json:"boardId"`
+	UserID    uuid.UUID `json:"userId"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// BackupImportResult reports how many rows an import inserted per table.
+// Rows that already exist (same ID) are skipped, so re-running an import is safe.
+type BackupImportResult struct {
+	Projects       int64 `json:"projects"`
+	ProjectMembers int64 `json:"projectMembers"`
+	FieldOptions   int64 `json:"fieldOptions"`
+	Boards         int64 `json:"boards"`
+	Participants   int64 `json:"participants"`
+	Comments       int64 `json:"comments"`
+}
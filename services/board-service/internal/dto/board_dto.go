@@ -41,6 +41,7 @@ type UpdateBoardRequest struct {
 	DueDate       *time.Time              `json:"dueDate" example:"2024-12-31T23:59:59Z"`
 	Participants  []uuid.UUID             `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
 	AttachmentIDs []uuid.UUID             `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Version       *int                    `json:"version,omitempty" example:"3"` // 클라이언트가 마지막으로 본 버전 (If-Match 헤더로도 전달 가능)
 }
 
 // UpdateBoardFieldRequest represents the request to update a single board field
@@ -60,6 +61,9 @@ type AttachmentResponse struct {
 	ContentType string    `json:"contentType" example:"application/pdf"`
 	UploadedBy  uuid.UUID `json:"uploadedBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	UploadedAt  time.Time `json:"uploadedAt" example:"2024-01-15T10:30:00Z"`
+	// 이미지 첨부파일의 썸네일 (생성 전이거나 이미지가 아니면 생략)
+	ThumbnailURL       *string `json:"thumbnailUrl,omitempty" example:"https://s3.amazonaws.com/bucket/board/thumbnails/small/photo.jpg"`
+	MediumThumbnailURL *string `json:"mediumThumbnailUrl,omitempty" example:"https://s3.amazonaws.com/bucket/board/thumbnails/medium/photo.jpg"`
 }
 
 // BoardResponse represents the board response
@@ -68,55 +72,191 @@ type AttachmentResponse struct {
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description participantIds contains an array of user IDs who are participants of the board
 type BoardResponse struct {
-	ID             uuid.UUID              `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
-	ProjectID      uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
-	WorkspaceID    uuid.UUID              `json:"workspaceId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
-	AuthorID       uuid.UUID              `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
-	AssigneeID     *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
-	Title          string                 `json:"title" example:"Implement user authentication"`
-	Content        string                 `json:"content" example:"Add JWT-based authentication to the API"`
-	CustomFields   map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
-	StartDate      *time.Time             `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
-	DueDate        *time.Time             `json:"dueDate,omitempty" example:"2024-12-31T23:59:59Z"`
-	ParticipantIDs []uuid.UUID            `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
-	Attachments    []AttachmentResponse   `json:"attachments"`
-	CreatedAt      time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
-	UpdatedAt      time.Time              `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
-}
-
-// PaginatedBoardsResponse represents a paginated list of boards with metadata.
+	ID               uuid.UUID              `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ProjectID        uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	WorkspaceID      uuid.UUID              `json:"workspaceId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	AuthorID         uuid.UUID              `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	AssigneeID       *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Title            string                 `json:"title" example:"Implement user authentication"`
+	Content          string                 `json:"content" example:"Add JWT-based authentication to the API"`
+	CustomFields     map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
+	StartDate        *time.Time             `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
+	DueDate          *time.Time             `json:"dueDate,omitempty" example:"2024-12-31T23:59:59Z"`
+	ParticipantIDs   []uuid.UUID            `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	Attachments      []AttachmentResponse   `json:"attachments"`
+	Position         string                 `json:"position" example:"a0i"`
+	SubtaskProgress  SubtaskProgress        `json:"subtaskProgress"`
+	RecurrenceRule   string                 `json:"recurrenceRule,omitempty" example:"0 9 * * 1"`
+	NextRecurrenceAt *time.Time             `json:"nextRecurrenceAt,omitempty" example:"2024-01-22T09:00:00Z"`
+	State            string                 `json:"state" example:"ACTIVE"` // ACTIVE 또는 ARCHIVED
+	ArchivedAt       *time.Time             `json:"archivedAt,omitempty" example:"2024-02-01T09:00:00Z"`
+	Version          int                    `json:"version" example:"3"`
+	CreatedAt        time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt        time.Time              `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+}
+
+// Board list page size limits
+const (
+	DefaultBoardPageLimit = 50
+	MaxBoardPageLimit     = 200
+)
+
+// PaginatedBoardsResponse represents a cursor-paginated list of boards.
+// @Description Boards are ordered by creation time (oldest first).
+// @Description Pass nextCursor as the cursor query parameter to fetch the next page; it is omitted on the last page.
 type PaginatedBoardsResponse struct {
-	Boards []BoardResponse `json:"boards"`
-	Total  int64           `json:"total"`
-	Page   int             `json:"page"`
-	Limit  int             `json:"limit"`
+	Boards     []*BoardResponse `json:"boards"`
+	NextCursor string           `json:"nextCursor,omitempty" example:"MTcwNTMxMjAwMDAwMDAwMDAwMF8xMjc1ZWFjNS1mMGY5LTRiZWUtODIzNS01NzZhMDA0MmY0MmI"`
+	HasMore    bool             `json:"hasMore" example:"true"`
+	Limit      int              `json:"limit" example:"50"`
 }
 
-// BoardDetailResponse represents the detailed board response with participants and comments
-// @Description Detailed board response with value-based customFields, participants, and comments
+// BoardDetailResponse represents the detailed board response with participants, comments and links
+// @Description Detailed board response with value-based customFields, participants, comments and dependency links
 // @Description customFields contains field type as key and value string as value (not UUIDs)
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
 type BoardDetailResponse struct {
 	BoardResponse
 	Participants []ParticipantResponse `json:"participants"`
 	Comments     []CommentResponse     `json:"comments"`
+	Links        []BoardLinkResponse   `json:"links"`
 }
 
 // BoardFilters represents the filter parameters for board queries
 type BoardFilters struct {
 	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	Cursor       string                 `json:"cursor,omitempty"` // nextCursor of the previous page
+	Limit        int                    `json:"limit,omitempty"`  // 0 = DefaultBoardPageLimit
+	State        string                 `json:"state,omitempty"`  // ACTIVE(기본), ARCHIVED 또는 ALL
 }
 
 // MoveBoardRequest represents the request to move a board
+// @Description prevBoardId/nextBoardId are the cards directly above/below the drop position in the target column.
+// @Description Omit both to append the card to the bottom of the column.
 type MoveBoardRequest struct {
-	ProjectID        string  `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
-	GroupByFieldName string  `json:"groupByFieldName" binding:"required" example:"stage"`
-	NewFieldValue    *string `json:"newFieldValue" example:"in_progress"`
+	ProjectID        string     `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	GroupByFieldName string     `json:"groupByFieldName" binding:"required" example:"stage"`
+	NewFieldValue    *string    `json:"newFieldValue" example:"in_progress"`
+	PrevBoardID      *uuid.UUID `json:"prevBoardId,omitempty" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	NextBoardID      *uuid.UUID `json:"nextBoardId,omitempty" example:"2386fbd6-01fa-4cff-9346-687b1153f53c"`
 }
 
 // MoveBoardResponse represents response after moving a board
 type MoveBoardResponse struct {
 	BoardID       string `json:"boardId"`
+	OldFieldValue string `json:"oldFieldValue"`
 	NewFieldValue string `json:"newFieldValue"`
+	Position      string `json:"position"`
+	Version       int    `json:"version"`
 	Message       string `json:"message"`
 }
+
+// BoardColumnOrderResponse represents the card order of one kanban column
+type BoardColumnOrderResponse struct {
+	GroupByFieldName string      `json:"groupByFieldName" example:"stage"`
+	FieldValue       string      `json:"fieldValue" example:"in_progress"`
+	BoardIDs         []uuid.UUID `json:"boardIds"`
+}
+
+// GroupedBoardsResponse represents the boards of a project grouped into kanban columns
+// @Description columns follow the displayOrder of the field options; boards in a column are in card order.
+// @Description unassigned holds the boards without a value for the field.
+type GroupedBoardsResponse struct {
+	GroupByFieldName string                `json:"groupByFieldName" example:"stage"`
+	Columns          []BoardColumnResponse `json:"columns"`
+	Unassigned       []*BoardResponse      `json:"unassigned"`
+}
+
+// BoardColumnResponse represents one kanban column with its boards in card order
+type BoardColumnResponse struct {
+	OptionID   uuid.UUID        `json:"optionId"`
+	FieldValue string           `json:"fieldValue" example:"in_progress"`
+	Label      string           `json:"label" example:"진행중"`
+	Color      string           `json:"color" example:"#3B82F6"`
+	Boards     []*BoardResponse `json:"boards"`
+}
+
+// BulkBoardOperationType identifies an operation of a bulk board request
+type BulkBoardOperationType string
+
+const (
+	BulkBoardOpMove         BulkBoardOperationType = "move"
+	BulkBoardOpUpdateFields BulkBoardOperationType = "updateCustomFields"
+	BulkBoardOpAssign       BulkBoardOperationType = "assign"
+	BulkBoardOpDelete       BulkBoardOperationType = "delete"
+)
+
+// MaxBulkBoardOperations is the maximum number of operations in one bulk request
+const MaxBulkBoardOperations = 100
+
+// BulkBoardOperation represents one operation of a bulk board request
+// @Description move: groupByFieldName의 값을 newFieldValue로 변경 (칸반 컬럼 이동)
+// @Description updateCustomFields: customFields의 필드만 변경하고 나머지 필드는 유지
+// @Description assign: assigneeId로 담당자 변경 (nil UUID이면 담당자 해제)
+// @Description delete: 보드 삭제 (같은 보드에 대한 이후 작업은 허용되지 않음)
+type BulkBoardOperation struct {
+	Type             BulkBoardOperationType `json:"type" binding:"required,oneof=move updateCustomFields assign delete" example:"move"`
+	BoardID          uuid.UUID              `json:"boardId" binding:"required" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	GroupByFieldName string                 `json:"groupByFieldName,omitempty" example:"stage"`
+	NewFieldValue    *string                `json:"newFieldValue,omitempty" example:"in_progress"`
+	CustomFields     map[string]interface{} `json:"customFields,omitempty" swaggertype:"object,string" example:"importance:high"`
+	AssigneeID       *uuid.UUID             `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+}
+
+// BulkBoardRequest represents a batch of board operations applied in one transaction
+// @Description All boards must belong to projectId. Operations are applied in order; if one fails, none are applied.
+type BulkBoardRequest struct {
+	ProjectID  uuid.UUID            `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Operations []BulkBoardOperation `json:"operations" binding:"required,min=1,max=100,dive"`
+}
+
+// BulkBoardMove describes a board that changed kanban column in a bulk request
+type BulkBoardMove struct {
+	BoardID          uuid.UUID `json:"boardId"`
+	GroupByFieldName string    `json:"groupByFieldName"`
+	From             string    `json:"from"`
+	To               string    `json:"to"`
+}
+
+// BulkBoardResponse represents the result of a bulk board request
+type BulkBoardResponse struct {
+	Boards          []*BoardResponse `json:"boards"`          // 변경된 보드 (삭제된 보드 제외)
+	DeletedBoardIDs []uuid.UUID      `json:"deletedBoardIds"` // 삭제된 보드 ID
+	Moves           []BulkBoardMove  `json:"moves"`           // 컬럼 이동 내역 (칸반 순서 갱신용)
+}
+
+// SetBoardRecurrenceRequest represents the request to make a board recurring
+// @Description rule is a standard 5-field cron expression (optionally prefixed with CRON_TZ=) or a descriptor such as @weekly.
+// @Description 실행 간격이 1시간보다 짧은 규칙은 허용되지 않습니다.
+type SetBoardRecurrenceRequest struct {
+	Rule string `json:"rule" binding:"required,max=100" example:"CRON_TZ=Asia/Seoul 0 9 * * 1"`
+}
+
+// BoardRecurrenceResponse represents the recurrence of a board
+type BoardRecurrenceResponse struct {
+	BoardID   uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Rule      string     `json:"rule" example:"CRON_TZ=Asia/Seoul 0 9 * * 1"`
+	NextRunAt *time.Time `json:"nextRunAt" example:"2024-01-22T00:00:00Z"`
+}
+
+// MaxBoardImportRows is the maximum number of data rows (헤더 제외) in one board import file
+const MaxBoardImportRows = 1000
+
+// BoardImportRowError describes a validation error of one cell of an imported file
+// @Description row는 파일의 행 번호(헤더가 1행)이며, 헤더 오류는 row 1로 보고됩니다
+type BoardImportRowError struct {
+	Row     int    `json:"row" example:"3"`
+	Column  string `json:"column" example:"assignee"`
+	Value   string `json:"value" example:"nobody@example.com"`
+	Message string `json:"message" example:"Assignee is not a project member"`
+}
+
+// BoardImportResponse represents the result of a board import
+// @Description dryRun이면 검증만 하고, 아니면 오류가 없을 때만 모든 보드를 한 트랜잭션으로 생성합니다
+type BoardImportResponse struct {
+	DryRun    bool                  `json:"dryRun"`
+	TotalRows int                   `json:"totalRows"` // 빈 행을 제외한 데이터 행 수
+	ValidRows int                   `json:"validRows"`
+	Errors    []BoardImportRowError `json:"errors"`
+	Boards    []*BoardResponse      `json:"boards"` // 생성된 보드 (dryRun이거나 오류가 있으면 빈 목록)
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Board link types as seen from the board of the request or response
+const (
+	BoardLinkBlocks    = "BLOCKS"
+	BoardLinkBlockedBy = "BLOCKED_BY"
+	BoardLinkRelatesTo = "RELATES_TO"
+)
+
+// CreateBoardLinkRequest represents the request to link a board to another board of the same project
+// @Description type is the relation of the board in the path to targetBoardId:
+// @Description BLOCKS (this board blocks the target), BLOCKED_BY (the target blocks this board) or RELATES_TO.
+// @Description BLOCKS/BLOCKED_BY links that would create a dependency cycle are rejected.
+type CreateBoardLinkRequest struct {
+	TargetBoardID uuid.UUID `json:"targetBoardId" binding:"required" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Type          string    `json:"type" binding:"required,oneof=BLOCKS BLOCKED_BY RELATES_TO" example:"BLOCKS"`
+}
+
+// BoardLinkResponse represents a link of a board to another board
+// @Description type is the relation from the point of view of the board the link was fetched for.
+type BoardLinkResponse struct {
+	LinkID    uuid.UUID `json:"linkId" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Type      string    `json:"type" example:"BLOCKED_BY"`
+	BoardID   uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Title     string    `json:"title" example:"API 스펙 확정"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateBoardTemplateRequest represents the request to create a board template
+// @Description customFields는 보드 생성과 같은 value 기반 (예: stage="in_progress")
+type CreateBoardTemplateRequest struct {
+	Name         string                 `json:"name" binding:"required,min=1,max=100" example:"버그 리포트"`
+	Title        string                 `json:"title" binding:"max=200" example:"[BUG] "`
+	Content      string                 `json:"content" binding:"max=5000" example:"## 재현 방법\n\n## 기대 결과\n"`
+	CustomFields map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
+	Participants []uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
+}
+
+// UpdateBoardTemplateRequest represents the request to update a board template
+// @Description All fields are optional; customFields and participants replace the stored values
+type UpdateBoardTemplateRequest struct {
+	Name         *string                 `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Title        *string                 `json:"title,omitempty" binding:"omitempty,max=200"`
+	Content      *string                 `json:"content,omitempty" binding:"omitempty,max=5000"`
+	CustomFields *map[string]interface{} `json:"customFields,omitempty" swaggertype:"object,string"`
+	Participants *[]uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
+}
+
+// BoardTemplateResponse represents the board template response
+type BoardTemplateResponse struct {
+	TemplateID   uuid.UUID              `json:"templateId"`
+	ProjectID    uuid.UUID              `json:"projectId"`
+	Name         string                 `json:"name"`
+	Title        string                 `json:"title"`
+	Content      string                 `json:"content"`
+	CustomFields map[string]interface{} `json:"customFields" swaggertype:"object,string"`
+	Participants []uuid.UUID            `json:"participants"`
+	CreatedBy    uuid.UUID              `json:"createdBy"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+// CreateBoardFromTemplateRequest represents the request to create a board from a template
+// @Description title이 비어 있으면 템플릿 제목을 사용합니다
+// @Description customFields는 템플릿 값 위에 덮어쓰고, participants는 템플릿 참여자에 추가됩니다
+type CreateBoardFromTemplateRequest struct {
+	Title         string                 `json:"title" binding:"max=200" example:"[BUG] 로그인 실패"`
+	CustomFields  map[string]interface{} `json:"customFields,omitempty" swaggertype:"object,string" example:"stage:in_progress"`
+	AssigneeID    *uuid.UUID             `json:"assigneeId,omitempty"`
+	StartDate     *time.Time             `json:"startDate,omitempty"`
+	DueDate       *time.Time             `json:"dueDate,omitempty"`
+	Participants  []uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
+	AttachmentIDs []uuid.UUID            `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid"`
+}
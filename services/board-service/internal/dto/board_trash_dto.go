@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashedBoardResponse represents a deleted board that can still be restored
+// @Description purgeAt is when the board and its comments and attachments are permanently deleted.
+type TrashedBoardResponse struct {
+	ID         uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ProjectID  uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	AuthorID   uuid.UUID  `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	AssigneeID *uuid.UUID `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Title      string     `json:"title" example:"Implement user authentication"`
+	DeletedAt  time.Time  `json:"deletedAt" example:"2024-02-01T09:00:00Z"`
+	PurgeAt    time.Time  `json:"purgeAt" example:"2024-03-02T09:00:00Z"`
+}
+
+// BoardTrashResponse represents the trash of a project, most recently deleted first
+type BoardTrashResponse struct {
+	ProjectID     uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	RetentionDays int                    `json:"retentionDays" example:"30"`
+	Boards        []TrashedBoardResponse `json:"boards"`
+}
@@ -9,10 +9,12 @@ import (
 // CreateCommentRequest represents the request to create a new comment
 // @Description Request body for creating a new comment with optional attachments
 // @Description attachmentIds is an optional array of attachment IDs to link to the comment
+// @Description parentCommentId makes the comment a reply to another comment of the same board
 type CreateCommentRequest struct {
-	BoardID       uuid.UUID   `json:"boardId" binding:"required"`
-	Content       string      `json:"content" binding:"required,min=1"`
-	AttachmentIDs []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	BoardID         uuid.UUID   `json:"boardId" binding:"required"`
+	ParentCommentID *uuid.UUID  `json:"parentCommentId,omitempty"`
+	Content         string      `json:"content" binding:"required,min=1"`
+	AttachmentIDs   []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
 }
 
 // UpdateCommentRequest represents the request to update a comment
@@ -24,12 +26,17 @@ type UpdateCommentRequest struct {
 }
 
 // CommentResponse represents the comment response
+// Replies is only filled by the threaded comment list (GET /boards/{boardId}/comments?threaded=true)
 type CommentResponse struct {
-	CommentID   uuid.UUID            `json:"commentId"`
-	BoardID     uuid.UUID            `json:"boardId"`
-	UserID      uuid.UUID            `json:"userId"`
-	Content     string               `json:"content"`
-	Attachments []AttachmentResponse `json:"attachments"`
-	CreatedAt   time.Time            `json:"createdAt"`
-	UpdatedAt   time.Time            `json:"updatedAt"`
+	CommentID       uuid.UUID                `json:"commentId"`
+	BoardID         uuid.UUID                `json:"boardId"`
+	ParentCommentID *uuid.UUID               `json:"parentCommentId,omitempty"`
+	UserID          uuid.UUID                `json:"userId"`
+	Content         string                   `json:"content"`
+	Mentions        []uuid.UUID              `json:"mentions"`
+	Attachments     []AttachmentResponse     `json:"attachments"`
+	Reactions       []CommentReactionSummary `json:"reactions"`
+	Replies         []*CommentResponse       `json:"replies,omitempty"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	UpdatedAt       time.Time                `json:"updatedAt"`
 }
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/google/uuid"
+
+// CommentReactionSummary is the aggregate of one emoji on a comment
+type CommentReactionSummary struct {
+	Emoji   string      `json:"emoji" example:"👍"`
+	Count   int         `json:"count" example:"2"`
+	UserIDs []uuid.UUID `json:"userIds"`
+}
+
+// CommentReactionsResponse represents the reactions of a comment after a reaction change
+// @Description The same payload is broadcast to the project WebSocket as COMMENT_REACTION_UPDATED.
+type CommentReactionsResponse struct {
+	CommentID uuid.UUID                `json:"commentId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	BoardID   uuid.UUID                `json:"boardId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	ProjectID uuid.UUID                `json:"projectId" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Reactions []CommentReactionSummary `json:"reactions"`
+}
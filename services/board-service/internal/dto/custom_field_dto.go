@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldOptionRequest represents an option of a select or multi_select custom field
+type CustomFieldOptionRequest struct {
+	Value        string `json:"value" binding:"required,max=100" example:"backend"`
+	Label        string `json:"label" binding:"required,max=200" example:"백엔드"`
+	Color        string `json:"color" binding:"required,hexcolor" example:"#3B82F6"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// CreateCustomFieldRequest represents the request to define a custom field for a project
+// @Description key is the customFields key of boards (lowercase letters, digits and underscores; not stage, role or importance).
+// @Description options are required for select and multi_select and not allowed for other types.
+type CreateCustomFieldRequest struct {
+	Key          string                     `json:"key" binding:"required,max=50" example:"component"`
+	Name         string                     `json:"name" binding:"required,max=100" example:"컴포넌트"`
+	Type         string                     `json:"type" binding:"required,oneof=select multi_select number date text" example:"select"`
+	DisplayOrder int                        `json:"displayOrder"`
+	Options      []CustomFieldOptionRequest `json:"options" binding:"omitempty,dive"`
+}
+
+// UpdateCustomFieldRequest represents the request to update a custom field.
+// @Description key and type cannot be changed because boards store their values by key.
+// @Description addOptions appends options to a select or multi_select field; existing options are edited with /field-options.
+type UpdateCustomFieldRequest struct {
+	Name         *string                    `json:"name" binding:"omitempty,max=100"`
+	DisplayOrder *int                       `json:"displayOrder"`
+	AddOptions   []CustomFieldOptionRequest `json:"addOptions" binding:"omitempty,dive"`
+}
+
+// CustomFieldResponse represents a project custom field with its options
+type CustomFieldResponse struct {
+	FieldID      uuid.UUID              `json:"fieldId"`
+	ProjectID    uuid.UUID              `json:"projectId"`
+	Key          string                 `json:"key" example:"component"`
+	Name         string                 `json:"name" example:"컴포넌트"`
+	Type         string                 `json:"type" example:"select"`
+	DisplayOrder int                    `json:"displayOrder"`
+	Options      []*FieldOptionResponse `json:"options"`
+	CreatedBy    uuid.UUID              `json:"createdBy"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
@@ -47,6 +47,8 @@ type ProjectResponse struct {
 	StartDate   *time.Time           `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
 	DueDate     *time.Time           `json:"dueDate,omitempty" example:"2024-03-31T23:59:59Z"`
 	Attachments []AttachmentResponse `json:"attachments"`
+	State       string               `json:"state" example:"ACTIVE"` // ACTIVE 또는 ARCHIVED
+	ArchivedAt  *time.Time           `json:"archivedAt,omitempty" example:"2024-04-01T09:00:00Z"`
 	CreatedAt   time.Time            `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt   time.Time            `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
 }
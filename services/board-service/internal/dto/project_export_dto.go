@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportFormat is the file format of a project export
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatCSV  ExportFormat = "csv"
+)
+
+// ProjectExportFormatVersion is the version of the project export document written by this service
+const ProjectExportFormatVersion = 1
+
+// ProjectExportHeader is everything of a JSON project export before the streamed boards array.
+// 문서 전체는 이 필드들 뒤에 "boards": [BoardExport...] 가 이어지는 하나의 JSON 객체입니다.
+type ProjectExportHeader struct {
+	FormatVersion int                   `json:"formatVersion"`
+	ExportedAt    time.Time             `json:"exportedAt"`
+	Project       ProjectBackup         `json:"project"`
+	Members       []ProjectMemberBackup `json:"members"`
+	FieldOptions  []FieldOptionBackup   `json:"fieldOptions"`
+	CustomFields  []CustomFieldExport   `json:"customFields"`
+}
+
+// CustomFieldExport is an exported custom field definition (옵션은 fieldOptions에 key를 fieldType으로 포함)
+type CustomFieldExport struct {
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// BoardExport is an exported board with its participants, comments and attachment metadata.
+// customFields는 다른 워크스페이스로 옮길 수 있도록 옵션 ID가 아닌 value로 내보냅니다.
+type BoardExport struct {
+	ID           uuid.UUID              `json:"id"`
+	AuthorID     uuid.UUID              `json:"authorId"`
+	AssigneeID   *uuid.UUID             `json:"assigneeId,omitempty"`
+	Title        string                 `json:"title"`
+	Content      string                 `json:"content"`
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	StartDate    *time.Time             `json:"startDate,omitempty"`
+	DueDate      *time.Time             `json:"dueDate,omitempty"`
+	Position     string                 `json:"position"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+	Participants []ParticipantBackup    `json:"participants"`
+	Comments     []CommentExport        `json:"comments"`
+	Attachments  []AttachmentExport     `json:"attachments"`
+}
+
+// CommentExport is an exported comment (답글은 parentCommentId로 상위 댓글을 가리킴)
+type CommentExport struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"userId"`
+	ParentCommentID *uuid.UUID         `json:"parentCommentId,omitempty"`
+	Content         string             `json:"content"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+	Attachments     []AttachmentExport `json:"attachments"`
+}
+
+// AttachmentExport is the metadata of an exported attachment; 파일 본문은 포함하지 않으며 fileKey는 S3 key입니다
+type AttachmentExport struct {
+	ID          uuid.UUID `json:"id"`
+	FileName    string    `json:"fileName"`
+	FileKey     string    `json:"fileKey"`
+	FileSize    int64     `json:"fileSize"`
+	ContentType string    `json:"contentType"`
+	UploadedBy  uuid.UUID `json:"uploadedBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
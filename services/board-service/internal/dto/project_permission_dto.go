@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectPermissionsResponse represents the permission matrix of a project.
+// @Description permissions maps each permission to the roles that hold it (OWNER는 항상 포함).
+// @Description customizable lists the permissions the owner can change; can_delete_project and can_manage_roles are owner-only.
+type ProjectPermissionsResponse struct {
+	ProjectID     uuid.UUID           `json:"projectId"`
+	Permissions   map[string][]string `json:"permissions"`
+	Customizable  []string            `json:"customizable"`
+	MyRole        string              `json:"myRole" example:"ADMIN"`
+	MyPermissions []string            `json:"myPermissions"`
+	UpdatedBy     *uuid.UUID          `json:"updatedBy,omitempty"`
+	UpdatedAt     *time.Time          `json:"updatedAt,omitempty"`
+}
+
+// UpdateProjectPermissionsRequest represents the request to customize the permission matrix of a project.
+// @Description Only the given permissions change; each is set to the listed roles (ADMIN, MEMBER).
+// @Description OWNER always holds every permission, so it may be omitted. An empty list makes the permission owner-only.
+type UpdateProjectPermissionsRequest struct {
+	Permissions map[string][]string `json:"permissions" binding:"required"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+// Project stats date range limits (days are UTC dates, both ends inclusive)
+const (
+	DefaultStatsRangeDays = 30
+	MaxStatsRangeDays     = 180
+	StatsDateLayout       = "2006-01-02"
+)
+
+// DailyBoardStats is one day of a project's throughput
+// @Description remaining is the number of boards not in the completed stage at the end of the day (burndown).
+type DailyBoardStats struct {
+	Date      string `json:"date" example:"2024-01-15"`
+	Created   int    `json:"created" example:"3"`
+	Completed int    `json:"completed" example:"2"`
+	Remaining int    `json:"remaining" example:"12"`
+}
+
+// ProjectThroughputResponse represents boards created and completed per day with the burndown line
+// @Description A board counts as completed when it moves into completedStage (the label of the project's "approved" stage option).
+type ProjectThroughputResponse struct {
+	ProjectID      uuid.UUID         `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	From           string            `json:"from" example:"2024-01-01"`
+	To             string            `json:"to" example:"2024-01-30"`
+	CompletedStage string            `json:"completedStage" example:"완료"`
+	TotalCreated   int               `json:"totalCreated" example:"40"`
+	TotalCompleted int               `json:"totalCompleted" example:"35"`
+	Days           []DailyBoardStats `json:"days"`
+}
+
+// StageCycleTime is the average time boards spent in one stage before moving on
+type StageCycleTime struct {
+	Stage        string  `json:"stage" example:"진행중"`
+	AverageHours float64 `json:"averageHours" example:"26.5"`
+	Samples      int     `json:"samples" example:"18"`
+}
+
+// ProjectCycleTimeResponse represents the average time in each stage for stage changes made in the range
+// @Description Only finished stays count: time from entering a stage (or board creation) until the board left it within the range.
+type ProjectCycleTimeResponse struct {
+	ProjectID uuid.UUID        `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	From      string           `json:"from" example:"2024-01-01"`
+	To        string           `json:"to" example:"2024-01-30"`
+	Stages    []StageCycleTime `json:"stages"`
+}
+
+// AssigneeLoad is the open (not completed, not archived) boards of one assignee
+type AssigneeLoad struct {
+	AssigneeID *uuid.UUID     `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"` // null이면 담당자 미지정
+	Open       int            `json:"open" example:"5"`
+	ByStage    map[string]int `json:"byStage" swaggertype:"object,integer" example:"진행중:3"`
+}
+
+// ProjectAssigneeLoadResponse represents the current open boards per assignee, busiest first
+type ProjectAssigneeLoadResponse struct {
+	ProjectID uuid.UUID      `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Assignees []AssigneeLoad `json:"assignees"`
+}
@@ -0,0 +1,161 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchType identifies a global search result group
+type SearchType string
+
+const (
+	SearchTypeBoards   SearchType = "boards"
+	SearchTypeComments SearchType = "comments"
+	SearchTypeMessages SearchType = "messages"
+	SearchTypeFiles    SearchType = "files"
+	SearchTypeMembers  SearchType = "members"
+)
+
+// AllSearchTypes lists every group searched when no types are requested
+var AllSearchTypes = []SearchType{SearchTypeBoards, SearchTypeComments, SearchTypeMessages, SearchTypeFiles, SearchTypeMembers}
+
+// GlobalSearchRequest represents the parameters of a "search everything" query
+type GlobalSearchRequest struct {
+	WorkspaceID uuid.UUID
+	Query       string
+	Types       []SearchType // empty = all types
+	Limit       int          // per group
+}
+
+// GlobalSearchResponse represents ranked search results grouped by type
+// @Description Search results grouped by type. A group is omitted when it was not requested.
+// @Description order lists the returned groups from the most to the least relevant top hit.
+// @Description unavailable lists the groups whose source failed or timed out; the other groups are still returned.
+type GlobalSearchResponse struct {
+	Query       string              `json:"query" example:"release"`
+	Order       []SearchType        `json:"order" example:"boards,members"`
+	Boards      *BoardSearchGroup   `json:"boards,omitempty"`
+	Comments    *CommentSearchGroup `json:"comments,omitempty"`
+	Messages    *MessageSearchGroup `json:"messages,omitempty"`
+	Files       *FileSearchGroup    `json:"files,omitempty"`
+	Members     *MemberSearchGroup  `json:"members,omitempty"`
+	Unavailable []SearchType        `json:"unavailable,omitempty" example:"messages"`
+}
+
+// BoardSearchGroup holds board hits
+type BoardSearchGroup struct {
+	Items   []BoardSearchHit `json:"items"`
+	HasMore bool             `json:"hasMore"`
+}
+
+// BoardSearchHit represents a board matched by title or content
+type BoardSearchHit struct {
+	BoardID     uuid.UUID `json:"boardId"`
+	ProjectID   uuid.UUID `json:"projectId"`
+	ProjectName string    `json:"projectName"`
+	Title       string    `json:"title"`
+	Snippet     string    `json:"snippet,omitempty"`
+	MatchedIn   string    `json:"matchedIn,omitempty" example:"comment"` // board search only: "board" or "comment"
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Score       float64   `json:"score"`
+}
+
+// Board full-text search match locations
+const (
+	BoardMatchedInBoard   = "board"
+	BoardMatchedInComment = "comment"
+)
+
+// Board full-text search page size limits
+const (
+	DefaultBoardSearchLimit = 20
+	MaxBoardSearchLimit     = 50
+)
+
+// BoardSearchRequest represents the parameters of a full-text board search
+type BoardSearchRequest struct {
+	WorkspaceID uuid.UUID
+	Query       string
+	Limit       int // 0 = DefaultBoardSearchLimit
+	Offset      int
+}
+
+// BoardSearchResponse represents a page of boards ranked by full-text relevance
+// @Description Boards of the projects the caller is a member of whose title, content or comments match every word of q.
+// @Description matchedIn is "comment" when a comment matched better than the board itself; the snippet is then taken from that comment.
+type BoardSearchResponse struct {
+	Query   string           `json:"query" example:"release"`
+	Boards  []BoardSearchHit `json:"boards"`
+	HasMore bool             `json:"hasMore" example:"false"`
+	Limit   int              `json:"limit" example:"20"`
+	Offset  int              `json:"offset" example:"0"`
+}
+
+// CommentSearchGroup holds comment hits
+type CommentSearchGroup struct {
+	Items   []CommentSearchHit `json:"items"`
+	HasMore bool               `json:"hasMore"`
+}
+
+// CommentSearchHit represents a comment matched by content
+type CommentSearchHit struct {
+	CommentID  uuid.UUID `json:"commentId"`
+	BoardID    uuid.UUID `json:"boardId"`
+	BoardTitle string    `json:"boardTitle"`
+	ProjectID  uuid.UUID `json:"projectId"`
+	UserID     uuid.UUID `json:"userId"`
+	Snippet    string    `json:"snippet"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	Score      float64   `json:"score"`
+}
+
+// MessageSearchGroup holds chat message hits
+type MessageSearchGroup struct {
+	Items   []MessageSearchHit `json:"items"`
+	HasMore bool               `json:"hasMore"`
+}
+
+// MessageSearchHit represents a chat message matched by content
+type MessageSearchHit struct {
+	MessageID uuid.UUID `json:"messageId"`
+	ChatID    uuid.UUID `json:"chatId"`
+	ChatName  string    `json:"chatName"`
+	UserID    uuid.UUID `json:"userId"`
+	Snippet   string    `json:"snippet"`
+	CreatedAt time.Time `json:"createdAt"`
+	Score     float64   `json:"score"`
+}
+
+// FileSearchGroup holds file hits
+type FileSearchGroup struct {
+	Items   []FileSearchHit `json:"items"`
+	HasMore bool            `json:"hasMore"`
+}
+
+// FileSearchHit represents a storage file matched by name or tag
+type FileSearchHit struct {
+	FileID      uuid.UUID `json:"fileId"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"contentType"`
+	FileSize    int64     `json:"fileSize"`
+	FileURL     string    `json:"fileUrl"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Score       float64   `json:"score"`
+}
+
+// MemberSearchGroup holds workspace member hits
+type MemberSearchGroup struct {
+	Items   []MemberSearchHit `json:"items"`
+	HasMore bool              `json:"hasMore"`
+}
+
+// MemberSearchHit represents a workspace member matched by nickname or email
+type MemberSearchHit struct {
+	UserID          uuid.UUID `json:"userId"`
+	NickName        string    `json:"nickName"`
+	Email           string    `json:"email"`
+	ProfileImageURL string    `json:"profileImageUrl,omitempty"`
+	RoleName        string    `json:"roleName"`
+	Score           float64   `json:"score"`
+}
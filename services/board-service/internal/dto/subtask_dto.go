@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateSubtaskRequest represents the request to add a subtask to a board
+// @Description The subtask is appended to the end of the board's checklist
+type CreateSubtaskRequest struct {
+	Title      string     `json:"title" binding:"required,min=1,max=255" example:"Write API docs"`
+	AssigneeID *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+}
+
+// UpdateSubtaskRequest represents the request to update a subtask
+// @Description All fields are optional. assigneeId 00000000-0000-0000-0000-000000000000 removes the assignee.
+// @Description prevSubtaskId/nextSubtaskId reorder the subtask between two subtasks of the same board.
+type UpdateSubtaskRequest struct {
+	Title         *string    `json:"title,omitempty" binding:"omitempty,min=1,max=255" example:"Write API docs"`
+	Done          *bool      `json:"done,omitempty" example:"true"`
+	AssigneeID    *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	PrevSubtaskID *uuid.UUID `json:"prevSubtaskId,omitempty"`
+	NextSubtaskID *uuid.UUID `json:"nextSubtaskId,omitempty"`
+}
+
+// SubtaskResponse represents the subtask response
+type SubtaskResponse struct {
+	SubtaskID  uuid.UUID  `json:"subtaskId"`
+	BoardID    uuid.UUID  `json:"boardId"`
+	Title      string     `json:"title"`
+	Done       bool       `json:"done"`
+	AssigneeID *uuid.UUID `json:"assigneeId"`
+	Position   string     `json:"position" example:"a0i"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// SubtaskProgress represents the checklist completion of a board
+type SubtaskProgress struct {
+	Total   int `json:"total" example:"4"`
+	Done    int `json:"done" example:"3"`
+	Percent int `json:"percent" example:"75"` // 0-100, 내림 (subtask가 없으면 0)
+}
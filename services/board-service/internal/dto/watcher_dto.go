@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/google/uuid"
+
+// BoardWatchResponse represents whether the caller watches a board
+// @Description Watchers receive board update notifications without being assigned to the board.
+type BoardWatchResponse struct {
+	BoardID  uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	UserID   uuid.UUID `json:"userId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Watching bool      `json:"watching" example:"true"`
+}
@@ -0,0 +1,83 @@
+// Package grpcserver implements board-service's internal gRPC API (see internalrpc.BoardServiceServer).
+// REST 핸들러와 같은 저장소를 사용합니다.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// BoardServer implements internalrpc.BoardServiceServer
+type BoardServer struct {
+	boardRepo repository.BoardRepository
+}
+
+// NewBoardServer creates a new BoardServer
+func NewBoardServer(boardRepo repository.BoardRepository) *BoardServer {
+	return &BoardServer{boardRepo: boardRepo}
+}
+
+// BatchGetBoards returns board summaries for up to MaxBatchGetBoards IDs
+// 없거나 삭제된 보드의 ID는 MissingIDs로 반환합니다.
+func (s *BoardServer) BatchGetBoards(ctx context.Context, req *internalrpc.BatchGetBoardsRequest) (*internalrpc.BatchGetBoardsResponse, error) {
+	if len(req.BoardIDs) > internalrpc.MaxBatchGetBoards {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d board IDs per request", internalrpc.MaxBatchGetBoards))
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.BoardIDs))
+	seen := make(map[uuid.UUID]bool, len(req.BoardIDs))
+	for _, raw := range req.BoardIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid board ID: %s", raw))
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	boards, err := s.boardRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, internalrpc.Error(err)
+	}
+
+	resp := &internalrpc.BatchGetBoardsResponse{Boards: make([]*internalrpc.Board, 0, len(boards))}
+	found := make(map[uuid.UUID]bool, len(boards))
+	for _, board := range boards {
+		found[board.ID] = true
+		resp.Boards = append(resp.Boards, toBoard(board))
+	}
+	for _, id := range ids {
+		if !found[id] {
+			resp.MissingIDs = append(resp.MissingIDs, id.String())
+		}
+	}
+	return resp, nil
+}
+
+func toBoard(board *domain.Board) *internalrpc.Board {
+	createdAt, updatedAt := board.CreatedAt, board.UpdatedAt
+	result := &internalrpc.Board{
+		ID:        board.ID.String(),
+		ProjectID: board.ProjectID.String(),
+		AuthorID:  board.AuthorID.String(),
+		Title:     board.Title,
+		StartDate: board.StartDate,
+		DueDate:   board.DueDate,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+	if board.AssigneeID != nil {
+		result.AssigneeID = board.AssigneeID.String()
+	}
+	return result
+}
@@ -22,6 +22,15 @@ func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repo
 	}
 }
 
+// thumbnailURL returns the public URL of a thumbnail key, or nil when the thumbnail has not been generated
+func (h *AttachmentHandler) thumbnailURL(key *string) *string {
+	if key == nil || *key == "" {
+		return nil
+	}
+	url := h.s3Client.GetFileURL(*key)
+	return &url
+}
+
 // MaxFileSize defines the maximum allowed file size for uploads (50MB).
 const MaxFileSize = 50 * 1024 * 1024
 
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// ConfirmUpload godoc
+// @Summary      Confirm presigned upload
+// @Description  Verifies that the file for a presigned URL was uploaded to S3 with the declared size and content type
+// @Description  Call after the PUT to the presigned URL succeeds and before linking the attachment to a board, comment or project
+// @Description  The attachment stays TEMP until the entity is saved (TEMP → CONFIRMED); unlinked TEMP attachments are removed by the cleanup job when they expire
+// @Description  Confirming an attachment that is already CONFIRMED returns it unchanged
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      200 {object} response.SuccessResponse{data=AttachmentResponse} "Upload verified"
+// @Failure      400 {object} response.ErrorResponse "Invalid attachment ID or uploaded object does not match the declared metadata"
+// @Failure      401 {object} response.ErrorResponse "Unauthorized - user not authenticated"
+// @Failure      403 {object} response.ErrorResponse "Forbidden - only the uploader can confirm the upload"
+// @Failure      404 {object} response.ErrorResponse "Attachment not found"
+// @Failure      409 {object} response.ErrorResponse "Uploaded object not found in S3"
+// @Failure      500 {object} response.ErrorResponse "Failed to verify uploaded object"
+// @Router       /attachments/{attachmentId}/confirm [post]
+func (h *AttachmentHandler) ConfirmUpload(c *gin.Context) {
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid attachment ID")
+		return
+	}
+
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	attachment, err := h.attachmentRepo.FindByID(c.Request.Context(), attachmentID)
+	if err != nil {
+		response.SendError(c, http.StatusNotFound, response.ErrCodeNotFound, "Attachment not found")
+		return
+	}
+
+	// 업로드한 사용자만 확정 가능
+	if attachment.UploadedBy != userID {
+		response.SendError(c, http.StatusForbidden, response.ErrCodeForbidden, "You do not have permission to confirm this upload")
+		return
+	}
+
+	// 이미 Board/댓글/프로젝트에 연결된 첨부파일은 검증을 마친 것으로 봄
+	if attachment.Status == domain.AttachmentStatusTemp {
+		if err := h.verifyUploadedObject(c, attachment); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	response.SendSuccess(c, http.StatusOK, AttachmentResponse{
+		ID:                 attachment.ID,
+		EntityType:         string(attachment.EntityType),
+		EntityID:           attachment.EntityID,
+		Status:             string(attachment.Status),
+		FileName:           attachment.FileName,
+		FileURL:            h.s3Client.GetFileURL(attachment.FileURL),
+		FileSize:           attachment.FileSize,
+		ContentType:        attachment.ContentType,
+		UploadedBy:         attachment.UploadedBy,
+		UploadedAt:         attachment.CreatedAt,
+		ExpiresAt:          attachment.ExpiresAt,
+		ThumbnailURL:       h.thumbnailURL(attachment.ThumbnailKey),
+		MediumThumbnailURL: h.thumbnailURL(attachment.MediumThumbnailKey),
+	})
+}
+
+// verifyUploadedObject checks the S3 object behind a TEMP attachment against the metadata declared for the presigned URL.
+// 클라이언트가 보낸 값을 신뢰하지 않고 S3의 실제 객체 메타데이터로 검증합니다.
+func (h *AttachmentHandler) verifyUploadedObject(c *gin.Context, attachment *domain.Attachment) error {
+	info, err := h.s3Client.HeadObject(c.Request.Context(), attachment.FileURL)
+	if err != nil {
+		if errors.Is(err, client.ErrObjectNotFound) {
+			return response.NewAppError(response.ErrCodeConflict, "Uploaded file not found, upload it with the presigned URL first", attachment.FileURL)
+		}
+		return response.NewInternalError("Failed to verify uploaded object", err.Error())
+	}
+
+	if info.Size != attachment.FileSize {
+		return response.NewValidationError("Uploaded file size does not match the declared size",
+			fmt.Sprintf("declared=%d actual=%d", attachment.FileSize, info.Size))
+	}
+	if info.ContentType != "" && !sameMediaType(info.ContentType, attachment.ContentType) {
+		return response.NewAppError(response.ErrCodeInvalidFileType, "Uploaded file type does not match the declared content type",
+			fmt.Sprintf("declared=%s actual=%s", attachment.ContentType, info.ContentType))
+	}
+	return nil
+}
+
+// sameMediaType compares content types ignoring case and parameters such as charset
+func sameMediaType(a, b string) bool {
+	mediaType := func(ct string) string {
+		if parsed, _, err := mime.ParseMediaType(ct); err == nil {
+			return parsed
+		}
+		return strings.ToLower(strings.TrimSpace(ct))
+	}
+	return mediaType(a) == mediaType(b)
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+)
+
+// setupConfirmUploadRouter creates a test router for upload confirmation
+func setupConfirmUploadRouter(mockRepo *mockAttachmentRepository, s3Client client.S3ClientInterface, userID uuid.UUID) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewAttachmentHandler(s3Client, mockRepo)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	})
+	router.POST("/attachments/:attachmentId/confirm", handler.ConfirmUpload)
+
+	return router
+}
+
+// TestConfirmUpload tests verification of presigned uploads against the S3 object metadata
+func TestConfirmUpload(t *testing.T) {
+	attachmentID := uuid.New()
+	uploaderID := uuid.New()
+	fileKey := "board/boards/workspace123/2024/01/test_1234567890.pdf"
+
+	newAttachment := func(status domain.AttachmentStatus) *domain.Attachment {
+		return &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: attachmentID},
+			EntityType:  domain.EntityTypeBoard,
+			Status:      status,
+			FileName:    "spec.pdf",
+			FileURL:     fileKey,
+			FileSize:    2048,
+			ContentType: "application/pdf",
+			UploadedBy:  uploaderID,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		status       domain.AttachmentStatus
+		userID       uuid.UUID
+		headObject   func(ctx context.Context, key string) (*client.ObjectInfo, error)
+		expectedCode int
+	}{
+		{
+			name:   "업로드된 객체가 선언한 메타데이터와 일치",
+			status: domain.AttachmentStatusTemp,
+			userID: uploaderID,
+			headObject: func(ctx context.Context, key string) (*client.ObjectInfo, error) {
+				return &client.ObjectInfo{Size: 2048, ContentType: "application/pdf"}, nil
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "아직 업로드되지 않음",
+			status:       domain.AttachmentStatusTemp,
+			userID:       uploaderID,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:   "크기가 다름",
+			status: domain.AttachmentStatusTemp,
+			userID: uploaderID,
+			headObject: func(ctx context.Context, key string) (*client.ObjectInfo, error) {
+				return &client.ObjectInfo{Size: 4096, ContentType: "application/pdf"}, nil
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Content-Type이 다름",
+			status: domain.AttachmentStatusTemp,
+			userID: uploaderID,
+			headObject: func(ctx context.Context, key string) (*client.ObjectInfo, error) {
+				return &client.ObjectInfo{Size: 2048, ContentType: "text/html"}, nil
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "S3 조회 실패",
+			status: domain.AttachmentStatusTemp,
+			userID: uploaderID,
+			headObject: func(ctx context.Context, key string) (*client.ObjectInfo, error) {
+				return nil, fmt.Errorf("connection refused")
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "업로드한 사용자가 아님",
+			status:       domain.AttachmentStatusTemp,
+			userID:       uuid.New(),
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "이미 연결된 첨부파일은 다시 검증하지 않음",
+			status:       domain.AttachmentStatusConfirmed,
+			userID:       uploaderID,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockAttachmentRepository{
+				findByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+					if id == attachmentID {
+						return newAttachment(tt.status), nil
+					}
+					return nil, fmt.Errorf("attachment not found")
+				},
+			}
+			s3Client := client.NewMockS3Client()
+			s3Client.HeadObjectFunc = tt.headObject
+
+			router := setupConfirmUploadRouter(mockRepo, s3Client, tt.userID)
+
+			req := httptest.NewRequest(http.MethodPost, "/attachments/"+attachmentID.String()+"/confirm", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+		})
+	}
+}
+
+// TestConfirmUpload_NotFound tests confirmation of a non-existent attachment
+func TestConfirmUpload_NotFound(t *testing.T) {
+	router := setupConfirmUploadRouter(&mockAttachmentRepository{}, client.NewMockS3Client(), uuid.New())
+
+	req := httptest.NewRequest(http.MethodPost, "/attachments/"+uuid.New().String()+"/confirm", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/response"
 )
@@ -69,6 +70,9 @@ type AttachmentResponse struct {
 	UploadedBy  uuid.UUID  `json:"uploadedBy"`
 	UploadedAt  time.Time  `json:"uploadedAt"`
 	ExpiresAt   *time.Time `json:"expiresAt"`
+	// 이미지 썸네일 URL (CONFIRMED 이후 비동기로 생성되며, 생성 전이거나 이미지가 아니면 생략)
+	ThumbnailURL       *string `json:"thumbnailUrl,omitempty"`
+	MediumThumbnailURL *string `json:"mediumThumbnailUrl,omitempty"`
 }
 
 // SaveAttachmentMetadata godoc
@@ -88,8 +92,7 @@ type AttachmentResponse struct {
 // @Router       /attachments [post]
 func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 	var req SaveAttachmentMetadataRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -129,13 +132,13 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		return
 	}
 	if req.FileSize > MaxFileSize {
-		response.SendError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "File size exceeds 50MB limit")
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeFileTooLarge, "File size exceeds 50MB limit")
 		return
 	}
 
 	// Validate file type
 	if err := validateFileType(req.FileName, req.ContentType); err != nil {
-		response.SendError(c, http.StatusBadRequest, "INVALID_FILE_TYPE", err.Error())
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeInvalidFileType, err.Error())
 		return
 	}
 
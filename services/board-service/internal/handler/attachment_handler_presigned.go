@@ -8,14 +8,14 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/response"
 )
 
 func (h *AttachmentHandler) GeneratePresignedURL(c *gin.Context) {
 	var req PresignedURLRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -48,7 +48,7 @@ func (h *AttachmentHandler) GeneratePresignedURL(c *gin.Context) {
 		return
 	}
 	if req.FileSize > MaxFileSize {
-		response.SendError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "File size exceeds 50MB limit")
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeFileTooLarge, "File size exceeds 50MB limit")
 		return
 	}
 
@@ -61,7 +61,7 @@ func (h *AttachmentHandler) GeneratePresignedURL(c *gin.Context) {
 
 	// Validate file type and extension
 	if err := validateFileType(req.FileName, req.ContentType); err != nil {
-		response.SendError(c, http.StatusBadRequest, "INVALID_FILE_TYPE", err.Error())
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeInvalidFileType, err.Error())
 		return
 	}
 
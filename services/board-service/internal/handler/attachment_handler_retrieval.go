@@ -31,17 +31,19 @@ func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:                 attachment.ID,
+			EntityType:         string(attachment.EntityType),
+			EntityID:           attachment.EntityID,
+			Status:             string(attachment.Status),
+			FileName:           attachment.FileName,
+			FileURL:            fileURL, // Return full URL to client
+			FileSize:           attachment.FileSize,
+			ContentType:        attachment.ContentType,
+			UploadedBy:         attachment.UploadedBy,
+			UploadedAt:         attachment.CreatedAt,
+			ExpiresAt:          attachment.ExpiresAt,
+			ThumbnailURL:       h.thumbnailURL(attachment.ThumbnailKey),
+			MediumThumbnailURL: h.thumbnailURL(attachment.MediumThumbnailKey),
 		}
 	}
 
@@ -81,17 +83,19 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:                 attachment.ID,
+			EntityType:         string(attachment.EntityType),
+			EntityID:           attachment.EntityID,
+			Status:             string(attachment.Status),
+			FileName:           attachment.FileName,
+			FileURL:            fileURL, // Return full URL to client
+			FileSize:           attachment.FileSize,
+			ContentType:        attachment.ContentType,
+			UploadedBy:         attachment.UploadedBy,
+			UploadedAt:         attachment.CreatedAt,
+			ExpiresAt:          attachment.ExpiresAt,
+			ThumbnailURL:       h.thumbnailURL(attachment.ThumbnailKey),
+			MediumThumbnailURL: h.thumbnailURL(attachment.MediumThumbnailKey),
 		}
 	}
 
@@ -131,17 +135,19 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:                 attachment.ID,
+			EntityType:         string(attachment.EntityType),
+			EntityID:           attachment.EntityID,
+			Status:             string(attachment.Status),
+			FileName:           attachment.FileName,
+			FileURL:            fileURL, // Return full URL to client
+			FileSize:           attachment.FileSize,
+			ContentType:        attachment.ContentType,
+			UploadedBy:         attachment.UploadedBy,
+			UploadedAt:         attachment.CreatedAt,
+			ExpiresAt:          attachment.ExpiresAt,
+			ThumbnailURL:       h.thumbnailURL(attachment.ThumbnailKey),
+			MediumThumbnailURL: h.thumbnailURL(attachment.MediumThumbnailKey),
 		}
 	}
 
@@ -219,6 +225,11 @@ func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
 			c.Error(err)
 		}
 	}
+	for _, key := range attachment.ThumbnailKeys() {
+		if err := h.s3Client.DeleteFile(c.Request.Context(), key); err != nil {
+			c.Error(err)
+		}
+	}
 
 	// Delete attachment record from database (soft delete)
 	if err := h.attachmentRepo.Delete(c.Request.Context(), attachmentID); err != nil {
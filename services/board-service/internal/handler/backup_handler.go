@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+// BackupHandler serves the internal workspace export/import endpoints used by user-service
+type BackupHandler struct {
+	backupService service.BackupService
+}
+
+func NewBackupHandler(backupService service.BackupService) *BackupHandler {
+	return &BackupHandler{
+		backupService: backupService,
+	}
+}
+
+// ExportWorkspace godoc
+// @Summary      Workspace 데이터 내보내기 (내부)
+// @Description  Workspace의 Project, 멤버, 필드 옵션, Board, 참여자, Comment를 백업 섹션으로 반환합니다
+// @Description  user-service의 Workspace 백업 작업이 호출하며 x-internal-api-key 헤더가 필요합니다
+// @Tags         internal
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.WorkspaceBackup} "내보내기 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} response.ErrorResponse "내부 API 키 오류"
+// @Router       /internal/workspaces/{workspaceId}/backup [get]
+func (h *BackupHandler) ExportWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	backup, err := h.backupService.Export(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, backup)
+}
+
+// ImportWorkspace godoc
+// @Summary      Workspace 데이터 가져오기 (내부)
+// @Description  내보낸 백업 섹션을 복원합니다. 이미 있는 행(같은 ID)은 건너뛰므로 다시 실행해도 안전합니다
+// @Description  user-service의 Workspace 복원 작업이 호출하며 x-internal-api-key 헤더가 필요합니다
+// @Tags         internal
+// @Accept       json
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Param        request body dto.WorkspaceBackup true "백업 섹션"
+// @Success      200 {object} response.SuccessResponse{data=dto.BackupImportResult} "가져오기 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 백업 섹션"
+// @Failure      401 {object} response.ErrorResponse "내부 API 키 오류"
+// @Router       /internal/workspaces/{workspaceId}/backup [post]
+func (h *BackupHandler) ImportWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	var backup dto.WorkspaceBackup
+	if err := validation.BindJSON(c, &backup); err != nil {
+		return
+	}
+
+	result, err := h.backupService.Import(c.Request.Context(), workspaceID, &backup)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
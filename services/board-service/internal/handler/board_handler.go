@@ -5,15 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/client"
-	"project-board-api/internal/database"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -23,12 +24,14 @@ import (
 type BoardHandler struct {
 	boardService service.BoardService
 	notiClient   client.NotiClient
+	workers      *lifecycle.Workers // 응답 후 전송하는 알림 고루틴 (종료 시 대기)
 }
 
-func NewBoardHandler(boardService service.BoardService, notiClient client.NotiClient) *BoardHandler {
+func NewBoardHandler(boardService service.BoardService, notiClient client.NotiClient, workers *lifecycle.Workers) *BoardHandler {
 	return &BoardHandler{
 		boardService: boardService,
 		notiClient:   notiClient,
+		workers:      workers,
 	}
 }
 
@@ -60,9 +63,8 @@ func (h *BoardHandler) CreateBoard(c *gin.Context) {
 	log.Debug("CreateBoard started")
 
 	var req dto.CreateBoardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		log.Warn("CreateBoard validation failed", zap.Error(err))
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
 		return
 	}
 
@@ -88,17 +90,73 @@ func (h *BoardHandler) CreateBoard(c *gin.Context) {
 
 	// 💡 [수정] 응답을 먼저 보낸 후 브로드캐스트
 	response.SendSuccess(c, http.StatusCreated, board)
+	h.publishBoardCreated(ctx, log, board)
+}
 
-	// 💡 [추가] 브로드캐스트
+// CreateBoardFromTemplate godoc
+// @Summary      템플릿으로 Board 생성
+// @Description  Board 템플릿의 제목, 내용, customFields, 참여자로 새 Board를 생성합니다
+// @Description  요청의 title이 비어 있으면 템플릿 제목을 사용하고, customFields는 템플릿 값 위에 덮어씁니다
+// @Description  participants는 템플릿 참여자에 추가되며 중복된 ID는 제거됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        templateId path string true "Board Template ID (UUID)"
+// @Param        request body dto.CreateBoardFromTemplateRequest true "템플릿 기반 Board 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
+// @Failure      404 {object} response.ErrorResponse "템플릿을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/from-template/{templateId} [post]
+func (h *BoardHandler) CreateBoardFromTemplate(c *gin.Context) {
+	log := getLogger(c)
+
+	templateID, err := uuid.Parse(c.Param("templateId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid template ID")
+		return
+	}
+
+	var req dto.CreateBoardFromTemplateRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if userID, exists := c.Get("user_id"); exists {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+
+	board, err := h.boardService.CreateBoardFromTemplate(ctx, templateID, &req)
+	if err != nil {
+		log.Error("CreateBoardFromTemplate service error", zap.Error(err))
+		handleServiceError(c, err)
+		return
+	}
+
+	log.Info("Board created from template",
+		zap.String("board.id", board.ID.String()),
+		zap.String("template.id", templateID.String()))
+
+	response.SendSuccess(c, http.StatusCreated, board)
+	h.publishBoardCreated(ctx, log, board)
+}
+
+// publishBoardCreated broadcasts BOARD_CREATED to the project and notifies the assignee
+// (응답을 보낸 뒤 호출)
+func (h *BoardHandler) publishBoardCreated(ctx context.Context, log *zap.Logger, board *dto.BoardResponse) {
 	event := WSEvent{
 		Type:    "BOARD_CREATED",
 		BoardID: board.ID.String(),
 		Payload: board,
+		Version: board.Version,
 	}
-	BroadcastEvent(req.ProjectID.String(), event)
+	BroadcastEvent(board.ProjectID.String(), event)
 
 	// 🔥 알림 전송: 보드 생성 시 담당자가 지정된 경우
-	go h.sendBoardNotifications(ctx, log, nil, board, nil, board.AssigneeID)
+	h.workers.Go(func() {
+		h.sendBoardNotifications(ctx, log, nil, board, nil, board.AssigneeID)
+	})
 }
 
 // GetBoard godoc
@@ -143,7 +201,8 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 
 // GetBoardsByProject godoc
 // @Summary      Project의 Board 목록 조회
-// @Description  특정 Project에 속한 모든 Board를 조회합니다. customFields 파라미터로 필터링 가능 (JSON 형식)
+// @Description  특정 Project에 속한 Board를 생성 순으로 페이지 단위 조회합니다. customFields 파라미터로 필터링 가능 (JSON 형식)
+// @Description  hasMore가 true이면 nextCursor를 cursor 파라미터로 전달해 다음 페이지를 조회합니다
 // @Description  응답의 customFields는 value 기반 (UUID가 아닌 문자열 값)
 // @Description  예시: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description  각 보드는 participantIds (참여자 ID 배열)와 attachments (첨부파일 메타데이터 배열)를 포함합니다
@@ -152,8 +211,11 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 // @Produce      json
 // @Param        projectId    path      string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
-// @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
-// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
+// @Param        limit        query     int     false  "페이지 크기 (기본 50, 최대 200)"
+// @Param        cursor       query     string  false  "이전 페이지 응답의 nextCursor"
+// @Param        state        query     string  false  "보관 상태 필터: ACTIVE(기본), ARCHIVED, ALL"
+// @Success      200 {object} response.SuccessResponse{data=dto.PaginatedBoardsResponse} "Board 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID, 필터 또는 커서"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/project/{projectId} [get]
@@ -170,20 +232,14 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 
 	log.Debug("GetBoardsByProject started", zap.String("project.id", projectID.String()))
 
-	filters := &dto.BoardFilters{}
-	customFieldsStr := c.Query("customFields")
-
-	if customFieldsStr != "" {
-		var customFields map[string]interface{}
-		if err := json.Unmarshal([]byte(customFieldsStr), &customFields); err != nil {
-			log.Warn("GetBoardsByProject invalid customFields", zap.String("customFields", customFieldsStr))
-			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid customFields format: must be valid JSON")
-			return
-		}
-		filters.CustomFields = customFields
+	filters, err := parseBoardListQuery(c)
+	if err != nil {
+		log.Warn("GetBoardsByProject invalid query", zap.Error(err))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
 	}
 
-	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
+	page, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
 	if err != nil {
 		log.Error("GetBoardsByProject service error", zap.String("project.id", projectID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -192,13 +248,15 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 
 	log.Debug("GetBoardsByProject completed",
 		zap.String("project.id", projectID.String()),
-		zap.Int("board.count", len(boards)))
-	response.SendSuccess(c, http.StatusOK, boards)
+		zap.Int("board.count", len(page.Boards)),
+		zap.Bool("has_more", page.HasMore))
+	response.SendSuccess(c, http.StatusOK, page)
 }
 
 // GetBoardsByProjectQuery godoc
 // @Summary      Project의 Board 목록 조회 (쿼리 파라미터 방식)
-// @Description  특정 Project에 속한 모든 Board를 조회합니다. 프론트엔드 호환용 엔드포인트
+// @Description  특정 Project에 속한 Board를 생성 순으로 페이지 단위 조회합니다. 프론트엔드 호환용 엔드포인트
+// @Description  hasMore가 true이면 nextCursor를 cursor 파라미터로 전달해 다음 페이지를 조회합니다
 // @Description  응답의 customFields는 value 기반 (UUID가 아닌 문자열 값)
 // @Description  예시: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description  각 보드는 participantIds (참여자 ID 배열)와 attachments (첨부파일 메타데이터 배열)를 포함합니다
@@ -207,8 +265,11 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 // @Produce      json
 // @Param        projectId    query     string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
-// @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
-// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
+// @Param        limit        query     int     false  "페이지 크기 (기본 50, 최대 200)"
+// @Param        cursor       query     string  false  "이전 페이지 응답의 nextCursor"
+// @Param        state        query     string  false  "보관 상태 필터: ACTIVE(기본), ARCHIVED, ALL"
+// @Success      200 {object} response.SuccessResponse{data=dto.PaginatedBoardsResponse} "Board 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID, 필터 또는 커서"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards [get]
@@ -231,20 +292,14 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 
 	log.Debug("GetBoardsByProjectQuery started", zap.String("project.id", projectID.String()))
 
-	filters := &dto.BoardFilters{}
-	customFieldsStr := c.Query("customFields")
-
-	if customFieldsStr != "" {
-		var customFields map[string]interface{}
-		if err := json.Unmarshal([]byte(customFieldsStr), &customFields); err != nil {
-			log.Warn("GetBoardsByProjectQuery invalid customFields", zap.String("customFields", customFieldsStr))
-			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid customFields format: must be valid JSON")
-			return
-		}
-		filters.CustomFields = customFields
+	filters, err := parseBoardListQuery(c)
+	if err != nil {
+		log.Warn("GetBoardsByProjectQuery invalid query", zap.Error(err))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
 	}
 
-	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
+	page, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
 	if err != nil {
 		log.Error("GetBoardsByProjectQuery service error", zap.String("project.id", projectID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -253,8 +308,33 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 
 	log.Debug("GetBoardsByProjectQuery completed",
 		zap.String("project.id", projectID.String()),
-		zap.Int("board.count", len(boards)))
-	response.SendSuccess(c, http.StatusOK, boards)
+		zap.Int("board.count", len(page.Boards)),
+		zap.Bool("has_more", page.HasMore))
+	response.SendSuccess(c, http.StatusOK, page)
+}
+
+// parseBoardListQuery parses the customFields, limit, cursor and state query parameters of the board list endpoints.
+// 반환되는 에러 메시지는 그대로 클라이언트에 응답됩니다.
+func parseBoardListQuery(c *gin.Context) (*dto.BoardFilters, error) {
+	filters := &dto.BoardFilters{Cursor: c.Query("cursor"), State: c.Query("state")}
+
+	if customFieldsStr := c.Query("customFields"); customFieldsStr != "" {
+		var customFields map[string]interface{}
+		if err := json.Unmarshal([]byte(customFieldsStr), &customFields); err != nil {
+			return nil, fmt.Errorf("Invalid customFields format: must be valid JSON")
+		}
+		filters.CustomFields = customFields
+	}
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > dto.MaxBoardPageLimit {
+			return nil, fmt.Errorf("Invalid limit: must be between 1 and %d", dto.MaxBoardPageLimit)
+		}
+		filters.Limit = limit
+	}
+
+	return filters, nil
 }
 
 // UpdateBoard godoc
@@ -265,14 +345,17 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 // @Description  예시 값: stage="completed", role="designer", importance="medium"
 // @Description  잘못된 field value 제공 시 400 에러 반환
 // @Description  startDate와 dueDate를 수정할 수 있으며, startDate는 dueDate보다 이전이어야 합니다
+// @Description  마지막으로 조회한 version을 If-Match 헤더나 body의 version으로 보내야 하며, 그 사이 다른 사용자가 수정했으면 409와 함께 error.current에 최신 Board를 반환합니다
 // @Tags         boards
 // @Accept       json
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
+// @Param        If-Match header string false "조회한 Board의 version (예: \"3\"). 없으면 body의 version 필수"
 // @Param        request body dto.UpdateBoardRequest true "Board 수정 요청"
 // @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 수정 성공"
-// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청, version 누락 또는 유효하지 않은 field value"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "다른 사용자가 먼저 수정함 (error.current에 최신 Board)"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId} [put]
 func (h *BoardHandler) UpdateBoard(c *gin.Context) {
@@ -287,9 +370,13 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 	}
 
 	var req dto.UpdateBoardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		log.Warn("UpdateBoard validation failed", zap.String("board.id", boardID.String()), zap.Error(err))
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+	if err := applyIfMatch(c, &req); err != nil {
+		log.Warn("UpdateBoard invalid If-Match", zap.String("board.id", boardID.String()), zap.Error(err))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
 		return
 	}
 
@@ -312,6 +399,7 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 	log.Info("Board updated", zap.String("board.id", boardID.String()))
 
 	// 💡 [수정] 응답을 먼저 보낸 후 브로드캐스트
+	c.Header("ETag", boardETag(board.Version))
 	response.SendSuccess(c, http.StatusOK, board)
 
 	// 💡 [추가] 브로드캐스트
@@ -319,6 +407,7 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 		Type:    "BOARD_UPDATED",
 		BoardID: boardID.String(),
 		Payload: board,
+		Version: board.Version,
 	}
 	BroadcastEvent(board.ProjectID.String(), event)
 
@@ -327,7 +416,10 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 	if oldBoard != nil {
 		oldBoardResponse = &oldBoard.BoardResponse
 	}
-	go h.sendBoardNotifications(c.Request.Context(), log, oldBoardResponse, board, oldAssigneeID, req.AssigneeID)
+	reqCtx := c.Request.Context()
+	h.workers.Go(func() {
+		h.sendBoardNotifications(reqCtx, log, oldBoardResponse, board, oldAssigneeID, req.AssigneeID)
+	})
 }
 
 // sendBoardNotifications sends notifications for board updates
@@ -411,12 +503,14 @@ func (h *BoardHandler) sendBoardNotifications(ctx context.Context, log *zap.Logg
 
 // DeleteBoard godoc
 // @Summary      Board 삭제
-// @Description  Board를 소프트 삭제합니다
+// @Description  Board를 소프트 삭제합니다 (can_delete_board 권한 필요, 기본 모든 멤버)
+// @Description  삭제된 Board는 휴지통(GET /projects/{projectId}/trash)에서 보관 기간 동안 복원할 수 있습니다
 // @Tags         boards
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
 // @Success      200 {object} response.SuccessResponse "Board 삭제 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "can_delete_board 권한 없음"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId} [delete]
@@ -441,7 +535,11 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 		return
 	}
 
-	err = h.boardService.DeleteBoard(c.Request.Context(), boardID)
+	// 삭제 권한 확인과 활동 기록을 위해 user_id를 context에 전달
+	userID, _ := c.Get("user_id")
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
+	err = h.boardService.DeleteBoard(ctx, boardID)
 	if err != nil {
 		log.Error("DeleteBoard service error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -468,8 +566,9 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 
 // MoveBoard godoc
 // @Summary      Board 이동 (실시간 동기화)
-// @Description  Board를 다른 컬럼으로 이동합니다. WebSocket을 통해 실시간으로 다른 클라이언트에게 전파됩니다
-// @Description  groupByFieldName에 해당하는 필드의 값을 newFieldValue로 변경합니다
+// @Description  Board를 다른 컬럼으로 이동하거나 같은 컬럼 안에서 순서를 바꿉니다. WebSocket을 통해 실시간으로 다른 클라이언트에게 전파됩니다
+// @Description  groupByFieldName에 해당하는 필드의 값을 newFieldValue로 변경하고, prevBoardId와 nextBoardId 사이에 배치합니다
+// @Description  카드 순서는 DB(position)에 저장되며, 이웃 카드의 순서가 이미 바뀌었으면 409를 반환합니다
 // @Tags         boards
 // @Accept       json
 // @Produce      json
@@ -478,6 +577,7 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 // @Success      200 {object} response.SuccessResponse{data=dto.MoveBoardResponse} "Board 이동 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "이웃 카드의 순서가 변경됨"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId}/move [put]
 func (h *BoardHandler) MoveBoard(c *gin.Context) {
@@ -489,8 +589,7 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 	}
 
 	var req dto.MoveBoardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -498,93 +597,252 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 	userID, _ := c.Get("user_id")
 	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
 
-	// 🔥 [수정] req.ProjectID를 UUID로 파싱
-	projectID, err := uuid.Parse(req.ProjectID)
+	result, err := h.boardService.MoveBoard(ctx, boardID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	// 실시간 브로드캐스트
+	event := WSEvent{
+		Type:    "BOARD_MOVED",
+		BoardID: boardID.String(),
+		Payload: map[string]string{
+			"from":     result.OldFieldValue,
+			"to":       result.NewFieldValue,
+			"position": result.Position,
+		},
+		Version: result.Version,
+	}
+
+	log := getLogger(c)
+	log.Info("Broadcasting BOARD_MOVED event",
+		zap.String("projectId", req.ProjectID),
+		zap.String("boardId", boardID.String()),
+		zap.String("from", result.OldFieldValue),
+		zap.String("to", result.NewFieldValue))
+
+	BroadcastEvent(req.ProjectID, event)
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// GetColumnOrder godoc
+// @Summary      칸반 컬럼 카드 순서 조회
+// @Description  groupByFieldName 필드 값이 value인 컬럼의 Board ID를 카드 순서대로 반환합니다
+// @Description  Redis 캐시를 사용하며, 캐시가 없으면 DB에 저장된 순서로 다시 채웁니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        groupByFieldName query string true "그룹 기준 필드 (예: stage)"
+// @Param        value query string true "컬럼 값 (예: in_progress)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardColumnOrderResponse} "조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/order [get]
+func (h *BoardHandler) GetColumnOrder(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
 		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
 		return
 	}
+	fieldName, value := c.Query("groupByFieldName"), c.Query("value")
+	if fieldName == "" || value == "" {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "groupByFieldName and value are required")
+		return
+	}
 
-	// 🔥 [수정] newFieldValue가 nil이면 에러
-	if req.NewFieldValue == nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "newFieldValue is required")
+	result, err := h.boardService.GetColumnOrder(c.Request.Context(), projectID, fieldName, value)
+	if err != nil {
+		handleServiceError(c, err)
 		return
 	}
+	response.SendSuccess(c, http.StatusOK, result)
+}
 
-	newFieldValue := *req.NewFieldValue // 🔥 포인터 역참조
+// GetGroupedBoards godoc
+// @Summary      칸반 컬럼별 Board 조회
+// @Description  프로젝트의 Board를 by 필드의 옵션(컬럼)별로 묶어 카드 순서대로 반환합니다
+// @Description  by는 stage, role, importance 또는 select 타입 커스텀 필드 key이며, 값이 없는 Board는 unassigned에 담깁니다
+// @Description  카드 순서는 Redis 캐시(GET /boards/project/{projectId}/order와 동일)를 사용합니다. 첨부파일은 포함하지 않습니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        by query string true "그룹 기준 필드 (예: stage)"
+// @Success      200 {object} response.SuccessResponse{data=dto.GroupedBoardsResponse} "조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 그룹으로 묶을 수 없는 필드"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/grouped [get]
+func (h *BoardHandler) GetGroupedBoards(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	fieldName := c.Query("by")
+	if fieldName == "" {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "by is required")
+		return
+	}
 
-	// 1. 기존 보드 가져오기
-	board, err := h.boardService.GetBoard(ctx, boardID)
+	result, err := h.boardService.GetGroupedBoards(c.Request.Context(), projectID, fieldName)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
+	response.SendSuccess(c, http.StatusOK, result)
+}
 
-	// 현재 그룹 값 추출
-	oldGroupValue := ""
-	if board.CustomFields != nil {
-		if val, ok := board.CustomFields[req.GroupByFieldName]; ok {
-			oldGroupValue = val.(string)
-		}
+// GetBoardActivities godoc
+// @Summary      Board 활동 기록 조회
+// @Description  Board의 변경 이력(누가, 언제, 어떤 필드를 무엇에서 무엇으로)을 최신순으로 조회합니다
+// @Description  다음 페이지는 응답의 nextCursor를 cursor 파라미터로 전달하여 조회합니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        limit query int false "페이지 크기 (기본 50, 최대 200)"
+// @Param        cursor query string false "이전 응답의 nextCursor"
+// @Success      200 {object} response.SuccessResponse{data=dto.PaginatedActivitiesResponse} "조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/activities [get]
+func (h *BoardHandler) GetBoardActivities(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
 	}
 
-	// 🔥 [핵심 수정] 기존 customFields를 유지하면서 해당 필드만 업데이트
-	existingCustomFields := make(map[string]interface{})
-	if board.CustomFields != nil {
-		// 기존 필드들을 모두 복사
-		for k, v := range board.CustomFields {
-			existingCustomFields[k] = v
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > dto.MaxActivityPageLimit {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation,
+				fmt.Sprintf("Invalid limit: must be between 1 and %d", dto.MaxActivityPageLimit))
+			return
 		}
 	}
-	// 변경할 필드만 업데이트
-	existingCustomFields[req.GroupByFieldName] = newFieldValue
 
-	// 2. 필드 값 업데이트
-	updateReq := &dto.UpdateBoardRequest{
-		CustomFields: &existingCustomFields,
-	}
-	_, err = h.boardService.UpdateBoard(ctx, boardID, updateReq)
+	page, err := h.boardService.GetBoardActivities(c.Request.Context(), boardID, c.Query("cursor"), limit)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
+	response.SendSuccess(c, http.StatusOK, page)
+}
 
-	// 3. Redis 순서 업데이트
-	redisClient := database.GetRedis()
-	if redisClient != nil {
-		oldKey := fmt.Sprintf("kanban:project:%s:group:%s", projectID.String(), oldGroupValue)
-		newKey := fmt.Sprintf("kanban:project:%s:group:%s", projectID.String(), newFieldValue)
+// SetBoardRecurrence godoc
+// @Summary      Board 반복 설정
+// @Description  cron 표현식(예: "0 9 * * 1" 매주 월요일 09시)에 따라 Board를 복제하여 새 Board를 생성하도록 설정합니다
+// @Description  CRON_TZ= 접두사로 시간대를 지정할 수 있으며, 1시간보다 짧은 간격은 허용되지 않습니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.SetBoardRecurrenceRequest true "반복 규칙"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardRecurrenceResponse} "설정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 반복 규칙"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/recurrence [put]
+func (h *BoardHandler) SetBoardRecurrence(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
 
-		redisClient.ZRem(context.Background(), oldKey, boardID.String())
-		redisClient.ZAdd(context.Background(), newKey, redis.Z{
-			Score:  float64(time.Now().UnixMilli()),
-			Member: boardID.String(),
-		})
+	var req dto.SetBoardRecurrenceRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
 	}
 
-	// 4. 실시간 브로드캐스트
-	event := WSEvent{
-		Type:    "BOARD_MOVED",
-		BoardID: boardID.String(),
-		Payload: map[string]string{
-			"from": oldGroupValue,
-			"to":   newFieldValue,
-		},
+	userID, _ := c.Get("user_id")
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
+	result, err := h.boardService.SetBoardRecurrence(ctx, boardID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
 	}
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// DeleteBoardRecurrence godoc
+// @Summary      Board 반복 해제
+// @Description  Board의 반복 설정을 제거합니다 (이미 생성된 Board는 유지됩니다)
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/recurrence [delete]
+func (h *BoardHandler) DeleteBoardRecurrence(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
+	if err := h.boardService.DeleteBoardRecurrence(ctx, boardID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+	response.SendSuccess(c, http.StatusOK, nil)
+}
 
+// BulkUpdateBoards godoc
+// @Summary      Board 일괄 작업 (실시간 동기화)
+// @Description  여러 Board에 대한 작업(move, updateCustomFields, assign, delete)을 한 트랜잭션으로 처리합니다
+// @Description  작업은 요청 순서대로 적용되며, 하나라도 실패하면 아무것도 반영되지 않습니다 (최대 100개)
+// @Description  모든 Board는 projectId 프로젝트에 속해야 하며, WebSocket으로 BOARDS_BULK_UPDATED 이벤트가 한 번만 전파됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.BulkBoardRequest true "Board 일괄 작업 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.BulkBoardResponse} "일괄 작업 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
+// @Failure      404 {object} response.ErrorResponse "Project 또는 Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/bulk [post]
+func (h *BoardHandler) BulkUpdateBoards(c *gin.Context) {
 	log := getLogger(c)
-	log.Info("Broadcasting BOARD_MOVED event",
-		zap.String("projectId", projectID.String()),
-		zap.String("boardId", boardID.String()),
-		zap.String("from", oldGroupValue),
-		zap.String("to", newFieldValue))
 
-	BroadcastEvent(projectID.String(), event)
+	var req dto.BulkBoardRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		log.Warn("BulkUpdateBoards validation failed", zap.Error(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if userID, exists := c.Get("user_id"); exists {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+
+	log.Debug("BulkUpdateBoards started",
+		zap.String("project.id", req.ProjectID.String()),
+		zap.Int("operation.count", len(req.Operations)))
+
+	result, err := h.boardService.BulkUpdateBoards(ctx, &req)
+	if err != nil {
+		log.Error("BulkUpdateBoards service error", zap.String("project.id", req.ProjectID.String()), zap.Error(err))
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
 
-	// 응답
-	response.SendSuccess(c, http.StatusOK, dto.MoveBoardResponse{
-		BoardID:       boardID.String(),
-		NewFieldValue: newFieldValue,
-		Message:       "Board moved successfully",
+	// 작업 수와 관계없이 이벤트 한 번만 브로드캐스트
+	BroadcastEvent(req.ProjectID.String(), WSEvent{
+		Type:    "BOARDS_BULK_UPDATED",
+		Payload: result,
 	})
 }
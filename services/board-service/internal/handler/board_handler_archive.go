@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// ArchiveBoard godoc
+// @Summary      Board 보관 (실시간 동기화)
+// @Description  Board를 보관 상태로 바꿉니다. 보관된 Board는 기본 목록과 칸반에서 제외되며 state=ARCHIVED 또는 ALL로 조회할 수 있습니다
+// @Description  can_delete_board 권한이 필요하며, WebSocket으로 BOARD_ARCHIVED 이벤트가 전파됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 보관 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "can_delete_board 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/archive [post]
+func (h *BoardHandler) ArchiveBoard(c *gin.Context) {
+	h.changeBoardState(c, "ArchiveBoard", "BOARD_ARCHIVED", h.boardService.ArchiveBoard)
+}
+
+// UnarchiveBoard godoc
+// @Summary      Board 보관 해제 (실시간 동기화)
+// @Description  보관된 Board를 다시 기본 목록과 칸반에 표시합니다
+// @Description  can_delete_board 권한이 필요하며, WebSocket으로 BOARD_UNARCHIVED 이벤트가 전파됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 보관 해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "can_delete_board 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/unarchive [post]
+func (h *BoardHandler) UnarchiveBoard(c *gin.Context) {
+	h.changeBoardState(c, "UnarchiveBoard", "BOARD_UNARCHIVED", h.boardService.UnarchiveBoard)
+}
+
+// changeBoardState runs an archive or trash state change and broadcasts eventType after responding
+func (h *BoardHandler) changeBoardState(c *gin.Context, op, eventType string, apply func(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error)) {
+	log := getLogger(c)
+
+	boardIDStr := c.Param("boardId")
+	boardID, err := uuid.Parse(boardIDStr)
+	if err != nil {
+		log.Warn(op+" invalid board ID", zap.String("board.id", boardIDStr))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	// 권한 확인과 활동 기록을 위해 user_id를 context에 전달
+	userID, _ := c.Get("user_id")
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
+	board, err := apply(ctx, boardID)
+	if err != nil {
+		log.Error(op+" service error", zap.String("board.id", boardID.String()), zap.Error(err))
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, board)
+
+	BroadcastEvent(board.ProjectID.String(), WSEvent{
+		Type:    eventType,
+		BoardID: boardID.String(),
+		Payload: board,
+		Version: board.Version,
+	})
+}
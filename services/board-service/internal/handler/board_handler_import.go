@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/response"
+	"project-board-api/internal/spreadsheet"
+)
+
+// MaxBoardImportFileBytes limits the size of an uploaded board import file
+const MaxBoardImportFileBytes int64 = 10 << 20
+
+// ImportBoards godoc
+// @Summary      CSV/XLSX 파일로 Board 일괄 생성
+// @Description  업로드한 파일의 첫 행(헤더)을 열 이름으로 사용해 행마다 Board를 만듭니다 (최대 1000행, 10MB)
+// @Description  열: title(필수), content, assignee(멤버 ID, 이메일 또는 닉네임), startDate, dueDate(YYYY-MM-DD), 그 외 열은 customFields key (stage, role, importance, 커스텀 필드)
+// @Description  multi_select 커스텀 필드는 쉼표로 여러 값을 구분합니다. XLSX는 첫 번째 시트만 읽습니다
+// @Description  dryRun=true이면 검증만 하고 행별 오류를 반환합니다. 아니면 오류가 없을 때만 모든 Board를 한 트랜잭션으로 생성하며, 오류가 있으면 아무것도 만들지 않습니다
+// @Tags         boards
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        file formData file true "가져올 CSV 또는 XLSX 파일"
+// @Param        dryRun query bool false "검증만 수행 (기본 false)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardImportResponse} "검증 결과 (dryRun이거나 오류가 있어 생성하지 않음)"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardImportResponse} "Board 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청, 지원하지 않는 파일 형식 또는 행 수 초과"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      413 {object} response.ErrorResponse "파일이 10MB를 넘음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/boards/import [post]
+func (h *BoardHandler) ImportBoards(c *gin.Context) {
+	log := getLogger(c)
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	dryRun := false
+	if raw := c.Query("dryRun"); raw != "" {
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "dryRun must be true or false")
+			return
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.SendError(c, http.StatusRequestEntityTooLarge, response.ErrCodeFileTooLarge, "File size exceeds 10MB limit")
+			return
+		}
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "file is required")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Failed to read the uploaded file")
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Failed to read the uploaded file")
+		return
+	}
+
+	format, err := spreadsheet.DetectFormat(fileHeader.Filename, data)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeInvalidFileType, "Only CSV and XLSX files are supported")
+		return
+	}
+	rows, err := spreadsheet.Read(data, format)
+	if err != nil {
+		log.Warn("ImportBoards failed to parse file", zap.String("format", string(format)), zap.Error(err))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	if userID, exists := c.Get("user_id"); exists {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+	// 담당자를 이메일/닉네임으로 찾을 때 워크스페이스 프로필 조회에 사용
+	ctx = context.WithValue(ctx, "jwtToken", c.GetString("jwtToken"))
+
+	result, err := h.boardService.ImportBoards(ctx, projectID, rows, dryRun)
+	if err != nil {
+		log.Error("ImportBoards service error", zap.String("project.id", projectID.String()), zap.Error(err))
+		handleServiceError(c, err)
+		return
+	}
+
+	if len(result.Boards) == 0 {
+		response.SendSuccess(c, http.StatusOK, result)
+		return
+	}
+	response.SendSuccess(c, http.StatusCreated, result)
+
+	// 가져온 Board 수와 관계없이 이벤트 한 번만 브로드캐스트
+	BroadcastEvent(projectID.String(), WSEvent{
+		Type:    "BOARDS_IMPORTED",
+		Payload: result.Boards,
+	})
+}
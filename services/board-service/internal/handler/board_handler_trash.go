@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/response"
+)
+
+// GetBoardTrash godoc
+// @Summary      프로젝트 휴지통 조회
+// @Description  삭제된 Board 중 아직 복원할 수 있는 Board를 최근 삭제 순으로 조회합니다
+// @Description  보관 기간(기본 30일)이 지난 Board는 댓글, 첨부파일과 함께 영구 삭제되며, purgeAt이 그 시각입니다
+// @Description  can_delete_board 권한이 필요합니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardTrashResponse} "조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "can_delete_board 권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/trash [get]
+func (h *BoardHandler) GetBoardTrash(c *gin.Context) {
+	log := getLogger(c)
+
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		log.Warn("GetBoardTrash invalid project ID", zap.String("project.id", projectIDStr))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
+	trash, err := h.boardService.GetBoardTrash(ctx, projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+	response.SendSuccess(c, http.StatusOK, trash)
+}
+
+// RestoreBoard godoc
+// @Summary      휴지통의 Board 복원 (실시간 동기화)
+// @Description  삭제된 Board를 보관 기간 안에 원래 상태(댓글, 첨부파일, 보관 여부 포함)로 복원합니다
+// @Description  can_delete_board 권한이 필요하며, WebSocket으로 BOARD_RESTORED 이벤트가 전파됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 복원 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "can_delete_board 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "휴지통에 없거나 보관 기간이 지난 Board"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/restore [post]
+func (h *BoardHandler) RestoreBoard(c *gin.Context) {
+	h.changeBoardState(c, "RestoreBoard", "BOARD_RESTORED", h.boardService.RestoreBoard)
+}
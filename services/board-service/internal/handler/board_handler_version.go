@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"project-board-api/internal/dto"
+)
+
+// boardETag formats a board version as a strong ETag
+func boardETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// applyIfMatch fills req.Version from the If-Match header.
+// 헤더와 body의 version이 둘 다 있으면 같아야 합니다.
+func applyIfMatch(c *gin.Context, req *dto.UpdateBoardRequest) error {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" {
+		return nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+	if err != nil {
+		return fmt.Errorf("Invalid If-Match header: must be a board version ETag")
+	}
+	if req.Version != nil && *req.Version != version {
+		return fmt.Errorf("If-Match header and version field disagree")
+	}
+	req.Version = &version
+	return nil
+}
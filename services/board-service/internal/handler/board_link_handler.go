@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type BoardLinkHandler struct {
+	linkService service.BoardLinkService
+}
+
+func NewBoardLinkHandler(linkService service.BoardLinkService) *BoardLinkHandler {
+	return &BoardLinkHandler{
+		linkService: linkService,
+	}
+}
+
+// CreateLink godoc
+// @Summary      Board 링크 생성
+// @Description  같은 프로젝트의 다른 Board와 관계(BLOCKS, BLOCKED_BY, RELATES_TO)를 연결합니다
+// @Description  의존 관계(BLOCKS/BLOCKED_BY)에 순환이 생기는 링크는 거부됩니다
+// @Tags         board-links
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.CreateBoardLinkRequest true "Board 링크 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardLinkResponse} "링크 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 순환 의존"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 연결된 Board"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/links [post]
+func (h *BoardLinkHandler) CreateLink(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateBoardLinkRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	link, err := h.linkService.CreateLink(c.Request.Context(), boardID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, link)
+}
+
+// GetLinks godoc
+// @Summary      Board 링크 목록 조회
+// @Description  Board에 연결된 링크를 이 Board 기준의 관계로 조회합니다
+// @Tags         board-links
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.BoardLinkResponse} "링크 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/links [get]
+func (h *BoardLinkHandler) GetLinks(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	links, err := h.linkService.GetLinks(c.Request.Context(), boardID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, links)
+}
+
+// DeleteLink godoc
+// @Summary      Board 링크 삭제
+// @Description  Board 링크를 삭제합니다 (링크의 양쪽 Board 어느 쪽에서든 삭제 가능)
+// @Tags         board-links
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        linkId path string true "Link ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "링크 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      404 {object} response.ErrorResponse "링크를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/links/{linkId} [delete]
+func (h *BoardLinkHandler) DeleteLink(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+	linkID, err := uuid.Parse(c.Param("linkId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid link ID")
+		return
+	}
+
+	if err := h.linkService.DeleteLink(c.Request.Context(), boardID, linkID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
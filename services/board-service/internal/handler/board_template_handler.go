@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type BoardTemplateHandler struct {
+	templateService service.BoardTemplateService
+}
+
+func NewBoardTemplateHandler(templateService service.BoardTemplateService) *BoardTemplateHandler {
+	return &BoardTemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// CreateTemplate godoc
+// @Summary      Board 템플릿 생성
+// @Description  프로젝트에 Board 템플릿을 추가합니다 (can_manage_templates 권한 필요, 기본 OWNER)
+// @Description  customFields는 보드 생성과 같은 value 기반이며 저장 전에 검증합니다
+// @Tags         board-templates
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateBoardTemplateRequest true "템플릿 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardTemplateResponse} "템플릿 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
+// @Failure      403 {object} response.ErrorResponse "can_manage_templates 권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/board-templates [post]
+func (h *BoardTemplateHandler) CreateTemplate(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateBoardTemplateRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	template, err := h.templateService.CreateTemplate(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, template)
+}
+
+// GetTemplates godoc
+// @Summary      프로젝트의 Board 템플릿 목록 조회
+// @Description  프로젝트의 모든 Board 템플릿을 이름순으로 조회합니다
+// @Tags         board-templates
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.BoardTemplateResponse} "템플릿 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/board-templates [get]
+func (h *BoardTemplateHandler) GetTemplates(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	templates, err := h.templateService.GetTemplates(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, templates)
+}
+
+// UpdateTemplate godoc
+// @Summary      Board 템플릿 수정
+// @Description  Board 템플릿을 수정합니다 (can_manage_templates 권한 필요, 기본 OWNER). 이미 생성된 Board에는 영향을 주지 않습니다
+// @Tags         board-templates
+// @Accept       json
+// @Produce      json
+// @Param        templateId path string true "Board Template ID (UUID)"
+// @Param        request body dto.UpdateBoardTemplateRequest true "템플릿 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardTemplateResponse} "템플릿 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "can_manage_templates 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "템플릿을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /board-templates/{templateId} [put]
+func (h *BoardTemplateHandler) UpdateTemplate(c *gin.Context) {
+	templateID, err := uuid.Parse(c.Param("templateId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid template ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateBoardTemplateRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	template, err := h.templateService.UpdateTemplate(c.Request.Context(), templateID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, template)
+}
+
+// DeleteTemplate godoc
+// @Summary      Board 템플릿 삭제
+// @Description  Board 템플릿을 소프트 삭제합니다 (can_manage_templates 권한 필요, 기본 OWNER)
+// @Tags         board-templates
+// @Produce      json
+// @Param        templateId path string true "Board Template ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "템플릿 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Template ID"
+// @Failure      403 {object} response.ErrorResponse "can_manage_templates 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "템플릿을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /board-templates/{templateId} [delete]
+func (h *BoardTemplateHandler) DeleteTemplate(c *gin.Context) {
+	templateID, err := uuid.Parse(c.Param("templateId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid template ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(c.Request.Context(), templateID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// requestUserID extracts the authenticated user ID, writing a 401 response when it is missing
+func requestUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return uuid.Nil, false
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return uuid.Nil, false
+	}
+	return userUUID, true
+}
@@ -1,11 +1,13 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -24,6 +26,7 @@ func NewCommentHandler(commentService service.CommentService) *CommentHandler {
 // CreateComment godoc
 // @Summary      Comment 생성
 // @Description  Board에 새로운 Comment를 작성합니다
+// @Description  본문의 @{userId} 또는 @nickname 멘션 중 프로젝트 멤버는 mentions에 저장되고 COMMENT_MENTION 알림을 받습니다
 // @Tags         comments
 // @Accept       json
 // @Produce      json
@@ -47,12 +50,13 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 	}
 
 	var req dto.CreateCommentRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
-	comment, err := h.commentService.CreateComment(c.Request.Context(), userUUID, &req)
+	// @nickname 멘션은 요청자의 토큰으로 워크스페이스 프로필을 조회해 해석
+	ctx := context.WithValue(c.Request.Context(), "jwtToken", c.GetString("jwtToken"))
+	comment, err := h.commentService.CreateComment(ctx, userUUID, &req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -122,6 +126,39 @@ func (h *CommentHandler) GetCommentsByQuery(c *gin.Context) {
 	response.SendSuccess(c, http.StatusOK, comments)
 }
 
+// GetBoardComments godoc
+// @Summary      Board의 Comment 목록 조회 (답글 스레드)
+// @Description  특정 Board의 Comment를 조회합니다. threaded=true이면 답글을 상위 댓글의 replies에 중첩해 반환합니다
+// @Tags         comments
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        threaded query bool false "답글을 상위 댓글 아래에 중첩 (기본값 false: 작성순 평면 목록)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.CommentResponse} "Comment 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/comments [get]
+func (h *CommentHandler) GetBoardComments(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var comments []*dto.CommentResponse
+	if c.Query("threaded") == "true" {
+		comments, err = h.commentService.GetCommentThreads(c.Request.Context(), boardID)
+	} else {
+		comments, err = h.commentService.GetComments(c.Request.Context(), boardID)
+	}
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, comments)
+}
+
 // UpdateComment godoc
 // @Summary      Comment 수정
 // @Description  Comment 내용을 수정합니다
@@ -144,8 +181,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 	}
 
 	var req dto.UpdateCommentRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -17,10 +17,11 @@ import (
 
 // MockCommentService is a mock implementation of CommentService
 type MockCommentService struct {
-	CreateCommentFunc func(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error)
-	GetCommentsFunc   func(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
-	UpdateCommentFunc func(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
-	DeleteCommentFunc func(ctx context.Context, commentID uuid.UUID) error
+	CreateCommentFunc     func(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error)
+	GetCommentsFunc       func(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
+	GetCommentThreadsFunc func(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
+	UpdateCommentFunc     func(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
+	DeleteCommentFunc     func(ctx context.Context, commentID uuid.UUID) error
 }
 
 func (m *MockCommentService) CreateComment(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
@@ -37,6 +38,13 @@ func (m *MockCommentService) GetComments(ctx context.Context, boardID uuid.UUID)
 	return nil, nil
 }
 
+func (m *MockCommentService) GetCommentThreads(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error) {
+	if m.GetCommentThreadsFunc != nil {
+		return m.GetCommentThreadsFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
 func (m *MockCommentService) UpdateComment(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
 	if m.UpdateCommentFunc != nil {
 		return m.UpdateCommentFunc(ctx, commentID, req)
@@ -205,6 +213,54 @@ func TestCommentHandler_GetComments(t *testing.T) {
 	}
 }
 
+func TestCommentHandler_GetBoardComments(t *testing.T) {
+	boardID := uuid.New()
+
+	tests := []struct {
+		name           string
+		query          string
+		wantThreaded   bool
+		expectedStatus int
+	}{
+		{name: "성공: 평면 목록", query: "", wantThreaded: false, expectedStatus: http.StatusOK},
+		{name: "성공: 답글 스레드", query: "?threaded=true", wantThreaded: true, expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var threaded bool
+			mockService := &MockCommentService{
+				GetCommentsFunc: func(ctx context.Context, id uuid.UUID) ([]*dto.CommentResponse, error) {
+					return []*dto.CommentResponse{}, nil
+				},
+				GetCommentThreadsFunc: func(ctx context.Context, id uuid.UUID) ([]*dto.CommentResponse, error) {
+					threaded = true
+					return []*dto.CommentResponse{}, nil
+				},
+			}
+			handler := NewCommentHandler(mockService)
+
+			router := setupTestRouter()
+			router.GET("/api/boards/:boardId/comments", handler.GetBoardComments)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/boards/"+boardID.String()+"/comments"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			if w.Code != tt.expectedStatus {
+				t.Errorf("GetBoardComments() status = %v, want %v", w.Code, tt.expectedStatus)
+			}
+			if threaded != tt.wantThreaded {
+				t.Errorf("threaded = %v, want %v", threaded, tt.wantThreaded)
+			}
+		})
+	}
+}
+
 func TestCommentHandler_UpdateComment(t *testing.T) {
 	commentID := uuid.New()
 	newContent := "Updated Comment"
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type CommentReactionHandler struct {
+	reactionService service.CommentReactionService
+}
+
+func NewCommentReactionHandler(reactionService service.CommentReactionService) *CommentReactionHandler {
+	return &CommentReactionHandler{
+		reactionService: reactionService,
+	}
+}
+
+// AddReaction godoc
+// @Summary      Comment 이모지 반응 추가
+// @Description  Comment에 이모지 반응을 남깁니다 (같은 이모지를 다시 남기면 그대로 성공)
+// @Description  변경된 반응 집계는 프로젝트 WebSocket으로 COMMENT_REACTION_UPDATED 이벤트로 전송됩니다
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID (UUID)"
+// @Param        emoji path string true "이모지 (URL 인코딩, 예: %F0%9F%91%8D)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CommentReactionsResponse} "반응 추가 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID 또는 이모지"
+// @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /comments/{commentId}/reactions/{emoji} [put]
+func (h *CommentReactionHandler) AddReaction(c *gin.Context) {
+	h.updateReaction(c, h.reactionService.AddReaction)
+}
+
+// RemoveReaction godoc
+// @Summary      Comment 이모지 반응 취소
+// @Description  Comment에 남긴 이모지 반응을 취소합니다 (남기지 않은 반응이어도 그대로 성공)
+// @Description  변경된 반응 집계는 프로젝트 WebSocket으로 COMMENT_REACTION_UPDATED 이벤트로 전송됩니다
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID (UUID)"
+// @Param        emoji path string true "이모지 (URL 인코딩, 예: %F0%9F%91%8D)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CommentReactionsResponse} "반응 취소 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID 또는 이모지"
+// @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /comments/{commentId}/reactions/{emoji} [delete]
+func (h *CommentReactionHandler) RemoveReaction(c *gin.Context) {
+	h.updateReaction(c, h.reactionService.RemoveReaction)
+}
+
+// updateReaction runs a reaction change and broadcasts the comment's new reactions to the project
+func (h *CommentReactionHandler) updateReaction(c *gin.Context, update func(ctx context.Context, commentID, userID uuid.UUID, emoji string) (*dto.CommentReactionsResponse, error)) {
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid comment ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := update(c.Request.Context(), commentID, userID, c.Param("emoji"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+
+	BroadcastEvent(result.ProjectID.String(), WSEvent{
+		Type:    "COMMENT_REACTION_UPDATED",
+		BoardID: result.BoardID.String(),
+		Payload: result,
+	})
+}
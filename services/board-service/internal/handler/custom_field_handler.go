@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type CustomFieldHandler struct {
+	customFieldService service.CustomFieldService
+}
+
+func NewCustomFieldHandler(customFieldService service.CustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		customFieldService: customFieldService,
+	}
+}
+
+// CreateCustomField godoc
+// @Summary      프로젝트 커스텀 필드 생성
+// @Description  stage/role/importance 외의 커스텀 필드를 프로젝트에 정의합니다 (can_manage_fields 권한 필요, 기본 OWNER/ADMIN)
+// @Description  type: select, multi_select, number, date(YYYY-MM-DD), text. select/multi_select는 options가 필요합니다
+// @Description  Board의 customFields[key] 값은 생성/수정 시 이 정의로 검증합니다
+// @Tags         custom-fields
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateCustomFieldRequest true "커스텀 필드 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.CustomFieldResponse} "커스텀 필드 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 (예약된 key, 옵션 누락 등)"
+// @Failure      403 {object} response.ErrorResponse "can_manage_fields 권한 없음"
+// @Failure      409 {object} response.ErrorResponse "같은 key의 커스텀 필드가 이미 존재"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/custom-fields [post]
+func (h *CustomFieldHandler) CreateCustomField(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCustomFieldRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	field, err := h.customFieldService.CreateCustomField(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, field)
+}
+
+// GetCustomFields godoc
+// @Summary      프로젝트 커스텀 필드 목록 조회
+// @Description  프로젝트의 커스텀 필드를 displayOrder 순으로 옵션과 함께 조회합니다
+// @Tags         custom-fields
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.CustomFieldResponse} "커스텀 필드 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/custom-fields [get]
+func (h *CustomFieldHandler) GetCustomFields(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	fields, err := h.customFieldService.GetCustomFields(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, fields)
+}
+
+// UpdateCustomField godoc
+// @Summary      프로젝트 커스텀 필드 수정
+// @Description  이름, 순서를 수정하거나 select/multi_select 필드에 옵션을 추가합니다 (can_manage_fields 권한 필요, 기본 OWNER/ADMIN)
+// @Description  key와 type은 변경할 수 없습니다
+// @Tags         custom-fields
+// @Accept       json
+// @Produce      json
+// @Param        fieldId path string true "Custom Field ID (UUID)"
+// @Param        request body dto.UpdateCustomFieldRequest true "커스텀 필드 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.CustomFieldResponse} "커스텀 필드 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "can_manage_fields 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "커스텀 필드를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /custom-fields/{fieldId} [patch]
+func (h *CustomFieldHandler) UpdateCustomField(c *gin.Context) {
+	fieldID, err := uuid.Parse(c.Param("fieldId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid custom field ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCustomFieldRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	field, err := h.customFieldService.UpdateCustomField(c.Request.Context(), fieldID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, field)
+}
+
+// DeleteCustomField godoc
+// @Summary      프로젝트 커스텀 필드 삭제
+// @Description  커스텀 필드와 옵션을 삭제하고 프로젝트 Board의 customFields에서 해당 값을 제거합니다 (can_manage_fields 권한 필요, 기본 OWNER/ADMIN)
+// @Tags         custom-fields
+// @Produce      json
+// @Param        fieldId path string true "Custom Field ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "커스텀 필드 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Custom Field ID"
+// @Failure      403 {object} response.ErrorResponse "can_manage_fields 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "커스텀 필드를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /custom-fields/{fieldId} [delete]
+func (h *CustomFieldHandler) DeleteCustomField(c *gin.Context) {
+	fieldID, err := uuid.Parse(c.Param("fieldId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid custom field ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.customFieldService.DeleteCustomField(c.Request.Context(), fieldID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -8,8 +8,10 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"project-board-api/internal/response"
+	"project-board-api/internal/service"
 )
 
 // getLogger retrieves the zap logger from gin context with trace context
@@ -48,6 +50,13 @@ func handleServiceError(c *gin.Context, err error) {
 		return
 	}
 
+	// Check for optimistic concurrency conflicts (응답에 최신 상태를 함께 보냄)
+	var conflict *service.BoardVersionConflictError
+	if errors.As(err, &conflict) {
+		response.SendConflict(c, "Board was modified by another user", conflict.Current)
+		return
+	}
+
 	// Check for custom AppError
 	var appErr *response.AppError
 	if errors.As(err, &appErr) {
@@ -65,22 +74,7 @@ func handleServiceError(c *gin.Context, err error) {
 	response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Internal server error")
 }
 
-// mapErrorCodeToHTTPStatus maps error codes to HTTP status codes
+// mapErrorCodeToHTTPStatus maps error codes to HTTP status codes using the shared error code catalog
 func mapErrorCodeToHTTPStatus(code string) int {
-	switch code {
-	case response.ErrCodeNotFound:
-		return http.StatusNotFound
-	case response.ErrCodeAlreadyExists:
-		return http.StatusConflict
-	case response.ErrCodeValidation:
-		return http.StatusBadRequest
-	case response.ErrCodeUnauthorized:
-		return http.StatusUnauthorized
-	case response.ErrCodeForbidden:
-		return http.StatusForbidden
-	case "ALREADY_MEMBER", "PENDING_REQUEST_EXISTS":
-		return http.StatusConflict
-	default:
-		return http.StatusInternalServerError
-	}
+	return apperrors.GetHTTPStatus(code)
 }
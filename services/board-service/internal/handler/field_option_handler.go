@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -68,8 +69,7 @@ func (h *FieldOptionHandler) GetFieldOptions(c *gin.Context) {
 // @Router       /field-options [post]
 func (h *FieldOptionHandler) CreateFieldOption(c *gin.Context) {
 	var req dto.CreateFieldOptionRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -104,8 +104,7 @@ func (h *FieldOptionHandler) UpdateFieldOption(c *gin.Context) {
 	}
 
 	var req dto.UpdateFieldOptionRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
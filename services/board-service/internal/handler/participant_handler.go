@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -36,8 +37,7 @@ func NewParticipantHandler(participantService service.ParticipantService) *Parti
 // @Router       /participants [post]
 func (h *ParticipantHandler) AddParticipants(c *gin.Context) {
 	var req dto.AddParticipantsRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -55,7 +55,7 @@ func (h *ParticipantHandler) AddParticipants(c *gin.Context) {
 
 	// If some participants failed (partial success), return 207 Multi-Status
 	if result.TotalFailed > 0 {
-		c.JSON(http.StatusMultiStatus, result)
+		response.SendSuccess(c, http.StatusMultiStatus, result)
 		return
 	}
 
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ProjectExportHandler struct {
+	exportService service.ProjectExportService
+}
+
+func NewProjectExportHandler(exportService service.ProjectExportService) *ProjectExportHandler {
+	return &ProjectExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportProject godoc
+// @Summary      프로젝트 내보내기 (JSON/CSV)
+// @Description  프로젝트의 모든 Board와 댓글, 참여자, 첨부파일 메타데이터를 백업이나 다른 워크스페이스로의 이전을 위해 파일로 내려받습니다 (프로젝트 멤버만 가능)
+// @Description  json: 프로젝트, 멤버, 필드 옵션, 커스텀 필드 뒤에 boards 배열이 이어지는 하나의 객체. customFields는 옵션 ID가 아닌 value로 내보냅니다
+// @Description  csv: recordType(board, participant, comment, attachment) 열로 구분되는 행 목록. 첨부파일 본문은 포함하지 않습니다
+// @Description  Board를 배치 단위로 읽으며 바로 전송하므로, 전송 중 오류가 나면 파일이 잘린 채로 끝날 수 있습니다
+// @Tags         projects
+// @Produce      json
+// @Produce      text/csv
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        format query string false "json 또는 csv (기본 json)"
+// @Success      200 {object} dto.ProjectExportHeader "내보내기 파일 (boards 배열 포함)"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 지원하지 않는 format"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/export [get]
+func (h *ProjectExportHandler) ExportProject(c *gin.Context) {
+	log := getLogger(c)
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+	format := dto.ExportFormat(c.DefaultQuery("format", string(dto.ExportFormatJSON)))
+
+	export, err := h.exportService.ExportProject(c.Request.Context(), projectID, userID, format)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	if err := export.WriteTo(c.Request.Context(), c.Writer); err != nil {
+		// 이미 응답을 보내기 시작했으므로 상태 코드를 바꿀 수 없음 (클라이언트는 잘린 파일을 받음)
+		log.Error("ExportProject stream failed", zap.String("project.id", projectID.String()), zap.Error(err))
+		c.Abort()
+	}
+}
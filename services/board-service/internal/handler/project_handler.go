@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -39,9 +40,8 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	log.Debug("CreateProject started")
 
 	var req dto.CreateProjectRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		log.Warn("CreateProject validation failed", zap.Error(err))
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
 		return
 	}
 
@@ -99,8 +99,9 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 // @Tags         projects
 // @Produce      json
 // @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Param        state query string false "보관 상태 필터: ACTIVE(기본), ARCHIVED, ALL"
 // @Success      200 {object} response.SuccessResponse{data=[]dto.ProjectResponse} "Project 목록 조회 성공"
-// @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID 또는 state"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /projects/workspace/{workspaceId} [get]
 func (h *ProjectHandler) GetProjectsByWorkspace(c *gin.Context) {
@@ -135,7 +136,7 @@ func (h *ProjectHandler) GetProjectsByWorkspace(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, tokenStr)
+	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, c.Query("state"), tokenStr)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -151,8 +152,9 @@ func (h *ProjectHandler) GetProjectsByWorkspace(c *gin.Context) {
 // @Tags         projects
 // @Produce      json
 // @Param        workspaceId query string true "Workspace ID (UUID)"
+// @Param        state query string false "보관 상태 필터: ACTIVE(기본), ARCHIVED, ALL"
 // @Success      200 {object} response.SuccessResponse{data=dto.PaginatedProjectsResponse} "Project 목록 조회 성공"
-// @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID 또는 state"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /projects [get]
 func (h *ProjectHandler) GetProjectsByWorkspaceQuery(c *gin.Context) {
@@ -192,7 +194,7 @@ func (h *ProjectHandler) GetProjectsByWorkspaceQuery(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, tokenStr)
+	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, c.Query("state"), tokenStr)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 )
@@ -52,7 +53,7 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 
 // UpdateProject godoc
 // @Summary      Project 수정
-// @Description  Project의 이름, 설명, 날짜를 수정합니다 (OWNER만 가능)
+// @Description  Project의 이름, 설명, 날짜를 수정합니다 (can_update_project 권한 필요, 기본 OWNER)
 // @Description  startDate와 dueDate를 수정할 수 있으며, startDate는 dueDate보다 이전이어야 합니다
 // @Tags         projects
 // @Accept       json
@@ -74,8 +75,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	}
 
 	var req dto.UpdateProjectRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -139,6 +139,71 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	response.SendSuccess(c, http.StatusOK, map[string]string{"message": "Project deleted successfully"})
 }
 
+// ArchiveProject godoc
+// @Summary      Project 보관
+// @Description  Project를 보관 상태로 바꿉니다. 보관된 Project는 기본 목록과 검색에서 제외되며 state=ARCHIVED 또는 ALL로 조회할 수 있습니다
+// @Description  can_delete_project 권한이 필요하며, 기본 프로젝트는 보관할 수 없습니다
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectResponse} "Project 보관 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 기본 프로젝트"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/archive [post]
+func (h *ProjectHandler) ArchiveProject(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.ArchiveProject(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, project)
+}
+
+// UnarchiveProject godoc
+// @Summary      Project 보관 해제
+// @Description  보관된 Project를 다시 기본 목록에 표시합니다 (can_delete_project 권한 필요)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectResponse} "Project 보관 해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/unarchive [post]
+func (h *ProjectHandler) UnarchiveProject(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.UnarchiveProject(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, project)
+}
+
 // SearchProjects godoc
 // @Summary      Project 검색
 // @Description  Workspace 내에서 Project를 이름이나 설명으로 검색합니다
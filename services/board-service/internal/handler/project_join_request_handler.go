@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -37,8 +38,7 @@ func NewProjectJoinRequestHandler(joinRequestService service.ProjectJoinRequestS
 // @Router       /join-requests [post]
 func (h *ProjectJoinRequestHandler) CreateJoinRequest(c *gin.Context) {
 	var req dto.CreateProjectJoinRequestRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -75,7 +75,7 @@ func (h *ProjectJoinRequestHandler) CreateJoinRequest(c *gin.Context) {
 
 // GetJoinRequests godoc
 // @Summary      프로젝트 가입 요청 목록 조회
-// @Description  프로젝트에 대한 가입 요청 목록을 조회합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  프로젝트에 대한 가입 요청 목록을 조회합니다 (can_invite 권한 필요, 기본 OWNER/ADMIN)
 // @Tags         project-join-requests
 // @Produce      json
 // @Param        projectId path string true "Project ID (UUID)"
@@ -132,7 +132,7 @@ func (h *ProjectJoinRequestHandler) GetJoinRequests(c *gin.Context) {
 
 // UpdateJoinRequest godoc
 // @Summary      프로젝트 가입 요청 승인/거부
-// @Description  가입 요청을 승인하거나 거부합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  가입 요청을 승인하거나 거부합니다 (can_invite 권한 필요, 기본 OWNER/ADMIN)
 // @Tags         project-join-requests
 // @Accept       json
 // @Produce      json
@@ -153,8 +153,7 @@ func (h *ProjectJoinRequestHandler) UpdateJoinRequest(c *gin.Context) {
 	}
 
 	var req dto.UpdateProjectJoinRequestRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
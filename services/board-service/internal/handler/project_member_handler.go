@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 	"project-board-api/internal/service"
@@ -74,7 +75,7 @@ func (h *ProjectMemberHandler) GetMembers(c *gin.Context) {
 
 // RemoveMember godoc
 // @Summary      프로젝트 멤버 제거
-// @Description  프로젝트에서 멤버를 제거합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  프로젝트에서 멤버를 제거합니다 (can_remove_members 권한 필요, 기본 OWNER/ADMIN)
 // @Tags         project-members
 // @Produce      json
 // @Param        projectId path string true "Project ID (UUID)"
@@ -151,8 +152,7 @@ func (h *ProjectMemberHandler) UpdateMemberRole(c *gin.Context) {
 	}
 
 	var req dto.UpdateProjectMemberRoleRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ProjectPermissionHandler struct {
+	permissionService service.ProjectPermissionService
+}
+
+func NewProjectPermissionHandler(permissionService service.ProjectPermissionService) *ProjectPermissionHandler {
+	return &ProjectPermissionHandler{
+		permissionService: permissionService,
+	}
+}
+
+// GetPermissions godoc
+// @Summary      프로젝트 권한 매트릭스 조회
+// @Description  권한별로 허용된 역할과 요청자의 역할/권한을 조회합니다 (프로젝트 멤버만 가능)
+// @Description  프로젝트가 바꾸지 않은 권한은 기본값을 따릅니다. OWNER는 항상 모든 권한을 가집니다
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectPermissionsResponse} "권한 매트릭스 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/permissions [get]
+func (h *ProjectPermissionHandler) GetPermissions(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	permissions, err := h.permissionService.GetPermissions(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, permissions)
+}
+
+// UpdatePermissions godoc
+// @Summary      프로젝트 권한 매트릭스 수정
+// @Description  요청한 권한만 지정한 역할(ADMIN, MEMBER)에 허용하도록 바꿉니다 (프로젝트 OWNER만 가능)
+// @Description  빈 목록은 OWNER 전용으로 만듭니다. can_delete_project, can_manage_roles는 바꿀 수 없습니다
+// @Tags         projects
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.UpdateProjectPermissionsRequest true "권한 매트릭스 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectPermissionsResponse} "권한 매트릭스 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 (바꿀 수 없는 권한, 잘못된 역할)"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 OWNER가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/permissions [put]
+func (h *ProjectPermissionHandler) UpdatePermissions(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateProjectPermissionsRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	permissions, err := h.permissionService.UpdatePermissions(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, permissions)
+}
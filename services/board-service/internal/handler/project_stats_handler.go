@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ProjectStatsHandler struct {
+	statsService service.ProjectStatsService
+}
+
+func NewProjectStatsHandler(statsService service.ProjectStatsService) *ProjectStatsHandler {
+	return &ProjectStatsHandler{
+		statsService: statsService,
+	}
+}
+
+// GetThroughput godoc
+// @Summary      프로젝트 처리량 / 번다운 조회
+// @Description  기간 내 날짜(UTC)별로 생성된 Board 수, 완료 stage로 이동한 Board 수, 그날 끝에 완료되지 않은 Board 수(remaining)를 조회합니다 (프로젝트 멤버만 가능)
+// @Description  완료 여부는 활동 기록의 stage 변경 이력으로 판단하므로, 이력이 남기 전의 변경은 반영되지 않습니다
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        from query string false "시작일 YYYY-MM-DD (기본: to 기준 30일 전)"
+// @Param        to query string false "종료일 YYYY-MM-DD (기본: 오늘, 최대 180일)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectThroughputResponse}
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 기간"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/stats/throughput [get]
+func (h *ProjectStatsHandler) GetThroughput(c *gin.Context) {
+	projectID, userID, from, to, ok := parseStatsRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.statsService.GetThroughput(c.Request.Context(), projectID, userID, from, to)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, resp)
+}
+
+// GetCycleTime godoc
+// @Summary      프로젝트 stage별 평균 체류 시간 조회
+// @Description  기간 내에 다른 stage로 이동한 Board가 이전 stage에 머문 평균 시간을 stage별로 조회합니다 (프로젝트 멤버만 가능)
+// @Description  첫 stage의 체류 시간은 Board 생성 시점부터 계산하며, 아직 머물고 있는 stage는 포함하지 않습니다
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        from query string false "시작일 YYYY-MM-DD (기본: to 기준 30일 전)"
+// @Param        to query string false "종료일 YYYY-MM-DD (기본: 오늘, 최대 180일)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectCycleTimeResponse}
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 기간"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/stats/cycle-time [get]
+func (h *ProjectStatsHandler) GetCycleTime(c *gin.Context) {
+	projectID, userID, from, to, ok := parseStatsRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.statsService.GetCycleTime(c.Request.Context(), projectID, userID, from, to)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, resp)
+}
+
+// GetAssigneeLoad godoc
+// @Summary      담당자별 진행 중인 Board 수 조회
+// @Description  완료되지 않았고 보관되지 않은 Board를 담당자별, stage별로 집계합니다. 많은 순으로 정렬하며 담당자 미지정(assigneeId null)은 마지막입니다 (프로젝트 멤버만 가능)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectAssigneeLoadResponse}
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/stats/assignee-load [get]
+func (h *ProjectStatsHandler) GetAssigneeLoad(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.statsService.GetAssigneeLoad(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, resp)
+}
+
+// parseStatsRequest parses the project ID, the requesting user and the from/to query dates.
+// to가 없으면 오늘(UTC), from이 없으면 to를 포함해 DefaultStatsRangeDays일 전부터입니다.
+func parseStatsRequest(c *gin.Context) (projectID, userID uuid.UUID, from, to time.Time, ok bool) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	if userID, ok = requestUserID(c); !ok {
+		return
+	}
+
+	to = time.Now().UTC().Truncate(24 * time.Hour)
+	if raw := c.Query("to"); raw != "" {
+		if to, err = time.Parse(dto.StatsDateLayout, raw); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid to date, expected YYYY-MM-DD")
+			return projectID, userID, from, to, false
+		}
+	}
+	from = to.AddDate(0, 0, -(dto.DefaultStatsRangeDays - 1))
+	if raw := c.Query("from"); raw != "" {
+		if from, err = time.Parse(dto.StatsDateLayout, raw); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid from date, expected YYYY-MM-DD")
+			return projectID, userID, from, to, false
+		}
+	}
+	return projectID, userID, from, to, true
+}
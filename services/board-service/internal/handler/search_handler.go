@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type SearchHandler struct {
+	searchService service.SearchService
+}
+
+func NewSearchHandler(searchService service.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+// Search godoc
+// @Summary      통합 검색
+// @Description  Workspace 전체에서 Board, Comment, 채팅 메시지, 파일, 멤버를 한 번에 검색합니다
+// @Description  접근 가능한 Project의 Board/Comment와 참여 중인 채팅방의 메시지만 반환하며, 그룹별로 관련도 순으로 정렬합니다
+// @Description  일부 서비스가 응답하지 않으면 해당 그룹은 unavailable에 표시되고 나머지 결과는 그대로 반환됩니다
+// @Tags         search
+// @Produce      json
+// @Param        workspaceId query string true "Workspace ID (UUID)"
+// @Param        q query string true "검색어 (최대 100자)"
+// @Param        types query string false "검색할 그룹 (쉼표 구분: boards,comments,messages,files,members, 기본값 전체)"
+// @Param        limit query int false "그룹별 결과 수 (최대 20)" default(5)
+// @Success      200 {object} response.SuccessResponse{data=dto.GlobalSearchResponse} "검색 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Router       /search [get]
+func (h *SearchHandler) Search(c *gin.Context) {
+	workspaceIDStr := c.Query("workspaceId")
+	if workspaceIDStr == "" {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Workspace ID is required")
+		return
+	}
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	req := &dto.GlobalSearchRequest{
+		WorkspaceID: workspaceID,
+		Query:       c.Query("q"),
+	}
+	if typesStr := c.Query("types"); typesStr != "" {
+		for _, t := range strings.Split(typesStr, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				req.Types = append(req.Types, dto.SearchType(t))
+			}
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil {
+			req.Limit = l
+		}
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	result, err := h.searchService.Search(c.Request.Context(), userUUID, req, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// SearchBoards godoc
+// @Summary      Board 전문 검색
+// @Description  참여 중인 Project의 Board 제목, 내용, 댓글을 Postgres 전문 검색(tsvector)으로 검색합니다
+// @Description  검색어의 모든 단어가 (접두어로) 일치하는 Board를 관련도 순으로 반환하며, 댓글이 더 잘 일치하면 matchedIn이 comment입니다
+// @Tags         search
+// @Produce      json
+// @Param        workspaceId query string true "Workspace ID (UUID)"
+// @Param        q query string true "검색어 (최대 100자)"
+// @Param        limit query int false "페이지 크기 (최대 50)" default(20)
+// @Param        offset query int false "건너뛸 결과 수" default(0)
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardSearchResponse} "검색 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/search [get]
+func (h *SearchHandler) SearchBoards(c *gin.Context) {
+	workspaceIDStr := c.Query("workspaceId")
+	if workspaceIDStr == "" {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Workspace ID is required")
+		return
+	}
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	req := &dto.BoardSearchRequest{
+		WorkspaceID: workspaceID,
+		Query:       c.Query("q"),
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if req.Limit, err = strconv.Atoi(limitStr); err != nil || req.Limit < 1 || req.Limit > dto.MaxBoardSearchLimit {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation,
+				fmt.Sprintf("Invalid limit: must be between 1 and %d", dto.MaxBoardSearchLimit))
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if req.Offset, err = strconv.Atoi(offsetStr); err != nil || req.Offset < 0 {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid offset")
+			return
+		}
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	result, err := h.searchService.SearchBoards(c.Request.Context(), userUUID, req, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type SubtaskHandler struct {
+	subtaskService service.SubtaskService
+}
+
+func NewSubtaskHandler(subtaskService service.SubtaskService) *SubtaskHandler {
+	return &SubtaskHandler{
+		subtaskService: subtaskService,
+	}
+}
+
+// CreateSubtask godoc
+// @Summary      Subtask 생성
+// @Description  Board의 체크리스트 맨 끝에 Subtask를 추가합니다
+// @Tags         subtasks
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.CreateSubtaskRequest true "Subtask 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.SubtaskResponse} "Subtask 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/subtasks [post]
+func (h *SubtaskHandler) CreateSubtask(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.CreateSubtaskRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	subtask, err := h.subtaskService.CreateSubtask(c.Request.Context(), boardID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, subtask)
+}
+
+// GetSubtasks godoc
+// @Summary      Board의 Subtask 목록 조회
+// @Description  특정 Board의 모든 Subtask를 체크리스트 순서대로 조회합니다
+// @Tags         subtasks
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.SubtaskResponse} "Subtask 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/subtasks [get]
+func (h *SubtaskHandler) GetSubtasks(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	subtasks, err := h.subtaskService.GetSubtasks(c.Request.Context(), boardID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, subtasks)
+}
+
+// UpdateSubtask godoc
+// @Summary      Subtask 수정
+// @Description  Subtask의 제목, 완료 여부, 담당자를 수정하거나 prevSubtaskId/nextSubtaskId 사이로 순서를 옮깁니다
+// @Tags         subtasks
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        subtaskId path string true "Subtask ID (UUID)"
+// @Param        request body dto.UpdateSubtaskRequest true "Subtask 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.SubtaskResponse} "Subtask 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Subtask를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "순서가 변경됨 (다시 조회 후 재시도)"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/subtasks/{subtaskId} [put]
+func (h *SubtaskHandler) UpdateSubtask(c *gin.Context) {
+	boardID, subtaskID, ok := parseSubtaskParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSubtaskRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	subtask, err := h.subtaskService.UpdateSubtask(c.Request.Context(), boardID, subtaskID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, subtask)
+}
+
+// DeleteSubtask godoc
+// @Summary      Subtask 삭제
+// @Description  Subtask를 소프트 삭제합니다
+// @Tags         subtasks
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        subtaskId path string true "Subtask ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "Subtask 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      404 {object} response.ErrorResponse "Subtask를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/subtasks/{subtaskId} [delete]
+func (h *SubtaskHandler) DeleteSubtask(c *gin.Context) {
+	boardID, subtaskID, ok := parseSubtaskParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.subtaskService.DeleteSubtask(c.Request.Context(), boardID, subtaskID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// parseSubtaskParams parses the boardId and subtaskId path parameters, writing a 400 response on failure
+func parseSubtaskParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	subtaskID, err := uuid.Parse(c.Param("subtaskId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid subtask ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return boardID, subtaskID, true
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type WatcherHandler struct {
+	watcherService service.WatcherService
+}
+
+func NewWatcherHandler(watcherService service.WatcherService) *WatcherHandler {
+	return &WatcherHandler{
+		watcherService: watcherService,
+	}
+}
+
+// WatchBoard godoc
+// @Summary      Board 구독
+// @Description  Board의 변경 알림을 받도록 구독합니다 (담당자/참여자로 지정되지 않아도 알림 수신)
+// @Description  이미 구독 중이면 그대로 성공합니다
+// @Tags         watchers
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardWatchResponse} "구독 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/watchers/me [post]
+func (h *WatcherHandler) WatchBoard(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.watcherService.WatchBoard(c.Request.Context(), boardID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// UnwatchBoard godoc
+// @Summary      Board 구독 해제
+// @Description  Board 변경 알림 구독을 해제합니다 (담당자/참여자 알림은 계속 수신)
+// @Description  구독하지 않은 Board여도 그대로 성공합니다
+// @Tags         watchers
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardWatchResponse} "구독 해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/watchers/me [delete]
+func (h *WatcherHandler) UnwatchBoard(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+	userID, ok := requestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.watcherService.UnwatchBoard(c.Request.Context(), boardID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// wsChannelPrefix is the Redis pub/sub channel prefix for project events (kanban:project:<projectId>)
+	wsChannelPrefix = "kanban:project:"
+	// wsPublishTimeout bounds a single publish so a slow Redis does not hold up the API response path
+	wsPublishTimeout = 2 * time.Second
+	// wsSubscribeTimeout bounds the initial subscription at startup
+	wsSubscribeTimeout = 5 * time.Second
+)
+
+// wsFanoutClient is set while this pod is subscribed to the project channels.
+// nil이면 (Redis 없음 또는 구독 실패) BroadcastEvent는 이 파드의 연결에만 이벤트를 보냅니다.
+var wsFanoutClient atomic.Pointer[redis.Client]
+
+// StartWSFanout subscribes this pod to every project channel and delivers the received events to the
+// WebSocket clients connected here. 이벤트를 보낸 파드도 Redis를 거쳐 받으므로 레플리카 수와 관계없이
+// 각 클라이언트는 이벤트를 한 번씩 받습니다. 반환된 함수는 구독을 끝내며 lifecycle.Shutdown에 등록합니다.
+func StartWSFanout(rdb *redis.Client, log *zap.Logger) (func(ctx context.Context) error, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), wsSubscribeTimeout)
+	defer cancel()
+
+	pubsub := rdb.PSubscribe(ctx, wsChannelPrefix+"*")
+	// 구독 확인을 받은 뒤에만 publish로 전환해, 그 사이 이벤트가 어느 파드에도 전달되지 않는 일을 막습니다.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	wsFanoutClient.Store(rdb)
+	log.Info("WebSocket fan-out subscribed", zap.String("pattern", wsChannelPrefix+"*"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for msg := range pubsub.Channel() {
+			deliverLocal(strings.TrimPrefix(msg.Channel, wsChannelPrefix), []byte(msg.Payload))
+		}
+	}()
+
+	return func(ctx context.Context) error {
+		wsFanoutClient.Store(nil)
+		if err := pubsub.Close(); err != nil {
+			return err
+		}
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}, nil
+}
+
+// BroadcastEvent broadcasts a WebSocket event to all clients subscribed to the given project on every pod.
+// Redis를 거치는 이벤트에는 seq가 붙고 재연결용으로 버퍼링되며, 발행에 실패하면 seq 없이 이 파드의 연결에만 보냅니다.
+func BroadcastEvent(projectID string, event WSEvent) {
+	log := getWSLogger()
+
+	if rdb := wsFanoutClient.Load(); rdb != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), wsPublishTimeout)
+		defer cancel()
+		err := publishEvent(ctx, rdb, projectID, event)
+		if err == nil {
+			return
+		}
+		log.Warn("Failed to publish WebSocket event, delivering to local clients only",
+			zap.String("projectID", projectID),
+			zap.String("eventType", event.Type),
+			zap.Error(err))
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error("Failed to marshal WebSocket event", zap.String("eventType", event.Type), zap.Error(err))
+		return
+	}
+	deliverLocal(projectID, payload)
+}
+
+// deliverLocal sends an encoded event to the project's clients connected to this pod.
+// 전송 채널이 가득 찬 클라이언트는 연결을 끊어, readPump가 정리하고 클라이언트가 재연결해 다시 동기화하게 합니다.
+func deliverLocal(projectID string, payload []byte) {
+	log := getWSLogger()
+
+	clientsMu.RLock()
+	defer clientsMu.RUnlock()
+
+	projectClients, ok := clients[projectID]
+	if !ok {
+		log.Debug("No clients found for project", zap.String("projectID", projectID))
+		return
+	}
+
+	log.Debug("Delivering event", zap.String("projectID", projectID), zap.Int("clientCount", len(projectClients)))
+	for client := range projectClients {
+		select {
+		case client.send <- payload:
+		default:
+			log.Warn("Client channel full, closing connection",
+				zap.String("projectID", projectID),
+				zap.String("userId", client.userID))
+			client.conn.Close()
+		}
+	}
+}
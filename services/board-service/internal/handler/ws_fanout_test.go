@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestBroadcastEvent_LocalFallback은 Redis fan-out이 없을 때 같은 프로젝트의 로컬 연결에만 한 번씩 전달되는지 테스트합니다.
+func TestBroadcastEvent_LocalFallback(t *testing.T) {
+	target := &Client{send: make(chan []byte, 2), projectID: "project-a"}
+	other := &Client{send: make(chan []byte, 2), projectID: "project-b"}
+
+	clientsMu.Lock()
+	clients["project-a"] = map[*Client]bool{target: true}
+	clients["project-b"] = map[*Client]bool{other: true}
+	clientsMu.Unlock()
+	t.Cleanup(func() {
+		clientsMu.Lock()
+		delete(clients, "project-a")
+		delete(clients, "project-b")
+		clientsMu.Unlock()
+	})
+
+	BroadcastEvent("project-a", WSEvent{Type: "BOARD_UPDATED", BoardID: "board-1", Version: 4})
+
+	if len(target.send) != 1 {
+		t.Fatalf("target received %d messages, want 1", len(target.send))
+	}
+	var got WSEvent
+	if err := json.Unmarshal(<-target.send, &got); err != nil {
+		t.Fatalf("invalid event payload: %v", err)
+	}
+	if got.Type != "BOARD_UPDATED" || got.BoardID != "board-1" || got.Version != 4 {
+		t.Errorf("received %+v", got)
+	}
+	if len(other.send) != 0 {
+		t.Errorf("other project received %d messages, want 0", len(other.send))
+	}
+}
@@ -3,14 +3,17 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"project-board-api/internal/client"
 	"project-board-api/internal/database"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -41,6 +44,8 @@ type WSEvent struct {
 	BoardID string      `json:"boardId,omitempty"`
 	From    string      `json:"from,omitempty"`
 	To      string      `json:"to,omitempty"`
+	Version int         `json:"version,omitempty"` // 이벤트 후 Board 버전 (클라이언트가 늦게 도착한 이벤트를 거를 때 사용)
+	Seq     int64       `json:"seq,omitempty"`     // 프로젝트별 이벤트 순번 (재연결 시 ?since=로 놓친 이벤트 재생, Redis 없으면 생략)
 }
 
 type Client struct {
@@ -51,14 +56,16 @@ type Client struct {
 }
 
 type WSHandler struct {
-	Logger     *zap.Logger
-	AuthClient client.UserClient
+	Logger      *zap.Logger
+	AuthClient  client.UserClient
+	ProjectRepo repository.ProjectRepository // 연결 전 프로젝트 멤버 여부 확인
 }
 
-func NewWSHandler(log *zap.Logger, authClient client.UserClient) *WSHandler {
+func NewWSHandler(log *zap.Logger, authClient client.UserClient, projectRepo repository.ProjectRepository) *WSHandler {
 	return &WSHandler{
-		Logger:     log,
-		AuthClient: authClient,
+		Logger:      log,
+		AuthClient:  authClient,
+		ProjectRepo: projectRepo,
 	}
 }
 
@@ -89,13 +96,17 @@ func getWSLogger() *zap.Logger {
 // @Summary      WebSocket 실시간 연결
 // @Description  프로젝트의 실시간 이벤트를 구독하기 위한 WebSocket 연결을 설정합니다
 // @Description  연결 후 BOARD_CREATED, BOARD_UPDATED, BOARD_MOVED, BOARD_DELETED 이벤트를 실시간으로 수신합니다
-// @Description  인증은 쿼리 파라미터로 전달된 JWT 토큰을 통해 수행됩니다
+// @Description  인증은 쿼리 파라미터로 전달된 JWT 토큰을 통해 수행되며, 프로젝트 멤버만 연결할 수 있습니다
+// @Description  각 이벤트의 seq를 기억했다가 재연결 시 since로 보내면 그 뒤의 이벤트를 먼저 재생하며, 버퍼(최근 200개)에서 밀려났으면 RESYNC_REQUIRED를 보냅니다
+// @Description  since 없이 연결하면 먼저 현재 seq를 담은 CONNECTED를 보냅니다
 // @Tags         websocket
 // @Produce      json
 // @Param        projectId path string true "Project ID (UUID)"
 // @Param        token query string true "JWT Access Token"
+// @Param        since query int false "마지막으로 받은 이벤트의 seq"
 // @Success      101 {string} string "Switching Protocols - WebSocket 연결 성공"
 // @Failure      401 {string} string "인증 실패"
+// @Failure      403 {string} string "프로젝트 멤버가 아님"
 // @Failure      500 {string} string "서버 에러"
 // @Router       /ws/project/{projectId} [get]
 func (h *WSHandler) HandleWebSocket(c *gin.Context) {
@@ -111,6 +122,17 @@ func (h *WSHandler) HandleWebSocket(c *gin.Context) {
 		return
 	}
 
+	var since int64 = -1
+	if sinceStr := c.Query("since"); sinceStr != "" {
+		parsed, err := strconv.ParseInt(sinceStr, 10, 64)
+		if err != nil || parsed < 0 {
+			log.Warn("WS connection attempt with invalid since", zap.String("projectId", projectID), zap.String("since", sinceStr))
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
 	authCtx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
@@ -121,6 +143,25 @@ func (h *WSHandler) HandleWebSocket(c *gin.Context) {
 		return
 	}
 
+	// 토큰만으로는 부족: 다른 프로젝트의 실시간 이벤트와 재생 버퍼를 받지 못하도록 멤버 여부를 업그레이드 전에 확인
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		log.Warn("WS connection attempt with invalid projectId", zap.String("projectId", projectID))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	isMember, err := h.ProjectRepo.IsProjectMember(authCtx, projectUUID, userID)
+	if err != nil {
+		log.Error("WebSocket membership check failed", zap.Error(err), zap.String("projectId", projectID))
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	if !isMember {
+		log.Warn("WS connection attempt by non-member", zap.String("projectId", projectID), zap.String("userId", userID.String()))
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
 	log.Info("WebSocket auth successful", zap.String("projectId", projectID), zap.String("userId", userID.String()))
 
 	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
@@ -131,6 +172,21 @@ func (h *WSHandler) HandleWebSocket(c *gin.Context) {
 
 	log.Info("WebSocket upgrade successful", zap.String("projectId", projectID))
 
+	// since 없이 연결하면 등록 전의 현재 seq를 기준으로 삼아, CONNECTED로 알려 주고 그 뒤의 이벤트를 재생합니다.
+	rdb := wsFanoutClient.Load()
+	connected := false
+	if rdb != nil && since < 0 {
+		seqCtx, cancelSeq := context.WithTimeout(c.Request.Context(), wsPublishTimeout)
+		current, err := currentSeq(seqCtx, rdb, projectID)
+		cancelSeq()
+		if err != nil {
+			log.Warn("Failed to read current event seq", zap.String("projectId", projectID), zap.Error(err))
+		} else {
+			since = current
+			connected = true
+		}
+	}
+
 	client := &Client{
 		conn:      conn,
 		send:      make(chan []byte, 256),
@@ -157,9 +213,30 @@ func (h *WSHandler) HandleWebSocket(c *gin.Context) {
 		zap.String("userId", client.userID),
 		zap.Int("totalClients", currentClientCount))
 
+	// 등록한 뒤 재생해야 그 사이 발행된 이벤트를 놓치지 않습니다 (중복은 클라이언트가 seq로 거름).
+	if connected {
+		if err := sendConnected(client, since); err != nil {
+			log.Warn("Failed to send current event seq", zap.String("projectId", projectID), zap.Error(err))
+		}
+	}
+	if rdb != nil && since >= 0 {
+		replayCtx, cancelReplay := context.WithTimeout(c.Request.Context(), wsPublishTimeout)
+		replayed, resync, err := replayToClient(replayCtx, rdb, client, since)
+		cancelReplay()
+		if err != nil {
+			log.Warn("Failed to replay missed events", zap.String("projectId", projectID), zap.Int64("since", since), zap.Error(err))
+		} else {
+			log.Info("Replayed missed events",
+				zap.String("projectId", projectID),
+				zap.Int64("since", since),
+				zap.Int("replayed", replayed),
+				zap.Bool("resync", resync))
+		}
+	}
+
+	// 다른 파드에서 발생한 이벤트도 StartWSFanout의 구독을 통해 이 연결로 전달됩니다.
 	go h.writePump(client, log)
 	go h.readPump(client, log)
-	go subscribeToRedis(projectID, client, log)
 
 	<-c.Request.Context().Done()
 	log.Info("WebSocket context done", zap.String("projectId", projectID))
@@ -281,46 +358,56 @@ func (h *WSHandler) writePump(client *Client, log *zap.Logger) {
 }
 
 // ============================================================================
-// subscribeToRedis: Redis Pub/Sub 구독
+// CloseAllClients: 서버 종료 시 WebSocket 연결 정리
 // ============================================================================
-func subscribeToRedis(projectID string, client *Client, log *zap.Logger) {
-	defer func() {
-		if r := recover(); r != nil {
-			log.Error("Recovered from panic in subscribeToRedis",
-				zap.String("projectId", projectID),
-				zap.Any("panic", r))
-		}
-	}()
 
-	rdb := database.GetRedis()
-	if rdb == nil {
-		log.Warn("Redis client not available")
-		return
+// CloseAllClients sends a close frame (1001 Going Away) to every WebSocket client and waits until they disconnect.
+// 클라이언트가 close 프레임에 응답하면 readPump가 온라인 상태 제거 등 정리를 수행하고,
+// ctx가 끝날 때까지 끊기지 않은 연결은 강제로 닫습니다.
+func CloseAllClients(ctx context.Context) error {
+	log := getWSLogger()
+
+	clientsMu.RLock()
+	var all []*Client
+	for _, projectClients := range clients {
+		for client := range projectClients {
+			all = append(all, client)
+		}
 	}
+	clientsMu.RUnlock()
 
-	ctx := context.Background()
-	channel := fmt.Sprintf("kanban:project:%s", projectID)
-	pubsub := rdb.Subscribe(ctx, channel)
-	defer pubsub.Close()
+	if len(all) == 0 {
+		return nil
+	}
+	log.Info("Closing WebSocket connections", zap.Int("clients", len(all)))
 
-	log.Info("Redis subscription started", zap.String("projectId", projectID), zap.String("channel", channel))
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	for _, client := range all {
+		// WriteControl은 writePump의 쓰기와 동시에 호출해도 안전합니다.
+		_ = client.conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(writeWait))
+	}
 
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
 	for {
-		msg, err := pubsub.ReceiveMessage(ctx)
-		if err != nil {
-			log.Error("Redis subscription error", zap.Error(err), zap.String("projectId", projectID))
-			return
+		clientsMu.RLock()
+		remaining := len(clients)
+		clientsMu.RUnlock()
+		if remaining == 0 {
+			return nil
 		}
 
 		select {
-		case client.send <- []byte(msg.Payload):
-			log.Debug("Message sent to WebSocket client",
-				zap.String("projectId", projectID),
-				zap.String("message", msg.Payload))
-		case <-time.After(1 * time.Second):
-			log.Warn("Failed to send message: channel full or closed",
-				zap.String("projectId", projectID))
-			return
+		case <-ctx.Done():
+			clientsMu.RLock()
+			for _, projectClients := range clients {
+				for client := range projectClients {
+					client.conn.Close()
+				}
+			}
+			clientsMu.RUnlock()
+			return ctx.Err()
+		case <-ticker.C:
 		}
 	}
 }
@@ -457,41 +544,8 @@ func (h *WSHandler) HandleGetOnlineUsers(c *gin.Context) {
 		zap.Int("onlineCount", len(users)),
 		zap.Strings("users", users))
 
-	c.JSON(http.StatusOK, gin.H{
+	response.SendSuccess(c, http.StatusOK, gin.H{
 		"onlineUsers": users,
 		"count":       len(users),
 	})
 }
-
-// BroadcastEvent broadcasts a WebSocket event to all clients subscribed to the given project.
-func BroadcastEvent(projectID string, event WSEvent) {
-	log := getWSLogger()
-	payload, _ := json.Marshal(event)
-
-	clientsMu.RLock()
-	defer clientsMu.RUnlock()
-
-	log.Debug("Broadcasting event",
-		zap.String("projectID", projectID),
-		zap.Int("clientCount", len(clients[projectID])),
-		zap.String("eventType", event.Type))
-
-	if projectClients, ok := clients[projectID]; ok {
-		for client := range projectClients {
-			select {
-			case client.send <- payload:
-				log.Debug("Message sent to client")
-			default:
-				log.Warn("Client channel full, closing")
-				close(client.send)
-			}
-		}
-	} else {
-		log.Debug("No clients found for project", zap.String("projectID", projectID))
-	}
-
-	redis := database.GetRedis()
-	if redis != nil {
-		redis.Publish(context.Background(), "kanban:project:"+projectID, payload)
-	}
-}
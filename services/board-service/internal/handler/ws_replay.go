@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// wsReplayBufferSize is the number of recent events kept per project for reconnecting clients
+	wsReplayBufferSize = 200
+	// wsReplayTTL drops the buffer of projects without events for a day
+	wsReplayTTL = 24 * time.Hour
+	// wsEventResyncRequired tells a reconnecting client that missed events are gone and it must reload
+	wsEventResyncRequired = "RESYNC_REQUIRED"
+	// wsEventConnected is sent first to a client connecting without since, with the project's current seq
+	wsEventConnected = "CONNECTED"
+)
+
+// publishEventScript assigns the next seq, buffers the event and publishes it in one atomic step.
+// ARGV[1]은 seq 없이 직렬화한 이벤트이며 스크립트가 맨 앞에 "seq" 필드를 붙입니다.
+var publishEventScript = redis.NewScript(`
+local seq = redis.call('INCR', KEYS[1])
+local payload = '{"seq":' .. seq .. ',' .. string.sub(ARGV[1], 2)
+redis.call('ZADD', KEYS[2], seq, payload)
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+redis.call('PUBLISH', ARGV[4], payload)
+return seq
+`)
+
+// wsSeqKey is the per-project event sequence counter. 만료시키지 않아 seq가 줄어들지 않습니다.
+func wsSeqKey(projectID string) string {
+	return wsChannelPrefix + projectID + ":seq"
+}
+
+// wsEventsKey is the sorted set (score = seq) of the project's most recent events
+func wsEventsKey(projectID string) string {
+	return wsChannelPrefix + projectID + ":events"
+}
+
+// publishEvent assigns the project's next sequence number to event, buffers it for replay and publishes it.
+// 여러 파드가 동시에 발행해도 seq 순서대로 발행되어야 클라이언트가 seq로 중복을 거를 때 이벤트를 잃지 않으므로,
+// 순번 증가부터 발행까지 하나의 Lua 스크립트로 실행합니다.
+func publishEvent(ctx context.Context, rdb *redis.Client, projectID string, event WSEvent) error {
+	event.Seq = 0
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return publishEventScript.Run(ctx, rdb,
+		[]string{wsSeqKey(projectID), wsEventsKey(projectID)},
+		payload, wsReplayBufferSize, int(wsReplayTTL/time.Second), wsChannelPrefix+projectID,
+	).Err()
+}
+
+// currentSeq returns the seq of the project's latest event (0 before the first event)
+func currentSeq(ctx context.Context, rdb *redis.Client, projectID string) (int64, error) {
+	seq, err := rdb.Get(ctx, wsSeqKey(projectID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return seq, err
+}
+
+// replayEvents returns the buffered events of a project after since, in sequence order.
+// since 다음 이벤트가 이미 버퍼에서 밀려났거나 seq가 초기화되었으면 resync=true를 반환합니다.
+func replayEvents(ctx context.Context, rdb *redis.Client, projectID string, since int64) (events []string, current int64, resync bool, err error) {
+	current, err = currentSeq(ctx, rdb, projectID)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if since > current {
+		return nil, current, true, nil
+	}
+	if since == current {
+		return nil, current, false, nil
+	}
+
+	entries, err := rdb.ZRangeByScoreWithScores(ctx, wsEventsKey(projectID), &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(since, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, current, false, err
+	}
+	if len(entries) == 0 || int64(entries[0].Score) != since+1 {
+		return nil, current, true, nil
+	}
+
+	events = make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if member, ok := entry.Member.(string); ok {
+			events = append(events, member)
+		}
+	}
+	return events, current, false, nil
+}
+
+// sendConnected tells a client that connected without since which seq its view of the project starts at
+func sendConnected(client *Client, seq int64) error {
+	payload, err := json.Marshal(WSEvent{Type: wsEventConnected, Seq: seq})
+	if err != nil {
+		return err
+	}
+	client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return client.conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// replayToClient writes the events a reconnecting client missed directly to its connection.
+// writePump보다 먼저 호출하므로 재생된 이벤트가 그사이 큐에 쌓인 실시간 이벤트보다 먼저 전달되며,
+// 클라이언트는 이미 받은 seq 이하의 이벤트를 무시하면 됩니다.
+func replayToClient(ctx context.Context, rdb *redis.Client, client *Client, since int64) (replayed int, resync bool, err error) {
+	events, current, resync, err := replayEvents(ctx, rdb, client.projectID, since)
+	if err != nil {
+		return 0, false, err
+	}
+	if resync {
+		payload, _ := json.Marshal(WSEvent{Type: wsEventResyncRequired, Seq: current})
+		events = []string{string(payload)}
+	}
+
+	for _, event := range events {
+		client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := client.conn.WriteMessage(websocket.TextMessage, []byte(event)); err != nil {
+			return 0, resync, err
+		}
+	}
+	if resync {
+		return 0, true, nil
+	}
+	return len(events), false, nil
+}
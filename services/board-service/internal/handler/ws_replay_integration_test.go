@@ -0,0 +1,75 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// TestPublishEvent_ConcurrentPublishersKeepSeqOrder는 여러 파드가 동시에 발행해도 구독자가 seq 순서대로 받는지 테스트합니다.
+func TestPublishEvent_ConcurrentPublishersKeepSeqOrder(t *testing.T) {
+	rdb := integration.Redis(t)
+	ctx := context.Background()
+	projectID := "project-a"
+
+	sub := rdb.Subscribe(ctx, wsChannelPrefix+projectID)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	messages := sub.Channel()
+
+	const publishers, perPublisher = 8, 25
+	var wg sync.WaitGroup
+	for i := 0; i < publishers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perPublisher; j++ {
+				if err := publishEvent(ctx, rdb, projectID, WSEvent{Type: "BOARD_UPDATED", Payload: map[string]int{"n": j}}); err != nil {
+					t.Errorf("publishEvent() error = %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for want := int64(1); want <= publishers*perPublisher; want++ {
+		select {
+		case msg := <-messages:
+			var event WSEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				t.Fatalf("invalid event payload %q: %v", msg.Payload, err)
+			}
+			if event.Seq != want || event.Type != "BOARD_UPDATED" {
+				t.Fatalf("received seq %d (%s), want %d", event.Seq, event.Type, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for seq %d", want)
+		}
+	}
+
+	// 재연결한 클라이언트는 같은 payload를 seq 순서대로 재생받음
+	events, current, resync, err := replayEvents(ctx, rdb, projectID, publishers*perPublisher-3)
+	if err != nil || resync {
+		t.Fatalf("replayEvents() resync = %v, error = %v", resync, err)
+	}
+	if current != publishers*perPublisher || len(events) != 3 {
+		t.Fatalf("replayEvents() current = %d, events = %d, want %d and 3", current, len(events), publishers*perPublisher)
+	}
+	var last WSEvent
+	if err := json.Unmarshal([]byte(events[2]), &last); err != nil || last.Seq != current {
+		t.Errorf("last replayed event = %s, want seq %d", events[2], current)
+	}
+}
@@ -0,0 +1,47 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DeletedBoardPurger permanently deletes boards whose trash retention has expired
+type DeletedBoardPurger interface {
+	PurgeDeletedBoards(ctx context.Context, now time.Time) (int, error)
+}
+
+// BoardTrashPurgeJob empties the board trash of boards deleted longer ago than the retention
+type BoardTrashPurgeJob struct {
+	purger DeletedBoardPurger
+	logger *zap.Logger
+}
+
+// NewBoardTrashPurgeJob creates a new BoardTrashPurgeJob instance
+func NewBoardTrashPurgeJob(purger DeletedBoardPurger, logger *zap.Logger) *BoardTrashPurgeJob {
+	return &BoardTrashPurgeJob{
+		purger: purger,
+		logger: logger,
+	}
+}
+
+// Run executes the board trash purge job
+// 한 번에 일정 개수만 지우고 나머지는 다음 실행에서 처리합니다 (여러 인스턴스에서 실행되어도 안전)
+func (j *BoardTrashPurgeJob) Run() {
+	ctx := context.Background()
+
+	purged, err := j.purger.PurgeDeletedBoards(ctx, time.Now())
+	if err != nil {
+		j.logger.Error("Failed to purge deleted boards",
+			zap.Error(err),
+		)
+		return
+	}
+
+	if purged > 0 {
+		j.logger.Info("Deleted boards purged",
+			zap.Int("count", purged),
+		)
+	}
+}
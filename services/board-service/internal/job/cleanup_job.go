@@ -12,6 +12,9 @@ import (
 	"project-board-api/internal/repository"
 )
 
+// fileKeyPrefix is the prefix of the S3 keys generated for board-service attachments
+const fileKeyPrefix = "board/"
+
 // CleanupJob handles cleanup of expired temporary attachments
 type CleanupJob struct {
 	attachmentRepo repository.AttachmentRepository
@@ -117,7 +120,12 @@ func (j *CleanupJob) Run() {
 
 // extractFileKeyFromURL extracts the S3 file key from a full S3 URL
 // Example: https://bucket.s3.region.amazonaws.com/board/boards/workspace/2024/01/file.jpg -> board/boards/workspace/2024/01/file.jpg
+// presigned URL로 만든 첨부파일은 FileURL에 S3 key(board/...)만 저장하므로 그대로 반환합니다.
 func (j *CleanupJob) extractFileKeyFromURL(fileURL string) string {
+	if strings.HasPrefix(fileURL, fileKeyPrefix) {
+		return fileURL
+	}
+
 	// Handle S3 URL format: https://bucket.s3.region.amazonaws.com/key
 	// or https://s3.region.amazonaws.com/bucket/key
 
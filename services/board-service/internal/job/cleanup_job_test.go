@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
 
+	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 )
 
@@ -82,6 +83,14 @@ func (m *MockS3Client) DeleteFile(ctx context.Context, key string) error {
 	return args.Error(0)
 }
 
+func (m *MockS3Client) HeadObject(ctx context.Context, key string) (*client.ObjectInfo, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*client.ObjectInfo), args.Error(1)
+}
+
 func (m *MockS3Client) GenerateFileKey(entityType, workspaceID, fileExt string) (string, error) {
 	args := m.Called(entityType, workspaceID, fileExt)
 	return args.String(0), args.Error(1)
@@ -341,6 +350,11 @@ func TestCleanupJob_ExtractFileKeyFromURL(t *testing.T) {
 			fileURL:  "https://bucket.s3.region.amazonaws.com/board/comments/workspace2/2024/12/test.pdf",
 			expected: "board/comments/workspace2/2024/12/test.pdf",
 		},
+		{
+			name:     "S3 key stored by presigned upload",
+			fileURL:  "board/boards/workspace1/2024/01/file.jpg",
+			expected: "board/boards/workspace1/2024/01/file.jpg",
+		},
 		{
 			name:     "Invalid URL format",
 			fileURL:  "invalid-url",
@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DueReminderSender sends reminders for boards whose due date is approaching
+type DueReminderSender interface {
+	SendDueReminders(ctx context.Context, now time.Time, windows []time.Duration) (int, error)
+}
+
+// DueReminderJob sends BOARD_DUE_SOON notifications before board due dates (boards.due_date)
+type DueReminderJob struct {
+	sender  DueReminderSender
+	windows []time.Duration
+	logger  *zap.Logger
+}
+
+// NewDueReminderJob creates a new DueReminderJob instance that reminds once per board and window
+func NewDueReminderJob(sender DueReminderSender, windows []time.Duration, logger *zap.Logger) *DueReminderJob {
+	return &DueReminderJob{
+		sender:  sender,
+		windows: windows,
+		logger:  logger,
+	}
+}
+
+// Run executes the due reminder job
+// 이미 알림을 보낸 보드와 윈도우는 건너뜁니다 (여러 인스턴스에서 실행되어도 한 번만 발송)
+func (j *DueReminderJob) Run() {
+	ctx := context.Background()
+
+	reminded, err := j.sender.SendDueReminders(ctx, time.Now(), j.windows)
+	if err != nil {
+		j.logger.Error("Failed to send due reminders",
+			zap.Error(err),
+		)
+		return
+	}
+
+	if reminded > 0 {
+		j.logger.Info("Due reminders sent",
+			zap.Int("count", reminded),
+		)
+	}
+}
@@ -0,0 +1,47 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RecurringBoardCreator creates the copies of recurring boards that are due
+type RecurringBoardCreator interface {
+	CreateDueRecurringBoards(ctx context.Context, now time.Time) (int, error)
+}
+
+// RecurringBoardJob creates boards on their user-defined recurrence rule (boards.recurrence_rule)
+type RecurringBoardJob struct {
+	creator RecurringBoardCreator
+	logger  *zap.Logger
+}
+
+// NewRecurringBoardJob creates a new RecurringBoardJob instance
+func NewRecurringBoardJob(creator RecurringBoardCreator, logger *zap.Logger) *RecurringBoardJob {
+	return &RecurringBoardJob{
+		creator: creator,
+		logger:  logger,
+	}
+}
+
+// Run executes the recurring board job
+// 매 실행마다 다음 반복 시각이 지난 보드를 복제합니다 (여러 인스턴스에서 실행되어도 한 번만 생성)
+func (j *RecurringBoardJob) Run() {
+	ctx := context.Background()
+
+	created, err := j.creator.CreateDueRecurringBoards(ctx, time.Now())
+	if err != nil {
+		j.logger.Error("Failed to create recurring boards",
+			zap.Error(err),
+		)
+		return
+	}
+
+	if created > 0 {
+		j.logger.Info("Recurring boards created",
+			zap.Int("count", created),
+		)
+	}
+}
@@ -0,0 +1,120 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/thumbnail"
+)
+
+const (
+	// thumbnailBatchSize is the number of attachments processed per run
+	thumbnailBatchSize = 20
+	// maxThumbnailSourceBytes is the largest original image the job downloads
+	maxThumbnailSourceBytes = 25 * 1024 * 1024
+)
+
+// ThumbnailStorage reads original images and stores rendered thumbnails
+type ThumbnailStorage interface {
+	GetObject(ctx context.Context, key string, maxBytes int64) ([]byte, error)
+	UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
+}
+
+// ThumbnailJob renders small and medium thumbnails for confirmed image attachments
+type ThumbnailJob struct {
+	repo    repository.AttachmentThumbnailRepository
+	storage ThumbnailStorage
+	logger  *zap.Logger
+}
+
+// NewThumbnailJob creates a new ThumbnailJob instance
+func NewThumbnailJob(repo repository.AttachmentThumbnailRepository, storage ThumbnailStorage, logger *zap.Logger) *ThumbnailJob {
+	return &ThumbnailJob{
+		repo:    repo,
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Run executes the thumbnail job
+// 썸네일이 없는 CONFIRMED 이미지를 오래된 순으로 처리합니다. S3 오류는 다음 실행에서 다시 시도하고,
+// 디코딩할 수 없거나 원본이 없는 이미지는 FAILED로 표시해 다시 꺼내지 않습니다.
+// 여러 인스턴스가 같은 첨부파일을 처리해도 같은 key에 같은 결과를 쓰므로 안전합니다.
+func (j *ThumbnailJob) Run() {
+	ctx := context.Background()
+
+	attachments, err := j.repo.FindPending(ctx, thumbnail.SupportedContentTypes, thumbnailBatchSize)
+	if err != nil {
+		j.logger.Error("Failed to find attachments without thumbnails",
+			zap.Error(err),
+		)
+		return
+	}
+
+	generated, failed := 0, 0
+	for _, attachment := range attachments {
+		if err := j.generate(ctx, attachment); err != nil {
+			j.logger.Warn("Failed to generate thumbnails",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.String("file_key", attachment.FileURL),
+				zap.Error(err),
+			)
+			failed++
+			continue
+		}
+		generated++
+	}
+
+	if len(attachments) > 0 {
+		j.logger.Info("Thumbnail job completed",
+			zap.Int("generated", generated),
+			zap.Int("failed", failed),
+		)
+	}
+}
+
+// generate renders and uploads both thumbnail sizes, then records their keys on the attachment
+func (j *ThumbnailJob) generate(ctx context.Context, attachment *domain.Attachment) error {
+	data, err := j.storage.GetObject(ctx, attachment.FileURL, maxThumbnailSourceBytes)
+	if err != nil {
+		if errors.Is(err, client.ErrObjectNotFound) || errors.Is(err, client.ErrObjectTooLarge) {
+			return j.markFailed(ctx, attachment, err)
+		}
+		return err
+	}
+
+	img, err := thumbnail.Decode(data)
+	if err != nil {
+		return j.markFailed(ctx, attachment, err)
+	}
+
+	keys := make([]string, 0, 2)
+	for _, size := range []thumbnail.Size{thumbnail.Small, thumbnail.Medium} {
+		rendered, err := thumbnail.Render(img, size)
+		if err != nil {
+			return j.markFailed(ctx, attachment, err)
+		}
+		key := thumbnail.Key(attachment.FileURL, size)
+		if _, err := j.storage.UploadFile(ctx, key, bytes.NewReader(rendered), "image/jpeg"); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	return j.repo.SaveThumbnails(ctx, attachment.ID, keys[0], keys[1])
+}
+
+// markFailed records a permanent failure and returns the cause
+func (j *ThumbnailJob) markFailed(ctx context.Context, attachment *domain.Attachment, cause error) error {
+	if err := j.repo.MarkFailed(ctx, attachment.ID); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
@@ -0,0 +1,95 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+)
+
+// fakeThumbnailRepository records the results the thumbnail job writes back
+type fakeThumbnailRepository struct {
+	pending []*domain.Attachment
+	saved   map[uuid.UUID][2]string
+	failed  map[uuid.UUID]bool
+}
+
+func (r *fakeThumbnailRepository) FindPending(ctx context.Context, contentTypes []string, limit int) ([]*domain.Attachment, error) {
+	return r.pending, nil
+}
+
+func (r *fakeThumbnailRepository) SaveThumbnails(ctx context.Context, id uuid.UUID, smallKey, mediumKey string) error {
+	r.saved[id] = [2]string{smallKey, mediumKey}
+	return nil
+}
+
+func (r *fakeThumbnailRepository) MarkFailed(ctx context.Context, id uuid.UUID) error {
+	r.failed[id] = true
+	return nil
+}
+
+// fakeThumbnailStorage serves objects from memory and keeps uploaded thumbnails
+type fakeThumbnailStorage struct {
+	objects  map[string][]byte
+	uploaded map[string]string // key → content type
+}
+
+func (s *fakeThumbnailStorage) GetObject(ctx context.Context, key string, maxBytes int64) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, client.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+func (s *fakeThumbnailStorage) UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error) {
+	s.uploaded[key] = contentType
+	return key, nil
+}
+
+func TestThumbnailJob_Run(t *testing.T) {
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 1200, 600))); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+
+	photo := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "board/boards/ws/2024/01/photo.png", ContentType: "image/png"}
+	broken := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "board/boards/ws/2024/01/broken.jpg", ContentType: "image/jpeg"}
+	missing := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "board/boards/ws/2024/01/missing.gif", ContentType: "image/gif"}
+
+	repo := &fakeThumbnailRepository{
+		pending: []*domain.Attachment{photo, broken, missing},
+		saved:   map[uuid.UUID][2]string{},
+		failed:  map[uuid.UUID]bool{},
+	}
+	storage := &fakeThumbnailStorage{
+		objects: map[string][]byte{
+			photo.FileURL:  img.Bytes(),
+			broken.FileURL: []byte("not an image"),
+		},
+		uploaded: map[string]string{},
+	}
+
+	NewThumbnailJob(repo, storage, zap.NewNop()).Run()
+
+	want := [2]string{"board/thumbnails/small/boards/ws/2024/01/photo.jpg", "board/thumbnails/medium/boards/ws/2024/01/photo.jpg"}
+	if repo.saved[photo.ID] != want {
+		t.Errorf("saved keys = %v, want %v", repo.saved[photo.ID], want)
+	}
+	for _, key := range want {
+		if storage.uploaded[key] != "image/jpeg" {
+			t.Errorf("thumbnail %s not uploaded as JPEG: %v", key, storage.uploaded)
+		}
+	}
+	if !repo.failed[broken.ID] || !repo.failed[missing.ID] || repo.failed[photo.ID] {
+		t.Errorf("failed = %v, want the undecodable and missing images only", repo.failed)
+	}
+}
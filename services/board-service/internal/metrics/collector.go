@@ -44,9 +44,9 @@ func (c *BusinessMetricsCollector) Collect(ctx context.Context) error {
 		c.metrics.SetProjectsTotal(projectCount)
 	}
 
-	// 보드 수 조회
+	// 보드 수 조회 (휴지통의 보드 제외)
 	var boardCount int64
-	if err := c.db.WithContext(ctx).Table("boards").Count(&boardCount).Error; err != nil {
+	if err := c.db.WithContext(ctx).Table("boards").Where("deleted_at IS NULL").Count(&boardCount).Error; err != nil {
 		if c.logger != nil {
 			c.logger.Error("보드 수 조회 실패", zap.Error(err))
 		}
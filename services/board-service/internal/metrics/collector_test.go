@@ -33,6 +33,7 @@ type testBoard struct {
 	Title     string `gorm:"type:varchar(255)"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
 }
 
 func (testBoard) TableName() string {
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"project-board-api/internal/response"
+)
+
+// InternalAPIKeyHeader는 서비스 간 내부 API 키 헤더입니다 (noti-service와 같은 헤더).
+const InternalAPIKeyHeader = "x-internal-api-key"
+
+// InternalAuth는 내부 라우트의 x-internal-api-key 헤더를 검증하는 미들웨어입니다.
+// apiKey가 비어 있으면 모든 요청을 거부합니다.
+func InternalAuth(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided := c.GetHeader(InternalAPIKeyHeader)
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid internal API key")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,109 @@
+// Package position generates fractional-index keys that order kanban cards.
+// 키는 [0-9a-z] 문자로만 구성되므로 DB 콜레이션과 관계없이 문자열 비교 순서가 곧 카드 순서입니다.
+// 두 카드 사이에 새 키를 만들 수 있어 카드 하나를 옮길 때 다른 카드를 다시 쓰지 않아도 됩니다.
+package position
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// digits is the key alphabet in ascending order
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var (
+	// ErrInvalidKey is returned when a bound contains characters outside the alphabet or ends in '0'
+	ErrInvalidKey = errors.New("position: invalid key")
+	// ErrInvalidRange is returned when the lower bound is not less than the upper bound
+	ErrInvalidRange = errors.New("position: lower bound must be less than upper bound")
+)
+
+// Between returns a key strictly between lower and upper.
+// An empty lower means "before every key" and an empty upper means "after every key".
+func Between(lower, upper string) (string, error) {
+	if !valid(lower) || !valid(upper) {
+		return "", ErrInvalidKey
+	}
+	if upper != "" && lower >= upper {
+		return "", ErrInvalidRange
+	}
+	return midpoint(lower, upper), nil
+}
+
+// Sequence returns n ascending keys greater than after, for assigning positions in bulk.
+// 모든 키의 길이가 같으므로 많은 카드에 순서를 매겨도 키가 길어지지 않습니다.
+func Sequence(after string, n int) []string {
+	prefix := ""
+	if after != "" {
+		prefix = midpoint(after, "")
+	}
+	width := 1
+	for capacity := len(digits); capacity <= n; capacity *= len(digits) {
+		width++
+	}
+
+	keys := make([]string, n)
+	for i := range keys {
+		counter := strconv.FormatInt(int64(i+1), len(digits))
+		// 끝자리가 '0'이 되지 않도록 중간 문자를 붙임
+		keys[i] = prefix + strings.Repeat("0", width-len(counter)) + counter + "i"
+	}
+	return keys
+}
+
+// valid reports whether key only uses the alphabet and does not end in '0'
+// (a trailing '0' would leave no room for a key right before it)
+func valid(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+	return key == "" || key[len(key)-1] != '0'
+}
+
+// midpoint returns a key between a and b, where b == "" has no upper bound. Requires a < b.
+func midpoint(a, b string) string {
+	if b != "" {
+		// 공통 접두사는 그대로 두고 나머지 자리에서 중간값을 찾음 (a의 빈 자리는 '0'으로 간주)
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(digits, a[0])
+	}
+	hi := len(digits)
+	if b != "" {
+		hi = strings.IndexByte(digits, b[0])
+	}
+	if hi-lo > 1 {
+		return string(digits[(lo+hi+1)/2])
+	}
+	// 첫 자리가 인접한 경우: b가 더 길면 b의 첫 자리만으로 충분히 작음
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[lo]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return '0'
+}
+
+func suffix(key string, i int) string {
+	if i >= len(key) {
+		return ""
+	}
+	return key[i:]
+}
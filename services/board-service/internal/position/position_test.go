@@ -0,0 +1,90 @@
+package position
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name         string
+		lower, upper string
+	}{
+		{"빈 컬럼", "", ""},
+		{"맨 앞", "", "i"},
+		{"맨 뒤", "i", ""},
+		{"인접한 첫 자리", "a", "b"},
+		{"접두사 관계", "1", "12"},
+		{"하한이 더 긴 키", "az", "b"},
+		{"상한이 '0'으로 시작", "", "01"},
+		{"마지막 자리 경계", "zz", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.lower, tt.upper)
+			if err != nil {
+				t.Fatalf("Between(%q, %q) error = %v", tt.lower, tt.upper, err)
+			}
+			if got <= tt.lower || (tt.upper != "" && got >= tt.upper) || !valid(got) {
+				t.Errorf("Between(%q, %q) = %q, not strictly between", tt.lower, tt.upper, got)
+			}
+		})
+	}
+}
+
+func TestBetween_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		lower, upper string
+		want         error
+	}{
+		{"역순", "b", "a", ErrInvalidRange},
+		{"같은 키", "a", "a", ErrInvalidRange},
+		{"허용되지 않는 문자", "A", "", ErrInvalidKey},
+		{"'0'으로 끝나는 키", "", "10", ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Between(tt.lower, tt.upper); !errors.Is(err, tt.want) {
+				t.Errorf("Between(%q, %q) error = %v, want %v", tt.lower, tt.upper, err, tt.want)
+			}
+		})
+	}
+}
+
+// 같은 자리에 카드를 반복해서 끼워 넣어도 순서가 유지되어야 함
+func TestBetween_RepeatedInsert(t *testing.T) {
+	lower, upper := "a", "b"
+	for i := 0; i < 200; i++ {
+		key, err := Between(lower, upper)
+		if err != nil {
+			t.Fatalf("iteration %d: %v", i, err)
+		}
+		if key <= lower || key >= upper {
+			t.Fatalf("iteration %d: %q not between %q and %q", i, key, lower, upper)
+		}
+		if i%2 == 0 {
+			upper = key
+		} else {
+			lower = key
+		}
+	}
+}
+
+func TestSequence(t *testing.T) {
+	for _, after := range []string{"", "i", "zzi"} {
+		keys := Sequence(after, 1500)
+		prev := after
+		for i, key := range keys {
+			if key <= prev || !valid(key) {
+				t.Fatalf("Sequence(%q)[%d] = %q, not greater than %q", after, i, key, prev)
+			}
+			if len(key) != len(keys[0]) {
+				t.Fatalf("Sequence(%q)[%d] = %q has a different length than %q", after, i, key, keys[0])
+			}
+			prev = key
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// ActivityLogRepository defines the interface for board activity history data access
+type ActivityLogRepository interface {
+	// CreateBatch appends activity entries within the current transaction
+	CreateBatch(ctx context.Context, logs []*domain.ActivityLog) error
+	// FindPageByBoardID finds up to limit entries of a board older than cursor, newest first
+	FindPageByBoardID(ctx context.Context, boardID uuid.UUID, cursor *BoardCursor, limit int) ([]*domain.ActivityLog, error)
+}
+
+// activityLogRepositoryImpl is the GORM implementation of ActivityLogRepository
+type activityLogRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewActivityLogRepository creates a new instance of ActivityLogRepository
+func NewActivityLogRepository(db *gorm.DB) ActivityLogRepository {
+	return &activityLogRepositoryImpl{db: db}
+}
+
+// CreateBatch inserts activity entries, so the history commits or rolls back with the board change
+func (r *activityLogRepositoryImpl) CreateBatch(ctx context.Context, logs []*domain.ActivityLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Create(&logs).Error
+}
+
+// FindPageByBoardID finds a page of a board's history in (created_at, id) descending order
+func (r *activityLogRepositoryImpl) FindPageByBoardID(ctx context.Context, boardID uuid.UUID, cursor *BoardCursor, limit int) ([]*domain.ActivityLog, error) {
+	var logs []*domain.ActivityLog
+	query := r.db.WithContext(ctx).Where("board_id = ?", boardID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
 	"project-board-api/internal/domain"
 )
 
@@ -36,7 +37,7 @@ func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
 
 // Create creates a new attachment
 func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *domain.Attachment) error {
-	if err := r.db.WithContext(ctx).Create(attachment).Error; err != nil {
+	if err := uow.DB(ctx, r.db).Create(attachment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -45,7 +46,7 @@ func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *domai
 // FindByID finds an attachment by its ID
 func (r *attachmentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
 	var attachment domain.Attachment
-	if err := r.db.WithContext(ctx).First(&attachment, id).Error; err != nil {
+	if err := uow.DB(ctx, r.db).First(&attachment, id).Error; err != nil {
 		return nil, err
 	}
 	return &attachment, nil
@@ -54,7 +55,7 @@ func (r *attachmentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (
 // FindByEntityID finds all attachments by entity type and entity ID
 func (r *attachmentRepositoryImpl) FindByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
 		Order("created_at DESC").
 		Find(&attachments).Error; err != nil {
@@ -70,7 +71,7 @@ func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUI
 	}
 
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("id IN ?", ids).
 		Find(&attachments).Error; err != nil {
 		return nil, err
@@ -80,7 +81,7 @@ func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUI
 
 // Delete soft deletes an attachment by ID
 func (r *attachmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Attachment{}, id).Error; err != nil {
+	if err := uow.DB(ctx, r.db).Delete(&domain.Attachment{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -89,7 +90,7 @@ func (r *attachmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) err
 // FindExpiredTempAttachments finds all temporary attachments that have exceeded their expiration time
 func (r *attachmentRepositoryImpl) FindExpiredTempAttachments(ctx context.Context) ([]*domain.Attachment, error) {
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("status = ? AND expires_at < ?", domain.AttachmentStatusTemp, time.Now()).
 		Find(&attachments).Error; err != nil {
 		return nil, err
@@ -103,7 +104,7 @@ func (r *attachmentRepositoryImpl) ConfirmAttachments(ctx context.Context, attac
 	}
 
 	// ✅ TEMP 상태만 업데이트, 결과 검증
-	result := r.db.WithContext(ctx).
+	result := uow.DB(ctx, r.db).
 		Model(&domain.Attachment{}).
 		Where("id IN ? AND status = ?", attachmentIDs, domain.AttachmentStatusTemp). // ✅
 		Updates(map[string]interface{}{
@@ -135,7 +136,7 @@ func (r *attachmentRepositoryImpl) DeleteBatch(ctx context.Context, attachmentID
 		return nil
 	}
 
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("id IN ?", attachmentIDs).
 		Delete(&domain.Attachment{}).Error; err != nil {
 		return err
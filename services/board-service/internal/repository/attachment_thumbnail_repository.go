@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// AttachmentThumbnailRepository defines the queue queries of the thumbnail job over the attachments table
+type AttachmentThumbnailRepository interface {
+	// FindPending returns up to limit confirmed attachments of the given content types without thumbnails, oldest first
+	FindPending(ctx context.Context, contentTypes []string, limit int) ([]*domain.Attachment, error)
+	// SaveThumbnails stores the thumbnail keys and marks the attachment READY
+	SaveThumbnails(ctx context.Context, id uuid.UUID, smallKey, mediumKey string) error
+	// MarkFailed marks an attachment whose image cannot be decoded so it is not picked up again
+	MarkFailed(ctx context.Context, id uuid.UUID) error
+}
+
+// attachmentThumbnailRepositoryImpl is the GORM implementation of AttachmentThumbnailRepository
+type attachmentThumbnailRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAttachmentThumbnailRepository creates a new instance of AttachmentThumbnailRepository
+func NewAttachmentThumbnailRepository(db *gorm.DB) AttachmentThumbnailRepository {
+	return &attachmentThumbnailRepositoryImpl{db: db}
+}
+
+// FindPending loads the next batch of the thumbnail queue (thumbnail_status가 비어 있는 CONFIRMED 이미지)
+func (r *attachmentThumbnailRepositoryImpl) FindPending(ctx context.Context, contentTypes []string, limit int) ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND thumbnail_status = ? AND content_type IN ?",
+			domain.AttachmentStatusConfirmed, domain.ThumbnailStatusNone, contentTypes).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&attachments).Error
+	return attachments, err
+}
+
+// SaveThumbnails updates only the thumbnail columns of the attachment
+func (r *attachmentThumbnailRepositoryImpl) SaveThumbnails(ctx context.Context, id uuid.UUID, smallKey, mediumKey string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Attachment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"thumbnail_key":        smallKey,
+			"medium_thumbnail_key": mediumKey,
+			"thumbnail_status":     domain.ThumbnailStatusReady,
+		}).Error
+}
+
+// MarkFailed sets thumbnail_status to FAILED
+func (r *attachmentThumbnailRepositoryImpl) MarkFailed(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Attachment{}).
+		Where("id = ?", id).
+		Update("thumbnail_status", domain.ThumbnailStatusFailed).Error
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// backupBatchSize is the number of rows inserted per statement during restore
+const backupBatchSize = 500
+
+// WorkspaceSnapshot holds every live board-service row of one workspace
+type WorkspaceSnapshot struct {
+	Projects       []domain.Project
+	ProjectMembers []domain.ProjectMember
+	FieldOptions   []domain.FieldOption
+	Boards         []domain.Board
+	Participants   []domain.Participant
+	Comments       []domain.Comment
+}
+
+// RestoreCounts is the number of rows inserted per table by a restore
+type RestoreCounts struct {
+	Projects       int64
+	ProjectMembers int64
+	FieldOptions   int64
+	Boards         int64
+	Participants   int64
+	Comments       int64
+}
+
+// BackupRepository defines the data access for workspace export and import
+type BackupRepository interface {
+	LoadWorkspace(ctx context.Context, workspaceID uuid.UUID) (*WorkspaceSnapshot, error)
+	// RestoreWorkspace inserts the snapshot in one transaction, skipping rows whose ID already exists
+	RestoreWorkspace(ctx context.Context, snapshot *WorkspaceSnapshot) (*RestoreCounts, error)
+}
+
+// backupRepositoryImpl is the GORM implementation of BackupRepository
+type backupRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBackupRepository creates a new instance of BackupRepository
+func NewBackupRepository(db *gorm.DB) BackupRepository {
+	return &backupRepositoryImpl{db: db}
+}
+
+// LoadWorkspace loads the workspace's projects and everything below them, excluding soft-deleted rows
+func (r *backupRepositoryImpl) LoadWorkspace(ctx context.Context, workspaceID uuid.UUID) (*WorkspaceSnapshot, error) {
+	db := r.db.WithContext(ctx)
+	projectIDs := db.Model(&domain.Project{}).Select("id").
+		Where("workspace_id = ? AND deleted_at IS NULL", workspaceID)
+	boardIDs := db.Model(&domain.Board{}).Select("id").
+		Where("project_id IN (?) AND deleted_at IS NULL", projectIDs)
+
+	snapshot := &WorkspaceSnapshot{}
+	queries := []struct {
+		dest  interface{}
+		query *gorm.DB
+	}{
+		{&snapshot.Projects, db.Where("workspace_id = ? AND deleted_at IS NULL", workspaceID)},
+		{&snapshot.ProjectMembers, db.Where("project_id IN (?)", projectIDs)},
+		{&snapshot.FieldOptions, db.Where("project_id IN (?) AND is_system_default = false AND deleted_at IS NULL", projectIDs)},
+		{&snapshot.Boards, db.Where("project_id IN (?) AND deleted_at IS NULL", projectIDs)},
+		{&snapshot.Participants, db.Where("board_id IN (?) AND deleted_at IS NULL", boardIDs)},
+		{&snapshot.Comments, db.Where("board_id IN (?) AND deleted_at IS NULL", boardIDs)},
+	}
+	for _, q := range queries {
+		if err := q.query.Order("id").Find(q.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// RestoreWorkspace inserts parents before children so foreign keys are satisfied
+func (r *backupRepositoryImpl) RestoreWorkspace(ctx context.Context, snapshot *WorkspaceSnapshot) (*RestoreCounts, error) {
+	counts := &RestoreCounts{}
+	err := uow.Transaction(ctx, r.db, func(ctx context.Context) error {
+		inserts := []struct {
+			rows  interface{}
+			empty bool
+			count *int64
+		}{
+			{&snapshot.Projects, len(snapshot.Projects) == 0, &counts.Projects},
+			{&snapshot.ProjectMembers, len(snapshot.ProjectMembers) == 0, &counts.ProjectMembers},
+			{&snapshot.FieldOptions, len(snapshot.FieldOptions) == 0, &counts.FieldOptions},
+			{&snapshot.Boards, len(snapshot.Boards) == 0, &counts.Boards},
+			{&snapshot.Participants, len(snapshot.Participants) == 0, &counts.Participants},
+			{&snapshot.Comments, len(snapshot.Comments) == 0, &counts.Comments},
+		}
+		for _, insert := range inserts {
+			if insert.empty {
+				continue
+			}
+			result := uow.DB(ctx, r.db).
+				Omit(clause.Associations).
+				Clauses(clause.OnConflict{DoNothing: true}).
+				CreateInBatches(insert.rows, backupBatchSize)
+			if result.Error != nil {
+				return result.Error
+			}
+			*insert.count = result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// BoardLinkRepository defines the interface for board link data access
+type BoardLinkRepository interface {
+	Create(ctx context.Context, link *domain.BoardLink) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardLink, error)
+	// FindByBoardID finds the links from or to a board, oldest link first
+	FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error)
+	// FindBlocksByProjectID locks the project row for the rest of the transaction and returns the project's BLOCKS links
+	FindBlocksByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardLink, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// boardLinkRepositoryImpl is the GORM implementation of BoardLinkRepository
+type boardLinkRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBoardLinkRepository creates a new instance of BoardLinkRepository
+func NewBoardLinkRepository(db *gorm.DB) BoardLinkRepository {
+	return &boardLinkRepositoryImpl{db: db}
+}
+
+// Create creates a new board link
+func (r *boardLinkRepositoryImpl) Create(ctx context.Context, link *domain.BoardLink) error {
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Create(link).Error
+}
+
+// FindByID finds a board link by ID
+func (r *boardLinkRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardLink, error) {
+	var link domain.BoardLink
+	if err := uow.DB(ctx, r.db).Where("id = ?", id).First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// FindByBoardID finds the links where the board is the source or the target
+func (r *boardLinkRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error) {
+	var links []*domain.BoardLink
+	if err := uow.DB(ctx, r.db).
+		Where("source_board_id = ? OR target_board_id = ?", boardID, boardID).
+		Order("created_at ASC").
+		Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// FindBlocksByProjectID returns every BLOCKS link of a project within the current transaction.
+// 프로젝트 행을 FOR UPDATE로 잠가 같은 프로젝트에 동시에 추가되는 링크가 순환 검사를 우회하지 못하게 합니다.
+func (r *boardLinkRepositoryImpl) FindBlocksByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardLink, error) {
+	db := uow.DB(ctx, r.db)
+	var locked []uuid.UUID
+	if err := db.Model(&domain.Project{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", projectID).
+		Pluck("id", &locked).Error; err != nil {
+		return nil, err
+	}
+
+	var links []*domain.BoardLink
+	if err := db.
+		Where("project_id = ? AND type = ?", projectID, domain.BoardLinkTypeBlocks).
+		Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// Delete deletes a board link
+func (r *boardLinkRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return uow.DB(ctx, r.db).Delete(&domain.BoardLink{}, "id = ?", id).Error
+}
@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
 	"project-board-api/internal/domain"
 )
 
@@ -15,8 +18,46 @@ type BoardRepository interface {
 	Create(ctx context.Context, board *domain.Board) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Board, error)
 	FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	FindPageByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *BoardCursor, limit int) ([]*domain.Board, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error)
+	FindByIDsWithParticipants(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error)
+	// FindColumnOrder returns the IDs of the boards whose fieldName is optionID, in kanban position order
+	FindColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error)
+	// MaxPosition locks the project row for the rest of the transaction and returns the greatest position
+	// in the project ("" when it has no boards), so concurrent creates and moves get distinct positions
+	MaxPosition(ctx context.Context, projectID uuid.UUID) (string, error)
+	// FindDueRecurrences returns up to limit recurring boards whose next occurrence is at or before now
+	FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*domain.Board, error)
+	// ClaimRecurrence moves the board's next occurrence from due to next, reporting false when
+	// another instance already claimed it
+	ClaimRecurrence(ctx context.Context, boardID uuid.UUID, due, next time.Time) (bool, error)
+	// FindDueSoon returns up to limit boards due after from and at or before to that have no reminder
+	// for windowMinutes yet, with participants and project
+	FindDueSoon(ctx context.Context, from, to time.Time, windowMinutes, limit int) ([]*domain.Board, error)
+	// ClaimDueReminder records a due reminder, reporting false when it was already sent
+	ClaimDueReminder(ctx context.Context, reminder *domain.BoardDueReminder) (bool, error)
 	Update(ctx context.Context, board *domain.Board) error
+	// UpdateVersioned saves the board only if its stored version is still version, reporting false when
+	// another write got there first
+	UpdateVersioned(ctx context.Context, board *domain.Board, version int) (bool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// FindDeleted returns the project's boards in the trash, most recently deleted first
+	FindDeleted(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	// FindDeletedByID returns a board in the trash (gorm.ErrRecordNotFound when it is not there)
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	// Restore takes the board out of the trash, reporting false when it was already restored or purged
+	Restore(ctx context.Context, board *domain.Board) (bool, error)
+	// FindDeletedBefore returns up to limit boards deleted at or before cutoff, oldest first
+	FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Board, error)
+	// Purge permanently deletes a board still in the trash, reporting false when it was restored meanwhile.
+	// Its comments and participants go with it (ON DELETE CASCADE)
+	Purge(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// BoardCursor is the (created_at, id) of the last row of a page (board lists and board activity history)
+type BoardCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
 }
 
 // boardRepositoryImpl is the GORM implementation of BoardRepository
@@ -31,7 +72,7 @@ func NewBoardRepository(db *gorm.DB) BoardRepository {
 
 // Create creates a new board
 func (r *boardRepositoryImpl) Create(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Create(board).Error; err != nil {
+	if err := uow.DB(ctx, r.db).Create(board).Error; err != nil {
 		return err
 	}
 	return nil
@@ -55,7 +96,38 @@ func (r *boardRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*doma
 	return &board, nil
 }
 
-// FindByProjectID finds all boards by project ID with optional filters
+// FindByIDs finds boards by IDs without preloading relations (internal batch lookups)
+// Missing or soft-deleted boards are omitted from the result
+func (r *boardRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if len(ids) == 0 {
+		return boards, nil
+	}
+	if err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindByIDsWithParticipants finds boards by IDs with preloaded participants (bulk operations)
+// Missing or soft-deleted boards are omitted from the result
+func (r *boardRepositoryImpl) FindByIDsWithParticipants(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if len(ids) == 0 {
+		return boards, nil
+	}
+	if err := uow.DB(ctx, r.db).
+		Preload("Participants").
+		Where("id IN ?", ids).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindByProjectID finds all active (not archived) boards by project ID with optional filters
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
 	var boards []*domain.Board
@@ -64,7 +136,8 @@ func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uui
 	query := r.db.WithContext(ctx).
 		Preload("Participants").
 		// Preload("Attachments"). // ✅ 제거
-		Where("project_id = ?", projectID)
+		Where("project_id = ?", projectID).
+		Scopes(archiveScope(domain.ArchiveStateActive))
 
 	// Apply filters if provided
 	if filters != nil {
@@ -86,18 +159,170 @@ func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uui
 	return boards, nil
 }
 
-// Update updates a board
+// FindPageByProjectID finds up to limit boards of a project in the given archive state after cursor, oldest first.
+// (created_at, id) 커서 기반이므로 페이지 조회 중 보드가 추가/삭제되어도 중복이 없습니다.
+func (r *boardRepositoryImpl) FindPageByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *BoardCursor, limit int) ([]*domain.Board, error) {
+	var boards []*domain.Board
+
+	query := r.db.WithContext(ctx).
+		Preload("Participants").
+		Where("project_id = ?", projectID).
+		Scopes(archiveScope(state))
+
+	if customFields, ok := filters.(map[string]interface{}); ok {
+		for key, value := range customFields {
+			query = query.Where("custom_fields->>? = ?", key, value)
+		}
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindColumnOrder finds the active board IDs of one kanban column ordered by position
+// 위치가 같은 경우(동시 이동 등)에도 순서가 고정되도록 id로 한 번 더 정렬합니다.
+func (r *boardRepositoryImpl) FindColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Board{}).
+		Where("project_id = ? AND custom_fields->>? = ?", projectID, fieldName, optionID).
+		Scopes(archiveScope(domain.ArchiveStateActive)).
+		Order("position ASC, id ASC").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// MaxPosition finds the greatest board position of a project within the current transaction
+// 프로젝트 행을 FOR UPDATE로 잠가 같은 프로젝트의 위치 계산을 직렬화합니다.
+func (r *boardRepositoryImpl) MaxPosition(ctx context.Context, projectID uuid.UUID) (string, error) {
+	db := uow.DB(ctx, r.db)
+	var locked []uuid.UUID
+	if err := db.Model(&domain.Project{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", projectID).
+		Pluck("id", &locked).Error; err != nil {
+		return "", err
+	}
+
+	var last string
+	if err := db.Model(&domain.Board{}).
+		Where("project_id = ?", projectID).
+		Select("COALESCE(MAX(position), '')").
+		Scan(&last).Error; err != nil {
+		return "", err
+	}
+	return last, nil
+}
+
+// FindDueRecurrences finds recurring boards whose next occurrence is due, oldest first, with participants
+// 보관된 보드는 반복 생성하지 않습니다.
+func (r *boardRepositoryImpl) FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if err := r.db.WithContext(ctx).
+		Preload("Participants").
+		Where("recurrence_rule <> '' AND next_recurrence_at <= ?", now).
+		Scopes(archiveScope(domain.ArchiveStateActive)).
+		Order("next_recurrence_at ASC, id ASC").
+		Limit(limit).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// ClaimRecurrence advances next_recurrence_at only if it still equals due.
+// 여러 인스턴스가 같은 스케줄을 실행해도 한 인스턴스만 반복 보드를 생성합니다.
+func (r *boardRepositoryImpl) ClaimRecurrence(ctx context.Context, boardID uuid.UUID, due, next time.Time) (bool, error) {
+	result := uow.DB(ctx, r.db).
+		Model(&domain.Board{}).
+		Where("id = ? AND next_recurrence_at = ?", boardID, due).
+		Update("next_recurrence_at", next)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// FindDueSoon finds boards whose due date falls in (from, to], soonest first, skipping boards already
+// reminded for the window at their current due date. 보관된 보드는 알리지 않습니다.
+func (r *boardRepositoryImpl) FindDueSoon(ctx context.Context, from, to time.Time, windowMinutes, limit int) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if err := r.db.WithContext(ctx).
+		Preload("Participants").
+		Preload("Project").
+		Where("due_date > ? AND due_date <= ?", from, to).
+		Where("NOT EXISTS (SELECT 1 FROM board_due_reminders WHERE board_due_reminders.board_id = boards.id AND board_due_reminders.window_minutes = ? AND board_due_reminders.due_date = boards.due_date)", windowMinutes).
+		Scopes(archiveScope(domain.ArchiveStateActive)).
+		Order("due_date ASC, id ASC").
+		Limit(limit).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// ClaimDueReminder inserts the reminder row unless it already exists.
+// 여러 인스턴스가 같은 스케줄을 실행해도 한 인스턴스만 알림을 보냅니다.
+func (r *boardRepositoryImpl) ClaimDueReminder(ctx context.Context, reminder *domain.BoardDueReminder) (bool, error) {
+	result := uow.DB(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Update updates a board and bumps its version
 func (r *boardRepositoryImpl) Update(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Save(board).Error; err != nil {
+	board.Version++
+	if err := uow.DB(ctx, r.db).Save(board).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
+// UpdateVersioned updates a board with a compare-and-set on its version.
+// 저장된 버전이 version과 다르면 아무것도 쓰지 않고 false를 반환합니다.
+func (r *boardRepositoryImpl) UpdateVersioned(ctx context.Context, board *domain.Board, version int) (bool, error) {
+	board.Version = version + 1
+	result := uow.DB(ctx, r.db).
+		Model(board).
+		Where("version = ?", version).
+		Select("*").
+		Omit(clause.Associations).
+		Updates(board)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
 // Delete soft deletes a board
 func (r *boardRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Board{}, id).Error; err != nil {
+	if err := uow.DB(ctx, r.db).Delete(&domain.Board{}, id).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// archiveScope limits a board or project query to the given archive state (ALL은 제한 없음)
+func archiveScope(state domain.ArchiveState) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch state {
+		case domain.ArchiveStateArchived:
+			return db.Where("archived_at IS NOT NULL")
+		case domain.ArchiveStateAll:
+			return db
+		default:
+			return db.Where("archived_at IS NULL")
+		}
+	}
+}
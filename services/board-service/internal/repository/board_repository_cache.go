@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// cachedBoardRepository caches the card order of kanban columns (FindColumnOrder) in Redis sorted sets
+// and delegates every other call to the wrapped repository
+type cachedBoardRepository struct {
+	BoardRepository
+	client *redis.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewCachedBoardRepository wraps repo so that FindColumnOrder goes through Redis.
+// boards.position이 원본이고 Redis는 캐시이므로, 키가 없으면(만료, flush) DB에서 다시 채웁니다.
+// Create, Update and Delete drop every cached column of the board's project once the transaction commits.
+func NewCachedBoardRepository(repo BoardRepository, client *redis.Client, ttl time.Duration, logger *zap.Logger) BoardRepository {
+	return &cachedBoardRepository{BoardRepository: repo, client: client, ttl: ttl, logger: logger}
+}
+
+// columnOrderKey is the sorted set of one column; the score is the card's rank in the column
+func columnOrderKey(projectID uuid.UUID, fieldName, optionID string) string {
+	return fmt.Sprintf("kanban:project:%s:group:%s:%s", projectID, fieldName, optionID)
+}
+
+// projectColumnsKey is the set of the project's cached column keys, used for invalidation
+func projectColumnsKey(projectID uuid.UUID) string {
+	return fmt.Sprintf("kanban:project:%s:columns", projectID)
+}
+
+// FindColumnOrder finds the board IDs of one kanban column through the cache
+func (r *cachedBoardRepository) FindColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error) {
+	key := columnOrderKey(projectID, fieldName, optionID)
+	members, err := r.client.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		r.logger.Warn("Failed to read column order cache", zap.String("key", key), zap.Error(err))
+	} else if len(members) > 0 {
+		if ids, err := parseUUIDs(members); err == nil {
+			return ids, nil
+		}
+	}
+
+	ids, err := r.BoardRepository.FindColumnOrder(ctx, projectID, fieldName, optionID)
+	if err != nil || len(ids) == 0 {
+		return ids, err
+	}
+
+	// 캐시 재구성: 기존 키를 지우고 DB 순서대로 다시 채움
+	entries := make([]redis.Z, len(ids))
+	for i, id := range ids {
+		entries[i] = redis.Z{Score: float64(i), Member: id.String()}
+	}
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZAdd(ctx, key, entries...)
+	pipe.Expire(ctx, key, r.ttl)
+	pipe.SAdd(ctx, projectColumnsKey(projectID), key)
+	pipe.Expire(ctx, projectColumnsKey(projectID), r.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Warn("Failed to rebuild column order cache", zap.String("key", key), zap.Error(err))
+	}
+	return ids, nil
+}
+
+// Create creates a board and invalidates its project's column order
+func (r *cachedBoardRepository) Create(ctx context.Context, board *domain.Board) error {
+	if err := r.BoardRepository.Create(ctx, board); err != nil {
+		return err
+	}
+	r.invalidateProject(ctx, board.ProjectID)
+	return nil
+}
+
+// Update updates a board and invalidates its project's column order
+func (r *cachedBoardRepository) Update(ctx context.Context, board *domain.Board) error {
+	if err := r.BoardRepository.Update(ctx, board); err != nil {
+		return err
+	}
+	r.invalidateProject(ctx, board.ProjectID)
+	return nil
+}
+
+// Delete soft deletes a board and invalidates its project's column order
+func (r *cachedBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// 삭제 후에는 프로젝트를 알 수 없으므로 먼저 조회
+	boards, err := r.BoardRepository.FindByIDs(ctx, []uuid.UUID{id})
+	if err != nil {
+		return err
+	}
+	if err := r.BoardRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	for _, board := range boards {
+		r.invalidateProject(ctx, board.ProjectID)
+	}
+	return nil
+}
+
+// Restore takes a board out of the trash and invalidates its project's column order
+func (r *cachedBoardRepository) Restore(ctx context.Context, board *domain.Board) (bool, error) {
+	restored, err := r.BoardRepository.Restore(ctx, board)
+	if err != nil || !restored {
+		return restored, err
+	}
+	r.invalidateProject(ctx, board.ProjectID)
+	return true, nil
+}
+
+// invalidateProject drops every cached column of the project after the current transaction commits
+// (롤백되면 캐시는 그대로 유효하고, 커밋 전에 지우면 다른 요청이 이전 순서로 다시 채울 수 있음)
+func (r *cachedBoardRepository) invalidateProject(ctx context.Context, projectID uuid.UUID) {
+	uow.AfterCommit(ctx, func() {
+		ctx := context.WithoutCancel(ctx)
+		setKey := projectColumnsKey(projectID)
+		keys, err := r.client.SMembers(ctx, setKey).Result()
+		if err != nil {
+			r.logger.Warn("Failed to list cached columns", zap.String("project.id", projectID.String()), zap.Error(err))
+			return
+		}
+		if err := r.client.Del(ctx, append(keys, setKey)...).Err(); err != nil {
+			r.logger.Warn("Failed to invalidate column order cache", zap.String("project.id", projectID.String()), zap.Error(err))
+		}
+	})
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(values))
+	for i, v := range values {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,345 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+	"project-board-api/internal/database"
+	"project-board-api/internal/domain"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// setupPostgresProject migrates a fresh Postgres database and creates a project to hold boards
+func setupPostgresProject(t *testing.T) (*gorm.DB, *domain.Project) {
+	t.Helper()
+	db := integration.Postgres(t)
+	if err := database.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	project := &domain.Project{
+		WorkspaceID: uuid.New(),
+		OwnerID:     uuid.New(),
+		Name:        "Integration Project",
+	}
+	if err := NewProjectRepository(db).Create(context.Background(), project); err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	return db, project
+}
+
+func createBoard(t *testing.T, repo BoardRepository, project *domain.Project, title, customFields string) *domain.Board {
+	t.Helper()
+	board := &domain.Board{
+		ProjectID:    project.ID,
+		AuthorID:     project.OwnerID,
+		Title:        title,
+		CustomFields: datatypes.JSON(customFields),
+	}
+	if err := repo.Create(context.Background(), board); err != nil {
+		t.Fatalf("failed to create board %q: %v", title, err)
+	}
+	return board
+}
+
+func boardTitles(boards []*domain.Board) map[string]bool {
+	titles := make(map[string]bool, len(boards))
+	for _, b := range boards {
+		titles[b.Title] = true
+	}
+	return titles
+}
+
+func TestBoardRepository_FindByProjectID_JSONBFilter(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	createBoard(t, repo, project, "in progress / high", `{"stage":"in_progress","importance":"high"}`)
+	createBoard(t, repo, project, "in progress / low", `{"stage":"in_progress","importance":"low"}`)
+	createBoard(t, repo, project, "done / high", `{"stage":"done","importance":"high"}`)
+	createBoard(t, repo, project, "no fields", `{}`)
+
+	tests := []struct {
+		name    string
+		filters interface{}
+		want    []string
+	}{
+		{
+			name:    "no filter",
+			filters: nil,
+			want:    []string{"in progress / high", "in progress / low", "done / high", "no fields"},
+		},
+		{
+			name:    "single field",
+			filters: map[string]interface{}{"stage": "in_progress"},
+			want:    []string{"in progress / high", "in progress / low"},
+		},
+		{
+			name:    "fields are combined with AND",
+			filters: map[string]interface{}{"stage": "in_progress", "importance": "high"},
+			want:    []string{"in progress / high"},
+		},
+		{
+			name:    "no match",
+			filters: map[string]interface{}{"stage": "review"},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boards, err := repo.FindByProjectID(ctx, project.ID, tt.filters)
+			if err != nil {
+				t.Fatalf("FindByProjectID() error = %v", err)
+			}
+			got := boardTitles(boards)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FindByProjectID() returned %v, want %v", got, tt.want)
+			}
+			for _, title := range tt.want {
+				if !got[title] {
+					t.Errorf("FindByProjectID() missing %q, got %v", title, got)
+				}
+			}
+		})
+	}
+}
+
+func TestBoardRepository_Delete_CascadesParticipants(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	board := createBoard(t, repo, project, "to delete", `{}`)
+	kept := createBoard(t, repo, project, "to keep", `{}`)
+	for _, b := range []*domain.Board{board, kept} {
+		if err := db.Omit(clause.Associations).Create(&domain.Participant{BoardID: b.ID, UserID: uuid.New()}).Error; err != nil {
+			t.Fatalf("failed to create participant: %v", err)
+		}
+	}
+
+	if err := repo.Delete(ctx, board.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, board.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByID() after Delete error = %v, want ErrRecordNotFound", err)
+	}
+	boards, err := repo.FindByIDs(ctx, []uuid.UUID{board.ID, kept.ID})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != kept.ID {
+		t.Errorf("FindByIDs() = %v, want only the kept board", boardTitles(boards))
+	}
+
+	var orphaned int64
+	if err := db.Model(&domain.Participant{}).Where("board_id = ?", board.ID).Count(&orphaned).Error; err != nil {
+		t.Fatalf("failed to count participants: %v", err)
+	}
+	if orphaned != 0 {
+		t.Errorf("participants of deleted board = %d, want 0", orphaned)
+	}
+}
+
+func TestBoardRepository_FindColumnOrder(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	third := createBoard(t, repo, project, "third", `{"stage":"todo"}`)
+	first := createBoard(t, repo, project, "first", `{"stage":"todo"}`)
+	other := createBoard(t, repo, project, "other column", `{"stage":"done"}`)
+	second := createBoard(t, repo, project, "second", `{"stage":"todo"}`)
+	for board, pos := range map[*domain.Board]string{first: "a", second: "b", third: "c", other: "z"} {
+		if err := db.Model(board).UpdateColumn("position", pos).Error; err != nil {
+			t.Fatalf("failed to set position: %v", err)
+		}
+	}
+
+	ids, err := repo.FindColumnOrder(ctx, project.ID, "stage", "todo")
+	if err != nil {
+		t.Fatalf("FindColumnOrder() error = %v", err)
+	}
+	want := []uuid.UUID{first.ID, second.ID, third.ID}
+	if len(ids) != len(want) {
+		t.Fatalf("FindColumnOrder() = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("FindColumnOrder()[%d] = %s, want %s", i, ids[i], want[i])
+		}
+	}
+
+	last, err := repo.MaxPosition(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("MaxPosition() error = %v", err)
+	}
+	if last != "z" {
+		t.Errorf("MaxPosition() = %q, want %q", last, "z")
+	}
+}
+
+func TestActivityLogRepository_FindPageByBoardID(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	board := createBoard(t, NewBoardRepository(db), project, "with history", `{}`)
+	repo := NewActivityLogRepository(db)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	var logs []*domain.ActivityLog
+	for i, field := range []string{"title", "stage", "assignee"} {
+		logs = append(logs, &domain.ActivityLog{
+			BoardID: board.ID, ProjectID: project.ID, ActorID: project.OwnerID,
+			Action: domain.ActivityActionUpdated, Field: field, CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	if err := repo.CreateBatch(ctx, logs); err != nil {
+		t.Fatalf("CreateBatch() error = %v", err)
+	}
+
+	first, err := repo.FindPageByBoardID(ctx, board.ID, nil, 2)
+	if err != nil {
+		t.Fatalf("FindPageByBoardID() error = %v", err)
+	}
+	if len(first) != 2 || first[0].Field != "assignee" || first[1].Field != "stage" {
+		t.Fatalf("first page = %v, want newest first", first)
+	}
+
+	last := first[len(first)-1]
+	rest, err := repo.FindPageByBoardID(ctx, board.ID, &BoardCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 2)
+	if err != nil {
+		t.Fatalf("FindPageByBoardID() with cursor error = %v", err)
+	}
+	if len(rest) != 1 || rest[0].Field != "title" {
+		t.Errorf("second page = %v, want only the oldest entry", rest)
+	}
+}
+
+func TestSubtaskRepository_CountByBoardIDs(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	boardRepo := NewBoardRepository(db)
+	withSubtasks := createBoard(t, boardRepo, project, "checklist", `{}`)
+	empty := createBoard(t, boardRepo, project, "no checklist", `{}`)
+	repo := NewSubtaskRepository(db)
+	ctx := context.Background()
+
+	for i, done := range []bool{true, false, true} {
+		subtask := &domain.Subtask{BoardID: withSubtasks.ID, Title: "item", Done: done, Position: string(rune('a' + i))}
+		if err := repo.Create(ctx, subtask); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if i == 2 {
+			// 삭제된 항목은 완료율에서 제외
+			if err := repo.Delete(ctx, subtask.ID); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+		}
+	}
+
+	counts, err := repo.CountByBoardIDs(ctx, []uuid.UUID{withSubtasks.ID, empty.ID})
+	if err != nil {
+		t.Fatalf("CountByBoardIDs() error = %v", err)
+	}
+	if got := counts[withSubtasks.ID]; got != (SubtaskCount{Total: 2, Done: 1}) {
+		t.Errorf("count = %+v, want {Total:2 Done:1}", got)
+	}
+	if _, ok := counts[empty.ID]; ok {
+		t.Error("boards without subtasks should be omitted")
+	}
+}
+
+func TestBoardRepository_ClaimRecurrence(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	due := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+	recurring := createBoard(t, repo, project, "weekly standup", `{}`)
+	recurring.RecurrenceRule = "@weekly"
+	recurring.NextRecurrenceAt = &due
+	if err := repo.Update(ctx, recurring); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	createBoard(t, repo, project, "one-off", `{}`)
+
+	boards, err := repo.FindDueRecurrences(ctx, time.Now(), 10)
+	if err != nil {
+		t.Fatalf("FindDueRecurrences() error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != recurring.ID {
+		t.Fatalf("due boards = %v, want only the recurring board", boardTitles(boards))
+	}
+
+	next := due.Add(7 * 24 * time.Hour)
+	for i, want := range []bool{true, false} {
+		// 두 번째 선점은 next_recurrence_at이 이미 바뀌었으므로 실패
+		claimed, err := repo.ClaimRecurrence(ctx, recurring.ID, due, next)
+		if err != nil {
+			t.Fatalf("ClaimRecurrence() error = %v", err)
+		}
+		if claimed != want {
+			t.Errorf("claim #%d = %v, want %v", i+1, claimed, want)
+		}
+	}
+}
+
+func TestSearchRepository_FullTextSearchBoards(t *testing.T) {
+	db, project := setupPostgresProject(t)
+	boardRepo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	byTitle := createBoard(t, boardRepo, project, "배포 체크리스트", `{}`)
+	byComment := createBoard(t, boardRepo, project, "QA", `{}`)
+	createBoard(t, boardRepo, project, "unrelated", `{}`)
+	comment := &domain.Comment{BoardID: byComment.ID, UserID: project.OwnerID, Content: "배포를 금요일로 미룹니다"}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	// 멤버가 아닌 프로젝트의 보드는 검색되지 않음
+	other := &domain.Project{WorkspaceID: project.WorkspaceID, OwnerID: uuid.New(), Name: "Other", IsPublic: true}
+	if err := NewProjectRepository(db).Create(ctx, other); err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	createBoard(t, boardRepo, other, "배포 일정", `{}`)
+
+	repo := NewSearchRepository(db)
+	rows, err := repo.FullTextSearchBoards(ctx, project.WorkspaceID, project.OwnerID, "배포", 10, 0)
+	if err != nil {
+		t.Fatalf("FullTextSearchBoards() error = %v", err)
+	}
+	found := make(map[uuid.UUID]BoardFullTextRow, len(rows))
+	for _, row := range rows {
+		found[row.ID] = row
+	}
+	if len(rows) != 2 {
+		t.Fatalf("found %d boards, want the title and comment matches only", len(rows))
+	}
+	if _, ok := found[byTitle.ID]; !ok {
+		t.Error("board matching by title was not found")
+	}
+	if row, ok := found[byComment.ID]; !ok || row.MatchedComment != comment.Content {
+		t.Errorf("board matching by comment = %+v, want the matched comment", row)
+	}
+
+	// tsquery 연산자는 검색어에서 제거됨
+	if _, err := repo.FullTextSearchBoards(ctx, project.WorkspaceID, project.OwnerID, "배포 & | !", 10, 0); err != nil {
+		t.Errorf("FullTextSearchBoards() with operators error = %v", err)
+	}
+}
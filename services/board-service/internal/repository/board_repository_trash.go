@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// FindDeleted finds the soft-deleted boards of a project (휴지통 목록)
+func (r *boardRepositoryImpl) FindDeleted(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("project_id = ? AND deleted_at IS NOT NULL", projectID).
+		Order("deleted_at DESC, id ASC").
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindDeletedByID finds a soft-deleted board by ID with preloaded participants
+func (r *boardRepositoryImpl) FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+	var board domain.Board
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Preload("Participants").
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// Restore clears deleted_at only if the board is still in the trash
+// (동시에 복원하거나 영구 삭제된 경우 한 요청만 성공)
+func (r *boardRepositoryImpl) Restore(ctx context.Context, board *domain.Board) (bool, error) {
+	result := uow.DB(ctx, r.db).
+		Unscoped().
+		Model(&domain.Board{}).
+		Where("id = ? AND deleted_at IS NOT NULL", board.ID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected != 1 {
+		return false, nil
+	}
+	board.DeletedAt = gorm.DeletedAt{}
+	return true, nil
+}
+
+// FindDeletedBefore finds the boards whose retention has expired, oldest deletion first
+func (r *boardRepositoryImpl) FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at <= ?", cutoff).
+		Order("deleted_at ASC, id ASC").
+		Limit(limit).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// Purge hard deletes a soft-deleted board (그 사이 복원된 보드는 지우지 않음)
+func (r *boardRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := uow.DB(ctx, r.db).
+		Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Delete(&domain.Board{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
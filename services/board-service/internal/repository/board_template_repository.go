@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// BoardTemplateRepository defines the interface for board template data access
+type BoardTemplateRepository interface {
+	Create(ctx context.Context, template *domain.BoardTemplate) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error)
+	Update(ctx context.Context, template *domain.BoardTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// boardTemplateRepositoryImpl is the GORM implementation of BoardTemplateRepository
+type boardTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBoardTemplateRepository creates a new instance of BoardTemplateRepository
+func NewBoardTemplateRepository(db *gorm.DB) BoardTemplateRepository {
+	return &boardTemplateRepositoryImpl{db: db}
+}
+
+// Create creates a new board template
+func (r *boardTemplateRepositoryImpl) Create(ctx context.Context, template *domain.BoardTemplate) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(template).Error
+}
+
+// FindByID finds a board template by ID
+func (r *boardTemplateRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+	var template domain.BoardTemplate
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByProjectID finds all board templates of a project ordered by name
+func (r *boardTemplateRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error) {
+	var templates []*domain.BoardTemplate
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("name ASC, id ASC").
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// Update updates a board template
+func (r *boardTemplateRepositoryImpl) Update(ctx context.Context, template *domain.BoardTemplate) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(template).Error
+}
+
+// Delete soft deletes a board template
+func (r *boardTemplateRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.BoardTemplate{}, id).Error
+}
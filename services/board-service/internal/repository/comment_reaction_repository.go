@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// CommentReactionRepository defines the interface for comment reaction data access
+type CommentReactionRepository interface {
+	// Add adds the user's emoji reaction to the comment; reacting twice with the same emoji is a no-op
+	Add(ctx context.Context, commentID, userID uuid.UUID, emoji string) error
+	// Remove removes the user's emoji reaction; removing a reaction that does not exist is a no-op
+	Remove(ctx context.Context, commentID, userID uuid.UUID, emoji string) error
+	FindByCommentIDs(ctx context.Context, commentIDs []uuid.UUID) ([]*domain.CommentReaction, error)
+}
+
+// commentReactionRepositoryImpl is the GORM implementation of CommentReactionRepository
+type commentReactionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCommentReactionRepository creates a new instance of CommentReactionRepository
+func NewCommentReactionRepository(db *gorm.DB) CommentReactionRepository {
+	return &commentReactionRepositoryImpl{db: db}
+}
+
+// Add inserts a reaction row, ignoring an existing one
+func (r *commentReactionRepositoryImpl) Add(ctx context.Context, commentID, userID uuid.UUID, emoji string) error {
+	return uow.DB(ctx, r.db).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.CommentReaction{CommentID: commentID, UserID: userID, Emoji: emoji}).Error
+}
+
+// Remove deletes a reaction row
+func (r *commentReactionRepositoryImpl) Remove(ctx context.Context, commentID, userID uuid.UUID, emoji string) error {
+	return uow.DB(ctx, r.db).
+		Where("comment_id = ? AND user_id = ? AND emoji = ?", commentID, userID, emoji).
+		Delete(&domain.CommentReaction{}).Error
+}
+
+// FindByCommentIDs finds the reactions of the given comments, oldest reaction first
+func (r *commentReactionRepositoryImpl) FindByCommentIDs(ctx context.Context, commentIDs []uuid.UUID) ([]*domain.CommentReaction, error) {
+	var reactions []*domain.CommentReaction
+	if len(commentIDs) == 0 {
+		return reactions, nil
+	}
+	if err := r.db.WithContext(ctx).
+		Where("comment_id IN ?", commentIDs).
+		Order("created_at ASC").
+		Find(&reactions).Error; err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// CustomFieldRepository defines the interface for project custom field definition data access
+type CustomFieldRepository interface {
+	Create(ctx context.Context, field *domain.CustomFieldDefinition) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error)
+	// FindByProjectID finds the custom fields of a project ordered by display_order
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error)
+	Update(ctx context.Context, field *domain.CustomFieldDefinition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RemoveBoardValues removes the field's key from the customFields of every board of the project
+	RemoveBoardValues(ctx context.Context, projectID uuid.UUID, key string) error
+}
+
+// customFieldRepositoryImpl is the GORM implementation of CustomFieldRepository
+type customFieldRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCustomFieldRepository creates a new instance of CustomFieldRepository
+func NewCustomFieldRepository(db *gorm.DB) CustomFieldRepository {
+	return &customFieldRepositoryImpl{db: db}
+}
+
+// Create creates a new custom field definition
+func (r *customFieldRepositoryImpl) Create(ctx context.Context, field *domain.CustomFieldDefinition) error {
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Create(field).Error
+}
+
+// FindByID finds a custom field definition by ID
+func (r *customFieldRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error) {
+	var field domain.CustomFieldDefinition
+	if err := uow.DB(ctx, r.db).Where("id = ?", id).First(&field).Error; err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+// FindByProjectID finds the custom field definitions of a project
+func (r *customFieldRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+	var fields []*domain.CustomFieldDefinition
+	if err := uow.DB(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("display_order ASC, created_at ASC").
+		Find(&fields).Error; err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// Update updates a custom field definition
+func (r *customFieldRepositoryImpl) Update(ctx context.Context, field *domain.CustomFieldDefinition) error {
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Save(field).Error
+}
+
+// Delete deletes a custom field definition
+func (r *customFieldRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return uow.DB(ctx, r.db).Delete(&domain.CustomFieldDefinition{}, "id = ?", id).Error
+}
+
+// RemoveBoardValues removes a key from the jsonb customFields of the project's boards without touching updated_at
+func (r *customFieldRepositoryImpl) RemoveBoardValues(ctx context.Context, projectID uuid.UUID, key string) error {
+	return uow.DB(ctx, r.db).Model(&domain.Board{}).
+		Where("project_id = ? AND custom_fields IS NOT NULL", projectID).
+		UpdateColumn("custom_fields", gorm.Expr("custom_fields - ?::text", key)).Error
+}
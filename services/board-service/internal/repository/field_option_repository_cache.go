@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	"project-board-api/internal/domain"
+)
+
+// cachedFieldOptionRepository caches field option lists by field type and by project in Redis
+// and delegates every other call to the wrapped repository
+type cachedFieldOptionRepository struct {
+	FieldOptionRepository
+	options *cache.Cache[[]*domain.FieldOption]
+}
+
+// NewCachedFieldOptionRepository wraps repo so that FindByFieldType and FindByProjectAndFieldType
+// go through options. Create, CreateBatch, Update and Delete invalidate the affected lists.
+func NewCachedFieldOptionRepository(repo FieldOptionRepository, options *cache.Cache[[]*domain.FieldOption]) FieldOptionRepository {
+	return &cachedFieldOptionRepository{FieldOptionRepository: repo, options: options}
+}
+
+// FindByFieldType finds all field options by field type through the cache
+func (r *cachedFieldOptionRepository) FindByFieldType(ctx context.Context, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+	return r.options.GetOrLoad(ctx, fieldTypeCacheKey(fieldType), func(ctx context.Context) ([]*domain.FieldOption, error) {
+		return r.FieldOptionRepository.FindByFieldType(ctx, fieldType)
+	})
+}
+
+// FindByProjectAndFieldType finds a project's field options through the cache
+func (r *cachedFieldOptionRepository) FindByProjectAndFieldType(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+	return r.options.GetOrLoad(ctx, projectFieldTypeCacheKey(projectID, fieldType), func(ctx context.Context) ([]*domain.FieldOption, error) {
+		return r.FieldOptionRepository.FindByProjectAndFieldType(ctx, projectID, fieldType)
+	})
+}
+
+// Create creates a field option and invalidates the lists it belongs to
+func (r *cachedFieldOptionRepository) Create(ctx context.Context, fieldOption *domain.FieldOption) error {
+	if err := r.FieldOptionRepository.Create(ctx, fieldOption); err != nil {
+		return err
+	}
+	r.invalidate(ctx, fieldOption)
+	return nil
+}
+
+// CreateBatch creates field options and invalidates the lists they belong to
+func (r *cachedFieldOptionRepository) CreateBatch(ctx context.Context, fieldOptions []*domain.FieldOption) error {
+	if err := r.FieldOptionRepository.CreateBatch(ctx, fieldOptions); err != nil {
+		return err
+	}
+	r.invalidate(ctx, fieldOptions...)
+	return nil
+}
+
+// Update updates a field option and invalidates the lists it belongs to
+func (r *cachedFieldOptionRepository) Update(ctx context.Context, fieldOption *domain.FieldOption) error {
+	if err := r.FieldOptionRepository.Update(ctx, fieldOption); err != nil {
+		return err
+	}
+	r.invalidate(ctx, fieldOption)
+	return nil
+}
+
+// Delete soft deletes a field option and invalidates the lists it belonged to
+func (r *cachedFieldOptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// 삭제 후에는 프로젝트/타입을 알 수 없으므로 먼저 조회
+	existing, _ := r.FieldOptionRepository.FindByID(ctx, id)
+	if err := r.FieldOptionRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	if existing != nil {
+		r.invalidate(ctx, existing)
+	}
+	return nil
+}
+
+// invalidate removes the field type and project lists that contain fieldOptions
+func (r *cachedFieldOptionRepository) invalidate(ctx context.Context, fieldOptions ...*domain.FieldOption) {
+	keys := make([]string, 0, len(fieldOptions)*2)
+	for _, option := range fieldOptions {
+		keys = append(keys, fieldTypeCacheKey(option.FieldType))
+		if option.ProjectID != nil {
+			keys = append(keys, projectFieldTypeCacheKey(*option.ProjectID, option.FieldType))
+		}
+	}
+	_ = r.options.Invalidate(ctx, keys...)
+}
+
+func fieldTypeCacheKey(fieldType domain.FieldType) string {
+	return "type:" + string(fieldType)
+}
+
+func projectFieldTypeCacheKey(projectID uuid.UUID, fieldType domain.FieldType) string {
+	return projectID.String() + ":" + string(fieldType)
+}
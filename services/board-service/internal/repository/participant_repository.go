@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
 	"project-board-api/internal/domain"
 )
 
@@ -30,7 +31,7 @@ func NewParticipantRepository(db *gorm.DB) ParticipantRepository {
 
 // Create creates a new participant
 func (r *participantRepositoryImpl) Create(ctx context.Context, participant *domain.Participant) error {
-	if err := r.db.WithContext(ctx).Create(participant).Error; err != nil {
+	if err := uow.DB(ctx, r.db).Create(participant).Error; err != nil {
 		return err
 	}
 	return nil
@@ -39,7 +40,7 @@ func (r *participantRepositoryImpl) Create(ctx context.Context, participant *dom
 // FindByBoardID finds all participants by board ID
 func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Participant, error) {
 	var participants []*domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("board_id = ?", boardID).
 		Find(&participants).Error; err != nil {
 		return nil, err
@@ -50,7 +51,7 @@ func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID u
 // FindByBoardAndUser finds a participant by board ID and user ID
 func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (*domain.Participant, error) {
 	var participant domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		First(&participant).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -63,7 +64,7 @@ func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boar
 
 // Delete soft deletes a participant by board ID and user ID
 func (r *participantRepositoryImpl) Delete(ctx context.Context, boardID, userID uuid.UUID) error {
-	if err := r.db.WithContext(ctx).
+	if err := uow.DB(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		Delete(&domain.Participant{}).Error; err != nil {
 		return err
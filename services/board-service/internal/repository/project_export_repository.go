@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ProjectExportRepository defines the data access for streaming a project export
+type ProjectExportRepository interface {
+	// FindFieldOptions returns the project's own field options (system defaults are not exported)
+	FindFieldOptions(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error)
+	// FindBoardsInBatches calls fn with the project's live boards in id order, at most batchSize at a time
+	FindBoardsInBatches(ctx context.Context, projectID uuid.UUID, batchSize int, fn func(boards []*domain.Board) error) error
+	FindParticipantsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Participant, error)
+	FindCommentsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Comment, error)
+	// FindAttachmentsByEntityIDs returns the confirmed attachments of the given boards or comments
+	FindAttachmentsByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) ([]*domain.Attachment, error)
+}
+
+// projectExportRepositoryImpl is the GORM implementation of ProjectExportRepository
+type projectExportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewProjectExportRepository creates a new instance of ProjectExportRepository
+func NewProjectExportRepository(db *gorm.DB) ProjectExportRepository {
+	return &projectExportRepositoryImpl{db: db}
+}
+
+func (r *projectExportRepositoryImpl) FindFieldOptions(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error) {
+	var options []*domain.FieldOption
+	err := r.db.WithContext(ctx).
+		Where("project_id = ? AND is_system_default = false AND deleted_at IS NULL", projectID).
+		Order("field_type, display_order, id").
+		Find(&options).Error
+	return options, err
+}
+
+// FindBoardsInBatches pages through the boards by primary key, so memory stays bounded for large projects
+func (r *projectExportRepositoryImpl) FindBoardsInBatches(ctx context.Context, projectID uuid.UUID, batchSize int, fn func(boards []*domain.Board) error) error {
+	var boards []*domain.Board
+	return r.db.WithContext(ctx).
+		Where("project_id = ? AND deleted_at IS NULL", projectID).
+		FindInBatches(&boards, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(boards)
+		}).Error
+}
+
+func (r *projectExportRepositoryImpl) FindParticipantsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Participant, error) {
+	var participants []*domain.Participant
+	err := r.db.WithContext(ctx).
+		Where("board_id IN ? AND deleted_at IS NULL", boardIDs).
+		Order("created_at, id").
+		Find(&participants).Error
+	return participants, err
+}
+
+func (r *projectExportRepositoryImpl) FindCommentsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Comment, error) {
+	var comments []*domain.Comment
+	err := r.db.WithContext(ctx).
+		Where("board_id IN ? AND deleted_at IS NULL", boardIDs).
+		Order("created_at, id").
+		Find(&comments).Error
+	return comments, err
+}
+
+func (r *projectExportRepositoryImpl) FindAttachmentsByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	if len(entityIDs) == 0 {
+		return attachments, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id IN ? AND status = ? AND deleted_at IS NULL",
+			entityType, entityIDs, domain.AttachmentStatusConfirmed).
+		Order("created_at, id").
+		Find(&attachments).Error
+	return attachments, err
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"project-board-api/internal/domain"
 )
@@ -14,7 +15,7 @@ import (
 type ProjectRepository interface {
 	Create(ctx context.Context, project *domain.Project) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
-	FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
+	FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, state domain.ArchiveState) ([]*domain.Project, error)
 	FindDefaultByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
 	Search(ctx context.Context, workspaceID uuid.UUID, query string, page, limit int) ([]*domain.Project, int64, error)
 	Update(ctx context.Context, project *domain.Project) error
@@ -34,6 +35,11 @@ type ProjectRepository interface {
 	FindJoinRequestByID(ctx context.Context, requestID uuid.UUID) (*domain.ProjectJoinRequest, error)
 	FindPendingByProjectAndUser(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectJoinRequest, error)
 	UpdateJoinRequestStatus(ctx context.Context, requestID uuid.UUID, status domain.ProjectJoinRequestStatus) error
+
+	// Permission matrix
+	// FindPermissionMatrix returns nil, nil when the project uses the default permissions
+	FindPermissionMatrix(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error)
+	SavePermissionMatrix(ctx context.Context, matrix *domain.ProjectPermissionMatrix) error
 }
 
 // projectRepositoryImpl is the GORM implementation of ProjectRepository
@@ -70,14 +76,15 @@ func (r *projectRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return &project, nil
 }
 
-// FindByWorkspaceID finds all projects by workspace ID
+// FindByWorkspaceID finds all projects in the given archive state by workspace ID
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
-func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
+func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, state domain.ArchiveState) ([]*domain.Project, error) {
 	// Explicitly initialize empty array to prevent nil return
 	projects := make([]*domain.Project, 0)
 	if err := r.db.WithContext(ctx).
 		// Preload("Attachments"). // ✅ 제거
 		Where("workspace_id = ?", workspaceID).
+		Scopes(archiveScope(state)).
 		Find(&projects).Error; err != nil {
 		return nil, err
 	}
@@ -119,7 +126,7 @@ func (r *projectRepositoryImpl) Search(ctx context.Context, workspaceID uuid.UUI
 	var projects []*domain.Project
 	var total int64
 
-	db := r.db.WithContext(ctx).Where("workspace_id = ?", workspaceID)
+	db := r.db.WithContext(ctx).Where("workspace_id = ?", workspaceID).Scopes(archiveScope(domain.ArchiveStateActive))
 
 	if query != "" {
 		searchPattern := "%" + query + "%"
@@ -249,3 +256,26 @@ func (r *projectRepositoryImpl) UpdateJoinRequestStatus(ctx context.Context, req
 			"updated_at": gorm.Expr("NOW()"),
 		}).Error
 }
+
+// FindPermissionMatrix finds the permission overrides of a project
+func (r *projectRepositoryImpl) FindPermissionMatrix(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+	var matrix domain.ProjectPermissionMatrix
+	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).First(&matrix).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &matrix, nil
+}
+
+// SavePermissionMatrix creates or replaces the permission overrides of a project
+func (r *projectRepositoryImpl) SavePermissionMatrix(ctx context.Context, matrix *domain.ProjectPermissionMatrix) error {
+	return r.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"permissions", "updated_by", "updated_at"}),
+		}).
+		Create(matrix).Error
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	"project-board-api/internal/domain"
+)
+
+// cachedProjectRepository caches project metadata lookups (FindByID) in Redis
+// and delegates every other call to the wrapped repository
+type cachedProjectRepository struct {
+	ProjectRepository
+	projects *cache.Cache[*domain.Project]
+}
+
+// NewCachedProjectRepository wraps repo so that FindByID goes through projects.
+// Update and Delete invalidate the cached project.
+func NewCachedProjectRepository(repo ProjectRepository, projects *cache.Cache[*domain.Project]) ProjectRepository {
+	return &cachedProjectRepository{ProjectRepository: repo, projects: projects}
+}
+
+// FindByID finds a project by ID through the cache
+func (r *cachedProjectRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+	return r.projects.GetOrLoad(ctx, id.String(), func(ctx context.Context) (*domain.Project, error) {
+		return r.ProjectRepository.FindByID(ctx, id)
+	})
+}
+
+// Update updates a project and invalidates its cache entry
+func (r *cachedProjectRepository) Update(ctx context.Context, project *domain.Project) error {
+	if err := r.ProjectRepository.Update(ctx, project); err != nil {
+		return err
+	}
+	_ = r.projects.Invalidate(ctx, project.ID.String())
+	return nil
+}
+
+// Delete soft deletes a project and invalidates its cache entry
+func (r *cachedProjectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.ProjectRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	_ = r.projects.Invalidate(ctx, id.String())
+	return nil
+}
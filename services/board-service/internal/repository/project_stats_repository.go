@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ProjectStatsRepository defines the read-only queries behind project analytics
+type ProjectStatsRepository interface {
+	// FindBoards returns the project's live boards with only the columns analytics needs
+	// (id, assignee, custom fields, created and archived times)
+	FindBoards(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	// FindStageChanges returns the project's stage changes made before until, grouped by board and oldest first
+	FindStageChanges(ctx context.Context, projectID uuid.UUID, until time.Time) ([]*domain.ActivityLog, error)
+}
+
+// projectStatsRepositoryImpl is the GORM implementation of ProjectStatsRepository
+type projectStatsRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewProjectStatsRepository creates a new instance of ProjectStatsRepository
+func NewProjectStatsRepository(db *gorm.DB) ProjectStatsRepository {
+	return &projectStatsRepositoryImpl{db: db}
+}
+
+// FindBoards loads a narrow projection of the project's boards (archived boards included)
+func (r *projectStatsRepositoryImpl) FindBoards(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	err := r.db.WithContext(ctx).
+		Select("id", "project_id", "assignee_id", "custom_fields", "created_at", "archived_at").
+		Where("project_id = ? AND deleted_at IS NULL", projectID).
+		Order("created_at ASC, id ASC").
+		Find(&boards).Error
+	return boards, err
+}
+
+// FindStageChanges loads the stage rows of the activity history (old/new values are stage labels)
+func (r *projectStatsRepositoryImpl) FindStageChanges(ctx context.Context, projectID uuid.UUID, until time.Time) ([]*domain.ActivityLog, error) {
+	var logs []*domain.ActivityLog
+	err := r.db.WithContext(ctx).
+		Where("project_id = ? AND action = ? AND field = ? AND created_at < ?",
+			projectID, domain.ActivityActionUpdated, string(domain.FieldTypeStage), until).
+		Order("board_id ASC, created_at ASC, id ASC").
+		Find(&logs).Error
+	return logs, err
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardSearchRow is a board matched by global search with its project name
+type BoardSearchRow struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	ProjectName string
+	Title       string
+	Content     string
+	UpdatedAt   time.Time
+}
+
+// CommentSearchRow is a comment matched by global search with its board title
+type CommentSearchRow struct {
+	ID         uuid.UUID
+	BoardID    uuid.UUID
+	BoardTitle string
+	ProjectID  uuid.UUID
+	UserID     uuid.UUID
+	Content    string
+	UpdatedAt  time.Time
+}
+
+// BoardFullTextRow is a board matched by full-text search on its own text or one of its comments
+type BoardFullTextRow struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	ProjectName    string
+	Title          string
+	Content        string
+	MatchedComment string // 댓글이 보드 본문보다 더 잘 맞으면 그 댓글 내용, 아니면 ""
+	Rank           float64
+	UpdatedAt      time.Time
+}
+
+// SearchRepository defines the data access for workspace-wide search.
+// Results are limited to projects the user can read: public projects and projects they own or belong to.
+type SearchRepository interface {
+	SearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]BoardSearchRow, error)
+	SearchComments(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]CommentSearchRow, error)
+	// FullTextSearchBoards ranks the boards of the projects the user owns or belongs to by the tsvector
+	// match of their title, content and comments (public projects the user is not a member of are excluded)
+	FullTextSearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit, offset int) ([]BoardFullTextRow, error)
+}
+
+// searchRepositoryImpl is the GORM implementation of SearchRepository
+type searchRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository creates a new instance of SearchRepository
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepositoryImpl{db: db}
+}
+
+// SearchBoards finds boards whose title or content contains the query, most recently updated first
+func (r *searchRepositoryImpl) SearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]BoardSearchRow, error) {
+	var rows []BoardSearchRow
+	pattern := "%" + query + "%"
+	err := r.accessibleProjects(ctx, workspaceID, userID).
+		Table("boards").
+		Select("boards.id, boards.project_id, projects.name AS project_name, boards.title, boards.content, boards.updated_at").
+		Joins("JOIN projects ON projects.id = boards.project_id").
+		Where("boards.deleted_at IS NULL").
+		Where("(boards.title ILIKE ? OR boards.content ILIKE ?)", pattern, pattern).
+		Order("boards.updated_at DESC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// SearchComments finds comments whose content contains the query, most recently updated first
+func (r *searchRepositoryImpl) SearchComments(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]CommentSearchRow, error) {
+	var rows []CommentSearchRow
+	err := r.accessibleProjects(ctx, workspaceID, userID).
+		Table("comments").
+		Select("comments.id, comments.board_id, boards.title AS board_title, boards.project_id, comments.user_id, comments.content, comments.updated_at").
+		Joins("JOIN boards ON boards.id = comments.board_id AND boards.deleted_at IS NULL").
+		Joins("JOIN projects ON projects.id = boards.project_id").
+		Where("comments.deleted_at IS NULL").
+		Where("comments.content ILIKE ?", "%"+query+"%").
+		Order("comments.updated_at DESC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// accessibleProjects restricts a query joined with projects to the workspace projects the user can read
+func (r *searchRepositoryImpl) accessibleProjects(ctx context.Context, workspaceID, userID uuid.UUID) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Where("projects.workspace_id = ? AND projects.deleted_at IS NULL", workspaceID).
+		Where("(projects.is_public = ? OR projects.owner_id = ? OR EXISTS (SELECT 1 FROM project_members WHERE project_members.project_id = projects.id AND project_members.user_id = ?))",
+			true, userID, userID)
+}
+
+// fullTextBoardsSQL matches boards and comments through the GIN expression indexes created by
+// database.AutoMigrate (idx_boards_search, idx_comments_search); the to_tsvector expressions must stay identical.
+const fullTextBoardsSQL = `
+WITH q AS (SELECT to_tsquery('simple', @query) AS query),
+matches AS (
+	SELECT boards.id AS board_id, ts_rank(to_tsvector('simple', coalesce(boards.title, '') || ' ' || coalesce(boards.content, '')), q.query) AS rank, '' AS matched_comment
+	FROM boards, q
+	WHERE to_tsvector('simple', coalesce(boards.title, '') || ' ' || coalesce(boards.content, '')) @@ q.query
+	UNION ALL
+	SELECT comments.board_id, ts_rank(to_tsvector('simple', comments.content), q.query), comments.content
+	FROM comments, q
+	WHERE to_tsvector('simple', comments.content) @@ q.query AND comments.deleted_at IS NULL
+),
+best AS (
+	SELECT DISTINCT ON (board_id) board_id, rank, matched_comment
+	FROM matches
+	ORDER BY board_id, rank DESC, matched_comment
+)
+SELECT boards.id, boards.project_id, projects.name AS project_name, boards.title, boards.content,
+	best.matched_comment, best.rank, boards.updated_at
+FROM best
+JOIN boards ON boards.id = best.board_id AND boards.deleted_at IS NULL
+JOIN projects ON projects.id = boards.project_id
+WHERE projects.workspace_id = @workspace AND projects.deleted_at IS NULL
+	AND (projects.owner_id = @user OR EXISTS (SELECT 1 FROM project_members WHERE project_members.project_id = projects.id AND project_members.user_id = @user))
+ORDER BY best.rank DESC, boards.updated_at DESC, boards.id
+LIMIT @limit OFFSET @offset`
+
+// FullTextSearchBoards finds boards whose title, content or comments match every word of the query, best match first.
+// 각 단어는 접두어로 검색되므로 "배포"로 "배포를", "배포일"도 찾습니다.
+func (r *searchRepositoryImpl) FullTextSearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit, offset int) ([]BoardFullTextRow, error) {
+	tsQuery := prefixTSQuery(query)
+	if tsQuery == "" {
+		return nil, nil
+	}
+	var rows []BoardFullTextRow
+	err := r.db.WithContext(ctx).Raw(fullTextBoardsSQL, map[string]interface{}{
+		"query":     tsQuery,
+		"workspace": workspaceID,
+		"user":      userID,
+		"limit":     limit,
+		"offset":    offset,
+	}).Scan(&rows).Error
+	return rows, err
+}
+
+// prefixTSQuery turns free text into a to_tsquery expression requiring every word as a prefix ("a:* & b:*").
+// 문자와 숫자만 남기므로 tsquery 연산자(&, |, !, :)가 입력되어도 구문 오류가 나지 않습니다.
+func prefixTSQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
@@ -0,0 +1,22 @@
+package repository
+
+import "testing"
+
+func TestPrefixTSQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "배포", want: "배포:*"},
+		{query: "  Release  Notes ", want: "release:* & notes:*"},
+		{query: "a&b | !c:*", want: "a:* & b:* & c:*"},
+		{query: "v1.2", want: "v1:* & 2:*"},
+		{query: "&|!", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := prefixTSQuery(tt.query); got != tt.want {
+			t.Errorf("prefixTSQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// SubtaskCount is the number of subtasks of a board and how many of them are done
+type SubtaskCount struct {
+	Total int
+	Done  int
+}
+
+// SubtaskRepository defines the interface for subtask data access
+type SubtaskRepository interface {
+	Create(ctx context.Context, subtask *domain.Subtask) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Subtask, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Subtask, error)
+	FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Subtask, error)
+	// CountByBoardIDs counts the subtasks of each board; boards without subtasks are omitted
+	CountByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) (map[uuid.UUID]SubtaskCount, error)
+	// MaxPosition finds the greatest subtask position of a board within the current transaction
+	MaxPosition(ctx context.Context, boardID uuid.UUID) (string, error)
+	Update(ctx context.Context, subtask *domain.Subtask) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// subtaskRepositoryImpl is the GORM implementation of SubtaskRepository
+type subtaskRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSubtaskRepository creates a new instance of SubtaskRepository
+func NewSubtaskRepository(db *gorm.DB) SubtaskRepository {
+	return &subtaskRepositoryImpl{db: db}
+}
+
+// Create creates a new subtask
+func (r *subtaskRepositoryImpl) Create(ctx context.Context, subtask *domain.Subtask) error {
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Create(subtask).Error
+}
+
+// FindByID finds a subtask by ID
+func (r *subtaskRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Subtask, error) {
+	var subtask domain.Subtask
+	if err := uow.DB(ctx, r.db).Where("id = ?", id).First(&subtask).Error; err != nil {
+		return nil, err
+	}
+	return &subtask, nil
+}
+
+// FindByIDs finds subtasks by IDs; missing or soft-deleted subtasks are omitted from the result
+func (r *subtaskRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Subtask, error) {
+	var subtasks []*domain.Subtask
+	if len(ids) == 0 {
+		return subtasks, nil
+	}
+	if err := uow.DB(ctx, r.db).Where("id IN ?", ids).Find(&subtasks).Error; err != nil {
+		return nil, err
+	}
+	return subtasks, nil
+}
+
+// FindByBoardID finds all subtasks of a board in checklist order
+func (r *subtaskRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Subtask, error) {
+	var subtasks []*domain.Subtask
+	if err := r.db.WithContext(ctx).
+		Where("board_id = ?", boardID).
+		Order("position ASC, id ASC").
+		Find(&subtasks).Error; err != nil {
+		return nil, err
+	}
+	return subtasks, nil
+}
+
+// CountByBoardIDs counts total and done subtasks per board in a single query
+func (r *subtaskRepositoryImpl) CountByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) (map[uuid.UUID]SubtaskCount, error) {
+	counts := make(map[uuid.UUID]SubtaskCount)
+	if len(boardIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		BoardID uuid.UUID
+		Total   int
+		Done    int
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Subtask{}).
+		Select("board_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE done) AS done").
+		Where("board_id IN ?", boardIDs).
+		Group("board_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.BoardID] = SubtaskCount{Total: row.Total, Done: row.Done}
+	}
+	return counts, nil
+}
+
+// MaxPosition locks the board row FOR UPDATE so that subtasks of the same board get distinct positions
+func (r *subtaskRepositoryImpl) MaxPosition(ctx context.Context, boardID uuid.UUID) (string, error) {
+	db := uow.DB(ctx, r.db)
+	var locked []uuid.UUID
+	if err := db.Model(&domain.Board{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", boardID).
+		Pluck("id", &locked).Error; err != nil {
+		return "", err
+	}
+
+	var last string
+	if err := db.Model(&domain.Subtask{}).
+		Where("board_id = ?", boardID).
+		Select("COALESCE(MAX(position), '')").
+		Scan(&last).Error; err != nil {
+		return "", err
+	}
+	return last, nil
+}
+
+// Update updates a subtask
+func (r *subtaskRepositoryImpl) Update(ctx context.Context, subtask *domain.Subtask) error {
+	return uow.DB(ctx, r.db).Omit(clause.Associations).Save(subtask).Error
+}
+
+// Delete soft deletes a subtask
+func (r *subtaskRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return uow.DB(ctx, r.db).Delete(&domain.Subtask{}, id).Error
+}
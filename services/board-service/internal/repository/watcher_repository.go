@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// WatcherRepository defines the interface for board watcher data access
+type WatcherRepository interface {
+	// Watch adds the user to the board's watchers; watching twice is a no-op
+	Watch(ctx context.Context, boardID, userID uuid.UUID) error
+	// Unwatch removes the user from the board's watchers; unwatching a board that is not watched is a no-op
+	Unwatch(ctx context.Context, boardID, userID uuid.UUID) error
+	FindUserIDsByBoardID(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// watcherRepositoryImpl is the GORM implementation of WatcherRepository
+type watcherRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWatcherRepository creates a new instance of WatcherRepository
+func NewWatcherRepository(db *gorm.DB) WatcherRepository {
+	return &watcherRepositoryImpl{db: db}
+}
+
+// Watch inserts a watcher row, ignoring an existing one
+func (r *watcherRepositoryImpl) Watch(ctx context.Context, boardID, userID uuid.UUID) error {
+	return uow.DB(ctx, r.db).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.Watcher{BoardID: boardID, UserID: userID}).Error
+}
+
+// Unwatch deletes a watcher row
+func (r *watcherRepositoryImpl) Unwatch(ctx context.Context, boardID, userID uuid.UUID) error {
+	return uow.DB(ctx, r.db).
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		Delete(&domain.Watcher{}).Error
+}
+
+// FindUserIDsByBoardID finds the IDs of the users watching a board, oldest watcher first
+func (r *watcherRepositoryImpl) FindUserIDsByBoardID(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Watcher{}).
+		Where("board_id = ?", boardID).
+		Order("created_at ASC").
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
@@ -13,6 +13,13 @@ const (
 	ErrCodeInternal      = apperrors.ErrCodeInternal
 	ErrCodeUnauthorized  = apperrors.ErrCodeUnauthorized
 	ErrCodeForbidden     = apperrors.ErrCodeForbidden
+	ErrCodeBadRequest    = apperrors.ErrCodeBadRequest
+	ErrCodeConflict      = apperrors.ErrCodeConflict
+
+	ErrCodeAlreadyMember        = apperrors.ErrCodeAlreadyMember
+	ErrCodePendingRequestExists = apperrors.ErrCodePendingRequestExists
+	ErrCodeFileTooLarge         = apperrors.ErrCodeFileTooLarge
+	ErrCodeInvalidFileType      = apperrors.ErrCodeInvalidFileType
 )
 
 // AppError is an alias for the common module's AppError
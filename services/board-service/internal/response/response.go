@@ -28,6 +28,11 @@ func SendError(c *gin.Context, statusCode int, code string, message string) {
 	commonresponse.Error(c, statusCode, code, message)
 }
 
+// SendConflict는 리소스의 현재 상태를 담은 409 에러 응답을 전송합니다.
+func SendConflict(c *gin.Context, message string, current interface{}) {
+	commonresponse.ConflictWithCurrent(c, message, current)
+}
+
 // SendErrorWithDetails는 상세 정보와 함께 에러 응답을 전송합니다.
 func SendErrorWithDetails(c *gin.Context, statusCode int, code string, message string, details string) {
 	commonresponse.ErrorWithDetails(c, statusCode, code, message, details)
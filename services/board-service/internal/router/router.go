@@ -2,30 +2,54 @@
 package router
 
 import (
+	"context"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	commonauth "github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/dbreplica"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/idempotency"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/tenancy"
 	"project-board-api/internal/client"
 	"project-board-api/internal/config"
 	"project-board-api/internal/converter"
 	"project-board-api/internal/database"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/grpcserver"
 	"project-board-api/internal/handler"
+	"project-board-api/internal/job"
 	"project-board-api/internal/metrics"
 	"project-board-api/internal/middleware"
 	"project-board-api/internal/repository"
 	"project-board-api/internal/service"
 )
 
+// maxBackupBodyBytes limits the size of an imported workspace backup section
+const maxBackupBodyBytes int64 = 256 << 20
+
 type Config struct {
 	DB                 *gorm.DB
+	Replicas           *dbreplica.Resolver // read replicas for list endpoints (optional)
 	Logger             *zap.Logger
 	JWTSecret          string // Deprecated: Use AuthServiceURL + JWTIssuer instead
 	AuthServiceURL     string // auth-service URL for SmartValidator
@@ -39,6 +63,29 @@ type Config struct {
 	RedisClient        *redis.Client
 	RateLimitConfig    config.RateLimitConfig
 	ServiceName        string // Service name for tracing (default: "board-service")
+	// GRPCServer가 있으면 같은 저장소로 내부 gRPC API를 등록합니다.
+	GRPCServer grpc.ServiceRegistrar
+	// Events가 있으면 보드 변경 도메인 이벤트를 발행합니다.
+	Events *messaging.Emitter
+	// Outbox가 있으면 보드 변경 이벤트를 같은 트랜잭션에서 아웃박스에 기록합니다 (Events보다 우선).
+	Outbox *outbox.Outbox
+	// Shutdown이 있으면 WebSocket 연결과 알림 고루틴 정리 단계를 등록합니다.
+	Shutdown *lifecycle.Shutdown
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
+	// CacheInvalidator가 있으면 멤버 변경 이벤트로 지워지는 워크스페이스 멤버십 Redis 캐시를 사용합니다.
+	CacheInvalidator *cache.Invalidator
+	// SearchClient가 있으면 통합 검색에 채팅 메시지, 파일, 멤버 그룹을 포함합니다.
+	SearchClient client.SearchClient
+	// InternalAPIKey가 있으면 user-service의 워크스페이스 백업용 내부 라우트를 등록합니다.
+	InternalAPIKey string
+	// Scheduler가 있으면 반복 보드를 생성하는 작업(RecurringBoardJob)과 마감 임박 알림 작업(DueReminderJob)을
+	// 매분, 휴지통을 비우는 작업(BoardTrashPurgeJob)을 10분마다 실행하도록 등록합니다.
+	Scheduler *cron.Cron
+	// DueReminderWindows는 마감 몇 시간 전에 BOARD_DUE_SOON 알림을 보낼지 정합니다 (비어 있으면 알림 없음).
+	DueReminderWindows []time.Duration
+	// TrashRetention은 삭제된 보드를 휴지통에서 복원할 수 있는 기간입니다 (0이면 30일).
+	TrashRetention time.Duration
 }
 
 // Setup initializes the router with all dependencies and routes.
@@ -54,26 +101,50 @@ func Setup(cfg Config) *gin.Engine {
 
 	// Apply global middleware chain (using common package)
 	router.Use(
-		commonmw.Recovery(cfg.Logger),                       // 1. Panic recovery
-		commonmw.OTELTracing(serviceName),                   // 2. OpenTelemetry HTTP tracing (otelgin)
-		commonmw.LoggerWithTracing(cfg.Logger, serviceName), // 3. Request logging with trace context
-		commonmw.DefaultCORS(),                              // 4. CORS configuration (includes X-Workspace-Id)
+		commonmw.Recovery(cfg.Logger),                                      // 1. Panic recovery
+		commonmw.OTELTracing(serviceName),                                  // 2. OpenTelemetry HTTP tracing (otelgin)
+		commonmw.LoggerWithTracing(cfg.Logger, serviceName),                // 3. Request logging with trace context
+		commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), cfg.Runtime), // 4. CORS configuration (includes X-Workspace-Id)
+		commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes),                   // 5. Request body size limit (1MB)
 	)
 
 	// Add metrics middleware if metrics is configured
 	if cfg.Metrics != nil {
 		router.Use(middleware.Metrics(cfg.Metrics))
+		if err := commonotel.EnableGORMMetrics(cfg.DB, cfg.Metrics.Metrics); err != nil {
+			cfg.Logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+		}
+		commonotel.EnableRedisMetrics(cfg.RedisClient, cfg.Metrics.Metrics)
+		if cfg.Replicas != nil {
+			cfg.Replicas.SetMetrics(cfg.Metrics.Metrics)
+		}
 		cfg.Logger.Info("Metrics middleware enabled")
 	}
 
-	// Add rate limiting middleware if enabled and Redis is available
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	router.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), cfg.Runtime, cfg.Logger))
+
+	// Response compression (br/gzip for responses over 1KB)
+	router.Use(commonmw.Compress(commonmw.DefaultCompressConfig()))
+
+	// Rate limiting (sliding window) if enabled and Redis is available.
+	// 사용자별 버킷을 위해 인증 뒤(setupRoutes)에 적용합니다.
+	var rateLimitMiddleware gin.HandlerFunc
 	if cfg.RateLimitConfig.Enabled && cfg.RedisClient != nil {
 		rlConfig := ratelimit.DefaultConfig().
 			WithRequestsPerMinute(cfg.RateLimitConfig.RequestsPerMinute).
 			WithBurstSize(cfg.RateLimitConfig.BurstSize).
 			WithKeyPrefix("rl:board:")
 		limiter := ratelimit.NewRedisRateLimiter(cfg.RedisClient, rlConfig, cfg.Logger)
-		router.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, cfg.Logger))
+		ratelimit.WatchSettings(limiter, cfg.Runtime)
+		// 기본 한도는 설정값(런타임 변경 반영), 비용이 큰 라우트는 별도 버킷으로 제한
+		policies := ratelimit.NewPolicies().
+			WithRoute(http.MethodPost, "/api/attachments/presigned-url", ratelimit.PerMinute("presign", 30)).
+			WithRoute(http.MethodGet, "/api/projects/search", ratelimit.PerMinute("search", 30)).
+			WithRoute(http.MethodGet, "/api/search", ratelimit.PerMinute("global-search", 60)).
+			WithRoute(http.MethodGet, "/api/boards/search", ratelimit.PerMinute("board-search", 60)).
+			WithRoute(http.MethodPost, "/api/join-requests", ratelimit.PerMinute("join", 10))
+		rateLimitMiddleware = ratelimit.PolicyMiddleware(limiter, policies, cfg.Logger)
 		cfg.Logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimitConfig.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimitConfig.BurstSize))
@@ -88,31 +159,138 @@ func Setup(cfg Config) *gin.Engine {
 	commentRepo := repository.NewCommentRepository(cfg.DB)
 	fieldOptionRepo := repository.NewFieldOptionRepository(cfg.DB)
 	attachmentRepo := repository.NewAttachmentRepository(cfg.DB)
+	activityRepo := repository.NewActivityLogRepository(cfg.DB)
+	subtaskRepo := repository.NewSubtaskRepository(cfg.DB)
+	templateRepo := repository.NewBoardTemplateRepository(cfg.DB)
+	watcherRepo := repository.NewWatcherRepository(cfg.DB)
+	reactionRepo := repository.NewCommentReactionRepository(cfg.DB)
+	linkRepo := repository.NewBoardLinkRepository(cfg.DB)
+	customFieldRepo := repository.NewCustomFieldRepository(cfg.DB)
+
+	// 프로젝트 메타데이터, 필드 옵션, 칸반 카드 순서는 Redis에 캐시하고 쓰기 경로에서 무효화 (Redis 없으면 DB 직접 조회)
+	if cfg.RedisClient != nil {
+		projectRepo = repository.NewCachedProjectRepository(projectRepo,
+			cache.New[*domain.Project](cfg.RedisClient, "board:project", cache.Options{TTL: 5 * time.Minute, Logger: cfg.Logger}))
+		fieldOptionRepo = repository.NewCachedFieldOptionRepository(fieldOptionRepo,
+			cache.New[[]*domain.FieldOption](cfg.RedisClient, "board:field_options", cache.Options{TTL: 10 * time.Minute, Logger: cfg.Logger}))
+		boardRepo = repository.NewCachedBoardRepository(boardRepo, cfg.RedisClient, 10*time.Minute, cfg.Logger)
+	}
+
+	// Internal gRPC API (board batch fetch)
+	if cfg.GRPCServer != nil {
+		internalrpc.RegisterBoardServiceServer(cfg.GRPCServer, grpcserver.NewBoardServer(boardRepo))
+	}
 
 	// Initialize converters
-	fieldOptionConverter := converter.NewFieldOptionConverter(fieldOptionRepo)
+	fieldOptionConverter := converter.NewFieldOptionConverter(fieldOptionRepo, converter.WithCustomFields(customFieldRepo))
+
+	// 워크스페이스 멤버십은 짧게 캐시하고, 서비스 레이어의 확인도 같은 캐시를 사용
+	// (요청이 워크스페이스를 지정하면 다른 워크스페이스 리소스 접근은 거부됨)
+	// 멤버 변경 이벤트를 구독하면 레플리카가 공유하는 Redis 캐시를 한 단계 더 둠
+	var membershipSource tenancy.MembershipChecker = cfg.UserClient
+	if cfg.CacheInvalidator != nil && cfg.RedisClient != nil {
+		sharedMembership := cache.New[bool](cfg.RedisClient, "board:membership", cache.Options{TTL: 10 * time.Minute, Logger: cfg.Logger})
+		membershipSource = client.WithSharedMembershipCache(cfg.UserClient, sharedMembership)
+		for _, eventType := range []string{messaging.EventMemberAdded, messaging.EventMemberRemoved, messaging.EventMemberRoleChanged} {
+			cfg.CacheInvalidator.Evict(eventType, sharedMembership, client.MembershipEventKeys)
+		}
+	}
+	membership := tenancy.NewCachedChecker(membershipSource, tenancy.DefaultCacheConfig())
+	if cfg.CacheInvalidator != nil {
+		// 이벤트를 처리한 레플리카의 프로세스 캐시도 지움 (다른 레플리카는 MemberTTL 이내에 만료)
+		cfg.CacheInvalidator.On(messaging.EventMemberRemoved, func(ctx context.Context, event *messaging.Event) error {
+			workspaceID, userID, err := client.MemberEventIDs(event)
+			if err != nil {
+				return messaging.Permanent(err)
+			}
+			membership.Invalidate(workspaceID, userID)
+			return nil
+		})
+	}
+	userClient := client.WithMembershipCache(cfg.UserClient, membership)
 
 	// Initialize services with repository dependencies
-	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, cfg.UserClient, cfg.Metrics, cfg.Logger)
-	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, cfg.Metrics, cfg.Logger)
+	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, userClient, cfg.Metrics, cfg.Logger)
+	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, cfg.Metrics, cfg.Logger, service.WithEvents(cfg.Events), service.WithOutbox(cfg.DB, cfg.Outbox), service.WithActivityLog(activityRepo), service.WithSubtasks(subtaskRepo), service.WithBoardTemplates(templateRepo), service.WithWatchers(watcherRepo), service.WithBoardLinks(linkRepo), service.WithCustomFields(customFieldRepo), service.WithAssigneeProfiles(userClient), service.WithTrashRetention(cfg.TrashRetention))
 	participantService := service.NewParticipantService(participantRepo, boardRepo)
-	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, cfg.S3Client, cfg.NotiClient, cfg.Logger)
+	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, cfg.S3Client, cfg.NotiClient, cfg.Logger, service.WithMentionProfiles(userClient), service.WithReactions(reactionRepo))
+	subtaskService := service.NewSubtaskService(subtaskRepo, boardRepo, cfg.DB)
+	templateService := service.NewBoardTemplateService(templateRepo, projectRepo, fieldOptionConverter)
+	watcherService := service.NewWatcherService(watcherRepo, boardRepo)
+	reactionService := service.NewCommentReactionService(reactionRepo, commentRepo, boardRepo)
+	linkService := service.NewBoardLinkService(linkRepo, boardRepo, cfg.DB)
 	fieldOptionService := service.NewFieldOptionService(fieldOptionRepo)
-	projectMemberService := service.NewProjectMemberService(projectRepo, cfg.UserClient)
-	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, cfg.UserClient)
+	customFieldService := service.NewCustomFieldService(customFieldRepo, fieldOptionRepo, projectRepo, cfg.DB, cfg.Logger)
+	projectExportService := service.NewProjectExportService(repository.NewProjectExportRepository(cfg.DB), projectRepo, customFieldRepo, fieldOptionConverter, cfg.Logger)
+	projectStatsService := service.NewProjectStatsService(repository.NewProjectStatsRepository(cfg.DB), projectRepo, fieldOptionRepo, cfg.Logger)
+	projectMemberService := service.NewProjectMemberService(projectRepo, userClient)
+	projectPermissionService := service.NewProjectPermissionService(projectRepo, cfg.Logger)
+	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, userClient)
+	searchService := service.NewSearchService(repository.NewSearchRepository(cfg.DB), cfg.SearchClient, userClient, 0, cfg.Logger)
 
 	// Initialize handlers with service dependencies
 	projectHandler := handler.NewProjectHandler(projectService)
-	boardHandler := handler.NewBoardHandler(boardService, cfg.NotiClient)
+	notificationWorkers := lifecycle.NewWorkers()
+	boardHandler := handler.NewBoardHandler(boardService, cfg.NotiClient, notificationWorkers)
 	participantHandler := handler.NewParticipantHandler(participantService)
 	commentHandler := handler.NewCommentHandler(commentService)
+	subtaskHandler := handler.NewSubtaskHandler(subtaskService)
+	templateHandler := handler.NewBoardTemplateHandler(templateService)
+	watcherHandler := handler.NewWatcherHandler(watcherService)
+	reactionHandler := handler.NewCommentReactionHandler(reactionService)
+	linkHandler := handler.NewBoardLinkHandler(linkService)
 	fieldOptionHandler := handler.NewFieldOptionHandler(fieldOptionService)
+	customFieldHandler := handler.NewCustomFieldHandler(customFieldService)
+	projectExportHandler := handler.NewProjectExportHandler(projectExportService)
+	projectStatsHandler := handler.NewProjectStatsHandler(projectStatsService)
 	projectMemberHandler := handler.NewProjectMemberHandler(projectMemberService)
+	projectPermissionHandler := handler.NewProjectPermissionHandler(projectPermissionService)
 	projectJoinRequestHandler := handler.NewProjectJoinRequestHandler(projectJoinRequestService)
 	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo)
+	searchHandler := handler.NewSearchHandler(searchService)
 
 	// 💡 WebSocket Handler 초기화
-	wsHandler := handler.NewWSHandler(cfg.Logger, cfg.UserClient)
+	wsHandler := handler.NewWSHandler(cfg.Logger, userClient, projectRepo)
+
+	// 종료 시 close 프레임으로 WebSocket을 닫은 뒤 진행 중인 알림 전송을 기다립니다.
+	cfg.Shutdown.Add("websocket", handler.CloseAllClients)
+	cfg.Shutdown.Add("notifications", notificationWorkers.Wait)
+
+	// 레플리카가 여러 개여도 실시간 이벤트가 모든 파드의 연결에 전달되도록 Redis pub/sub으로 fan-out합니다.
+	// Redis가 없으면 같은 파드에 연결된 클라이언트에만 전달됩니다.
+	handler.InitWSLogger(cfg.Logger)
+	if cfg.RedisClient != nil {
+		stopFanout, err := handler.StartWSFanout(cfg.RedisClient, cfg.Logger)
+		if err != nil {
+			cfg.Logger.Warn("WebSocket fan-out unavailable, events reach this pod's clients only", zap.Error(err))
+		} else {
+			cfg.Shutdown.Add("websocket-fanout", stopFanout)
+		}
+	}
+
+	if cfg.Scheduler != nil {
+		recurringBoardJob := job.NewRecurringBoardJob(boardService, cfg.Logger)
+		if _, err := cfg.Scheduler.AddFunc("@every 1m", recurringBoardJob.Run); err != nil {
+			cfg.Logger.Error("Failed to schedule recurring board job", zap.Error(err))
+		}
+		trashPurgeJob := job.NewBoardTrashPurgeJob(boardService, cfg.Logger)
+		if _, err := cfg.Scheduler.AddFunc("@every 10m", trashPurgeJob.Run); err != nil {
+			cfg.Logger.Error("Failed to schedule board trash purge job", zap.Error(err))
+		}
+		if cfg.NotiClient != nil && len(cfg.DueReminderWindows) > 0 {
+			dueReminderJob := job.NewDueReminderJob(boardService, cfg.DueReminderWindows, cfg.Logger)
+			if _, err := cfg.Scheduler.AddFunc("@every 1m", dueReminderJob.Run); err != nil {
+				cfg.Logger.Error("Failed to schedule due reminder job", zap.Error(err))
+			}
+		}
+		if cfg.S3Client != nil {
+			// CONFIRMED 이미지 첨부파일의 썸네일을 API 요청과 분리해 생성
+			thumbnailJob := job.NewThumbnailJob(repository.NewAttachmentThumbnailRepository(cfg.DB), cfg.S3Client, cfg.Logger)
+			if _, err := cfg.Scheduler.AddFunc("@every 1m", thumbnailJob.Run); err != nil {
+				cfg.Logger.Error("Failed to schedule thumbnail job", zap.Error(err))
+			}
+		}
+	}
 
 	// Create base path group if configured
 	var baseGroup *gin.RouterGroup
@@ -125,7 +303,12 @@ func Setup(cfg Config) *gin.Engine {
 	}
 
 	// Health check endpoints using common package
-	healthChecker := commonhealth.NewHealthChecker(cfg.DB, database.GetRedis())
+	healthChecker := commonhealth.NewHealthChecker(cfg.DB, database.GetRedis()).
+		AddDownstream("auth-service", cfg.AuthServiceURL, commonhealth.AuthServiceLivenessPath).
+		AddDownstream("user-service", cfg.UserServiceBaseURL, commonhealth.LivenessPath)
+	if cfg.Replicas != nil {
+		healthChecker.AddCheck("db-replicas", cfg.Replicas.Check, commonhealth.NonCritical())
+	}
 	healthChecker.RegisterRoutes(router, cfg.BasePath)
 
 	// Swagger documentation endpoint - temporarily disabled for CI compatibility
@@ -134,11 +317,11 @@ func Setup(cfg Config) *gin.Engine {
 
 	// Metrics endpoint (no authentication required)
 	// Add metrics endpoint at root level for compatibility
-	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	router.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Also add metrics endpoint under base path if configured
 	if cfg.BasePath != "" {
-		baseGroup.GET("/metrics", gin.WrapH(promhttp.Handler()))
+		baseGroup.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 		cfg.Logger.Info("Metrics endpoint configured at both root and base path",
 			zap.String("root_path", "/metrics"),
 			zap.String("base_path", cfg.BasePath+"/metrics"))
@@ -159,14 +342,37 @@ func Setup(cfg Config) *gin.Engine {
 	} else if cfg.AuthServiceURL != "" {
 		// Docker Compose / K8s without Istio: SmartValidator로 전체 검증
 		tokenValidator := middleware.NewSmartValidator(cfg.AuthServiceURL, cfg.JWTIssuer, cfg.Logger)
+		// 로그아웃 등으로 폐기된 토큰 즉시 거부 (Redis 없으면 생략)
+		if cfg.RedisClient != nil {
+			tokenValidator.WithRevocation(commonauth.NewRedisRevocationStore(cfg.RedisClient))
+		}
 		authMiddleware = middleware.AuthWithValidator(tokenValidator)
 		cfg.Logger.Info("Using SmartValidator mode (full validation)",
 			zap.String("auth_service_url", cfg.AuthServiceURL),
-			zap.String("jwt_issuer", cfg.JWTIssuer))
+			zap.String("jwt_issuer", cfg.JWTIssuer),
+			zap.Bool("revocation_check", cfg.RedisClient != nil))
+	}
+
+	// 재시도로 인한 보드/댓글 중복 생성 방지 (Idempotency-Key 헤더, Redis 없으면 생략)
+	var idempotencyMiddleware gin.HandlerFunc
+	if cfg.RedisClient != nil {
+		idempotencyMiddleware = idempotency.Middleware(idempotency.NewRedisStore(cfg.RedisClient), idempotency.DefaultConfig(), cfg.Logger)
+	}
+
+	// Internal routes (user-service workspace export/import, x-internal-api-key)
+	if cfg.InternalAPIKey != "" {
+		backupHandler := handler.NewBackupHandler(service.NewBackupService(repository.NewBackupRepository(cfg.DB)))
+		internal := router.Group(cfg.BasePath + "/internal") // 서비스 간 호출은 버전 없이 유지
+		internal.Use(middleware.InternalAuth(cfg.InternalAPIKey))
+		{
+			internal.GET("/workspaces/:workspaceId/backup", backupHandler.ExportWorkspace)
+			// 백업 섹션은 기본 본문 제한(1MB)보다 클 수 있음
+			internal.POST("/workspaces/:workspaceId/backup", commonmw.BodyLimit(maxBackupBodyBytes), backupHandler.ImportWorkspace)
+		}
 	}
 
 	// Setup API routes
-	setupRoutes(baseGroup, authMiddleware, projectHandler, boardHandler, participantHandler, commentHandler, fieldOptionHandler, projectMemberHandler, projectJoinRequestHandler, attachmentHandler, wsHandler)
+	setupRoutes(baseGroup, authMiddleware, tenancy.Middleware(membership, cfg.Logger), rateLimitMiddleware, idempotencyMiddleware, projectHandler, boardHandler, participantHandler, commentHandler, subtaskHandler, templateHandler, watcherHandler, reactionHandler, linkHandler, fieldOptionHandler, customFieldHandler, projectExportHandler, projectStatsHandler, projectMemberHandler, projectPermissionHandler, projectJoinRequestHandler, attachmentHandler, searchHandler, wsHandler)
 
 	// 🔥 [중요] WebSocket은 baseGroup에 직접 등록 (chat-service와 동일한 패턴)
 	// basePath가 /api/boards일 때: /api/boards/ws/project/:projectId
@@ -179,31 +385,56 @@ func Setup(cfg Config) *gin.Engine {
 func setupRoutes(
 	baseGroup *gin.RouterGroup,
 	authMiddleware gin.HandlerFunc,
+	tenancyMiddleware gin.HandlerFunc,
+	rateLimitMiddleware gin.HandlerFunc,
+	idempotencyMiddleware gin.HandlerFunc,
 	projectHandler *handler.ProjectHandler,
 	boardHandler *handler.BoardHandler,
 	participantHandler *handler.ParticipantHandler,
 	commentHandler *handler.CommentHandler,
+	subtaskHandler *handler.SubtaskHandler,
+	templateHandler *handler.BoardTemplateHandler,
+	watcherHandler *handler.WatcherHandler,
+	reactionHandler *handler.CommentReactionHandler,
+	linkHandler *handler.BoardLinkHandler,
 	fieldOptionHandler *handler.FieldOptionHandler,
+	customFieldHandler *handler.CustomFieldHandler,
+	projectExportHandler *handler.ProjectExportHandler,
+	projectStatsHandler *handler.ProjectStatsHandler,
 	projectMemberHandler *handler.ProjectMemberHandler,
+	projectPermissionHandler *handler.ProjectPermissionHandler,
 	projectJoinRequestHandler *handler.ProjectJoinRequestHandler,
 	attachmentHandler *handler.AttachmentHandler,
+	searchHandler *handler.SearchHandler,
 	wsHandler *handler.WSHandler, // 🔥 온라인 사용자 조회용
 ) {
-	// API group with authentication
-	api := baseGroup.Group("/api")
+	// API group with authentication (/api와 /api/v1 모두 현재 v1 라우트로 처리)
+	api := apiversion.WithLegacy(baseGroup, "/api", apiversion.V1)
 	if authMiddleware != nil {
 		api.Use(authMiddleware)
+		// 워크스페이스 지정 요청(:workspaceId, ?workspaceId, X-Workspace-Id)의 멤버십 확인
+		api.Use(tenancyMiddleware)
+	}
+	if rateLimitMiddleware != nil {
+		api.Use(rateLimitMiddleware)
+	}
+	if idempotencyMiddleware != nil {
+		// 인증 뒤에 적용해 키를 사용자별로 분리
+		api.Use(idempotencyMiddleware)
 	}
 	{
+		// Global search (boards, comments, chat messages, files, members)
+		api.GET("/search", dbreplica.ReadFromReplica(), searchHandler.Search)
+
 		// Project routes
 		projects := api.Group("/projects")
 		{
 			// Frontend compatibility route (query parameter style)
-			projects.GET("", projectHandler.GetProjectsByWorkspaceQuery)
+			projects.GET("", dbreplica.ReadFromReplica(), projectHandler.GetProjectsByWorkspaceQuery)
 
 			// Existing routes
 			projects.POST("", projectHandler.CreateProject)
-			projects.GET("/workspace/:workspaceId", projectHandler.GetProjectsByWorkspace)
+			projects.GET("/workspace/:workspaceId", dbreplica.ReadFromReplica(), projectHandler.GetProjectsByWorkspace)
 			projects.GET("/workspace/:workspaceId/default", projectHandler.GetDefaultProject)
 
 			// New project management extension routes
@@ -211,16 +442,42 @@ func setupRoutes(
 			projects.GET("/:projectId", projectHandler.GetProject)
 			projects.PUT("/:projectId", projectHandler.UpdateProject)
 			projects.DELETE("/:projectId", projectHandler.DeleteProject)
+			projects.POST("/:projectId/archive", projectHandler.ArchiveProject)
+			projects.POST("/:projectId/unarchive", projectHandler.UnarchiveProject)
 			projects.GET("/:projectId/init-settings", projectHandler.GetProjectInitSettings)
 
 			// Project member routes
-			projects.GET("/:projectId/members", projectMemberHandler.GetMembers)
+			projects.GET("/:projectId/members", dbreplica.ReadFromReplica(), projectMemberHandler.GetMembers)
 			projects.DELETE("/:projectId/members/:memberId", projectMemberHandler.RemoveMember)
 			projects.PUT("/:projectId/members/:memberId/role", projectMemberHandler.UpdateMemberRole)
 
+			// Project permission matrix routes (역할별 권한, OWNER만 수정)
+			projects.GET("/:projectId/permissions", projectPermissionHandler.GetPermissions)
+			projects.PUT("/:projectId/permissions", projectPermissionHandler.UpdatePermissions)
+
 			// Project join request routes
 			projects.GET("/:projectId/join-requests", projectJoinRequestHandler.GetJoinRequests)
 
+			// Board template routes
+			projects.GET("/:projectId/board-templates", templateHandler.GetTemplates)
+			projects.POST("/:projectId/board-templates", templateHandler.CreateTemplate)
+
+			// Board trash route (삭제 직후 목록에 보이도록 primary에서 읽음)
+			projects.GET("/:projectId/trash", boardHandler.GetBoardTrash)
+
+			// Board import routes (CSV/XLSX 파일은 기본 본문 제한(1MB)보다 클 수 있음)
+			projects.POST("/:projectId/boards/import", commonmw.BodyLimit(handler.MaxBoardImportFileBytes), boardHandler.ImportBoards)
+
+			// Custom field routes
+			projects.GET("/:projectId/custom-fields", customFieldHandler.GetCustomFields)
+			projects.POST("/:projectId/custom-fields", customFieldHandler.CreateCustomField)
+
+			// Project export route (Board를 배치 단위로 스트리밍)
+			projects.GET("/:projectId/export", dbreplica.ReadFromReplica(), projectExportHandler.ExportProject)
+			projects.GET("/:projectId/stats/throughput", dbreplica.ReadFromReplica(), projectStatsHandler.GetThroughput)
+			projects.GET("/:projectId/stats/cycle-time", dbreplica.ReadFromReplica(), projectStatsHandler.GetCycleTime)
+			projects.GET("/:projectId/stats/assignee-load", dbreplica.ReadFromReplica(), projectStatsHandler.GetAssigneeLoad)
+
 			// Attachment routes for projects
 			projects.GET("/:projectId/attachments", attachmentHandler.GetProjectAttachments)
 
@@ -239,19 +496,56 @@ func setupRoutes(
 		boards := api.Group("/boards")
 		{
 			// Frontend compatibility route (query parameter style)
-			boards.GET("", boardHandler.GetBoardsByProjectQuery)
+			boards.GET("", dbreplica.ReadFromReplica(), commonmw.ETag(), boardHandler.GetBoardsByProjectQuery)
 
 			boards.POST("", boardHandler.CreateBoard)
 			boards.GET("/:boardId", boardHandler.GetBoard)
-			boards.GET("/project/:projectId", boardHandler.GetBoardsByProject)
+			boards.GET("/project/:projectId", dbreplica.ReadFromReplica(), commonmw.ETag(), boardHandler.GetBoardsByProject)
+			boards.GET("/search", dbreplica.ReadFromReplica(), searchHandler.SearchBoards)
+			// 캐시가 비면 DB에서 다시 채우므로 복제 지연이 캐시에 남지 않도록 primary에서 읽음
+			boards.GET("/project/:projectId/order", boardHandler.GetColumnOrder)
+			boards.GET("/project/:projectId/grouped", boardHandler.GetGroupedBoards)
 			boards.PUT("/:boardId", boardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", boardHandler.DeleteBoard)
+			boards.POST("/:boardId/archive", boardHandler.ArchiveBoard)
+			boards.POST("/:boardId/unarchive", boardHandler.UnarchiveBoard)
+			boards.POST("/:boardId/restore", boardHandler.RestoreBoard)
 			boards.PUT("/:boardId/move", boardHandler.MoveBoard) // ✅ 이 라인 추가
+			boards.GET("/:boardId/activities", boardHandler.GetBoardActivities)
+			boards.PUT("/:boardId/recurrence", boardHandler.SetBoardRecurrence)
+			boards.DELETE("/:boardId/recurrence", boardHandler.DeleteBoardRecurrence)
+			boards.POST("/bulk", boardHandler.BulkUpdateBoards)
+			boards.POST("/from-template/:templateId", boardHandler.CreateBoardFromTemplate)
+
+			// Subtask (checklist) routes for boards
+			boards.GET("/:boardId/subtasks", subtaskHandler.GetSubtasks)
+			boards.POST("/:boardId/subtasks", subtaskHandler.CreateSubtask)
+			boards.PUT("/:boardId/subtasks/:subtaskId", subtaskHandler.UpdateSubtask)
+			boards.DELETE("/:boardId/subtasks/:subtaskId", subtaskHandler.DeleteSubtask)
+
+			// Comment routes for boards (threaded=true이면 답글을 중첩)
+			boards.GET("/:boardId/comments", commentHandler.GetBoardComments)
+
+			// Board link routes (BLOCKS/BLOCKED_BY/RELATES_TO, 같은 프로젝트 안에서만)
+			boards.GET("/:boardId/links", linkHandler.GetLinks)
+			boards.POST("/:boardId/links", linkHandler.CreateLink)
+			boards.DELETE("/:boardId/links/:linkId", linkHandler.DeleteLink)
+
+			// Watcher routes (알림만 받는 구독, 참여자와 별개)
+			boards.POST("/:boardId/watchers/me", watcherHandler.WatchBoard)
+			boards.DELETE("/:boardId/watchers/me", watcherHandler.UnwatchBoard)
 
 			// Attachment routes for boards
 			boards.GET("/:boardId/attachments", attachmentHandler.GetBoardAttachments)
 		}
 
+		// Board template routes (생성/목록은 /projects/:projectId/board-templates)
+		boardTemplates := api.Group("/board-templates")
+		{
+			boardTemplates.PUT("/:templateId", templateHandler.UpdateTemplate)
+			boardTemplates.DELETE("/:templateId", templateHandler.DeleteTemplate)
+		}
+
 		// Participant routes
 		participants := api.Group("/participants")
 		{
@@ -271,6 +565,10 @@ func setupRoutes(
 			comments.PUT("/:commentId", commentHandler.UpdateComment)
 			comments.DELETE("/:commentId", commentHandler.DeleteComment)
 
+			// Reaction routes for comments (이모지는 URL 인코딩된 경로 파라미터)
+			comments.PUT("/:commentId/reactions/:emoji", reactionHandler.AddReaction)
+			comments.DELETE("/:commentId/reactions/:emoji", reactionHandler.RemoveReaction)
+
 			// Attachment routes for comments
 			comments.GET("/:commentId/attachments", attachmentHandler.GetCommentAttachments)
 		}
@@ -284,11 +582,20 @@ func setupRoutes(
 			fieldOptions.DELETE("/:optionId", fieldOptionHandler.DeleteFieldOption)
 		}
 
+		// Custom field routes (생성/목록은 /projects/:projectId/custom-fields)
+		customFields := api.Group("/custom-fields")
+		{
+			customFields.PATCH("/:fieldId", customFieldHandler.UpdateCustomField)
+			customFields.DELETE("/:fieldId", customFieldHandler.DeleteCustomField)
+		}
+
 		// Attachment routes (Presigned URL approach)
 		attachments := api.Group("/attachments")
 		{
 			// Generate presigned URL for direct S3 upload
 			attachments.POST("/presigned-url", attachmentHandler.GeneratePresignedURL)
+			// Verify the S3 object after the client's PUT to the presigned URL
+			attachments.POST("/:attachmentId/confirm", attachmentHandler.ConfirmUpload)
 			// Save attachment metadata after successful S3 upload
 			attachments.POST("", attachmentHandler.SaveAttachmentMetadata)
 			// Delete attachment
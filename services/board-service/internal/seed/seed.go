@@ -0,0 +1,116 @@
+// Package seed writes the board-service part of the development dataset.
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/service"
+)
+
+// Run inserts projects, project members, default field options, boards and participants from data.
+// Existing rows are left untouched.
+func Run(ctx context.Context, db *gorm.DB, data *commonseed.Dataset) error {
+	now := time.Now().UTC()
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range data.Projects {
+			if err := commonseed.Insert(tx, &domain.Project{
+				BaseModel:   domain.BaseModel{ID: p.ID, CreatedAt: now, UpdatedAt: now},
+				WorkspaceID: p.WorkspaceID,
+				OwnerID:     p.OwnerID,
+				Name:        p.Name,
+				Description: p.Description,
+			}); err != nil {
+				return err
+			}
+
+			for _, m := range p.Members {
+				if err := commonseed.Insert(tx, &domain.ProjectMember{
+					ID:        commonseed.ID("project-member", p.ID.String()+"/"+m.UserID.String()),
+					ProjectID: p.ID,
+					UserID:    m.UserID,
+					RoleName:  domain.ProjectRole(m.Role),
+					JoinedAt:  now,
+				}); err != nil {
+					return err
+				}
+			}
+
+			for _, option := range service.DefaultFieldOptions(p.ID) {
+				option.ID = commonseed.ID("field-option", fmt.Sprintf("%s/%s/%s", p.ID, option.FieldType, option.Value))
+				if err := commonseed.Insert(tx, option); err != nil {
+					return err
+				}
+			}
+			// Boards store field option IDs; read them back in case the project already had options
+			var options []domain.FieldOption
+			if err := tx.Where("project_id = ?", p.ID).Find(&options).Error; err != nil {
+				return err
+			}
+			optionIDs := make(map[string]uuid.UUID, len(options))
+			for _, option := range options {
+				optionIDs[string(option.FieldType)+"/"+option.Value] = option.ID
+			}
+
+			for _, b := range p.Boards {
+				if err := insertBoard(tx, p.ID, b, optionIDs, now); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func insertBoard(tx *gorm.DB, projectID uuid.UUID, b commonseed.Board, optionIDs map[string]uuid.UUID, now time.Time) error {
+	customFields := map[string]string{}
+	for fieldType, value := range map[domain.FieldType]string{
+		domain.FieldTypeStage:      b.Stage,
+		domain.FieldTypeImportance: b.Importance,
+		domain.FieldTypeRole:       b.Role,
+	} {
+		if id, ok := optionIDs[string(fieldType)+"/"+value]; ok && value != "" {
+			customFields[string(fieldType)] = id.String()
+		}
+	}
+	raw, err := json.Marshal(customFields)
+	if err != nil {
+		return err
+	}
+
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: b.ID, CreatedAt: now, UpdatedAt: now},
+		ProjectID:    projectID,
+		AuthorID:     b.AuthorID,
+		AssigneeID:   b.AssigneeID,
+		Title:        b.Title,
+		Content:      b.Content,
+		CustomFields: datatypes.JSON(raw),
+	}
+	if b.DueInDays > 0 {
+		start := now.AddDate(0, 0, -1)
+		due := now.AddDate(0, 0, b.DueInDays)
+		board.StartDate, board.DueDate = &start, &due
+	}
+	if err := commonseed.Insert(tx, board); err != nil {
+		return err
+	}
+
+	if b.AssigneeID == nil {
+		return nil
+	}
+	return commonseed.Insert(tx, &domain.Participant{
+		BaseModel: domain.BaseModel{ID: commonseed.ID("participant", b.ID.String()+"/"+b.AssigneeID.String()), CreatedAt: now, UpdatedAt: now},
+		BoardID:   b.ID,
+		UserID:    *b.AssigneeID,
+	})
+}
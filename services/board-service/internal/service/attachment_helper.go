@@ -27,6 +27,15 @@ func toDomainAttachments(attachments []*domain.Attachment) []domain.Attachment {
 	return result
 }
 
+// thumbnailURL returns the public URL of a thumbnail key, or nil when the thumbnail has not been generated
+func thumbnailURL(getFileURL func(key string) string, key *string) *string {
+	if key == nil || *key == "" {
+		return nil
+	}
+	url := getFileURL(*key)
+	return &url
+}
+
 // removeDuplicateUUIDs removes duplicate UUIDs from a slice
 func removeDuplicateUUIDs(uuids []uuid.UUID) []uuid.UUID {
 	seen := make(map[uuid.UUID]bool)
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// BackupService defines the interface for workspace export and import.
+// It is called by user-service, which bundles every service's section into the workspace archive.
+type BackupService interface {
+	Export(ctx context.Context, workspaceID uuid.UUID) (*dto.WorkspaceBackup, error)
+	Import(ctx context.Context, workspaceID uuid.UUID, backup *dto.WorkspaceBackup) (*dto.BackupImportResult, error)
+}
+
+// backupServiceImpl is the implementation of BackupService
+type backupServiceImpl struct {
+	backupRepo repository.BackupRepository
+}
+
+// NewBackupService creates a new instance of BackupService
+func NewBackupService(backupRepo repository.BackupRepository) BackupService {
+	return &backupServiceImpl{backupRepo: backupRepo}
+}
+
+// Export returns the workspace's projects, members, field options, boards, participants and comments
+func (s *backupServiceImpl) Export(ctx context.Context, workspaceID uuid.UUID) (*dto.WorkspaceBackup, error) {
+	snapshot, err := s.backupRepo.LoadWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to load workspace data", err.Error())
+	}
+
+	backup := &dto.WorkspaceBackup{
+		FormatVersion:  dto.BackupFormatVersion,
+		WorkspaceID:    workspaceID,
+		Projects:       make([]dto.ProjectBackup, len(snapshot.Projects)),
+		ProjectMembers: make([]dto.ProjectMemberBackup, len(snapshot.ProjectMembers)),
+		FieldOptions:   make([]dto.FieldOptionBackup, len(snapshot.FieldOptions)),
+		Boards:         make([]dto.BoardBackup, len(snapshot.Boards)),
+		Participants:   make([]dto.ParticipantBackup, len(snapshot.Participants)),
+		Comments:       make([]dto.CommentBackup, len(snapshot.Comments)),
+	}
+	for i, p := range snapshot.Projects {
+		backup.Projects[i] = dto.ProjectBackup{
+			ID: p.ID, OwnerID: p.OwnerID, Name: p.Name, Description: p.Description,
+			StartDate: p.StartDate, DueDate: p.DueDate, IsDefault: p.IsDefault, IsPublic: p.IsPublic,
+			CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+		}
+	}
+	for i, m := range snapshot.ProjectMembers {
+		backup.ProjectMembers[i] = dto.ProjectMemberBackup{
+			ID: m.ID, ProjectID: m.ProjectID, UserID: m.UserID, RoleName: string(m.RoleName), JoinedAt: m.JoinedAt,
+		}
+	}
+	for i, o := range snapshot.FieldOptions {
+		backup.FieldOptions[i] = dto.FieldOptionBackup{
+			ID: o.ID, ProjectID: *o.ProjectID, FieldType: string(o.FieldType), Value: o.Value, Label: o.Label,
+			Color: o.Color, DisplayOrder: o.DisplayOrder, CreatedAt: o.CreatedAt, UpdatedAt: o.UpdatedAt,
+		}
+	}
+	for i, b := range snapshot.Boards {
+		backup.Boards[i] = dto.BoardBackup{
+			ID: b.ID, ProjectID: b.ProjectID, AuthorID: b.AuthorID, AssigneeID: b.AssigneeID,
+			Title: b.Title, Content: b.Content, CustomFields: []byte(b.CustomFields),
+			StartDate: b.StartDate, DueDate: b.DueDate, Position: b.Position, CreatedAt: b.CreatedAt, UpdatedAt: b.UpdatedAt,
+		}
+	}
+	for i, p := range snapshot.Participants {
+		backup.Participants[i] = dto.ParticipantBackup{ID: p.ID, BoardID: p.BoardID, UserID: p.UserID, CreatedAt: p.CreatedAt}
+	}
+	for i, c := range snapshot.Comments {
+		backup.Comments[i] = dto.CommentBackup{
+			ID: c.ID, BoardID: c.BoardID, UserID: c.UserID, Content: c.Content, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+		}
+	}
+	return backup, nil
+}
+
+// Import restores an exported section into workspaceID. Rows that already exist are kept as is,
+// so importing the same archive twice (e.g. a retried job) does not duplicate data.
+func (s *backupServiceImpl) Import(ctx context.Context, workspaceID uuid.UUID, backup *dto.WorkspaceBackup) (*dto.BackupImportResult, error) {
+	if backup.FormatVersion != dto.BackupFormatVersion {
+		return nil, response.NewValidationError(fmt.Sprintf("Unsupported backup format version: %d", backup.FormatVersion), "")
+	}
+	if backup.WorkspaceID != workspaceID {
+		return nil, response.NewValidationError("Backup belongs to a different workspace", "")
+	}
+	snapshot, err := backupToSnapshot(workspaceID, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.backupRepo.RestoreWorkspace(ctx, snapshot)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to restore workspace data", err.Error())
+	}
+	return &dto.BackupImportResult{
+		Projects:       counts.Projects,
+		ProjectMembers: counts.ProjectMembers,
+		FieldOptions:   counts.FieldOptions,
+		Boards:         counts.Boards,
+		Participants:   counts.Participants,
+		Comments:       counts.Comments,
+	}, nil
+}
+
+// backupToSnapshot converts an imported section to domain rows, rejecting rows that
+// reference a project or board outside the section (the archive would not restore cleanly)
+func backupToSnapshot(workspaceID uuid.UUID, backup *dto.WorkspaceBackup) (*repository.WorkspaceSnapshot, error) {
+	snapshot := &repository.WorkspaceSnapshot{}
+	projects := make(map[uuid.UUID]bool, len(backup.Projects))
+	boards := make(map[uuid.UUID]bool, len(backup.Boards))
+	invalid := func(kind string, id uuid.UUID) error {
+		return response.NewValidationError(fmt.Sprintf("Backup %s %s references a missing parent", kind, id), "")
+	}
+
+	for _, p := range backup.Projects {
+		projects[p.ID] = true
+		snapshot.Projects = append(snapshot.Projects, domain.Project{
+			BaseModel:   domain.BaseModel{ID: p.ID, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt},
+			WorkspaceID: workspaceID, OwnerID: p.OwnerID, Name: p.Name, Description: p.Description,
+			StartDate: p.StartDate, DueDate: p.DueDate, IsDefault: p.IsDefault, IsPublic: p.IsPublic,
+		})
+	}
+	for _, m := range backup.ProjectMembers {
+		if !projects[m.ProjectID] {
+			return nil, invalid("project member", m.ID)
+		}
+		snapshot.ProjectMembers = append(snapshot.ProjectMembers, domain.ProjectMember{
+			ID: m.ID, ProjectID: m.ProjectID, UserID: m.UserID, RoleName: domain.ProjectRole(m.RoleName), JoinedAt: m.JoinedAt,
+		})
+	}
+	for _, o := range backup.FieldOptions {
+		if !projects[o.ProjectID] {
+			return nil, invalid("field option", o.ID)
+		}
+		projectID := o.ProjectID
+		snapshot.FieldOptions = append(snapshot.FieldOptions, domain.FieldOption{
+			BaseModel: domain.BaseModel{ID: o.ID, CreatedAt: o.CreatedAt, UpdatedAt: o.UpdatedAt},
+			ProjectID: &projectID, FieldType: domain.FieldType(o.FieldType), Value: o.Value, Label: o.Label,
+			Color: o.Color, DisplayOrder: o.DisplayOrder,
+		})
+	}
+	for _, b := range backup.Boards {
+		if !projects[b.ProjectID] {
+			return nil, invalid("board", b.ID)
+		}
+		boards[b.ID] = true
+		snapshot.Boards = append(snapshot.Boards, domain.Board{
+			BaseModel: domain.BaseModel{ID: b.ID, CreatedAt: b.CreatedAt, UpdatedAt: b.UpdatedAt},
+			ProjectID: b.ProjectID, AuthorID: b.AuthorID, AssigneeID: b.AssigneeID,
+			Title: b.Title, Content: b.Content, CustomFields: datatypes.JSON(b.CustomFields),
+			StartDate: b.StartDate, DueDate: b.DueDate, Position: b.Position,
+		})
+	}
+	for _, p := range backup.Participants {
+		if !boards[p.BoardID] {
+			return nil, invalid("participant", p.ID)
+		}
+		snapshot.Participants = append(snapshot.Participants, domain.Participant{
+			BaseModel: domain.BaseModel{ID: p.ID, CreatedAt: p.CreatedAt, UpdatedAt: p.CreatedAt},
+			BoardID:   p.BoardID, UserID: p.UserID,
+		})
+	}
+	for _, c := range backup.Comments {
+		if !boards[c.BoardID] {
+			return nil, invalid("comment", c.ID)
+		}
+		snapshot.Comments = append(snapshot.Comments, domain.Comment{
+			BaseModel: domain.BaseModel{ID: c.ID, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt},
+			BoardID:   c.BoardID, UserID: c.UserID, Content: c.Content,
+		})
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// TestBackupService_RoundTrip은 내보낸 섹션을 다시 가져오면 같은 행이 복원되는지 테스트합니다.
+func TestBackupService_RoundTrip(t *testing.T) {
+	workspaceID := uuid.New()
+	userID := uuid.New()
+	projectID, boardID := uuid.New(), uuid.New()
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	loaded := &repository.WorkspaceSnapshot{
+		Projects: []domain.Project{{
+			BaseModel:   domain.BaseModel{ID: projectID, CreatedAt: createdAt, UpdatedAt: createdAt},
+			WorkspaceID: workspaceID, OwnerID: userID, Name: "Launch", IsDefault: true,
+		}},
+		ProjectMembers: []domain.ProjectMember{{ID: uuid.New(), ProjectID: projectID, UserID: userID, RoleName: domain.ProjectRoleOwner, JoinedAt: createdAt}},
+		FieldOptions: []domain.FieldOption{{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: &projectID, FieldType: domain.FieldTypeStage, Value: "qa", Label: "QA", Color: "#FF0000",
+		}},
+		Boards: []domain.Board{{
+			BaseModel: domain.BaseModel{ID: boardID, CreatedAt: createdAt, UpdatedAt: createdAt},
+			ProjectID: projectID, AuthorID: userID, Title: "Release", CustomFields: datatypes.JSON(`{"stage":"qa"}`),
+		}},
+		Participants: []domain.Participant{{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt}, BoardID: boardID, UserID: userID}},
+		Comments:     []domain.Comment{{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID, Content: "LGTM"}},
+	}
+
+	var restored *repository.WorkspaceSnapshot
+	repo := &MockBackupRepository{
+		LoadWorkspaceFunc: func(ctx context.Context, id uuid.UUID) (*repository.WorkspaceSnapshot, error) {
+			return loaded, nil
+		},
+		RestoreWorkspaceFunc: func(ctx context.Context, snapshot *repository.WorkspaceSnapshot) (*repository.RestoreCounts, error) {
+			restored = snapshot
+			return &repository.RestoreCounts{Projects: 1, Boards: 1}, nil
+		},
+	}
+	s := NewBackupService(repo)
+
+	backup, err := s.Export(context.Background(), workspaceID)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if backup.FormatVersion != dto.BackupFormatVersion || backup.WorkspaceID != workspaceID {
+		t.Fatalf("unexpected backup header: %+v", backup)
+	}
+
+	result, err := s.Import(context.Background(), workspaceID, backup)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if result.Projects != 1 || result.Boards != 1 {
+		t.Errorf("unexpected import result: %+v", result)
+	}
+	if len(restored.Projects) != 1 || restored.Projects[0].WorkspaceID != workspaceID || restored.Projects[0].Name != "Launch" {
+		t.Errorf("project not restored: %+v", restored.Projects)
+	}
+	if len(restored.Boards) != 1 || string(restored.Boards[0].CustomFields) != `{"stage":"qa"}` {
+		t.Errorf("board custom fields not restored: %+v", restored.Boards)
+	}
+	if len(restored.FieldOptions) != 1 || *restored.FieldOptions[0].ProjectID != projectID {
+		t.Errorf("field option not restored: %+v", restored.FieldOptions)
+	}
+	if len(restored.ProjectMembers) != 1 || len(restored.Participants) != 1 || len(restored.Comments) != 1 {
+		t.Errorf("child rows not restored: %+v", restored)
+	}
+}
+
+// TestBackupService_Import_Validation은 잘못된 백업 섹션을 복원 전에 거부하는지 테스트합니다.
+func TestBackupService_Import_Validation(t *testing.T) {
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+
+	tests := []struct {
+		name   string
+		backup dto.WorkspaceBackup
+	}{
+		{name: "지원하지 않는 버전", backup: dto.WorkspaceBackup{FormatVersion: dto.BackupFormatVersion + 1, WorkspaceID: workspaceID}},
+		{name: "다른 워크스페이스", backup: dto.WorkspaceBackup{FormatVersion: dto.BackupFormatVersion, WorkspaceID: uuid.New()}},
+		{
+			name: "없는 Project를 참조하는 Board",
+			backup: dto.WorkspaceBackup{
+				FormatVersion: dto.BackupFormatVersion, WorkspaceID: workspaceID,
+				Projects: []dto.ProjectBackup{{ID: projectID}},
+				Boards:   []dto.BoardBackup{{ID: uuid.New(), ProjectID: uuid.New()}},
+			},
+		},
+		{
+			name: "없는 Board를 참조하는 Comment",
+			backup: dto.WorkspaceBackup{
+				FormatVersion: dto.BackupFormatVersion, WorkspaceID: workspaceID,
+				Projects: []dto.ProjectBackup{{ID: projectID}},
+				Comments: []dto.CommentBackup{{ID: uuid.New(), BoardID: uuid.New()}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockBackupRepository{
+				RestoreWorkspaceFunc: func(ctx context.Context, snapshot *repository.WorkspaceSnapshot) (*repository.RestoreCounts, error) {
+					t.Fatal("invalid backup should not be restored")
+					return nil, nil
+				},
+			}
+			_, err := NewBackupService(repo).Import(context.Background(), workspaceID, &tt.backup)
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+				t.Errorf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
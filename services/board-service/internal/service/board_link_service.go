@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// BoardLinkService defines the interface for board dependency link business logic
+type BoardLinkService interface {
+	CreateLink(ctx context.Context, boardID, userID uuid.UUID, req *dto.CreateBoardLinkRequest) (*dto.BoardLinkResponse, error)
+	GetLinks(ctx context.Context, boardID uuid.UUID) ([]dto.BoardLinkResponse, error)
+	DeleteLink(ctx context.Context, boardID, linkID uuid.UUID) error
+}
+
+// boardLinkServiceImpl is the implementation of BoardLinkService
+type boardLinkServiceImpl struct {
+	linkRepo  repository.BoardLinkRepository
+	boardRepo repository.BoardRepository
+	db        *gorm.DB // optional, serializes cycle detection per project
+}
+
+// NewBoardLinkService creates a new instance of BoardLinkService
+func NewBoardLinkService(linkRepo repository.BoardLinkRepository, boardRepo repository.BoardRepository, db *gorm.DB) BoardLinkService {
+	return &boardLinkServiceImpl{
+		linkRepo:  linkRepo,
+		boardRepo: boardRepo,
+		db:        db,
+	}
+}
+
+// inTx runs fn in a transaction when a database is configured
+func (s *boardLinkServiceImpl) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.db == nil {
+		return fn(ctx)
+	}
+	return uow.Transaction(ctx, s.db, fn)
+}
+
+// CreateLink links a board to another board of the same project.
+// BLOCKED_BY는 방향을 뒤집어 BLOCKS로 저장하며, 의존 관계에 순환이 생기면 거부합니다.
+func (s *boardLinkServiceImpl) CreateLink(ctx context.Context, boardID, userID uuid.UUID, req *dto.CreateBoardLinkRequest) (*dto.BoardLinkResponse, error) {
+	if req.TargetBoardID == boardID {
+		return nil, response.NewValidationError("A board cannot be linked to itself", "")
+	}
+
+	board, err := s.findBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.findBoard(ctx, req.TargetBoardID)
+	if err != nil {
+		return nil, err
+	}
+	if target.ProjectID != board.ProjectID {
+		return nil, response.NewValidationError("Linked boards must belong to the same project", "")
+	}
+
+	link := &domain.BoardLink{
+		ProjectID:     board.ProjectID,
+		SourceBoardID: boardID,
+		TargetBoardID: target.ID,
+		Type:          domain.BoardLinkTypeBlocks,
+		CreatedBy:     userID,
+	}
+	switch req.Type {
+	case dto.BoardLinkBlockedBy:
+		link.SourceBoardID, link.TargetBoardID = target.ID, boardID
+	case dto.BoardLinkRelatesTo:
+		link.Type = domain.BoardLinkTypeRelatesTo
+	}
+
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		existing, err := s.linkRepo.FindByBoardID(ctx, boardID)
+		if err != nil {
+			return response.NewInternalError("Failed to fetch board links", err.Error())
+		}
+		for _, l := range existing {
+			if sameBoardLink(l, link) {
+				return response.NewAppError(response.ErrCodeConflict, "Boards are already linked", "")
+			}
+		}
+
+		if link.Type == domain.BoardLinkTypeBlocks {
+			blocks, err := s.linkRepo.FindBlocksByProjectID(ctx, board.ProjectID)
+			if err != nil {
+				return response.NewInternalError("Failed to fetch board links", err.Error())
+			}
+			if blocksReachable(blocks, link.TargetBoardID, link.SourceBoardID) {
+				return response.NewValidationError("Link would create a dependency cycle", "")
+			}
+		}
+
+		if err := s.linkRepo.Create(ctx, link); err != nil {
+			return response.NewInternalError("Failed to create board link", err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	resp := toBoardLinkResponse(link, boardID, target.Title)
+	return &resp, nil
+}
+
+// GetLinks retrieves the links of a board from the board's point of view
+func (s *boardLinkServiceImpl) GetLinks(ctx context.Context, boardID uuid.UUID) ([]dto.BoardLinkResponse, error) {
+	if _, err := s.findBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+	links, err := loadBoardLinks(ctx, s.linkRepo, s.boardRepo, boardID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch board links", err.Error())
+	}
+	return links, nil
+}
+
+// DeleteLink deletes a link of a board (either end of the link can delete it)
+func (s *boardLinkServiceImpl) DeleteLink(ctx context.Context, boardID, linkID uuid.UUID) error {
+	link, err := s.linkRepo.FindByID(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Board link not found", "")
+		}
+		return response.NewInternalError("Failed to fetch board link", err.Error())
+	}
+	if link.SourceBoardID != boardID && link.TargetBoardID != boardID {
+		return response.NewNotFoundError("Board link not found", "")
+	}
+	if err := s.linkRepo.Delete(ctx, linkID); err != nil {
+		return response.NewInternalError("Failed to delete board link", err.Error())
+	}
+	return nil
+}
+
+// findBoard returns a not found error when the board does not exist
+func (s *boardLinkServiceImpl) findBoard(ctx context.Context, boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+	return board, nil
+}
+
+// sameBoardLink reports whether two links describe the same relation (RELATES_TO는 방향 무관)
+func sameBoardLink(a, b *domain.BoardLink) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	if a.SourceBoardID == b.SourceBoardID && a.TargetBoardID == b.TargetBoardID {
+		return true
+	}
+	return a.Type == domain.BoardLinkTypeRelatesTo && a.SourceBoardID == b.TargetBoardID && a.TargetBoardID == b.SourceBoardID
+}
+
+// blocksReachable reports whether to can be reached from from by following BLOCKS links
+func blocksReachable(blocks []*domain.BoardLink, from, to uuid.UUID) bool {
+	next := make(map[uuid.UUID][]uuid.UUID, len(blocks))
+	for _, l := range blocks {
+		next[l.SourceBoardID] = append(next[l.SourceBoardID], l.TargetBoardID)
+	}
+
+	visited := map[uuid.UUID]bool{from: true}
+	stack := []uuid.UUID{from}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == to {
+			return true
+		}
+		for _, n := range next[id] {
+			if !visited[n] {
+				visited[n] = true
+				stack = append(stack, n)
+			}
+		}
+	}
+	return false
+}
+
+// loadBoardLinks fetches the links of a board with the titles of the linked boards (never nil)
+func loadBoardLinks(ctx context.Context, linkRepo repository.BoardLinkRepository, boardRepo repository.BoardRepository, boardID uuid.UUID) ([]dto.BoardLinkResponse, error) {
+	links, err := linkRepo.FindByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.BoardLinkResponse, 0, len(links))
+	if len(links) == 0 {
+		return responses, nil
+	}
+
+	otherIDs := make([]uuid.UUID, 0, len(links))
+	for _, l := range links {
+		otherIDs = append(otherIDs, linkedBoardID(l, boardID))
+	}
+	boards, err := boardRepo.FindByIDs(ctx, removeDuplicateUUIDs(otherIDs))
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[uuid.UUID]string, len(boards))
+	for _, b := range boards {
+		titles[b.ID] = b.Title
+	}
+
+	for _, l := range links {
+		responses = append(responses, toBoardLinkResponse(l, boardID, titles[linkedBoardID(l, boardID)]))
+	}
+	return responses, nil
+}
+
+// linkedBoardID returns the other end of a link
+func linkedBoardID(link *domain.BoardLink, boardID uuid.UUID) uuid.UUID {
+	if link.SourceBoardID == boardID {
+		return link.TargetBoardID
+	}
+	return link.SourceBoardID
+}
+
+// toBoardLinkResponse converts domain.BoardLink to dto.BoardLinkResponse from the point of view of boardID
+func toBoardLinkResponse(link *domain.BoardLink, boardID uuid.UUID, title string) dto.BoardLinkResponse {
+	linkType := dto.BoardLinkRelatesTo
+	if link.Type == domain.BoardLinkTypeBlocks {
+		linkType = dto.BoardLinkBlocks
+		if link.TargetBoardID == boardID {
+			linkType = dto.BoardLinkBlockedBy
+		}
+	}
+	return dto.BoardLinkResponse{
+		LinkID:    link.ID,
+		Type:      linkType,
+		BoardID:   linkedBoardID(link, boardID),
+		Title:     title,
+		CreatedAt: link.CreatedAt,
+	}
+}
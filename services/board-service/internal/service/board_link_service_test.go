@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// linkTestBoards returns a board repository holding boards a, b, c of one project and d of another project
+func linkTestBoards(projectID uuid.UUID) (*MockBoardRepository, [4]uuid.UUID) {
+	ids := [4]uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	boards := map[uuid.UUID]*domain.Board{}
+	for i, id := range ids {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID, Title: string(rune('A' + i))}
+		if i == 3 {
+			board.ProjectID = uuid.New()
+		}
+		boards[id] = board
+	}
+	return &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			if board, ok := boards[id]; ok {
+				return board, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindByIDsFunc: func(ctx context.Context, want []uuid.UUID) ([]*domain.Board, error) {
+			var result []*domain.Board
+			for _, id := range want {
+				result = append(result, boards[id])
+			}
+			return result, nil
+		},
+	}, ids
+}
+
+func TestBoardLinkService_CreateLink(t *testing.T) {
+	projectID := uuid.New()
+	boardRepo, ids := linkTestBoards(projectID)
+	a, b, c, other := ids[0], ids[1], ids[2], ids[3]
+
+	// 기존 관계: A가 B를 막고, B가 C를 막음, A와 C는 관련
+	existing := []*domain.BoardLink{
+		{ID: uuid.New(), ProjectID: projectID, SourceBoardID: a, TargetBoardID: b, Type: domain.BoardLinkTypeBlocks},
+		{ID: uuid.New(), ProjectID: projectID, SourceBoardID: b, TargetBoardID: c, Type: domain.BoardLinkTypeBlocks},
+		{ID: uuid.New(), ProjectID: projectID, SourceBoardID: a, TargetBoardID: c, Type: domain.BoardLinkTypeRelatesTo},
+	}
+
+	tests := []struct {
+		name        string
+		boardID     uuid.UUID
+		req         dto.CreateBoardLinkRequest
+		wantErrCode string
+		wantSource  uuid.UUID
+		wantTarget  uuid.UUID
+		wantType    string
+	}{
+		{name: "성공: BLOCKS", boardID: a, req: dto.CreateBoardLinkRequest{TargetBoardID: c, Type: dto.BoardLinkBlocks}, wantSource: a, wantTarget: c, wantType: dto.BoardLinkBlocks},
+		{name: "실패: BLOCKED_BY도 순환 검사 (C가 A를 막음)", boardID: a, req: dto.CreateBoardLinkRequest{TargetBoardID: c, Type: dto.BoardLinkBlockedBy}, wantErrCode: response.ErrCodeValidation},
+		{name: "성공: BLOCKED_BY는 방향을 뒤집어 저장", boardID: c, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkBlockedBy}, wantSource: a, wantTarget: c, wantType: dto.BoardLinkBlockedBy},
+		{name: "실패: 순환 의존 (C가 A를 막음)", boardID: c, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkBlocks}, wantErrCode: response.ErrCodeValidation},
+		{name: "실패: 직접 순환 (B가 A를 막음)", boardID: b, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkBlocks}, wantErrCode: response.ErrCodeValidation},
+		{name: "실패: 이미 있는 의존 (B는 A에 막혀 있음)", boardID: b, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkBlockedBy}, wantErrCode: response.ErrCodeConflict},
+		{name: "실패: 반대 방향 RELATES_TO 중복", boardID: c, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkRelatesTo}, wantErrCode: response.ErrCodeConflict},
+		{name: "실패: 자기 자신", boardID: a, req: dto.CreateBoardLinkRequest{TargetBoardID: a, Type: dto.BoardLinkRelatesTo}, wantErrCode: response.ErrCodeValidation},
+		{name: "실패: 다른 프로젝트", boardID: a, req: dto.CreateBoardLinkRequest{TargetBoardID: other, Type: dto.BoardLinkRelatesTo}, wantErrCode: response.ErrCodeValidation},
+		{name: "실패: 대상 Board 없음", boardID: a, req: dto.CreateBoardLinkRequest{TargetBoardID: uuid.New(), Type: dto.BoardLinkBlocks}, wantErrCode: response.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.BoardLink
+			linkRepo := &MockBoardLinkRepository{
+				FindByBoardIDFunc: func(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error) {
+					var links []*domain.BoardLink
+					for _, l := range existing {
+						if l.SourceBoardID == boardID || l.TargetBoardID == boardID {
+							links = append(links, l)
+						}
+					}
+					return links, nil
+				},
+				FindBlocksByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.BoardLink, error) {
+					var blocks []*domain.BoardLink
+					for _, l := range existing {
+						if l.Type == domain.BoardLinkTypeBlocks {
+							blocks = append(blocks, l)
+						}
+					}
+					return blocks, nil
+				},
+				CreateFunc: func(ctx context.Context, link *domain.BoardLink) error {
+					created = link
+					return nil
+				},
+			}
+			s := NewBoardLinkService(linkRepo, boardRepo, nil)
+
+			got, err := s.CreateLink(context.Background(), tt.boardID, uuid.New(), &tt.req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if created != nil {
+					t.Error("link should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateLink() error = %v", err)
+			}
+			if created.SourceBoardID != tt.wantSource || created.TargetBoardID != tt.wantTarget || created.Type != domain.BoardLinkTypeBlocks {
+				t.Errorf("stored link = %s -%s-> %s, want %s -BLOCKS-> %s", created.SourceBoardID, created.Type, created.TargetBoardID, tt.wantSource, tt.wantTarget)
+			}
+			if got.Type != tt.wantType || got.BoardID != tt.req.TargetBoardID {
+				t.Errorf("response = %+v, want %s to %s", got, tt.wantType, tt.req.TargetBoardID)
+			}
+		})
+	}
+}
+
+func TestBoardLinkService_GetLinks(t *testing.T) {
+	projectID := uuid.New()
+	boardRepo, ids := linkTestBoards(projectID)
+	a, b, c := ids[0], ids[1], ids[2]
+
+	linkRepo := &MockBoardLinkRepository{
+		FindByBoardIDFunc: func(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error) {
+			return []*domain.BoardLink{
+				{ID: uuid.New(), SourceBoardID: a, TargetBoardID: b, Type: domain.BoardLinkTypeBlocks},
+				{ID: uuid.New(), SourceBoardID: b, TargetBoardID: c, Type: domain.BoardLinkTypeBlocks},
+			}, nil
+		},
+	}
+	s := NewBoardLinkService(linkRepo, boardRepo, nil)
+
+	got, err := s.GetLinks(context.Background(), b)
+	if err != nil {
+		t.Fatalf("GetLinks() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d links, want 2", len(got))
+	}
+	if got[0].Type != dto.BoardLinkBlockedBy || got[0].BoardID != a || got[0].Title != "A" {
+		t.Errorf("first link = %+v, want BLOCKED_BY A", got[0])
+	}
+	if got[1].Type != dto.BoardLinkBlocks || got[1].BoardID != c || got[1].Title != "C" {
+		t.Errorf("second link = %+v, want BLOCKS C", got[1])
+	}
+}
+
+func TestBoardLinkService_DeleteLink(t *testing.T) {
+	a, b, unrelated := uuid.New(), uuid.New(), uuid.New()
+	linkID := uuid.New()
+
+	tests := []struct {
+		name        string
+		boardID     uuid.UUID
+		wantErrCode string
+	}{
+		{name: "성공: 대상 쪽 Board에서 삭제", boardID: b},
+		{name: "실패: 링크와 무관한 Board", boardID: unrelated, wantErrCode: response.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			linkRepo := &MockBoardLinkRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.BoardLink, error) {
+					return &domain.BoardLink{ID: linkID, SourceBoardID: a, TargetBoardID: b, Type: domain.BoardLinkTypeBlocks}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					deleted = true
+					return nil
+				},
+			}
+			s := NewBoardLinkService(linkRepo, &MockBoardRepository{}, nil)
+
+			err := s.DeleteLink(context.Background(), tt.boardID, linkID)
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode || deleted {
+					t.Fatalf("expected %s error without deleting, got %v (deleted=%v)", tt.wantErrCode, err, deleted)
+				}
+				return
+			}
+			if err != nil || !deleted {
+				t.Fatalf("DeleteLink() error = %v, deleted = %v", err, deleted)
+			}
+		})
+	}
+}
@@ -2,10 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/datatypes"
@@ -15,6 +22,7 @@ import (
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/metrics"
+	"project-board-api/internal/position"
 	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
@@ -22,10 +30,26 @@ import (
 // BoardService defines the interface for board business logic
 type BoardService interface {
 	CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
+	CreateBoardFromTemplate(ctx context.Context, templateID uuid.UUID, req *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error)
 	GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
-	GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
+	GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) (*dto.PaginatedBoardsResponse, error)
 	UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
 	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
+	ArchiveBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error)
+	UnarchiveBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error)
+	GetBoardTrash(ctx context.Context, projectID uuid.UUID) (*dto.BoardTrashResponse, error)
+	RestoreBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error)
+	PurgeDeletedBoards(ctx context.Context, now time.Time) (int, error)
+	BulkUpdateBoards(ctx context.Context, req *dto.BulkBoardRequest) (*dto.BulkBoardResponse, error)
+	MoveBoard(ctx context.Context, boardID uuid.UUID, req *dto.MoveBoardRequest) (*dto.MoveBoardResponse, error)
+	GetColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, value string) (*dto.BoardColumnOrderResponse, error)
+	GetGroupedBoards(ctx context.Context, projectID uuid.UUID, fieldName string) (*dto.GroupedBoardsResponse, error)
+	ImportBoards(ctx context.Context, projectID uuid.UUID, rows [][]string, dryRun bool) (*dto.BoardImportResponse, error)
+	GetBoardActivities(ctx context.Context, boardID uuid.UUID, cursor string, limit int) (*dto.PaginatedActivitiesResponse, error)
+	SetBoardRecurrence(ctx context.Context, boardID uuid.UUID, req *dto.SetBoardRecurrenceRequest) (*dto.BoardRecurrenceResponse, error)
+	DeleteBoardRecurrence(ctx context.Context, boardID uuid.UUID) error
+	CreateDueRecurringBoards(ctx context.Context, now time.Time) (int, error)
+	SendDueReminders(ctx context.Context, now time.Time, windows []time.Duration) (int, error)
 }
 
 // boardServiceImpl is the implementation of BoardService
@@ -37,7 +61,18 @@ type boardServiceImpl struct {
 	attachmentRepo       repository.AttachmentRepository
 	s3Client             S3Client
 	fieldOptionConverter FieldOptionConverter
-	notiClient           client.NotiClient // for sending notifications
+	notiClient           client.NotiClient                  // for sending notifications
+	events               *messaging.Emitter                 // optional, board domain events
+	outbox               *outbox.Outbox                     // optional, transactional board domain events
+	db                   *gorm.DB                           // optional, unit-of-work transactions and outbox writes
+	activityRepo         repository.ActivityLogRepository   // optional, board activity history
+	subtaskRepo          repository.SubtaskRepository       // optional, checklist completion in responses
+	templateRepo         repository.BoardTemplateRepository // optional, boards from templates
+	watcherRepo          repository.WatcherRepository       // optional, watchers of update notifications
+	linkRepo             repository.BoardLinkRepository     // optional, dependency links in board detail
+	customFieldRepo      repository.CustomFieldRepository   // optional, grouping by select custom fields
+	userClient           client.UserClient                  // optional, importing assignees by email or nickname
+	trashRetention       time.Duration                      // how long deleted boards stay restorable
 	metrics              *metrics.Metrics
 	logger               *zap.Logger
 }
@@ -62,8 +97,9 @@ func NewBoardService(
 	notiClient client.NotiClient,
 	m *metrics.Metrics,
 	logger *zap.Logger,
+	opts ...BoardServiceOption,
 ) BoardService {
-	return &boardServiceImpl{
+	s := &boardServiceImpl{
 		boardRepo:            boardRepo,
 		projectRepo:          projectRepo,
 		fieldOptionRepo:      fieldOptionRepo,
@@ -72,9 +108,14 @@ func NewBoardService(
 		s3Client:             s3Client,
 		fieldOptionConverter: fieldOptionConverter,
 		notiClient:           notiClient,
+		trashRetention:       DefaultTrashRetention,
 		metrics:              m,
 		logger:               logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // log returns a trace-context aware logger
@@ -154,40 +195,47 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		DueDate:      req.DueDate,
 	}
 
-	// Save to repository
-	if err := s.boardRepo.Create(ctx, board); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
-	}
-
-	// Confirm attachments after board creation
-	var createdAttachments []*domain.Attachment
-	if len(req.AttachmentIDs) > 0 {
-		// 에러 발생 시 board도 롤백
+	// 보드, board.created 이벤트, 첨부파일 확정, 참여자 추가를 한 트랜잭션으로 처리
+	// 하나라도 실패하면 모두 롤백되어 첨부파일이 다른 보드에 다시 쓰일 수 있는 상태로 남습니다.
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		// 새 카드는 컬럼 맨 아래에 배치
+		last, err := s.boardRepo.MaxPosition(ctx, req.ProjectID)
+		if err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
+		if board.Position, err = position.Between(last, ""); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
+		if err := s.boardRepo.Create(ctx, board); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
+		if err := s.emitBoardEvent(ctx, messaging.EventBoardCreated, board, authorID, nil); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
+		if err := s.recordActivities(ctx, board, authorID, domain.ActivityActionCreated, nil); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
 		if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments, rolling back board creation",
-				zap.String("board_id", board.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(req.AttachmentIDs))
-					for i, id := range req.AttachmentIDs {
-						ids[i] = id.String()
-					}
-					return ids
-				}()),
-				zap.Error(err))
-
-			// board 삭제 (롤백)
-			if deleteErr := s.boardRepo.Delete(ctx, board.ID); deleteErr != nil {
-				s.logger.Error("Failed to rollback board after attachment confirmation failure",
-					zap.String("board_id", board.ID.String()),
-					zap.Error(deleteErr))
-			}
-
-			return nil, response.NewAppError(response.ErrCodeInternal,
+			return response.NewAppError(response.ErrCodeInternal,
 				"Failed to confirm attachments: "+err.Error(),
 				"Please ensure all attachment IDs are valid and not already used")
 		}
+		if err := s.addParticipantsInternal(ctx, board.ID, req.Participants); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to add participants", err.Error())
+		}
+		return nil
+	}); err != nil {
+		log.Error("CreateBoard rolled back",
+			zap.String("project.id", req.ProjectID.String()),
+			zap.Int("attachment.count", len(req.AttachmentIDs)),
+			zap.Int("participant.count", len(req.Participants)),
+			zap.Error(err))
+		return nil, err
+	}
 
-		// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
+	// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
+	var createdAttachments []*domain.Attachment
+	if len(req.AttachmentIDs) > 0 {
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
 		if err != nil {
 			s.logger.Warn("Failed to fetch confirmed attachments for response", zap.Error(err))
@@ -201,17 +249,8 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		s.metrics.IncrementBoardCreated()
 	}
 
-	// Add participants if provided
+	// Reload board with participants to include them in response
 	if len(req.Participants) > 0 {
-		successCount, err := s.addParticipantsInternal(ctx, board.ID, req.Participants)
-		if err != nil {
-			s.logger.Warn("Error occurred while adding participants during board creation",
-				zap.String("board_id", board.ID.String()),
-				zap.Int("success_count", successCount),
-				zap.Error(err))
-		}
-
-		// Reload board with participants to include them in response
 		reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
 		if err != nil {
 			s.logger.Warn("Failed to reload board with participants",
@@ -274,14 +313,40 @@ func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dt
 	log.Debug("GetBoard completed", zap.String("board.id", boardID.String()))
 
 	// Convert to detailed response DTO
-	return s.toBoardDetailResponse(ctx, board), nil
+	detail := s.toBoardDetailResponse(ctx, board)
+	s.fillSubtaskProgress(ctx, &detail.BoardResponse)
+	s.fillBoardLinks(ctx, detail)
+	return detail, nil
 }
 
-// GetBoardsByProject retrieves all boards for a project with optional filters
-func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error) {
+// GetBoardsByProject retrieves a page of boards for a project with optional filters
+func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) (*dto.PaginatedBoardsResponse, error) {
 	log := s.log(ctx)
 	log.Debug("GetBoardsByProject service started", zap.String("project.id", projectID.String()))
 
+	if filters == nil {
+		filters = &dto.BoardFilters{}
+	}
+
+	limit := filters.Limit
+	if limit < 1 || limit > dto.MaxBoardPageLimit {
+		limit = dto.DefaultBoardPageLimit
+	}
+
+	state, ok := domain.ParseArchiveState(filters.State)
+	if !ok {
+		return nil, response.NewValidationError("Invalid state: must be ACTIVE, ARCHIVED or ALL", "")
+	}
+
+	var cursor *repository.BoardCursor
+	if filters.Cursor != "" {
+		decoded, err := decodeBoardCursor(filters.Cursor)
+		if err != nil {
+			return nil, response.NewValidationError("Invalid cursor", "")
+		}
+		cursor = decoded
+	}
+
 	// Verify project exists
 	_, err := s.projectRepo.FindByID(ctx, projectID)
 	if err != nil {
@@ -295,17 +360,25 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 
 	// Prepare filter parameter for repository
 	var filterParam interface{}
-	if filters != nil && filters.CustomFields != nil {
+	if filters.CustomFields != nil {
 		filterParam = filters.CustomFields
 	}
 
-	// Fetch boards from repository with filters
-	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, filterParam)
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	boards, err := s.boardRepo.FindPageByProjectID(ctx, projectID, filterParam, state, cursor, limit+1)
 	if err != nil {
 		log.Error("GetBoardsByProject failed to fetch boards", zap.String("project.id", projectID.String()), zap.Error(err))
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
 	}
 
+	page := &dto.PaginatedBoardsResponse{Limit: limit}
+	if len(boards) > limit {
+		boards = boards[:limit]
+		last := boards[limit-1]
+		page.HasMore = true
+		page.NextCursor = encodeBoardCursor(&repository.BoardCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
 	// Board 목록 조회 시 Attachments 로드 (효율을 위해 각 board별로 로드)
 	for _, board := range boards {
 		attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
@@ -323,24 +396,54 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 
 	log.Debug("GetBoardsByProject completed",
 		zap.String("project.id", projectID.String()),
-		zap.Int("board.count", len(boards)))
+		zap.Int("board.count", len(boards)),
+		zap.Bool("has_more", page.HasMore))
 
 	// Convert to response DTOs
-	responses := make([]*dto.BoardResponse, len(boards))
+	page.Boards = make([]*dto.BoardResponse, len(boards))
 	for i, board := range boards {
-		responses[i] = s.toBoardResponseWithWorkspace(ctx, board)
+		page.Boards[i] = s.toBoardResponseWithWorkspace(ctx, board)
 	}
+	s.fillSubtaskProgress(ctx, page.Boards...)
 
-	return responses, nil
+	return page, nil
 }
 
-// DeleteBoard deletes a board and its associated attachments
+// encodeBoardCursor encodes a board list position as an opaque cursor
+func encodeBoardCursor(cursor *repository.BoardCursor) string {
+	raw := fmt.Sprintf("%d_%s", cursor.CreatedAt.UnixNano(), cursor.ID.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeBoardCursor decodes a cursor created by encodeBoardCursor
+func decodeBoardCursor(value string) (*repository.BoardCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	nanos, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return nil, errors.New("malformed cursor")
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.BoardCursor{CreatedAt: time.Unix(0, unixNano), ID: cursorID}, nil
+}
+
+// DeleteBoard moves a board to the project's trash.
+// 첨부파일은 복원 기간 동안 남겨 두고, 기간이 지나 영구 삭제할 때 함께 지웁니다 (PurgeDeletedBoards).
 func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
 	log := s.log(ctx)
 	log.Debug("DeleteBoard service started", zap.String("board.id", boardID.String()))
 
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, boardID)
+	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Debug("DeleteBoard board not found", zap.String("board.id", boardID.String()))
@@ -350,30 +453,28 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
 
-	// Find all attachments associated with this board
-	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, boardID)
-	if err != nil {
-		log.Warn("DeleteBoard failed to fetch attachments",
-			zap.String("board.id", boardID.String()),
-			zap.Error(err))
-		// Continue with board deletion even if attachment fetch fails
+	// Check if the user's project role can delete boards
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+	if _, err := authorize(ctx, s.projectRepo, board.ProjectID, actorID, domain.PermissionDeleteBoard); err != nil {
+		log.Debug("DeleteBoard not allowed", zap.String("board.id", boardID.String()), zap.Error(err))
+		return err
 	}
 
-	// Delete attachments from S3 and database
-	if len(attachments) > 0 {
-		log.Debug("DeleteBoard deleting attachments",
-			zap.String("board.id", boardID.String()),
-			zap.Int("attachment.count", len(attachments)))
-		s.deleteAttachmentsWithS3(ctx, attachments)
-	}
-
-	// Delete board
-	if err := s.boardRepo.Delete(ctx, boardID); err != nil {
+	// Soft delete board (board.deleted 이벤트와 활동 기록도 같은 트랜잭션에 기록)
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		if err := s.boardRepo.Delete(ctx, boardID); err != nil {
+			return err
+		}
+		if err := s.recordActivities(ctx, board, actorID, domain.ActivityActionDeleted, nil); err != nil {
+			return err
+		}
+		return s.emitBoardEvent(ctx, messaging.EventBoardDeleted, board, actorID, nil)
+	}); err != nil {
 		log.Error("DeleteBoard failed to delete", zap.String("board.id", boardID.String()), zap.Error(err))
 		return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
 	}
 
-	log.Info("Board deleted", zap.String("board.id", boardID.String()))
+	log.Info("Board moved to trash", zap.String("board.id", boardID.String()))
 	return nil
 }
 
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WithActivityLog records every board change in the board's activity history
+func WithActivityLog(repo repository.ActivityLogRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.activityRepo = repo
+	}
+}
+
+// recordActivities appends a board change to its history within the current transaction.
+// updated는 변경된 필드마다 한 행을 기록하고, 변경이 없으면 아무것도 기록하지 않습니다.
+func (s *boardServiceImpl) recordActivities(ctx context.Context, board *domain.Board, actorID uuid.UUID, action domain.ActivityAction, changes []BoardChange) error {
+	if s.activityRepo == nil {
+		return nil
+	}
+
+	var logs []*domain.ActivityLog
+	if action == domain.ActivityActionUpdated {
+		for _, change := range changes {
+			logs = append(logs, &domain.ActivityLog{
+				BoardID: board.ID, ProjectID: board.ProjectID, ActorID: actorID, Action: action,
+				Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue,
+			})
+		}
+	} else {
+		logs = append(logs, &domain.ActivityLog{BoardID: board.ID, ProjectID: board.ProjectID, ActorID: actorID, Action: action})
+	}
+	return s.activityRepo.CreateBatch(ctx, logs)
+}
+
+// customFieldChanges lists the custom fields that differ between two field option ID maps,
+// using labels (e.g. "진행중") for display in notifications and the activity history
+func (s *boardServiceImpl) customFieldChanges(ctx context.Context, original, updated map[string]interface{}) []BoardChange {
+	originalReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, original)
+	updatedReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, updated)
+
+	var changes []BoardChange
+	for key, newVal := range updated {
+		if oldVal, existed := original[key]; existed && reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+		changes = append(changes, BoardChange{
+			Field:    key,
+			OldValue: formatInterface(originalReadable[key]),
+			NewValue: formatInterface(updatedReadable[key]),
+		})
+	}
+	return changes
+}
+
+// GetBoardActivities retrieves a page of a board's change history, newest first
+func (s *boardServiceImpl) GetBoardActivities(ctx context.Context, boardID uuid.UUID, cursorValue string, limit int) (*dto.PaginatedActivitiesResponse, error) {
+	log := s.log(ctx)
+
+	if limit <= 0 {
+		limit = dto.DefaultActivityPageLimit
+	}
+	if limit > dto.MaxActivityPageLimit {
+		limit = dto.MaxActivityPageLimit
+	}
+	var cursor *repository.BoardCursor
+	if cursorValue != "" {
+		decoded, err := decodeBoardCursor(cursorValue)
+		if err != nil {
+			return nil, response.NewValidationError("Invalid cursor", "")
+		}
+		cursor = decoded
+	}
+
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+
+	page := &dto.PaginatedActivitiesResponse{Activities: []dto.ActivityResponse{}, Limit: limit}
+	if s.activityRepo == nil {
+		return page, nil
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	logs, err := s.activityRepo.FindPageByBoardID(ctx, boardID, cursor, limit+1)
+	if err != nil {
+		log.Error("GetBoardActivities failed to fetch activities", zap.String("board.id", boardID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to fetch activities", err.Error())
+	}
+	if len(logs) > limit {
+		logs = logs[:limit]
+		last := logs[limit-1]
+		page.HasMore = true
+		page.NextCursor = encodeBoardCursor(&repository.BoardCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	for _, a := range logs {
+		page.Activities = append(page.Activities, dto.ActivityResponse{
+			ID: a.ID, BoardID: a.BoardID, ActorID: a.ActorID, Action: string(a.Action),
+			Field: a.Field, OldValue: a.OldValue, NewValue: a.NewValue, CreatedAt: a.CreatedAt,
+		})
+	}
+	return page, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_UpdateBoard_RecordsActivities(t *testing.T) {
+	projectID, boardID, actorID := uuid.New(), uuid.New(), uuid.New()
+	original, _ := json.Marshal(map[string]interface{}{"stage": "stage-todo-id", "importance": "importance-high-id"})
+
+	var recorded []*domain.ActivityLog
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, Title: "old", CustomFields: original}, nil
+		},
+	}
+	converter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"stage": "stage-done-id", "importance": "importance-high-id"}, nil
+		},
+		ConvertIDsToLabelsFunc: func(ctx context.Context, fields map[string]interface{}) (map[string]interface{}, error) {
+			labels := map[string]interface{}{}
+			for k, v := range fields {
+				labels[k] = map[interface{}]string{"stage-todo-id": "대기", "stage-done-id": "완료", "importance-high-id": "높음"}[v]
+			}
+			return labels, nil
+		},
+	}
+	activityRepo := &MockActivityLogRepository{
+		CreateBatchFunc: func(ctx context.Context, logs []*domain.ActivityLog) error {
+			recorded = append(recorded, logs...)
+			return nil
+		},
+	}
+	s := NewBoardService(boardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, converter, nil, nil, zap.NewNop(), WithActivityLog(activityRepo))
+
+	title := "new"
+	fields := map[string]interface{}{"stage": "done", "importance": "high"}
+	ctx := context.WithValue(context.Background(), "user_id", actorID)
+	if _, err := s.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{Title: &title, CustomFields: &fields, Version: new(int)}); err != nil {
+		t.Fatalf("UpdateBoard() error = %v", err)
+	}
+
+	got := map[string][2]string{}
+	for _, a := range recorded {
+		if a.BoardID != boardID || a.ActorID != actorID || a.Action != domain.ActivityActionUpdated {
+			t.Errorf("unexpected activity %+v", a)
+		}
+		got[a.Field] = [2]string{a.OldValue, a.NewValue}
+	}
+	want := map[string][2]string{"title": {"old", "new"}, "stage": {"대기", "완료"}}
+	if len(got) != len(want) {
+		t.Fatalf("recorded %v, want %v (unchanged importance must not be recorded)", got, want)
+	}
+	for field, values := range want {
+		if got[field] != values {
+			t.Errorf("activity %s = %v, want %v", field, got[field], values)
+		}
+	}
+}
+
+func TestBoardService_GetBoardActivities(t *testing.T) {
+	boardID := uuid.New()
+	now := time.Now()
+	logs := []*domain.ActivityLog{
+		{ID: uuid.New(), BoardID: boardID, Action: domain.ActivityActionUpdated, Field: "title", CreatedAt: now},
+		{ID: uuid.New(), BoardID: boardID, Action: domain.ActivityActionUpdated, Field: "stage", CreatedAt: now.Add(-time.Minute)},
+		{ID: uuid.New(), BoardID: boardID, Action: domain.ActivityActionCreated, CreatedAt: now.Add(-time.Hour)},
+	}
+
+	var gotCursor *repository.BoardCursor
+	activityRepo := &MockActivityLogRepository{
+		FindPageByBoardIDFunc: func(ctx context.Context, id uuid.UUID, cursor *repository.BoardCursor, limit int) ([]*domain.ActivityLog, error) {
+			gotCursor = cursor
+			if limit > len(logs) {
+				limit = len(logs)
+			}
+			return logs[:limit], nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+		},
+	}
+	s := NewBoardService(boardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, nil, zap.NewNop(), WithActivityLog(activityRepo))
+
+	page, err := s.GetBoardActivities(context.Background(), boardID, "", 2)
+	if err != nil {
+		t.Fatalf("GetBoardActivities() error = %v", err)
+	}
+	if len(page.Activities) != 2 || !page.HasMore || page.NextCursor == "" {
+		t.Fatalf("expected a full first page with a cursor, got %+v", page)
+	}
+
+	if _, err := s.GetBoardActivities(context.Background(), boardID, page.NextCursor, 2); err != nil {
+		t.Fatalf("GetBoardActivities() second page error = %v", err)
+	}
+	if gotCursor == nil || gotCursor.ID != logs[1].ID || !gotCursor.CreatedAt.Equal(logs[1].CreatedAt) {
+		t.Errorf("second page cursor = %+v, want the last activity of the first page", gotCursor)
+	}
+
+	_, err = s.GetBoardActivities(context.Background(), boardID, "not-a-cursor", 2)
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("expected validation error for an invalid cursor, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// ArchiveBoard hides a board from the default board lists and the kanban without deleting it.
+// 삭제와 같은 권한(can_delete_board)이 필요하며, 보관된 보드는 반복 생성도 멈춥니다.
+func (s *boardServiceImpl) ArchiveBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error) {
+	return s.setBoardArchived(ctx, boardID, true)
+}
+
+// UnarchiveBoard brings an archived board back to the board lists and the kanban
+func (s *boardServiceImpl) UnarchiveBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error) {
+	return s.setBoardArchived(ctx, boardID, false)
+}
+
+// setBoardArchived archives or restores a board with its activity record and board.updated event.
+// 이미 그 상태이면 활동/이벤트 없이 현재 상태를 반환합니다.
+func (s *boardServiceImpl) setBoardArchived(ctx context.Context, boardID uuid.UUID, archived bool) (*dto.BoardResponse, error) {
+	log := s.log(ctx)
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+
+	if _, err := authorize(ctx, s.projectRepo, board.ProjectID, actorID, domain.PermissionDeleteBoard); err != nil {
+		return nil, err
+	}
+
+	if (board.ArchivedAt != nil) != archived {
+		action := domain.ActivityActionUnarchived
+		board.ArchivedAt = nil
+		if archived {
+			now := time.Now()
+			action = domain.ActivityActionArchived
+			board.ArchivedAt = &now
+		}
+
+		if err := s.inTx(ctx, func(ctx context.Context) error {
+			if err := s.boardRepo.Update(ctx, board); err != nil {
+				return err
+			}
+			if err := s.recordActivities(ctx, board, actorID, action, nil); err != nil {
+				return err
+			}
+			return s.emitBoardEvent(ctx, messaging.EventBoardUpdated, board, actorID, []string{"archived"})
+		}); err != nil {
+			log.Error("Failed to update board archive state", zap.String("board.id", boardID.String()), zap.Error(err))
+			return nil, response.NewInternalError("Failed to update board archive state", err.Error())
+		}
+
+		log.Info("Board archive state changed",
+			zap.String("board.id", boardID.String()),
+			zap.Bool("board.archived", archived))
+	}
+
+	return s.boardStateResponse(ctx, board)
+}
+
+// boardStateResponse builds the response of a board whose archive or trash state changed
+func (s *boardServiceImpl) boardStateResponse(ctx context.Context, board *domain.Board) (*dto.BoardResponse, error) {
+	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.log(ctx).Warn("Failed to fetch attachments for board", zap.String("board.id", board.ID.String()), zap.Error(err))
+	}
+	board.Attachments = toDomainAttachments(attachments)
+
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, []*domain.Board{board}); err != nil {
+		return nil, response.NewInternalError("Failed to convert custom fields", err.Error())
+	}
+
+	resp := s.toBoardResponseWithWorkspace(ctx, board)
+	s.fillSubtaskProgress(ctx, resp)
+	return resp, nil
+}
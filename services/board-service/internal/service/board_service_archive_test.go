@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_ArchiveBoard(t *testing.T) {
+	boardID, projectID, userID := uuid.New(), uuid.New(), uuid.New()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}
+	updates := 0
+
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+		UpdateFunc: func(ctx context.Context, b *domain.Board) error {
+			updates++
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+
+	got, err := service.ArchiveBoard(ctx, boardID)
+	if err != nil {
+		t.Fatalf("ArchiveBoard() error = %v", err)
+	}
+	if got.State != string(domain.ArchiveStateArchived) || got.ArchivedAt == nil {
+		t.Errorf("ArchiveBoard() state = %s, archivedAt = %v", got.State, got.ArchivedAt)
+	}
+
+	// 이미 보관된 보드는 다시 저장하지 않음
+	if _, err := service.ArchiveBoard(ctx, boardID); err != nil {
+		t.Fatalf("ArchiveBoard() second call error = %v", err)
+	}
+	if updates != 1 {
+		t.Errorf("board updated %d times, want 1", updates)
+	}
+
+	got, err = service.UnarchiveBoard(ctx, boardID)
+	if err != nil {
+		t.Fatalf("UnarchiveBoard() error = %v", err)
+	}
+	if got.State != string(domain.ArchiveStateActive) || got.ArchivedAt != nil || updates != 2 {
+		t.Errorf("UnarchiveBoard() state = %s, archivedAt = %v, updates = %d", got.State, got.ArchivedAt, updates)
+	}
+}
+
+func TestBoardService_ArchiveBoard_RequiresDeletePermission(t *testing.T) {
+	boardID := uuid.New()
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: uuid.New()}, nil
+		},
+		UpdateFunc: func(ctx context.Context, b *domain.Board) error {
+			t.Error("board must not be archived")
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+		FindPermissionMatrixFunc: func(ctx context.Context, pid uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+			return &domain.ProjectPermissionMatrix{ProjectID: pid, Permissions: []byte(`{"can_delete_board":["ADMIN"]}`)}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	_, err := service.ArchiveBoard(context.WithValue(context.Background(), "user_id", uuid.New()), boardID)
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeForbidden {
+		t.Errorf("ArchiveBoard() error = %v, want forbidden", err)
+	}
+}
+
+func TestBoardService_GetBoardsByProject_StateFilter(t *testing.T) {
+	var gotState domain.ArchiveState
+	mockBoardRepo := &MockBoardRepository{
+		FindPageByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
+			gotState = state
+			return []*domain.Board{}, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	tests := []struct {
+		state string
+		want  domain.ArchiveState
+	}{
+		{"", domain.ArchiveStateActive},
+		{"archived", domain.ArchiveStateArchived},
+		{"ALL", domain.ArchiveStateAll},
+	}
+	for _, tt := range tests {
+		if _, err := service.GetBoardsByProject(context.Background(), uuid.New(), &dto.BoardFilters{State: tt.state}); err != nil {
+			t.Fatalf("GetBoardsByProject(state=%q) error = %v", tt.state, err)
+		}
+		if gotState != tt.want {
+			t.Errorf("GetBoardsByProject(state=%q) passed %s, want %s", tt.state, gotState, tt.want)
+		}
+	}
+
+	_, err := service.GetBoardsByProject(context.Background(), uuid.New(), &dto.BoardFilters{State: "DELETED"})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("expected validation error for invalid state, got %v", err)
+	}
+}
+
+func TestProjectService_ArchiveProject(t *testing.T) {
+	projectID, ownerID := uuid.New(), uuid.New()
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return project, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			role := domain.ProjectRoleAdmin
+			if uid == ownerID {
+				role = domain.ProjectRoleOwner
+			}
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: role}, nil
+		},
+	}
+	service := NewProjectService(mockProjectRepo, &MockFieldOptionRepository{}, &MockAttachmentRepository{}, nil, nil, nil, zap.NewNop())
+	ctx := context.Background()
+
+	// ADMIN은 기본적으로 프로젝트를 보관할 수 없음
+	_, err := service.ArchiveProject(ctx, projectID, uuid.New())
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeForbidden {
+		t.Errorf("ArchiveProject() by admin error = %v, want forbidden", err)
+	}
+
+	got, err := service.ArchiveProject(ctx, projectID, ownerID)
+	if err != nil {
+		t.Fatalf("ArchiveProject() error = %v", err)
+	}
+	if got.State != string(domain.ArchiveStateArchived) || got.ArchivedAt == nil {
+		t.Errorf("ArchiveProject() state = %s, archivedAt = %v", got.State, got.ArchivedAt)
+	}
+
+	got, err = service.UnarchiveProject(ctx, projectID, ownerID)
+	if err != nil {
+		t.Fatalf("UnarchiveProject() error = %v", err)
+	}
+	if got.State != string(domain.ArchiveStateActive) || got.ArchivedAt != nil {
+		t.Errorf("UnarchiveProject() state = %s, archivedAt = %v", got.State, got.ArchivedAt)
+	}
+
+	// 기본 프로젝트는 보관할 수 없음
+	project.IsDefault = true
+	_, err = service.ArchiveProject(ctx, projectID, ownerID)
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("ArchiveProject() on default project error = %v, want validation", err)
+	}
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/position"
+	"project-board-api/internal/response"
+)
+
+// bulkBoardState tracks the pending changes of one board in a bulk request
+type bulkBoardState struct {
+	board            *domain.Board
+	customFields     map[string]interface{} // field type -> field option ID
+	originalFields   map[string]interface{} // customFields before the request
+	originalAssignee *uuid.UUID
+	changed          []string
+	activities       []BoardChange // 활동 기록용 변경 내역 (라벨 기준)
+	moved            bool          // 다른 컬럼으로 이동하여 컬럼 맨 아래에 배치해야 함
+	deleted          bool
+}
+
+func (st *bulkBoardState) markChanged(field string) {
+	if !slices.Contains(st.changed, field) {
+		st.changed = append(st.changed, field)
+	}
+}
+
+// BulkUpdateBoards applies a batch of board operations (move, update customFields, assign, delete) in one transaction.
+// 보드별 변경 내역은 단건 수정과 같은 형식으로 활동 기록에 남습니다.
+// 여러 카드 드래그나 일괄 담당자 변경을 요청 한 번으로 처리하며, 하나라도 실패하면 아무것도 반영되지 않습니다.
+func (s *boardServiceImpl) BulkUpdateBoards(ctx context.Context, req *dto.BulkBoardRequest) (*dto.BulkBoardResponse, error) {
+	log := s.log(ctx)
+	log.Debug("BulkUpdateBoards service started",
+		zap.String("project.id", req.ProjectID.String()),
+		zap.Int("operation.count", len(req.Operations)))
+
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
+	if len(req.Operations) == 0 || len(req.Operations) > dto.MaxBulkBoardOperations {
+		return nil, response.NewValidationError(
+			fmt.Sprintf("operations must contain between 1 and %d items", dto.MaxBulkBoardOperations), "")
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		log.Error("BulkUpdateBoards failed to verify project", zap.String("project.id", req.ProjectID.String()), zap.Error(err))
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// 요청에 등장한 순서대로 보드 로드 및 프로젝트 소속 확인
+	var order []uuid.UUID
+	for _, op := range req.Operations {
+		if !slices.Contains(order, op.BoardID) {
+			order = append(order, op.BoardID)
+		}
+	}
+	boards, err := s.boardRepo.FindByIDsWithParticipants(ctx, order)
+	if err != nil {
+		log.Error("BulkUpdateBoards failed to fetch boards", zap.Error(err))
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	states := make(map[uuid.UUID]*bulkBoardState, len(boards))
+	for _, board := range boards {
+		st := &bulkBoardState{board: board, customFields: map[string]interface{}{}, originalFields: map[string]interface{}{}}
+		if len(board.CustomFields) > 0 {
+			_ = json.Unmarshal(board.CustomFields, &st.customFields)
+			_ = json.Unmarshal(board.CustomFields, &st.originalFields)
+		}
+		if board.AssigneeID != nil {
+			id := *board.AssigneeID
+			st.originalAssignee = &id
+		}
+		states[board.ID] = st
+	}
+	for _, id := range order {
+		st, ok := states[id]
+		if !ok {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", id.String())
+		}
+		if st.board.ProjectID != req.ProjectID {
+			return nil, response.NewValidationError(fmt.Sprintf("Board %s does not belong to the project", id), "")
+		}
+	}
+
+	// 삭제 작업이 있으면 역할의 Board 삭제 권한 확인
+	if slices.ContainsFunc(req.Operations, func(op dto.BulkBoardOperation) bool { return op.Type == dto.BulkBoardOpDelete }) {
+		if _, err := authorize(ctx, s.projectRepo, req.ProjectID, actorID, domain.PermissionDeleteBoard); err != nil {
+			return nil, err
+		}
+	}
+
+	// 작업을 순서대로 메모리에 적용 (검증 실패 시 DB 변경 없음)
+	var moves []dto.BulkBoardMove
+	for i, op := range req.Operations {
+		st := states[op.BoardID]
+		if st.deleted {
+			return nil, response.NewValidationError(
+				fmt.Sprintf("operations[%d]: board %s is deleted by an earlier operation", i, op.BoardID), "")
+		}
+
+		switch op.Type {
+		case dto.BulkBoardOpMove:
+			if op.GroupByFieldName == "" || op.NewFieldValue == nil {
+				return nil, response.NewValidationError(
+					fmt.Sprintf("operations[%d]: groupByFieldName and newFieldValue are required for move", i), "")
+			}
+			fromID, _ := st.customFields[op.GroupByFieldName].(string)
+			moved, err := s.applyBulkCustomFields(ctx, st, map[string]interface{}{op.GroupByFieldName: *op.NewFieldValue})
+			if err != nil {
+				return nil, err
+			}
+			if moved {
+				st.moved = true
+				st.markChanged("position")
+				moves = append(moves, dto.BulkBoardMove{
+					BoardID: op.BoardID, GroupByFieldName: op.GroupByFieldName, From: fromID, To: *op.NewFieldValue,
+				})
+			}
+		case dto.BulkBoardOpUpdateFields:
+			if len(op.CustomFields) == 0 {
+				return nil, response.NewValidationError(
+					fmt.Sprintf("operations[%d]: customFields is required for updateCustomFields", i), "")
+			}
+			if _, err := s.applyBulkCustomFields(ctx, st, op.CustomFields); err != nil {
+				return nil, err
+			}
+		case dto.BulkBoardOpAssign:
+			if op.AssigneeID == nil {
+				return nil, response.NewValidationError(fmt.Sprintf("operations[%d]: assigneeId is required for assign", i), "")
+			}
+			var assigneeID *uuid.UUID
+			if *op.AssigneeID != uuid.Nil {
+				id := *op.AssigneeID
+				assigneeID = &id
+			}
+			if s.isAssigneeChanged(st.board.AssigneeID, assigneeID) {
+				st.board.AssigneeID = assigneeID
+				st.markChanged("assignee")
+			}
+		case dto.BulkBoardOpDelete:
+			st.deleted = true
+		default:
+			return nil, response.NewValidationError(fmt.Sprintf("operations[%d]: unknown operation type %q", i, op.Type), "")
+		}
+	}
+
+	// 이동 전 컬럼은 field option ID로 저장되어 있으므로 value로 변환
+	for i := range moves {
+		if moves[i].From == "" {
+			continue
+		}
+		values, err := s.fieldOptionConverter.ConvertIDsToValues(ctx, map[string]interface{}{moves[i].GroupByFieldName: moves[i].From})
+		if err == nil {
+			if value, ok := values[moves[i].GroupByFieldName].(string); ok {
+				moves[i].From = value
+			}
+		}
+	}
+
+	var updated []*domain.Board // 삭제되지 않은 보드 (변경 없는 보드 포함, 응답용)
+	var deletedIDs []uuid.UUID
+	changedCount := 0
+	for _, id := range order {
+		st := states[id]
+		switch {
+		case st.deleted:
+			deletedIDs = append(deletedIDs, id)
+		case len(st.changed) > 0:
+			jsonBytes, err := json.Marshal(st.customFields)
+			if err != nil {
+				return nil, response.NewAppError(response.ErrCodeInternal, "Failed to marshal custom fields", err.Error())
+			}
+			st.board.CustomFields = jsonBytes
+			if s.isAssigneeChanged(st.originalAssignee, st.board.AssigneeID) {
+				st.activities = append(st.activities, BoardChange{
+					Field: "assignee", OldValue: formatUUIDPtr(st.originalAssignee), NewValue: formatUUIDPtr(st.board.AssigneeID),
+				})
+			}
+			st.activities = append(st.activities, s.customFieldChanges(ctx, st.originalFields, st.customFields)...)
+			updated = append(updated, st.board)
+			changedCount++
+		default:
+			updated = append(updated, st.board)
+		}
+	}
+
+	// 보드 변경과 board.updated/board.deleted 이벤트를 한 트랜잭션으로 기록
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		// 이동한 카드는 요청 순서대로 대상 컬럼 맨 아래에 배치
+		var last string
+		if len(moves) > 0 {
+			var err error
+			if last, err = s.boardRepo.MaxPosition(ctx, req.ProjectID); err != nil {
+				return err
+			}
+		}
+		for _, id := range order {
+			st := states[id]
+			if st.moved && !st.deleted {
+				next, err := position.Between(last, "")
+				if err != nil {
+					return err
+				}
+				st.board.Position, last = next, next
+			}
+			if st.deleted {
+				if err := s.boardRepo.Delete(ctx, id); err != nil {
+					return err
+				}
+			} else if len(st.changed) > 0 {
+				if err := s.boardRepo.Update(ctx, st.board); err != nil {
+					return err
+				}
+			} else {
+				continue
+			}
+			// Save 이후에 설정해야 프로젝트가 연관관계로 다시 저장되지 않음
+			st.board.Project = *project
+			eventType, changed, action := messaging.EventBoardUpdated, st.changed, domain.ActivityActionUpdated
+			if st.deleted {
+				eventType, changed, action = messaging.EventBoardDeleted, nil, domain.ActivityActionDeleted
+			}
+			if err := s.recordActivities(ctx, st.board, actorID, action, st.activities); err != nil {
+				return err
+			}
+			if err := s.emitBoardEvent(ctx, eventType, st.board, actorID, changed); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Error("BulkUpdateBoards rolled back", zap.String("project.id", req.ProjectID.String()), zap.Error(err))
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to apply board operations", err.Error())
+	}
+
+	log.Info("Bulk board operations applied",
+		zap.String("project.id", req.ProjectID.String()),
+		zap.Int("board.updated", changedCount),
+		zap.Int("board.deleted", len(deletedIDs)),
+		zap.Int("board.moved", len(moves)))
+
+	// 커밋 후 처리: 담당자 알림 (삭제된 보드는 휴지통으로 이동하며 첨부파일은 영구 삭제할 때 정리)
+	for _, board := range updated {
+		st := states[board.ID]
+		if board.AssigneeID != nil && s.isAssigneeChanged(st.originalAssignee, board.AssigneeID) {
+			s.sendAssigneeNotification(ctx, board, actorID)
+		}
+	}
+
+	// Build response (customFields는 value 기반으로 변환)
+	for _, board := range updated {
+		attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("BulkUpdateBoards failed to fetch attachments", zap.String("board.id", board.ID.String()), zap.Error(err))
+		}
+		board.Attachments = toDomainAttachments(attachments)
+		board.Project = *project
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, updated); err != nil {
+		// 변경은 이미 커밋되었으므로 응답 변환 실패는 로그만 남김
+		log.Warn("BulkUpdateBoards failed to convert custom fields", zap.Error(err))
+	}
+
+	resp := &dto.BulkBoardResponse{
+		Boards:          make([]*dto.BoardResponse, len(updated)),
+		DeletedBoardIDs: deletedIDs,
+		Moves:           moves,
+	}
+	for i, board := range updated {
+		resp.Boards[i] = s.toBoardResponseWithWorkspace(ctx, board)
+	}
+	s.fillSubtaskProgress(ctx, resp.Boards...)
+	if resp.DeletedBoardIDs == nil {
+		resp.DeletedBoardIDs = []uuid.UUID{}
+	}
+	if resp.Moves == nil {
+		resp.Moves = []dto.BulkBoardMove{}
+	}
+	return resp, nil
+}
+
+// applyBulkCustomFields merges value-based custom fields into the board's pending custom fields
+// It reports whether any field changed
+func (s *boardServiceImpl) applyBulkCustomFields(ctx context.Context, st *bulkBoardState, values map[string]interface{}) (bool, error) {
+	converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, st.board.ProjectID, values)
+	if err != nil {
+		return false, response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+	}
+
+	changed := false
+	for field, id := range converted {
+		if current, ok := st.customFields[field].(string); ok && current == id {
+			continue
+		}
+		st.customFields[field] = id
+		st.markChanged(field)
+		changed = true
+	}
+	return changed, nil
+}
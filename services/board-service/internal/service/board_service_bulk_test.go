@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// newBulkTestService는 "stage:<value>" 형식의 field option ID를 사용하는 보드 서비스를 만듭니다.
+func newBulkTestService(boardRepo *MockBoardRepository, projectID uuid.UUID) BoardService {
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: uuid.New()}, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+	}
+	converter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			result := map[string]interface{}{}
+			for k, v := range fields {
+				if v == "invalid" {
+					return nil, errors.New("invalid field option value")
+				}
+				result[k] = fmt.Sprintf("%s:%v", k, v)
+			}
+			return result, nil
+		},
+	}
+	return NewBoardService(boardRepo, projectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, converter, nil, nil, zap.NewNop())
+}
+
+func TestBoardService_BulkUpdateBoards(t *testing.T) {
+	projectID := uuid.New()
+	assignee := uuid.New()
+	moveID, assignID, deleteID := uuid.New(), uuid.New(), uuid.New()
+	stage, _ := json.Marshal(map[string]interface{}{"stage": "stage:todo", "importance": "importance:high"})
+
+	var updated []uuid.UUID
+	var deleted []uuid.UUID
+	boardRepo := &MockBoardRepository{
+		MaxPositionFunc: func(ctx context.Context, pid uuid.UUID) (string, error) {
+			return "x", nil
+		},
+		FindByIDsWithParticipantsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+			if len(ids) != 3 {
+				t.Errorf("expected 3 distinct boards to be loaded, got %d", len(ids))
+			}
+			return []*domain.Board{
+				{BaseModel: domain.BaseModel{ID: moveID}, ProjectID: projectID, CustomFields: stage},
+				{BaseModel: domain.BaseModel{ID: assignID}, ProjectID: projectID},
+				{BaseModel: domain.BaseModel{ID: deleteID}, ProjectID: projectID},
+			}, nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			updated = append(updated, board.ID)
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	s := newBulkTestService(boardRepo, projectID)
+
+	inProgress := "in_progress"
+	result, err := s.BulkUpdateBoards(context.Background(), &dto.BulkBoardRequest{
+		ProjectID: projectID,
+		Operations: []dto.BulkBoardOperation{
+			{Type: dto.BulkBoardOpMove, BoardID: moveID, GroupByFieldName: "stage", NewFieldValue: &inProgress},
+			{Type: dto.BulkBoardOpAssign, BoardID: assignID, AssigneeID: &assignee},
+			{Type: dto.BulkBoardOpUpdateFields, BoardID: assignID, CustomFields: map[string]interface{}{"role": "developer"}},
+			{Type: dto.BulkBoardOpAssign, BoardID: deleteID, AssigneeID: &assignee},
+			{Type: dto.BulkBoardOpDelete, BoardID: deleteID},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdateBoards() error = %v", err)
+	}
+
+	if len(updated) != 2 || updated[0] != moveID || updated[1] != assignID {
+		t.Errorf("expected move and assign boards to be updated in request order, got %v", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != deleteID {
+		t.Errorf("expected one board to be deleted, got %v", deleted)
+	}
+	if len(result.Boards) != 2 || len(result.DeletedBoardIDs) != 1 {
+		t.Fatalf("expected 2 boards and 1 deleted ID, got %d and %d", len(result.Boards), len(result.DeletedBoardIDs))
+	}
+	if got := result.Boards[0].CustomFields; got["stage"] != "stage:in_progress" || got["importance"] != "importance:high" {
+		t.Errorf("move should only change the group field, got %v", got)
+	}
+	if result.Boards[0].Position <= "x" || result.Boards[1].Position != "" {
+		t.Errorf("only the moved board should be placed after the last position, got %q and %q",
+			result.Boards[0].Position, result.Boards[1].Position)
+	}
+	if b := result.Boards[1]; b.AssigneeID == nil || *b.AssigneeID != assignee || b.CustomFields["role"] != "role:developer" {
+		t.Errorf("assign and updateCustomFields should both apply, got %+v", b)
+	}
+	if len(result.Moves) != 1 || result.Moves[0].BoardID != moveID || result.Moves[0].To != inProgress {
+		t.Errorf("unexpected moves %+v", result.Moves)
+	}
+}
+
+func TestBoardService_BulkUpdateBoards_Validation(t *testing.T) {
+	projectID := uuid.New()
+	boardID, otherProjectBoardID := uuid.New(), uuid.New()
+	invalid := "invalid"
+
+	tests := []struct {
+		name        string
+		ops         []dto.BulkBoardOperation
+		wantErrCode string
+	}{
+		{
+			name:        "다른 프로젝트의 보드",
+			ops:         []dto.BulkBoardOperation{{Type: dto.BulkBoardOpDelete, BoardID: otherProjectBoardID}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "존재하지 않는 보드",
+			ops:         []dto.BulkBoardOperation{{Type: dto.BulkBoardOpDelete, BoardID: uuid.New()}},
+			wantErrCode: response.ErrCodeNotFound,
+		},
+		{
+			name: "삭제 이후 작업",
+			ops: []dto.BulkBoardOperation{
+				{Type: dto.BulkBoardOpDelete, BoardID: boardID},
+				{Type: dto.BulkBoardOpAssign, BoardID: boardID, AssigneeID: &uuid.Nil},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "move 필드 누락",
+			ops:         []dto.BulkBoardOperation{{Type: dto.BulkBoardOpMove, BoardID: boardID}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "유효하지 않은 field value",
+			ops:         []dto.BulkBoardOperation{{Type: dto.BulkBoardOpMove, BoardID: boardID, GroupByFieldName: "stage", NewFieldValue: &invalid}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardRepo := &MockBoardRepository{
+				FindByIDsWithParticipantsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+					return []*domain.Board{
+						{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID},
+						{BaseModel: domain.BaseModel{ID: otherProjectBoardID}, ProjectID: uuid.New()},
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					t.Error("no board should be written when validation fails")
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					t.Error("no board should be deleted when validation fails")
+					return nil
+				},
+			}
+			s := newBulkTestService(boardRepo, projectID)
+
+			_, err := s.BulkUpdateBoards(context.Background(), &dto.BulkBoardRequest{ProjectID: projectID, Operations: tt.ops})
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+				t.Errorf("expected %s error, got %v", tt.wantErrCode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"project-board-api/internal/domain"
+)
+
+// BoardServiceOption configures optional BoardService behavior
+type BoardServiceOption func(*boardServiceImpl)
+
+// WithEvents publishes board domain events (created, updated, deleted) to the event bus
+func WithEvents(events *messaging.Emitter) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.events = events
+	}
+}
+
+// WithOutbox records board domain events in the transactional outbox instead of publishing them directly
+// 보드 변경과 같은 트랜잭션에 기록되므로 커밋된 변경의 이벤트는 유실되지 않습니다 (WithEvents보다 우선).
+// db는 ob가 nil이어도 보드 생성처럼 여러 저장소에 걸친 변경을 한 트랜잭션으로 묶는 데 사용합니다.
+func WithOutbox(db *gorm.DB, ob *outbox.Outbox) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.db = db
+		s.outbox = ob
+	}
+}
+
+// inTx runs fn in a unit-of-work transaction shared by all repositories, or directly without a database
+func (s *boardServiceImpl) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.db == nil {
+		return fn(ctx)
+	}
+	return uow.Transaction(ctx, s.db, fn)
+}
+
+// emitBoardEvent records or publishes a board domain event
+// With the outbox the error rolls back the surrounding transaction; direct publishing failures
+// are logged by the emitter and never fail the request
+func (s *boardServiceImpl) emitBoardEvent(ctx context.Context, eventType string, board *domain.Board, actorID uuid.UUID, changed []string) error {
+	if s.events == nil && s.outbox == nil {
+		return nil
+	}
+
+	// Get WorkspaceID from project (preloaded or fetched)
+	workspaceID := board.Project.WorkspaceID
+	if board.Project.ID == uuid.Nil {
+		if project, err := s.projectRepo.FindByID(ctx, board.ProjectID); err == nil && project != nil {
+			workspaceID = project.WorkspaceID
+		}
+	}
+
+	data := messaging.BoardEventData{
+		BoardID:   board.ID.String(),
+		ProjectID: board.ProjectID.String(),
+		Title:     board.Title,
+		Changed:   changed,
+	}
+	if board.AssigneeID != nil {
+		data.AssigneeID = board.AssigneeID.String()
+	}
+
+	actor := ""
+	if actorID != uuid.Nil {
+		actor = actorID.String()
+	}
+	if s.outbox != nil {
+		return s.outbox.Emit(ctx, eventType, workspaceID.String(), actor, data)
+	}
+	// 롤백된 변경은 알리지 않도록 커밋 후 발행
+	uow.AfterCommit(ctx, func() {
+		_ = s.events.Emit(context.WithoutCancel(ctx), eventType, workspaceID.String(), actor, data)
+	})
+	return nil
+}
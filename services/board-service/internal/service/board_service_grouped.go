@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WithCustomFields allows grouping boards by the project's select custom fields
+func WithCustomFields(repo repository.CustomFieldRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.customFieldRepo = repo
+	}
+}
+
+// GetGroupedBoards returns the boards of a project grouped into kanban columns by a field.
+// 컬럼은 필드 옵션의 displayOrder 순이며, 컬럼 안의 카드는 FindColumnOrder(Redis 캐시) 순서를 따릅니다.
+// 캐시에 아직 반영되지 않은 카드는 DB의 position 순으로 컬럼 끝에 붙고, 값이 없는 카드는 Unassigned에 담깁니다.
+func (s *boardServiceImpl) GetGroupedBoards(ctx context.Context, projectID uuid.UUID, fieldName string) (*dto.GroupedBoardsResponse, error) {
+	log := s.log(ctx)
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+	if err := s.requireGroupableField(ctx, projectID, fieldName); err != nil {
+		return nil, err
+	}
+
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldType(fieldName))
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch field options", err.Error())
+	}
+	if len(options) == 0 {
+		return nil, response.NewValidationError(fmt.Sprintf("Field '%s' has no options to group by", fieldName), "")
+	}
+
+	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		log.Error("GetGroupedBoards failed to fetch boards", zap.String("project.id", projectID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to fetch boards", err.Error())
+	}
+
+	// 옵션 ID 기준으로 나눈 뒤(ID → value 변환 전) 컬럼별로 정렬
+	byOption := make(map[string][]*domain.Board, len(options))
+	for _, option := range options {
+		byOption[option.ID.String()] = nil
+	}
+	var unassigned []*domain.Board
+	for _, board := range boards {
+		board.Project = *project // 응답의 workspaceId를 보드마다 조회하지 않도록
+		optionID := boardFieldValue(board, fieldName)
+		if _, ok := byOption[optionID]; ok {
+			byOption[optionID] = append(byOption[optionID], board)
+		} else {
+			unassigned = append(unassigned, board)
+		}
+	}
+
+	columns := make([][]*domain.Board, len(options))
+	for i, option := range options {
+		order, err := s.boardRepo.FindColumnOrder(ctx, projectID, fieldName, option.ID.String())
+		if err != nil {
+			log.Error("GetGroupedBoards failed to fetch column order",
+				zap.String("project.id", projectID.String()),
+				zap.String("option.id", option.ID.String()),
+				zap.Error(err))
+			return nil, response.NewInternalError("Failed to fetch column order", err.Error())
+		}
+		columns[i] = orderColumnBoards(byOption[option.ID.String()], order)
+	}
+	unassigned = orderColumnBoards(unassigned, nil)
+
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		log.Error("GetGroupedBoards failed to convert custom fields", zap.String("project.id", projectID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to convert custom fields", err.Error())
+	}
+
+	result := &dto.GroupedBoardsResponse{
+		GroupByFieldName: fieldName,
+		Columns:          make([]dto.BoardColumnResponse, len(options)),
+		Unassigned:       s.toBoardResponses(ctx, unassigned),
+	}
+	all := append([]*dto.BoardResponse{}, result.Unassigned...)
+	for i, option := range options {
+		result.Columns[i] = dto.BoardColumnResponse{
+			OptionID:   option.ID,
+			FieldValue: option.Value,
+			Label:      option.Label,
+			Color:      option.Color,
+			Boards:     s.toBoardResponses(ctx, columns[i]),
+		}
+		all = append(all, result.Columns[i].Boards...)
+	}
+	s.fillSubtaskProgress(ctx, all...)
+
+	log.Debug("GetGroupedBoards completed",
+		zap.String("project.id", projectID.String()),
+		zap.String("group_by", fieldName),
+		zap.Int("board.count", len(boards)))
+	return result, nil
+}
+
+// requireGroupableField rejects fields whose value is not a single field option (built-in fields and select custom fields)
+func (s *boardServiceImpl) requireGroupableField(ctx context.Context, projectID uuid.UUID, fieldName string) error {
+	if isValidFieldType(domain.FieldType(fieldName)) {
+		return nil
+	}
+	if s.customFieldRepo == nil {
+		return response.NewValidationError(fmt.Sprintf("Cannot group boards by '%s'", fieldName), "")
+	}
+	fields, err := s.customFieldRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return response.NewInternalError("Failed to fetch custom fields", err.Error())
+	}
+	for _, field := range fields {
+		if field.Key == fieldName && field.Type == domain.CustomFieldTypeSelect {
+			return nil
+		}
+	}
+	return response.NewValidationError(fmt.Sprintf("Cannot group boards by '%s': only stage, role, importance and select custom fields are supported", fieldName), "")
+}
+
+// boardFieldValue returns the stored (option ID) value of a board's custom field, or "" when unset
+func boardFieldValue(board *domain.Board, fieldName string) string {
+	if len(board.CustomFields) == 0 {
+		return ""
+	}
+	var customFields map[string]interface{}
+	if err := json.Unmarshal(board.CustomFields, &customFields); err != nil {
+		return ""
+	}
+	value, _ := customFields[fieldName].(string)
+	return value
+}
+
+// orderColumnBoards sorts the boards of a column by their index in order;
+// boards missing from order follow in position order (같은 position이면 id 순)
+func orderColumnBoards(boards []*domain.Board, order []uuid.UUID) []*domain.Board {
+	rank := make(map[uuid.UUID]int, len(order))
+	for i, id := range order {
+		rank[id] = i
+	}
+	rankOf := func(board *domain.Board) int {
+		if r, ok := rank[board.ID]; ok {
+			return r
+		}
+		return len(order)
+	}
+
+	sort.SliceStable(boards, func(i, j int) bool {
+		ri, rj := rankOf(boards[i]), rankOf(boards[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if boards[i].Position != boards[j].Position {
+			return boards[i].Position < boards[j].Position
+		}
+		return boards[i].ID.String() < boards[j].ID.String()
+	})
+	return boards
+}
+
+// toBoardResponses converts boards to response DTOs (never nil)
+func (s *boardServiceImpl) toBoardResponses(ctx context.Context, boards []*domain.Board) []*dto.BoardResponse {
+	responses := make([]*dto.BoardResponse, len(boards))
+	for i, board := range boards {
+		responses[i] = s.toBoardResponseWithWorkspace(ctx, board)
+	}
+	return responses
+}
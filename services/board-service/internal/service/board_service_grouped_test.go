@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_GetGroupedBoards(t *testing.T) {
+	projectID, workspaceID := uuid.New(), uuid.New()
+	todo := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeStage, Value: "todo", Label: "할 일"}
+	doing := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeStage, Value: "in_progress", Label: "진행중"}
+
+	newBoard := func(position string, stage *domain.FieldOption) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Position: position}
+		if stage != nil {
+			board.CustomFields, _ = json.Marshal(map[string]interface{}{"stage": stage.ID.String()})
+		}
+		return board
+	}
+	// b3는 컬럼 캐시에 아직 없는 카드, b5는 stage가 없는 카드
+	b1, b2, b3 := newBoard("a", todo), newBoard("b", todo), newBoard("c", todo)
+	b4, b5 := newBoard("d", doing), newBoard("e", nil)
+
+	boardRepo := &MockBoardRepository{
+		FindByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+			return []*domain.Board{b5, b3, b4, b1, b2}, nil
+		},
+		FindColumnOrderFunc: func(ctx context.Context, pid uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error) {
+			if optionID == todo.ID.String() {
+				return []uuid.UUID{b2.ID, b1.ID}, nil
+			}
+			return []uuid.UUID{b4.ID}, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}, WorkspaceID: workspaceID}, nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			return []*domain.FieldOption{todo, doing}, nil
+		},
+	}
+	s := NewBoardService(boardRepo, projectRepo, fieldOptionRepo, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	result, err := s.GetGroupedBoards(context.Background(), projectID, "stage")
+	if err != nil {
+		t.Fatalf("GetGroupedBoards() error = %v", err)
+	}
+
+	ids := func(boards []*dto.BoardResponse) []uuid.UUID {
+		out := make([]uuid.UUID, len(boards))
+		for i, b := range boards {
+			out[i] = b.ID
+		}
+		return out
+	}
+	want := [][]uuid.UUID{{b2.ID, b1.ID, b3.ID}, {b4.ID}}
+	if len(result.Columns) != len(want) {
+		t.Fatalf("columns = %d, want %d", len(result.Columns), len(want))
+	}
+	for i, column := range result.Columns {
+		got := ids(column.Boards)
+		if len(got) != len(want[i]) {
+			t.Fatalf("column %s = %v, want %v", column.FieldValue, got, want[i])
+		}
+		for j := range got {
+			if got[j] != want[i][j] {
+				t.Errorf("column %s = %v, want %v", column.FieldValue, got, want[i])
+				break
+			}
+		}
+	}
+	if result.Columns[0].FieldValue != "todo" || result.Columns[1].Label != "진행중" {
+		t.Errorf("columns = %+v, want the field options in order", result.Columns)
+	}
+	if len(result.Unassigned) != 1 || result.Unassigned[0].ID != b5.ID {
+		t.Errorf("unassigned = %v, want [%s]", ids(result.Unassigned), b5.ID)
+	}
+	if result.Columns[0].Boards[0].WorkspaceID != workspaceID {
+		t.Errorf("workspaceId = %s, want %s", result.Columns[0].Boards[0].WorkspaceID, workspaceID)
+	}
+}
+
+func TestBoardService_GetGroupedBoards_RejectsUngroupableFields(t *testing.T) {
+	projectID := uuid.New()
+	customFieldRepo := &MockCustomFieldRepository{
+		FindByProjectIDFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+			return []*domain.CustomFieldDefinition{
+				{ProjectID: pid, Key: "tags", Type: domain.CustomFieldTypeMultiSelect},
+				{ProjectID: pid, Key: "story_points", Type: domain.CustomFieldTypeNumber},
+			}, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	s := NewBoardService(&MockBoardRepository{}, projectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop(), WithCustomFields(customFieldRepo))
+
+	for _, field := range []string{"tags", "story_points", "unknown"} {
+		t.Run(field, func(t *testing.T) {
+			_, err := s.GetGroupedBoards(context.Background(), projectID, field)
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+				t.Errorf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
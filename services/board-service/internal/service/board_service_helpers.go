@@ -3,14 +3,11 @@ package service
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
@@ -117,6 +114,8 @@ func (s *boardServiceImpl) toBoardResponseWithWorkspace(ctx context.Context, boa
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+			ThumbnailURL:       thumbnailURL(s.s3Client.GetFileURL, a.ThumbnailKey),
+			MediumThumbnailURL: thumbnailURL(s.s3Client.GetFileURL, a.MediumThumbnailKey),
 		})
 	}
 
@@ -134,20 +133,26 @@ func (s *boardServiceImpl) toBoardResponseWithWorkspace(ctx context.Context, boa
 	}
 
 	return &dto.BoardResponse{
-		ID:             board.ID,
-		ProjectID:      board.ProjectID,
-		WorkspaceID:    workspaceID,
-		AuthorID:       board.AuthorID,
-		AssigneeID:     board.AssigneeID,
-		Title:          board.Title,
-		Content:        board.Content,
-		CustomFields:   customFields,
-		StartDate:      board.StartDate,
-		DueDate:        board.DueDate,
-		ParticipantIDs: participantIDs,
-		Attachments:    attachments,
-		CreatedAt:      board.CreatedAt,
-		UpdatedAt:      board.UpdatedAt,
+		ID:               board.ID,
+		ProjectID:        board.ProjectID,
+		WorkspaceID:      workspaceID,
+		AuthorID:         board.AuthorID,
+		AssigneeID:       board.AssigneeID,
+		Title:            board.Title,
+		Content:          board.Content,
+		CustomFields:     customFields,
+		StartDate:        board.StartDate,
+		DueDate:          board.DueDate,
+		ParticipantIDs:   participantIDs,
+		Attachments:      attachments,
+		Position:         board.Position,
+		RecurrenceRule:   board.RecurrenceRule,
+		NextRecurrenceAt: board.NextRecurrenceAt,
+		State:            string(domain.ArchiveStateOf(board.ArchivedAt)),
+		ArchivedAt:       board.ArchivedAt,
+		Version:          board.Version,
+		CreatedAt:        board.CreatedAt,
+		UpdatedAt:        board.UpdatedAt,
 	}
 }
 
@@ -185,66 +190,19 @@ func (s *boardServiceImpl) toBoardDetailResponse(ctx context.Context, board *dom
 }
 
 // addParticipantsInternal is an internal helper to add participants during board creation
-// It does not verify board existence (assumes board was just created)
-// Returns the number of successfully added participants and any errors
-func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) (int, error) {
-	// Remove duplicates from the user IDs
-	uniqueUserIDs := removeDuplicateUUIDs(userIDs)
-
-	successCount := 0
-	var failedUserIDs []uuid.UUID
-
-	// Process each participant individually
-	for _, userID := range uniqueUserIDs {
-		// Check if participant already exists
-		existing, err := s.participantRepo.FindByBoardAndUser(ctx, boardID, userID)
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			s.logger.Warn("Failed to check existing participant",
-				zap.String("board_id", boardID.String()),
-				zap.String("user_id", userID.String()),
-				zap.Error(err))
-			failedUserIDs = append(failedUserIDs, userID)
-			continue
-		}
-		if existing != nil {
-			// Participant already exists, skip
-			continue
-		}
-
-		// Create domain model
+// It does not verify board existence (assumes board was just created in the same transaction),
+// so there are no existing participants to skip; any failure fails the whole creation
+func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) error {
+	for _, userID := range removeDuplicateUUIDs(userIDs) {
 		participant := &domain.Participant{
 			BoardID: boardID,
 			UserID:  userID,
 		}
-
-		// Save to repository
 		if err := s.participantRepo.Create(ctx, participant); err != nil {
-			// Check for unique constraint violation
-			if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
-				// Participant already exists, skip
-				continue
-			}
-			s.logger.Warn("Failed to add participant",
-				zap.String("board_id", boardID.String()),
-				zap.String("user_id", userID.String()),
-				zap.Error(err))
-			failedUserIDs = append(failedUserIDs, userID)
-			continue
+			return fmt.Errorf("failed to add participant %s: %w", userID, err)
 		}
-
-		successCount++
-	}
-
-	// Log summary if there were failures
-	if len(failedUserIDs) > 0 {
-		s.logger.Warn("Some participants failed to be added during board creation",
-			zap.String("board_id", boardID.String()),
-			zap.Int("success_count", successCount),
-			zap.Int("failed_count", len(failedUserIDs)),
-			zap.Any("failed_user_ids", failedUserIDs))
 	}
-
-	return successCount, nil
+	return nil
 }
 
 // validateAndConfirmAttachments validates that attachments exist and are in TEMP status
@@ -303,6 +261,14 @@ func (s *boardServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attachme
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		for _, key := range attachment.ThumbnailKeys() {
+			if err := s.s3Client.DeleteFile(ctx, key); err != nil {
+				s.logger.Warn("Failed to delete thumbnail from S3",
+					zap.String("attachment_id", attachment.ID.String()),
+					zap.String("file_key", key),
+					zap.Error(err))
+			}
+		}
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -331,6 +297,18 @@ func (s *boardServiceImpl) isAssigneeChanged(original, current *uuid.UUID) bool
 	return *original != *current
 }
 
+// sendNotificationsAsync runs send without delaying the response
+// Notifications recorded in the outbox are only a DB insert, so they run inline with the request context
+// (trace 유지, 유실 없음); HTTP/NATS clients run in a goroutine with a background context to avoid
+// cancellation when the request completes
+func sendNotificationsAsync(ctx context.Context, notiClient client.NotiClient, send func(ctx context.Context)) {
+	if client.IsQueued(notiClient) {
+		send(ctx)
+		return
+	}
+	go send(context.Background())
+}
+
 // sendAssigneeNotification sends a BOARD_ASSIGNED notification to the assignee
 // This is called asynchronously (in a goroutine) so notification failures don't affect the main business logic
 func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *domain.Board, actorID uuid.UUID) {
@@ -362,15 +340,14 @@ func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *
 	}
 
 	// Send notification asynchronously
-	go func() {
-		// Use background context to avoid cancellation when request completes
-		if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
+		if err := s.notiClient.SendNotification(ctx, event); err != nil {
 			s.logger.Warn("Failed to send board assigned notification",
 				zap.String("board.id", board.ID.String()),
 				zap.String("assignee.id", board.AssigneeID.String()),
 				zap.Error(err))
 		}
-	}()
+	})
 }
 
 // sendParticipantAddedNotifications sends BOARD_PARTICIPANT_ADDED notifications to new participants
@@ -389,7 +366,7 @@ func (s *boardServiceImpl) sendParticipantAddedNotifications(ctx context.Context
 	}
 
 	// Send notification to each participant asynchronously
-	go func() {
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
 		for _, participantID := range participantIDs {
 			event := &client.NotificationEvent{
 				Type:         client.NotificationTypeBoardParticipantAdded,
@@ -405,14 +382,14 @@ func (s *boardServiceImpl) sendParticipantAddedNotifications(ctx context.Context
 				},
 			}
 
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+			if err := s.notiClient.SendNotification(ctx, event); err != nil {
 				s.logger.Warn("Failed to send participant added notification",
 					zap.String("board.id", board.ID.String()),
 					zap.String("participant.id", participantID.String()),
 					zap.Error(err))
 			}
 		}
-	}()
+	})
 }
 
 // sendBoardUpdateNotifications sends BOARD_UPDATED notifications to assignee and all participants
@@ -432,7 +409,7 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 		return
 	}
 
-	// Collect all users to notify (assignee + participants), excluding actor
+	// Collect all users to notify (assignee + participants + watchers), excluding actor
 	notifyUserIDs := make(map[uuid.UUID]bool)
 
 	// Add assignee if exists and not the actor
@@ -447,6 +424,9 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 		}
 	}
 
+	// Add watchers if not the actor
+	s.addWatchers(ctx, board, actorID, notifyUserIDs)
+
 	if len(notifyUserIDs) == 0 {
 		return
 	}
@@ -462,7 +442,7 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 	}
 
 	// Send notifications asynchronously
-	go func() {
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
 		for userID := range notifyUserIDs {
 			event := &client.NotificationEvent{
 				Type:         client.NotificationTypeBoardUpdated,
@@ -479,14 +459,14 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 				},
 			}
 
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+			if err := s.notiClient.SendNotification(ctx, event); err != nil {
 				s.logger.Warn("Failed to send board update notification",
 					zap.String("board.id", board.ID.String()),
 					zap.String("target.user.id", userID.String()),
 					zap.Error(err))
 			}
 		}
-	}()
+	})
 }
 
 // sendCommentAddedNotifications sends BOARD_COMMENT_ADDED notifications to assignee and all participants
@@ -505,7 +485,7 @@ func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, bo
 		return
 	}
 
-	// Collect all users to notify (assignee + participants), excluding actor
+	// Collect all users to notify (assignee + participants + watchers), excluding actor
 	notifyUserIDs := make(map[uuid.UUID]bool)
 
 	// Add assignee if exists and not the actor
@@ -520,6 +500,9 @@ func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, bo
 		}
 	}
 
+	// Add watchers if not the actor
+	s.addWatchers(ctx, board, actorID, notifyUserIDs)
+
 	if len(notifyUserIDs) == 0 {
 		return
 	}
@@ -530,7 +513,7 @@ func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, bo
 	}
 
 	// Send notifications asynchronously
-	go func() {
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
 		for userID := range notifyUserIDs {
 			event := &client.NotificationEvent{
 				Type:         client.NotificationTypeBoardCommentAdded,
@@ -548,12 +531,12 @@ func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, bo
 				},
 			}
 
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+			if err := s.notiClient.SendNotification(ctx, event); err != nil {
 				s.logger.Warn("Failed to send comment notification",
 					zap.String("board.id", board.ID.String()),
 					zap.String("target.user.id", userID.String()),
 					zap.Error(err))
 			}
 		}
-	}()
+	})
 }
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/position"
+	"project-board-api/internal/response"
+	"project-board-api/internal/spreadsheet"
+)
+
+// WithAssigneeProfiles resolves imported assignees given by email or nickname through the members' workspace profiles
+// (없으면 담당자 열에 멤버의 사용자 ID만 쓸 수 있음)
+func WithAssigneeProfiles(userClient client.UserClient) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.userClient = userClient
+	}
+}
+
+// 가져오기 파일의 기본 열 (헤더는 대소문자, 공백, '_', '-'를 무시하고 비교)
+const (
+	importColumnTitle     = "title"
+	importColumnContent   = "content"
+	importColumnAssignee  = "assignee"
+	importColumnStartDate = "startDate"
+	importColumnDueDate   = "dueDate"
+)
+
+var importBaseColumns = []string{importColumnTitle, importColumnContent, importColumnAssignee, importColumnStartDate, importColumnDueDate}
+
+// importColumn is a recognized column of an import file
+type importColumn struct {
+	index int
+	name  string                        // 기본 열 이름 또는 customFields key
+	field *domain.CustomFieldDefinition // 커스텀 필드 열 (기본 필드 stage/role/importance는 nil)
+	base  bool
+}
+
+// importRow is a validated row ready to be created
+type importRow struct {
+	row   int
+	board *domain.Board
+}
+
+// ImportBoards validates the rows of an uploaded CSV/XLSX file and, unless dryRun, creates a board per row.
+// 첫 행은 헤더이며 title, content, assignee, startDate, dueDate 외의 열은 customFields key(stage, role, importance, 커스텀 필드)로 검증합니다.
+// 오류는 행/열 단위로 모두 모아 반환하고, 오류가 하나라도 있으면 보드를 만들지 않습니다. 생성은 한 트랜잭션으로 처리됩니다.
+func (s *boardServiceImpl) ImportBoards(ctx context.Context, projectID uuid.UUID, rows [][]string, dryRun bool) (*dto.BoardImportResponse, error) {
+	log := s.log(ctx)
+	log.Debug("ImportBoards service started",
+		zap.String("project.id", projectID.String()),
+		zap.Int("row.count", len(rows)),
+		zap.Bool("dry_run", dryRun))
+
+	authorID, exists := ctx.Value("user_id").(uuid.UUID)
+	if !exists {
+		return nil, response.NewAppError(response.ErrCodeUnauthorized, "User ID not found in context", "")
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		log.Error("ImportBoards failed to verify project", zap.String("project.id", projectID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+
+	if len(rows) == 0 {
+		return nil, response.NewValidationError("The file is empty", "A header row is required")
+	}
+	result := &dto.BoardImportResponse{DryRun: dryRun, Errors: []dto.BoardImportRowError{}, Boards: []*dto.BoardResponse{}}
+
+	columns, headerErrors, err := s.importColumns(ctx, projectID, rows[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(headerErrors) > 0 {
+		result.Errors = headerErrors
+		return result, nil
+	}
+
+	var dataRows []int
+	for i := 1; i < len(rows); i++ {
+		if !isBlankRow(rows[i]) {
+			dataRows = append(dataRows, i)
+		}
+	}
+	if len(dataRows) > dto.MaxBoardImportRows {
+		return nil, response.NewValidationError(
+			fmt.Sprintf("The file must contain at most %d rows", dto.MaxBoardImportRows), "")
+	}
+	result.TotalRows = len(dataRows)
+
+	importer := &boardImporter{s: s, project: project, authorID: authorID, converted: make(map[string]importConversion)}
+	var valid []importRow
+	for _, i := range dataRows {
+		board, rowErrors := importer.parseRow(ctx, i+1, rows[i], columns)
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		valid = append(valid, importRow{row: i + 1, board: board})
+	}
+	result.ValidRows = len(valid)
+	if importer.err != nil {
+		log.Error("ImportBoards failed to validate rows", zap.String("project.id", projectID.String()), zap.Error(importer.err))
+		return nil, importer.err
+	}
+
+	if dryRun || len(result.Errors) > 0 || len(valid) == 0 {
+		log.Debug("ImportBoards validated",
+			zap.String("project.id", projectID.String()),
+			zap.Int("valid.count", len(valid)),
+			zap.Int("error.count", len(result.Errors)))
+		return result, nil
+	}
+
+	boards := make([]*domain.Board, len(valid))
+	for i, row := range valid {
+		boards[i] = row.board
+	}
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		// 가져온 카드는 파일 순서대로 컬럼 맨 아래에 배치
+		last, err := s.boardRepo.MaxPosition(ctx, projectID)
+		if err != nil {
+			return response.NewInternalError("Failed to import boards", err.Error())
+		}
+		positions := position.Sequence(last, len(boards))
+		for i, board := range boards {
+			board.Position = positions[i]
+			if err := s.boardRepo.Create(ctx, board); err != nil {
+				return response.NewInternalError("Failed to import boards", fmt.Sprintf("row %d: %v", valid[i].row, err))
+			}
+			if err := s.emitBoardEvent(ctx, messaging.EventBoardCreated, board, authorID, nil); err != nil {
+				return response.NewInternalError("Failed to import boards", err.Error())
+			}
+			if err := s.recordActivities(ctx, board, authorID, domain.ActivityActionCreated, nil); err != nil {
+				return response.NewInternalError("Failed to import boards", err.Error())
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Error("ImportBoards rolled back",
+			zap.String("project.id", projectID.String()),
+			zap.Int("board.count", len(boards)),
+			zap.Error(err))
+		return nil, err
+	}
+
+	for _, board := range boards {
+		if s.metrics != nil {
+			s.metrics.IncrementBoardCreated()
+		}
+		// 가져오기를 한 사용자 자신에게는 보드마다 알림을 보내지 않음
+		if board.AssigneeID != nil && *board.AssigneeID != authorID {
+			s.sendAssigneeNotification(ctx, board, authorID)
+		}
+	}
+
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		log.Warn("ImportBoards failed to convert custom fields for response", zap.Error(err))
+	}
+	for _, board := range boards {
+		board.Project = *project
+	}
+	result.Boards = s.toBoardResponses(ctx, boards)
+
+	log.Info("ImportBoards completed",
+		zap.String("project.id", projectID.String()),
+		zap.Int("board.count", len(boards)))
+	return result, nil
+}
+
+// importColumns maps the header row to base columns and customFields keys; 알 수 없거나 중복된 열은 헤더 오류로 보고
+func (s *boardServiceImpl) importColumns(ctx context.Context, projectID uuid.UUID, header []string) ([]importColumn, []dto.BoardImportRowError, error) {
+	var customFields []*domain.CustomFieldDefinition
+	if s.customFieldRepo != nil {
+		var err error
+		if customFields, err = s.customFieldRepo.FindByProjectID(ctx, projectID); err != nil {
+			return nil, nil, response.NewInternalError("Failed to fetch custom fields", err.Error())
+		}
+	}
+
+	var columns []importColumn
+	var headerErrors []dto.BoardImportRowError
+	seen := make(map[string]bool)
+	for i, cell := range header {
+		if cell == "" {
+			continue
+		}
+		column, ok := matchImportColumn(cell, customFields)
+		if !ok {
+			headerErrors = append(headerErrors, dto.BoardImportRowError{Row: 1, Column: cell, Value: cell,
+				Message: "Unknown column: use title, content, assignee, startDate, dueDate or a custom field key"})
+			continue
+		}
+		if seen[column.name] {
+			headerErrors = append(headerErrors, dto.BoardImportRowError{Row: 1, Column: cell, Value: cell, Message: "Duplicate column"})
+			continue
+		}
+		seen[column.name] = true
+		column.index = i
+		columns = append(columns, column)
+	}
+	if !seen[importColumnTitle] && len(headerErrors) == 0 {
+		headerErrors = append(headerErrors, dto.BoardImportRowError{Row: 1, Column: importColumnTitle, Message: "The title column is required"})
+	}
+	return columns, headerErrors, nil
+}
+
+func matchImportColumn(header string, customFields []*domain.CustomFieldDefinition) (importColumn, bool) {
+	normalized := strings.ToLower(strings.NewReplacer(" ", "", "_", "", "-", "").Replace(header))
+	for _, name := range importBaseColumns {
+		if normalized == strings.ToLower(name) {
+			return importColumn{name: name, base: true}, true
+		}
+	}
+	key := strings.ToLower(strings.TrimSpace(header))
+	if isValidFieldType(domain.FieldType(key)) {
+		return importColumn{name: key}, true
+	}
+	for _, field := range customFields {
+		if field.Key == key {
+			return importColumn{name: key, field: field}, true
+		}
+	}
+	return importColumn{}, false
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importConversion is the memoized converted (option ID) value of a customFields cell
+type importConversion struct {
+	value   interface{}
+	message string // 검증 오류 메시지 (빈 문자열이면 성공)
+}
+
+// boardImporter validates the rows of one import, caching lookups shared by rows
+type boardImporter struct {
+	s         *boardServiceImpl
+	project   *domain.Project
+	authorID  uuid.UUID
+	converted map[string]importConversion // "key\x00cell" → 변환 결과 (행마다 옵션을 다시 조회하지 않도록)
+	members   map[uuid.UUID]bool          // 프로젝트 멤버 (소유자 포함)
+	aliases   map[string]uuid.UUID        // 소문자 이메일/닉네임 → 사용자 ID (uuid.Nil이면 닉네임이 여러 멤버와 겹침)
+	err       error                       // 행 오류가 아닌 조회 실패
+}
+
+// parseRow builds the board of one data row, or returns its cell errors
+func (im *boardImporter) parseRow(ctx context.Context, rowNumber int, row []string, columns []importColumn) (*domain.Board, []dto.BoardImportRowError) {
+	var rowErrors []dto.BoardImportRowError
+	fail := func(column, value, message string) {
+		rowErrors = append(rowErrors, dto.BoardImportRowError{Row: rowNumber, Column: column, Value: value, Message: message})
+	}
+
+	board := &domain.Board{ProjectID: im.project.ID, AuthorID: im.authorID, AssigneeID: &im.authorID}
+	customFields := make(map[string]interface{})
+	for _, column := range columns {
+		var cell string
+		if column.index < len(row) {
+			cell = row[column.index]
+		}
+		if !column.base {
+			if cell == "" {
+				continue
+			}
+			value, message := im.convertCustomField(ctx, column, cell)
+			if message != "" {
+				fail(column.name, cell, message)
+				continue
+			}
+			customFields[column.name] = value
+			continue
+		}
+
+		switch column.name {
+		case importColumnTitle:
+			if cell == "" {
+				fail(column.name, cell, "Title is required")
+			} else if utf8.RuneCountInString(cell) > 200 {
+				fail(column.name, cell, "Title must be at most 200 characters")
+			}
+			board.Title = cell
+		case importColumnContent:
+			if utf8.RuneCountInString(cell) > 5000 {
+				fail(column.name, "", "Content must be at most 5000 characters")
+			}
+			board.Content = cell
+		case importColumnAssignee:
+			if cell == "" {
+				continue
+			}
+			assigneeID, message := im.resolveAssignee(ctx, cell)
+			if message != "" {
+				fail(column.name, cell, message)
+				continue
+			}
+			board.AssigneeID = &assigneeID
+		case importColumnStartDate, importColumnDueDate:
+			if cell == "" {
+				continue
+			}
+			date, err := spreadsheet.ParseDate(cell)
+			if err != nil {
+				fail(column.name, cell, "Invalid date: use YYYY-MM-DD")
+				continue
+			}
+			if column.name == importColumnStartDate {
+				board.StartDate = &date
+			} else {
+				board.DueDate = &date
+			}
+		}
+	}
+
+	if err := validateDateRange(board.StartDate, board.DueDate); err != nil {
+		fail(importColumnDueDate, board.DueDate.Format(time.DateOnly), "Start date cannot be after due date")
+	}
+	if len(customFields) > 0 {
+		customFieldsJSON, err := json.Marshal(customFields)
+		if err != nil {
+			fail("", "", "Failed to encode custom fields")
+		}
+		board.CustomFields = customFieldsJSON
+	}
+	return board, rowErrors
+}
+
+// convertCustomField parses a customFields cell by the field type and converts it to option IDs
+func (im *boardImporter) convertCustomField(ctx context.Context, column importColumn, cell string) (interface{}, string) {
+	cacheKey := column.name + "\x00" + cell
+	if c, ok := im.converted[cacheKey]; ok {
+		return c.value, c.message
+	}
+
+	var value interface{} = cell
+	var conversion importConversion
+	if column.field != nil {
+		switch column.field.Type {
+		case domain.CustomFieldTypeMultiSelect:
+			// 여러 값은 쉼표로 구분
+			var values []interface{}
+			for _, v := range strings.Split(cell, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					values = append(values, v)
+				}
+			}
+			value = values
+		case domain.CustomFieldTypeNumber:
+			n, err := strconv.ParseFloat(cell, 64)
+			if err != nil {
+				conversion.message = "Invalid number"
+			}
+			value = n
+		case domain.CustomFieldTypeDate:
+			date, err := spreadsheet.ParseDate(cell)
+			if err != nil {
+				conversion.message = "Invalid date: use YYYY-MM-DD"
+			}
+			value = date.Format(time.DateOnly)
+		}
+	}
+
+	if conversion.message == "" {
+		converted, err := im.s.fieldOptionConverter.ConvertValuesToIDs(ctx, im.project.ID, map[string]interface{}{column.name: value})
+		if err != nil {
+			conversion.message = "Invalid value: " + err.Error()
+		} else {
+			conversion.value = converted[column.name]
+		}
+	}
+	im.converted[cacheKey] = conversion
+	return conversion.value, conversion.message
+}
+
+// resolveAssignee resolves a project member by user ID, or by email or nickname when profiles are available.
+// 사용자에게 보여줄 오류 메시지를 반환합니다 (빈 문자열이면 성공).
+func (im *boardImporter) resolveAssignee(ctx context.Context, cell string) (uuid.UUID, string) {
+	if im.err != nil || (im.members == nil && !im.loadMembers(ctx)) {
+		return uuid.Nil, "Failed to verify assignee"
+	}
+	if id, err := uuid.Parse(cell); err == nil {
+		if !im.members[id] {
+			return uuid.Nil, "Assignee is not a project member"
+		}
+		return id, ""
+	}
+	if im.s.userClient == nil {
+		return uuid.Nil, "Assignee must be a project member ID"
+	}
+	if im.aliases == nil {
+		im.loadAliases(ctx)
+	}
+	id, ok := im.aliases[strings.ToLower(cell)]
+	switch {
+	case !ok:
+		return uuid.Nil, "Unknown assignee: no project member has this email or nickname"
+	case id == uuid.Nil:
+		return uuid.Nil, "Ambiguous assignee: several project members have this nickname"
+	}
+	return id, ""
+}
+
+func (im *boardImporter) loadMembers(ctx context.Context) bool {
+	members, err := im.s.projectRepo.FindMembersByProjectID(ctx, im.project.ID)
+	if err != nil {
+		im.err = response.NewInternalError("Failed to fetch project members", err.Error())
+		return false
+	}
+	im.members = map[uuid.UUID]bool{im.project.OwnerID: true}
+	for _, m := range members {
+		im.members[m.UserID] = true
+	}
+	return true
+}
+
+// loadAliases fetches the workspace profile of every member once; 조회에 실패한 멤버는 이메일/닉네임으로 찾을 수 없음
+func (im *boardImporter) loadAliases(ctx context.Context) {
+	token, _ := ctx.Value("jwtToken").(string)
+	im.aliases = make(map[string]uuid.UUID)
+	for memberID := range im.members {
+		profile, err := im.s.userClient.GetWorkspaceProfile(ctx, im.project.WorkspaceID, memberID, token)
+		if err != nil || profile == nil {
+			im.s.logger.Warn("Failed to fetch workspace profile for board import",
+				zap.String("user.id", memberID.String()),
+				zap.Error(err))
+			continue
+		}
+		if profile.Email != "" {
+			im.aliases[strings.ToLower(profile.Email)] = memberID
+		}
+		if nickname := strings.ToLower(profile.NickName); nickname != "" {
+			if existing, ok := im.aliases[nickname]; ok && existing != memberID {
+				im.aliases[nickname] = uuid.Nil
+			} else {
+				im.aliases[nickname] = memberID
+			}
+		}
+	}
+}
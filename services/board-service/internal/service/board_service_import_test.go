@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// newImportTestService returns a board service whose project has an owner and one member (kim@example.com, 닉네임 kim)
+func newImportTestService(boardRepo *MockBoardRepository, projectID, ownerID, memberID uuid.UUID) BoardService {
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: uuid.New(), OwnerID: ownerID}, nil
+		},
+		FindMembersByProjectIDFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.ProjectMember, error) {
+			return []*domain.ProjectMember{{ProjectID: pid, UserID: memberID}}, nil
+		},
+	}
+	userClient := &MockUserClient{
+		GetWorkspaceProfileFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceProfile, error) {
+			if userID == memberID {
+				return &client.WorkspaceProfile{UserID: userID, NickName: "Kim", Email: "kim@example.com"}, nil
+			}
+			return &client.WorkspaceProfile{UserID: userID, NickName: "owner", Email: "owner@example.com"}, nil
+		},
+	}
+	customFieldRepo := &MockCustomFieldRepository{
+		FindByProjectIDFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+			return []*domain.CustomFieldDefinition{
+				{ProjectID: pid, Key: "tags", Type: domain.CustomFieldTypeMultiSelect},
+				{ProjectID: pid, Key: "story_points", Type: domain.CustomFieldTypeNumber},
+			}, nil
+		},
+	}
+	converter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			result := map[string]interface{}{}
+			for k, v := range fields {
+				if v == "archived" {
+					return nil, errors.New("invalid field option value")
+				}
+				result[k] = v
+			}
+			return result, nil
+		},
+	}
+	return NewBoardService(boardRepo, projectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, converter, nil, nil, zap.NewNop(),
+		WithCustomFields(customFieldRepo), WithAssigneeProfiles(userClient))
+}
+
+func TestBoardService_ImportBoards(t *testing.T) {
+	projectID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+	var created []*domain.Board
+	boardRepo := &MockBoardRepository{
+		MaxPositionFunc: func(ctx context.Context, pid uuid.UUID) (string, error) {
+			return "m", nil
+		},
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			created = append(created, board)
+			return nil
+		},
+	}
+	s := newImportTestService(boardRepo, projectID, ownerID, memberID)
+	ctx := context.WithValue(context.Background(), "user_id", ownerID)
+
+	rows := [][]string{
+		{"Title", "Assignee", "Start Date", "due_date", "stage", "tags", "story_points"},
+		{"로그인 구현", "KIM@example.com", "2026-10-01", "46315", "in_progress", "api, ui", "3"},
+		{},
+		{"배포", memberID.String(), "", "", "", "", ""},
+		{"회고"},
+	}
+
+	dryRun, err := s.ImportBoards(ctx, projectID, rows, true)
+	if err != nil {
+		t.Fatalf("ImportBoards(dryRun) error = %v", err)
+	}
+	if len(created) != 0 || len(dryRun.Boards) != 0 {
+		t.Fatalf("dry run created %d boards", len(created))
+	}
+	if dryRun.TotalRows != 3 || dryRun.ValidRows != 3 || len(dryRun.Errors) != 0 {
+		t.Fatalf("dry run = %+v, want 3 valid rows", dryRun)
+	}
+
+	result, err := s.ImportBoards(ctx, projectID, rows, false)
+	if err != nil {
+		t.Fatalf("ImportBoards() error = %v", err)
+	}
+	if len(created) != 3 || len(result.Boards) != 3 {
+		t.Fatalf("created %d boards, want 3", len(created))
+	}
+
+	first := created[0]
+	if first.AssigneeID == nil || *first.AssigneeID != memberID {
+		t.Errorf("assignee = %v, want %s (resolved by email)", first.AssigneeID, memberID)
+	}
+	if first.DueDate == nil || !first.DueDate.Equal(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("dueDate = %v, want 2026-10-20 (Excel serial date)", first.DueDate)
+	}
+	var customFields map[string]interface{}
+	if err := json.Unmarshal(first.CustomFields, &customFields); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(customFields["tags"]) != "[api ui]" || customFields["story_points"] != float64(3) || customFields["stage"] != "in_progress" {
+		t.Errorf("customFields = %v", customFields)
+	}
+	if created[2].AssigneeID == nil || *created[2].AssigneeID != ownerID {
+		t.Errorf("assignee without a value = %v, want the importing user", created[2].AssigneeID)
+	}
+	if !(created[0].Position > "m" && created[0].Position < created[1].Position && created[1].Position < created[2].Position) {
+		t.Errorf("positions = %q, %q, %q, want ascending after %q",
+			created[0].Position, created[1].Position, created[2].Position, "m")
+	}
+}
+
+func TestBoardService_ImportBoards_ReportsRowErrors(t *testing.T) {
+	projectID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+	boardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			t.Fatal("no board should be created when a row is invalid")
+			return nil
+		},
+	}
+	s := newImportTestService(boardRepo, projectID, ownerID, memberID)
+	ctx := context.WithValue(context.Background(), "user_id", ownerID)
+
+	rows := [][]string{
+		{"title", "assignee", "startDate", "dueDate", "stage", "story_points"},
+		{"정상", "kim", "", "", "", ""},
+		{"", "nobody@example.com", "", "", "", ""},
+		{"날짜 오류", uuid.New().String(), "10/01/2026", "2026-10-01", "archived", "many"},
+		{"기간 역전", "", "2026-10-20", "2026-10-01", "", ""},
+	}
+
+	result, err := s.ImportBoards(ctx, projectID, rows, false)
+	if err != nil {
+		t.Fatalf("ImportBoards() error = %v", err)
+	}
+	if result.ValidRows != 1 || len(result.Boards) != 0 {
+		t.Errorf("result = %+v, want 1 valid row and no boards", result)
+	}
+
+	got := map[string]bool{}
+	for _, e := range result.Errors {
+		got[fmt.Sprintf("%d:%s", e.Row, e.Column)] = true
+	}
+	want := []string{"3:title", "3:assignee", "4:assignee", "4:startDate", "4:stage", "4:story_points", "5:dueDate"}
+	for _, key := range want {
+		if !got[key] {
+			t.Errorf("missing error %s in %+v", key, result.Errors)
+		}
+	}
+	if len(result.Errors) != len(want) {
+		t.Errorf("errors = %+v, want %d", result.Errors, len(want))
+	}
+}
+
+func TestBoardService_ImportBoards_RejectsInvalidHeader(t *testing.T) {
+	projectID, ownerID := uuid.New(), uuid.New()
+	s := newImportTestService(&MockBoardRepository{}, projectID, ownerID, uuid.New())
+	ctx := context.WithValue(context.Background(), "user_id", ownerID)
+
+	tests := map[string][]string{
+		"title 열 없음":     {"content", "stage"},
+		"알 수 없는 열":       {"title", "priority"},
+		"중복된 열":          {"title", "Due Date", "dueDate"},
+		"정의되지 않은 커스텀 필드": {"title", "severity"},
+	}
+	for name, header := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := s.ImportBoards(ctx, projectID, [][]string{header, {"보드"}}, false)
+			if err != nil {
+				t.Fatalf("ImportBoards() error = %v", err)
+			}
+			if len(result.Errors) == 0 || result.Errors[0].Row != 1 {
+				t.Errorf("errors = %+v, want a header error", result.Errors)
+			}
+		})
+	}
+
+	rows := [][]string{{"title"}}
+	for i := 0; i <= dto.MaxBoardImportRows; i++ {
+		rows = append(rows, []string{fmt.Sprintf("보드 %d", i)})
+	}
+	if _, err := s.ImportBoards(ctx, projectID, rows, true); err == nil {
+		t.Error("ImportBoards() should reject files over the row limit")
+	}
+}
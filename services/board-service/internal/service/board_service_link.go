@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// WithBoardLinks includes the board's dependency links in BoardDetailResponse
+func WithBoardLinks(repo repository.BoardLinkRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.linkRepo = repo
+	}
+}
+
+// fillBoardLinks sets Links of a board detail (never nil).
+// 링크는 부가 정보이므로 조회에 실패해도 보드 응답은 그대로 반환합니다.
+func (s *boardServiceImpl) fillBoardLinks(ctx context.Context, detail *dto.BoardDetailResponse) {
+	detail.Links = []dto.BoardLinkResponse{}
+	if s.linkRepo == nil {
+		return
+	}
+	links, err := loadBoardLinks(ctx, s.linkRepo, s.boardRepo, detail.ID)
+	if err != nil {
+		s.log(ctx).Warn("Failed to fetch board links", zap.String("board.id", detail.ID.String()), zap.Error(err))
+		return
+	}
+	detail.Links = links
+}
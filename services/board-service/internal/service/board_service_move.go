@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/position"
+	"project-board-api/internal/response"
+)
+
+// MoveBoard moves a board to another kanban column and/or between two cards of the target column.
+// 컬럼 값과 위치(boards.position) 변경, 활동 기록, board.updated 이벤트를 한 트랜잭션으로 기록하므로
+// Redis가 비워져도 카드 순서가 유지됩니다.
+func (s *boardServiceImpl) MoveBoard(ctx context.Context, boardID uuid.UUID, req *dto.MoveBoardRequest) (*dto.MoveBoardResponse, error) {
+	log := s.log(ctx)
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
+	projectID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid project ID", "")
+	}
+	if req.NewFieldValue == nil {
+		return nil, response.NewValidationError("newFieldValue is required", "")
+	}
+	for _, neighbor := range []*uuid.UUID{req.PrevBoardID, req.NextBoardID} {
+		if neighbor != nil && *neighbor == boardID {
+			return nil, response.NewValidationError("A board cannot be placed next to itself", "")
+		}
+	}
+	field, newValue := req.GroupByFieldName, *req.NewFieldValue
+
+	var (
+		board         *domain.Board
+		oldFieldID    string
+		changed       []string
+		columnChanges []BoardChange // 컬럼 변경 (라벨 기준, 순서만 바뀐 경우 비어 있음)
+	)
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		// 프로젝트 행을 잠근 뒤 읽으므로 같은 프로젝트의 동시 이동/생성과 위치가 겹치지 않음
+		last, err := s.boardRepo.MaxPosition(ctx, projectID)
+		if err != nil {
+			return response.NewInternalError("Failed to move board", err.Error())
+		}
+
+		board, err = s.boardRepo.FindByID(ctx, boardID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+			}
+			return response.NewInternalError("Failed to fetch board", err.Error())
+		}
+		if board.ProjectID != projectID {
+			return response.NewValidationError("Board does not belong to the project", "")
+		}
+
+		converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{field: newValue})
+		if err != nil {
+			return response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+		}
+		customFields := map[string]interface{}{}
+		if len(board.CustomFields) > 0 {
+			_ = json.Unmarshal(board.CustomFields, &customFields)
+		}
+		oldFieldID, _ = customFields[field].(string)
+		if newFieldID := converted[field]; newFieldID != customFields[field] {
+			columnChanges = s.customFieldChanges(ctx, map[string]interface{}{field: customFields[field]}, map[string]interface{}{field: newFieldID})
+			customFields[field] = newFieldID
+			changed = append(changed, field)
+		}
+
+		lower, upper, err := s.moveNeighborPositions(ctx, projectID, req, last)
+		if err != nil {
+			return err
+		}
+		newPosition, err := position.Between(lower, upper)
+		if err != nil {
+			// 이웃 카드의 순서가 클라이언트가 본 것과 다름 (다른 사용자가 먼저 이동)
+			return response.NewAppError(response.ErrCodeConflict, "Board order has changed, reload the column and retry", err.Error())
+		}
+		if newPosition != board.Position {
+			board.Position = newPosition
+			changed = append(changed, "position")
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+
+		jsonBytes, err := json.Marshal(customFields)
+		if err != nil {
+			return response.NewInternalError("Failed to marshal custom fields", err.Error())
+		}
+		board.CustomFields = jsonBytes
+		if err := s.boardRepo.Update(ctx, board); err != nil {
+			return response.NewInternalError("Failed to move board", err.Error())
+		}
+		if err := s.recordActivities(ctx, board, actorID, domain.ActivityActionUpdated, columnChanges); err != nil {
+			return response.NewInternalError("Failed to move board", err.Error())
+		}
+		return s.emitBoardEvent(ctx, messaging.EventBoardUpdated, board, actorID, changed)
+	}); err != nil {
+		log.Warn("MoveBoard rolled back", zap.String("board.id", boardID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	oldValue := oldFieldID
+	if oldFieldID != "" {
+		if values, err := s.fieldOptionConverter.ConvertIDsToValues(ctx, map[string]interface{}{field: oldFieldID}); err == nil {
+			if value, ok := values[field].(string); ok {
+				oldValue = value
+			}
+		}
+	}
+
+	// 컬럼이 바뀐 경우에만 알림 (같은 컬럼 안에서의 순서 변경은 알리지 않음)
+	if len(columnChanges) > 0 {
+		s.sendBoardUpdateNotifications(ctx, board, actorID, columnChanges)
+	}
+
+	log.Info("Board moved",
+		zap.String("board.id", boardID.String()),
+		zap.String("board.from", oldValue),
+		zap.String("board.to", newValue),
+		zap.String("board.position", board.Position))
+
+	return &dto.MoveBoardResponse{
+		BoardID:       boardID.String(),
+		OldFieldValue: oldValue,
+		NewFieldValue: newValue,
+		Position:      board.Position,
+		Version:       board.Version,
+		Message:       "Board moved successfully",
+	}, nil
+}
+
+// moveNeighborPositions returns the positions the moved board must fall between.
+// 이웃이 없으면 프로젝트의 마지막 위치 뒤(컬럼 맨 아래)에 배치합니다.
+func (s *boardServiceImpl) moveNeighborPositions(ctx context.Context, projectID uuid.UUID, req *dto.MoveBoardRequest, last string) (string, string, error) {
+	if req.PrevBoardID == nil && req.NextBoardID == nil {
+		return last, "", nil
+	}
+
+	var ids []uuid.UUID
+	for _, id := range []*uuid.UUID{req.PrevBoardID, req.NextBoardID} {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	neighbors, err := s.boardRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return "", "", response.NewInternalError("Failed to fetch neighbor boards", err.Error())
+	}
+	positions := make(map[uuid.UUID]string, len(neighbors))
+	for _, n := range neighbors {
+		if n.ProjectID == projectID {
+			positions[n.ID] = n.Position
+		}
+	}
+
+	var lower, upper string
+	if req.PrevBoardID != nil {
+		p, ok := positions[*req.PrevBoardID]
+		if !ok {
+			return "", "", response.NewValidationError("prevBoardId does not belong to the project", "")
+		}
+		lower = p
+	}
+	if req.NextBoardID != nil {
+		p, ok := positions[*req.NextBoardID]
+		if !ok {
+			return "", "", response.NewValidationError("nextBoardId does not belong to the project", "")
+		}
+		upper = p
+	}
+	return lower, upper, nil
+}
+
+// GetColumnOrder returns the board IDs of one kanban column in position order
+// (Redis 캐시를 거치며, 캐시가 없으면 DB에서 읽어 다시 채움)
+func (s *boardServiceImpl) GetColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, value string) (*dto.BoardColumnOrderResponse, error) {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+
+	converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{fieldName: value})
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+	}
+	optionID, _ := converted[fieldName].(string)
+
+	ids, err := s.boardRepo.FindColumnOrder(ctx, projectID, fieldName, optionID)
+	if err != nil {
+		s.log(ctx).Error("GetColumnOrder failed to fetch column order", zap.String("project.id", projectID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to fetch column order", err.Error())
+	}
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	return &dto.BoardColumnOrderResponse{GroupByFieldName: fieldName, FieldValue: value, BoardIDs: ids}, nil
+}
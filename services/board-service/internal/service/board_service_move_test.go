@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_MoveBoard(t *testing.T) {
+	projectID := uuid.New()
+	boardID, prevID, nextID, otherProjectID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	todo, _ := json.Marshal(map[string]interface{}{"stage": "stage:todo"})
+	inProgress, todoValue := "in_progress", "todo"
+
+	tests := []struct {
+		name         string
+		req          dto.MoveBoardRequest
+		wantErrCode  string
+		wantPosition func(t *testing.T, pos string)
+	}{
+		{
+			name: "이웃 카드 사이로 이동",
+			req:  dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &inProgress, PrevBoardID: &prevID, NextBoardID: &nextID},
+			wantPosition: func(t *testing.T, pos string) {
+				if pos <= "c" || pos >= "e" {
+					t.Errorf("position = %q, want between prev and next", pos)
+				}
+			},
+		},
+		{
+			name: "이웃 없이 이동하면 맨 아래",
+			req:  dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &inProgress},
+			wantPosition: func(t *testing.T, pos string) {
+				if pos <= "x" {
+					t.Errorf("position = %q, want after the project's last position", pos)
+				}
+			},
+		},
+		{
+			name: "같은 컬럼 안에서 순서만 변경",
+			req:  dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &todoValue, NextBoardID: &prevID},
+			wantPosition: func(t *testing.T, pos string) {
+				if pos >= "c" {
+					t.Errorf("position = %q, want before next", pos)
+				}
+			},
+		},
+		{
+			name:        "이웃 순서가 뒤바뀜",
+			req:         dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &inProgress, PrevBoardID: &nextID, NextBoardID: &prevID},
+			wantErrCode: response.ErrCodeConflict,
+		},
+		{
+			name:        "다른 프로젝트의 이웃",
+			req:         dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &inProgress, PrevBoardID: &otherProjectID},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "자기 자신을 이웃으로 지정",
+			req:         dto.MoveBoardRequest{GroupByFieldName: "stage", NewFieldValue: &inProgress, PrevBoardID: &boardID},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "newFieldValue 누락",
+			req:         dto.MoveBoardRequest{GroupByFieldName: "stage"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.Board
+			boardRepo := &MockBoardRepository{
+				MaxPositionFunc: func(ctx context.Context, pid uuid.UUID) (string, error) {
+					return "x", nil
+				},
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					if id != boardID {
+						return nil, gorm.ErrRecordNotFound
+					}
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, CustomFields: todo, Position: "m"}, nil
+				},
+				FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+					return []*domain.Board{
+						{BaseModel: domain.BaseModel{ID: prevID}, ProjectID: projectID, Position: "c"},
+						{BaseModel: domain.BaseModel{ID: nextID}, ProjectID: projectID, Position: "e"},
+						{BaseModel: domain.BaseModel{ID: otherProjectID}, ProjectID: uuid.New(), Position: "d"},
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					saved = board
+					return nil
+				},
+			}
+			s := newBulkTestService(boardRepo, projectID)
+
+			req := tt.req
+			req.ProjectID = projectID.String()
+			result, err := s.MoveBoard(context.Background(), boardID, &req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if saved != nil {
+					t.Error("board should not be saved when the move is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MoveBoard() error = %v", err)
+			}
+			if saved == nil {
+				t.Fatal("expected the board to be saved")
+			}
+			if saved.Position != result.Position {
+				t.Errorf("saved position %q differs from response %q", saved.Position, result.Position)
+			}
+			tt.wantPosition(t, result.Position)
+
+			var fields map[string]interface{}
+			_ = json.Unmarshal(saved.CustomFields, &fields)
+			if fields["stage"] != "stage:"+*req.NewFieldValue {
+				t.Errorf("stage = %v, want stage:%s", fields["stage"], *req.NewFieldValue)
+			}
+		})
+	}
+}
+
+func TestBoardService_GetColumnOrder(t *testing.T) {
+	projectID := uuid.New()
+	order := []uuid.UUID{uuid.New(), uuid.New()}
+
+	boardRepo := &MockBoardRepository{
+		FindColumnOrderFunc: func(ctx context.Context, pid uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error) {
+			if pid != projectID || fieldName != "stage" || optionID != "stage:in_progress" {
+				t.Errorf("unexpected column %s %s=%s", pid, fieldName, optionID)
+			}
+			return order, nil
+		},
+	}
+	s := newBulkTestService(boardRepo, projectID)
+
+	result, err := s.GetColumnOrder(context.Background(), projectID, "stage", "in_progress")
+	if err != nil {
+		t.Fatalf("GetColumnOrder() error = %v", err)
+	}
+	if len(result.BoardIDs) != 2 || result.BoardIDs[0] != order[0] || result.BoardIDs[1] != order[1] {
+		t.Errorf("BoardIDs = %v, want %v", result.BoardIDs, order)
+	}
+}
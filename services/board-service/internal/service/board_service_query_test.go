@@ -12,6 +12,7 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
 
@@ -125,7 +126,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					customFields1JSON, _ := json.Marshal(map[string]interface{}{"stage": "in_progress"})
 					customFields2JSON, _ := json.Marshal(map[string]interface{}{"stage": "approved"})
 					return []*domain.Board{
@@ -158,7 +159,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					// Simulate filtering
 					if customFields, ok := filters.(map[string]interface{}); ok {
 						if stage, ok := customFields["stage"]; ok && stage == "in_progress" {
@@ -192,7 +193,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					// Simulate AND filtering
 					if customFields, ok := filters.(map[string]interface{}); ok {
 						stage, hasStage := customFields["stage"]
@@ -230,7 +231,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					return []*domain.Board{}, nil
 				}
 			},
@@ -284,11 +285,11 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 					t.Errorf("GetBoardsByProject() unexpected error = %v", err)
 					return
 				}
-				if len(got) != tt.wantCount {
-					t.Errorf("GetBoardsByProject() count = %v, want %v", len(got), tt.wantCount)
+				if len(got.Boards) != tt.wantCount {
+					t.Errorf("GetBoardsByProject() count = %v, want %v", len(got.Boards), tt.wantCount)
 				}
 				// Verify CustomFields are in response
-				for _, board := range got {
+				for _, board := range got.Boards {
 					if board.CustomFields == nil && tt.filters != nil && tt.filters.CustomFields != nil {
 						t.Error("Board.CustomFields = nil in response")
 					}
@@ -298,13 +299,85 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 	}
 }
 
+// TestBoardService_GetBoardsByProject_Pagination은 limit+1 조회, nextCursor 생성, 커서 전달을 테스트합니다.
+func TestBoardService_GetBoardsByProject_Pagination(t *testing.T) {
+	projectID := uuid.New()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	boards := make([]*domain.Board, 5)
+	for i := range boards {
+		boards[i] = &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: base.Add(time.Duration(i) * time.Minute)}}
+	}
+
+	mockBoardRepo := &MockBoardRepository{
+		FindPageByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
+			start := 0
+			if cursor != nil {
+				for i, b := range boards {
+					if b.ID == cursor.ID && b.CreatedAt.Equal(cursor.CreatedAt) {
+						start = i + 1
+					}
+				}
+			}
+			end := start + limit
+			if end > len(boards) {
+				end = len(boards)
+			}
+			return boards[start:end], nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	first, err := service.GetBoardsByProject(context.Background(), projectID, &dto.BoardFilters{Limit: 3})
+	if err != nil {
+		t.Fatalf("GetBoardsByProject() first page error = %v", err)
+	}
+	if len(first.Boards) != 3 || !first.HasMore || first.NextCursor == "" || first.Limit != 3 {
+		t.Fatalf("first page = %d boards, hasMore=%v, nextCursor=%q, limit=%d", len(first.Boards), first.HasMore, first.NextCursor, first.Limit)
+	}
+
+	second, err := service.GetBoardsByProject(context.Background(), projectID, &dto.BoardFilters{Limit: 3, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("GetBoardsByProject() second page error = %v", err)
+	}
+	if len(second.Boards) != 2 || second.HasMore || second.NextCursor != "" {
+		t.Fatalf("second page = %d boards, hasMore=%v, nextCursor=%q", len(second.Boards), second.HasMore, second.NextCursor)
+	}
+	if second.Boards[0].ID != boards[3].ID {
+		t.Errorf("second page should start after the cursor, got %s want %s", second.Boards[0].ID, boards[3].ID)
+	}
+
+	// 잘못된 커서는 검증 에러
+	_, err = service.GetBoardsByProject(context.Background(), projectID, &dto.BoardFilters{Cursor: "not-a-cursor"})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("expected validation error for invalid cursor, got %v", err)
+	}
+}
+
+func TestBoardCursor_RoundTrip(t *testing.T) {
+	cursor := &repository.BoardCursor{CreatedAt: time.Date(2026, 1, 1, 12, 0, 0, 123456789, time.UTC), ID: uuid.New()}
+	got, err := decodeBoardCursor(encodeBoardCursor(cursor))
+	if err != nil {
+		t.Fatalf("decodeBoardCursor() error = %v", err)
+	}
+	if !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID {
+		t.Errorf("decodeBoardCursor() = %+v, want %+v", got, cursor)
+	}
+}
+
 func TestBoardService_DeleteBoard(t *testing.T) {
 	boardID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name        string
 		boardID     uuid.UUID
 		mockBoard   func(*MockBoardRepository)
+		permissions string // 프로젝트 권한 매트릭스 (빈 값이면 기본값)
 		wantErr     bool
 		wantErrCode string
 	}{
@@ -334,13 +407,39 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 			wantErr:     true,
 			wantErrCode: response.ErrCodeNotFound,
 		},
+		{
+			name:    "실패: 프로젝트가 MEMBER의 Board 삭제를 허용하지 않음",
+			boardID: boardID,
+			mockBoard: func(m *MockBoardRepository) {
+				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+				}
+				m.DeleteFunc = func(ctx context.Context, id uuid.UUID) error {
+					t.Error("board must not be deleted")
+					return nil
+				}
+			},
+			permissions: `{"can_delete_board":["ADMIN"]}`,
+			wantErr:     true,
+			wantErrCode: response.ErrCodeForbidden,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
 			mockBoardRepo := &MockBoardRepository{}
-			mockProjectRepo := &MockProjectRepository{}
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, uid uuid.UUID) (*domain.ProjectMember, error) {
+					return &domain.ProjectMember{ProjectID: projectID, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+				},
+				FindPermissionMatrixFunc: func(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+					if tt.permissions == "" {
+						return nil, nil
+					}
+					return &domain.ProjectPermissionMatrix{ProjectID: projectID, Permissions: []byte(tt.permissions)}, nil
+				},
+			}
 			mockFieldOptionRepo := &MockFieldOptionRepository{}
 			mockConverter := &MockFieldOptionConverter{}
 			tt.mockBoard(mockBoardRepo)
@@ -350,7 +449,8 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
 
 			// When
-			err := service.DeleteBoard(context.Background(), tt.boardID)
+			ctx := context.WithValue(context.Background(), "user_id", userID)
+			err := service.DeleteBoard(ctx, tt.boardID)
 
 			// Then
 			if tt.wantErr {
@@ -395,7 +495,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					return []*domain.Board{
 						{
 							BaseModel: domain.BaseModel{ID: uuid.New()},
@@ -422,7 +522,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					return []*domain.Board{
 						{
 							BaseModel:    domain.BaseModel{ID: uuid.New()},
@@ -445,7 +545,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 				}
 			},
 			mockBoard: func(m *MockBoardRepository) {
-				m.FindByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				m.FindPageByProjectIDFunc = func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
 					return []*domain.Board{
 						{
 							BaseModel: domain.BaseModel{ID: uuid.New()},
@@ -509,7 +609,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 				}
 
 				// Verify participant IDs are included in response
-				for _, board := range got {
+				for _, board := range got.Boards {
 					expectedParticipants, ok := tt.wantParticipants[board.Title]
 					if !ok {
 						t.Errorf("Unexpected board title: %s", board.Title)
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+const (
+	// minRecurrenceInterval is the shortest allowed gap between two occurrences of a recurring board
+	minRecurrenceInterval = time.Hour
+	// recurrenceBatchSize is the maximum number of recurring boards created per run
+	recurrenceBatchSize = 100
+)
+
+// parseRecurrenceRule parses a cron expression and rejects schedules firing more often than minRecurrenceInterval
+func parseRecurrenceRule(rule string, now time.Time) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(rule)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid recurrence rule", err.Error())
+	}
+	first := schedule.Next(now)
+	if first.IsZero() || schedule.Next(first).Sub(first) < minRecurrenceInterval {
+		return nil, response.NewValidationError("Recurrence rule must not run more often than hourly", "")
+	}
+	return schedule, nil
+}
+
+// SetBoardRecurrence makes a board recurring: a copy of it is created each time rule fires
+func (s *boardServiceImpl) SetBoardRecurrence(ctx context.Context, boardID uuid.UUID, req *dto.SetBoardRecurrenceRequest) (*dto.BoardRecurrenceResponse, error) {
+	now := time.Now()
+	schedule, err := parseRecurrenceRule(req.Rule, now)
+	if err != nil {
+		return nil, err
+	}
+	next := schedule.Next(now)
+	return s.updateBoardRecurrence(ctx, boardID, req.Rule, &next)
+}
+
+// DeleteBoardRecurrence stops creating copies of a recurring board
+func (s *boardServiceImpl) DeleteBoardRecurrence(ctx context.Context, boardID uuid.UUID) error {
+	_, err := s.updateBoardRecurrence(ctx, boardID, "", nil)
+	return err
+}
+
+// updateBoardRecurrence saves the recurrence of a board with its activity record and board.updated event
+func (s *boardServiceImpl) updateBoardRecurrence(ctx context.Context, boardID uuid.UUID, rule string, next *time.Time) (*dto.BoardRecurrenceResponse, error) {
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+
+	// 규칙이 같으면 다음 실행 시각만 다시 계산하고 활동/이벤트는 남기지 않음
+	var changes []BoardChange
+	if board.RecurrenceRule != rule {
+		changes = append(changes, BoardChange{Field: "recurrenceRule", OldValue: board.RecurrenceRule, NewValue: rule})
+	}
+	board.RecurrenceRule = rule
+	board.NextRecurrenceAt = next
+
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		if err := s.boardRepo.Update(ctx, board); err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := s.recordActivities(ctx, board, actorID, domain.ActivityActionUpdated, changes); err != nil {
+			return err
+		}
+		return s.emitBoardEvent(ctx, messaging.EventBoardUpdated, board, actorID, []string{"recurrenceRule"})
+	}); err != nil {
+		return nil, response.NewInternalError("Failed to update board recurrence", err.Error())
+	}
+
+	s.log(ctx).Info("Board recurrence updated",
+		zap.String("board.id", boardID.String()),
+		zap.String("board.recurrence_rule", rule))
+
+	return &dto.BoardRecurrenceResponse{BoardID: board.ID, Rule: board.RecurrenceRule, NextRunAt: board.NextRecurrenceAt}, nil
+}
+
+// CreateDueRecurringBoards creates a copy of every recurring board whose next occurrence is at or before now
+// and returns the number of boards created.
+// 다음 실행 시각을 먼저 선점(ClaimRecurrence)하므로 여러 인스턴스에서 동시에 실행되어도 한 번만 생성되며,
+// 서비스가 중단되어 놓친 실행은 몰아서 생성하지 않고 건너뜁니다.
+func (s *boardServiceImpl) CreateDueRecurringBoards(ctx context.Context, now time.Time) (int, error) {
+	log := s.log(ctx)
+
+	boards, err := s.boardRepo.FindDueRecurrences(ctx, now, recurrenceBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, board := range boards {
+		ok, err := s.createRecurrence(ctx, board, now)
+		if err != nil {
+			log.Error("Failed to create recurring board",
+				zap.String("board.id", board.ID.String()),
+				zap.String("board.recurrence_rule", board.RecurrenceRule),
+				zap.Error(err))
+			continue
+		}
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// createRecurrence claims the due occurrence of a recurring board and creates its copy.
+// 선점에 실패하면(다른 인스턴스가 먼저 처리) 아무것도 생성하지 않습니다.
+func (s *boardServiceImpl) createRecurrence(ctx context.Context, board *domain.Board, now time.Time) (bool, error) {
+	due := *board.NextRecurrenceAt
+	schedule, err := cron.ParseStandard(board.RecurrenceRule)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := s.boardRepo.ClaimRecurrence(ctx, board.ID, due, schedule.Next(now))
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	customFields := map[string]interface{}{}
+	if len(board.CustomFields) > 0 {
+		_ = json.Unmarshal(board.CustomFields, &customFields)
+	}
+	values, err := s.fieldOptionConverter.ConvertIDsToValues(ctx, customFields)
+	if err != nil {
+		return false, err
+	}
+	participants := make([]uuid.UUID, 0, len(board.Participants))
+	for _, p := range board.Participants {
+		participants = append(participants, p.UserID)
+	}
+
+	// 반복 보드는 원본 작성자가 만든 것으로 기록
+	ctx = context.WithValue(ctx, "user_id", board.AuthorID)
+	if _, err := s.CreateBoard(ctx, &dto.CreateBoardRequest{
+		ProjectID:    board.ProjectID,
+		Title:        board.Title + " (" + due.Format("2006-01-02") + ")",
+		Content:      board.Content,
+		CustomFields: values,
+		AssigneeID:   board.AssigneeID,
+		Participants: participants,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
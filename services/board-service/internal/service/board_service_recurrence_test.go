@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_SetBoardRecurrence(t *testing.T) {
+	projectID, boardID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		rule        string
+		wantErrCode string
+	}{
+		{name: "매시간 반복", rule: "@every 1h"},
+		{name: "1시간보다 짧은 간격", rule: "@every 5m", wantErrCode: response.ErrCodeValidation},
+		{name: "잘못된 cron 표현식", rule: "not a cron", wantErrCode: response.ErrCodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.Board
+			boardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					if id != boardID {
+						return nil, gorm.ErrRecordNotFound
+					}
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, Title: "Weekly standup"}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					saved = board
+					return nil
+				},
+			}
+			s := newBulkTestService(boardRepo, projectID)
+
+			result, err := s.SetBoardRecurrence(context.Background(), boardID, &dto.SetBoardRecurrenceRequest{Rule: tt.rule})
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if saved != nil {
+					t.Error("board should not be saved when the rule is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetBoardRecurrence() error = %v", err)
+			}
+			if saved == nil || saved.RecurrenceRule != tt.rule || saved.NextRecurrenceAt == nil {
+				t.Fatalf("saved board = %+v, want rule %q with next occurrence", saved, tt.rule)
+			}
+			if result.NextRunAt == nil || !result.NextRunAt.After(time.Now()) {
+				t.Errorf("NextRunAt = %v, want a future time", result.NextRunAt)
+			}
+		})
+	}
+}
+
+func TestBoardService_DeleteBoardRecurrence(t *testing.T) {
+	projectID, boardID := uuid.New(), uuid.New()
+	next := time.Now().Add(time.Hour)
+
+	var saved *domain.Board
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, RecurrenceRule: "@every 1h", NextRecurrenceAt: &next}, nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			saved = board
+			return nil
+		},
+	}
+	s := newBulkTestService(boardRepo, projectID)
+
+	if err := s.DeleteBoardRecurrence(context.Background(), boardID); err != nil {
+		t.Fatalf("DeleteBoardRecurrence() error = %v", err)
+	}
+	if saved == nil || saved.RecurrenceRule != "" || saved.NextRecurrenceAt != nil {
+		t.Errorf("saved board = %+v, want recurrence cleared", saved)
+	}
+}
+
+func TestBoardService_CreateDueRecurringBoards(t *testing.T) {
+	projectID, authorID, participantID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		claimed     bool
+		wantCreated int
+	}{
+		{name: "선점 성공 시 보드 생성", claimed: true, wantCreated: 1},
+		{name: "다른 인스턴스가 먼저 선점", claimed: false, wantCreated: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &domain.Board{
+				BaseModel:        domain.BaseModel{ID: uuid.New()},
+				ProjectID:        projectID,
+				AuthorID:         authorID,
+				Title:            "Weekly standup",
+				Content:          "agenda",
+				RecurrenceRule:   "@every 1h",
+				NextRecurrenceAt: &due,
+				Participants:     []domain.Participant{{BoardID: uuid.New(), UserID: participantID}},
+			}
+
+			var claimedNext time.Time
+			var created []*domain.Board
+			boardRepo := &MockBoardRepository{
+				FindDueRecurrencesFunc: func(ctx context.Context, at time.Time, limit int) ([]*domain.Board, error) {
+					return []*domain.Board{source}, nil
+				},
+				ClaimRecurrenceFunc: func(ctx context.Context, boardID uuid.UUID, d, next time.Time) (bool, error) {
+					if boardID != source.ID || !d.Equal(due) {
+						t.Errorf("unexpected claim of %s at %v", boardID, d)
+					}
+					claimedNext = next
+					return tt.claimed, nil
+				},
+				CreateFunc: func(ctx context.Context, board *domain.Board) error {
+					created = append(created, board)
+					return nil
+				},
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return created[len(created)-1], nil
+				},
+			}
+			s := newBulkTestService(boardRepo, projectID)
+
+			count, err := s.CreateDueRecurringBoards(context.Background(), now)
+			if err != nil {
+				t.Fatalf("CreateDueRecurringBoards() error = %v", err)
+			}
+			if count != tt.wantCreated || len(created) != tt.wantCreated {
+				t.Fatalf("created %d boards (count %d), want %d", len(created), count, tt.wantCreated)
+			}
+			if !claimedNext.Equal(now.Add(time.Hour)) {
+				t.Errorf("next occurrence = %v, want %v (missed runs are skipped)", claimedNext, now.Add(time.Hour))
+			}
+			if tt.wantCreated == 0 {
+				return
+			}
+
+			board := created[0]
+			if board.Title != "Weekly standup (2024-01-22)" || board.Content != "agenda" {
+				t.Errorf("created board = %q/%q, want a dated copy of the source", board.Title, board.Content)
+			}
+			if board.AuthorID != authorID {
+				t.Errorf("AuthorID = %s, want the source author %s", board.AuthorID, authorID)
+			}
+			if board.RecurrenceRule != "" {
+				t.Error("the copy must not recur itself")
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+)
+
+// dueReminderBatchSize is the maximum number of boards reminded per window and run
+const dueReminderBatchSize = 100
+
+// SendDueReminders sends BOARD_DUE_SOON notifications to the assignee and participants of boards whose due
+// date comes within one of windows, and returns the number of boards reminded.
+// 윈도우마다 보드당 한 번만 보내며, 가장 작은 윈도우부터 구간을 나눠 (이전 윈도우, 윈도우] 안의 보드만 알립니다
+// (예: 24h, 1h이면 마감 30분 전에 만든 보드는 1h 알림만 받음).
+func (s *boardServiceImpl) SendDueReminders(ctx context.Context, now time.Time, windows []time.Duration) (int, error) {
+	if s.notiClient == nil || len(windows) == 0 {
+		return 0, nil
+	}
+	log := s.log(ctx)
+
+	sorted := slices.Clone(windows)
+	slices.Sort(sorted)
+
+	reminded := 0
+	var lower time.Duration
+	for _, window := range sorted {
+		windowMinutes := int(window / time.Minute)
+		boards, err := s.boardRepo.FindDueSoon(ctx, now.Add(lower), now.Add(window), windowMinutes, dueReminderBatchSize)
+		if err != nil {
+			return reminded, err
+		}
+		lower = window
+
+		for _, board := range boards {
+			ok, err := s.sendDueReminder(ctx, board, windowMinutes, now)
+			if err != nil {
+				log.Error("Failed to send due reminder",
+					zap.String("board.id", board.ID.String()),
+					zap.Int("reminder.window_minutes", windowMinutes),
+					zap.Error(err))
+				continue
+			}
+			if ok {
+				reminded++
+			}
+		}
+	}
+	return reminded, nil
+}
+
+// sendDueReminder claims a board's reminder for the window and notifies its assignee and participants.
+// 선점과 전송을 한 트랜잭션에서 처리하므로 전송이 실패하면 다음 실행에서 다시 시도합니다.
+func (s *boardServiceImpl) sendDueReminder(ctx context.Context, board *domain.Board, windowMinutes int, now time.Time) (bool, error) {
+	recipients := dueReminderRecipients(board)
+	if len(recipients) == 0 {
+		return false, nil
+	}
+
+	dueDate := board.DueDate.Format(time.RFC3339)
+	events := make([]*client.NotificationEvent, 0, len(recipients))
+	for _, userID := range recipients {
+		event := client.NewBoardDueSoonNotification(board.AuthorID, userID, board.Project.WorkspaceID, board.ID, board.Title, dueDate)
+		event.Metadata["projectId"] = board.ProjectID.String()
+		event.Metadata["projectName"] = board.Project.Name
+		event.Metadata["windowMinutes"] = windowMinutes
+		events = append(events, event)
+	}
+
+	claimed := false
+	err := s.inTx(ctx, func(ctx context.Context) error {
+		ok, err := s.boardRepo.ClaimDueReminder(ctx, &domain.BoardDueReminder{
+			BoardID:       board.ID,
+			WindowMinutes: windowMinutes,
+			DueDate:       *board.DueDate,
+			SentAt:        now,
+		})
+		if err != nil || !ok {
+			return err
+		}
+		claimed = true
+		return s.notiClient.SendBulkNotifications(ctx, events)
+	})
+	if err != nil {
+		return false, err
+	}
+	return claimed, nil
+}
+
+// dueReminderRecipients returns the assignee and participants of a board without duplicates
+func dueReminderRecipients(board *domain.Board) []uuid.UUID {
+	recipients := make([]uuid.UUID, 0, len(board.Participants)+1)
+	if board.AssigneeID != nil {
+		recipients = append(recipients, *board.AssigneeID)
+	}
+	for _, p := range board.Participants {
+		if !slices.Contains(recipients, p.UserID) {
+			recipients = append(recipients, p.UserID)
+		}
+	}
+	return recipients
+}
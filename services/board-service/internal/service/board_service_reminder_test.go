@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+)
+
+// TestBoardService_SendDueReminders는 윈도우 구간별로 보드를 찾고, 담당자와 참여자에게 한 번씩 알리며,
+// 같은 윈도우의 알림은 다시 보내지 않는지 테스트합니다.
+func TestBoardService_SendDueReminders(t *testing.T) {
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	assigneeID, participantID := uuid.New(), uuid.New()
+	dueSoon := now.Add(30 * time.Minute)
+	dueTomorrow := now.Add(20 * time.Hour)
+	boards := []*domain.Board{
+		{
+			BaseModel:    domain.BaseModel{ID: uuid.New()},
+			Title:        "Release",
+			DueDate:      &dueSoon,
+			AssigneeID:   &assigneeID,
+			Participants: []domain.Participant{{UserID: participantID}, {UserID: assigneeID}},
+		},
+		{
+			BaseModel:  domain.BaseModel{ID: uuid.New()},
+			Title:      "Retro",
+			DueDate:    &dueTomorrow,
+			AssigneeID: &assigneeID,
+		},
+	}
+
+	claimed := map[string]bool{}
+	var queried []string
+	mockBoardRepo := &MockBoardRepository{
+		FindDueSoonFunc: func(ctx context.Context, from, to time.Time, windowMinutes, limit int) ([]*domain.Board, error) {
+			queried = append(queried, fmt.Sprintf("%s-%s", from.Sub(now), to.Sub(now)))
+			var due []*domain.Board
+			for _, b := range boards {
+				if b.DueDate.After(from) && !b.DueDate.After(to) && !claimed[fmt.Sprintf("%s/%d", b.ID, windowMinutes)] {
+					due = append(due, b)
+				}
+			}
+			return due, nil
+		},
+		ClaimDueReminderFunc: func(ctx context.Context, reminder *domain.BoardDueReminder) (bool, error) {
+			key := fmt.Sprintf("%s/%d", reminder.BoardID, reminder.WindowMinutes)
+			if claimed[key] {
+				return false, nil
+			}
+			claimed[key] = true
+			return true, nil
+		},
+	}
+	noti := &recordingNotiClient{targets: make(chan uuid.UUID, 10)}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, noti, nil, zap.NewNop())
+
+	reminded, err := service.SendDueReminders(context.Background(), now, []time.Duration{24 * time.Hour, time.Hour})
+	if err != nil {
+		t.Fatalf("SendDueReminders() error = %v", err)
+	}
+	if reminded != 2 {
+		t.Errorf("SendDueReminders() reminded = %d, want 2", reminded)
+	}
+	// 작은 윈도우부터 (이전 윈도우, 윈도우] 구간으로 조회
+	if want := []string{"0s-1h0m0s", "1h0m0s-24h0m0s"}; !slices.Equal(queried, want) {
+		t.Errorf("queried windows = %v, want %v", queried, want)
+	}
+
+	close(noti.targets)
+	var targets []uuid.UUID
+	for id := range noti.targets {
+		targets = append(targets, id)
+	}
+	// Release: 담당자 + 참여자 (중복 제외), Retro: 담당자
+	if len(targets) != 3 || !slices.Contains(targets, participantID) {
+		t.Errorf("notified %v, want assignee twice and participant once", targets)
+	}
+
+	// 이미 보낸 윈도우는 다시 보내지 않음
+	noti.targets = make(chan uuid.UUID, 10)
+	reminded, err = service.SendDueReminders(context.Background(), now, []time.Duration{24 * time.Hour, time.Hour})
+	if err != nil || reminded != 0 || len(noti.targets) != 0 {
+		t.Errorf("second run reminded = %d, sent = %d, err = %v", reminded, len(noti.targets), err)
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// WithSubtasks includes the checklist completion of each board in board responses
+func WithSubtasks(repo repository.SubtaskRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.subtaskRepo = repo
+	}
+}
+
+// fillSubtaskProgress sets SubtaskProgress of the responses with one count query.
+// 진행률은 부가 정보이므로 조회에 실패해도 보드 응답은 그대로 반환합니다.
+func (s *boardServiceImpl) fillSubtaskProgress(ctx context.Context, boards ...*dto.BoardResponse) {
+	if s.subtaskRepo == nil || len(boards) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		ids[i] = board.ID
+	}
+	counts, err := s.subtaskRepo.CountByBoardIDs(ctx, ids)
+	if err != nil {
+		s.log(ctx).Warn("Failed to count subtasks", zap.Int("board.count", len(ids)), zap.Error(err))
+		return
+	}
+	for _, board := range boards {
+		count := counts[board.ID]
+		board.SubtaskProgress = dto.SubtaskProgress{Total: count.Total, Done: count.Done}
+		if count.Total > 0 {
+			board.SubtaskProgress.Percent = count.Done * 100 / count.Total
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WithBoardTemplates enables creating boards from the project's board templates
+func WithBoardTemplates(repo repository.BoardTemplateRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.templateRepo = repo
+	}
+}
+
+// CreateBoardFromTemplate creates a board in the template's project, filled in from the template.
+// 요청 값이 템플릿보다 우선하며, 생성 자체는 CreateBoard와 같은 경로(위치, 이벤트, 활동 기록)를 거칩니다.
+func (s *boardServiceImpl) CreateBoardFromTemplate(ctx context.Context, templateID uuid.UUID, req *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error) {
+	if s.templateRepo == nil {
+		return nil, response.NewNotFoundError("Board template not found", "")
+	}
+	template, err := s.templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board template not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board template", err.Error())
+	}
+
+	title := req.Title
+	if title == "" {
+		title = template.Title
+	}
+	if title == "" {
+		return nil, response.NewValidationError("title is required when the template has no title", "")
+	}
+
+	customFields := map[string]interface{}{}
+	if len(template.CustomFields) > 0 {
+		_ = json.Unmarshal(template.CustomFields, &customFields)
+	}
+	for key, value := range req.CustomFields {
+		customFields[key] = value
+	}
+
+	return s.CreateBoard(ctx, &dto.CreateBoardRequest{
+		ProjectID:     template.ProjectID,
+		Title:         title,
+		Content:       template.Content,
+		CustomFields:  customFields,
+		AssigneeID:    req.AssigneeID,
+		StartDate:     req.StartDate,
+		DueDate:       req.DueDate,
+		Participants:  append(templateParticipants(template), req.Participants...),
+		AttachmentIDs: req.AttachmentIDs,
+	})
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+const (
+	// DefaultTrashRetention is how long deleted boards stay in the trash when not configured
+	DefaultTrashRetention = 30 * 24 * time.Hour
+	// trashPurgeBatchSize is the number of expired boards permanently deleted per run
+	trashPurgeBatchSize = 100
+)
+
+// WithTrashRetention sets how long deleted boards can be restored before PurgeDeletedBoards removes them
+func WithTrashRetention(retention time.Duration) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		if retention > 0 {
+			s.trashRetention = retention
+		}
+	}
+}
+
+// GetBoardTrash returns the deleted boards of a project that can still be restored.
+// 삭제와 같은 권한(can_delete_board)이 필요합니다.
+func (s *boardServiceImpl) GetBoardTrash(ctx context.Context, projectID uuid.UUID) (*dto.BoardTrashResponse, error) {
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+	if _, err := authorize(ctx, s.projectRepo, projectID, actorID, domain.PermissionDeleteBoard); err != nil {
+		return nil, err
+	}
+
+	boards, err := s.boardRepo.FindDeleted(ctx, projectID)
+	if err != nil {
+		s.log(ctx).Error("GetBoardTrash failed to fetch boards", zap.String("project.id", projectID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to fetch deleted boards", err.Error())
+	}
+
+	// 영구 삭제 작업이 아직 돌지 않아 보관 기간이 지난 보드는 복원할 수 없으므로 제외
+	now := time.Now()
+	resp := &dto.BoardTrashResponse{
+		ProjectID:     projectID,
+		RetentionDays: int(s.trashRetention / (24 * time.Hour)),
+		Boards:        make([]dto.TrashedBoardResponse, 0, len(boards)),
+	}
+	for _, board := range boards {
+		purgeAt := board.DeletedAt.Time.Add(s.trashRetention)
+		if !purgeAt.After(now) {
+			continue
+		}
+		resp.Boards = append(resp.Boards, dto.TrashedBoardResponse{
+			ID:         board.ID,
+			ProjectID:  board.ProjectID,
+			AuthorID:   board.AuthorID,
+			AssigneeID: board.AssigneeID,
+			Title:      board.Title,
+			DeletedAt:  board.DeletedAt.Time,
+			PurgeAt:    purgeAt,
+		})
+	}
+	return resp, nil
+}
+
+// RestoreBoard takes a board out of the trash with its activity record and board.updated event.
+// 보관 기간이 지난 보드는 곧 영구 삭제되므로 찾을 수 없는 것으로 처리합니다.
+func (s *boardServiceImpl) RestoreBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardResponse, error) {
+	log := s.log(ctx)
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
+	board, err := s.boardRepo.FindDeletedByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found in trash", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+
+	if _, err := authorize(ctx, s.projectRepo, board.ProjectID, actorID, domain.PermissionDeleteBoard); err != nil {
+		return nil, err
+	}
+
+	if !board.DeletedAt.Time.Add(s.trashRetention).After(time.Now()) {
+		return nil, response.NewNotFoundError("Board not found in trash", "retention period expired")
+	}
+
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		restored, err := s.boardRepo.Restore(ctx, board)
+		if err != nil {
+			return err
+		}
+		if !restored {
+			return gorm.ErrRecordNotFound
+		}
+		if err := s.recordActivities(ctx, board, actorID, domain.ActivityActionRestored, nil); err != nil {
+			return err
+		}
+		return s.emitBoardEvent(ctx, messaging.EventBoardUpdated, board, actorID, []string{"deleted"})
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found in trash", "")
+		}
+		log.Error("Failed to restore board", zap.String("board.id", boardID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to restore board", err.Error())
+	}
+
+	log.Info("Board restored from trash", zap.String("board.id", boardID.String()))
+	return s.boardStateResponse(ctx, board)
+}
+
+// PurgeDeletedBoards permanently deletes the boards that have been in the trash longer than the retention
+// and returns the number of boards purged.
+// 보드를 먼저 지우고(댓글, 참여자는 CASCADE) 첨부파일을 정리하므로, 실패한 보드는 다음 실행에서 다시 시도합니다.
+func (s *boardServiceImpl) PurgeDeletedBoards(ctx context.Context, now time.Time) (int, error) {
+	log := s.log(ctx)
+
+	boards, err := s.boardRepo.FindDeletedBefore(ctx, now.Add(-s.trashRetention), trashPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, board := range boards {
+		attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error("Failed to fetch attachments of expired board", zap.String("board.id", board.ID.String()), zap.Error(err))
+			continue
+		}
+		deleted, err := s.boardRepo.Purge(ctx, board.ID)
+		if err != nil {
+			log.Error("Failed to purge board", zap.String("board.id", board.ID.String()), zap.Error(err))
+			continue
+		}
+		if !deleted {
+			continue
+		}
+		if len(attachments) > 0 {
+			s.deleteAttachmentsWithS3(ctx, attachments)
+		}
+		purged++
+	}
+	return purged, nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// trashedBoard returns a board deleted the given time ago
+func trashedBoard(projectID uuid.UUID, title string, ago time.Duration) *domain.Board {
+	return &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		Title:     title,
+		DeletedAt: gorm.DeletedAt{Time: time.Now().Add(-ago), Valid: true},
+	}
+}
+
+func memberProjectRepo() *MockProjectRepository {
+	return &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+	}
+}
+
+func TestBoardService_GetBoardTrash(t *testing.T) {
+	projectID := uuid.New()
+	recent := trashedBoard(projectID, "어제 삭제", 24*time.Hour)
+	expired := trashedBoard(projectID, "보관 기간 지남", 8*24*time.Hour)
+
+	mockBoardRepo := &MockBoardRepository{
+		FindDeletedFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.Board, error) {
+			return []*domain.Board{recent, expired}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, memberProjectRepo(), &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop(), WithTrashRetention(7*24*time.Hour))
+
+	got, err := service.GetBoardTrash(context.WithValue(context.Background(), "user_id", uuid.New()), projectID)
+	if err != nil {
+		t.Fatalf("GetBoardTrash() error = %v", err)
+	}
+	if got.RetentionDays != 7 {
+		t.Errorf("RetentionDays = %d, want 7", got.RetentionDays)
+	}
+	if len(got.Boards) != 1 || got.Boards[0].ID != recent.ID {
+		t.Fatalf("Boards = %+v, want only the recently deleted board", got.Boards)
+	}
+	if want := recent.DeletedAt.Time.Add(7 * 24 * time.Hour); !got.Boards[0].PurgeAt.Equal(want) {
+		t.Errorf("PurgeAt = %v, want %v", got.Boards[0].PurgeAt, want)
+	}
+}
+
+func TestBoardService_RestoreBoard(t *testing.T) {
+	projectID := uuid.New()
+	board := trashedBoard(projectID, "복원할 보드", time.Hour)
+	expired := trashedBoard(projectID, "보관 기간 지남", 31*24*time.Hour)
+	restored := 0
+
+	mockBoardRepo := &MockBoardRepository{
+		FindDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			switch id {
+			case board.ID:
+				return board, nil
+			case expired.ID:
+				return expired, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		RestoreFunc: func(ctx context.Context, b *domain.Board) (bool, error) {
+			restored++
+			b.DeletedAt = gorm.DeletedAt{}
+			return true, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, memberProjectRepo(), &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	got, err := service.RestoreBoard(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("RestoreBoard() error = %v", err)
+	}
+	if got.ID != board.ID || restored != 1 {
+		t.Errorf("RestoreBoard() = %s, restored %d times", got.ID, restored)
+	}
+
+	for name, id := range map[string]uuid.UUID{"보관 기간 지남": expired.ID, "휴지통에 없음": uuid.New()} {
+		_, err := service.RestoreBoard(ctx, id)
+		if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeNotFound {
+			t.Errorf("RestoreBoard(%s) error = %v, want not found", name, err)
+		}
+	}
+	if restored != 1 {
+		t.Errorf("board restored %d times, want 1", restored)
+	}
+}
+
+func TestBoardService_RestoreBoard_RequiresDeletePermission(t *testing.T) {
+	board := trashedBoard(uuid.New(), "복원할 보드", time.Hour)
+	mockBoardRepo := &MockBoardRepository{
+		FindDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+		RestoreFunc: func(ctx context.Context, b *domain.Board) (bool, error) {
+			t.Error("board must not be restored")
+			return true, nil
+		},
+	}
+	mockProjectRepo := memberProjectRepo()
+	mockProjectRepo.FindPermissionMatrixFunc = func(ctx context.Context, pid uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+		return &domain.ProjectPermissionMatrix{ProjectID: pid, Permissions: []byte(`{"can_delete_board":["ADMIN"]}`)}, nil
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	_, err := service.RestoreBoard(context.WithValue(context.Background(), "user_id", uuid.New()), board.ID)
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeForbidden {
+		t.Errorf("RestoreBoard() error = %v, want forbidden", err)
+	}
+}
+
+func TestBoardService_PurgeDeletedBoards(t *testing.T) {
+	now := time.Now()
+	purgeable := trashedBoard(uuid.New(), "영구 삭제", 31*24*time.Hour)
+	restoredMeanwhile := trashedBoard(uuid.New(), "그 사이 복원", 31*24*time.Hour)
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "https://bucket.s3.ap-northeast-2.amazonaws.com/board/boards/ws/2024/01/a.png"}
+
+	var cutoff time.Time
+	mockBoardRepo := &MockBoardRepository{
+		FindDeletedBeforeFunc: func(ctx context.Context, c time.Time, limit int) ([]*domain.Board, error) {
+			cutoff = c
+			return []*domain.Board{purgeable, restoredMeanwhile}, nil
+		},
+		PurgeFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return id == purgeable.ID, nil
+		},
+	}
+	var deletedAttachments []uuid.UUID
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			return []*domain.Attachment{attachment}, nil
+		},
+		DeleteBatchFunc: func(ctx context.Context, ids []uuid.UUID) error {
+			deletedAttachments = append(deletedAttachments, ids...)
+			return nil
+		},
+	}
+	var deletedKeys []string
+	mockS3 := &MockS3Client{
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			deletedKeys = append(deletedKeys, key)
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, mockAttachmentRepo, mockS3, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	purged, err := service.PurgeDeletedBoards(context.Background(), now)
+	if err != nil {
+		t.Fatalf("PurgeDeletedBoards() error = %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("purged = %d, want 1", purged)
+	}
+	if want := now.Add(-DefaultTrashRetention); !cutoff.Equal(want) {
+		t.Errorf("cutoff = %v, want %v", cutoff, want)
+	}
+	// 그 사이 복원된 보드의 첨부파일은 남겨 둠
+	if len(deletedAttachments) != 1 || len(deletedKeys) != 1 || deletedKeys[0] != "board/boards/ws/2024/01/a.png" {
+		t.Errorf("deleted attachments = %v, keys = %v, want only the purged board's", deletedAttachments, deletedKeys)
+	}
+}
@@ -4,20 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 )
 
+// BoardVersionConflictError is returned by UpdateBoard when the board was saved by someone else
+// after the client read it. Current는 서버에 저장된 최신 보드로 409 응답에 그대로 실립니다.
+type BoardVersionConflictError struct {
+	Current *dto.BoardDetailResponse
+}
+
+func (e *BoardVersionConflictError) Error() string {
+	return fmt.Sprintf("board %s was modified by another user (current version %d)", e.Current.ID, e.Current.Version)
+}
+
+// errBoardVersionChanged aborts the update transaction when the versioned write loses the race
+var errBoardVersionChanged = errors.New("board version changed")
+
+// UpdateBoard applies a partial update to a board the client last read at req.Version.
+// 버전이 다르면 아무것도 저장하지 않고 최신 상태를 담은 BoardVersionConflictError를 반환합니다.
 func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
 	// Extract user_id from context for notification actor
 	actorID, _ := ctx.Value("user_id").(uuid.UUID)
 
+	if req.Version == nil {
+		return nil, response.NewValidationError("version is required", "send the board version in the If-Match header or the version field")
+	}
+
 	// Fetch existing board
 	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
@@ -26,6 +47,9 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
 	}
+	if board.Version != *req.Version {
+		return nil, s.boardVersionConflict(ctx, boardID)
+	}
 
 	// Store original values for change detection
 	var originalAssigneeID *uuid.UUID
@@ -108,8 +132,56 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		board.DueDate = req.DueDate
 	}
 
-	// Update board first
-	if err := s.boardRepo.Update(ctx, board); err != nil {
+	// 🔔 Build list of changes for notification
+	changes := make([]BoardChange, 0)
+
+	if req.Title != nil && originalTitle != board.Title {
+		changes = append(changes, BoardChange{Field: "title", OldValue: originalTitle, NewValue: board.Title})
+	}
+	if req.Content != nil && originalContent != board.Content {
+		changes = append(changes, BoardChange{Field: "content", OldValue: "(내용 변경)", NewValue: "(내용 변경)"})
+	}
+	if req.StartDate != nil && !datesEqual(originalStartDate, board.StartDate) {
+		changes = append(changes, BoardChange{Field: "startDate", OldValue: formatDatePtr(originalStartDate), NewValue: formatDatePtr(board.StartDate)})
+	}
+	if req.DueDate != nil && !datesEqual(originalDueDate, board.DueDate) {
+		changes = append(changes, BoardChange{Field: "dueDate", OldValue: formatDatePtr(originalDueDate), NewValue: formatDatePtr(board.DueDate)})
+	}
+	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) {
+		changes = append(changes, BoardChange{Field: "assignee", OldValue: formatUUIDPtr(originalAssigneeID), NewValue: formatUUIDPtr(board.AssigneeID)})
+	}
+
+	// Check customFields changes (stage, role, importance, etc.)
+	if req.CustomFields != nil {
+		var newCustomFields map[string]interface{}
+		if len(board.CustomFields) > 0 {
+			_ = json.Unmarshal(board.CustomFields, &newCustomFields)
+		}
+		changes = append(changes, s.customFieldChanges(ctx, originalCustomFields, newCustomFields)...)
+	}
+
+	changedFields := make([]string, 0, len(changes))
+	for _, change := range changes {
+		changedFields = append(changedFields, change.Field)
+	}
+
+	// Update board first (board.updated 이벤트와 활동 기록도 같은 트랜잭션에 기록)
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		ok, err := s.boardRepo.UpdateVersioned(ctx, board, *req.Version)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errBoardVersionChanged
+		}
+		if err := s.recordActivities(ctx, board, actorID, domain.ActivityActionUpdated, changes); err != nil {
+			return err
+		}
+		return s.emitBoardEvent(ctx, messaging.EventBoardUpdated, board, actorID, changedFields)
+	}); err != nil {
+		if errors.Is(err, errBoardVersionChanged) {
+			return nil, s.boardVersionConflict(ctx, boardID)
+		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
 	}
 
@@ -202,51 +274,6 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		board.Attachments = toDomainAttachments(allAttachments)
 	}
 
-	// 🔔 Build list of changes for notification
-	changes := make([]BoardChange, 0)
-
-	if req.Title != nil && originalTitle != board.Title {
-		changes = append(changes, BoardChange{Field: "title", OldValue: originalTitle, NewValue: board.Title})
-	}
-	if req.Content != nil && originalContent != board.Content {
-		changes = append(changes, BoardChange{Field: "content", OldValue: "(내용 변경)", NewValue: "(내용 변경)"})
-	}
-	if req.StartDate != nil && !datesEqual(originalStartDate, board.StartDate) {
-		changes = append(changes, BoardChange{Field: "startDate", OldValue: formatDatePtr(originalStartDate), NewValue: formatDatePtr(board.StartDate)})
-	}
-	if req.DueDate != nil && !datesEqual(originalDueDate, board.DueDate) {
-		changes = append(changes, BoardChange{Field: "dueDate", OldValue: formatDatePtr(originalDueDate), NewValue: formatDatePtr(board.DueDate)})
-	}
-	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) {
-		changes = append(changes, BoardChange{Field: "assignee", OldValue: formatUUIDPtr(originalAssigneeID), NewValue: formatUUIDPtr(board.AssigneeID)})
-	}
-
-	// Check customFields changes (stage, role, importance, etc.)
-	if req.CustomFields != nil {
-		var newCustomFields map[string]interface{}
-		if len(board.CustomFields) > 0 {
-			_ = json.Unmarshal(board.CustomFields, &newCustomFields)
-		}
-
-		// Convert UUIDs to human-readable labels (Korean) for notification display
-		originalReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, originalCustomFields)
-		newReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, newCustomFields)
-
-		for key, newVal := range newCustomFields {
-			oldVal, existed := originalCustomFields[key]
-			if !existed || oldVal != newVal {
-				// Use readable values for display
-				oldReadableVal := formatInterface(originalReadable[key])
-				newReadableVal := formatInterface(newReadable[key])
-				changes = append(changes, BoardChange{
-					Field:    key,
-					OldValue: oldReadableVal,
-					NewValue: newReadableVal,
-				})
-			}
-		}
-	}
-
 	// Send notifications for board update
 
 	// 1. Notify new assignee if assignee changed
@@ -273,7 +300,18 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 	}
 
 	// Convert to response DTO
-	return s.toBoardResponseWithWorkspace(ctx, board), nil
+	resp := s.toBoardResponseWithWorkspace(ctx, board)
+	s.fillSubtaskProgress(ctx, resp)
+	return resp, nil
+}
+
+// boardVersionConflict loads the stored board for a BoardVersionConflictError
+func (s *boardServiceImpl) boardVersionConflict(ctx context.Context, boardID uuid.UUID) error {
+	current, err := s.GetBoard(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	return &BoardVersionConflictError{Current: current}
 }
 
 // DeleteBoard soft deletes a board and its associated attachments
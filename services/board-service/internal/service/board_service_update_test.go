@@ -35,7 +35,8 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 			name:    "성공: Board 업데이트",
 			boardID: boardID,
 			req: &dto.UpdateBoardRequest{
-				Title: &newTitle,
+				Version: new(int),
+				Title:   &newTitle,
 			},
 			mockBoard: func(m *MockBoardRepository) {
 				var updatedBoard *domain.Board
@@ -56,9 +57,9 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 						CustomFields: customFieldsJSON,
 					}, nil
 				}
-				m.UpdateFunc = func(ctx context.Context, board *domain.Board) error {
+				m.UpdateVersionedFunc = func(ctx context.Context, board *domain.Board, version int) (bool, error) {
 					updatedBoard = board
-					return nil
+					return true, nil
 				}
 			},
 			wantErr: false,
@@ -67,6 +68,7 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 			name:    "성공: CustomFields 업데이트",
 			boardID: boardID,
 			req: &dto.UpdateBoardRequest{
+				Version:      new(int),
 				CustomFields: &newCustomFields,
 			},
 			mockBoard: func(m *MockBoardRepository) {
@@ -88,9 +90,9 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 						CustomFields: customFieldsJSON,
 					}, nil
 				}
-				m.UpdateFunc = func(ctx context.Context, board *domain.Board) error {
+				m.UpdateVersionedFunc = func(ctx context.Context, board *domain.Board, version int) (bool, error) {
 					updatedBoard = board
-					return nil
+					return true, nil
 				}
 			},
 			wantErr: false,
@@ -99,7 +101,8 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 			name:    "실패: Board가 존재하지 않음",
 			boardID: boardID,
 			req: &dto.UpdateBoardRequest{
-				Title: &newTitle,
+				Version: new(int),
+				Title:   &newTitle,
 			},
 			mockBoard: func(m *MockBoardRepository) {
 				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
@@ -214,9 +217,9 @@ func TestBoardService_UpdateBoard_CustomFields(t *testing.T) {
 						CustomFields: customFieldsJSON,
 					}, nil
 				},
-				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+				UpdateVersionedFunc: func(ctx context.Context, board *domain.Board, version int) (bool, error) {
 					updatedBoard = board
-					return nil
+					return true, nil
 				},
 			}
 
@@ -228,6 +231,7 @@ func TestBoardService_UpdateBoard_CustomFields(t *testing.T) {
 			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
 
 			req := &dto.UpdateBoardRequest{
+				Version:      new(int),
 				CustomFields: &tt.updateFields,
 			}
 
@@ -302,6 +306,7 @@ func TestUpdateBoard_DateValidation(t *testing.T) {
 	ctx := context.Background()
 
 	req := &dto.UpdateBoardRequest{
+		Version: new(int),
 		DueDate: &newDueDate,
 	}
 
@@ -340,9 +345,6 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 				StartDate: &existingStartDate,
 			}, nil
 		},
-		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
-			return nil
-		},
 	}
 
 	mockProjectRepo := &MockProjectRepository{}
@@ -356,6 +358,7 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 	ctx := context.Background()
 
 	req := &dto.UpdateBoardRequest{
+		Version: new(int),
 		DueDate: &newDueDate,
 	}
 
@@ -370,3 +373,44 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 		t.Fatal("Expected result, got nil")
 	}
 }
+
+// TestUpdateBoard_VersionConflict tests that stale or racing updates are rejected with the stored board
+func TestUpdateBoard_VersionConflict(t *testing.T) {
+	boardID := uuid.New()
+	title := "Updated Title"
+	lostRace := false
+
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, Title: "Stored Title", Version: 3}, nil
+		},
+		UpdateVersionedFunc: func(ctx context.Context, board *domain.Board, version int) (bool, error) {
+			return !lostRace, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, nil, zap.NewNop())
+
+	// 버전 없이 보내면 검증 에러
+	_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("UpdateBoard() without version error = %v, want validation", err)
+	}
+
+	stale := 2
+	_, err = service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title, Version: &stale})
+	conflict, ok := err.(*BoardVersionConflictError)
+	if !ok {
+		t.Fatalf("UpdateBoard() with stale version error = %v, want BoardVersionConflictError", err)
+	}
+	if conflict.Current.Version != 3 || conflict.Current.Title != "Stored Title" {
+		t.Errorf("conflict current = version %d, title %q", conflict.Current.Version, conflict.Current.Title)
+	}
+
+	// 읽은 뒤 다른 사용자가 먼저 저장한 경우
+	lostRace = true
+	current := 3
+	_, err = service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title, Version: &current})
+	if _, ok := err.(*BoardVersionConflictError); !ok {
+		t.Errorf("UpdateBoard() after lost race error = %v, want BoardVersionConflictError", err)
+	}
+}
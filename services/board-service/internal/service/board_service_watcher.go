@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// WithWatchers also sends board update notifications to the board's watchers
+func WithWatchers(repo repository.WatcherRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.watcherRepo = repo
+	}
+}
+
+// addWatchers adds the board's watchers other than the actor to the notification recipients.
+// 구독자 조회에 실패해도 담당자/참여자 알림은 그대로 보냅니다.
+func (s *boardServiceImpl) addWatchers(ctx context.Context, board *domain.Board, actorID uuid.UUID, recipients map[uuid.UUID]bool) {
+	if s.watcherRepo == nil {
+		return
+	}
+	watcherIDs, err := s.watcherRepo.FindUserIDsByBoardID(ctx, board.ID)
+	if err != nil {
+		s.logger.Warn("Failed to fetch board watchers for update notification",
+			zap.String("board.id", board.ID.String()),
+			zap.Error(err))
+		return
+	}
+	for _, userID := range watcherIDs {
+		if userID != actorID {
+			recipients[userID] = true
+		}
+	}
+}
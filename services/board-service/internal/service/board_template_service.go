@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// BoardTemplateService defines the interface for board template business logic
+type BoardTemplateService interface {
+	CreateTemplate(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateBoardTemplateRequest) (*dto.BoardTemplateResponse, error)
+	GetTemplates(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, templateID, userID uuid.UUID, req *dto.UpdateBoardTemplateRequest) (*dto.BoardTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) error
+}
+
+// boardTemplateServiceImpl is the implementation of BoardTemplateService
+type boardTemplateServiceImpl struct {
+	templateRepo         repository.BoardTemplateRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionConverter FieldOptionConverter
+}
+
+// NewBoardTemplateService creates a new instance of BoardTemplateService
+func NewBoardTemplateService(templateRepo repository.BoardTemplateRepository, projectRepo repository.ProjectRepository, fieldOptionConverter FieldOptionConverter) BoardTemplateService {
+	return &boardTemplateServiceImpl{
+		templateRepo:         templateRepo,
+		projectRepo:          projectRepo,
+		fieldOptionConverter: fieldOptionConverter,
+	}
+}
+
+// CreateTemplate creates a board template; only the project owner can manage templates
+func (s *boardTemplateServiceImpl) CreateTemplate(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateBoardTemplateRequest) (*dto.BoardTemplateResponse, error) {
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionManageTemplates); err != nil {
+		return nil, err
+	}
+
+	customFields, err := s.validateCustomFields(ctx, projectID, req.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &domain.BoardTemplate{
+		ProjectID:    projectID,
+		CreatedBy:    userID,
+		Name:         req.Name,
+		Title:        req.Title,
+		Content:      req.Content,
+		CustomFields: customFields,
+	}
+	if template.Participants, err = json.Marshal(removeDuplicateUUIDs(req.Participants)); err != nil {
+		return nil, response.NewInternalError("Failed to marshal participants", err.Error())
+	}
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, response.NewInternalError("Failed to create board template", err.Error())
+	}
+
+	return toBoardTemplateResponse(template), nil
+}
+
+// GetTemplates retrieves all board templates of a project
+func (s *boardTemplateServiceImpl) GetTemplates(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error) {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+
+	templates, err := s.templateRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch board templates", err.Error())
+	}
+
+	responses := make([]*dto.BoardTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = toBoardTemplateResponse(template)
+	}
+	return responses, nil
+}
+
+// UpdateTemplate updates the provided fields of a board template
+func (s *boardTemplateServiceImpl) UpdateTemplate(ctx context.Context, templateID, userID uuid.UUID, req *dto.UpdateBoardTemplateRequest) (*dto.BoardTemplateResponse, error) {
+	template, err := s.findTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorize(ctx, s.projectRepo, template.ProjectID, userID, domain.PermissionManageTemplates); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		template.Name = *req.Name
+	}
+	if req.Title != nil {
+		template.Title = *req.Title
+	}
+	if req.Content != nil {
+		template.Content = *req.Content
+	}
+	if req.CustomFields != nil {
+		if template.CustomFields, err = s.validateCustomFields(ctx, template.ProjectID, *req.CustomFields); err != nil {
+			return nil, err
+		}
+	}
+	if req.Participants != nil {
+		if template.Participants, err = json.Marshal(removeDuplicateUUIDs(*req.Participants)); err != nil {
+			return nil, response.NewInternalError("Failed to marshal participants", err.Error())
+		}
+	}
+
+	if err := s.templateRepo.Update(ctx, template); err != nil {
+		return nil, response.NewInternalError("Failed to update board template", err.Error())
+	}
+	return toBoardTemplateResponse(template), nil
+}
+
+// DeleteTemplate soft deletes a board template; boards created from it are not affected
+func (s *boardTemplateServiceImpl) DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) error {
+	template, err := s.findTemplate(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if _, err := authorize(ctx, s.projectRepo, template.ProjectID, userID, domain.PermissionManageTemplates); err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Delete(ctx, templateID); err != nil {
+		return response.NewInternalError("Failed to delete board template", err.Error())
+	}
+	return nil
+}
+
+func (s *boardTemplateServiceImpl) findTemplate(ctx context.Context, templateID uuid.UUID) (*domain.BoardTemplate, error) {
+	template, err := s.templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board template not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board template", err.Error())
+	}
+	return template, nil
+}
+
+// validateCustomFields checks that the values exist in the project's field options.
+// 템플릿에는 value를 그대로 저장하고, 보드를 만들 때 CreateBoard가 ID로 변환합니다.
+func (s *boardTemplateServiceImpl) validateCustomFields(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) ([]byte, error) {
+	if len(customFields) == 0 {
+		return nil, nil
+	}
+	if _, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, customFields); err != nil {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+	}
+	jsonBytes, err := json.Marshal(customFields)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to marshal custom fields", err.Error())
+	}
+	return jsonBytes, nil
+}
+
+// templateParticipants decodes the participant IDs of a template (never nil)
+func templateParticipants(template *domain.BoardTemplate) []uuid.UUID {
+	participants := []uuid.UUID{}
+	if len(template.Participants) > 0 {
+		_ = json.Unmarshal(template.Participants, &participants)
+	}
+	return participants
+}
+
+// toBoardTemplateResponse converts domain.BoardTemplate to dto.BoardTemplateResponse
+func toBoardTemplateResponse(template *domain.BoardTemplate) *dto.BoardTemplateResponse {
+	customFields := map[string]interface{}{}
+	if len(template.CustomFields) > 0 {
+		_ = json.Unmarshal(template.CustomFields, &customFields)
+	}
+	participants := templateParticipants(template)
+
+	return &dto.BoardTemplateResponse{
+		TemplateID:   template.ID,
+		ProjectID:    template.ProjectID,
+		Name:         template.Name,
+		Title:        template.Title,
+		Content:      template.Content,
+		CustomFields: customFields,
+		Participants: participants,
+		CreatedBy:    template.CreatedBy,
+		CreatedAt:    template.CreatedAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+}
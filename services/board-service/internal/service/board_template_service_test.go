@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardTemplateService_CreateTemplate(t *testing.T) {
+	projectID, ownerID, memberID := uuid.New(), uuid.New(), uuid.New()
+	participant := uuid.New()
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		req         dto.CreateBoardTemplateRequest
+		wantErrCode string
+	}{
+		{
+			name:   "OWNER가 템플릿 생성",
+			userID: ownerID,
+			req: dto.CreateBoardTemplateRequest{
+				Name: "버그", Title: "[BUG] ", CustomFields: map[string]interface{}{"importance": "high"},
+				Participants: []uuid.UUID{participant, participant},
+			},
+		},
+		{
+			name:        "MEMBER는 생성 불가",
+			userID:      memberID,
+			req:         dto.CreateBoardTemplateRequest{Name: "버그"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "존재하지 않는 field value",
+			userID:      ownerID,
+			req:         dto.CreateBoardTemplateRequest{Name: "버그", CustomFields: map[string]interface{}{"stage": "unknown"}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.BoardTemplate
+			templateRepo := &MockBoardTemplateRepository{
+				CreateFunc: func(ctx context.Context, template *domain.BoardTemplate) error {
+					created = template
+					return nil
+				},
+			}
+			projectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+					role := domain.ProjectRoleMember
+					if uid == ownerID {
+						role = domain.ProjectRoleOwner
+					}
+					return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: role}, nil
+				},
+			}
+			converter := &MockFieldOptionConverter{
+				ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+					if fields["stage"] == "unknown" {
+						return nil, errors.New("invalid stage value")
+					}
+					return fields, nil
+				},
+			}
+			s := NewBoardTemplateService(templateRepo, projectRepo, converter)
+
+			req := tt.req
+			result, err := s.CreateTemplate(context.Background(), projectID, tt.userID, &req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if created != nil {
+					t.Error("template should not be saved when creation is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTemplate() error = %v", err)
+			}
+			if created == nil || created.ProjectID != projectID || created.CreatedBy != tt.userID {
+				t.Fatalf("created = %+v, want a template of the project by the user", created)
+			}
+			if len(result.Participants) != 1 || result.Participants[0] != participant {
+				t.Errorf("participants = %v, want duplicates removed", result.Participants)
+			}
+			if result.CustomFields["importance"] != "high" {
+				t.Errorf("customFields = %v, want values kept as given", result.CustomFields)
+			}
+		})
+	}
+}
+
+func TestBoardService_CreateBoardFromTemplate(t *testing.T) {
+	projectID, templateID, userID := uuid.New(), uuid.New(), uuid.New()
+	templateParticipant, extraParticipant := uuid.New(), uuid.New()
+	fields, _ := json.Marshal(map[string]interface{}{"stage": "todo", "importance": "high"})
+	participants, _ := json.Marshal([]uuid.UUID{templateParticipant})
+
+	templateRepo := &MockBoardTemplateRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+			return &domain.BoardTemplate{
+				BaseModel: domain.BaseModel{ID: templateID}, ProjectID: projectID,
+				Title: "[BUG] ", Content: "## 재현 방법", CustomFields: fields, Participants: participants,
+			}, nil
+		},
+	}
+	var created *domain.Board
+	boardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			created = board
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return created, nil
+		},
+	}
+	var added []uuid.UUID
+	participantRepo := &MockParticipantRepository{
+		CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+			added = append(added, participant.UserID)
+			return nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	s := NewBoardService(boardRepo, projectRepo, &MockFieldOptionRepository{}, participantRepo,
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, nil, zap.NewNop(), WithBoardTemplates(templateRepo))
+
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+	req := &dto.CreateBoardFromTemplateRequest{
+		Title:        "[BUG] 로그인 실패",
+		CustomFields: map[string]interface{}{"stage": "in_progress"},
+		Participants: []uuid.UUID{extraParticipant, templateParticipant},
+	}
+	if _, err := s.CreateBoardFromTemplate(ctx, templateID, req); err != nil {
+		t.Fatalf("CreateBoardFromTemplate() error = %v", err)
+	}
+
+	if created == nil || created.ProjectID != projectID || created.Title != req.Title || created.Content != "## 재현 방법" {
+		t.Fatalf("created = %+v, want the template's content with the requested title", created)
+	}
+	var got map[string]interface{}
+	_ = json.Unmarshal(created.CustomFields, &got)
+	if got["stage"] != "in_progress" || got["importance"] != "high" {
+		t.Errorf("customFields = %v, want request values over template values", got)
+	}
+	if len(added) != 2 {
+		t.Errorf("participants = %v, want template and requested participants without duplicates", added)
+	}
+}
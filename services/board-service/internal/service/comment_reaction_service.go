@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// maxReactionEmojiRunes is the longest emoji sequence accepted (ZWJ 조합 이모지, 피부색 수정자 포함)
+const maxReactionEmojiRunes = 8
+
+// CommentReactionService defines the interface for comment emoji reactions
+type CommentReactionService interface {
+	AddReaction(ctx context.Context, commentID, userID uuid.UUID, emoji string) (*dto.CommentReactionsResponse, error)
+	RemoveReaction(ctx context.Context, commentID, userID uuid.UUID, emoji string) (*dto.CommentReactionsResponse, error)
+}
+
+// commentReactionServiceImpl is the implementation of CommentReactionService
+type commentReactionServiceImpl struct {
+	reactionRepo repository.CommentReactionRepository
+	commentRepo  repository.CommentRepository
+	boardRepo    repository.BoardRepository
+}
+
+// NewCommentReactionService creates a new instance of CommentReactionService
+func NewCommentReactionService(reactionRepo repository.CommentReactionRepository, commentRepo repository.CommentRepository, boardRepo repository.BoardRepository) CommentReactionService {
+	return &commentReactionServiceImpl{
+		reactionRepo: reactionRepo,
+		commentRepo:  commentRepo,
+		boardRepo:    boardRepo,
+	}
+}
+
+// AddReaction adds the user's emoji reaction to a comment (idempotent) and returns the comment's reactions
+func (s *commentReactionServiceImpl) AddReaction(ctx context.Context, commentID, userID uuid.UUID, emoji string) (*dto.CommentReactionsResponse, error) {
+	if err := validateReactionEmoji(emoji); err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reactionRepo.Add(ctx, commentID, userID, emoji); err != nil {
+		return nil, response.NewInternalError("Failed to add reaction", err.Error())
+	}
+	return s.reactionsResponse(ctx, comment)
+}
+
+// RemoveReaction removes the user's emoji reaction from a comment (idempotent) and returns the comment's reactions
+func (s *commentReactionServiceImpl) RemoveReaction(ctx context.Context, commentID, userID uuid.UUID, emoji string) (*dto.CommentReactionsResponse, error) {
+	if err := validateReactionEmoji(emoji); err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reactionRepo.Remove(ctx, commentID, userID, emoji); err != nil {
+		return nil, response.NewInternalError("Failed to remove reaction", err.Error())
+	}
+	return s.reactionsResponse(ctx, comment)
+}
+
+// findComment returns a not found error when the comment does not exist
+func (s *commentReactionServiceImpl) findComment(ctx context.Context, commentID uuid.UUID) (*domain.Comment, error) {
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Comment not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch comment", err.Error())
+	}
+	return comment, nil
+}
+
+// reactionsResponse reloads the reactions of a comment with the project to broadcast them to
+func (s *commentReactionServiceImpl) reactionsResponse(ctx context.Context, comment *domain.Comment) (*dto.CommentReactionsResponse, error) {
+	board, err := s.boardRepo.FindByID(ctx, comment.BoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch board", err.Error())
+	}
+	reactions, err := s.reactionRepo.FindByCommentIDs(ctx, []uuid.UUID{comment.ID})
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch reactions", err.Error())
+	}
+	return &dto.CommentReactionsResponse{
+		CommentID: comment.ID,
+		BoardID:   comment.BoardID,
+		ProjectID: board.ProjectID,
+		Reactions: summarizeReactions(toDomainReactions(reactions)),
+	}, nil
+}
+
+// WithReactions includes the emoji reaction counts of comments in CommentResponse
+func WithReactions(repo repository.CommentReactionRepository) CommentServiceOption {
+	return func(s *commentServiceImpl) {
+		s.reactionRepo = repo
+	}
+}
+
+// loadReactions loads the reactions of the given comments with a single query.
+// 반응 조회에 실패해도 댓글 목록은 그대로 반환합니다.
+func (s *commentServiceImpl) loadReactions(ctx context.Context, comments []*domain.Comment) {
+	if s.reactionRepo == nil || len(comments) == 0 {
+		return
+	}
+	ids := make([]uuid.UUID, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+	reactions, err := s.reactionRepo.FindByCommentIDs(ctx, ids)
+	if err != nil {
+		s.logger.Warn("Failed to fetch comment reactions", zap.Int("comment.count", len(comments)), zap.Error(err))
+		return
+	}
+	byComment := make(map[uuid.UUID][]domain.CommentReaction, len(comments))
+	for _, r := range reactions {
+		byComment[r.CommentID] = append(byComment[r.CommentID], *r)
+	}
+	for _, c := range comments {
+		c.Reactions = byComment[c.ID]
+	}
+}
+
+// validateReactionEmoji accepts a single emoji (or emoji sequence) and rejects plain text
+func validateReactionEmoji(emoji string) error {
+	if emoji == "" || !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxReactionEmojiRunes {
+		return response.NewValidationError("Invalid reaction emoji", "")
+	}
+	for _, r := range emoji {
+		if r < utf8.RuneSelf || unicode.IsLetter(r) || unicode.IsSpace(r) {
+			return response.NewValidationError("Invalid reaction emoji", "reactions must be emoji, not text")
+		}
+	}
+	return nil
+}
+
+// summarizeReactions groups reactions by emoji in order of the first reaction (never nil)
+func summarizeReactions(reactions []domain.CommentReaction) []dto.CommentReactionSummary {
+	summaries := []dto.CommentReactionSummary{}
+	index := make(map[string]int)
+	for _, r := range reactions {
+		i, ok := index[r.Emoji]
+		if !ok {
+			i = len(summaries)
+			index[r.Emoji] = i
+			summaries = append(summaries, dto.CommentReactionSummary{Emoji: r.Emoji, UserIDs: []uuid.UUID{}})
+		}
+		summaries[i].Count++
+		summaries[i].UserIDs = append(summaries[i].UserIDs, r.UserID)
+	}
+	return summaries
+}
+
+// toDomainReactions converts repository results to the value slice stored on domain.Comment
+func toDomainReactions(reactions []*domain.CommentReaction) []domain.CommentReaction {
+	result := make([]domain.CommentReaction, len(reactions))
+	for i, r := range reactions {
+		result[i] = *r
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+func TestValidateReactionEmoji(t *testing.T) {
+	tests := []struct {
+		emoji   string
+		wantErr bool
+	}{
+		{emoji: "👍"},
+		{emoji: "❤️"},
+		{emoji: "👩‍💻"},
+		{emoji: "👍🏽"},
+		{emoji: "", wantErr: true},
+		{emoji: "ok", wantErr: true},
+		{emoji: "좋아요", wantErr: true},
+		{emoji: "👍 👍", wantErr: true},
+		{emoji: "🎉🎉🎉🎉🎉🎉🎉🎉🎉", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.emoji, func(t *testing.T) {
+			if err := validateReactionEmoji(tt.emoji); (err != nil) != tt.wantErr {
+				t.Errorf("validateReactionEmoji(%q) error = %v, wantErr %v", tt.emoji, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommentReactionService_AddReaction(t *testing.T) {
+	projectID, boardID, commentID := uuid.New(), uuid.New(), uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+
+	var added []string
+	reactionRepo := &MockCommentReactionRepository{
+		AddFunc: func(ctx context.Context, cid, userID uuid.UUID, emoji string) error {
+			added = append(added, emoji)
+			return nil
+		},
+		FindByCommentIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.CommentReaction, error) {
+			return []*domain.CommentReaction{
+				{CommentID: commentID, UserID: alice, Emoji: "👍"},
+				{CommentID: commentID, UserID: bob, Emoji: "🎉"},
+				{CommentID: commentID, UserID: bob, Emoji: "👍"},
+			}, nil
+		},
+	}
+	commentRepo := &MockCommentRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+			if id != commentID {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil
+		},
+	}
+	s := NewCommentReactionService(reactionRepo, commentRepo, boardRepo)
+
+	t.Run("성공: 이모지별 집계 반환", func(t *testing.T) {
+		got, err := s.AddReaction(context.Background(), commentID, bob, "👍")
+		if err != nil {
+			t.Fatalf("AddReaction() error = %v", err)
+		}
+		if got.ProjectID != projectID || got.BoardID != boardID {
+			t.Errorf("response = %+v, want the board and project of the comment", got)
+		}
+		if len(got.Reactions) != 2 || got.Reactions[0].Emoji != "👍" || got.Reactions[0].Count != 2 || got.Reactions[1].Count != 1 {
+			t.Errorf("Reactions = %+v, want 👍×2 then 🎉×1", got.Reactions)
+		}
+	})
+
+	t.Run("실패: 텍스트 반응", func(t *testing.T) {
+		added = nil
+		_, err := s.AddReaction(context.Background(), commentID, bob, "lol")
+		var appErr *response.AppError
+		if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+		if len(added) != 0 {
+			t.Error("invalid reaction should not be stored")
+		}
+	})
+
+	t.Run("실패: 댓글 없음", func(t *testing.T) {
+		_, err := s.RemoveReaction(context.Background(), uuid.New(), bob, "👍")
+		var appErr *response.AppError
+		if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeNotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func TestCommentService_GetCommentsIncludesReactions(t *testing.T) {
+	boardID, first, second, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	commentRepo := &MockCommentRepository{
+		FindByBoardIDFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.Comment, error) {
+			return []*domain.Comment{
+				{BaseModel: domain.BaseModel{ID: first}, BoardID: boardID},
+				{BaseModel: domain.BaseModel{ID: second}, BoardID: boardID},
+			}, nil
+		},
+	}
+	var queried []uuid.UUID
+	reactionRepo := &MockCommentReactionRepository{
+		FindByCommentIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.CommentReaction, error) {
+			queried = ids
+			return []*domain.CommentReaction{{CommentID: second, UserID: userID, Emoji: "👀"}}, nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+		},
+	}
+	s := NewCommentService(commentRepo, boardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, nil, nil, zap.NewNop(), WithReactions(reactionRepo))
+
+	got, err := s.GetComments(context.Background(), boardID)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(queried) != 2 {
+		t.Errorf("reactions should be loaded with one query for both comments, got %v", queried)
+	}
+	if len(got[0].Reactions) != 0 || got[0].Reactions == nil {
+		t.Errorf("first comment reactions = %v, want an empty list", got[0].Reactions)
+	}
+	if len(got[1].Reactions) != 1 || got[1].Reactions[0].Emoji != "👀" || got[1].Reactions[0].UserIDs[0] != userID {
+		t.Errorf("second comment reactions = %+v, want 👀 by the user", got[1].Reactions)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/google/uuid"
@@ -19,6 +20,7 @@ import (
 type CommentService interface {
 	CreateComment(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error)
 	GetComments(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
+	GetCommentThreads(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, commentID uuid.UUID) error
 }
@@ -31,6 +33,8 @@ type commentServiceImpl struct {
 	attachmentRepo repository.AttachmentRepository
 	s3Client       S3Client
 	notiClient     client.NotiClient
+	userClient     client.UserClient                    // optional, @nickname 멘션 해석에 사용
+	reactionRepo   repository.CommentReactionRepository // optional, 응답에 이모지 반응 집계 포함
 	logger         *zap.Logger
 }
 
@@ -43,8 +47,9 @@ func NewCommentService(
 	s3Client S3Client,
 	notiClient client.NotiClient,
 	logger *zap.Logger,
+	opts ...CommentServiceOption,
 ) CommentService {
-	return &commentServiceImpl{
+	s := &commentServiceImpl{
 		commentRepo:    commentRepo,
 		boardRepo:      boardRepo,
 		projectRepo:    projectRepo,
@@ -53,6 +58,10 @@ func NewCommentService(
 		notiClient:     notiClient,
 		logger:         logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateComment creates a new comment on a board
@@ -69,6 +78,14 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
 
+	// Replies must be written to a comment of the same board
+	var parent *domain.Comment
+	if req.ParentCommentID != nil {
+		if parent, err = s.findParentComment(ctx, req.BoardID, *req.ParentCommentID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate and confirm attachments if provided
 	if len(validAttachmentIDs) > 0 {
 		if err := s.validateAndConfirmAttachments(ctx, validAttachmentIDs, domain.EntityTypeComment); err != nil {
@@ -76,11 +93,32 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 		}
 	}
 
+	// Get project info for mentions and notifications (실패해도 댓글 작성은 계속 진행)
+	project, err := s.projectRepo.FindByID(ctx, board.ProjectID)
+	if err != nil {
+		s.logger.Warn("Failed to get project for comment mentions and notifications",
+			zap.String("board.id", board.ID.String()),
+			zap.Error(err))
+		project = nil
+	}
+
 	// Create domain model from request
 	comment := &domain.Comment{
-		BoardID: req.BoardID,
-		UserID:  userID,
-		Content: req.Content,
+		BoardID:         req.BoardID,
+		UserID:          userID,
+		ParentCommentID: req.ParentCommentID,
+		Content:         req.Content,
+	}
+
+	// Store the project members mentioned in the body (@{userId} or @nickname)
+	var mentioned []uuid.UUID
+	if project != nil {
+		mentioned = s.resolveMentions(ctx, project, req.Content)
+	}
+	if len(mentioned) > 0 {
+		if comment.Mentions, err = json.Marshal(mentioned); err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to marshal comment mentions", err.Error())
+		}
 	}
 
 	// Save to repository
@@ -128,8 +166,17 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 	// 생성된 Attachments를 Comment 객체에 할당 (타입 변환 적용)
 	comment.Attachments = toDomainAttachments(createdAttachments)
 
-	// Send notification to assignee and all participants (excluding comment author)
-	s.sendCommentNotification(ctx, board, comment, userID)
+	// Notify mentioned users with COMMENT_MENTION, the parent comment author with COMMENT_REPLY,
+	// and the remaining assignee and participants with BOARD_COMMENT_ADDED (excluding comment author)
+	if project != nil && s.notiClient != nil {
+		s.sendMentionNotifications(ctx, project, board, comment, userID, mentioned)
+		notified := mentioned
+		if parent != nil {
+			s.sendReplyNotification(ctx, project, board, parent, comment, userID, mentioned)
+			notified = append(notified, parent.UserID)
+		}
+		s.sendCommentNotification(ctx, project, board, comment, userID, notified)
+	}
 
 	// Convert to response DTO
 	return s.toCommentResponse(comment), nil
@@ -160,6 +207,7 @@ func (s *commentServiceImpl) GetComments(ctx context.Context, boardID uuid.UUID)
 		}
 		comment.Attachments = toDomainAttachments(attachments)
 	}
+	s.loadReactions(ctx, comments)
 
 	// Convert to response DTOs
 	responses := make([]*dto.CommentResponse, len(comments))
@@ -229,6 +277,7 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 		// DB에서 최신 Attachments 목록을 로드하여 comment 객체에 할당
 		comment.Attachments = toDomainAttachments(allAttachments)
 	}
+	s.loadReactions(ctx, []*domain.Comment{comment})
 
 	// Convert to response DTO
 	return s.toCommentResponse(comment), nil
@@ -283,17 +332,22 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+			ThumbnailURL:       thumbnailURL(s.s3Client.GetFileURL, a.ThumbnailKey),
+			MediumThumbnailURL: thumbnailURL(s.s3Client.GetFileURL, a.MediumThumbnailKey),
 		})
 	}
 
 	return &dto.CommentResponse{
-		CommentID:   comment.ID,
-		BoardID:     comment.BoardID,
-		UserID:      comment.UserID,
-		Content:     comment.Content,
-		Attachments: attachments,
-		CreatedAt:   comment.CreatedAt,
-		UpdatedAt:   comment.UpdatedAt,
+		CommentID:       comment.ID,
+		BoardID:         comment.BoardID,
+		ParentCommentID: comment.ParentCommentID,
+		UserID:          comment.UserID,
+		Content:         comment.Content,
+		Mentions:        commentMentions(comment),
+		Attachments:     attachments,
+		Reactions:       summarizeReactions(comment.Reactions),
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
 	}
 }
 
@@ -366,6 +420,14 @@ func (s *commentServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		for _, key := range attachment.ThumbnailKeys() {
+			if err := s.s3Client.DeleteFile(ctx, key); err != nil {
+				s.logger.Warn("Failed to delete thumbnail from S3",
+					zap.String("attachment_id", attachment.ID.String()),
+					zap.String("file_key", key),
+					zap.Error(err))
+			}
+		}
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -381,22 +443,9 @@ func (s *commentServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 }
 
 // sendCommentNotification sends a COMMENT_ADDED notification to the board assignee and all participants
-// Excludes the actor (the person who added the comment)
+// Excludes the actor (the person who added the comment) and the users notified otherwise (mentions, reply to their comment)
 // This is called asynchronously (in a goroutine) so notification failures don't affect the main business logic
-func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board *domain.Board, comment *domain.Comment, actorID uuid.UUID) {
-	if s.notiClient == nil {
-		return
-	}
-
-	// Get project info for workspace ID
-	project, err := s.projectRepo.FindByID(ctx, board.ProjectID)
-	if err != nil {
-		s.logger.Warn("Failed to get project for comment notification",
-			zap.String("board.id", board.ID.String()),
-			zap.Error(err))
-		return
-	}
-
+func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, project *domain.Project, board *domain.Board, comment *domain.Comment, actorID uuid.UUID, excluded []uuid.UUID) {
 	// Collect all users to notify (assignee + participants), excluding actor
 	notifyUserIDs := make(map[uuid.UUID]bool)
 
@@ -412,18 +461,18 @@ func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board
 		}
 	}
 
+	for _, userID := range excluded {
+		delete(notifyUserIDs, userID)
+	}
+
 	if len(notifyUserIDs) == 0 {
 		return
 	}
 
-	// Truncate comment content for notification preview (max 100 chars)
-	contentPreview := comment.Content
-	if len(contentPreview) > 100 {
-		contentPreview = contentPreview[:100] + "..."
-	}
+	contentPreview := commentPreview(comment.Content)
 
 	// Send notifications asynchronously
-	go func() {
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
 		for userID := range notifyUserIDs {
 			event := &client.NotificationEvent{
 				Type:         client.NotificationTypeBoardCommentAdded,
@@ -441,8 +490,7 @@ func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board
 				},
 			}
 
-			// Use background context to avoid cancellation when request completes
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+			if err := s.notiClient.SendNotification(ctx, event); err != nil {
 				s.logger.Warn("Failed to send comment notification",
 					zap.String("board.id", board.ID.String()),
 					zap.String("comment.id", comment.ID.String()),
@@ -450,5 +498,13 @@ func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board
 					zap.Error(err))
 			}
 		}
-	}()
+	})
+}
+
+// commentPreview truncates comment content for notification preview (max 100 chars)
+func commentPreview(content string) string {
+	if len(content) > 100 {
+		return content[:100] + "..."
+	}
+	return content
 }
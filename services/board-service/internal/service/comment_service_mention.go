@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+)
+
+// CommentServiceOption configures optional CommentService dependencies
+type CommentServiceOption func(*commentServiceImpl)
+
+// WithMentionProfiles resolves @nickname mentions through the members' workspace profiles
+// (없으면 @{userId} 형식의 멘션만 인식)
+func WithMentionProfiles(userClient client.UserClient) CommentServiceOption {
+	return func(s *commentServiceImpl) {
+		s.userClient = userClient
+	}
+}
+
+var (
+	// mentionIDPattern matches @{userId} mentions
+	mentionIDPattern = regexp.MustCompile(`@\{([0-9a-fA-F-]{36})\}`)
+	// mentionNicknamePattern matches @nickname mentions (이메일 주소의 @는 앞에 문자가 있으므로 제외)
+	mentionNicknamePattern = regexp.MustCompile(`(?:^|[^\w@{])@([\p{L}\p{N}_.\-]+)`)
+)
+
+// parseMentions extracts the user IDs and nicknames mentioned in a comment body
+func parseMentions(content string) ([]uuid.UUID, []string) {
+	var ids []uuid.UUID
+	for _, m := range mentionIDPattern.FindAllStringSubmatch(content, -1) {
+		if id, err := uuid.Parse(m[1]); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	var nicknames []string
+	seen := make(map[string]bool)
+	for _, m := range mentionNicknamePattern.FindAllStringSubmatch(mentionIDPattern.ReplaceAllString(content, " "), -1) {
+		nickname := strings.TrimRight(m[1], ".-")
+		key := strings.ToLower(nickname)
+		if nickname == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		nicknames = append(nicknames, nickname)
+	}
+	return removeDuplicateUUIDs(ids), nicknames
+}
+
+// resolveMentions returns the project members mentioned in a comment body.
+// 프로젝트 멤버(소유자 포함)가 아닌 사용자나 찾을 수 없는 닉네임은 댓글 작성을 막지 않고 무시합니다.
+func (s *commentServiceImpl) resolveMentions(ctx context.Context, project *domain.Project, content string) []uuid.UUID {
+	ids, nicknames := parseMentions(content)
+	if len(ids) == 0 && len(nicknames) == 0 {
+		return nil
+	}
+
+	members, err := s.projectRepo.FindMembersByProjectID(ctx, project.ID)
+	if err != nil {
+		s.logger.Warn("Failed to fetch project members for comment mentions",
+			zap.String("project.id", project.ID.String()),
+			zap.Error(err))
+		return nil
+	}
+	memberIDs := []uuid.UUID{project.OwnerID}
+	isMember := map[uuid.UUID]bool{project.OwnerID: true}
+	for _, m := range members {
+		if !isMember[m.UserID] {
+			isMember[m.UserID] = true
+			memberIDs = append(memberIDs, m.UserID)
+		}
+	}
+
+	var mentioned []uuid.UUID
+	for _, id := range ids {
+		if isMember[id] {
+			mentioned = append(mentioned, id)
+		}
+	}
+	if len(nicknames) > 0 && s.userClient != nil {
+		mentioned = append(mentioned, s.resolveNicknames(ctx, project.WorkspaceID, memberIDs, nicknames)...)
+	}
+	return removeDuplicateUUIDs(mentioned)
+}
+
+// resolveNicknames matches nicknames (case-insensitive) against the workspace profiles of the given members
+func (s *commentServiceImpl) resolveNicknames(ctx context.Context, workspaceID uuid.UUID, memberIDs []uuid.UUID, nicknames []string) []uuid.UUID {
+	wanted := make(map[string]bool, len(nicknames))
+	for _, nickname := range nicknames {
+		wanted[strings.ToLower(nickname)] = true
+	}
+	token, _ := ctx.Value("jwtToken").(string)
+
+	var resolved []uuid.UUID
+	for _, memberID := range memberIDs {
+		if len(wanted) == 0 {
+			break
+		}
+		profile, err := s.userClient.GetWorkspaceProfile(ctx, workspaceID, memberID, token)
+		if err != nil || profile == nil {
+			continue
+		}
+		key := strings.ToLower(profile.NickName)
+		if wanted[key] {
+			delete(wanted, key)
+			resolved = append(resolved, memberID)
+		}
+	}
+	return resolved
+}
+
+// commentMentions decodes the mentioned user IDs of a comment (never nil)
+func commentMentions(comment *domain.Comment) []uuid.UUID {
+	mentions := []uuid.UUID{}
+	if len(comment.Mentions) > 0 {
+		_ = json.Unmarshal(comment.Mentions, &mentions)
+	}
+	return mentions
+}
+
+// sendMentionNotifications sends a COMMENT_MENTION notification to each mentioned user except the actor
+func (s *commentServiceImpl) sendMentionNotifications(ctx context.Context, project *domain.Project, board *domain.Board, comment *domain.Comment, actorID uuid.UUID, mentioned []uuid.UUID) {
+	var targets []uuid.UUID
+	for _, userID := range mentioned {
+		if userID != actorID {
+			targets = append(targets, userID)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	contentPreview := commentPreview(comment.Content)
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
+		for _, userID := range targets {
+			event := &client.NotificationEvent{
+				Type:         client.NotificationTypeCommentMention,
+				ActorID:      actorID,
+				TargetUserID: userID,
+				WorkspaceID:  project.WorkspaceID,
+				ResourceType: client.ResourceTypeBoard,
+				ResourceID:   board.ID,
+				ResourceName: &board.Title,
+				Metadata: map[string]interface{}{
+					"projectId":      board.ProjectID.String(),
+					"projectName":    project.Name,
+					"commentId":      comment.ID.String(),
+					"commentPreview": contentPreview,
+				},
+			}
+
+			if err := s.notiClient.SendNotification(ctx, event); err != nil {
+				s.logger.Warn("Failed to send comment mention notification",
+					zap.String("board.id", board.ID.String()),
+					zap.String("comment.id", comment.ID.String()),
+					zap.String("target.user.id", userID.String()),
+					zap.Error(err))
+			}
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// eventRecordingNotiClient collects sent notification events
+type eventRecordingNotiClient struct {
+	events chan *client.NotificationEvent
+}
+
+func (c *eventRecordingNotiClient) SendNotification(ctx context.Context, event *client.NotificationEvent) error {
+	c.events <- event
+	return nil
+}
+
+func (c *eventRecordingNotiClient) SendBulkNotifications(ctx context.Context, events []*client.NotificationEvent) error {
+	for _, event := range events {
+		c.events <- event
+	}
+	return nil
+}
+
+func TestParseMentions(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name          string
+		content       string
+		wantIDs       []uuid.UUID
+		wantNicknames []string
+	}{
+		{name: "멘션 없음", content: "LGTM"},
+		{name: "user ID 멘션", content: "@{" + id.String() + "} 확인 부탁드려요", wantIDs: []uuid.UUID{id}},
+		{name: "닉네임 멘션 (중복 제거)", content: "@alice, @bob 그리고 @Alice.", wantNicknames: []string{"alice", "bob"}},
+		{name: "한글 닉네임", content: "@김철수 님 리뷰 부탁", wantNicknames: []string{"김철수"}},
+		{name: "이메일 주소는 멘션이 아님", content: "mail me at dev@example.com"},
+		{name: "혼합", content: "@{" + id.String() + "} @{" + id.String() + "} cc @carol", wantIDs: []uuid.UUID{id}, wantNicknames: []string{"carol"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, nicknames := parseMentions(tt.content)
+			if len(ids) != len(tt.wantIDs) || (len(ids) > 0 && !reflect.DeepEqual(ids, tt.wantIDs)) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if !reflect.DeepEqual(nicknames, tt.wantNicknames) {
+				t.Errorf("nicknames = %v, want %v", nicknames, tt.wantNicknames)
+			}
+		})
+	}
+}
+
+// TestCommentService_CreateCommentMentions는 프로젝트 멤버로 확인된 멘션만 저장되고, 멘션된 사용자에게는
+// COMMENT_MENTION이, 나머지 담당자에게는 BOARD_COMMENT_ADDED가 전송되는지 테스트합니다.
+func TestCommentService_CreateCommentMentions(t *testing.T) {
+	workspaceID, projectID, ownerID := uuid.New(), uuid.New(), uuid.New()
+	actorID, aliceID, assigneeID, outsiderID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	board := &domain.Board{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		ProjectID:  projectID,
+		AssigneeID: &assigneeID,
+		Title:      "Release",
+	}
+
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: workspaceID, OwnerID: ownerID, Name: "Apollo"}, nil
+		},
+		FindMembersByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.ProjectMember, error) {
+			return []*domain.ProjectMember{{UserID: actorID}, {UserID: aliceID}, {UserID: assigneeID}}, nil
+		},
+	}
+	var saved *domain.Comment
+	commentRepo := &MockCommentRepository{
+		CreateFunc: func(ctx context.Context, comment *domain.Comment) error {
+			saved = comment
+			return nil
+		},
+	}
+	userClient := &MockUserClient{
+		GetWorkspaceProfileFunc: func(ctx context.Context, ws, userID uuid.UUID, token string) (*client.WorkspaceProfile, error) {
+			if token != "user-token" {
+				t.Errorf("token = %q, want the request token", token)
+			}
+			nickname := "someone"
+			if userID == aliceID {
+				nickname = "Alice"
+			}
+			return &client.WorkspaceProfile{WorkspaceID: ws, UserID: userID, NickName: nickname}, nil
+		},
+	}
+	noti := &eventRecordingNotiClient{events: make(chan *client.NotificationEvent, 10)}
+	s := NewCommentService(commentRepo, boardRepo, projectRepo, &MockAttachmentRepository{}, nil, noti, zap.NewNop(), WithMentionProfiles(userClient))
+
+	ctx := context.WithValue(context.Background(), "jwtToken", "user-token")
+	content := "@alice @{" + ownerID.String() + "} @{" + outsiderID.String() + "} @{" + actorID.String() + "} @nobody 확인 부탁드립니다"
+	got, err := s.CreateComment(ctx, actorID, &dto.CreateCommentRequest{BoardID: board.ID, Content: content})
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	want := map[uuid.UUID]bool{ownerID: true, actorID: true, aliceID: true}
+	if len(got.Mentions) != len(want) {
+		t.Fatalf("Mentions = %v, want owner, actor and alice", got.Mentions)
+	}
+	for _, id := range got.Mentions {
+		if !want[id] {
+			t.Errorf("unexpected mention %s (outsiders must be ignored)", id)
+		}
+	}
+	if saved == nil || len(commentMentions(saved)) != len(want) {
+		t.Errorf("mentions were not stored on the comment")
+	}
+
+	wantTypes := map[uuid.UUID]client.NotificationType{
+		ownerID:    client.NotificationTypeCommentMention,
+		aliceID:    client.NotificationTypeCommentMention,
+		assigneeID: client.NotificationTypeBoardCommentAdded,
+	}
+	for len(wantTypes) > 0 {
+		select {
+		case event := <-noti.events:
+			if wantType, ok := wantTypes[event.TargetUserID]; !ok || event.Type != wantType {
+				t.Errorf("got %s notification for %s", event.Type, event.TargetUserID)
+			}
+			delete(wantTypes, event.TargetUserID)
+		case <-time.After(time.Second):
+			t.Fatalf("missing notifications: %v", wantTypes)
+		}
+	}
+	select {
+	case event := <-noti.events:
+		t.Errorf("unexpected %s notification for %s", event.Type, event.TargetUserID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// GetCommentThreads retrieves the comments of a board nested under their parent comments
+func (s *commentServiceImpl) GetCommentThreads(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error) {
+	comments, err := s.GetComments(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	return buildCommentThreads(comments), nil
+}
+
+// buildCommentThreads nests replies under their parent comments, keeping creation order at every level.
+// 상위 댓글이 삭제된 답글은 최상위 댓글로 보여줍니다.
+func buildCommentThreads(comments []*dto.CommentResponse) []*dto.CommentResponse {
+	byID := make(map[uuid.UUID]*dto.CommentResponse, len(comments))
+	for _, c := range comments {
+		byID[c.CommentID] = c
+	}
+
+	roots := make([]*dto.CommentResponse, 0, len(comments))
+	for _, c := range comments {
+		if c.ParentCommentID != nil {
+			if parent, ok := byID[*c.ParentCommentID]; ok && parent != c {
+				parent.Replies = append(parent.Replies, c)
+				continue
+			}
+		}
+		roots = append(roots, c)
+	}
+	return roots
+}
+
+// findParentComment fetches the comment a reply is written to and checks it belongs to the same board
+func (s *commentServiceImpl) findParentComment(ctx context.Context, boardID, parentID uuid.UUID) (*domain.Comment, error) {
+	parent, err := s.commentRepo.FindByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Parent comment not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch parent comment", err.Error())
+	}
+	if parent.BoardID != boardID {
+		return nil, response.NewValidationError("Parent comment belongs to another board", "")
+	}
+	return parent, nil
+}
+
+// sendReplyNotification sends a COMMENT_REPLY notification to the author of the parent comment.
+// 작성자 본인의 댓글에 단 답글이거나 이미 멘션 알림을 받는 경우에는 보내지 않습니다.
+func (s *commentServiceImpl) sendReplyNotification(ctx context.Context, project *domain.Project, board *domain.Board, parent, comment *domain.Comment, actorID uuid.UUID, mentioned []uuid.UUID) {
+	if parent.UserID == actorID {
+		return
+	}
+	for _, userID := range mentioned {
+		if userID == parent.UserID {
+			return
+		}
+	}
+
+	contentPreview := commentPreview(comment.Content)
+	sendNotificationsAsync(ctx, s.notiClient, func(ctx context.Context) {
+		event := &client.NotificationEvent{
+			Type:         client.NotificationTypeCommentReply,
+			ActorID:      actorID,
+			TargetUserID: parent.UserID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata: map[string]interface{}{
+				"projectId":       board.ProjectID.String(),
+				"projectName":     project.Name,
+				"commentId":       comment.ID.String(),
+				"parentCommentId": parent.ID.String(),
+				"commentPreview":  contentPreview,
+			},
+		}
+
+		if err := s.notiClient.SendNotification(ctx, event); err != nil {
+			s.logger.Warn("Failed to send comment reply notification",
+				zap.String("board.id", board.ID.String()),
+				zap.String("comment.id", comment.ID.String()),
+				zap.String("target.user.id", parent.UserID.String()),
+				zap.Error(err))
+		}
+	})
+}
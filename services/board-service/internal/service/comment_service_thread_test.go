@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBuildCommentThreads(t *testing.T) {
+	rootID, replyID, nestedID, orphanID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	deletedID := uuid.New()
+	comments := []*dto.CommentResponse{
+		{CommentID: rootID},
+		{CommentID: replyID, ParentCommentID: &rootID},
+		{CommentID: orphanID, ParentCommentID: &deletedID},
+		{CommentID: nestedID, ParentCommentID: &replyID},
+	}
+
+	roots := buildCommentThreads(comments)
+
+	if len(roots) != 2 || roots[0].CommentID != rootID || roots[1].CommentID != orphanID {
+		t.Fatalf("roots = %+v, want the root comment and the orphaned reply", roots)
+	}
+	if len(roots[0].Replies) != 1 || roots[0].Replies[0].CommentID != replyID {
+		t.Fatalf("replies of root = %+v, want the direct reply", roots[0].Replies)
+	}
+	if nested := roots[0].Replies[0].Replies; len(nested) != 1 || nested[0].CommentID != nestedID {
+		t.Errorf("replies of reply = %+v, want the nested reply", nested)
+	}
+}
+
+// TestCommentService_CreateReply는 답글이 같은 보드의 댓글에만 달리고, 상위 댓글 작성자에게는
+// COMMENT_REPLY가, 나머지 담당자에게는 BOARD_COMMENT_ADDED가 전송되는지 테스트합니다.
+func TestCommentService_CreateReply(t *testing.T) {
+	projectID, actorID, parentAuthorID, assigneeID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    projectID,
+		AssigneeID:   &assigneeID,
+		Participants: []domain.Participant{{UserID: parentAuthorID}},
+		Title:        "Release",
+	}
+	parent := &domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: board.ID, UserID: parentAuthorID}
+	otherBoardComment := &domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: uuid.New(), UserID: parentAuthorID}
+
+	tests := []struct {
+		name        string
+		parentID    uuid.UUID
+		wantErrCode string
+	}{
+		{name: "성공: 답글 작성", parentID: parent.ID},
+		{name: "실패: 다른 보드의 댓글", parentID: otherBoardComment.ID, wantErrCode: response.ErrCodeValidation},
+		{name: "실패: 상위 댓글 없음", parentID: uuid.New(), wantErrCode: response.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.Comment
+			commentRepo := &MockCommentRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+					for _, c := range []*domain.Comment{parent, otherBoardComment} {
+						if c.ID == id {
+							return c, nil
+						}
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+				CreateFunc: func(ctx context.Context, comment *domain.Comment) error {
+					saved = comment
+					return nil
+				},
+			}
+			boardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return board, nil
+				},
+			}
+			projectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: uuid.New(), Name: "Apollo"}, nil
+				},
+			}
+			noti := &eventRecordingNotiClient{events: make(chan *client.NotificationEvent, 10)}
+			s := NewCommentService(commentRepo, boardRepo, projectRepo, &MockAttachmentRepository{}, nil, noti, zap.NewNop())
+
+			parentID := tt.parentID
+			got, err := s.CreateComment(context.Background(), actorID, &dto.CreateCommentRequest{BoardID: board.ID, ParentCommentID: &parentID, Content: "동의합니다"})
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if saved != nil {
+					t.Error("reply should not be saved")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateComment() error = %v", err)
+			}
+			if got.ParentCommentID == nil || *got.ParentCommentID != parent.ID {
+				t.Errorf("ParentCommentID = %v, want %s", got.ParentCommentID, parent.ID)
+			}
+
+			wantTypes := map[uuid.UUID]client.NotificationType{
+				parentAuthorID: client.NotificationTypeCommentReply,
+				assigneeID:     client.NotificationTypeBoardCommentAdded,
+			}
+			for len(wantTypes) > 0 {
+				select {
+				case event := <-noti.events:
+					if wantType, ok := wantTypes[event.TargetUserID]; !ok || event.Type != wantType {
+						t.Errorf("got %s notification for %s", event.Type, event.TargetUserID)
+					}
+					delete(wantTypes, event.TargetUserID)
+				case <-time.After(time.Second):
+					t.Fatalf("missing notifications: %v", wantTypes)
+				}
+			}
+			select {
+			case event := <-noti.events:
+				t.Errorf("unexpected %s notification for %s", event.Type, event.TargetUserID)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
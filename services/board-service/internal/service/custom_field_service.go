@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// customFieldKeyPattern is the format of custom field keys (the key of the field in board customFields)
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// CustomFieldService defines the interface for project custom field business logic
+type CustomFieldService interface {
+	GetCustomFields(ctx context.Context, projectID uuid.UUID) ([]*dto.CustomFieldResponse, error)
+	CreateCustomField(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateCustomFieldRequest) (*dto.CustomFieldResponse, error)
+	UpdateCustomField(ctx context.Context, fieldID, userID uuid.UUID, req *dto.UpdateCustomFieldRequest) (*dto.CustomFieldResponse, error)
+	DeleteCustomField(ctx context.Context, fieldID, userID uuid.UUID) error
+}
+
+// customFieldServiceImpl is the implementation of CustomFieldService
+type customFieldServiceImpl struct {
+	customFieldRepo repository.CustomFieldRepository
+	fieldOptionRepo repository.FieldOptionRepository
+	projectRepo     repository.ProjectRepository
+	db              *gorm.DB // optional, removes board values and the definition atomically
+	logger          *zap.Logger
+}
+
+// NewCustomFieldService creates a new instance of CustomFieldService
+func NewCustomFieldService(
+	customFieldRepo repository.CustomFieldRepository,
+	fieldOptionRepo repository.FieldOptionRepository,
+	projectRepo repository.ProjectRepository,
+	db *gorm.DB,
+	logger *zap.Logger,
+) CustomFieldService {
+	return &customFieldServiceImpl{
+		customFieldRepo: customFieldRepo,
+		fieldOptionRepo: fieldOptionRepo,
+		projectRepo:     projectRepo,
+		db:              db,
+		logger:          logger,
+	}
+}
+
+// inTx runs fn in a transaction when a database is configured
+func (s *customFieldServiceImpl) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.db == nil {
+		return fn(ctx)
+	}
+	return uow.Transaction(ctx, s.db, fn)
+}
+
+// GetCustomFields retrieves the custom fields of a project with their options
+func (s *customFieldServiceImpl) GetCustomFields(ctx context.Context, projectID uuid.UUID) ([]*dto.CustomFieldResponse, error) {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+
+	fields, err := s.customFieldRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch custom fields", err.Error())
+	}
+
+	responses := make([]*dto.CustomFieldResponse, len(fields))
+	for i, field := range fields {
+		if responses[i], err = s.toCustomFieldResponse(ctx, field); err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+// CreateCustomField defines a custom field for a project; only the project owner or admins can manage custom fields
+func (s *customFieldServiceImpl) CreateCustomField(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateCustomFieldRequest) (*dto.CustomFieldResponse, error) {
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionManageFields); err != nil {
+		return nil, err
+	}
+
+	if !customFieldKeyPattern.MatchString(req.Key) {
+		return nil, response.NewValidationError("Custom field key must start with a lowercase letter and contain only lowercase letters, digits and underscores", "")
+	}
+	if isValidFieldType(domain.FieldType(req.Key)) {
+		return nil, response.NewValidationError(fmt.Sprintf("Custom field key '%s' is reserved", req.Key), "")
+	}
+
+	fieldType := domain.CustomFieldType(req.Type)
+	if fieldType.HasOptions() && len(req.Options) == 0 {
+		return nil, response.NewValidationError(fmt.Sprintf("Custom field of type '%s' requires at least one option", req.Type), "")
+	}
+	if !fieldType.HasOptions() && len(req.Options) > 0 {
+		return nil, response.NewValidationError(fmt.Sprintf("Custom field of type '%s' cannot have options", req.Type), "")
+	}
+	if err := validateCustomFieldOptions(req.Options, nil); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.customFieldRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to check custom fields", err.Error())
+	}
+	for _, field := range existing {
+		if field.Key == req.Key {
+			return nil, response.NewAppError(response.ErrCodeConflict, fmt.Sprintf("Custom field '%s' already exists", req.Key), "")
+		}
+	}
+
+	field := &domain.CustomFieldDefinition{
+		ProjectID:    projectID,
+		Key:          req.Key,
+		Name:         req.Name,
+		Type:         fieldType,
+		DisplayOrder: req.DisplayOrder,
+		CreatedBy:    userID,
+	}
+	if err := s.customFieldRepo.Create(ctx, field); err != nil {
+		return nil, response.NewInternalError("Failed to create custom field", err.Error())
+	}
+
+	// 옵션은 캐시 무효화를 위해 field option 저장소로 저장하며, 실패하면 정의도 되돌립니다.
+	if err := s.fieldOptionRepo.CreateBatch(ctx, toCustomFieldOptions(projectID, req.Key, req.Options)); err != nil {
+		if delErr := s.customFieldRepo.Delete(ctx, field.ID); delErr != nil {
+			s.logger.Warn("Failed to roll back custom field after option creation failure",
+				zap.String("custom_field.id", field.ID.String()),
+				zap.Error(delErr))
+		}
+		return nil, response.NewInternalError("Failed to create custom field options", err.Error())
+	}
+
+	return s.toCustomFieldResponse(ctx, field)
+}
+
+// UpdateCustomField renames, reorders or adds options to a custom field
+func (s *customFieldServiceImpl) UpdateCustomField(ctx context.Context, fieldID, userID uuid.UUID, req *dto.UpdateCustomFieldRequest) (*dto.CustomFieldResponse, error) {
+	field, err := s.findCustomField(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorize(ctx, s.projectRepo, field.ProjectID, userID, domain.PermissionManageFields); err != nil {
+		return nil, err
+	}
+
+	if len(req.AddOptions) > 0 {
+		if !field.Type.HasOptions() {
+			return nil, response.NewValidationError(fmt.Sprintf("Custom field of type '%s' cannot have options", field.Type), "")
+		}
+		existing, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, field.ProjectID, domain.FieldType(field.Key))
+		if err != nil {
+			return nil, response.NewInternalError("Failed to fetch custom field options", err.Error())
+		}
+		if err := validateCustomFieldOptions(req.AddOptions, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Name != nil {
+		field.Name = *req.Name
+	}
+	if req.DisplayOrder != nil {
+		field.DisplayOrder = *req.DisplayOrder
+	}
+	if err := s.customFieldRepo.Update(ctx, field); err != nil {
+		return nil, response.NewInternalError("Failed to update custom field", err.Error())
+	}
+	if err := s.fieldOptionRepo.CreateBatch(ctx, toCustomFieldOptions(field.ProjectID, field.Key, req.AddOptions)); err != nil {
+		return nil, response.NewInternalError("Failed to create custom field options", err.Error())
+	}
+
+	return s.toCustomFieldResponse(ctx, field)
+}
+
+// DeleteCustomField deletes a custom field, its options and its values on every board of the project
+func (s *customFieldServiceImpl) DeleteCustomField(ctx context.Context, fieldID, userID uuid.UUID) error {
+	field, err := s.findCustomField(ctx, fieldID)
+	if err != nil {
+		return err
+	}
+	if _, err := authorize(ctx, s.projectRepo, field.ProjectID, userID, domain.PermissionManageFields); err != nil {
+		return err
+	}
+
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		if err := s.customFieldRepo.RemoveBoardValues(ctx, field.ProjectID, field.Key); err != nil {
+			return err
+		}
+		return s.customFieldRepo.Delete(ctx, field.ID)
+	}); err != nil {
+		return response.NewInternalError("Failed to delete custom field", err.Error())
+	}
+
+	// 정의가 없는 옵션은 어디에서도 쓰이지 않으므로, 옵션 삭제 실패는 기록만 합니다.
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, field.ProjectID, domain.FieldType(field.Key))
+	if err != nil {
+		s.logger.Warn("Failed to fetch options of deleted custom field",
+			zap.String("custom_field.id", field.ID.String()),
+			zap.Error(err))
+		return nil
+	}
+	for _, option := range options {
+		if err := s.fieldOptionRepo.Delete(ctx, option.ID); err != nil {
+			s.logger.Warn("Failed to delete option of deleted custom field",
+				zap.String("custom_field.id", field.ID.String()),
+				zap.String("option.id", option.ID.String()),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *customFieldServiceImpl) findCustomField(ctx context.Context, fieldID uuid.UUID) (*domain.CustomFieldDefinition, error) {
+	field, err := s.customFieldRepo.FindByID(ctx, fieldID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Custom field not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch custom field", err.Error())
+	}
+	return field, nil
+}
+
+// validateCustomFieldOptions rejects option values that repeat within the request or match an existing option
+func validateCustomFieldOptions(options []dto.CustomFieldOptionRequest, existing []*domain.FieldOption) error {
+	seen := make(map[string]bool, len(options)+len(existing))
+	for _, option := range existing {
+		seen[option.Value] = true
+	}
+	for _, option := range options {
+		if seen[option.Value] {
+			return response.NewValidationError(fmt.Sprintf("Duplicate option value '%s'", option.Value), "")
+		}
+		seen[option.Value] = true
+	}
+	return nil
+}
+
+// toCustomFieldOptions builds the field options of a custom field; the field key is used as their field type
+func toCustomFieldOptions(projectID uuid.UUID, key string, options []dto.CustomFieldOptionRequest) []*domain.FieldOption {
+	fieldOptions := make([]*domain.FieldOption, len(options))
+	for i, option := range options {
+		fieldOptions[i] = &domain.FieldOption{
+			ProjectID:    &projectID,
+			FieldType:    domain.FieldType(key),
+			Value:        option.Value,
+			Label:        option.Label,
+			Color:        option.Color,
+			DisplayOrder: option.DisplayOrder,
+		}
+	}
+	return fieldOptions
+}
+
+// toCustomFieldResponse converts domain.CustomFieldDefinition to dto.CustomFieldResponse with its options
+func (s *customFieldServiceImpl) toCustomFieldResponse(ctx context.Context, field *domain.CustomFieldDefinition) (*dto.CustomFieldResponse, error) {
+	resp := &dto.CustomFieldResponse{
+		FieldID:      field.ID,
+		ProjectID:    field.ProjectID,
+		Key:          field.Key,
+		Name:         field.Name,
+		Type:         string(field.Type),
+		DisplayOrder: field.DisplayOrder,
+		Options:      []*dto.FieldOptionResponse{},
+		CreatedBy:    field.CreatedBy,
+		CreatedAt:    field.CreatedAt,
+		UpdatedAt:    field.UpdatedAt,
+	}
+	if !field.Type.HasOptions() {
+		return resp, nil
+	}
+
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, field.ProjectID, domain.FieldType(field.Key))
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch custom field options", err.Error())
+	}
+	for _, option := range options {
+		resp.Options = append(resp.Options, &dto.FieldOptionResponse{
+			OptionID:        option.ID,
+			FieldType:       string(option.FieldType),
+			Value:           option.Value,
+			Label:           option.Label,
+			Color:           option.Color,
+			DisplayOrder:    option.DisplayOrder,
+			IsSystemDefault: option.IsSystemDefault,
+			CreatedAt:       option.CreatedAt,
+			UpdatedAt:       option.UpdatedAt,
+		})
+	}
+	return resp, nil
+}
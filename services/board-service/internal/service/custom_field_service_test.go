@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// customFieldProjectRepo returns a project repository where ownerID is OWNER, adminID is ADMIN and everyone else is MEMBER
+func customFieldProjectRepo(ownerID, adminID uuid.UUID) *MockProjectRepository {
+	return &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			role := domain.ProjectRoleMember
+			switch uid {
+			case ownerID:
+				role = domain.ProjectRoleOwner
+			case adminID:
+				role = domain.ProjectRoleAdmin
+			}
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: role}, nil
+		},
+	}
+}
+
+func TestCustomFieldService_CreateCustomField(t *testing.T) {
+	projectID, ownerID, adminID, memberID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	options := []dto.CustomFieldOptionRequest{
+		{Value: "backend", Label: "백엔드", Color: "#3B82F6"},
+		{Value: "frontend", Label: "프론트엔드", Color: "#10B981", DisplayOrder: 1},
+	}
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		req         dto.CreateCustomFieldRequest
+		wantErrCode string
+		wantOptions int
+	}{
+		{
+			name:        "ADMIN이 select 필드 생성",
+			userID:      adminID,
+			req:         dto.CreateCustomFieldRequest{Key: "component", Name: "컴포넌트", Type: "select", Options: options},
+			wantOptions: 2,
+		},
+		{
+			name:   "OWNER가 number 필드 생성",
+			userID: ownerID,
+			req:    dto.CreateCustomFieldRequest{Key: "story_points", Name: "스토리 포인트", Type: "number"},
+		},
+		{
+			name:        "MEMBER는 생성 불가",
+			userID:      memberID,
+			req:         dto.CreateCustomFieldRequest{Key: "component", Name: "컴포넌트", Type: "text"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "기본 필드 key는 예약됨",
+			userID:      ownerID,
+			req:         dto.CreateCustomFieldRequest{Key: "stage", Name: "단계", Type: "text"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "잘못된 key 형식",
+			userID:      ownerID,
+			req:         dto.CreateCustomFieldRequest{Key: "Story Points", Name: "스토리 포인트", Type: "number"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "select 필드에 옵션 없음",
+			userID:      ownerID,
+			req:         dto.CreateCustomFieldRequest{Key: "component", Name: "컴포넌트", Type: "multi_select"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "text 필드에 옵션 지정",
+			userID:      ownerID,
+			req:         dto.CreateCustomFieldRequest{Key: "memo", Name: "메모", Type: "text", Options: options},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:   "중복된 옵션 value",
+			userID: ownerID,
+			req: dto.CreateCustomFieldRequest{Key: "component", Name: "컴포넌트", Type: "select",
+				Options: []dto.CustomFieldOptionRequest{options[0], options[0]}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "이미 존재하는 key",
+			userID:      ownerID,
+			req:         dto.CreateCustomFieldRequest{Key: "sprint", Name: "스프린트", Type: "text"},
+			wantErrCode: response.ErrCodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.CustomFieldDefinition
+			var createdOptions []*domain.FieldOption
+			customFieldRepo := &MockCustomFieldRepository{
+				FindByProjectIDFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+					return []*domain.CustomFieldDefinition{{ProjectID: pid, Key: "sprint", Type: domain.CustomFieldTypeText}}, nil
+				},
+				CreateFunc: func(ctx context.Context, field *domain.CustomFieldDefinition) error {
+					field.ID = uuid.New()
+					created = field
+					return nil
+				},
+			}
+			fieldOptionRepo := &MockFieldOptionRepository{
+				CreateBatchFunc: func(ctx context.Context, fieldOptions []*domain.FieldOption) error {
+					createdOptions = append(createdOptions, fieldOptions...)
+					return nil
+				},
+				FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+					return createdOptions, nil
+				},
+			}
+			s := NewCustomFieldService(customFieldRepo, fieldOptionRepo, customFieldProjectRepo(ownerID, adminID), nil, zap.NewNop())
+
+			req := tt.req
+			result, err := s.CreateCustomField(context.Background(), projectID, tt.userID, &req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if created != nil {
+					t.Error("custom field should not be saved when creation is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateCustomField() error = %v", err)
+			}
+			if created == nil || created.ProjectID != projectID || created.CreatedBy != tt.userID || created.Key != tt.req.Key {
+				t.Fatalf("created = %+v, want a field of the project by the user", created)
+			}
+			if len(result.Options) != tt.wantOptions {
+				t.Fatalf("options = %d, want %d", len(result.Options), tt.wantOptions)
+			}
+			for _, option := range createdOptions {
+				if option.ProjectID == nil || *option.ProjectID != projectID || option.FieldType != domain.FieldType(tt.req.Key) || option.IsSystemDefault {
+					t.Errorf("option = %+v, want a project option with the field key as field type", option)
+				}
+			}
+		})
+	}
+}
+
+func TestCustomFieldService_CreateCustomField_RollsBackOnOptionFailure(t *testing.T) {
+	projectID, ownerID := uuid.New(), uuid.New()
+
+	var deleted uuid.UUID
+	customFieldRepo := &MockCustomFieldRepository{
+		CreateFunc: func(ctx context.Context, field *domain.CustomFieldDefinition) error {
+			field.ID = uuid.New()
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = id
+			return nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		CreateBatchFunc: func(ctx context.Context, fieldOptions []*domain.FieldOption) error {
+			return errors.New("duplicate key")
+		},
+	}
+	s := NewCustomFieldService(customFieldRepo, fieldOptionRepo, customFieldProjectRepo(ownerID, uuid.Nil), nil, zap.NewNop())
+
+	_, err := s.CreateCustomField(context.Background(), projectID, ownerID, &dto.CreateCustomFieldRequest{
+		Key: "component", Name: "컴포넌트", Type: "select",
+		Options: []dto.CustomFieldOptionRequest{{Value: "backend", Label: "백엔드", Color: "#3B82F6"}},
+	})
+
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeInternal {
+		t.Fatalf("expected internal error, got %v", err)
+	}
+	if deleted == uuid.Nil {
+		t.Error("definition should be deleted when its options cannot be created")
+	}
+}
+
+func TestCustomFieldService_UpdateCustomField(t *testing.T) {
+	projectID, ownerID, fieldID := uuid.New(), uuid.New(), uuid.New()
+	name := "담당 컴포넌트"
+
+	tests := []struct {
+		name        string
+		fieldType   domain.CustomFieldType
+		req         dto.UpdateCustomFieldRequest
+		wantErrCode string
+		wantAdded   int
+	}{
+		{
+			name:      "이름 변경과 옵션 추가",
+			fieldType: domain.CustomFieldTypeSelect,
+			req: dto.UpdateCustomFieldRequest{Name: &name,
+				AddOptions: []dto.CustomFieldOptionRequest{{Value: "infra", Label: "인프라", Color: "#F59E0B"}}},
+			wantAdded: 1,
+		},
+		{
+			name:      "이미 있는 옵션 value 추가",
+			fieldType: domain.CustomFieldTypeMultiSelect,
+			req: dto.UpdateCustomFieldRequest{
+				AddOptions: []dto.CustomFieldOptionRequest{{Value: "backend", Label: "백엔드", Color: "#3B82F6"}}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:      "date 필드에 옵션 추가",
+			fieldType: domain.CustomFieldTypeDate,
+			req: dto.UpdateCustomFieldRequest{
+				AddOptions: []dto.CustomFieldOptionRequest{{Value: "q1", Label: "1분기", Color: "#F59E0B"}}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *domain.CustomFieldDefinition
+			customFieldRepo := &MockCustomFieldRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error) {
+					return &domain.CustomFieldDefinition{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID,
+						Key: "component", Name: "컴포넌트", Type: tt.fieldType}, nil
+				},
+				UpdateFunc: func(ctx context.Context, field *domain.CustomFieldDefinition) error {
+					updated = field
+					return nil
+				},
+			}
+			var added []*domain.FieldOption
+			fieldOptionRepo := &MockFieldOptionRepository{
+				FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+					return []*domain.FieldOption{{ProjectID: &pid, FieldType: fieldType, Value: "backend"}}, nil
+				},
+				CreateBatchFunc: func(ctx context.Context, fieldOptions []*domain.FieldOption) error {
+					added = append(added, fieldOptions...)
+					return nil
+				},
+			}
+			s := NewCustomFieldService(customFieldRepo, fieldOptionRepo, customFieldProjectRepo(ownerID, uuid.Nil), nil, zap.NewNop())
+
+			req := tt.req
+			_, err := s.UpdateCustomField(context.Background(), fieldID, ownerID, &req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if updated != nil || len(added) > 0 {
+					t.Error("nothing should be saved when the update is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateCustomField() error = %v", err)
+			}
+			if updated == nil || updated.Name != name || updated.Key != "component" {
+				t.Errorf("updated = %+v, want the name changed and the key kept", updated)
+			}
+			if len(added) != tt.wantAdded {
+				t.Errorf("added options = %d, want %d", len(added), tt.wantAdded)
+			}
+		})
+	}
+}
+
+func TestCustomFieldService_DeleteCustomField(t *testing.T) {
+	projectID, ownerID, memberID, fieldID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	optionIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	for _, tc := range []struct {
+		name        string
+		userID      uuid.UUID
+		wantErrCode string
+	}{
+		{name: "OWNER가 삭제하면 보드 값과 옵션도 제거", userID: ownerID},
+		{name: "MEMBER는 삭제 불가", userID: memberID, wantErrCode: response.ErrCodeForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var removedKey string
+			var deletedField uuid.UUID
+			customFieldRepo := &MockCustomFieldRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error) {
+					return &domain.CustomFieldDefinition{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID,
+						Key: "component", Type: domain.CustomFieldTypeSelect}, nil
+				},
+				RemoveBoardValuesFunc: func(ctx context.Context, pid uuid.UUID, key string) error {
+					removedKey = key
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					deletedField = id
+					return nil
+				},
+			}
+			var deletedOptions []uuid.UUID
+			fieldOptionRepo := &MockFieldOptionRepository{
+				FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+					if fieldType != "component" {
+						t.Errorf("fieldType = %s, want the field key", fieldType)
+					}
+					return []*domain.FieldOption{
+						{BaseModel: domain.BaseModel{ID: optionIDs[0]}}, {BaseModel: domain.BaseModel{ID: optionIDs[1]}},
+					}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					deletedOptions = append(deletedOptions, id)
+					return nil
+				},
+			}
+			s := NewCustomFieldService(customFieldRepo, fieldOptionRepo, customFieldProjectRepo(ownerID, uuid.Nil), nil, zap.NewNop())
+
+			err := s.DeleteCustomField(context.Background(), fieldID, tc.userID)
+
+			if tc.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tc.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tc.wantErrCode, err)
+				}
+				if deletedField != uuid.Nil || removedKey != "" {
+					t.Error("nothing should be deleted when the user is not an admin")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteCustomField() error = %v", err)
+			}
+			if deletedField != fieldID || removedKey != "component" {
+				t.Errorf("deleted field = %s, removed key = %q", deletedField, removedKey)
+			}
+			if len(deletedOptions) != len(optionIDs) {
+				t.Errorf("deleted options = %v, want %v", deletedOptions, optionIDs)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package service
 
-import "project-board-api/internal/domain"
+import (
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
 
 // fieldOptionTemplate represents a template for creating field options
 type fieldOptionTemplate struct {
@@ -36,3 +40,22 @@ func getDefaultFieldOptions() []fieldOptionTemplate {
 		{FieldType: domain.FieldTypeRole, Value: "qa", Label: "QA", Color: "#06B6D4", DisplayOrder: 4},
 	}
 }
+
+// DefaultFieldOptions returns the default field options for a new project
+func DefaultFieldOptions(projectID uuid.UUID) []*domain.FieldOption {
+	templates := getDefaultFieldOptions()
+
+	options := make([]*domain.FieldOption, len(templates))
+	for i, template := range templates {
+		options[i] = &domain.FieldOption{
+			ProjectID:       &projectID,
+			FieldType:       template.FieldType,
+			Value:           template.Value,
+			Label:           template.Label,
+			Color:           template.Color,
+			DisplayOrder:    template.DisplayOrder,
+			IsSystemDefault: false,
+		}
+	}
+	return options
+}
@@ -146,3 +146,31 @@ func (m *MockS3Client) GetFileURL(key string) string {
 	}
 	return "https://mock-s3-url.com/" + key
 }
+
+// MockSearchClient is a mock implementation of SearchClient
+type MockSearchClient struct {
+	SearchMessagesFunc func(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]client.MessageHit, error)
+	SearchFilesFunc    func(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]client.FileHit, error)
+	SearchMembersFunc  func(ctx context.Context, workspaceID uuid.UUID, query string, token string) ([]client.MemberHit, error)
+}
+
+func (m *MockSearchClient) SearchMessages(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]client.MessageHit, error) {
+	if m.SearchMessagesFunc != nil {
+		return m.SearchMessagesFunc(ctx, workspaceID, query, limit, token)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchClient) SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, limit int, token string) ([]client.FileHit, error) {
+	if m.SearchFilesFunc != nil {
+		return m.SearchFilesFunc(ctx, workspaceID, query, limit, token)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchClient) SearchMembers(ctx context.Context, workspaceID uuid.UUID, query string, token string) ([]client.MemberHit, error) {
+	if m.SearchMembersFunc != nil {
+		return m.SearchMembersFunc(ctx, workspaceID, query, token)
+	}
+	return nil, nil
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 )
 
 // MockFieldOptionRepository is a mock implementation of FieldOptionRepository
@@ -170,11 +172,26 @@ func (m *MockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 
 // MockBoardRepository is a mock implementation of BoardRepository
 type MockBoardRepository struct {
-	CreateFunc          func(ctx context.Context, board *domain.Board) error
-	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
-	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
-	UpdateFunc          func(ctx context.Context, board *domain.Board) error
-	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+	CreateFunc                    func(ctx context.Context, board *domain.Board) error
+	FindByIDFunc                  func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindByProjectIDFunc           func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	FindPageByProjectIDFunc       func(ctx context.Context, projectID uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error)
+	FindByIDsFunc                 func(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error)
+	FindByIDsWithParticipantsFunc func(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error)
+	FindColumnOrderFunc           func(ctx context.Context, projectID uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error)
+	MaxPositionFunc               func(ctx context.Context, projectID uuid.UUID) (string, error)
+	FindDueRecurrencesFunc        func(ctx context.Context, now time.Time, limit int) ([]*domain.Board, error)
+	ClaimRecurrenceFunc           func(ctx context.Context, boardID uuid.UUID, due, next time.Time) (bool, error)
+	FindDueSoonFunc               func(ctx context.Context, from, to time.Time, windowMinutes, limit int) ([]*domain.Board, error)
+	ClaimDueReminderFunc          func(ctx context.Context, reminder *domain.BoardDueReminder) (bool, error)
+	UpdateFunc                    func(ctx context.Context, board *domain.Board) error
+	UpdateVersionedFunc           func(ctx context.Context, board *domain.Board, version int) (bool, error)
+	DeleteFunc                    func(ctx context.Context, id uuid.UUID) error
+	FindDeletedFunc               func(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	FindDeletedByIDFunc           func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	RestoreFunc                   func(ctx context.Context, board *domain.Board) (bool, error)
+	FindDeletedBeforeFunc         func(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Board, error)
+	PurgeFunc                     func(ctx context.Context, id uuid.UUID) (bool, error)
 }
 
 func (m *MockBoardRepository) Create(ctx context.Context, board *domain.Board) error {
@@ -198,6 +215,69 @@ func (m *MockBoardRepository) FindByProjectID(ctx context.Context, projectID uui
 	return nil, nil
 }
 
+func (m *MockBoardRepository) FindPageByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
+	if m.FindPageByProjectIDFunc != nil {
+		return m.FindPageByProjectIDFunc(ctx, projectID, filters, state, cursor, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) FindByIDsWithParticipants(ctx context.Context, ids []uuid.UUID) ([]*domain.Board, error) {
+	if m.FindByIDsWithParticipantsFunc != nil {
+		return m.FindByIDsWithParticipantsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) FindColumnOrder(ctx context.Context, projectID uuid.UUID, fieldName, optionID string) ([]uuid.UUID, error) {
+	if m.FindColumnOrderFunc != nil {
+		return m.FindColumnOrderFunc(ctx, projectID, fieldName, optionID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) MaxPosition(ctx context.Context, projectID uuid.UUID) (string, error) {
+	if m.MaxPositionFunc != nil {
+		return m.MaxPositionFunc(ctx, projectID)
+	}
+	return "", nil
+}
+
+func (m *MockBoardRepository) FindDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*domain.Board, error) {
+	if m.FindDueRecurrencesFunc != nil {
+		return m.FindDueRecurrencesFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) ClaimRecurrence(ctx context.Context, boardID uuid.UUID, due, next time.Time) (bool, error) {
+	if m.ClaimRecurrenceFunc != nil {
+		return m.ClaimRecurrenceFunc(ctx, boardID, due, next)
+	}
+	return true, nil
+}
+
+func (m *MockBoardRepository) FindDueSoon(ctx context.Context, from, to time.Time, windowMinutes, limit int) ([]*domain.Board, error) {
+	if m.FindDueSoonFunc != nil {
+		return m.FindDueSoonFunc(ctx, from, to, windowMinutes, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) ClaimDueReminder(ctx context.Context, reminder *domain.BoardDueReminder) (bool, error) {
+	if m.ClaimDueReminderFunc != nil {
+		return m.ClaimDueReminderFunc(ctx, reminder)
+	}
+	return true, nil
+}
+
 func (m *MockBoardRepository) Update(ctx context.Context, board *domain.Board) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, board)
@@ -205,6 +285,14 @@ func (m *MockBoardRepository) Update(ctx context.Context, board *domain.Board) e
 	return nil
 }
 
+func (m *MockBoardRepository) UpdateVersioned(ctx context.Context, board *domain.Board, version int) (bool, error) {
+	if m.UpdateVersionedFunc != nil {
+		return m.UpdateVersionedFunc(ctx, board, version)
+	}
+	board.Version = version + 1
+	return true, nil
+}
+
 func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -212,11 +300,46 @@ func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (m *MockBoardRepository) FindDeleted(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	if m.FindDeletedFunc != nil {
+		return m.FindDeletedFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+	if m.FindDeletedByIDFunc != nil {
+		return m.FindDeletedByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) Restore(ctx context.Context, board *domain.Board) (bool, error) {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, board)
+	}
+	return true, nil
+}
+
+func (m *MockBoardRepository) FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Board, error) {
+	if m.FindDeletedBeforeFunc != nil {
+		return m.FindDeletedBeforeFunc(ctx, cutoff, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) Purge(ctx context.Context, id uuid.UUID) (bool, error) {
+	if m.PurgeFunc != nil {
+		return m.PurgeFunc(ctx, id)
+	}
+	return true, nil
+}
+
 // MockProjectRepository is a mock implementation of ProjectRepository
 type MockProjectRepository struct {
 	CreateFunc                      func(ctx context.Context, project *domain.Project) error
 	FindByIDFunc                    func(ctx context.Context, id uuid.UUID) (*domain.Project, error)
-	FindByWorkspaceIDFunc           func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
+	FindByWorkspaceIDFunc           func(ctx context.Context, workspaceID uuid.UUID, state domain.ArchiveState) ([]*domain.Project, error)
 	FindDefaultByWorkspaceIDFunc    func(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
 	UpdateFunc                      func(ctx context.Context, project *domain.Project) error
 	DeleteFunc                      func(ctx context.Context, id uuid.UUID) error
@@ -232,6 +355,8 @@ type MockProjectRepository struct {
 	FindJoinRequestsByProjectIDFunc func(ctx context.Context, projectID uuid.UUID, status *domain.ProjectJoinRequestStatus) ([]*domain.ProjectJoinRequest, error)
 	FindPendingByProjectAndUserFunc func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectJoinRequest, error)
 	UpdateJoinRequestStatusFunc     func(ctx context.Context, id uuid.UUID, status domain.ProjectJoinRequestStatus) error
+	FindPermissionMatrixFunc        func(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error)
+	SavePermissionMatrixFunc        func(ctx context.Context, matrix *domain.ProjectPermissionMatrix) error
 }
 
 func (m *MockProjectRepository) Create(ctx context.Context, project *domain.Project) error {
@@ -248,9 +373,9 @@ func (m *MockProjectRepository) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return nil, nil
 }
 
-func (m *MockProjectRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
+func (m *MockProjectRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, state domain.ArchiveState) ([]*domain.Project, error) {
 	if m.FindByWorkspaceIDFunc != nil {
-		return m.FindByWorkspaceIDFunc(ctx, workspaceID)
+		return m.FindByWorkspaceIDFunc(ctx, workspaceID, state)
 	}
 	return nil, nil
 }
@@ -360,6 +485,20 @@ func (m *MockProjectRepository) UpdateJoinRequestStatus(ctx context.Context, id
 	return nil
 }
 
+func (m *MockProjectRepository) FindPermissionMatrix(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+	if m.FindPermissionMatrixFunc != nil {
+		return m.FindPermissionMatrixFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectRepository) SavePermissionMatrix(ctx context.Context, matrix *domain.ProjectPermissionMatrix) error {
+	if m.SavePermissionMatrixFunc != nil {
+		return m.SavePermissionMatrixFunc(ctx, matrix)
+	}
+	return nil
+}
+
 // MockParticipantRepository is a mock implementation of ParticipantRepository
 type MockParticipantRepository struct {
 	CreateFunc             func(ctx context.Context, participant *domain.Participant) error
@@ -439,3 +578,399 @@ func (m *MockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error
 	}
 	return nil
 }
+
+// MockSearchRepository is a mock implementation of SearchRepository
+type MockSearchRepository struct {
+	SearchBoardsFunc         func(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]repository.BoardSearchRow, error)
+	SearchCommentsFunc       func(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]repository.CommentSearchRow, error)
+	FullTextSearchBoardsFunc func(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit, offset int) ([]repository.BoardFullTextRow, error)
+}
+
+func (m *MockSearchRepository) SearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]repository.BoardSearchRow, error) {
+	if m.SearchBoardsFunc != nil {
+		return m.SearchBoardsFunc(ctx, workspaceID, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) SearchComments(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]repository.CommentSearchRow, error) {
+	if m.SearchCommentsFunc != nil {
+		return m.SearchCommentsFunc(ctx, workspaceID, userID, query, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockSearchRepository) FullTextSearchBoards(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit, offset int) ([]repository.BoardFullTextRow, error) {
+	if m.FullTextSearchBoardsFunc != nil {
+		return m.FullTextSearchBoardsFunc(ctx, workspaceID, userID, query, limit, offset)
+	}
+	return nil, nil
+}
+
+// MockBackupRepository is a mock implementation of BackupRepository
+type MockBackupRepository struct {
+	LoadWorkspaceFunc    func(ctx context.Context, workspaceID uuid.UUID) (*repository.WorkspaceSnapshot, error)
+	RestoreWorkspaceFunc func(ctx context.Context, snapshot *repository.WorkspaceSnapshot) (*repository.RestoreCounts, error)
+}
+
+func (m *MockBackupRepository) LoadWorkspace(ctx context.Context, workspaceID uuid.UUID) (*repository.WorkspaceSnapshot, error) {
+	if m.LoadWorkspaceFunc != nil {
+		return m.LoadWorkspaceFunc(ctx, workspaceID)
+	}
+	return &repository.WorkspaceSnapshot{}, nil
+}
+
+func (m *MockBackupRepository) RestoreWorkspace(ctx context.Context, snapshot *repository.WorkspaceSnapshot) (*repository.RestoreCounts, error) {
+	if m.RestoreWorkspaceFunc != nil {
+		return m.RestoreWorkspaceFunc(ctx, snapshot)
+	}
+	return &repository.RestoreCounts{}, nil
+}
+
+// MockActivityLogRepository is a mock implementation of ActivityLogRepository
+type MockActivityLogRepository struct {
+	CreateBatchFunc       func(ctx context.Context, logs []*domain.ActivityLog) error
+	FindPageByBoardIDFunc func(ctx context.Context, boardID uuid.UUID, cursor *repository.BoardCursor, limit int) ([]*domain.ActivityLog, error)
+}
+
+func (m *MockActivityLogRepository) CreateBatch(ctx context.Context, logs []*domain.ActivityLog) error {
+	if m.CreateBatchFunc != nil {
+		return m.CreateBatchFunc(ctx, logs)
+	}
+	return nil
+}
+
+func (m *MockActivityLogRepository) FindPageByBoardID(ctx context.Context, boardID uuid.UUID, cursor *repository.BoardCursor, limit int) ([]*domain.ActivityLog, error) {
+	if m.FindPageByBoardIDFunc != nil {
+		return m.FindPageByBoardIDFunc(ctx, boardID, cursor, limit)
+	}
+	return nil, nil
+}
+
+// MockSubtaskRepository is a mock implementation of SubtaskRepository
+type MockSubtaskRepository struct {
+	CreateFunc          func(ctx context.Context, subtask *domain.Subtask) error
+	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.Subtask, error)
+	FindByIDsFunc       func(ctx context.Context, ids []uuid.UUID) ([]*domain.Subtask, error)
+	FindByBoardIDFunc   func(ctx context.Context, boardID uuid.UUID) ([]*domain.Subtask, error)
+	CountByBoardIDsFunc func(ctx context.Context, boardIDs []uuid.UUID) (map[uuid.UUID]repository.SubtaskCount, error)
+	MaxPositionFunc     func(ctx context.Context, boardID uuid.UUID) (string, error)
+	UpdateFunc          func(ctx context.Context, subtask *domain.Subtask) error
+	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockSubtaskRepository) Create(ctx context.Context, subtask *domain.Subtask) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, subtask)
+	}
+	return nil
+}
+
+func (m *MockSubtaskRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Subtask, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockSubtaskRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Subtask, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockSubtaskRepository) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Subtask, error) {
+	if m.FindByBoardIDFunc != nil {
+		return m.FindByBoardIDFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+func (m *MockSubtaskRepository) CountByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) (map[uuid.UUID]repository.SubtaskCount, error) {
+	if m.CountByBoardIDsFunc != nil {
+		return m.CountByBoardIDsFunc(ctx, boardIDs)
+	}
+	return map[uuid.UUID]repository.SubtaskCount{}, nil
+}
+
+func (m *MockSubtaskRepository) MaxPosition(ctx context.Context, boardID uuid.UUID) (string, error) {
+	if m.MaxPositionFunc != nil {
+		return m.MaxPositionFunc(ctx, boardID)
+	}
+	return "", nil
+}
+
+func (m *MockSubtaskRepository) Update(ctx context.Context, subtask *domain.Subtask) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, subtask)
+	}
+	return nil
+}
+
+func (m *MockSubtaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockBoardTemplateRepository is a mock implementation of BoardTemplateRepository
+type MockBoardTemplateRepository struct {
+	CreateFunc          func(ctx context.Context, template *domain.BoardTemplate) error
+	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error)
+	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error)
+	UpdateFunc          func(ctx context.Context, template *domain.BoardTemplate) error
+	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockBoardTemplateRepository) Create(ctx context.Context, template *domain.BoardTemplate) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, template)
+	}
+	return nil
+}
+
+func (m *MockBoardTemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardTemplateRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardTemplateRepository) Update(ctx context.Context, template *domain.BoardTemplate) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, template)
+	}
+	return nil
+}
+
+func (m *MockBoardTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockWatcherRepository is a mock implementation of WatcherRepository
+type MockWatcherRepository struct {
+	WatchFunc                func(ctx context.Context, boardID, userID uuid.UUID) error
+	UnwatchFunc              func(ctx context.Context, boardID, userID uuid.UUID) error
+	FindUserIDsByBoardIDFunc func(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error)
+}
+
+func (m *MockWatcherRepository) Watch(ctx context.Context, boardID, userID uuid.UUID) error {
+	if m.WatchFunc != nil {
+		return m.WatchFunc(ctx, boardID, userID)
+	}
+	return nil
+}
+
+func (m *MockWatcherRepository) Unwatch(ctx context.Context, boardID, userID uuid.UUID) error {
+	if m.UnwatchFunc != nil {
+		return m.UnwatchFunc(ctx, boardID, userID)
+	}
+	return nil
+}
+
+func (m *MockWatcherRepository) FindUserIDsByBoardID(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindUserIDsByBoardIDFunc != nil {
+		return m.FindUserIDsByBoardIDFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+// MockCommentReactionRepository is a mock implementation of CommentReactionRepository
+type MockCommentReactionRepository struct {
+	AddFunc              func(ctx context.Context, commentID, userID uuid.UUID, emoji string) error
+	RemoveFunc           func(ctx context.Context, commentID, userID uuid.UUID, emoji string) error
+	FindByCommentIDsFunc func(ctx context.Context, commentIDs []uuid.UUID) ([]*domain.CommentReaction, error)
+}
+
+func (m *MockCommentReactionRepository) Add(ctx context.Context, commentID, userID uuid.UUID, emoji string) error {
+	if m.AddFunc != nil {
+		return m.AddFunc(ctx, commentID, userID, emoji)
+	}
+	return nil
+}
+
+func (m *MockCommentReactionRepository) Remove(ctx context.Context, commentID, userID uuid.UUID, emoji string) error {
+	if m.RemoveFunc != nil {
+		return m.RemoveFunc(ctx, commentID, userID, emoji)
+	}
+	return nil
+}
+
+func (m *MockCommentReactionRepository) FindByCommentIDs(ctx context.Context, commentIDs []uuid.UUID) ([]*domain.CommentReaction, error) {
+	if m.FindByCommentIDsFunc != nil {
+		return m.FindByCommentIDsFunc(ctx, commentIDs)
+	}
+	return nil, nil
+}
+
+// MockBoardLinkRepository is a mock implementation of BoardLinkRepository
+type MockBoardLinkRepository struct {
+	CreateFunc                func(ctx context.Context, link *domain.BoardLink) error
+	FindByIDFunc              func(ctx context.Context, id uuid.UUID) (*domain.BoardLink, error)
+	FindByBoardIDFunc         func(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error)
+	FindBlocksByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardLink, error)
+	DeleteFunc                func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockBoardLinkRepository) Create(ctx context.Context, link *domain.BoardLink) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, link)
+	}
+	return nil
+}
+
+func (m *MockBoardLinkRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardLink, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardLinkRepository) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.BoardLink, error) {
+	if m.FindByBoardIDFunc != nil {
+		return m.FindByBoardIDFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardLinkRepository) FindBlocksByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardLink, error) {
+	if m.FindBlocksByProjectIDFunc != nil {
+		return m.FindBlocksByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardLinkRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockCustomFieldRepository is a mock implementation of CustomFieldRepository
+type MockCustomFieldRepository struct {
+	CreateFunc            func(ctx context.Context, field *domain.CustomFieldDefinition) error
+	FindByIDFunc          func(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error)
+	FindByProjectIDFunc   func(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error)
+	UpdateFunc            func(ctx context.Context, field *domain.CustomFieldDefinition) error
+	DeleteFunc            func(ctx context.Context, id uuid.UUID) error
+	RemoveBoardValuesFunc func(ctx context.Context, projectID uuid.UUID, key string) error
+}
+
+func (m *MockCustomFieldRepository) Create(ctx context.Context, field *domain.CustomFieldDefinition) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, field)
+	}
+	return nil
+}
+
+func (m *MockCustomFieldRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.CustomFieldDefinition, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomFieldRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomFieldRepository) Update(ctx context.Context, field *domain.CustomFieldDefinition) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, field)
+	}
+	return nil
+}
+
+func (m *MockCustomFieldRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockCustomFieldRepository) RemoveBoardValues(ctx context.Context, projectID uuid.UUID, key string) error {
+	if m.RemoveBoardValuesFunc != nil {
+		return m.RemoveBoardValuesFunc(ctx, projectID, key)
+	}
+	return nil
+}
+
+// MockProjectExportRepository is a mock implementation of ProjectExportRepository
+type MockProjectExportRepository struct {
+	FindFieldOptionsFunc           func(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error)
+	FindBoardsInBatchesFunc        func(ctx context.Context, projectID uuid.UUID, batchSize int, fn func(boards []*domain.Board) error) error
+	FindParticipantsByBoardIDsFunc func(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Participant, error)
+	FindCommentsByBoardIDsFunc     func(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Comment, error)
+	FindAttachmentsByEntityIDsFunc func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) ([]*domain.Attachment, error)
+}
+
+func (m *MockProjectExportRepository) FindFieldOptions(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error) {
+	if m.FindFieldOptionsFunc != nil {
+		return m.FindFieldOptionsFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectExportRepository) FindBoardsInBatches(ctx context.Context, projectID uuid.UUID, batchSize int, fn func(boards []*domain.Board) error) error {
+	if m.FindBoardsInBatchesFunc != nil {
+		return m.FindBoardsInBatchesFunc(ctx, projectID, batchSize, fn)
+	}
+	return nil
+}
+
+func (m *MockProjectExportRepository) FindParticipantsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Participant, error) {
+	if m.FindParticipantsByBoardIDsFunc != nil {
+		return m.FindParticipantsByBoardIDsFunc(ctx, boardIDs)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectExportRepository) FindCommentsByBoardIDs(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Comment, error) {
+	if m.FindCommentsByBoardIDsFunc != nil {
+		return m.FindCommentsByBoardIDsFunc(ctx, boardIDs)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectExportRepository) FindAttachmentsByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) ([]*domain.Attachment, error) {
+	if m.FindAttachmentsByEntityIDsFunc != nil {
+		return m.FindAttachmentsByEntityIDsFunc(ctx, entityType, entityIDs)
+	}
+	return nil, nil
+}
+
+// MockProjectStatsRepository is a mock implementation of ProjectStatsRepository
+type MockProjectStatsRepository struct {
+	FindBoardsFunc       func(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	FindStageChangesFunc func(ctx context.Context, projectID uuid.UUID, until time.Time) ([]*domain.ActivityLog, error)
+}
+
+func (m *MockProjectStatsRepository) FindBoards(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	if m.FindBoardsFunc != nil {
+		return m.FindBoardsFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectStatsRepository) FindStageChanges(ctx context.Context, projectID uuid.UUID, until time.Time) ([]*domain.ActivityLog, error) {
+	if m.FindStageChangesFunc != nil {
+		return m.FindStageChangesFunc(ctx, projectID, until)
+	}
+	return nil, nil
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// projectExportBatchSize is the number of boards loaded (with their comments, participants and attachments) per query round
+const projectExportBatchSize = 200
+
+// ProjectExportService defines the interface for exporting a project for backup or migration
+type ProjectExportService interface {
+	// ExportProject checks the project and permission; the returned export writes the file when WriteTo is called
+	ExportProject(ctx context.Context, projectID, userID uuid.UUID, format dto.ExportFormat) (*ProjectExport, error)
+}
+
+// ProjectExport is a prepared project export.
+// 검증은 ExportProject에서 끝나므로 응답 헤더를 쓴 뒤 WriteTo 중에 실패하면 파일이 잘린 채로 끝납니다.
+type ProjectExport struct {
+	Filename    string
+	ContentType string
+	WriteTo     func(ctx context.Context, w io.Writer) error
+}
+
+// projectExportServiceImpl is the implementation of ProjectExportService
+type projectExportServiceImpl struct {
+	exportRepo           repository.ProjectExportRepository
+	projectRepo          repository.ProjectRepository
+	customFieldRepo      repository.CustomFieldRepository
+	fieldOptionConverter FieldOptionConverter
+	logger               *zap.Logger
+}
+
+// NewProjectExportService creates a new instance of ProjectExportService
+func NewProjectExportService(exportRepo repository.ProjectExportRepository, projectRepo repository.ProjectRepository, customFieldRepo repository.CustomFieldRepository, fieldOptionConverter FieldOptionConverter, logger *zap.Logger) ProjectExportService {
+	return &projectExportServiceImpl{
+		exportRepo:           exportRepo,
+		projectRepo:          projectRepo,
+		customFieldRepo:      customFieldRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		logger:               logger,
+	}
+}
+
+// ExportProject prepares an export of the project's boards, comments, participants and attachment metadata.
+// 프로젝트 멤버만 내보낼 수 있습니다. Board는 배치 단위로 읽어 바로 쓰므로 큰 프로젝트도 메모리에 모두 올리지 않습니다.
+func (s *projectExportServiceImpl) ExportProject(ctx context.Context, projectID, userID uuid.UUID, format dto.ExportFormat) (*ProjectExport, error) {
+	var contentType string
+	switch format {
+	case dto.ExportFormatJSON:
+		contentType = "application/json; charset=utf-8"
+	case dto.ExportFormatCSV:
+		contentType = "text/csv; charset=utf-8"
+	default:
+		return nil, response.NewValidationError(fmt.Sprintf("Unsupported export format: %s", format), "format must be json or csv")
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to verify project", err.Error())
+	}
+	if _, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return nil, response.NewInternalError("Failed to check membership", err.Error())
+	}
+
+	// JSON 헤더(멤버, 필드 옵션, 커스텀 필드)는 응답을 시작하기 전에 읽어 실패를 에러 응답으로 돌려줌
+	var header *dto.ProjectExportHeader
+	if format == dto.ExportFormatJSON {
+		if header, err = s.exportHeader(ctx, project); err != nil {
+			return nil, response.NewInternalError("Failed to export project", err.Error())
+		}
+	}
+
+	return &ProjectExport{
+		Filename:    fmt.Sprintf("project-%s-%s.%s", project.ID, time.Now().UTC().Format("20060102"), format),
+		ContentType: contentType,
+		WriteTo: func(ctx context.Context, w io.Writer) error {
+			var out projectExportWriter = newCSVProjectExportWriter(w)
+			if header != nil {
+				out = &jsonProjectExportWriter{w: w, header: header}
+			}
+			return s.writeBoards(ctx, project, out)
+		},
+	}, nil
+}
+
+// writeBoards streams the project's boards through out, one batch at a time
+func (s *projectExportServiceImpl) writeBoards(ctx context.Context, project *domain.Project, out projectExportWriter) error {
+	log := commnotel.WithTraceContext(ctx, s.logger)
+
+	count := 0
+	if err := out.begin(); err != nil {
+		return err
+	}
+	err := s.exportRepo.FindBoardsInBatches(ctx, project.ID, projectExportBatchSize, func(boards []*domain.Board) error {
+		exports, err := s.exportBoards(ctx, boards)
+		if err != nil {
+			return err
+		}
+		for _, board := range exports {
+			if err := out.writeBoard(board); err != nil {
+				return err
+			}
+		}
+		count += len(exports)
+		return out.flush()
+	})
+	if err != nil {
+		log.Error("Project export aborted",
+			zap.String("project.id", project.ID.String()),
+			zap.Int("board.count", count),
+			zap.Error(err))
+		return err
+	}
+	if err := out.end(); err != nil {
+		return err
+	}
+
+	log.Info("Project exported",
+		zap.String("project.id", project.ID.String()),
+		zap.Int("board.count", count))
+	return nil
+}
+
+// exportHeader loads the project-level data written before the boards of a JSON export
+func (s *projectExportServiceImpl) exportHeader(ctx context.Context, project *domain.Project) (*dto.ProjectExportHeader, error) {
+	members, err := s.projectRepo.FindMembersByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch project members: %w", err)
+	}
+	options, err := s.exportRepo.FindFieldOptions(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch field options: %w", err)
+	}
+	fields, err := s.customFieldRepo.FindByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom fields: %w", err)
+	}
+
+	header := &dto.ProjectExportHeader{
+		FormatVersion: dto.ProjectExportFormatVersion,
+		ExportedAt:    time.Now().UTC(),
+		Project: dto.ProjectBackup{
+			ID: project.ID, OwnerID: project.OwnerID, Name: project.Name, Description: project.Description,
+			StartDate: project.StartDate, DueDate: project.DueDate, IsDefault: project.IsDefault, IsPublic: project.IsPublic,
+			CreatedAt: project.CreatedAt, UpdatedAt: project.UpdatedAt,
+		},
+		Members:      make([]dto.ProjectMemberBackup, len(members)),
+		FieldOptions: make([]dto.FieldOptionBackup, len(options)),
+		CustomFields: make([]dto.CustomFieldExport, len(fields)),
+	}
+	for i, m := range members {
+		header.Members[i] = dto.ProjectMemberBackup{
+			ID: m.ID, ProjectID: m.ProjectID, UserID: m.UserID, RoleName: string(m.RoleName), JoinedAt: m.JoinedAt,
+		}
+	}
+	for i, o := range options {
+		header.FieldOptions[i] = dto.FieldOptionBackup{
+			ID: o.ID, ProjectID: project.ID, FieldType: string(o.FieldType), Value: o.Value, Label: o.Label,
+			Color: o.Color, DisplayOrder: o.DisplayOrder, CreatedAt: o.CreatedAt, UpdatedAt: o.UpdatedAt,
+		}
+	}
+	for i, f := range fields {
+		header.CustomFields[i] = dto.CustomFieldExport{Key: f.Key, Name: f.Name, Type: string(f.Type), DisplayOrder: f.DisplayOrder}
+	}
+	return header, nil
+}
+
+// exportBoards loads the participants, comments and attachments of a batch of boards
+func (s *projectExportServiceImpl) exportBoards(ctx context.Context, boards []*domain.Board) ([]*dto.BoardExport, error) {
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
+	}
+
+	participants, err := s.exportRepo.FindParticipantsByBoardIDs(ctx, boardIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch participants: %w", err)
+	}
+	comments, err := s.exportRepo.FindCommentsByBoardIDs(ctx, boardIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch comments: %w", err)
+	}
+	commentIDs := make([]uuid.UUID, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+	}
+	boardAttachments, err := s.exportRepo.FindAttachmentsByEntityIDs(ctx, domain.EntityTypeBoard, boardIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch board attachments: %w", err)
+	}
+	commentAttachments, err := s.exportRepo.FindAttachmentsByEntityIDs(ctx, domain.EntityTypeComment, commentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch comment attachments: %w", err)
+	}
+	// 옵션 ID를 value로 변환 (다른 워크스페이스에서는 ID가 의미 없음)
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, fmt.Errorf("failed to convert custom fields: %w", err)
+	}
+
+	attachmentsOf := groupExportAttachments(append(boardAttachments, commentAttachments...))
+	exports := make([]*dto.BoardExport, len(boards))
+	byBoard := make(map[uuid.UUID]*dto.BoardExport, len(boards))
+	for i, b := range boards {
+		export := &dto.BoardExport{
+			ID: b.ID, AuthorID: b.AuthorID, AssigneeID: b.AssigneeID, Title: b.Title, Content: b.Content,
+			StartDate: b.StartDate, DueDate: b.DueDate, Position: b.Position, CreatedAt: b.CreatedAt, UpdatedAt: b.UpdatedAt,
+			Participants: []dto.ParticipantBackup{},
+			Comments:     []dto.CommentExport{},
+			Attachments:  attachmentsOf[b.ID],
+		}
+		if len(b.CustomFields) > 0 {
+			_ = json.Unmarshal(b.CustomFields, &export.CustomFields)
+		}
+		exports[i] = export
+		byBoard[b.ID] = export
+	}
+	for _, p := range participants {
+		if export, ok := byBoard[p.BoardID]; ok {
+			export.Participants = append(export.Participants, dto.ParticipantBackup{ID: p.ID, BoardID: p.BoardID, UserID: p.UserID, CreatedAt: p.CreatedAt})
+		}
+	}
+	for _, c := range comments {
+		if export, ok := byBoard[c.BoardID]; ok {
+			export.Comments = append(export.Comments, dto.CommentExport{
+				ID: c.ID, UserID: c.UserID, ParentCommentID: c.ParentCommentID, Content: c.Content,
+				CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt, Attachments: attachmentsOf[c.ID],
+			})
+		}
+	}
+	return exports, nil
+}
+
+// groupExportAttachments groups attachment metadata by the board or comment they belong to
+func groupExportAttachments(attachments []*domain.Attachment) map[uuid.UUID][]dto.AttachmentExport {
+	grouped := make(map[uuid.UUID][]dto.AttachmentExport)
+	for _, a := range attachments {
+		if a.EntityID == nil {
+			continue
+		}
+		grouped[*a.EntityID] = append(grouped[*a.EntityID], dto.AttachmentExport{
+			ID: a.ID, FileName: a.FileName, FileKey: a.FileURL, FileSize: a.FileSize,
+			ContentType: a.ContentType, UploadedBy: a.UploadedBy, CreatedAt: a.CreatedAt,
+		})
+	}
+	return grouped
+}
+
+// projectExportWriter writes boards in one export format as they are loaded
+type projectExportWriter interface {
+	begin() error
+	writeBoard(board *dto.BoardExport) error
+	flush() error
+	end() error
+}
+
+// flushWriter is implemented by writers that can push buffered bytes to the client (http.ResponseWriter)
+type flushWriter interface {
+	Flush()
+}
+
+func flushIfPossible(w io.Writer) {
+	if f, ok := w.(flushWriter); ok {
+		f.Flush()
+	}
+}
+
+// jsonProjectExportWriter writes one JSON object: the header fields followed by a "boards" array written board by board
+type jsonProjectExportWriter struct {
+	w      io.Writer
+	header *dto.ProjectExportHeader
+	count  int
+}
+
+func (j *jsonProjectExportWriter) begin() error {
+	head, err := json.Marshal(j.header)
+	if err != nil {
+		return err
+	}
+	// 헤더 객체의 닫는 중괄호 대신 boards 배열을 이어 씀
+	if _, err := j.w.Write(head[:len(head)-1]); err != nil {
+		return err
+	}
+	_, err = io.WriteString(j.w, `,"boards":[`)
+	return err
+}
+
+func (j *jsonProjectExportWriter) writeBoard(board *dto.BoardExport) error {
+	data, err := json.Marshal(board)
+	if err != nil {
+		return err
+	}
+	if j.count > 0 {
+		if _, err := io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	j.count++
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonProjectExportWriter) flush() error {
+	flushIfPossible(j.w)
+	return nil
+}
+
+func (j *jsonProjectExportWriter) end() error {
+	_, err := io.WriteString(j.w, "]}\n")
+	return err
+}
+
+// projectExportCSVHeader is the header row of a CSV export.
+// 한 행이 하나의 레코드이며 recordType(board, participant, comment, attachment)에 따라 쓰는 열이 다릅니다.
+// 각 board 행 뒤에 그 Board의 참여자, 댓글, 첨부파일 행이 이어집니다 (attachment의 parentId는 Board 또는 댓글 ID).
+var projectExportCSVHeader = []string{
+	"recordType", "id", "boardId", "parentId", "userId", "title", "content", "assigneeId", "customFields",
+	"startDate", "dueDate", "position", "fileName", "fileKey", "fileSize", "contentType", "createdAt", "updatedAt",
+}
+
+// csvProjectExportWriter writes a CSV export
+type csvProjectExportWriter struct {
+	w   io.Writer
+	csv *csv.Writer
+}
+
+func newCSVProjectExportWriter(w io.Writer) *csvProjectExportWriter {
+	return &csvProjectExportWriter{w: w, csv: csv.NewWriter(w)}
+}
+
+func (c *csvProjectExportWriter) begin() error {
+	return c.csv.Write(projectExportCSVHeader)
+}
+
+func (c *csvProjectExportWriter) writeBoard(board *dto.BoardExport) error {
+	var customFields string
+	if len(board.CustomFields) > 0 {
+		data, err := json.Marshal(board.CustomFields)
+		if err != nil {
+			return err
+		}
+		customFields = string(data)
+	}
+	records := [][]string{exportCSVRecord(map[string]string{
+		"recordType": "board", "id": board.ID.String(), "boardId": board.ID.String(), "userId": board.AuthorID.String(),
+		"title": board.Title, "content": board.Content, "assigneeId": optionalUUID(board.AssigneeID), "customFields": customFields,
+		"startDate": optionalTime(board.StartDate), "dueDate": optionalTime(board.DueDate), "position": board.Position,
+		"createdAt": board.CreatedAt.Format(time.RFC3339), "updatedAt": board.UpdatedAt.Format(time.RFC3339),
+	})}
+	for _, p := range board.Participants {
+		records = append(records, exportCSVRecord(map[string]string{
+			"recordType": "participant", "id": p.ID.String(), "boardId": board.ID.String(), "userId": p.UserID.String(),
+			"createdAt": p.CreatedAt.Format(time.RFC3339),
+		}))
+	}
+	attachments := func(parentID uuid.UUID, list []dto.AttachmentExport) {
+		for _, a := range list {
+			records = append(records, exportCSVRecord(map[string]string{
+				"recordType": "attachment", "id": a.ID.String(), "boardId": board.ID.String(), "parentId": parentID.String(),
+				"userId": a.UploadedBy.String(), "fileName": a.FileName, "fileKey": a.FileKey,
+				"fileSize": strconv.FormatInt(a.FileSize, 10), "contentType": a.ContentType, "createdAt": a.CreatedAt.Format(time.RFC3339),
+			}))
+		}
+	}
+	attachments(board.ID, board.Attachments)
+	for _, comment := range board.Comments {
+		records = append(records, exportCSVRecord(map[string]string{
+			"recordType": "comment", "id": comment.ID.String(), "boardId": board.ID.String(), "parentId": optionalUUID(comment.ParentCommentID),
+			"userId": comment.UserID.String(), "content": comment.Content,
+			"createdAt": comment.CreatedAt.Format(time.RFC3339), "updatedAt": comment.UpdatedAt.Format(time.RFC3339),
+		}))
+		attachments(comment.ID, comment.Attachments)
+	}
+	for _, record := range records {
+		if err := c.csv.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *csvProjectExportWriter) flush() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return err
+	}
+	flushIfPossible(c.w)
+	return nil
+}
+
+func (c *csvProjectExportWriter) end() error {
+	return c.flush()
+}
+
+// exportCSVRecord places named values into the columns of projectExportCSVHeader
+func exportCSVRecord(values map[string]string) []string {
+	record := make([]string, len(projectExportCSVHeader))
+	for i, column := range projectExportCSVHeader {
+		record[i] = values[column]
+	}
+	return record
+}
+
+func optionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
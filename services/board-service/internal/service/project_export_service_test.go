@@ -0,0 +1,194 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// newExportTestService returns an export service over two batches of boards;
+// 첫 Board에는 참여자, 답글이 달린 댓글, Board/댓글 첨부파일이 있음
+func newExportTestService(projectID, userID uuid.UUID) (ProjectExportService, []*domain.Board) {
+	boardID, commentID, replyID := uuid.New(), uuid.New(), uuid.New()
+	boards := []*domain.Board{
+		{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, Title: "로그인 구현", Position: "a",
+			CustomFields: []byte(`{"stage":"` + uuid.NewString() + `"}`)},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "배포", Position: "b"},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "회고", Content: "잘한 점, \"아쉬운 점\"", Position: "c"},
+	}
+
+	exportRepo := &MockProjectExportRepository{
+		FindBoardsInBatchesFunc: func(ctx context.Context, pid uuid.UUID, batchSize int, fn func(boards []*domain.Board) error) error {
+			if err := fn(boards[:2]); err != nil {
+				return err
+			}
+			return fn(boards[2:])
+		},
+		FindParticipantsByBoardIDsFunc: func(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Participant, error) {
+			return []*domain.Participant{{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID}}, nil
+		},
+		FindCommentsByBoardIDsFunc: func(ctx context.Context, boardIDs []uuid.UUID) ([]*domain.Comment, error) {
+			if boardIDs[0] != boardID {
+				return nil, nil
+			}
+			return []*domain.Comment{
+				{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID, UserID: userID, Content: "확인 부탁드립니다"},
+				{BaseModel: domain.BaseModel{ID: replyID}, BoardID: boardID, UserID: userID, ParentCommentID: &commentID, Content: "확인했습니다"},
+			}, nil
+		},
+		FindAttachmentsByEntityIDsFunc: func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) ([]*domain.Attachment, error) {
+			entityID := boardID
+			if entityType == domain.EntityTypeComment {
+				if len(entityIDs) == 0 {
+					return nil, nil
+				}
+				entityID = replyID
+			} else if entityIDs[0] != boardID {
+				return nil, nil
+			}
+			return []*domain.Attachment{{BaseModel: domain.BaseModel{ID: uuid.New()}, EntityType: entityType, EntityID: &entityID,
+				FileName: "spec.pdf", FileURL: "board/boards/spec.pdf", FileSize: 1024, ContentType: "application/pdf"}}, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}, Name: "웹 리뉴얼"}, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			if uid != userID {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+		FindMembersByProjectIDFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.ProjectMember, error) {
+			return []*domain.ProjectMember{{ProjectID: pid, UserID: userID, RoleName: domain.ProjectRoleMember}}, nil
+		},
+	}
+	converter := &MockFieldOptionConverter{
+		ConvertIDsToValuesBatchFunc: func(ctx context.Context, boards []*domain.Board) error {
+			for _, board := range boards {
+				if len(board.CustomFields) > 0 {
+					board.CustomFields = []byte(`{"stage":"in_progress"}`)
+				}
+			}
+			return nil
+		},
+	}
+	return NewProjectExportService(exportRepo, projectRepo, &MockCustomFieldRepository{}, converter, zap.NewNop()), boards
+}
+
+func TestProjectExportService_ExportJSON(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s, boards := newExportTestService(projectID, userID)
+
+	export, err := s.ExportProject(context.Background(), projectID, userID, dto.ExportFormatJSON)
+	if err != nil {
+		t.Fatalf("ExportProject() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := export.WriteTo(context.Background(), &buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	var doc struct {
+		dto.ProjectExportHeader
+		Boards []dto.BoardExport `json:"boards"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if doc.FormatVersion != dto.ProjectExportFormatVersion || doc.Project.Name != "웹 리뉴얼" || len(doc.Members) != 1 {
+		t.Errorf("header = %+v", doc.ProjectExportHeader)
+	}
+	if len(doc.Boards) != len(boards) {
+		t.Fatalf("boards = %d, want %d", len(doc.Boards), len(boards))
+	}
+
+	first := doc.Boards[0]
+	if first.CustomFields["stage"] != "in_progress" {
+		t.Errorf("customFields = %v, want option values", first.CustomFields)
+	}
+	if len(first.Participants) != 1 || len(first.Comments) != 2 || len(first.Attachments) != 1 {
+		t.Fatalf("first board = %+v", first)
+	}
+	if first.Comments[1].ParentCommentID == nil || len(first.Comments[1].Attachments) != 1 {
+		t.Errorf("reply = %+v, want the parent comment and its attachment", first.Comments[1])
+	}
+	if doc.Boards[1].Comments == nil || len(doc.Boards[1].Comments) != 0 {
+		t.Errorf("board without comments = %+v, want an empty list", doc.Boards[1].Comments)
+	}
+}
+
+func TestProjectExportService_ExportCSV(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s, boards := newExportTestService(projectID, userID)
+
+	export, err := s.ExportProject(context.Background(), projectID, userID, dto.ExportFormatCSV)
+	if err != nil {
+		t.Fatalf("ExportProject() error = %v", err)
+	}
+	if export.ContentType != "text/csv; charset=utf-8" {
+		t.Errorf("content type = %s", export.ContentType)
+	}
+	var buf bytes.Buffer
+	if err := export.WriteTo(context.Background(), &buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v", err)
+	}
+	var types []string
+	for _, row := range rows[1:] {
+		types = append(types, row[0])
+	}
+	want := []string{"board", "participant", "attachment", "comment", "comment", "attachment", "board", "board"}
+	if len(types) != len(want) {
+		t.Fatalf("record types = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("record types = %v, want %v", types, want)
+		}
+	}
+	last := rows[len(rows)-1]
+	if last[1] != boards[2].ID.String() || last[6] != boards[2].Content {
+		t.Errorf("last board row = %q", last)
+	}
+}
+
+func TestProjectExportService_RejectsInvalidRequests(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s, _ := newExportTestService(projectID, userID)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		format dto.ExportFormat
+		code   string
+	}{
+		{"지원하지 않는 형식", userID, "xml", response.ErrCodeValidation},
+		{"프로젝트 멤버가 아님", uuid.New(), dto.ExportFormatJSON, response.ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ExportProject(context.Background(), projectID, tt.userID, tt.format)
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.code {
+				t.Errorf("ExportProject() error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
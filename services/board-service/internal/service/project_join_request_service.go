@@ -62,7 +62,7 @@ func (s *projectJoinRequestServiceImpl) CreateJoinRequest(ctx context.Context, p
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
 	if isMember {
-		return nil, response.NewAppError("ALREADY_MEMBER", "User is already a member of this project", "")
+		return nil, response.NewAppError(response.ErrCodeAlreadyMember, "User is already a member of this project", "")
 	}
 
 	// Check if user already has a pending join request
@@ -71,7 +71,7 @@ func (s *projectJoinRequestServiceImpl) CreateJoinRequest(ctx context.Context, p
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check pending requests", err.Error())
 	}
 	if pendingRequest != nil {
-		return nil, response.NewAppError("PENDING_REQUEST_EXISTS", "User already has a pending join request for this project", "")
+		return nil, response.NewAppError(response.ErrCodePendingRequestExists, "User already has a pending join request for this project", "")
 	}
 
 	// Create join request
@@ -100,16 +100,9 @@ func (s *projectJoinRequestServiceImpl) CreateJoinRequest(ctx context.Context, p
 
 // GetJoinRequests retrieves join requests for a project with authorization checks
 func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, projectID, userID uuid.UUID, status *string, token string) ([]*dto.ProjectJoinRequestResponse, error) {
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return nil, response.NewForbiddenError("Only project owner or admin can view join requests", "")
+	// Check if requester's role can manage join requests
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionInvite); err != nil {
+		return nil, err
 	}
 
 	// Fetch project to get workspace ID
@@ -180,16 +173,9 @@ func (s *projectJoinRequestServiceImpl) UpdateJoinRequest(ctx context.Context, r
 		return nil, response.NewValidationError("Join request has already been processed", "")
 	}
 
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, joinRequest.ProjectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return nil, response.NewForbiddenError("Only project owner or admin can update join requests", "")
+	// Check if requester's role can manage join requests
+	if _, err := authorize(ctx, s.projectRepo, joinRequest.ProjectID, userID, domain.PermissionInvite); err != nil {
+		return nil, err
 	}
 
 	// Update join request status
@@ -86,16 +86,9 @@ func (s *projectMemberServiceImpl) GetMembers(ctx context.Context, projectID, us
 
 // RemoveMember removes a member from a project with authorization checks
 func (s *projectMemberServiceImpl) RemoveMember(ctx context.Context, projectID, requesterID, memberID uuid.UUID) error {
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, requesterID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return response.NewForbiddenError("Only project owner or admin can remove members", "")
+	// Check if requester's role can remove members
+	if _, err := authorize(ctx, s.projectRepo, projectID, requesterID, domain.PermissionRemoveMembers); err != nil {
+		return err
 	}
 
 	// Fetch the member to be removed
@@ -127,16 +120,9 @@ func (s *projectMemberServiceImpl) RemoveMember(ctx context.Context, projectID,
 
 // UpdateMemberRole updates a member's role with authorization checks
 func (s *projectMemberServiceImpl) UpdateMemberRole(ctx context.Context, projectID, requesterID, memberID uuid.UUID, role string) (*dto.ProjectMemberResponse, error) {
-	// Check if requester is OWNER
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, requesterID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner {
-		return nil, response.NewForbiddenError("Only project owner can change member roles", "")
+	// Check if requester can change roles (owner only)
+	if _, err := authorize(ctx, s.projectRepo, projectID, requesterID, domain.PermissionManageRoles); err != nil {
+		return nil, err
 	}
 
 	// Validate role
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// permissionActions describes each permission in forbidden error messages
+var permissionActions = map[domain.ProjectPermission]string{
+	domain.PermissionUpdateProject:   "update this project",
+	domain.PermissionDeleteBoard:     "delete boards",
+	domain.PermissionManageFields:    "manage custom fields",
+	domain.PermissionManageTemplates: "manage board templates",
+	domain.PermissionInvite:          "manage join requests",
+	domain.PermissionRemoveMembers:   "remove members",
+	domain.PermissionDeleteProject:   "delete this project",
+	domain.PermissionManageRoles:     "change member roles or permissions",
+}
+
+// authorize returns the user's membership, or a forbidden error unless the project's permission matrix
+// grants the user's role the permission. 역할 기반 권한 검사는 모두 이 함수를 거칩니다.
+func authorize(ctx context.Context, projectRepo repository.ProjectRepository, projectID, userID uuid.UUID, permission domain.ProjectPermission) (*domain.ProjectMember, error) {
+	member, err := projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return nil, response.NewInternalError("Failed to check membership", err.Error())
+	}
+	if member == nil {
+		return nil, response.NewForbiddenError("You are not a member of this project", "")
+	}
+	if member.RoleName == domain.ProjectRoleOwner {
+		return member, nil
+	}
+
+	overrides, err := loadPermissionOverrides(ctx, projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.RoleHasPermission(overrides, member.RoleName, permission) {
+		return nil, response.NewForbiddenError(
+			fmt.Sprintf("Your project role is not allowed to %s", permissionActions[permission]),
+			string(permission),
+		)
+	}
+	return member, nil
+}
+
+// loadPermissionOverrides returns the permissions the project changed from the defaults
+func loadPermissionOverrides(ctx context.Context, projectRepo repository.ProjectRepository, projectID uuid.UUID) (map[domain.ProjectPermission][]domain.ProjectRole, error) {
+	matrix, err := projectRepo.FindPermissionMatrix(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch project permissions", err.Error())
+	}
+	overrides, err := matrix.Overrides()
+	if err != nil {
+		return nil, response.NewInternalError("Failed to decode project permissions", err.Error())
+	}
+	return overrides, nil
+}
+
+// ProjectPermissionService defines the interface for viewing and customizing a project's permission matrix
+type ProjectPermissionService interface {
+	GetPermissions(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectPermissionsResponse, error)
+	UpdatePermissions(ctx context.Context, projectID, userID uuid.UUID, req *dto.UpdateProjectPermissionsRequest) (*dto.ProjectPermissionsResponse, error)
+}
+
+// projectPermissionServiceImpl is the implementation of ProjectPermissionService
+type projectPermissionServiceImpl struct {
+	projectRepo repository.ProjectRepository
+	logger      *zap.Logger
+}
+
+// NewProjectPermissionService creates a new instance of ProjectPermissionService
+func NewProjectPermissionService(projectRepo repository.ProjectRepository, logger *zap.Logger) ProjectPermissionService {
+	return &projectPermissionServiceImpl{
+		projectRepo: projectRepo,
+		logger:      logger,
+	}
+}
+
+// GetPermissions returns the effective permission matrix of a project; any member can view it
+func (s *projectPermissionServiceImpl) GetPermissions(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectPermissionsResponse, error) {
+	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewInternalError("Failed to check membership", err.Error())
+	}
+	if member == nil {
+		return nil, response.NewForbiddenError("You are not a member of this project", "")
+	}
+
+	matrix, err := s.projectRepo.FindPermissionMatrix(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch project permissions", err.Error())
+	}
+	return toProjectPermissionsResponse(projectID, member.RoleName, matrix)
+}
+
+// UpdatePermissions changes which roles hold the given permissions; only the project owner can customize the matrix
+func (s *projectPermissionServiceImpl) UpdatePermissions(ctx context.Context, projectID, userID uuid.UUID, req *dto.UpdateProjectPermissionsRequest) (*dto.ProjectPermissionsResponse, error) {
+	member, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Permissions) == 0 {
+		return nil, response.NewValidationError("permissions must not be empty", "")
+	}
+
+	overrides, err := loadPermissionOverrides(ctx, s.projectRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if overrides == nil {
+		overrides = make(map[domain.ProjectPermission][]domain.ProjectRole, len(req.Permissions))
+	}
+	for key, roleNames := range req.Permissions {
+		permission := domain.ProjectPermission(key)
+		if !permission.IsCustomizable() {
+			return nil, response.NewValidationError("Permission cannot be customized", key)
+		}
+		roles := make([]domain.ProjectRole, 0, len(roleNames))
+		seen := make(map[domain.ProjectRole]bool, len(roleNames))
+		for _, name := range roleNames {
+			role := domain.ProjectRole(name)
+			switch role {
+			case domain.ProjectRoleOwner:
+				continue // OWNER는 항상 모든 권한을 가짐
+			case domain.ProjectRoleAdmin, domain.ProjectRoleMember:
+			default:
+				return nil, response.NewValidationError("Invalid role", fmt.Sprintf("%s: %s", key, name))
+			}
+			if !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+		overrides[permission] = roles
+	}
+
+	permissions, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to encode project permissions", err.Error())
+	}
+	matrix := &domain.ProjectPermissionMatrix{
+		ProjectID:   projectID,
+		Permissions: permissions,
+		UpdatedBy:   userID,
+	}
+	if err := s.projectRepo.SavePermissionMatrix(ctx, matrix); err != nil {
+		return nil, response.NewInternalError("Failed to save project permissions", err.Error())
+	}
+
+	s.logger.Info("Project permissions updated",
+		zap.String("project_id", projectID.String()),
+		zap.String("user_id", userID.String()),
+		zap.Int("permission_count", len(req.Permissions)))
+
+	return toProjectPermissionsResponse(projectID, member.RoleName, matrix)
+}
+
+// toProjectPermissionsResponse resolves every permission against the defaults and the project's overrides
+func toProjectPermissionsResponse(projectID uuid.UUID, myRole domain.ProjectRole, matrix *domain.ProjectPermissionMatrix) (*dto.ProjectPermissionsResponse, error) {
+	overrides, err := matrix.Overrides()
+	if err != nil {
+		return nil, response.NewInternalError("Failed to decode project permissions", err.Error())
+	}
+
+	res := &dto.ProjectPermissionsResponse{
+		ProjectID:     projectID,
+		Permissions:   make(map[string][]string, len(domain.ProjectPermissions)),
+		Customizable:  []string{},
+		MyRole:        string(myRole),
+		MyPermissions: []string{},
+	}
+	roles := []domain.ProjectRole{domain.ProjectRoleOwner, domain.ProjectRoleAdmin, domain.ProjectRoleMember}
+	for _, permission := range domain.ProjectPermissions {
+		holders := []string{}
+		for _, role := range roles {
+			if domain.RoleHasPermission(overrides, role, permission) {
+				holders = append(holders, string(role))
+			}
+		}
+		res.Permissions[string(permission)] = holders
+		if permission.IsCustomizable() {
+			res.Customizable = append(res.Customizable, string(permission))
+		}
+		if domain.RoleHasPermission(overrides, myRole, permission) {
+			res.MyPermissions = append(res.MyPermissions, string(permission))
+		}
+	}
+	if matrix != nil {
+		res.UpdatedBy = &matrix.UpdatedBy
+		res.UpdatedAt = &matrix.UpdatedAt
+	}
+	return res, nil
+}
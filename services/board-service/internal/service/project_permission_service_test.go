@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// newPermissionTestRepo returns a project repository whose members have the given roles and whose
+// permission matrix is kept in memory
+func newPermissionTestRepo(roles map[uuid.UUID]domain.ProjectRole) *MockProjectRepository {
+	var saved *domain.ProjectPermissionMatrix
+	return &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+			role, ok := roles[userID]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.ProjectMember{ProjectID: projectID, UserID: userID, RoleName: role}, nil
+		},
+		FindPermissionMatrixFunc: func(ctx context.Context, projectID uuid.UUID) (*domain.ProjectPermissionMatrix, error) {
+			return saved, nil
+		},
+		SavePermissionMatrixFunc: func(ctx context.Context, matrix *domain.ProjectPermissionMatrix) error {
+			saved = matrix
+			return nil
+		},
+	}
+}
+
+func TestAuthorize_DefaultMatrixKeepsRoleChecks(t *testing.T) {
+	projectID := uuid.New()
+	owner, admin, member := uuid.New(), uuid.New(), uuid.New()
+	repo := newPermissionTestRepo(map[uuid.UUID]domain.ProjectRole{
+		owner: domain.ProjectRoleOwner, admin: domain.ProjectRoleAdmin, member: domain.ProjectRoleMember,
+	})
+
+	tests := []struct {
+		permission domain.ProjectPermission
+		allowed    []uuid.UUID
+	}{
+		{domain.PermissionUpdateProject, []uuid.UUID{owner}},
+		{domain.PermissionDeleteBoard, []uuid.UUID{owner, admin, member}},
+		{domain.PermissionManageFields, []uuid.UUID{owner, admin}},
+		{domain.PermissionManageTemplates, []uuid.UUID{owner}},
+		{domain.PermissionInvite, []uuid.UUID{owner, admin}},
+		{domain.PermissionRemoveMembers, []uuid.UUID{owner, admin}},
+		{domain.PermissionDeleteProject, []uuid.UUID{owner}},
+		{domain.PermissionManageRoles, []uuid.UUID{owner}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.permission), func(t *testing.T) {
+			for _, userID := range []uuid.UUID{owner, admin, member, uuid.New()} {
+				_, err := authorize(context.Background(), repo, projectID, userID, tt.permission)
+				if want := slices.Contains(tt.allowed, userID); (err == nil) != want {
+					t.Errorf("authorize(%s) error = %v, want allowed %v", userID, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectPermissionService_UpdatePermissions(t *testing.T) {
+	projectID := uuid.New()
+	owner, admin, member := uuid.New(), uuid.New(), uuid.New()
+	repo := newPermissionTestRepo(map[uuid.UUID]domain.ProjectRole{
+		owner: domain.ProjectRoleOwner, admin: domain.ProjectRoleAdmin, member: domain.ProjectRoleMember,
+	})
+	s := NewProjectPermissionService(repo, zap.NewNop())
+	ctx := context.Background()
+
+	res, err := s.UpdatePermissions(ctx, projectID, owner, &dto.UpdateProjectPermissionsRequest{
+		Permissions: map[string][]string{
+			"can_manage_fields": {"OWNER", "ADMIN", "MEMBER", "MEMBER"},
+			"can_delete_board":  {"ADMIN"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdatePermissions() error = %v", err)
+	}
+	if got := res.Permissions["can_manage_fields"]; !slices.Equal(got, []string{"OWNER", "ADMIN", "MEMBER"}) {
+		t.Errorf("can_manage_fields = %v", got)
+	}
+	if got := res.Permissions["can_invite"]; !slices.Equal(got, []string{"OWNER", "ADMIN"}) {
+		t.Errorf("can_invite = %v, want the default", got)
+	}
+
+	// 바뀐 매트릭스가 authorize에 바로 반영됨
+	if _, err := authorize(ctx, repo, projectID, member, domain.PermissionManageFields); err != nil {
+		t.Errorf("member should manage fields after update: %v", err)
+	}
+	if _, err := authorize(ctx, repo, projectID, member, domain.PermissionDeleteBoard); err == nil {
+		t.Error("member should no longer delete boards")
+	}
+
+	got, err := s.GetPermissions(ctx, projectID, member)
+	if err != nil {
+		t.Fatalf("GetPermissions() error = %v", err)
+	}
+	if got.MyRole != "MEMBER" || !slices.Contains(got.MyPermissions, "can_manage_fields") || slices.Contains(got.MyPermissions, "can_delete_board") {
+		t.Errorf("GetPermissions() = %+v", got)
+	}
+	if got.UpdatedBy == nil || *got.UpdatedBy != owner {
+		t.Errorf("updatedBy = %v, want %s", got.UpdatedBy, owner)
+	}
+}
+
+func TestProjectPermissionService_UpdatePermissions_Rejects(t *testing.T) {
+	projectID := uuid.New()
+	owner, admin := uuid.New(), uuid.New()
+	repo := newPermissionTestRepo(map[uuid.UUID]domain.ProjectRole{owner: domain.ProjectRoleOwner, admin: domain.ProjectRoleAdmin})
+	s := NewProjectPermissionService(repo, zap.NewNop())
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		permissions map[string][]string
+		code        string
+	}{
+		{"ADMIN은 매트릭스를 바꿀 수 없음", admin, map[string][]string{"can_delete_board": {"ADMIN"}}, response.ErrCodeForbidden},
+		{"멤버가 아님", uuid.New(), map[string][]string{"can_delete_board": {"ADMIN"}}, response.ErrCodeForbidden},
+		{"소유자 전용 권한", owner, map[string][]string{"can_delete_project": {"ADMIN"}}, response.ErrCodeValidation},
+		{"알 수 없는 권한", owner, map[string][]string{"can_fly": {"ADMIN"}}, response.ErrCodeValidation},
+		{"잘못된 역할", owner, map[string][]string{"can_invite": {"GUEST"}}, response.ErrCodeValidation},
+		{"빈 요청", owner, map[string][]string{}, response.ErrCodeValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UpdatePermissions(context.Background(), projectID, tt.userID, &dto.UpdateProjectPermissionsRequest{Permissions: tt.permissions})
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.code {
+				t.Errorf("UpdatePermissions() error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -29,11 +29,13 @@ type S3Client interface {
 // ProjectService defines the interface for project business logic
 type ProjectService interface {
 	CreateProject(ctx context.Context, req *dto.CreateProjectRequest, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
-	GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error)
+	GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, state string, token string) ([]*dto.ProjectResponse, error)
 	GetDefaultProject(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	GetProject(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	UpdateProject(ctx context.Context, projectID, userID uuid.UUID, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
 	DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error
+	ArchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
+	UnarchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
 	SearchProjects(ctx context.Context, workspaceID, userID uuid.UUID, query string, page, limit int, token string) (*dto.PaginatedProjectsResponse, error)
 	GetProjectInitSettings(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectInitSettingsResponse, error)
 }
@@ -171,8 +173,13 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 	return s.toProjectResponse(project), nil
 }
 
-// GetProjectsByWorkspace retrieves all projects for a workspace
-func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error) {
+// GetProjectsByWorkspace retrieves the projects of a workspace in the given archive state (빈 값은 ACTIVE)
+func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, state string, token string) ([]*dto.ProjectResponse, error) {
+	archiveState, ok := domain.ParseArchiveState(state)
+	if !ok {
+		return nil, response.NewValidationError("Invalid state: must be ACTIVE, ARCHIVED or ALL", "")
+	}
+
 	// Validate workspace membership
 	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
 	if err != nil {
@@ -185,7 +192,7 @@ func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspa
 	}
 
 	// Fetch projects from repository
-	projects, err := s.projectRepo.FindByWorkspaceID(ctx, workspaceID)
+	projects, err := s.projectRepo.FindByWorkspaceID(ctx, workspaceID, archiveState)
 	if err != nil {
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch projects", err.Error())
 	}
@@ -344,16 +351,9 @@ func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID, userI
 		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
 	}
 
-	// Check if user is the project owner
-	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if member.RoleName != domain.ProjectRoleOwner {
-		return response.NewForbiddenError("Only project owner can delete project", "")
+	// Check if user can delete the project (owner only)
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionDeleteProject); err != nil {
+		return err
 	}
 
 	// Find all attachments associated with this project
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// ArchiveProject hides a project from the default project listings without deleting anything.
+// 삭제와 같은 권한(can_delete_project)이 필요하며, 기본 프로젝트는 보관할 수 없습니다.
+func (s *projectServiceImpl) ArchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	return s.setProjectArchived(ctx, projectID, userID, true)
+}
+
+// UnarchiveProject brings an archived project back to the default project listings
+func (s *projectServiceImpl) UnarchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	return s.setProjectArchived(ctx, projectID, userID, false)
+}
+
+// setProjectArchived archives or restores a project; 이미 그 상태이면 변경 없이 현재 상태를 반환합니다
+func (s *projectServiceImpl) setProjectArchived(ctx context.Context, projectID, userID uuid.UUID, archived bool) (*dto.ProjectResponse, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch project", err.Error())
+	}
+
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionDeleteProject); err != nil {
+		return nil, err
+	}
+
+	if (project.ArchivedAt != nil) != archived {
+		if archived {
+			if project.IsDefault {
+				return nil, response.NewValidationError("Default project cannot be archived", "")
+			}
+			now := time.Now()
+			project.ArchivedAt = &now
+		} else {
+			project.ArchivedAt = nil
+		}
+
+		if err := s.projectRepo.Update(ctx, project); err != nil {
+			return nil, response.NewInternalError("Failed to update project archive state", err.Error())
+		}
+
+		s.logger.Info("Project archive state changed",
+			zap.String("project_id", projectID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Bool("archived", archived))
+	}
+
+	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeProject, project.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Warn("Failed to fetch attachments for project", zap.String("project_id", projectID.String()), zap.Error(err))
+	}
+	project.Attachments = toDomainAttachments(attachments)
+
+	return s.toProjectResponse(project), nil
+}
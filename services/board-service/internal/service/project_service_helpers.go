@@ -29,6 +29,8 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+			ThumbnailURL:       thumbnailURL(s.s3Client.GetFileURL, a.ThumbnailKey),
+			MediumThumbnailURL: thumbnailURL(s.s3Client.GetFileURL, a.MediumThumbnailKey),
 		})
 	}
 
@@ -42,6 +44,8 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 		DueDate:     project.DueDate,
 		IsPublic:    project.IsPublic,
 		Attachments: attachments,
+		State:       string(domain.ArchiveStateOf(project.ArchivedAt)),
+		ArchivedAt:  project.ArchivedAt,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
 	}
@@ -80,25 +84,8 @@ func (s *projectServiceImpl) toProjectResponseWithProfile(ctx context.Context, p
 // GetProject retrieves a project by ID with membership validation
 
 func (s *projectServiceImpl) createDefaultFieldOptions(ctx context.Context, projectID uuid.UUID) error {
-	// Get hardcoded default options
-	templates := getDefaultFieldOptions()
-
-	// Create project-specific options from templates
-	projectOptions := make([]*domain.FieldOption, len(templates))
-	for i, template := range templates {
-		projectOptions[i] = &domain.FieldOption{
-			ProjectID:       &projectID,
-			FieldType:       template.FieldType,
-			Value:           template.Value,
-			Label:           template.Label,
-			Color:           template.Color,
-			DisplayOrder:    template.DisplayOrder,
-			IsSystemDefault: false,
-		}
-	}
-
 	// Batch create all project options
-	if err := s.fieldOptionRepo.CreateBatch(ctx, projectOptions); err != nil {
+	if err := s.fieldOptionRepo.CreateBatch(ctx, DefaultFieldOptions(projectID)); err != nil {
 		return err
 	}
 
@@ -176,6 +163,14 @@ func (s *projectServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		for _, key := range attachment.ThumbnailKeys() {
+			if err := s.s3Client.DeleteFile(ctx, key); err != nil {
+				s.logger.Warn("Failed to delete thumbnail from S3",
+					zap.String("attachment_id", attachment.ID.String()),
+					zap.String("file_key", key),
+					zap.Error(err))
+			}
+		}
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
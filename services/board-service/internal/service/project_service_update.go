@@ -23,16 +23,9 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
 	}
 
-	// Check if user is the project owner
-	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if member.RoleName != domain.ProjectRoleOwner {
-		return nil, response.NewForbiddenError("Only project owner can update project", "")
+	// Check if user's role can update the project
+	if _, err := authorize(ctx, s.projectRepo, projectID, userID, domain.PermissionUpdateProject); err != nil {
+		return nil, err
 	}
 
 	// Determine the effective start and due dates for validation
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// completedStageValue is the stage option value treated as done (기본 옵션의 "완료")
+const completedStageValue = "approved"
+
+// ProjectStatsService defines the interface for project analytics.
+// 모든 통계는 활동 기록의 stage 변경 이력에서 계산하므로, 이력이 쌓이기 전의 변경은 반영되지 않습니다.
+type ProjectStatsService interface {
+	// GetThroughput counts boards created and completed per day in [from, to] with the remaining (burndown) line
+	GetThroughput(ctx context.Context, projectID, userID uuid.UUID, from, to time.Time) (*dto.ProjectThroughputResponse, error)
+	// GetCycleTime averages how long boards stayed in each stage, for stays that ended in [from, to]
+	GetCycleTime(ctx context.Context, projectID, userID uuid.UUID, from, to time.Time) (*dto.ProjectCycleTimeResponse, error)
+	// GetAssigneeLoad counts the open boards of each assignee by stage
+	GetAssigneeLoad(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectAssigneeLoadResponse, error)
+}
+
+// projectStatsServiceImpl is the implementation of ProjectStatsService
+type projectStatsServiceImpl struct {
+	statsRepo       repository.ProjectStatsRepository
+	projectRepo     repository.ProjectRepository
+	fieldOptionRepo repository.FieldOptionRepository
+	logger          *zap.Logger
+}
+
+// NewProjectStatsService creates a new instance of ProjectStatsService
+func NewProjectStatsService(statsRepo repository.ProjectStatsRepository, projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, logger *zap.Logger) ProjectStatsService {
+	return &projectStatsServiceImpl{
+		statsRepo:       statsRepo,
+		projectRepo:     projectRepo,
+		fieldOptionRepo: fieldOptionRepo,
+		logger:          logger,
+	}
+}
+
+// statsBoard is a board with its stage history, used to answer "which stage was it in at time t"
+type statsBoard struct {
+	board   *domain.Board
+	initial string                // 첫 stage 변경 전(생성 시점)의 stage 라벨
+	changes []*domain.ActivityLog // stage 변경, 오래된 순
+}
+
+// stageAt returns the stage label of the board just before t
+func (b *statsBoard) stageAt(t time.Time) string {
+	stage := b.initial
+	for _, change := range b.changes {
+		if !change.CreatedAt.Before(t) {
+			break
+		}
+		stage = change.NewValue
+	}
+	return stage
+}
+
+// projectStages holds the project's stage labels in display order and the label counted as completed
+type projectStages struct {
+	labels    map[string]string // option ID → label
+	order     []string          // labels in display order
+	completed string
+}
+
+// GetThroughput counts boards created and completed per day with the boards still open at the end of each day
+func (s *projectStatsServiceImpl) GetThroughput(ctx context.Context, projectID, userID uuid.UUID, from, to time.Time) (*dto.ProjectThroughputResponse, error) {
+	if err := validateStatsRange(from, to); err != nil {
+		return nil, err
+	}
+	if err := s.checkMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	end := to.AddDate(0, 0, 1)
+
+	stages, err := s.loadStages(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	boards, err := s.loadBoards(ctx, projectID, end, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ProjectThroughputResponse{
+		ProjectID:      projectID,
+		From:           from.Format(dto.StatsDateLayout),
+		To:             to.Format(dto.StatsDateLayout),
+		CompletedStage: stages.completed,
+		Days:           []dto.DailyBoardStats{},
+	}
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		stats := dto.DailyBoardStats{Date: day.Format(dto.StatsDateLayout)}
+		for _, b := range boards {
+			createdAt := b.board.CreatedAt
+			if createdAt.Before(next) && b.stageAt(next) != stages.completed {
+				stats.Remaining++
+			}
+			if !createdAt.Before(day) && createdAt.Before(next) {
+				stats.Created++
+			}
+			if stages.completed != "" && completedWithin(b.changes, stages.completed, day, next) {
+				stats.Completed++
+			}
+		}
+		resp.TotalCreated += stats.Created
+		resp.TotalCompleted += stats.Completed
+		resp.Days = append(resp.Days, stats)
+	}
+	return resp, nil
+}
+
+// GetCycleTime averages the finished stays in each stage; 보드 생성부터 첫 stage 변경까지를 첫 stage에 머문 시간으로 봅니다
+func (s *projectStatsServiceImpl) GetCycleTime(ctx context.Context, projectID, userID uuid.UUID, from, to time.Time) (*dto.ProjectCycleTimeResponse, error) {
+	if err := validateStatsRange(from, to); err != nil {
+		return nil, err
+	}
+	if err := s.checkMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	end := to.AddDate(0, 0, 1)
+
+	stages, err := s.loadStages(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	boards, err := s.loadBoards(ctx, projectID, end, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	total := map[string]time.Duration{}
+	samples := map[string]int{}
+	for _, b := range boards {
+		stage, enteredAt := b.initial, b.board.CreatedAt
+		for _, change := range b.changes {
+			if d := change.CreatedAt.Sub(enteredAt); d >= 0 && !change.CreatedAt.Before(from) {
+				total[stage] += d
+				samples[stage]++
+			}
+			stage, enteredAt = change.NewValue, change.CreatedAt
+		}
+	}
+
+	resp := &dto.ProjectCycleTimeResponse{
+		ProjectID: projectID,
+		From:      from.Format(dto.StatsDateLayout),
+		To:        to.Format(dto.StatsDateLayout),
+		Stages:    []dto.StageCycleTime{},
+	}
+	for _, stage := range stages.orderedWith(samples) {
+		average := total[stage].Hours() / float64(samples[stage])
+		resp.Stages = append(resp.Stages, dto.StageCycleTime{
+			Stage:        stage,
+			AverageHours: math.Round(average*100) / 100,
+			Samples:      samples[stage],
+		})
+	}
+	return resp, nil
+}
+
+// GetAssigneeLoad counts the boards that are neither completed nor archived per assignee, busiest first
+func (s *projectStatsServiceImpl) GetAssigneeLoad(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectAssigneeLoadResponse, error) {
+	if err := s.checkMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	stages, err := s.loadStages(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	boards, err := s.statsRepo.FindBoards(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch boards", err.Error())
+	}
+
+	loads := map[uuid.UUID]*dto.AssigneeLoad{}
+	var unassigned *dto.AssigneeLoad
+	for _, board := range boards {
+		stage := stages.current(board)
+		if board.ArchivedAt != nil || (stages.completed != "" && stage == stages.completed) {
+			continue
+		}
+
+		load := unassigned
+		if board.AssigneeID != nil {
+			load = loads[*board.AssigneeID]
+		}
+		if load == nil {
+			load = &dto.AssigneeLoad{AssigneeID: board.AssigneeID, ByStage: map[string]int{}}
+			if board.AssigneeID != nil {
+				loads[*board.AssigneeID] = load
+			} else {
+				unassigned = load
+			}
+		}
+		load.Open++
+		load.ByStage[stage]++
+	}
+
+	resp := &dto.ProjectAssigneeLoadResponse{ProjectID: projectID, Assignees: []dto.AssigneeLoad{}}
+	for _, load := range loads {
+		resp.Assignees = append(resp.Assignees, *load)
+	}
+	sort.Slice(resp.Assignees, func(i, j int) bool {
+		a, b := resp.Assignees[i], resp.Assignees[j]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.AssigneeID.String() < b.AssigneeID.String()
+	})
+	// 담당자 미지정은 항상 마지막
+	if unassigned != nil {
+		resp.Assignees = append(resp.Assignees, *unassigned)
+	}
+	return resp, nil
+}
+
+// checkMember verifies that the project exists and userID is a member
+func (s *projectStatsServiceImpl) checkMember(ctx context.Context, projectID, userID uuid.UUID) error {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Project not found", "")
+		}
+		return response.NewInternalError("Failed to verify project", err.Error())
+	}
+	if _, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return response.NewInternalError("Failed to check membership", err.Error())
+	}
+	return nil
+}
+
+// loadStages loads the project's stage options
+func (s *projectStatsServiceImpl) loadStages(ctx context.Context, projectID uuid.UUID) (*projectStages, error) {
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldTypeStage)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch stage options", err.Error())
+	}
+	sort.SliceStable(options, func(i, j int) bool { return options[i].DisplayOrder < options[j].DisplayOrder })
+
+	stages := &projectStages{labels: map[string]string{}}
+	for _, option := range options {
+		stages.labels[option.ID.String()] = option.Label
+		stages.order = append(stages.order, option.Label)
+		if option.Value == completedStageValue {
+			stages.completed = option.Label
+		}
+	}
+	return stages, nil
+}
+
+// loadBoards loads the project's boards with their stage changes made before until
+func (s *projectStatsServiceImpl) loadBoards(ctx context.Context, projectID uuid.UUID, until time.Time, stages *projectStages) ([]*statsBoard, error) {
+	boards, err := s.statsRepo.FindBoards(ctx, projectID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch boards", err.Error())
+	}
+	changes, err := s.statsRepo.FindStageChanges(ctx, projectID, until)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch stage history", err.Error())
+	}
+
+	byID := make(map[uuid.UUID]*statsBoard, len(boards))
+	result := make([]*statsBoard, 0, len(boards))
+	for _, board := range boards {
+		if !board.CreatedAt.Before(until) {
+			continue
+		}
+		b := &statsBoard{board: board}
+		byID[board.ID] = b
+		result = append(result, b)
+	}
+	for _, change := range changes {
+		if b, ok := byID[change.BoardID]; ok {
+			b.changes = append(b.changes, change)
+		}
+	}
+
+	// 이력이 있으면 첫 변경의 이전 값이, 없으면 현재 stage가 생성 시점의 stage
+	for _, b := range result {
+		if len(b.changes) > 0 {
+			b.initial = b.changes[0].OldValue
+		} else {
+			b.initial = stages.current(b.board)
+		}
+	}
+	return result, nil
+}
+
+// current returns the label of the board's current stage ("" when it has none)
+func (p *projectStages) current(board *domain.Board) string {
+	var fields map[string]interface{}
+	if len(board.CustomFields) == 0 || json.Unmarshal(board.CustomFields, &fields) != nil {
+		return ""
+	}
+	optionID, ok := fields[string(domain.FieldTypeStage)].(string)
+	if !ok {
+		return ""
+	}
+	return p.labels[optionID]
+}
+
+// orderedWith returns the stages present in samples, project stages first in display order
+// and then stages no longer configured (renamed or deleted options) alphabetically
+func (p *projectStages) orderedWith(samples map[string]int) []string {
+	seen := map[string]bool{}
+	var ordered []string
+	for _, label := range p.order {
+		if samples[label] > 0 && !seen[label] {
+			seen[label] = true
+			ordered = append(ordered, label)
+		}
+	}
+	var rest []string
+	for label := range samples {
+		if !seen[label] {
+			rest = append(rest, label)
+		}
+	}
+	sort.Strings(rest)
+	return append(ordered, rest...)
+}
+
+// completedWithin reports whether the board moved into the completed stage in [from, to)
+func completedWithin(changes []*domain.ActivityLog, completed string, from, to time.Time) bool {
+	for _, change := range changes {
+		if change.NewValue == completed && !change.CreatedAt.Before(from) && change.CreatedAt.Before(to) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateStatsRange checks that from and to are UTC dates with from <= to within MaxStatsRangeDays
+func validateStatsRange(from, to time.Time) error {
+	if to.Before(from) {
+		return response.NewValidationError("Invalid date range", "from must not be after to")
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > dto.MaxStatsRangeDays {
+		return response.NewValidationError("Date range too long", fmt.Sprintf("at most %d days", dto.MaxStatsRangeDays))
+	}
+	return nil
+}
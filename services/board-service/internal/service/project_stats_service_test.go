@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// newStatsTestService returns a stats service over three boards created on 1/1:
+// A는 1/2 진행중 → 1/3 완료, B는 1/2 진행중에 머무름, C는 담당자 없이 대기
+func newStatsTestService(projectID, userID, assigneeID uuid.UUID) ProjectStatsService {
+	day := func(d, h int) time.Time { return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC) }
+	pendingID, progressID, doneID := uuid.New(), uuid.New(), uuid.New()
+	stageOf := func(id uuid.UUID) []byte { return []byte(`{"stage":"` + id.String() + `"}`) }
+
+	boardA := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: day(1, 9)}, ProjectID: projectID,
+		AssigneeID: &assigneeID, CustomFields: stageOf(doneID)}
+	boardB := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: day(1, 9)}, ProjectID: projectID,
+		AssigneeID: &assigneeID, CustomFields: stageOf(progressID)}
+	boardC := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: day(1, 12)}, ProjectID: projectID,
+		CustomFields: stageOf(pendingID)}
+	change := func(board *domain.Board, at time.Time, from, to string) *domain.ActivityLog {
+		return &domain.ActivityLog{BoardID: board.ID, ProjectID: projectID, Action: domain.ActivityActionUpdated,
+			Field: "stage", OldValue: from, NewValue: to, CreatedAt: at}
+	}
+	changes := []*domain.ActivityLog{
+		change(boardA, day(2, 9), "대기", "진행중"),
+		change(boardA, day(3, 21), "진행중", "완료"),
+		change(boardB, day(2, 21), "대기", "진행중"),
+	}
+
+	statsRepo := &MockProjectStatsRepository{
+		FindBoardsFunc: func(ctx context.Context, pid uuid.UUID) ([]*domain.Board, error) {
+			return []*domain.Board{boardA, boardB, boardC}, nil
+		},
+		FindStageChangesFunc: func(ctx context.Context, pid uuid.UUID, until time.Time) ([]*domain.ActivityLog, error) {
+			var result []*domain.ActivityLog
+			for _, c := range changes {
+				if c.CreatedAt.Before(until) {
+					result = append(result, c)
+				}
+			}
+			return result, nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			return []*domain.FieldOption{
+				{BaseModel: domain.BaseModel{ID: doneID}, Value: "approved", Label: "완료", DisplayOrder: 3},
+				{BaseModel: domain.BaseModel{ID: pendingID}, Value: "pending", Label: "대기", DisplayOrder: 1},
+				{BaseModel: domain.BaseModel{ID: progressID}, Value: "in_progress", Label: "진행중", DisplayOrder: 2},
+			}, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pid, uid uuid.UUID) (*domain.ProjectMember, error) {
+			if uid != userID {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.ProjectMember{ProjectID: pid, UserID: uid, RoleName: domain.ProjectRoleMember}, nil
+		},
+	}
+	return NewProjectStatsService(statsRepo, projectRepo, fieldOptionRepo, zap.NewNop())
+}
+
+func TestProjectStatsService_GetThroughput(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s := newStatsTestService(projectID, userID, uuid.New())
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.GetThroughput(context.Background(), projectID, userID, from, from.AddDate(0, 0, 3))
+	if err != nil {
+		t.Fatalf("GetThroughput() error = %v", err)
+	}
+	if resp.CompletedStage != "완료" || resp.TotalCreated != 3 || resp.TotalCompleted != 1 || len(resp.Days) != 4 {
+		t.Fatalf("response = %+v", resp)
+	}
+	wantRemaining := []int{3, 3, 2, 2}
+	for i, d := range resp.Days {
+		if d.Remaining != wantRemaining[i] {
+			t.Errorf("%s remaining = %d, want %d", d.Date, d.Remaining, wantRemaining[i])
+		}
+	}
+	if resp.Days[0].Created != 3 || resp.Days[2].Completed != 1 {
+		t.Errorf("days = %+v", resp.Days)
+	}
+}
+
+func TestProjectStatsService_GetCycleTime(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s := newStatsTestService(projectID, userID, uuid.New())
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.GetCycleTime(context.Background(), projectID, userID, from, from.AddDate(0, 0, 3))
+	if err != nil {
+		t.Fatalf("GetCycleTime() error = %v", err)
+	}
+	// 대기: A 24h, B 36h / 진행중: A 36h (B는 아직 진행중이라 제외)
+	if len(resp.Stages) != 2 {
+		t.Fatalf("stages = %+v", resp.Stages)
+	}
+	if s := resp.Stages[0]; s.Stage != "대기" || s.Samples != 2 || s.AverageHours != 30 {
+		t.Errorf("대기 = %+v", s)
+	}
+	if s := resp.Stages[1]; s.Stage != "진행중" || s.Samples != 1 || s.AverageHours != 36 {
+		t.Errorf("진행중 = %+v", s)
+	}
+}
+
+func TestProjectStatsService_GetAssigneeLoad(t *testing.T) {
+	projectID, userID, assigneeID := uuid.New(), uuid.New(), uuid.New()
+	s := newStatsTestService(projectID, userID, assigneeID)
+
+	resp, err := s.GetAssigneeLoad(context.Background(), projectID, userID)
+	if err != nil {
+		t.Fatalf("GetAssigneeLoad() error = %v", err)
+	}
+	if len(resp.Assignees) != 2 {
+		t.Fatalf("assignees = %+v", resp.Assignees)
+	}
+	first, last := resp.Assignees[0], resp.Assignees[1]
+	if first.AssigneeID == nil || *first.AssigneeID != assigneeID || first.Open != 1 || first.ByStage["진행중"] != 1 {
+		t.Errorf("assignee = %+v, want only the unfinished board", first)
+	}
+	if last.AssigneeID != nil || last.Open != 1 || last.ByStage["대기"] != 1 {
+		t.Errorf("unassigned = %+v", last)
+	}
+}
+
+func TestProjectStatsService_RejectsInvalidRequests(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	s := newStatsTestService(projectID, userID, uuid.New())
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		to     time.Time
+		code   string
+	}{
+		{"시작일이 종료일보다 늦음", userID, from.AddDate(0, 0, -1), response.ErrCodeValidation},
+		{"최대 기간 초과", userID, from.AddDate(0, 0, 180), response.ErrCodeValidation},
+		{"프로젝트 멤버가 아님", uuid.New(), from, response.ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.GetThroughput(context.Background(), projectID, tt.userID, from, tt.to)
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.code {
+				t.Errorf("GetThroughput() error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+const (
+	defaultSearchLimit = 5
+	maxSearchLimit     = 20
+	maxSearchQueryLen  = 100
+	// searchCandidateFactor is how many candidates per requested hit each source returns for ranking
+	searchCandidateFactor = 3
+	// defaultSearchTimeout bounds each source so one slow service does not hold up the whole response
+	defaultSearchTimeout = 3 * time.Second
+)
+
+// SearchService defines the interface for workspace-wide "search everything"
+type SearchService interface {
+	Search(ctx context.Context, userID uuid.UUID, req *dto.GlobalSearchRequest, token string) (*dto.GlobalSearchResponse, error)
+	SearchBoards(ctx context.Context, userID uuid.UUID, req *dto.BoardSearchRequest, token string) (*dto.BoardSearchResponse, error)
+}
+
+// searchServiceImpl fans a query out to boards and comments (local) and to
+// chat messages, files and members (owning services), then ranks each group.
+type searchServiceImpl struct {
+	searchRepo   repository.SearchRepository
+	searchClient client.SearchClient // optional, remote groups are skipped when nil
+	userClient   client.UserClient
+	timeout      time.Duration
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// NewSearchService creates a new instance of SearchService.
+// timeout bounds each source; zero uses the default.
+func NewSearchService(searchRepo repository.SearchRepository, searchClient client.SearchClient, userClient client.UserClient, timeout time.Duration, logger *zap.Logger) SearchService {
+	if timeout <= 0 {
+		timeout = defaultSearchTimeout
+	}
+	return &searchServiceImpl{
+		searchRepo:   searchRepo,
+		searchClient: searchClient,
+		userClient:   userClient,
+		timeout:      timeout,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// Search queries every requested group concurrently. A failing or slow source is
+// reported in Unavailable instead of failing the whole search.
+func (s *searchServiceImpl) Search(ctx context.Context, userID uuid.UUID, req *dto.GlobalSearchRequest, token string) (*dto.GlobalSearchResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, response.NewValidationError("Search query cannot be empty", "")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLen {
+		return nil, response.NewValidationError("Search query is too long", "")
+	}
+	types, err := normalizeSearchTypes(req.Types)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit < 1 || limit > maxSearchLimit {
+		limit = defaultSearchLimit
+	}
+
+	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, req.WorkspaceID, userID, token)
+	if err != nil || !isValid {
+		return nil, response.NewForbiddenError("You are not a member of this workspace", "")
+	}
+
+	result := &dto.GlobalSearchResponse{Query: query}
+	best := make(map[dto.SearchType]float64)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, searchType := range types {
+		wg.Add(1)
+		go func(searchType dto.SearchType) {
+			defer wg.Done()
+			sourceCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			defer cancel()
+
+			setGroup, top, err := s.searchGroup(sourceCtx, searchType, userID, req.WorkspaceID, query, limit, token)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, client.ErrSearchSourceDisabled):
+				// 연결된 서비스가 없는 그룹은 응답에서 생략
+			case err != nil:
+				s.logger.Warn("Search source failed",
+					zap.String("type", string(searchType)),
+					zap.String("workspace_id", req.WorkspaceID.String()),
+					zap.Error(err))
+				result.Unavailable = append(result.Unavailable, searchType)
+			default:
+				setGroup(result)
+				best[searchType] = top
+			}
+		}(searchType)
+	}
+	wg.Wait()
+
+	result.Order = groupOrder(types, best)
+	result.Unavailable = sortedSearchTypes(result.Unavailable)
+	return result, nil
+}
+
+// SearchBoards finds boards by full-text match of their title, content and comments in the
+// workspace projects the user is a member of, best match first
+func (s *searchServiceImpl) SearchBoards(ctx context.Context, userID uuid.UUID, req *dto.BoardSearchRequest, token string) (*dto.BoardSearchResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, response.NewValidationError("Search query cannot be empty", "")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLen {
+		return nil, response.NewValidationError("Search query is too long", "")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = dto.DefaultBoardSearchLimit
+	}
+	if limit > dto.MaxBoardSearchLimit {
+		limit = dto.MaxBoardSearchLimit
+	}
+	offset := max(req.Offset, 0)
+
+	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, req.WorkspaceID, userID, token)
+	if err != nil || !isValid {
+		return nil, response.NewForbiddenError("You are not a member of this workspace", "")
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	rows, err := s.searchRepo.FullTextSearchBoards(ctx, req.WorkspaceID, userID, query, limit+1, offset)
+	if err != nil {
+		s.logger.Error("Board full-text search failed",
+			zap.String("workspace_id", req.WorkspaceID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to search boards", err.Error())
+	}
+
+	result := &dto.BoardSearchResponse{Query: query, Boards: []dto.BoardSearchHit{}, Limit: limit, Offset: offset}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		result.HasMore = true
+	}
+	for _, row := range rows {
+		hit := dto.BoardSearchHit{
+			BoardID:     row.ID,
+			ProjectID:   row.ProjectID,
+			ProjectName: row.ProjectName,
+			Title:       row.Title,
+			Snippet:     snippet(row.Content, query),
+			MatchedIn:   dto.BoardMatchedInBoard,
+			UpdatedAt:   row.UpdatedAt,
+			Score:       row.Rank,
+		}
+		if row.MatchedComment != "" {
+			hit.Snippet = snippet(row.MatchedComment, query)
+			hit.MatchedIn = dto.BoardMatchedInComment
+		}
+		result.Boards = append(result.Boards, hit)
+	}
+	return result, nil
+}
+
+// searchGroup runs one source and ranks its hits. It returns a setter that stores the
+// group in the response and the group's best score (0 when there are no hits).
+func (s *searchServiceImpl) searchGroup(ctx context.Context, searchType dto.SearchType, userID, workspaceID uuid.UUID, query string, limit int, token string) (func(*dto.GlobalSearchResponse), float64, error) {
+	candidates := limit * searchCandidateFactor
+	now := s.now()
+
+	switch searchType {
+	case dto.SearchTypeBoards:
+		rows, err := s.searchRepo.SearchBoards(ctx, workspaceID, userID, query, candidates)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits := make([]dto.BoardSearchHit, len(rows))
+		for i, row := range rows {
+			hits[i] = dto.BoardSearchHit{
+				BoardID:     row.ID,
+				ProjectID:   row.ProjectID,
+				ProjectName: row.ProjectName,
+				Title:       row.Title,
+				Snippet:     snippet(row.Content, query),
+				UpdatedAt:   row.UpdatedAt,
+				Score:       rank(query, row.Title, []string{row.Content}, row.UpdatedAt, now),
+			}
+		}
+		sortByScore(hits, func(h dto.BoardSearchHit) float64 { return h.Score })
+		group := &dto.BoardSearchGroup{Items: truncate(hits, limit), HasMore: len(hits) > limit}
+		return func(r *dto.GlobalSearchResponse) { r.Boards = group }, topScore(group.Items, func(h dto.BoardSearchHit) float64 { return h.Score }), nil
+
+	case dto.SearchTypeComments:
+		rows, err := s.searchRepo.SearchComments(ctx, workspaceID, userID, query, candidates)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits := make([]dto.CommentSearchHit, len(rows))
+		for i, row := range rows {
+			hits[i] = dto.CommentSearchHit{
+				CommentID:  row.ID,
+				BoardID:    row.BoardID,
+				BoardTitle: row.BoardTitle,
+				ProjectID:  row.ProjectID,
+				UserID:     row.UserID,
+				Snippet:    snippet(row.Content, query),
+				UpdatedAt:  row.UpdatedAt,
+				Score:      rank(query, "", []string{row.Content}, row.UpdatedAt, now),
+			}
+		}
+		sortByScore(hits, func(h dto.CommentSearchHit) float64 { return h.Score })
+		group := &dto.CommentSearchGroup{Items: truncate(hits, limit), HasMore: len(hits) > limit}
+		return func(r *dto.GlobalSearchResponse) { r.Comments = group }, topScore(group.Items, func(h dto.CommentSearchHit) float64 { return h.Score }), nil
+
+	case dto.SearchTypeMessages:
+		if s.searchClient == nil {
+			return nil, 0, client.ErrSearchSourceDisabled
+		}
+		messages, err := s.searchClient.SearchMessages(ctx, workspaceID, query, candidates, token)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits := make([]dto.MessageSearchHit, len(messages))
+		for i, message := range messages {
+			hits[i] = dto.MessageSearchHit{
+				MessageID: message.MessageID,
+				ChatID:    message.ChatID,
+				ChatName:  message.ChatName,
+				UserID:    message.UserID,
+				Snippet:   snippet(message.Content, query),
+				CreatedAt: message.CreatedAt,
+				Score:     rank(query, "", []string{message.Content}, message.CreatedAt, now),
+			}
+		}
+		sortByScore(hits, func(h dto.MessageSearchHit) float64 { return h.Score })
+		group := &dto.MessageSearchGroup{Items: truncate(hits, limit), HasMore: len(hits) > limit}
+		return func(r *dto.GlobalSearchResponse) { r.Messages = group }, topScore(group.Items, func(h dto.MessageSearchHit) float64 { return h.Score }), nil
+
+	case dto.SearchTypeFiles:
+		if s.searchClient == nil {
+			return nil, 0, client.ErrSearchSourceDisabled
+		}
+		files, err := s.searchClient.SearchFiles(ctx, workspaceID, query, candidates, token)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits := make([]dto.FileSearchHit, len(files))
+		for i, file := range files {
+			name := file.OriginalName
+			if name == "" {
+				name = file.Name
+			}
+			hits[i] = dto.FileSearchHit{
+				FileID:      file.ID,
+				Name:        name,
+				ContentType: file.ContentType,
+				FileSize:    file.FileSize,
+				FileURL:     file.FileURL,
+				UpdatedAt:   file.UpdatedAt,
+				Score:       rank(query, name, file.Tags, file.UpdatedAt, now),
+			}
+		}
+		sortByScore(hits, func(h dto.FileSearchHit) float64 { return h.Score })
+		group := &dto.FileSearchGroup{Items: truncate(hits, limit), HasMore: len(hits) > limit}
+		return func(r *dto.GlobalSearchResponse) { r.Files = group }, topScore(group.Items, func(h dto.FileSearchHit) float64 { return h.Score }), nil
+
+	case dto.SearchTypeMembers:
+		if s.searchClient == nil {
+			return nil, 0, client.ErrSearchSourceDisabled
+		}
+		members, err := s.searchClient.SearchMembers(ctx, workspaceID, query, token)
+		if err != nil {
+			return nil, 0, err
+		}
+		hits := make([]dto.MemberSearchHit, len(members))
+		for i, member := range members {
+			hits[i] = dto.MemberSearchHit{
+				UserID:          member.UserID,
+				NickName:        member.NickName,
+				Email:           member.Email,
+				ProfileImageURL: member.ProfileImageURL,
+				RoleName:        member.RoleName,
+				Score:           rank(query, member.NickName, []string{member.Email}, time.Time{}, now),
+			}
+		}
+		sortByScore(hits, func(h dto.MemberSearchHit) float64 { return h.Score })
+		group := &dto.MemberSearchGroup{Items: truncate(hits, limit), HasMore: len(hits) > limit}
+		return func(r *dto.GlobalSearchResponse) { r.Members = group }, topScore(group.Items, func(h dto.MemberSearchHit) float64 { return h.Score }), nil
+	}
+	return nil, 0, nil
+}
+
+// normalizeSearchTypes validates the requested groups, defaulting to all of them
+func normalizeSearchTypes(types []dto.SearchType) ([]dto.SearchType, error) {
+	if len(types) == 0 {
+		return dto.AllSearchTypes, nil
+	}
+	seen := make(map[dto.SearchType]bool, len(types))
+	for _, searchType := range types {
+		if !isSearchType(searchType) {
+			return nil, response.NewValidationError("Invalid search type: "+string(searchType), "")
+		}
+		seen[searchType] = true
+	}
+	normalized := make([]dto.SearchType, 0, len(seen))
+	for _, searchType := range dto.AllSearchTypes {
+		if seen[searchType] {
+			normalized = append(normalized, searchType)
+		}
+	}
+	return normalized, nil
+}
+
+func isSearchType(searchType dto.SearchType) bool {
+	for _, known := range dto.AllSearchTypes {
+		if searchType == known {
+			return true
+		}
+	}
+	return false
+}
+
+// groupOrder orders the returned groups by their best hit; groups without hits go last
+func groupOrder(types []dto.SearchType, best map[dto.SearchType]float64) []dto.SearchType {
+	order := make([]dto.SearchType, 0, len(best))
+	for _, searchType := range types {
+		if _, ok := best[searchType]; ok {
+			order = append(order, searchType)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return best[order[i]] > best[order[j]]
+	})
+	return order
+}
+
+// sortedSearchTypes puts types back in AllSearchTypes order (goroutines finish in any order)
+func sortedSearchTypes(types []dto.SearchType) []dto.SearchType {
+	if len(types) == 0 {
+		return nil
+	}
+	set := make(map[dto.SearchType]bool, len(types))
+	for _, searchType := range types {
+		set[searchType] = true
+	}
+	sorted := make([]dto.SearchType, 0, len(types))
+	for _, searchType := range dto.AllSearchTypes {
+		if set[searchType] {
+			sorted = append(sorted, searchType)
+		}
+	}
+	return sorted
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// Match quality of the primary field (title, name, nickname)
+	scoreExact      = 1.0
+	scorePrefix     = 0.8
+	scoreWordPrefix = 0.6
+	scoreContains   = 0.4
+	// Secondary fields (content, tags, email) count at this fraction of the primary score
+	secondaryWeight = 0.5
+	// Recent items get up to recencyWeight extra, halving every recencyHalfLife
+	recencyWeight   = 0.1
+	recencyHalfLife = 14 * 24 * time.Hour
+
+	snippetRadius = 60 // runes shown on each side of the first match
+)
+
+// rank scores a hit for the query: the best match in the primary or a secondary field
+// (exact > prefix > word prefix > contains) plus a small recency boost for ties.
+// A zero updatedAt gets no boost.
+func rank(query, primary string, secondary []string, updatedAt, now time.Time) float64 {
+	score := matchScore(primary, query)
+	for _, field := range secondary {
+		score = math.Max(score, matchScore(field, query)*secondaryWeight)
+	}
+	if !updatedAt.IsZero() {
+		age := now.Sub(updatedAt)
+		if age < 0 {
+			age = 0
+		}
+		score += recencyWeight * math.Pow(0.5, float64(age)/float64(recencyHalfLife))
+	}
+	return math.Round(score*1000) / 1000
+}
+
+// matchScore returns how well text matches the query, case-insensitively (0 = no match)
+func matchScore(text, query string) float64 {
+	text = strings.ToLower(strings.TrimSpace(text))
+	query = strings.ToLower(query)
+	if text == "" || query == "" {
+		return 0
+	}
+	switch {
+	case text == query:
+		return scoreExact
+	case strings.HasPrefix(text, query):
+		return scorePrefix
+	}
+	index := strings.Index(text, query)
+	if index < 0 {
+		return 0
+	}
+	for ; index >= 0; index = nextIndex(text, query, index) {
+		if !isWordRune(lastRune(text[:index])) {
+			return scoreWordPrefix
+		}
+	}
+	return scoreContains
+}
+
+// nextIndex finds the next occurrence of query in text after position from (-1 = none)
+func nextIndex(text, query string, from int) int {
+	next := strings.Index(text[from+1:], query)
+	if next < 0 {
+		return -1
+	}
+	return from + 1 + next
+}
+
+func lastRune(s string) rune {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return ' '
+	}
+	return runes[len(runes)-1]
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// snippet returns the text around the first match of the query, collapsed to one line
+func snippet(text, query string) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	if len(runes) == 0 {
+		return ""
+	}
+	lower := []rune(strings.ToLower(string(runes)))
+	queryRunes := []rune(strings.ToLower(query))
+
+	match := 0
+	for i := 0; i+len(queryRunes) <= len(lower); i++ {
+		if string(lower[i:i+len(queryRunes)]) == string(queryRunes) {
+			match = i
+			break
+		}
+	}
+
+	start := max(match-snippetRadius, 0)
+	end := min(match+len(queryRunes)+snippetRadius, len(runes))
+	result := string(runes[start:end])
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(runes) {
+		result += "…"
+	}
+	return result
+}
+
+// sortByScore orders hits by descending score, keeping the source order (recency) for ties
+func sortByScore[T any](hits []T, score func(T) float64) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		return score(hits[i]) > score(hits[j])
+	})
+}
+
+// truncate returns at most limit hits
+func truncate[T any](hits []T, limit int) []T {
+	if len(hits) > limit {
+		return hits[:limit]
+	}
+	return hits
+}
+
+// topScore returns the score of the first (best) hit, or 0 for an empty group
+func topScore[T any](hits []T, score func(T) float64) float64 {
+	if len(hits) == 0 {
+		return 0
+	}
+	return score(hits[0])
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func newTestSearchService(repo *MockSearchRepository, searchClient client.SearchClient, userClient *MockUserClient) *searchServiceImpl {
+	s := NewSearchService(repo, searchClient, userClient, time.Second, zap.NewNop()).(*searchServiceImpl)
+	s.now = func() time.Time { return time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC) }
+	return s
+}
+
+// TestSearchService_Search는 그룹별 랭킹, 그룹 순서, 일부 소스 실패 처리를 테스트합니다.
+func TestSearchService_Search(t *testing.T) {
+	workspaceID := uuid.New()
+	userID := uuid.New()
+	recent := time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC)
+	old := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	exactID, containsID, contentID := uuid.New(), uuid.New(), uuid.New()
+	repo := &MockSearchRepository{
+		SearchBoardsFunc: func(ctx context.Context, ws, user uuid.UUID, query string, limit int) ([]repository.BoardSearchRow, error) {
+			if ws != workspaceID || user != userID || query != "release" {
+				t.Errorf("unexpected board search args: %s %s %q", ws, user, query)
+			}
+			if limit != 2*searchCandidateFactor {
+				t.Errorf("expected %d candidates, got %d", 2*searchCandidateFactor, limit)
+			}
+			// 최신순으로 반환되지만 관련도 순으로 재정렬되어야 함
+			return []repository.BoardSearchRow{
+				{ID: contentID, Title: "Sprint notes", Content: "plan the release", UpdatedAt: recent},
+				{ID: containsID, Title: "Prerelease checklist", UpdatedAt: recent},
+				{ID: exactID, Title: "Release", UpdatedAt: old},
+			}, nil
+		},
+	}
+	searchClient := &MockSearchClient{
+		SearchMessagesFunc: func(ctx context.Context, ws uuid.UUID, query string, limit int, token string) ([]client.MessageHit, error) {
+			return nil, errors.New("chat-service unavailable")
+		},
+		SearchMembersFunc: func(ctx context.Context, ws uuid.UUID, query string, token string) ([]client.MemberHit, error) {
+			return []client.MemberHit{{UserID: uuid.New(), NickName: "release-bot", Email: "bot@example.com"}}, nil
+		},
+	}
+	s := newTestSearchService(repo, searchClient, &MockUserClient{})
+
+	result, err := s.Search(context.Background(), userID, &dto.GlobalSearchRequest{
+		WorkspaceID: workspaceID,
+		Query:       "  release ",
+		Types:       []dto.SearchType{dto.SearchTypeMembers, dto.SearchTypeMessages, dto.SearchTypeBoards},
+		Limit:       2,
+	}, "token")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if result.Boards == nil || len(result.Boards.Items) != 2 || !result.Boards.HasMore {
+		t.Fatalf("expected 2 board hits with more available, got %+v", result.Boards)
+	}
+	if result.Boards.Items[0].BoardID != exactID || result.Boards.Items[1].BoardID != containsID {
+		t.Errorf("expected exact title match first, then title contains, got %+v", result.Boards.Items)
+	}
+	if result.Comments != nil || result.Files != nil {
+		t.Errorf("groups that were not requested should be omitted")
+	}
+	if result.Messages != nil || !reflect.DeepEqual(result.Unavailable, []dto.SearchType{dto.SearchTypeMessages}) {
+		t.Errorf("failed source should be reported as unavailable, got messages=%+v unavailable=%v", result.Messages, result.Unavailable)
+	}
+	if want := []dto.SearchType{dto.SearchTypeBoards, dto.SearchTypeMembers}; !reflect.DeepEqual(result.Order, want) {
+		t.Errorf("Order = %v, want %v", result.Order, want)
+	}
+}
+
+// TestSearchService_Search_Validation은 입력 검증과 워크스페이스 멤버십 확인을 테스트합니다.
+func TestSearchService_Search_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.GlobalSearchRequest
+		member      bool
+		wantErrCode string
+	}{
+		{name: "빈 검색어", req: dto.GlobalSearchRequest{Query: "   "}, member: true, wantErrCode: response.ErrCodeValidation},
+		{name: "알 수 없는 그룹", req: dto.GlobalSearchRequest{Query: "a", Types: []dto.SearchType{"projects"}}, member: true, wantErrCode: response.ErrCodeValidation},
+		{name: "워크스페이스 멤버 아님", req: dto.GlobalSearchRequest{Query: "a"}, member: false, wantErrCode: response.ErrCodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userClient := &MockUserClient{
+				ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+					return tt.member, nil
+				},
+			}
+			s := newTestSearchService(&MockSearchRepository{}, nil, userClient)
+
+			_, err := s.Search(context.Background(), uuid.New(), &tt.req, "token")
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+				t.Errorf("expected %s error, got %v", tt.wantErrCode, err)
+			}
+		})
+	}
+}
+
+// TestSearchService_Search_NoSearchClient는 원격 소스가 없으면 해당 그룹을 생략하는지 테스트합니다.
+func TestSearchService_Search_NoSearchClient(t *testing.T) {
+	s := newTestSearchService(&MockSearchRepository{}, nil, &MockUserClient{})
+
+	result, err := s.Search(context.Background(), uuid.New(), &dto.GlobalSearchRequest{WorkspaceID: uuid.New(), Query: "x"}, "token")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Boards == nil || result.Comments == nil {
+		t.Errorf("local groups should always be returned")
+	}
+	if result.Messages != nil || result.Files != nil || result.Members != nil || len(result.Unavailable) != 0 {
+		t.Errorf("remote groups should be omitted without a search client, got %+v", result)
+	}
+}
+
+func TestMatchScore(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        float64
+	}{
+		{"Release", "release", scoreExact},
+		{"Release plan", "rel", scorePrefix},
+		{"Q1 release plan", "release", scoreWordPrefix},
+		{"Prerelease", "release", scoreContains},
+		{"prerelease, release", "release", scoreWordPrefix},
+		{"Board", "release", 0},
+	}
+	for _, tt := range tests {
+		if got := matchScore(tt.text, tt.query); got != tt.want {
+			t.Errorf("matchScore(%q, %q) = %v, want %v", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := "시작 " + strings.Repeat("가", 100) + "\n배포 일정 " + strings.Repeat("나", 100)
+	got := []rune(snippet(text, "배포"))
+	if got[0] != '…' || got[len(got)-1] != '…' {
+		t.Errorf("snippet should be elided on both sides, got %q", string(got))
+	}
+	if want := "가 배포 일정 나"; !strings.Contains(string(got), want) {
+		t.Errorf("snippet should contain the match on one line %q, got %q", want, string(got))
+	}
+	if got := snippet("short\n text", "text"); got != "short text" {
+		t.Errorf("snippet(short) = %q", got)
+	}
+}
+
+// TestSearchService_SearchBoards는 전문 검색 결과 변환, 페이지 처리, 권한 검사를 테스트합니다.
+func TestSearchService_SearchBoards(t *testing.T) {
+	workspaceID, userID := uuid.New(), uuid.New()
+	byTitle, byComment := uuid.New(), uuid.New()
+
+	repo := &MockSearchRepository{
+		FullTextSearchBoardsFunc: func(ctx context.Context, ws, user uuid.UUID, query string, limit, offset int) ([]repository.BoardFullTextRow, error) {
+			if ws != workspaceID || user != userID || query != "release" || offset != 2 {
+				t.Errorf("unexpected full-text search args: %s %s %q offset=%d", ws, user, query, offset)
+			}
+			if limit != 3 {
+				t.Errorf("expected limit+1 = 3 rows to be requested, got %d", limit)
+			}
+			return []repository.BoardFullTextRow{
+				{ID: byTitle, Title: "Release notes", Content: "draft the release notes", Rank: 0.6},
+				{ID: byComment, Title: "QA", Content: "checklist", MatchedComment: "blocked until the release branch is cut", Rank: 0.3},
+				{ID: uuid.New(), Title: "Release retro", Rank: 0.1},
+			}, nil
+		},
+	}
+	s := newTestSearchService(repo, nil, &MockUserClient{})
+
+	result, err := s.SearchBoards(context.Background(), userID, &dto.BoardSearchRequest{WorkspaceID: workspaceID, Query: " release ", Limit: 2, Offset: 2}, "token")
+	if err != nil {
+		t.Fatalf("SearchBoards() error = %v", err)
+	}
+	if !result.HasMore || len(result.Boards) != 2 {
+		t.Fatalf("got %d boards (hasMore=%v), want 2 with more", len(result.Boards), result.HasMore)
+	}
+	if hit := result.Boards[0]; hit.BoardID != byTitle || hit.MatchedIn != dto.BoardMatchedInBoard || hit.Score != 0.6 {
+		t.Errorf("first hit = %+v, want the title match", hit)
+	}
+	if hit := result.Boards[1]; hit.BoardID != byComment || hit.MatchedIn != dto.BoardMatchedInComment || !strings.Contains(hit.Snippet, "release branch") {
+		t.Errorf("second hit = %+v, want the comment match with a comment snippet", hit)
+	}
+}
+
+func TestSearchService_SearchBoards_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		member      bool
+		wantErrCode string
+	}{
+		{name: "빈 검색어", query: "   ", member: true, wantErrCode: response.ErrCodeValidation},
+		{name: "너무 긴 검색어", query: strings.Repeat("a", maxSearchQueryLen+1), member: true, wantErrCode: response.ErrCodeValidation},
+		{name: "워크스페이스 멤버 아님", query: "a", member: false, wantErrCode: response.ErrCodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userClient := &MockUserClient{
+				ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+					return tt.member, nil
+				},
+			}
+			s := newTestSearchService(&MockSearchRepository{}, nil, userClient)
+
+			_, err := s.SearchBoards(context.Background(), uuid.New(), &dto.BoardSearchRequest{WorkspaceID: uuid.New(), Query: tt.query}, "token")
+			var appErr *response.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+				t.Errorf("expected %s error, got %v", tt.wantErrCode, err)
+			}
+		})
+	}
+}
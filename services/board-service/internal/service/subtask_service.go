@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/position"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// SubtaskService defines the interface for board checklist business logic
+type SubtaskService interface {
+	CreateSubtask(ctx context.Context, boardID uuid.UUID, req *dto.CreateSubtaskRequest) (*dto.SubtaskResponse, error)
+	GetSubtasks(ctx context.Context, boardID uuid.UUID) ([]*dto.SubtaskResponse, error)
+	UpdateSubtask(ctx context.Context, boardID, subtaskID uuid.UUID, req *dto.UpdateSubtaskRequest) (*dto.SubtaskResponse, error)
+	DeleteSubtask(ctx context.Context, boardID, subtaskID uuid.UUID) error
+}
+
+// subtaskServiceImpl is the implementation of SubtaskService
+type subtaskServiceImpl struct {
+	subtaskRepo repository.SubtaskRepository
+	boardRepo   repository.BoardRepository
+	db          *gorm.DB // optional, serializes position assignment per board
+}
+
+// NewSubtaskService creates a new instance of SubtaskService
+func NewSubtaskService(subtaskRepo repository.SubtaskRepository, boardRepo repository.BoardRepository, db *gorm.DB) SubtaskService {
+	return &subtaskServiceImpl{
+		subtaskRepo: subtaskRepo,
+		boardRepo:   boardRepo,
+		db:          db,
+	}
+}
+
+// inTx runs fn in a transaction when a database is configured
+func (s *subtaskServiceImpl) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.db == nil {
+		return fn(ctx)
+	}
+	return uow.Transaction(ctx, s.db, fn)
+}
+
+// CreateSubtask appends a subtask to the end of a board's checklist
+func (s *subtaskServiceImpl) CreateSubtask(ctx context.Context, boardID uuid.UUID, req *dto.CreateSubtaskRequest) (*dto.SubtaskResponse, error) {
+	if err := s.verifyBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	subtask := &domain.Subtask{BoardID: boardID, Title: req.Title}
+	if req.AssigneeID != nil && *req.AssigneeID != uuid.Nil {
+		subtask.AssigneeID = req.AssigneeID
+	}
+
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		last, err := s.subtaskRepo.MaxPosition(ctx, boardID)
+		if err != nil {
+			return err
+		}
+		if subtask.Position, err = position.Between(last, ""); err != nil {
+			return err
+		}
+		return s.subtaskRepo.Create(ctx, subtask)
+	}); err != nil {
+		return nil, response.NewInternalError("Failed to create subtask", err.Error())
+	}
+
+	return toSubtaskResponse(subtask), nil
+}
+
+// GetSubtasks retrieves all subtasks of a board in checklist order
+func (s *subtaskServiceImpl) GetSubtasks(ctx context.Context, boardID uuid.UUID) ([]*dto.SubtaskResponse, error) {
+	if err := s.verifyBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	subtasks, err := s.subtaskRepo.FindByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to fetch subtasks", err.Error())
+	}
+
+	responses := make([]*dto.SubtaskResponse, len(subtasks))
+	for i, subtask := range subtasks {
+		responses[i] = toSubtaskResponse(subtask)
+	}
+	return responses, nil
+}
+
+// UpdateSubtask updates a subtask's title, done state or assignee and optionally moves it
+// between two other subtasks of the same board
+func (s *subtaskServiceImpl) UpdateSubtask(ctx context.Context, boardID, subtaskID uuid.UUID, req *dto.UpdateSubtaskRequest) (*dto.SubtaskResponse, error) {
+	for _, neighbor := range []*uuid.UUID{req.PrevSubtaskID, req.NextSubtaskID} {
+		if neighbor != nil && *neighbor == subtaskID {
+			return nil, response.NewValidationError("A subtask cannot be placed next to itself", "")
+		}
+	}
+
+	var subtask *domain.Subtask
+	if err := s.inTx(ctx, func(ctx context.Context) error {
+		reorder := req.PrevSubtaskID != nil || req.NextSubtaskID != nil
+		if reorder {
+			// 보드 행을 잠근 뒤 이웃 위치를 읽으므로 동시 추가/이동과 위치가 겹치지 않음
+			if _, err := s.subtaskRepo.MaxPosition(ctx, boardID); err != nil {
+				return response.NewInternalError("Failed to update subtask", err.Error())
+			}
+		}
+
+		var err error
+		subtask, err = s.findSubtask(ctx, boardID, subtaskID)
+		if err != nil {
+			return err
+		}
+
+		if req.Title != nil {
+			subtask.Title = *req.Title
+		}
+		if req.Done != nil {
+			subtask.Done = *req.Done
+		}
+		if req.AssigneeID != nil {
+			if *req.AssigneeID == uuid.Nil {
+				subtask.AssigneeID = nil
+			} else {
+				subtask.AssigneeID = req.AssigneeID
+			}
+		}
+		if reorder {
+			if subtask.Position, err = s.neighborPosition(ctx, boardID, req); err != nil {
+				return err
+			}
+		}
+
+		if err := s.subtaskRepo.Update(ctx, subtask); err != nil {
+			return response.NewInternalError("Failed to update subtask", err.Error())
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return toSubtaskResponse(subtask), nil
+}
+
+// DeleteSubtask soft deletes a subtask
+func (s *subtaskServiceImpl) DeleteSubtask(ctx context.Context, boardID, subtaskID uuid.UUID) error {
+	if _, err := s.findSubtask(ctx, boardID, subtaskID); err != nil {
+		return err
+	}
+	if err := s.subtaskRepo.Delete(ctx, subtaskID); err != nil {
+		return response.NewInternalError("Failed to delete subtask", err.Error())
+	}
+	return nil
+}
+
+// verifyBoard returns a not found error when the board does not exist
+func (s *subtaskServiceImpl) verifyBoard(ctx context.Context, boardID uuid.UUID) error {
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return response.NewInternalError("Failed to verify board", err.Error())
+	}
+	return nil
+}
+
+// findSubtask finds a subtask of the board; a subtask of another board is reported as not found
+func (s *subtaskServiceImpl) findSubtask(ctx context.Context, boardID, subtaskID uuid.UUID) (*domain.Subtask, error) {
+	subtask, err := s.subtaskRepo.FindByID(ctx, subtaskID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Subtask not found", "")
+		}
+		return nil, response.NewInternalError("Failed to fetch subtask", err.Error())
+	}
+	if subtask.BoardID != boardID {
+		return nil, response.NewAppError(response.ErrCodeNotFound, "Subtask not found", "")
+	}
+	return subtask, nil
+}
+
+// neighborPosition returns a position between the requested neighbor subtasks
+// (한쪽만 지정하면 목록의 처음 또는 끝 쪽으로 열린 구간)
+func (s *subtaskServiceImpl) neighborPosition(ctx context.Context, boardID uuid.UUID, req *dto.UpdateSubtaskRequest) (string, error) {
+	var ids []uuid.UUID
+	for _, id := range []*uuid.UUID{req.PrevSubtaskID, req.NextSubtaskID} {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	neighbors, err := s.subtaskRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return "", response.NewInternalError("Failed to fetch neighbor subtasks", err.Error())
+	}
+	positions := make(map[uuid.UUID]string, len(neighbors))
+	for _, n := range neighbors {
+		if n.BoardID == boardID {
+			positions[n.ID] = n.Position
+		}
+	}
+
+	var lower, upper string
+	if req.PrevSubtaskID != nil {
+		p, ok := positions[*req.PrevSubtaskID]
+		if !ok {
+			return "", response.NewValidationError("prevSubtaskId does not belong to the board", "")
+		}
+		lower = p
+	}
+	if req.NextSubtaskID != nil {
+		p, ok := positions[*req.NextSubtaskID]
+		if !ok {
+			return "", response.NewValidationError("nextSubtaskId does not belong to the board", "")
+		}
+		upper = p
+	}
+
+	between, err := position.Between(lower, upper)
+	if err != nil {
+		// 이웃 순서가 클라이언트가 본 것과 다름 (다른 사용자가 먼저 이동)
+		return "", response.NewAppError(response.ErrCodeConflict, "Subtask order has changed, reload the board and retry", err.Error())
+	}
+	return between, nil
+}
+
+// toSubtaskResponse converts domain.Subtask to dto.SubtaskResponse
+func toSubtaskResponse(subtask *domain.Subtask) *dto.SubtaskResponse {
+	return &dto.SubtaskResponse{
+		SubtaskID:  subtask.ID,
+		BoardID:    subtask.BoardID,
+		Title:      subtask.Title,
+		Done:       subtask.Done,
+		AssigneeID: subtask.AssigneeID,
+		Position:   subtask.Position,
+		CreatedAt:  subtask.CreatedAt,
+		UpdatedAt:  subtask.UpdatedAt,
+	}
+}
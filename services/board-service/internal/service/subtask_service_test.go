@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestSubtaskService_CreateSubtask_AppendsToChecklist(t *testing.T) {
+	boardID := uuid.New()
+	var created *domain.Subtask
+	subtaskRepo := &MockSubtaskRepository{
+		MaxPositionFunc: func(ctx context.Context, id uuid.UUID) (string, error) {
+			return "m", nil
+		},
+		CreateFunc: func(ctx context.Context, subtask *domain.Subtask) error {
+			created = subtask
+			return nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	s := NewSubtaskService(subtaskRepo, boardRepo, nil)
+
+	result, err := s.CreateSubtask(context.Background(), boardID, &dto.CreateSubtaskRequest{Title: "Write docs"})
+	if err != nil {
+		t.Fatalf("CreateSubtask() error = %v", err)
+	}
+	if created == nil || created.BoardID != boardID || created.Done {
+		t.Fatalf("created = %+v, want an open subtask of the board", created)
+	}
+	if result.Position <= "m" {
+		t.Errorf("position = %q, want after the board's last subtask", result.Position)
+	}
+}
+
+func TestSubtaskService_UpdateSubtask(t *testing.T) {
+	boardID, subtaskID, prevID, nextID, otherBoardSubtaskID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	done := true
+
+	tests := []struct {
+		name        string
+		req         dto.UpdateSubtaskRequest
+		urlBoardID  uuid.UUID
+		wantErrCode string
+		check       func(t *testing.T, saved *domain.Subtask)
+	}{
+		{
+			name:       "완료 처리",
+			req:        dto.UpdateSubtaskRequest{Done: &done},
+			urlBoardID: boardID,
+			check: func(t *testing.T, saved *domain.Subtask) {
+				if !saved.Done || saved.Position != "m" {
+					t.Errorf("saved = %+v, want done without moving", saved)
+				}
+			},
+		},
+		{
+			name:       "이웃 사이로 이동",
+			req:        dto.UpdateSubtaskRequest{PrevSubtaskID: &prevID, NextSubtaskID: &nextID},
+			urlBoardID: boardID,
+			check: func(t *testing.T, saved *domain.Subtask) {
+				if saved.Position <= "c" || saved.Position >= "e" {
+					t.Errorf("position = %q, want between prev and next", saved.Position)
+				}
+			},
+		},
+		{
+			name:        "이웃 순서가 뒤바뀜",
+			req:         dto.UpdateSubtaskRequest{PrevSubtaskID: &nextID, NextSubtaskID: &prevID},
+			urlBoardID:  boardID,
+			wantErrCode: response.ErrCodeConflict,
+		},
+		{
+			name:        "다른 보드의 이웃",
+			req:         dto.UpdateSubtaskRequest{NextSubtaskID: &otherBoardSubtaskID},
+			urlBoardID:  boardID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "다른 보드 경로로 접근",
+			req:         dto.UpdateSubtaskRequest{Done: &done},
+			urlBoardID:  uuid.New(),
+			wantErrCode: response.ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.Subtask
+			subtaskRepo := &MockSubtaskRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Subtask, error) {
+					return &domain.Subtask{BaseModel: domain.BaseModel{ID: subtaskID}, BoardID: boardID, Title: "task", Position: "m"}, nil
+				},
+				FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Subtask, error) {
+					return []*domain.Subtask{
+						{BaseModel: domain.BaseModel{ID: prevID}, BoardID: boardID, Position: "c"},
+						{BaseModel: domain.BaseModel{ID: nextID}, BoardID: boardID, Position: "e"},
+						{BaseModel: domain.BaseModel{ID: otherBoardSubtaskID}, BoardID: uuid.New(), Position: "d"},
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, subtask *domain.Subtask) error {
+					saved = subtask
+					return nil
+				},
+			}
+			s := NewSubtaskService(subtaskRepo, &MockBoardRepository{}, nil)
+
+			req := tt.req
+			_, err := s.UpdateSubtask(context.Background(), tt.urlBoardID, subtaskID, &req)
+
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("expected %s error, got %v", tt.wantErrCode, err)
+				}
+				if saved != nil {
+					t.Error("subtask should not be saved when the update is rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateSubtask() error = %v", err)
+			}
+			if saved == nil {
+				t.Fatal("expected the subtask to be saved")
+			}
+			tt.check(t, saved)
+		})
+	}
+}
+
+func TestBoardService_GetBoardsByProject_SubtaskProgress(t *testing.T) {
+	projectID := uuid.New()
+	withSubtasks, withoutSubtasks := uuid.New(), uuid.New()
+
+	boardRepo := &MockBoardRepository{
+		FindPageByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, filters interface{}, state domain.ArchiveState, cursor *repository.BoardCursor, limit int) ([]*domain.Board, error) {
+			return []*domain.Board{
+				{BaseModel: domain.BaseModel{ID: withSubtasks}, ProjectID: projectID},
+				{BaseModel: domain.BaseModel{ID: withoutSubtasks}, ProjectID: projectID},
+			}, nil
+		},
+	}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	countCalls := 0
+	subtaskRepo := &MockSubtaskRepository{
+		CountByBoardIDsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]repository.SubtaskCount, error) {
+			countCalls++
+			return map[uuid.UUID]repository.SubtaskCount{withSubtasks: {Total: 3, Done: 2}}, nil
+		},
+	}
+	s := NewBoardService(boardRepo, projectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, nil, zap.NewNop(), WithSubtasks(subtaskRepo))
+
+	page, err := s.GetBoardsByProject(context.Background(), projectID, &dto.BoardFilters{})
+	if err != nil {
+		t.Fatalf("GetBoardsByProject() error = %v", err)
+	}
+	if countCalls != 1 {
+		t.Errorf("CountByBoardIDs called %d times, want a single batch query", countCalls)
+	}
+
+	want := map[uuid.UUID]dto.SubtaskProgress{
+		withSubtasks:    {Total: 3, Done: 2, Percent: 66},
+		withoutSubtasks: {},
+	}
+	for _, board := range page.Boards {
+		if board.SubtaskProgress != want[board.ID] {
+			t.Errorf("board %s progress = %+v, want %+v", board.ID, board.SubtaskProgress, want[board.ID])
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WatcherService defines the interface for board watch subscriptions
+type WatcherService interface {
+	WatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchResponse, error)
+	UnwatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchResponse, error)
+}
+
+// watcherServiceImpl is the implementation of WatcherService
+type watcherServiceImpl struct {
+	watcherRepo repository.WatcherRepository
+	boardRepo   repository.BoardRepository
+}
+
+// NewWatcherService creates a new instance of WatcherService
+func NewWatcherService(watcherRepo repository.WatcherRepository, boardRepo repository.BoardRepository) WatcherService {
+	return &watcherServiceImpl{
+		watcherRepo: watcherRepo,
+		boardRepo:   boardRepo,
+	}
+}
+
+// WatchBoard subscribes the user to a board's update notifications (idempotent)
+func (s *watcherServiceImpl) WatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchResponse, error) {
+	if err := s.verifyBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+	if err := s.watcherRepo.Watch(ctx, boardID, userID); err != nil {
+		return nil, response.NewInternalError("Failed to watch board", err.Error())
+	}
+	return &dto.BoardWatchResponse{BoardID: boardID, UserID: userID, Watching: true}, nil
+}
+
+// UnwatchBoard unsubscribes the user from a board's update notifications (idempotent)
+func (s *watcherServiceImpl) UnwatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchResponse, error) {
+	if err := s.verifyBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+	if err := s.watcherRepo.Unwatch(ctx, boardID, userID); err != nil {
+		return nil, response.NewInternalError("Failed to unwatch board", err.Error())
+	}
+	return &dto.BoardWatchResponse{BoardID: boardID, UserID: userID, Watching: false}, nil
+}
+
+// verifyBoard returns a not found error when the board does not exist
+func (s *watcherServiceImpl) verifyBoard(ctx context.Context, boardID uuid.UUID) error {
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Board not found", "")
+		}
+		return response.NewInternalError("Failed to verify board", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+func TestWatcherService_WatchBoard(t *testing.T) {
+	boardID, userID := uuid.New(), uuid.New()
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			if id != boardID {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+		},
+	}
+
+	var watched, unwatched []uuid.UUID
+	watcherRepo := &MockWatcherRepository{
+		WatchFunc: func(ctx context.Context, bid, uid uuid.UUID) error {
+			watched = append(watched, uid)
+			return nil
+		},
+		UnwatchFunc: func(ctx context.Context, bid, uid uuid.UUID) error {
+			unwatched = append(unwatched, uid)
+			return nil
+		},
+	}
+	s := NewWatcherService(watcherRepo, boardRepo)
+
+	result, err := s.WatchBoard(context.Background(), boardID, userID)
+	if err != nil {
+		t.Fatalf("WatchBoard() error = %v", err)
+	}
+	if !result.Watching || len(watched) != 1 || watched[0] != userID {
+		t.Errorf("WatchBoard() = %+v (watched %v), want the user watching", result, watched)
+	}
+
+	result, err = s.UnwatchBoard(context.Background(), boardID, userID)
+	if err != nil {
+		t.Fatalf("UnwatchBoard() error = %v", err)
+	}
+	if result.Watching || len(unwatched) != 1 || unwatched[0] != userID {
+		t.Errorf("UnwatchBoard() = %+v (unwatched %v), want the user not watching", result, unwatched)
+	}
+
+	_, err = s.WatchBoard(context.Background(), uuid.New(), userID)
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeNotFound {
+		t.Errorf("expected NOT_FOUND for a missing board, got %v", err)
+	}
+}
+
+// recordingNotiClient collects the target users of sent notifications
+type recordingNotiClient struct {
+	targets chan uuid.UUID
+}
+
+func (c *recordingNotiClient) SendNotification(ctx context.Context, event *client.NotificationEvent) error {
+	c.targets <- event.TargetUserID
+	return nil
+}
+
+func (c *recordingNotiClient) SendBulkNotifications(ctx context.Context, events []*client.NotificationEvent) error {
+	for _, event := range events {
+		c.targets <- event.TargetUserID
+	}
+	return nil
+}
+
+// TestBoardService_UpdateNotificationsIncludeWatchers는 변경 알림이 담당자, 참여자, 구독자에게
+// 한 번씩 전송되고 변경한 사용자는 제외되는지 테스트합니다.
+func TestBoardService_UpdateNotificationsIncludeWatchers(t *testing.T) {
+	projectID, actorID, assigneeID, participantID, watcherID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    projectID,
+		AssigneeID:   &assigneeID,
+		Title:        "Release",
+		Participants: []domain.Participant{{UserID: participantID}, {UserID: actorID}},
+	}
+
+	noti := &recordingNotiClient{targets: make(chan uuid.UUID, 10)}
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: uuid.New()}, nil
+		},
+	}
+	watcherRepo := &MockWatcherRepository{
+		FindUserIDsByBoardIDFunc: func(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error) {
+			// 참여자이면서 구독한 사용자와 변경한 사용자는 중복/제외 처리되어야 함
+			return []uuid.UUID{watcherID, participantID, actorID}, nil
+		},
+	}
+	s := NewBoardService(&MockBoardRepository{}, projectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, noti, nil, zap.NewNop(), WithWatchers(watcherRepo)).(*boardServiceImpl)
+
+	s.sendBoardUpdateNotifications(context.Background(), board, actorID, []BoardChange{{Field: "title", OldValue: "a", NewValue: "b"}})
+
+	var got []uuid.UUID
+	for len(got) < 3 {
+		select {
+		case target := <-noti.targets:
+			got = append(got, target)
+		case <-time.After(time.Second):
+			t.Fatalf("received notifications for %v, want assignee, participant and watcher", got)
+		}
+	}
+	select {
+	case extra := <-noti.targets:
+		t.Errorf("unexpected extra notification for %s", extra)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	want := []uuid.UUID{assigneeID, participantID, watcherID}
+	sortUUIDs := func(ids []uuid.UUID) {
+		sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	}
+	sortUUIDs(got)
+	sortUUIDs(want)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("notified %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Package spreadsheet reads the rows of uploaded CSV and XLSX files.
+// XLSX는 외부 라이브러리 없이 첫 번째 시트의 셀 값만 읽습니다 (서식, 수식 계산, 병합 셀은 지원하지 않음).
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format identifies a supported spreadsheet file format
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+var (
+	// ErrUnsupportedFormat is returned for files that are neither CSV nor XLSX
+	ErrUnsupportedFormat = errors.New("spreadsheet: unsupported file format")
+	// ErrInvalidDate is returned by ParseDate for values that are not a date
+	ErrInvalidDate = errors.New("spreadsheet: invalid date")
+)
+
+// maxXMLBytes limits the uncompressed size of each XLSX part read (압축 폭탄 방지)
+const maxXMLBytes int64 = 64 << 20
+
+// maxRows is the row limit of an Excel worksheet
+const maxRows = 1 << 20
+
+// utf8BOM is prepended to CSV files exported by Excel
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// DetectFormat returns the format of a file from its name, falling back to the zip signature of XLSX files
+func DetectFormat(filename string, head []byte) (Format, error) {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	}
+	if bytes.HasPrefix(head, []byte("PK\x03\x04")) {
+		return FormatXLSX, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Read returns the rows of a CSV or XLSX file with every cell trimmed
+func Read(data []byte, format Format) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return ReadCSV(bytes.NewReader(data))
+	case FormatXLSX:
+		return ReadXLSX(bytes.NewReader(data), int64(len(data)))
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// ReadCSV returns the rows of a CSV file. 행마다 열 개수가 달라도 됩니다.
+func ReadCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet: invalid csv: %w", err)
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+	return rows, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+// xlsxRichText is a string made of a plain text or of formatted runs
+type xlsxRichText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Ref       string       `xml:"r,attr"`
+			Type      string       `xml:"t,attr"`
+			Value     string       `xml:"v"`
+			InlineStr xlsxRichText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX returns the rows of the first worksheet of an XLSX file.
+// 빈 행도 행 번호가 유지되도록 빈 슬라이스로 채웁니다.
+func ReadXLSX(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet: invalid xlsx: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var shared []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		var sst xlsxSharedStrings
+		if err := decodeXML(f, &sst); err != nil {
+			return nil, err
+		}
+		shared = make([]string, len(sst.Items))
+		for i, item := range sst.Items {
+			shared[i] = item.String()
+		}
+	}
+
+	sheet, ok := files[firstSheetPath(files)]
+	if !ok {
+		return nil, errors.New("spreadsheet: invalid xlsx: worksheet not found")
+	}
+	var ws xlsxWorksheet
+	if err := decodeXML(sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, row := range ws.Rows {
+		index := row.Index
+		if index <= 0 {
+			index = len(rows) + 1
+		}
+		if index > maxRows {
+			return nil, fmt.Errorf("spreadsheet: invalid xlsx: row %d out of range", index)
+		}
+		for len(rows) < index {
+			rows = append(rows, []string{})
+		}
+		var cells []string
+		for i, cell := range row.Cells {
+			col := i
+			if cell.Ref != "" {
+				if col, err = columnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			var value string
+			switch cell.Type {
+			case "s":
+				n, err := strconv.Atoi(cell.Value)
+				if err != nil || n < 0 || n >= len(shared) {
+					return nil, fmt.Errorf("spreadsheet: invalid xlsx: bad shared string index in %s", cell.Ref)
+				}
+				value = shared[n]
+			case "inlineStr":
+				value = cell.InlineStr.String()
+			case "b":
+				value = "FALSE"
+				if cell.Value == "1" {
+					value = "TRUE"
+				}
+			default: // n, str, e
+				value = cell.Value
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+			cells[col] = strings.TrimSpace(value)
+		}
+		rows[index-1] = cells
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the first worksheet of the workbook, falling back to the default sheet1.xml
+func firstSheetPath(files map[string]*zip.File) string {
+	const fallback = "xl/worksheets/sheet1.xml"
+	workbookFile, ok := files["xl/workbook.xml"]
+	relsFile, relsOK := files["xl/_rels/workbook.xml.rels"]
+	if !ok || !relsOK {
+		return fallback
+	}
+	var workbook xlsxWorkbook
+	var rels xlsxRelationships
+	if decodeXML(workbookFile, &workbook) != nil || decodeXML(relsFile, &rels) != nil || len(workbook.Sheets) == 0 {
+		return fallback
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+		return path.Join("xl", rel.Target)
+	}
+	return fallback
+}
+
+func decodeXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("spreadsheet: invalid xlsx: %w", err)
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(io.LimitReader(rc, maxXMLBytes)).Decode(v); err != nil {
+		return fmt.Errorf("spreadsheet: invalid xlsx: %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// columnIndex returns the zero-based column of a cell reference such as "C12"
+func columnIndex(ref string) (int, error) {
+	col := 0
+	i := 0
+	for ; i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z'; i++ {
+		col = col*26 + int(ref[i]-'A'+1)
+	}
+	if i == 0 || col > 16384 {
+		return 0, fmt.Errorf("spreadsheet: invalid xlsx: bad cell reference %q", ref)
+	}
+	return col - 1, nil
+}
+
+// excelEpoch is day zero of Excel serial dates (1900 날짜 체계의 윤년 버그 보정 포함)
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// ParseDate parses a date cell written as YYYY-MM-DD, RFC 3339 or an Excel serial date number
+func ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	// XLSX는 날짜 셀을 1899-12-30부터의 일수로 저장
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial >= 1 && serial < 2958466 {
+		days := math.Floor(serial)
+		seconds := math.Round((serial - days) * 86400)
+		return excelEpoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second), nil
+	}
+	return time.Time{}, ErrInvalidDate
+}
@@ -0,0 +1,137 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCSV(t *testing.T) {
+	input := "\xEF\xBB\xBFtitle,assignee, dueDate \n 로그인 구현 ,kim@example.com,2026-10-20\n\"쉼표, 포함\",\n"
+
+	got, err := ReadCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	want := [][]string{
+		{"title", "assignee", "dueDate"},
+		{"로그인 구현", "kim@example.com", "2026-10-20"},
+		{"쉼표, 포함", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadCSV() = %q, want %q", got, want)
+	}
+}
+
+// buildXLSX zips the given parts into an in-memory XLSX file
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Boards" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/boards.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>title</t></si><si><t>dueDate</t></si><si><r><t>로그인</t></r><r><t> 구현</t></r></si></sst>`,
+		// 두 번째 행은 비어 있고, 세 번째 행은 B열부터 값이 있음
+		"xl/worksheets/boards.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="inlineStr"><is><t>done</t></is></c></row>
+<row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3"><v>46315</v></c><c r="C3" t="b"><v>1</v></c></row>
+</sheetData></worksheet>`,
+	})
+
+	got, err := ReadXLSX(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("ReadXLSX() error = %v", err)
+	}
+	want := [][]string{
+		{"title", "dueDate", "", "done"},
+		{},
+		{"로그인 구현", "46315", "TRUE"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadXLSX() = %q, want %q", got, want)
+	}
+}
+
+func TestReadXLSX_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"zip이 아닌 파일": []byte("title,dueDate\n"),
+		"시트 없음":      buildXLSX(t, map[string]string{"xl/workbook.xml": "<workbook/>"}),
+		"잘못된 공유 문자열": buildXLSX(t, map[string]string{
+			"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>7</v></c></row></sheetData></worksheet>`,
+		}),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ReadXLSX(bytes.NewReader(data), int64(len(data))); err == nil {
+				t.Error("ReadXLSX() should fail")
+			}
+		})
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		filename string
+		head     []byte
+		want     Format
+		wantErr  bool
+	}{
+		{"boards.CSV", nil, FormatCSV, false},
+		{"boards.xlsx", nil, FormatXLSX, false},
+		{"upload", []byte("PK\x03\x04..."), FormatXLSX, false},
+		{"boards.xls", []byte{0xD0, 0xCF}, "", true},
+	}
+	for _, tt := range tests {
+		got, err := DetectFormat(tt.filename, tt.head)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("DetectFormat(%q) = %q, %v, want %q", tt.filename, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2026-10-20", time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"2026-10-20T09:30:00Z", time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)},
+		{"46315", time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"46315.5", time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.value)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", tt.value, got, err, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "20/10/2026", "2026-13-01", "0", "내일"} {
+		if _, err := ParseDate(value); err == nil {
+			t.Errorf("ParseDate(%q) should fail", value)
+		}
+	}
+}
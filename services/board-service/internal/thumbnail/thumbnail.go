@@ -0,0 +1,142 @@
+// Package thumbnail renders small JPEG previews of image attachments.
+// 외부 라이브러리 없이 표준 라이브러리로 JPEG, PNG, GIF(첫 프레임)만 지원하며, EXIF 회전 정보는 반영하지 않습니다.
+// 투명한 영역은 흰 배경으로 채웁니다.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // GIF 디코더 등록
+	"image/jpeg"
+	_ "image/png" // PNG 디코더 등록
+	"path"
+	"strings"
+)
+
+// Size is a thumbnail variant bounded by MaxEdge pixels on its longer side
+type Size struct {
+	Name    string
+	MaxEdge int
+}
+
+var (
+	// Small is shown on kanban cards and attachment lists
+	Small = Size{Name: "small", MaxEdge: 200}
+	// Medium is shown in the board detail view
+	Medium = Size{Name: "medium", MaxEdge: 800}
+)
+
+const (
+	// MaxPixels bounds the decoded image size (압축 폭탄 방지, RGBA 기준 약 100MB)
+	MaxPixels = 25_000_000
+	// jpegQuality is the quality of rendered thumbnails
+	jpegQuality = 80
+	// keyPrefix is the prefix of attachment file keys; thumbnails are stored beside them under thumbnails/
+	keyPrefix = "board/"
+)
+
+// SupportedContentTypes lists the attachment content types thumbnails can be rendered for
+var SupportedContentTypes = []string{"image/jpeg", "image/jpg", "image/png", "image/gif"}
+
+var (
+	// ErrUnsupportedImage is returned when the data is not a decodable JPEG, PNG or GIF image
+	ErrUnsupportedImage = errors.New("thumbnail: unsupported image")
+	// ErrImageTooLarge is returned when the image has more than MaxPixels pixels
+	ErrImageTooLarge = errors.New("thumbnail: image too large")
+)
+
+// Decode decodes an image after checking its dimensions, so oversized images are rejected before allocation
+func Decode(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	return img, nil
+}
+
+// Render scales img to fit size (never enlarging it) and encodes it as JPEG
+func Render(img image.Image, size Size) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := fit(bounds.Dx(), bounds.Dy(), size.MaxEdge)
+
+	// 투명 영역을 흰색으로 합성한 뒤 축소
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scale(src, width, height), &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Key returns the S3 key of a thumbnail for an attachment file key.
+// 예: board/boards/{workspace}/2024/01/a.png → board/thumbnails/small/boards/{workspace}/2024/01/a.jpg
+func Key(fileKey string, size Size) string {
+	rest := strings.TrimPrefix(fileKey, keyPrefix)
+	rest = strings.TrimSuffix(rest, path.Ext(rest))
+	return keyPrefix + "thumbnails/" + size.Name + "/" + rest + ".jpg"
+}
+
+// fit returns the dimensions of a width x height image scaled down so its longer side is at most maxEdge
+func fit(width, height, maxEdge int) (int, int) {
+	if width <= maxEdge && height <= maxEdge {
+		return width, height
+	}
+	if width >= height {
+		return maxEdge, max(1, height*maxEdge/width)
+	}
+	return max(1, width*maxEdge/height), maxEdge
+}
+
+// scale shrinks src to width x height by averaging the source pixels covered by each destination pixel
+func scale(src *image.RGBA, width, height int) *image.RGBA {
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcW == width && srcH == height {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for dy := 0; dy < height; dy++ {
+		y0 := dy * srcH / height
+		y1 := max(y0+1, (dy+1)*srcH/height)
+		for dx := 0; dx < width; dx++ {
+			x0 := dx * srcW / width
+			x1 := max(x0+1, (dx+1)*srcW/width)
+
+			var r, g, b, a, n int
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride:]
+				for x := x0; x < x1; x++ {
+					p := row[x*4 : x*4+4]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+			i := dst.PixOffset(dx, dy)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,85 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// encodePNG returns a width x height PNG whose left half is transparent and right half red
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := width / 2; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name                  string
+		width, height         int
+		size                  Size
+		wantWidth, wantHeight int
+	}{
+		{"가로가 긴 이미지", 1600, 900, Small, 200, 112},
+		{"세로가 긴 이미지", 900, 1600, Medium, 450, 800},
+		{"이미 작은 이미지는 키우지 않음", 120, 80, Medium, 120, 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := Decode(encodePNG(t, tt.width, tt.height))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			data, err := Render(img, tt.size)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			got, err := jpeg.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("thumbnail is not a JPEG: %v", err)
+			}
+			if b := got.Bounds(); b.Dx() != tt.wantWidth || b.Dy() != tt.wantHeight {
+				t.Fatalf("thumbnail = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.wantWidth, tt.wantHeight)
+			}
+			// 투명한 왼쪽은 흰색, 오른쪽은 빨간색
+			if r, g, b, _ := got.At(2, tt.wantHeight/2).RGBA(); r>>8 < 240 || g>>8 < 240 || b>>8 < 240 {
+				t.Errorf("transparent area = (%d,%d,%d), want white", r>>8, g>>8, b>>8)
+			}
+			if r, g, _, _ := got.At(tt.wantWidth-3, tt.wantHeight/2).RGBA(); r>>8 < 200 || g>>8 > 60 {
+				t.Errorf("opaque area = (%d,%d), want red", r>>8, g>>8)
+			}
+		})
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	if _, err := Decode([]byte("not an image")); !errors.Is(err, ErrUnsupportedImage) {
+		t.Errorf("Decode(text) error = %v, want ErrUnsupportedImage", err)
+	}
+	if _, err := Decode(encodePNG(t, 6000, 5000)); !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("Decode(30MP) error = %v, want ErrImageTooLarge", err)
+	}
+}
+
+func TestKey(t *testing.T) {
+	got := Key("board/boards/ws-1/2024/01/a1b2_1700000000.png", Small)
+	want := "board/thumbnails/small/boards/ws-1/2024/01/a1b2_1700000000.jpg"
+	if got != want {
+		t.Errorf("Key() = %q, want %q", got, want)
+	}
+}
@@ -31,7 +31,10 @@ import (
 
 	_ "chat-service/docs" // Swagger docs import
 
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -49,9 +52,16 @@ func main() {
 	}
 
 	// Initialize logger
-	logger := initLogger(cfg.Server.Env, cfg.Server.LogLevel)
+	logger, logLevel := initLogger(cfg.Server.Env, cfg.Server.LogLevel)
 	defer func() { _ = logger.Sync() }()
 
+	// Runtime settings hot-reload (RUNTIME_CONFIG_PATH, SIGHUP)
+	runtimeCfg := commonconfig.WatcherFromEnv(logger)
+	runtimeCfg.WatchLogLevel(logLevel)
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("chat-service")
@@ -115,6 +125,11 @@ func main() {
 		defer func() { _ = redisClient.Close() }()
 	}
 
+	// srv.Shutdown 이후 실행할 정리 단계 (하이재킹된 WebSocket 연결은 srv.Shutdown이 기다리지 않음)
+	shutdown := lifecycle.NewShutdown(logger)
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	shutdown.Add("profiling", profiling.Start(profiling.ConfigFromEnv(), logger))
+
 	// Setup router
 	r := router.Setup(router.RouterConfig{
 		Config:      cfg,
@@ -122,6 +137,8 @@ func main() {
 		RedisClient: redisClient,
 		Logger:      logger,
 		ServiceName: "chat-service",
+		Runtime:     runtimeCfg,
+		Shutdown:    shutdown,
 	})
 
 	// Create HTTP server
@@ -155,11 +172,12 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
+	shutdown.Run(ctx)
 
 	logger.Info("Server exited")
 }
 
-func initLogger(env, level string) *zap.Logger {
+func initLogger(env, level string) (*zap.Logger, zap.AtomicLevel) {
 	var config zap.Config
 
 	if env == "production" || env == "prod" {
@@ -185,5 +203,5 @@ func initLogger(env, level string) *zap.Logger {
 		panic(err)
 	}
 
-	return logger
+	return logger, config.Level
 }
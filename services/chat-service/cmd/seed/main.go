@@ -0,0 +1,58 @@
+// Command seed fills the chat-service database with the shared development dataset
+// (channels, participants, messages). Run it after the user-service seed command.
+//
+//	go run ./cmd/seed [-migrate]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"go.uber.org/zap"
+
+	"chat-service/internal/config"
+	"chat-service/internal/database"
+	"chat-service/internal/seed"
+)
+
+func main() {
+	migrate := flag.Bool("migrate", false, "run auto migration before seeding")
+	flag.Parse()
+
+	if err := commonseed.CheckEnvironment(os.Getenv("ENV")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load("configs/config.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+	if *migrate {
+		cfg.Database.AutoMigrate = true
+	}
+
+	log, err := commonlogger.NewWithConfig(&commonlogger.Config{Level: "info", OutputPath: "stdout", Encoding: "console"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = log.Sync() }()
+
+	db, err := database.NewDB(cfg)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	data := commonseed.Default()
+	if err := seed.Run(context.Background(), db, data); err != nil {
+		log.Fatal("Failed to seed chat-service", zap.Error(err))
+	}
+
+	log.Info("Seeded chat-service", zap.Int("channels", len(data.Channels)))
+}
@@ -39,7 +39,7 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 	)
 
 	var response commonclient.WorkspaceValidationResponse
-	if err := c.DoRequest(ctx, "GET", url, token, &response); err != nil {
+	if err := c.DoRequestWithRetry(ctx, "GET", url, token, &response); err != nil {
 		c.Logger.Error("Failed to validate workspace member",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -133,3 +133,14 @@ type ChatWithUnread struct {
 	Chat
 	UnreadCount int64 `json:"unreadCount"`
 }
+
+// MessageSearchResult represents a message matched by search, with its chat name
+type MessageSearchResult struct {
+	MessageID   uuid.UUID   `json:"messageId"`
+	ChatID      uuid.UUID   `json:"chatId"`
+	ChatName    string      `json:"chatName"`
+	UserID      uuid.UUID   `json:"userId"`
+	Content     string      `json:"content"`
+	MessageType MessageType `json:"messageType"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
@@ -83,7 +83,7 @@ func (h *ChatHandler) GetMyChats(c *gin.Context) {
 	log.Debug("GetMyChats completed",
 		zap.String("enduser.id", userID.String()),
 		zap.Int("chat.count", len(chats)))
-	response.Success(c, chats)
+	response.OK(c, chats)
 }
 
 // GetWorkspaceChats returns chats in a workspace
@@ -110,7 +110,7 @@ func (h *ChatHandler) GetWorkspaceChats(c *gin.Context) {
 	log.Debug("GetWorkspaceChats completed",
 		zap.String("workspace.id", workspaceID.String()),
 		zap.Int("chat.count", len(chats)))
-	response.Success(c, chats)
+	response.OK(c, chats)
 }
 
 // GetChat returns a specific chat
@@ -149,7 +149,7 @@ func (h *ChatHandler) GetChat(c *gin.Context) {
 	}
 
 	log.Debug("GetChat completed", zap.String("chat.id", chatID.String()))
-	response.Success(c, chat)
+	response.OK(c, chat)
 }
 
 // DeleteChat soft deletes a chat (creator only)
@@ -234,7 +234,7 @@ func (h *ChatHandler) AddParticipants(c *gin.Context) {
 	log.Info("Participants added",
 		zap.String("chat.id", chatID.String()),
 		zap.Int("added.count", len(req.UserIDs)))
-	response.Success(c, chat)
+	response.OK(c, chat)
 }
 
 // RemoveParticipant removes a participant from a chat
@@ -10,7 +10,9 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 )
 
 // 최대 파일 크기: 50MB
@@ -79,7 +81,7 @@ func (h *FileHandler) GeneratePresignedURL(c *gin.Context) {
 	userIDValue, exists := c.Get("user_id")
 	if !exists {
 		log.Warn("GeneratePresignedURL user not authenticated")
-		response.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		response.SendError(c, http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "User not authenticated")
 		return
 	}
 
@@ -88,22 +90,21 @@ func (h *FileHandler) GeneratePresignedURL(c *gin.Context) {
 		// 문자열인 경우 파싱 시도
 		userIDStr, ok := userIDValue.(string)
 		if !ok {
-			response.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid user ID format")
+			response.SendError(c, http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid user ID format")
 			return
 		}
 		var err error
 		userID, err = uuid.Parse(userIDStr)
 		if err != nil {
-			response.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid user ID format")
+			response.SendError(c, http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid user ID format")
 			return
 		}
 	}
 
 	// 요청 바인딩
 	var req PresignedURLRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		log.Warn("GeneratePresignedURL validation failed", zap.Error(err))
-		response.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body")
 		return
 	}
 
@@ -112,7 +113,7 @@ func (h *FileHandler) GeneratePresignedURL(c *gin.Context) {
 		log.Warn("GeneratePresignedURL file too large",
 			zap.Int64("fileSize", req.FileSize),
 			zap.Int64("maxSize", MaxFileSize))
-		response.SendError(c, http.StatusBadRequest, "FILE_TOO_LARGE", "File size exceeds 50MB limit")
+		response.SendError(c, http.StatusBadRequest, apperrors.ErrCodeFileTooLarge, "File size exceeds 50MB limit")
 		return
 	}
 
@@ -120,14 +121,14 @@ func (h *FileHandler) GeneratePresignedURL(c *gin.Context) {
 	if !allowedImageTypes[req.ContentType] {
 		log.Warn("GeneratePresignedURL invalid content type",
 			zap.String("contentType", req.ContentType))
-		response.SendError(c, http.StatusBadRequest, "INVALID_FILE_TYPE", "Only image files are allowed (jpeg, png, gif, webp)")
+		response.SendError(c, http.StatusBadRequest, apperrors.ErrCodeInvalidFileType, "Only image files are allowed (jpeg, png, gif, webp)")
 		return
 	}
 
 	// 워크스페이스 ID 검증
 	if _, err := uuid.Parse(req.WorkspaceID); err != nil {
 		log.Warn("GeneratePresignedURL invalid workspace ID")
-		response.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid workspace ID")
+		response.SendError(c, http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace ID")
 		return
 	}
 
@@ -146,7 +147,7 @@ func (h *FileHandler) GeneratePresignedURL(c *gin.Context) {
 	)
 	if err != nil {
 		log.Error("GeneratePresignedURL failed to generate URL", zap.Error(err))
-		response.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to generate presigned URL")
+		response.SendError(c, http.StatusInternalServerError, apperrors.ErrCodeInternal, "Failed to generate presigned URL")
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"chat-service/internal/response"
 	"chat-service/internal/service"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -55,7 +56,35 @@ func (h *MessageHandler) GetMessages(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, messages)
+	response.OK(c, messages)
+}
+
+// SearchMessages searches messages in the user's chats within a workspace
+func (h *MessageHandler) SearchMessages(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	workspaceID, err := uuid.Parse(c.Query("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		response.BadRequest(c, "Search query is required")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	messages, err := h.chatService.SearchMessages(c.Request.Context(), workspaceID, userID, query, limit)
+	if err != nil {
+		h.logger.Error("failed to search messages", zap.Error(err))
+		response.InternalError(c, "Failed to search messages")
+		return
+	}
+
+	response.OK(c, messages)
 }
 
 // SendMessage sends a message to a chat
@@ -37,7 +37,7 @@ func (h *PresenceHandler) GetOnlineUsers(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, presences)
+	response.OK(c, presences)
 }
 
 // GetUserStatus returns a user's online status
@@ -54,5 +54,5 @@ func (h *PresenceHandler) GetUserStatus(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, presence)
+	response.OK(c, presence)
 }
@@ -0,0 +1,165 @@
+//go:build integration
+
+package repository
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"chat-service/internal/config"
+	"chat-service/internal/database"
+	"chat-service/internal/domain"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// setupPostgres runs the service's own migrations (including the partial unique indexes)
+func setupPostgres(t *testing.T) *gorm.DB {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Database.URL = integration.PostgresDSN(t)
+	cfg.Database.AutoMigrate = true
+
+	db, err := database.NewDB(cfg)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func createChat(t *testing.T, repo *ChatRepository, creator uuid.UUID, members ...uuid.UUID) *domain.Chat {
+	t.Helper()
+	chat := &domain.Chat{
+		WorkspaceID: uuid.New(),
+		ChatType:    domain.ChatTypeGroup,
+		ChatName:    "general",
+		CreatedBy:   creator,
+	}
+	if err := repo.Create(chat); err != nil {
+		t.Fatalf("failed to create chat: %v", err)
+	}
+	for _, userID := range append([]uuid.UUID{creator}, members...) {
+		if err := repo.AddParticipant(&domain.ChatParticipant{ChatID: chat.ID, UserID: userID}); err != nil {
+			t.Fatalf("AddParticipant() error = %v", err)
+		}
+	}
+	return chat
+}
+
+func sendMessage(t *testing.T, repo *MessageRepository, chatID, userID uuid.UUID, content string) *domain.Message {
+	t.Helper()
+	message := &domain.Message{ChatID: chatID, UserID: userID, Content: content, MessageType: domain.MessageTypeText}
+	if err := repo.Create(message); err != nil {
+		t.Fatalf("failed to create message: %v", err)
+	}
+	return message
+}
+
+func TestChatRepository_AddParticipantIsIdempotent(t *testing.T) {
+	db := setupPostgres(t)
+	chats := NewChatRepository(db)
+	alice, bob := uuid.New(), uuid.New()
+	chat := createChat(t, chats, alice, bob)
+
+	// Adding an active participant again must hit the partial unique index instead of duplicating
+	if err := chats.AddParticipant(&domain.ChatParticipant{ChatID: chat.ID, UserID: bob}); err != nil {
+		t.Fatalf("AddParticipant() again error = %v", err)
+	}
+
+	var count int64
+	if err := db.Model(&domain.ChatParticipant{}).
+		Where("chat_id = ? AND user_id = ?", chat.ID, bob).
+		Count(&count).Error; err != nil {
+		t.Fatalf("failed to count participants: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("participant rows = %d, want 1", count)
+	}
+}
+
+func TestMessageRepository_SoftDeleteHidesMessage(t *testing.T) {
+	db := setupPostgres(t)
+	chats := NewChatRepository(db)
+	messages := NewMessageRepository(db)
+	alice, bob := uuid.New(), uuid.New()
+	chat := createChat(t, chats, alice, bob)
+
+	first := sendMessage(t, messages, chat.ID, alice, "first")
+	deleted := sendMessage(t, messages, chat.ID, alice, "deleted")
+	sendMessage(t, messages, chat.ID, bob, "reply")
+
+	if err := messages.SoftDelete(deleted.ID); err != nil {
+		t.Fatalf("SoftDelete() error = %v", err)
+	}
+
+	if _, err := messages.GetByID(deleted.ID); err == nil {
+		t.Error("GetByID() should not return a soft-deleted message")
+	}
+	history, err := messages.GetByChatID(chat.ID, 50, nil)
+	if err != nil {
+		t.Fatalf("GetByChatID() error = %v", err)
+	}
+	if len(history) != 2 || history[0].ID != first.ID {
+		t.Errorf("GetByChatID() returned %d messages, want 2 in chronological order", len(history))
+	}
+
+	// Bob's unread count ignores his own reply and the deleted message
+	unread, err := messages.GetUnreadCount(chat.ID, bob, nil)
+	if err != nil {
+		t.Fatalf("GetUnreadCount() error = %v", err)
+	}
+	if unread != 1 {
+		t.Errorf("GetUnreadCount() = %d, want 1", unread)
+	}
+}
+
+func TestChatRepository_GetUserChats(t *testing.T) {
+	db := setupPostgres(t)
+	chats := NewChatRepository(db)
+	messages := NewMessageRepository(db)
+	alice, bob := uuid.New(), uuid.New()
+
+	active := createChat(t, chats, alice, bob)
+	removed := createChat(t, chats, alice, bob)
+	sendMessage(t, messages, active.ID, alice, "hello")
+	sendMessage(t, messages, active.ID, alice, "are you there?")
+
+	if err := chats.SoftDelete(removed.ID); err != nil {
+		t.Fatalf("SoftDelete() error = %v", err)
+	}
+
+	result, err := chats.GetUserChats(bob)
+	if err != nil {
+		t.Fatalf("GetUserChats() error = %v", err)
+	}
+	if len(result) != 1 || result[0].ID != active.ID {
+		t.Fatalf("GetUserChats() returned %d chats, want only the active chat", len(result))
+	}
+	if result[0].UnreadCount != 2 {
+		t.Errorf("UnreadCount = %d, want 2", result[0].UnreadCount)
+	}
+
+	// Reading up to now clears the unread count
+	if err := chats.UpdateLastReadAt(active.ID, bob); err != nil {
+		t.Fatalf("UpdateLastReadAt() error = %v", err)
+	}
+	result, err = chats.GetUserChats(bob)
+	if err != nil {
+		t.Fatalf("GetUserChats() error = %v", err)
+	}
+	if len(result) != 1 || result[0].UnreadCount != 0 {
+		t.Errorf("UnreadCount after reading = %v, want 0", result)
+	}
+}
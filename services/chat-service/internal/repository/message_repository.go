@@ -53,6 +53,23 @@ func (r *MessageRepository) GetByChatID(chatID uuid.UUID, limit int, before *uui
 	return messages, err
 }
 
+// Search는 사용자가 참여 중인 워크스페이스 채팅방의 메시지를 내용으로 검색합니다.
+// 최신 메시지부터 반환합니다.
+func (r *MessageRepository) Search(workspaceID, userID uuid.UUID, query string, limit int) ([]domain.MessageSearchResult, error) {
+	var results []domain.MessageSearchResult
+	err := r.db.Table("messages").
+		Select("messages.id AS message_id, messages.chat_id, chats.chat_name, messages.user_id, messages.content, messages.message_type, messages.created_at").
+		Joins("JOIN chats ON chats.id = messages.chat_id").
+		Joins("JOIN chat_participants ON chat_participants.chat_id = messages.chat_id").
+		Where("chats.workspace_id = ? AND chats.deleted_at IS NULL AND messages.deleted_at IS NULL", workspaceID).
+		Where("chat_participants.user_id = ? AND chat_participants.is_active = ?", userID, true).
+		Where("messages.content ILIKE ?", "%"+query+"%").
+		Order("messages.created_at DESC").
+		Limit(limit).
+		Scan(&results).Error
+	return results, err
+}
+
 func (r *MessageRepository) SoftDelete(id uuid.UUID) error {
 	now := time.Now()
 	return r.db.Model(&domain.Message{}).
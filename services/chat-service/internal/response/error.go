@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
 // AppError는 공통 에러 패키지의 타입 alias입니다.
@@ -96,85 +97,67 @@ func HandleServiceError(c *gin.Context, err error) {
 		Forbidden(c, "You are not a member of this workspace")
 
 	default:
-		// 타입화된 AppError 처리
-		if appErr := apperrors.AsAppError(err); appErr != nil {
-			Error(c, appErr)
-			return
-		}
-		// 기본 내부 에러
-		InternalError(c, "An internal error occurred")
+		// 타입화된 AppError 및 기타 에러는 공통 매핑 사용
+		commonresponse.HandleError(c, err)
 	}
 }
 
 // Error sends an error response using the common AppError type.
 func Error(c *gin.Context, err *apperrors.AppError) {
-	status := apperrors.GetHTTPStatus(err.Code)
-	c.JSON(status, gin.H{
-		"success": false,
-		"error": gin.H{
-			"code":    err.Code,
-			"message": err.Message,
-		},
-	})
+	commonresponse.ErrorFrom(c, err)
 }
 
 // BadRequest sends a 400 Bad Request response.
 func BadRequest(c *gin.Context, message string) {
-	Error(c, apperrors.BadRequest(message, ""))
+	commonresponse.BadRequest(c, message)
 }
 
 // ValidationError sends a 400 response for validation errors.
 func ValidationError(c *gin.Context, message string) {
-	Error(c, apperrors.Validation(message, ""))
+	commonresponse.ValidationError(c, message)
 }
 
 // Unauthorized sends a 401 Unauthorized response.
 func Unauthorized(c *gin.Context, message string) {
-	Error(c, apperrors.Unauthorized(message, ""))
+	commonresponse.Unauthorized(c, message)
 }
 
 // Forbidden sends a 403 Forbidden response.
 func Forbidden(c *gin.Context, message string) {
-	Error(c, apperrors.Forbidden(message, ""))
+	commonresponse.Forbidden(c, message)
 }
 
 // NotFound sends a 404 Not Found response.
 func NotFound(c *gin.Context, message string) {
-	Error(c, apperrors.NotFound(message, ""))
+	commonresponse.NotFound(c, message)
 }
 
 // Conflict sends a 409 Conflict response.
 func Conflict(c *gin.Context, message string) {
-	Error(c, apperrors.Conflict(message, ""))
+	commonresponse.Conflict(c, message)
 }
 
 // InternalError sends a 500 Internal Server Error response.
 func InternalError(c *gin.Context, message string) {
-	Error(c, apperrors.Internal(message, ""))
+	commonresponse.InternalError(c, message)
 }
 
-// Success sends a 200 OK response with success wrapper and message.
-func Success(c *gin.Context, message interface{}) {
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"message": message,
-	})
+// Success sends a 200 OK response with a message.
+func Success(c *gin.Context, message string) {
+	commonresponse.SuccessWithMessage(c, http.StatusOK, nil, message)
 }
 
-// OK sends a 200 OK response with success wrapper and data.
+// OK sends a 200 OK response with data.
 func OK(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data":    data,
-	})
+	commonresponse.OK(c, data)
 }
 
 // Created sends a 201 Created response with data.
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, data)
+	commonresponse.Created(c, data)
 }
 
 // NoContent sends a 204 No Content response.
 func NoContent(c *gin.Context) {
-	c.Status(http.StatusNoContent)
+	commonresponse.NoContent(c)
 }
@@ -1,26 +1,35 @@
 package router
 
 import (
+	"net/http"
+
 	"chat-service/internal/client"
 	"chat-service/internal/config"
 	"chat-service/internal/handler"
 	"chat-service/internal/metrics"
 	"chat-service/internal/middleware"
 	"chat-service/internal/repository"
+	"chat-service/internal/response"
 	"chat-service/internal/service"
 	"chat-service/internal/websocket"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	// swaggerFiles "github.com/swaggo/files"
 	// ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/tenancy"
 )
 
 // RouterConfig holds router configuration
@@ -30,6 +39,10 @@ type RouterConfig struct {
 	RedisClient *redis.Client
 	Logger      *zap.Logger
 	ServiceName string // Service name for OTEL tracing
+	// Shutdown이 있으면 종료 시 WebSocket 연결을 close 프레임으로 정리하는 단계를 등록합니다.
+	Shutdown *lifecycle.Shutdown
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
 }
 
 func Setup(routerCfg RouterConfig) *gin.Engine {
@@ -56,8 +69,22 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	r.Use(commonmw.Recovery(logger))
 	r.Use(commonmw.OTELTracing(serviceName))                 // OpenTelemetry HTTP tracing (otelgin)
 	r.Use(commonmw.LoggerWithTracing(logger, serviceName))   // Request logging with trace context
-	r.Use(commonmw.DefaultCORS())
+	r.Use(commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), routerCfg.Runtime))
 	r.Use(metrics.HTTPMiddleware(m))
+	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes)) // Request body size limit (1MB)
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	r.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), routerCfg.Runtime, logger))
+	// Response compression (br/gzip for responses over 1KB)
+	r.Use(commonmw.Compress(commonmw.DefaultCompressConfig()))
+
+	// DB/Redis 메트릭 (요청 trace를 exemplar로 연결)
+	if err := commonotel.EnableGORMMetrics(db, m.Metrics); err != nil {
+		logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+	}
+	if err := commonotel.EnableGORMPoolMetrics(db, m.Metrics); err != nil {
+		logger.Warn("Failed to enable DB pool metrics", zap.Error(err))
+	}
+	commonotel.EnableRedisMetrics(redisClient, m.Metrics)
 
 	// Rate limiting middleware
 	if cfg.RateLimit.Enabled && redisClient != nil {
@@ -66,7 +93,8 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 			WithBurstSize(cfg.RateLimit.BurstSize).
 			WithKeyPrefix("rl:chat:")
 		limiter := ratelimit.NewRedisRateLimiter(redisClient, rlConfig, logger)
-		r.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, logger))
+		ratelimit.WatchSettings(limiter, routerCfg.Runtime)
+		r.Use(ratelimit.PolicyMiddleware(limiter, ratelimit.NewPolicies(), logger))
 		logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimit.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimit.BurstSize))
@@ -80,9 +108,12 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	presenceRepo := repository.NewPresenceRepository(db)
 
 	// Initialize user client for workspace validation
+	// 멤버십 결과는 캐시하고 워크스페이스 지정 요청은 tenancy 미들웨어에서 한 번 확인
 	var userClient client.UserClient
+	var membership *tenancy.CachedChecker
 	if cfg.UserAPI.BaseURL != "" {
-		userClient = client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger)
+		membership = tenancy.NewCachedChecker(client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger), tenancy.DefaultCacheConfig())
+		userClient = membership
 		logger.Info("User client initialized", zap.String("url", cfg.UserAPI.BaseURL))
 	} else {
 		logger.Warn("User service URL not configured, workspace validation will be skipped")
@@ -116,6 +147,7 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 
 	// Initialize WebSocket hub
 	wsHub := websocket.NewHub(chatService, presenceService, wsValidator, redisClient, logger)
+	routerCfg.Shutdown.Add("websocket", wsHub.Shutdown)
 
 	// Initialize S3 client (optional, for file uploads)
 	var fileHandler *handler.FileHandler
@@ -137,17 +169,19 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	presenceHandler := handler.NewPresenceHandler(presenceService, logger)
 
 	// Health check routes (using common package)
-	healthChecker := commonhealth.NewHealthChecker(db, redisClient)
+	healthChecker := commonhealth.NewHealthChecker(db, redisClient).
+		AddDownstream("auth-service", cfg.Auth.ServiceURL, commonhealth.AuthServiceLivenessPath).
+		AddDownstream("user-service", cfg.UserAPI.BaseURL, commonhealth.LivenessPath)
 	healthChecker.RegisterRoutes(r, cfg.Server.BasePath)
 
 	// Prometheus metrics endpoint
-	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Swagger documentation (disabled for faster builds)
 	// r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// API routes with base path
-	api := r.Group(cfg.Server.BasePath)
+	// API routes with base path (/api/chats와 /api/v1/chats 모두 현재 v1 라우트로 처리)
+	api := apiversion.WithLegacy(r, cfg.Server.BasePath, apiversion.V1)
 	{
 
 		// WebSocket endpoints (static route must come before dynamic route)
@@ -157,6 +191,9 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 		// Authenticated routes
 		authenticated := api.Group("")
 		authenticated.Use(authMiddleware)
+		if membership != nil {
+			authenticated.Use(tenancy.Middleware(membership, logger))
+		}
 		{
 			// Chat routes
 			authenticated.POST("", chatHandler.CreateChat)
@@ -168,7 +205,8 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 			authenticated.DELETE("/:chatId/participants/:userId", chatHandler.RemoveParticipant)
 
 			// Message routes
-			authenticated.GET("/messages/:chatId", messageHandler.GetMessages)
+			authenticated.GET("/messages/search", messageHandler.SearchMessages)
+			authenticated.GET("/messages/:chatId", commonmw.ETag(), messageHandler.GetMessages)
 			authenticated.POST("/messages/:chatId", messageHandler.SendMessage)
 			authenticated.DELETE("/messages/:messageId", messageHandler.DeleteMessage)
 			authenticated.POST("/messages/read", messageHandler.MarkMessagesAsRead)
@@ -187,10 +225,7 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 				// This prevents CloudFront from returning index.html for 404
 				authenticated.POST("/files/presigned-url", func(c *gin.Context) {
 					logger.Warn("File upload attempted but S3 is not configured")
-					c.JSON(503, gin.H{
-						"error":   "SERVICE_UNAVAILABLE",
-						"message": "File upload service is not available. S3 is not configured.",
-					})
+					response.SendError(c, http.StatusServiceUnavailable, apperrors.ErrCodeServiceUnavailable, "File upload service is not available. S3 is not configured.")
 				})
 			}
 		}
@@ -0,0 +1,64 @@
+// Package seed writes the chat-service part of the development dataset.
+package seed
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"chat-service/internal/domain"
+
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+)
+
+// Run inserts chats, participants and messages from data. Existing rows are left untouched.
+// Messages are spread over the last hour so the newest one is the last in each channel.
+func Run(ctx context.Context, db *gorm.DB, data *commonseed.Dataset) error {
+	now := time.Now().UTC()
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, c := range data.Channels {
+			if err := commonseed.Insert(tx, &domain.Chat{
+				ID:          c.ID,
+				WorkspaceID: c.WorkspaceID,
+				ProjectID:   c.ProjectID,
+				ChatType:    domain.ChatType(c.Type),
+				ChatName:    c.Name,
+				CreatedBy:   c.CreatedBy,
+				CreatedAt:   now.Add(-time.Hour),
+				UpdatedAt:   now,
+			}); err != nil {
+				return err
+			}
+
+			for _, userID := range c.Members {
+				if err := commonseed.Insert(tx, &domain.ChatParticipant{
+					ID:       commonseed.ID("chat-participant", c.ID.String()+"/"+userID.String()),
+					ChatID:   c.ID,
+					UserID:   userID,
+					JoinedAt: now.Add(-time.Hour),
+					IsActive: true,
+				}); err != nil {
+					return err
+				}
+			}
+
+			for i, m := range c.Messages {
+				sentAt := now.Add(-time.Duration(len(c.Messages)-i) * 10 * time.Minute)
+				if err := commonseed.Insert(tx, &domain.Message{
+					ID:          m.ID,
+					ChatID:      c.ID,
+					UserID:      m.UserID,
+					Content:     m.Content,
+					MessageType: domain.MessageTypeText,
+					CreatedAt:   sentAt,
+					UpdatedAt:   sentAt,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -354,6 +354,15 @@ func (s *ChatService) GetMessages(ctx context.Context, chatID uuid.UUID, limit i
 	return s.messageRepo.GetByChatID(chatID, limit, before)
 }
 
+// SearchMessages는 사용자가 참여 중인 채팅방에서 메시지를 검색합니다.
+// 참여하지 않은 채팅방의 메시지는 결과에 포함되지 않습니다.
+func (s *ChatService) SearchMessages(ctx context.Context, workspaceID, userID uuid.UUID, query string, limit int) ([]domain.MessageSearchResult, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.messageRepo.Search(workspaceID, userID, query, limit)
+}
+
 // DeleteMessage는 메시지를 소프트 삭제합니다.
 // 메시지 작성자만 삭제할 수 있습니다.
 func (s *ChatService) DeleteMessage(ctx context.Context, messageID, userID uuid.UUID) error {
@@ -17,8 +17,23 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+)
+
+// WebSocket ERROR 프레임의 에러 코드 (공통 에러 카탈로그에 등록되어 category가 함께 전송됨)
+const (
+	ErrCodeInvalidMessage   = "INVALID_MESSAGE"
+	ErrCodeInvalidMessageID = "INVALID_MESSAGE_ID"
+	ErrCodeSendFailed       = "SEND_FAILED"
 )
 
+func init() {
+	apperrors.MustRegister(apperrors.CodeInfo{Code: ErrCodeInvalidMessage, Category: apperrors.CategoryValidation, HTTPStatus: http.StatusBadRequest, Description: "WebSocket message could not be parsed"})
+	apperrors.MustRegister(apperrors.CodeInfo{Code: ErrCodeInvalidMessageID, Category: apperrors.CategoryValidation, HTTPStatus: http.StatusBadRequest, Description: "WebSocket message references an invalid message ID"})
+	apperrors.MustRegister(apperrors.CodeInfo{Code: ErrCodeSendFailed, Category: apperrors.CategoryInternal, HTTPStatus: http.StatusInternalServerError, Description: "Chat message could not be stored or delivered"})
+}
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -306,7 +321,7 @@ func (c *Client) handleMessage(data []byte) {
 	}
 
 	if err := json.Unmarshal(data, &msg); err != nil {
-		c.sendError("INVALID_MESSAGE", "Invalid message format")
+		c.sendError(ErrCodeInvalidMessage, "Invalid message format")
 		return
 	}
 
@@ -327,7 +342,7 @@ func (c *Client) handleMessage(data []byte) {
 			FileSize:    msg.FileSize,
 		})
 		if err != nil {
-			c.sendError("SEND_FAILED", "Failed to send message")
+			c.sendError(ErrCodeSendFailed, "Failed to send message")
 			return
 		}
 
@@ -358,7 +373,7 @@ func (c *Client) handleMessage(data []byte) {
 	case "READ_MESSAGE":
 		messageID, err := uuid.Parse(msg.MessageID)
 		if err != nil {
-			c.sendError("INVALID_MESSAGE_ID", "Invalid message ID")
+			c.sendError(ErrCodeInvalidMessageID, "Invalid message ID")
 			return
 		}
 
@@ -376,13 +391,72 @@ func (c *Client) handleMessage(data []byte) {
 
 func (c *Client) sendError(code, message string) {
 	response, _ := json.Marshal(map[string]interface{}{
-		"type":    "ERROR",
-		"code":    code,
-		"message": message,
+		"type":     "ERROR",
+		"code":     code,
+		"category": apperrors.CategoryOf(code),
+		"message":  message,
 	})
 	c.Send <- response
 }
 
+// Shutdown sends a close frame (1001 Going Away) to every chat and presence client and waits until they disconnect.
+// 클라이언트가 응답하면 readPump가 등록 해제와 오프라인 처리를 수행하고,
+// ctx가 끝날 때까지 끊기지 않은 연결은 강제로 닫습니다.
+func (h *Hub) Shutdown(ctx context.Context) error {
+	conns := h.connections()
+	if len(conns) == 0 {
+		return nil
+	}
+	h.logger.Info("Closing WebSocket connections", zap.Int("connections", len(conns)))
+
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	for _, conn := range conns {
+		// WriteControl은 writePump의 쓰기와 동시에 호출해도 안전합니다.
+		_ = conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(10*time.Second))
+	}
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		remaining := h.connections()
+		if len(remaining) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			for _, conn := range remaining {
+				_ = conn.Close()
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// connections returns the connections of all registered chat and presence clients
+func (h *Hub) connections() []*websocket.Conn {
+	var conns []*websocket.Conn
+
+	h.mu.RLock()
+	for _, clients := range h.userConnections {
+		for client := range clients {
+			conns = append(conns, client.Conn)
+		}
+	}
+	h.mu.RUnlock()
+
+	presenceClients.RLock()
+	for _, clients := range presenceClients.clients {
+		for client := range clients {
+			conns = append(conns, client.Conn)
+		}
+	}
+	presenceClients.RUnlock()
+
+	return conns
+}
+
 // PresenceClient represents a connected client for presence tracking
 type PresenceClient struct {
 	Hub    *Hub
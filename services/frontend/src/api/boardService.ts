@@ -10,7 +10,21 @@ import {
   CreateBoardRequest,
   UpdateBoardRequest,
   MoveBoardRequest, // 추가
+  MoveBoardResponse,
+  BoardColumnOrderResponse,
+  GroupedBoardsResponse,
+  BoardImportResponse,
+  PaginatedActivitiesResponse,
+  SetBoardRecurrenceRequest,
+  BoardSearchResponse,
+  BoardWatchResponse,
+  BoardRecurrenceResponse,
+  BulkBoardRequest,
+  BulkBoardResponse,
   BoardFilters,
+  ArchiveStateFilter,
+  BoardTrashResponse,
+  PaginatedBoardsResponse,
   ProjectResponse,
   CreateProjectRequest,
   UpdateProjectRequest,
@@ -18,22 +32,37 @@ import {
   ProjectInitSettingsResponse,
   ProjectMemberResponse,
   UpdateProjectMemberRoleRequest,
+  ProjectPermissionsResponse,
+  UpdateProjectPermissionsRequest,
   ProjectJoinRequestResponse,
   CreateProjectJoinRequestRequest,
   UpdateProjectJoinRequestRequest,
   CommentResponse,
   CreateCommentRequest,
   UpdateCommentRequest,
+  CommentReactionsResponse,
   ParticipantResponse,
   AddParticipantsRequest, // 변경 (AddParticipantRequest -> AddParticipantsRequest)
   AddParticipantsResponse, // 추가
   FieldOptionResponse,
   CreateFieldOptionRequest,
   UpdateFieldOptionRequest,
+  CustomFieldResponse,
+  CreateCustomFieldRequest,
+  UpdateCustomFieldRequest,
   AttachmentResponse,
   PresignedURLRequest, // 추가
   PresignedURLResponse, // 추가
   SaveAttachmentMetadataRequest, // 추가
+  SubtaskResponse,
+  BoardLinkResponse,
+  CreateBoardLinkRequest,
+  BoardTemplateResponse,
+  CreateBoardTemplateRequest,
+  UpdateBoardTemplateRequest,
+  CreateBoardFromTemplateRequest,
+  CreateSubtaskRequest,
+  UpdateSubtaskRequest,
 } from '../types/board';
 
 /**
@@ -64,10 +93,13 @@ export const getProjectInitSettings = async (
 // 프로젝트 관련 API
 // ============================================================================
 
-export const getProjects = async (workspaceId: string): Promise<ProjectResponse[]> => {
+export const getProjects = async (
+  workspaceId: string,
+  state?: ArchiveStateFilter,
+): Promise<ProjectResponse[]> => {
   try {
     const response: AxiosResponse<SuccessResponse<ProjectResponse[]>> =
-      await boardServiceClient.get(`/projects/workspace/${workspaceId}`);
+      await boardServiceClient.get(`/projects/workspace/${workspaceId}`, { params: state ? { state } : {} });
     return response.data.data || [];
   } catch (error) {
     console.error('getProjects error:', error);
@@ -169,6 +201,30 @@ export const deleteProject = async (projectId: string): Promise<void> => {
   }
 };
 
+export const archiveProject = async (projectId: string): Promise<ProjectResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<ProjectResponse>> = await boardServiceClient.post(
+      `/projects/${projectId}/archive`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('archiveProject error:', error);
+    throw error;
+  }
+};
+
+export const unarchiveProject = async (projectId: string): Promise<ProjectResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<ProjectResponse>> = await boardServiceClient.post(
+      `/projects/${projectId}/unarchive`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('unarchiveProject error:', error);
+    throw error;
+  }
+};
+
 export const searchProjects = async (
   workspaceId: string,
   query: string,
@@ -249,6 +305,42 @@ export const removeProjectMember = async (projectId: string, memberId: string):
   }
 };
 
+/**
+ * 프로젝트 권한 매트릭스와 내 권한을 조회합니다.
+ * [API] GET /api/projects/{projectId}/permissions
+ */
+export const getProjectPermissions = async (projectId: string): Promise<ProjectPermissionsResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<ProjectPermissionsResponse>> = await boardServiceClient.get(
+      `/projects/${projectId}/permissions`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('getProjectPermissions error:', error);
+    throw error;
+  }
+};
+
+/**
+ * 역할별 권한을 바꿉니다 (OWNER만 가능).
+ * [API] PUT /api/projects/{projectId}/permissions
+ */
+export const updateProjectPermissions = async (
+  projectId: string,
+  data: UpdateProjectPermissionsRequest,
+): Promise<ProjectPermissionsResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<ProjectPermissionsResponse>> = await boardServiceClient.put(
+      `/projects/${projectId}/permissions`,
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('updateProjectPermissions error:', error);
+    throw error;
+  }
+};
+
 // ============================================================================
 // 🔥 프로젝트 온라인 사용자 조회 API (WebSocket 연결 기반)
 // ============================================================================
@@ -259,9 +351,9 @@ export const removeProjectMember = async (projectId: string, memberId: string):
  */
 export const getProjectOnlineUsers = async (projectId: string): Promise<string[]> => {
   try {
-    const response: AxiosResponse<{ onlineUsers: string[]; count: number }> =
+    const response: AxiosResponse<{ data: { onlineUsers: string[]; count: number } }> =
       await boardServiceClient.get(`/projects/${projectId}/online-users`);
-    return response.data?.onlineUsers || [];
+    return response.data?.data?.onlineUsers || [];
   } catch (error) {
     console.error('getProjectOnlineUsers error:', error);
     return []; // 에러 시 빈 배열 반환
@@ -319,6 +411,24 @@ export const updateProjectJoinRequest = async (
 // 보드 관련 API
 // ============================================================================
 
+// 보드 목록은 커서 기반 페이지네이션 - 칸반 화면은 전체 보드가 필요하므로 마지막 페이지까지 조회
+const BOARD_PAGE_LIMIT = 200;
+
+const fetchAllBoardPages = async (url: string, params: any): Promise<BoardResponse[]> => {
+  const boards: BoardResponse[] = [];
+  let cursor: string | undefined;
+  do {
+    const response: AxiosResponse<SuccessResponse<PaginatedBoardsResponse>> =
+      await boardServiceClient.get(url, {
+        params: { ...params, limit: BOARD_PAGE_LIMIT, ...(cursor ? { cursor } : {}) },
+      });
+    const page = response.data.data;
+    boards.push(...(page?.boards || []));
+    cursor = page?.hasMore ? page.nextCursor : undefined;
+  } while (cursor);
+  return boards;
+};
+
 export const getBoards = async (
   projectId: string,
   filters?: BoardFilters,
@@ -350,12 +460,11 @@ export const getBoards = async (
     if (filters?.customFields) {
       params.customFields = JSON.stringify(filters.customFields);
     }
+    if (filters?.state) {
+      params.state = filters.state;
+    }
 
-    const response: AxiosResponse<SuccessResponse<BoardResponse[]>> = await boardServiceClient.get(
-      '/boards',
-      { params },
-    );
-    return response.data.data || [];
+    return await fetchAllBoardPages('/boards', params);
   } catch (error) {
     console.error('getBoards error:', error);
     throw error;
@@ -372,12 +481,11 @@ export const getBoardsByProject = async (
     if (filters?.customFields) {
       params.customFields = JSON.stringify(filters.customFields);
     }
+    if (filters?.state) {
+      params.state = filters.state;
+    }
 
-    const response: AxiosResponse<SuccessResponse<BoardResponse[]>> = await boardServiceClient.get(
-      `/boards/project/${projectId}`,
-      { params },
-    );
-    return response.data.data || [];
+    return await fetchAllBoardPages(`/boards/project/${projectId}`, params);
   } catch (error) {
     console.error('getBoardsByProject error:', error);
     throw error;
@@ -425,6 +533,74 @@ export const createBoard = async (data: CreateBoardRequest): Promise<BoardRespon
   }
 };
 
+export const createBoardFromTemplate = async (
+  templateId: string,
+  data: CreateBoardFromTemplateRequest,
+): Promise<BoardResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardResponse>> = await boardServiceClient.post(
+      `/boards/from-template/${templateId}`,
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('createBoardFromTemplate error:', error);
+    throw error;
+  }
+};
+
+// ============================================================================
+// 보드 템플릿 관련 API
+// ============================================================================
+
+export const getBoardTemplates = async (projectId: string): Promise<BoardTemplateResponse[]> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardTemplateResponse[]>> =
+      await boardServiceClient.get(`/projects/${projectId}/board-templates`);
+    return response.data.data || [];
+  } catch (error) {
+    console.error('getBoardTemplates error:', error);
+    throw error;
+  }
+};
+
+export const createBoardTemplate = async (
+  projectId: string,
+  data: CreateBoardTemplateRequest,
+): Promise<BoardTemplateResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardTemplateResponse>> =
+      await boardServiceClient.post(`/projects/${projectId}/board-templates`, data);
+    return response.data.data;
+  } catch (error) {
+    console.error('createBoardTemplate error:', error);
+    throw error;
+  }
+};
+
+export const updateBoardTemplate = async (
+  templateId: string,
+  data: UpdateBoardTemplateRequest,
+): Promise<BoardTemplateResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardTemplateResponse>> =
+      await boardServiceClient.put(`/board-templates/${templateId}`, data);
+    return response.data.data;
+  } catch (error) {
+    console.error('updateBoardTemplate error:', error);
+    throw error;
+  }
+};
+
+export const deleteBoardTemplate = async (templateId: string): Promise<void> => {
+  try {
+    await boardServiceClient.delete(`/board-templates/${templateId}`);
+  } catch (error) {
+    console.error('deleteBoardTemplate error:', error);
+    throw error;
+  }
+};
+
 export const updateBoard = async (
   boardId: string,
   data: UpdateBoardRequest,
@@ -450,19 +626,255 @@ export const deleteBoard = async (boardId: string): Promise<void> => {
   }
 };
 
+export const archiveBoard = async (boardId: string): Promise<BoardResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardResponse>> = await boardServiceClient.post(
+      `/boards/${boardId}/archive`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('archiveBoard error:', error);
+    throw error;
+  }
+};
+
+export const unarchiveBoard = async (boardId: string): Promise<BoardResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardResponse>> = await boardServiceClient.post(
+      `/boards/${boardId}/unarchive`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('unarchiveBoard error:', error);
+    throw error;
+  }
+};
+
+export const getBoardTrash = async (projectId: string): Promise<BoardTrashResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardTrashResponse>> = await boardServiceClient.get(
+      `/projects/${projectId}/trash`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('getBoardTrash error:', error);
+    throw error;
+  }
+};
+
+export const restoreBoard = async (boardId: string): Promise<BoardResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardResponse>> = await boardServiceClient.post(
+      `/boards/${boardId}/restore`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('restoreBoard error:', error);
+    throw error;
+  }
+};
+
 // ============================================================================
 // 보드 이동 API (WebSocket 실시간 동기화용)
 // ============================================================================
 
-export const moveBoard = async (boardId: string, data: MoveBoardRequest): Promise<void> => {
+export const moveBoard = async (boardId: string, data: MoveBoardRequest): Promise<MoveBoardResponse> => {
   try {
-    await boardServiceClient.put(`/boards/${boardId}/move`, data);
+    const response: AxiosResponse<SuccessResponse<MoveBoardResponse>> = await boardServiceClient.put(
+      `/boards/${boardId}/move`,
+      data,
+    );
+    return response.data.data;
   } catch (error) {
     console.error('moveBoard error:', error);
     throw error;
   }
 };
 
+export const getColumnOrder = async (
+  projectId: string,
+  groupByFieldName: string,
+  value: string,
+): Promise<BoardColumnOrderResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardColumnOrderResponse>> = await boardServiceClient.get(
+      `/boards/project/${projectId}/order`,
+      { params: { groupByFieldName, value } },
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('getColumnOrder error:', error);
+    throw error;
+  }
+};
+
+/**
+ * 프로젝트 보드를 칸반 컬럼별로 묶어 카드 순서대로 조회합니다.
+ * [API] GET /api/boards/project/{projectId}/grouped?by=
+ */
+export const getGroupedBoards = async (
+  projectId: string,
+  by: string,
+): Promise<GroupedBoardsResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<GroupedBoardsResponse>> = await boardServiceClient.get(
+      `/boards/project/${projectId}/grouped`,
+      { params: { by } },
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('getGroupedBoards error:', error);
+    throw error;
+  }
+};
+
+/**
+ * CSV/XLSX 파일로 보드를 일괄 생성합니다. dryRun이면 행별 검증 결과만 반환합니다.
+ * [API] POST /api/projects/{projectId}/boards/import
+ */
+export const importBoards = async (
+  projectId: string,
+  file: File,
+  dryRun: boolean,
+): Promise<BoardImportResponse> => {
+  try {
+    const formData = new FormData();
+    formData.append('file', file);
+    const response: AxiosResponse<SuccessResponse<BoardImportResponse>> = await boardServiceClient.post(
+      `/projects/${projectId}/boards/import`,
+      formData,
+      { params: { dryRun }, headers: { 'Content-Type': 'multipart/form-data' } },
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('importBoards error:', error);
+    throw error;
+  }
+};
+
+/**
+ * 프로젝트의 보드, 댓글, 참여자, 첨부파일 메타데이터를 파일로 내려받습니다.
+ * [API] GET /api/projects/{projectId}/export?format=json|csv
+ */
+export const exportProject = async (projectId: string, format: 'json' | 'csv' = 'json'): Promise<void> => {
+  try {
+    const response = await boardServiceClient.get(`/projects/${projectId}/export`, {
+      params: { format },
+      responseType: 'blob',
+    });
+
+    const url = window.URL.createObjectURL(new Blob([response.data]));
+    const link = document.createElement('a');
+    link.href = url;
+    link.setAttribute('download', `project-${projectId}.${format}`);
+    document.body.appendChild(link);
+    link.click();
+
+    link.remove();
+    window.URL.revokeObjectURL(url);
+  } catch (error) {
+    console.error('exportProject error:', error);
+    throw error;
+  }
+};
+
+export const getBoardActivities = async (
+  boardId: string,
+  cursor?: string,
+  limit?: number,
+): Promise<PaginatedActivitiesResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<PaginatedActivitiesResponse>> = await boardServiceClient.get(
+      `/boards/${boardId}/activities`,
+      { params: { cursor, limit } },
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('getBoardActivities error:', error);
+    throw error;
+  }
+};
+
+export const watchBoard = async (boardId: string): Promise<BoardWatchResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardWatchResponse>> = await boardServiceClient.post(
+      `/boards/${boardId}/watchers/me`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('watchBoard error:', error);
+    throw error;
+  }
+};
+
+export const unwatchBoard = async (boardId: string): Promise<BoardWatchResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardWatchResponse>> = await boardServiceClient.delete(
+      `/boards/${boardId}/watchers/me`,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('unwatchBoard error:', error);
+    throw error;
+  }
+};
+
+export const searchBoards = async (
+  workspaceId: string,
+  q: string,
+  limit?: number,
+  offset?: number,
+): Promise<BoardSearchResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardSearchResponse>> = await boardServiceClient.get(
+      '/boards/search',
+      { params: { workspaceId, q, limit, offset } },
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('searchBoards error:', error);
+    throw error;
+  }
+};
+
+export const setBoardRecurrence = async (
+  boardId: string,
+  data: SetBoardRecurrenceRequest,
+): Promise<BoardRecurrenceResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardRecurrenceResponse>> = await boardServiceClient.put(
+      `/boards/${boardId}/recurrence`,
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('setBoardRecurrence error:', error);
+    throw error;
+  }
+};
+
+export const deleteBoardRecurrence = async (boardId: string): Promise<void> => {
+  try {
+    await boardServiceClient.delete(`/boards/${boardId}/recurrence`);
+  } catch (error) {
+    console.error('deleteBoardRecurrence error:', error);
+    throw error;
+  }
+};
+
+export const bulkUpdateBoards = async (data: BulkBoardRequest): Promise<BulkBoardResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BulkBoardResponse>> = await boardServiceClient.post(
+      '/boards/bulk',
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('bulkUpdateBoards error:', error);
+    throw error;
+  }
+};
+
 // ============================================================================
 // 필드 옵션 관련 API
 // ============================================================================
@@ -518,6 +930,58 @@ export const deleteFieldOption = async (optionId: string): Promise<void> => {
   }
 };
 
+// ============================================================================
+// 프로젝트 커스텀 필드 관련 API
+// ============================================================================
+
+export const getCustomFields = async (projectId: string): Promise<CustomFieldResponse[]> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CustomFieldResponse[]>> =
+      await boardServiceClient.get(`/projects/${projectId}/custom-fields`);
+    return response.data.data || [];
+  } catch (error) {
+    console.error('getCustomFields error:', error);
+    throw error;
+  }
+};
+
+export const createCustomField = async (
+  projectId: string,
+  data: CreateCustomFieldRequest,
+): Promise<CustomFieldResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CustomFieldResponse>> =
+      await boardServiceClient.post(`/projects/${projectId}/custom-fields`, data);
+    return response.data.data;
+  } catch (error) {
+    console.error('createCustomField error:', error);
+    throw error;
+  }
+};
+
+export const updateCustomField = async (
+  fieldId: string,
+  data: UpdateCustomFieldRequest,
+): Promise<CustomFieldResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CustomFieldResponse>> =
+      await boardServiceClient.patch(`/custom-fields/${fieldId}`, data);
+    return response.data.data;
+  } catch (error) {
+    console.error('updateCustomField error:', error);
+    throw error;
+  }
+};
+
+export const deleteCustomField = async (fieldId: string): Promise<void> => {
+  try {
+    await boardServiceClient.delete(`/custom-fields/${fieldId}`);
+  } catch (error) {
+    console.error('deleteCustomField error:', error);
+    throw error;
+  }
+};
+
 // ============================================================================
 // 댓글 관련 API
 // ============================================================================
@@ -546,6 +1010,22 @@ export const getCommentsByBoard = async (boardId: string): Promise<CommentRespon
   }
 };
 
+/**
+ * 답글 스레드 조회: threaded=true이면 답글이 상위 댓글의 replies에 중첩됨
+ */
+export const getCommentThreads = async (boardId: string): Promise<CommentResponse[]> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CommentResponse[]>> =
+      await boardServiceClient.get(`/boards/${boardId}/comments`, {
+        params: { threaded: true },
+      });
+    return response.data.data || [];
+  } catch (error) {
+    console.error('getCommentThreads error:', error);
+    throw error;
+  }
+};
+
 export const createComment = async (data: CreateCommentRequest): Promise<CommentResponse> => {
   try {
     const response: AxiosResponse<SuccessResponse<CommentResponse>> = await boardServiceClient.post(
@@ -584,6 +1064,129 @@ export const deleteComment = async (commentId: string): Promise<void> => {
   }
 };
 
+export const addCommentReaction = async (
+  commentId: string,
+  emoji: string,
+): Promise<CommentReactionsResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CommentReactionsResponse>> =
+      await boardServiceClient.put(`/comments/${commentId}/reactions/${encodeURIComponent(emoji)}`);
+    return response.data.data;
+  } catch (error) {
+    console.error('addCommentReaction error:', error);
+    throw error;
+  }
+};
+
+export const removeCommentReaction = async (
+  commentId: string,
+  emoji: string,
+): Promise<CommentReactionsResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<CommentReactionsResponse>> =
+      await boardServiceClient.delete(`/comments/${commentId}/reactions/${encodeURIComponent(emoji)}`);
+    return response.data.data;
+  } catch (error) {
+    console.error('removeCommentReaction error:', error);
+    throw error;
+  }
+};
+
+// ============================================================================
+// 체크리스트(Subtask) 관련 API
+// ============================================================================
+
+export const getSubtasks = async (boardId: string): Promise<SubtaskResponse[]> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<SubtaskResponse[]>> =
+      await boardServiceClient.get(`/boards/${boardId}/subtasks`);
+    return response.data.data || [];
+  } catch (error) {
+    console.error('getSubtasks error:', error);
+    throw error;
+  }
+};
+
+export const createSubtask = async (
+  boardId: string,
+  data: CreateSubtaskRequest,
+): Promise<SubtaskResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<SubtaskResponse>> = await boardServiceClient.post(
+      `/boards/${boardId}/subtasks`,
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('createSubtask error:', error);
+    throw error;
+  }
+};
+
+export const updateSubtask = async (
+  boardId: string,
+  subtaskId: string,
+  data: UpdateSubtaskRequest,
+): Promise<SubtaskResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<SubtaskResponse>> = await boardServiceClient.put(
+      `/boards/${boardId}/subtasks/${subtaskId}`,
+      data,
+    );
+    return response.data.data;
+  } catch (error) {
+    console.error('updateSubtask error:', error);
+    throw error;
+  }
+};
+
+export const deleteSubtask = async (boardId: string, subtaskId: string): Promise<void> => {
+  try {
+    await boardServiceClient.delete(`/boards/${boardId}/subtasks/${subtaskId}`);
+  } catch (error) {
+    console.error('deleteSubtask error:', error);
+    throw error;
+  }
+};
+
+// ============================================================================
+// 보드 링크(의존 관계) 관련 API
+// ============================================================================
+
+export const getBoardLinks = async (boardId: string): Promise<BoardLinkResponse[]> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardLinkResponse[]>> =
+      await boardServiceClient.get(`/boards/${boardId}/links`);
+    return response.data.data || [];
+  } catch (error) {
+    console.error('getBoardLinks error:', error);
+    throw error;
+  }
+};
+
+export const createBoardLink = async (
+  boardId: string,
+  data: CreateBoardLinkRequest,
+): Promise<BoardLinkResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<BoardLinkResponse>> =
+      await boardServiceClient.post(`/boards/${boardId}/links`, data);
+    return response.data.data;
+  } catch (error) {
+    console.error('createBoardLink error:', error);
+    throw error;
+  }
+};
+
+export const deleteBoardLink = async (boardId: string, linkId: string): Promise<void> => {
+  try {
+    await boardServiceClient.delete(`/boards/${boardId}/links/${linkId}`);
+  } catch (error) {
+    console.error('deleteBoardLink error:', error);
+    throw error;
+  }
+};
+
 // ============================================================================
 // 참여자(Participant) 관련 API
 // ============================================================================
@@ -696,6 +1299,21 @@ export const uploadFileToS3 = async (uploadUrl: string, file: File): Promise<voi
   }
 };
 
+/**
+ * 3단계: S3 업로드를 확정합니다. 서버가 업로드된 객체를 요청한 크기/타입과 대조해 검증합니다.
+ * [API] POST /api/attachments/{attachmentId}/confirm
+ */
+export const confirmAttachmentUpload = async (attachmentId: string): Promise<AttachmentResponse> => {
+  try {
+    const response: AxiosResponse<SuccessResponse<AttachmentResponse>> =
+      await boardServiceClient.post(`/attachments/${attachmentId}/confirm`);
+    return response.data.data;
+  } catch (error) {
+    console.error('confirmAttachmentUpload error:', error);
+    throw error;
+  }
+};
+
 /**
  * 3단계: 업로드 완료 후 메타데이터를 서버에 저장합니다.
  * [API] POST /api/attachments
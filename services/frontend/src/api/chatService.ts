@@ -4,18 +4,19 @@ import { chatServiceClient } from './apiConfig';
 import { AxiosResponse } from 'axios';
 import type { Chat, Message, CreateChatRequest, SendMessageRequest } from '../types/chat';
 
-// chat-service 응답 wrapper 타입
+// chat-service 공통 응답 wrapper 타입 ({ success, data, requestId })
 interface ChatServiceResponse<T> {
-  message: T;
   success: boolean;
+  data: T;
+  requestId?: string;
 }
 
 // 응답에서 실제 데이터 추출 헬퍼
 const extractData = <T>(response: AxiosResponse<ChatServiceResponse<T> | T>): T => {
   const data = response.data;
-  // wrapper 형태인지 확인 (message 필드가 있고 success 필드가 있는 경우)
-  if (data && typeof data === 'object' && 'message' in data && 'success' in data) {
-    return (data as ChatServiceResponse<T>).message;
+  // wrapper 형태인지 확인 (data 필드가 있고 success 필드가 있는 경우)
+  if (data && typeof data === 'object' && 'data' in data && 'success' in data) {
+    return (data as ChatServiceResponse<T>).data;
   }
   // 직접 데이터인 경우
   return data as T;
@@ -17,7 +17,7 @@ export const getNotifications = async (
     params: { page, limit, unreadOnly },
     headers: { 'X-Workspace-Id': workspaceId },
   });
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -28,7 +28,7 @@ export const getUnreadCount = async (workspaceId: string): Promise<UnreadCount>
   const response = await notiServiceClient.get('/api/notifications/unread-count', {
     headers: { 'X-Workspace-Id': workspaceId },
   });
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -37,7 +37,7 @@ export const getUnreadCount = async (workspaceId: string): Promise<UnreadCount>
  */
 export const markAsRead = async (notificationId: string): Promise<Notification> => {
   const response = await notiServiceClient.patch(`/api/notifications/${notificationId}/read`);
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -166,6 +166,10 @@ export const getNotificationMessage = (notification: Notification): string => {
       return `${projectPrefix}"${resourceName}" 카드 마감이 임박했습니다.`;
     case 'BOARD_OVERDUE':
       return `${projectPrefix}"${resourceName}" 카드가 마감일을 초과했습니다.`;
+    case 'COMMENT_MENTION':
+      return `${projectPrefix}"${resourceName}" 카드 댓글에서 회원님을 멘션했습니다.`;
+    case 'COMMENT_REPLY':
+      return `${projectPrefix}"${resourceName}" 카드의 회원님 댓글에 답글이 달렸습니다.`;
     default:
       return '새 알림이 있습니다.';
   }
@@ -31,11 +31,10 @@ import { AxiosResponse } from 'axios';
  * [API] GET /api/workspaces/all
  */
 export const getMyWorkspaces = async (): Promise<UserWorkspaceResponse[]> => {
-  const response: AxiosResponse<UserWorkspaceResponse[]> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: UserWorkspaceResponse[] }> = await userRepoClient.get(
     '/api/workspaces/all',
   );
-  console.log(response.data);
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -44,10 +43,10 @@ export const getMyWorkspaces = async (): Promise<UserWorkspaceResponse[]> => {
  * @returns WorkspaceResponse 배열을 담은 Promise
  */
 export const getPublicWorkspaces = async (workspaceName: string): Promise<WorkspaceResponse[]> => {
-  const response: AxiosResponse<WorkspaceResponse[]> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: WorkspaceResponse[] }> = await userRepoClient.get(
     `/api/workspaces/public/${workspaceName}`,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -68,11 +67,11 @@ export const getWorkspace = async (workspaceId: string): Promise<WorkspaceRespon
  * * Response: WorkspaceResponse
  */
 export const createWorkspace = async (data: CreateWorkspaceRequest): Promise<WorkspaceResponse> => {
-  const response: AxiosResponse<WorkspaceResponse> = await userRepoClient.post(
+  const response: AxiosResponse<{ data: WorkspaceResponse }> = await userRepoClient.post(
     '/api/workspaces/create',
     data,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -106,10 +105,10 @@ export const deleteWorkspace = async (workspaceId: string): Promise<void> => {
 export const getWorkspaceSettings = async (
   workspaceId: string,
 ): Promise<WorkspaceSettingsResponse> => {
-  const response: AxiosResponse<WorkspaceSettingsResponse> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: WorkspaceSettingsResponse }> = await userRepoClient.get(
     `/api/workspaces/${workspaceId}/settings`,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -120,11 +119,11 @@ export const updateWorkspaceSettings = async (
   workspaceId: string,
   data: UpdateWorkspaceSettingsRequest,
 ): Promise<WorkspaceSettingsResponse> => {
-  const response: AxiosResponse<WorkspaceSettingsResponse> = await userRepoClient.put(
+  const response: AxiosResponse<{ data: WorkspaceSettingsResponse }> = await userRepoClient.put(
     `/api/workspaces/${workspaceId}/settings`,
     data,
   );
-  return response.data;
+  return response.data.data;
 };
 
 // ========================================
@@ -138,10 +137,10 @@ export const updateWorkspaceSettings = async (
 export const getWorkspaceMembers = async (
   workspaceId: string,
 ): Promise<WorkspaceMemberResponse[]> => {
-  const response: AxiosResponse<WorkspaceMemberResponse[]> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: WorkspaceMemberResponse[] }> = await userRepoClient.get(
     `/api/workspaces/${workspaceId}/members`,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -149,10 +148,10 @@ export const getWorkspaceMembers = async (
  * [API] GET /api/workspaces/{workspaceId}/pendingMembers
  */
 export const getPendingMembers = async (workspaceId: string): Promise<JoinRequestResponse[]> => {
-  const response: AxiosResponse<JoinRequestResponse[]> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: JoinRequestResponse[] }> = await userRepoClient.get(
     `/api/workspaces/${workspaceId}/pendingMembers`,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -239,12 +238,11 @@ export const getJoinRequests = async (
  */
 export const createJoinRequest = async (workspaceId: string): Promise<JoinRequestResponse> => {
   const data = { workspaceId };
-  const response: AxiosResponse<JoinRequestResponse> = await userRepoClient.post(
+  const response: AxiosResponse<{ data: JoinRequestResponse }> = await userRepoClient.post(
     '/api/workspaces/join-requests',
     data,
   );
-  console.log(response.data);
-  return response.data;
+  return response.data.data;
 };
 
 // ========================================
@@ -258,12 +256,12 @@ export const createJoinRequest = async (workspaceId: string): Promise<JoinReques
  * * Response: { data: UserProfileResponse }
  */
 export const getMyProfile = async (workspaceId: string): Promise<UserProfileResponse> => {
-  const response: AxiosResponse<UserProfileResponse> = await userRepoClient.get('/api/profiles/me', {
+  const response: AxiosResponse<{ data: UserProfileResponse }> = await userRepoClient.get('/api/profiles/me', {
     headers: {
       'X-Workspace-Id': workspaceId,
     },
   });
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -272,11 +270,10 @@ export const getMyProfile = async (workspaceId: string): Promise<UserProfileResp
  * * Response: UserProfileResponse[] (API 스펙에 따라 data 필드가 없을 수 있음)
  */
 export const getAllMyProfiles = async (): Promise<UserProfileResponse[]> => {
-  const response: AxiosResponse<UserProfileResponse[]> = await userRepoClient.get(
+  const response: AxiosResponse<{ data: UserProfileResponse[] }> = await userRepoClient.get(
     '/api/profiles/all/me',
   );
-  console.log(response.data);
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -286,7 +283,7 @@ export const getAllMyProfiles = async (): Promise<UserProfileResponse[]> => {
  * * Response: UserProfileResponse
  */
 export const updateMyProfile = async (data: UpdateProfileRequest): Promise<UserProfileResponse> => {
-  const response: AxiosResponse<UserProfileResponse> = await userRepoClient.put(
+  const response: AxiosResponse<{ data: UserProfileResponse }> = await userRepoClient.put(
     '/api/profiles/me',
     data,
     {
@@ -295,7 +292,7 @@ export const updateMyProfile = async (data: UpdateProfileRequest): Promise<UserP
       },
     },
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -330,11 +327,11 @@ export const setDefaultWorkspace = async (workspaceId: string): Promise<void> =>
 export const generateProfilePresignedUrl = async (
   data: PresignedUrlRequest,
 ): Promise<PresignedUrlResponse> => {
-  const response: AxiosResponse<PresignedUrlResponse> = await userRepoClient.post(
+  const response: AxiosResponse<{ data: PresignedUrlResponse }> = await userRepoClient.post(
     '/api/profiles/me/image/presigned-url',
     data,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -345,11 +342,11 @@ export const generateProfilePresignedUrl = async (
 export const saveProfileAttachmentMetadata = async (
   data: SaveAttachmentRequest,
 ): Promise<AttachmentResponse> => {
-  const response: AxiosResponse<AttachmentResponse> = await userRepoClient.post(
+  const response: AxiosResponse<{ data: AttachmentResponse }> = await userRepoClient.post(
     '/api/profiles/me/image/attachment',
     data,
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -366,7 +363,7 @@ export const updateProfileImage = async (
     workspaceId,
     attachmentId,
   };
-  const response: AxiosResponse<UserProfileResponse> = await userRepoClient.put(
+  const response: AxiosResponse<{ data: UserProfileResponse }> = await userRepoClient.put(
     '/api/profiles/me/image',
     data,
     {
@@ -375,7 +372,7 @@ export const updateProfileImage = async (
       },
     },
   );
-  return response.data;
+  return response.data.data;
 };
 
 /**
@@ -6,7 +6,7 @@ import { useTheme } from '../../contexts/ThemeContext';
 import { LoadingSpinner } from '../common/LoadingSpinner';
 import { getDefaultColorByIndex } from '../../constants/colors';
 import { ProjectResponse, BoardResponse, Column, ViewState, FieldOption } from '../../types/board';
-import { getBoardsByProject, moveBoard } from '../../api/boardService';
+import { getGroupedBoards, moveBoard } from '../../api/boardService';
 import { BoardDetailModal } from '../modals/board/BoardDetailModal';
 import { FilterBar } from '../modals/board/FilterBar';
 import { useAuth } from '../../contexts/AuthContext';
@@ -124,34 +124,19 @@ export const ProjectContent: React.FC<ProjectContentProps> = ({
     setIsLoading(true);
     setError(null);
     try {
-      const stages = stageOptions;
-      const boardsResponse = await getBoardsByProject(selectedProject.projectId);
+      // 컬럼 구성과 카드 순서(Redis 캐시 + DB position)는 서버에서 계산
+      const grouped = await getGroupedBoards(selectedProject.projectId, 'stage');
 
-      // 데이터 처리 로직
-      const stageMap = new Map<string, { stage: FieldOption; boards: BoardResponse[] }>();
-
-      stages.forEach((stage: FieldOption) => {
-        stageMap.set(stage.optionValue, { stage, boards: [] });
-      });
-
-      boardsResponse?.forEach((board: BoardResponse) => {
-        const stageId = board.customFields?.stage;
-
-        if (stageId && stageMap.has(stageId)) {
-          stageMap.get(stageId)!.boards.push(board);
-        } else {
-          console.warn(`⚠️ 보드 "${board.title}"에 유효하지 않은 Stage ID가 있습니다: ${stageId}`);
-        }
+      grouped.unassigned.forEach((board: BoardResponse) => {
+        console.warn(`⚠️ 보드 "${board.title}"에 유효하지 않은 Stage ID가 있습니다: ${board.customFields?.stage}`);
       });
 
-      const newColumns: Column[] = Array.from(stageMap.values())
-        .sort((a, b) => (a.stage as any).displayOrder - (b.stage as any).displayOrder)
-        .map(({ stage, boards }) => ({
-          stageId: stage.optionValue,
-          title: stage.optionLabel,
-          color: (stage as any).color,
-          boards: boards,
-        }));
+      const newColumns: Column[] = grouped.columns.map((column) => ({
+        stageId: column.fieldValue,
+        title: column.label,
+        color: column.color,
+        boards: column.boards,
+      }));
 
       console.log('📌 최종 newColumns:', newColumns);
       setColumns(newColumns);
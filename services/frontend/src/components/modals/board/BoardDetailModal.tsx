@@ -54,6 +54,7 @@ interface BoardState {
   fileUrl?: string;
   fileName?: string;
   attachments: AttachmentResponse[]; // 💡 첨부파일 배열 호환을 위해 추가
+  version: number; // 수정 시 충돌 감지용
 }
 
 const initialBoardState: BoardState = {
@@ -72,6 +73,7 @@ const initialBoardState: BoardState = {
   fileUrl: undefined,
   fileName: undefined,
   attachments: [],
+  version: 0,
 };
 
 interface BoardDetailModalProps {
@@ -93,6 +95,7 @@ interface BoardDetailModalProps {
     startDate?: string;
     participantIds?: string[];
     attachments?: AttachmentResponse[];
+    version?: number;
   }) => void;
   fieldOptionsLookup: {
     stages?: FieldOption[];
@@ -161,6 +164,7 @@ export const BoardDetailModal: React.FC<BoardDetailModalProps> = ({
           // 💡 단일 fileUrl 지원을 위해 첫 번째 첨부파일 매핑 (필요 시)
           fileUrl: data.attachments?.[0]?.fileUrl,
           fileName: data.attachments?.[0]?.fileName,
+          version: data.version,
         });
 
         setComments(data.comments || []);
@@ -557,6 +561,7 @@ export const BoardDetailModal: React.FC<BoardDetailModalProps> = ({
                   dueDate: boardData.dueDate,
                   startDate: boardData.startDate,
                   attachments: boardData.attachments,
+                  version: boardData.version,
                 });
               }}
               className="flex-1 px-4 py-2 bg-blue-500 text-white font-semibold rounded-lg hover:bg-blue-600 transition disabled:opacity-50 flex items-center justify-center gap-2"
@@ -52,6 +52,7 @@ interface BoardManageModalProps {
       fileSize: number;
       contentType: string;
     }>;
+    version?: number; // 조회 시점의 보드 버전 (수정 충돌 감지용)
   } | null;
   workspaceId: string;
   onClose: () => void;
@@ -261,7 +262,7 @@ export const BoardManageModal: React.FC<BoardManageModalProps> = ({
       });
 
       if (isEditing) {
-        await updateBoard(editData!.boardId, boardData);
+        await updateBoard(editData!.boardId, { ...boardData, version: editData!.version });
         alert('✅ 보드가 수정되었습니다!');
       } else {
         await createBoard(boardData as CreateBoardRequest);
@@ -271,7 +272,10 @@ export const BoardManageModal: React.FC<BoardManageModalProps> = ({
       onBoardCreated();
       onClose();
     } catch (err: any) {
-      const errorMsg = err.response?.data?.error?.message || err.message;
+      const errorMsg =
+        err.response?.status === 409
+          ? '다른 사용자가 먼저 보드를 수정했습니다. 최신 내용을 확인한 뒤 다시 저장해 주세요.'
+          : err.response?.data?.error?.message || err.message;
 
       // ✅ 상세 에러 로그 추가
       console.error('❌ 보드 저장 실패:', {
@@ -40,6 +40,8 @@ export interface AttachmentResponse {
   uploadedAt: string; // 업로드 일시
   status?: string; // 파일 상태
   expiresAt?: string; // 만료 일시 (임시 파일인 경우)
+  thumbnailUrl?: string; // 작은 썸네일 (이미지, 최대 200px) — 업로드 직후에는 아직 없을 수 있음
+  mediumThumbnailUrl?: string; // 중간 썸네일 (이미지, 최대 800px)
 }
 
 /**
@@ -99,6 +101,42 @@ export interface BoardResponse {
   updatedAt: string;
   participantIds?: string[]; // Swagger: participantIds
   attachments: AttachmentResponse[]; // 💡 [변경] 단일 URL -> 배열 객체
+  position: string; // 컬럼 내 카드 순서 (문자열 비교로 정렬)
+  subtaskProgress: SubtaskProgress;
+  recurrenceRule?: string; // 반복 보드의 cron 표현식 (반복하지 않으면 생략)
+  nextRecurrenceAt?: string;
+  state: ArchiveState;
+  archivedAt?: string; // 보관된 경우에만 포함
+  version: number; // 저장할 때마다 1씩 증가, 수정 요청과 WebSocket 이벤트에 사용
+}
+
+/**
+ * 보드/프로젝트 보관 상태. 목록 필터에서는 ALL(보관 여부와 관계없이 모두)도 사용 가능
+ */
+export type ArchiveState = 'ACTIVE' | 'ARCHIVED';
+export type ArchiveStateFilter = ArchiveState | 'ALL';
+
+/**
+ * @summary 휴지통의 보드 (dto.TrashedBoardResponse)
+ */
+export interface TrashedBoardResponse {
+  boardId: string;
+  projectId: string;
+  authorId: string;
+  assigneeId?: string;
+  title: string;
+  deletedAt: string;
+  purgeAt: string; // 이 시각에 댓글, 첨부파일과 함께 영구 삭제
+}
+
+/**
+ * @summary 프로젝트 휴지통 응답 DTO (dto.BoardTrashResponse)
+ * [API: GET /api/projects/{projectId}/trash]
+ */
+export interface BoardTrashResponse {
+  projectId: string;
+  retentionDays: number;
+  boards: TrashedBoardResponse[]; // 최근 삭제순
 }
 
 /**
@@ -108,9 +146,38 @@ export interface BoardResponse {
 export interface BoardDetailResponse extends BoardResponse {
   participants: ParticipantResponse[];
   comments: CommentResponse[];
+  links: BoardLinkResponse[]; // 이 보드 기준의 의존/관련 관계
   // attachments는 상속받은 BoardResponse에 이미 포함됨
 }
 
+/**
+ * 보드 링크 관계 (요청/응답 모두 경로의 보드 기준)
+ * - BLOCKS: 이 보드가 상대 보드를 막음 / BLOCKED_BY: 상대 보드가 이 보드를 막음
+ */
+export type BoardLinkType = 'BLOCKS' | 'BLOCKED_BY' | 'RELATES_TO';
+
+/**
+ * @summary 보드 링크 생성 요청 (dto.CreateBoardLinkRequest)
+ * [API: POST /api/boards/{boardId}/links]
+ * 의존 관계에 순환이 생기면 400
+ */
+export interface CreateBoardLinkRequest {
+  targetBoardId: string;
+  type: BoardLinkType;
+}
+
+/**
+ * @summary 보드 링크 응답 (dto.BoardLinkResponse)
+ * [API: GET /api/boards/{boardId}/links]
+ */
+export interface BoardLinkResponse {
+  linkId: string;
+  type: BoardLinkType;
+  boardId: string; // 연결된 상대 보드
+  title: string;
+  createdAt: string;
+}
+
 /**
  * @summary 보드 생성 요청 (dto.CreateBoardRequest)
  * [API: POST /api/boards]
@@ -127,6 +194,47 @@ export interface CreateBoardRequest {
   attachmentIds?: string[]; // 💡 [변경] fileUrl -> 업로드 완료된 attachment ID 배열
 }
 
+/**
+ * @summary 보드 템플릿 (dto.BoardTemplateResponse)
+ * [API: /api/projects/{projectId}/board-templates, /api/board-templates/{templateId}]
+ */
+export interface BoardTemplateResponse {
+  templateId: string;
+  projectId: string;
+  name: string;
+  title: string;
+  content: string;
+  customFields: Record<string, any>; // value 기반 (예: stage: 'in_progress')
+  participants: string[];
+  createdBy: string;
+  createdAt: string;
+  updatedAt: string;
+}
+
+export interface CreateBoardTemplateRequest {
+  name: string;
+  title?: string;
+  content?: string;
+  customFields?: Record<string, any>;
+  participants?: string[];
+}
+
+export type UpdateBoardTemplateRequest = Partial<CreateBoardTemplateRequest>;
+
+/**
+ * @summary 템플릿으로 보드 생성 요청 (dto.CreateBoardFromTemplateRequest)
+ * [API: POST /api/boards/from-template/{templateId}]
+ */
+export interface CreateBoardFromTemplateRequest {
+  title?: string; // 비어 있으면 템플릿 제목
+  customFields?: Record<string, any>; // 템플릿 값 위에 덮어씀
+  assigneeId?: string;
+  startDate?: string;
+  dueDate?: string;
+  participants?: string[]; // 템플릿 참여자에 추가
+  attachmentIds?: string[];
+}
+
 /**
  * @summary 보드 수정 요청 (dto.UpdateBoardRequest)
  * [API: PUT /api/boards/{boardId}]
@@ -139,6 +247,7 @@ export interface UpdateBoardRequest {
   dueDate?: string;
   customFields?: Record<string, any>;
   attachmentIds?: string[]; // 💡 [변경] 추가할 attachment ID 배열
+  version?: number; // 마지막으로 조회한 보드 버전 (필수, If-Match 헤더로 대신 보낼 수 있음). 다르면 409
 }
 
 /**
@@ -149,6 +258,215 @@ export interface MoveBoardRequest {
   projectId: string;
   groupByFieldName: string; // 예: 'stage'
   newFieldValue: string; // 예: 'in_progress'
+  prevBoardId?: string; // 놓은 위치 바로 위 카드 (없으면 생략)
+  nextBoardId?: string; // 놓은 위치 바로 아래 카드 (둘 다 생략하면 컬럼 맨 아래)
+}
+
+/**
+ * @summary 보드 이동 응답 (dto.MoveBoardResponse)
+ */
+export interface MoveBoardResponse {
+  boardId: string;
+  oldFieldValue: string;
+  newFieldValue: string;
+  position: string;
+  version: number;
+  message: string;
+}
+
+/**
+ * @summary 보드 구독 상태 (dto.BoardWatchResponse)
+ * [API: POST/DELETE /api/boards/{boardId}/watchers/me]
+ */
+export interface BoardWatchResponse {
+  boardId: string;
+  userId: string;
+  watching: boolean; // 구독자는 담당자/참여자가 아니어도 변경 알림을 받음
+}
+
+/**
+ * @summary 보드 전문 검색 결과 (dto.BoardSearchHit)
+ */
+export interface BoardSearchHit {
+  boardId: string;
+  projectId: string;
+  projectName: string;
+  title: string;
+  snippet?: string; // matchedIn이 'comment'이면 댓글 내용 일부
+  matchedIn?: 'board' | 'comment';
+  updatedAt: string;
+  score: number;
+}
+
+/**
+ * @summary 보드 전문 검색 응답 (dto.BoardSearchResponse)
+ * [API: GET /api/boards/search?workspaceId=&q=]
+ */
+export interface BoardSearchResponse {
+  query: string;
+  boards: BoardSearchHit[];
+  hasMore: boolean;
+  limit: number;
+  offset: number;
+}
+
+/**
+ * @summary 보드 반복 설정 요청 (dto.SetBoardRecurrenceRequest)
+ * [API: PUT /api/boards/{boardId}/recurrence]
+ */
+export interface SetBoardRecurrenceRequest {
+  rule: string; // cron 표현식 (예: 'CRON_TZ=Asia/Seoul 0 9 * * 1' 매주 월요일 09시), 1시간 미만 간격 불가
+}
+
+/**
+ * @summary 보드 반복 설정 응답 (dto.BoardRecurrenceResponse)
+ */
+export interface BoardRecurrenceResponse {
+  boardId: string;
+  rule: string;
+  nextRunAt: string | null;
+}
+
+/**
+ * @summary 체크리스트 완료율 (dto.SubtaskProgress)
+ */
+export interface SubtaskProgress {
+  total: number;
+  done: number;
+  percent: number; // 0-100 (항목이 없으면 0)
+}
+
+/**
+ * @summary 체크리스트 항목 (dto.SubtaskResponse)
+ * [API: /api/boards/{boardId}/subtasks]
+ */
+export interface SubtaskResponse {
+  subtaskId: string;
+  boardId: string;
+  title: string;
+  done: boolean;
+  assigneeId: string | null;
+  position: string; // 문자열 비교로 정렬
+  createdAt: string;
+  updatedAt: string;
+}
+
+export interface CreateSubtaskRequest {
+  title: string;
+  assigneeId?: string;
+}
+
+export interface UpdateSubtaskRequest {
+  title?: string;
+  done?: boolean;
+  assigneeId?: string; // '00000000-0000-0000-0000-000000000000'이면 담당자 해제
+  prevSubtaskId?: string;
+  nextSubtaskId?: string;
+}
+
+/**
+ * @summary 보드 활동 기록 (dto.ActivityResponse)
+ * [API: GET /api/boards/{boardId}/activities]
+ */
+export interface ActivityResponse {
+  activityId: string;
+  boardId: string;
+  actorId: string;
+  action: 'created' | 'updated' | 'deleted' | 'archived' | 'unarchived' | 'restored';
+  field?: string; // updated일 때만
+  oldValue?: string; // 커스텀 필드는 라벨 (예: '대기')
+  newValue?: string;
+  createdAt: string;
+}
+
+export interface PaginatedActivitiesResponse {
+  activities: ActivityResponse[];
+  nextCursor?: string; // 마지막 페이지면 없음
+  hasMore: boolean;
+  limit: number;
+}
+
+/**
+ * @summary 칸반 컬럼 카드 순서 (dto.BoardColumnOrderResponse)
+ * [API: GET /api/boards/project/{projectId}/order]
+ */
+export interface BoardColumnOrderResponse {
+  groupByFieldName: string;
+  fieldValue: string;
+  boardIds: string[];
+}
+
+/**
+ * @summary 칸반 컬럼 (dto.BoardColumnResponse)
+ */
+export interface BoardColumnResponse {
+  optionId: string;
+  fieldValue: string;
+  label: string;
+  color: string;
+  boards: BoardResponse[]; // 카드 순서
+}
+
+/**
+ * @summary 컬럼별 보드 목록 (dto.GroupedBoardsResponse)
+ * [API: GET /api/boards/project/{projectId}/grouped?by=stage]
+ */
+export interface GroupedBoardsResponse {
+  groupByFieldName: string;
+  columns: BoardColumnResponse[]; // 필드 옵션 displayOrder 순
+  unassigned: BoardResponse[]; // 필드 값이 없는 보드
+}
+
+/**
+ * @summary 보드 가져오기 행 오류 (dto.BoardImportRowError)
+ */
+export interface BoardImportRowError {
+  row: number; // 파일의 행 번호 (헤더가 1행)
+  column: string;
+  value: string;
+  message: string;
+}
+
+/**
+ * @summary CSV/XLSX 보드 가져오기 결과 (dto.BoardImportResponse)
+ * [API: POST /api/projects/{projectId}/boards/import]
+ */
+export interface BoardImportResponse {
+  dryRun: boolean;
+  totalRows: number;
+  validRows: number;
+  errors: BoardImportRowError[];
+  boards: BoardResponse[]; // dryRun이거나 오류가 있으면 빈 목록
+}
+
+/**
+ * @summary 보드 일괄 작업 (dto.BulkBoardOperation)
+ */
+export interface BulkBoardOperation {
+  type: 'move' | 'updateCustomFields' | 'assign' | 'delete';
+  boardId: string;
+  groupByFieldName?: string; // move
+  newFieldValue?: string; // move
+  customFields?: Record<string, string>; // updateCustomFields (지정한 필드만 변경)
+  assigneeId?: string; // assign (nil UUID이면 담당자 해제)
+}
+
+/**
+ * @summary 보드 일괄 작업 요청 (dto.BulkBoardRequest)
+ * [API: POST /api/boards/bulk]
+ */
+export interface BulkBoardRequest {
+  projectId: string;
+  operations: BulkBoardOperation[]; // 최대 100개, 한 트랜잭션으로 처리
+}
+
+/**
+ * @summary 보드 일괄 작업 결과 (dto.BulkBoardResponse)
+ */
+export interface BulkBoardResponse {
+  boards: BoardResponse[];
+  deletedBoardIds: string[];
+  moves: { boardId: string; groupByFieldName: string; from: string; to: string }[];
 }
 
 /**
@@ -156,6 +474,7 @@ export interface MoveBoardRequest {
  */
 export interface BoardFilters {
   customFields?: Record<string, any>;
+  state?: ArchiveStateFilter; // 생략하면 ACTIVE (보관되지 않은 보드만)
 }
 
 /**
@@ -163,8 +482,8 @@ export interface BoardFilters {
  */
 export interface PaginatedBoardsResponse {
   boards: BoardResponse[];
-  total: number;
-  page: number;
+  nextCursor?: string; // hasMore일 때만 포함, 다음 요청의 cursor로 전달
+  hasMore: boolean;
   limit: number;
 }
 
@@ -198,6 +517,8 @@ export interface ProjectResponse {
   attachments: AttachmentResponse[]; // ✅ 추가: 첨부파일 배열
   createdAt: string;
   updatedAt: string;
+  state: ArchiveState;
+  archivedAt?: string; // 보관된 경우에만 포함
 }
 /**
  * @summary 프로젝트 기본 정보 (dto.ProjectBasicInfo)
@@ -345,6 +666,66 @@ export interface UpdateFieldOptionRequest {
   displayOrder?: number;
 }
 
+// =======================================================
+// Custom Field Types
+// =======================================================
+
+/**
+ * @summary 커스텀 필드 타입
+ * select는 옵션 value, multi_select는 value 배열, number는 숫자, date는 'YYYY-MM-DD', text는 문자열을
+ * 보드 customFields[key]에 넣습니다.
+ */
+export type CustomFieldType = 'select' | 'multi_select' | 'number' | 'date' | 'text';
+
+/**
+ * @summary 커스텀 필드 옵션 요청 (dto.CustomFieldOptionRequest)
+ */
+export interface CustomFieldOptionRequest {
+  value: string;
+  label: string;
+  color: string;
+  displayOrder?: number;
+}
+
+/**
+ * @summary 커스텀 필드 생성 요청 (dto.CreateCustomFieldRequest)
+ * [API: POST /api/projects/{projectId}/custom-fields] (OWNER/ADMIN)
+ */
+export interface CreateCustomFieldRequest {
+  key: string; // 소문자로 시작, 소문자/숫자/_ (stage, role, importance 불가)
+  name: string;
+  type: CustomFieldType;
+  displayOrder?: number;
+  options?: CustomFieldOptionRequest[]; // select/multi_select만
+}
+
+/**
+ * @summary 커스텀 필드 수정 요청 (dto.UpdateCustomFieldRequest)
+ * [API: PATCH /api/custom-fields/{fieldId}] (key, type은 변경 불가)
+ */
+export interface UpdateCustomFieldRequest {
+  name?: string;
+  displayOrder?: number;
+  addOptions?: CustomFieldOptionRequest[];
+}
+
+/**
+ * @summary 커스텀 필드 응답 (dto.CustomFieldResponse)
+ * [API: GET /api/projects/{projectId}/custom-fields]
+ */
+export interface CustomFieldResponse {
+  fieldId: string;
+  projectId: string;
+  key: string;
+  name: string;
+  type: CustomFieldType;
+  displayOrder: number;
+  options: FieldOptionResponse[];
+  createdBy: string;
+  createdAt: string;
+  updatedAt: string;
+}
+
 // =======================================================
 // Project Member Types
 // =======================================================
@@ -371,6 +752,41 @@ export interface UpdateProjectMemberRoleRequest {
   roleName: 'OWNER' | 'ADMIN' | 'MEMBER';
 }
 
+/**
+ * @summary 프로젝트 권한 (OWNER는 항상 모든 권한을 가짐)
+ */
+export type ProjectPermission =
+  | 'can_update_project'
+  | 'can_delete_board'
+  | 'can_manage_fields'
+  | 'can_manage_templates'
+  | 'can_invite'
+  | 'can_remove_members'
+  | 'can_delete_project'
+  | 'can_manage_roles';
+
+/**
+ * @summary 프로젝트 권한 매트릭스 (dto.ProjectPermissionsResponse)
+ * [API: GET /api/projects/{projectId}/permissions]
+ */
+export interface ProjectPermissionsResponse {
+  projectId: string;
+  permissions: Record<ProjectPermission, string[]>; // 권한별 허용 역할 (OWNER 포함)
+  customizable: ProjectPermission[];
+  myRole: string;
+  myPermissions: ProjectPermission[];
+  updatedBy?: string;
+  updatedAt?: string;
+}
+
+/**
+ * @summary 프로젝트 권한 매트릭스 수정 요청 (dto.UpdateProjectPermissionsRequest)
+ * [API: PUT /api/projects/{projectId}/permissions]
+ */
+export interface UpdateProjectPermissionsRequest {
+  permissions: Partial<Record<ProjectPermission, ('ADMIN' | 'MEMBER')[]>>; // 빈 목록이면 OWNER 전용
+}
+
 // =======================================================
 // Project Join Request Types
 // =======================================================
@@ -417,19 +833,45 @@ export interface UpdateProjectJoinRequestRequest {
 export interface CommentResponse {
   commentId: string;
   boardId: string;
+  parentCommentId?: string; // 답글인 경우 상위 댓글 ID
   userId: string;
   content: string;
+  mentions: string[]; // 본문에서 멘션된 프로젝트 멤버 user ID
+  reactions: CommentReactionSummary[]; // 이모지별 반응 집계 (첫 반응 순)
+  replies?: CommentResponse[]; // threaded=true 조회 시에만 포함
   createdAt: string;
   updatedAt: string;
   attachments: AttachmentResponse[]; // 💡 [추가] 댓글도 첨부파일 배열 포함
 }
 
+/**
+ * @summary 댓글 이모지 반응 집계 (dto.CommentReactionSummary)
+ */
+export interface CommentReactionSummary {
+  emoji: string;
+  count: number;
+  userIds: string[];
+}
+
+/**
+ * @summary 반응 변경 후 댓글의 반응 목록 (dto.CommentReactionsResponse)
+ * [API: PUT/DELETE /api/comments/{commentId}/reactions/{emoji}]
+ * 같은 내용이 프로젝트 WebSocket의 COMMENT_REACTION_UPDATED 이벤트로 전송됨
+ */
+export interface CommentReactionsResponse {
+  commentId: string;
+  boardId: string;
+  projectId: string;
+  reactions: CommentReactionSummary[];
+}
+
 /**
  * @summary 댓글 생성 요청 (dto.CreateCommentRequest)
  * [API: POST /api/comments]
  */
 export interface CreateCommentRequest {
   boardId: string;
+  parentCommentId?: string; // 답글 작성 시 같은 보드의 상위 댓글 ID
   content: string;
   attachmentIds?: string[]; // 💡 [추가] 첨부파일 연결 지원
 }
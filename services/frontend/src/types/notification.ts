@@ -24,7 +24,9 @@ export type NotificationType =
   | 'BOARD_STATUS_CHANGED'
   | 'BOARD_COMMENT_ADDED'
   | 'BOARD_DUE_SOON'
-  | 'BOARD_OVERDUE';
+  | 'BOARD_OVERDUE'
+  | 'COMMENT_MENTION'
+  | 'COMMENT_REPLY';
 
 export type ResourceType = 'task' | 'comment' | 'workspace' | 'project' | 'board';
 
@@ -5,12 +5,16 @@ import { getBoardWebSocketUrl, refreshAccessToken } from '../api/apiConfig';
 let ws: WebSocket | null = null;
 let pingInterval: number | null = null;
 let isConnecting = false; // 🔥 연결 중 플래그 추가
+let lastSeq = -1; // 마지막으로 받은 이벤트 seq (재연결 시 since로 보내 놓친 이벤트를 재생, -1이면 아직 모름)
 
 export const WS_BOARD_MTH = [
   'BOARD_CREATED',
   'BOARD_UPDATED',
   'BOARD_MOVED',
   'BOARD_DELETED',
+  'BOARDS_BULK_UPDATED', // POST /boards/bulk - 일괄 작업당 한 번
+  'COMMENT_REACTION_UPDATED', // PUT/DELETE /comments/{commentId}/reactions/{emoji} - payload: CommentReactionsResponse
+  'RESYNC_REQUIRED', // 재연결 시 놓친 이벤트가 서버 버퍼에 없음 - 전체 다시 조회
 ] as const;
 
 export type WSBoardMethod = (typeof WS_BOARD_MTH)[number];
@@ -36,6 +40,7 @@ export const connectWebSocket = (projectId: string, onMessage: (data: any) => vo
     pingInterval = null;
   }
 
+  lastSeq = -1;
   let reconnectAttempts = 0;
   const maxReconnectAttempts = 5;
   const reconnectDelay = 3000;
@@ -48,7 +53,8 @@ export const connectWebSocket = (projectId: string, onMessage: (data: any) => vo
       return;
     }
 
-    const wsUrl = getBoardWebSocketUrl(projectId, token);
+    const baseUrl = getBoardWebSocketUrl(projectId, token);
+    const wsUrl = lastSeq >= 0 ? `${baseUrl}&since=${lastSeq}` : baseUrl;
     console.log('🔌 [WS] 연결 시도:', wsUrl);
 
     isConnecting = true; // 🔥 연결 시작
@@ -81,6 +87,20 @@ export const connectWebSocket = (projectId: string, onMessage: (data: any) => vo
           return;
         }
 
+        // since 없이 연결하면 서버가 현재 seq를 먼저 알려 줌 - 이후 재연결은 이 seq부터 재생
+        if (data.type === 'CONNECTED') {
+          lastSeq = data.seq ?? 0;
+          return;
+        }
+
+        // 재생과 실시간 전달이 겹치면 같은 이벤트가 두 번 올 수 있으므로 이미 받은 seq는 무시
+        if (data.type === 'RESYNC_REQUIRED') {
+          lastSeq = data.seq ?? 0;
+        } else if (typeof data.seq === 'number') {
+          if (data.seq <= lastSeq) return;
+          lastSeq = data.seq;
+        }
+
         console.log('📨 [WS] 메시지 수신:', data);
         onMessage(data);
       } catch (error) {
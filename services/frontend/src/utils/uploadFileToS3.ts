@@ -33,9 +33,13 @@ export const uploadFileToS3 = async (
       },
     });
 
+    // 3️⃣ [Backend] 업로드 확정: 서버가 S3 객체의 존재/크기/타입을 검증
+    // 검증된 첨부파일만 보드/댓글 저장 시 attachmentIds로 연결합니다.
+    await boardServiceClient.post(`/attachments/${attachmentId}/confirm`);
+
     console.log('✅ S3 Upload Success:', fileKey, ':id:', attachmentId);
 
-    // 3️⃣ 결과 반환
+    // 4️⃣ 결과 반환
     return presignedRes.data.data;
   } catch (error) {
     console.error('❌ File Upload Failed:', error);
@@ -28,6 +28,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -36,9 +37,13 @@ import (
 
 	_ "noti-service/docs" // Swagger docs import
 
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
 
 	"noti-service/internal/config"
 	"noti-service/internal/database"
@@ -54,9 +59,16 @@ func main() {
 	}
 
 	// Initialize logger
-	logger := initLogger(cfg.Server.Env, cfg.Server.LogLevel)
+	logger, logLevel := initLogger(cfg.Server.Env, cfg.Server.LogLevel)
 	defer func() { _ = logger.Sync() }()
 
+	// Runtime settings hot-reload (RUNTIME_CONFIG_PATH, SIGHUP)
+	runtimeCfg := commonconfig.WatcherFromEnv(logger)
+	runtimeCfg.WatchLogLevel(logLevel)
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("noti-service")
@@ -120,13 +132,30 @@ func main() {
 	}
 
 	// Setup router
-	r := router.Setup(router.RouterConfig{
+	routerCfg := router.RouterConfig{
 		Config:      cfg,
 		DB:          db,
 		RedisClient: redisClient,
 		Logger:      logger,
 		ServiceName: "noti-service",
-	})
+		Runtime:     runtimeCfg,
+	}
+
+	// Internal gRPC API (alongside REST), authenticated with the same internal API key
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Port > 0 {
+		if cfg.InternalAuth.InternalAPIKey == "" {
+			logger.Warn("gRPC server disabled: INTERNAL_API_KEY is not configured")
+		} else {
+			grpcServer = internalrpc.NewServer(internalrpc.ServerConfig{
+				Logger: logger,
+				APIKey: cfg.InternalAuth.InternalAPIKey,
+			})
+			routerCfg.GRPCServer = grpcServer
+		}
+	}
+
+	r := router.Setup(routerCfg)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -145,6 +174,22 @@ func main() {
 		}
 	}()
 
+	if grpcServer != nil {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+		if err != nil {
+			logger.Fatal("Failed to listen for gRPC", zap.Error(err))
+		}
+		go func() {
+			logger.Info("gRPC server listening", zap.Int("port", cfg.GRPC.Port))
+			if err := grpcServer.Serve(lis); err != nil {
+				logger.Fatal("Failed to start gRPC server", zap.Error(err))
+			}
+		}()
+	}
+
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	stopProfiling := profiling.Start(profiling.ConfigFromEnv(), logger)
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -159,11 +204,15 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
+	_ = stopProfiling(ctx)
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
 
 	logger.Info("Server exited")
 }
 
-func initLogger(env, level string) *zap.Logger {
+func initLogger(env, level string) (*zap.Logger, zap.AtomicLevel) {
 	var config zap.Config
 
 	if env == "production" || env == "prod" {
@@ -190,5 +239,5 @@ func initLogger(env, level string) *zap.Logger {
 		panic(err)
 	}
 
-	return logger
+	return logger, config.Level
 }
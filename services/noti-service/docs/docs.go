@@ -23,7 +23,350 @@ const docTemplate = `{
     },
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
-    "paths": {},
+    "paths": {
+        "/internal/notifications": {
+            "post": {
+                "security": [
+                    {
+                        "InternalAPIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "summary": "Create a notification",
+                "operationId": "createNotification",
+                "parameters": [
+                    {
+                        "description": "Notification event",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/noti-service_internal_domain.NotificationEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/noti-service_internal_domain.Notification"
+                        }
+                    }
+                }
+            }
+        },
+        "/internal/notifications/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "InternalAPIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "summary": "Create notifications in bulk",
+                "operationId": "createBulkNotifications",
+                "parameters": [
+                    {
+                        "description": "Notification events (max 100)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/noti-service_internal_domain.CreateBulkNotificationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/noti-service_internal_domain.BulkNotificationsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "noti-service_internal_domain.BulkNotificationsResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/noti-service_internal_domain.Notification"
+                    }
+                }
+            }
+        },
+        "noti-service_internal_domain.ChannelDeliveries": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/noti-service_internal_domain.ChannelDelivery"
+            }
+        },
+        "noti-service_internal_domain.ChannelDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/noti-service_internal_domain.DeliveryState"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "noti-service_internal_domain.CreateBulkNotificationsRequest": {
+            "type": "object",
+            "required": [
+                "notifications"
+            ],
+            "properties": {
+                "notifications": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/noti-service_internal_domain.NotificationEvent"
+                    }
+                }
+            }
+        },
+        "noti-service_internal_domain.DeliveryState": {
+            "type": "string",
+            "enum": [
+                "SENT",
+                "RETRYING",
+                "FAILED",
+                "DROPPED"
+            ],
+            "x-enum-comments": {
+                "DeliveryStateDropped": "Target no longer exists (expired subscription, revoked integration)",
+                "DeliveryStateFailed": "Moved to the dead-letter table"
+            },
+            "x-enum-varnames": [
+                "DeliveryStateSent",
+                "DeliveryStateRetrying",
+                "DeliveryStateFailed",
+                "DeliveryStateDropped"
+            ]
+        },
+        "noti-service_internal_domain.Notification": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "archivedAt": {
+                    "type": "string",
+                    "description": "Hidden from the inbox when set"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveryStatus": {
+                    "description": "Outbound channel states (push, integrations)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/noti-service_internal_domain.ChannelDeliveries"
+                        }
+                    ]
+                },
+                "groupCount": {
+                    "type": "integer",
+                    "description": "Number of similar events collapsed into this notification"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isRead": {
+                    "type": "boolean"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "readAt": {
+                    "type": "string"
+                },
+                "resourceId": {
+                    "type": "string"
+                },
+                "resourceName": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "$ref": "#/definitions/noti-service_internal_domain.ResourceType"
+                },
+                "targetUserId": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "description": "Rendered in the reader's locale"
+                },
+                "type": {
+                    "$ref": "#/definitions/noti-service_internal_domain.NotificationType"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "description": "Last grouped event"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "noti-service_internal_domain.NotificationEvent": {
+            "type": "object",
+            "required": [
+                "actorId",
+                "resourceId",
+                "resourceType",
+                "targetUserId",
+                "type"
+            ],
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "critical": {
+                    "type": "boolean",
+                    "description": "Bypasses the target user's quiet hours"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "resourceId": {
+                    "type": "string"
+                },
+                "resourceName": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "$ref": "#/definitions/noti-service_internal_domain.ResourceType"
+                },
+                "targetUserId": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/noti-service_internal_domain.NotificationType"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "noti-service_internal_domain.NotificationType": {
+            "type": "string",
+            "enum": [
+                "TASK_ASSIGNED",
+                "TASK_UNASSIGNED",
+                "TASK_MENTIONED",
+                "TASK_DUE_SOON",
+                "TASK_OVERDUE",
+                "TASK_STATUS_CHANGED",
+                "COMMENT_ADDED",
+                "COMMENT_MENTIONED",
+                "WORKSPACE_INVITED",
+                "WORKSPACE_ROLE_CHANGED",
+                "WORKSPACE_REMOVED",
+                "PROJECT_INVITED",
+                "PROJECT_ROLE_CHANGED",
+                "PROJECT_REMOVED",
+                "BOARD_ASSIGNED",
+                "BOARD_UNASSIGNED",
+                "BOARD_PARTICIPANT_ADDED",
+                "BOARD_UPDATED",
+                "BOARD_STATUS_CHANGED",
+                "BOARD_COMMENT_ADDED",
+                "BOARD_DUE_SOON",
+                "BOARD_OVERDUE",
+                "CHAT_MENTIONED",
+                "SECURITY_NEW_LOGIN"
+            ],
+            "x-enum-varnames": [
+                "NotificationTypeTaskAssigned",
+                "NotificationTypeTaskUnassigned",
+                "NotificationTypeTaskMentioned",
+                "NotificationTypeTaskDueSoon",
+                "NotificationTypeTaskOverdue",
+                "NotificationTypeTaskStatusChanged",
+                "NotificationTypeCommentAdded",
+                "NotificationTypeCommentMentioned",
+                "NotificationTypeWorkspaceInvited",
+                "NotificationTypeWorkspaceRoleChanged",
+                "NotificationTypeWorkspaceRemoved",
+                "NotificationTypeProjectInvited",
+                "NotificationTypeProjectRoleChanged",
+                "NotificationTypeProjectRemoved",
+                "NotificationTypeBoardAssigned",
+                "NotificationTypeBoardUnassigned",
+                "NotificationTypeBoardParticipantAdded",
+                "NotificationTypeBoardUpdated",
+                "NotificationTypeBoardStatusChanged",
+                "NotificationTypeBoardCommentAdded",
+                "NotificationTypeBoardDueSoon",
+                "NotificationTypeBoardOverdue",
+                "NotificationTypeChatMentioned",
+                "NotificationTypeSecurityNewLogin"
+            ]
+        },
+        "noti-service_internal_domain.ResourceType": {
+            "type": "string",
+            "enum": [
+                "task",
+                "comment",
+                "workspace",
+                "project",
+                "board",
+                "account"
+            ],
+            "x-enum-comments": {
+                "ResourceTypeAccount": "Account-level events carry no workspace"
+            },
+            "x-enum-varnames": [
+                "ResourceTypeTask",
+                "ResourceTypeComment",
+                "ResourceTypeWorkspace",
+                "ResourceTypeProject",
+                "ResourceTypeBoard",
+                "ResourceTypeAccount"
+            ]
+        }
+    },
     "securityDefinitions": {
         "BearerAuth": {
             "description": "Type \"Bearer\" followed by a space and JWT token.",
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+// Workspace roles returned by user-service
+const (
+	WorkspaceRoleOwner  = "OWNER"
+	WorkspaceRoleAdmin  = "ADMIN"
+	WorkspaceRoleMember = "MEMBER"
+)
+
+// UserClient defines the interface for User API interactions
+type UserClient interface {
+	// GetWorkspaceRole returns the role of a user in a workspace, or "" if the user is not a member.
+	GetWorkspaceRole(ctx context.Context, workspaceID, userID uuid.UUID, token string) (string, error)
+}
+
+// workspaceMember is the subset of user-service WorkspaceMemberResponse used here
+type workspaceMember struct {
+	UserID   uuid.UUID `json:"userId"`
+	RoleName string    `json:"roleName"`
+	IsActive bool      `json:"isActive"`
+}
+
+// userClient implements UserClient interface using common HTTP client
+type userClient struct {
+	*commonclient.BaseHTTPClient
+}
+
+// NewUserClient creates a new User API client
+func NewUserClient(baseURL string, timeout time.Duration, logger *zap.Logger) UserClient {
+	return &userClient{
+		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
+	}
+}
+
+// GetWorkspaceRole looks up the role of a user from the workspace member list
+func (c *userClient) GetWorkspaceRole(ctx context.Context, workspaceID, userID uuid.UUID, token string) (string, error) {
+	url := c.BuildURL(fmt.Sprintf("/workspaces/%s/members", workspaceID.String()))
+
+	var members []workspaceMember
+	if err := c.DoRequestWithRetry(ctx, "GET", url, token, &members); err != nil {
+		c.Logger.Error("Failed to get workspace members",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+		)
+		return "", err
+	}
+
+	for _, member := range members {
+		if member.UserID == userID && member.IsActive {
+			return member.RoleName, nil
+		}
+	}
+	return "", nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"gopkg.in/yaml.v3"
@@ -12,8 +13,17 @@ import (
 type Config struct {
 	commonconfig.BaseConfig `yaml:",inline"`
 	InternalAuth            InternalAuthConfig `yaml:"internal_auth"`
+	GRPC                    GRPCConfig         `yaml:"grpc"`
 	App                     AppConfig          `yaml:"app"`
 	RateLimit               RateLimitConfig    `yaml:"rate_limit"`
+	WebPush                 WebPushConfig      `yaml:"web_push"`
+	MobilePush              MobilePushConfig   `yaml:"mobile_push"`
+	Integrations            IntegrationConfig  `yaml:"integrations"`
+	Delivery                DeliveryConfig     `yaml:"delivery"`
+	Events                  EventsConfig       `yaml:"events"`
+	QuietHours              QuietHoursConfig   `yaml:"quiet_hours"`
+	SMS                     SMSConfig          `yaml:"sms"`
+	Webhooks                WebhookConfig      `yaml:"webhooks"`
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -28,10 +38,114 @@ type InternalAuthConfig struct {
 	InternalAPIKey string `yaml:"internal_api_key"`
 }
 
+// GRPCConfig configures the internal gRPC API (SubmitNotification), served alongside REST.
+type GRPCConfig struct {
+	Port int `yaml:"port"` // 0 disables the gRPC server
+}
+
 // AppConfig contains notification-specific configuration.
 type AppConfig struct {
 	CacheUnreadTTL int `yaml:"cache_unread_ttl"` // seconds
 	CleanupDays    int `yaml:"cleanup_days"`
+	// AggregationWindow collapses similar events (same user, resource, type) within this many seconds. 0 disables.
+	AggregationWindow int `yaml:"aggregation_window"`
+	// DefaultLocale is used for users without a locale preference and for workspace channels.
+	DefaultLocale string `yaml:"default_locale"`
+	// TemplatesDir overrides the built-in templates with <locale>.yaml files. Empty uses built-ins only.
+	TemplatesDir string `yaml:"templates_dir"`
+}
+
+// WebPushConfig holds browser push (VAPID) configuration
+// VAPID 키가 비어 있으면 최초 기동 시 생성하여 DB에 저장하고 모든 레플리카가 공유합니다.
+type WebPushConfig struct {
+	Enabled         bool     `yaml:"enabled"`
+	VAPIDPublicKey  string   `yaml:"vapid_public_key"`
+	VAPIDPrivateKey string   `yaml:"vapid_private_key"`
+	Subject         string   `yaml:"subject"`        // mailto: or https: contact for push services
+	TTL             int      `yaml:"ttl"`            // seconds
+	PriorityTypes   []string `yaml:"priority_types"` // Notification types sent as web push
+}
+
+// MobilePushConfig holds FCM (Android) and APNs (iOS) configuration
+// 자격 증명이 설정된 플랫폼만 전송하며, 나머지 플랫폼의 토큰은 등록만 됩니다.
+type MobilePushConfig struct {
+	Enabled            bool     `yaml:"enabled"`
+	Types              []string `yaml:"types"`                // Notification types sent to mobile devices
+	FCMCredentialsFile string   `yaml:"fcm_credentials_file"` // Service account JSON key
+	APNsKeyFile        string   `yaml:"apns_key_file"`        // .p8 signing key
+	APNsKeyID          string   `yaml:"apns_key_id"`
+	APNsTeamID         string   `yaml:"apns_team_id"`
+	APNsBundleID       string   `yaml:"apns_bundle_id"`
+	APNsProduction     bool     `yaml:"apns_production"`
+}
+
+// IntegrationConfig holds Slack/Microsoft Teams workspace integration configuration
+// 웹훅 연동은 별도 설정 없이 가능하고, Slack 앱 설치(OAuth)는 클라이언트 자격 증명이 필요합니다.
+type IntegrationConfig struct {
+	Enabled           bool   `yaml:"enabled"`
+	SlackClientID     string `yaml:"slack_client_id"`
+	SlackClientSecret string `yaml:"slack_client_secret"`
+	SlackRedirectURL  string `yaml:"slack_redirect_url"` // Frontend page that receives the OAuth code
+	AppURL            string `yaml:"app_url"`            // Base URL for links in channel messages
+	DedupWindow       int    `yaml:"dedup_window"`       // seconds; posts one message per event across recipients
+}
+
+// WebhookConfig holds outbound workspace webhook configuration
+type WebhookConfig struct {
+	Enabled              bool `yaml:"enabled"`
+	MaxPerWorkspace      int  `yaml:"max_per_workspace"`
+	DisableAfterFailures int  `yaml:"disable_after_failures"` // Consecutive failed attempts before a webhook is disabled. 0 never disables
+	LogRetentionDays     int  `yaml:"log_retention_days"`
+	// AllowPrivateNetworks permits endpoints on private/loopback addresses (self-hosted internal tools).
+	AllowPrivateNetworks bool `yaml:"allow_private_networks"`
+}
+
+// DeliveryConfig holds retry configuration of outbound deliveries (push, integrations)
+// 재시도 간격은 BaseDelay부터 두 배씩 늘어나며 MaxDelay를 넘지 않습니다.
+type DeliveryConfig struct {
+	MaxAttempts    int `yaml:"max_attempts"`     // Attempts before moving to the dead-letter table
+	RetryBaseDelay int `yaml:"retry_base_delay"` // seconds
+	RetryMaxDelay  int `yaml:"retry_max_delay"`  // seconds
+}
+
+// QuietHoursConfig holds do-not-disturb configuration
+// 방해 금지 시간 동안 푸시/이메일은 보류 후 종료 시각에 전송하고, 낮은 우선순위 타입은 전송하지 않습니다.
+type QuietHoursConfig struct {
+	DefaultTimezone  string   `yaml:"default_timezone"`   // Suggested to users without quiet hours
+	LowPriorityTypes []string `yaml:"low_priority_types"` // Dropped instead of held during quiet hours
+}
+
+// SMSConfig holds SMS (Twilio or Amazon SNS) configuration
+// 비용이 발생하므로 사용자별 시간당, 워크스페이스별 월간, 서비스 전체 일간 전송량을 제한합니다.
+type SMSConfig struct {
+	Enabled            bool     `yaml:"enabled"`
+	Provider           string   `yaml:"provider"` // twilio or sns
+	TwilioAccountSID   string   `yaml:"twilio_account_sid"`
+	TwilioAuthToken    string   `yaml:"twilio_auth_token"`
+	TwilioFrom         string   `yaml:"twilio_from"` // Sender number or Messaging Service SID
+	SNSRegion          string   `yaml:"sns_region"`
+	SNSAccessKeyID     string   `yaml:"sns_access_key_id"`
+	SNSSecretAccessKey string   `yaml:"sns_secret_access_key"`
+	SNSSessionToken    string   `yaml:"sns_session_token"`
+	SNSSenderID        string   `yaml:"sns_sender_id"`
+	Types              []string `yaml:"types"`                   // Critical notification types sent by SMS
+	UserHourlyLimit    int      `yaml:"user_hourly_limit"`       // Messages per recipient per hour
+	WorkspaceMonthly   int      `yaml:"workspace_monthly_limit"` // Default and maximum monthly messages per workspace
+	DailyLimit         int      `yaml:"daily_limit"`             // Messages per day across all workspaces, including verification codes
+}
+
+// EventsConfig holds NATS JetStream ingestion configuration
+// 활성화하면 다른 서비스가 스트림에 발행한 알림 이벤트를 소비합니다 (내부 HTTP API도 계속 지원).
+type EventsConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	NATSURL     string `yaml:"nats_url"` // nats://[user:password@]host:4222
+	Stream      string `yaml:"stream"`
+	Subject     string `yaml:"subject"` // Subject filter of the consumer, e.g. notifications.events.>
+	Durable     string `yaml:"durable"` // Durable consumer name shared by all replicas
+	MaxDeliver  int    `yaml:"max_deliver"`
+	BatchSize   int    `yaml:"batch_size"`
+	RetryDelay  int    `yaml:"retry_delay"`  // seconds before a failed event is redelivered
+	DedupWindow int    `yaml:"dedup_window"` // seconds; ignores redeliveries of processed events
 }
 
 // Load reads configuration from yaml file and environment variables.
@@ -42,9 +156,55 @@ func Load(path string) (*Config, error) {
 
 	cfg := &Config{
 		BaseConfig: base,
+		GRPC: GRPCConfig{
+			Port: 50051,
+		},
 		App: AppConfig{
-			CacheUnreadTTL: 300, // 5 minutes
-			CleanupDays:    30,
+			CacheUnreadTTL:    300, // 5 minutes
+			CleanupDays:       30,
+			AggregationWindow: 300, // 5 minutes
+			DefaultLocale:     "ko",
+		},
+		WebPush: WebPushConfig{
+			Subject: "mailto:admin@wealist.co.kr",
+			TTL:     86400, // 1 day
+		},
+		Integrations: IntegrationConfig{
+			DedupWindow: 60,
+		},
+		Delivery: DeliveryConfig{
+			MaxAttempts:    5,
+			RetryBaseDelay: 30,
+			RetryMaxDelay:  3600, // 1 hour
+		},
+		QuietHours: QuietHoursConfig{
+			DefaultTimezone: "Asia/Seoul",
+			LowPriorityTypes: []string{
+				"BOARD_UPDATED",
+				"BOARD_PARTICIPANT_ADDED",
+				"TASK_STATUS_CHANGED",
+				"BOARD_STATUS_CHANGED",
+			},
+		},
+		Webhooks: WebhookConfig{
+			MaxPerWorkspace:      10,
+			DisableAfterFailures: 50,
+			LogRetentionDays:     14,
+		},
+		SMS: SMSConfig{
+			Provider:         "twilio",
+			UserHourlyLimit:  5,
+			WorkspaceMonthly: 500,
+			DailyLimit:       2000,
+		},
+		Events: EventsConfig{
+			Stream:      "NOTIFICATIONS",
+			Subject:     "notifications.events.>",
+			Durable:     "noti-service",
+			MaxDeliver:  10,
+			BatchSize:   50,
+			RetryDelay:  5,
+			DedupWindow: 86400, // 1 day
 		},
 	}
 
@@ -62,6 +222,23 @@ func Load(path string) (*Config, error) {
 	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
 		cfg.InternalAuth.InternalAPIKey = apiKey
 	}
+	if port := os.Getenv("GRPC_PORT"); port != "" {
+		if v, err := strconv.Atoi(port); err == nil {
+			cfg.GRPC.Port = v
+		}
+	}
+
+	if window := os.Getenv("NOTIFICATION_AGGREGATION_WINDOW"); window != "" {
+		if v, err := strconv.Atoi(window); err == nil {
+			cfg.App.AggregationWindow = v
+		}
+	}
+	if locale := os.Getenv("NOTIFICATION_DEFAULT_LOCALE"); locale != "" {
+		cfg.App.DefaultLocale = locale
+	}
+	if dir := os.Getenv("NOTIFICATION_TEMPLATES_DIR"); dir != "" {
+		cfg.App.TemplatesDir = dir
+	}
 
 	// Rate Limit
 	if rateLimitEnabled := os.Getenv("RATE_LIMIT_ENABLED"); rateLimitEnabled != "" {
@@ -81,5 +258,213 @@ func Load(path string) (*Config, error) {
 		cfg.RateLimit.RequestsPerMinute = 60
 	}
 
+	// Web Push
+	if enabled := os.Getenv("WEBPUSH_ENABLED"); enabled != "" {
+		cfg.WebPush.Enabled = enabled == "true"
+	}
+	if publicKey := os.Getenv("VAPID_PUBLIC_KEY"); publicKey != "" {
+		cfg.WebPush.VAPIDPublicKey = publicKey
+	}
+	if privateKey := os.Getenv("VAPID_PRIVATE_KEY"); privateKey != "" {
+		cfg.WebPush.VAPIDPrivateKey = privateKey
+	}
+	if subject := os.Getenv("VAPID_SUBJECT"); subject != "" {
+		cfg.WebPush.Subject = subject
+	}
+	if ttl := os.Getenv("WEBPUSH_TTL"); ttl != "" {
+		if v, err := strconv.Atoi(ttl); err == nil {
+			cfg.WebPush.TTL = v
+		}
+	}
+	if types := os.Getenv("WEBPUSH_PRIORITY_TYPES"); types != "" {
+		cfg.WebPush.PriorityTypes = splitList(types)
+	}
+
+	// Mobile Push
+	if enabled := os.Getenv("MOBILE_PUSH_ENABLED"); enabled != "" {
+		cfg.MobilePush.Enabled = enabled == "true"
+	}
+	if types := os.Getenv("MOBILE_PUSH_TYPES"); types != "" {
+		cfg.MobilePush.Types = splitList(types)
+	}
+	if file := os.Getenv("FCM_CREDENTIALS_FILE"); file != "" {
+		cfg.MobilePush.FCMCredentialsFile = file
+	}
+	if file := os.Getenv("APNS_KEY_FILE"); file != "" {
+		cfg.MobilePush.APNsKeyFile = file
+	}
+	if keyID := os.Getenv("APNS_KEY_ID"); keyID != "" {
+		cfg.MobilePush.APNsKeyID = keyID
+	}
+	if teamID := os.Getenv("APNS_TEAM_ID"); teamID != "" {
+		cfg.MobilePush.APNsTeamID = teamID
+	}
+	if bundleID := os.Getenv("APNS_BUNDLE_ID"); bundleID != "" {
+		cfg.MobilePush.APNsBundleID = bundleID
+	}
+	if production := os.Getenv("APNS_PRODUCTION"); production != "" {
+		cfg.MobilePush.APNsProduction = production == "true"
+	}
+
+	// Slack / Teams integrations
+	if enabled := os.Getenv("INTEGRATIONS_ENABLED"); enabled != "" {
+		cfg.Integrations.Enabled = enabled == "true"
+	}
+	if clientID := os.Getenv("SLACK_CLIENT_ID"); clientID != "" {
+		cfg.Integrations.SlackClientID = clientID
+	}
+	if clientSecret := os.Getenv("SLACK_CLIENT_SECRET"); clientSecret != "" {
+		cfg.Integrations.SlackClientSecret = clientSecret
+	}
+	if redirectURL := os.Getenv("SLACK_REDIRECT_URL"); redirectURL != "" {
+		cfg.Integrations.SlackRedirectURL = redirectURL
+	}
+	if appURL := os.Getenv("APP_URL"); appURL != "" {
+		cfg.Integrations.AppURL = appURL
+	}
+	if window := os.Getenv("INTEGRATION_DEDUP_WINDOW"); window != "" {
+		if v, err := strconv.Atoi(window); err == nil {
+			cfg.Integrations.DedupWindow = v
+		}
+	}
+
+	// Delivery retries
+	if attempts := os.Getenv("DELIVERY_MAX_ATTEMPTS"); attempts != "" {
+		if v, err := strconv.Atoi(attempts); err == nil {
+			cfg.Delivery.MaxAttempts = v
+		}
+	}
+	if delay := os.Getenv("DELIVERY_RETRY_BASE_DELAY"); delay != "" {
+		if v, err := strconv.Atoi(delay); err == nil {
+			cfg.Delivery.RetryBaseDelay = v
+		}
+	}
+	if delay := os.Getenv("DELIVERY_RETRY_MAX_DELAY"); delay != "" {
+		if v, err := strconv.Atoi(delay); err == nil {
+			cfg.Delivery.RetryMaxDelay = v
+		}
+	}
+
+	// Quiet hours
+	if timezone := os.Getenv("QUIET_HOURS_DEFAULT_TIMEZONE"); timezone != "" {
+		cfg.QuietHours.DefaultTimezone = timezone
+	}
+	if types := os.Getenv("QUIET_HOURS_LOW_PRIORITY_TYPES"); types != "" {
+		cfg.QuietHours.LowPriorityTypes = splitList(types)
+	}
+
+	// SMS
+	if enabled := os.Getenv("SMS_ENABLED"); enabled != "" {
+		cfg.SMS.Enabled = enabled == "true"
+	}
+	if provider := os.Getenv("SMS_PROVIDER"); provider != "" {
+		cfg.SMS.Provider = provider
+	}
+	if sid := os.Getenv("TWILIO_ACCOUNT_SID"); sid != "" {
+		cfg.SMS.TwilioAccountSID = sid
+	}
+	if token := os.Getenv("TWILIO_AUTH_TOKEN"); token != "" {
+		cfg.SMS.TwilioAuthToken = token
+	}
+	if from := os.Getenv("TWILIO_FROM"); from != "" {
+		cfg.SMS.TwilioFrom = from
+	}
+	if region := os.Getenv("SNS_REGION"); region != "" {
+		cfg.SMS.SNSRegion = region
+	} else if region := os.Getenv("AWS_REGION"); region != "" && cfg.SMS.SNSRegion == "" {
+		cfg.SMS.SNSRegion = region
+	}
+	if keyID := os.Getenv("AWS_ACCESS_KEY_ID"); keyID != "" {
+		cfg.SMS.SNSAccessKeyID = keyID
+	}
+	if secret := os.Getenv("AWS_SECRET_ACCESS_KEY"); secret != "" {
+		cfg.SMS.SNSSecretAccessKey = secret
+	}
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		cfg.SMS.SNSSessionToken = token
+	}
+	if senderID := os.Getenv("SNS_SENDER_ID"); senderID != "" {
+		cfg.SMS.SNSSenderID = senderID
+	}
+	if types := os.Getenv("SMS_TYPES"); types != "" {
+		cfg.SMS.Types = splitList(types)
+	}
+	if limit := os.Getenv("SMS_USER_HOURLY_LIMIT"); limit != "" {
+		if v, err := strconv.Atoi(limit); err == nil {
+			cfg.SMS.UserHourlyLimit = v
+		}
+	}
+	if limit := os.Getenv("SMS_WORKSPACE_MONTHLY_LIMIT"); limit != "" {
+		if v, err := strconv.Atoi(limit); err == nil {
+			cfg.SMS.WorkspaceMonthly = v
+		}
+	}
+	if limit := os.Getenv("SMS_DAILY_LIMIT"); limit != "" {
+		if v, err := strconv.Atoi(limit); err == nil {
+			cfg.SMS.DailyLimit = v
+		}
+	}
+
+	// Workspace webhooks
+	if enabled := os.Getenv("WEBHOOKS_ENABLED"); enabled != "" {
+		cfg.Webhooks.Enabled = enabled == "true"
+	}
+	if max := os.Getenv("WEBHOOK_MAX_PER_WORKSPACE"); max != "" {
+		if v, err := strconv.Atoi(max); err == nil {
+			cfg.Webhooks.MaxPerWorkspace = v
+		}
+	}
+	if failures := os.Getenv("WEBHOOK_DISABLE_AFTER_FAILURES"); failures != "" {
+		if v, err := strconv.Atoi(failures); err == nil {
+			cfg.Webhooks.DisableAfterFailures = v
+		}
+	}
+	if days := os.Getenv("WEBHOOK_LOG_RETENTION_DAYS"); days != "" {
+		if v, err := strconv.Atoi(days); err == nil {
+			cfg.Webhooks.LogRetentionDays = v
+		}
+	}
+	if allow := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"); allow != "" {
+		cfg.Webhooks.AllowPrivateNetworks = allow == "true"
+	}
+
+	// Event ingestion (NATS JetStream)
+	if enabled := os.Getenv("EVENTS_ENABLED"); enabled != "" {
+		cfg.Events.Enabled = enabled == "true"
+	}
+	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
+		cfg.Events.NATSURL = natsURL
+	}
+	if stream := os.Getenv("NATS_STREAM"); stream != "" {
+		cfg.Events.Stream = stream
+	}
+	if subject := os.Getenv("NATS_SUBJECT"); subject != "" {
+		cfg.Events.Subject = subject
+	}
+	if durable := os.Getenv("NATS_DURABLE"); durable != "" {
+		cfg.Events.Durable = durable
+	}
+	if maxDeliver := os.Getenv("EVENTS_MAX_DELIVER"); maxDeliver != "" {
+		if v, err := strconv.Atoi(maxDeliver); err == nil {
+			cfg.Events.MaxDeliver = v
+		}
+	}
+	if delay := os.Getenv("EVENTS_RETRY_DELAY"); delay != "" {
+		if v, err := strconv.Atoi(delay); err == nil {
+			cfg.Events.RetryDelay = v
+		}
+	}
+
 	return cfg, nil
 }
+
+// splitList splits a comma-separated environment value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
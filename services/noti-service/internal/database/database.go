@@ -41,14 +41,20 @@ func NewDB(cfg *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	sqlDB.SetConnMaxLifetime(time.Hour)
+	if cfg.Database.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	}
+	if cfg.Database.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	}
+	if cfg.Database.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	}
 
 	// Auto migrate (conditional based on DB_AUTO_MIGRATE env)
 	if cfg.Database.AutoMigrate {
 		log.Println("Running database migrations (DB_AUTO_MIGRATE=true)")
-		if err := db.AutoMigrate(&domain.Notification{}, &domain.NotificationPreference{}); err != nil {
+		if err := db.AutoMigrate(&domain.Notification{}, &domain.NotificationPreference{}, &domain.NotificationLocale{}, &domain.QuietHours{}, &domain.PushSubscription{}, &domain.VAPIDKey{}, &domain.DeviceToken{}, &domain.PushDelivery{}, &domain.WorkspaceIntegration{}, &domain.IntegrationRoute{}, &domain.DeadLetter{}, &domain.PhoneNumber{}, &domain.WorkspaceSMSSetting{}, &domain.WorkspaceWebhook{}, &domain.WebhookDelivery{}); err != nil {
 			return nil, err
 		}
 
@@ -67,6 +73,10 @@ func createIndexes(db *gorm.DB) {
 	db.Exec(`CREATE INDEX IF NOT EXISTS idx_notifications_user_workspace_created
 		ON notifications (target_user_id, workspace_id, created_at DESC)`)
 
+	// Index for the cursor-paginated inbox feed
+	db.Exec(`CREATE INDEX IF NOT EXISTS idx_notifications_user_feed
+		ON notifications (target_user_id, created_at DESC, id DESC)`)
+
 	// Index for unread count query
 	db.Exec(`CREATE INDEX IF NOT EXISTS idx_notifications_user_read
 		ON notifications (target_user_id, is_read)`)
@@ -79,7 +89,8 @@ func createIndexes(db *gorm.DB) {
 	db.Exec(`CREATE INDEX IF NOT EXISTS idx_notifications_created
 		ON notifications (created_at)`)
 
-	// Unique constraint for preferences
-	db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_preferences_unique
-		ON notification_preferences (user_id, COALESCE(workspace_id, '00000000-0000-0000-0000-000000000000'::uuid), type)`)
+	// Unique constraint for preferences (per channel)
+	db.Exec(`DROP INDEX IF EXISTS idx_preferences_unique`)
+	db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_preferences_unique_channel
+		ON notification_preferences (user_id, COALESCE(workspace_id, '00000000-0000-0000-0000-000000000000'::uuid), type, channel)`)
 }
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryChannel identifies an outbound delivery pipeline
+// 같은 PUSH 채널이라도 웹 푸시와 모바일 푸시는 대상과 재시도 단위가 다르므로 구분합니다.
+type DeliveryChannel string
+
+const (
+	DeliveryChannelEmail       DeliveryChannel = "EMAIL"
+	DeliveryChannelWebPush     DeliveryChannel = "WEB_PUSH"    // Target: push subscription
+	DeliveryChannelMobilePush  DeliveryChannel = "MOBILE_PUSH" // Target: device token
+	DeliveryChannelIntegration DeliveryChannel = "INTEGRATION" // Target: Slack/Teams integration
+	DeliveryChannelSMS         DeliveryChannel = "SMS"         // Target: verified phone number (user ID)
+	DeliveryChannelWebhook     DeliveryChannel = "WEBHOOK"     // Target: workspace webhook endpoint
+)
+
+// IsValid returns true if the delivery channel is known
+func (c DeliveryChannel) IsValid() bool {
+	switch c {
+	case DeliveryChannelEmail, DeliveryChannelWebPush, DeliveryChannelMobilePush, DeliveryChannelIntegration, DeliveryChannelSMS, DeliveryChannelWebhook:
+		return true
+	}
+	return false
+}
+
+// DeliveryState defines the state of a notification on a delivery channel
+type DeliveryState string
+
+const (
+	DeliveryStateSent     DeliveryState = "SENT"
+	DeliveryStateRetrying DeliveryState = "RETRYING"
+	DeliveryStateFailed   DeliveryState = "FAILED"  // Moved to the dead-letter table
+	DeliveryStateDropped  DeliveryState = "DROPPED" // Target no longer exists (expired subscription, revoked integration)
+)
+
+// ChannelDelivery is the delivery state of a notification on one channel
+// 대상이 여러 개(브라우저, 디바이스)인 경우 한 곳이라도 전송되면 SENT를 유지합니다.
+type ChannelDelivery struct {
+	Status    DeliveryState `json:"status"`
+	Attempts  int           `json:"attempts"`
+	LastError string        `json:"lastError,omitempty"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// ChannelDeliveries maps delivery channels to their state on a notification
+type ChannelDeliveries map[DeliveryChannel]ChannelDelivery
+
+// DeliveryJob is one delivery of a notification to a single target
+// 알림 스냅샷을 포함하므로 인앱 저장이 꺼진 알림도 재시도할 수 있습니다.
+type DeliveryJob struct {
+	ID           uuid.UUID       `json:"id"`
+	Channel      DeliveryChannel `json:"channel"`
+	TargetID     uuid.UUID       `json:"targetId"`
+	Attempt      int             `json:"attempt"` // Attempts already made
+	Locale       string          `json:"locale,omitempty"`
+	Notification Notification    `json:"notification"`
+}
+
+// DeadLetter stores an outbound delivery that failed permanently or exhausted its retries
+type DeadLetter struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	NotificationID uuid.UUID       `gorm:"type:uuid;not null;index" json:"notificationId"`
+	TargetUserID   uuid.UUID       `gorm:"type:uuid;not null" json:"targetUserId"`
+	WorkspaceID    uuid.UUID       `gorm:"type:uuid;not null" json:"workspaceId"`
+	Channel        DeliveryChannel `gorm:"type:varchar(20);not null;index" json:"channel"`
+	TargetID       uuid.UUID       `gorm:"type:uuid;not null" json:"targetId"`
+	Attempts       int             `gorm:"not null" json:"attempts"`
+	LastError      *string         `gorm:"type:varchar(255)" json:"lastError,omitempty"`
+	Locale         string          `gorm:"type:varchar(20)" json:"-"`
+	Payload        Notification    `gorm:"type:jsonb;serializer:json;not null" json:"-"` // Notification snapshot used for redrive
+	RedrivenAt     *time.Time      `gorm:"type:timestamptz" json:"redrivenAt,omitempty"`
+	CreatedAt      time.Time       `gorm:"type:timestamptz;default:now();not null;index" json:"createdAt"`
+}
+
+func (DeadLetter) TableName() string {
+	return "delivery_dead_letters"
+}
+
+// DeadLetterFilter narrows the dead-letter list
+type DeadLetterFilter struct {
+	Channel         DeliveryChannel `form:"channel"`
+	IncludeRedriven bool            `form:"includeRedriven"`
+	Limit           int             `form:"limit"`
+}
+
+// DeadLetterListResponse represents the dead-letter list
+type DeadLetterListResponse struct {
+	DeadLetters []DeadLetter `json:"deadLetters"`
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DevicePlatform defines the mobile platform of a device token
+type DevicePlatform string
+
+const (
+	DevicePlatformIOS     DevicePlatform = "IOS"     // APNs
+	DevicePlatformAndroid DevicePlatform = "ANDROID" // FCM
+)
+
+// IsValid returns true if the platform is supported
+func (p DevicePlatform) IsValid() bool {
+	return p == DevicePlatformIOS || p == DevicePlatformAndroid
+}
+
+// DefaultMobilePushTypes lists notification types delivered to mobile apps by default
+// 모바일 앱은 보드/채팅 알림을 수신합니다.
+var DefaultMobilePushTypes = []NotificationType{
+	NotificationTypeBoardAssigned,
+	NotificationTypeBoardUnassigned,
+	NotificationTypeBoardParticipantAdded,
+	NotificationTypeBoardUpdated,
+	NotificationTypeBoardStatusChanged,
+	NotificationTypeBoardCommentAdded,
+	NotificationTypeBoardDueSoon,
+	NotificationTypeBoardOverdue,
+	NotificationTypeBoardCommentMention,
+	NotificationTypeBoardCommentReply,
+	NotificationTypeChatMentioned,
+}
+
+// DeviceToken represents a mobile device registered for push notifications
+// 공급자가 토큰 무효를 응답하면 InvalidatedAt을 기록하고 전송 대상에서 제외합니다.
+type DeviceToken struct {
+	ID             uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserID         uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId"`
+	Platform       DevicePlatform `gorm:"type:varchar(20);not null" json:"platform"`
+	Token          string         `gorm:"type:varchar(512);not null;uniqueIndex" json:"-"`
+	DeviceName     string         `gorm:"type:varchar(100)" json:"deviceName,omitempty"`
+	AppVersion     string         `gorm:"type:varchar(50)" json:"appVersion,omitempty"`
+	FailureCount   int            `gorm:"not null;default:0" json:"failureCount"`
+	LastDeliveryAt *time.Time     `gorm:"type:timestamptz" json:"lastDeliveryAt,omitempty"`
+	LastFailureAt  *time.Time     `gorm:"type:timestamptz" json:"lastFailureAt,omitempty"`
+	LastError      *string        `gorm:"type:varchar(255)" json:"lastError,omitempty"`
+	InvalidatedAt  *time.Time     `gorm:"type:timestamptz" json:"invalidatedAt,omitempty"`
+	CreatedAt      time.Time      `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt      time.Time      `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (DeviceToken) TableName() string {
+	return "device_tokens"
+}
+
+// DeliveryStatus defines the result of a push delivery to a device
+type DeliveryStatus string
+
+const (
+	DeliveryStatusSent         DeliveryStatus = "SENT"
+	DeliveryStatusFailed       DeliveryStatus = "FAILED"
+	DeliveryStatusInvalidToken DeliveryStatus = "INVALID_TOKEN"
+)
+
+// PushDelivery records a push delivery attempt to a single device
+type PushDelivery struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	NotificationID    uuid.UUID      `gorm:"type:uuid;not null;index" json:"notificationId"`
+	DeviceTokenID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"deviceTokenId"`
+	UserID            uuid.UUID      `gorm:"type:uuid;not null" json:"userId"`
+	Platform          DevicePlatform `gorm:"type:varchar(20);not null" json:"platform"`
+	Status            DeliveryStatus `gorm:"type:varchar(20);not null" json:"status"`
+	ProviderMessageID *string        `gorm:"type:varchar(255)" json:"providerMessageId,omitempty"`
+	Error             *string        `gorm:"type:varchar(255)" json:"error,omitempty"`
+	CreatedAt         time.Time      `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+}
+
+func (PushDelivery) TableName() string {
+	return "push_deliveries"
+}
+
+// RegisterDeviceRequest represents request for registering a mobile device token
+type RegisterDeviceRequest struct {
+	Platform   DevicePlatform `json:"platform" binding:"required"`
+	Token      string         `json:"token" binding:"required,max=512"`
+	DeviceName string         `json:"deviceName,omitempty" binding:"max=100"`
+	AppVersion string         `json:"appVersion,omitempty" binding:"max=50"`
+}
+
+// UnregisterDeviceRequest represents request for removing a mobile device token
+// 로그아웃 시 호출하여 다른 사용자에게 알림이 전송되지 않도록 합니다.
+type UnregisterDeviceRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 100
+)
+
+// NotificationFeedFilter represents filters for the notification inbox feed
+// WorkspaceID가 없으면 모든 워크스페이스의 알림을 조회합니다.
+type NotificationFeedFilter struct {
+	WorkspaceID *uuid.UUID
+	Types       []NotificationType
+	Read        *bool
+	From        *time.Time
+	To          *time.Time
+	Archived    bool // true면 보관함, false면 받은 알림함
+	Cursor      string
+	Limit       int
+}
+
+// NotificationFeed represents a cursor-paginated page of the notification inbox
+type NotificationFeed struct {
+	Notifications []Notification `json:"notifications"`
+	NextCursor    string         `json:"nextCursor,omitempty"`
+	HasMore       bool           `json:"hasMore"`
+}
+
+// FeedCursor is the position after the last notification of a page
+type FeedCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IntegrationProvider defines the chat tool a workspace is connected to
+type IntegrationProvider string
+
+const (
+	IntegrationProviderSlack IntegrationProvider = "SLACK"
+	IntegrationProviderTeams IntegrationProvider = "TEAMS"
+)
+
+// IsValid returns true if the provider is supported
+func (p IntegrationProvider) IsValid() bool {
+	return p == IntegrationProviderSlack || p == IntegrationProviderTeams
+}
+
+// IntegrationAuthType defines how messages are posted to the provider
+type IntegrationAuthType string
+
+const (
+	IntegrationAuthWebhook IntegrationAuthType = "WEBHOOK" // Incoming webhook URL
+	IntegrationAuthOAuth   IntegrationAuthType = "OAUTH"   // Installed app with a bot token (Slack)
+)
+
+// DefaultIntegrationTypes lists notification types routed to a new integration
+// 채널 전체가 알아야 하는 보드 진행 상황 위주로 기본 라우팅합니다.
+var DefaultIntegrationTypes = []NotificationType{
+	NotificationTypeBoardStatusChanged,
+	NotificationTypeBoardCommentAdded,
+	NotificationTypeBoardDueSoon,
+	NotificationTypeBoardOverdue,
+}
+
+// WorkspaceIntegration represents a Slack/Teams connection of a workspace
+// 웹훅 URL과 토큰은 자격 증명이므로 응답에 포함하지 않습니다.
+type WorkspaceIntegration struct {
+	ID             uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID    uuid.UUID           `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	Provider       IntegrationProvider `gorm:"type:varchar(20);not null" json:"provider"`
+	AuthType       IntegrationAuthType `gorm:"type:varchar(20);not null" json:"authType"`
+	Name           string              `gorm:"type:varchar(100);not null" json:"name"`
+	WebhookURL     string              `gorm:"type:text" json:"-"`
+	AccessToken    string              `gorm:"type:text" json:"-"`
+	ChannelID      string              `gorm:"type:varchar(100)" json:"channelId,omitempty"` // Default channel of an OAuth install
+	ChannelName    string              `gorm:"type:varchar(255)" json:"channelName,omitempty"`
+	ExternalTeamID string              `gorm:"type:varchar(100)" json:"externalTeamId,omitempty"` // Slack team ID
+	Enabled        bool                `gorm:"not null;default:true" json:"enabled"`
+	CreatedBy      uuid.UUID           `gorm:"type:uuid;not null" json:"createdBy"`
+	FailureCount   int                 `gorm:"not null;default:0" json:"failureCount"`
+	LastDeliveryAt *time.Time          `gorm:"type:timestamptz" json:"lastDeliveryAt,omitempty"`
+	LastError      *string             `gorm:"type:varchar(255)" json:"lastError,omitempty"`
+	Routes         []IntegrationRoute  `gorm:"foreignKey:IntegrationID;constraint:OnDelete:CASCADE" json:"routes"`
+	CreatedAt      time.Time           `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt      time.Time           `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (WorkspaceIntegration) TableName() string {
+	return "workspace_integrations"
+}
+
+// IntegrationRoute routes a notification type to an integration
+// OAuth 연동은 ChannelID로 타입별 채널을 지정할 수 있고, 비어 있으면 기본 채널로 전송합니다.
+type IntegrationRoute struct {
+	ID            uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	IntegrationID uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_integration_routes_type" json:"integrationId"`
+	Type          NotificationType `gorm:"type:varchar(50);not null;uniqueIndex:idx_integration_routes_type" json:"type"`
+	ChannelID     string           `gorm:"type:varchar(100)" json:"channelId,omitempty"`
+	CreatedAt     time.Time        `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+}
+
+func (IntegrationRoute) TableName() string {
+	return "integration_routes"
+}
+
+// IntegrationRouteRequest represents a routing rule in a request
+type IntegrationRouteRequest struct {
+	Type      NotificationType `json:"type" binding:"required"`
+	ChannelID string           `json:"channelId,omitempty" binding:"max=100"`
+}
+
+// CreateIntegrationRequest represents request for connecting a webhook integration
+// Routes가 비어 있으면 DefaultIntegrationTypes로 라우팅합니다.
+type CreateIntegrationRequest struct {
+	Provider   IntegrationProvider       `json:"provider" binding:"required"`
+	Name       string                    `json:"name" binding:"required,max=100"`
+	WebhookURL string                    `json:"webhookUrl" binding:"required,max=2048"`
+	Routes     []IntegrationRouteRequest `json:"routes,omitempty" binding:"dive"`
+}
+
+// UpdateIntegrationRequest represents request for updating an integration
+type UpdateIntegrationRequest struct {
+	Name       *string `json:"name,omitempty" binding:"omitempty,max=100"`
+	WebhookURL *string `json:"webhookUrl,omitempty" binding:"omitempty,max=2048"`
+	Enabled    *bool   `json:"enabled,omitempty"`
+}
+
+// UpdateIntegrationRoutesRequest replaces the routing rules of an integration
+type UpdateIntegrationRoutesRequest struct {
+	Routes []IntegrationRouteRequest `json:"routes" binding:"dive"`
+}
+
+// SlackAuthorizeResponse contains the Slack consent page URL for an OAuth install
+type SlackAuthorizeResponse struct {
+	AuthorizeURL string `json:"authorizeUrl"`
+}
+
+// SlackOAuthCallbackRequest completes an OAuth install with the code Slack redirected with
+type SlackOAuthCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
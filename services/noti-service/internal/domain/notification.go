@@ -41,8 +41,79 @@ const (
 	NotificationTypeBoardCommentAdded    NotificationType = "BOARD_COMMENT_ADDED"
 	NotificationTypeBoardDueSoon         NotificationType = "BOARD_DUE_SOON"
 	NotificationTypeBoardOverdue         NotificationType = "BOARD_OVERDUE"
+	NotificationTypeBoardCommentMention  NotificationType = "COMMENT_MENTION" // board-service 댓글의 @멘션
+	NotificationTypeBoardCommentReply    NotificationType = "COMMENT_REPLY"   // 내 댓글에 달린 답글
+
+	// Chat events
+	NotificationTypeChatMentioned NotificationType = "CHAT_MENTIONED"
+
+	// Account security events (not tied to a workspace)
+	NotificationTypeSecurityNewLogin NotificationType = "SECURITY_NEW_LOGIN"
+)
+
+// AllNotificationTypes lists every notification type users can configure
+var AllNotificationTypes = []NotificationType{
+	NotificationTypeTaskAssigned,
+	NotificationTypeTaskUnassigned,
+	NotificationTypeTaskMentioned,
+	NotificationTypeTaskDueSoon,
+	NotificationTypeTaskOverdue,
+	NotificationTypeTaskStatusChanged,
+	NotificationTypeCommentAdded,
+	NotificationTypeCommentMentioned,
+	NotificationTypeWorkspaceInvited,
+	NotificationTypeWorkspaceRoleChanged,
+	NotificationTypeWorkspaceRemoved,
+	NotificationTypeProjectInvited,
+	NotificationTypeProjectRoleChanged,
+	NotificationTypeProjectRemoved,
+	NotificationTypeBoardAssigned,
+	NotificationTypeBoardUnassigned,
+	NotificationTypeBoardParticipantAdded,
+	NotificationTypeBoardUpdated,
+	NotificationTypeBoardStatusChanged,
+	NotificationTypeBoardCommentAdded,
+	NotificationTypeBoardDueSoon,
+	NotificationTypeBoardOverdue,
+	NotificationTypeBoardCommentMention,
+	NotificationTypeBoardCommentReply,
+	NotificationTypeChatMentioned,
+	NotificationTypeSecurityNewLogin,
+}
+
+// IsValid returns true if the notification type is a known type
+func (t NotificationType) IsValid() bool {
+	for _, known := range AllNotificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationChannel defines how a notification is delivered
+type NotificationChannel string
+
+const (
+	NotificationChannelInApp NotificationChannel = "IN_APP" // Stored and pushed via SSE
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelPush  NotificationChannel = "PUSH" // Mobile/web push
+	NotificationChannelSMS   NotificationChannel = "SMS"  // Critical types only, verified phone numbers
 )
 
+// AllNotificationChannels lists every delivery channel
+var AllNotificationChannels = []NotificationChannel{
+	NotificationChannelInApp,
+	NotificationChannelEmail,
+	NotificationChannelPush,
+	NotificationChannelSMS,
+}
+
+// IsValid returns true if the channel is a known channel
+func (c NotificationChannel) IsValid() bool {
+	return c == NotificationChannelInApp || c == NotificationChannelEmail || c == NotificationChannelPush || c == NotificationChannelSMS
+}
+
 // ResourceType defines the type of resource
 type ResourceType string
 
@@ -52,6 +123,7 @@ const (
 	ResourceTypeWorkspace ResourceType = "workspace"
 	ResourceTypeProject   ResourceType = "project"
 	ResourceTypeBoard     ResourceType = "board"
+	ResourceTypeAccount   ResourceType = "account" // Account-level events carry no workspace
 )
 
 // Notification represents a notification entity
@@ -67,7 +139,15 @@ type Notification struct {
 	Metadata     map[string]interface{} `gorm:"type:jsonb;serializer:json" json:"metadata,omitempty"`
 	IsRead       bool                   `gorm:"default:false" json:"isRead"`
 	ReadAt       *time.Time             `gorm:"type:timestamptz" json:"readAt,omitempty"`
+	GroupCount   int                    `gorm:"not null;default:1" json:"groupCount"` // Number of similar events collapsed into this notification
+	ArchivedAt   *time.Time             `gorm:"type:timestamptz" json:"archivedAt,omitempty"` // Hidden from the inbox when set
+	Deliveries   ChannelDeliveries      `gorm:"type:jsonb;serializer:json" json:"deliveryStatus,omitempty"` // Outbound channel states (push, integrations)
 	CreatedAt    time.Time              `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt    *time.Time             `gorm:"type:timestamptz" json:"updatedAt,omitempty"` // Last grouped event
+	Title        string                 `gorm:"-" json:"title,omitempty"` // Rendered in the reader's locale
+	Body         string                 `gorm:"-" json:"body,omitempty"`
+	Locale       string                 `gorm:"-" json:"-"` // Target user's locale, resolved once per delivery
+	Critical     bool                   `gorm:"-" json:"-"` // Delivered on push/email even during quiet hours
 }
 
 func (Notification) TableName() string {
@@ -75,14 +155,17 @@ func (Notification) TableName() string {
 }
 
 // NotificationPreference represents user notification preferences
+// WorkspaceID가 nil이면 전체 워크스페이스 기본값이고, 워크스페이스별 설정이 이를 덮어씁니다.
+// 설정이 없는 타입/채널 조합은 활성화된 것으로 간주합니다.
 type NotificationPreference struct {
-	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	UserID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
-	WorkspaceID *uuid.UUID `gorm:"type:uuid;index" json:"workspaceId,omitempty"`
-	Type        string     `gorm:"type:varchar(50);not null" json:"type"`
-	Enabled     bool       `gorm:"default:true" json:"enabled"`
-	CreatedAt   time.Time  `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
-	UpdatedAt   time.Time  `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+	ID          uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID           `gorm:"type:uuid;not null;index" json:"userId"`
+	WorkspaceID *uuid.UUID          `gorm:"type:uuid;index" json:"workspaceId,omitempty"`
+	Type        string              `gorm:"type:varchar(50);not null" json:"type"`
+	Channel     NotificationChannel `gorm:"type:varchar(20);not null;default:'IN_APP'" json:"channel"`
+	Enabled     bool                `gorm:"default:true" json:"enabled"`
+	CreatedAt   time.Time           `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt   time.Time           `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
 }
 
 func (NotificationPreference) TableName() string {
@@ -94,12 +177,24 @@ type NotificationEvent struct {
 	Type         NotificationType       `json:"type" binding:"required"`
 	ActorID      uuid.UUID              `json:"actorId" binding:"required"`
 	TargetUserID uuid.UUID              `json:"targetUserId" binding:"required"`
-	WorkspaceID  uuid.UUID              `json:"workspaceId" binding:"required"`
+	WorkspaceID  uuid.UUID              `json:"workspaceId" binding:"required_unless=ResourceType account"`
 	ResourceType ResourceType           `json:"resourceType" binding:"required"`
 	ResourceID   uuid.UUID              `json:"resourceId" binding:"required"`
 	ResourceName *string                `json:"resourceName,omitempty"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
 	OccurredAt   *time.Time             `json:"occurredAt,omitempty"`
+	Critical     bool                   `json:"critical,omitempty"` // Bypasses the target user's quiet hours
+}
+
+// CreateBulkNotificationsRequest represents the internal bulk notification request
+type CreateBulkNotificationsRequest struct {
+	Notifications []NotificationEvent `json:"notifications" binding:"required,min=1,max=100"`
+}
+
+// BulkNotificationsResponse represents the internal bulk notification response
+type BulkNotificationsResponse struct {
+	Created       int            `json:"created"`
+	Notifications []Notification `json:"notifications"`
 }
 
 // PaginatedNotifications represents paginated notification response
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PreferenceSetting represents whether a notification type is delivered on a channel
+type PreferenceSetting struct {
+	Type    NotificationType    `json:"type" binding:"required"`
+	Channel NotificationChannel `json:"channel" binding:"required"`
+	Enabled bool                `json:"enabled"`
+}
+
+// UpdatePreferencesRequest represents request for updating notification preferences
+// WorkspaceID가 없으면 모든 워크스페이스에 적용되는 기본값을 수정합니다.
+type UpdatePreferencesRequest struct {
+	WorkspaceID *uuid.UUID          `json:"workspaceId,omitempty"`
+	Preferences []PreferenceSetting `json:"preferences" binding:"required,min=1,max=200,dive"`
+}
+
+// PreferencesResponse represents the effective preferences of a user
+// 모든 타입 x 채널 조합을 기본값/워크스페이스 설정을 반영한 최종 값으로 반환합니다.
+type PreferencesResponse struct {
+	WorkspaceID *uuid.UUID          `json:"workspaceId,omitempty"`
+	Preferences []PreferenceSetting `json:"preferences"`
+}
+
+// ChannelPreferences maps each channel to whether it is enabled for a notification
+type ChannelPreferences map[NotificationChannel]bool
+
+// Enabled returns whether the channel is enabled (channels without a setting are enabled)
+func (p ChannelPreferences) Enabled(channel NotificationChannel) bool {
+	enabled, ok := p[channel]
+	return !ok || enabled
+}
+
+// NotificationLocale stores the language a user receives notifications in
+// 설정이 없으면 서버 기본 언어(NOTIFICATION_DEFAULT_LOCALE)를 사용합니다.
+type NotificationLocale struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"userId"`
+	Locale    string    `gorm:"type:varchar(20);not null" json:"locale"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (NotificationLocale) TableName() string {
+	return "notification_locales"
+}
+
+// UpdateLocaleRequest represents request for changing the notification language
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale" binding:"required,max=20"`
+}
+
+// LocaleResponse represents the notification language of a user
+type LocaleResponse struct {
+	Locale    string   `json:"locale"`
+	Available []string `json:"available"`
+}
+
+// QuietHours is a daily do-not-disturb window during which push/email deliveries are held
+// 사용자 시간대의 현지 시각 기준이며, 종료 시각이 시작 시각보다 이르면 자정을 넘기는 구간입니다.
+type QuietHours struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
+	Enabled   bool      `gorm:"not null" json:"enabled"`
+	StartTime string    `gorm:"type:varchar(5);not null" json:"start"` // HH:MM
+	EndTime   string    `gorm:"type:varchar(5);not null" json:"end"`   // HH:MM
+	Timezone  string    `gorm:"type:varchar(64);not null" json:"timezone"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (QuietHours) TableName() string {
+	return "notification_quiet_hours"
+}
+
+// Until returns when the quiet period containing t ends, or the zero time if t is outside quiet hours.
+func (q *QuietHours) Until(t time.Time) time.Time {
+	if q == nil || !q.Enabled {
+		return time.Time{}
+	}
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return time.Time{}
+	}
+	startHour, startMinute, err := ParseClock(q.StartTime)
+	if err != nil {
+		return time.Time{}
+	}
+	endHour, endMinute, err := ParseClock(q.EndTime)
+	if err != nil {
+		return time.Time{}
+	}
+
+	local := t.In(loc)
+	now := local.Hour()*60 + local.Minute()
+	start := startHour*60 + startMinute
+	end := endHour*60 + endMinute
+	endToday := time.Date(local.Year(), local.Month(), local.Day(), endHour, endMinute, 0, 0, loc)
+
+	switch {
+	case start < end && now >= start && now < end:
+		return endToday
+	case start > end && now >= start:
+		// 자정을 넘기는 구간의 전반부: 다음 날 종료
+		return endToday.AddDate(0, 0, 1)
+	case start > end && now < end:
+		return endToday
+	}
+	return time.Time{}
+}
+
+// ParseClock parses a HH:MM time of day
+func ParseClock(value string) (hour, minute int, err error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, err
+	}
+	return parsed.Hour(), parsed.Minute(), nil
+}
+
+// UpdateQuietHoursRequest represents request for changing quiet hours
+type UpdateQuietHoursRequest struct {
+	Enabled  bool   `json:"enabled"`
+	Start    string `json:"start" binding:"required"`
+	End      string `json:"end" binding:"required"`
+	Timezone string `json:"timezone" binding:"required,max=64"`
+}
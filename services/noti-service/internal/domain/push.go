@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPushPriorityTypes lists notification types delivered as web push by default
+// 즉시 확인이 필요한 알림(할당, 멘션, 마감 임박, 초대)만 푸시로 전송합니다.
+var DefaultPushPriorityTypes = []NotificationType{
+	NotificationTypeTaskAssigned,
+	NotificationTypeTaskMentioned,
+	NotificationTypeTaskDueSoon,
+	NotificationTypeCommentMentioned,
+	NotificationTypeWorkspaceInvited,
+	NotificationTypeProjectInvited,
+	NotificationTypeBoardAssigned,
+	NotificationTypeBoardDueSoon,
+	NotificationTypeBoardCommentMention,
+	NotificationTypeChatMentioned,
+}
+
+// PushSubscription represents a browser push subscription of a user
+// Endpoint는 브라우저(푸시 서비스)마다 고유하므로 다른 사용자가 같은 브라우저로 로그인하면 소유자가 바뀝니다.
+type PushSubscription struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
+	Endpoint   string     `gorm:"type:text;not null;uniqueIndex" json:"endpoint"`
+	P256dh     string     `gorm:"type:varchar(255);not null" json:"-"`
+	Auth       string     `gorm:"type:varchar(255);not null" json:"-"`
+	UserAgent  string     `gorm:"type:varchar(500)" json:"userAgent,omitempty"`
+	LastUsedAt *time.Time `gorm:"type:timestamptz" json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (PushSubscription) TableName() string {
+	return "push_subscriptions"
+}
+
+// VAPIDKey stores the generated application server key pair shared by all replicas
+type VAPIDKey struct {
+	ID         int       `gorm:"primaryKey" json:"-"`
+	PublicKey  string    `gorm:"type:varchar(255);not null" json:"publicKey"`
+	PrivateKey string    `gorm:"type:varchar(255);not null" json:"-"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+}
+
+func (VAPIDKey) TableName() string {
+	return "vapid_keys"
+}
+
+// PushSubscriptionKeys holds the client keys of a push subscription
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" binding:"required,max=255"`
+	Auth   string `json:"auth" binding:"required,max=255"`
+}
+
+// RegisterPushSubscriptionRequest represents request for registering a push subscription
+// 브라우저의 PushSubscription.toJSON() 결과를 그대로 전달합니다.
+type RegisterPushSubscriptionRequest struct {
+	Endpoint string               `json:"endpoint" binding:"required,max=2048"`
+	Keys     PushSubscriptionKeys `json:"keys" binding:"required"`
+}
+
+// UnregisterPushSubscriptionRequest represents request for removing a push subscription
+type UnregisterPushSubscriptionRequest struct {
+	Endpoint string `json:"endpoint" binding:"required"`
+}
+
+// VAPIDPublicKeyResponse represents the application server key used to subscribe
+type VAPIDPublicKeyResponse struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// PushMessage is the payload delivered to the service worker
+type PushMessage struct {
+	ID           uuid.UUID        `json:"id"`
+	Type         NotificationType `json:"type"`
+	WorkspaceID  uuid.UUID        `json:"workspaceId"`
+	ResourceType ResourceType     `json:"resourceType"`
+	ResourceID   uuid.UUID        `json:"resourceId"`
+	ResourceName *string          `json:"resourceName,omitempty"`
+	Title        string           `json:"title"` // Rendered in the user's locale
+	Body         string           `json:"body,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// SSEEventReadState is the SSE event emitted when read state changes
+const SSEEventReadState = "read_state"
+
+// UpdateReadStateRequest represents request for marking several notifications read or unread
+type UpdateReadStateRequest struct {
+	IDs  []uuid.UUID `json:"ids" binding:"required,min=1,max=500"`
+	Read *bool       `json:"read" binding:"required"`
+}
+
+// ReadStateResponse represents the result of a read state change
+type ReadStateResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+// WorkspaceUnreadCounts represents unread counts of a user across workspaces
+// 읽지 않은 알림이 없는 워크스페이스는 포함하지 않습니다.
+type WorkspaceUnreadCounts struct {
+	Workspaces []UnreadCount `json:"workspaces"`
+	Total      int64         `json:"total"`
+}
+
+// ReadStateEvent is pushed to every open tab of a user so badges update instantly
+type ReadStateEvent struct {
+	WorkspaceID     uuid.UUID   `json:"workspaceId"`
+	UnreadCount     int64       `json:"unreadCount"`
+	NotificationIDs []uuid.UUID `json:"notificationIds,omitempty"` // Empty when all notifications changed
+	Read            bool        `json:"read"`
+}
+
+// SSEMessage wraps a non-notification event published on a user's Redis channel
+// 알림 자체는 기존과 같이 그대로 발행하고, 그 외 이벤트만 event/data로 감쌉니다.
+type SSEMessage struct {
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
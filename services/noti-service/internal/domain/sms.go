@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSMSTypes lists the critical notification types delivered by SMS by default
+// 비용이 발생하는 채널이므로 놓치면 안 되는 기한 초과/권한 변경 알림만 전송합니다.
+var DefaultSMSTypes = []NotificationType{
+	NotificationTypeTaskOverdue,
+	NotificationTypeBoardOverdue,
+	NotificationTypeWorkspaceRemoved,
+}
+
+// PhoneNumber is the verified SMS phone number of a user
+type PhoneNumber struct {
+	UserID     uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
+	Number     string    `gorm:"type:varchar(20);not null" json:"-"` // E.164
+	VerifiedAt time.Time `gorm:"type:timestamptz;not null" json:"verifiedAt"`
+	Disabled   bool      `gorm:"not null;default:false" json:"disabled"` // Provider reported the number as unreachable or opted out
+	UpdatedAt  time.Time `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (PhoneNumber) TableName() string {
+	return "sms_phone_numbers"
+}
+
+// WorkspaceSMSSetting enables SMS notifications for a workspace with a monthly message cap
+// 설정이 없는 워크스페이스는 SMS를 받지 않습니다.
+type WorkspaceSMSSetting struct {
+	WorkspaceID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"workspaceId"`
+	Enabled      bool      `gorm:"not null" json:"enabled"`
+	MonthlyLimit int       `gorm:"not null" json:"monthlyLimit"`
+	UpdatedBy    uuid.UUID `gorm:"type:uuid;not null" json:"updatedBy"`
+	UpdatedAt    time.Time `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (WorkspaceSMSSetting) TableName() string {
+	return "workspace_sms_settings"
+}
+
+// RegisterPhoneRequest represents request for sending a verification code to a phone number
+type RegisterPhoneRequest struct {
+	PhoneNumber string `json:"phoneNumber" binding:"required,max=20"` // E.164, e.g. +821012345678
+}
+
+// VerifyPhoneRequest represents request for confirming a phone number with its verification code
+type VerifyPhoneRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+// PhoneNumberResponse represents the SMS phone number of a user (masked)
+type PhoneNumberResponse struct {
+	PhoneNumber string     `json:"phoneNumber,omitempty"`
+	Verified    bool       `json:"verified"`
+	Disabled    bool       `json:"disabled,omitempty"`
+	VerifiedAt  *time.Time `json:"verifiedAt,omitempty"`
+	Pending     string     `json:"pendingPhoneNumber,omitempty"` // Number awaiting verification
+}
+
+// UpdateWorkspaceSMSRequest represents request for changing the SMS setting of a workspace
+type UpdateWorkspaceSMSRequest struct {
+	Enabled      bool `json:"enabled"`
+	MonthlyLimit *int `json:"monthlyLimit,omitempty" binding:"omitempty,min=1"`
+}
+
+// WorkspaceSMSResponse represents the SMS setting and usage of a workspace
+type WorkspaceSMSResponse struct {
+	Enabled       bool               `json:"enabled"`
+	MonthlyLimit  int                `json:"monthlyLimit"`
+	SentThisMonth int64              `json:"sentThisMonth"`
+	Types         []NotificationType `json:"types"`
+}
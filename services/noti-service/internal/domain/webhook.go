@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventNotificationCreated is the event type sent for every new notification
+const WebhookEventNotificationCreated = "notification.created"
+
+// WorkspaceWebhook is an outbound webhook endpoint of a workspace
+// Types가 비어 있으면 모든 알림 타입을 전송합니다. 서명 비밀키는 생성/재발급 응답에서만 노출합니다.
+type WorkspaceWebhook struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID    uuid.UUID          `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	Name           string             `gorm:"type:varchar(100);not null" json:"name"`
+	URL            string             `gorm:"type:text;not null" json:"url"`
+	Secret         string             `gorm:"type:varchar(100);not null" json:"-"`
+	Types          []NotificationType `gorm:"type:jsonb;serializer:json;not null" json:"types"`
+	Enabled        bool               `gorm:"not null;default:true" json:"enabled"`
+	CreatedBy      uuid.UUID          `gorm:"type:uuid;not null" json:"createdBy"`
+	FailureCount   int                `gorm:"not null;default:0" json:"failureCount"` // Consecutive failed attempts
+	LastDeliveryAt *time.Time         `gorm:"type:timestamptz" json:"lastDeliveryAt,omitempty"`
+	LastError      *string            `gorm:"type:varchar(255)" json:"lastError,omitempty"`
+	CreatedAt      time.Time          `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	UpdatedAt      time.Time          `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+}
+
+func (WorkspaceWebhook) TableName() string {
+	return "workspace_webhooks"
+}
+
+// Accepts returns true if the webhook receives a notification type
+func (w *WorkspaceWebhook) Accepts(notificationType NotificationType) bool {
+	if len(w.Types) == 0 {
+		return true
+	}
+	for _, t := range w.Types {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one delivery attempt to a webhook, kept as its delivery log
+type WebhookDelivery struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WebhookID      uuid.UUID `gorm:"type:uuid;not null;index:idx_webhook_deliveries_webhook_created" json:"webhookId"`
+	EventID        uuid.UUID `gorm:"type:uuid;not null" json:"eventId"` // Notification ID, same across retries
+	EventType      string    `gorm:"type:varchar(50);not null" json:"eventType"`
+	StatusCode     int       `gorm:"not null;default:0" json:"statusCode"` // 0 if no response was received
+	Success        bool      `gorm:"not null" json:"success"`
+	Error          *string   `gorm:"type:varchar(255)" json:"error,omitempty"`
+	DurationMillis int64     `gorm:"not null" json:"durationMs"`
+	CreatedAt      time.Time `gorm:"type:timestamptz;default:now();not null;index:idx_webhook_deliveries_webhook_created" json:"createdAt"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookEvent is the JSON body posted to webhook endpoints
+type WebhookEvent struct {
+	ID           uuid.UUID           `json:"id"`
+	Type         string              `json:"type"`
+	WorkspaceID  uuid.UUID           `json:"workspaceId"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	Notification WebhookNotification `json:"notification"`
+	Test         bool                `json:"test,omitempty"`
+}
+
+// WebhookNotification is the notification carried by a webhook event
+type WebhookNotification struct {
+	ID           uuid.UUID              `json:"id"`
+	Type         NotificationType       `json:"type"`
+	ActorID      uuid.UUID              `json:"actorId"`
+	TargetUserID uuid.UUID              `json:"targetUserId"`
+	ResourceType ResourceType           `json:"resourceType"`
+	ResourceID   uuid.UUID              `json:"resourceId"`
+	ResourceName *string                `json:"resourceName,omitempty"`
+	Title        string                 `json:"title"`
+	Body         string                 `json:"body,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    time.Time              `json:"createdAt"`
+}
+
+// CreateWebhookRequest represents request for registering a webhook endpoint
+type CreateWebhookRequest struct {
+	Name  string             `json:"name" binding:"required,max=100"`
+	URL   string             `json:"url" binding:"required,max=2048"`
+	Types []NotificationType `json:"types,omitempty"` // Empty receives all notification types
+}
+
+// UpdateWebhookRequest represents request for updating a webhook endpoint
+type UpdateWebhookRequest struct {
+	Name    *string             `json:"name,omitempty" binding:"omitempty,max=100"`
+	URL     *string             `json:"url,omitempty" binding:"omitempty,max=2048"`
+	Types   *[]NotificationType `json:"types,omitempty"`
+	Enabled *bool               `json:"enabled,omitempty"`
+}
+
+// WebhookSecretResponse returns a webhook with its signing secret (on create and rotate only)
+type WebhookSecretResponse struct {
+	WorkspaceWebhook
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryFilter narrows the delivery log
+type WebhookDeliveryFilter struct {
+	FailedOnly bool `form:"failedOnly"`
+	Limit      int  `form:"limit"`
+}
+
+// WebhookDeliveryListResponse represents the delivery log of a webhook
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
@@ -0,0 +1,93 @@
+// Package grpcserver implements noti-service's internal gRPC API (see internalrpc.NotificationServiceServer).
+// REST 내부 API(POST /api/internal/notifications)와 같은 서비스 계층을 사용합니다.
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"noti-service/internal/domain"
+	"noti-service/internal/service"
+)
+
+// NotificationServer implements internalrpc.NotificationServiceServer
+type NotificationServer struct {
+	service *service.NotificationService
+}
+
+// NewNotificationServer creates a new NotificationServer
+func NewNotificationServer(notificationService *service.NotificationService) *NotificationServer {
+	return &NotificationServer{service: notificationService}
+}
+
+// SubmitNotification creates and dispatches a notification
+func (s *NotificationServer) SubmitNotification(ctx context.Context, req *internalrpc.SubmitNotificationRequest) (*internalrpc.SubmitNotificationResponse, error) {
+	event, err := toEvent(req)
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := s.service.CreateNotification(ctx, event)
+	if err != nil {
+		return nil, internalrpc.Error(err)
+	}
+	// 대상 사용자가 이 알림 타입의 인앱 수신을 끈 경우
+	if notification == nil {
+		return &internalrpc.SubmitNotificationResponse{Suppressed: true}, nil
+	}
+	return &internalrpc.SubmitNotificationResponse{NotificationID: notification.ID.String()}, nil
+}
+
+// toEvent applies the same required-field rules as the REST binding tags on domain.NotificationEvent
+func toEvent(req *internalrpc.SubmitNotificationRequest) (*domain.NotificationEvent, error) {
+	if req.Type == "" || req.ResourceType == "" {
+		return nil, status.Error(codes.InvalidArgument, "type and resourceType are required")
+	}
+
+	event := &domain.NotificationEvent{
+		Type:         domain.NotificationType(req.Type),
+		ResourceType: domain.ResourceType(req.ResourceType),
+		OccurredAt:   req.OccurredAt,
+		Critical:     req.Critical,
+	}
+
+	var err error
+	if event.ActorID, err = parseID(req.ActorID, "actorId"); err != nil {
+		return nil, err
+	}
+	if event.TargetUserID, err = parseID(req.TargetUserID, "targetUserId"); err != nil {
+		return nil, err
+	}
+	if event.ResourceID, err = parseID(req.ResourceID, "resourceId"); err != nil {
+		return nil, err
+	}
+	if req.WorkspaceID != "" || event.ResourceType != domain.ResourceTypeAccount {
+		if event.WorkspaceID, err = parseID(req.WorkspaceID, "workspaceId"); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ResourceName != "" {
+		name := req.ResourceName
+		event.ResourceName = &name
+	}
+	if len(req.Metadata) > 0 {
+		event.Metadata = make(map[string]interface{}, len(req.Metadata))
+		for key, value := range req.Metadata {
+			event.Metadata[key] = value
+		}
+	}
+	return event, nil
+}
+
+func parseID(value, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return id, nil
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// DeliveryHandler handles internal HTTP requests for failed outbound deliveries.
+type DeliveryHandler struct {
+	service *service.DeliveryService
+	logger  *zap.Logger
+}
+
+// NewDeliveryHandler creates a new DeliveryHandler with the given dependencies.
+func NewDeliveryHandler(service *service.DeliveryService, logger *zap.Logger) *DeliveryHandler {
+	return &DeliveryHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *DeliveryHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// GetDeadLetters returns the newest permanently failed deliveries.
+// channel, includeRedriven, limit 쿼리로 필터링합니다.
+func (h *DeliveryHandler) GetDeadLetters(c *gin.Context) {
+	log := h.log(c)
+
+	var filter domain.DeadLetterFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		log.Warn("GetDeadLetters invalid query", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	deadLetters, err := h.service.ListDeadLetters(c.Request.Context(), &filter)
+	if err != nil {
+		log.Error("GetDeadLetters failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, domain.DeadLetterListResponse{DeadLetters: deadLetters})
+}
+
+// RedriveDeadLetter delivers a dead letter again in the background.
+func (h *DeliveryHandler) RedriveDeadLetter(c *gin.Context) {
+	log := h.log(c)
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid dead letter ID")
+		return
+	}
+
+	if err := h.service.Redrive(c.Request.Context(), id); err != nil {
+		log.Warn("RedriveDeadLetter failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(202)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// DeviceHandler handles HTTP requests for mobile push device registration.
+type DeviceHandler struct {
+	service *service.MobilePushService
+	logger  *zap.Logger
+}
+
+// NewDeviceHandler creates a new DeviceHandler with the given dependencies.
+func NewDeviceHandler(service *service.MobilePushService, logger *zap.Logger) *DeviceHandler {
+	return &DeviceHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *DeviceHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// GetDevices returns the devices of the current user with their delivery state.
+func (h *DeviceHandler) GetDevices(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	devices, err := h.service.GetDevices(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("GetDevices failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, gin.H{"devices": devices})
+}
+
+// RegisterDevice registers an FCM/APNs device token for the current user.
+func (h *DeviceHandler) RegisterDevice(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("RegisterDevice started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("RegisterDevice validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	device, err := h.service.RegisterDevice(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Error("RegisterDevice failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, device)
+}
+
+// UnregisterDevice removes a device token of the current user.
+func (h *DeviceHandler) UnregisterDevice(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UnregisterDevice started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UnregisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UnregisterDevice validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.service.UnregisterDevice(c.Request.Context(), userID, req.Token); err != nil {
+		log.Error("UnregisterDevice failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/middleware"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// IntegrationHandler handles HTTP requests for Slack/Teams workspace integrations.
+// 모든 요청은 x-workspace-id 헤더의 워크스페이스 관리자 권한을 요구합니다.
+type IntegrationHandler struct {
+	service *service.IntegrationService
+	logger  *zap.Logger
+}
+
+// NewIntegrationHandler creates a new IntegrationHandler with the given dependencies.
+func NewIntegrationHandler(service *service.IntegrationService, logger *zap.Logger) *IntegrationHandler {
+	return &IntegrationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *IntegrationHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// caller returns the current user, workspace and token used for the admin check.
+func (h *IntegrationHandler) caller(c *gin.Context) (uuid.UUID, uuid.UUID, string) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+	workspaceID := c.MustGet("workspace_id").(uuid.UUID)
+	token, _ := middleware.GetJWTToken(c)
+	return userID, workspaceID, token
+}
+
+// GetIntegrations returns the integrations of the workspace.
+func (h *IntegrationHandler) GetIntegrations(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	integrations, err := h.service.ListIntegrations(c.Request.Context(), workspaceID, userID, token)
+	if err != nil {
+		log.Error("GetIntegrations failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, gin.H{
+		"integrations":      integrations,
+		"slackOAuthEnabled": h.service.SlackOAuthEnabled(),
+	})
+}
+
+// CreateIntegration connects a Slack or Teams incoming webhook to the workspace.
+func (h *IntegrationHandler) CreateIntegration(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("CreateIntegration started")
+
+	userID, workspaceID, token := h.caller(c)
+
+	var req domain.CreateIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("CreateIntegration validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	integration, err := h.service.CreateIntegration(c.Request.Context(), workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("CreateIntegration failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, integration)
+}
+
+// UpdateIntegration updates the name, webhook URL or enabled state of an integration.
+func (h *IntegrationHandler) UpdateIntegration(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("integrationId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid integration ID")
+		return
+	}
+
+	var req domain.UpdateIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateIntegration validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	integration, err := h.service.UpdateIntegration(c.Request.Context(), id, workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("UpdateIntegration failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, integration)
+}
+
+// UpdateRoutes replaces the per-type routing rules of an integration.
+func (h *IntegrationHandler) UpdateRoutes(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("integrationId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid integration ID")
+		return
+	}
+
+	var req domain.UpdateIntegrationRoutesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateRoutes validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	integration, err := h.service.UpdateRoutes(c.Request.Context(), id, workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("UpdateRoutes failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, integration)
+}
+
+// DeleteIntegration disconnects an integration from the workspace.
+func (h *IntegrationHandler) DeleteIntegration(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("integrationId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid integration ID")
+		return
+	}
+
+	if err := h.service.DeleteIntegration(c.Request.Context(), id, workspaceID, userID, token); err != nil {
+		log.Error("DeleteIntegration failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// TestIntegration posts a test message to an integration.
+func (h *IntegrationHandler) TestIntegration(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("integrationId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid integration ID")
+		return
+	}
+
+	if err := h.service.TestIntegration(c.Request.Context(), id, workspaceID, userID, token); err != nil {
+		log.Warn("TestIntegration failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetSlackAuthorizeURL starts a Slack app install for the workspace.
+func (h *IntegrationHandler) GetSlackAuthorizeURL(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	authorizeURL, err := h.service.SlackAuthorizeURL(c.Request.Context(), workspaceID, userID, token)
+	if err != nil {
+		log.Error("GetSlackAuthorizeURL failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, domain.SlackAuthorizeResponse{AuthorizeURL: authorizeURL})
+}
+
+// CompleteSlackOAuth finishes a Slack app install with the code from the OAuth redirect.
+func (h *IntegrationHandler) CompleteSlackOAuth(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	var req domain.SlackOAuthCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("CompleteSlackOAuth validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	integration, err := h.service.CompleteSlackOAuth(c.Request.Context(), workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("CompleteSlackOAuth failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, integration)
+}
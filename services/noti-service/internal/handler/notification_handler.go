@@ -5,11 +5,14 @@
 package handler
 
 import (
+	"fmt"
 	"noti-service/internal/domain"
 	"noti-service/internal/response"
 	"noti-service/internal/service"
 	"noti-service/internal/sse"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -53,17 +56,10 @@ func (h *NotificationHandler) GetNotifications(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 	workspaceID := c.MustGet("workspace_id").(uuid.UUID)
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	pageReq := response.ParsePageRequest(c, 20)
+	page, limit := pageReq.Page, pageReq.Limit
 	unreadOnly := c.Query("unreadOnly") == "true"
 
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 20
-	}
-
 	log.Debug("GetNotifications fetching",
 		zap.String("enduser.id", userID.String()),
 		zap.String("workspace.id", workspaceID.String()),
@@ -81,7 +77,7 @@ func (h *NotificationHandler) GetNotifications(c *gin.Context) {
 	log.Debug("GetNotifications completed",
 		zap.String("enduser.id", userID.String()),
 		zap.Int("notification.count", len(result.Notifications)))
-	c.JSON(200, result)
+	response.OK(c, result)
 }
 
 // GetUnreadCount returns unread notification count
@@ -106,7 +102,7 @@ func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
 	log.Debug("GetUnreadCount completed",
 		zap.String("enduser.id", userID.String()),
 		zap.Int64("unread.count", result.Count))
-	c.JSON(200, result)
+	response.OK(c, result)
 }
 
 // StreamNotifications handles SSE connection
@@ -146,7 +142,7 @@ func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
 	}
 
 	log.Info("Notification marked as read", zap.String("notification.id", notificationID.String()))
-	c.JSON(200, notification)
+	response.OK(c, notification)
 }
 
 // MarkAllAsRead marks all notifications as read
@@ -171,7 +167,7 @@ func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
 	log.Info("All notifications marked as read",
 		zap.String("enduser.id", userID.String()),
 		zap.Int64("marked.count", count))
-	c.JSON(200, gin.H{"markedAsRead": count})
+	response.OK(c, gin.H{"markedAsRead": count})
 }
 
 // DeleteNotification deletes a notification
@@ -212,6 +208,15 @@ func (h *NotificationHandler) DeleteNotification(c *gin.Context) {
 }
 
 // CreateNotification creates a new notification (internal API)
+// @Summary Create a notification
+// @ID createNotification
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Security InternalAPIKey
+// @Param request body domain.NotificationEvent true "Notification event"
+// @Success 201 {object} domain.Notification
+// @Router /internal/notifications [post]
 func (h *NotificationHandler) CreateNotification(c *gin.Context) {
 	log := h.log(c)
 	log.Debug("CreateNotification started")
@@ -236,21 +241,35 @@ func (h *NotificationHandler) CreateNotification(c *gin.Context) {
 		return
 	}
 
+	// 대상 사용자가 이 알림 타입의 인앱 수신을 끈 경우
+	if notification == nil {
+		log.Debug("CreateNotification suppressed by preference",
+			zap.String("notification.type", string(event.Type)))
+		response.OK(c, gin.H{"suppressed": true})
+		return
+	}
+
 	log.Info("Notification created",
 		zap.String("notification.id", notification.ID.String()),
 		zap.String("notification.type", string(notification.Type)))
-	c.JSON(201, notification)
+	response.Created(c, notification)
 }
 
 // CreateBulkNotifications creates multiple notifications (internal API)
+// @Summary Create notifications in bulk
+// @ID createBulkNotifications
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Security InternalAPIKey
+// @Param request body domain.CreateBulkNotificationsRequest true "Notification events (max 100)"
+// @Success 201 {object} domain.BulkNotificationsResponse
+// @Router /internal/notifications/bulk [post]
 func (h *NotificationHandler) CreateBulkNotifications(c *gin.Context) {
 	log := h.log(c)
 	log.Debug("CreateBulkNotifications started")
 
-	var req struct {
-		Notifications []domain.NotificationEvent `json:"notifications" binding:"required,min=1,max=100"`
-	}
-
+	var req domain.CreateBulkNotificationsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		log.Warn("CreateBulkNotifications validation failed", zap.Error(err))
 		response.BadRequest(c, err.Error())
@@ -268,8 +287,188 @@ func (h *NotificationHandler) CreateBulkNotifications(c *gin.Context) {
 	}
 
 	log.Info("Bulk notifications created", zap.Int("created.count", len(notifications)))
-	c.JSON(201, gin.H{
-		"created":       len(notifications),
-		"notifications": notifications,
+	response.Created(c, domain.BulkNotificationsResponse{
+		Created:       len(notifications),
+		Notifications: notifications,
 	})
 }
+
+// MarkAsUnread marks a single notification as unread
+func (h *NotificationHandler) MarkAsUnread(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("MarkAsUnread started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Warn("MarkAsUnread invalid notification ID")
+		response.BadRequest(c, "Invalid notification ID")
+		return
+	}
+
+	notification, err := h.service.MarkAsUnread(c.Request.Context(), notificationID, userID)
+	if err != nil {
+		log.Error("MarkAsUnread failed",
+			zap.String("notification.id", notificationID.String()),
+			zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, notification)
+}
+
+// UpdateReadState marks several notifications read or unread
+func (h *NotificationHandler) UpdateReadState(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UpdateReadState started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UpdateReadStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateReadState validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	count, err := h.service.UpdateReadState(c.Request.Context(), userID, req.IDs, *req.Read)
+	if err != nil {
+		log.Error("UpdateReadState failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, domain.ReadStateResponse{Updated: count})
+}
+
+// GetUnreadCounts returns unread notification counts for every workspace
+func (h *NotificationHandler) GetUnreadCounts(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("GetUnreadCounts started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	result, err := h.service.GetUnreadCounts(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("GetUnreadCounts failed", zap.Error(err))
+		response.InternalError(c, "Failed to get unread counts")
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// GetFeed returns the notification inbox with cursor pagination and filters
+// 쿼리: workspaceId, types(쉼표 구분), read, from/to(RFC3339), archived, cursor, limit
+func (h *NotificationHandler) GetFeed(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("GetFeed started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	filter, err := parseFeedFilter(c)
+	if err != nil {
+		log.Warn("GetFeed invalid query", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.service.GetFeed(c.Request.Context(), userID, filter)
+	if err != nil {
+		log.Error("GetFeed failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// ArchiveNotification moves a notification to the archive
+func (h *NotificationHandler) ArchiveNotification(c *gin.Context) {
+	h.setArchived(c, true)
+}
+
+// UnarchiveNotification restores an archived notification to the inbox
+func (h *NotificationHandler) UnarchiveNotification(c *gin.Context) {
+	h.setArchived(c, false)
+}
+
+func (h *NotificationHandler) setArchived(c *gin.Context, archived bool) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Warn("setArchived invalid notification ID")
+		response.BadRequest(c, "Invalid notification ID")
+		return
+	}
+
+	notification, err := h.service.ArchiveNotification(c.Request.Context(), notificationID, userID, archived)
+	if err != nil {
+		log.Error("setArchived failed",
+			zap.String("notification.id", notificationID.String()),
+			zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, notification)
+}
+
+// parseFeedFilter parses feed query parameters
+func parseFeedFilter(c *gin.Context) (*domain.NotificationFeedFilter, error) {
+	filter := &domain.NotificationFeedFilter{
+		Cursor:   c.Query("cursor"),
+		Archived: c.Query("archived") == "true",
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		v, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit")
+		}
+		filter.Limit = v
+	}
+
+	if ws := c.Query("workspaceId"); ws != "" {
+		id, err := uuid.Parse(ws)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspaceId")
+		}
+		filter.WorkspaceID = &id
+	} else if id, exists := c.Get("workspace_id"); exists {
+		wsID := id.(uuid.UUID)
+		filter.WorkspaceID = &wsID
+	}
+
+	if types := c.Query("types"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				filter.Types = append(filter.Types, domain.NotificationType(t))
+			}
+		}
+	}
+
+	if read := c.Query("read"); read != "" {
+		v, err := strconv.ParseBool(read)
+		if err != nil {
+			return nil, fmt.Errorf("invalid read")
+		}
+		filter.Read = &v
+	}
+
+	for name, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if value := c.Query(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: must be RFC3339", name)
+			}
+			*target = &t
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// PreferenceHandler handles HTTP requests for notification preferences.
+type PreferenceHandler struct {
+	service *service.PreferenceService
+	logger  *zap.Logger
+}
+
+// NewPreferenceHandler creates a new PreferenceHandler with the given dependencies.
+func NewPreferenceHandler(service *service.PreferenceService, logger *zap.Logger) *PreferenceHandler {
+	return &PreferenceHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *PreferenceHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// GetPreferences returns the effective preferences for every notification type and channel.
+// x-workspace-id 헤더가 있으면 해당 워크스페이스 설정을, 없으면 전체 기본값을 반환합니다.
+func (h *PreferenceHandler) GetPreferences(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("GetPreferences started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var workspaceID *uuid.UUID
+	if id, exists := c.Get("workspace_id"); exists {
+		wsID := id.(uuid.UUID)
+		workspaceID = &wsID
+	}
+
+	result, err := h.service.GetPreferences(c.Request.Context(), userID, workspaceID)
+	if err != nil {
+		log.Error("GetPreferences failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// UpdatePreferences enables or disables notification types per channel.
+func (h *PreferenceHandler) UpdatePreferences(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UpdatePreferences started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdatePreferences validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.service.UpdatePreferences(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Error("UpdatePreferences failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	log.Info("Notification preferences updated",
+		zap.String("enduser.id", userID.String()),
+		zap.Int("preference.count", len(req.Preferences)))
+	response.OK(c, result)
+}
+
+// GetLocale returns the language used for the user's notification texts.
+func (h *PreferenceHandler) GetLocale(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	result, err := h.service.GetLocale(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("GetLocale failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// UpdateLocale sets the language used for the user's notification texts.
+func (h *PreferenceHandler) UpdateLocale(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UpdateLocale started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UpdateLocaleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateLocale validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.service.UpdateLocale(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Warn("UpdateLocale failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// GetQuietHours returns the user's do-not-disturb window.
+func (h *PreferenceHandler) GetQuietHours(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	result, err := h.service.GetQuietHours(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("GetQuietHours failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
+
+// UpdateQuietHours sets the user's do-not-disturb window.
+func (h *PreferenceHandler) UpdateQuietHours(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UpdateQuietHours started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UpdateQuietHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateQuietHours validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.service.UpdateQuietHours(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Warn("UpdateQuietHours failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, result)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// PushHandler handles HTTP requests for browser push subscriptions.
+type PushHandler struct {
+	service *service.PushService
+	logger  *zap.Logger
+}
+
+// NewPushHandler creates a new PushHandler with the given dependencies.
+func NewPushHandler(service *service.PushService, logger *zap.Logger) *PushHandler {
+	return &PushHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *PushHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// GetVAPIDPublicKey returns the application server key used by PushManager.subscribe.
+func (h *PushHandler) GetVAPIDPublicKey(c *gin.Context) {
+	response.OK(c, domain.VAPIDPublicKeyResponse{PublicKey: h.service.PublicKey()})
+}
+
+// RegisterSubscription registers a browser push subscription for the current user.
+func (h *PushHandler) RegisterSubscription(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("RegisterSubscription started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.RegisterPushSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("RegisterSubscription validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	sub, err := h.service.Subscribe(c.Request.Context(), userID, &req, c.Request.UserAgent())
+	if err != nil {
+		log.Error("RegisterSubscription failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, sub)
+}
+
+// UnregisterSubscription removes a browser push subscription of the current user.
+func (h *PushHandler) UnregisterSubscription(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UnregisterSubscription started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.UnregisterPushSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UnregisterSubscription validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.service.Unsubscribe(c.Request.Context(), userID, req.Endpoint); err != nil {
+		log.Error("UnregisterSubscription failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"net/http"
+
+	"noti-service/internal/domain"
+	"noti-service/internal/middleware"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// SMSHandler handles HTTP requests for SMS phone numbers and workspace SMS settings.
+type SMSHandler struct {
+	service *service.SMSService
+	logger  *zap.Logger
+}
+
+// NewSMSHandler creates a new SMSHandler with the given dependencies.
+func NewSMSHandler(service *service.SMSService, logger *zap.Logger) *SMSHandler {
+	return &SMSHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *SMSHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// GetPhone returns the SMS phone number of the current user (masked).
+func (h *SMSHandler) GetPhone(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	phone, err := h.service.GetPhone(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("GetPhone failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, phone)
+}
+
+// RegisterPhone sends a verification code to a new phone number of the current user.
+func (h *SMSHandler) RegisterPhone(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("RegisterPhone started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.RegisterPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("RegisterPhone validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	phone, err := h.service.RegisterPhone(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Error("RegisterPhone failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusAccepted, phone)
+}
+
+// VerifyPhone confirms the pending phone number of the current user.
+func (h *SMSHandler) VerifyPhone(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("VerifyPhone started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var req domain.VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("VerifyPhone validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	phone, err := h.service.VerifyPhone(c.Request.Context(), userID, &req)
+	if err != nil {
+		log.Error("VerifyPhone failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, phone)
+}
+
+// DeletePhone removes the SMS phone number of the current user.
+func (h *SMSHandler) DeletePhone(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	if err := h.service.DeletePhone(c.Request.Context(), userID); err != nil {
+		log.Error("DeletePhone failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetWorkspaceSMS returns the SMS setting and usage of the workspace (admins only).
+func (h *SMSHandler) GetWorkspaceSMS(c *gin.Context) {
+	log := h.log(c)
+	userID := c.MustGet("user_id").(uuid.UUID)
+	workspaceID := c.MustGet("workspace_id").(uuid.UUID)
+	token, _ := middleware.GetJWTToken(c)
+
+	setting, err := h.service.GetWorkspaceSMS(c.Request.Context(), workspaceID, userID, token)
+	if err != nil {
+		log.Error("GetWorkspaceSMS failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, setting)
+}
+
+// UpdateWorkspaceSMS enables or disables SMS for the workspace (admins only).
+func (h *SMSHandler) UpdateWorkspaceSMS(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("UpdateWorkspaceSMS started")
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	workspaceID := c.MustGet("workspace_id").(uuid.UUID)
+	token, _ := middleware.GetJWTToken(c)
+
+	var req domain.UpdateWorkspaceSMSRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateWorkspaceSMS validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	setting, err := h.service.UpdateWorkspaceSMS(c.Request.Context(), workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("UpdateWorkspaceSMS failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, setting)
+}
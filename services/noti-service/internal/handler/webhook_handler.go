@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"noti-service/internal/domain"
+	"noti-service/internal/middleware"
+	"noti-service/internal/response"
+	"noti-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// WebhookHandler handles HTTP requests for outbound workspace webhooks.
+type WebhookHandler struct {
+	service *service.WebhookService
+	logger  *zap.Logger
+}
+
+// NewWebhookHandler creates a new WebhookHandler with the given dependencies.
+func NewWebhookHandler(service *service.WebhookService, logger *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (h *WebhookHandler) log(c *gin.Context) *zap.Logger {
+	return commnotel.WithTraceContext(c.Request.Context(), h.logger)
+}
+
+// caller returns the user, workspace and token of the request.
+func (h *WebhookHandler) caller(c *gin.Context) (uuid.UUID, uuid.UUID, string) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+	workspaceID := c.MustGet("workspace_id").(uuid.UUID)
+	token, _ := middleware.GetJWTToken(c)
+	return userID, workspaceID, token
+}
+
+// GetWebhooks returns the webhooks of the workspace.
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	webhooks, err := h.service.ListWebhooks(c.Request.Context(), workspaceID, userID, token)
+	if err != nil {
+		log.Error("GetWebhooks failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, gin.H{"webhooks": webhooks})
+}
+
+// CreateWebhook registers a webhook endpoint. The signing secret is only returned here and on rotation.
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	log := h.log(c)
+	log.Debug("CreateWebhook started")
+
+	userID, workspaceID, token := h.caller(c)
+
+	var req domain.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("CreateWebhook validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	webhook, err := h.service.CreateWebhook(c.Request.Context(), workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("CreateWebhook failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, webhook)
+}
+
+// UpdateWebhook updates the name, URL, type filter or enabled state of a webhook.
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	var req domain.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateWebhook validation failed", zap.Error(err))
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhook(c.Request.Context(), id, workspaceID, userID, token, &req)
+	if err != nil {
+		log.Error("UpdateWebhook failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, webhook)
+}
+
+// RotateSecret issues a new signing secret for a webhook.
+func (h *WebhookHandler) RotateSecret(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	webhook, err := h.service.RotateSecret(c.Request.Context(), id, workspaceID, userID, token)
+	if err != nil {
+		log.Error("RotateSecret failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, webhook)
+}
+
+// DeleteWebhook removes a webhook and its delivery log.
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	if err := h.service.DeleteWebhook(c.Request.Context(), id, workspaceID, userID, token); err != nil {
+		log.Error("DeleteWebhook failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// TestWebhook posts a test event to a webhook and returns the delivery result.
+func (h *WebhookHandler) TestWebhook(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	delivery, err := h.service.TestWebhook(c.Request.Context(), id, workspaceID, userID, token)
+	if err != nil {
+		log.Error("TestWebhook failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, delivery)
+}
+
+// GetDeliveries returns the delivery log of a webhook.
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	log := h.log(c)
+	userID, workspaceID, token := h.caller(c)
+
+	id, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	var filter domain.WebhookDeliveryFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	deliveries, err := h.service.ListDeliveries(c.Request.Context(), id, workspaceID, userID, token, &filter)
+	if err != nil {
+		log.Error("GetDeliveries failed", zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, domain.WebhookDeliveryListResponse{Deliveries: deliveries})
+}
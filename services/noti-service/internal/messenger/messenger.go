@@ -0,0 +1,97 @@
+// Package messenger delivers notifications to team chat tools such as
+// Slack and Microsoft Teams.
+//
+// 워크스페이스 채널로 보내는 메시지이므로 개인 알림과 달리 수신자가 아닌 이벤트 중심으로 구성합니다.
+package messenger
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrRevoked is returned when the webhook or access token no longer accepts messages.
+// 웹훅 삭제, 앱 제거, 채널 보관 등으로 복구가 불가능하므로 호출자가 연동을 비활성화해야 합니다.
+var ErrRevoked = errors.New("integration is no longer authorized")
+
+// ErrInvalidWebhookURL is returned when a webhook URL does not point to the provider.
+var ErrInvalidWebhookURL = errors.New("invalid webhook url")
+
+// Message is a provider-independent chat message.
+type Message struct {
+	Title  string
+	Text   string
+	Link   string // Opens the resource in weAlist
+	Fields []Field
+	Footer string
+}
+
+// Field is a short label/value pair rendered below the message text.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// DeliveryError is a delivery failure reported by a chat provider.
+type DeliveryError struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("chat provider returned status %d: %s", e.StatusCode, e.Reason)
+}
+
+// teamsWebhookHostSuffixes lists hosts that serve Teams incoming webhooks and Workflows triggers.
+var teamsWebhookHostSuffixes = []string{
+	".webhook.office.com",
+	".logic.azure.com",
+	".environment.api.powerplatform.com",
+}
+
+// ValidateSlackWebhookURL checks that a URL is a Slack incoming webhook.
+// 서버가 임의 주소로 요청하지 않도록(SSRF) Slack 웹훅 호스트만 허용합니다.
+func ValidateSlackWebhookURL(raw string) error {
+	u, err := parseHTTPS(raw)
+	if err != nil {
+		return err
+	}
+	if u.Hostname() != "hooks.slack.com" || !strings.HasPrefix(u.Path, "/services/") {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// ValidateTeamsWebhookURL checks that a URL is a Teams incoming webhook or Workflows trigger.
+func ValidateTeamsWebhookURL(raw string) error {
+	u, err := parseHTTPS(raw)
+	if err != nil {
+		return err
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, suffix := range teamsWebhookHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookURL
+}
+
+// parseHTTPS parses an absolute https URL without credentials or a custom port.
+func parseHTTPS(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil || u.Port() != "" {
+		return nil, ErrInvalidWebhookURL
+	}
+	return u, nil
+}
+
+// truncate shortens text to at most max runes.
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,145 @@
+package messenger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *Message {
+	return &Message{
+		Title:  "보드 상태가 변경되었습니다",
+		Text:   "Release <checklist> & notes",
+		Link:   "https://wealist.co.kr/workspace/ws-1",
+		Fields: []Field{{Name: "상태", Value: "TODO → DONE"}},
+		Footer: "weAlist",
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	assert.NoError(t, ValidateSlackWebhookURL("https://hooks.slack.com/services/T000/B000/XXXX"))
+	assert.NoError(t, ValidateTeamsWebhookURL("https://contoso.webhook.office.com/webhookb2/abc"))
+	assert.NoError(t, ValidateTeamsWebhookURL("https://prod-01.westus.logic.azure.com/workflows/abc"))
+
+	// 다른 호스트, 평문 HTTP, 자격 증명/포트가 포함된 URL은 거부 (SSRF 방지)
+	assert.ErrorIs(t, ValidateSlackWebhookURL("http://hooks.slack.com/services/T000"), ErrInvalidWebhookURL)
+	assert.ErrorIs(t, ValidateSlackWebhookURL("https://hooks.slack.com.evil.com/services/T000"), ErrInvalidWebhookURL)
+	assert.ErrorIs(t, ValidateSlackWebhookURL("https://hooks.slack.com/api/other"), ErrInvalidWebhookURL)
+	assert.ErrorIs(t, ValidateSlackWebhookURL("https://user@hooks.slack.com/services/T000"), ErrInvalidWebhookURL)
+	assert.ErrorIs(t, ValidateTeamsWebhookURL("https://webhook.office.com.attacker.io/x"), ErrInvalidWebhookURL)
+	assert.ErrorIs(t, ValidateTeamsWebhookURL("https://localhost:8443/x"), ErrInvalidWebhookURL)
+}
+
+func TestBuildSlackPayload(t *testing.T) {
+	payload := BuildSlackPayload(testMessage())
+
+	require.Len(t, payload.Blocks, 3)
+	// mrkdwn 제어 문자는 이스케이프되고 제목은 링크로 렌더링
+	assert.Equal(t, "*<https://wealist.co.kr/workspace/ws-1|보드 상태가 변경되었습니다>*\nRelease &lt;checklist&gt; &amp; notes", payload.Blocks[0].Text.Text)
+	assert.Equal(t, "*상태*\nTODO → DONE", payload.Blocks[1].Fields[0].Text)
+	assert.Equal(t, "context", payload.Blocks[2].Type)
+	assert.Contains(t, payload.Text, "보드 상태가 변경되었습니다")
+}
+
+func TestBuildTeamsPayload(t *testing.T) {
+	payload := BuildTeamsPayload(testMessage())
+
+	require.Len(t, payload.Attachments, 1)
+	card := payload.Attachments[0].Content
+	assert.Equal(t, "application/vnd.microsoft.card.adaptive", payload.Attachments[0].ContentType)
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, "FactSet", card.Body[2]["type"])
+	require.Len(t, card.Actions, 1)
+	assert.Equal(t, "https://wealist.co.kr/workspace/ws-1", card.Actions[0]["url"])
+}
+
+func TestSlackClient_PostWebhook(t *testing.T) {
+	status := http.StatusOK
+	reason := "ok"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload SlackPayload
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.NotEmpty(t, payload.Blocks)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(reason))
+	}))
+	defer server.Close()
+
+	client := NewSlackClient("", "", 5*time.Second)
+	assert.NoError(t, client.PostWebhook(context.Background(), server.URL, testMessage()))
+
+	// 삭제된 웹훅은 복구 불가능한 오류로 분류
+	status, reason = http.StatusNotFound, "no_service"
+	assert.ErrorIs(t, client.PostWebhook(context.Background(), server.URL, testMessage()), ErrRevoked)
+
+	status, reason = http.StatusInternalServerError, "internal_error"
+	err := client.PostWebhook(context.Background(), server.URL, testMessage())
+	var deliveryErr *DeliveryError
+	assert.True(t, errors.As(err, &deliveryErr))
+	assert.False(t, errors.Is(err, ErrRevoked))
+}
+
+func TestSlackClient_PostMessage(t *testing.T) {
+	var result string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		var payload SlackPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "C0123456", payload.Channel)
+		_, _ = w.Write([]byte(result))
+	}))
+	defer server.Close()
+
+	client := NewSlackClient("id", "secret", 5*time.Second)
+	client.apiURL = server.URL
+
+	result = `{"ok":true,"ts":"1.2"}`
+	assert.NoError(t, client.PostMessage(context.Background(), "xoxb-token", "C0123456", testMessage()))
+
+	// Web API는 HTTP 200과 ok=false로 실패를 알림
+	result = `{"ok":false,"error":"token_revoked"}`
+	assert.ErrorIs(t, client.PostMessage(context.Background(), "xoxb-token", "C0123456", testMessage()), ErrRevoked)
+
+	result = `{"ok":false,"error":"ratelimited"}`
+	err := client.PostMessage(context.Background(), "xoxb-token", "C0123456", testMessage())
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrRevoked))
+}
+
+func TestSlackClient_ExchangeCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "code-1", r.PostForm.Get("code"))
+		assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		_, _ = w.Write([]byte(`{"ok":true,"access_token":"xoxb-1","team":{"id":"T1","name":"weAlist"},"incoming_webhook":{"channel":"#dev","channel_id":"C0123456"}}`))
+	}))
+	defer server.Close()
+
+	client := NewSlackClient("id", "secret", 5*time.Second)
+	client.apiURL = server.URL
+
+	result, err := client.ExchangeCode(context.Background(), "code-1", "https://wealist.co.kr/callback")
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-1", result.AccessToken)
+	assert.Equal(t, "T1", result.TeamID)
+	assert.Equal(t, "C0123456", result.ChannelID)
+	assert.Contains(t, client.AuthorizeURL("https://wealist.co.kr/callback", "state-1"), "state=state-1")
+}
+
+func TestTeamsError(t *testing.T) {
+	assert.ErrorIs(t, teamsError(http.StatusNotFound, ""), ErrRevoked)
+	assert.ErrorIs(t, teamsError(http.StatusGone, ""), ErrRevoked)
+
+	var deliveryErr *DeliveryError
+	assert.True(t, errors.As(teamsError(http.StatusTooManyRequests, "throttled"), &deliveryErr))
+}
@@ -0,0 +1,279 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSlackAPIURL       = "https://slack.com/api"
+	defaultSlackAuthorizeURL = "https://slack.com/oauth/v2/authorize"
+
+	// SlackOAuthScopes are the bot scopes requested when installing the app.
+	// incoming-webhook으로 설치 시 기본 채널을 고르고, chat:write로 타입별 채널에 게시합니다.
+	SlackOAuthScopes = "chat:write,incoming-webhook"
+)
+
+// slackRevokedErrors are Slack API errors that cannot be recovered by retrying.
+var slackRevokedErrors = map[string]bool{
+	"invalid_auth":      true,
+	"token_revoked":     true,
+	"account_inactive":  true,
+	"channel_not_found": true,
+	"not_in_channel":    true,
+	"is_archived":       true,
+	"no_service":        true,
+	"no_team":           true,
+	"team_disabled":     true,
+}
+
+// SlackClient posts messages to Slack through incoming webhooks or the Web API.
+type SlackClient struct {
+	clientID     string
+	clientSecret string
+	apiURL       string
+	authorizeURL string
+	httpClient   *http.Client
+}
+
+// NewSlackClient creates a SlackClient. clientID and clientSecret are only needed for OAuth installs.
+func NewSlackClient(clientID, clientSecret string, timeout time.Duration) *SlackClient {
+	return &SlackClient{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		apiURL:       defaultSlackAPIURL,
+		authorizeURL: defaultSlackAuthorizeURL,
+		httpClient:   &http.Client{Timeout: timeout},
+	}
+}
+
+// OAuthEnabled reports whether the app credentials for OAuth installs are configured.
+func (c *SlackClient) OAuthEnabled() bool {
+	return c.clientID != "" && c.clientSecret != ""
+}
+
+// SlackPayload is the body of a Slack message with Block Kit blocks.
+type SlackPayload struct {
+	Channel string       `json:"channel,omitempty"`
+	Text    string       `json:"text"` // Fallback for notifications and clients without blocks
+	Blocks  []SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a Block Kit layout block.
+type SlackBlock struct {
+	Type     string      `json:"type"`
+	Text     *SlackText  `json:"text,omitempty"`
+	Fields   []SlackText `json:"fields,omitempty"`
+	Elements []SlackText `json:"elements,omitempty"`
+}
+
+// SlackText is a Block Kit text object.
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackOAuthResult is the installation returned by oauth.v2.access.
+type SlackOAuthResult struct {
+	AccessToken string
+	BotUserID   string
+	TeamID      string
+	TeamName    string
+	ChannelID   string
+	ChannelName string
+}
+
+// BuildSlackPayload converts a message into Block Kit blocks.
+func BuildSlackPayload(msg *Message) SlackPayload {
+	title := "*" + slackEscape(msg.Title) + "*"
+	if msg.Link != "" {
+		title = fmt.Sprintf("*<%s|%s>*", msg.Link, slackEscape(msg.Title))
+	}
+	body := title
+	if msg.Text != "" {
+		body += "\n" + slackEscape(truncate(msg.Text, 2500))
+	}
+
+	blocks := []SlackBlock{{
+		Type: "section",
+		Text: &SlackText{Type: "mrkdwn", Text: body},
+	}}
+	if len(msg.Fields) > 0 {
+		fields := make([]SlackText, 0, len(msg.Fields))
+		for _, field := range msg.Fields {
+			// Slack은 section 당 최대 10개의 필드만 허용
+			if len(fields) == 10 {
+				break
+			}
+			fields = append(fields, SlackText{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*%s*\n%s", slackEscape(field.Name), slackEscape(truncate(field.Value, 1000))),
+			})
+		}
+		blocks = append(blocks, SlackBlock{Type: "section", Fields: fields})
+	}
+	if msg.Footer != "" {
+		blocks = append(blocks, SlackBlock{
+			Type:     "context",
+			Elements: []SlackText{{Type: "mrkdwn", Text: slackEscape(msg.Footer)}},
+		})
+	}
+
+	text := msg.Title
+	if msg.Text != "" {
+		text += ": " + msg.Text
+	}
+	return SlackPayload{Text: truncate(text, 3000), Blocks: blocks}
+}
+
+// PostWebhook sends a message to a Slack incoming webhook.
+func (c *SlackClient) PostWebhook(ctx context.Context, webhookURL string, msg *Message) error {
+	status, body, err := c.post(ctx, webhookURL, "", BuildSlackPayload(msg))
+	if err != nil {
+		return err
+	}
+	if status >= 200 && status < 300 {
+		return nil
+	}
+	return slackWebhookError(status, strings.TrimSpace(string(body)))
+}
+
+// PostMessage sends a message to a channel with a bot token (chat.postMessage).
+func (c *SlackClient) PostMessage(ctx context.Context, token, channel string, msg *Message) error {
+	payload := BuildSlackPayload(msg)
+	payload.Channel = channel
+
+	status, body, err := c.post(ctx, c.apiURL+"/chat.postMessage", token, payload)
+	if err != nil {
+		return err
+	}
+	return slackAPIError(status, body)
+}
+
+// AuthorizeURL returns the Slack consent page URL for installing the app.
+func (c *SlackClient) AuthorizeURL(redirectURI, state string) string {
+	params := url.Values{}
+	params.Set("client_id", c.clientID)
+	params.Set("scope", SlackOAuthScopes)
+	params.Set("redirect_uri", redirectURI)
+	params.Set("state", state)
+	return c.authorizeURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an OAuth authorization code for a bot token (oauth.v2.access).
+func (c *SlackClient) ExchangeCode(ctx context.Context, code, redirectURI string) (*SlackOAuthResult, error) {
+	form := url.Values{}
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+"/oauth.v2.access", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Error       string `json:"error"`
+		AccessToken string `json:"access_token"`
+		BotUserID   string `json:"bot_user_id"`
+		Team        struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"team"`
+		IncomingWebhook struct {
+			Channel   string `json:"channel"`
+			ChannelID string `json:"channel_id"`
+		} `json:"incoming_webhook"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid Slack OAuth response: %w", err)
+	}
+	if !result.OK {
+		return nil, &DeliveryError{StatusCode: resp.StatusCode, Reason: result.Error}
+	}
+
+	return &SlackOAuthResult{
+		AccessToken: result.AccessToken,
+		BotUserID:   result.BotUserID,
+		TeamID:      result.Team.ID,
+		TeamName:    result.Team.Name,
+		ChannelID:   result.IncomingWebhook.ChannelID,
+		ChannelName: result.IncomingWebhook.Channel,
+	}, nil
+}
+
+// post sends a JSON request and returns the status and a bounded response body.
+func (c *SlackClient) post(ctx context.Context, endpoint, token string, payload any) (int, []byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	return resp.StatusCode, respBody, nil
+}
+
+// slackWebhookError maps an incoming webhook failure to an error.
+// 웹훅은 실패 사유를 평문으로 응답합니다 (예: 404 no_service, 410 channel_is_archived).
+func slackWebhookError(status int, reason string) error {
+	if status == http.StatusNotFound || status == http.StatusGone || slackRevokedErrors[reason] ||
+		reason == "channel_is_archived" || reason == "invalid_token" {
+		return fmt.Errorf("%w: %s", ErrRevoked, reason)
+	}
+	return &DeliveryError{StatusCode: status, Reason: reason}
+}
+
+// slackAPIError maps a Web API response to an error.
+// Web API는 HTTP 200과 함께 {"ok": false, "error": "..."}로 실패를 알립니다.
+func slackAPIError(status int, body []byte) error {
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return &DeliveryError{StatusCode: status, Reason: "invalid response"}
+	}
+	if result.OK {
+		return nil
+	}
+	if slackRevokedErrors[result.Error] {
+		return fmt.Errorf("%w: %s", ErrRevoked, result.Error)
+	}
+	return &DeliveryError{StatusCode: status, Reason: result.Error}
+}
+
+// slackEscape escapes the control characters of Slack mrkdwn.
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
@@ -0,0 +1,134 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TeamsClient posts Adaptive Card messages to Microsoft Teams webhooks.
+// 기존 Office 365 커넥터 웹훅과 Workflows(Power Automate) 트리거 URL 모두 같은 형식을 받습니다.
+type TeamsClient struct {
+	httpClient *http.Client
+}
+
+// NewTeamsClient creates a TeamsClient.
+func NewTeamsClient(timeout time.Duration) *TeamsClient {
+	return &TeamsClient{httpClient: &http.Client{Timeout: timeout}}
+}
+
+// TeamsPayload is a Teams message carrying a single Adaptive Card attachment.
+type TeamsPayload struct {
+	Type        string            `json:"type"`
+	Attachments []TeamsAttachment `json:"attachments"`
+}
+
+// TeamsAttachment wraps an Adaptive Card.
+type TeamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is the subset of the Adaptive Card schema used for notifications.
+type AdaptiveCard struct {
+	Schema  string           `json:"$schema"`
+	Type    string           `json:"type"`
+	Version string           `json:"version"`
+	Body    []map[string]any `json:"body"`
+	Actions []map[string]any `json:"actions,omitempty"`
+}
+
+// BuildTeamsPayload converts a message into an Adaptive Card.
+func BuildTeamsPayload(msg *Message) TeamsPayload {
+	body := []map[string]any{{
+		"type":   "TextBlock",
+		"text":   msg.Title,
+		"weight": "Bolder",
+		"size":   "Medium",
+		"wrap":   true,
+	}}
+	if msg.Text != "" {
+		body = append(body, map[string]any{
+			"type": "TextBlock",
+			"text": truncate(msg.Text, 2500),
+			"wrap": true,
+		})
+	}
+	if len(msg.Fields) > 0 {
+		facts := make([]map[string]string, 0, len(msg.Fields))
+		for _, field := range msg.Fields {
+			facts = append(facts, map[string]string{"title": field.Name, "value": truncate(field.Value, 1000)})
+		}
+		body = append(body, map[string]any{"type": "FactSet", "facts": facts})
+	}
+	if msg.Footer != "" {
+		body = append(body, map[string]any{
+			"type":     "TextBlock",
+			"text":     msg.Footer,
+			"size":     "Small",
+			"isSubtle": true,
+			"wrap":     true,
+		})
+	}
+
+	card := AdaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		Body:    body,
+	}
+	if msg.Link != "" {
+		card.Actions = []map[string]any{{
+			"type":  "Action.OpenUrl",
+			"title": "weAlist에서 보기",
+			"url":   msg.Link,
+		}}
+	}
+
+	return TeamsPayload{
+		Type: "message",
+		Attachments: []TeamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     card,
+		}},
+	}
+}
+
+// PostWebhook sends a message to a Teams incoming webhook or Workflows trigger.
+func (c *TeamsClient) PostWebhook(ctx context.Context, webhookURL string, msg *Message) error {
+	body, err := json.Marshal(BuildTeamsPayload(msg))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return teamsError(resp.StatusCode, strings.TrimSpace(string(respBody)))
+}
+
+// teamsError maps a Teams webhook failure to an error.
+// 삭제된 웹훅/워크플로는 404 또는 410을 응답합니다.
+func teamsError(status int, reason string) error {
+	if status == http.StatusNotFound || status == http.StatusGone {
+		return ErrRevoked
+	}
+	return &DeliveryError{StatusCode: status, Reason: truncate(reason, 200)}
+}
@@ -28,6 +28,28 @@ type Metrics struct {
 	NotificationsReadTotal prometheus.Counter
 	// NotificationsDeletedTotal counts deleted notifications.
 	NotificationsDeletedTotal prometheus.Counter
+	// NotificationsSuppressedTotal counts deliveries skipped by user preferences, by channel.
+	NotificationsSuppressedTotal *prometheus.CounterVec
+	// NotificationsGroupedTotal counts events collapsed into an existing notification.
+	NotificationsGroupedTotal prometheus.Counter
+	// PushDeliveriesTotal counts web push delivery attempts, by result.
+	PushDeliveriesTotal *prometheus.CounterVec
+	// MobilePushDeliveriesTotal counts mobile push delivery attempts, by platform and status.
+	MobilePushDeliveriesTotal *prometheus.CounterVec
+	// IntegrationDeliveriesTotal counts Slack/Teams delivery attempts, by provider and result.
+	IntegrationDeliveriesTotal *prometheus.CounterVec
+	// DeliveryRetriesTotal counts outbound deliveries scheduled for another attempt, by channel.
+	DeliveryRetriesTotal *prometheus.CounterVec
+	// DeadLettersTotal counts outbound deliveries that failed permanently, by channel.
+	DeadLettersTotal *prometheus.CounterVec
+	// EventsIngestedTotal counts notification events consumed from the event stream, by result.
+	EventsIngestedTotal *prometheus.CounterVec
+	// QuietHoursTotal counts push/email deliveries held or dropped during quiet hours, by action.
+	QuietHoursTotal *prometheus.CounterVec
+	// SMSMessagesTotal counts SMS sends and guardrail rejections, by provider and result.
+	SMSMessagesTotal *prometheus.CounterVec
+	// WebhookDeliveriesTotal counts workspace webhook delivery attempts by result.
+	WebhookDeliveriesTotal *prometheus.CounterVec
 
 	// SSEConnectionsTotal tracks the current number of active SSE connections.
 	SSEConnectionsTotal prometheus.Gauge
@@ -85,6 +107,93 @@ func NewWithRegistry(registerer prometheus.Registerer) *Metrics {
 				Help:      "Total number of notifications deleted",
 			},
 		),
+		NotificationsSuppressedTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "notifications_suppressed_total",
+				Help:      "Total number of notification deliveries skipped by user preferences",
+			},
+			[]string{"channel"},
+		),
+		NotificationsGroupedTotal: factory.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "notifications_grouped_total",
+				Help:      "Total number of events collapsed into an existing notification",
+			},
+		),
+		PushDeliveriesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "push_deliveries_total",
+				Help:      "Total number of web push delivery attempts",
+			},
+			[]string{"result"},
+		),
+		MobilePushDeliveriesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "mobile_push_deliveries_total",
+				Help:      "Total number of mobile push delivery attempts",
+			},
+			[]string{"platform", "status"},
+		),
+		IntegrationDeliveriesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "integration_deliveries_total",
+				Help:      "Total number of Slack/Teams integration delivery attempts",
+			},
+			[]string{"provider", "result"},
+		),
+		DeliveryRetriesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "delivery_retries_total",
+				Help:      "Total number of outbound deliveries scheduled for retry",
+			},
+			[]string{"channel"},
+		),
+		DeadLettersTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "delivery_dead_letters_total",
+				Help:      "Total number of outbound deliveries moved to the dead-letter table",
+			},
+			[]string{"channel"},
+		),
+		EventsIngestedTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "events_ingested_total",
+				Help:      "Total number of notification events consumed from the event stream",
+			},
+			[]string{"result"},
+		),
+		QuietHoursTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "quiet_hours_deliveries_total",
+				Help:      "Total number of push/email deliveries held or dropped during quiet hours",
+			},
+			[]string{"action"},
+		),
+		SMSMessagesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "sms_messages_total",
+				Help:      "Total number of SMS messages sent or rejected by cost guardrails",
+			},
+			[]string{"provider", "result"},
+		),
+		WebhookDeliveriesTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "webhook_deliveries_total",
+				Help:      "Total number of workspace webhook delivery attempts",
+			},
+			[]string{"result"},
+		),
 		SSEConnectionsTotal: factory.NewGauge(
 			prometheus.GaugeOpts{
 				Namespace: namespace,
@@ -142,6 +251,61 @@ func (m *Metrics) RecordNotificationDeleted() {
 	m.NotificationsDeletedTotal.Inc()
 }
 
+// RecordNotificationSuppressed increments the suppressed delivery counter for a channel.
+func (m *Metrics) RecordNotificationSuppressed(channel string) {
+	m.NotificationsSuppressedTotal.WithLabelValues(channel).Inc()
+}
+
+// RecordNotificationGrouped increments the grouped notification counter.
+func (m *Metrics) RecordNotificationGrouped() {
+	m.NotificationsGroupedTotal.Inc()
+}
+
+// RecordPushDelivery increments the web push delivery counter for a result (sent, expired, failed).
+func (m *Metrics) RecordPushDelivery(result string) {
+	m.PushDeliveriesTotal.WithLabelValues(result).Inc()
+}
+
+// RecordMobilePushDelivery increments the mobile push delivery counter for a platform and status.
+func (m *Metrics) RecordMobilePushDelivery(platform, status string) {
+	m.MobilePushDeliveriesTotal.WithLabelValues(platform, status).Inc()
+}
+
+// RecordIntegrationDelivery increments the integration delivery counter for a provider and result (sent, revoked, failed).
+func (m *Metrics) RecordIntegrationDelivery(provider, result string) {
+	m.IntegrationDeliveriesTotal.WithLabelValues(provider, result).Inc()
+}
+
+// RecordDeliveryRetry increments the retry counter for a delivery channel.
+func (m *Metrics) RecordDeliveryRetry(channel string) {
+	m.DeliveryRetriesTotal.WithLabelValues(channel).Inc()
+}
+
+// RecordDeadLetter increments the dead-letter counter for a delivery channel.
+func (m *Metrics) RecordDeadLetter(channel string) {
+	m.DeadLettersTotal.WithLabelValues(channel).Inc()
+}
+
+// RecordEventIngested increments the stream ingestion counter for a result (created, duplicate, invalid, failed).
+func (m *Metrics) RecordEventIngested(result string) {
+	m.EventsIngestedTotal.WithLabelValues(result).Inc()
+}
+
+// RecordQuietHours increments the quiet hours counter for an action (held, dropped).
+func (m *Metrics) RecordQuietHours(action string) {
+	m.QuietHoursTotal.WithLabelValues(action).Inc()
+}
+
+// RecordSMS increments the SMS counter for a provider and result (sent, failed, invalid, verification, rate_limited).
+func (m *Metrics) RecordSMS(provider, result string) {
+	m.SMSMessagesTotal.WithLabelValues(provider, result).Inc()
+}
+
+// RecordWebhookDelivery increments the webhook delivery counter for a result (sent, failed, disabled).
+func (m *Metrics) RecordWebhookDelivery(result string) {
+	m.WebhookDeliveriesTotal.WithLabelValues(result).Inc()
+}
+
 // RecordSSEConnectionOpened increments SSE connection counters.
 func (m *Metrics) RecordSSEConnectionOpened() {
 	m.SSEConnectionsCreatedTotal.Inc()
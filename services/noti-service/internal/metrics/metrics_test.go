@@ -55,6 +55,66 @@ func TestMetrics_RecordNotificationDeleted(t *testing.T) {
 	// Should not panic
 }
 
+func TestMetrics_RecordNotificationSuppressed(t *testing.T) {
+	m := NewForTest()
+	m.RecordNotificationSuppressed("EMAIL")
+	// Should not panic
+}
+
+func TestMetrics_RecordNotificationGrouped(t *testing.T) {
+	m := NewForTest()
+	m.RecordNotificationGrouped()
+	// Should not panic
+}
+
+func TestMetrics_RecordPushDelivery(t *testing.T) {
+	m := NewForTest()
+	m.RecordPushDelivery("sent")
+	// Should not panic
+}
+
+func TestMetrics_RecordMobilePushDelivery(t *testing.T) {
+	m := NewForTest()
+	m.RecordMobilePushDelivery("IOS", "SENT")
+	// Should not panic
+}
+
+func TestMetrics_RecordIntegrationDelivery(t *testing.T) {
+	m := NewForTest()
+	m.RecordIntegrationDelivery("SLACK", "sent")
+	// Should not panic
+}
+
+func TestMetrics_RecordDeliveryRetry(t *testing.T) {
+	m := NewForTest()
+	m.RecordDeliveryRetry("WEB_PUSH")
+	// Should not panic
+}
+
+func TestMetrics_RecordDeadLetter(t *testing.T) {
+	m := NewForTest()
+	m.RecordDeadLetter("INTEGRATION")
+	// Should not panic
+}
+
+func TestMetrics_RecordEventIngested(t *testing.T) {
+	m := NewForTest()
+	m.RecordEventIngested("created")
+	// Should not panic
+}
+
+func TestMetrics_RecordQuietHours(t *testing.T) {
+	m := NewForTest()
+	m.RecordQuietHours("held")
+	// Should not panic
+}
+
+func TestMetrics_RecordSMS(t *testing.T) {
+	m := NewForTest()
+	m.RecordSMS("twilio", "sent")
+	// Should not panic
+}
+
 func TestMetrics_RecordSSEConnectionOpened(t *testing.T) {
 	m := NewForTest()
 	m.RecordSSEConnectionOpened()
@@ -154,3 +214,9 @@ func TestMetrics_RecordHTTPRequest(t *testing.T) {
 	m.RecordHTTPRequest("GET", "/api/notifications", 200, 100*time.Millisecond)
 	// Should not panic
 }
+
+func TestMetrics_RecordWebhookDelivery(t *testing.T) {
+	m := NewForTest()
+	m.RecordWebhookDelivery("sent")
+	// Should not panic
+}
@@ -0,0 +1,190 @@
+package mobilepush
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	apnsProductionURL = "https://api.push.apple.com"
+	apnsSandboxURL    = "https://api.sandbox.push.apple.com"
+
+	// apnsTokenRefresh is how often the provider token is regenerated.
+	// APNs는 20분~60분 사이 주기의 토큰 갱신을 요구합니다.
+	apnsTokenRefresh = 50 * time.Minute
+)
+
+// APNsConfig holds token-based APNs authentication settings.
+type APNsConfig struct {
+	KeyFile    string // .p8 signing key downloaded from the Apple developer portal
+	KeyID      string
+	TeamID     string
+	BundleID   string // apns-topic
+	Production bool
+}
+
+// APNsClient sends notifications with the APNs HTTP/2 provider API.
+type APNsClient struct {
+	keyID      string
+	teamID     string
+	topic      string
+	baseURL    string
+	key        crypto.Signer
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewAPNsClient creates an APNsClient from a .p8 key file.
+func NewAPNsClient(cfg APNsConfig, timeout time.Duration) (*APNsClient, error) {
+	if cfg.KeyID == "" || cfg.TeamID == "" || cfg.BundleID == "" {
+		return nil, errors.New("APNs key ID, team ID and bundle ID are required")
+	}
+	data, err := os.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read APNs key: %w", err)
+	}
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := key.(*ecdsa.PrivateKey); !ok {
+		return nil, errors.New("APNs key must be an EC (P-256) key")
+	}
+
+	baseURL := apnsSandboxURL
+	if cfg.Production {
+		baseURL = apnsProductionURL
+	}
+
+	// 기본 Transport는 TLS 연결에서 HTTP/2를 사용
+	return &APNsClient{
+		keyID:      cfg.KeyID,
+		teamID:     cfg.TeamID,
+		topic:      cfg.BundleID,
+		baseURL:    baseURL,
+		key:        key,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// APNsPayload is the JSON body of an APNs notification.
+// 사용자 정의 데이터는 aps 딕셔너리와 같은 레벨에 추가됩니다.
+type APNsPayload map[string]interface{}
+
+// BuildAPNsPayload builds the APNs JSON payload for a message.
+func BuildAPNsPayload(msg *Message) APNsPayload {
+	aps := map[string]interface{}{
+		"alert": map[string]string{
+			"title": msg.Title,
+			"body":  msg.Body,
+		},
+		"sound": "default",
+	}
+	if msg.Badge != nil {
+		aps["badge"] = *msg.Badge
+	}
+	if msg.ThreadID != "" {
+		aps["thread-id"] = msg.ThreadID
+	}
+
+	payload := APNsPayload{"aps": aps}
+	for k, v := range msg.Data {
+		if k != "aps" {
+			payload[k] = v
+		}
+	}
+	return payload
+}
+
+// Send implements Provider.
+func (c *APNsClient) Send(ctx context.Context, token string, msg *Message) (string, error) {
+	providerToken, err := c.providerToken()
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(BuildAPNsPayload(msg))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create APNs request: %w", err)
+	}
+	req.Header.Set("authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", c.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	req.Header.Set("Content-Type", "application/json")
+	if msg.CollapseKey != "" {
+		req.Header.Set("apns-collapse-id", msg.CollapseKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send APNs notification: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode == http.StatusOK {
+		return resp.Header.Get("apns-id"), nil
+	}
+
+	var result struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.Unmarshal(respBody, &result)
+	return "", apnsError(resp.StatusCode, result.Reason)
+}
+
+// apnsError maps an APNs error response to ErrTokenInvalid or a ProviderError.
+func apnsError(status int, reason string) error {
+	switch reason {
+	case "BadDeviceToken", "Unregistered", "DeviceTokenNotForTopic":
+		return fmt.Errorf("%w: %s", ErrTokenInvalid, reason)
+	}
+	if status == http.StatusGone {
+		return fmt.Errorf("%w: %s", ErrTokenInvalid, reason)
+	}
+	if reason == "" {
+		reason = http.StatusText(status)
+	}
+	return &ProviderError{StatusCode: status, Reason: reason}
+}
+
+// providerToken returns the cached ES256 provider token, regenerating it periodically.
+func (c *APNsClient) providerToken() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Since(c.issuedAt) < apnsTokenRefresh {
+		return c.token, nil
+	}
+
+	now := time.Now()
+	token, err := signJWT(c.key, map[string]interface{}{"kid": c.keyID}, map[string]interface{}{
+		"iss": c.teamID,
+		"iat": now.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs token: %w", err)
+	}
+	c.token = token
+	c.issuedAt = now
+	return token, nil
+}
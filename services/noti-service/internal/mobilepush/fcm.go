@@ -0,0 +1,271 @@
+package mobilepush
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fcmScope         = "https://www.googleapis.com/auth/firebase.messaging"
+	defaultTokenURI  = "https://oauth2.googleapis.com/token"
+	defaultFCMAPIURL = "https://fcm.googleapis.com"
+)
+
+// serviceAccount is the subset of a Google service account JSON key used for FCM.
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMClient sends messages with the FCM HTTP v1 API.
+type FCMClient struct {
+	projectID   string
+	clientEmail string
+	tokenURI    string
+	apiURL      string
+	key         crypto.Signer
+	httpClient  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMClientFromFile creates an FCMClient from a service account JSON key file.
+func NewFCMClientFromFile(path string, timeout time.Duration) (*FCMClient, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read FCM credentials: %w", err)
+	}
+	return NewFCMClient(data, timeout)
+}
+
+// NewFCMClient creates an FCMClient from service account JSON key contents.
+func NewFCMClient(credentials []byte, timeout time.Duration) (*FCMClient, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(credentials, &account); err != nil {
+		return nil, fmt.Errorf("invalid FCM credentials: %w", err)
+	}
+	if account.ProjectID == "" || account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.New("invalid FCM credentials: project_id, client_email and private_key are required")
+	}
+	key, err := parsePrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = defaultTokenURI
+	}
+
+	return &FCMClient{
+		projectID:   account.ProjectID,
+		clientEmail: account.ClientEmail,
+		tokenURI:    account.TokenURI,
+		apiURL:      defaultFCMAPIURL,
+		key:         key,
+		httpClient:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// fcmMessage is the body of a messages:send request.
+type fcmMessage struct {
+	Message FCMPayload `json:"message"`
+}
+
+// FCMPayload is an FCM v1 message for an Android device.
+type FCMPayload struct {
+	Token        string            `json:"token"`
+	Notification *FCMNotification  `json:"notification,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      *FCMAndroidConfig `json:"android,omitempty"`
+}
+
+// FCMNotification is the user-visible part of an FCM message.
+type FCMNotification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+// FCMAndroidConfig holds Android specific delivery options.
+type FCMAndroidConfig struct {
+	Priority     string                  `json:"priority,omitempty"`
+	CollapseKey  string                  `json:"collapse_key,omitempty"`
+	Notification *FCMAndroidNotification `json:"notification,omitempty"`
+}
+
+// FCMAndroidNotification holds Android specific notification options.
+type FCMAndroidNotification struct {
+	Tag               string `json:"tag,omitempty"`
+	NotificationCount *int   `json:"notification_count,omitempty"`
+}
+
+// BuildFCMPayload builds an FCM v1 message for a device token.
+// 데이터 메시지 값은 모두 문자열이어야 합니다.
+func BuildFCMPayload(token string, msg *Message) FCMPayload {
+	payload := FCMPayload{
+		Token: token,
+		Notification: &FCMNotification{
+			Title: msg.Title,
+			Body:  msg.Body,
+		},
+		Data: msg.Data,
+		Android: &FCMAndroidConfig{
+			Priority:    "HIGH",
+			CollapseKey: msg.CollapseKey,
+		},
+	}
+	if msg.ThreadID != "" || msg.Badge != nil {
+		payload.Android.Notification = &FCMAndroidNotification{
+			Tag:               msg.ThreadID,
+			NotificationCount: msg.Badge,
+		}
+	}
+	return payload
+}
+
+// Send implements Provider.
+func (c *FCMClient) Send(ctx context.Context, token string, msg *Message) (string, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(fcmMessage{Message: BuildFCMPayload(token, msg)})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", c.apiURL, url.PathEscape(c.projectID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create FCM request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send FCM message: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode == http.StatusOK {
+		var result struct {
+			Name string `json:"name"`
+		}
+		_ = json.Unmarshal(respBody, &result)
+		return result.Name, nil
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// 토큰이 폐기된 경우 다음 요청에서 새로 발급
+		c.mu.Lock()
+		c.accessToken = ""
+		c.mu.Unlock()
+	}
+
+	return "", fcmError(resp.StatusCode, respBody)
+}
+
+// fcmError maps an FCM error response to ErrTokenInvalid or a ProviderError.
+// UNREGISTERED(404) 또는 토큰 형식 오류(400 INVALID_ARGUMENT)는 토큰 무효로 처리합니다.
+func fcmError(status int, body []byte) error {
+	var result struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	_ = json.Unmarshal(body, &result)
+
+	reason := result.Error.Status
+	for _, detail := range result.Error.Details {
+		if detail.ErrorCode != "" {
+			reason = detail.ErrorCode
+		}
+	}
+
+	switch {
+	case reason == "UNREGISTERED" || status == http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrTokenInvalid, reason)
+	case reason == "INVALID_ARGUMENT" && strings.Contains(strings.ToLower(result.Error.Message), "registration token"):
+		return fmt.Errorf("%w: %s", ErrTokenInvalid, result.Error.Message)
+	}
+	if reason == "" {
+		reason = http.StatusText(status)
+	}
+	return &ProviderError{StatusCode: status, Reason: reason}
+}
+
+// getAccessToken returns a cached OAuth2 access token, exchanging a signed JWT when it expires.
+func (c *FCMClient) getAccessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && time.Now().Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := signJWT(c.key, map[string]interface{}{}, map[string]interface{}{
+		"iss":   c.clientEmail,
+		"scope": fcmScope,
+		"aud":   c.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign FCM assertion: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get FCM access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("failed to get FCM access token: status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil || token.AccessToken == "" {
+		return "", errors.New("failed to get FCM access token: invalid response")
+	}
+
+	// 만료 1분 전에 갱신
+	c.accessToken = token.AccessToken
+	c.expiresAt = now.Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+	return c.accessToken, nil
+}
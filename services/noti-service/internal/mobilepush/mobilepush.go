@@ -0,0 +1,41 @@
+// Package mobilepush delivers notifications to mobile devices through
+// Firebase Cloud Messaging (Android) and Apple Push Notification service (iOS).
+//
+// 외부 SDK 없이 표준 라이브러리로 FCM HTTP v1 API와 APNs 토큰 기반 인증을 구현합니다.
+package mobilepush
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrTokenInvalid is returned when the provider reports that a device token is no longer valid.
+// 앱 삭제, 토큰 갱신 등으로 더 이상 전송할 수 없는 토큰이므로 호출자가 비활성화해야 합니다.
+var ErrTokenInvalid = errors.New("device token is no longer valid")
+
+// Message is a platform-independent push message.
+type Message struct {
+	Title       string
+	Body        string
+	Data        map[string]string // Custom key/value data delivered to the app
+	CollapseKey string            // Replaces an undelivered message with the same key
+	ThreadID    string            // Groups notifications on the device
+	Badge       *int
+}
+
+// Provider sends push messages to one mobile platform.
+type Provider interface {
+	// Send delivers a message to a device token and returns the provider message ID.
+	Send(ctx context.Context, token string, msg *Message) (string, error)
+}
+
+// ProviderError is a delivery failure reported by a push provider.
+type ProviderError struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("push provider returned status %d: %s", e.StatusCode, e.Reason)
+}
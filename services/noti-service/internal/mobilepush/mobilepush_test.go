@@ -0,0 +1,124 @@
+package mobilepush
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *Message {
+	badge := 3
+	return &Message{
+		Title:       "Board assigned",
+		Body:        "Release checklist",
+		Data:        map[string]string{"type": "BOARD_ASSIGNED", "aps": "ignored"},
+		CollapseKey: "board-1",
+		ThreadID:    "workspace-1",
+		Badge:       &badge,
+	}
+}
+
+func TestBuildFCMPayload(t *testing.T) {
+	payload := BuildFCMPayload("token-1", testMessage())
+
+	assert.Equal(t, "token-1", payload.Token)
+	assert.Equal(t, "Board assigned", payload.Notification.Title)
+	assert.Equal(t, "HIGH", payload.Android.Priority)
+	assert.Equal(t, "board-1", payload.Android.CollapseKey)
+	assert.Equal(t, "workspace-1", payload.Android.Notification.Tag)
+	assert.Equal(t, "BOARD_ASSIGNED", payload.Data["type"])
+}
+
+func TestBuildAPNsPayload(t *testing.T) {
+	payload := BuildAPNsPayload(testMessage())
+
+	aps := payload["aps"].(map[string]interface{})
+	assert.Equal(t, map[string]string{"title": "Board assigned", "body": "Release checklist"}, aps["alert"])
+	assert.Equal(t, 3, aps["badge"])
+	assert.Equal(t, "workspace-1", aps["thread-id"])
+	// 사용자 데이터는 최상위에 추가되지만 aps를 덮어쓸 수 없음
+	assert.Equal(t, "BOARD_ASSIGNED", payload["type"])
+	_, isMap := payload["aps"].(map[string]interface{})
+	assert.True(t, isMap)
+}
+
+func TestAPNsError(t *testing.T) {
+	assert.ErrorIs(t, apnsError(http.StatusGone, "Unregistered"), ErrTokenInvalid)
+	assert.ErrorIs(t, apnsError(http.StatusBadRequest, "BadDeviceToken"), ErrTokenInvalid)
+
+	err := apnsError(http.StatusTooManyRequests, "TooManyRequests")
+	assert.False(t, errors.Is(err, ErrTokenInvalid))
+	var providerErr *ProviderError
+	assert.True(t, errors.As(err, &providerErr))
+}
+
+func TestFCMError(t *testing.T) {
+	unregistered := `{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`
+	assert.ErrorIs(t, fcmError(http.StatusNotFound, []byte(unregistered)), ErrTokenInvalid)
+
+	invalid := `{"error":{"status":"INVALID_ARGUMENT","message":"The registration token is not a valid FCM registration token"}}`
+	assert.ErrorIs(t, fcmError(http.StatusBadRequest, []byte(invalid)), ErrTokenInvalid)
+
+	unavailable := `{"error":{"status":"UNAVAILABLE"}}`
+	assert.False(t, errors.Is(fcmError(http.StatusServiceUnavailable, []byte(unavailable)), ErrTokenInvalid))
+}
+
+func TestFCMClient_Send(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			tokenRequests++
+			require.NoError(t, r.ParseForm())
+			assert.Len(t, strings.Split(r.PostForm.Get("assertion"), "."), 3)
+			_, _ = w.Write([]byte(`{"access_token":"access-1","expires_in":3600}`))
+		case r.URL.Path == "/v1/projects/wealist/messages:send":
+			assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+			body, _ := io.ReadAll(r.Body)
+			var msg fcmMessage
+			require.NoError(t, json.Unmarshal(body, &msg))
+			assert.Equal(t, "device-token", msg.Message.Token)
+			_, _ = w.Write([]byte(`{"name":"projects/wealist/messages/1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	credentials, _ := json.Marshal(map[string]string{
+		"project_id":   "wealist",
+		"client_email": "fcm@wealist.iam.gserviceaccount.com",
+		"private_key":  string(keyPEM),
+		"token_uri":    server.URL + "/token",
+	})
+	client, err := NewFCMClient(credentials, time.Second)
+	require.NoError(t, err)
+	client.apiURL = server.URL
+
+	for i := 0; i < 2; i++ {
+		id, err := client.Send(context.Background(), "device-token", testMessage())
+		require.NoError(t, err)
+		assert.Equal(t, "projects/wealist/messages/1", id)
+	}
+	// 액세스 토큰은 캐시되어 재사용
+	assert.Equal(t, 1, tokenRequests)
+}
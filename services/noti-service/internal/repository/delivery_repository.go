@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"encoding/json"
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DeliveryRepository handles outbound delivery state and the dead-letter table.
+type DeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewDeliveryRepository creates a new DeliveryRepository with the given GORM database.
+func NewDeliveryRepository(db *gorm.DB) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+// UpdateChannelStatus merges the state of one channel into a notification's delivery status.
+// 다른 대상으로 이미 전송된 채널(SENT)은 덮어쓰지 않습니다. 인앱 저장이 꺼진 알림은 갱신 대상이 없습니다.
+func (r *DeliveryRepository) UpdateChannelStatus(notificationID uuid.UUID, channel domain.DeliveryChannel, delivery domain.ChannelDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Model(&domain.Notification{}).
+		Where("id = ?", notificationID).
+		Where("COALESCE(deliveries -> ? ->> 'status', '') <> ?", string(channel), string(domain.DeliveryStateSent)).
+		Update("deliveries", gorm.Expr("COALESCE(deliveries, '{}'::jsonb) || jsonb_build_object(?::text, ?::jsonb)", string(channel), string(data))).
+		Error
+}
+
+// CreateDeadLetter stores a permanently failed delivery.
+func (r *DeliveryRepository) CreateDeadLetter(deadLetter *domain.DeadLetter) error {
+	return r.db.Create(deadLetter).Error
+}
+
+// GetDeadLetter returns a dead letter by ID.
+func (r *DeliveryRepository) GetDeadLetter(id uuid.UUID) (*domain.DeadLetter, error) {
+	var deadLetter domain.DeadLetter
+	if err := r.db.First(&deadLetter, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &deadLetter, nil
+}
+
+// ListDeadLetters returns the newest dead letters matching the filter.
+func (r *DeliveryRepository) ListDeadLetters(filter *domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	query := r.db.Model(&domain.DeadLetter{})
+	if filter.Channel != "" {
+		query = query.Where("channel = ?", filter.Channel)
+	}
+	if !filter.IncludeRedriven {
+		query = query.Where("redriven_at IS NULL")
+	}
+
+	var deadLetters []domain.DeadLetter
+	err := query.Order("created_at DESC").Limit(filter.Limit).Find(&deadLetters).Error
+	return deadLetters, err
+}
+
+// MarkRedriven marks a dead letter as redriven. Returns false if it was already redriven.
+// 조건부 갱신으로 동시 요청이 같은 항목을 두 번 재전송하지 않도록 합니다.
+func (r *DeliveryRepository) MarkRedriven(id uuid.UUID) (bool, error) {
+	result := r.db.Model(&domain.DeadLetter{}).
+		Where("id = ? AND redriven_at IS NULL", id).
+		Update("redriven_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeviceRepository handles mobile device token and delivery tracking persistence.
+type DeviceRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceRepository creates a new DeviceRepository with the given GORM database.
+func NewDeviceRepository(db *gorm.DB) *DeviceRepository {
+	return &DeviceRepository{db: db}
+}
+
+// Upsert registers a device token, reassigning it to the user and clearing any invalidation.
+func (r *DeviceRepository) Upsert(device *domain.DeviceToken) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "token"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"user_id":        device.UserID,
+			"platform":       device.Platform,
+			"device_name":    device.DeviceName,
+			"app_version":    device.AppVersion,
+			"failure_count":  0,
+			"last_error":     nil,
+			"invalidated_at": nil,
+			"updated_at":     device.UpdatedAt,
+		}),
+	}).Create(device).Error
+}
+
+// GetByUser returns all registered devices of a user, including invalidated ones.
+func (r *DeviceRepository) GetByUser(userID uuid.UUID) ([]domain.DeviceToken, error) {
+	var devices []domain.DeviceToken
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&devices).Error
+	return devices, err
+}
+
+// GetActiveByUser returns the devices of a user that can receive push notifications.
+func (r *DeviceRepository) GetActiveByUser(userID uuid.UUID) ([]domain.DeviceToken, error) {
+	var devices []domain.DeviceToken
+	err := r.db.Where("user_id = ? AND invalidated_at IS NULL", userID).Find(&devices).Error
+	return devices, err
+}
+
+// GetActiveByID returns a device that can receive push notifications.
+func (r *DeviceRepository) GetActiveByID(id uuid.UUID) (*domain.DeviceToken, error) {
+	var device domain.DeviceToken
+	if err := r.db.First(&device, "id = ? AND invalidated_at IS NULL", id).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// Delete removes a device token of a user.
+func (r *DeviceRepository) Delete(userID uuid.UUID, token string) (int64, error) {
+	result := r.db.Where("user_id = ? AND token = ?", userID, token).Delete(&domain.DeviceToken{})
+	return result.RowsAffected, result.Error
+}
+
+// RecordDelivery stores a delivery attempt and updates the device delivery state.
+func (r *DeviceRepository) RecordDelivery(delivery *domain.PushDelivery) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(delivery).Error; err != nil {
+			return err
+		}
+
+		now := delivery.CreatedAt
+		var updates map[string]interface{}
+		switch delivery.Status {
+		case domain.DeliveryStatusSent:
+			updates = map[string]interface{}{
+				"last_delivery_at": now,
+				"failure_count":    0,
+				"last_error":       nil,
+			}
+		case domain.DeliveryStatusInvalidToken:
+			updates = map[string]interface{}{
+				"last_failure_at": now,
+				"last_error":      delivery.Error,
+				"invalidated_at":  now,
+			}
+		default:
+			updates = map[string]interface{}{
+				"last_failure_at": now,
+				"last_error":      delivery.Error,
+				"failure_count":   gorm.Expr("failure_count + 1"),
+			}
+		}
+		updates["updated_at"] = time.Now()
+
+		return tx.Model(&domain.DeviceToken{}).Where("id = ?", delivery.DeviceTokenID).Updates(updates).Error
+	})
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IntegrationRepository handles Slack/Teams workspace integration persistence.
+type IntegrationRepository struct {
+	db *gorm.DB
+}
+
+// NewIntegrationRepository creates a new IntegrationRepository with the given GORM database.
+func NewIntegrationRepository(db *gorm.DB) *IntegrationRepository {
+	return &IntegrationRepository{db: db}
+}
+
+// Create stores an integration together with its routing rules.
+func (r *IntegrationRepository) Create(integration *domain.WorkspaceIntegration) error {
+	return r.db.Create(integration).Error
+}
+
+// GetByID returns an integration of a workspace with its routing rules.
+func (r *IntegrationRepository) GetByID(id, workspaceID uuid.UUID) (*domain.WorkspaceIntegration, error) {
+	var integration domain.WorkspaceIntegration
+	err := r.db.Preload("Routes").
+		Where("id = ? AND workspace_id = ?", id, workspaceID).
+		First(&integration).Error
+	if err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// GetByWorkspace returns all integrations of a workspace with their routing rules.
+func (r *IntegrationRepository) GetByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceIntegration, error) {
+	var integrations []domain.WorkspaceIntegration
+	err := r.db.Preload("Routes").
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&integrations).Error
+	return integrations, err
+}
+
+// GetRoutedForType returns the enabled integrations of a workspace that route a notification type.
+// 각 연동의 Routes에는 해당 타입의 규칙만 담깁니다.
+func (r *IntegrationRepository) GetRoutedForType(workspaceID uuid.UUID, notificationType domain.NotificationType) ([]domain.WorkspaceIntegration, error) {
+	var integrations []domain.WorkspaceIntegration
+	err := r.db.Preload("Routes", "type = ?", notificationType).
+		Where("workspace_id = ? AND enabled = ?", workspaceID, true).
+		Where("EXISTS (SELECT 1 FROM integration_routes ir WHERE ir.integration_id = workspace_integrations.id AND ir.type = ?)", notificationType).
+		Find(&integrations).Error
+	return integrations, err
+}
+
+// GetRoutedByID returns an enabled integration if it still routes a notification type.
+func (r *IntegrationRepository) GetRoutedByID(id uuid.UUID, notificationType domain.NotificationType) (*domain.WorkspaceIntegration, error) {
+	var integration domain.WorkspaceIntegration
+	err := r.db.Preload("Routes", "type = ?", notificationType).
+		Where("id = ? AND enabled = ?", id, true).
+		Where("EXISTS (SELECT 1 FROM integration_routes ir WHERE ir.integration_id = workspace_integrations.id AND ir.type = ?)", notificationType).
+		First(&integration).Error
+	if err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// Update saves the mutable fields of an integration.
+func (r *IntegrationRepository) Update(integration *domain.WorkspaceIntegration) error {
+	return r.db.Model(&domain.WorkspaceIntegration{}).
+		Where("id = ?", integration.ID).
+		Updates(map[string]interface{}{
+			"name":          integration.Name,
+			"webhook_url":   integration.WebhookURL,
+			"enabled":       integration.Enabled,
+			"failure_count": integration.FailureCount,
+			"last_error":    integration.LastError,
+			"updated_at":    time.Now(),
+		}).Error
+}
+
+// ReplaceRoutes replaces all routing rules of an integration.
+func (r *IntegrationRepository) ReplaceRoutes(integrationID uuid.UUID, routes []domain.IntegrationRoute) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("integration_id = ?", integrationID).Delete(&domain.IntegrationRoute{}).Error; err != nil {
+			return err
+		}
+		if len(routes) == 0 {
+			return nil
+		}
+		return tx.Create(&routes).Error
+	})
+}
+
+// Delete removes an integration of a workspace and its routing rules.
+func (r *IntegrationRepository) Delete(id, workspaceID uuid.UUID) (int64, error) {
+	var rows int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND workspace_id = ?", id, workspaceID).Delete(&domain.WorkspaceIntegration{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rows = result.RowsAffected
+		if rows == 0 {
+			return nil
+		}
+		return tx.Where("integration_id = ?", id).Delete(&domain.IntegrationRoute{}).Error
+	})
+	return rows, err
+}
+
+// RecordDelivery updates the delivery state of an integration.
+// revoked가 true이면 연동을 비활성화하여 이후 전송을 중단합니다.
+func (r *IntegrationRepository) RecordDelivery(id uuid.UUID, deliveryErr *string, revoked bool) error {
+	now := time.Now()
+	var updates map[string]interface{}
+	if deliveryErr == nil {
+		updates = map[string]interface{}{
+			"last_delivery_at": now,
+			"failure_count":    0,
+			"last_error":       nil,
+		}
+	} else {
+		updates = map[string]interface{}{
+			"last_error":    deliveryErr,
+			"failure_count": gorm.Expr("failure_count + 1"),
+		}
+		if revoked {
+			updates["enabled"] = false
+		}
+	}
+	updates["updated_at"] = now
+
+	return r.db.Model(&domain.WorkspaceIntegration{}).Where("id = ?", id).Updates(updates).Error
+}
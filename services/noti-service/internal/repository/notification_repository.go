@@ -5,6 +5,7 @@
 package repository
 
 import (
+	"encoding/json"
 	"noti-service/internal/domain"
 	"time"
 
@@ -42,6 +43,42 @@ func (r *NotificationRepository) GetByID(id uuid.UUID) (*domain.Notification, er
 	return &notification, nil
 }
 
+// IncrementGroup folds a similar event into an existing notification.
+// 묶인 알림은 최신 이벤트 정보로 갱신되고 다시 읽지 않음 상태가 됩니다.
+func (r *NotificationRepository) IncrementGroup(id uuid.UUID, latest *domain.Notification) (*domain.Notification, error) {
+	var metadata interface{}
+	if latest.Metadata != nil {
+		data, err := json.Marshal(latest.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		metadata = string(data)
+	}
+
+	now := time.Now()
+	result := r.db.Model(&domain.Notification{}).
+		Where("id = ? AND target_user_id = ?", id, latest.TargetUserID).
+		Updates(map[string]interface{}{
+			"group_count":   gorm.Expr("group_count + 1"),
+			"actor_id":      latest.ActorID,
+			"resource_name": latest.ResourceName,
+			"metadata":      metadata,
+			"is_read":       false,
+			"read_at":       nil,
+			"updated_at":    now,
+		})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return r.GetByID(id)
+}
+
 // GetByIDAndUserID retrieves a notification ensuring it belongs to the specified user.
 func (r *NotificationRepository) GetByIDAndUserID(id, userID uuid.UUID) (*domain.Notification, error) {
 	var notification domain.Notification
@@ -58,7 +95,7 @@ func (r *NotificationRepository) GetByUserAndWorkspace(userID, workspaceID uuid.
 	var total int64
 
 	query := r.db.Model(&domain.Notification{}).
-		Where("target_user_id = ? AND workspace_id = ?", userID, workspaceID)
+		Where("target_user_id = ? AND workspace_id = ? AND archived_at IS NULL", userID, workspaceID)
 
 	if unreadOnly {
 		query = query.Where("is_read = ?", false)
@@ -81,6 +118,70 @@ func (r *NotificationRepository) GetByUserAndWorkspace(userID, workspaceID uuid.
 	return notifications, total, nil
 }
 
+// GetFeed returns up to limit notifications of a user matching the filter, newest first.
+// (created_at, id) 커서 기반 페이지네이션으로 새 알림이 추가되어도 중복/누락이 없습니다.
+func (r *NotificationRepository) GetFeed(userID uuid.UUID, filter *domain.NotificationFeedFilter, cursor *domain.FeedCursor, limit int) ([]domain.Notification, error) {
+	query := r.db.Model(&domain.Notification{}).Where("target_user_id = ?", userID)
+
+	if filter.WorkspaceID != nil {
+		query = query.Where("workspace_id = ?", *filter.WorkspaceID)
+	}
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", filter.Types)
+	}
+	if filter.Read != nil {
+		query = query.Where("is_read = ?", *filter.Read)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if filter.Archived {
+		query = query.Where("archived_at IS NOT NULL")
+	} else {
+		query = query.Where("archived_at IS NULL")
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	var notifications []domain.Notification
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&notifications).Error
+	return notifications, err
+}
+
+// SetArchived archives or unarchives a notification and returns the updated notification.
+// 보관 시 읽음 처리도 함께 하여 배지 수에 남지 않도록 합니다.
+func (r *NotificationRepository) SetArchived(id, userID uuid.UUID, archived bool) (*domain.Notification, bool, error) {
+	var notification domain.Notification
+	if err := r.db.First(&notification, "id = ? AND target_user_id = ?", id, userID).Error; err != nil {
+		return nil, false, err
+	}
+	wasUnread := !notification.IsRead
+
+	updates := map[string]interface{}{"archived_at": nil}
+	if archived {
+		now := time.Now()
+		updates["archived_at"] = now
+		if wasUnread {
+			updates["is_read"] = true
+			updates["read_at"] = now
+		}
+	}
+
+	if err := r.db.Model(&notification).Updates(updates).Error; err != nil {
+		return nil, false, err
+	}
+
+	updated, err := r.GetByID(id)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, archived && wasUnread, nil
+}
+
 // GetUnreadCount returns the count of unread notifications for a user in a workspace.
 func (r *NotificationRepository) GetUnreadCount(userID, workspaceID uuid.UUID) (int64, error) {
 	var count int64
@@ -111,6 +212,67 @@ func (r *NotificationRepository) MarkAsRead(id, userID uuid.UUID) (*domain.Notif
 	return r.GetByID(id)
 }
 
+// MarkAsUnread marks a notification as unread and returns the updated notification.
+func (r *NotificationRepository) MarkAsUnread(id, userID uuid.UUID) (*domain.Notification, error) {
+	result := r.db.Model(&domain.Notification{}).
+		Where("id = ? AND target_user_id = ?", id, userID).
+		Updates(map[string]interface{}{
+			"is_read": false,
+			"read_at": nil,
+		})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return r.GetByID(id)
+}
+
+// SetReadState marks the given notifications of a user read or unread.
+// 상태가 실제로 바뀐 알림 수와 해당 알림들의 워크스페이스 목록을 반환합니다.
+func (r *NotificationRepository) SetReadState(userID uuid.UUID, ids []uuid.UUID, read bool) (int64, []uuid.UUID, error) {
+	var workspaceIDs []uuid.UUID
+	var affected int64
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&domain.Notification{}).
+			Where("id IN ? AND target_user_id = ? AND is_read = ?", ids, userID, !read)
+
+		if err := query.Session(&gorm.Session{}).Distinct().Pluck("workspace_id", &workspaceIDs).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{"is_read": read, "read_at": nil}
+		if read {
+			updates["read_at"] = time.Now()
+		}
+		result := query.Updates(updates)
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return affected, workspaceIDs, nil
+}
+
+// GetUnreadCountsByWorkspace returns unread counts of a user grouped by workspace.
+func (r *NotificationRepository) GetUnreadCountsByWorkspace(userID uuid.UUID) ([]domain.UnreadCount, error) {
+	var counts []domain.UnreadCount
+	err := r.db.Model(&domain.Notification{}).
+		Select("workspace_id, COUNT(*) AS count").
+		Where("target_user_id = ? AND is_read = ?", userID, false).
+		Group("workspace_id").
+		Order("count DESC").
+		Scan(&counts).Error
+	return counts, err
+}
+
 // MarkAllAsRead marks all unread notifications as read for a user in a workspace.
 func (r *NotificationRepository) MarkAllAsRead(userID, workspaceID uuid.UUID) (int64, error) {
 	now := time.Now()
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PreferenceRepository handles notification preference persistence.
+type PreferenceRepository struct {
+	db *gorm.DB
+}
+
+// NewPreferenceRepository creates a new PreferenceRepository with the given GORM database.
+func NewPreferenceRepository(db *gorm.DB) *PreferenceRepository {
+	return &PreferenceRepository{db: db}
+}
+
+// GetByUser returns the global preferences of a user and, if workspaceID is set, its workspace overrides.
+func (r *PreferenceRepository) GetByUser(userID uuid.UUID, workspaceID *uuid.UUID) ([]domain.NotificationPreference, error) {
+	var prefs []domain.NotificationPreference
+	query := r.db.Where("user_id = ?", userID)
+	if workspaceID != nil {
+		query = query.Where("workspace_id IS NULL OR workspace_id = ?", *workspaceID)
+	} else {
+		query = query.Where("workspace_id IS NULL")
+	}
+	err := query.Find(&prefs).Error
+	return prefs, err
+}
+
+// GetByUserAndType returns the preferences that apply to one notification type in a workspace.
+func (r *PreferenceRepository) GetByUserAndType(userID, workspaceID uuid.UUID, notificationType domain.NotificationType) ([]domain.NotificationPreference, error) {
+	var prefs []domain.NotificationPreference
+	err := r.db.
+		Where("user_id = ? AND type = ? AND (workspace_id IS NULL OR workspace_id = ?)", userID, string(notificationType), workspaceID).
+		Find(&prefs).Error
+	return prefs, err
+}
+
+// Upsert creates or updates preferences for a user in a single transaction.
+func (r *PreferenceRepository) Upsert(userID uuid.UUID, workspaceID *uuid.UUID, settings []domain.PreferenceSetting) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, setting := range settings {
+			query := tx.Model(&domain.NotificationPreference{}).
+				Where("user_id = ? AND type = ? AND channel = ?", userID, string(setting.Type), setting.Channel)
+			if workspaceID != nil {
+				query = query.Where("workspace_id = ?", *workspaceID)
+			} else {
+				query = query.Where("workspace_id IS NULL")
+			}
+
+			result := query.Updates(map[string]interface{}{
+				"enabled":    setting.Enabled,
+				"updated_at": now,
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				continue
+			}
+
+			pref := domain.NotificationPreference{
+				ID:          uuid.New(),
+				UserID:      userID,
+				WorkspaceID: workspaceID,
+				Type:        string(setting.Type),
+				Channel:     setting.Channel,
+				Enabled:     setting.Enabled,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+			// Enabled=false를 명시적으로 저장하기 위해 Select 사용 (GORM은 zero value를 기본값으로 대체)
+			if err := tx.Select("*").Create(&pref).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetLocale returns the notification locale of a user, or "" if not set.
+func (r *PreferenceRepository) GetLocale(userID uuid.UUID) (string, error) {
+	var locale domain.NotificationLocale
+	err := r.db.Where("user_id = ?", userID).Limit(1).Find(&locale).Error
+	return locale.Locale, err
+}
+
+// UpsertLocale saves the notification locale of a user.
+func (r *PreferenceRepository) UpsertLocale(userID uuid.UUID, locale string) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"locale", "updated_at"}),
+	}).Create(&domain.NotificationLocale{
+		UserID:    userID,
+		Locale:    locale,
+		UpdatedAt: time.Now(),
+	}).Error
+}
+
+// GetQuietHours returns the quiet hours of a user, or nil if not set.
+func (r *PreferenceRepository) GetQuietHours(userID uuid.UUID) (*domain.QuietHours, error) {
+	var quietHours []domain.QuietHours
+	if err := r.db.Where("user_id = ?", userID).Limit(1).Find(&quietHours).Error; err != nil {
+		return nil, err
+	}
+	if len(quietHours) == 0 {
+		return nil, nil
+	}
+	return &quietHours[0], nil
+}
+
+// UpsertQuietHours saves the quiet hours of a user.
+func (r *PreferenceRepository) UpsertQuietHours(quietHours *domain.QuietHours) error {
+	// Enabled=false를 명시적으로 저장하기 위해 Select 사용
+	return r.db.Select("*").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "start_time", "end_time", "timezone", "updated_at"}),
+	}).Create(quietHours).Error
+}
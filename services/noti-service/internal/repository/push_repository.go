@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// vapidKeyID is the primary key of the single VAPID key row.
+const vapidKeyID = 1
+
+// PushRepository handles push subscription and VAPID key persistence.
+type PushRepository struct {
+	db *gorm.DB
+}
+
+// NewPushRepository creates a new PushRepository with the given GORM database.
+func NewPushRepository(db *gorm.DB) *PushRepository {
+	return &PushRepository{db: db}
+}
+
+// UpsertSubscription creates a subscription or reassigns an existing endpoint to the user.
+func (r *PushRepository) UpsertSubscription(sub *domain.PushSubscription) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "endpoint"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "p256dh", "auth", "user_agent", "updated_at"}),
+	}).Create(sub).Error
+}
+
+// GetSubscriptionsByUser returns all push subscriptions of a user.
+func (r *PushRepository) GetSubscriptionsByUser(userID uuid.UUID) ([]domain.PushSubscription, error) {
+	var subs []domain.PushSubscription
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&subs).Error
+	return subs, err
+}
+
+// GetSubscriptionByID returns a push subscription by ID.
+func (r *PushRepository) GetSubscriptionByID(id uuid.UUID) (*domain.PushSubscription, error) {
+	var sub domain.PushSubscription
+	if err := r.db.First(&sub, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// DeleteSubscription removes a subscription of a user by endpoint.
+func (r *PushRepository) DeleteSubscription(userID uuid.UUID, endpoint string) (int64, error) {
+	result := r.db.Where("user_id = ? AND endpoint = ?", userID, endpoint).Delete(&domain.PushSubscription{})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteSubscriptionByID removes an expired subscription.
+func (r *PushRepository) DeleteSubscriptionByID(id uuid.UUID) error {
+	return r.db.Delete(&domain.PushSubscription{}, "id = ?", id).Error
+}
+
+// TouchSubscription records a successful delivery to a subscription.
+func (r *PushRepository) TouchSubscription(id uuid.UUID) error {
+	return r.db.Model(&domain.PushSubscription{}).
+		Where("id = ?", id).
+		Update("last_used_at", time.Now()).Error
+}
+
+// GetVAPIDKey returns the stored VAPID key pair, or nil if none has been generated.
+func (r *PushRepository) GetVAPIDKey() (*domain.VAPIDKey, error) {
+	var key domain.VAPIDKey
+	err := r.db.First(&key, "id = ?", vapidKeyID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// CreateVAPIDKeyIfAbsent stores a VAPID key pair unless another replica stored one first.
+func (r *PushRepository) CreateVAPIDKeyIfAbsent(key *domain.VAPIDKey) error {
+	key.ID = vapidKeyID
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(key).Error
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SMSRepository handles SMS phone number and workspace setting persistence.
+type SMSRepository struct {
+	db *gorm.DB
+}
+
+// NewSMSRepository creates a new SMSRepository with the given GORM database.
+func NewSMSRepository(db *gorm.DB) *SMSRepository {
+	return &SMSRepository{db: db}
+}
+
+// GetPhone returns the phone number of a user, or nil if not registered.
+func (r *SMSRepository) GetPhone(userID uuid.UUID) (*domain.PhoneNumber, error) {
+	var phones []domain.PhoneNumber
+	if err := r.db.Where("user_id = ?", userID).Limit(1).Find(&phones).Error; err != nil {
+		return nil, err
+	}
+	if len(phones) == 0 {
+		return nil, nil
+	}
+	return &phones[0], nil
+}
+
+// GetActivePhone returns the phone number of a user if it can receive messages.
+// Returns gorm.ErrRecordNotFound if the number was removed or disabled.
+func (r *SMSRepository) GetActivePhone(userID uuid.UUID) (*domain.PhoneNumber, error) {
+	var phone domain.PhoneNumber
+	err := r.db.Where("user_id = ? AND disabled = false", userID).First(&phone).Error
+	if err != nil {
+		return nil, err
+	}
+	return &phone, nil
+}
+
+// UpsertPhone saves a verified phone number of a user, re-enabling it if it was disabled.
+func (r *SMSRepository) UpsertPhone(phone *domain.PhoneNumber) error {
+	return r.db.Select("*").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"number", "verified_at", "disabled", "updated_at"}),
+	}).Create(phone).Error
+}
+
+// DisablePhone marks a phone number as unable to receive messages.
+func (r *SMSRepository) DisablePhone(userID uuid.UUID) error {
+	return r.db.Model(&domain.PhoneNumber{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{"disabled": true, "updated_at": time.Now()}).Error
+}
+
+// DeletePhone removes the phone number of a user.
+func (r *SMSRepository) DeletePhone(userID uuid.UUID) (int64, error) {
+	result := r.db.Where("user_id = ?", userID).Delete(&domain.PhoneNumber{})
+	return result.RowsAffected, result.Error
+}
+
+// GetWorkspaceSetting returns the SMS setting of a workspace, or nil if not configured.
+func (r *SMSRepository) GetWorkspaceSetting(workspaceID uuid.UUID) (*domain.WorkspaceSMSSetting, error) {
+	var settings []domain.WorkspaceSMSSetting
+	if err := r.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&settings).Error; err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 {
+		return nil, nil
+	}
+	return &settings[0], nil
+}
+
+// UpsertWorkspaceSetting saves the SMS setting of a workspace.
+func (r *SMSRepository) UpsertWorkspaceSetting(setting *domain.WorkspaceSMSSetting) error {
+	return r.db.Select("*").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "monthly_limit", "updated_by", "updated_at"}),
+	}).Create(setting).Error
+}
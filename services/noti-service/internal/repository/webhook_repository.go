@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"noti-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookRepository handles workspace webhook and delivery log persistence.
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new WebhookRepository with the given GORM database.
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// Create stores a webhook.
+func (r *WebhookRepository) Create(webhook *domain.WorkspaceWebhook) error {
+	return r.db.Create(webhook).Error
+}
+
+// GetByID returns a webhook of a workspace.
+func (r *WebhookRepository) GetByID(id, workspaceID uuid.UUID) (*domain.WorkspaceWebhook, error) {
+	var webhook domain.WorkspaceWebhook
+	err := r.db.Where("id = ? AND workspace_id = ?", id, workspaceID).First(&webhook).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetEnabledByID returns a webhook if it is still enabled.
+func (r *WebhookRepository) GetEnabledByID(id uuid.UUID) (*domain.WorkspaceWebhook, error) {
+	var webhook domain.WorkspaceWebhook
+	err := r.db.Where("id = ? AND enabled = ?", id, true).First(&webhook).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetByWorkspace returns all webhooks of a workspace.
+func (r *WebhookRepository) GetByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceWebhook, error) {
+	var webhooks []domain.WorkspaceWebhook
+	err := r.db.Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// GetEnabledByWorkspace returns the enabled webhooks of a workspace.
+func (r *WebhookRepository) GetEnabledByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceWebhook, error) {
+	var webhooks []domain.WorkspaceWebhook
+	err := r.db.Where("workspace_id = ? AND enabled = ?", workspaceID, true).Find(&webhooks).Error
+	return webhooks, err
+}
+
+// CountByWorkspace returns the number of webhooks of a workspace.
+func (r *WebhookRepository) CountByWorkspace(workspaceID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.WorkspaceWebhook{}).Where("workspace_id = ?", workspaceID).Count(&count).Error
+	return count, err
+}
+
+// Update saves the mutable fields of a webhook.
+// 구조체로 갱신해야 Types가 JSON으로 직렬화되므로 Select로 false/nil 값도 함께 저장합니다.
+func (r *WebhookRepository) Update(webhook *domain.WorkspaceWebhook) error {
+	webhook.UpdatedAt = time.Now()
+	return r.db.Model(webhook).
+		Select("name", "url", "secret", "types", "enabled", "failure_count", "last_error", "updated_at").
+		Updates(webhook).Error
+}
+
+// Delete removes a webhook of a workspace and its delivery log.
+func (r *WebhookRepository) Delete(id, workspaceID uuid.UUID) (int64, error) {
+	var rows int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND workspace_id = ?", id, workspaceID).Delete(&domain.WorkspaceWebhook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rows = result.RowsAffected
+		if rows == 0 {
+			return nil
+		}
+		return tx.Where("webhook_id = ?", id).Delete(&domain.WebhookDelivery{}).Error
+	})
+	return rows, err
+}
+
+// RecordDelivery appends an attempt to the delivery log and updates the webhook delivery state.
+// disable가 true이면 연속 실패로 웹훅을 비활성화합니다.
+func (r *WebhookRepository) RecordDelivery(delivery *domain.WebhookDelivery, disable bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(delivery).Error; err != nil {
+			return err
+		}
+
+		var updates map[string]interface{}
+		if delivery.Success {
+			updates = map[string]interface{}{
+				"last_delivery_at": delivery.CreatedAt,
+				"failure_count":    0,
+				"last_error":       nil,
+			}
+		} else {
+			updates = map[string]interface{}{
+				"last_error":    delivery.Error,
+				"failure_count": gorm.Expr("failure_count + 1"),
+			}
+			if disable {
+				updates["enabled"] = false
+			}
+		}
+		updates["updated_at"] = time.Now()
+		return tx.Model(&domain.WorkspaceWebhook{}).Where("id = ?", delivery.WebhookID).Updates(updates).Error
+	})
+}
+
+// ListDeliveries returns the newest delivery log entries of a webhook.
+func (r *WebhookRepository) ListDeliveries(webhookID uuid.UUID, filter *domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, error) {
+	query := r.db.Where("webhook_id = ?", webhookID)
+	if filter.FailedOnly {
+		query = query.Where("success = ?", false)
+	}
+
+	var deliveries []domain.WebhookDelivery
+	err := query.Order("created_at DESC").Limit(filter.Limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// CleanupDeliveries removes delivery log entries older than the given time.
+func (r *WebhookRepository) CleanupDeliveries(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", before).Delete(&domain.WebhookDelivery{})
+	return result.RowsAffected, result.Error
+}
@@ -134,7 +134,8 @@ func TestSuccess(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
 	assert.True(t, resp["success"].(bool))
-	assert.Equal(t, "operation completed", resp["message"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "operation completed", data["message"])
 }
 
 func TestOK(t *testing.T) {
@@ -173,9 +174,11 @@ func TestOKWithPagination(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, resp["success"].(bool))
 	assert.NotNil(t, resp["data"])
-	assert.Equal(t, float64(100), resp["total"])
-	assert.Equal(t, float64(1), resp["page"])
-	assert.Equal(t, float64(20), resp["limit"])
+	pagination := resp["meta"].(map[string]interface{})["pagination"].(map[string]interface{})
+	assert.Equal(t, float64(100), pagination["total"])
+	assert.Equal(t, float64(1), pagination["page"])
+	assert.Equal(t, float64(20), pagination["limit"])
+	assert.Equal(t, float64(5), pagination["totalPages"])
 }
 
 func TestCreated(t *testing.T) {
@@ -0,0 +1,17 @@
+package response
+
+import (
+	"embed"
+
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
+)
+
+// locales는 이 서비스의 에러 메시지 번역입니다 (locales/<lang>.yaml).
+// Accept-Language에 맞는 번역이 있으면 공통 응답 함수가 메시지를 바꿔 보냅니다.
+//
+//go:embed locales/*.yaml
+var locales embed.FS
+
+func init() {
+	commonresponse.MustLoadBundles(locales, "locales")
+}
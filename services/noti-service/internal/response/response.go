@@ -16,6 +16,14 @@ type (
 	ErrorResponse = commonresponse.ErrorResponse
 )
 
+// PageRequest는 오프셋 페이지네이션 요청입니다. (공통 모듈 사용)
+type PageRequest = commonresponse.PageRequest
+
+// ParsePageRequest는 page, limit 쿼리를 기본값과 상한을 적용해 읽습니다. (공통 모듈 사용)
+func ParsePageRequest(c *gin.Context, defaultLimit int) PageRequest {
+	return commonresponse.ParsePageRequest(c, defaultLimit)
+}
+
 // SendSuccess는 성공 응답을 전송합니다. (공통 모듈 사용)
 func SendSuccess(c *gin.Context, statusCode int, data interface{}) {
 	commonresponse.Success(c, statusCode, data)
@@ -0,0 +1,59 @@
+//go:build integration
+
+package router
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"noti-service/internal/config"
+	"noti-service/internal/database"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/contract"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// TestProviderContract_BoardService는 board-service의 내부 알림 API 호출을 실제 라우터가 받아들이는지 확인합니다.
+// 이벤트 메시지 계약은 service.TestEventConsumerContract에서 확인합니다.
+func TestProviderContract_BoardService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c := contract.Load(t, "board-service", "noti-service")
+
+	cfg, err := config.Load("")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	cfg.Database.URL = integration.PostgresDSN(t)
+	cfg.Database.AutoMigrate = true
+	// 계약의 요청이 보내는 내부 API 키
+	cfg.InternalAuth.InternalAPIKey = c.Interactions[0].Request.Headers["x-internal-api-key"]
+
+	db, err := database.NewDB(cfg)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	r := Setup(RouterConfig{
+		Config:      cfg,
+		DB:          db,
+		RedisClient: integration.Redis(t),
+		Logger:      zap.NewNop(),
+	})
+
+	contract.Verify(t, c, r, contract.States{
+		// 수신 설정이 없으면 모든 인앱 알림을 받습니다
+		"target user receives in-app notifications": func(t *testing.T, params map[string]string) {},
+	})
+}
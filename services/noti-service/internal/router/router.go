@@ -5,22 +5,32 @@
 package router
 
 import (
+	"context"
+	"noti-service/internal/client"
 	"noti-service/internal/config"
+	"noti-service/internal/grpcserver"
 	"noti-service/internal/handler"
 	"noti-service/internal/metrics"
 	"noti-service/internal/middleware"
 	"noti-service/internal/repository"
 	"noti-service/internal/service"
 	"noti-service/internal/sse"
+	"noti-service/internal/templates"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
 )
 
@@ -31,6 +41,10 @@ type RouterConfig struct {
 	RedisClient *redis.Client
 	Logger      *zap.Logger
 	ServiceName string
+	// GRPCServer가 있으면 같은 NotificationService로 내부 gRPC API를 등록합니다.
+	GRPCServer grpc.ServiceRegistrar
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
 }
 
 // Setup configures and returns the Gin router with all routes and middleware.
@@ -59,8 +73,20 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	r.Use(commonmw.Recovery(logger))
 	r.Use(commonmw.OTELTracing(serviceName))                 // OpenTelemetry HTTP tracing (otelgin)
 	r.Use(commonmw.LoggerWithTracing(logger, serviceName))   // Request logging with trace context
-	r.Use(commonmw.DefaultCORS())
+	r.Use(commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), routerCfg.Runtime))
 	r.Use(metrics.HTTPMiddleware(m))
+	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes)) // Request body size limit (1MB)
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	r.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), routerCfg.Runtime, logger))
+
+	// DB/Redis 메트릭 (요청 trace를 exemplar로 연결)
+	if err := commonotel.EnableGORMMetrics(db, m.Metrics); err != nil {
+		logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+	}
+	if err := commonotel.EnableGORMPoolMetrics(db, m.Metrics); err != nil {
+		logger.Warn("Failed to enable DB pool metrics", zap.Error(err))
+	}
+	commonotel.EnableRedisMetrics(redisClient, m.Metrics)
 
 	// Rate limiting middleware
 	if cfg.RateLimit.Enabled && redisClient != nil {
@@ -69,7 +95,8 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 			WithBurstSize(cfg.RateLimit.BurstSize).
 			WithKeyPrefix("rl:noti:")
 		limiter := ratelimit.NewRedisRateLimiter(redisClient, rlConfig, logger)
-		r.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, logger))
+		ratelimit.WatchSettings(limiter, routerCfg.Runtime)
+		r.Use(ratelimit.PolicyMiddleware(limiter, ratelimit.NewPolicies(), logger))
 		logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimit.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimit.BurstSize))
@@ -80,9 +107,127 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	// Initialize services
 	// 레포지토리와 SSE 서비스 초기화
 	notificationRepo := repository.NewNotificationRepository(db)
+	preferenceRepo := repository.NewPreferenceRepository(db)
 	sseService := sse.NewSSEService(redisClient, logger)
-	// 알림 서비스 초기화 (메트릭 포함)
-	notificationService := service.NewNotificationService(notificationRepo, redisClient, cfg, logger, m)
+	// 알림 문구 템플릿 (외부 디렉터리 로드 실패 시 내장 템플릿으로 기동)
+	renderer, err := templates.Load(cfg.App.TemplatesDir, cfg.App.DefaultLocale)
+	if err != nil {
+		logger.Error("Failed to load notification templates, using built-in templates", zap.Error(err))
+		renderer = templates.Builtin("ko")
+	}
+
+	// 알림 서비스 초기화 (메트릭, 사용자 수신 설정 포함)
+	preferenceService := service.NewPreferenceService(preferenceRepo, renderer, cfg.QuietHours, logger)
+
+	// 외부 채널 전송 파이프라인 (재시도, dead-letter)
+	deliveryService := service.NewDeliveryService(repository.NewDeliveryRepository(db), redisClient, cfg.Delivery, logger, m)
+	go deliveryService.Start(context.Background())
+
+	// 웹 푸시 채널 (활성화된 경우 외부 채널 전송기로 등록)
+	var senders []service.ChannelSender
+	var pushService *service.PushService
+	if cfg.WebPush.Enabled {
+		var err error
+		pushService, err = service.NewPushService(repository.NewPushRepository(db), redisClient, cfg.WebPush, renderer, deliveryService, logger, m)
+		if err != nil {
+			logger.Error("Web push disabled: failed to initialize", zap.Error(err))
+		} else {
+			senders = append(senders, pushService)
+			deliveryService.Register(pushService)
+			logger.Info("Web push channel enabled")
+		}
+	}
+
+	// 모바일 푸시 채널 (FCM/APNs)
+	var mobilePushService *service.MobilePushService
+	if cfg.MobilePush.Enabled {
+		var err error
+		mobilePushService, err = service.NewMobilePushService(repository.NewDeviceRepository(db), cfg.MobilePush, renderer, deliveryService, logger, m)
+		if err != nil {
+			logger.Error("Mobile push disabled: failed to initialize", zap.Error(err))
+		} else {
+			senders = append(senders, mobilePushService)
+			deliveryService.Register(mobilePushService)
+			logger.Info("Mobile push channel enabled")
+		}
+	}
+
+	// 워크스페이스 관리자 권한 확인 (Slack/Teams 연동, SMS 설정)
+	var userClient client.UserClient
+	if cfg.UserAPI.BaseURL != "" {
+		userClient = client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger)
+	}
+
+	// SMS 채널 (중요 알림만, 워크스페이스별 활성화)
+	var smsService *service.SMSService
+	if cfg.SMS.Enabled {
+		if userClient == nil {
+			logger.Error("SMS disabled: USER_SERVICE_URL is not configured")
+		} else {
+			var err error
+			smsService, err = service.NewSMSService(repository.NewSMSRepository(db), redisClient, userClient, preferenceService, cfg.SMS, renderer, deliveryService, logger, m)
+			if err != nil {
+				logger.Error("SMS disabled: failed to initialize", zap.Error(err))
+			} else {
+				senders = append(senders, smsService)
+				deliveryService.Register(smsService)
+				logger.Info("SMS channel enabled", zap.String("sms.provider", cfg.SMS.Provider))
+			}
+		}
+	}
+
+	notificationService := service.NewNotificationService(notificationRepo, redisClient, cfg, logger, m, preferenceService, renderer, senders...)
+	// 방해 금지 시간 동안 보류한 푸시/이메일을 종료 시각에 전송
+	go notificationService.StartHeldDeliveries(context.Background())
+
+	// Slack/Teams 워크스페이스 연동 (관리자 권한 확인에 user-service 필요)
+	var integrationService *service.IntegrationService
+	if cfg.Integrations.Enabled {
+		if userClient == nil {
+			logger.Error("Integrations disabled: USER_SERVICE_URL is not configured")
+		} else {
+			integrationService = service.NewIntegrationService(repository.NewIntegrationRepository(db), redisClient, userClient, cfg.Integrations, renderer, deliveryService, logger, m)
+			deliveryService.Register(integrationService)
+			notificationService.SetIntegrations(integrationService)
+			logger.Info("Slack/Teams integrations enabled",
+				zap.Bool("slack_oauth", integrationService.SlackOAuthEnabled()))
+		}
+	}
+
+	// 워크스페이스 웹훅 (관리자 권한 확인에 user-service 필요)
+	var webhookService *service.WebhookService
+	if cfg.Webhooks.Enabled {
+		if userClient == nil {
+			logger.Error("Webhooks disabled: USER_SERVICE_URL is not configured")
+		} else {
+			webhookService = service.NewWebhookService(repository.NewWebhookRepository(db), userClient, cfg.Webhooks, renderer, deliveryService, logger, m)
+			deliveryService.Register(webhookService)
+			notificationService.SetWebhooks(webhookService)
+			go webhookService.StartLogCleanup(context.Background())
+			logger.Info("Workspace webhooks enabled",
+				zap.Bool("webhook.allow_private_networks", cfg.Webhooks.AllowPrivateNetworks))
+		}
+	}
+
+	// 플랫폼 이벤트 버스에 알림 생성 도메인 이벤트 발행 (NATS_URL 설정 시)
+	if cfg.Events.NATSURL != "" {
+		eventPublisher := messaging.NewEventPublisher(messaging.Config{URL: cfg.Events.NATSURL, Name: "noti-service"}, logger)
+		notificationService.SetEvents(messaging.NewEmitter(eventPublisher, "noti-service", logger))
+		logger.Info("Domain event publishing enabled")
+	}
+
+	// 다른 서비스가 발행한 알림 이벤트 스트림 소비 (NATS JetStream)
+	if cfg.Events.Enabled {
+		if cfg.Events.NATSURL == "" {
+			logger.Error("Event ingestion disabled: NATS_URL is not configured")
+		} else {
+			eventConsumer := service.NewEventConsumer(notificationService, redisClient, cfg.Events, logger, m)
+			go eventConsumer.Start(context.Background())
+			logger.Info("Event ingestion enabled",
+				zap.String("stream", cfg.Events.Stream),
+				zap.String("subject", cfg.Events.Subject))
+		}
+	}
 
 	// Initialize auth middleware based on ISTIO_JWT_MODE
 	var authMiddleware gin.HandlerFunc
@@ -106,20 +251,29 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 			zap.String("jwt_issuer", cfg.Auth.JWTIssuer))
 	}
 
+	// Internal gRPC API (SubmitNotification)
+	if routerCfg.GRPCServer != nil {
+		internalrpc.RegisterNotificationServiceServer(routerCfg.GRPCServer, grpcserver.NewNotificationServer(notificationService))
+	}
+
 	notificationHandler := handler.NewNotificationHandler(notificationService, sseService, logger)
+	preferenceHandler := handler.NewPreferenceHandler(preferenceService, logger)
+	deliveryHandler := handler.NewDeliveryHandler(deliveryService, logger)
 
 	// Health check routes (using common package)
-	healthChecker := commonhealth.NewHealthChecker(db, redisClient)
+	healthChecker := commonhealth.NewHealthChecker(db, redisClient).
+		AddDownstream("auth-service", cfg.Auth.ServiceURL, commonhealth.AuthServiceLivenessPath).
+		AddDownstream("user-service", cfg.UserAPI.BaseURL, commonhealth.LivenessPath)
 	healthChecker.RegisterRoutes(r, "")
 
 	// Prometheus metrics endpoint
-	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Swagger documentation (disabled for faster builds)
 	// r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// API routes
-	api := r.Group("/api")
+	// API routes (/api와 /api/v1 모두 현재 v1 라우트로 처리)
+	api := apiversion.WithLegacy(r, "/api", apiversion.V1)
 	{
 		// SSE stream endpoint (uses query param token because EventSource doesn't support headers)
 		// SSE는 Istio를 통하지 않을 수 있으므로 SmartValidator 사용
@@ -132,17 +286,89 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 		{
 			notifications.GET("", middleware.RequireWorkspace(), notificationHandler.GetNotifications)
 			notifications.GET("/unread-count", middleware.RequireWorkspace(), notificationHandler.GetUnreadCount)
+			notifications.GET("/feed", notificationHandler.GetFeed)
+			notifications.GET("/unread-counts", notificationHandler.GetUnreadCounts)
 			notifications.PATCH("/:id/read", notificationHandler.MarkAsRead)
+			notifications.PATCH("/:id/unread", notificationHandler.MarkAsUnread)
+			notifications.POST("/read", notificationHandler.UpdateReadState)
+			notifications.POST("/:id/archive", notificationHandler.ArchiveNotification)
+			notifications.DELETE("/:id/archive", notificationHandler.UnarchiveNotification)
 			notifications.POST("/read-all", middleware.RequireWorkspace(), notificationHandler.MarkAllAsRead)
 			notifications.DELETE("/:id", notificationHandler.DeleteNotification)
+
+			// Per-user notification preferences (type x channel)
+			notifications.GET("/preferences", preferenceHandler.GetPreferences)
+			notifications.PUT("/preferences", preferenceHandler.UpdatePreferences)
+			notifications.GET("/preferences/locale", preferenceHandler.GetLocale)
+			notifications.PUT("/preferences/locale", preferenceHandler.UpdateLocale)
+			notifications.GET("/preferences/quiet-hours", preferenceHandler.GetQuietHours)
+			notifications.PUT("/preferences/quiet-hours", preferenceHandler.UpdateQuietHours)
+
+			// Browser push subscriptions (VAPID)
+			if pushService != nil {
+				pushHandler := handler.NewPushHandler(pushService, logger)
+				notifications.GET("/push/vapid-public-key", pushHandler.GetVAPIDPublicKey)
+				notifications.POST("/push/subscriptions", pushHandler.RegisterSubscription)
+				notifications.DELETE("/push/subscriptions", pushHandler.UnregisterSubscription)
+			}
+
+			// Mobile push devices (FCM/APNs)
+			if mobilePushService != nil {
+				deviceHandler := handler.NewDeviceHandler(mobilePushService, logger)
+				notifications.GET("/devices", deviceHandler.GetDevices)
+				notifications.POST("/devices", deviceHandler.RegisterDevice)
+				notifications.DELETE("/devices", deviceHandler.UnregisterDevice)
+			}
+
+			// SMS phone number verification and workspace SMS settings (workspace admins only)
+			if smsService != nil {
+				smsHandler := handler.NewSMSHandler(smsService, logger)
+				notifications.GET("/sms/phone", smsHandler.GetPhone)
+				notifications.PUT("/sms/phone", smsHandler.RegisterPhone)
+				notifications.POST("/sms/phone/verify", smsHandler.VerifyPhone)
+				notifications.DELETE("/sms/phone", smsHandler.DeletePhone)
+				notifications.GET("/sms/workspace", middleware.RequireWorkspace(), smsHandler.GetWorkspaceSMS)
+				notifications.PUT("/sms/workspace", middleware.RequireWorkspace(), smsHandler.UpdateWorkspaceSMS)
+			}
+
+			// Workspace Slack/Teams integrations (workspace admins only)
+			if integrationService != nil {
+				integrationHandler := handler.NewIntegrationHandler(integrationService, logger)
+				integrations := notifications.Group("/integrations", middleware.RequireWorkspace())
+				integrations.GET("", integrationHandler.GetIntegrations)
+				integrations.POST("", integrationHandler.CreateIntegration)
+				integrations.GET("/slack/authorize", integrationHandler.GetSlackAuthorizeURL)
+				integrations.POST("/slack/callback", integrationHandler.CompleteSlackOAuth)
+				integrations.PATCH("/:integrationId", integrationHandler.UpdateIntegration)
+				integrations.DELETE("/:integrationId", integrationHandler.DeleteIntegration)
+				integrations.PUT("/:integrationId/routes", integrationHandler.UpdateRoutes)
+				integrations.POST("/:integrationId/test", integrationHandler.TestIntegration)
+			}
+
+			// Workspace outbound webhooks (workspace admins only)
+			if webhookService != nil {
+				webhookHandler := handler.NewWebhookHandler(webhookService, logger)
+				webhooks := notifications.Group("/webhooks", middleware.RequireWorkspace())
+				webhooks.GET("", webhookHandler.GetWebhooks)
+				webhooks.POST("", webhookHandler.CreateWebhook)
+				webhooks.PATCH("/:webhookId", webhookHandler.UpdateWebhook)
+				webhooks.DELETE("/:webhookId", webhookHandler.DeleteWebhook)
+				webhooks.POST("/:webhookId/rotate-secret", webhookHandler.RotateSecret)
+				webhooks.POST("/:webhookId/test", webhookHandler.TestWebhook)
+				webhooks.GET("/:webhookId/deliveries", webhookHandler.GetDeliveries)
+			}
 		}
 
 		// Internal API routes (require API key)
-		internal := api.Group("/internal")
+		internal := r.Group("/api/internal") // 서비스 간 호출은 버전 없이 유지
 		internal.Use(middleware.InternalAuthMiddleware(cfg.InternalAuth.InternalAPIKey))
 		{
 			internal.POST("/notifications", notificationHandler.CreateNotification)
 			internal.POST("/notifications/bulk", notificationHandler.CreateBulkNotifications)
+
+			// Failed outbound deliveries (push, Slack/Teams)
+			internal.GET("/notifications/dead-letters", deliveryHandler.GetDeadLetters)
+			internal.POST("/notifications/dead-letters/:id/redrive", deliveryHandler.RedriveDeadLetter)
 		}
 	}
 
@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// deliveryRetryKey is a sorted set of pending retries scored by their due time (unix ms).
+	deliveryRetryKey = "noti:delivery:retry"
+	// deliveryPollInterval is how often each replica checks for due retries.
+	deliveryPollInterval = time.Second
+	// deliveryPollBatch bounds the retries claimed per poll.
+	deliveryPollBatch = 50
+)
+
+// ErrTargetGone reports that a delivery target no longer exists (expired subscription, invalid token, revoked integration).
+// 재시도나 dead-letter 없이 DROPPED로 기록합니다.
+var ErrTargetGone = errors.New("delivery target no longer exists")
+
+// permanentError marks a delivery failure that retrying cannot fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps a delivery error so that it is dead-lettered without retrying.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// isPermanentStatus returns true if a provider status code will not succeed on retry.
+// 408/429를 제외한 4xx는 요청 자체의 문제이므로 재시도하지 않습니다.
+func isPermanentStatus(status int) bool {
+	return status >= 400 && status < 500 && status != 408 && status != 429
+}
+
+// TargetSender delivers a notification to a single target of a delivery channel.
+// 한 번만 시도하고 결과를 반환하며, 재시도와 dead-letter는 DeliveryService가 담당합니다.
+type TargetSender interface {
+	DeliveryChannel() domain.DeliveryChannel
+	DeliverTo(ctx context.Context, notification *domain.Notification, targetID uuid.UUID) error
+}
+
+// DeliveryService runs outbound deliveries with exponential backoff retries and a dead-letter table.
+// 재시도 대기열은 Redis sorted set에 두어 모든 레플리카가 나눠 처리하고 재시작에도 유지됩니다.
+type DeliveryService struct {
+	repo    *repository.DeliveryRepository
+	redis   *redis.Client
+	senders map[domain.DeliveryChannel]TargetSender
+	config  config.DeliveryConfig
+	logger  *zap.Logger
+	metrics *metrics.Metrics
+}
+
+// NewDeliveryService creates a new DeliveryService with the given dependencies.
+func NewDeliveryService(
+	repo *repository.DeliveryRepository,
+	redis *redis.Client,
+	cfg config.DeliveryConfig,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) *DeliveryService {
+	return &DeliveryService{
+		repo:    repo,
+		redis:   redis,
+		senders: make(map[domain.DeliveryChannel]TargetSender),
+		config:  cfg,
+		logger:  logger,
+		metrics: m,
+	}
+}
+
+// log returns a trace-context aware logger
+func (s *DeliveryService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// Register makes a sender available for retries and redrives of its channel.
+func (s *DeliveryService) Register(sender TargetSender) {
+	s.senders[sender.DeliveryChannel()] = sender
+}
+
+// Submit makes the first delivery attempt to each target and schedules retries for transient failures.
+// 첫 시도는 호출한 고루틴에서 실행되므로 전송기는 백그라운드에서 호출합니다.
+func (s *DeliveryService) Submit(ctx context.Context, sender TargetSender, notification *domain.Notification, targets []uuid.UUID) {
+	for _, targetID := range targets {
+		s.attempt(ctx, sender, &domain.DeliveryJob{
+			ID:           uuid.New(),
+			Channel:      sender.DeliveryChannel(),
+			TargetID:     targetID,
+			Locale:       notification.Locale,
+			Notification: *notification,
+		})
+	}
+}
+
+// Start polls for due retries until the context is canceled.
+func (s *DeliveryService) Start(ctx context.Context) {
+	if s.redis == nil {
+		s.logger.Warn("Delivery retries disabled: Redis is not available")
+		return
+	}
+
+	ticker := time.NewTicker(deliveryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.processDue(ctx)
+		}
+	}
+}
+
+// processDue claims and runs the retries whose due time has passed.
+// ZREM에 성공한 레플리카만 작업을 실행하므로 같은 재시도가 중복 실행되지 않습니다.
+func (s *DeliveryService) processDue(ctx context.Context) {
+	log := s.log(ctx)
+
+	members, err := s.redis.ZRangeArgs(ctx, redis.ZRangeArgs{
+		Key:     deliveryRetryKey,
+		Start:   "-inf",
+		Stop:    strconv.FormatInt(time.Now().UnixMilli(), 10),
+		ByScore: true,
+		Count:   deliveryPollBatch,
+	}).Result()
+	if err != nil {
+		log.Warn("Failed to load due delivery retries", zap.Error(err))
+		return
+	}
+
+	for _, member := range members {
+		claimed, err := s.redis.ZRem(ctx, deliveryRetryKey, member).Result()
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		var job domain.DeliveryJob
+		if err := json.Unmarshal([]byte(member), &job); err != nil {
+			log.Error("Dropping malformed delivery retry", zap.Error(err))
+			continue
+		}
+
+		sender, ok := s.senders[job.Channel]
+		if !ok {
+			s.deadLetter(ctx, &job, errors.New("delivery channel is not enabled"))
+			continue
+		}
+		s.attempt(ctx, sender, &job)
+	}
+}
+
+// attempt runs one delivery attempt and records its outcome.
+func (s *DeliveryService) attempt(ctx context.Context, sender TargetSender, job *domain.DeliveryJob) {
+	notification := job.Notification
+	notification.Locale = job.Locale
+
+	err := sender.DeliverTo(ctx, &notification, job.TargetID)
+	job.Attempt++
+
+	switch state := deliveryOutcome(err, job.Attempt, s.config.MaxAttempts); state {
+	case domain.DeliveryStateFailed:
+		s.deadLetter(ctx, job, err)
+	case domain.DeliveryStateRetrying:
+		s.scheduleRetry(ctx, job, err)
+	default:
+		s.updateStatus(ctx, job, state, err)
+	}
+}
+
+// deliveryOutcome classifies the result of an attempt.
+func deliveryOutcome(err error, attempt, maxAttempts int) domain.DeliveryState {
+	var permanent *permanentError
+	switch {
+	case err == nil:
+		return domain.DeliveryStateSent
+	case errors.Is(err, ErrTargetGone):
+		return domain.DeliveryStateDropped
+	case errors.As(err, &permanent) || attempt >= maxAttempts:
+		return domain.DeliveryStateFailed
+	default:
+		return domain.DeliveryStateRetrying
+	}
+}
+
+// scheduleRetry queues the next attempt of a job after its backoff delay.
+func (s *DeliveryService) scheduleRetry(ctx context.Context, job *domain.DeliveryJob, cause error) {
+	if s.redis == nil {
+		s.deadLetter(ctx, job, cause)
+		return
+	}
+
+	member, err := json.Marshal(job)
+	if err != nil {
+		s.deadLetter(ctx, job, cause)
+		return
+	}
+
+	delay := withJitter(retryBackoff(job.Attempt, s.baseDelay(), s.maxDelay()))
+	due := time.Now().Add(delay).UnixMilli()
+	if err := s.redis.ZAdd(ctx, deliveryRetryKey, redis.Z{Score: float64(due), Member: member}).Err(); err != nil {
+		// 재시도를 예약할 수 없으면 유실 대신 dead-letter로 보관
+		s.log(ctx).Warn("Failed to schedule delivery retry", zap.Error(err))
+		s.deadLetter(ctx, job, cause)
+		return
+	}
+
+	s.log(ctx).Info("Delivery retry scheduled",
+		zap.String("delivery.channel", string(job.Channel)),
+		zap.String("notification.id", job.Notification.ID.String()),
+		zap.Int("delivery.attempt", job.Attempt),
+		zap.Duration("delivery.retry_in", delay),
+		zap.Error(cause))
+	if s.metrics != nil {
+		s.metrics.RecordDeliveryRetry(string(job.Channel))
+	}
+	s.updateStatus(ctx, job, domain.DeliveryStateRetrying, cause)
+}
+
+// deadLetter stores a job that will not be retried.
+func (s *DeliveryService) deadLetter(ctx context.Context, job *domain.DeliveryJob, cause error) {
+	log := s.log(ctx)
+
+	deadLetter := &domain.DeadLetter{
+		ID:             uuid.New(),
+		NotificationID: job.Notification.ID,
+		TargetUserID:   job.Notification.TargetUserID,
+		WorkspaceID:    job.Notification.WorkspaceID,
+		Channel:        job.Channel,
+		TargetID:       job.TargetID,
+		Attempts:       job.Attempt,
+		LastError:      truncateError(cause),
+		Locale:         job.Locale,
+		Payload:        job.Notification,
+		CreatedAt:      time.Now(),
+	}
+	if err := s.repo.CreateDeadLetter(deadLetter); err != nil {
+		log.Error("Failed to store dead letter", zap.Error(err))
+	}
+
+	log.Warn("Delivery moved to dead-letter table",
+		zap.String("delivery.channel", string(job.Channel)),
+		zap.String("notification.id", job.Notification.ID.String()),
+		zap.String("delivery.target_id", job.TargetID.String()),
+		zap.Int("delivery.attempts", job.Attempt),
+		zap.Error(cause))
+	if s.metrics != nil {
+		s.metrics.RecordDeadLetter(string(job.Channel))
+	}
+	s.updateStatus(ctx, job, domain.DeliveryStateFailed, cause)
+}
+
+// updateStatus records the channel state on the notification.
+func (s *DeliveryService) updateStatus(ctx context.Context, job *domain.DeliveryJob, state domain.DeliveryState, cause error) {
+	delivery := domain.ChannelDelivery{
+		Status:    state,
+		Attempts:  job.Attempt,
+		UpdatedAt: time.Now(),
+	}
+	if cause != nil {
+		delivery.LastError = *truncateError(cause)
+	}
+	if err := s.repo.UpdateChannelStatus(job.Notification.ID, job.Channel, delivery); err != nil {
+		s.log(ctx).Warn("Failed to update delivery status", zap.Error(err))
+	}
+}
+
+// ListDeadLetters returns the newest dead letters, excluding redriven ones unless requested.
+func (s *DeliveryService) ListDeadLetters(ctx context.Context, filter *domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	if filter.Channel != "" && !filter.Channel.IsValid() {
+		return nil, response.NewValidationError("invalid delivery channel", string(filter.Channel))
+	}
+	if filter.Limit <= 0 || filter.Limit > 200 {
+		filter.Limit = 50
+	}
+
+	deadLetters, err := s.repo.ListDeadLetters(filter)
+	if err != nil {
+		s.log(ctx).Error("ListDeadLetters failed", zap.Error(err))
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// Redrive delivers a dead letter again with a fresh retry budget.
+func (s *DeliveryService) Redrive(ctx context.Context, id uuid.UUID) error {
+	log := s.log(ctx)
+
+	deadLetter, err := s.repo.GetDeadLetter(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("dead letter not found", "")
+		}
+		log.Error("Failed to load dead letter", zap.Error(err))
+		return err
+	}
+
+	sender, ok := s.senders[deadLetter.Channel]
+	if !ok {
+		return response.NewValidationError("delivery channel is not enabled", string(deadLetter.Channel))
+	}
+
+	marked, err := s.repo.MarkRedriven(id)
+	if err != nil {
+		log.Error("Failed to mark dead letter redriven", zap.Error(err))
+		return err
+	}
+	if !marked {
+		return response.NewValidationError("dead letter was already redriven", "")
+	}
+
+	job := &domain.DeliveryJob{
+		ID:           uuid.New(),
+		Channel:      deadLetter.Channel,
+		TargetID:     deadLetter.TargetID,
+		Locale:       deadLetter.Locale,
+		Notification: deadLetter.Payload,
+	}
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.attempt(context.WithoutCancel(ctx), sender, job)
+
+	log.Info("Dead letter redriven",
+		zap.String("dead_letter.id", id.String()),
+		zap.String("delivery.channel", string(deadLetter.Channel)))
+	return nil
+}
+
+func (s *DeliveryService) baseDelay() time.Duration {
+	return time.Duration(s.config.RetryBaseDelay) * time.Second
+}
+
+func (s *DeliveryService) maxDelay() time.Duration {
+	return time.Duration(s.config.RetryMaxDelay) * time.Second
+}
+
+// retryBackoff returns the delay before the next attempt: base * 2^(attempt-1), capped at limit.
+func retryBackoff(attempt int, base, limit time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= limit {
+			return limit
+		}
+	}
+	if delay > limit {
+		return limit
+	}
+	return delay
+}
+
+// withJitter spreads retries by up to 20% so failures of one provider do not retry in lockstep.
+func withJitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	return delay + time.Duration(rand.Int64N(int64(delay)/5+1))
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// eventIngestKeyPrefix marks stream messages that were already turned into notifications.
+	eventIngestKeyPrefix = "noti:ingest:"
+	// eventFetchWait is how long a pull request waits for new events.
+	eventFetchWait = 5 * time.Second
+	// eventAckWait is how long the server waits for an ack before redelivering an event.
+	eventAckWait = 30 * time.Second
+	// eventSetupTimeout bounds creating the stream and consumer on connect.
+	eventSetupTimeout = 10 * time.Second
+	// eventReconnectMaxDelay bounds the wait between reconnect attempts.
+	eventReconnectMaxDelay = 30 * time.Second
+)
+
+// Ingestion results recorded in metrics.
+const (
+	ingestCreated   = "created"
+	ingestDuplicate = "duplicate"
+	ingestInvalid   = "invalid"
+	ingestFailed    = "failed"
+)
+
+// notificationCreator creates notifications from events (NotificationService).
+type notificationCreator interface {
+	CreateNotification(ctx context.Context, event *domain.NotificationEvent) (*domain.Notification, error)
+}
+
+// EventConsumer ingests notification events published to NATS JetStream by other services.
+// 모든 레플리카가 같은 durable 컨슈머에서 이벤트를 나눠 가져가며, 처리에 실패한 이벤트는
+// ACK하지 않고 지연 후 재전달받으므로 알림 서비스가 내려가 있어도 이벤트가 유실되지 않습니다.
+type EventConsumer struct {
+	notifications notificationCreator
+	redis         *redis.Client
+	config        config.EventsConfig
+	logger        *zap.Logger
+	metrics       *metrics.Metrics
+}
+
+// NewEventConsumer creates a new EventConsumer with the given dependencies.
+func NewEventConsumer(
+	notifications *NotificationService,
+	redis *redis.Client,
+	cfg config.EventsConfig,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) *EventConsumer {
+	return &EventConsumer{
+		notifications: notifications,
+		redis:         redis,
+		config:        cfg,
+		logger:        logger,
+		metrics:       m,
+	}
+}
+
+// log returns a logger with trace context
+func (c *EventConsumer) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, c.logger)
+}
+
+// Start consumes events until the context is cancelled, reconnecting with backoff.
+func (c *EventConsumer) Start(ctx context.Context) {
+	delay := time.Second
+	for {
+		connected, err := c.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = time.Second
+		}
+		c.logger.Warn("Event consumer disconnected, reconnecting",
+			zap.Error(err),
+			zap.Duration("retry_in", delay))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, eventReconnectMaxDelay)
+	}
+}
+
+// run connects, ensures the stream and consumer exist and processes events until fetching fails.
+func (c *EventConsumer) run(ctx context.Context) (bool, error) {
+	conn, err := messaging.Connect(messaging.Config{URL: c.config.NATSURL, Name: "noti-service"})
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	consumer, err := c.consumer(ctx, conn)
+	if err != nil {
+		return false, err
+	}
+
+	c.logger.Info("Event consumer started",
+		zap.String("stream", c.config.Stream),
+		zap.String("subject", c.config.Subject),
+		zap.String("durable", c.config.Durable))
+
+	for {
+		msgs, err := messaging.Fetch(consumer, c.config.BatchSize, eventFetchWait)
+		for _, msg := range msgs {
+			c.handle(ctx, msg)
+		}
+		if ctx.Err() != nil {
+			return true, ctx.Err()
+		}
+		if err != nil {
+			return true, err
+		}
+	}
+}
+
+// consumer ensures the notification stream and the durable pull consumer exist.
+func (c *EventConsumer) consumer(ctx context.Context, conn *nats.Conn) (jetstream.Consumer, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, eventSetupTimeout)
+	defer cancel()
+	if err := messaging.EnsureStream(ctx, js, jetstream.StreamConfig{
+		Name:       c.config.Stream,
+		Subjects:   []string{c.config.Subject},
+		MaxAge:     7 * 24 * time.Hour,
+		Duplicates: 2 * time.Minute,
+	}); err != nil {
+		return nil, err
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, c.config.Stream, jetstream.ConsumerConfig{
+		Durable:       c.config.Durable,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       eventAckWait,
+		MaxDeliver:    c.config.MaxDeliver,
+		FilterSubject: c.config.Subject,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure consumer %s: %w", c.config.Durable, err)
+	}
+	return consumer, nil
+}
+
+// handle turns one stream message into a notification and acknowledges it.
+// 형식이 잘못된 이벤트는 재전달해도 성공할 수 없으므로 종료(TERM)합니다.
+func (c *EventConsumer) handle(ctx context.Context, msg *messaging.Msg) string {
+	ctx, span := commnotel.StartSpan(messaging.TraceContext(ctx, msg), "noti-service", "notification.ingest")
+	defer span.End()
+	log := c.log(ctx)
+
+	result := c.process(ctx, msg)
+	if c.metrics != nil {
+		c.metrics.RecordEventIngested(result)
+	}
+
+	var err error
+	switch result {
+	case ingestInvalid:
+		err = msg.Term()
+	case ingestFailed:
+		err = msg.Nak(time.Duration(c.config.RetryDelay) * time.Second)
+	default:
+		err = msg.Ack()
+	}
+	if err != nil {
+		// ACK를 보내지 못하면 AckWait 후 재전달되고 처리 여부 키로 중복이 걸러짐
+		log.Warn("Failed to acknowledge event", zap.String("result", result), zap.Error(err))
+	}
+	return result
+}
+
+// process decodes, deduplicates and creates the notification of a message.
+func (c *EventConsumer) process(ctx context.Context, msg *messaging.Msg) string {
+	log := c.log(ctx)
+
+	var event domain.NotificationEvent
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		log.Warn("Dropping malformed event", zap.String("subject", msg.Subject), zap.Error(err))
+		return ingestInvalid
+	}
+	if err := binding.Validator.ValidateStruct(&event); err != nil {
+		log.Warn("Dropping invalid event", zap.String("subject", msg.Subject), zap.Error(err))
+		return ingestInvalid
+	}
+
+	// 처리 후 ACK 전에 재시작된 경우의 재전달은 스트림 시퀀스로 걸러냄
+	key := ""
+	if meta, err := msg.Metadata(); err == nil {
+		key = fmt.Sprintf("%s%s:%d", eventIngestKeyPrefix, meta.Stream, meta.StreamSeq)
+		if meta.NumDelivered > 1 && c.redis != nil {
+			if exists, err := c.redis.Exists(ctx, key).Result(); err == nil && exists > 0 {
+				log.Debug("Skipping already ingested event", zap.Uint64("seq", meta.StreamSeq))
+				return ingestDuplicate
+			}
+		}
+	}
+
+	if _, err := c.notifications.CreateNotification(ctx, &event); err != nil {
+		log.Error("Failed to create notification from event, will retry",
+			zap.String("notification.type", string(event.Type)),
+			zap.Error(err))
+		return ingestFailed
+	}
+
+	if key != "" && c.redis != nil {
+		ttl := time.Duration(c.config.DedupWindow) * time.Second
+		if err := c.redis.Set(ctx, key, 1, ttl).Err(); err != nil {
+			log.Warn("Failed to mark event as ingested", zap.Error(err))
+		}
+	}
+	return ingestCreated
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"noti-service/internal/domain"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/contract"
+)
+
+// recordingCreator records events instead of creating notifications
+type recordingCreator struct {
+	events []*domain.NotificationEvent
+}
+
+func (r *recordingCreator) CreateNotification(ctx context.Context, event *domain.NotificationEvent) (*domain.Notification, error) {
+	r.events = append(r.events, event)
+	return &domain.Notification{}, nil
+}
+
+// TestEventConsumerContract는 board-service가 발행하는 알림 이벤트(계약의 messages)를
+// EventConsumer가 버리지 않고 알림으로 만드는지 확인합니다.
+func TestEventConsumerContract(t *testing.T) {
+	c := contract.Load(t, "board-service", "noti-service")
+	if len(c.Messages) == 0 {
+		t.Fatal("contract has no messages")
+	}
+
+	for _, message := range c.Messages {
+		t.Run(message.Description, func(t *testing.T) {
+			creator := &recordingCreator{}
+			consumer := &EventConsumer{notifications: creator, logger: zap.NewNop()}
+
+			result := consumer.process(context.Background(), &messaging.Msg{Subject: message.Subject, Data: message.Body})
+			if result != ingestCreated {
+				t.Fatalf("process() = %s, want %s", result, ingestCreated)
+			}
+			if len(creator.events) != 1 {
+				t.Fatalf("expected one notification, got %d", len(creator.events))
+			}
+			if event := creator.events[0]; !event.Type.IsValid() {
+				t.Errorf("notification type %q is not supported", event.Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,613 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"noti-service/internal/client"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/messenger"
+	"noti-service/internal/metrics"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// integrationSendTimeout bounds a single delivery to Slack/Teams.
+	integrationSendTimeout = 10 * time.Second
+	// slackOAuthStateTTL bounds how long an OAuth install may take.
+	slackOAuthStateTTL = 10 * time.Minute
+)
+
+// Slack 채널 ID는 공개(C) 또는 비공개(G) 채널 접두사로 시작
+var slackChannelPattern = regexp.MustCompile(`^[CG][A-Z0-9]{6,30}$`)
+
+// IntegrationService manages Slack/Teams connections of workspaces and delivers notifications to them.
+// 연동 관리는 워크스페이스 OWNER/ADMIN만 가능하며, 전송은 타입별 라우팅 규칙을 따릅니다.
+type IntegrationService struct {
+	repo       *repository.IntegrationRepository
+	redis      *redis.Client
+	users      client.UserClient
+	slack      *messenger.SlackClient
+	teams      *messenger.TeamsClient
+	templates  *templates.Renderer
+	deliveries *DeliveryService
+	config     config.IntegrationConfig
+	logger     *zap.Logger
+	metrics    *metrics.Metrics
+}
+
+// NewIntegrationService creates a new IntegrationService with the given dependencies.
+func NewIntegrationService(
+	repo *repository.IntegrationRepository,
+	redis *redis.Client,
+	users client.UserClient,
+	cfg config.IntegrationConfig,
+	renderer *templates.Renderer,
+	deliveries *DeliveryService,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) *IntegrationService {
+	return &IntegrationService{
+		repo:       repo,
+		redis:      redis,
+		users:      users,
+		slack:      messenger.NewSlackClient(cfg.SlackClientID, cfg.SlackClientSecret, integrationSendTimeout),
+		teams:      messenger.NewTeamsClient(integrationSendTimeout),
+		templates:  renderer,
+		deliveries: deliveries,
+		config:     cfg,
+		logger:     logger,
+		metrics:    m,
+	}
+}
+
+// log returns a trace-context aware logger
+func (s *IntegrationService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// SlackOAuthEnabled reports whether Slack app installs are configured.
+func (s *IntegrationService) SlackOAuthEnabled() bool {
+	return s.slack.OAuthEnabled() && s.config.SlackRedirectURL != ""
+}
+
+// requireAdmin checks that the user is an owner or admin of the workspace.
+func (s *IntegrationService) requireAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) error {
+	return requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token)
+}
+
+// requireWorkspaceAdmin checks with user-service that the user is an owner or admin of the workspace.
+func requireWorkspaceAdmin(ctx context.Context, users client.UserClient, logger *zap.Logger, workspaceID, userID uuid.UUID, token string) error {
+	role, err := users.GetWorkspaceRole(ctx, workspaceID, userID, token)
+	if err != nil {
+		logger.Error("Failed to verify workspace role", zap.Error(err))
+		return response.NewInternalError("failed to verify workspace role", "")
+	}
+	if role == "" {
+		return response.ErrNotWorkspaceMember
+	}
+	if role != client.WorkspaceRoleOwner && role != client.WorkspaceRoleAdmin {
+		return response.NewForbiddenError("workspace admin permission required", "")
+	}
+	return nil
+}
+
+// ListIntegrations returns the integrations of a workspace.
+func (s *IntegrationService) ListIntegrations(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]domain.WorkspaceIntegration, error) {
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	integrations, err := s.repo.GetByWorkspace(workspaceID)
+	if err != nil {
+		s.log(ctx).Error("ListIntegrations failed", zap.Error(err))
+		return nil, err
+	}
+	return integrations, nil
+}
+
+// CreateIntegration connects a Slack or Teams incoming webhook to a workspace.
+func (s *IntegrationService) CreateIntegration(ctx context.Context, workspaceID, userID uuid.UUID, token string, req *domain.CreateIntegrationRequest) (*domain.WorkspaceIntegration, error) {
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+	if !req.Provider.IsValid() {
+		return nil, response.NewValidationError("invalid integration provider", string(req.Provider))
+	}
+	if err := validateWebhookURL(req.Provider, req.WebhookURL); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	integration := &domain.WorkspaceIntegration{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Provider:    req.Provider,
+		AuthType:    domain.IntegrationAuthWebhook,
+		Name:        req.Name,
+		WebhookURL:  req.WebhookURL,
+		Enabled:     true,
+		CreatedBy:   userID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	routes, err := buildIntegrationRoutes(integration, req.Routes)
+	if err != nil {
+		return nil, err
+	}
+	integration.Routes = routes
+
+	if err := s.repo.Create(integration); err != nil {
+		s.log(ctx).Error("CreateIntegration failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Workspace integration connected",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("integration.id", integration.ID.String()),
+		zap.String("integration.provider", string(integration.Provider)))
+	return integration, nil
+}
+
+// UpdateIntegration renames, re-points or enables/disables an integration.
+// 다시 활성화하면 실패 횟수와 마지막 오류를 초기화합니다.
+func (s *IntegrationService) UpdateIntegration(ctx context.Context, id, workspaceID, userID uuid.UUID, token string, req *domain.UpdateIntegrationRequest) (*domain.WorkspaceIntegration, error) {
+	integration, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, response.NewValidationError("name must not be empty", "")
+		}
+		integration.Name = *req.Name
+	}
+	if req.WebhookURL != nil {
+		if integration.AuthType != domain.IntegrationAuthWebhook {
+			return nil, response.NewValidationError("webhook url can only be changed on webhook integrations", "")
+		}
+		if err := validateWebhookURL(integration.Provider, *req.WebhookURL); err != nil {
+			return nil, err
+		}
+		integration.WebhookURL = *req.WebhookURL
+	}
+	if req.Enabled != nil {
+		if *req.Enabled && !integration.Enabled {
+			integration.FailureCount = 0
+			integration.LastError = nil
+		}
+		integration.Enabled = *req.Enabled
+	}
+
+	if err := s.repo.Update(integration); err != nil {
+		s.log(ctx).Error("UpdateIntegration failed", zap.Error(err))
+		return nil, err
+	}
+	return integration, nil
+}
+
+// UpdateRoutes replaces the per-type routing rules of an integration.
+func (s *IntegrationService) UpdateRoutes(ctx context.Context, id, workspaceID, userID uuid.UUID, token string, req *domain.UpdateIntegrationRoutesRequest) (*domain.WorkspaceIntegration, error) {
+	integration, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 빈 목록은 "전송 안 함"이므로 기본 라우팅으로 채우지 않음
+	routes := []domain.IntegrationRoute{}
+	if len(req.Routes) > 0 {
+		if routes, err = buildIntegrationRoutes(integration, req.Routes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.ReplaceRoutes(integration.ID, routes); err != nil {
+		s.log(ctx).Error("UpdateRoutes failed", zap.Error(err))
+		return nil, err
+	}
+	integration.Routes = routes
+
+	s.log(ctx).Info("Integration routes updated",
+		zap.String("integration.id", integration.ID.String()),
+		zap.Int("route.count", len(routes)))
+	return integration, nil
+}
+
+// DeleteIntegration disconnects an integration from a workspace.
+func (s *IntegrationService) DeleteIntegration(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) error {
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.Delete(id, workspaceID)
+	if err != nil {
+		s.log(ctx).Error("DeleteIntegration failed", zap.Error(err))
+		return err
+	}
+	if deleted == 0 {
+		return response.NewNotFoundError("integration not found", "")
+	}
+
+	s.log(ctx).Info("Workspace integration disconnected",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("integration.id", id.String()))
+	return nil
+}
+
+// TestIntegration posts a test message so admins can verify the connection.
+func (s *IntegrationService) TestIntegration(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) error {
+	integration, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return err
+	}
+
+	msg := &messenger.Message{
+		Title:  "weAlist 알림 연동 테스트",
+		Text:   fmt.Sprintf("'%s' 연동이 정상적으로 설정되었습니다.", integration.Name),
+		Link:   s.workspaceLink(workspaceID),
+		Footer: "weAlist",
+	}
+	if err := s.post(ctx, integration, integration.ChannelID, msg); err != nil {
+		s.log(ctx).Warn("Integration test delivery failed",
+			zap.String("integration.id", integration.ID.String()),
+			zap.Error(err))
+		return response.NewValidationError("test message delivery failed", err.Error())
+	}
+	return nil
+}
+
+// SlackAuthorizeURL starts a Slack app install and returns the consent page URL.
+// state는 Redis에 워크스페이스/사용자와 함께 저장되어 콜백에서 한 번만 사용됩니다.
+func (s *IntegrationService) SlackAuthorizeURL(ctx context.Context, workspaceID, userID uuid.UUID, token string) (string, error) {
+	if !s.SlackOAuthEnabled() {
+		return "", response.NewValidationError("slack app install is not configured", "")
+	}
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return "", err
+	}
+	if s.redis == nil {
+		return "", response.NewInternalError("oauth state store unavailable", "")
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	state := base64.RawURLEncoding.EncodeToString(buf)
+
+	value := workspaceID.String() + ":" + userID.String()
+	if err := s.redis.Set(ctx, slackOAuthStateKey(state), value, slackOAuthStateTTL).Err(); err != nil {
+		s.log(ctx).Error("Failed to store OAuth state", zap.Error(err))
+		return "", err
+	}
+	return s.slack.AuthorizeURL(s.config.SlackRedirectURL, state), nil
+}
+
+// CompleteSlackOAuth exchanges the authorization code and stores the installation.
+func (s *IntegrationService) CompleteSlackOAuth(ctx context.Context, workspaceID, userID uuid.UUID, token string, req *domain.SlackOAuthCallbackRequest) (*domain.WorkspaceIntegration, error) {
+	if !s.SlackOAuthEnabled() {
+		return nil, response.NewValidationError("slack app install is not configured", "")
+	}
+	if s.redis == nil {
+		return nil, response.NewInternalError("oauth state store unavailable", "")
+	}
+
+	value, err := s.redis.GetDel(ctx, slackOAuthStateKey(req.State)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		s.log(ctx).Error("Failed to load OAuth state", zap.Error(err))
+		return nil, err
+	}
+	// 다른 워크스페이스/사용자가 시작한 설치는 완료할 수 없음
+	if value != workspaceID.String()+":"+userID.String() {
+		return nil, response.NewValidationError("invalid or expired oauth state", "")
+	}
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	result, err := s.slack.ExchangeCode(ctx, req.Code, s.config.SlackRedirectURL)
+	if err != nil {
+		s.log(ctx).Warn("Slack OAuth code exchange failed", zap.Error(err))
+		return nil, response.NewValidationError("slack authorization failed", err.Error())
+	}
+
+	now := time.Now()
+	integration := &domain.WorkspaceIntegration{
+		ID:             uuid.New(),
+		WorkspaceID:    workspaceID,
+		Provider:       domain.IntegrationProviderSlack,
+		AuthType:       domain.IntegrationAuthOAuth,
+		Name:           result.TeamName,
+		AccessToken:    result.AccessToken,
+		ChannelID:      result.ChannelID,
+		ChannelName:    result.ChannelName,
+		ExternalTeamID: result.TeamID,
+		Enabled:        true,
+		CreatedBy:      userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if integration.Name == "" {
+		integration.Name = "Slack"
+	}
+	if integration.Routes, err = buildIntegrationRoutes(integration, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(integration); err != nil {
+		s.log(ctx).Error("CompleteSlackOAuth failed to save", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Slack app installed for workspace",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("integration.id", integration.ID.String()),
+		zap.String("slack.team.id", result.TeamID))
+	return integration, nil
+}
+
+// Dispatch delivers a notification to the integrations that route its type. Deliveries run in the background.
+// 한 이벤트가 여러 사용자에게 알림으로 생성되므로 중복 창 안에서는 채널에 한 번만 게시합니다.
+func (s *IntegrationService) Dispatch(ctx context.Context, notification *domain.Notification) {
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.deliver(context.WithoutCancel(ctx), notification)
+}
+
+// DeliveryChannel implements TargetSender.
+func (s *IntegrationService) DeliveryChannel() domain.DeliveryChannel {
+	return domain.DeliveryChannelIntegration
+}
+
+// deliver posts a notification to every routed integration of its workspace.
+func (s *IntegrationService) deliver(ctx context.Context, notification *domain.Notification) {
+	integrations, err := s.repo.GetRoutedForType(notification.WorkspaceID, notification.Type)
+	if err != nil {
+		s.log(ctx).Error("Failed to load workspace integrations", zap.Error(err))
+		return
+	}
+
+	var targets []uuid.UUID
+	for i := range integrations {
+		if s.claimDelivery(ctx, integrations[i].ID, notification) {
+			targets = append(targets, integrations[i].ID)
+		}
+	}
+	s.deliveries.Submit(ctx, s, notification, targets)
+}
+
+// DeliverTo implements TargetSender. It posts a notification to one integration if it still routes the type.
+func (s *IntegrationService) DeliverTo(ctx context.Context, notification *domain.Notification, integrationID uuid.UUID) error {
+	log := s.log(ctx)
+
+	integration, err := s.repo.GetRoutedByID(integrationID, notification.Type)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 재시도 사이에 연동이 비활성화/삭제되었거나 라우팅에서 빠진 경우
+			return ErrTargetGone
+		}
+		return err
+	}
+
+	channelID := integration.ChannelID
+	if len(integration.Routes) > 0 && integration.Routes[0].ChannelID != "" {
+		channelID = integration.Routes[0].ChannelID
+	}
+
+	msg := buildIntegrationMessage(notification, s.templates, s.workspaceLink(notification.WorkspaceID))
+	err = s.post(ctx, integration, channelID, msg)
+	revoked := errors.Is(err, messenger.ErrRevoked)
+	result := "sent"
+	switch {
+	case err == nil:
+	case revoked:
+		result = "revoked"
+		log.Info("Integration disabled: provider revoked access",
+			zap.String("integration.id", integration.ID.String()),
+			zap.Error(err))
+	default:
+		result = "failed"
+		log.Warn("Integration delivery failed",
+			zap.String("integration.id", integration.ID.String()),
+			zap.String("notification.id", notification.ID.String()),
+			zap.Error(err))
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordIntegrationDelivery(string(integration.Provider), result)
+	}
+	var deliveryErr *string
+	if err != nil {
+		deliveryErr = truncateError(err)
+	}
+	if err := s.repo.RecordDelivery(integration.ID, deliveryErr, revoked); err != nil {
+		log.Warn("Failed to record integration delivery", zap.Error(err))
+	}
+
+	var providerErr *messenger.DeliveryError
+	switch {
+	case revoked:
+		return fmt.Errorf("%w: %v", ErrTargetGone, err)
+	case errors.As(err, &providerErr) && isPermanentStatus(providerErr.StatusCode):
+		return Permanent(err)
+	}
+	return err
+}
+
+// claimDelivery returns false if the same event was already posted to the integration within the dedup window.
+func (s *IntegrationService) claimDelivery(ctx context.Context, integrationID uuid.UUID, notification *domain.Notification) bool {
+	if s.redis == nil || s.config.DedupWindow <= 0 {
+		return true
+	}
+
+	key := fmt.Sprintf("noti:integration:sent:%s:%s:%s:%s",
+		integrationID, notification.Type, notification.ResourceID, notification.ActorID)
+	ok, err := s.redis.SetNX(ctx, key, notification.ID.String(), time.Duration(s.config.DedupWindow)*time.Second).Result()
+	if err != nil {
+		// Redis 장애 시에는 중복 게시가 유실보다 낫다
+		s.log(ctx).Warn("Integration dedup check failed", zap.Error(err))
+		return true
+	}
+	return ok
+}
+
+// post sends a message through the provider of an integration.
+func (s *IntegrationService) post(ctx context.Context, integration *domain.WorkspaceIntegration, channelID string, msg *messenger.Message) error {
+	sendCtx, cancel := context.WithTimeout(ctx, integrationSendTimeout)
+	defer cancel()
+
+	switch {
+	case integration.Provider == domain.IntegrationProviderSlack && integration.AuthType == domain.IntegrationAuthOAuth:
+		return s.slack.PostMessage(sendCtx, integration.AccessToken, channelID, msg)
+	case integration.Provider == domain.IntegrationProviderSlack:
+		return s.slack.PostWebhook(sendCtx, integration.WebhookURL, msg)
+	case integration.Provider == domain.IntegrationProviderTeams:
+		return s.teams.PostWebhook(sendCtx, integration.WebhookURL, msg)
+	default:
+		return fmt.Errorf("unsupported integration provider: %s", integration.Provider)
+	}
+}
+
+// getForAdmin loads an integration after checking workspace admin permission.
+func (s *IntegrationService) getForAdmin(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) (*domain.WorkspaceIntegration, error) {
+	if err := s.requireAdmin(ctx, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	integration, err := s.repo.GetByID(id, workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("integration not found", "")
+		}
+		s.log(ctx).Error("Failed to load integration", zap.Error(err))
+		return nil, err
+	}
+	return integration, nil
+}
+
+// workspaceLink returns the weAlist URL of a workspace, or "" if no app URL is configured.
+func (s *IntegrationService) workspaceLink(workspaceID uuid.UUID) string {
+	if s.config.AppURL == "" {
+		return ""
+	}
+	return strings.TrimRight(s.config.AppURL, "/") + "/workspace/" + workspaceID.String()
+}
+
+// buildIntegrationRoutes validates routing rules, using the default types when none are given.
+func buildIntegrationRoutes(integration *domain.WorkspaceIntegration, reqs []domain.IntegrationRouteRequest) ([]domain.IntegrationRoute, error) {
+	if len(reqs) == 0 {
+		for _, t := range domain.DefaultIntegrationTypes {
+			reqs = append(reqs, domain.IntegrationRouteRequest{Type: t})
+		}
+	}
+
+	seen := make(map[domain.NotificationType]bool)
+	routes := make([]domain.IntegrationRoute, 0, len(reqs))
+	for _, req := range reqs {
+		if !req.Type.IsValid() {
+			return nil, response.ErrInvalidNotificationType
+		}
+		if seen[req.Type] {
+			return nil, response.NewValidationError("duplicate routing rule", string(req.Type))
+		}
+		seen[req.Type] = true
+
+		if req.ChannelID != "" {
+			// 웹훅은 생성 시 채널이 고정되므로 타입별 채널은 OAuth 연동만 지원
+			if integration.AuthType != domain.IntegrationAuthOAuth {
+				return nil, response.NewValidationError("channel routing requires an installed Slack app", string(req.Type))
+			}
+			if !slackChannelPattern.MatchString(req.ChannelID) {
+				return nil, response.NewValidationError("invalid slack channel id", req.ChannelID)
+			}
+		}
+
+		routes = append(routes, domain.IntegrationRoute{
+			ID:            uuid.New(),
+			IntegrationID: integration.ID,
+			Type:          req.Type,
+			ChannelID:     req.ChannelID,
+			CreatedAt:     time.Now(),
+		})
+	}
+	return routes, nil
+}
+
+// validateWebhookURL checks that a webhook URL belongs to the provider.
+func validateWebhookURL(provider domain.IntegrationProvider, webhookURL string) error {
+	var err error
+	switch provider {
+	case domain.IntegrationProviderSlack:
+		err = messenger.ValidateSlackWebhookURL(webhookURL)
+	case domain.IntegrationProviderTeams:
+		err = messenger.ValidateTeamsWebhookURL(webhookURL)
+	default:
+		return response.NewValidationError("invalid integration provider", string(provider))
+	}
+	if err != nil {
+		return response.NewValidationError("invalid webhook url", string(provider))
+	}
+	return nil
+}
+
+// buildIntegrationMessage converts a notification into a channel message.
+// 채널 구성원 모두가 읽는 메시지이므로 수신자 언어가 아닌 기본 언어로 렌더링합니다.
+func buildIntegrationMessage(notification *domain.Notification, renderer *templates.Renderer, link string) *messenger.Message {
+	rendered := renderer.Render(renderer.DefaultLocale(), string(notification.Type), templates.ChannelIntegration, templateData(notification))
+
+	msg := &messenger.Message{
+		Title:  rendered.Title,
+		Text:   rendered.Body,
+		Link:   link,
+		Footer: "weAlist · " + notification.CreatedAt.Format("2006-01-02 15:04"),
+	}
+
+	meta := notification.Metadata
+	if project := metadataString(meta, "projectName"); project != "" {
+		msg.Fields = append(msg.Fields, messenger.Field{Name: "프로젝트", Value: project})
+	}
+	oldStatus, newStatus := metadataString(meta, "oldStatus"), metadataString(meta, "newStatus")
+	if newStatus != "" {
+		value := newStatus
+		if oldStatus != "" {
+			value = oldStatus + " → " + newStatus
+		}
+		msg.Fields = append(msg.Fields, messenger.Field{Name: "상태", Value: value})
+	}
+	if dueDate := metadataString(meta, "dueDate"); dueDate != "" {
+		msg.Fields = append(msg.Fields, messenger.Field{Name: "마감일", Value: dueDate})
+	}
+	return msg
+}
+
+// metadataString returns a string metadata value, or "" if missing.
+func metadataString(meta map[string]interface{}, key string) string {
+	if meta == nil {
+		return ""
+	}
+	value, ok := meta[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+// slackOAuthStateKey returns the Redis key of a pending Slack install.
+func slackOAuthStateKey(state string) string {
+	return "noti:integration:oauth:" + state
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"noti-service/internal/mobilepush"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// mobilePushSendTimeout bounds a single delivery to FCM/APNs.
+const mobilePushSendTimeout = 10 * time.Second
+
+var (
+	// APNs 디바이스 토큰은 16진수 문자열
+	apnsTokenPattern = regexp.MustCompile(`^[0-9a-fA-F]{64,200}$`)
+	// FCM 등록 토큰은 URL-safe 문자와 콜론으로 구성
+	fcmTokenPattern = regexp.MustCompile(`^[A-Za-z0-9_:\-]{32,512}$`)
+)
+
+// MobilePushService delivers notifications to registered iOS/Android devices.
+// 디바이스별 전송 결과를 기록하고, 공급자가 무효라고 응답한 토큰은 비활성화합니다.
+type MobilePushService struct {
+	repo       *repository.DeviceRepository
+	providers  map[domain.DevicePlatform]mobilepush.Provider
+	types      map[domain.NotificationType]bool
+	templates  *templates.Renderer
+	deliveries *DeliveryService
+	logger     *zap.Logger
+	metrics    *metrics.Metrics
+}
+
+// NewMobilePushService creates a new MobilePushService with providers for the configured platforms.
+func NewMobilePushService(
+	repo *repository.DeviceRepository,
+	cfg config.MobilePushConfig,
+	renderer *templates.Renderer,
+	deliveries *DeliveryService,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) (*MobilePushService, error) {
+	providers := make(map[domain.DevicePlatform]mobilepush.Provider)
+
+	if cfg.FCMCredentialsFile != "" {
+		fcm, err := mobilepush.NewFCMClientFromFile(cfg.FCMCredentialsFile, mobilePushSendTimeout)
+		if err != nil {
+			return nil, err
+		}
+		providers[domain.DevicePlatformAndroid] = fcm
+	}
+	if cfg.APNsKeyFile != "" {
+		apns, err := mobilepush.NewAPNsClient(mobilepush.APNsConfig{
+			KeyFile:    cfg.APNsKeyFile,
+			KeyID:      cfg.APNsKeyID,
+			TeamID:     cfg.APNsTeamID,
+			BundleID:   cfg.APNsBundleID,
+			Production: cfg.APNsProduction,
+		}, mobilePushSendTimeout)
+		if err != nil {
+			return nil, err
+		}
+		providers[domain.DevicePlatformIOS] = apns
+	}
+	if len(providers) == 0 {
+		return nil, errors.New("no mobile push provider configured")
+	}
+
+	types := make(map[domain.NotificationType]bool)
+	if len(cfg.Types) > 0 {
+		for _, t := range cfg.Types {
+			notificationType := domain.NotificationType(t)
+			if !notificationType.IsValid() {
+				return nil, fmt.Errorf("invalid mobile push type: %s", t)
+			}
+			types[notificationType] = true
+		}
+	} else {
+		for _, t := range domain.DefaultMobilePushTypes {
+			types[t] = true
+		}
+	}
+
+	return &MobilePushService{
+		repo:       repo,
+		providers:  providers,
+		types:      types,
+		templates:  renderer,
+		deliveries: deliveries,
+		logger:     logger,
+		metrics:    m,
+	}, nil
+}
+
+// log returns a trace-context aware logger
+func (s *MobilePushService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// RegisterDevice registers a device token for a user.
+// 같은 토큰이 다른 사용자로 등록되어 있으면 현재 사용자로 이전하고 무효화 상태를 해제합니다.
+func (s *MobilePushService) RegisterDevice(ctx context.Context, userID uuid.UUID, req *domain.RegisterDeviceRequest) (*domain.DeviceToken, error) {
+	if err := validateDeviceToken(req.Platform, req.Token); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	device := &domain.DeviceToken{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Platform:   req.Platform,
+		Token:      req.Token,
+		DeviceName: req.DeviceName,
+		AppVersion: req.AppVersion,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.repo.Upsert(device); err != nil {
+		s.log(ctx).Error("RegisterDevice failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Device registered for push",
+		zap.String("enduser.id", userID.String()),
+		zap.String("device.platform", string(req.Platform)))
+	return device, nil
+}
+
+// UnregisterDevice removes a device token of a user.
+func (s *MobilePushService) UnregisterDevice(ctx context.Context, userID uuid.UUID, token string) error {
+	deleted, err := s.repo.Delete(userID, token)
+	if err != nil {
+		s.log(ctx).Error("UnregisterDevice failed", zap.Error(err))
+		return err
+	}
+	if deleted == 0 {
+		return response.NewNotFoundError("device not found", "")
+	}
+	return nil
+}
+
+// GetDevices returns the registered devices of a user with their delivery state.
+func (s *MobilePushService) GetDevices(ctx context.Context, userID uuid.UUID) ([]domain.DeviceToken, error) {
+	devices, err := s.repo.GetByUser(userID)
+	if err != nil {
+		s.log(ctx).Error("GetDevices failed", zap.Error(err))
+		return nil, err
+	}
+	return devices, nil
+}
+
+// Channel implements ChannelSender.
+func (s *MobilePushService) Channel() domain.NotificationChannel {
+	return domain.NotificationChannelPush
+}
+
+// Send implements ChannelSender. Deliveries run in the background.
+func (s *MobilePushService) Send(ctx context.Context, notification *domain.Notification) error {
+	if !s.types[notification.Type] {
+		return nil
+	}
+
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.deliver(context.WithoutCancel(ctx), notification)
+	return nil
+}
+
+// DeliveryChannel implements TargetSender.
+func (s *MobilePushService) DeliveryChannel() domain.DeliveryChannel {
+	return domain.DeliveryChannelMobilePush
+}
+
+// deliver sends a notification to every active device of the target user.
+func (s *MobilePushService) deliver(ctx context.Context, notification *domain.Notification) {
+	devices, err := s.repo.GetActiveByUser(notification.TargetUserID)
+	if err != nil {
+		s.log(ctx).Error("Failed to load devices", zap.Error(err))
+		return
+	}
+
+	targets := make([]uuid.UUID, 0, len(devices))
+	for _, device := range devices {
+		// 자격 증명이 없는 플랫폼의 토큰은 등록만 되어 있으므로 전송 대상에서 제외
+		if _, ok := s.providers[device.Platform]; ok {
+			targets = append(targets, device.ID)
+		}
+	}
+	s.deliveries.Submit(ctx, s, notification, targets)
+}
+
+// DeliverTo implements TargetSender. It sends a notification to one device and records the result.
+func (s *MobilePushService) DeliverTo(ctx context.Context, notification *domain.Notification, deviceID uuid.UUID) error {
+	log := s.log(ctx)
+
+	device, err := s.repo.GetActiveByID(deviceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTargetGone
+		}
+		return err
+	}
+	provider, ok := s.providers[device.Platform]
+	if !ok {
+		return Permanent(fmt.Errorf("no push provider configured for %s", device.Platform))
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, mobilePushSendTimeout)
+	messageID, err := provider.Send(sendCtx, device.Token, buildMobileMessage(notification, s.templates))
+	cancel()
+
+	delivery := &domain.PushDelivery{
+		ID:             uuid.New(),
+		NotificationID: notification.ID,
+		DeviceTokenID:  device.ID,
+		UserID:         device.UserID,
+		Platform:       device.Platform,
+		Status:         domain.DeliveryStatusSent,
+		CreatedAt:      time.Now(),
+	}
+	var providerErr *mobilepush.ProviderError
+	result := err
+	switch {
+	case err == nil:
+		if messageID != "" {
+			delivery.ProviderMessageID = &messageID
+		}
+	case errors.Is(err, mobilepush.ErrTokenInvalid):
+		delivery.Status = domain.DeliveryStatusInvalidToken
+		delivery.Error = truncateError(err)
+		result = fmt.Errorf("%w: %v", ErrTargetGone, err)
+		log.Info("Device token invalidated",
+			zap.String("device.id", device.ID.String()),
+			zap.String("device.platform", string(device.Platform)),
+			zap.Error(err))
+	default:
+		delivery.Status = domain.DeliveryStatusFailed
+		delivery.Error = truncateError(err)
+		if errors.As(err, &providerErr) && isPermanentStatus(providerErr.StatusCode) {
+			result = Permanent(err)
+		}
+		log.Warn("Mobile push delivery failed",
+			zap.String("notification.id", notification.ID.String()),
+			zap.String("device.id", device.ID.String()),
+			zap.Error(err))
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordMobilePushDelivery(string(device.Platform), string(delivery.Status))
+	}
+	if err := s.repo.RecordDelivery(delivery); err != nil {
+		log.Warn("Failed to record push delivery", zap.Error(err))
+	}
+	return result
+}
+
+// buildMobileMessage converts a notification into a platform-independent push message.
+func buildMobileMessage(notification *domain.Notification, renderer *templates.Renderer) *mobilepush.Message {
+	rendered := renderNotification(renderer, notification, string(domain.NotificationChannelPush))
+
+	return &mobilepush.Message{
+		Title: rendered.Title,
+		Body:  rendered.Body,
+		Data: map[string]string{
+			"notificationId": notification.ID.String(),
+			"type":           string(notification.Type),
+			"workspaceId":    notification.WorkspaceID.String(),
+			"resourceType":   string(notification.ResourceType),
+			"resourceId":     notification.ResourceID.String(),
+		},
+		CollapseKey: notification.ResourceID.String(),
+		ThreadID:    notification.WorkspaceID.String(),
+	}
+}
+
+// validateDeviceToken checks the platform and token format.
+// APNs 토큰은 요청 경로에 포함되므로 형식을 엄격히 검사합니다.
+func validateDeviceToken(platform domain.DevicePlatform, token string) error {
+	switch platform {
+	case domain.DevicePlatformIOS:
+		if !apnsTokenPattern.MatchString(token) {
+			return response.NewValidationError("invalid APNs device token", "")
+		}
+	case domain.DevicePlatformAndroid:
+		if !fcmTokenPattern.MatchString(token) {
+			return response.NewValidationError("invalid FCM registration token", "")
+		}
+	default:
+		return response.NewValidationError("invalid device platform", string(platform))
+	}
+	return nil
+}
+
+// truncateError returns an error message that fits the delivery error column.
+func truncateError(err error) *string {
+	msg := err.Error()
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	return &msg
+}
@@ -6,32 +6,63 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"noti-service/internal/config"
 	"noti-service/internal/domain"
 	"noti-service/internal/metrics"
 	"noti-service/internal/repository"
 	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 )
 
+const (
+	// quietHoursHeldKey is a sorted set of deliveries held for quiet hours, scored by release time (unix ms).
+	quietHoursHeldKey = "noti:quiet:held"
+	// quietHoursPollInterval is how often each replica releases held deliveries.
+	quietHoursPollInterval = 15 * time.Second
+	// quietHoursReleaseBatch bounds the deliveries released per poll.
+	quietHoursReleaseBatch = 100
+)
+
 // NotificationService provides notification management operations.
 // It handles notification CRUD, Redis pub/sub for real-time delivery,
 // and caching for unread count optimization.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
 type NotificationService struct {
-	repo    *repository.NotificationRepository
-	redis   *redis.Client
-	config  *config.Config
-	logger  *zap.Logger
-	metrics *metrics.Metrics // 메트릭 수집을 위한 필드
+	repo        *repository.NotificationRepository
+	redis       *redis.Client
+	config      *config.Config
+	logger      *zap.Logger
+	metrics     *metrics.Metrics    // 메트릭 수집을 위한 필드
+	preferences *PreferenceService  // 사용자 수신 설정 (nil이면 모든 채널 전송)
+	templates   *templates.Renderer // 타입/채널/언어별 문구 (nil이면 렌더링 생략)
+	senders     []ChannelSender     // 이메일/푸시 등 외부 채널 전송기
+	// 워크스페이스 Slack/Teams 연동 (nil이면 비활성화)
+	integrations *IntegrationService
+	// 워크스페이스 웹훅 (nil이면 비활성화)
+	webhooks *WebhookService
+	// 플랫폼 도메인 이벤트 발행 (nil이면 비활성화)
+	events *messaging.Emitter
+}
+
+// ChannelSender delivers notifications on an external channel such as email or push.
+type ChannelSender interface {
+	Channel() domain.NotificationChannel
+	Send(ctx context.Context, notification *domain.Notification) error
 }
 
 // NewNotificationService creates a new NotificationService with the given dependencies.
@@ -42,22 +73,44 @@ func NewNotificationService(
 	config *config.Config,
 	logger *zap.Logger,
 	m *metrics.Metrics,
+	preferences *PreferenceService,
+	renderer *templates.Renderer,
+	senders ...ChannelSender,
 ) *NotificationService {
 	return &NotificationService{
-		repo:    repo,
-		redis:   redis,
-		config:  config,
-		logger:  logger,
-		metrics: m,
+		repo:        repo,
+		redis:       redis,
+		config:      config,
+		logger:      logger,
+		metrics:     m,
+		preferences: preferences,
+		templates:   renderer,
+		senders:     senders,
 	}
 }
 
+// SetIntegrations enables delivery to the Slack/Teams integrations of workspaces.
+func (s *NotificationService) SetIntegrations(integrations *IntegrationService) {
+	s.integrations = integrations
+}
+
+// SetWebhooks enables delivery to the outbound webhooks of workspaces.
+func (s *NotificationService) SetWebhooks(webhooks *WebhookService) {
+	s.webhooks = webhooks
+}
+
+// SetEvents enables publishing notification domain events to the platform event bus.
+func (s *NotificationService) SetEvents(events *messaging.Emitter) {
+	s.events = events
+}
+
 // log returns a trace-context aware logger
 func (s *NotificationService) log(ctx context.Context) *zap.Logger {
 	return commnotel.WithTraceContext(ctx, s.logger)
 }
 
 // CreateNotification creates a new notification from an event and publishes it via Redis.
+// 사용자가 인앱 알림을 끈 경우 저장/SSE 전송을 생략하고 nil을 반환합니다.
 func (s *NotificationService) CreateNotification(ctx context.Context, event *domain.NotificationEvent) (*domain.Notification, error) {
 	log := s.log(ctx)
 	log.Debug("CreateNotification service started",
@@ -75,12 +128,41 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 		ResourceName: event.ResourceName,
 		Metadata:     event.Metadata,
 		IsRead:       false,
+		GroupCount:   1,
 		CreatedAt:    time.Now(),
 	}
 
 	if event.OccurredAt != nil {
 		notification.CreatedAt = *event.OccurredAt
 	}
+	notification.Critical = event.Critical
+	// 수신자 언어는 채널별 전송기에서도 사용하므로 한 번만 조회
+	notification.Locale = s.localeFor(ctx, notification.TargetUserID)
+
+	// 외부 채널(이메일/푸시)은 인앱 설정과 무관하게 각 채널 설정에 따라 전송
+	channels := s.channelPreferences(ctx, notification)
+
+	if !channels.Enabled(domain.NotificationChannelInApp) {
+		if s.metrics != nil {
+			s.metrics.RecordNotificationSuppressed(string(domain.NotificationChannelInApp))
+		}
+		log.Debug("In-app notification suppressed by user preference",
+			zap.String("notification.type", string(notification.Type)),
+			zap.String("target.user.id", notification.TargetUserID.String()))
+		// 같은 집계 창의 후속 이벤트는 외부 채널로도 다시 보내지 않음
+		if _, grouped := s.claimGroup(ctx, notification); !grouped {
+			s.sendExternal(ctx, notification, channels)
+			s.dispatchWorkspace(ctx, notification)
+		}
+		return nil, nil
+	}
+
+	// 집계 창 안의 유사 이벤트(같은 사용자/리소스/타입)는 기존 알림에 묶음
+	if groupID, grouped := s.claimGroup(ctx, notification); grouped {
+		if existing := s.addToGroup(ctx, groupID, notification); existing != nil {
+			return existing, nil
+		}
+	}
 
 	if err := s.repo.Create(notification); err != nil {
 		log.Error("CreateNotification failed to save", zap.Error(err))
@@ -93,6 +175,9 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 	// Invalidate cache
 	s.invalidateUnreadCountCache(ctx, notification.TargetUserID, notification.WorkspaceID)
 
+	s.sendExternal(ctx, notification, channels)
+	s.dispatchWorkspace(ctx, notification)
+
 	// 메트릭 기록: 알림 생성 성공
 	if s.metrics != nil {
 		s.metrics.RecordNotificationCreated()
@@ -103,6 +188,15 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 		zap.String("notification.type", string(notification.Type)),
 		zap.String("target.user.id", notification.TargetUserID.String()))
 
+	// 발행 실패는 Emitter가 로그로 남기며 알림 생성 결과에는 영향 없음
+	_ = s.events.Emit(ctx, messaging.EventNotificationCreated, notification.WorkspaceID.String(), notification.ActorID.String(), messaging.NotificationEventData{
+		NotificationID: notification.ID.String(),
+		Type:           string(notification.Type),
+		TargetUserID:   notification.TargetUserID.String(),
+		ResourceType:   string(notification.ResourceType),
+		ResourceID:     notification.ResourceID.String(),
+	})
+
 	return notification, nil
 }
 
@@ -120,6 +214,9 @@ func (s *NotificationService) CreateBulkNotifications(ctx context.Context, event
 			log.Error("CreateBulkNotifications failed for one", zap.Error(err))
 			continue
 		}
+		if notification == nil {
+			continue
+		}
 		notifications = append(notifications, *notification)
 	}
 
@@ -151,6 +248,8 @@ func (s *NotificationService) GetNotifications(ctx context.Context, userID, work
 		zap.Int64("total", total),
 		zap.Int("fetched.count", len(notifications)))
 
+	s.localize(ctx, userID, notifications)
+
 	return &domain.PaginatedNotifications{
 		Notifications: notifications,
 		Total:         total,
@@ -160,6 +259,108 @@ func (s *NotificationService) GetNotifications(ctx context.Context, userID, work
 	}, nil
 }
 
+// GetFeed returns a cursor-paginated page of the notification inbox.
+func (s *NotificationService) GetFeed(ctx context.Context, userID uuid.UUID, filter *domain.NotificationFeedFilter) (*domain.NotificationFeed, error) {
+	log := s.log(ctx)
+
+	for _, t := range filter.Types {
+		if !t.IsValid() {
+			return nil, response.ErrInvalidNotificationType
+		}
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, response.NewValidationError("invalid date range", "from must be before to")
+	}
+
+	limit := filter.Limit
+	if limit < 1 || limit > domain.MaxFeedLimit {
+		limit = domain.DefaultFeedLimit
+	}
+
+	var cursor *domain.FeedCursor
+	if filter.Cursor != "" {
+		decoded, err := decodeFeedCursor(filter.Cursor)
+		if err != nil {
+			return nil, response.NewValidationError("invalid cursor", "")
+		}
+		cursor = decoded
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	notifications, err := s.repo.GetFeed(userID, filter, cursor, limit+1)
+	if err != nil {
+		log.Error("GetFeed failed", zap.Error(err))
+		return nil, err
+	}
+
+	feed := &domain.NotificationFeed{Notifications: notifications}
+	if len(notifications) > limit {
+		feed.Notifications = notifications[:limit]
+		feed.HasMore = true
+		last := feed.Notifications[limit-1]
+		feed.NextCursor = encodeFeedCursor(&domain.FeedCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	s.localize(ctx, userID, feed.Notifications)
+
+	return feed, nil
+}
+
+// ArchiveNotification archives or restores a notification.
+func (s *NotificationService) ArchiveNotification(ctx context.Context, id, userID uuid.UUID, archived bool) (*domain.Notification, error) {
+	log := s.log(ctx)
+
+	notification, markedRead, err := s.repo.SetArchived(id, userID, archived)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.ErrNotificationNotFound
+		}
+		log.Error("ArchiveNotification failed",
+			zap.String("notification.id", id.String()),
+			zap.Error(err))
+		return nil, err
+	}
+
+	// 읽지 않은 알림을 보관하면 읽음 처리되므로 배지 갱신
+	if markedRead {
+		s.invalidateUnreadCountCache(ctx, userID, notification.WorkspaceID)
+		s.publishReadState(ctx, userID, notification.WorkspaceID, []uuid.UUID{id}, true)
+	}
+
+	log.Info("Notification archive state updated",
+		zap.String("notification.id", id.String()),
+		zap.Bool("archived", archived))
+
+	s.localizeOne(ctx, userID, notification)
+	return notification, nil
+}
+
+// encodeFeedCursor encodes a feed position as an opaque cursor.
+func encodeFeedCursor(cursor *domain.FeedCursor) string {
+	raw := fmt.Sprintf("%d_%s", cursor.CreatedAt.UnixNano(), cursor.ID.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeFeedCursor decodes a cursor created by encodeFeedCursor.
+func decodeFeedCursor(value string) (*domain.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	nanos, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return nil, errors.New("malformed cursor")
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.FeedCursor{CreatedAt: time.Unix(0, unixNano), ID: cursorID}, nil
+}
+
 // GetNotificationByID retrieves a single notification by ID for a specific user.
 // userID를 함께 조회하여 소유권을 검증합니다.
 func (s *NotificationService) GetNotificationByID(ctx context.Context, id, userID uuid.UUID) (*domain.Notification, error) {
@@ -178,6 +379,7 @@ func (s *NotificationService) GetNotificationByID(ctx context.Context, id, userI
 	}
 
 	log.Debug("GetNotificationByID completed", zap.String("notification.id", id.String()))
+	s.localizeOne(ctx, userID, notification)
 	return notification, nil
 }
 
@@ -201,6 +403,7 @@ func (s *NotificationService) MarkAsRead(ctx context.Context, id, userID uuid.UU
 
 	// Invalidate cache
 	s.invalidateUnreadCountCache(ctx, notification.TargetUserID, notification.WorkspaceID)
+	s.publishReadState(ctx, userID, notification.WorkspaceID, []uuid.UUID{id}, true)
 
 	// 메트릭 기록: 알림 읽음 처리
 	if s.metrics != nil {
@@ -211,9 +414,66 @@ func (s *NotificationService) MarkAsRead(ctx context.Context, id, userID uuid.UU
 		zap.String("notification.id", id.String()),
 		zap.String("enduser.id", userID.String()))
 
+	s.localizeOne(ctx, userID, notification)
 	return notification, nil
 }
 
+// MarkAsUnread marks a single notification as unread again.
+func (s *NotificationService) MarkAsUnread(ctx context.Context, id, userID uuid.UUID) (*domain.Notification, error) {
+	log := s.log(ctx)
+
+	notification, err := s.repo.MarkAsUnread(id, userID)
+	if err != nil {
+		log.Warn("MarkAsUnread failed",
+			zap.String("notification.id", id.String()),
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return nil, response.ErrNotificationNotFound
+	}
+
+	s.invalidateUnreadCountCache(ctx, userID, notification.WorkspaceID)
+	s.publishReadState(ctx, userID, notification.WorkspaceID, []uuid.UUID{id}, false)
+
+	log.Info("Notification marked as unread",
+		zap.String("notification.id", id.String()),
+		zap.String("enduser.id", userID.String()))
+
+	s.localizeOne(ctx, userID, notification)
+	return notification, nil
+}
+
+// UpdateReadState marks several notifications read or unread.
+// 다른 사용자의 알림 ID는 무시되며, 상태가 바뀐 알림 수를 반환합니다.
+func (s *NotificationService) UpdateReadState(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, read bool) (int64, error) {
+	log := s.log(ctx)
+
+	count, workspaceIDs, err := s.repo.SetReadState(userID, ids, read)
+	if err != nil {
+		log.Error("UpdateReadState failed",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return 0, err
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		s.invalidateUnreadCountCache(ctx, userID, workspaceID)
+		s.publishReadState(ctx, userID, workspaceID, ids, read)
+	}
+
+	if read && s.metrics != nil {
+		for i := int64(0); i < count; i++ {
+			s.metrics.RecordNotificationRead()
+		}
+	}
+
+	log.Info("Notification read state updated",
+		zap.String("enduser.id", userID.String()),
+		zap.Bool("read", read),
+		zap.Int64("updated.count", count))
+
+	return count, nil
+}
+
 // MarkAllAsRead marks all notifications as read for a user in a workspace.
 // 전체 읽음 처리된 알림 수만큼 메트릭을 기록합니다.
 func (s *NotificationService) MarkAllAsRead(ctx context.Context, userID, workspaceID uuid.UUID) (int64, error) {
@@ -233,6 +493,9 @@ func (s *NotificationService) MarkAllAsRead(ctx context.Context, userID, workspa
 
 	// Invalidate cache
 	s.invalidateUnreadCountCache(ctx, userID, workspaceID)
+	if count > 0 {
+		s.publishReadState(ctx, userID, workspaceID, nil, true)
+	}
 
 	// 메트릭 기록: 읽음 처리된 알림 수만큼 카운터 증가
 	if s.metrics != nil && count > 0 {
@@ -290,6 +553,21 @@ func (s *NotificationService) GetUnreadCount(ctx context.Context, userID, worksp
 	}, nil
 }
 
+// GetUnreadCounts returns the unread counts of a user in every workspace.
+func (s *NotificationService) GetUnreadCounts(ctx context.Context, userID uuid.UUID) (*domain.WorkspaceUnreadCounts, error) {
+	counts, err := s.repo.GetUnreadCountsByWorkspace(userID)
+	if err != nil {
+		s.log(ctx).Error("GetUnreadCounts failed", zap.Error(err))
+		return nil, err
+	}
+
+	result := &domain.WorkspaceUnreadCounts{Workspaces: counts}
+	for _, c := range counts {
+		result.Total += c.Count
+	}
+	return result, nil
+}
+
 // DeleteNotification deletes a notification and invalidates the cache if it was unread.
 // 삭제 성공 시 메트릭을 기록합니다.
 func (s *NotificationService) DeleteNotification(ctx context.Context, id, userID uuid.UUID) (bool, error) {
@@ -344,6 +622,285 @@ func (s *NotificationService) CleanupOldNotifications(ctx context.Context) (int6
 	return count, err
 }
 
+// aggregationKey returns the Redis key of the aggregation window for a notification.
+func aggregationKey(notification *domain.Notification) string {
+	return fmt.Sprintf("noti:agg:%s:%s:%s:%s",
+		notification.TargetUserID.String(),
+		notification.ResourceType,
+		notification.ResourceID.String(),
+		notification.Type)
+}
+
+// claimGroup opens an aggregation window for the notification, or returns the ID of the
+// notification that already owns the current window.
+// 윈도우는 첫 이벤트 시점부터 고정되며, Redis가 없거나 비활성화된 경우 묶지 않습니다.
+func (s *NotificationService) claimGroup(ctx context.Context, notification *domain.Notification) (uuid.UUID, bool) {
+	if s.redis == nil || s.config == nil || s.config.App.AggregationWindow <= 0 {
+		return uuid.Nil, false
+	}
+
+	key := aggregationKey(notification)
+	window := time.Duration(s.config.App.AggregationWindow) * time.Second
+
+	// SET NX + GET: 새 윈도우를 열거나 기존 윈도우의 알림 ID를 원자적으로 조회
+	existing, err := s.redis.SetArgs(ctx, key, notification.ID.String(), redis.SetArgs{
+		Mode: "NX",
+		TTL:  window,
+		Get:  true,
+	}).Result()
+	if err == redis.Nil {
+		return uuid.Nil, false
+	}
+	if err != nil {
+		s.log(ctx).Warn("Failed to claim aggregation window", zap.Error(err))
+		return uuid.Nil, false
+	}
+
+	groupID, err := uuid.Parse(existing)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return groupID, true
+}
+
+// addToGroup folds a notification into the grouped notification and re-publishes it.
+// 기존 알림이 삭제되었으면 nil을 반환하고, 새 알림이 집계 창을 이어받습니다.
+func (s *NotificationService) addToGroup(ctx context.Context, groupID uuid.UUID, notification *domain.Notification) *domain.Notification {
+	log := s.log(ctx)
+
+	grouped, err := s.repo.IncrementGroup(groupID, notification)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("Failed to group notification", zap.Error(err))
+		}
+		window := time.Duration(s.config.App.AggregationWindow) * time.Second
+		s.redis.Set(ctx, aggregationKey(notification), notification.ID.String(), window)
+		return nil
+	}
+	grouped.Locale = notification.Locale
+
+	s.publishNotification(ctx, grouped)
+	s.invalidateUnreadCountCache(ctx, grouped.TargetUserID, grouped.WorkspaceID)
+
+	if s.metrics != nil {
+		s.metrics.RecordNotificationGrouped()
+	}
+
+	log.Debug("Notification grouped",
+		zap.String("notification.id", grouped.ID.String()),
+		zap.String("notification.type", string(grouped.Type)),
+		zap.Int("notification.group_count", grouped.GroupCount))
+
+	return grouped
+}
+
+// channelPreferences returns the target user's channel preferences for a notification.
+// 설정 조회에 실패하면 알림 유실을 막기 위해 모든 채널로 전송합니다.
+func (s *NotificationService) channelPreferences(ctx context.Context, notification *domain.Notification) domain.ChannelPreferences {
+	if s.preferences == nil {
+		return nil
+	}
+
+	channels, err := s.preferences.ChannelsFor(ctx, notification.TargetUserID, notification.WorkspaceID, notification.Type)
+	if err != nil {
+		s.log(ctx).Warn("Failed to load notification preferences, delivering on all channels",
+			zap.String("target.user.id", notification.TargetUserID.String()),
+			zap.Error(err))
+		return nil
+	}
+	return channels
+}
+
+// sendExternal delivers a notification through the registered external channel senders.
+// 대상 사용자의 방해 금지 시간에는 전송을 보류하거나(낮은 우선순위 타입은) 생략합니다.
+func (s *NotificationService) sendExternal(ctx context.Context, notification *domain.Notification, channels domain.ChannelPreferences) {
+	log := s.log(ctx)
+	if s.deferForQuietHours(ctx, notification, channels) {
+		return
+	}
+	for _, sender := range s.senders {
+		channel := sender.Channel()
+		if !channels.Enabled(channel) {
+			if s.metrics != nil {
+				s.metrics.RecordNotificationSuppressed(string(channel))
+			}
+			continue
+		}
+		if err := sender.Send(ctx, notification); err != nil {
+			log.Error("External notification delivery failed",
+				zap.String("notification.channel", string(channel)),
+				zap.String("target.user.id", notification.TargetUserID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// heldNotification is a push/email delivery postponed until the end of the recipient's quiet hours.
+type heldNotification struct {
+	Notification domain.Notification       `json:"notification"`
+	Locale       string                    `json:"locale,omitempty"`
+	Channels     domain.ChannelPreferences `json:"channels,omitempty"`
+}
+
+// deferForQuietHours holds or drops external deliveries during the recipient's quiet hours.
+// 보류한 알림은 Redis sorted set에 종료 시각을 점수로 저장하며, 저장에 실패하면 즉시 전송합니다.
+func (s *NotificationService) deferForQuietHours(ctx context.Context, notification *domain.Notification, channels domain.ChannelPreferences) bool {
+	if notification.Critical || s.preferences == nil || !s.hasExternalChannel(channels) {
+		return false
+	}
+	until := s.preferences.QuietUntil(ctx, notification.TargetUserID, time.Now())
+	if until.IsZero() {
+		return false
+	}
+	log := s.log(ctx)
+
+	if s.preferences.IsLowPriority(notification.Type) {
+		if s.metrics != nil {
+			s.metrics.RecordQuietHours("dropped")
+		}
+		log.Debug("External delivery dropped during quiet hours",
+			zap.String("notification.type", string(notification.Type)),
+			zap.String("target.user.id", notification.TargetUserID.String()))
+		return true
+	}
+	if s.redis == nil {
+		return false
+	}
+
+	data, err := json.Marshal(heldNotification{Notification: *notification, Locale: notification.Locale, Channels: channels})
+	if err != nil {
+		return false
+	}
+	if err := s.redis.ZAdd(ctx, quietHoursHeldKey, redis.Z{Score: float64(until.UnixMilli()), Member: data}).Err(); err != nil {
+		log.Warn("Failed to hold notification for quiet hours, delivering now", zap.Error(err))
+		return false
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordQuietHours("held")
+	}
+	log.Debug("External delivery held until end of quiet hours",
+		zap.String("notification.id", notification.ID.String()),
+		zap.Time("quiet_hours.until", until))
+	return true
+}
+
+// hasExternalChannel returns whether any registered sender's channel is enabled.
+func (s *NotificationService) hasExternalChannel(channels domain.ChannelPreferences) bool {
+	for _, sender := range s.senders {
+		if channels.Enabled(sender.Channel()) {
+			return true
+		}
+	}
+	return false
+}
+
+// StartHeldDeliveries sends notifications held for quiet hours once their quiet period ends.
+// It blocks until the context is cancelled; run it in a goroutine.
+func (s *NotificationService) StartHeldDeliveries(ctx context.Context) {
+	if s.redis == nil {
+		return
+	}
+
+	ticker := time.NewTicker(quietHoursPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.releaseHeld(ctx)
+		}
+	}
+}
+
+// releaseHeld claims and sends the held notifications whose quiet period has ended.
+// ZREM에 성공한 레플리카만 전송하므로 여러 레플리카에서도 한 번만 전송됩니다.
+func (s *NotificationService) releaseHeld(ctx context.Context) {
+	log := s.log(ctx)
+
+	members, err := s.redis.ZRangeArgs(ctx, redis.ZRangeArgs{
+		Key:     quietHoursHeldKey,
+		Start:   "-inf",
+		Stop:    strconv.FormatInt(time.Now().UnixMilli(), 10),
+		ByScore: true,
+		Count:   quietHoursReleaseBatch,
+	}).Result()
+	if err != nil {
+		log.Warn("Failed to load held notifications", zap.Error(err))
+		return
+	}
+
+	for _, member := range members {
+		claimed, err := s.redis.ZRem(ctx, quietHoursHeldKey, member).Result()
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		var held heldNotification
+		if err := json.Unmarshal([]byte(member), &held); err != nil {
+			log.Warn("Dropping malformed held notification", zap.Error(err))
+			continue
+		}
+		held.Notification.Locale = held.Locale
+		// 설정이 바뀌어 아직 방해 금지 시간이면 다시 보류됨
+		s.sendExternal(ctx, &held.Notification, held.Channels)
+	}
+}
+
+// dispatchWorkspace posts a notification to the Slack/Teams integrations and webhooks of its workspace.
+// 워크스페이스 단위 전송이므로 개인 수신 설정과 무관하게 관리자 라우팅/필터 규칙을 따릅니다.
+func (s *NotificationService) dispatchWorkspace(ctx context.Context, notification *domain.Notification) {
+	if s.integrations != nil {
+		s.integrations.Dispatch(ctx, notification)
+	}
+	if s.webhooks != nil {
+		s.webhooks.Dispatch(ctx, notification)
+	}
+}
+
+// localeFor returns the locale notifications of a user are rendered in.
+func (s *NotificationService) localeFor(ctx context.Context, userID uuid.UUID) string {
+	if s.templates == nil {
+		return ""
+	}
+	if s.preferences == nil {
+		return s.templates.DefaultLocale()
+	}
+	return s.preferences.LocaleFor(ctx, userID)
+}
+
+// localize renders the in-app title and body of notifications in the reader's locale.
+func (s *NotificationService) localize(ctx context.Context, userID uuid.UUID, notifications []domain.Notification) {
+	if s.templates == nil || len(notifications) == 0 {
+		return
+	}
+	locale := s.localeFor(ctx, userID)
+	for i := range notifications {
+		notifications[i].Locale = locale
+		s.render(&notifications[i])
+	}
+}
+
+// localizeOne renders the in-app title and body of a single notification.
+func (s *NotificationService) localizeOne(ctx context.Context, userID uuid.UUID, notification *domain.Notification) {
+	if s.templates == nil || notification == nil {
+		return
+	}
+	notification.Locale = s.localeFor(ctx, userID)
+	s.render(notification)
+}
+
+// render sets the in-app title and body of a notification from its locale.
+func (s *NotificationService) render(notification *domain.Notification) {
+	if s.templates == nil {
+		return
+	}
+	rendered := renderNotification(s.templates, notification, templates.ChannelDefault)
+	notification.Title = rendered.Title
+	notification.Body = rendered.Body
+}
+
 // publishNotification publishes a notification to Redis for SSE delivery.
 func (s *NotificationService) publishNotification(ctx context.Context, notification *domain.Notification) {
 	log := s.log(ctx)
@@ -351,6 +908,9 @@ func (s *NotificationService) publishNotification(ctx context.Context, notificat
 		return
 	}
 
+	// SSE 클라이언트가 타입별 문구 없이 표시할 수 있도록 렌더링된 제목/본문을 포함
+	s.render(notification)
+
 	channel := fmt.Sprintf("notifications:user:%s", notification.TargetUserID.String())
 	data, err := json.Marshal(notification)
 	if err != nil {
@@ -367,6 +927,39 @@ func (s *NotificationService) publishNotification(ctx context.Context, notificat
 	}
 }
 
+// publishReadState publishes a read state event so every open tab of the user updates its badge.
+// 이벤트에 최신 읽지 않은 수를 포함하여 클라이언트가 별도 조회 없이 배지를 갱신합니다.
+func (s *NotificationService) publishReadState(ctx context.Context, userID, workspaceID uuid.UUID, ids []uuid.UUID, read bool) {
+	log := s.log(ctx)
+	if s.redis == nil {
+		return
+	}
+
+	unread, err := s.GetUnreadCount(ctx, userID, workspaceID)
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(domain.SSEMessage{
+		Event: domain.SSEEventReadState,
+		Data: domain.ReadStateEvent{
+			WorkspaceID:     workspaceID,
+			UnreadCount:     unread.Count,
+			NotificationIDs: ids,
+			Read:            read,
+		},
+	})
+	if err != nil {
+		log.Error("publishReadState marshal failed", zap.Error(err))
+		return
+	}
+
+	channel := fmt.Sprintf("notifications:user:%s", userID.String())
+	if err := s.redis.Publish(ctx, channel, data).Err(); err != nil {
+		log.Error("publishReadState Redis publish failed", zap.Error(err))
+	}
+}
+
 // invalidateUnreadCountCache removes the cached unread count for a user/workspace.
 func (s *NotificationService) invalidateUnreadCountCache(ctx context.Context, userID, workspaceID uuid.UUID) {
 	log := s.log(ctx)
@@ -381,3 +974,22 @@ func (s *NotificationService) invalidateUnreadCountCache(ctx context.Context, us
 		log.Debug("Unread count cache invalidated", zap.String("cache.key", cacheKey))
 	}
 }
+
+// templateData builds the template context of a notification.
+func templateData(notification *domain.Notification) templates.Data {
+	data := templates.Data{
+		Type:         string(notification.Type),
+		ResourceType: string(notification.ResourceType),
+		Count:        notification.GroupCount,
+		Metadata:     notification.Metadata,
+	}
+	if notification.ResourceName != nil {
+		data.ResourceName = *notification.ResourceName
+	}
+	return data
+}
+
+// renderNotification renders a notification for a channel in its target user's locale.
+func renderNotification(renderer *templates.Renderer, notification *domain.Notification, channel string) templates.Rendered {
+	return renderer.Render(notification.Locale, string(notification.Type), channel, templateData(notification))
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// PreferenceService manages per-user notification preferences.
+// 알림 전송 파이프라인은 전송 전에 ChannelsFor로 채널별 수신 여부를 확인합니다.
+type PreferenceService struct {
+	repo      *repository.PreferenceRepository
+	templates *templates.Renderer // 지원 언어 목록과 기본 언어
+	quiet     config.QuietHoursConfig
+	logger    *zap.Logger
+}
+
+// NewPreferenceService creates a new PreferenceService with the given dependencies.
+func NewPreferenceService(repo *repository.PreferenceRepository, renderer *templates.Renderer, quiet config.QuietHoursConfig, logger *zap.Logger) *PreferenceService {
+	return &PreferenceService{
+		repo:      repo,
+		templates: renderer,
+		quiet:     quiet,
+		logger:    logger,
+	}
+}
+
+// log returns a trace-context aware logger
+func (s *PreferenceService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// GetPreferences returns the effective preferences of a user for every type and channel.
+func (s *PreferenceService) GetPreferences(ctx context.Context, userID uuid.UUID, workspaceID *uuid.UUID) (*domain.PreferencesResponse, error) {
+	prefs, err := s.repo.GetByUser(userID, workspaceID)
+	if err != nil {
+		s.log(ctx).Error("GetPreferences failed", zap.Error(err))
+		return nil, err
+	}
+
+	resolved := resolvePreferences(prefs)
+	settings := make([]domain.PreferenceSetting, 0, len(domain.AllNotificationTypes)*len(domain.AllNotificationChannels))
+	for _, notificationType := range domain.AllNotificationTypes {
+		channels := resolved[string(notificationType)]
+		for _, channel := range domain.AllNotificationChannels {
+			settings = append(settings, domain.PreferenceSetting{
+				Type:    notificationType,
+				Channel: channel,
+				Enabled: channels.Enabled(channel),
+			})
+		}
+	}
+
+	return &domain.PreferencesResponse{
+		WorkspaceID: workspaceID,
+		Preferences: settings,
+	}, nil
+}
+
+// UpdatePreferences saves preferences for a user and returns the effective preferences.
+func (s *PreferenceService) UpdatePreferences(ctx context.Context, userID uuid.UUID, req *domain.UpdatePreferencesRequest) (*domain.PreferencesResponse, error) {
+	log := s.log(ctx)
+
+	for _, setting := range req.Preferences {
+		if !setting.Type.IsValid() {
+			return nil, response.ErrInvalidNotificationType
+		}
+		if !setting.Channel.IsValid() {
+			return nil, response.NewValidationError("invalid notification channel", string(setting.Channel))
+		}
+	}
+
+	if err := s.repo.Upsert(userID, req.WorkspaceID, req.Preferences); err != nil {
+		log.Error("UpdatePreferences failed", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("Notification preferences updated",
+		zap.String("enduser.id", userID.String()),
+		zap.Int("preference.count", len(req.Preferences)))
+
+	return s.GetPreferences(ctx, userID, req.WorkspaceID)
+}
+
+// ChannelsFor returns the channel preferences that apply to a notification type.
+func (s *PreferenceService) ChannelsFor(ctx context.Context, userID, workspaceID uuid.UUID, notificationType domain.NotificationType) (domain.ChannelPreferences, error) {
+	prefs, err := s.repo.GetByUserAndType(userID, workspaceID, notificationType)
+	if err != nil {
+		return nil, err
+	}
+	return resolvePreferences(prefs)[string(notificationType)], nil
+}
+
+// GetLocale returns the notification language of a user and the available languages.
+func (s *PreferenceService) GetLocale(ctx context.Context, userID uuid.UUID) (*domain.LocaleResponse, error) {
+	locale, err := s.repo.GetLocale(userID)
+	if err != nil {
+		s.log(ctx).Error("GetLocale failed", zap.Error(err))
+		return nil, err
+	}
+	if resolved := s.templates.Resolve(locale); resolved != "" {
+		locale = resolved
+	} else {
+		locale = s.templates.DefaultLocale()
+	}
+
+	return &domain.LocaleResponse{
+		Locale:    locale,
+		Available: s.templates.Locales(),
+	}, nil
+}
+
+// UpdateLocale changes the notification language of a user.
+// "en-US"처럼 지역이 포함된 값은 지원하는 언어("en")로 저장합니다.
+func (s *PreferenceService) UpdateLocale(ctx context.Context, userID uuid.UUID, req *domain.UpdateLocaleRequest) (*domain.LocaleResponse, error) {
+	locale := s.templates.Resolve(req.Locale)
+	if locale == "" {
+		return nil, response.NewValidationError("unsupported locale", req.Locale)
+	}
+
+	if err := s.repo.UpsertLocale(userID, locale); err != nil {
+		s.log(ctx).Error("UpdateLocale failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Notification locale updated",
+		zap.String("enduser.id", userID.String()),
+		zap.String("locale", locale))
+
+	return &domain.LocaleResponse{
+		Locale:    locale,
+		Available: s.templates.Locales(),
+	}, nil
+}
+
+// LocaleFor returns the locale notifications of a user are rendered in.
+// 조회에 실패하면 기본 언어로 렌더링합니다.
+func (s *PreferenceService) LocaleFor(ctx context.Context, userID uuid.UUID) string {
+	locale, err := s.repo.GetLocale(userID)
+	if err != nil {
+		s.log(ctx).Warn("Failed to load notification locale, using default",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return s.templates.DefaultLocale()
+	}
+	if resolved := s.templates.Resolve(locale); resolved != "" {
+		return resolved
+	}
+	return s.templates.DefaultLocale()
+}
+
+// GetQuietHours returns the quiet hours of a user.
+// 설정이 없으면 비활성화된 기본 구간(22:00-08:00, 기본 시간대)을 반환합니다.
+func (s *PreferenceService) GetQuietHours(ctx context.Context, userID uuid.UUID) (*domain.QuietHours, error) {
+	quietHours, err := s.repo.GetQuietHours(userID)
+	if err != nil {
+		s.log(ctx).Error("GetQuietHours failed", zap.Error(err))
+		return nil, err
+	}
+	if quietHours == nil {
+		quietHours = &domain.QuietHours{
+			UserID:    userID,
+			StartTime: "22:00",
+			EndTime:   "08:00",
+			Timezone:  s.quiet.DefaultTimezone,
+		}
+	}
+	return quietHours, nil
+}
+
+// UpdateQuietHours changes the quiet hours of a user.
+func (s *PreferenceService) UpdateQuietHours(ctx context.Context, userID uuid.UUID, req *domain.UpdateQuietHoursRequest) (*domain.QuietHours, error) {
+	if _, _, err := domain.ParseClock(req.Start); err != nil {
+		return nil, response.NewValidationError("invalid start time, expected HH:MM", req.Start)
+	}
+	if _, _, err := domain.ParseClock(req.End); err != nil {
+		return nil, response.NewValidationError("invalid end time, expected HH:MM", req.End)
+	}
+	if req.Start == req.End {
+		return nil, response.NewValidationError("start and end time must differ", req.Start)
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil || req.Timezone == "" || req.Timezone == "Local" {
+		return nil, response.NewValidationError("unknown timezone", req.Timezone)
+	}
+
+	quietHours := &domain.QuietHours{
+		UserID:    userID,
+		Enabled:   req.Enabled,
+		StartTime: req.Start,
+		EndTime:   req.End,
+		Timezone:  req.Timezone,
+		UpdatedAt: time.Now(),
+	}
+	if err := s.repo.UpsertQuietHours(quietHours); err != nil {
+		s.log(ctx).Error("UpdateQuietHours failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Quiet hours updated",
+		zap.String("enduser.id", userID.String()),
+		zap.Bool("enabled", req.Enabled))
+	return quietHours, nil
+}
+
+// QuietUntil returns when the current quiet period of a user ends, or the zero time if not in quiet hours.
+// 조회에 실패하면 알림이 지연되지 않도록 즉시 전송합니다.
+func (s *PreferenceService) QuietUntil(ctx context.Context, userID uuid.UUID, now time.Time) time.Time {
+	quietHours, err := s.repo.GetQuietHours(userID)
+	if err != nil {
+		s.log(ctx).Warn("Failed to load quiet hours, delivering immediately",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return time.Time{}
+	}
+	return quietHours.Until(now)
+}
+
+// IsLowPriority returns whether a notification type is dropped instead of held during quiet hours.
+func (s *PreferenceService) IsLowPriority(notificationType domain.NotificationType) bool {
+	for _, t := range s.quiet.LowPriorityTypes {
+		if t == string(notificationType) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePreferences builds type -> channel preferences, applying workspace overrides over global defaults.
+func resolvePreferences(prefs []domain.NotificationPreference) map[string]domain.ChannelPreferences {
+	resolved := make(map[string]domain.ChannelPreferences)
+	apply := func(workspaceScoped bool) {
+		for _, pref := range prefs {
+			if (pref.WorkspaceID != nil) != workspaceScoped {
+				continue
+			}
+			if resolved[pref.Type] == nil {
+				resolved[pref.Type] = make(domain.ChannelPreferences)
+			}
+			resolved[pref.Type][pref.Channel] = pref.Enabled
+		}
+	}
+	apply(false)
+	apply(true)
+	return resolved
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"noti-service/internal/webpush"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// pushSendTimeout bounds a single delivery to a push service.
+const pushSendTimeout = 10 * time.Second
+
+// PushService delivers high-priority notifications as browser web push.
+// SSE로 실시간 연결된 사용자는 이미 알림을 받으므로 푸시를 보내지 않습니다.
+type PushService struct {
+	repo          *repository.PushRepository
+	redis         *redis.Client
+	client        *webpush.Client
+	priorityTypes map[domain.NotificationType]bool
+	ttl           time.Duration
+	templates     *templates.Renderer
+	deliveries    *DeliveryService
+	logger        *zap.Logger
+	metrics       *metrics.Metrics
+}
+
+// NewPushService creates a new PushService, loading or generating the VAPID key pair.
+func NewPushService(
+	repo *repository.PushRepository,
+	redis *redis.Client,
+	cfg config.WebPushConfig,
+	renderer *templates.Renderer,
+	deliveries *DeliveryService,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) (*PushService, error) {
+	keys, err := loadVAPIDKeys(repo, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := webpush.NewClient(keys, cfg.Subject, pushSendTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	priorityTypes := make(map[domain.NotificationType]bool)
+	if len(cfg.PriorityTypes) > 0 {
+		for _, t := range cfg.PriorityTypes {
+			notificationType := domain.NotificationType(t)
+			if !notificationType.IsValid() {
+				return nil, fmt.Errorf("invalid web push priority type: %s", t)
+			}
+			priorityTypes[notificationType] = true
+		}
+	} else {
+		for _, t := range domain.DefaultPushPriorityTypes {
+			priorityTypes[t] = true
+		}
+	}
+
+	return &PushService{
+		repo:          repo,
+		redis:         redis,
+		client:        client,
+		priorityTypes: priorityTypes,
+		ttl:           time.Duration(cfg.TTL) * time.Second,
+		templates:     renderer,
+		deliveries:    deliveries,
+		logger:        logger,
+		metrics:       m,
+	}, nil
+}
+
+// loadVAPIDKeys returns the configured keys, or the keys stored in the database.
+// 설정과 DB 모두 비어 있으면 키를 생성하고, 동시에 기동한 레플리카와 경합해도 DB에 먼저 저장된 키를 사용합니다.
+func loadVAPIDKeys(repo *repository.PushRepository, cfg config.WebPushConfig, logger *zap.Logger) (*webpush.VAPIDKeys, error) {
+	if cfg.VAPIDPublicKey != "" || cfg.VAPIDPrivateKey != "" {
+		keys := &webpush.VAPIDKeys{PublicKey: cfg.VAPIDPublicKey, PrivateKey: cfg.VAPIDPrivateKey}
+		if err := keys.Validate(); err != nil {
+			return nil, err
+		}
+		return keys, nil
+	}
+
+	stored, err := repo.GetVAPIDKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load VAPID key: %w", err)
+	}
+	if stored == nil {
+		generated, err := webpush.GenerateVAPIDKeys()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate VAPID key: %w", err)
+		}
+		if err := repo.CreateVAPIDKeyIfAbsent(&domain.VAPIDKey{
+			PublicKey:  generated.PublicKey,
+			PrivateKey: generated.PrivateKey,
+			CreatedAt:  time.Now(),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to save VAPID key: %w", err)
+		}
+		if stored, err = repo.GetVAPIDKey(); err != nil || stored == nil {
+			return nil, fmt.Errorf("failed to load VAPID key: %w", err)
+		}
+		logger.Info("VAPID key pair initialized", zap.String("vapid.public_key", stored.PublicKey))
+	}
+
+	keys := &webpush.VAPIDKeys{PublicKey: stored.PublicKey, PrivateKey: stored.PrivateKey}
+	if err := keys.Validate(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// log returns a trace-context aware logger
+func (s *PushService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// PublicKey returns the VAPID public key browsers subscribe with.
+func (s *PushService) PublicKey() string {
+	return s.client.PublicKey()
+}
+
+// Subscribe registers a browser push subscription for a user.
+func (s *PushService) Subscribe(ctx context.Context, userID uuid.UUID, req *domain.RegisterPushSubscriptionRequest, userAgent string) (*domain.PushSubscription, error) {
+	if err := validatePushEndpoint(req.Endpoint); err != nil {
+		return nil, err
+	}
+	if err := webpush.ValidateKeys(req.Keys.P256dh, req.Keys.Auth); err != nil {
+		return nil, response.NewValidationError("invalid push subscription keys", "")
+	}
+	if len(userAgent) > 500 {
+		userAgent = userAgent[:500]
+	}
+
+	now := time.Now()
+	sub := &domain.PushSubscription{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Endpoint:  req.Endpoint,
+		P256dh:    req.Keys.P256dh,
+		Auth:      req.Keys.Auth,
+		UserAgent: userAgent,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.UpsertSubscription(sub); err != nil {
+		s.log(ctx).Error("Subscribe failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Push subscription registered",
+		zap.String("enduser.id", userID.String()))
+	return sub, nil
+}
+
+// Unsubscribe removes a browser push subscription of a user.
+func (s *PushService) Unsubscribe(ctx context.Context, userID uuid.UUID, endpoint string) error {
+	deleted, err := s.repo.DeleteSubscription(userID, endpoint)
+	if err != nil {
+		s.log(ctx).Error("Unsubscribe failed", zap.Error(err))
+		return err
+	}
+	if deleted == 0 {
+		return response.NewNotFoundError("push subscription not found", "")
+	}
+
+	s.log(ctx).Info("Push subscription removed",
+		zap.String("enduser.id", userID.String()))
+	return nil
+}
+
+// Channel implements ChannelSender.
+func (s *PushService) Channel() domain.NotificationChannel {
+	return domain.NotificationChannelPush
+}
+
+// Send implements ChannelSender. High-priority notifications are pushed in the background
+// when the user has no active SSE connection.
+func (s *PushService) Send(ctx context.Context, notification *domain.Notification) error {
+	if !s.priorityTypes[notification.Type] {
+		return nil
+	}
+	if s.isOnline(ctx, notification.TargetUserID) {
+		return nil
+	}
+
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.deliver(context.WithoutCancel(ctx), notification)
+	return nil
+}
+
+// isOnline returns true if any replica holds an SSE connection for the user.
+// SSE 연결마다 사용자 채널을 구독하므로 구독자 수로 판단합니다.
+func (s *PushService) isOnline(ctx context.Context, userID uuid.UUID) bool {
+	if s.redis == nil {
+		return false
+	}
+	channel := fmt.Sprintf("notifications:user:%s", userID.String())
+	counts, err := s.redis.PubSubNumSub(ctx, channel).Result()
+	if err != nil {
+		s.log(ctx).Warn("Failed to check SSE presence", zap.Error(err))
+		return false
+	}
+	return counts[channel] > 0
+}
+
+// DeliveryChannel implements TargetSender.
+func (s *PushService) DeliveryChannel() domain.DeliveryChannel {
+	return domain.DeliveryChannelWebPush
+}
+
+// deliver sends a notification to every push subscription of the target user.
+func (s *PushService) deliver(ctx context.Context, notification *domain.Notification) {
+	subs, err := s.repo.GetSubscriptionsByUser(notification.TargetUserID)
+	if err != nil {
+		s.log(ctx).Error("Failed to load push subscriptions", zap.Error(err))
+		return
+	}
+
+	targets := make([]uuid.UUID, 0, len(subs))
+	for _, sub := range subs {
+		targets = append(targets, sub.ID)
+	}
+	s.deliveries.Submit(ctx, s, notification, targets)
+}
+
+// DeliverTo implements TargetSender. It sends a notification to one push subscription.
+func (s *PushService) DeliverTo(ctx context.Context, notification *domain.Notification, subscriptionID uuid.UUID) error {
+	sub, err := s.repo.GetSubscriptionByID(subscriptionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTargetGone
+		}
+		return err
+	}
+
+	payload, err := buildPushPayload(notification, s.templates)
+	if err != nil {
+		return Permanent(err)
+	}
+
+	opts := webpush.Options{
+		TTL:     s.ttl,
+		Urgency: webpush.UrgencyHigh,
+		Topic:   notificationTopic(notification),
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, pushSendTimeout)
+	status, err := s.client.Send(sendCtx, webpush.Subscription{
+		Endpoint: sub.Endpoint,
+		P256dh:   sub.P256dh,
+		Auth:     sub.Auth,
+	}, payload, opts)
+	cancel()
+
+	switch {
+	case err == nil:
+		s.recordDelivery("sent")
+		if err := s.repo.TouchSubscription(sub.ID); err != nil {
+			s.log(ctx).Warn("Failed to update push subscription", zap.Error(err))
+		}
+		return nil
+	case webpush.IsSubscriptionGone(status) || errors.Is(err, webpush.ErrInvalidSubscription):
+		// 만료되었거나 해지된 구독은 삭제
+		s.recordDelivery("expired")
+		if err := s.repo.DeleteSubscriptionByID(sub.ID); err != nil {
+			s.log(ctx).Warn("Failed to delete expired push subscription", zap.Error(err))
+		}
+		return fmt.Errorf("%w: %v", ErrTargetGone, err)
+	default:
+		s.recordDelivery("failed")
+		s.log(ctx).Warn("Web push delivery failed",
+			zap.String("notification.id", notification.ID.String()),
+			zap.Int("push.status", status),
+			zap.Error(err))
+		if isPermanentStatus(status) {
+			return Permanent(err)
+		}
+		return err
+	}
+}
+
+func (s *PushService) recordDelivery(result string) {
+	if s.metrics != nil {
+		s.metrics.RecordPushDelivery(result)
+	}
+}
+
+// buildPushPayload encodes the message delivered to the service worker.
+// 큰 메타데이터는 제외하고 클라이언트가 알림을 표시/이동하는 데 필요한 필드만 보냅니다.
+func buildPushPayload(notification *domain.Notification, renderer *templates.Renderer) ([]byte, error) {
+	rendered := renderNotification(renderer, notification, string(domain.NotificationChannelPush))
+	message := domain.PushMessage{
+		ID:           notification.ID,
+		Type:         notification.Type,
+		WorkspaceID:  notification.WorkspaceID,
+		ResourceType: notification.ResourceType,
+		ResourceID:   notification.ResourceID,
+		ResourceName: notification.ResourceName,
+		Title:        rendered.Title,
+		Body:         rendered.Body,
+		CreatedAt:    notification.CreatedAt,
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > webpush.MaxPayloadSize {
+		message.ResourceName = nil
+		message.Body = ""
+		return json.Marshal(message)
+	}
+	return payload, nil
+}
+
+// notificationTopic lets a newer push for the same resource replace an undelivered one.
+// Topic 헤더는 URL-safe base64 문자 32자 이내여야 합니다.
+func notificationTopic(notification *domain.Notification) string {
+	id := notification.ResourceID
+	return fmt.Sprintf("%x", id[:16])
+}
+
+// validatePushEndpoint rejects endpoints that are not public HTTPS URLs.
+// 구독 엔드포인트로 서버가 요청을 보내므로 SSRF를 막기 위해 https와 도메인 호스트만 허용합니다.
+func validatePushEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return response.NewValidationError("invalid push endpoint", "")
+	}
+	if net.ParseIP(u.Hostname()) != nil || u.Hostname() == "localhost" {
+		return response.NewValidationError("invalid push endpoint", "")
+	}
+	return nil
+}
@@ -0,0 +1,571 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"noti-service/internal/client"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/sms"
+	"noti-service/internal/templates"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// smsSendTimeout bounds a single request to the SMS provider.
+	smsSendTimeout = 10 * time.Second
+	// smsVerificationType is the template entry of verification messages.
+	smsVerificationType = "SMS_VERIFICATION"
+	// smsCodeTTL is how long a verification code stays valid.
+	smsCodeTTL = 10 * time.Minute
+	// smsCodeCooldown is the minimum interval between verification codes for a user.
+	smsCodeCooldown = time.Minute
+	// smsCodeDailyLimit caps verification codes per user per day.
+	smsCodeDailyLimit = 5
+	// smsCodeMaxAttempts is the number of wrong codes allowed before the code is discarded.
+	smsCodeMaxAttempts = 5
+)
+
+// SMSService delivers critical notifications as SMS to verified phone numbers.
+// 워크스페이스 관리자가 활성화한 워크스페이스에서, 설정된 중요 알림 타입만 전송하며
+// 사용자별 시간당, 워크스페이스별 월간, 서비스 전체 일간 한도를 넘으면 전송하지 않습니다.
+type SMSService struct {
+	repo        *repository.SMSRepository
+	redis       *redis.Client
+	users       client.UserClient
+	preferences *PreferenceService
+	provider    sms.Provider
+	types       map[domain.NotificationType]bool
+	config      config.SMSConfig
+	templates   *templates.Renderer
+	deliveries  *DeliveryService
+	logger      *zap.Logger
+	metrics     *metrics.Metrics
+}
+
+// NewSMSService creates a new SMSService with the configured provider.
+// Redis is required for verification codes and send counters.
+func NewSMSService(
+	repo *repository.SMSRepository,
+	redis *redis.Client,
+	users client.UserClient,
+	preferences *PreferenceService,
+	cfg config.SMSConfig,
+	renderer *templates.Renderer,
+	deliveries *DeliveryService,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) (*SMSService, error) {
+	if redis == nil {
+		return nil, fmt.Errorf("sms requires redis")
+	}
+	if cfg.UserHourlyLimit <= 0 || cfg.WorkspaceMonthly <= 0 || cfg.DailyLimit <= 0 {
+		return nil, fmt.Errorf("sms limits must be positive")
+	}
+
+	var provider sms.Provider
+	var err error
+	switch cfg.Provider {
+	case "twilio":
+		provider, err = sms.NewTwilioClient(sms.TwilioConfig{
+			AccountSID: cfg.TwilioAccountSID,
+			AuthToken:  cfg.TwilioAuthToken,
+			From:       cfg.TwilioFrom,
+		}, smsSendTimeout)
+	case "sns":
+		provider, err = sms.NewSNSClient(sms.SNSConfig{
+			Region:          cfg.SNSRegion,
+			AccessKeyID:     cfg.SNSAccessKeyID,
+			SecretAccessKey: cfg.SNSSecretAccessKey,
+			SessionToken:    cfg.SNSSessionToken,
+			SenderID:        cfg.SNSSenderID,
+		}, smsSendTimeout)
+	default:
+		return nil, fmt.Errorf("unknown sms provider: %s", cfg.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[domain.NotificationType]bool)
+	if len(cfg.Types) > 0 {
+		for _, t := range cfg.Types {
+			notificationType := domain.NotificationType(t)
+			if !notificationType.IsValid() {
+				return nil, fmt.Errorf("invalid sms notification type: %s", t)
+			}
+			types[notificationType] = true
+		}
+	} else {
+		for _, t := range domain.DefaultSMSTypes {
+			types[t] = true
+		}
+	}
+
+	return &SMSService{
+		repo:        repo,
+		redis:       redis,
+		users:       users,
+		preferences: preferences,
+		provider:    provider,
+		types:       types,
+		config:      cfg,
+		templates:   renderer,
+		deliveries:  deliveries,
+		logger:      logger,
+		metrics:     m,
+	}, nil
+}
+
+// log returns a trace-context aware logger
+func (s *SMSService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// GetPhone returns the SMS phone number of a user and any number awaiting verification.
+func (s *SMSService) GetPhone(ctx context.Context, userID uuid.UUID) (*domain.PhoneNumberResponse, error) {
+	phone, err := s.repo.GetPhone(userID)
+	if err != nil {
+		s.log(ctx).Error("GetPhone failed", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &domain.PhoneNumberResponse{}
+	if phone != nil {
+		resp.PhoneNumber = sms.MaskPhoneNumber(phone.Number)
+		resp.Verified = true
+		resp.Disabled = phone.Disabled
+		resp.VerifiedAt = &phone.VerifiedAt
+	}
+	pending, err := s.redis.HGet(ctx, smsVerificationKey(userID), "number").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		s.log(ctx).Warn("Failed to load pending phone verification", zap.Error(err))
+	}
+	if pending != "" {
+		resp.Pending = sms.MaskPhoneNumber(pending)
+	}
+	return resp, nil
+}
+
+// RegisterPhone sends a verification code to a phone number.
+// 번호는 인증번호를 확인한 뒤에 저장되며, 발송 간격과 일일 발송 횟수를 제한합니다.
+func (s *SMSService) RegisterPhone(ctx context.Context, userID uuid.UUID, req *domain.RegisterPhoneRequest) (*domain.PhoneNumberResponse, error) {
+	number := strings.TrimSpace(req.PhoneNumber)
+	if !sms.ValidatePhoneNumber(number) {
+		return nil, response.NewValidationError("phone number must be in E.164 format", "e.g. +821012345678")
+	}
+
+	ok, err := s.redis.SetNX(ctx, smsCooldownKey(userID), 1, smsCodeCooldown).Result()
+	if err != nil {
+		s.log(ctx).Error("Failed to check verification cooldown", zap.Error(err))
+		return nil, response.NewInternalError("failed to send verification code", "")
+	}
+	if !ok {
+		return nil, response.NewValidationError("verification code was sent recently, try again later", "")
+	}
+	allowed, err := s.incrWithin(ctx, smsCodeDailyKey(userID, time.Now()), smsCodeDailyLimit, 24*time.Hour)
+	if err != nil {
+		s.log(ctx).Error("Failed to check verification limit", zap.Error(err))
+		return nil, response.NewInternalError("failed to send verification code", "")
+	}
+	if !allowed {
+		return nil, response.NewValidationError("daily verification limit reached", "")
+	}
+	if reason := s.reserveGlobal(ctx, time.Now()); reason != "" {
+		s.recordSMS(reason)
+		return nil, response.NewValidationError("sms is temporarily unavailable", "")
+	}
+
+	code, err := generateSMSCode()
+	if err != nil {
+		return nil, response.NewInternalError("failed to send verification code", "")
+	}
+	key := smsVerificationKey(userID)
+	pipe := s.redis.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, "number", number, "code", hashSMSCode(code), "attempts", 0)
+	pipe.Expire(ctx, key, smsCodeTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.log(ctx).Error("Failed to store verification code", zap.Error(err))
+		return nil, response.NewInternalError("failed to send verification code", "")
+	}
+
+	locale := s.templates.DefaultLocale()
+	if s.preferences != nil {
+		locale = s.preferences.LocaleFor(ctx, userID)
+	}
+	rendered := s.templates.Render(locale, smsVerificationType, string(domain.NotificationChannelSMS), templates.Data{
+		Type:     smsVerificationType,
+		Metadata: map[string]interface{}{"code": code},
+	})
+
+	sendCtx, cancel := context.WithTimeout(ctx, smsSendTimeout)
+	_, err = s.provider.Send(sendCtx, number, smsText(rendered))
+	cancel()
+	if err != nil {
+		s.redis.Del(ctx, key)
+		if errors.Is(err, sms.ErrInvalidNumber) {
+			s.recordSMS("invalid")
+			return nil, response.NewValidationError("phone number cannot receive messages", "")
+		}
+		s.recordSMS("failed")
+		s.log(ctx).Error("Failed to send verification code", zap.Error(err))
+		return nil, response.NewInternalError("failed to send verification code", "")
+	}
+	s.recordSMS("verification")
+
+	s.log(ctx).Info("Phone verification code sent",
+		zap.String("enduser.id", userID.String()),
+		zap.String("sms.number", sms.MaskPhoneNumber(number)))
+	return s.GetPhone(ctx, userID)
+}
+
+// VerifyPhone confirms the pending phone number of a user with the verification code.
+func (s *SMSService) VerifyPhone(ctx context.Context, userID uuid.UUID, req *domain.VerifyPhoneRequest) (*domain.PhoneNumberResponse, error) {
+	key := smsVerificationKey(userID)
+	pending, err := s.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		s.log(ctx).Error("Failed to load verification code", zap.Error(err))
+		return nil, response.NewInternalError("failed to verify phone number", "")
+	}
+	if pending["code"] == "" {
+		return nil, response.NewValidationError("no pending verification or code expired", "")
+	}
+
+	attempts, err := s.redis.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil {
+		s.log(ctx).Error("Failed to count verification attempt", zap.Error(err))
+		return nil, response.NewInternalError("failed to verify phone number", "")
+	}
+	if attempts > smsCodeMaxAttempts {
+		s.redis.Del(ctx, key)
+		return nil, response.NewValidationError("too many attempts, request a new code", "")
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSMSCode(req.Code)), []byte(pending["code"])) != 1 {
+		return nil, response.NewValidationError("invalid verification code", "")
+	}
+
+	now := time.Now()
+	if err := s.repo.UpsertPhone(&domain.PhoneNumber{
+		UserID:     userID,
+		Number:     pending["number"],
+		VerifiedAt: now,
+		Disabled:   false,
+		UpdatedAt:  now,
+	}); err != nil {
+		s.log(ctx).Error("VerifyPhone failed", zap.Error(err))
+		return nil, err
+	}
+	s.redis.Del(ctx, key)
+
+	s.log(ctx).Info("Phone number verified",
+		zap.String("enduser.id", userID.String()))
+	return s.GetPhone(ctx, userID)
+}
+
+// DeletePhone removes the SMS phone number of a user.
+func (s *SMSService) DeletePhone(ctx context.Context, userID uuid.UUID) error {
+	s.redis.Del(ctx, smsVerificationKey(userID))
+	deleted, err := s.repo.DeletePhone(userID)
+	if err != nil {
+		s.log(ctx).Error("DeletePhone failed", zap.Error(err))
+		return err
+	}
+	if deleted == 0 {
+		return response.NewNotFoundError("phone number not found", "")
+	}
+
+	s.log(ctx).Info("Phone number removed",
+		zap.String("enduser.id", userID.String()))
+	return nil
+}
+
+// GetWorkspaceSMS returns the SMS setting and monthly usage of a workspace.
+func (s *SMSService) GetWorkspaceSMS(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*domain.WorkspaceSMSResponse, error) {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	setting, err := s.repo.GetWorkspaceSetting(workspaceID)
+	if err != nil {
+		s.log(ctx).Error("GetWorkspaceSMS failed", zap.Error(err))
+		return nil, err
+	}
+	return s.workspaceResponse(ctx, workspaceID, setting), nil
+}
+
+// UpdateWorkspaceSMS enables or disables SMS for a workspace and sets its monthly limit.
+// 월간 한도는 서비스 설정값(SMS_WORKSPACE_MONTHLY_LIMIT)을 넘을 수 없습니다.
+func (s *SMSService) UpdateWorkspaceSMS(ctx context.Context, workspaceID, userID uuid.UUID, token string, req *domain.UpdateWorkspaceSMSRequest) (*domain.WorkspaceSMSResponse, error) {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	limit := s.config.WorkspaceMonthly
+	if req.MonthlyLimit != nil {
+		if *req.MonthlyLimit > s.config.WorkspaceMonthly {
+			return nil, response.NewValidationError("monthly limit exceeds the maximum", fmt.Sprintf("max %d", s.config.WorkspaceMonthly))
+		}
+		limit = *req.MonthlyLimit
+	}
+
+	setting := &domain.WorkspaceSMSSetting{
+		WorkspaceID:  workspaceID,
+		Enabled:      req.Enabled,
+		MonthlyLimit: limit,
+		UpdatedBy:    userID,
+		UpdatedAt:    time.Now(),
+	}
+	if err := s.repo.UpsertWorkspaceSetting(setting); err != nil {
+		s.log(ctx).Error("UpdateWorkspaceSMS failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Workspace SMS setting updated",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.Bool("sms.enabled", setting.Enabled),
+		zap.Int("sms.monthly_limit", setting.MonthlyLimit))
+	return s.workspaceResponse(ctx, workspaceID, setting), nil
+}
+
+// workspaceResponse builds the setting response with this month's usage.
+func (s *SMSService) workspaceResponse(ctx context.Context, workspaceID uuid.UUID, setting *domain.WorkspaceSMSSetting) *domain.WorkspaceSMSResponse {
+	resp := &domain.WorkspaceSMSResponse{
+		MonthlyLimit: s.config.WorkspaceMonthly,
+		Types:        make([]domain.NotificationType, 0, len(s.types)),
+	}
+	if setting != nil {
+		resp.Enabled = setting.Enabled
+		resp.MonthlyLimit = setting.MonthlyLimit
+	}
+	sent, err := s.redis.Get(ctx, smsWorkspaceKey(workspaceID, time.Now())).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		s.log(ctx).Warn("Failed to load workspace SMS usage", zap.Error(err))
+	}
+	resp.SentThisMonth = sent
+	for _, t := range domain.AllNotificationTypes {
+		if s.types[t] {
+			resp.Types = append(resp.Types, t)
+		}
+	}
+	return resp
+}
+
+// Channel implements ChannelSender.
+func (s *SMSService) Channel() domain.NotificationChannel {
+	return domain.NotificationChannelSMS
+}
+
+// Send implements ChannelSender. Critical notifications are sent in the background
+// when the workspace has SMS enabled and the user has a verified phone number.
+func (s *SMSService) Send(ctx context.Context, notification *domain.Notification) error {
+	if !s.types[notification.Type] {
+		return nil
+	}
+
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.deliver(context.WithoutCancel(ctx), notification)
+	return nil
+}
+
+// DeliveryChannel implements TargetSender.
+func (s *SMSService) DeliveryChannel() domain.DeliveryChannel {
+	return domain.DeliveryChannelSMS
+}
+
+// deliver checks the workspace setting and send limits, then submits the message.
+// 한도는 전송 전에 차감하므로 재시도는 한도를 다시 사용하지 않습니다.
+func (s *SMSService) deliver(ctx context.Context, notification *domain.Notification) {
+	setting, err := s.repo.GetWorkspaceSetting(notification.WorkspaceID)
+	if err != nil {
+		s.log(ctx).Error("Failed to load workspace SMS setting", zap.Error(err))
+		return
+	}
+	if setting == nil || !setting.Enabled {
+		return
+	}
+	if _, err := s.repo.GetActivePhone(notification.TargetUserID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.log(ctx).Error("Failed to load phone number", zap.Error(err))
+		}
+		return
+	}
+
+	if reason := s.reserve(ctx, notification, setting.MonthlyLimit, time.Now()); reason != "" {
+		s.recordSMS(reason)
+		s.log(ctx).Warn("SMS suppressed by send limit",
+			zap.String("notification.id", notification.ID.String()),
+			zap.String("workspace.id", notification.WorkspaceID.String()),
+			zap.String("sms.reason", reason))
+		return
+	}
+	s.deliveries.Submit(ctx, s, notification, []uuid.UUID{notification.TargetUserID})
+}
+
+// DeliverTo implements TargetSender. It sends a notification to the phone number of a user.
+func (s *SMSService) DeliverTo(ctx context.Context, notification *domain.Notification, userID uuid.UUID) error {
+	phone, err := s.repo.GetActivePhone(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTargetGone
+		}
+		return err
+	}
+
+	rendered := renderNotification(s.templates, notification, string(domain.NotificationChannelSMS))
+	sendCtx, cancel := context.WithTimeout(ctx, smsSendTimeout)
+	_, err = s.provider.Send(sendCtx, phone.Number, smsText(rendered))
+	cancel()
+
+	var providerErr *sms.ProviderError
+	switch {
+	case err == nil:
+		s.recordSMS("sent")
+		return nil
+	case errors.Is(err, sms.ErrInvalidNumber):
+		// 없는 번호이거나 수신 거부한 번호는 비활성화 (사용자가 다시 인증하면 복구)
+		s.recordSMS("invalid")
+		if err := s.repo.DisablePhone(userID); err != nil {
+			s.log(ctx).Warn("Failed to disable phone number", zap.Error(err))
+		}
+		return fmt.Errorf("%w: %v", ErrTargetGone, err)
+	default:
+		s.recordSMS("failed")
+		s.log(ctx).Warn("SMS delivery failed",
+			zap.String("notification.id", notification.ID.String()),
+			zap.Error(err))
+		if errors.As(err, &providerErr) && isPermanentStatus(providerErr.StatusCode) {
+			return Permanent(err)
+		}
+		return err
+	}
+}
+
+// reserve counts a message against the user, workspace and global limits.
+// It returns the metric result of the exceeded limit, or "" if the message may be sent.
+// Redis 오류 시에는 비용 보호를 위해 전송하지 않습니다.
+func (s *SMSService) reserve(ctx context.Context, notification *domain.Notification, monthlyLimit int, now time.Time) string {
+	userKey := smsUserKey(notification.TargetUserID, now)
+	allowed, err := s.incrWithin(ctx, userKey, int64(s.config.UserHourlyLimit), time.Hour)
+	if err != nil || !allowed {
+		return limitResult("user_limited", err)
+	}
+
+	workspaceKey := smsWorkspaceKey(notification.WorkspaceID, now)
+	allowed, err = s.incrWithin(ctx, workspaceKey, int64(monthlyLimit), 32*24*time.Hour)
+	if err != nil || !allowed {
+		s.redis.Decr(ctx, userKey)
+		return limitResult("workspace_limited", err)
+	}
+
+	if reason := s.reserveGlobal(ctx, now); reason != "" {
+		s.redis.Decr(ctx, userKey)
+		s.redis.Decr(ctx, workspaceKey)
+		return reason
+	}
+	return ""
+}
+
+// reserveGlobal counts a message against the daily limit of the whole service.
+func (s *SMSService) reserveGlobal(ctx context.Context, now time.Time) string {
+	allowed, err := s.incrWithin(ctx, smsDailyKey(now), int64(s.config.DailyLimit), 25*time.Hour)
+	if err != nil || !allowed {
+		return limitResult("daily_limited", err)
+	}
+	return ""
+}
+
+// incrWithin increments a counter and returns false (undoing the increment) if it exceeds limit.
+func (s *SMSService) incrWithin(ctx context.Context, key string, limit int64, ttl time.Duration) (bool, error) {
+	count, err := s.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	if count == 1 {
+		s.redis.Expire(ctx, key, ttl)
+	}
+	if count > limit {
+		s.redis.Decr(ctx, key)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (s *SMSService) recordSMS(result string) {
+	if s.metrics != nil {
+		s.metrics.RecordSMS(s.provider.Name(), result)
+	}
+}
+
+// limitResult returns the metric result of a limit check.
+func limitResult(limited string, err error) string {
+	if err != nil {
+		return "error"
+	}
+	return limited
+}
+
+// smsText joins a rendered title and body into one message that fits a single segment.
+func smsText(rendered templates.Rendered) string {
+	text := rendered.Title
+	if rendered.Body != "" {
+		text += "\n" + rendered.Body
+	}
+	return sms.FitSegment(text)
+}
+
+// generateSMSCode returns a random 6-digit verification code.
+func generateSMSCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashSMSCode hashes a verification code so Redis never holds it in plain text.
+func hashSMSCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func smsVerificationKey(userID uuid.UUID) string {
+	return "noti:sms:verify:" + userID.String()
+}
+
+func smsCooldownKey(userID uuid.UUID) string {
+	return "noti:sms:verify:cooldown:" + userID.String()
+}
+
+func smsCodeDailyKey(userID uuid.UUID, now time.Time) string {
+	return fmt.Sprintf("noti:sms:verify:daily:%s:%s", userID.String(), now.UTC().Format("20060102"))
+}
+
+func smsUserKey(userID uuid.UUID, now time.Time) string {
+	return fmt.Sprintf("noti:sms:user:%s:%s", userID.String(), now.UTC().Format("2006010215"))
+}
+
+func smsWorkspaceKey(workspaceID uuid.UUID, now time.Time) string {
+	return fmt.Sprintf("noti:sms:ws:%s:%s", workspaceID.String(), now.UTC().Format("200601"))
+}
+
+func smsDailyKey(now time.Time) string {
+	return "noti:sms:daily:" + now.UTC().Format("20060102")
+}
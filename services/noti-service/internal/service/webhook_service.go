@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"noti-service/internal/client"
+	"noti-service/internal/config"
+	"noti-service/internal/domain"
+	"noti-service/internal/metrics"
+	"noti-service/internal/repository"
+	"noti-service/internal/response"
+	"noti-service/internal/templates"
+	"noti-service/internal/webhook"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// webhookSendTimeout bounds a single delivery to a webhook endpoint.
+	webhookSendTimeout = 10 * time.Second
+	// webhookCleanupInterval is how often old delivery log entries are removed.
+	webhookCleanupInterval = time.Hour
+)
+
+// WebhookService manages outbound webhooks of workspaces and delivers notification events to them.
+// 웹훅 관리는 워크스페이스 OWNER/ADMIN만 가능하며, 전송은 DeliveryService의 재시도/dead-letter를 따르고
+// 모든 시도를 전송 기록(webhook_deliveries)에 남깁니다.
+type WebhookService struct {
+	repo       *repository.WebhookRepository
+	users      client.UserClient
+	client     *webhook.Client
+	templates  *templates.Renderer
+	deliveries *DeliveryService
+	config     config.WebhookConfig
+	logger     *zap.Logger
+	metrics    *metrics.Metrics
+}
+
+// NewWebhookService creates a new WebhookService with the given dependencies.
+func NewWebhookService(
+	repo *repository.WebhookRepository,
+	users client.UserClient,
+	cfg config.WebhookConfig,
+	renderer *templates.Renderer,
+	deliveries *DeliveryService,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) *WebhookService {
+	return &WebhookService{
+		repo:       repo,
+		users:      users,
+		client:     webhook.NewClient(webhookSendTimeout, cfg.AllowPrivateNetworks),
+		templates:  renderer,
+		deliveries: deliveries,
+		config:     cfg,
+		logger:     logger,
+		metrics:    m,
+	}
+}
+
+// log returns a trace-context aware logger
+func (s *WebhookService) log(ctx context.Context) *zap.Logger {
+	return commnotel.WithTraceContext(ctx, s.logger)
+}
+
+// ListWebhooks returns the webhooks of a workspace.
+func (s *WebhookService) ListWebhooks(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]domain.WorkspaceWebhook, error) {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.repo.GetByWorkspace(workspaceID)
+	if err != nil {
+		s.log(ctx).Error("ListWebhooks failed", zap.Error(err))
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// CreateWebhook registers a webhook endpoint and returns it with its signing secret.
+func (s *WebhookService) CreateWebhook(ctx context.Context, workspaceID, userID uuid.UUID, token string, req *domain.CreateWebhookRequest) (*domain.WebhookSecretResponse, error) {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, response.NewValidationError("name must not be empty", "")
+	}
+	if err := s.validateEndpoint(req.URL); err != nil {
+		return nil, err
+	}
+	types, err := validateWebhookTypes(req.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountByWorkspace(workspaceID)
+	if err != nil {
+		s.log(ctx).Error("CreateWebhook failed to count webhooks", zap.Error(err))
+		return nil, err
+	}
+	if s.config.MaxPerWorkspace > 0 && count >= int64(s.config.MaxPerWorkspace) {
+		return nil, response.NewValidationError("webhook limit reached", fmt.Sprintf("max %d", s.config.MaxPerWorkspace))
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, response.NewInternalError("failed to generate webhook secret", "")
+	}
+
+	now := time.Now()
+	hook := &domain.WorkspaceWebhook{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Secret:      secret,
+		Types:       types,
+		Enabled:     true,
+		CreatedBy:   userID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.Create(hook); err != nil {
+		s.log(ctx).Error("CreateWebhook failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Workspace webhook created",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("webhook.id", hook.ID.String()))
+	return &domain.WebhookSecretResponse{WorkspaceWebhook: *hook, Secret: secret}, nil
+}
+
+// UpdateWebhook renames, re-points, re-filters or enables/disables a webhook.
+// 다시 활성화하면 실패 횟수와 마지막 오류를 초기화합니다.
+func (s *WebhookService) UpdateWebhook(ctx context.Context, id, workspaceID, userID uuid.UUID, token string, req *domain.UpdateWebhookRequest) (*domain.WorkspaceWebhook, error) {
+	hook, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, response.NewValidationError("name must not be empty", "")
+		}
+		hook.Name = *req.Name
+	}
+	if req.URL != nil {
+		if err := s.validateEndpoint(*req.URL); err != nil {
+			return nil, err
+		}
+		hook.URL = *req.URL
+	}
+	if req.Types != nil {
+		if hook.Types, err = validateWebhookTypes(*req.Types); err != nil {
+			return nil, err
+		}
+	}
+	if req.Enabled != nil {
+		if *req.Enabled && !hook.Enabled {
+			hook.FailureCount = 0
+			hook.LastError = nil
+		}
+		hook.Enabled = *req.Enabled
+	}
+
+	if err := s.repo.Update(hook); err != nil {
+		s.log(ctx).Error("UpdateWebhook failed", zap.Error(err))
+		return nil, err
+	}
+	return hook, nil
+}
+
+// RotateSecret replaces the signing secret of a webhook and returns the new one.
+func (s *WebhookService) RotateSecret(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) (*domain.WebhookSecretResponse, error) {
+	hook, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if hook.Secret, err = webhook.GenerateSecret(); err != nil {
+		return nil, response.NewInternalError("failed to generate webhook secret", "")
+	}
+	if err := s.repo.Update(hook); err != nil {
+		s.log(ctx).Error("RotateSecret failed", zap.Error(err))
+		return nil, err
+	}
+
+	s.log(ctx).Info("Webhook secret rotated",
+		zap.String("webhook.id", hook.ID.String()))
+	return &domain.WebhookSecretResponse{WorkspaceWebhook: *hook, Secret: hook.Secret}, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery log.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) error {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.Delete(id, workspaceID)
+	if err != nil {
+		s.log(ctx).Error("DeleteWebhook failed", zap.Error(err))
+		return err
+	}
+	if deleted == 0 {
+		return response.NewNotFoundError("webhook not found", "")
+	}
+
+	s.log(ctx).Info("Workspace webhook deleted",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("webhook.id", id.String()))
+	return nil
+}
+
+// TestWebhook posts a test event so admins can verify the endpoint and its signature check.
+func (s *WebhookService) TestWebhook(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) (*domain.WebhookDelivery, error) {
+	hook, err := s.getForAdmin(ctx, id, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &domain.WebhookEvent{
+		ID:          uuid.New(),
+		Type:        domain.WebhookEventNotificationCreated,
+		WorkspaceID: workspaceID,
+		CreatedAt:   time.Now(),
+		Notification: domain.WebhookNotification{
+			ID:           uuid.New(),
+			Type:         domain.NotificationTypeBoardUpdated,
+			ActorID:      userID,
+			TargetUserID: userID,
+			ResourceType: domain.ResourceTypeBoard,
+			ResourceID:   uuid.New(),
+			Title:        "weAlist 웹훅 테스트",
+			Body:         fmt.Sprintf("'%s' 웹훅이 정상적으로 설정되었습니다.", hook.Name),
+			CreatedAt:    time.Now(),
+		},
+		Test: true,
+	}
+	delivery, err := s.post(ctx, hook, event)
+	if err != nil {
+		s.log(ctx).Warn("Webhook test delivery failed",
+			zap.String("webhook.id", hook.ID.String()),
+			zap.Error(err))
+	}
+	return delivery, nil
+}
+
+// ListDeliveries returns the delivery log of a webhook, newest first.
+func (s *WebhookService) ListDeliveries(ctx context.Context, id, workspaceID, userID uuid.UUID, token string, filter *domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, error) {
+	if _, err := s.getForAdmin(ctx, id, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 || filter.Limit > 200 {
+		filter.Limit = 50
+	}
+
+	deliveries, err := s.repo.ListDeliveries(id, filter)
+	if err != nil {
+		s.log(ctx).Error("ListDeliveries failed", zap.Error(err))
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Dispatch delivers a notification to the webhooks of its workspace that accept its type.
+// Deliveries run in the background.
+func (s *WebhookService) Dispatch(ctx context.Context, notification *domain.Notification) {
+	// 요청 컨텍스트가 끝나도 전송은 계속되도록 취소를 분리
+	go s.deliver(context.WithoutCancel(ctx), notification)
+}
+
+// DeliveryChannel implements TargetSender.
+func (s *WebhookService) DeliveryChannel() domain.DeliveryChannel {
+	return domain.DeliveryChannelWebhook
+}
+
+// deliver submits a notification to every matching webhook of its workspace.
+func (s *WebhookService) deliver(ctx context.Context, notification *domain.Notification) {
+	webhooks, err := s.repo.GetEnabledByWorkspace(notification.WorkspaceID)
+	if err != nil {
+		s.log(ctx).Error("Failed to load workspace webhooks", zap.Error(err))
+		return
+	}
+
+	var targets []uuid.UUID
+	for i := range webhooks {
+		if webhooks[i].Accepts(notification.Type) {
+			targets = append(targets, webhooks[i].ID)
+		}
+	}
+	s.deliveries.Submit(ctx, s, notification, targets)
+}
+
+// DeliverTo implements TargetSender. It posts a notification event to one webhook if it still accepts the type.
+func (s *WebhookService) DeliverTo(ctx context.Context, notification *domain.Notification, webhookID uuid.UUID) error {
+	hook, err := s.repo.GetEnabledByID(webhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 재시도 사이에 웹훅이 비활성화/삭제된 경우
+			return ErrTargetGone
+		}
+		return err
+	}
+	if !hook.Accepts(notification.Type) {
+		return ErrTargetGone
+	}
+
+	_, err = s.post(ctx, hook, buildWebhookEvent(notification, s.templates))
+	if err == nil {
+		return nil
+	}
+
+	var deliveryErr *webhook.DeliveryError
+	switch {
+	case errors.Is(err, webhook.ErrPrivateAddress):
+		return Permanent(err)
+	case errors.As(err, &deliveryErr) && isPermanentStatus(deliveryErr.StatusCode):
+		return Permanent(err)
+	}
+	return err
+}
+
+// post sends an event to a webhook and records the attempt in its delivery log.
+// 연속 실패가 DisableAfterFailures에 도달하면 웹훅을 비활성화합니다.
+func (s *WebhookService) post(ctx context.Context, hook *domain.WorkspaceWebhook, event *domain.WebhookEvent) (*domain.WebhookDelivery, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, Permanent(err)
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, webhookSendTimeout)
+	result, err := s.client.Post(sendCtx, hook.URL, hook.Secret, event.ID.String(), event.Type, body)
+	cancel()
+
+	delivery := &domain.WebhookDelivery{
+		ID:             uuid.New(),
+		WebhookID:      hook.ID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		StatusCode:     result.StatusCode,
+		Success:        err == nil,
+		DurationMillis: result.Duration.Milliseconds(),
+		CreatedAt:      time.Now(),
+	}
+	outcome := "sent"
+	disable := false
+	if err != nil {
+		delivery.Error = truncateError(err)
+		outcome = "failed"
+		disable = s.config.DisableAfterFailures > 0 && hook.FailureCount+1 >= s.config.DisableAfterFailures
+		if disable {
+			outcome = "disabled"
+			s.log(ctx).Warn("Webhook disabled after consecutive failures",
+				zap.String("webhook.id", hook.ID.String()),
+				zap.Int("webhook.failures", hook.FailureCount+1))
+		} else {
+			s.log(ctx).Warn("Webhook delivery failed",
+				zap.String("webhook.id", hook.ID.String()),
+				zap.String("event.id", event.ID.String()),
+				zap.Int("http.status_code", result.StatusCode),
+				zap.Error(err))
+		}
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordWebhookDelivery(outcome)
+	}
+	if err := s.repo.RecordDelivery(delivery, disable); err != nil {
+		s.log(ctx).Warn("Failed to record webhook delivery", zap.Error(err))
+	}
+	return delivery, err
+}
+
+// StartLogCleanup removes delivery log entries older than the retention period until the context is canceled.
+func (s *WebhookService) StartLogCleanup(ctx context.Context) {
+	if s.config.LogRetentionDays <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(webhookCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			before := time.Now().AddDate(0, 0, -s.config.LogRetentionDays)
+			deleted, err := s.repo.CleanupDeliveries(before)
+			if err != nil {
+				s.logger.Warn("Failed to clean up webhook delivery log", zap.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.logger.Info("Webhook delivery log cleaned up", zap.Int64("deleted", deleted))
+			}
+		}
+	}
+}
+
+// getForAdmin loads a webhook after checking workspace admin permission.
+func (s *WebhookService) getForAdmin(ctx context.Context, id, workspaceID, userID uuid.UUID, token string) (*domain.WorkspaceWebhook, error) {
+	if err := requireWorkspaceAdmin(ctx, s.users, s.log(ctx), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	hook, err := s.repo.GetByID(id, workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("webhook not found", "")
+		}
+		s.log(ctx).Error("Failed to load webhook", zap.Error(err))
+		return nil, err
+	}
+	return hook, nil
+}
+
+// validateEndpoint rejects endpoints the service must not call.
+// 기본적으로 https와 공개 호스트만 허용하며, 사설망 허용 시 사내 도구용 http도 허용합니다.
+// 호스트 이름이 사설 주소로 해석되는 경우는 전송 시점에 webhook.Client가 차단합니다.
+func (s *WebhookService) validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" || u.User != nil {
+		return response.NewValidationError("invalid webhook url", "")
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && s.config.AllowPrivateNetworks) {
+		return response.NewValidationError("webhook url must use https", "")
+	}
+	if s.config.AllowPrivateNetworks {
+		return nil
+	}
+	if ip := net.ParseIP(u.Hostname()); (ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified())) ||
+		u.Hostname() == "localhost" {
+		return response.NewValidationError("webhook url must be a public host", "")
+	}
+	return nil
+}
+
+// validateWebhookTypes checks a type filter, removing duplicates. Empty means all types.
+func validateWebhookTypes(types []domain.NotificationType) ([]domain.NotificationType, error) {
+	seen := make(map[domain.NotificationType]bool)
+	result := make([]domain.NotificationType, 0, len(types))
+	for _, t := range types {
+		if !t.IsValid() {
+			return nil, response.NewValidationError("invalid notification type", string(t))
+		}
+		if !seen[t] {
+			seen[t] = true
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+// buildWebhookEvent converts a notification into the event posted to webhooks.
+// 이벤트 ID는 알림 ID를 사용하므로 재시도에도 같아 수신 측에서 중복을 제거할 수 있습니다.
+func buildWebhookEvent(notification *domain.Notification, renderer *templates.Renderer) *domain.WebhookEvent {
+	rendered := renderNotification(renderer, notification, templates.ChannelDefault)
+	return &domain.WebhookEvent{
+		ID:          notification.ID,
+		Type:        domain.WebhookEventNotificationCreated,
+		WorkspaceID: notification.WorkspaceID,
+		CreatedAt:   notification.CreatedAt,
+		Notification: domain.WebhookNotification{
+			ID:           notification.ID,
+			Type:         notification.Type,
+			ActorID:      notification.ActorID,
+			TargetUserID: notification.TargetUserID,
+			ResourceType: notification.ResourceType,
+			ResourceID:   notification.ResourceID,
+			ResourceName: notification.ResourceName,
+			Title:        rendered.Title,
+			Body:         rendered.Body,
+			Metadata:     notification.Metadata,
+			CreatedAt:    notification.CreatedAt,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// Package sms delivers text messages through Twilio or Amazon SNS.
+//
+// 외부 SDK 없이 표준 라이브러리로 Twilio REST API와 SNS Publish(SigV4 서명)를 구현합니다.
+// 비용이 발생하는 채널이므로 호출자가 대상 타입, 워크스페이스 활성화, 전송량 한도를 관리합니다.
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidNumber is returned when the provider reports that a phone number cannot receive messages.
+// 존재하지 않는 번호, 수신 거부(STOP) 등 재시도로 해결되지 않으므로 호출자가 번호를 비활성화해야 합니다.
+var ErrInvalidNumber = errors.New("phone number cannot receive messages")
+
+// e164Pattern matches phone numbers in E.164 format (+ country code and subscriber number)
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// Provider sends text messages.
+type Provider interface {
+	// Name returns the provider name used in metrics.
+	Name() string
+	// Send delivers a message to a phone number in E.164 format and returns the provider message ID.
+	Send(ctx context.Context, to, body string) (string, error)
+}
+
+// ProviderError is a delivery failure reported by an SMS provider.
+type ProviderError struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("sms provider returned status %d: %s", e.StatusCode, e.Reason)
+}
+
+// ValidatePhoneNumber checks that a phone number is in E.164 format.
+func ValidatePhoneNumber(number string) bool {
+	return e164Pattern.MatchString(number)
+}
+
+// MaskPhoneNumber hides all but the country code prefix and the last four digits.
+func MaskPhoneNumber(number string) string {
+	if len(number) <= 7 {
+		return strings.Repeat("*", len(number))
+	}
+	return number[:3] + strings.Repeat("*", len(number)-7) + number[len(number)-4:]
+}
+
+// FitSegment shortens text to a single SMS segment (160 GSM-7 or 70 UCS-2 characters).
+// SMS는 세그먼트 단위로 과금되므로 한 세그먼트를 넘지 않도록 자릅니다.
+// ASCII가 아닌 문자(한글 등)가 포함되면 UCS-2로 인코딩되어 70자로 제한됩니다.
+func FitSegment(text string) string {
+	limit := 160
+	for _, r := range text {
+		if r > 0x7f {
+			limit = 70
+			break
+		}
+	}
+	return truncate(text, limit)
+}
+
+// truncate shortens text to at most max runes.
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
+}
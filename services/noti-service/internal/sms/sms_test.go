@@ -0,0 +1,114 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePhoneNumber(t *testing.T) {
+	assert.True(t, ValidatePhoneNumber("+821012345678"))
+	assert.True(t, ValidatePhoneNumber("+14155550100"))
+
+	// 국가 코드 없는 번호, 구분자 포함, 너무 짧거나 긴 번호는 거부
+	assert.False(t, ValidatePhoneNumber("01012345678"))
+	assert.False(t, ValidatePhoneNumber("+82-10-1234-5678"))
+	assert.False(t, ValidatePhoneNumber("+8210"))
+	assert.False(t, ValidatePhoneNumber("+0123456789"))
+	assert.False(t, ValidatePhoneNumber("+1234567890123456"))
+}
+
+func TestMaskPhoneNumber(t *testing.T) {
+	assert.Equal(t, "+82******5678", MaskPhoneNumber("+821012345678"))
+	assert.Equal(t, "*****", MaskPhoneNumber("+8210"))
+}
+
+func TestFitSegment(t *testing.T) {
+	assert.Equal(t, "short", FitSegment("short"))
+	assert.Len(t, []rune(FitSegment(strings.Repeat("a", 200))), 160)
+	// 한글이 포함되면 UCS-2 세그먼트(70자)
+	assert.Len(t, []rune(FitSegment(strings.Repeat("가", 100))), 70)
+}
+
+func TestTwilioClient_Send(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+
+		if form.Get("To") == "+821000000000" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"code":21211,"message":"Invalid 'To' Phone Number","status":400}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"sid":"SM123"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewTwilioClient(TwilioConfig{AccountSID: "AC123", AuthToken: "secret", From: "MG999"}, time.Second)
+	require.NoError(t, err)
+	client.apiURL = server.URL
+
+	id, err := client.Send(context.Background(), "+821012345678", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "SM123", id)
+	// MG로 시작하는 발신자는 Messaging Service로 전송
+	assert.Equal(t, "MG999", form.Get("MessagingServiceSid"))
+	assert.Equal(t, "hello", form.Get("Body"))
+
+	_, err = client.Send(context.Background(), "+821000000000", "hello")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func TestSNSClient_Send(t *testing.T) {
+	var authorization string
+	var form url.Values
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = io.WriteString(w, `<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`)
+			return
+		}
+		_, _ = io.WriteString(w, `<PublishResponse><PublishResult><MessageId>abc-123</MessageId></PublishResult></PublishResponse>`)
+	}))
+	defer server.Close()
+
+	client, err := NewSNSClient(SNSConfig{Region: "ap-northeast-2", AccessKeyID: "AKID", SecretAccessKey: "secret", SenderID: "weAlist"}, time.Second)
+	require.NoError(t, err)
+	client.endpoint = server.URL + "/"
+	client.now = func() time.Time { return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	id, err := client.Send(context.Background(), "+821012345678", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "abc-123", id)
+	assert.Equal(t, "Publish", form.Get("Action"))
+	assert.Equal(t, "Transactional", form.Get("MessageAttributes.entry.1.Value.StringValue"))
+	assert.Equal(t, "weAlist", form.Get("MessageAttributes.entry.2.Value.StringValue"))
+	assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKID/20240301/ap-northeast-2/sns/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature="))
+
+	status = http.StatusBadRequest
+	_, err = client.Send(context.Background(), "+821012345678", "hello")
+	var providerErr *ProviderError
+	require.True(t, errors.As(err, &providerErr))
+	assert.Equal(t, http.StatusBadRequest, providerErr.StatusCode)
+	assert.Contains(t, providerErr.Reason, "Throttling")
+}
@@ -0,0 +1,184 @@
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const snsAPIVersion = "2010-03-31"
+
+// SNSConfig holds Amazon SNS credentials.
+type SNSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Temporary credentials only
+	SenderID        string // Alphanumeric sender ID, where supported by the destination country
+}
+
+// SNSClient sends messages with the Amazon SNS Publish API.
+type SNSClient struct {
+	config     SNSConfig
+	endpoint   string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewSNSClient creates an SNSClient.
+func NewSNSClient(cfg SNSConfig, timeout time.Duration) (*SNSClient, error) {
+	if cfg.Region == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("sns region and access keys are required")
+	}
+	return &SNSClient{
+		config:     cfg,
+		endpoint:   fmt.Sprintf("https://sns.%s.amazonaws.com/", cfg.Region),
+		httpClient: &http.Client{Timeout: timeout},
+		now:        time.Now,
+	}, nil
+}
+
+// snsPublishResponse is the XML response of Publish.
+type snsPublishResponse struct {
+	MessageID string `xml:"PublishResult>MessageId"`
+}
+
+// snsErrorResponse is the XML error response of the SNS query API.
+type snsErrorResponse struct {
+	Code    string `xml:"Error>Code"`
+	Message string `xml:"Error>Message"`
+}
+
+// Name implements Provider.
+func (c *SNSClient) Name() string {
+	return "sns"
+}
+
+// Send implements Provider.
+func (c *SNSClient) Send(ctx context.Context, to, body string) (string, error) {
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", snsAPIVersion)
+	form.Set("PhoneNumber", to)
+	form.Set("Message", body)
+	// 알림은 마케팅(Promotional)보다 전달 신뢰성이 높은 Transactional 유형으로 전송
+	form.Set("MessageAttributes.entry.1.Name", "AWS.SNS.SMS.SMSType")
+	form.Set("MessageAttributes.entry.1.Value.DataType", "String")
+	form.Set("MessageAttributes.entry.1.Value.StringValue", "Transactional")
+	if c.config.SenderID != "" {
+		form.Set("MessageAttributes.entry.2.Name", "AWS.SNS.SMS.SenderID")
+		form.Set("MessageAttributes.entry.2.Value.DataType", "String")
+		form.Set("MessageAttributes.entry.2.Value.StringValue", c.config.SenderID)
+	}
+	payload := []byte(form.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(string(payload)))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	c.sign(req, payload, c.now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= 300 {
+		var result snsErrorResponse
+		_ = xml.Unmarshal(data, &result)
+		reason := result.Message
+		if result.Code != "" {
+			reason = result.Code + ": " + result.Message
+		}
+		if reason == "" {
+			reason = http.StatusText(resp.StatusCode)
+		}
+		if result.Code == "InvalidParameter" && strings.Contains(result.Message, "PhoneNumber") {
+			return "", fmt.Errorf("%w: %s", ErrInvalidNumber, reason)
+		}
+		return "", &ProviderError{StatusCode: resp.StatusCode, Reason: reason}
+	}
+
+	var result snsPublishResponse
+	if err := xml.Unmarshal(data, &result); err != nil {
+		return "", fmt.Errorf("invalid sns response: %w", err)
+	}
+	return result.MessageID, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to the request.
+func (c *SNSClient) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := []string{"content-type", "host", "x-amz-date"}
+	values := map[string]string{
+		"content-type": req.Header.Get("Content-Type"),
+		"host":         req.URL.Host,
+		"x-amz-date":   amzDate,
+	}
+	if c.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.config.SessionToken)
+		headers = append(headers, "x-amz-security-token")
+		values["x-amz-security-token"] = c.config.SessionToken
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headers {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(values[name]) + "\n")
+	}
+	signedHeaders := strings.Join(headers, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+
+	scope := date + "/" + c.config.Region + "/sns/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.config.SecretAccessKey), date)
+	key = hmacSHA256(key, c.config.Region)
+	key = hmacSHA256(key, "sns")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,98 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTwilioAPIURL = "https://api.twilio.com"
+
+// Twilio error codes that mean the number can never receive messages
+// 21211: 잘못된 번호, 21614: 휴대폰 번호 아님, 21610: 수신 거부(STOP)
+var twilioInvalidNumberCodes = map[int]bool{21211: true, 21614: true, 21610: true}
+
+// TwilioConfig holds Twilio credentials.
+type TwilioConfig struct {
+	AccountSID string
+	AuthToken  string
+	From       string // Sender number, or a Messaging Service SID (MG...)
+}
+
+// TwilioClient sends messages with the Twilio Messages API.
+type TwilioClient struct {
+	config     TwilioConfig
+	apiURL     string
+	httpClient *http.Client
+}
+
+// NewTwilioClient creates a TwilioClient.
+func NewTwilioClient(cfg TwilioConfig, timeout time.Duration) (*TwilioClient, error) {
+	if cfg.AccountSID == "" || cfg.AuthToken == "" || cfg.From == "" {
+		return nil, fmt.Errorf("twilio account sid, auth token and sender are required")
+	}
+	return &TwilioClient{
+		config:     cfg,
+		apiURL:     defaultTwilioAPIURL,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// twilioResponse is the subset of a message resource or error response used by the client.
+type twilioResponse struct {
+	SID     string `json:"sid"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Name implements Provider.
+func (c *TwilioClient) Name() string {
+	return "twilio"
+}
+
+// Send implements Provider.
+func (c *TwilioClient) Send(ctx context.Context, to, body string) (string, error) {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("Body", body)
+	if strings.HasPrefix(c.config.From, "MG") {
+		form.Set("MessagingServiceSid", c.config.From)
+	} else {
+		form.Set("From", c.config.From)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", c.apiURL, url.PathEscape(c.config.AccountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(c.config.AccountSID, c.config.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result twilioResponse
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	_ = json.Unmarshal(data, &result)
+
+	if resp.StatusCode >= 300 {
+		if twilioInvalidNumberCodes[result.Code] {
+			return "", fmt.Errorf("%w: twilio error %d: %s", ErrInvalidNumber, result.Code, result.Message)
+		}
+		reason := result.Message
+		if reason == "" {
+			reason = http.StatusText(resp.StatusCode)
+		}
+		return "", &ProviderError{StatusCode: resp.StatusCode, Reason: reason}
+	}
+	return result.SID, nil
+}
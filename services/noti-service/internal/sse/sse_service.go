@@ -115,6 +115,12 @@ func (s *SSEService) subscribeToUserChannel(ctx context.Context, client *SSEClie
 				continue
 			}
 
+			// 읽음 상태 등 알림 외 이벤트는 {"event", "data"} 형태로 발행됨
+			if event, ok := notification["event"].(string); ok && event != "" {
+				s.sendEvent(client, event, notification["data"])
+				continue
+			}
+
 			s.sendEvent(client, "notification", notification)
 		}
 	}
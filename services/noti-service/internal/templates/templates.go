@@ -0,0 +1,277 @@
+// Package templates renders notification titles and bodies per type,
+// channel and locale.
+//
+// 문구는 locales/<locale>.yaml에 정의하며, 운영 환경에서는 디렉터리(ConfigMap 등)의
+// 같은 이름 파일로 기본 문구를 덮어쓰거나 새 언어를 추가할 수 있습니다.
+// 새 알림 타입이나 언어를 추가할 때 채널별 전송 코드를 수정할 필요가 없습니다.
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultType holds the fallback templates for types without their own entry.
+	DefaultType = "_default"
+	// ChannelDefault holds the templates used when a channel has no variant.
+	ChannelDefault = "default"
+	// ChannelIntegration is the variant for workspace chat channels (Slack/Teams).
+	ChannelIntegration = "INTEGRATION"
+)
+
+//go:embed locales/*.yaml
+var builtinFS embed.FS
+
+// Data is the template context of a notification.
+type Data struct {
+	Type         string
+	ResourceType string
+	ResourceName string
+	Count        int // Number of grouped events (1 if not grouped)
+	Metadata     map[string]interface{}
+}
+
+// Others returns the number of grouped events besides the latest one.
+func (d Data) Others() int {
+	if d.Count <= 1 {
+		return 0
+	}
+	return d.Count - 1
+}
+
+// Meta returns a metadata value as a string, or "" if missing.
+func (d Data) Meta(key string) string {
+	value, ok := d.Metadata[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// Rendered is a localized notification text.
+type Rendered struct {
+	Title string
+	Body  string
+}
+
+// entry is the parsed title/body of one type and channel. Nil fields fall back.
+type entry struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// fileEntry is the YAML form of an entry.
+type fileEntry struct {
+	Title *string `yaml:"title"`
+	Body  *string `yaml:"body"`
+}
+
+// catalog maps type -> channel -> entry for one locale.
+type catalog map[string]map[string]*entry
+
+// Renderer renders notification texts with locale and channel fallback.
+type Renderer struct {
+	locales       map[string]catalog
+	defaultLocale string
+}
+
+// Builtin returns a Renderer with the built-in templates only.
+// 내장 템플릿은 테스트로 검증되므로 실패 시 panic합니다.
+func Builtin(defaultLocale string) *Renderer {
+	r, err := Load("", defaultLocale)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Load parses the built-in templates and overlays the <locale>.yaml files in dir, if set.
+func Load(dir, defaultLocale string) (*Renderer, error) {
+	r := &Renderer{
+		locales:       make(map[string]catalog),
+		defaultLocale: defaultLocale,
+	}
+
+	builtin, err := fs.Sub(builtinFS, "locales")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadFS(builtin); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := r.loadFS(os.DirFS(dir)); err != nil {
+			return nil, fmt.Errorf("failed to load templates from %s: %w", dir, err)
+		}
+	}
+
+	defaults, ok := r.locales[defaultLocale]
+	if !ok {
+		return nil, fmt.Errorf("default locale %q has no templates", defaultLocale)
+	}
+	fallback := defaults[DefaultType][ChannelDefault]
+	if fallback == nil || fallback.title == nil {
+		return nil, fmt.Errorf("default locale %q must define %s.%s.title", defaultLocale, DefaultType, ChannelDefault)
+	}
+	return r, nil
+}
+
+// loadFS parses every <locale>.yaml file of a file system.
+func (r *Renderer) loadFS(fsys fs.FS) error {
+	files, err := fs.Glob(fsys, "*.yaml")
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		locale := strings.TrimSuffix(filepath.Base(name), ".yaml")
+		if err := r.parse(locale, data); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// parse merges a locale file into the catalog, overriding existing entries field by field.
+func (r *Renderer) parse(locale string, data []byte) error {
+	var file map[string]map[string]fileEntry
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	cat := r.locales[locale]
+	if cat == nil {
+		cat = make(catalog)
+		r.locales[locale] = cat
+	}
+	for notificationType, channels := range file {
+		if cat[notificationType] == nil {
+			cat[notificationType] = make(map[string]*entry)
+		}
+		for channel, fe := range channels {
+			e := cat[notificationType][channel]
+			if e == nil {
+				e = &entry{}
+				cat[notificationType][channel] = e
+			}
+			name := locale + "." + notificationType + "." + channel
+			if fe.Title != nil {
+				t, err := parseTemplate(name+".title", *fe.Title)
+				if err != nil {
+					return err
+				}
+				e.title = t
+			}
+			if fe.Body != nil {
+				t, err := parseTemplate(name+".body", *fe.Body)
+				if err != nil {
+					return err
+				}
+				e.body = t
+			}
+		}
+	}
+	return nil
+}
+
+// parseTemplate parses a template and checks that it executes against sample data.
+func parseTemplate(name, text string) (*template.Template, error) {
+	t, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	sample := Data{Type: "SAMPLE", ResourceName: "sample", Count: 2, Metadata: map[string]interface{}{}}
+	if err := t.Execute(&bytes.Buffer{}, sample); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DefaultLocale returns the locale used when a user has not chosen one.
+func (r *Renderer) DefaultLocale() string {
+	return r.defaultLocale
+}
+
+// Locales returns the available locales in sorted order.
+func (r *Renderer) Locales() []string {
+	locales := make([]string, 0, len(r.locales))
+	for locale := range r.locales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Resolve returns the best available locale for a requested one ("ko-KR" -> "ko"), or "" if none matches.
+func (r *Renderer) Resolve(locale string) string {
+	if locale == "" {
+		return ""
+	}
+	if _, ok := r.locales[locale]; ok {
+		return locale
+	}
+	base, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	base = strings.ToLower(base)
+	if _, ok := r.locales[base]; ok {
+		return base
+	}
+	return ""
+}
+
+// Render renders the title and body of a notification.
+// 각 필드는 (요청 언어 → 기본 언어) 순서로, 언어마다 타입+채널 → 타입 기본 → 공통+채널 → 공통 기본을 찾습니다.
+func (r *Renderer) Render(locale, notificationType, channel string, data Data) Rendered {
+	chain := []string{r.defaultLocale}
+	if resolved := r.Resolve(locale); resolved != "" && resolved != r.defaultLocale {
+		chain = []string{resolved, r.defaultLocale}
+	}
+
+	title, err := r.execute(chain, notificationType, channel, data, func(e *entry) *template.Template { return e.title })
+	if err != nil || title == "" {
+		title = notificationType
+	}
+	body, _ := r.execute(chain, notificationType, channel, data, func(e *entry) *template.Template { return e.body })
+
+	return Rendered{Title: title, Body: body}
+}
+
+// execute runs the first template found along the fallback chain.
+func (r *Renderer) execute(chain []string, notificationType, channel string, data Data, field func(*entry) *template.Template) (string, error) {
+	for _, locale := range chain {
+		cat := r.locales[locale]
+		for _, key := range [][2]string{
+			{notificationType, channel},
+			{notificationType, ChannelDefault},
+			{DefaultType, channel},
+			{DefaultType, ChannelDefault},
+		} {
+			e := cat[key[0]][key[1]]
+			if e == nil || field(e) == nil {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := field(e).Execute(&buf, data); err != nil {
+				continue
+			}
+			return strings.TrimSpace(buf.String()), nil
+		}
+	}
+	return "", errors.New("no template found")
+}
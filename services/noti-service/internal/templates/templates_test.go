@@ -0,0 +1,91 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltin_Locales(t *testing.T) {
+	r := Builtin("ko")
+
+	assert.Equal(t, "ko", r.DefaultLocale())
+	assert.Equal(t, []string{"en", "ko"}, r.Locales())
+	assert.Equal(t, "en", r.Resolve("en-US"))
+	assert.Equal(t, "ko", r.Resolve("ko_KR"))
+	assert.Equal(t, "", r.Resolve("fr"))
+	assert.Equal(t, "", r.Resolve(""))
+}
+
+func TestRender_Fallback(t *testing.T) {
+	r := Builtin("ko")
+	data := Data{ResourceName: "Release checklist", Count: 1, Metadata: map[string]interface{}{"newStatus": "DONE"}}
+
+	// 타입 기본 문구
+	rendered := r.Render("ko", "BOARD_STATUS_CHANGED", ChannelDefault, data)
+	assert.Equal(t, "보드 상태가 변경되었습니다", rendered.Title)
+	assert.Equal(t, "Release checklist → DONE", rendered.Body)
+
+	// 채널 변형이 있으면 우선 사용
+	assert.Equal(t, "보드 담당자가 지정되었습니다", r.Render("ko", "BOARD_ASSIGNED", ChannelIntegration, data).Title)
+
+	// 채널 변형이 없으면 타입 기본 문구, 없는 언어는 기본 언어로 대체
+	assert.Equal(t, "보드에 담당자로 지정되었습니다", r.Render("fr", "BOARD_ASSIGNED", "PUSH", data).Title)
+
+	// 모르는 타입은 공통 문구
+	rendered = r.Render("en", "SOMETHING_NEW", ChannelDefault, data)
+	assert.NotEmpty(t, rendered.Title)
+	assert.Equal(t, "Release checklist", rendered.Body)
+}
+
+func TestRender_GroupedCount(t *testing.T) {
+	r := Builtin("ko")
+
+	single := r.Render("ko", "BOARD_COMMENT_ADDED", ChannelDefault, Data{Count: 1})
+	grouped := r.Render("ko", "BOARD_COMMENT_ADDED", ChannelDefault, Data{Count: 3})
+
+	assert.NotContains(t, single.Title, "3")
+	assert.Contains(t, grouped.Title, "3")
+}
+
+func TestLoad_DirOverride(t *testing.T) {
+	dir := t.TempDir()
+	// 기존 언어의 일부 문구 덮어쓰기와 새 언어 추가
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ko.yaml"), []byte(`
+BOARD_ASSIGNED:
+  default:
+    title: "{{.ResourceName}} 담당자 지정"
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ja.yaml"), []byte(`
+_default:
+  default:
+    title: "新しい通知があります"
+`), 0o644))
+
+	r, err := Load(dir, "ko")
+	require.NoError(t, err)
+
+	data := Data{ResourceName: "Roadmap"}
+	assert.Equal(t, "Roadmap 담당자 지정", r.Render("ko", "BOARD_ASSIGNED", ChannelDefault, data).Title)
+	// 덮어쓰지 않은 채널 변형은 그대로 유지
+	assert.Equal(t, "보드 담당자가 지정되었습니다", r.Render("ko", "BOARD_ASSIGNED", ChannelIntegration, data).Title)
+	assert.Equal(t, "新しい通知があります", r.Render("ja", "BOARD_ASSIGNED", ChannelDefault, data).Title)
+	assert.Contains(t, r.Locales(), "ja")
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load("", "fr")
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ko.yaml"), []byte(`
+BOARD_ASSIGNED:
+  default:
+    title: "{{.ResourceName"
+`), 0o644))
+	_, err = Load(dir, "ko")
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Package webhook posts signed JSON events to workspace webhook endpoints.
+//
+// 수신 측은 X-Wealist-Signature 헤더의 HMAC-SHA256 서명으로 요청이 weAlist에서 왔는지,
+// 타임스탬프로 재전송 공격이 아닌지 확인할 수 있습니다.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader carries "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">".
+	SignatureHeader = "X-Wealist-Signature"
+	// EventHeader carries the event type.
+	EventHeader = "X-Wealist-Event"
+	// EventIDHeader carries the event ID, identical across retries so receivers can deduplicate.
+	EventIDHeader = "X-Wealist-Event-Id"
+	// secretPrefix marks webhook signing secrets.
+	secretPrefix = "whsec_"
+)
+
+// ErrInvalidSignature is returned by Verify when a signature does not match.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrPrivateAddress is returned when an endpoint resolves to a loopback, private or link-local address.
+var ErrPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// DeliveryError is a non-2xx response of a webhook endpoint.
+type DeliveryError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("webhook endpoint returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// Result describes one delivery attempt.
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Client posts events to webhook endpoints.
+type Client struct {
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewClient creates a Client. Redirects are not followed so an endpoint cannot bounce requests to another host.
+// allowPrivate가 false이면 연결 시점에 사설/루프백/링크 로컬 주소를 차단하여 SSRF를 막습니다.
+// (DNS 재바인딩도 실제 연결 주소로 검사하므로 우회할 수 없습니다)
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// isPrivateIP returns true for addresses that must not be reachable from webhooks.
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// Post sends a signed event body to an endpoint.
+// 응답이 2xx가 아니면 *DeliveryError를 반환합니다.
+func (c *Client) Post(ctx context.Context, endpoint, secret, eventID, eventType string, body []byte) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "weAlist-Webhooks/1.0")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(EventIDHeader, eventID)
+	req.Header.Set(SignatureHeader, Sign(secret, c.now(), body))
+
+	started := time.Now()
+	resp, err := c.httpClient.Do(req)
+	result := Result{Duration: time.Since(started)}
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, &DeliveryError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
+	}
+	return result, nil
+}
+
+// Sign returns the signature header value of a body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + computeSignature(secret, t, body)
+}
+
+// Verify checks a signature header against a body, rejecting timestamps older than tolerance.
+// 수신 측 구현 예시이자 테스트용입니다.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature = value
+		}
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || signature == "" {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(computeSignature(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// GenerateSecret returns a new random signing secret.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
+
+func computeSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"type":"notification.created"}`)
+	header := Sign("whsec_test", now, body)
+
+	assert.True(t, strings.HasPrefix(header, "t=1700000000,v1="))
+	assert.NoError(t, Verify("whsec_test", header, body, now.Add(time.Minute), 5*time.Minute))
+
+	// 다른 비밀키, 변조된 본문, 오래된 타임스탬프는 거부
+	assert.ErrorIs(t, Verify("whsec_other", header, body, now, 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("whsec_test", header, []byte(`{}`), now, 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("whsec_test", header, body, now.Add(time.Hour), 5*time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("whsec_test", "garbage", body, now, 5*time.Minute), ErrInvalidSignature)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(a, "whsec_"))
+	assert.Len(t, a, len("whsec_")+64)
+	assert.NotEqual(t, a, b)
+}
+
+func TestClient_Post(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "notification.created", r.Header.Get(EventHeader))
+		assert.Equal(t, "evt-1", r.Header.Get(EventIDHeader))
+		assert.NoError(t, Verify("whsec_test", r.Header.Get(SignatureHeader), body, time.Now(), time.Minute))
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, "nope")
+	}))
+	defer server.Close()
+
+	client := NewClient(time.Second, true)
+	result, err := client.Post(context.Background(), server.URL, "whsec_test", "evt-1", "notification.created", []byte(`{"a":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	status = http.StatusGone
+	result, err = client.Post(context.Background(), server.URL, "whsec_test", "evt-1", "notification.created", []byte(`{"a":1}`))
+	var deliveryErr *DeliveryError
+	require.True(t, errors.As(err, &deliveryErr))
+	assert.Equal(t, http.StatusGone, deliveryErr.StatusCode)
+	assert.Equal(t, "nope", deliveryErr.Body)
+	assert.Equal(t, http.StatusGone, result.StatusCode)
+}
+
+func TestClient_DoesNotFollowRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/", http.StatusFound)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(time.Second, true).Post(context.Background(), server.URL, "s", "e", "t", []byte(`{}`))
+	var deliveryErr *DeliveryError
+	require.True(t, errors.As(err, &deliveryErr))
+	assert.Equal(t, http.StatusFound, deliveryErr.StatusCode)
+}
+
+func TestClient_BlocksPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not reach a loopback endpoint")
+	}))
+	defer server.Close()
+
+	_, err := NewClient(time.Second, false).Post(context.Background(), server.URL, "s", "e", "t", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrPrivateAddress)
+}
@@ -0,0 +1,104 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Subscription is a browser push subscription (PushSubscription.toJSON()).
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// Urgency indicates how soon a push message should be delivered (RFC 8030 section 5.3).
+type Urgency string
+
+const (
+	UrgencyNormal Urgency = "normal"
+	UrgencyHigh   Urgency = "high"
+)
+
+// Options controls a single push message.
+type Options struct {
+	TTL     time.Duration // How long the push service keeps an undelivered message
+	Urgency Urgency
+	Topic   string // Replaces a pending message with the same topic
+}
+
+// Client sends encrypted push messages signed with VAPID.
+type Client struct {
+	keys       *VAPIDKeys
+	subject    string
+	httpClient *http.Client
+}
+
+// NewClient creates a new web push Client.
+// subject는 푸시 서비스가 운영자에게 연락할 수 있는 mailto: 또는 https: URL입니다.
+func NewClient(keys *VAPIDKeys, subject string, timeout time.Duration) (*Client, error) {
+	if err := keys.Validate(); err != nil {
+		return nil, err
+	}
+	return &Client{
+		keys:       keys,
+		subject:    subject,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// PublicKey returns the VAPID public key browsers subscribe with.
+func (c *Client) PublicKey() string {
+	return c.keys.PublicKey
+}
+
+// Send encrypts and delivers a payload to a subscription and returns the push service status code.
+// 404/410 응답은 구독이 만료된 것이므로 호출자가 구독을 삭제해야 합니다.
+func (c *Client) Send(ctx context.Context, sub Subscription, payload []byte, opts Options) (int, error) {
+	body, err := encrypt(payload, sub.P256dh, sub.Auth)
+	if err != nil {
+		return 0, err
+	}
+
+	authorization, err := c.keys.authorization(sub.Endpoint, c.subject, 12*time.Hour)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create push request: %w", err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(opts.TTL.Seconds())))
+	if opts.Urgency != "" {
+		req.Header.Set("Urgency", string(opts.Urgency))
+	}
+	if opts.Topic != "" {
+		req.Header.Set("Topic", opts.Topic)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send push message: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("push service returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// IsSubscriptionGone returns true if the status code means the subscription no longer exists.
+func IsSubscriptionGone(status int) bool {
+	return status == http.StatusNotFound || status == http.StatusGone
+}
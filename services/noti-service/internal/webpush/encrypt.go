@@ -0,0 +1,130 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// recordSize is the aes128gcm record size advertised in the content coding header.
+	recordSize = 4096
+	// MaxPayloadSize is the largest plaintext that fits in a single record.
+	// 레코드 크기 - 태그(16) - 구분자(1)
+	MaxPayloadSize = recordSize - 16 - 1
+)
+
+// ErrPayloadTooLarge is returned when a payload does not fit in one aes128gcm record.
+var ErrPayloadTooLarge = errors.New("push payload too large")
+
+// ErrInvalidSubscription is returned when subscription keys are malformed.
+var ErrInvalidSubscription = errors.New("invalid push subscription keys")
+
+// encrypt encrypts a payload for a subscription using the aes128gcm content coding (RFC 8291).
+func encrypt(payload []byte, p256dh, auth string) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+
+	uaPublicBytes, err := decode(p256dh)
+	if err != nil {
+		return nil, ErrInvalidSubscription
+	}
+	authSecret, err := decode(auth)
+	if err != nil || len(authSecret) == 0 {
+		return nil, ErrInvalidSubscription
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, ErrInvalidSubscription
+	}
+
+	// 요청마다 새로운 임시 키와 salt 사용
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	cek, nonce, err := deriveKeys(sharedSecret, authSecret, salt, uaPublicBytes, asPublicBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 단일(마지막) 레코드: 평문 뒤에 0x02 구분자
+	plaintext := append(append(make([]byte, 0, len(payload)+1), payload...), 0x02)
+
+	// 헤더: salt(16) || rs(4) || idlen(1) || keyid(서버 임시 공개키)
+	header := make([]byte, 0, 21+len(asPublicBytes))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublicBytes)))
+	header = append(header, asPublicBytes...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// deriveKeys derives the content encryption key and nonce (RFC 8291 section 3.4).
+func deriveKeys(sharedSecret, authSecret, salt, uaPublic, asPublic []byte) (cek, nonce []byte, err error) {
+	keyInfo := make([]byte, 0, 14+len(uaPublic)+len(asPublic))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cek, err = hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16); err != nil {
+		return nil, nil, err
+	}
+	if nonce, err = hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12); err != nil {
+		return nil, nil, err
+	}
+	return cek, nonce, nil
+}
+
+// ValidateKeys checks that subscription keys are a valid P-256 public key and a 16-byte auth secret.
+func ValidateKeys(p256dh, auth string) error {
+	uaPublic, err := decode(p256dh)
+	if err != nil {
+		return ErrInvalidSubscription
+	}
+	if _, err := ecdh.P256().NewPublicKey(uaPublic); err != nil {
+		return ErrInvalidSubscription
+	}
+	authSecret, err := decode(auth)
+	if err != nil || len(authSecret) != 16 {
+		return ErrInvalidSubscription
+	}
+	return nil
+}
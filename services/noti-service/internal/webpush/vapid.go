@@ -0,0 +1,126 @@
+// Package webpush implements the Web Push protocol (RFC 8030) with VAPID
+// authentication (RFC 8292) and aes128gcm payload encryption (RFC 8291).
+//
+// 외부 라이브러리 없이 표준 라이브러리의 crypto 패키지만 사용합니다.
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+// ErrInvalidVAPIDKey is returned when a VAPID key cannot be decoded.
+var ErrInvalidVAPIDKey = errors.New("invalid VAPID key")
+
+// VAPIDKeys holds an application server key pair, base64url encoded without padding.
+// PublicKey는 브라우저의 PushManager.subscribe(applicationServerKey)에 그대로 전달합니다.
+type VAPIDKeys struct {
+	PublicKey  string // Uncompressed P-256 point (65 bytes)
+	PrivateKey string // P-256 private scalar (32 bytes)
+}
+
+// GenerateVAPIDKeys creates a new P-256 VAPID key pair.
+func GenerateVAPIDKeys() (*VAPIDKeys, error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &VAPIDKeys{
+		PublicKey:  encode(key.PublicKey().Bytes()),
+		PrivateKey: encode(key.Bytes()),
+	}, nil
+}
+
+// signingKey converts the VAPID private key into an ECDSA key for ES256 signing.
+func (k *VAPIDKeys) signingKey() (*ecdsa.PrivateKey, error) {
+	raw, err := decode(k.PrivateKey)
+	if err != nil {
+		return nil, ErrInvalidVAPIDKey
+	}
+	key, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, ErrInvalidVAPIDKey
+	}
+
+	// 비압축 공개키: 0x04 || X(32) || Y(32)
+	pub := key.PublicKey().Bytes()
+	if k.PublicKey != "" && k.PublicKey != encode(pub) {
+		return nil, fmt.Errorf("%w: public key does not match private key", ErrInvalidVAPIDKey)
+	}
+
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:65]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}, nil
+}
+
+// Validate checks that the key pair is well-formed and consistent.
+func (k *VAPIDKeys) Validate() error {
+	_, err := k.signingKey()
+	return err
+}
+
+// authorization builds the VAPID Authorization header value for a push endpoint.
+// aud는 푸시 서비스의 origin이며 토큰은 최대 24시간 유효해야 합니다.
+func (k *VAPIDKeys) authorization(endpoint, subject string, expiry time.Duration) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid push endpoint: %w", err)
+	}
+
+	key, err := k.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	header, _ := json.Marshal(map[string]string{"typ": "JWT", "alg": "ES256"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": time.Now().Add(expiry).Unix(),
+		"sub": subject,
+	})
+	unsigned := encode(header) + "." + encode(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign VAPID token: %w", err)
+	}
+
+	// ES256 서명은 r || s (각 32바이트 고정 길이)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return fmt.Sprintf("vapid t=%s.%s, k=%s", unsigned, encode(sig), k.PublicKey), nil
+}
+
+// encode returns unpadded base64url.
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decode accepts base64url or standard base64, with or without padding.
+// 브라우저 구현마다 구독 키 인코딩이 조금씩 달라 모두 허용합니다.
+func decode(s string) ([]byte, error) {
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.StdEncoding} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, errors.New("invalid base64 value")
+}
@@ -0,0 +1,153 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newUserAgentKeys creates browser-side subscription keys
+func newUserAgentKeys(t *testing.T) (*ecdh.PrivateKey, []byte) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+	return key, auth
+}
+
+// decrypt decrypts an aes128gcm body as a browser would
+func decrypt(t *testing.T, body []byte, uaKey *ecdh.PrivateKey, auth []byte) []byte {
+	salt := body[:16]
+	assert.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[16:20]))
+	idLen := int(body[20])
+	asPublicBytes := body[21 : 21+idLen]
+
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	require.NoError(t, err)
+	sharedSecret, err := uaKey.ECDH(asPublic)
+	require.NoError(t, err)
+
+	cek, nonce, err := deriveKeys(sharedSecret, auth, salt, uaKey.PublicKey().Bytes(), asPublicBytes)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plaintext, err := gcm.Open(nil, nonce, body[21+idLen:], nil)
+	require.NoError(t, err)
+	// 마지막 레코드 구분자 제거
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1])
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	uaKey, auth := newUserAgentKeys(t)
+	payload := []byte(`{"type":"TASK_ASSIGNED"}`)
+
+	body, err := encrypt(payload, encode(uaKey.PublicKey().Bytes()), encode(auth))
+	require.NoError(t, err)
+	assert.Equal(t, payload, decrypt(t, body, uaKey, auth))
+}
+
+func TestEncrypt_Validation(t *testing.T) {
+	uaKey, auth := newUserAgentKeys(t)
+
+	_, err := encrypt(make([]byte, MaxPayloadSize+1), encode(uaKey.PublicKey().Bytes()), encode(auth))
+	assert.ErrorIs(t, err, ErrPayloadTooLarge)
+
+	_, err = encrypt([]byte("x"), "not-a-key", encode(auth))
+	assert.ErrorIs(t, err, ErrInvalidSubscription)
+
+	assert.NoError(t, ValidateKeys(encode(uaKey.PublicKey().Bytes()), encode(auth)))
+	assert.ErrorIs(t, ValidateKeys(encode(uaKey.PublicKey().Bytes()), encode(auth[:8])), ErrInvalidSubscription)
+}
+
+func TestVAPIDKeys_Validate(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	assert.NoError(t, keys.Validate())
+
+	other, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	mismatched := &VAPIDKeys{PublicKey: other.PublicKey, PrivateKey: keys.PrivateKey}
+	assert.ErrorIs(t, mismatched.Validate(), ErrInvalidVAPIDKey)
+}
+
+func TestVAPIDKeys_Authorization(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	header, err := keys.authorization("https://push.example.com/send/abc", "mailto:ops@example.com", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(header, ", k="+keys.PublicKey))
+
+	token := strings.TrimSuffix(strings.TrimPrefix(header, "vapid t="), ", k="+keys.PublicKey)
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	claimsJSON, err := decode(parts[1])
+	require.NoError(t, err)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	assert.Equal(t, "https://push.example.com", claims["aud"])
+	assert.Equal(t, "mailto:ops@example.com", claims["sub"])
+
+	// ES256 서명 검증
+	key, err := keys.signingKey()
+	require.NoError(t, err)
+	sig, err := decode(parts[2])
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.True(t, ecdsa.Verify(&key.PublicKey, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])))
+}
+
+func TestClient_Send(t *testing.T) {
+	uaKey, auth := newUserAgentKeys(t)
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "60", r.Header.Get("TTL"))
+		assert.Equal(t, "high", r.Header.Get("Urgency"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "vapid t="))
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(keys, "mailto:ops@example.com", time.Second)
+	require.NoError(t, err)
+
+	sub := Subscription{Endpoint: server.URL, P256dh: encode(uaKey.PublicKey().Bytes()), Auth: encode(auth)}
+	status, err := client.Send(context.Background(), sub, []byte("hello"), Options{TTL: time.Minute, Urgency: UrgencyHigh})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, []byte("hello"), decrypt(t, received, uaKey, auth))
+}
+
+func TestIsSubscriptionGone(t *testing.T) {
+	assert.True(t, IsSubscriptionGone(http.StatusGone))
+	assert.True(t, IsSubscriptionGone(http.StatusNotFound))
+	assert.False(t, IsSubscriptionGone(http.StatusTooManyRequests))
+}
@@ -1,5 +1,5 @@
 import apiClient from './client'
-import type { ArgoCDApplication, ServiceMetrics, ClusterMetrics, SystemOverview } from '../types'
+import type { ArgoCDApplication, ServiceMetrics, ClusterMetrics, SystemOverview, ServicesHealth } from '../types'
 
 export const getApplications = async (): Promise<ArgoCDApplication[]> => {
   const response = await apiClient.get('/monitoring/applications')
@@ -46,3 +46,14 @@ export const getClusterMetrics = async (): Promise<ClusterMetrics> => {
     unhealthyPods: 0,
   }
 }
+
+// Service health API
+
+export const getServicesHealth = async (): Promise<ServicesHealth> => {
+  const response = await apiClient.get('/monitoring/health/services')
+  return response.data.data || {
+    status: 'ready',
+    services: [],
+    checkedAt: '',
+  }
+}
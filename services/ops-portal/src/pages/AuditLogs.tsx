@@ -17,7 +17,7 @@ export default function AuditLogs() {
   })
 
   const logs = data?.data || []
-  const totalPages = Math.ceil((data?.total || 0) / 20)
+  const totalPages = data?.meta.pagination.totalPages || 0
 
   const getActionBadgeClass = (action: ActionType) => {
     switch (action) {
@@ -143,7 +143,7 @@ export default function AuditLogs() {
             {totalPages > 1 && (
               <div className="flex items-center justify-between mt-4 pt-4 border-t">
                 <p className="text-sm text-gray-600">
-                  Page {page} of {totalPages} ({data?.total} total)
+                  Page {page} of {totalPages} ({data?.meta.pagination.total} total)
                 </p>
                 <div className="flex gap-2">
                   <button
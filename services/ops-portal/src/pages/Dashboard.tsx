@@ -1,6 +1,6 @@
 import { useQuery } from '@tanstack/react-query'
 import { useAuth } from '../contexts/AuthContext'
-import { getApplications, getSystemOverview, getClusterMetrics } from '../api/monitoring'
+import { getApplications, getSystemOverview, getClusterMetrics, getServicesHealth } from '../api/monitoring'
 import { getAllUsers } from '../api/users'
 import {
   Activity,
@@ -43,6 +43,12 @@ export default function Dashboard() {
     refetchInterval: 60000, // Refresh every minute
   })
 
+  const { data: servicesHealth, isLoading: healthLoading } = useQuery({
+    queryKey: ['servicesHealth'],
+    queryFn: getServicesHealth,
+    refetchInterval: 30000, // Refresh every 30 seconds
+  })
+
   const healthyApps = applications.filter(app => app.health === 'Healthy').length
   const unhealthyApps = applications.filter(app => app.health !== 'Healthy').length
   const syncedApps = applications.filter(app => app.sync === 'Synced').length
@@ -186,6 +192,47 @@ export default function Dashboard() {
         </div>
       </div>
 
+      {/* Service Health (/readyz) */}
+      <h2 className="text-lg font-semibold text-gray-900 mb-4">Service Health</h2>
+      <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6 mb-8">
+        {healthLoading && <div className="card text-gray-500">Loading...</div>}
+        {servicesHealth?.services.map((svc) => (
+          <div key={svc.name} className="card">
+            <div className="flex items-center justify-between">
+              <div className="flex items-center">
+                {svc.status === 'ready' ? (
+                  <CheckCircle className="w-5 h-5 text-green-600" />
+                ) : svc.status === 'degraded' ? (
+                  <AlertCircle className="w-5 h-5 text-yellow-600" />
+                ) : (
+                  <XCircle className="w-5 h-5 text-red-600" />
+                )}
+                <p className="ml-2 font-medium text-gray-900">{svc.name}</p>
+              </div>
+              <span className="text-sm text-gray-500">{formatLatency(svc.latencyMs)}</span>
+            </div>
+            {svc.error && <p className="mt-2 text-sm text-red-600 truncate">{svc.error}</p>}
+            {svc.components && (
+              <div className="mt-3 flex flex-wrap gap-2">
+                {svc.components.map((comp) => (
+                  <span
+                    key={comp.name}
+                    title={comp.error}
+                    className={`px-2 py-1 text-xs rounded ${
+                      comp.status === 'up' ? 'bg-green-100 text-green-800' :
+                      comp.status === 'down' ? (comp.critical ? 'bg-red-100 text-red-800' : 'bg-yellow-100 text-yellow-800') :
+                      'bg-gray-100 text-gray-600'
+                    }`}
+                  >
+                    {comp.name}
+                  </span>
+                ))}
+              </div>
+            )}
+          </div>
+        ))}
+      </div>
+
       {/* Cluster Metrics */}
       <h2 className="text-lg font-semibold text-gray-900 mb-4">Cluster Resources</h2>
       <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-4 gap-6 mb-8">
@@ -107,6 +107,33 @@ export interface ErrorOverview {
   mostErrorCount: number
 }
 
+// Service health types (/readyz of each service)
+export type ReadinessStatus = 'ready' | 'degraded' | 'not_ready' | 'unreachable'
+
+export interface HealthComponent {
+  name: string
+  status: 'up' | 'down' | 'not_configured'
+  critical: boolean
+  latencyMs: number
+  error?: string
+}
+
+export interface ServiceHealth {
+  name: string
+  url: string
+  status: ReadinessStatus
+  httpStatus?: number
+  latencyMs: number
+  components?: HealthComponent[]
+  error?: string
+}
+
+export interface ServicesHealth {
+  status: ReadinessStatus
+  services: ServiceHealth[]
+  checkedAt: string
+}
+
 // SLO Dashboard types
 export interface ServiceSLO {
   serviceName: string
@@ -169,14 +196,23 @@ export interface ServiceInfo {
 // API Response types
 export interface ApiResponse<T> {
   success: boolean
-  message?: string
   data?: T
+  requestId?: string
 }
 
-export interface PaginatedResponse<T> {
-  success: boolean
-  data: T[]
+export interface Pagination {
   page: number
   limit: number
   total: number
+  totalPages: number
+  hasNext: boolean
+}
+
+export interface PaginatedResponse<T> {
+  success: boolean
+  data: T[]
+  meta: {
+    pagination: Pagination
+  }
+  requestId?: string
 }
@@ -33,7 +33,10 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	commonauth "github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 	"ops-service/internal/client"
 	"ops-service/internal/config"
 	"ops-service/internal/database"
@@ -50,13 +53,20 @@ func main() {
 	}
 
 	// Initialize logger
-	logger, err := initLogger(cfg.Logger.Level)
+	logger, logLevel, err := initLogger(cfg.Logger.Level)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
 		os.Exit(1)
 	}
 	defer func() { _ = logger.Sync() }()
 
+	// Runtime settings hot-reload (RUNTIME_CONFIG_PATH, SIGHUP)
+	runtimeCfg := commonconfig.WatcherFromEnv(logger)
+	runtimeCfg.WatchLogLevel(logLevel)
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("ops-service")
@@ -177,8 +187,9 @@ func main() {
 	var prometheusClient *client.PrometheusClient
 	if cfg.Prometheus.BaseURL != "" {
 		prometheusClient = client.NewPrometheusClient(client.PrometheusConfig{
-			BaseURL: cfg.Prometheus.BaseURL,
-			Timeout: cfg.Prometheus.Timeout,
+			BaseURL:          cfg.Prometheus.BaseURL,
+			Timeout:          cfg.Prometheus.Timeout,
+			RetryMaxAttempts: cfg.Prometheus.RetryMaxAttempts,
 		}, logger)
 		logger.Info("Prometheus client initialized",
 			zap.String("baseURL", cfg.Prometheus.BaseURL),
@@ -205,6 +216,13 @@ func main() {
 		logger.Warn("Loki URL not configured, logs endpoints will return empty results")
 	}
 
+	// Initialize service health client (readiness dashboard)
+	var healthClient *client.ServiceHealthClient
+	if len(cfg.ServiceHealth.Targets) > 0 {
+		healthClient = client.NewServiceHealthClient(cfg.ServiceHealth.Targets, cfg.ServiceHealth.Timeout, logger)
+		logger.Info("Service health client initialized", zap.Int("targets", len(cfg.ServiceHealth.Targets)))
+	}
+
 	// Initialize Auth validator (SmartValidator for RS256 JWKS support)
 	var tokenValidator middleware.TokenValidator
 	if cfg.AuthAPI.BaseURL != "" {
@@ -212,10 +230,16 @@ func main() {
 		if jwtIssuer == "" {
 			jwtIssuer = "wealist-auth-service"
 		}
-		tokenValidator = middleware.NewSmartValidator(cfg.AuthAPI.BaseURL, jwtIssuer, logger)
+		smartValidator := middleware.NewSmartValidator(cfg.AuthAPI.BaseURL, jwtIssuer, logger)
+		// 로그아웃 등으로 폐기된 토큰 즉시 거부 (Redis 없으면 생략)
+		if redisClient := database.GetRedis(); redisClient != nil {
+			smartValidator.WithRevocation(commonauth.NewRedisRevocationStore(redisClient))
+		}
+		tokenValidator = smartValidator
 		logger.Info("SmartValidator initialized",
 			zap.String("auth_api_url", cfg.AuthAPI.BaseURL),
-			zap.String("jwt_issuer", jwtIssuer))
+			zap.String("jwt_issuer", jwtIssuer),
+			zap.Bool("revocation_check", database.GetRedis() != nil))
 	}
 
 	// Setup router
@@ -229,12 +253,15 @@ func main() {
 		ArgoCDNamespace:  cfg.ArgoCD.Namespace,
 		RedisClient:      database.GetRedis(),
 		RateLimitConfig:  cfg.RateLimit,
+		CSRFConfig:       cfg.CSRF,
 		ServiceName:      "ops-service",
+		Runtime:          runtimeCfg,
 		TokenValidator:   tokenValidator,
 		PrometheusClient: prometheusClient,
 		PrometheusNS:     cfg.Prometheus.Namespace,
 		LokiClient:       lokiClient,
 		LokiNS:           cfg.Loki.Namespace,
+		HealthClient:     healthClient,
 	})
 
 	// Create HTTP server
@@ -255,6 +282,9 @@ func main() {
 		}
 	}()
 
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	stopProfiling := profiling.Start(profiling.ConfigFromEnv(), logger)
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -268,11 +298,12 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
+	_ = stopProfiling(ctx)
 
 	logger.Info("Server exited gracefully")
 }
 
-func initLogger(level string) (*zap.Logger, error) {
+func initLogger(level string) (*zap.Logger, zap.AtomicLevel, error) {
 	var zapLevel zapcore.Level
 	switch level {
 	case "debug":
@@ -287,8 +318,9 @@ func initLogger(level string) (*zap.Logger, error) {
 		zapLevel = zapcore.InfoLevel
 	}
 
+	atomicLevel := zap.NewAtomicLevelAt(zapLevel)
 	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapLevel),
+		Level:            atomicLevel,
 		Development:      zapLevel == zapcore.DebugLevel,
 		Encoding:         "json",
 		EncoderConfig:    zap.NewProductionEncoderConfig(),
@@ -296,5 +328,6 @@ func initLogger(level string) (*zap.Logger, error) {
 		ErrorOutputPaths: []string{"stderr"},
 	}
 
-	return config.Build()
+	logger, err := config.Build()
+	return logger, atomicLevel, err
 }
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 )
 
 // ArgoCDClient handles ArgoCD API calls
@@ -38,8 +40,9 @@ func NewArgoCDClient(cfg ArgoCDConfig, logger *zap.Logger) *ArgoCDClient {
 		serverURL: strings.TrimSuffix(cfg.ServerURL, "/"),
 		token:     cfg.Token,
 		client: &http.Client{
-			Timeout:   30 * time.Second,
-			Transport: transport,
+			Timeout: 30 * time.Second,
+			// ArgoCD 장애 시 타임아웃을 기다리지 않고 바로 실패 (호스트별 circuit breaker)
+			Transport: commonclient.NewBreakerTransport(transport, commonclient.DefaultBreakerConfig()),
 		},
 		logger: logger,
 	}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 )
 
 // LokiClient is a client for Loki API
@@ -69,7 +71,8 @@ func NewLokiClient(baseURL, namespace string, timeout time.Duration, logger *zap
 		baseURL:   baseURL,
 		namespace: namespace,
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: commonclient.NewBreakerTransport(nil, commonclient.DefaultBreakerConfig()),
 		},
 		logger: logger,
 	}
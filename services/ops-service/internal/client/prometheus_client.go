@@ -11,19 +11,23 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 )
 
 // PrometheusClient handles Prometheus API calls
 type PrometheusClient struct {
 	baseURL string
 	client  *http.Client
+	retry   commonclient.RetryConfig
 	logger  *zap.Logger
 }
 
 // PrometheusConfig holds Prometheus client configuration
 type PrometheusConfig struct {
-	BaseURL string
-	Timeout time.Duration
+	BaseURL          string
+	Timeout          time.Duration // per attempt
+	RetryMaxAttempts int           // 0 = default (3)
 }
 
 // NewPrometheusClient creates a new Prometheus client
@@ -33,11 +37,24 @@ func NewPrometheusClient(cfg PrometheusConfig, logger *zap.Logger) *PrometheusCl
 		timeout = 10 * time.Second
 	}
 
+	retry := commonclient.DefaultRetryConfig()
+	if cfg.RetryMaxAttempts > 0 {
+		retry.MaxAttempts = cfg.RetryMaxAttempts
+	}
+	retry.OnRetry = func(attempt int, err error, delay time.Duration) {
+		logger.Warn("Retrying Prometheus query",
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", delay),
+			zap.Error(err))
+	}
+
 	return &PrometheusClient{
 		baseURL: cfg.BaseURL,
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: commonclient.NewBreakerTransport(nil, commonclient.DefaultBreakerConfig()),
 		},
+		retry:  retry,
 		logger: logger,
 	}
 }
@@ -101,28 +118,7 @@ func (c *PrometheusClient) Query(ctx context.Context, query string) (*Prometheus
 	params := url.Values{}
 	params.Set("query", query)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("prometheus query failed: %s", string(body))
-	}
-
-	var result PrometheusResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &result, nil
+	return c.get(ctx, endpoint+"?"+params.Encode(), "prometheus query failed")
 }
 
 // QueryRange executes a PromQL range query
@@ -135,25 +131,40 @@ func (c *PrometheusClient) QueryRange(ctx context.Context, query string, start,
 	params.Set("end", strconv.FormatInt(end.Unix(), 10))
 	params.Set("step", step.String())
 
-	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+	return c.get(ctx, endpoint+"?"+params.Encode(), "prometheus range query failed")
+}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
+// get performs a read-only query API call, retrying transient failures (network errors, 5xx, 429)
+func (c *PrometheusClient) get(ctx context.Context, target, failureMessage string) (*PrometheusResponse, error) {
+	var result PrometheusResponse
+	err := commonclient.Retry(ctx, c.retry, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("prometheus range query failed: %s", string(body))
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("request failed: %w", err)
+		}
+		defer resp.Body.Close()
 
-	var result PrometheusResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			return &commonclient.StatusError{
+				StatusCode: resp.StatusCode,
+				Message:    fmt.Sprintf("%s: %s", failureMessage, string(body)),
+			}
+		}
+
+		result = PrometheusResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &result, nil
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+)
+
+// StatusUnreachable is reported for services whose readiness endpoint could not be called
+const StatusUnreachable = "unreachable"
+
+// ServiceHealthClient collects readiness reports (/readyz) of the platform services
+type ServiceHealthClient struct {
+	targets map[string]string // service name -> readiness URL
+	client  *http.Client
+	logger  *zap.Logger
+}
+
+// ServiceHealth is the readiness of a single service
+type ServiceHealth struct {
+	Name       string                   `json:"name"`
+	URL        string                   `json:"url"`
+	Status     string                   `json:"status"` // ready, degraded, not_ready, unreachable
+	HTTPStatus int                      `json:"httpStatus,omitempty"`
+	LatencyMs  int64                    `json:"latencyMs"`
+	Components []commonhealth.Component `json:"components,omitempty"`
+	Error      string                   `json:"error,omitempty"`
+}
+
+// ServicesHealth is the readiness overview of all services
+type ServicesHealth struct {
+	Status    string          `json:"status"` // worst status of all services
+	Services  []ServiceHealth `json:"services"`
+	CheckedAt time.Time       `json:"checkedAt"`
+}
+
+// NewServiceHealthClient creates a new service health client
+func NewServiceHealthClient(targets map[string]string, timeout time.Duration, logger *zap.Logger) *ServiceHealthClient {
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	return &ServiceHealthClient{
+		targets: targets,
+		client:  &http.Client{Timeout: timeout},
+		logger:  logger,
+	}
+}
+
+// GetServicesHealth calls every service's readiness endpoint concurrently
+func (c *ServiceHealthClient) GetServicesHealth(ctx context.Context) *ServicesHealth {
+	names := make([]string, 0, len(c.targets))
+	for name := range c.targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	services := make([]ServiceHealth, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			services[i] = c.check(ctx, name, c.targets[name])
+		}(i, name)
+	}
+	wg.Wait()
+
+	overall := commonhealth.StatusReady
+	for _, svc := range services {
+		switch svc.Status {
+		case commonhealth.StatusReady:
+		case commonhealth.StatusDegraded:
+			if overall == commonhealth.StatusReady {
+				overall = commonhealth.StatusDegraded
+			}
+		default:
+			overall = commonhealth.StatusNotReady
+		}
+	}
+
+	return &ServicesHealth{
+		Status:    overall,
+		Services:  services,
+		CheckedAt: time.Now().UTC(),
+	}
+}
+
+func (c *ServiceHealthClient) check(ctx context.Context, name, url string) ServiceHealth {
+	result := ServiceHealth{Name: name, URL: url}
+
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		result.Status = StatusUnreachable
+		result.Error = err.Error()
+		return result
+	}
+	resp, err := c.client.Do(req)
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		c.logger.Warn("Service readiness check failed", zap.String("service", name), zap.Error(err))
+		result.Status = StatusUnreachable
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.HTTPStatus = resp.StatusCode
+
+	// Go services return a health.Report; others (e.g. Spring actuator) only their status code
+	var report commonhealth.Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err == nil && report.Status != "" && len(report.Components) > 0 {
+		result.Status = report.Status
+		result.Components = report.Components
+		return result
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		result.Status = commonhealth.StatusReady
+	} else {
+		result.Status = commonhealth.StatusNotReady
+	}
+	return result
+}
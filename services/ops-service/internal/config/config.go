@@ -22,15 +22,19 @@ type Config struct {
 	ArgoCD     ArgoCDConfig     `yaml:"argocd"`
 	Kubernetes KubernetesConfig `yaml:"kubernetes"`
 	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	CSRF       CSRFConfig       `yaml:"csrf"`
 	Prometheus PrometheusConfig `yaml:"prometheus"`
 	Loki       LokiConfig       `yaml:"loki"`
+	// ServiceHealth lists the services shown on the readiness dashboard
+	ServiceHealth ServiceHealthConfig `yaml:"service_health"`
 }
 
 // PrometheusConfig holds Prometheus API configuration
 type PrometheusConfig struct {
-	BaseURL   string        `yaml:"base_url"`
-	Timeout   time.Duration `yaml:"timeout"`
-	Namespace string        `yaml:"namespace"` // Namespace to query metrics for
+	BaseURL          string        `yaml:"base_url"`
+	Timeout          time.Duration `yaml:"timeout"`            // per attempt
+	Namespace        string        `yaml:"namespace"`          // Namespace to query metrics for
+	RetryMaxAttempts int           `yaml:"retry_max_attempts"` // Queries are retried on transient failures
 }
 
 // LokiConfig holds Loki API configuration
@@ -40,6 +44,12 @@ type LokiConfig struct {
 	Namespace string        `yaml:"namespace"` // Namespace to query logs for
 }
 
+// ServiceHealthConfig holds the readiness endpoints of the platform services
+type ServiceHealthConfig struct {
+	Targets map[string]string `yaml:"targets"` // service name -> readiness URL
+	Timeout time.Duration     `yaml:"timeout"`
+}
+
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port            string        `yaml:"port"`
@@ -108,6 +118,14 @@ type RateLimitConfig struct {
 	BurstSize         int  `yaml:"burst_size"`
 }
 
+// CSRFConfig holds CSRF protection configuration for cookie-authenticated portal requests.
+// Requests authenticated with an Authorization: Bearer header are not checked.
+type CSRFConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	SessionCookies string `yaml:"session_cookies"` // Comma-separated cookie names used for authentication (empty: any cookie)
+	TrustedOrigins string `yaml:"trusted_origins"` // Comma-separated origins allowed besides the request host
+}
+
 // Load loads configuration from file and environment variables
 func Load(configPath string) (*Config, error) {
 	var cfg Config
@@ -182,15 +200,27 @@ func getDefaultConfig() Config {
 			BurstSize:         10,
 		},
 		Prometheus: PrometheusConfig{
-			BaseURL:   "http://prometheus:9090",
-			Timeout:   10 * time.Second,
-			Namespace: "wealist-prod",
+			BaseURL:          "http://prometheus:9090",
+			Timeout:          10 * time.Second,
+			Namespace:        "wealist-prod",
+			RetryMaxAttempts: 3,
 		},
 		Loki: LokiConfig{
 			BaseURL:   "http://loki:3100",
 			Timeout:   30 * time.Second,
 			Namespace: "wealist-prod",
 		},
+		ServiceHealth: ServiceHealthConfig{
+			Targets: map[string]string{
+				"auth-service":    "http://auth-service:8080/actuator/health/readiness",
+				"board-service":   "http://board-service:8000/readyz",
+				"chat-service":    "http://chat-service:8001/readyz",
+				"noti-service":    "http://noti-service:8002/readyz",
+				"storage-service": "http://storage-service:8003/readyz",
+				"user-service":    "http://user-service:8081/readyz",
+			},
+			Timeout: 5 * time.Second,
+		},
 	}
 }
 
@@ -249,6 +279,23 @@ func (c *Config) overrideFromEnv() {
 		c.Database.AutoMigrate = autoMigrate == "true"
 	}
 
+	// Connection pool tuning
+	if maxOpen := os.Getenv("DB_MAX_OPEN_CONNS"); maxOpen != "" {
+		if v, err := strconv.Atoi(maxOpen); err == nil {
+			c.Database.MaxOpenConns = v
+		}
+	}
+	if maxIdle := os.Getenv("DB_MAX_IDLE_CONNS"); maxIdle != "" {
+		if v, err := strconv.Atoi(maxIdle); err == nil {
+			c.Database.MaxIdleConns = v
+		}
+	}
+	if lifetime := os.Getenv("DB_CONN_MAX_LIFETIME"); lifetime != "" {
+		if d, err := time.ParseDuration(lifetime); err == nil {
+			c.Database.ConnMaxLifetime = d
+		}
+	}
+
 	// Logger
 	if level := os.Getenv("LOG_LEVEL"); level != "" {
 		c.Logger.Level = level
@@ -315,6 +362,17 @@ func (c *Config) overrideFromEnv() {
 		c.RateLimit.RequestsPerMinute = 60
 	}
 
+	// CSRF
+	if csrfEnabled := os.Getenv("CSRF_ENABLED"); csrfEnabled != "" {
+		c.CSRF.Enabled = csrfEnabled == "true"
+	}
+	if cookies := os.Getenv("CSRF_SESSION_COOKIES"); cookies != "" {
+		c.CSRF.SessionCookies = cookies
+	}
+	if origins := os.Getenv("CSRF_TRUSTED_ORIGINS"); origins != "" {
+		c.CSRF.TrustedOrigins = origins
+	}
+
 	// Prometheus
 	if prometheusURL := os.Getenv("PROMETHEUS_URL"); prometheusURL != "" {
 		c.Prometheus.BaseURL = prometheusURL
@@ -322,6 +380,11 @@ func (c *Config) overrideFromEnv() {
 	if prometheusNS := os.Getenv("PROMETHEUS_NAMESPACE"); prometheusNS != "" {
 		c.Prometheus.Namespace = prometheusNS
 	}
+	if attempts := os.Getenv("PROMETHEUS_RETRY_MAX_ATTEMPTS"); attempts != "" {
+		if v, err := strconv.Atoi(attempts); err == nil {
+			c.Prometheus.RetryMaxAttempts = v
+		}
+	}
 
 	// Loki
 	if lokiURL := os.Getenv("LOKI_URL"); lokiURL != "" {
@@ -330,6 +393,11 @@ func (c *Config) overrideFromEnv() {
 	if lokiNS := os.Getenv("LOKI_NAMESPACE"); lokiNS != "" {
 		c.Loki.Namespace = lokiNS
 	}
+
+	// Service health - SERVICE_HEALTH_TARGETS="board-service=http://board-service:8000/readyz,..."
+	if targets := os.Getenv("SERVICE_HEALTH_TARGETS"); targets != "" {
+		c.ServiceHealth.Targets = parseServiceTargets(targets)
+	}
 }
 
 func (c *Config) validate() error {
@@ -407,3 +475,16 @@ func parseDatabaseURL(databaseURL string) (host, port, user, password, dbname st
 
 	return host, port, user, password, dbname, nil
 }
+
+// parseServiceTargets parses comma separated name=url pairs
+func parseServiceTargets(value string) map[string]string {
+	targets := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		name, target, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" || target == "" {
+			continue
+		}
+		targets[name] = target
+	}
+	return targets
+}
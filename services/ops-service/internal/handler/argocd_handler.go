@@ -153,7 +153,7 @@ func (h *ArgoCDHandler) GetApplications(c *gin.Context) {
 	apps, err := h.argoCDService.GetApplications(c.Request.Context())
 	if err != nil {
 		h.logger.Error("Failed to get applications", zap.Error(err))
-		response.InternalError(c, "Failed to get ArgoCD applications")
+		response.UpstreamError(c, err, "Failed to get ArgoCD applications")
 		return
 	}
 
@@ -210,7 +210,7 @@ func (h *ArgoCDHandler) SyncApplication(c *gin.Context) {
 
 	if err := h.argoCDService.SyncApplication(c.Request.Context(), req.Name, userID); err != nil {
 		h.logger.Error("Failed to sync application", zap.String("name", req.Name), zap.Error(err))
-		response.InternalError(c, "Failed to sync ArgoCD application")
+		response.UpstreamError(c, err, "Failed to sync ArgoCD application")
 		return
 	}
 
@@ -232,7 +232,7 @@ func (h *ArgoCDHandler) GetDeploymentHistory(c *gin.Context) {
 	history, err := h.argoCDService.GetDeploymentHistory(c.Request.Context())
 	if err != nil {
 		h.logger.Error("Failed to get deployment history", zap.Error(err))
-		response.InternalError(c, "Failed to get deployment history")
+		response.UpstreamError(c, err, "Failed to get deployment history")
 		return
 	}
 
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -37,12 +36,11 @@ func NewAuditHandler(auditService *service.AuditLogService) *AuditHandler {
 // @Success 200 {object} response.PaginatedResponse
 // @Router /api/admin/audit-logs [get]
 func (h *AuditHandler) List(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	pageReq := response.ParsePageRequest(c, 20)
 
 	opts := repository.ListOptions{
-		Page:  page,
-		Limit: limit,
+		Page:  pageReq.Page,
+		Limit: pageReq.Limit,
 	}
 
 	// Parse user_id filter
@@ -91,7 +89,7 @@ func (h *AuditHandler) List(c *gin.Context) {
 		responses[i] = log.ToResponse()
 	}
 
-	response.Paginated(c, responses, page, limit, total)
+	response.Paginated(c, responses, pageReq.Page, pageReq.Limit, total)
 }
 
 // GetByID returns an audit log by ID
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"ops-service/internal/domain"
 	"ops-service/internal/middleware"
 	"ops-service/internal/response"
@@ -99,8 +100,7 @@ func (h *ConfigHandler) Create(c *gin.Context) {
 	}
 
 	var req domain.CreateAppConfigRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -136,8 +136,7 @@ func (h *ConfigHandler) Update(c *gin.Context) {
 	}
 
 	var req domain.UpdateAppConfigRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -43,7 +43,7 @@ func (h *ErrorTrackerHandler) GetErrorOverview(c *gin.Context) {
 	overview, err := h.prometheusClient.GetErrorOverview(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get error overview", zap.Error(err))
-		response.InternalError(c, "Failed to get error overview")
+		response.UpstreamError(c, err, "Failed to get error overview")
 		return
 	}
 
@@ -75,7 +75,7 @@ func (h *ErrorTrackerHandler) GetRecentErrors(c *gin.Context) {
 	errors, err := h.prometheusClient.GetRecentErrors(c.Request.Context(), h.namespace, limit)
 	if err != nil {
 		h.logger.Error("Failed to get recent errors", zap.Error(err))
-		response.InternalError(c, "Failed to get recent errors")
+		response.UpstreamError(c, err, "Failed to get recent errors")
 		return
 	}
 
@@ -99,7 +99,7 @@ func (h *ErrorTrackerHandler) GetErrorTrend(c *gin.Context) {
 	trend, err := h.prometheusClient.GetErrorTrend(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get error trend", zap.Error(err))
-		response.InternalError(c, "Failed to get error trend")
+		response.UpstreamError(c, err, "Failed to get error trend")
 		return
 	}
 
@@ -123,7 +123,7 @@ func (h *ErrorTrackerHandler) GetErrorsByService(c *gin.Context) {
 	summaries, err := h.prometheusClient.GetErrorsByService(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get errors by service", zap.Error(err))
-		response.InternalError(c, "Failed to get errors by service")
+		response.UpstreamError(c, err, "Failed to get errors by service")
 		return
 	}
 
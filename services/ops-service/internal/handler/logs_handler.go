@@ -85,7 +85,7 @@ func (h *LogsHandler) GetLogs(c *gin.Context) {
 	result, err := h.lokiClient.GetLogs(params)
 	if err != nil {
 		h.logger.Error("Failed to query logs", zap.Error(err))
-		response.InternalError(c, "Failed to query logs: "+err.Error())
+		response.UpstreamError(c, err, "Failed to query logs: "+err.Error())
 		return
 	}
 
@@ -109,7 +109,7 @@ func (h *LogsHandler) GetServices(c *gin.Context) {
 	services, err := h.lokiClient.GetServices()
 	if err != nil {
 		h.logger.Error("Failed to get services", zap.Error(err))
-		response.InternalError(c, "Failed to get services: "+err.Error())
+		response.UpstreamError(c, err, "Failed to get services: "+err.Error())
 		return
 	}
 
@@ -41,7 +41,7 @@ func (h *MetricsHandler) GetSystemOverview(c *gin.Context) {
 	overview, err := h.prometheusClient.GetSystemOverview(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get system overview", zap.Error(err))
-		response.InternalError(c, "Failed to get system overview metrics")
+		response.UpstreamError(c, err, "Failed to get system overview metrics")
 		return
 	}
 
@@ -65,7 +65,7 @@ func (h *MetricsHandler) GetServiceMetrics(c *gin.Context) {
 	metrics, err := h.prometheusClient.GetServiceMetrics(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get service metrics", zap.Error(err))
-		response.InternalError(c, "Failed to get service metrics")
+		response.UpstreamError(c, err, "Failed to get service metrics")
 		return
 	}
 
@@ -89,7 +89,7 @@ func (h *MetricsHandler) GetClusterMetrics(c *gin.Context) {
 	metrics, err := h.prometheusClient.GetClusterMetrics(c.Request.Context())
 	if err != nil {
 		h.logger.Error("Failed to get cluster metrics", zap.Error(err))
-		response.InternalError(c, "Failed to get cluster metrics")
+		response.UpstreamError(c, err, "Failed to get cluster metrics")
 		return
 	}
 
@@ -122,7 +122,7 @@ func (h *MetricsHandler) GetServiceDetail(c *gin.Context) {
 	metrics, err := h.prometheusClient.GetServiceMetrics(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get service metrics", zap.Error(err))
-		response.InternalError(c, "Failed to get service metrics")
+		response.UpstreamError(c, err, "Failed to get service metrics")
 		return
 	}
 
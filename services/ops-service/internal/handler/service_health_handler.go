@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"ops-service/internal/client"
+	"ops-service/internal/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServiceHealthHandler handles service readiness dashboard requests
+type ServiceHealthHandler struct {
+	healthClient *client.ServiceHealthClient
+}
+
+// NewServiceHealthHandler creates a new service health handler
+func NewServiceHealthHandler(healthClient *client.ServiceHealthClient) *ServiceHealthHandler {
+	return &ServiceHealthHandler{
+		healthClient: healthClient,
+	}
+}
+
+// GetServicesHealth returns the readiness of all platform services
+// @Summary Get services health
+// @Description Returns each service's readiness and dependency (component) statuses from its /readyz endpoint
+// @Tags Monitoring
+// @Produce json
+// @Success 200 {object} client.ServicesHealth
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/monitoring/health/services [get]
+func (h *ServiceHealthHandler) GetServicesHealth(c *gin.Context) {
+	if h.healthClient == nil {
+		response.InternalError(c, "Service health targets not configured")
+		return
+	}
+
+	response.Success(c, h.healthClient.GetServicesHealth(c.Request.Context()))
+}
@@ -41,7 +41,7 @@ func (h *SLOHandler) GetSLOOverview(c *gin.Context) {
 	overview, err := h.prometheusClient.GetSLOOverview(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get SLO overview", zap.Error(err))
-		response.InternalError(c, "Failed to get SLO overview")
+		response.UpstreamError(c, err, "Failed to get SLO overview")
 		return
 	}
 
@@ -65,7 +65,7 @@ func (h *SLOHandler) GetBurnRates(c *gin.Context) {
 	burnRates, err := h.prometheusClient.GetBurnRates(c.Request.Context(), h.namespace)
 	if err != nil {
 		h.logger.Error("Failed to get burn rates", zap.Error(err))
-		response.InternalError(c, "Failed to get burn rates")
+		response.UpstreamError(c, err, "Failed to get burn rates")
 		return
 	}
 
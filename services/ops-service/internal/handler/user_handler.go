@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"ops-service/internal/domain"
 	"ops-service/internal/middleware"
 	"ops-service/internal/response"
@@ -96,8 +97,7 @@ func (h *UserHandler) InviteUser(c *gin.Context) {
 	}
 
 	var req domain.InviteUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -133,8 +133,7 @@ func (h *UserHandler) UpdateRole(c *gin.Context) {
 	}
 
 	var req domain.UpdateUserRoleRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
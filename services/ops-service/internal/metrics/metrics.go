@@ -1,70 +1,56 @@
+// Package metrics provides Prometheus metrics for ops-service.
+//
+// This package extends the common metrics package with ops portal metrics,
+// so ops-service exposes the same RED metrics (ops_service_http_requests_total, ...)
+// as the other services and shares their dashboards.
 package metrics
 
 import (
-	"strconv"
-	"time"
-
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
+	commonmiddleware "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
 )
 
+const namespace = "ops_service"
+
 // Metrics holds all Prometheus metrics for the ops service
 type Metrics struct {
-	RequestsTotal   *prometheus.CounterVec
-	RequestDuration *prometheus.HistogramVec
-	ActiveUsers     prometheus.Gauge
-	AuditLogsTotal  prometheus.Counter
+	// Embedded common metrics for HTTP requests, database operations, etc.
+	*commonmetrics.Metrics
+
+	ActiveUsers    prometheus.Gauge
+	AuditLogsTotal prometheus.Counter
 }
 
-// New creates new metrics
+// New creates and registers all metrics with the default Prometheus registerer.
 func New() *Metrics {
+	return NewWithRegistry(prometheus.DefaultRegisterer)
+}
+
+// NewWithRegistry creates metrics with a custom registry.
+func NewWithRegistry(registerer prometheus.Registerer) *Metrics {
+	common := commonmetrics.New(&commonmetrics.Config{
+		Namespace: namespace,
+		Registry:  registerer,
+	})
+
 	return &Metrics{
-		RequestsTotal: promauto.NewCounterVec(
-			prometheus.CounterOpts{
-				Name: "ops_http_requests_total",
-				Help: "Total number of HTTP requests",
-			},
-			[]string{"method", "path", "status"},
-		),
-		RequestDuration: promauto.NewHistogramVec(
-			prometheus.HistogramOpts{
-				Name:    "ops_http_request_duration_seconds",
-				Help:    "HTTP request duration in seconds",
-				Buckets: prometheus.DefBuckets,
-			},
-			[]string{"method", "path"},
-		),
-		ActiveUsers: promauto.NewGauge(
-			prometheus.GaugeOpts{
-				Name: "ops_active_users",
-				Help: "Number of active portal users",
-			},
-		),
-		AuditLogsTotal: promauto.NewCounter(
-			prometheus.CounterOpts{
-				Name: "ops_audit_logs_total",
-				Help: "Total number of audit log entries created",
-			},
-		),
+		Metrics:        common,
+		ActiveUsers:    common.RegisterGauge("active_users", "Number of active portal users"),
+		AuditLogsTotal: common.RegisterCounter("audit_logs_total", "Total number of audit log entries created"),
 	}
 }
 
+// NewForTest creates metrics with an isolated registry for testing.
+func NewForTest() *Metrics {
+	return NewWithRegistry(prometheus.NewRegistry())
+}
+
 // HTTPMiddleware creates HTTP metrics middleware
+// Uses the common middleware package for consistent metrics recording.
 func HTTPMiddleware(m *Metrics) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		start := time.Now()
-
-		c.Next()
-
-		duration := time.Since(start).Seconds()
-		status := strconv.Itoa(c.Writer.Status())
-		path := c.FullPath()
-		if path == "" {
-			path = c.Request.URL.Path
-		}
-
-		m.RequestsTotal.WithLabelValues(c.Request.Method, path, status).Inc()
-		m.RequestDuration.WithLabelValues(c.Request.Method, path).Observe(duration)
-	}
+	// ops-service의 basePath는 "/api"
+	return commonmiddleware.MetricsMiddleware(m.Metrics, "/api")
 }
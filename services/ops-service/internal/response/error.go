@@ -5,7 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
 // Type alias for convenience
@@ -77,20 +79,15 @@ func HandleServiceError(c *gin.Context, err error) {
 		Conflict(c, "Config key already exists")
 	case errors.Is(err, ErrAuditLogNotFound):
 		NotFound(c, "Audit log not found")
+	case commonclient.IsCircuitOpen(err):
+		c.Header("Retry-After", "30")
+		ServiceUnavailable(c, "Upstream service temporarily unavailable")
 	default:
-		if appErr := apperrors.AsAppError(err); appErr != nil {
-			Error(c, appErr)
-			return
-		}
-		InternalError(c, "An internal error occurred")
+		commonresponse.HandleError(c, err)
 	}
 }
 
 // Error sends an error response based on AppError type
 func Error(c *gin.Context, appErr *AppError) {
-	status := apperrors.GetHTTPStatus(appErr.Code)
-	c.JSON(status, Response{
-		Success: false,
-		Message: appErr.Message,
-	})
+	commonresponse.ErrorFrom(c, appErr)
 }
@@ -4,110 +4,97 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
-// Response is the standard API response structure
-type Response struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
-}
+// Response is the standard API response structure (common response envelope)
+type Response = commonresponse.SuccessResponse
 
-// PaginatedResponse is the paginated API response structure
-type PaginatedResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
-	Page    int         `json:"page"`
-	Limit   int         `json:"limit"`
-	Total   int64       `json:"total"`
-}
+// ErrorResponse is the error API response structure (common response envelope)
+type ErrorResponse = commonresponse.ErrorResponse
+
+// PaginatedResponse is the paginated API response structure; page info is in meta.pagination
+type PaginatedResponse = commonresponse.SuccessResponse
 
 // Success sends a success response
 func Success(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, Response{
-		Success: true,
-		Data:    data,
-	})
+	commonresponse.OK(c, data)
 }
 
 // SuccessWithMessage sends a success response with a message
+// The message is returned as data.message when there is no data
 func SuccessWithMessage(c *gin.Context, message string, data interface{}) {
-	c.JSON(http.StatusOK, Response{
-		Success: true,
-		Message: message,
-		Data:    data,
-	})
+	commonresponse.SuccessWithMessage(c, http.StatusOK, data, message)
 }
 
 // Created sends a created response
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, Response{
-		Success: true,
-		Data:    data,
-	})
+	commonresponse.Created(c, data)
 }
 
 // NoContent sends a no content response
 func NoContent(c *gin.Context) {
-	c.Status(http.StatusNoContent)
+	commonresponse.NoContent(c)
+}
+
+// PageRequest is an offset pagination request (?page=&limit=)
+type PageRequest = commonresponse.PageRequest
+
+// ParsePageRequest reads page and limit query parameters with defaults and the common limit cap
+func ParsePageRequest(c *gin.Context, defaultLimit int) PageRequest {
+	return commonresponse.ParsePageRequest(c, defaultLimit)
 }
 
 // Paginated sends a paginated response
 func Paginated(c *gin.Context, data interface{}, page, limit int, total int64) {
-	c.JSON(http.StatusOK, PaginatedResponse{
-		Success: true,
-		Data:    data,
-		Page:    page,
-		Limit:   limit,
-		Total:   total,
-	})
+	commonresponse.Paginated(c, data, commonresponse.NewPagination(page, limit, total))
 }
 
 // BadRequest sends a bad request response
 func BadRequest(c *gin.Context, message string) {
-	c.JSON(http.StatusBadRequest, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.BadRequest(c, message)
 }
 
 // Unauthorized sends an unauthorized response
 func Unauthorized(c *gin.Context, message string) {
-	c.JSON(http.StatusUnauthorized, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.Unauthorized(c, message)
 }
 
 // Forbidden sends a forbidden response
 func Forbidden(c *gin.Context, message string) {
-	c.JSON(http.StatusForbidden, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.Forbidden(c, message)
 }
 
 // NotFound sends a not found response
 func NotFound(c *gin.Context, message string) {
-	c.JSON(http.StatusNotFound, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.NotFound(c, message)
 }
 
 // Conflict sends a conflict response
 func Conflict(c *gin.Context, message string) {
-	c.JSON(http.StatusConflict, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.Conflict(c, message)
 }
 
 // InternalError sends an internal server error response
 func InternalError(c *gin.Context, message string) {
-	c.JSON(http.StatusInternalServerError, Response{
-		Success: false,
-		Message: message,
-	})
+	commonresponse.InternalError(c, message)
+}
+
+// ServiceUnavailable sends a service unavailable response
+func ServiceUnavailable(c *gin.Context, message string) {
+	commonresponse.ServiceUnavailable(c, message)
+}
+
+// UpstreamError sends an error response for a failed Prometheus/Loki/ArgoCD call.
+// While the upstream's circuit breaker is open it responds 503 with Retry-After
+// so the dashboard can back off instead of showing an internal error.
+func UpstreamError(c *gin.Context, err error, message string) {
+	if commonclient.IsCircuitOpen(err) {
+		c.Header("Retry-After", "30")
+		ServiceUnavailable(c, message+" (upstream temporarily unavailable)")
+		return
+	}
+	InternalError(c, message)
 }
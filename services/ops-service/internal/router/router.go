@@ -2,15 +2,18 @@ package router
 
 import (
 	"os"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
 
 	"ops-service/internal/client"
@@ -36,11 +39,15 @@ type Config struct {
 	Metrics          *metrics.Metrics
 	RedisClient      *redis.Client
 	RateLimitConfig  config.RateLimitConfig
+	CSRFConfig       config.CSRFConfig
 	ServiceName      string
 	PrometheusClient *client.PrometheusClient
 	PrometheusNS     string
 	LokiClient       *client.LokiClient
 	LokiNS           string
+	HealthClient     *client.ServiceHealthClient
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
 }
 
 // Setup sets up the router with all routes
@@ -63,8 +70,31 @@ func Setup(cfg Config) *gin.Engine {
 	r.Use(commonmw.Recovery(cfg.Logger))
 	r.Use(commonmw.OTELTracing(serviceName))
 	r.Use(commonmw.LoggerWithTracing(cfg.Logger, serviceName))
-	r.Use(commonmw.DefaultCORS())
+	r.Use(commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), cfg.Runtime))
 	r.Use(metrics.HTTPMiddleware(m))
+	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes))
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	r.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), cfg.Runtime, cfg.Logger))
+
+	// CSRF protection for cookie-authenticated portal requests (Bearer token requests are exempt)
+	if cfg.CSRFConfig.Enabled {
+		csrfConfig := commonmw.DefaultCSRFConfig().
+			WithSessionCookies(splitList(cfg.CSRFConfig.SessionCookies)...).
+			WithTrustedOrigins(splitList(cfg.CSRFConfig.TrustedOrigins)...)
+		r.Use(commonmw.CSRF(csrfConfig))
+		cfg.Logger.Info("CSRF protection enabled",
+			zap.Strings("session_cookies", csrfConfig.SessionCookies),
+			zap.Strings("trusted_origins", csrfConfig.TrustedOrigins))
+	}
+
+	// DB/Redis 메트릭 (요청 trace를 exemplar로 연결)
+	if err := commonotel.EnableGORMMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+	}
+	if err := commonotel.EnableGORMPoolMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable DB pool metrics", zap.Error(err))
+	}
+	commonotel.EnableRedisMetrics(cfg.RedisClient, m.Metrics)
 
 	// Rate limiting middleware
 	if cfg.RateLimitConfig.Enabled && cfg.RedisClient != nil {
@@ -73,14 +103,15 @@ func Setup(cfg Config) *gin.Engine {
 			WithBurstSize(cfg.RateLimitConfig.BurstSize).
 			WithKeyPrefix("rl:ops:")
 		limiter := ratelimit.NewRedisRateLimiter(cfg.RedisClient, rlConfig, cfg.Logger)
-		r.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, cfg.Logger))
+		ratelimit.WatchSettings(limiter, cfg.Runtime)
+		r.Use(ratelimit.PolicyMiddleware(limiter, ratelimit.NewPolicies(), cfg.Logger))
 		cfg.Logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimitConfig.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimitConfig.BurstSize))
 	}
 
 	// Prometheus metrics endpoint
-	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Health check routes
 	healthChecker := commonhealth.NewHealthChecker(cfg.DB, cfg.RedisClient)
@@ -119,6 +150,7 @@ func Setup(cfg Config) *gin.Engine {
 	errorTrackerHandler := handler.NewErrorTrackerHandler(cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
 	sloHandler := handler.NewSLOHandler(cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
 	logsHandler := handler.NewLogsHandler(cfg.LokiClient, cfg.LokiNS, cfg.Logger)
+	serviceHealthHandler := handler.NewServiceHealthHandler(cfg.HealthClient)
 
 	// API routes group
 	api := r.Group(cfg.BasePath)
@@ -248,6 +280,9 @@ func Setup(cfg Config) *gin.Engine {
 		// Logs Viewer
 		monitoring.GET("/logs", logsHandler.GetLogs)
 		monitoring.GET("/logs/services", logsHandler.GetServices)
+
+		// Service readiness (/readyz of each service)
+		monitoring.GET("/health/services", serviceHealthHandler.GetServicesHealth)
 	}
 
 	// ============================================================
@@ -266,3 +301,14 @@ func Setup(cfg Config) *gin.Engine {
 
 	return r
 }
+
+// splitList splits a comma-separated config value, dropping empty entries.
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -33,12 +33,18 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 	"storage-service/internal/client"
 	"storage-service/internal/config"
 	"storage-service/internal/database"
+	"storage-service/internal/job"
 	"storage-service/internal/middleware"
+	"storage-service/internal/repository"
 	"storage-service/internal/router"
+	"storage-service/internal/service"
 )
 
 func main() {
@@ -50,13 +56,20 @@ func main() {
 	}
 
 	// Initialize logger
-	logger, err := initLogger(cfg.Logger.Level)
+	logger, logLevel, err := initLogger(cfg.Logger.Level)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
 		os.Exit(1)
 	}
 	defer func() { _ = logger.Sync() }()
 
+	// Runtime settings hot-reload (RUNTIME_CONFIG_PATH, SIGHUP)
+	runtimeCfg := commonconfig.WatcherFromEnv(logger)
+	runtimeCfg.WatchLogLevel(logLevel)
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("storage-service")
@@ -96,6 +109,7 @@ func main() {
 		MaxOpenConns:    cfg.Database.MaxOpenConns,
 		MaxIdleConns:    cfg.Database.MaxIdleConns,
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		ReplicaDSNs:     cfg.Database.ReplicaDSNs,
 	}
 
 	// Retry up to 30 times (5s interval = ~2.5 minutes total wait)
@@ -115,6 +129,15 @@ func main() {
 		logger.Info("GORM OpenTelemetry tracing enabled")
 	}
 
+	// Route heavy list reads to read replicas (optional)
+	replicas, err := database.EnableReadReplicas(db, dbConfig, logger)
+	if err != nil {
+		logger.Warn("Failed to enable read replicas, all reads use primary", zap.Error(err))
+	} else if replicas != nil {
+		defer replicas.Close()
+		logger.Info("Read replica routing enabled", zap.Int("replicas", len(dbConfig.ReplicaDSNs)))
+	}
+
 	// Run auto migration (conditional based on DB_AUTO_MIGRATE env)
 	if cfg.Database.AutoMigrate {
 		logger.Info("Running database migrations (DB_AUTO_MIGRATE=true)")
@@ -183,20 +206,115 @@ func main() {
 		logger.Warn("User API base URL not configured, workspace validation disabled")
 	}
 
+	// Initialize OCR client
+	var ocrClient client.OCRClient
+	if cfg.OCR.Enabled {
+		ocrClient = client.NewOCRClient(cfg.OCR.BaseURL, cfg.OCR.Timeout)
+		logger.Info("OCR client initialized",
+			zap.String("url", cfg.OCR.BaseURL),
+			zap.Duration("timeout", cfg.OCR.Timeout))
+	}
+
+	// Domain events (file changes) on the platform event bus
+	var events *messaging.Emitter
+	if cfg.Events.NATSURL != "" {
+		eventPublisher := messaging.NewEventPublisher(messaging.Config{URL: cfg.Events.NATSURL, Name: "storage-service"}, logger)
+		defer eventPublisher.Close()
+		events = messaging.NewEmitter(eventPublisher, "storage-service", logger)
+		logger.Info("Domain event publishing enabled")
+	}
+
 	// Setup router
 	r := router.Setup(router.Config{
 		DB:              db,
+		Replicas:        replicas,
 		Logger:          logger,
 		JWTSecret:       cfg.JWT.Secret,
 		BasePath:        cfg.Server.BasePath,
 		S3Client:        s3Client,
 		TokenValidator:  tokenValidator,
 		UserClient:      userClient,
+		AuthServiceURL:  cfg.AuthAPI.BaseURL,
+		UserServiceURL:  cfg.UserAPI.BaseURL,
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
+		LifecycleConfig: cfg.Lifecycle,
+		AccessLogConfig: cfg.AccessLog,
+		UploadEvents:    cfg.UploadEvents,
+		OCRConfig:       cfg.OCR,
+		WebhookConfig:   cfg.Webhook,
+		OCRClient:       ocrClient,
+		Events:          events,
 		ServiceName:     "storage-service",
+		InternalAPIKey:  cfg.InternalAPI.APIKey,
+		Runtime:         runtimeCfg,
 	})
 
+	// Start lifecycle tiering job (requires S3)
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.Lifecycle.Enabled && s3Client != nil {
+		lifecycleService := service.NewLifecycleService(
+			repository.NewWorkspaceSettingsRepository(db),
+			repository.NewFileRepository(db),
+			s3Client,
+			cfg.Lifecycle.BatchSize,
+			logger,
+		)
+		lifecycleJob := job.NewLifecycleJob(lifecycleService, cfg.Lifecycle.Interval, logger)
+		go lifecycleJob.Start(jobCtx)
+		logger.Info("Lifecycle tiering job scheduled",
+			zap.Duration("interval", cfg.Lifecycle.Interval),
+			zap.Int("batch_size", cfg.Lifecycle.BatchSize))
+	}
+
+	// Start access log retention cleanup job
+	accessLogService := service.NewAccessLogService(
+		repository.NewAccessLogRepository(db),
+		repository.NewFileRepository(db),
+		repository.NewWorkspaceSettingsRepository(db),
+		cfg.AccessLog.RetentionDays,
+		logger,
+	)
+	accessLogCleanupJob := job.NewAccessLogCleanupJob(accessLogService, cfg.AccessLog.CleanupInterval, logger)
+	go accessLogCleanupJob.Start(jobCtx)
+	logger.Info("Access log cleanup job scheduled",
+		zap.Duration("interval", cfg.AccessLog.CleanupInterval),
+		zap.Int("default_retention_days", cfg.AccessLog.RetentionDays))
+
+	// Start webhook retry job (재시도 예약은 DB에 저장되어 재시작 후에도 이어서 전송)
+	webhookService := service.NewWebhookService(
+		repository.NewWebhookRepository(db),
+		repository.NewProjectRepository(db),
+		s3Client,
+		cfg.Webhook.AllowPrivateNetworks,
+		logger,
+	)
+	webhookRetryJob := job.NewWebhookRetryJob(webhookService, cfg.Webhook.RetryInterval, logger)
+	go webhookRetryJob.Start(jobCtx)
+	logger.Info("Webhook retry job scheduled",
+		zap.Duration("interval", cfg.Webhook.RetryInterval),
+		zap.Bool("allow_private_networks", cfg.Webhook.AllowPrivateNetworks))
+
+	// Start OCR job (requires S3 and an OCR server)
+	if ocrClient != nil && s3Client != nil {
+		ocrService := service.NewOCRService(
+			repository.NewFileTextRepository(db),
+			repository.NewWorkspaceSettingsRepository(db),
+			repository.NewFileRepository(db),
+			s3Client,
+			ocrClient,
+			cfg.OCR.BatchSize,
+			cfg.OCR.MaxFileSize,
+			logger,
+		)
+		ocrJob := job.NewOCRJob(ocrService, cfg.OCR.Interval, logger)
+		go ocrJob.Start(jobCtx)
+		logger.Info("OCR job scheduled",
+			zap.Duration("interval", cfg.OCR.Interval),
+			zap.Int("batch_size", cfg.OCR.BatchSize))
+	}
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Server.Port),
@@ -215,12 +333,18 @@ func main() {
 		}
 	}()
 
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	stopProfiling := profiling.Start(profiling.ConfigFromEnv(), logger)
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	logger.Info("Shutting down server...")
 
+	// Stop background jobs
+	stopJobs()
+
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
@@ -228,11 +352,12 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
+	_ = stopProfiling(ctx)
 
 	logger.Info("Server exited gracefully")
 }
 
-func initLogger(level string) (*zap.Logger, error) {
+func initLogger(level string) (*zap.Logger, zap.AtomicLevel, error) {
 	var zapLevel zapcore.Level
 	switch level {
 	case "debug":
@@ -247,8 +372,9 @@ func initLogger(level string) (*zap.Logger, error) {
 		zapLevel = zapcore.InfoLevel
 	}
 
+	atomicLevel := zap.NewAtomicLevelAt(zapLevel)
 	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapLevel),
+		Level:            atomicLevel,
 		Development:      zapLevel == zapcore.DebugLevel,
 		Encoding:         "json",
 		EncoderConfig:    zap.NewProductionEncoderConfig(),
@@ -256,5 +382,6 @@ func initLogger(level string) (*zap.Logger, error) {
 		ErrorOutputPaths: []string{"stderr"},
 	}
 
-	return config.Build()
+	logger, err := config.Build()
+	return logger, atomicLevel, err
 }
@@ -0,0 +1,82 @@
+// Command seed fills the storage-service database with the shared development dataset
+// (projects, folders, files) and uploads the file contents when S3 is configured.
+// Run it after the user-service seed command.
+//
+//	go run ./cmd/seed [-migrate]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"storage-service/internal/client"
+	"storage-service/internal/config"
+	"storage-service/internal/database"
+	"storage-service/internal/seed"
+)
+
+func main() {
+	migrate := flag.Bool("migrate", false, "run auto migration before seeding")
+	flag.Parse()
+
+	if err := commonseed.CheckEnvironment(os.Getenv("ENV")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load("configs/config.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	log, err := commonlogger.NewWithConfig(&commonlogger.Config{Level: "info", OutputPath: "stdout", Encoding: "console"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = log.Sync() }()
+
+	db, err := database.New(database.Config{
+		DSN:             cfg.Database.GetDSN(),
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+	})
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	if *migrate {
+		if err := database.AutoMigrate(db); err != nil {
+			log.Fatal("Failed to run database migrations", zap.Error(err))
+		}
+	}
+
+	// Without S3 only metadata is seeded; downloads of seed files will fail
+	var uploader seed.ObjectUploader
+	if cfg.S3.Bucket != "" && cfg.S3.Region != "" {
+		s3Client, err := client.NewS3Client(&cfg.S3)
+		if err != nil {
+			log.Warn("Failed to initialize S3 client, skipping file uploads", zap.Error(err))
+		} else {
+			uploader = s3Client
+		}
+	}
+
+	data := commonseed.Default()
+	if err := seed.Run(context.Background(), db, uploader, data); err != nil {
+		log.Fatal("Failed to seed storage-service", zap.Error(err))
+	}
+
+	log.Info("Seeded storage-service",
+		zap.Int("folders", len(data.Folders)),
+		zap.Int("files", len(data.Files)),
+		zap.Bool("uploaded", uploader != nil))
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// OCRClient extracts text from images and scanned documents
+type OCRClient interface {
+	ExtractText(ctx context.Context, contentType string, data []byte, languages []string) (string, error)
+}
+
+// httpOCRClient implements OCRClient against an HTTP OCR server (e.g. a Tesseract sidecar)
+// 요청: POST {baseURL}/ocr?lang=kor+eng (본문은 원본 파일), 응답: {"text": "..."}
+type httpOCRClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewOCRClient creates a new OCR API client
+func NewOCRClient(baseURL string, timeout time.Duration) OCRClient {
+	return &httpOCRClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: commonotel.NewHTTPClient(timeout),
+	}
+}
+
+// ocrResponse represents the OCR server response body
+type ocrResponse struct {
+	Text string `json:"text"`
+}
+
+// ExtractText sends a file to the OCR server and returns the recognized text
+func (c *httpOCRClient) ExtractText(ctx context.Context, contentType string, data []byte, languages []string) (string, error) {
+	endpoint := c.baseURL + "/ocr?lang=" + url.QueryEscape(strings.Join(languages, "+"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCR request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to call OCR server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("OCR server returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result ocrResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode OCR response: %w", err)
+	}
+	return result.Text, nil
+}
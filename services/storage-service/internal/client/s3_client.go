@@ -1,8 +1,12 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
 	"time"
 
@@ -10,6 +14,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/google/uuid"
 
 	internalConfig "storage-service/internal/config"
@@ -23,6 +29,7 @@ type S3Client struct {
 	region         string
 	endpoint       string
 	publicEndpoint string
+	signer         *CloudFrontSigner // CDN 모드 서명 URL (nil이면 미사용)
 }
 
 // NewS3Client creates a new S3Client
@@ -104,6 +111,14 @@ func NewS3Client(cfg *internalConfig.S3Config) (*S3Client, error) {
 		presignClient = client
 	}
 
+	var signer *CloudFrontSigner
+	if cfg.CloudFrontKeyPairID != "" {
+		signer, err = NewCloudFrontSigner(cfg.CloudFrontKeyPairID, cfg.CloudFrontPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &S3Client{
 		client:         client,
 		presignClient:  presignClient,
@@ -111,11 +126,13 @@ func NewS3Client(cfg *internalConfig.S3Config) (*S3Client, error) {
 		region:         cfg.Region,
 		endpoint:       cfg.Endpoint,
 		publicEndpoint: cfg.PublicEndpoint,
+		signer:         signer,
 	}, nil
 }
 
 // GeneratePresignedURL generates a presigned URL for uploading a file
-func (c *S3Client) GeneratePresignedURL(ctx context.Context, workspaceID, fileName, contentType string) (string, string, error) {
+// kmsKeyID가 주어지면 SSE-KMS 헤더가 서명에 포함되며, 클라이언트는 반환된 헤더를 함께 전송해야 합니다.
+func (c *S3Client) GeneratePresignedURL(ctx context.Context, workspaceID, fileName, contentType, kmsKeyID string) (string, string, map[string]string, error) {
 	// Generate unique file key with workspace prefix
 	fileKey := fmt.Sprintf("storage/%s/%s/%s", workspaceID, uuid.New().String(), fileName)
 
@@ -128,29 +145,78 @@ func (c *S3Client) GeneratePresignedURL(ctx context.Context, workspaceID, fileNa
 		Key:         aws.String(fileKey),
 		ContentType: aws.String(contentType),
 	}
+	if kmsKeyID != "" {
+		putObjectInput.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		putObjectInput.SSEKMSKeyId = aws.String(kmsKeyID)
+	}
 
 	presignedReq, err := presignClient.PresignPutObject(ctx, putObjectInput, func(opts *s3.PresignOptions) {
 		opts.Expires = 5 * time.Minute
 	})
 	if err != nil {
-		return "", "", fmt.Errorf("failed to generate presigned URL: %w", err)
+		return "", "", nil, fmt.Errorf("failed to generate presigned URL: %w", err)
 	}
 
-	return presignedReq.URL, fileKey, nil
+	var headers map[string]string
+	for name, values := range presignedReq.SignedHeader {
+		if strings.HasPrefix(strings.ToLower(name), "x-amz-server-side-encryption") && len(values) > 0 {
+			if headers == nil {
+				headers = make(map[string]string)
+			}
+			headers[name] = values[0]
+		}
+	}
+
+	return presignedReq.URL, fileKey, headers, nil
+}
+
+// ErrIPRestrictionUnsupported is returned when an IP-restricted URL is requested without CloudFront signing
+var ErrIPRestrictionUnsupported = errors.New("IP-restricted URLs require CloudFront signed URLs")
+
+// AccessURLOptions controls how a download URL is generated
+type AccessURLOptions struct {
+	FileName    string        // File name sent in Content-Disposition
+	ContentType string        // Response content type override (empty keeps stored type)
+	Inline      bool          // inline (preview) instead of attachment
+	Expires     time.Duration // URL lifetime
+	IPRange     string        // Client CIDR allowed to use the URL (CloudFront only)
 }
 
-// GenerateDownloadURL generates a presigned URL for downloading a file
-func (c *S3Client) GenerateDownloadURL(ctx context.Context, fileKey, fileName string) (string, error) {
+// GenerateAccessURL generates a presigned URL for downloading or previewing a file
+// IP 대역 제한은 S3 presigned URL로 불가능하므로 CDN 모드의 CloudFront 커스텀 정책을 사용합니다.
+func (c *S3Client) GenerateAccessURL(ctx context.Context, fileKey string, opts AccessURLOptions) (string, error) {
+	disposition := ContentDisposition(opts.Inline, opts.FileName)
+
+	if opts.IPRange != "" {
+		if c.signer == nil || !c.cdnMode() {
+			return "", ErrIPRestrictionUnsupported
+		}
+		query := url.Values{}
+		query.Set("response-content-disposition", disposition)
+		if opts.ContentType != "" {
+			query.Set("response-content-type", opts.ContentType)
+		}
+		resource := c.GetFileURL(fileKey) + "?" + query.Encode()
+		signed, err := c.signer.SignURL(resource, time.Now().Add(opts.Expires), opts.IPRange)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate download URL: %w", err)
+		}
+		return signed, nil
+	}
+
 	presignClient := s3.NewPresignClient(c.presignClient)
 
 	getObjectInput := &s3.GetObjectInput{
 		Bucket:                     aws.String(c.bucket),
 		Key:                        aws.String(fileKey),
-		ResponseContentDisposition: aws.String(fmt.Sprintf("attachment; filename=\"%s\"", fileName)),
+		ResponseContentDisposition: aws.String(disposition),
+	}
+	if opts.ContentType != "" {
+		getObjectInput.ResponseContentType = aws.String(opts.ContentType)
 	}
 
-	presignedReq, err := presignClient.PresignGetObject(ctx, getObjectInput, func(opts *s3.PresignOptions) {
-		opts.Expires = 1 * time.Hour
+	presignedReq, err := presignClient.PresignGetObject(ctx, getObjectInput, func(po *s3.PresignOptions) {
+		po.Expires = opts.Expires
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to generate download URL: %w", err)
@@ -159,6 +225,54 @@ func (c *S3Client) GenerateDownloadURL(ctx context.Context, fileKey, fileName st
 	return presignedReq.URL, nil
 }
 
+// ContentDisposition builds a Content-Disposition value safe for any file name (RFC 6266)
+// ASCII 대체 이름과 UTF-8 인코딩 이름을 함께 지정하고, 따옴표/제어 문자는 제거합니다.
+func ContentDisposition(inline bool, fileName string) string {
+	mode := "attachment"
+	if inline {
+		mode = "inline"
+	}
+
+	name := strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '/' || r == '\\' {
+			return -1
+		}
+		return r
+	}, fileName)
+	if name == "" {
+		name = "download"
+	}
+
+	fallback := strings.Map(func(r rune) rune {
+		if r > 0x7e || r == '"' || r == '%' || r == ';' {
+			return '_'
+		}
+		return r
+	}, name)
+
+	return fmt.Sprintf("%s; filename=\"%s\"; filename*=UTF-8''%s", mode, fallback, encodeRFC5987(name))
+}
+
+// encodeRFC5987 percent-encodes every byte that is not an RFC 5987 attr-char
+func encodeRFC5987(s string) string {
+	const attrChars = "!#$&+-.^_`|~"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z') || ('0' <= ch && ch <= '9') || strings.IndexByte(attrChars, ch) >= 0 {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", ch)
+	}
+	return b.String()
+}
+
+// cdnMode returns true if files are served through CloudFront in front of S3
+func (c *S3Client) cdnMode() bool {
+	return c.publicEndpoint != "" && c.endpoint == ""
+}
+
 // GetFileURL returns the public URL for a file
 func (c *S3Client) GetFileURL(fileKey string) string {
 	// CDN mode: publicEndpoint is set but endpoint is empty (AWS S3 + CloudFront)
@@ -197,7 +311,7 @@ func (c *S3Client) DeleteFile(ctx context.Context, fileKey string) error {
 func (c *S3Client) CopyFile(ctx context.Context, sourceKey, destKey string) error {
 	_, err := c.client.CopyObject(ctx, &s3.CopyObjectInput{
 		Bucket:     aws.String(c.bucket),
-		CopySource: aws.String(fmt.Sprintf("%s/%s", c.bucket, sourceKey)),
+		CopySource: aws.String(c.copySource(sourceKey)),
 		Key:        aws.String(destKey),
 	})
 	if err != nil {
@@ -206,6 +320,16 @@ func (c *S3Client) CopyFile(ctx context.Context, sourceKey, destKey string) erro
 	return nil
 }
 
+// copySource returns the URL-encoded "bucket/key" of a CopyObject source.
+// 키의 각 경로 세그먼트를 인코딩해야 한글이나 공백이 들어간 파일명도 복사됩니다.
+func (c *S3Client) copySource(fileKey string) string {
+	segments := strings.Split(fileKey, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return c.bucket + "/" + strings.Join(segments, "/")
+}
+
 // FileExists checks if a file exists in S3
 func (c *S3Client) FileExists(ctx context.Context, fileKey string) (bool, error) {
 	_, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
@@ -218,3 +342,164 @@ func (c *S3Client) FileExists(ctx context.Context, fileKey string) (bool, error)
 	}
 	return true, nil
 }
+
+// TransitionStorageClass moves an object to another storage class by copying it onto itself
+// 복사 시 암호화 설정이 유지되도록 기존 KMS 키를 다시 지정합니다.
+func (c *S3Client) TransitionStorageClass(ctx context.Context, fileKey, storageClass, kmsKeyID string) error {
+	if err := c.copyInPlace(ctx, fileKey, storageClass, kmsKeyID); err != nil {
+		return fmt.Errorf("failed to transition storage class: %w", err)
+	}
+	return nil
+}
+
+// ReencryptObject re-encrypts an object with another KMS key by copying it onto itself
+func (c *S3Client) ReencryptObject(ctx context.Context, fileKey, storageClass, kmsKeyID string) error {
+	if err := c.copyInPlace(ctx, fileKey, storageClass, kmsKeyID); err != nil {
+		return fmt.Errorf("failed to re-encrypt object: %w", err)
+	}
+	return nil
+}
+
+// copyInPlace copies an object onto itself with the given storage class and KMS key
+func (c *S3Client) copyInPlace(ctx context.Context, fileKey, storageClass, kmsKeyID string) error {
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(c.bucket),
+		CopySource:        aws.String(c.copySource(fileKey)),
+		Key:               aws.String(fileKey),
+		StorageClass:      types.StorageClass(storageClass),
+		MetadataDirective: types.MetadataDirectiveCopy,
+	}
+	if kmsKeyID != "" {
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(kmsKeyID)
+	}
+	_, err := c.client.CopyObject(ctx, input)
+	return err
+}
+
+// RestoreObject requests a temporary restore of an archived object
+// 이미 복원이 진행 중인 경우 에러로 취급하지 않습니다.
+func (c *S3Client) RestoreObject(ctx context.Context, fileKey string, days int32) error {
+	_, err := c.client.RestoreObject(ctx, &s3.RestoreObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(fileKey),
+		RestoreRequest: &types.RestoreRequest{
+			Days: aws.Int32(days),
+			GlacierJobParameters: &types.GlacierJobParameters{
+				Tier: types.TierStandard,
+			},
+		},
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "RestoreAlreadyInProgress" {
+			return nil
+		}
+		return fmt.Errorf("failed to restore object: %w", err)
+	}
+	return nil
+}
+
+// GetRestoreStatus reports whether an archived object is restored or being restored
+// HeadObject의 x-amz-restore 헤더를 해석합니다.
+func (c *S3Client) GetRestoreStatus(ctx context.Context, fileKey string) (restored bool, inProgress bool, err error) {
+	out, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		return false, false, fmt.Errorf("failed to head object: %w", err)
+	}
+	if out.Restore == nil {
+		return false, false, nil
+	}
+	if strings.Contains(*out.Restore, `ongoing-request="true"`) {
+		return false, true, nil
+	}
+	return true, false, nil
+}
+
+// GetObject downloads an object into memory
+// maxBytes보다 큰 객체는 읽지 않고 에러를 반환합니다.
+func (c *S3Client) GetObject(ctx context.Context, fileKey string, maxBytes int64) ([]byte, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer out.Body.Close()
+
+	if out.ContentLength != nil && *out.ContentLength > maxBytes {
+		return nil, fmt.Errorf("object too large: %d bytes", *out.ContentLength)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(out.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("object too large: more than %d bytes", maxBytes)
+	}
+	return data, nil
+}
+
+// PutObject uploads an object, replacing any existing object with the same key
+func (c *S3Client) PutObject(ctx context.Context, fileKey, contentType string, data []byte, kmsKeyID string) error {
+	input := &s3.PutObjectInput{
+		Bucket:        aws.String(c.bucket),
+		Key:           aws.String(fileKey),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		ContentType:   aws.String(contentType),
+	}
+	if kmsKeyID != "" {
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(kmsKeyID)
+	}
+	_, err := c.client.PutObject(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	return nil
+}
+
+// ErrObjectNotFound is returned when an S3 object does not exist
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectInfo holds metadata of an S3 object
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+	ETag        string
+	KMSKeyID    string // SSE-KMS key ARN; empty if not KMS-encrypted
+}
+
+// HeadObject gets object metadata without downloading it
+func (c *S3Client) HeadObject(ctx context.Context, fileKey string) (*ObjectInfo, error) {
+	out, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		var apiErr smithy.APIError
+		if errors.As(err, &notFound) || (errors.As(err, &apiErr) && apiErr.ErrorCode() == "NotFound") {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+
+	return &ObjectInfo{
+		Size:        aws.ToInt64(out.ContentLength),
+		ContentType: aws.ToString(out.ContentType),
+		ETag:        strings.Trim(aws.ToString(out.ETag), `"`),
+		KMSKeyID:    aws.ToString(out.SSEKMSKeyId),
+	}, nil
+}
+
+// Bucket returns the configured bucket name
+func (c *S3Client) Bucket() string {
+	return c.bucket
+}
@@ -0,0 +1,158 @@
+//go:build integration
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+	"storage-service/internal/config"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// newMinIOClient returns an S3Client pointed at a fresh bucket in the shared MinIO container
+func newMinIOClient(t *testing.T) *S3Client {
+	t.Helper()
+	minio := integration.MinIO(t)
+
+	c, err := NewS3Client(&config.S3Config{
+		Bucket:         minio.Bucket,
+		Region:         minio.Region,
+		AccessKey:      minio.AccessKey,
+		SecretKey:      minio.SecretKey,
+		Endpoint:       minio.Endpoint,
+		PublicEndpoint: minio.Endpoint,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Client() error = %v", err)
+	}
+	if _, err := c.client.CreateBucket(context.Background(), &s3.CreateBucketInput{Bucket: aws.String(minio.Bucket)}); err != nil {
+		t.Fatalf("failed to create bucket: %v", err)
+	}
+	return c
+}
+
+func TestS3Client_PresignedUploadAndDownload(t *testing.T) {
+	c := newMinIOClient(t)
+	ctx := context.Background()
+	content := []byte("회의록 내용입니다.\n")
+
+	uploadURL, fileKey, headers, err := c.GeneratePresignedURL(ctx, "ws-1", "notes.txt", "text/plain", "")
+	if err != nil {
+		t.Fatalf("GeneratePresignedURL() error = %v", err)
+	}
+	if !strings.HasPrefix(fileKey, "storage/ws-1/") || !strings.HasSuffix(fileKey, "/notes.txt") {
+		t.Errorf("fileKey = %q, want storage/ws-1/<id>/notes.txt", fileKey)
+	}
+
+	// The browser uploads straight to the presigned URL with the signed headers
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to build upload request: %v", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("upload request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("upload status = %d, want 200", resp.StatusCode)
+	}
+
+	info, err := c.HeadObject(ctx, fileKey)
+	if err != nil {
+		t.Fatalf("HeadObject() error = %v", err)
+	}
+	if info.Size != int64(len(content)) || info.ContentType != "text/plain" {
+		t.Errorf("HeadObject() = %+v, want size %d and text/plain", info, len(content))
+	}
+
+	downloadURL, err := c.GenerateAccessURL(ctx, fileKey, AccessURLOptions{
+		FileName: "회의록.txt",
+		Expires:  time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("GenerateAccessURL() error = %v", err)
+	}
+	resp, err = http.Get(downloadURL)
+	if err != nil {
+		t.Fatalf("download request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("download status = %d, want 200", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !bytes.Equal(body, content) {
+		t.Errorf("downloaded %q, want %q", body, content)
+	}
+	if got, want := resp.Header.Get("Content-Disposition"), ContentDisposition(false, "회의록.txt"); got != want {
+		t.Errorf("Content-Disposition = %q, want %q", got, want)
+	}
+}
+
+func TestS3Client_PresignedUploadRejectsTamperedRequest(t *testing.T) {
+	c := newMinIOClient(t)
+	ctx := context.Background()
+
+	uploadURL, fileKey, _, err := c.GeneratePresignedURL(ctx, "ws-1", "image.png", "image/png", "")
+	if err != nil {
+		t.Fatalf("GeneratePresignedURL() error = %v", err)
+	}
+
+	// Content-Type is part of the signature, so a different type must be refused
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, strings.NewReader("<script>"))
+	req.Header.Set("Content-Type", "text/html")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("upload request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("tampered upload status = %d, want 403", resp.StatusCode)
+	}
+
+	exists, err := c.FileExists(ctx, fileKey)
+	if err != nil {
+		t.Fatalf("FileExists() error = %v", err)
+	}
+	if exists {
+		t.Error("object should not exist after a rejected upload")
+	}
+}
+
+func TestS3Client_DeleteFile(t *testing.T) {
+	c := newMinIOClient(t)
+	ctx := context.Background()
+
+	if err := c.PutObject(ctx, "storage/ws-1/doc.txt", "text/plain", []byte("doc"), ""); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+	if exists, _ := c.FileExists(ctx, "storage/ws-1/doc.txt"); !exists {
+		t.Fatal("object should exist after PutObject")
+	}
+
+	if err := c.DeleteFile(ctx, "storage/ws-1/doc.txt"); err != nil {
+		t.Fatalf("DeleteFile() error = %v", err)
+	}
+	if exists, _ := c.FileExists(ctx, "storage/ws-1/doc.txt"); exists {
+		t.Error("object should not exist after DeleteFile")
+	}
+}
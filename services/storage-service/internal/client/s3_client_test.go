@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"storage-service/internal/config"
+)
+
+func TestS3Client_TransitionStorageClass_EncodesCopySource(t *testing.T) {
+	var copySource string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		copySource = r.Header.Get("X-Amz-Copy-Source")
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`))
+	}))
+	defer server.Close()
+
+	s3Client, err := NewS3Client(&config.S3Config{
+		Bucket:    "wealist",
+		Region:    "ap-northeast-2",
+		AccessKey: "test",
+		SecretKey: "test",
+		Endpoint:  server.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Client() error = %v", err)
+	}
+
+	key := "storage/ws-1/file-1/회의록 최종.pdf"
+	if err := s3Client.TransitionStorageClass(context.Background(), key, "STANDARD_IA", ""); err != nil {
+		t.Fatalf("TransitionStorageClass() error = %v", err)
+	}
+
+	want := "wealist/storage/ws-1/file-1/%ED%9A%8C%EC%9D%98%EB%A1%9D%20%EC%B5%9C%EC%A2%85.pdf"
+	if copySource != want {
+		t.Errorf("copy source = %q, want %q", copySource, want)
+	}
+}
@@ -9,11 +9,28 @@ import (
 	"go.uber.org/zap"
 
 	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/tenancy"
+)
+
+// Workspace roles returned by user-service
+const (
+	WorkspaceRoleOwner  = "OWNER"
+	WorkspaceRoleAdmin  = "ADMIN"
+	WorkspaceRoleMember = "MEMBER"
 )
 
 // UserClient defines the interface for User API interactions
 type UserClient interface {
 	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+	// GetWorkspaceRole returns the role of a user in a workspace, or "" if the user is not a member.
+	GetWorkspaceRole(ctx context.Context, workspaceID, userID uuid.UUID, token string) (string, error)
+}
+
+// workspaceMember is the subset of user-service WorkspaceMemberResponse used here
+type workspaceMember struct {
+	UserID   uuid.UUID `json:"userId"`
+	RoleName string    `json:"roleName"`
+	IsActive bool      `json:"isActive"`
 }
 
 // userClient implements UserClient interface using common HTTP client
@@ -39,7 +56,7 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 	)
 
 	var response commonclient.WorkspaceValidationResponse
-	if err := c.DoRequest(ctx, "GET", url, token, &response); err != nil {
+	if err := c.DoRequestWithRetry(ctx, "GET", url, token, &response); err != nil {
 		c.Logger.Error("Failed to validate workspace member",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -58,3 +75,41 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 
 	return isValid, nil
 }
+
+// GetWorkspaceRole looks up the role of a user from the workspace member list
+func (c *userClient) GetWorkspaceRole(ctx context.Context, workspaceID, userID uuid.UUID, token string) (string, error) {
+	url := c.BuildURL(fmt.Sprintf("/workspaces/%s/members", workspaceID.String()))
+
+	var members []workspaceMember
+	if err := c.DoRequestWithRetry(ctx, "GET", url, token, &members); err != nil {
+		c.Logger.Error("Failed to get workspace members",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+		)
+		return "", err
+	}
+
+	for _, member := range members {
+		if member.UserID == userID && member.IsActive {
+			return member.RoleName, nil
+		}
+	}
+	return "", nil
+}
+
+// membershipCachedUserClient answers membership checks from a cache and role lookups from user-service
+type membershipCachedUserClient struct {
+	UserClient
+	membership tenancy.MembershipChecker
+}
+
+// WithMembershipCache returns a UserClient whose ValidateWorkspaceMember goes through membership
+// (e.g. tenancy.CachedChecker wrapping the same client)
+func WithMembershipCache(users UserClient, membership tenancy.MembershipChecker) UserClient {
+	return &membershipCachedUserClient{UserClient: users, membership: membership}
+}
+
+// ValidateWorkspaceMember validates membership through the cache
+func (c *membershipCachedUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	return c.membership.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+}
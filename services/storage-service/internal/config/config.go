@@ -13,15 +13,69 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server    ServerConfig    `yaml:"server"`
-	Database  DatabaseConfig  `yaml:"database"`
-	Logger    LoggerConfig    `yaml:"logger"`
-	JWT       JWTConfig       `yaml:"jwt"`
-	AuthAPI   AuthAPIConfig   `yaml:"auth_api"`
-	UserAPI   UserAPIConfig   `yaml:"user_api"`
-	CORS      CORSConfig      `yaml:"cors"`
-	S3        S3Config        `yaml:"s3"`
-	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Server       ServerConfig       `yaml:"server"`
+	Database     DatabaseConfig     `yaml:"database"`
+	Logger       LoggerConfig       `yaml:"logger"`
+	JWT          JWTConfig          `yaml:"jwt"`
+	AuthAPI      AuthAPIConfig      `yaml:"auth_api"`
+	UserAPI      UserAPIConfig      `yaml:"user_api"`
+	CORS         CORSConfig         `yaml:"cors"`
+	S3           S3Config           `yaml:"s3"`
+	RateLimit    RateLimitConfig    `yaml:"rate_limit"`
+	Lifecycle    LifecycleConfig    `yaml:"lifecycle"`
+	AccessLog    AccessLogConfig    `yaml:"access_log"`
+	UploadEvents UploadEventsConfig `yaml:"upload_events"`
+	OCR          OCRConfig          `yaml:"ocr"`
+	Events       EventsConfig       `yaml:"events"`
+	InternalAPI  InternalAPIConfig  `yaml:"internal_api"`
+	Webhook      WebhookConfig      `yaml:"webhook"`
+}
+
+// WebhookConfig holds outbound project webhook configuration
+type WebhookConfig struct {
+	// AllowPrivateNetworks permits endpoints on private/loopback addresses (self-hosted internal tools).
+	AllowPrivateNetworks bool          `yaml:"allow_private_networks"`
+	RetryInterval        time.Duration `yaml:"retry_interval"` // How often due delivery retries are sent
+}
+
+// InternalAPIConfig holds service-to-service API configuration
+type InternalAPIConfig struct {
+	APIKey string `yaml:"api_key"` // Shared x-internal-api-key for workspace backup routes (empty = disabled)
+}
+
+// EventsConfig holds domain event bus configuration
+type EventsConfig struct {
+	NATSURL string `yaml:"nats_url"` // Publishes file events when set (empty = disabled)
+}
+
+// OCRConfig holds OCR text extraction configuration
+type OCRConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	BaseURL     string        `yaml:"base_url"`      // OCR server endpoint
+	Timeout     time.Duration `yaml:"timeout"`       // Per-file OCR request timeout
+	Interval    time.Duration `yaml:"interval"`      // How often pending files are processed
+	BatchSize   int           `yaml:"batch_size"`    // Max files processed per run
+	MaxFileSize int64         `yaml:"max_file_size"` // Larger files are not sent to OCR
+}
+
+// UploadEventsConfig holds S3 bucket event (ObjectCreated) upload confirmation configuration
+type UploadEventsConfig struct {
+	Enabled      bool     `yaml:"enabled"`
+	AuthToken    string   `yaml:"auth_token"`     // Shared token required on event requests
+	SNSTopicARNs []string `yaml:"sns_topic_arns"` // Allowed SNS topics (empty allows all)
+}
+
+// AccessLogConfig holds file access audit log configuration
+type AccessLogConfig struct {
+	RetentionDays   int           `yaml:"retention_days"`   // Default retention when a workspace has none configured
+	CleanupInterval time.Duration `yaml:"cleanup_interval"` // How often expired logs are purged
+}
+
+// LifecycleConfig holds storage lifecycle tiering job configuration
+type LifecycleConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batch_size"` // Max files transitioned per workspace per run
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -53,6 +107,8 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	AutoMigrate     bool          `yaml:"auto_migrate"`
+	// ReplicaDSNs are read replica DSNs for heavy list endpoints (empty = primary only)
+	ReplicaDSNs []string `yaml:"replica_dsns"`
 }
 
 // LoggerConfig holds logger configuration
@@ -92,6 +148,10 @@ type S3Config struct {
 	SecretKey      string `yaml:"secret_key"`
 	Endpoint       string `yaml:"endpoint"`
 	PublicEndpoint string `yaml:"public_endpoint"`
+
+	// CloudFront signed URLs (CDN mode), required for IP-restricted download URLs
+	CloudFrontKeyPairID  string `yaml:"cloudfront_key_pair_id"`
+	CloudFrontPrivateKey string `yaml:"cloudfront_private_key"` // PEM-encoded RSA private key
 }
 
 // Load loads configuration from file and environment variables
@@ -225,6 +285,33 @@ func (c *Config) overrideFromEnv() {
 		c.Database.AutoMigrate = autoMigrate == "true"
 	}
 
+	// Connection pool tuning
+	if maxOpen := os.Getenv("DB_MAX_OPEN_CONNS"); maxOpen != "" {
+		if v, err := strconv.Atoi(maxOpen); err == nil {
+			c.Database.MaxOpenConns = v
+		}
+	}
+	if maxIdle := os.Getenv("DB_MAX_IDLE_CONNS"); maxIdle != "" {
+		if v, err := strconv.Atoi(maxIdle); err == nil {
+			c.Database.MaxIdleConns = v
+		}
+	}
+	if lifetime := os.Getenv("DB_CONN_MAX_LIFETIME"); lifetime != "" {
+		if d, err := time.ParseDuration(lifetime); err == nil {
+			c.Database.ConnMaxLifetime = d
+		}
+	}
+
+	// Read replicas (comma-separated DSNs)
+	if replicas := os.Getenv("DB_REPLICA_DSNS"); replicas != "" {
+		c.Database.ReplicaDSNs = nil
+		for _, dsn := range strings.Split(replicas, ",") {
+			if dsn = strings.TrimSpace(dsn); dsn != "" {
+				c.Database.ReplicaDSNs = append(c.Database.ReplicaDSNs, dsn)
+			}
+		}
+	}
+
 	// Logger
 	if level := os.Getenv("LOG_LEVEL"); level != "" {
 		c.Logger.Level = level
@@ -281,6 +368,13 @@ func (c *Config) overrideFromEnv() {
 	if s3PublicEndpoint := os.Getenv("S3_PUBLIC_ENDPOINT"); s3PublicEndpoint != "" {
 		c.S3.PublicEndpoint = s3PublicEndpoint
 	}
+	if keyPairID := os.Getenv("CLOUDFRONT_KEY_PAIR_ID"); keyPairID != "" {
+		c.S3.CloudFrontKeyPairID = keyPairID
+	}
+	if privateKey := os.Getenv("CLOUDFRONT_PRIVATE_KEY"); privateKey != "" {
+		// 환경 변수에서는 줄바꿈을 \n으로 전달할 수 있음
+		c.S3.CloudFrontPrivateKey = strings.ReplaceAll(privateKey, `\n`, "\n")
+	}
 
 	// Rate Limit
 	if rateLimitEnabled := os.Getenv("RATE_LIMIT_ENABLED"); rateLimitEnabled != "" {
@@ -299,6 +393,119 @@ func (c *Config) overrideFromEnv() {
 	if c.RateLimit.RequestsPerMinute == 0 {
 		c.RateLimit.RequestsPerMinute = 60
 	}
+
+	// Lifecycle tiering job
+	if lifecycleEnabled := os.Getenv("LIFECYCLE_ENABLED"); lifecycleEnabled != "" {
+		c.Lifecycle.Enabled = lifecycleEnabled == "true"
+	}
+	if interval := os.Getenv("LIFECYCLE_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Lifecycle.Interval = d
+		}
+	}
+	if batch := os.Getenv("LIFECYCLE_BATCH_SIZE"); batch != "" {
+		if v, err := strconv.Atoi(batch); err == nil {
+			c.Lifecycle.BatchSize = v
+		}
+	}
+	if c.Lifecycle.Interval == 0 {
+		c.Lifecycle.Interval = 6 * time.Hour
+	}
+	if c.Lifecycle.BatchSize == 0 {
+		c.Lifecycle.BatchSize = 500
+	}
+
+	// File access audit log
+	if retention := os.Getenv("ACCESS_LOG_RETENTION_DAYS"); retention != "" {
+		if v, err := strconv.Atoi(retention); err == nil {
+			c.AccessLog.RetentionDays = v
+		}
+	}
+	if interval := os.Getenv("ACCESS_LOG_CLEANUP_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.AccessLog.CleanupInterval = d
+		}
+	}
+	if c.AccessLog.RetentionDays == 0 {
+		c.AccessLog.RetentionDays = 90
+	}
+	if c.AccessLog.CleanupInterval == 0 {
+		c.AccessLog.CleanupInterval = 24 * time.Hour
+	}
+
+	// S3 event-driven upload confirmation
+	if uploadEventsEnabled := os.Getenv("UPLOAD_EVENTS_ENABLED"); uploadEventsEnabled != "" {
+		c.UploadEvents.Enabled = uploadEventsEnabled == "true"
+	}
+	if token := os.Getenv("UPLOAD_EVENTS_AUTH_TOKEN"); token != "" {
+		c.UploadEvents.AuthToken = token
+	}
+	if arns := os.Getenv("UPLOAD_EVENTS_SNS_TOPIC_ARNS"); arns != "" {
+		c.UploadEvents.SNSTopicARNs = strings.Split(arns, ",")
+	}
+
+	// Outbound project webhooks
+	if allow := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"); allow != "" {
+		c.Webhook.AllowPrivateNetworks = allow == "true"
+	}
+	if interval := os.Getenv("WEBHOOK_RETRY_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Webhook.RetryInterval = d
+		}
+	}
+	if c.Webhook.RetryInterval == 0 {
+		c.Webhook.RetryInterval = 5 * time.Second
+	}
+
+	// INTERNAL_API_KEY - user-service 워크스페이스 백업 내부 라우트
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		c.InternalAPI.APIKey = apiKey
+	}
+
+	// NATS_URL - 도메인 이벤트(파일 변경) 발행
+	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
+		c.Events.NATSURL = natsURL
+	}
+
+	// OCR text extraction
+	if ocrEnabled := os.Getenv("OCR_ENABLED"); ocrEnabled != "" {
+		c.OCR.Enabled = ocrEnabled == "true"
+	}
+	if baseURL := os.Getenv("OCR_BASE_URL"); baseURL != "" {
+		c.OCR.BaseURL = baseURL
+	}
+	if timeout := os.Getenv("OCR_TIMEOUT"); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			c.OCR.Timeout = d
+		}
+	}
+	if interval := os.Getenv("OCR_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.OCR.Interval = d
+		}
+	}
+	if batch := os.Getenv("OCR_BATCH_SIZE"); batch != "" {
+		if v, err := strconv.Atoi(batch); err == nil {
+			c.OCR.BatchSize = v
+		}
+	}
+	if maxSize := os.Getenv("OCR_MAX_FILE_SIZE"); maxSize != "" {
+		if v, err := strconv.ParseInt(maxSize, 10, 64); err == nil {
+			c.OCR.MaxFileSize = v
+		}
+	}
+	if c.OCR.Timeout == 0 {
+		c.OCR.Timeout = 2 * time.Minute
+	}
+	if c.OCR.Interval == 0 {
+		c.OCR.Interval = 30 * time.Second
+	}
+	if c.OCR.BatchSize == 0 {
+		c.OCR.BatchSize = 10
+	}
+	if c.OCR.MaxFileSize == 0 {
+		c.OCR.MaxFileSize = 20 * 1024 * 1024
+	}
 }
 
 // validate validates the configuration
@@ -321,6 +528,15 @@ func (c *Config) validate() error {
 	if c.JWT.Secret == "" {
 		return fmt.Errorf("jwt secret is required")
 	}
+	if c.UploadEvents.Enabled && c.UploadEvents.AuthToken == "" {
+		return fmt.Errorf("upload events auth token is required when upload events are enabled")
+	}
+	if c.OCR.Enabled && c.OCR.BaseURL == "" {
+		return fmt.Errorf("ocr base url is required when ocr is enabled")
+	}
+	if (c.S3.CloudFrontKeyPairID == "") != (c.S3.CloudFrontPrivateKey == "") {
+		return fmt.Errorf("cloudfront key pair id and private key must be set together")
+	}
 	return nil
 }
 
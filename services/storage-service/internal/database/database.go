@@ -17,6 +17,8 @@ type Config struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	// ReplicaDSNs are read replica DSNs used by EnableReadReplicas (optional)
+	ReplicaDSNs []string
 }
 
 // New creates a new database connection
@@ -50,6 +52,16 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.File{},
 		&domain.FileShare{},
 		&domain.FolderShare{},
+		&domain.WorkspaceSettings{},
+		&domain.Webhook{},
+		&domain.WebhookDelivery{},
+		&domain.FileAccessLog{},
+		&domain.FileACLEntry{},
+		&domain.Tag{},
+		&domain.FileTag{},
+		&domain.BatchOperationLog{},
+		&domain.WorkspaceEncryptionKey{},
+		&domain.FileText{},
 	)
 }
 
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileAccessAction represents the kind of access recorded in the audit trail
+type FileAccessAction string
+
+const (
+	FileAccessDownload     FileAccessAction = "DOWNLOAD"      // Download URL generated
+	FileAccessPreview      FileAccessAction = "PREVIEW"       // Inline preview URL generated
+	FileAccessShareCreated FileAccessAction = "SHARE_CREATED" // Share created for the file
+	FileAccessShareLink    FileAccessAction = "SHARE_LINK"    // File opened through a share link
+)
+
+// IsValid returns true if the action is a supported value
+func (a FileAccessAction) IsValid() bool {
+	switch a {
+	case FileAccessDownload, FileAccessPreview, FileAccessShareCreated, FileAccessShareLink:
+		return true
+	default:
+		return false
+	}
+}
+
+// FileAccessLog represents a single access to a file for security review
+// 파일 접근 감사 로그 (다운로드, 미리보기, 공유)
+type FileAccessLog struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID        `gorm:"type:uuid;not null;index:idx_access_log_workspace_created" json:"workspaceId"`
+	ProjectID   *uuid.UUID       `gorm:"type:uuid;index" json:"projectId,omitempty"`
+	FileID      uuid.UUID        `gorm:"type:uuid;not null;index" json:"fileId"`
+	FileName    string           `gorm:"size:255;not null" json:"fileName"`       // Name at access time
+	UserID      *uuid.UUID       `gorm:"type:uuid;index" json:"userId,omitempty"` // nil for anonymous share link access
+	Action      FileAccessAction `gorm:"size:32;not null;index" json:"action"`
+	ShareID     *uuid.UUID       `gorm:"type:uuid" json:"shareId,omitempty"`
+	IPAddress   string           `gorm:"size:64" json:"ipAddress"`
+	UserAgent   string           `gorm:"size:512" json:"userAgent"`
+	CreatedAt   time.Time        `gorm:"not null;index:idx_access_log_workspace_created" json:"createdAt"`
+}
+
+// TableName returns the table name for FileAccessLog
+func (FileAccessLog) TableName() string {
+	return "storage_file_access_logs"
+}
+
+// AccessLogFilter holds filters for querying access logs
+type AccessLogFilter struct {
+	FileID *uuid.UUID
+	UserID *uuid.UUID
+	Action *FileAccessAction
+	From   *time.Time
+	To     *time.Time
+}
+
+// AccessLogListResponse represents list of access logs with pagination
+type AccessLogListResponse struct {
+	Logs       []FileAccessLog `json:"logs"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"pageSize"`
+	TotalPages int             `json:"totalPages"`
+}
+
+// UpdateAccessLogSettingsRequest represents request for updating access log retention
+type UpdateAccessLogSettingsRequest struct {
+	RetentionDays int `json:"retentionDays" binding:"min=1,max=3650"`
+}
+
+// AccessLogSettingsResponse represents access log settings returned to client
+type AccessLogSettingsResponse struct {
+	WorkspaceID   uuid.UUID `json:"workspaceId"`
+	RetentionDays int       `json:"retentionDays"`
+	IsDefault     bool      `json:"isDefault"` // true when the service default is used
+}
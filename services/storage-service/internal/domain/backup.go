@@ -0,0 +1,28 @@
+package domain
+
+import "github.com/google/uuid"
+
+// BackupFormatVersion is the version of the workspace backup section written by this service.
+// Import rejects other versions so an old service never half-restores a newer archive.
+const BackupFormatVersion = 1
+
+// WorkspaceBackup is the storage-service section of a workspace export archive.
+// It is a manifest of folder and file metadata (including trash); the objects themselves
+// stay in the bucket under their FileKey and are not copied into the archive.
+type WorkspaceBackup struct {
+	FormatVersion int       `json:"formatVersion"`
+	WorkspaceID   uuid.UUID `json:"workspaceId"`
+	Folders       []Folder  `json:"folders"`
+	Files         []File    `json:"files"`
+	Tags          []Tag     `json:"tags"`
+	FileTags      []FileTag `json:"fileTags"`
+}
+
+// BackupImportResult reports how many rows an import inserted per table.
+// Rows that already exist (same ID or file key) are skipped, so re-running an import is safe.
+type BackupImportResult struct {
+	Folders  int64 `json:"folders"`
+	Files    int64 `json:"files"`
+	Tags     int64 `json:"tags"`
+	FileTags int64 `json:"fileTags"`
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBatchFiles is the maximum number of files in a single batch request
+const MaxBatchFiles = 100
+
+// BatchOperation represents an operation applied to many files at once
+type BatchOperation string
+
+const (
+	BatchOperationDelete  BatchOperation = "DELETE"  // Move files to trash
+	BatchOperationRestore BatchOperation = "RESTORE" // Restore files from trash
+	BatchOperationMove    BatchOperation = "MOVE"    // Move files to a folder
+	BatchOperationTag     BatchOperation = "TAG"     // Attach tags to files
+)
+
+// IsValid returns true if the operation is supported
+func (o BatchOperation) IsValid() bool {
+	switch o {
+	case BatchOperationDelete, BatchOperationRestore, BatchOperationMove, BatchOperationTag:
+		return true
+	default:
+		return false
+	}
+}
+
+// BatchFileRequest represents request for applying one operation to many files
+type BatchFileRequest struct {
+	WorkspaceID uuid.UUID      `json:"workspaceId" binding:"required"`
+	Operation   BatchOperation `json:"operation" binding:"required"`
+	FileIDs     []uuid.UUID    `json:"fileIds" binding:"required,min=1,max=100"`
+	FolderID    *uuid.UUID     `json:"folderId,omitempty"` // MOVE destination; uuid.Nil moves to root
+	Tags        []string       `json:"tags,omitempty"`     // TAG names
+}
+
+// BatchItemResult represents the outcome for a single file in a batch
+type BatchItemResult struct {
+	FileID  uuid.UUID `json:"fileId"`
+	Success bool      `json:"success"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// BatchFileResponse represents the outcome of a batch request
+type BatchFileResponse struct {
+	BatchID   uuid.UUID         `json:"batchId"`
+	Operation BatchOperation    `json:"operation"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []BatchItemResult `json:"results"`
+}
+
+// BatchOperationLog is the aggregated audit entry of a batch request
+// 배치 요청 하나당 하나의 감사 로그를 남기고, 파일별 결과는 Results에 JSON으로 저장합니다.
+type BatchOperationLog struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID      `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	UserID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId"`
+	Operation   BatchOperation `gorm:"size:20;not null" json:"operation"`
+	Succeeded   int            `gorm:"not null" json:"succeeded"`
+	Failed      int            `gorm:"not null" json:"failed"`
+	Results     string         `gorm:"type:text;not null" json:"results"` // JSON-encoded []BatchItemResult
+	CreatedAt   time.Time      `gorm:"not null;index" json:"createdAt"`
+}
+
+// TableName returns the table name for BatchOperationLog
+func (BatchOperationLog) TableName() string {
+	return "storage_batch_operation_logs"
+}
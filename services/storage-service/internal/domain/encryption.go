@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EncryptionKeyStatus represents the state of a workspace KMS key
+type EncryptionKeyStatus string
+
+const (
+	EncryptionKeyActive   EncryptionKeyStatus = "ACTIVE"   // Used for new uploads
+	EncryptionKeyRetired  EncryptionKeyStatus = "RETIRED"  // Rotated out, still decrypts existing files
+	EncryptionKeyDisabled EncryptionKeyStatus = "DISABLED" // Downloads of files encrypted with it are denied
+)
+
+// WorkspaceEncryptionKey is a KMS key used for SSE-KMS encryption of a workspace's files
+// 워크스페이스별 KMS 키. 로테이션 시 이전 키는 RETIRED로 남아 기존 파일 복호화에 사용됩니다.
+type WorkspaceEncryptionKey struct {
+	ID          uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID           `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	KMSKeyID    string              `gorm:"size:2048;not null" json:"kmsKeyId"` // KMS key ID, ARN, or alias
+	Status      EncryptionKeyStatus `gorm:"size:20;not null;index" json:"status"`
+	CreatedBy   uuid.UUID           `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt   time.Time           `gorm:"not null" json:"createdAt"`
+	RotatedAt   *time.Time          `json:"rotatedAt,omitempty"`
+	DisabledAt  *time.Time          `json:"disabledAt,omitempty"`
+}
+
+// TableName returns the table name for WorkspaceEncryptionKey
+func (WorkspaceEncryptionKey) TableName() string {
+	return "storage_workspace_encryption_keys"
+}
+
+// RotateEncryptionKeyRequest represents request for setting a new active KMS key
+type RotateEncryptionKeyRequest struct {
+	KMSKeyID string `json:"kmsKeyId" binding:"required,max=2048"`
+}
+
+// ReencryptFilesResponse represents the outcome of re-encrypting files with the active key
+type ReencryptFilesResponse struct {
+	Reencrypted int   `json:"reencrypted"`
+	Failed      int   `json:"failed"`
+	Remaining   int64 `json:"remaining"`
+}
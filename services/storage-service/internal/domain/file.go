@@ -19,26 +19,39 @@ const (
 
 // File represents a file in the storage system
 type File struct {
-	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	WorkspaceID uuid.UUID  `gorm:"type:uuid;not null;index" json:"workspaceId"`
-	ProjectID   *uuid.UUID `gorm:"type:uuid;index" json:"projectId,omitempty"` // nil means workspace-level (no project)
-	FolderID    *uuid.UUID `gorm:"type:uuid;index" json:"folderId,omitempty"`  // nil means root folder
-	Name        string     `gorm:"size:255;not null" json:"name"`
-	OriginalName string    `gorm:"size:255;not null" json:"originalName"`
-	FileKey     string     `gorm:"size:512;not null;uniqueIndex" json:"fileKey"` // S3 key
-	FileSize    int64      `gorm:"not null" json:"fileSize"`                      // Size in bytes
-	ContentType string     `gorm:"size:128;not null" json:"contentType"`
-	Status      FileStatus `gorm:"size:20;not null;default:'ACTIVE'" json:"status"`
-	Version     int        `gorm:"not null;default:1" json:"version"` // File versioning
-	UploadedBy  uuid.UUID  `gorm:"type:uuid;not null;index" json:"uploadedBy"`
-	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
-	UpdatedAt   time.Time  `gorm:"not null" json:"updatedAt"`
-	DeletedAt   *time.Time `gorm:"index" json:"deletedAt,omitempty"` // Soft delete for trash
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID  uuid.UUID  `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	ProjectID    *uuid.UUID `gorm:"type:uuid;index" json:"projectId,omitempty"` // nil means workspace-level (no project)
+	FolderID     *uuid.UUID `gorm:"type:uuid;index" json:"folderId,omitempty"`  // nil means root folder
+	Name         string     `gorm:"size:255;not null" json:"name"`
+	OriginalName string     `gorm:"size:255;not null" json:"originalName"`
+	FileKey      string     `gorm:"size:512;not null;uniqueIndex" json:"fileKey"` // S3 key
+	FileSize     int64      `gorm:"not null" json:"fileSize"`                     // Size in bytes
+	ContentType  string     `gorm:"size:128;not null" json:"contentType"`
+	Status       FileStatus `gorm:"size:20;not null;default:'ACTIVE'" json:"status"`
+	Version      int        `gorm:"not null;default:1" json:"version"` // File versioning
+	UploadedBy   uuid.UUID  `gorm:"type:uuid;not null;index" json:"uploadedBy"`
+
+	// Lifecycle tiering
+	StorageClass       StorageClass `gorm:"size:32;not null;default:'STANDARD';index" json:"storageClass"`
+	LastAccessedAt     *time.Time   `gorm:"index" json:"lastAccessedAt,omitempty"` // Last download URL generation
+	RestoreRequestedAt *time.Time   `json:"restoreRequestedAt,omitempty"`          // Archive restore in progress since
+
+	// Privacy
+	MetadataStrippedAt *time.Time `json:"metadataStrippedAt,omitempty"` // EXIF/GPS metadata removed at
+
+	// Encryption
+	KMSKeyID *string `gorm:"size:2048;index" json:"kmsKeyId,omitempty"` // SSE-KMS key; nil uses bucket default encryption
+
+	CreatedAt time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt time.Time  `gorm:"not null" json:"updatedAt"`
+	DeletedAt *time.Time `gorm:"index" json:"deletedAt,omitempty"` // Soft delete for trash
 
 	// Relations
 	Project *Project    `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
 	Folder  *Folder     `gorm:"foreignKey:FolderID" json:"folder,omitempty"`
 	Shares  []FileShare `gorm:"foreignKey:FileID" json:"shares,omitempty"`
+	Tags    []Tag       `gorm:"-" json:"tags,omitempty"` // Loaded by FileRepository
 }
 
 // TableName returns the table name for File
@@ -80,6 +93,23 @@ func (f *File) IsDocument() bool {
 	return false
 }
 
+// KMSKey returns the SSE-KMS key of this file, or "" for bucket default encryption
+func (f *File) KMSKey() string {
+	if f.KMSKeyID == nil {
+		return ""
+	}
+	return *f.KMSKeyID
+}
+
+// TagNames returns the names of the tags attached to this file
+func (f *File) TagNames() []string {
+	names := make([]string, 0, len(f.Tags))
+	for _, tag := range f.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
 // CreateFileRequest represents request for creating a new file record
 type CreateFileRequest struct {
 	WorkspaceID  uuid.UUID  `json:"workspaceId" binding:"required"`
@@ -110,10 +140,11 @@ type GenerateUploadURLRequest struct {
 
 // GenerateUploadURLResponse represents response with presigned upload URL
 type GenerateUploadURLResponse struct {
-	UploadURL string    `json:"uploadUrl"`
-	FileKey   string    `json:"fileKey"`
-	FileID    uuid.UUID `json:"fileId"`
-	ExpiresAt time.Time `json:"expiresAt"`
+	UploadURL     string            `json:"uploadUrl"`
+	UploadHeaders map[string]string `json:"uploadHeaders,omitempty"` // Headers the client must send with the PUT (e.g. SSE-KMS)
+	FileKey       string            `json:"fileKey"`
+	FileID        uuid.UUID         `json:"fileId"`
+	ExpiresAt     time.Time         `json:"expiresAt"`
 }
 
 // ConfirmUploadRequest represents request to confirm file upload completion
@@ -123,24 +154,27 @@ type ConfirmUploadRequest struct {
 
 // FileResponse represents file data returned to client
 type FileResponse struct {
-	ID           uuid.UUID  `json:"id"`
-	WorkspaceID  uuid.UUID  `json:"workspaceId"`
-	ProjectID    *uuid.UUID `json:"projectId,omitempty"`
-	FolderID     *uuid.UUID `json:"folderId,omitempty"`
-	Name         string     `json:"name"`
-	OriginalName string     `json:"originalName"`
-	FileURL      string     `json:"fileUrl"` // Public URL to access the file
-	FileSize     int64      `json:"fileSize"`
-	ContentType  string     `json:"contentType"`
-	Status       FileStatus `json:"status"`
-	Version      int        `json:"version"`
-	UploadedBy   uuid.UUID  `json:"uploadedBy"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	UpdatedAt    time.Time  `json:"updatedAt"`
-	IsDeleted    bool       `json:"isDeleted"`
-	IsImage      bool       `json:"isImage"`
-	IsDocument   bool       `json:"isDocument"`
-	Extension    string     `json:"extension"`
+	ID           uuid.UUID    `json:"id"`
+	WorkspaceID  uuid.UUID    `json:"workspaceId"`
+	ProjectID    *uuid.UUID   `json:"projectId,omitempty"`
+	FolderID     *uuid.UUID   `json:"folderId,omitempty"`
+	Name         string       `json:"name"`
+	OriginalName string       `json:"originalName"`
+	FileURL      string       `json:"fileUrl"` // Public URL to access the file
+	FileSize     int64        `json:"fileSize"`
+	ContentType  string       `json:"contentType"`
+	Status       FileStatus   `json:"status"`
+	Version      int          `json:"version"`
+	UploadedBy   uuid.UUID    `json:"uploadedBy"`
+	StorageClass StorageClass `json:"storageClass"`
+	Encrypted    bool         `json:"encrypted"` // Encrypted with a workspace KMS key
+	Tags         []string     `json:"tags"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
+	IsDeleted    bool         `json:"isDeleted"`
+	IsImage      bool         `json:"isImage"`
+	IsDocument   bool         `json:"isDocument"`
+	Extension    string       `json:"extension"`
 }
 
 // ToResponse converts File to FileResponse
@@ -158,6 +192,9 @@ func (f *File) ToResponse(fileURL string) FileResponse {
 		Status:       f.Status,
 		Version:      f.Version,
 		UploadedBy:   f.UploadedBy,
+		StorageClass: f.StorageClass,
+		Encrypted:    f.KMSKeyID != nil,
+		Tags:         f.TagNames(),
 		CreatedAt:    f.CreatedAt,
 		UpdatedAt:    f.UpdatedAt,
 		IsDeleted:    f.IsDeleted(),
@@ -167,6 +204,34 @@ func (f *File) ToResponse(fileURL string) FileResponse {
 	}
 }
 
+// Download URL expiry limits
+const (
+	DefaultDownloadURLExpiry = time.Hour
+	MinDownloadURLExpiry     = time.Minute
+	MaxDownloadURLExpiry     = 12 * time.Hour
+)
+
+// DownloadURLOptions represents caller options for generating a download URL
+type DownloadURLOptions struct {
+	ExpiresIn time.Duration // 0 uses DefaultDownloadURLExpiry
+	Inline    bool          // inline instead of attachment (ignored for active content)
+	FileName  string        // Content-Disposition file name override
+	IPRange   string        // Client IP or CIDR allowed to use the URL
+}
+
+// IsSafeInlineContentType returns false for content types that can run script in the browser
+// HTML/SVG/XML 등은 인라인으로 열면 스크립트가 실행될 수 있어 항상 첨부 파일로 내려줍니다.
+func IsSafeInlineContentType(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch mediaType {
+	case "text/html", "application/xhtml+xml", "image/svg+xml", "text/xml", "application/xml",
+		"text/javascript", "application/javascript", "application/x-javascript":
+		return false
+	default:
+		return true
+	}
+}
+
 // FileListResponse represents list of files with pagination
 type FileListResponse struct {
 	Files      []FileResponse `json:"files"`
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ACLPrincipalType represents who an ACL entry grants access to
+type ACLPrincipalType string
+
+const (
+	ACLPrincipalUser    ACLPrincipalType = "USER"    // A single user
+	ACLPrincipalProject ACLPrincipalType = "PROJECT" // All members of a project (team)
+)
+
+// IsValid returns true if the principal type is supported
+func (t ACLPrincipalType) IsValid() bool {
+	return t == ACLPrincipalUser || t == ACLPrincipalProject
+}
+
+// ACLPermission represents the access level granted by an ACL entry
+type ACLPermission string
+
+const (
+	ACLPermissionView ACLPermission = "VIEW" // Can view and download
+	ACLPermissionEdit ACLPermission = "EDIT" // Can view, download, update, and share
+)
+
+// IsValid returns true if the permission is supported
+func (p ACLPermission) IsValid() bool {
+	return p == ACLPermissionView || p == ACLPermissionEdit
+}
+
+// Allows returns true if this permission satisfies the required project permission
+func (p ACLPermission) Allows(required ProjectPermission) bool {
+	switch required {
+	case ProjectPermissionViewer:
+		return p == ACLPermissionView || p == ACLPermissionEdit
+	case ProjectPermissionEditor:
+		return p == ACLPermissionEdit
+	default:
+		// OWNER 수준 작업은 ACL로 부여할 수 없음
+		return false
+	}
+}
+
+// FileACLEntry restricts a file to specific users or teams
+// 파일에 ACL 항목이 하나라도 있으면 프로젝트 권한에 더해 ACL도 만족해야 접근 가능합니다.
+type FileACLEntry struct {
+	ID            uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	FileID        uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_file_acl_principal" json:"fileId"`
+	PrincipalType ACLPrincipalType `gorm:"size:20;not null;uniqueIndex:idx_file_acl_principal" json:"principalType"`
+	PrincipalID   uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_file_acl_principal" json:"principalId"`
+	Permission    ACLPermission    `gorm:"size:20;not null;default:'VIEW'" json:"permission"`
+	CreatedBy     uuid.UUID        `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt     time.Time        `gorm:"not null" json:"createdAt"`
+}
+
+// TableName returns the table name for FileACLEntry
+func (FileACLEntry) TableName() string {
+	return "storage_file_acl_entries"
+}
+
+// FileACLEntryRequest represents a single ACL entry in a request
+type FileACLEntryRequest struct {
+	PrincipalType ACLPrincipalType `json:"principalType" binding:"required"`
+	PrincipalID   uuid.UUID        `json:"principalId" binding:"required"`
+	Permission    ACLPermission    `json:"permission" binding:"required"`
+}
+
+// SetFileACLRequest represents request for replacing the ACL of a file
+// 빈 목록은 ACL 제한 해제(프로젝트 권한만 적용)를 의미합니다.
+type SetFileACLRequest struct {
+	Entries []FileACLEntryRequest `json:"entries" binding:"dive"`
+}
+
+// FileACLResponse represents the ACL of a file returned to client
+type FileACLResponse struct {
+	FileID     uuid.UUID      `json:"fileId"`
+	Restricted bool           `json:"restricted"`
+	Entries    []FileACLEntry `json:"entries"`
+}
@@ -94,3 +94,24 @@ func (f *Folder) ToResponse() FolderResponse {
 		IsDeleted:   f.IsDeleted(),
 	}
 }
+
+// MaxFolderTreeDepth limits the depth of a folder tree request
+const MaxFolderTreeDepth = 50
+
+// FolderTreeStat holds recursive file statistics of a folder
+type FolderTreeStat struct {
+	FolderID  uuid.UUID
+	FileCount int64
+	TotalSize int64
+}
+
+// FolderTreeResponse represents the folder hierarchy of a workspace
+// 각 폴더의 FileCount/TotalSize는 하위 폴더를 포함한 재귀 합계이고, FolderCount는 직속 하위 폴더 수입니다.
+type FolderTreeResponse struct {
+	WorkspaceID uuid.UUID        `json:"workspaceId"`
+	FolderID    *uuid.UUID       `json:"folderId,omitempty"` // Subtree root (nil means workspace root)
+	Depth       int              `json:"depth"`              // 0 means unlimited
+	FileCount   int64            `json:"fileCount"`
+	TotalSize   int64            `json:"totalSize"`
+	Folders     []FolderResponse `json:"folders"`
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OCRStatus represents the text extraction state of a file
+type OCRStatus string
+
+const (
+	OCRStatusPending    OCRStatus = "PENDING"    // Waiting for the OCR job
+	OCRStatusProcessing OCRStatus = "PROCESSING" // Claimed by an OCR worker
+	OCRStatusCompleted  OCRStatus = "COMPLETED"  // Text extracted
+	OCRStatusFailed     OCRStatus = "FAILED"     // Gave up after max attempts
+)
+
+// DefaultOCRLanguages is used when a workspace has not selected OCR languages
+const DefaultOCRLanguages = "kor+eng"
+
+// SupportedOCRLanguages lists the Tesseract language codes accepted in workspace settings
+var SupportedOCRLanguages = map[string]bool{
+	"eng":     true,
+	"kor":     true,
+	"jpn":     true,
+	"chi_sim": true,
+	"chi_tra": true,
+	"deu":     true,
+	"fra":     true,
+	"spa":     true,
+}
+
+// ParseOCRLanguages splits a "kor+eng" style language list and validates each code
+// 잘못된 코드가 있으면 ok=false를 반환합니다.
+func ParseOCRLanguages(languages string) ([]string, bool) {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, code := range strings.Split(languages, "+") {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if !SupportedOCRLanguages[code] {
+			return nil, false
+		}
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes, len(codes) > 0
+}
+
+// SupportsOCR returns true if text can be extracted from the content type
+// 스캔 문서(PDF)와 일반적인 래스터 이미지만 OCR 대상입니다.
+func SupportsOCR(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch mediaType {
+	case "application/pdf", "image/jpeg", "image/png", "image/tiff", "image/webp", "image/bmp":
+		return true
+	default:
+		return false
+	}
+}
+
+// FileText holds text extracted from a file by OCR
+// 파일과 1:1로 저장되며 파일 검색 시 함께 조회됩니다.
+type FileText struct {
+	FileID      uuid.UUID  `gorm:"type:uuid;primaryKey" json:"fileId"`
+	WorkspaceID uuid.UUID  `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	Status      OCRStatus  `gorm:"size:20;not null;default:'PENDING';index" json:"status"`
+	Languages   string     `gorm:"size:100;not null" json:"languages"`
+	Content     string     `gorm:"type:text" json:"content"`
+	Error       *string    `gorm:"size:1000" json:"error,omitempty"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	ProcessedAt *time.Time `json:"processedAt,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for FileText
+func (FileText) TableName() string {
+	return "storage_file_texts"
+}
+
+// UpdateOCRSettingsRequest represents request for updating workspace OCR settings
+type UpdateOCRSettingsRequest struct {
+	Enabled   *bool   `json:"enabled,omitempty"`
+	Languages *string `json:"languages,omitempty"` // Tesseract codes joined with "+", e.g. "kor+eng"
+}
+
+// OCRSettingsResponse represents workspace OCR settings returned to client
+type OCRSettingsResponse struct {
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	Enabled     bool      `json:"enabled"`
+	Languages   string    `json:"languages"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ToOCRSettingsResponse converts WorkspaceSettings to OCRSettingsResponse
+func (w *WorkspaceSettings) ToOCRSettingsResponse() OCRSettingsResponse {
+	return OCRSettingsResponse{
+		WorkspaceID: w.WorkspaceID,
+		Enabled:     w.OCREnabled,
+		Languages:   w.OCRLanguagesOrDefault(),
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// OCRLanguagesOrDefault returns the configured OCR languages or DefaultOCRLanguages
+func (w *WorkspaceSettings) OCRLanguagesOrDefault() string {
+	if w.OCRLanguages == "" {
+		return DefaultOCRLanguages
+	}
+	return w.OCRLanguages
+}
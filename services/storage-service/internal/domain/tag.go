@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tag represents a label that can be attached to files in a workspace
+// 자유 태그는 파일에 태그할 때 자동 생성되고, 관리 태그는 워크스페이스에서 미리 정의합니다.
+type Tag struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_tag_workspace_name" json:"workspaceId"`
+	Name        string    `gorm:"size:50;not null;uniqueIndex:idx_tag_workspace_name" json:"name"` // Normalized to lowercase
+	Color       string    `gorm:"size:7" json:"color,omitempty"`                                   // Hex color, e.g. #FF8800
+	Managed     bool      `gorm:"not null;default:false" json:"managed"`                           // Defined by the workspace
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for Tag
+func (Tag) TableName() string {
+	return "storage_tags"
+}
+
+// FileTag links a tag to a file
+type FileTag struct {
+	FileID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"fileId"`
+	TagID     uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"tagId"`
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// TableName returns the table name for FileTag
+func (FileTag) TableName() string {
+	return "storage_file_tags"
+}
+
+// CreateTagRequest represents request for creating a workspace-managed tag
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required,max=50"`
+	Color string `json:"color,omitempty"` // Hex color, e.g. #FF8800
+}
+
+// UpdateTagRequest represents request for updating a tag
+type UpdateTagRequest struct {
+	Name    *string `json:"name,omitempty" binding:"omitempty,max=50"`
+	Color   *string `json:"color,omitempty"` // Empty string clears the color
+	Managed *bool   `json:"managed,omitempty"`
+}
+
+// TagFileRequest represents request for tagging a file
+type TagFileRequest struct {
+	Tags []string `json:"tags" binding:"required,min=1,dive,required,max=50"`
+}
+
+// TagResponse represents a tag with its usage count returned to client
+type TagResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color,omitempty"`
+	Managed   bool      `json:"managed"`
+	FileCount int64     `json:"fileCount"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// FileTagsResponse represents the tags of a file returned to client
+type FileTagsResponse struct {
+	FileID uuid.UUID `json:"fileId"`
+	Tags   []string  `json:"tags"`
+}
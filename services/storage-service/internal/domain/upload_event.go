@@ -0,0 +1,69 @@
+package domain
+
+import "strings"
+
+// S3EventNotification is the bucket event notification body sent by AWS S3 (via SNS) and MinIO
+type S3EventNotification struct {
+	EventName string          `json:"EventName,omitempty"` // MinIO webhook only
+	Key       string          `json:"Key,omitempty"`       // MinIO webhook only: "<bucket>/<key>"
+	Event     string          `json:"Event,omitempty"`     // AWS test event ("s3:TestEvent")
+	Records   []S3EventRecord `json:"Records"`
+}
+
+// S3EventRecord is a single object event in a notification
+type S3EventRecord struct {
+	EventSource string        `json:"eventSource"`
+	EventName   string        `json:"eventName"` // e.g. "ObjectCreated:Put" (AWS), "s3:ObjectCreated:Put" (MinIO)
+	EventTime   string        `json:"eventTime"`
+	S3          S3EventEntity `json:"s3"`
+}
+
+// S3EventEntity holds bucket and object of an event record
+type S3EventEntity struct {
+	Bucket S3EventBucket `json:"bucket"`
+	Object S3EventObject `json:"object"`
+}
+
+// S3EventBucket identifies the bucket of an event
+type S3EventBucket struct {
+	Name string `json:"name"`
+}
+
+// S3EventObject describes the object of an event
+// Key는 URL 인코딩되어 전달됩니다.
+type S3EventObject struct {
+	Key         string `json:"key"`
+	Size        int64  `json:"size"`
+	ETag        string `json:"eTag"`
+	ContentType string `json:"contentType,omitempty"` // MinIO only
+}
+
+// IsObjectCreated returns true if the record is an object creation event
+func (r *S3EventRecord) IsObjectCreated() bool {
+	return strings.HasPrefix(strings.TrimPrefix(r.EventName, "s3:"), "ObjectCreated:")
+}
+
+// SNS message types
+const (
+	SNSTypeNotification             = "Notification"
+	SNSTypeSubscriptionConfirmation = "SubscriptionConfirmation"
+	SNSTypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+// SNSMessage is the envelope of an SNS HTTP(S) delivery
+type SNSMessage struct {
+	Type         string `json:"Type"`
+	MessageID    string `json:"MessageId"`
+	TopicArn     string `json:"TopicArn"`
+	Message      string `json:"Message"` // JSON encoded S3EventNotification
+	SubscribeURL string `json:"SubscribeURL,omitempty"`
+	Timestamp    string `json:"Timestamp"`
+}
+
+// UploadEventResult summarizes how a notification was processed
+type UploadEventResult struct {
+	Received  int `json:"received"`
+	Activated int `json:"activated"`
+	Skipped   int `json:"skipped"`
+	Failed    int `json:"failed"`
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileEventType represents the type of a file lifecycle event
+type FileEventType string
+
+const (
+	FileEventUploaded FileEventType = "file.uploaded"
+	FileEventUpdated  FileEventType = "file.updated"
+	FileEventDeleted  FileEventType = "file.deleted"
+	FileEventRestored FileEventType = "file.restored"
+)
+
+// IsValid returns true if the event type is supported
+func (e FileEventType) IsValid() bool {
+	switch e {
+	case FileEventUploaded, FileEventUpdated, FileEventDeleted, FileEventRestored:
+		return true
+	default:
+		return false
+	}
+}
+
+// Webhook represents an outbound webhook registered on a project
+type Webhook struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	ProjectID   uuid.UUID `gorm:"type:uuid;not null;index" json:"projectId"`
+	URL         string    `gorm:"size:2048;not null" json:"url"`
+	Secret      string    `gorm:"size:128;not null" json:"-"`      // HMAC signing secret
+	Events      string    `gorm:"size:512;not null" json:"events"` // Comma-separated FileEventType list
+	IsActive    bool      `gorm:"not null;default:true" json:"isActive"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for Webhook
+func (Webhook) TableName() string {
+	return "storage_webhooks"
+}
+
+// EventList returns the subscribed events
+func (w *Webhook) EventList() []FileEventType {
+	var events []FileEventType
+	for _, e := range strings.Split(w.Events, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			events = append(events, FileEventType(e))
+		}
+	}
+	return events
+}
+
+// Subscribes returns true if the webhook is subscribed to the event
+func (w *Webhook) Subscribes(event FileEventType) bool {
+	for _, e := range w.EventList() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinEvents converts an event list to the stored comma-separated form
+func JoinEvents(events []FileEventType) string {
+	parts := make([]string, 0, len(events))
+	for _, e := range events {
+		parts = append(parts, string(e))
+	}
+	return strings.Join(parts, ",")
+}
+
+// WebhookDelivery represents a single delivery attempt of a webhook
+type WebhookDelivery struct {
+	ID         uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WebhookID  uuid.UUID     `gorm:"type:uuid;not null;index" json:"webhookId"`
+	EventID    uuid.UUID     `gorm:"type:uuid;not null;index" json:"eventId"` // Same for all retries of one event
+	EventType  FileEventType `gorm:"size:64;not null" json:"eventType"`
+	Attempt    int           `gorm:"not null" json:"attempt"`
+	StatusCode int           `gorm:"not null;default:0" json:"statusCode"` // 0 when the request failed before a response
+	Success    bool          `gorm:"not null;default:false" json:"success"`
+	Error      *string       `gorm:"size:1024" json:"error,omitempty"`
+	DurationMs int64         `gorm:"not null;default:0" json:"durationMs"`
+	Payload    string        `gorm:"type:text;not null" json:"payload"`
+	// NextAttemptAt is set on a failed attempt while a retry is pending; the retry job clears it when it picks the retry up
+	NextAttemptAt *time.Time `gorm:"index" json:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time  `gorm:"not null;index" json:"createdAt"`
+}
+
+// TableName returns the table name for WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "storage_webhook_deliveries"
+}
+
+// CreateWebhookRequest represents request for registering a webhook
+type CreateWebhookRequest struct {
+	URL    string          `json:"url" binding:"required,url"`
+	Events []FileEventType `json:"events" binding:"required,min=1"`
+}
+
+// UpdateWebhookRequest represents request for updating a webhook
+type UpdateWebhookRequest struct {
+	URL      *string         `json:"url,omitempty" binding:"omitempty,url"`
+	Events   []FileEventType `json:"events,omitempty"`
+	IsActive *bool           `json:"isActive,omitempty"`
+}
+
+// WebhookResponse represents webhook data returned to client
+type WebhookResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	WorkspaceID uuid.UUID       `json:"workspaceId"`
+	ProjectID   uuid.UUID       `json:"projectId"`
+	URL         string          `json:"url"`
+	Events      []FileEventType `json:"events"`
+	IsActive    bool            `json:"isActive"`
+	Secret      string          `json:"secret,omitempty"` // Only returned on creation
+	CreatedBy   uuid.UUID       `json:"createdBy"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// ToResponse converts Webhook to WebhookResponse (secret omitted)
+func (w *Webhook) ToResponse() WebhookResponse {
+	return WebhookResponse{
+		ID:          w.ID,
+		WorkspaceID: w.WorkspaceID,
+		ProjectID:   w.ProjectID,
+		URL:         w.URL,
+		Events:      w.EventList(),
+		IsActive:    w.IsActive,
+		CreatedBy:   w.CreatedBy,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// FileEventPayload is the JSON body delivered to webhook endpoints
+type FileEventPayload struct {
+	EventID     uuid.UUID     `json:"eventId"`
+	Event       FileEventType `json:"event"`
+	OccurredAt  time.Time     `json:"occurredAt"`
+	WorkspaceID uuid.UUID     `json:"workspaceId"`
+	ProjectID   *uuid.UUID    `json:"projectId,omitempty"`
+	ActorID     uuid.UUID     `json:"actorId"`
+	File        FileResponse  `json:"file"`
+}
+
+// WebhookDeliveryListResponse represents list of webhook deliveries with pagination
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"pageSize"`
+	TotalPages int               `json:"totalPages"`
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StorageClass represents the S3 storage class of a file object
+type StorageClass string
+
+const (
+	StorageClassStandard    StorageClass = "STANDARD"     // Frequently accessed (default)
+	StorageClassStandardIA  StorageClass = "STANDARD_IA"  // Infrequent access, instant retrieval
+	StorageClassGlacierIR   StorageClass = "GLACIER_IR"   // Archive, instant retrieval
+	StorageClassGlacier     StorageClass = "GLACIER"      // Archive, restore required before download
+	StorageClassDeepArchive StorageClass = "DEEP_ARCHIVE" // Long-term archive, restore required before download
+)
+
+// IsValid returns true if the storage class is a supported value
+func (s StorageClass) IsValid() bool {
+	switch s {
+	case StorageClassStandard, StorageClassStandardIA, StorageClassGlacierIR, StorageClassGlacier, StorageClassDeepArchive:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsArchive returns true if the storage class is one of the archive tiers
+func (s StorageClass) IsArchive() bool {
+	return s == StorageClassGlacierIR || s == StorageClassGlacier || s == StorageClassDeepArchive
+}
+
+// RequiresRestore returns true if objects in this class must be restored before download
+func (s StorageClass) RequiresRestore() bool {
+	return s == StorageClassGlacier || s == StorageClassDeepArchive
+}
+
+// WorkspaceSettings holds per-workspace storage settings
+// 워크스페이스 단위 스토리지 설정 (라이프사이클 정책 등)
+type WorkspaceSettings struct {
+	WorkspaceID uuid.UUID `gorm:"type:uuid;primaryKey" json:"workspaceId"`
+
+	// Lifecycle tiering: 일정 기간 접근되지 않은 파일을 저렴한 스토리지 클래스로 전환
+	LifecycleEnabled          bool         `gorm:"not null;default:false" json:"lifecycleEnabled"`
+	InfrequentAccessAfterDays int          `gorm:"not null;default:0" json:"infrequentAccessAfterDays"` // 0 means disabled
+	ArchiveAfterDays          int          `gorm:"not null;default:0" json:"archiveAfterDays"`          // 0 means disabled
+	ArchiveStorageClass       StorageClass `gorm:"size:32;not null;default:'GLACIER'" json:"archiveStorageClass"`
+
+	// Access audit trail: nil이면 서비스 기본 보존 기간 사용
+	AccessLogRetentionDays *int `json:"accessLogRetentionDays,omitempty"`
+
+	// Privacy: 업로드 확정 후 이미지의 EXIF/GPS 메타데이터 제거
+	StripImageMetadata bool `gorm:"not null;default:false" json:"stripImageMetadata"`
+
+	// OCR: 업로드된 이미지/스캔 PDF의 텍스트를 추출해 검색에 사용
+	OCREnabled   bool   `gorm:"not null;default:false" json:"ocrEnabled"`
+	OCRLanguages string `gorm:"size:100" json:"ocrLanguages,omitempty"` // Empty means DefaultOCRLanguages
+
+	UpdatedBy *uuid.UUID `gorm:"type:uuid" json:"updatedBy,omitempty"`
+	CreatedAt time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for WorkspaceSettings
+func (WorkspaceSettings) TableName() string {
+	return "storage_workspace_settings"
+}
+
+// DefaultWorkspaceSettings returns the settings used when a workspace has none stored
+func DefaultWorkspaceSettings(workspaceID uuid.UUID) *WorkspaceSettings {
+	return &WorkspaceSettings{
+		WorkspaceID:         workspaceID,
+		ArchiveStorageClass: StorageClassGlacier,
+	}
+}
+
+// UpdateLifecyclePolicyRequest represents request for updating a workspace lifecycle policy
+type UpdateLifecyclePolicyRequest struct {
+	Enabled                   *bool         `json:"enabled,omitempty"`
+	InfrequentAccessAfterDays *int          `json:"infrequentAccessAfterDays,omitempty" binding:"omitempty,min=0,max=3650"`
+	ArchiveAfterDays          *int          `json:"archiveAfterDays,omitempty" binding:"omitempty,min=0,max=3650"`
+	ArchiveStorageClass       *StorageClass `json:"archiveStorageClass,omitempty"`
+}
+
+// LifecyclePolicyResponse represents lifecycle policy data returned to client
+type LifecyclePolicyResponse struct {
+	WorkspaceID               uuid.UUID    `json:"workspaceId"`
+	Enabled                   bool         `json:"enabled"`
+	InfrequentAccessAfterDays int          `json:"infrequentAccessAfterDays"`
+	ArchiveAfterDays          int          `json:"archiveAfterDays"`
+	ArchiveStorageClass       StorageClass `json:"archiveStorageClass"`
+	UpdatedAt                 time.Time    `json:"updatedAt"`
+}
+
+// ToLifecyclePolicyResponse converts WorkspaceSettings to LifecyclePolicyResponse
+func (w *WorkspaceSettings) ToLifecyclePolicyResponse() LifecyclePolicyResponse {
+	return LifecyclePolicyResponse{
+		WorkspaceID:               w.WorkspaceID,
+		Enabled:                   w.LifecycleEnabled,
+		InfrequentAccessAfterDays: w.InfrequentAccessAfterDays,
+		ArchiveAfterDays:          w.ArchiveAfterDays,
+		ArchiveStorageClass:       w.ArchiveStorageClass,
+		UpdatedAt:                 w.UpdatedAt,
+	}
+}
+
+// UpdatePrivacySettingsRequest represents request for updating workspace privacy settings
+type UpdatePrivacySettingsRequest struct {
+	StripImageMetadata *bool `json:"stripImageMetadata,omitempty"`
+}
+
+// PrivacySettingsResponse represents workspace privacy settings returned to client
+type PrivacySettingsResponse struct {
+	WorkspaceID        uuid.UUID `json:"workspaceId"`
+	StripImageMetadata bool      `json:"stripImageMetadata"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+// ToPrivacySettingsResponse converts WorkspaceSettings to PrivacySettingsResponse
+func (w *WorkspaceSettings) ToPrivacySettingsResponse() PrivacySettingsResponse {
+	return PrivacySettingsResponse{
+		WorkspaceID:        w.WorkspaceID,
+		StripImageMetadata: w.StripImageMetadata,
+		UpdatedAt:          w.UpdatedAt,
+	}
+}
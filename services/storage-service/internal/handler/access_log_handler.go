@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// AccessLogHandler handles file access audit log HTTP requests
+type AccessLogHandler struct {
+	accessLogService *service.AccessLogService
+	accessService    service.AccessService
+}
+
+// NewAccessLogHandler creates a new AccessLogHandler
+func NewAccessLogHandler(accessLogService *service.AccessLogService, accessService service.AccessService) *AccessLogHandler {
+	return &AccessLogHandler{
+		accessLogService: accessLogService,
+		accessService:    accessService,
+	}
+}
+
+// fileAccessContext builds the audit context (user, IP, user agent) of a request
+func fileAccessContext(c *gin.Context, userID, shareID *uuid.UUID) service.FileAccessContext {
+	return service.FileAccessContext{
+		UserID:    userID,
+		ShareID:   shareID,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// parseAccessLogFilter parses userId, action, from, to query parameters
+func parseAccessLogFilter(c *gin.Context) (domain.AccessLogFilter, bool) {
+	var filter domain.AccessLogFilter
+
+	if userIDStr := c.Query("userId"); userIDStr != "" {
+		userID, err := parseUUID(userIDStr)
+		if err != nil {
+			handleBadRequest(c, "Invalid user ID")
+			return filter, false
+		}
+		filter.UserID = &userID
+	}
+
+	if actionStr := c.Query("action"); actionStr != "" {
+		action := domain.FileAccessAction(actionStr)
+		filter.Action = &action
+	}
+
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			handleBadRequest(c, "Invalid from time (RFC3339 expected)")
+			return filter, false
+		}
+		filter.From = &from
+	}
+
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			handleBadRequest(c, "Invalid to time (RFC3339 expected)")
+			return filter, false
+		}
+		filter.To = &to
+	}
+
+	return filter, true
+}
+
+// GetFileAccessLogs godoc
+// @Summary Get file access log
+// @Description Gets who generated download URLs, previews, and shares for a file
+// @Tags access-logs
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param userId query string false "Filter by user ID"
+// @Param action query string false "Filter by action (DOWNLOAD, PREVIEW, SHARE_CREATED, SHARE_LINK)"
+// @Param from query string false "From time (RFC3339)"
+// @Param to query string false "To time (RFC3339)"
+// @Param page query int false "Page number (default 1)"
+// @Param pageSize query int false "Page size (default 20, max 100)"
+// @Success 200 {object} domain.AccessLogListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/access-logs [get]
+func (h *AccessLogHandler) GetFileAccessLogs(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	// 접근 로그는 프로젝트 OWNER만 조회 가능
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, token, domain.ProjectPermissionOwner); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	filter, ok := parseAccessLogFilter(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	logs, err := h.accessLogService.GetFileAccessLogs(c.Request.Context(), fileID, filter, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, logs)
+}
+
+// GetWorkspaceAccessLogs godoc
+// @Summary Get workspace access log
+// @Description Gets file access history of a workspace for security review. Members who are not owners or admins only get their own entries.
+// @Tags access-logs
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param userId query string false "Filter by user ID"
+// @Param action query string false "Filter by action (DOWNLOAD, PREVIEW, SHARE_CREATED, SHARE_LINK)"
+// @Param from query string false "From time (RFC3339)"
+// @Param to query string false "To time (RFC3339)"
+// @Param page query int false "Page number (default 1)"
+// @Param pageSize query int false "Page size (default 20, max 100)"
+// @Success 200 {object} domain.AccessLogListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/access-logs [get]
+func (h *AccessLogHandler) GetWorkspaceAccessLogs(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	isAdmin := true
+	if h.accessService != nil {
+		isAdmin, err = h.accessService.IsWorkspaceAdmin(c.Request.Context(), workspaceID, userID, token)
+		if err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	filter, ok := parseAccessLogFilter(c)
+	if !ok {
+		return
+	}
+	// 워크스페이스 전체 기록은 소유자/관리자만, 일반 멤버는 본인 기록만 조회
+	if !isAdmin {
+		filter.UserID = &userID
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	logs, err := h.accessLogService.GetWorkspaceAccessLogs(c.Request.Context(), workspaceID, filter, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, logs)
+}
+
+// GetAccessLogSettings godoc
+// @Summary Get access log settings
+// @Description Gets the access log retention period of a workspace
+// @Tags access-logs
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.AccessLogSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/access-log-settings [get]
+func (h *AccessLogHandler) GetAccessLogSettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	settings, err := h.accessLogService.GetSettings(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings)
+}
+
+// UpdateAccessLogSettings godoc
+// @Summary Update access log settings
+// @Description Updates how long access logs of a workspace are retained
+// @Tags access-logs
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdateAccessLogSettingsRequest true "Access log settings"
+// @Success 200 {object} domain.AccessLogSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/access-log-settings [put]
+func (h *AccessLogHandler) UpdateAccessLogSettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	// 보관 기간 변경은 워크스페이스 소유자/관리자만 가능
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAdmin(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var req domain.UpdateAccessLogSettingsRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	settings, err := h.accessLogService.UpdateSettings(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings)
+}
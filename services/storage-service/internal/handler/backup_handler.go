@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// BackupHandler handles the internal workspace export/import requests from user-service
+type BackupHandler struct {
+	backupService *service.BackupService
+}
+
+// NewBackupHandler creates a new BackupHandler
+func NewBackupHandler(backupService *service.BackupService) *BackupHandler {
+	return &BackupHandler{
+		backupService: backupService,
+	}
+}
+
+// ExportWorkspace godoc
+// @Summary Export workspace file manifest
+// @Description Returns the folder/file metadata and tags of a workspace for the workspace backup archive (objects are not included)
+// @Tags internal
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.WorkspaceBackup
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /internal/storage/workspaces/{workspaceId}/backup [get]
+func (h *BackupHandler) ExportWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	backup, err := h.backupService.Export(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, backup)
+}
+
+// ImportWorkspace godoc
+// @Summary Import workspace file manifest
+// @Description Restores an exported folder/file manifest. Existing rows are skipped, so the import can be retried.
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.WorkspaceBackup true "Backup section"
+// @Success 200 {object} domain.BackupImportResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /internal/storage/workspaces/{workspaceId}/backup [post]
+func (h *BackupHandler) ImportWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var backup domain.WorkspaceBackup
+	if err := validation.BindJSON(c, &backup); err != nil {
+		return
+	}
+
+	result, err := h.backupService.Import(c.Request.Context(), workspaceID, &backup)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
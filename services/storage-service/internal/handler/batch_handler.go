@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// BatchHandler handles batch file operation HTTP requests
+type BatchHandler struct {
+	batchService  *service.BatchService
+	accessService service.AccessService
+}
+
+// NewBatchHandler creates a new BatchHandler
+func NewBatchHandler(batchService *service.BatchService, accessService service.AccessService) *BatchHandler {
+	return &BatchHandler{
+		batchService:  batchService,
+		accessService: accessService,
+	}
+}
+
+// BatchFiles godoc
+// @Summary Apply an operation to many files
+// @Description Deletes, restores, moves, or tags up to 100 files in one request. Returns a result per file; valid files are changed in one transaction.
+// @Tags files
+// @Accept json
+// @Produce json
+// @Param request body domain.BatchFileRequest true "Batch request"
+// @Success 200 {object} domain.BatchFileResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/batch [post]
+func (h *BatchHandler) BatchFiles(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	var req domain.BatchFileRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	var authorize service.BatchAuthorizer
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), req.WorkspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		// 파일별 편집 권한 (프로젝트 권한 + 파일 ACL)
+		authorize = func(ctx context.Context, file *domain.File) error {
+			return h.accessService.ValidateFileRecordAccess(ctx, file, userID, token, domain.ProjectPermissionEditor)
+		}
+	}
+
+	result, err := h.batchService.ExecuteBatch(c.Request.Context(), req, userID, authorize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
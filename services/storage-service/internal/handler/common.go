@@ -7,52 +7,31 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 	"storage-service/internal/response"
 )
 
-// ErrorResponse represents an error response (kept for backward compatibility)
-type ErrorResponse struct {
-	Error ErrorDetail `json:"error"`
-}
-
-// ErrorDetail contains error details
-type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
-
-// SuccessResponse represents a success response
-type SuccessResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
-}
+// 공통 응답 봉투 타입 (Swagger 문서용)
+type (
+	// ErrorResponse represents an error response
+	ErrorResponse = response.ErrorResponse
+	// SuccessResponse represents a success response
+	SuccessResponse = response.SuccessResponse
+)
 
 // respondWithError sends an error response
 func respondWithError(c *gin.Context, code int, errorCode, message string) {
-	c.JSON(code, ErrorResponse{
-		Error: ErrorDetail{
-			Code:    errorCode,
-			Message: message,
-		},
-	})
+	response.SendError(c, code, errorCode, message)
 }
 
 // respondWithSuccess sends a success response
 func respondWithSuccess(c *gin.Context, code int, message string, data interface{}) {
-	c.JSON(code, SuccessResponse{
-		Success: true,
-		Message: message,
-		Data:    data,
-	})
+	response.SendSuccessMessage(c, code, data, message)
 }
 
 // respondWithData sends a success response with data only
 func respondWithData(c *gin.Context, code int, data interface{}) {
-	c.JSON(code, SuccessResponse{
-		Success: true,
-		Data:    data,
-	})
+	response.SendSuccess(c, code, data)
 }
 
 // getUserID extracts user ID from context
@@ -109,29 +88,29 @@ func parseIntQuery(s string) (int, error) {
 
 // handleNotFound handles 404 response
 func handleNotFound(c *gin.Context, message string) {
-	respondWithError(c, http.StatusNotFound, "NOT_FOUND", message)
+	respondWithError(c, http.StatusNotFound, apperrors.ErrCodeNotFound, message)
 }
 
 // handleBadRequest handles 400 response
 func handleBadRequest(c *gin.Context, message string) {
-	respondWithError(c, http.StatusBadRequest, "BAD_REQUEST", message)
+	respondWithError(c, http.StatusBadRequest, apperrors.ErrCodeBadRequest, message)
 }
 
 // handleUnauthorized handles 401 response
 func handleUnauthorized(c *gin.Context, message string) {
-	respondWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", message)
+	respondWithError(c, http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, message)
 }
 
 // handleForbidden handles 403 response
 //
 //nolint:unused // Reserved for future use
 func handleForbidden(c *gin.Context, message string) {
-	respondWithError(c, http.StatusForbidden, "FORBIDDEN", message)
+	respondWithError(c, http.StatusForbidden, apperrors.ErrCodeForbidden, message)
 }
 
 // handleInternalError handles 500 response
 func handleInternalError(c *gin.Context, message string) {
-	respondWithError(c, http.StatusInternalServerError, "INTERNAL_ERROR", message)
+	respondWithError(c, http.StatusInternalServerError, apperrors.ErrCodeInternal, message)
 }
 
 // handleServiceError maps service errors to HTTP responses using errors.Is()
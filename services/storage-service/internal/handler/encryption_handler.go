@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// EncryptionHandler handles workspace KMS key HTTP requests
+type EncryptionHandler struct {
+	encryptionService *service.EncryptionService
+	accessService     service.AccessService
+}
+
+// NewEncryptionHandler creates a new EncryptionHandler
+func NewEncryptionHandler(encryptionService *service.EncryptionService, accessService service.AccessService) *EncryptionHandler {
+	return &EncryptionHandler{
+		encryptionService: encryptionService,
+		accessService:     accessService,
+	}
+}
+
+// GetEncryptionKeys godoc
+// @Summary Get workspace encryption keys
+// @Description Gets the KMS keys of a workspace with their status
+// @Tags encryption
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.WorkspaceEncryptionKey
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/encryption-keys [get]
+func (h *EncryptionHandler) GetEncryptionKeys(c *gin.Context) {
+	workspaceID, _, ok := h.authorizeWorkspace(c, false)
+	if !ok {
+		return
+	}
+
+	keys, err := h.encryptionService.GetKeys(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, keys)
+}
+
+// RotateEncryptionKey godoc
+// @Summary Set or rotate workspace encryption key
+// @Description Makes a KMS key the active key for new uploads. The previous key is retired but still decrypts existing files.
+// @Tags encryption
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.RotateEncryptionKeyRequest true "KMS key"
+// @Success 200 {object} domain.WorkspaceEncryptionKey
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/encryption-keys [post]
+func (h *EncryptionHandler) RotateEncryptionKey(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	var req domain.RotateEncryptionKeyRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	key, err := h.encryptionService.RotateKey(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, key)
+}
+
+// DisableEncryptionKey godoc
+// @Summary Disable workspace encryption key
+// @Description Disables a KMS key. Downloads of files encrypted with it are denied.
+// @Tags encryption
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param keyId path string true "Key ID"
+// @Success 200 {object} domain.WorkspaceEncryptionKey
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/encryption-keys/{keyId}/disable [post]
+func (h *EncryptionHandler) DisableEncryptionKey(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	keyID, err := parseUUID(c.Param("keyId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid key ID")
+		return
+	}
+
+	key, err := h.encryptionService.DisableKey(c.Request.Context(), workspaceID, keyID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, key)
+}
+
+// EnableEncryptionKey godoc
+// @Summary Enable workspace encryption key
+// @Description Re-enables a disabled KMS key
+// @Tags encryption
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param keyId path string true "Key ID"
+// @Success 200 {object} domain.WorkspaceEncryptionKey
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/encryption-keys/{keyId}/enable [post]
+func (h *EncryptionHandler) EnableEncryptionKey(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	keyID, err := parseUUID(c.Param("keyId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid key ID")
+		return
+	}
+
+	key, err := h.encryptionService.EnableKey(c.Request.Context(), workspaceID, keyID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, key)
+}
+
+// ReencryptFiles godoc
+// @Summary Re-encrypt workspace files
+// @Description Re-encrypts a batch of files with the active KMS key. Repeat until remaining is 0.
+// @Tags encryption
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.ReencryptFilesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/encryption-keys/reencrypt [post]
+func (h *EncryptionHandler) ReencryptFiles(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	result, err := h.encryptionService.ReencryptFiles(c.Request.Context(), workspaceID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// authorizeWorkspace resolves the workspace and validates membership.
+// Changing keys or re-encrypting files (requireAdmin) is limited to workspace owners and admins.
+func (h *EncryptionHandler) authorizeWorkspace(c *gin.Context, requireAdmin bool) (workspaceID, userID uuid.UUID, ok bool) {
+	userID, ok = getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		validate := h.accessService.ValidateWorkspaceAccess
+		if requireAdmin {
+			validate = h.accessService.ValidateWorkspaceAdmin
+		}
+		if err := validate(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	return workspaceID, userID, true
+}
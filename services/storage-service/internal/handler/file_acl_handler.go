@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// FileACLHandler handles per-file ACL HTTP requests
+type FileACLHandler struct {
+	aclService    *service.FileACLService
+	accessService service.AccessService
+}
+
+// NewFileACLHandler creates a new FileACLHandler
+func NewFileACLHandler(aclService *service.FileACLService, accessService service.AccessService) *FileACLHandler {
+	return &FileACLHandler{
+		aclService:    aclService,
+		accessService: accessService,
+	}
+}
+
+// GetFileACL godoc
+// @Summary Get file ACL
+// @Description Gets the users and teams a file is restricted to
+// @Tags files
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} domain.FileACLResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/acl [get]
+func (h *FileACLHandler) GetFileACL(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, token, domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	acl, err := h.aclService.GetFileACL(c.Request.Context(), fileID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, acl)
+}
+
+// SetFileACL godoc
+// @Summary Set file ACL
+// @Description Replaces the ACL of a file. An empty list removes the restriction. Only the uploader or a project owner can change it.
+// @Tags files
+// @Accept json
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param request body domain.SetFileACLRequest true "ACL entries"
+// @Success 200 {object} domain.FileACLResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/acl [put]
+func (h *FileACLHandler) SetFileACL(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFileACLManage(c.Request.Context(), fileID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var req domain.SetFileACLRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	acl, err := h.aclService.SetFileACL(c.Request.Context(), fileID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, acl)
+}
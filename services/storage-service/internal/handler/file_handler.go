@@ -1,26 +1,32 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"storage-service/internal/domain"
+	"storage-service/internal/response"
 	"storage-service/internal/service"
 )
 
 // FileHandler handles file HTTP requests
 type FileHandler struct {
-	fileService   *service.FileService
-	accessService service.AccessService
+	fileService      *service.FileService
+	accessService    service.AccessService
+	accessLogService *service.AccessLogService
 }
 
 // NewFileHandler creates a new FileHandler
-func NewFileHandler(fileService *service.FileService, accessService service.AccessService) *FileHandler {
+func NewFileHandler(fileService *service.FileService, accessService service.AccessService, accessLogService *service.AccessLogService) *FileHandler {
 	return &FileHandler{
-		fileService:   fileService,
-		accessService: accessService,
+		fileService:      fileService,
+		accessService:    accessService,
+		accessLogService: accessLogService,
 	}
 }
 
@@ -46,8 +52,7 @@ func (h *FileHandler) GenerateUploadURL(c *gin.Context) {
 	token := c.GetString("jwtToken")
 
 	var req domain.GenerateUploadURLRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -71,7 +76,7 @@ func (h *FileHandler) GenerateUploadURL(c *gin.Context) {
 
 // ConfirmUpload godoc
 // @Summary Confirm file upload
-// @Description Confirms that file upload is complete and activates the file
+// @Description Verifies the uploaded S3 object (size, content type) and activates the file
 // @Tags files
 // @Accept json
 // @Produce json
@@ -79,6 +84,7 @@ func (h *FileHandler) GenerateUploadURL(c *gin.Context) {
 // @Success 200 {object} domain.FileResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Uploaded object not found"
 // @Security BearerAuth
 // @Router /storage/files/confirm [post]
 func (h *FileHandler) ConfirmUpload(c *gin.Context) {
@@ -89,14 +95,13 @@ func (h *FileHandler) ConfirmUpload(c *gin.Context) {
 	}
 
 	var req domain.ConfirmUploadRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
 	file, err := h.fileService.ConfirmUpload(c.Request.Context(), req.FileID, userID)
 	if err != nil {
-		handleBadRequest(c, err.Error())
+		handleServiceError(c, err)
 		return
 	}
 
@@ -152,6 +157,7 @@ func (h *FileHandler) GetFile(c *gin.Context) {
 // @Tags files
 // @Produce json
 // @Param workspaceId path string true "Workspace ID"
+// @Param tags query string false "Comma-separated tags; only files carrying all tags are returned"
 // @Param page query int false "Page number (default 1)"
 // @Param pageSize query int false "Page size (default 20, max 100)"
 // @Success 200 {object} domain.FileListResponse
@@ -185,7 +191,9 @@ func (h *FileHandler) GetWorkspaceFiles(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
 
-	response, err := h.fileService.GetWorkspaceFiles(c.Request.Context(), workspaceID, page, pageSize)
+	tags := service.ParseTagFilter(c.Query("tags"))
+
+	response, err := h.fileService.GetWorkspaceFiles(c.Request.Context(), workspaceID, tags, page, pageSize)
 	if err != nil {
 		handleInternalError(c, err.Error())
 		return
@@ -232,8 +240,7 @@ func (h *FileHandler) UpdateFile(c *gin.Context) {
 	}
 
 	var req domain.UpdateFileRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -389,11 +396,12 @@ func (h *FileHandler) GetTrashFiles(c *gin.Context) {
 
 // SearchFiles godoc
 // @Summary Search files
-// @Description Searches files by name in workspace
+// @Description Searches files by name and tags in workspace
 // @Tags files
 // @Produce json
 // @Param workspaceId path string true "Workspace ID"
-// @Param q query string true "Search query"
+// @Param q query string false "Search query (required unless tags is given)"
+// @Param tags query string false "Comma-separated tags; only files carrying all tags are returned"
 // @Param page query int false "Page number (default 1)"
 // @Param pageSize query int false "Page size (default 20, max 100)"
 // @Success 200 {object} domain.FileListResponse
@@ -425,15 +433,16 @@ func (h *FileHandler) SearchFiles(c *gin.Context) {
 	}
 
 	query := c.Query("q")
-	if query == "" {
-		handleBadRequest(c, "Search query is required")
+	tags := service.ParseTagFilter(c.Query("tags"))
+	if query == "" && len(tags) == 0 {
+		handleBadRequest(c, "Search query or tags is required")
 		return
 	}
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
 
-	response, err := h.fileService.SearchFiles(c.Request.Context(), workspaceID, query, page, pageSize)
+	response, err := h.fileService.SearchFiles(c.Request.Context(), workspaceID, query, tags, page, pageSize)
 	if err != nil {
 		handleInternalError(c, err.Error())
 		return
@@ -497,12 +506,38 @@ func (h *FileHandler) GetStorageUsage(c *gin.Context) {
 // @Tags files
 // @Produce json
 // @Param fileId path string true "File ID"
+// @Param expiresIn query int false "URL lifetime in seconds (60-43200, default 3600)"
+// @Param disposition query string false "Content disposition (attachment|inline)" default(attachment)
+// @Param filename query string false "File name presented to the browser (default: original name)"
+// @Param ipRange query string false "Client IP or CIDR allowed to use the URL (CDN only)"
 // @Success 200 {object} map[string]string
+// @Success 202 {object} SuccessResponse "Archived file restore in progress"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Security BearerAuth
 // @Router /storage/files/{fileId}/download [get]
 func (h *FileHandler) GetDownloadURL(c *gin.Context) {
+	h.respondWithAccessURL(c, domain.FileAccessDownload)
+}
+
+// GetPreviewURL godoc
+// @Summary Get preview URL
+// @Description Generates a presigned URL for viewing a file inline in the browser
+// @Tags files
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} map[string]string
+// @Success 202 {object} SuccessResponse "Archived file restore in progress"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/preview [get]
+func (h *FileHandler) GetPreviewURL(c *gin.Context) {
+	h.respondWithAccessURL(c, domain.FileAccessPreview)
+}
+
+// respondWithAccessURL generates a download or preview URL and records the access
+func (h *FileHandler) respondWithAccessURL(c *gin.Context, action domain.FileAccessAction) {
 	userID, ok := getUserID(c)
 	if !ok {
 		handleUnauthorized(c, "User not authenticated")
@@ -526,13 +561,73 @@ func (h *FileHandler) GetDownloadURL(c *gin.Context) {
 		}
 	}
 
-	url, err := h.fileService.GenerateDownloadURL(c.Request.Context(), fileID)
+	var url string
+	var expiresAt time.Time
+	if action == domain.FileAccessPreview {
+		url, expiresAt, err = h.fileService.GeneratePreviewURL(c.Request.Context(), fileID)
+	} else {
+		opts, ok := parseDownloadURLOptions(c)
+		if !ok {
+			return
+		}
+		url, expiresAt, err = h.fileService.GenerateDownloadURL(c.Request.Context(), fileID, opts)
+	}
 	if err != nil {
-		handleNotFound(c, err.Error())
+		// 아카이브 파일 복원 중: 클라이언트는 잠시 후 재요청
+		if errors.Is(err, response.ErrFileRestoreInProgress) {
+			respondWithSuccess(c, http.StatusAccepted, "File is being restored from archive, retry later", gin.H{
+				"restoreStatus": "IN_PROGRESS",
+			})
+			return
+		}
+		handleServiceError(c, err)
+		return
+	}
+
+	// 감사 로그: URL 발급 시점에 접근자/IP 기록
+	if h.accessLogService != nil {
+		h.accessLogService.RecordFileAccess(c.Request.Context(), fileID, action, fileAccessContext(c, &userID, nil))
+	}
+
+	if action == domain.FileAccessPreview {
+		respondWithData(c, http.StatusOK, gin.H{
+			"previewUrl": url,
+			"expiresAt":  expiresAt,
+		})
 		return
 	}
 
 	respondWithData(c, http.StatusOK, gin.H{
 		"downloadUrl": url,
+		"expiresAt":   expiresAt,
 	})
 }
+
+// parseDownloadURLOptions parses download URL options from query parameters
+// 범위 검증(만료 시간 상한, IP 대역 등)은 FileService에서 수행합니다.
+func parseDownloadURLOptions(c *gin.Context) (domain.DownloadURLOptions, bool) {
+	opts := domain.DownloadURLOptions{
+		FileName: c.Query("filename"),
+		IPRange:  c.Query("ipRange"),
+	}
+
+	if v := c.Query("expiresIn"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds <= 0 {
+			handleBadRequest(c, "Invalid expiresIn")
+			return opts, false
+		}
+		opts.ExpiresIn = time.Duration(seconds) * time.Second
+	}
+
+	switch c.DefaultQuery("disposition", "attachment") {
+	case "attachment":
+	case "inline":
+		opts.Inline = true
+	default:
+		handleBadRequest(c, "Invalid disposition (must be attachment or inline)")
+		return opts, false
+	}
+
+	return opts, true
+}
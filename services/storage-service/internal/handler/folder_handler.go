@@ -2,10 +2,12 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"storage-service/internal/domain"
 	"storage-service/internal/service"
 )
@@ -48,8 +50,7 @@ func (h *FolderHandler) CreateFolder(c *gin.Context) {
 	token := c.GetString("jwtToken")
 
 	var req domain.CreateFolderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -186,6 +187,83 @@ func (h *FolderHandler) GetFolderContents(c *gin.Context) {
 	respondWithData(c, http.StatusOK, response)
 }
 
+// GetFolderTree godoc
+// @Summary Get folder tree
+// @Description Gets the folder hierarchy of a workspace with recursive file counts and sizes
+// @Tags folders
+// @Produce json
+// @Param workspaceId query string true "Workspace ID"
+// @Param folderId query string false "Subtree root folder ID (omit for workspace root)"
+// @Param depth query int false "Maximum depth (0 or omitted for full tree, max 50)"
+// @Success 200 {object} domain.FolderTreeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/folders/tree [get]
+func (h *FolderHandler) GetFolderTree(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceIDStr := c.Query("workspaceId")
+	if workspaceIDStr == "" {
+		handleBadRequest(c, "workspaceId is required")
+		return
+	}
+
+	workspaceID, err := parseUUID(workspaceIDStr)
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	// Validate workspace access
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var folderID *uuid.UUID
+	if folderIDStr := c.Query("folderId"); folderIDStr != "" {
+		id, err := parseUUID(folderIDStr)
+		if err != nil {
+			handleBadRequest(c, "Invalid folder ID")
+			return
+		}
+		folderID = &id
+
+		if h.accessService != nil {
+			if err := h.accessService.ValidateFolderAccess(c.Request.Context(), id, userID, token, domain.ProjectPermissionViewer); err != nil {
+				handleServiceError(c, err)
+				return
+			}
+		}
+	}
+
+	depth := 0
+	if depthStr := c.Query("depth"); depthStr != "" {
+		depth, err = strconv.Atoi(depthStr)
+		if err != nil {
+			handleBadRequest(c, "Invalid depth")
+			return
+		}
+	}
+
+	tree, err := h.folderService.GetFolderTree(c.Request.Context(), workspaceID, folderID, depth)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tree)
+}
+
 // GetWorkspaceFolders godoc
 // @Summary Get all folders in workspace
 // @Description Gets all folders in a workspace as a tree structure
@@ -272,8 +350,7 @@ func (h *FolderHandler) UpdateFolder(c *gin.Context) {
 	}
 
 	var req domain.UpdateFolderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// LifecycleHandler handles workspace lifecycle policy HTTP requests
+type LifecycleHandler struct {
+	lifecycleService *service.LifecycleService
+	accessService    service.AccessService
+}
+
+// NewLifecycleHandler creates a new LifecycleHandler
+func NewLifecycleHandler(lifecycleService *service.LifecycleService, accessService service.AccessService) *LifecycleHandler {
+	return &LifecycleHandler{
+		lifecycleService: lifecycleService,
+		accessService:    accessService,
+	}
+}
+
+// GetLifecyclePolicy godoc
+// @Summary Get workspace lifecycle policy
+// @Description Gets the storage class tiering policy of a workspace
+// @Tags lifecycle
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.LifecyclePolicyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/lifecycle-policy [get]
+func (h *LifecycleHandler) GetLifecyclePolicy(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	settings, err := h.lifecycleService.GetLifecyclePolicy(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToLifecyclePolicyResponse())
+}
+
+// UpdateLifecyclePolicy godoc
+// @Summary Update workspace lifecycle policy
+// @Description Updates when cold files transition to infrequent-access and archive storage classes
+// @Tags lifecycle
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdateLifecyclePolicyRequest true "Lifecycle policy"
+// @Success 200 {object} domain.LifecyclePolicyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/lifecycle-policy [put]
+func (h *LifecycleHandler) UpdateLifecyclePolicy(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	// 보관 정책 변경은 워크스페이스 소유자/관리자만 가능
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAdmin(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var req domain.UpdateLifecyclePolicyRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	settings, err := h.lifecycleService.UpdateLifecyclePolicy(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToLifecyclePolicyResponse())
+}
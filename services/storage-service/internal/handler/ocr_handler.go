@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// OCRHandler handles OCR settings and extracted text HTTP requests
+type OCRHandler struct {
+	ocrService    *service.OCRService
+	accessService service.AccessService
+}
+
+// NewOCRHandler creates a new OCRHandler
+func NewOCRHandler(ocrService *service.OCRService, accessService service.AccessService) *OCRHandler {
+	return &OCRHandler{
+		ocrService:    ocrService,
+		accessService: accessService,
+	}
+}
+
+// GetOCRSettings godoc
+// @Summary Get workspace OCR settings
+// @Description Gets whether uploaded images and scanned PDFs are OCR'd and which languages are used
+// @Tags ocr
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.OCRSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/ocr-settings [get]
+func (h *OCRHandler) GetOCRSettings(c *gin.Context) {
+	workspaceID, _, ok := h.authorizeWorkspace(c, false)
+	if !ok {
+		return
+	}
+
+	settings, err := h.ocrService.GetOCRSettings(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToOCRSettingsResponse())
+}
+
+// UpdateOCRSettings godoc
+// @Summary Update workspace OCR settings
+// @Description Enables or disables OCR for new uploads and selects the OCR languages (e.g. "kor+eng"). Workspace owners and admins only
+// @Tags ocr
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdateOCRSettingsRequest true "OCR settings"
+// @Success 200 {object} domain.OCRSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/ocr-settings [put]
+func (h *OCRHandler) UpdateOCRSettings(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateOCRSettingsRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	settings, err := h.ocrService.UpdateOCRSettings(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToOCRSettingsResponse())
+}
+
+// GetFileText godoc
+// @Summary Get extracted file text
+// @Description Gets the OCR status and text extracted from an image or scanned PDF
+// @Tags ocr
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} domain.FileText
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/text [get]
+func (h *OCRHandler) GetFileText(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, token, domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	text, err := h.ocrService.GetFileText(c.Request.Context(), fileID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, text)
+}
+
+// authorizeWorkspace authenticates the user and validates workspace access.
+// Changing OCR settings (requireAdmin) is limited to workspace owners and admins.
+func (h *OCRHandler) authorizeWorkspace(c *gin.Context, requireAdmin bool) (workspaceID, userID uuid.UUID, ok bool) {
+	userID, ok = getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		validate := h.accessService.ValidateWorkspaceAccess
+		if requireAdmin {
+			validate = h.accessService.ValidateWorkspaceAdmin
+		}
+		if err := validate(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	return workspaceID, userID, true
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// PrivacyHandler handles workspace privacy settings HTTP requests
+type PrivacyHandler struct {
+	privacyService *service.PrivacyService
+	accessService  service.AccessService
+}
+
+// NewPrivacyHandler creates a new PrivacyHandler
+func NewPrivacyHandler(privacyService *service.PrivacyService, accessService service.AccessService) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+		accessService:  accessService,
+	}
+}
+
+// GetPrivacySettings godoc
+// @Summary Get workspace privacy settings
+// @Description Gets whether image metadata (EXIF, GPS) is stripped on upload
+// @Tags privacy
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.PrivacySettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/privacy-settings [get]
+func (h *PrivacyHandler) GetPrivacySettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	settings, err := h.privacyService.GetPrivacySettings(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToPrivacySettingsResponse())
+}
+
+// UpdatePrivacySettings godoc
+// @Summary Update workspace privacy settings
+// @Description Enables or disables stripping image metadata (EXIF, GPS) after upload confirmation
+// @Tags privacy
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdatePrivacySettingsRequest true "Privacy settings"
+// @Success 200 {object} domain.PrivacySettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/privacy-settings [put]
+func (h *PrivacyHandler) UpdatePrivacySettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	// 개인정보 설정 변경은 워크스페이스 소유자/관리자만 가능
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAdmin(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var req domain.UpdatePrivacySettingsRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	settings, err := h.privacyService.UpdatePrivacySettings(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings.ToPrivacySettingsResponse())
+}
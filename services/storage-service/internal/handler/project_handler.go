@@ -40,12 +40,7 @@ func NewProjectHandler(projectService service.ProjectService) *ProjectHandler {
 func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -53,12 +48,7 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 
 	var req domain.CreateProjectRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
-			},
-		})
+		handleBadRequest(c, err.Error())
 		return
 	}
 
@@ -68,7 +58,7 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, project)
+	respondWithData(c, http.StatusCreated, project)
 }
 
 // GetProject godoc
@@ -86,12 +76,7 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 func (h *ProjectHandler) GetProject(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -99,12 +84,7 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
@@ -114,7 +94,7 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, project)
+	respondWithData(c, http.StatusOK, project)
 }
 
 // GetWorkspaceProjects godoc
@@ -133,12 +113,7 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 func (h *ProjectHandler) GetWorkspaceProjects(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -146,12 +121,7 @@ func (h *ProjectHandler) GetWorkspaceProjects(c *gin.Context) {
 
 	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_WORKSPACE_ID",
-				Message: "Invalid workspace ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid workspace ID format")
 		return
 	}
 
@@ -171,7 +141,7 @@ func (h *ProjectHandler) GetWorkspaceProjects(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, projects)
+	respondWithData(c, http.StatusOK, projects)
 }
 
 // UpdateProject godoc
@@ -192,12 +162,7 @@ func (h *ProjectHandler) GetWorkspaceProjects(c *gin.Context) {
 func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -205,23 +170,13 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
 	var req domain.UpdateProjectRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
-			},
-		})
+		handleBadRequest(c, err.Error())
 		return
 	}
 
@@ -231,7 +186,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, project)
+	respondWithData(c, http.StatusOK, project)
 }
 
 // DeleteProject godoc
@@ -248,12 +203,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -261,12 +211,7 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
@@ -296,12 +241,7 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 func (h *ProjectHandler) AddMember(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -309,23 +249,13 @@ func (h *ProjectHandler) AddMember(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
 	var req domain.AddProjectMemberRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
-			},
-		})
+		handleBadRequest(c, err.Error())
 		return
 	}
 
@@ -335,7 +265,7 @@ func (h *ProjectHandler) AddMember(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, member)
+	respondWithData(c, http.StatusCreated, member)
 }
 
 // GetMembers godoc
@@ -353,12 +283,7 @@ func (h *ProjectHandler) AddMember(c *gin.Context) {
 func (h *ProjectHandler) GetMembers(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -366,12 +291,7 @@ func (h *ProjectHandler) GetMembers(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
@@ -381,7 +301,7 @@ func (h *ProjectHandler) GetMembers(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"members": members})
+	respondWithData(c, http.StatusOK, gin.H{"members": members})
 }
 
 // UpdateMember godoc
@@ -403,12 +323,7 @@ func (h *ProjectHandler) GetMembers(c *gin.Context) {
 func (h *ProjectHandler) UpdateMember(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -416,34 +331,19 @@ func (h *ProjectHandler) UpdateMember(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
 	memberUserID, err := uuid.Parse(c.Param("userId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_USER_ID",
-				Message: "Invalid user ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid user ID format")
 		return
 	}
 
 	var req domain.UpdateProjectMemberRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: err.Error(),
-			},
-		})
+		handleBadRequest(c, err.Error())
 		return
 	}
 
@@ -453,7 +353,7 @@ func (h *ProjectHandler) UpdateMember(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, member)
+	respondWithData(c, http.StatusOK, member)
 }
 
 // RemoveMember godoc
@@ -472,12 +372,7 @@ func (h *ProjectHandler) UpdateMember(c *gin.Context) {
 func (h *ProjectHandler) RemoveMember(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "UNAUTHORIZED",
-				Message: "User not authenticated",
-			},
-		})
+		handleUnauthorized(c, "User not authenticated")
 		return
 	}
 
@@ -485,23 +380,13 @@ func (h *ProjectHandler) RemoveMember(c *gin.Context) {
 
 	projectID, err := uuid.Parse(c.Param("projectId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_PROJECT_ID",
-				Message: "Invalid project ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid project ID format")
 		return
 	}
 
 	memberUserID, err := uuid.Parse(c.Param("userId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: ErrorDetail{
-				Code:    "INVALID_USER_ID",
-				Message: "Invalid user ID format",
-			},
-		})
+		handleBadRequest(c, "Invalid user ID format")
 		return
 	}
 
@@ -4,20 +4,26 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
 	"storage-service/internal/domain"
 	"storage-service/internal/service"
 )
 
 // ShareHandler handles share HTTP requests
 type ShareHandler struct {
-	shareService *service.ShareService
+	shareService     *service.ShareService
+	accessService    service.AccessService
+	accessLogService *service.AccessLogService
 }
 
 // NewShareHandler creates a new ShareHandler
-func NewShareHandler(shareService *service.ShareService) *ShareHandler {
+func NewShareHandler(shareService *service.ShareService, accessService service.AccessService, accessLogService *service.AccessLogService) *ShareHandler {
 	return &ShareHandler{
-		shareService: shareService,
+		shareService:     shareService,
+		accessService:    accessService,
+		accessLogService: accessLogService,
 	}
 }
 
@@ -41,17 +47,37 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 	}
 
 	var req domain.CreateShareRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
+	// 공유는 편집 권한 필요 (파일 ACL 포함)
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		var err error
+		switch req.EntityType {
+		case domain.ShareTypeFile:
+			err = h.accessService.ValidateFileAccess(c.Request.Context(), req.EntityID, userID, token, domain.ProjectPermissionEditor)
+		case domain.ShareTypeFolder:
+			err = h.accessService.ValidateFolderAccess(c.Request.Context(), req.EntityID, userID, token, domain.ProjectPermissionEditor)
+		}
+		if err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
 	response, err := h.shareService.CreateShare(c.Request.Context(), req, userID)
 	if err != nil {
 		handleBadRequest(c, err.Error())
 		return
 	}
 
+	// 감사 로그: 파일 공유 생성 기록
+	if h.accessLogService != nil && response.EntityType == domain.ShareTypeFile {
+		h.accessLogService.RecordFileAccess(c.Request.Context(), response.EntityID, domain.FileAccessShareCreated, fileAccessContext(c, &userID, &response.ID))
+	}
+
 	respondWithData(c, http.StatusCreated, response)
 }
 
@@ -158,6 +184,15 @@ func (h *ShareHandler) GetShareByLink(c *gin.Context) {
 		return
 	}
 
+	// 감사 로그: 공유 링크를 통한 파일 접근 기록 (공개 링크는 사용자 없음)
+	if h.accessLogService != nil && share.EntityType == domain.ShareTypeFile {
+		var accessorID *uuid.UUID
+		if userID, ok := getUserID(c); ok {
+			accessorID = &userID
+		}
+		h.accessLogService.RecordFileAccess(c.Request.Context(), share.EntityID, domain.FileAccessShareLink, fileAccessContext(c, accessorID, &share.ID))
+	}
+
 	respondWithData(c, http.StatusOK, share)
 }
 
@@ -203,8 +238,7 @@ func (h *ShareHandler) UpdateShare(c *gin.Context) {
 	}
 
 	var req domain.UpdateShareRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleBadRequest(c, "Invalid request body: "+err.Error())
+	if err := validation.BindJSON(c, &req); err != nil {
 		return
 	}
 
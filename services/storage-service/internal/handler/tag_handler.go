@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// TagHandler handles file tag HTTP requests
+type TagHandler struct {
+	tagService    *service.TagService
+	accessService service.AccessService
+}
+
+// NewTagHandler creates a new TagHandler
+func NewTagHandler(tagService *service.TagService, accessService service.AccessService) *TagHandler {
+	return &TagHandler{
+		tagService:    tagService,
+		accessService: accessService,
+	}
+}
+
+// GetWorkspaceTags godoc
+// @Summary Get workspace tags
+// @Description Gets the tags of a workspace with the number of files carrying each tag
+// @Tags tags
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/tags [get]
+func (h *TagHandler) GetWorkspaceTags(c *gin.Context) {
+	workspaceID, _, ok := h.authorizeWorkspace(c, false)
+	if !ok {
+		return
+	}
+
+	tags, err := h.tagService.GetWorkspaceTags(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// CreateTag godoc
+// @Summary Create workspace tag
+// @Description Creates a workspace-managed tag. An existing free-form tag with the same name becomes managed. Workspace owners and admins only
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.CreateTagRequest true "Tag"
+// @Success 201 {object} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/tags [post]
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateTagRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	tag, err := h.tagService.CreateTag(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusCreated, tag)
+}
+
+// UpdateTag godoc
+// @Summary Update workspace tag
+// @Description Renames or recolors a tag, or changes whether it is workspace-managed. Workspace owners and admins only
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param tagId path string true "Tag ID"
+// @Param request body domain.UpdateTagRequest true "Tag changes"
+// @Success 200 {object} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/tags/{tagId} [put]
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	tagID, err := parseUUID(c.Param("tagId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	var req domain.UpdateTagRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	tag, err := h.tagService.UpdateTag(c.Request.Context(), workspaceID, tagID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tag)
+}
+
+// DeleteTag godoc
+// @Summary Delete workspace tag
+// @Description Deletes a tag and removes it from all files. Workspace owners and admins only
+// @Tags tags
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param tagId path string true "Tag ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/tags/{tagId} [delete]
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	workspaceID, userID, ok := h.authorizeWorkspace(c, true)
+	if !ok {
+		return
+	}
+
+	tagID, err := parseUUID(c.Param("tagId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	if err := h.tagService.DeleteTag(c.Request.Context(), workspaceID, tagID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Tag deleted", nil)
+}
+
+// TagFile godoc
+// @Summary Tag file
+// @Description Attaches tags to a file. Unknown names become free-form workspace tags.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param request body domain.TagFileRequest true "Tags"
+// @Success 200 {object} domain.FileTagsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/tags [post]
+func (h *TagHandler) TagFile(c *gin.Context) {
+	fileID, userID, ok := h.authorizeFileEdit(c)
+	if !ok {
+		return
+	}
+
+	var req domain.TagFileRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	tags, err := h.tagService.TagFile(c.Request.Context(), fileID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// UntagFile godoc
+// @Summary Untag file
+// @Description Removes a tag from a file
+// @Tags tags
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param tag path string true "Tag name"
+// @Success 200 {object} domain.FileTagsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/tags/{tag} [delete]
+func (h *TagHandler) UntagFile(c *gin.Context) {
+	fileID, userID, ok := h.authorizeFileEdit(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.tagService.UntagFile(c.Request.Context(), fileID, c.Param("tag"), userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// authorizeWorkspace resolves the workspace and validates membership.
+// Managing workspace tags (requireAdmin) is limited to workspace owners and admins.
+func (h *TagHandler) authorizeWorkspace(c *gin.Context, requireAdmin bool) (workspaceID, userID uuid.UUID, ok bool) {
+	userID, ok = getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		validate := h.accessService.ValidateWorkspaceAccess
+		if requireAdmin {
+			validate = h.accessService.ValidateWorkspaceAdmin
+		}
+		if err := validate(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	return workspaceID, userID, true
+}
+
+// authorizeFileEdit resolves the file and validates edit access
+func (h *TagHandler) authorizeFileEdit(c *gin.Context) (fileID, userID uuid.UUID, ok bool) {
+	userID, ok = getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			handleServiceError(c, err)
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	return fileID, userID, true
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// maxEventBodySize limits the size of a bucket event notification body
+const maxEventBodySize = 1 << 20
+
+// UploadEventHandler handles S3 bucket event notification HTTP requests
+type UploadEventHandler struct {
+	uploadEventService *service.UploadEventService
+}
+
+// NewUploadEventHandler creates a new UploadEventHandler
+func NewUploadEventHandler(uploadEventService *service.UploadEventService) *UploadEventHandler {
+	return &UploadEventHandler{
+		uploadEventService: uploadEventService,
+	}
+}
+
+// HandleS3Event godoc
+// @Summary Receive S3 bucket events
+// @Description Receives ObjectCreated notifications (MinIO webhook or SNS HTTP subscription) and activates verified uploads
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param token query string false "Shared event token (SNS subscriptions)"
+// @Success 200 {object} domain.UploadEventResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /internal/storage/s3-events [post]
+func (h *UploadEventHandler) HandleS3Event(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxEventBodySize))
+	if err != nil {
+		handleBadRequest(c, "Failed to read request body")
+		return
+	}
+
+	// SNS는 x-amz-sns-message-type 헤더와 함께 봉투(envelope) 형식으로 전달
+	if c.GetHeader("x-amz-sns-message-type") != "" {
+		var msg domain.SNSMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			handleBadRequest(c, "Invalid SNS message: "+err.Error())
+			return
+		}
+
+		result, err := h.uploadEventService.HandleSNSMessage(c.Request.Context(), &msg)
+		if err != nil {
+			handleServiceError(c, err)
+			return
+		}
+
+		respondWithData(c, http.StatusOK, result)
+		return
+	}
+
+	var notification domain.S3EventNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		handleBadRequest(c, "Invalid event notification: "+err.Error())
+		return
+	}
+
+	result := h.uploadEventService.HandleNotification(c.Request.Context(), &notification)
+	respondWithData(c, http.StatusOK, result)
+}
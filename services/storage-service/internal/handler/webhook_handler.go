@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/validation"
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// WebhookHandler handles project webhook HTTP requests
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+	accessService  service.AccessService
+}
+
+// NewWebhookHandler creates a new WebhookHandler
+func NewWebhookHandler(webhookService *service.WebhookService, accessService service.AccessService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		accessService:  accessService,
+	}
+}
+
+// authorizeOwner extracts the user and project and validates project owner permission
+// 웹훅 관리는 프로젝트 OWNER만 가능합니다.
+func (h *WebhookHandler) authorizeOwner(c *gin.Context) (userID, projectID uuid.UUID, ok bool) {
+	userID, ok = getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	projectID, err := parseUUID(c.Param("projectId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid project ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.accessService != nil {
+		token := c.GetString("jwtToken")
+		if err := h.accessService.ValidateProjectAccess(c.Request.Context(), projectID, userID, token, domain.ProjectPermissionOwner); err != nil {
+			handleServiceError(c, err)
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	return userID, projectID, true
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook
+// @Description Registers a webhook receiving signed file events of a project
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Param request body domain.CreateWebhookRequest true "Webhook request"
+// @Success 201 {object} domain.WebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/projects/{projectId}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	userID, projectID, ok := h.authorizeOwner(c)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateWebhookRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), projectID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusCreated, webhook)
+}
+
+// GetWebhooks godoc
+// @Summary List webhooks
+// @Description Lists webhooks registered on a project
+// @Tags webhooks
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Success 200 {array} domain.WebhookResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/projects/{projectId}/webhooks [get]
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	_, projectID, ok := h.authorizeOwner(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.GetProjectWebhooks(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, webhooks)
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Updates URL, subscribed events, or active state of a webhook
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Param webhookId path string true "Webhook ID"
+// @Param request body domain.UpdateWebhookRequest true "Update request"
+// @Success 200 {object} domain.WebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/projects/{projectId}/webhooks/{webhookId} [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	userID, projectID, ok := h.authorizeOwner(c)
+	if !ok {
+		return
+	}
+
+	webhookID, err := parseUUID(c.Param("webhookId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	var req domain.UpdateWebhookRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(c.Request.Context(), projectID, webhookID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Deletes a webhook and its delivery log
+// @Tags webhooks
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Param webhookId path string true "Webhook ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/projects/{projectId}/webhooks/{webhookId} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	userID, projectID, ok := h.authorizeOwner(c)
+	if !ok {
+		return
+	}
+
+	webhookID, err := parseUUID(c.Param("webhookId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), projectID, webhookID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Webhook deleted", nil)
+}
+
+// GetDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Lists delivery attempts of a webhook, newest first
+// @Tags webhooks
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Param webhookId path string true "Webhook ID"
+// @Param page query int false "Page number (default 1)"
+// @Param pageSize query int false "Page size (default 20, max 100)"
+// @Success 200 {object} domain.WebhookDeliveryListResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/projects/{projectId}/webhooks/{webhookId}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	_, projectID, ok := h.authorizeOwner(c)
+	if !ok {
+		return
+	}
+
+	webhookID, err := parseUUID(c.Param("webhookId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Request.Context(), projectID, webhookID, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, deliveries)
+}
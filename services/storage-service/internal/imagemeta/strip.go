@@ -0,0 +1,213 @@
+// Package imagemeta strips privacy-sensitive metadata (EXIF, GPS, XMP, text) from images.
+// 이미지 디코딩/재인코딩 없이 메타데이터 세그먼트만 제거하므로 화질 손실이 없습니다.
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// ErrMalformed is returned when the image structure cannot be parsed
+var ErrMalformed = errors.New("malformed image")
+
+var (
+	jpegSOI      = []byte{0xFF, 0xD8}
+	pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+)
+
+// Supports returns true if metadata stripping is supported for the content type
+func Supports(contentType string) bool {
+	switch normalize(contentType) {
+	case "image/jpeg", "image/jpg", "image/png", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+// Strip removes metadata from a JPEG, PNG, or WebP image.
+// changed is false when the content type is unsupported or no metadata was found.
+func Strip(contentType string, data []byte) (out []byte, changed bool, err error) {
+	switch normalize(contentType) {
+	case "image/jpeg", "image/jpg":
+		return stripJPEG(data)
+	case "image/png":
+		return stripPNG(data)
+	case "image/webp":
+		return stripWebP(data)
+	default:
+		return data, false, nil
+	}
+}
+
+// normalize lowercases a content type and drops parameters
+func normalize(contentType string) string {
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
+
+// stripJPEG removes APP1 (EXIF/XMP), APP13 (IPTC) and COM segments
+func stripJPEG(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, jpegSOI) {
+		return nil, false, ErrMalformed
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, jpegSOI...)
+	changed := false
+
+	i := len(jpegSOI)
+	for i < len(data) {
+		if data[i] != 0xFF {
+			return nil, false, ErrMalformed
+		}
+		// 마커 앞의 fill byte(0xFF) 건너뛰기
+		for i < len(data) && data[i] == 0xFF {
+			i++
+		}
+		if i >= len(data) {
+			return nil, false, ErrMalformed
+		}
+		marker := data[i]
+		i++
+
+		// Standalone markers without a length field
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			out = append(out, 0xFF, marker)
+			continue
+		}
+		if marker == 0xD9 { // EOI
+			out = append(out, 0xFF, marker)
+			return out, changed, nil
+		}
+
+		if i+2 > len(data) {
+			return nil, false, ErrMalformed
+		}
+		length := int(binary.BigEndian.Uint16(data[i : i+2]))
+		if length < 2 || i+length > len(data) {
+			return nil, false, ErrMalformed
+		}
+		segment := data[i : i+length]
+		i += length
+
+		// SOS 이후는 엔트로피 코딩 데이터이므로 그대로 복사
+		if marker == 0xDA {
+			out = append(out, 0xFF, marker)
+			out = append(out, segment...)
+			out = append(out, data[i:]...)
+			return out, changed, nil
+		}
+
+		switch marker {
+		case 0xE1, 0xED, 0xFE: // APP1, APP13, COM
+			changed = true
+			continue
+		}
+
+		out = append(out, 0xFF, marker)
+		out = append(out, segment...)
+	}
+
+	return out, changed, nil
+}
+
+// pngMetadataChunks are ancillary chunks that may carry personal data
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripPNG removes eXIf, text and timestamp chunks
+func stripPNG(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, false, ErrMalformed
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, pngSignature...)
+	changed := false
+
+	i := len(pngSignature)
+	for i < len(data) {
+		if i+8 > len(data) {
+			return nil, false, ErrMalformed
+		}
+		length := int(binary.BigEndian.Uint32(data[i : i+4]))
+		chunkType := string(data[i+4 : i+8])
+		end := i + 12 + length // length + type + data + crc
+		if length < 0 || end > len(data) {
+			return nil, false, ErrMalformed
+		}
+
+		if pngMetadataChunks[chunkType] {
+			changed = true
+		} else {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+
+		if chunkType == "IEND" {
+			break
+		}
+	}
+
+	return out, changed, nil
+}
+
+// WebP VP8X feature flags
+const (
+	webpFlagXMP  = 0x04
+	webpFlagEXIF = 0x08
+)
+
+// stripWebP removes EXIF and XMP chunks and clears the matching VP8X flags
+func stripWebP(data []byte) ([]byte, bool, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, false, ErrMalformed
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	changed := false
+	vp8xOffset := -1
+
+	i := 12
+	for i+8 <= len(data) {
+		fourCC := string(data[i : i+4])
+		size := int(binary.LittleEndian.Uint32(data[i+4 : i+8]))
+		end := i + 8 + size + size%2 // chunks are padded to even size
+		if size < 0 || end > len(data) {
+			return nil, false, ErrMalformed
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+			changed = true
+		case "VP8X":
+			vp8xOffset = len(out) + 8
+			out = append(out, data[i:end]...)
+		default:
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	if !changed {
+		return data, false, nil
+	}
+
+	if vp8xOffset >= 0 && vp8xOffset < len(out) {
+		out[vp8xOffset] &^= webpFlagEXIF | webpFlagXMP
+	}
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+
+	return out, true, nil
+}
@@ -0,0 +1,143 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jpegSegment builds a JPEG marker segment
+func jpegSegment(marker byte, payload []byte) []byte {
+	seg := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:4], uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+// pngChunk builds a PNG chunk with a valid CRC
+func pngChunk(chunkType string, payload []byte) []byte {
+	chunk := make([]byte, 8, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk[0:4], uint32(len(payload)))
+	copy(chunk[4:8], chunkType)
+	chunk = append(chunk, payload...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+// webpChunk builds a RIFF chunk padded to even size
+func webpChunk(fourCC string, payload []byte) []byte {
+	chunk := make([]byte, 8, 9+len(payload))
+	copy(chunk[0:4], fourCC)
+	binary.LittleEndian.PutUint32(chunk[4:8], uint32(len(payload)))
+	chunk = append(chunk, payload...)
+	if len(payload)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func TestSupports(t *testing.T) {
+	assert.True(t, Supports("image/jpeg"))
+	assert.True(t, Supports("IMAGE/PNG; charset=binary"))
+	assert.True(t, Supports("image/webp"))
+	assert.False(t, Supports("image/gif"))
+	assert.False(t, Supports("application/pdf"))
+}
+
+func TestStrip_JPEG(t *testing.T) {
+	app0 := jpegSegment(0xE0, []byte("JFIF\x00"))
+	exif := jpegSegment(0xE1, []byte("Exif\x00\x00GPS"))
+	comment := jpegSegment(0xFE, []byte("secret"))
+	sos := jpegSegment(0xDA, []byte{0x01, 0x02})
+	scan := []byte{0x12, 0xFF, 0x00, 0x34, 0xFF, 0xD9}
+
+	var src []byte
+	src = append(src, 0xFF, 0xD8)
+	src = append(src, app0...)
+	src = append(src, exif...)
+	src = append(src, comment...)
+	src = append(src, sos...)
+	src = append(src, scan...)
+
+	out, changed, err := Strip("image/jpeg", src)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, bytes.Contains(out, []byte("Exif")))
+	assert.False(t, bytes.Contains(out, []byte("secret")))
+	assert.True(t, bytes.Contains(out, app0))
+	assert.True(t, bytes.HasSuffix(out, append(sos, scan...)))
+}
+
+func TestStrip_JPEG_NoMetadata(t *testing.T) {
+	src := append([]byte{0xFF, 0xD8}, jpegSegment(0xE0, []byte("JFIF\x00"))...)
+	src = append(src, 0xFF, 0xD9)
+
+	out, changed, err := Strip("image/jpeg", src)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, src, out)
+}
+
+func TestStrip_PNG(t *testing.T) {
+	ihdr := pngChunk("IHDR", make([]byte, 13))
+	text := pngChunk("tEXt", []byte("Author\x00someone"))
+	exif := pngChunk("eXIf", []byte("MM\x00*"))
+	idat := pngChunk("IDAT", []byte{1, 2, 3})
+	iend := pngChunk("IEND", nil)
+
+	var src []byte
+	src = append(src, pngSignature...)
+	for _, c := range [][]byte{ihdr, text, exif, idat, iend} {
+		src = append(src, c...)
+	}
+
+	out, changed, err := Strip("image/png", src)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	var expected []byte
+	expected = append(expected, pngSignature...)
+	for _, c := range [][]byte{ihdr, idat, iend} {
+		expected = append(expected, c...)
+	}
+	assert.Equal(t, expected, out)
+}
+
+func TestStrip_WebP(t *testing.T) {
+	vp8x := webpChunk("VP8X", []byte{webpFlagEXIF | webpFlagXMP | 0x10, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	vp8l := webpChunk("VP8L", []byte{1, 2, 3})
+	exif := webpChunk("EXIF", []byte("MM\x00*GPS"))
+	xmp := webpChunk("XMP ", []byte("<x:xmpmeta/>"))
+
+	body := append([]byte("WEBP"), vp8x...)
+	body = append(body, vp8l...)
+	body = append(body, exif...)
+	body = append(body, xmp...)
+	src := append([]byte("RIFF\x00\x00\x00\x00"), body...)
+	binary.LittleEndian.PutUint32(src[4:8], uint32(len(body)))
+
+	out, changed, err := Strip("image/webp", src)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, bytes.Contains(out, []byte("GPS")))
+	assert.Equal(t, uint32(len(out)-8), binary.LittleEndian.Uint32(out[4:8]))
+	// VP8X EXIF/XMP 플래그는 해제, 나머지 플래그는 유지
+	assert.Equal(t, byte(0x10), out[20])
+}
+
+func TestStrip_Malformed(t *testing.T) {
+	_, _, err := Strip("image/jpeg", []byte("not a jpeg"))
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	_, _, err = Strip("image/png", []byte{0x89, 'P', 'N', 'G'})
+	assert.ErrorIs(t, err, ErrMalformed)
+}
+
+func TestStrip_Unsupported(t *testing.T) {
+	src := []byte("GIF89a")
+	out, changed, err := Strip("image/gif", src)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, src, out)
+}
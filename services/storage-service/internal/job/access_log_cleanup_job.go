@@ -0,0 +1,57 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/service"
+)
+
+// AccessLogCleanupJob periodically purges access logs past their retention period
+type AccessLogCleanupJob struct {
+	accessLogService *service.AccessLogService
+	interval         time.Duration
+	logger           *zap.Logger
+}
+
+// NewAccessLogCleanupJob creates a new AccessLogCleanupJob instance
+func NewAccessLogCleanupJob(accessLogService *service.AccessLogService, interval time.Duration, logger *zap.Logger) *AccessLogCleanupJob {
+	return &AccessLogCleanupJob{
+		accessLogService: accessLogService,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+// Run executes the cleanup job once
+func (j *AccessLogCleanupJob) Run(ctx context.Context) {
+	start := time.Now()
+	deleted, err := j.accessLogService.PurgeExpired(ctx)
+	if err != nil {
+		j.logger.Error("Access log cleanup job failed", zap.Error(err))
+		return
+	}
+
+	j.logger.Info("Access log cleanup job completed",
+		zap.Int64("deleted", deleted),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// Start runs the job on every interval until ctx is cancelled
+func (j *AccessLogCleanupJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("Access log cleanup job stopped")
+			return
+		case <-ticker.C:
+			j.Run(ctx)
+		}
+	}
+}
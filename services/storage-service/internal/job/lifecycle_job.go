@@ -0,0 +1,58 @@
+// Package job provides background job implementations.
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/service"
+)
+
+// LifecycleJob periodically applies workspace lifecycle policies
+type LifecycleJob struct {
+	lifecycleService *service.LifecycleService
+	interval         time.Duration
+	logger           *zap.Logger
+}
+
+// NewLifecycleJob creates a new LifecycleJob instance
+func NewLifecycleJob(lifecycleService *service.LifecycleService, interval time.Duration, logger *zap.Logger) *LifecycleJob {
+	return &LifecycleJob{
+		lifecycleService: lifecycleService,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+// Run executes the lifecycle job once
+func (j *LifecycleJob) Run(ctx context.Context) {
+	j.logger.Info("Starting lifecycle tiering job")
+
+	start := time.Now()
+	if err := j.lifecycleService.ApplyPolicies(ctx); err != nil {
+		j.logger.Error("Lifecycle tiering job failed", zap.Error(err))
+		return
+	}
+
+	j.logger.Info("Lifecycle tiering job completed",
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// Start runs the job on every interval until ctx is cancelled
+func (j *LifecycleJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("Lifecycle tiering job stopped")
+			return
+		case <-ticker.C:
+			j.Run(ctx)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/service"
+)
+
+// OCRJob periodically extracts text from files queued for OCR
+type OCRJob struct {
+	ocrService *service.OCRService
+	interval   time.Duration
+	logger     *zap.Logger
+}
+
+// NewOCRJob creates a new OCRJob instance
+func NewOCRJob(ocrService *service.OCRService, interval time.Duration, logger *zap.Logger) *OCRJob {
+	return &OCRJob{
+		ocrService: ocrService,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Run processes one batch of queued files
+// 실패한 항목은 다음 실행 주기에 재시도됩니다.
+func (j *OCRJob) Run(ctx context.Context) {
+	start := time.Now()
+
+	processed, err := j.ocrService.ProcessPending(ctx)
+	if err != nil {
+		j.logger.Error("OCR job failed", zap.Error(err))
+		return
+	}
+
+	if processed > 0 {
+		j.logger.Info("OCR job completed",
+			zap.Int("processed", processed),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+}
+
+// Start runs the job on every interval until ctx is cancelled
+func (j *OCRJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("OCR job stopped")
+			return
+		case <-ticker.C:
+			j.Run(ctx)
+		}
+	}
+}
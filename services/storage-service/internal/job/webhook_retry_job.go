@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/service"
+)
+
+// WebhookRetryJob periodically resends failed webhook deliveries whose retry is due
+type WebhookRetryJob struct {
+	webhookService *service.WebhookService
+	interval       time.Duration
+	logger         *zap.Logger
+}
+
+// NewWebhookRetryJob creates a new WebhookRetryJob instance
+func NewWebhookRetryJob(webhookService *service.WebhookService, interval time.Duration, logger *zap.Logger) *WebhookRetryJob {
+	return &WebhookRetryJob{
+		webhookService: webhookService,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+// Run sends one batch of due retries
+func (j *WebhookRetryJob) Run(ctx context.Context) {
+	sent, err := j.webhookService.RetryDueDeliveries(ctx)
+	if err != nil {
+		j.logger.Error("Webhook retry job failed", zap.Error(err))
+		return
+	}
+
+	if sent > 0 {
+		j.logger.Debug("Webhook retries sent", zap.Int("sent", sent))
+	}
+}
+
+// Start runs the job on every interval until ctx is cancelled
+func (j *WebhookRetryJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("Webhook retry job stopped")
+			return
+		case <-ticker.C:
+			j.Run(ctx)
+		}
+	}
+}
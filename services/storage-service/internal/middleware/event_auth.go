@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/response"
+)
+
+// EventAuthMiddleware는 스토리지 이벤트 알림(MinIO webhook, SNS) 요청의 공유 토큰을 검증합니다.
+// MinIO는 Authorization 헤더(Bearer 토큰)를, SNS HTTP 구독은 ?token= 쿼리를 사용합니다.
+func EventAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "))
+		if provided == "" {
+			provided = c.Query("token")
+		}
+
+		if token == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			response.Unauthorized(c, "Invalid event token")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/response"
+)
+
+// InternalAuthMiddleware는 서비스 간 내부 API 키(x-internal-api-key 헤더)를 검증합니다.
+// apiKey가 비어 있으면 모든 요청을 거부합니다.
+func InternalAuthMiddleware(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided := c.GetHeader("x-internal-api-key")
+
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			response.Unauthorized(c, "Invalid internal API key")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
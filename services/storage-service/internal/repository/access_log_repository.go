@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// AccessLogRepository handles file access audit log database operations
+type AccessLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAccessLogRepository creates a new AccessLogRepository
+func NewAccessLogRepository(db *gorm.DB) *AccessLogRepository {
+	return &AccessLogRepository{db: db}
+}
+
+// Create records a file access
+func (r *AccessLogRepository) Create(ctx context.Context, log *domain.FileAccessLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+// FindByWorkspaceID finds access logs of a workspace with filters and pagination
+func (r *AccessLogRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, filter domain.AccessLogFilter, page, pageSize int) ([]domain.FileAccessLog, int64, error) {
+	var logs []domain.FileAccessLog
+	var total int64
+
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&domain.FileAccessLog{}).Where("workspace_id = ?", workspaceID), filter)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&logs).Error
+
+	return logs, total, err
+}
+
+// applyFilter applies optional filters to an access log query
+func (r *AccessLogRepository) applyFilter(query *gorm.DB, filter domain.AccessLogFilter) *gorm.DB {
+	if filter.FileID != nil {
+		query = query.Where("file_id = ?", *filter.FileID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Action != nil {
+		query = query.Where("action = ?", *filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	return query
+}
+
+// DeleteExpired deletes logs older than each workspace's retention period
+// 워크스페이스 설정이 없거나 보존 기간이 지정되지 않은 경우 defaultRetentionDays를 사용합니다.
+func (r *AccessLogRepository) DeleteExpired(ctx context.Context, defaultRetentionDays int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		DELETE FROM storage_file_access_logs l
+		WHERE l.created_at < NOW() - make_interval(days => COALESCE(
+			(SELECT s.access_log_retention_days FROM storage_workspace_settings s WHERE s.workspace_id = l.workspace_id),
+			?
+		))`, defaultRetentionDays)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// backupBatchSize is the number of rows inserted per statement during restore
+const backupBatchSize = 500
+
+// BackupRepository handles workspace export and import
+type BackupRepository struct {
+	db *gorm.DB
+}
+
+// NewBackupRepository creates a new BackupRepository
+func NewBackupRepository(db *gorm.DB) *BackupRepository {
+	return &BackupRepository{db: db}
+}
+
+// LoadWorkspace loads every folder, file (including trash) and tag of a workspace.
+// Folders are ordered by path so parents come before their children.
+func (r *BackupRepository) LoadWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceBackup, error) {
+	db := r.db.WithContext(ctx)
+	backup := &domain.WorkspaceBackup{WorkspaceID: workspaceID}
+
+	if err := db.Where("workspace_id = ?", workspaceID).Order("path, id").Find(&backup.Folders).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("workspace_id = ?", workspaceID).Order("created_at, id").Find(&backup.Files).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("workspace_id = ?", workspaceID).Order("name").Find(&backup.Tags).Error; err != nil {
+		return nil, err
+	}
+	err := db.Where("tag_id IN (?)", db.Model(&domain.Tag{}).Select("id").Where("workspace_id = ?", workspaceID)).
+		Order("file_id, tag_id").
+		Find(&backup.FileTags).Error
+	if err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// RestoreWorkspace inserts the backup in one transaction, skipping rows that already exist
+func (r *BackupRepository) RestoreWorkspace(ctx context.Context, backup *domain.WorkspaceBackup) (*domain.BackupImportResult, error) {
+	result := &domain.BackupImportResult{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		inserts := []struct {
+			rows  interface{}
+			empty bool
+			count *int64
+		}{
+			{&backup.Folders, len(backup.Folders) == 0, &result.Folders},
+			{&backup.Files, len(backup.Files) == 0, &result.Files},
+			{&backup.Tags, len(backup.Tags) == 0, &result.Tags},
+			{&backup.FileTags, len(backup.FileTags) == 0, &result.FileTags},
+		}
+		for _, insert := range inserts {
+			if insert.empty {
+				continue
+			}
+			created := tx.Omit(clause.Associations).
+				Clauses(clause.OnConflict{DoNothing: true}).
+				CreateInBatches(insert.rows, backupBatchSize)
+			if created.Error != nil {
+				return created.Error
+			}
+			*insert.count = created.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
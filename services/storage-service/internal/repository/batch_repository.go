@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// BatchRepository applies batch file operations together with their audit entry
+// 변경 사항과 감사 로그를 하나의 트랜잭션으로 저장합니다.
+type BatchRepository struct {
+	db *gorm.DB
+}
+
+// NewBatchRepository creates a new BatchRepository
+func NewBatchRepository(db *gorm.DB) *BatchRepository {
+	return &BatchRepository{db: db}
+}
+
+// SoftDelete moves files to trash
+func (r *BatchRepository) SoftDelete(ctx context.Context, fileIDs []uuid.UUID, audit *domain.BatchOperationLog) error {
+	return r.execute(ctx, fileIDs, audit, func(tx *gorm.DB) error {
+		now := time.Now()
+		return tx.Model(&domain.File{}).
+			Where("id IN ? AND deleted_at IS NULL", fileIDs).
+			Updates(map[string]interface{}{
+				"deleted_at": now,
+				"status":     domain.FileStatusDeleted,
+			}).Error
+	})
+}
+
+// Restore restores files from trash
+func (r *BatchRepository) Restore(ctx context.Context, fileIDs []uuid.UUID, audit *domain.BatchOperationLog) error {
+	return r.execute(ctx, fileIDs, audit, func(tx *gorm.DB) error {
+		return tx.Model(&domain.File{}).
+			Where("id IN ?", fileIDs).
+			Updates(map[string]interface{}{
+				"deleted_at": nil,
+				"status":     domain.FileStatusActive,
+			}).Error
+	})
+}
+
+// Move moves files to a folder (nil means root)
+func (r *BatchRepository) Move(ctx context.Context, fileIDs []uuid.UUID, folderID *uuid.UUID, audit *domain.BatchOperationLog) error {
+	return r.execute(ctx, fileIDs, audit, func(tx *gorm.DB) error {
+		return tx.Model(&domain.File{}).
+			Where("id IN ? AND deleted_at IS NULL", fileIDs).
+			Updates(map[string]interface{}{
+				"folder_id":  folderID,
+				"updated_at": time.Now(),
+			}).Error
+	})
+}
+
+// Tag attaches tags to files, creating free-form tags as needed
+func (r *BatchRepository) Tag(ctx context.Context, workspaceID uuid.UUID, fileIDs []uuid.UUID, names []string, audit *domain.BatchOperationLog) error {
+	return r.execute(ctx, fileIDs, audit, func(tx *gorm.DB) error {
+		tags, err := findOrCreateTags(tx, workspaceID, names, audit.UserID)
+		if err != nil {
+			return err
+		}
+		tagIDs := make([]uuid.UUID, 0, len(tags))
+		for _, tag := range tags {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+		return addFileTags(tx, fileIDs, tagIDs, audit.UserID)
+	})
+}
+
+// execute runs apply and stores the audit entry in one transaction
+// 적용할 파일이 없어도 감사 로그는 남깁니다.
+func (r *BatchRepository) execute(ctx context.Context, fileIDs []uuid.UUID, audit *domain.BatchOperationLog, apply func(tx *gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(fileIDs) > 0 {
+			if err := apply(tx); err != nil {
+				return err
+			}
+		}
+		return tx.Create(audit).Error
+	})
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// EncryptionKeyRepository handles workspace KMS key database operations
+type EncryptionKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewEncryptionKeyRepository creates a new EncryptionKeyRepository
+func NewEncryptionKeyRepository(db *gorm.DB) *EncryptionKeyRepository {
+	return &EncryptionKeyRepository{db: db}
+}
+
+// FindByWorkspaceID finds all keys of a workspace, newest first
+func (r *EncryptionKeyRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]domain.WorkspaceEncryptionKey, error) {
+	var keys []domain.WorkspaceEncryptionKey
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// FindByID finds a key by ID
+func (r *EncryptionKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	var key domain.WorkspaceEncryptionKey
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindActive finds the active key of a workspace
+func (r *EncryptionKeyRepository) FindActive(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	var key domain.WorkspaceEncryptionKey
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND status = ?", workspaceID, domain.EncryptionKeyActive).
+		First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindByKMSKeyID finds a workspace key by its KMS key ID
+func (r *EncryptionKeyRepository) FindByKMSKeyID(ctx context.Context, workspaceID uuid.UUID, kmsKeyID string) (*domain.WorkspaceEncryptionKey, error) {
+	var key domain.WorkspaceEncryptionKey
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND kms_key_id = ?", workspaceID, kmsKeyID).
+		First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// CountByWorkspaceID counts the keys of a workspace
+func (r *EncryptionKeyRepository) CountByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.WorkspaceEncryptionKey{}).
+		Where("workspace_id = ?", workspaceID).
+		Count(&count).Error
+	return count, err
+}
+
+// Activate makes a key the active key of its workspace, retiring the previous one
+func (r *EncryptionKeyRepository) Activate(ctx context.Context, key *domain.WorkspaceEncryptionKey) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.WorkspaceEncryptionKey{}).
+			Where("workspace_id = ? AND status = ? AND id <> ?", key.WorkspaceID, domain.EncryptionKeyActive, key.ID).
+			Updates(map[string]interface{}{
+				"status":     domain.EncryptionKeyRetired,
+				"rotated_at": time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+		key.Status = domain.EncryptionKeyActive
+		key.DisabledAt = nil
+		return tx.Save(key).Error
+	})
+}
+
+// Update updates a key
+func (r *EncryptionKeyRepository) Update(ctx context.Context, key *domain.WorkspaceEncryptionKey) error {
+	return r.db.WithContext(ctx).Save(key).Error
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// FileACLRepository handles per-file ACL database operations
+type FileACLRepository struct {
+	db *gorm.DB
+}
+
+// NewFileACLRepository creates a new FileACLRepository
+func NewFileACLRepository(db *gorm.DB) *FileACLRepository {
+	return &FileACLRepository{db: db}
+}
+
+// FindByFileID finds all ACL entries of a file
+func (r *FileACLRepository) FindByFileID(ctx context.Context, fileID uuid.UUID) ([]domain.FileACLEntry, error) {
+	var entries []domain.FileACLEntry
+	err := r.db.WithContext(ctx).
+		Where("file_id = ?", fileID).
+		Order("created_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+// ReplaceForFile replaces all ACL entries of a file
+func (r *FileACLRepository) ReplaceForFile(ctx context.Context, fileID uuid.UUID, entries []domain.FileACLEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("file_id = ?", fileID).Delete(&domain.FileACLEntry{}).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.Create(&entries).Error
+	})
+}
+
+// DeleteByFileID deletes all ACL entries of a file
+func (r *FileACLRepository) DeleteByFileID(ctx context.Context, fileID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("file_id = ?", fileID).
+		Delete(&domain.FileACLEntry{}).Error
+}
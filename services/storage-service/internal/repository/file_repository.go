@@ -35,7 +35,11 @@ func (r *FileRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Fi
 	if err != nil {
 		return nil, err
 	}
-	return &file, nil
+	files := []domain.File{file}
+	if err := r.attachTags(ctx, files); err != nil {
+		return nil, err
+	}
+	return &files[0], nil
 }
 
 // FindByIDWithDeleted finds a file by ID including deleted ones
@@ -50,6 +54,15 @@ func (r *FileRepository) FindByIDWithDeleted(ctx context.Context, id uuid.UUID)
 	return &file, nil
 }
 
+// FindByIDsWithDeleted finds files by IDs including deleted ones
+func (r *FileRepository) FindByIDsWithDeleted(ctx context.Context, ids []uuid.UUID) ([]domain.File, error) {
+	var files []domain.File
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&files).Error; err != nil {
+		return nil, err
+	}
+	return files, r.attachTags(ctx, files)
+}
+
 // FindByFileKey finds a file by S3 key
 func (r *FileRepository) FindByFileKey(ctx context.Context, fileKey string) (*domain.File, error) {
 	var file domain.File
@@ -63,13 +76,13 @@ func (r *FileRepository) FindByFileKey(ctx context.Context, fileKey string) (*do
 }
 
 // FindByWorkspaceID finds all files in a workspace with pagination
-func (r *FileRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, page, pageSize int) ([]domain.File, int64, error) {
+// tags가 주어지면 모든 태그가 붙은 파일만 조회합니다.
+func (r *FileRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, tags []string, page, pageSize int) ([]domain.File, int64, error) {
 	var files []domain.File
 	var total int64
 
 	// Count total
-	err := r.db.WithContext(ctx).
-		Model(&domain.File{}).
+	err := withTags(r.db.WithContext(ctx).Model(&domain.File{}), workspaceID, tags).
 		Where("workspace_id = ? AND deleted_at IS NULL", workspaceID).
 		Count(&total).Error
 	if err != nil {
@@ -78,14 +91,17 @@ func (r *FileRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid
 
 	// Get files with pagination
 	offset := (page - 1) * pageSize
-	err = r.db.WithContext(ctx).
+	err = withTags(r.db.WithContext(ctx), workspaceID, tags).
 		Where("workspace_id = ? AND deleted_at IS NULL", workspaceID).
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
 		Find(&files).Error
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return files, total, err
+	return files, total, r.attachTags(ctx, files)
 }
 
 // FindByFolderID finds all files in a folder
@@ -99,8 +115,10 @@ func (r *FileRepository) FindByFolderID(ctx context.Context, workspaceID uuid.UU
 		query = query.Where("folder_id = ?", folderID)
 	}
 
-	err := query.Order("name ASC").Find(&files).Error
-	return files, err
+	if err := query.Order("name ASC").Find(&files).Error; err != nil {
+		return nil, err
+	}
+	return files, r.attachTags(ctx, files)
 }
 
 // FindRootFiles finds all files in the root folder
@@ -188,9 +206,20 @@ func (r *FileRepository) Restore(ctx context.Context, id uuid.UUID) error {
 		}).Error
 }
 
-// PermanentDelete permanently deletes a file record
+// PermanentDelete permanently deletes a file record with its ACL entries, tags, and OCR text
 func (r *FileRepository) PermanentDelete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Unscoped().Delete(&domain.File{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("file_id = ?", id).Delete(&domain.FileACLEntry{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("file_id = ?", id).Delete(&domain.FileTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("file_id = ?", id).Delete(&domain.FileText{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.File{}, id).Error
+	})
 }
 
 // FindDeleted finds all deleted files in a workspace (trash)
@@ -200,7 +229,10 @@ func (r *FileRepository) FindDeleted(ctx context.Context, workspaceID uuid.UUID)
 		Where("workspace_id = ? AND deleted_at IS NOT NULL", workspaceID).
 		Order("deleted_at DESC").
 		Find(&files).Error
-	return files, err
+	if err != nil {
+		return nil, err
+	}
+	return files, r.attachTags(ctx, files)
 }
 
 // CountByWorkspaceID counts files in a workspace
@@ -290,32 +322,192 @@ func (r *FileRepository) GenerateUniqueName(ctx context.Context, workspaceID uui
 	return "", fmt.Errorf("could not generate unique name for file: %s", name)
 }
 
-// Search searches files by name
-func (r *FileRepository) Search(ctx context.Context, workspaceID uuid.UUID, query string, page, pageSize int) ([]domain.File, int64, error) {
+// Search searches files by name and tags
+// query가 비어 있으면 태그 조건만 적용합니다.
+func (r *FileRepository) Search(ctx context.Context, workspaceID uuid.UUID, query string, tags []string, page, pageSize int) ([]domain.File, int64, error) {
 	var files []domain.File
 	var total int64
 
-	searchQuery := "%" + query + "%"
+	search := func() *gorm.DB {
+		q := withTags(r.db.WithContext(ctx).Model(&domain.File{}), workspaceID, tags).
+			Where("workspace_id = ? AND deleted_at IS NULL", workspaceID)
+		if query != "" {
+			searchQuery := "%" + query + "%"
+			// OCR로 추출된 본문 텍스트도 검색 대상
+			q = q.Where(`(name ILIKE ? OR original_name ILIKE ? OR EXISTS (
+				SELECT 1 FROM storage_file_texts t
+				WHERE t.file_id = storage_files.id AND t.status = ? AND t.content ILIKE ?))`,
+				searchQuery, searchQuery, domain.OCRStatusCompleted, searchQuery)
+		}
+		return q
+	}
 
 	// Count total
-	err := r.db.WithContext(ctx).
-		Model(&domain.File{}).
-		Where("workspace_id = ? AND (name ILIKE ? OR original_name ILIKE ?) AND deleted_at IS NULL",
-			workspaceID, searchQuery, searchQuery).
-		Count(&total).Error
-	if err != nil {
+	if err := search().Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// Get files with pagination
 	offset := (page - 1) * pageSize
-	err = r.db.WithContext(ctx).
-		Where("workspace_id = ? AND (name ILIKE ? OR original_name ILIKE ?) AND deleted_at IS NULL",
-			workspaceID, searchQuery, searchQuery).
+	err := search().
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
 		Find(&files).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return files, total, r.attachTags(ctx, files)
+}
+
+// withTags restricts a file query to files carrying all of the given tag names
+func withTags(query *gorm.DB, workspaceID uuid.UUID, tags []string) *gorm.DB {
+	if len(tags) == 0 {
+		return query
+	}
+	return query.Where(`id IN (
+		SELECT ft.file_id FROM storage_file_tags ft
+		JOIN storage_tags t ON t.id = ft.tag_id
+		WHERE t.workspace_id = ? AND t.name IN ?
+		GROUP BY ft.file_id
+		HAVING COUNT(DISTINCT t.id) = ?)`, workspaceID, tags, len(tags))
+}
+
+// attachTags loads the tags of the given files
+func (r *FileRepository) attachTags(ctx context.Context, files []domain.File) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(files))
+	for i := range files {
+		ids[i] = files[i].ID
+	}
+
+	var rows []struct {
+		domain.Tag
+		FileID uuid.UUID
+	}
+	err := r.db.WithContext(ctx).
+		Table("storage_tags t").
+		Select("t.*, ft.file_id").
+		Joins("JOIN storage_file_tags ft ON ft.tag_id = t.id").
+		Where("ft.file_id IN ?", ids).
+		Order("t.name ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	byFile := make(map[uuid.UUID][]domain.Tag, len(files))
+	for _, row := range rows {
+		byFile[row.FileID] = append(byFile[row.FileID], row.Tag)
+	}
+	for i := range files {
+		files[i].Tags = byFile[files[i].ID]
+	}
+	return nil
+}
+
+// FindColdFiles finds active files in the given storage classes not accessed since cutoff
+// 마지막 접근 시각이 없으면 생성 시각을 기준으로 판단합니다.
+func (r *FileRepository) FindColdFiles(ctx context.Context, workspaceID uuid.UUID, classes []domain.StorageClass, cutoff time.Time, limit int) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND status = ? AND deleted_at IS NULL", workspaceID, domain.FileStatusActive).
+		Where("storage_class IN ?", classes).
+		Where("COALESCE(last_accessed_at, created_at) < ?", cutoff).
+		Order("COALESCE(last_accessed_at, created_at) ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// FindNotEncryptedWith finds files whose objects are not encrypted with the given KMS key
+// 복원이 필요한 아카이브 객체와 업로드 중인 파일은 제외합니다.
+func (r *FileRepository) FindNotEncryptedWith(ctx context.Context, workspaceID uuid.UUID, kmsKeyID string, limit int) ([]domain.File, error) {
+	var files []domain.File
+	err := r.notEncryptedWith(r.db.WithContext(ctx), workspaceID, kmsKeyID).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// CountNotEncryptedWith counts files whose objects are not encrypted with the given KMS key
+func (r *FileRepository) CountNotEncryptedWith(ctx context.Context, workspaceID uuid.UUID, kmsKeyID string) (int64, error) {
+	var count int64
+	err := r.notEncryptedWith(r.db.WithContext(ctx).Model(&domain.File{}), workspaceID, kmsKeyID).
+		Count(&count).Error
+	return count, err
+}
+
+// notEncryptedWith builds the query for files not encrypted with a key
+func (r *FileRepository) notEncryptedWith(query *gorm.DB, workspaceID uuid.UUID, kmsKeyID string) *gorm.DB {
+	return query.
+		Where("workspace_id = ? AND status <> ?", workspaceID, domain.FileStatusUploading).
+		Where("kms_key_id IS DISTINCT FROM ?", kmsKeyID).
+		Where("storage_class NOT IN ?", []domain.StorageClass{domain.StorageClassGlacier, domain.StorageClassDeepArchive})
+}
+
+// UpdateKMSKeyID updates the KMS key a file is encrypted with
+func (r *FileRepository) UpdateKMSKeyID(ctx context.Context, id uuid.UUID, kmsKeyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		Update("kms_key_id", kmsKeyID).Error
+}
+
+// UpdateStorageClass updates the storage class of a file
+func (r *FileRepository) UpdateStorageClass(ctx context.Context, id uuid.UUID, storageClass domain.StorageClass) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"storage_class":        storageClass,
+			"restore_requested_at": nil,
+			"updated_at":           time.Now(),
+		}).Error
+}
 
-	return files, total, err
+// TouchLastAccessed records the last access time of a file
+func (r *FileRepository) TouchLastAccessed(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		UpdateColumn("last_accessed_at", time.Now()).Error
+}
+
+// MarkRestoreRequested records that an archive restore was requested for a file
+func (r *FileRepository) MarkRestoreRequested(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		UpdateColumn("restore_requested_at", time.Now()).Error
+}
+
+// MarkMetadataStripped records that image metadata was removed and stores the new object size
+func (r *FileRepository) MarkMetadataStripped(ctx context.Context, id uuid.UUID, fileSize int64) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"file_size":            fileSize,
+			"metadata_stripped_at": time.Now(),
+		}).Error
+}
+
+// ActivateUpload moves a file from UPLOADING to ACTIVE
+// 동시에 여러 경로(클라이언트 확정, 버킷 이벤트)에서 호출되어도 한 번만 성공합니다.
+func (r *FileRepository) ActivateUpload(ctx context.Context, id uuid.UUID, name string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ? AND status = ?", id, domain.FileStatusUploading).
+		Updates(map[string]interface{}{
+			"name":       name,
+			"status":     domain.FileStatusActive,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
 }
@@ -0,0 +1,142 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+	"storage-service/internal/database"
+	"storage-service/internal/domain"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+func setupPostgres(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := integration.Postgres(t)
+	if err := database.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+func createFile(t *testing.T, repo *FileRepository, workspaceID uuid.UUID, folderID *uuid.UUID, name string) *domain.File {
+	t.Helper()
+	file := &domain.File{
+		WorkspaceID:  workspaceID,
+		FolderID:     folderID,
+		Name:         name,
+		OriginalName: name,
+		FileKey:      "storage/" + workspaceID.String() + "/" + uuid.NewString() + "/" + name,
+		FileSize:     128,
+		ContentType:  "text/plain",
+		Status:       domain.FileStatusActive,
+		UploadedBy:   uuid.New(),
+	}
+	if err := repo.Create(context.Background(), file); err != nil {
+		t.Fatalf("failed to create file %q: %v", name, err)
+	}
+	return file
+}
+
+func TestFileRepository_TrashLifecycle(t *testing.T) {
+	repo := NewFileRepository(setupPostgres(t))
+	ctx := context.Background()
+	workspaceID := uuid.New()
+
+	trashed := createFile(t, repo, workspaceID, nil, "old.txt")
+	kept := createFile(t, repo, workspaceID, nil, "kept.txt")
+
+	if err := repo.SoftDelete(ctx, trashed.ID); err != nil {
+		t.Fatalf("SoftDelete() error = %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, trashed.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByID() on trashed file error = %v, want ErrRecordNotFound", err)
+	}
+	withDeleted, err := repo.FindByIDWithDeleted(ctx, trashed.ID)
+	if err != nil {
+		t.Fatalf("FindByIDWithDeleted() error = %v", err)
+	}
+	if !withDeleted.IsDeleted() || withDeleted.Status != domain.FileStatusDeleted {
+		t.Errorf("trashed file = status %s, deletedAt %v; want DELETED with deletedAt", withDeleted.Status, withDeleted.DeletedAt)
+	}
+
+	count, err := repo.CountByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		t.Fatalf("CountByWorkspaceID() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("CountByWorkspaceID() = %d, want 1 (trash excluded)", count)
+	}
+	trash, err := repo.FindDeleted(ctx, workspaceID)
+	if err != nil {
+		t.Fatalf("FindDeleted() error = %v", err)
+	}
+	if len(trash) != 1 || trash[0].ID != trashed.ID {
+		t.Errorf("FindDeleted() returned %d files, want only the trashed one", len(trash))
+	}
+
+	if err := repo.Restore(ctx, trashed.ID); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	restored, err := repo.FindByID(ctx, trashed.ID)
+	if err != nil {
+		t.Fatalf("FindByID() after Restore error = %v", err)
+	}
+	if restored.IsDeleted() {
+		t.Error("restored file should not be deleted")
+	}
+
+	if err := repo.PermanentDelete(ctx, kept.ID); err != nil {
+		t.Fatalf("PermanentDelete() error = %v", err)
+	}
+	if _, err := repo.FindByIDWithDeleted(ctx, kept.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByIDWithDeleted() after PermanentDelete error = %v, want ErrRecordNotFound", err)
+	}
+}
+
+func TestFileRepository_SoftDeleteByFolderID(t *testing.T) {
+	db := setupPostgres(t)
+	repo := NewFileRepository(db)
+	ctx := context.Background()
+	workspaceID := uuid.New()
+
+	folder := &domain.Folder{WorkspaceID: workspaceID, Name: "docs", Path: "/docs", CreatedBy: uuid.New()}
+	if err := NewFolderRepository(db).Create(ctx, folder); err != nil {
+		t.Fatalf("failed to create folder: %v", err)
+	}
+	folderID := folder.ID
+
+	createFile(t, repo, workspaceID, &folderID, "a.txt")
+	createFile(t, repo, workspaceID, &folderID, "b.txt")
+	createFile(t, repo, workspaceID, nil, "root.txt")
+
+	if err := repo.SoftDeleteByFolderID(ctx, folderID); err != nil {
+		t.Fatalf("SoftDeleteByFolderID() error = %v", err)
+	}
+
+	inFolder, err := repo.CountByFolderID(ctx, folderID)
+	if err != nil {
+		t.Fatalf("CountByFolderID() error = %v", err)
+	}
+	if inFolder != 0 {
+		t.Errorf("CountByFolderID() = %d, want 0", inFolder)
+	}
+	inWorkspace, err := repo.CountByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		t.Fatalf("CountByWorkspaceID() error = %v", err)
+	}
+	if inWorkspace != 1 {
+		t.Errorf("CountByWorkspaceID() = %d, want 1", inWorkspace)
+	}
+}
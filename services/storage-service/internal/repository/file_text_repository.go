@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// FileTextRepository handles OCR text database operations
+type FileTextRepository struct {
+	db *gorm.DB
+}
+
+// NewFileTextRepository creates a new FileTextRepository
+func NewFileTextRepository(db *gorm.DB) *FileTextRepository {
+	return &FileTextRepository{db: db}
+}
+
+// FindByFileID finds the extracted text of a file
+func (r *FileTextRepository) FindByFileID(ctx context.Context, fileID uuid.UUID) (*domain.FileText, error) {
+	var text domain.FileText
+	err := r.db.WithContext(ctx).
+		Where("file_id = ?", fileID).
+		First(&text).Error
+	if err != nil {
+		return nil, err
+	}
+	return &text, nil
+}
+
+// Enqueue creates or resets a PENDING OCR entry for a file
+// 파일 내용이 바뀌어 다시 업로드된 경우 기존 결과를 초기화합니다.
+func (r *FileTextRepository) Enqueue(ctx context.Context, text *domain.FileText) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "file_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"status", "languages", "content", "error", "attempts", "processed_at", "updated_at",
+			}),
+		}).
+		Create(text).Error
+}
+
+// ClaimPending marks up to limit pending entries as PROCESSING and returns them
+// 여러 인스턴스가 동시에 실행돼도 SKIP LOCKED로 같은 항목을 중복 처리하지 않습니다.
+// staleAfter보다 오래 PROCESSING 상태인 항목(워커 중단)도 다시 가져옵니다.
+func (r *FileTextRepository) ClaimPending(ctx context.Context, limit int, staleAfter time.Duration) ([]domain.FileText, error) {
+	var texts []domain.FileText
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at < ?)",
+				domain.OCRStatusPending, domain.OCRStatusProcessing, now.Add(-staleAfter)).
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&texts).Error
+		if err != nil || len(texts) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(texts))
+		for i := range texts {
+			ids[i] = texts[i].FileID
+			texts[i].Status = domain.OCRStatusProcessing
+			texts[i].Attempts++
+			texts[i].UpdatedAt = now
+		}
+
+		return tx.Model(&domain.FileText{}).
+			Where("file_id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":     domain.OCRStatusProcessing,
+				"attempts":   gorm.Expr("attempts + 1"),
+				"updated_at": now,
+			}).Error
+	})
+	return texts, err
+}
+
+// Complete stores the extracted text of a file
+func (r *FileTextRepository) Complete(ctx context.Context, fileID uuid.UUID, content string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&domain.FileText{}).
+		Where("file_id = ?", fileID).
+		Updates(map[string]interface{}{
+			"status":       domain.OCRStatusCompleted,
+			"content":      content,
+			"error":        nil,
+			"processed_at": now,
+			"updated_at":   now,
+		}).Error
+}
+
+// Fail records an OCR failure; the entry is retried unless it reached max attempts
+func (r *FileTextRepository) Fail(ctx context.Context, fileID uuid.UUID, message string, final bool) error {
+	status := domain.OCRStatusPending
+	if final {
+		status = domain.OCRStatusFailed
+	}
+	return r.db.WithContext(ctx).
+		Model(&domain.FileText{}).
+		Where("file_id = ?", fileID).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"error":      message,
+			"updated_at": time.Now(),
+		}).Error
+}
@@ -102,6 +102,38 @@ func (r *FolderRepository) FindChildrenRecursive(ctx context.Context, workspaceI
 	return folders, err
 }
 
+// FindTreeStats computes recursive file counts and sizes for every folder in a workspace
+// 재귀 CTE로 한 번의 쿼리에서 모든 폴더의 하위 파일 수/용량을 집계합니다.
+func (r *FolderRepository) FindTreeStats(ctx context.Context, workspaceID uuid.UUID) (map[uuid.UUID]domain.FolderTreeStat, error) {
+	var rows []domain.FolderTreeStat
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT id AS root_id, id AS folder_id
+			FROM storage_folders
+			WHERE workspace_id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT tree.root_id, child.id
+			FROM tree
+			JOIN storage_folders child ON child.parent_id = tree.folder_id AND child.deleted_at IS NULL
+		)
+		SELECT tree.root_id AS folder_id,
+			COUNT(f.id) AS file_count,
+			COALESCE(SUM(f.file_size), 0) AS total_size
+		FROM tree
+		LEFT JOIN storage_files f ON f.folder_id = tree.folder_id AND f.deleted_at IS NULL
+		GROUP BY tree.root_id`, workspaceID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[uuid.UUID]domain.FolderTreeStat, len(rows))
+	for _, row := range rows {
+		stats[row.FolderID] = row
+	}
+	return stats, nil
+}
+
 // Update updates a folder
 func (r *FolderRepository) Update(ctx context.Context, folder *domain.Folder) error {
 	return r.db.WithContext(ctx).Save(folder).Error
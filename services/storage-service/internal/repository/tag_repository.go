@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// TagRepository handles tag database operations
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new TagRepository
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+// TagWithCount is a tag with the number of active files carrying it
+type TagWithCount struct {
+	domain.Tag
+	FileCount int64
+}
+
+// FindByID finds a tag by ID
+func (r *TagRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindByName finds a tag by normalized name in a workspace
+func (r *TagRepository) FindByName(ctx context.Context, workspaceID uuid.UUID, name string) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND name = ?", workspaceID, name).
+		First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindByWorkspaceIDWithCounts finds the tags of a workspace with active file counts
+// 사용 중이지 않은 자유 태그는 제외하고, 관리 태그는 항상 포함합니다.
+func (r *TagRepository) FindByWorkspaceIDWithCounts(ctx context.Context, workspaceID uuid.UUID) ([]TagWithCount, error) {
+	var tags []TagWithCount
+	err := r.db.WithContext(ctx).
+		Table("storage_tags t").
+		Select("t.*, COUNT(f.id) AS file_count").
+		Joins("LEFT JOIN storage_file_tags ft ON ft.tag_id = t.id").
+		Joins("LEFT JOIN storage_files f ON f.id = ft.file_id AND f.deleted_at IS NULL").
+		Where("t.workspace_id = ?", workspaceID).
+		Group("t.id").
+		Having("t.managed OR COUNT(f.id) > 0").
+		Order("t.name ASC").
+		Scan(&tags).Error
+	return tags, err
+}
+
+// FindOrCreate finds tags by name in a workspace, creating free-form tags for missing names
+func (r *TagRepository) FindOrCreate(ctx context.Context, workspaceID uuid.UUID, names []string, userID uuid.UUID) ([]domain.Tag, error) {
+	var result []domain.Tag
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		result, err = findOrCreateTags(tx, workspaceID, names, userID)
+		return err
+	})
+	return result, err
+}
+
+// findOrCreateTags finds or creates tags within a transaction
+func findOrCreateTags(tx *gorm.DB, workspaceID uuid.UUID, names []string, userID uuid.UUID) ([]domain.Tag, error) {
+	now := time.Now()
+	tags := make([]domain.Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, domain.Tag{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Name:        name,
+			CreatedBy:   userID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+
+	// 동시에 같은 태그가 생성될 수 있으므로 충돌 시 기존 태그 사용
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	var result []domain.Tag
+	err := tx.Where("workspace_id = ? AND name IN ?", workspaceID, names).
+		Order("name ASC").
+		Find(&result).Error
+	return result, err
+}
+
+// Create creates a new tag
+func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	return r.db.WithContext(ctx).Create(tag).Error
+}
+
+// Update updates a tag
+func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	return r.db.WithContext(ctx).Save(tag).Error
+}
+
+// Delete deletes a tag and detaches it from all files
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&domain.FileTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Tag{}, id).Error
+	})
+}
+
+// AddToFile attaches tags to a file, ignoring tags already attached
+func (r *TagRepository) AddToFile(ctx context.Context, fileID uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) error {
+	return addFileTags(r.db.WithContext(ctx), []uuid.UUID{fileID}, tagIDs, userID)
+}
+
+// addFileTags links every tag to every file, ignoring existing links
+func addFileTags(tx *gorm.DB, fileIDs, tagIDs []uuid.UUID, userID uuid.UUID) error {
+	if len(fileIDs) == 0 || len(tagIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	links := make([]domain.FileTag, 0, len(fileIDs)*len(tagIDs))
+	for _, fileID := range fileIDs {
+		for _, tagID := range tagIDs {
+			links = append(links, domain.FileTag{
+				FileID:    fileID,
+				TagID:     tagID,
+				CreatedBy: userID,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&links).Error
+}
+
+// RemoveFromFile detaches a tag from a file
+func (r *TagRepository) RemoveFromFile(ctx context.Context, fileID, tagID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("file_id = ? AND tag_id = ?", fileID, tagID).
+		Delete(&domain.FileTag{}).Error
+}
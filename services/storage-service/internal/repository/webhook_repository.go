@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// WebhookRepository handles webhook database operations
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new WebhookRepository
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// Create creates a new webhook
+func (r *WebhookRepository) Create(ctx context.Context, webhook *domain.Webhook) error {
+	return r.db.WithContext(ctx).Create(webhook).Error
+}
+
+// FindByID finds a webhook by ID
+func (r *WebhookRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&webhook).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// FindByProjectID finds all webhooks of a project
+func (r *WebhookRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// FindActiveByProjectID finds active webhooks of a project
+func (r *WebhookRepository) FindActiveByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.WithContext(ctx).
+		Where("project_id = ? AND is_active = ?", projectID, true).
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// Update updates a webhook
+func (r *WebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	return r.db.WithContext(ctx).Save(webhook).Error
+}
+
+// Delete deletes a webhook and its delivery log
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&domain.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Webhook{}, "id = ?", id).Error
+	})
+}
+
+// CreateDelivery records a delivery attempt
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return r.db.WithContext(ctx).Create(delivery).Error
+}
+
+// ClaimDueRetries returns up to limit failed deliveries whose retry is due and clears their schedule
+// 여러 인스턴스가 동시에 실행돼도 SKIP LOCKED로 같은 재시도를 중복 전송하지 않습니다.
+func (r *WebhookRepository) ClaimDueRetries(ctx context.Context, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("next_attempt_at IS NOT NULL AND next_attempt_at <= ?", time.Now()).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+			deliveries[i].NextAttemptAt = nil
+		}
+
+		return tx.Model(&domain.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", nil).Error
+	})
+	return deliveries, err
+}
+
+// FindDeliveries finds delivery attempts of a webhook with pagination
+func (r *WebhookRepository) FindDeliveries(ctx context.Context, webhookID uuid.UUID, page, pageSize int) ([]domain.WebhookDelivery, int64, error) {
+	var deliveries []domain.WebhookDelivery
+	var total int64
+
+	err := r.db.WithContext(ctx).
+		Model(&domain.WebhookDelivery{}).
+		Where("webhook_id = ?", webhookID).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = r.db.WithContext(ctx).
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&deliveries).Error
+
+	return deliveries, total, err
+}
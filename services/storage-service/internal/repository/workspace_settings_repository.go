@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// WorkspaceSettingsRepository handles workspace settings database operations
+type WorkspaceSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceSettingsRepository creates a new WorkspaceSettingsRepository
+func NewWorkspaceSettingsRepository(db *gorm.DB) *WorkspaceSettingsRepository {
+	return &WorkspaceSettingsRepository{db: db}
+}
+
+// FindByWorkspaceID finds settings for a workspace
+// 저장된 설정이 없으면 기본 설정을 반환합니다.
+func (r *WorkspaceSettingsRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	var settings domain.WorkspaceSettings
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.DefaultWorkspaceSettings(workspaceID), nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Save creates or updates workspace settings
+func (r *WorkspaceSettingsRepository) Save(ctx context.Context, settings *domain.WorkspaceSettings) error {
+	return r.db.WithContext(ctx).Save(settings).Error
+}
+
+// FindLifecycleEnabled finds all workspaces with an enabled lifecycle policy
+func (r *WorkspaceSettingsRepository) FindLifecycleEnabled(ctx context.Context) ([]domain.WorkspaceSettings, error) {
+	var settings []domain.WorkspaceSettings
+	err := r.db.WithContext(ctx).
+		Where("lifecycle_enabled = ?", true).
+		Find(&settings).Error
+	return settings, err
+}
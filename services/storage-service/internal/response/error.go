@@ -1,4 +1,5 @@
 // Package response provides HTTP response utilities for the storage-service.
+// 응답 형식은 공통 모듈의 응답 봉투를 따릅니다.
 package response
 
 import (
@@ -7,44 +8,27 @@ import (
 	"github.com/gin-gonic/gin"
 
 	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
 // Error sends an error response using the common AppError type.
 func Error(c *gin.Context, err *apperrors.AppError) {
-	status := apperrors.GetHTTPStatus(err.Code)
-	c.JSON(status, gin.H{
-		"error": gin.H{
-			"code":    err.Code,
-			"message": err.Message,
-		},
-	})
+	commonresponse.ErrorFrom(c, err)
 }
 
 // ErrorWithDetails sends an error response with additional details.
 func ErrorWithDetails(c *gin.Context, err *apperrors.AppError) {
-	status := apperrors.GetHTTPStatus(err.Code)
-	response := gin.H{
-		"code":    err.Code,
-		"message": err.Message,
-	}
-	if err.Details != "" {
-		response["details"] = err.Details
-	}
-	c.JSON(status, gin.H{"error": response})
+	commonresponse.ErrorFrom(c, err)
 }
 
 // HandleError converts a generic error to an appropriate HTTP response.
 func HandleError(c *gin.Context, err error) {
-	if appErr := apperrors.AsAppError(err); appErr != nil {
-		Error(c, appErr)
-		return
-	}
-	Error(c, apperrors.Internal("An unexpected error occurred", err.Error()))
+	commonresponse.HandleError(c, err)
 }
 
 // BadRequest sends a 400 Bad Request response.
 func BadRequest(c *gin.Context, message string) {
-	Error(c, apperrors.BadRequest(message, ""))
+	commonresponse.BadRequest(c, message)
 }
 
 // BadRequestWithDetails sends a 400 Bad Request response with details.
@@ -54,35 +38,36 @@ func BadRequestWithDetails(c *gin.Context, message, details string) {
 
 // ValidationError sends a 400 response for validation errors.
 func ValidationError(c *gin.Context, message string) {
-	Error(c, apperrors.Validation(message, ""))
+	commonresponse.ValidationError(c, message)
 }
 
 // Unauthorized sends a 401 Unauthorized response.
 func Unauthorized(c *gin.Context, message string) {
-	Error(c, apperrors.Unauthorized(message, ""))
+	commonresponse.Unauthorized(c, message)
 }
 
 // Forbidden sends a 403 Forbidden response.
 func Forbidden(c *gin.Context, message string) {
-	Error(c, apperrors.Forbidden(message, ""))
+	commonresponse.Forbidden(c, message)
 }
 
 // NotFound sends a 404 Not Found response.
 func NotFound(c *gin.Context, message string) {
-	Error(c, apperrors.NotFound(message, ""))
+	commonresponse.NotFound(c, message)
 }
 
 // Conflict sends a 409 Conflict response.
 func Conflict(c *gin.Context, message string) {
-	Error(c, apperrors.Conflict(message, ""))
+	commonresponse.Conflict(c, message)
 }
 
 // InternalError sends a 500 Internal Server Error response.
 func InternalError(c *gin.Context, message string) {
-	Error(c, apperrors.Internal(message, ""))
+	commonresponse.InternalError(c, message)
 }
 
-// InternalErrorWithDetails sends a 500 response with error details.
+// InternalErrorWithDetails sends a 500 response.
+// The cause is not exposed to clients (common envelope hides 5xx details).
 func InternalErrorWithDetails(c *gin.Context, message string, err error) {
 	details := ""
 	if err != nil {
@@ -93,29 +78,20 @@ func InternalErrorWithDetails(c *gin.Context, message string, err error) {
 
 // Success sends a success response with a message.
 func Success(c *gin.Context, message string) {
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"message": message,
-	})
+	commonresponse.SuccessWithMessage(c, http.StatusOK, nil, message)
 }
 
 // Created sends a 201 Created response with data.
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, gin.H{
-		"success": true,
-		"data":    data,
-	})
+	commonresponse.Created(c, data)
 }
 
 // OK sends a 200 OK response with data.
 func OK(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data":    data,
-	})
+	commonresponse.OK(c, data)
 }
 
 // NoContent sends a 204 No Content response.
 func NoContent(c *gin.Context) {
-	c.Status(http.StatusNoContent)
+	commonresponse.NoContent(c)
 }
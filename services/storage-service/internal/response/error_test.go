@@ -147,7 +147,8 @@ func TestSuccess(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
 	assert.True(t, resp["success"].(bool))
-	assert.Equal(t, "operation completed", resp["message"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "operation completed", data["message"])
 }
 
 func TestCreated(t *testing.T) {
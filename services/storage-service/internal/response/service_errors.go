@@ -26,6 +26,7 @@ var (
 	ErrFolderNotFound         = errors.New("folder not found")
 	ErrMemberAlreadyExists    = errors.New("member already exists")
 	ErrShareNotFound          = errors.New("share not found")
+	ErrFileRestoreInProgress  = errors.New("file restore from archive in progress")
 )
 
 // ============================================================
@@ -122,12 +123,7 @@ func HandleServiceError(c *gin.Context, err error) {
 		Error(c, apperrors.NotFound("Share not found", ""))
 
 	default:
-		// Handle AppError if present
-		if appErr := apperrors.AsAppError(err); appErr != nil {
-			Error(c, appErr)
-			return
-		}
-		// Default to internal error
-		Error(c, apperrors.Internal("An internal error occurred", ""))
+		// AppError 및 기타 에러는 공통 매핑 사용
+		HandleError(c, err)
 	}
 }
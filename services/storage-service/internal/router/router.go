@@ -4,14 +4,21 @@ import (
 	"os"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/dbreplica"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/idempotency"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/tenancy"
 	"storage-service/internal/client"
 	"storage-service/internal/config"
 	"storage-service/internal/handler"
@@ -24,18 +31,34 @@ import (
 // Config holds router configuration
 type Config struct {
 	DB              *gorm.DB
+	Replicas        *dbreplica.Resolver // 목록 조회용 읽기 복제본 (nil이면 primary만 사용)
 	Logger          *zap.Logger
 	JWTSecret       string
 	BasePath        string
 	S3Client        *client.S3Client
 	TokenValidator  middleware.TokenValidator // 공통 모듈의 TokenValidator 인터페이스 사용
 	UserClient      client.UserClient
+	AuthServiceURL  string // readiness에서 다운스트림으로 확인 (비어 있으면 생략)
+	UserServiceURL  string // readiness에서 다운스트림으로 확인 (비어 있으면 생략)
 	Metrics         *metrics.Metrics
 	RedisClient     *redis.Client
 	RateLimitConfig config.RateLimitConfig
-	ServiceName     string // Service name for OTEL tracing
+	LifecycleConfig config.LifecycleConfig
+	AccessLogConfig config.AccessLogConfig
+	UploadEvents    config.UploadEventsConfig
+	OCRConfig       config.OCRConfig
+	WebhookConfig   config.WebhookConfig
+	OCRClient       client.OCRClient   // nil이면 업로드 시 OCR 대기열 등록 생략
+	Events          *messaging.Emitter // nil이면 파일 도메인 이벤트 발행 생략
+	ServiceName     string             // Service name for OTEL tracing
+	InternalAPIKey  string             // 워크스페이스 백업 내부 라우트 키 (비어 있으면 라우트 미등록)
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
 }
 
+// maxBackupBodyBytes limits the size of an imported workspace file manifest
+const maxBackupBodyBytes int64 = 256 << 20
+
 // Setup sets up the router with all routes
 func Setup(cfg Config) *gin.Engine {
 	r := gin.New()
@@ -56,8 +79,25 @@ func Setup(cfg Config) *gin.Engine {
 	r.Use(commonmw.Recovery(cfg.Logger))
 	r.Use(commonmw.OTELTracing(serviceName))                   // OpenTelemetry HTTP tracing (otelgin)
 	r.Use(commonmw.LoggerWithTracing(cfg.Logger, serviceName)) // Request logging with trace context
-	r.Use(commonmw.DefaultCORS())
+	r.Use(commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), cfg.Runtime))
 	r.Use(metrics.HTTPMiddleware(m))
+	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes)) // Request body size limit (1MB)
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	r.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), cfg.Runtime, cfg.Logger))
+	// Response compression (br/gzip for responses over 1KB)
+	r.Use(commonmw.Compress(commonmw.DefaultCompressConfig()))
+
+	// DB/Redis 메트릭 (요청 trace를 exemplar로 연결)
+	if err := commonotel.EnableGORMMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+	}
+	if err := commonotel.EnableGORMPoolMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable DB pool metrics", zap.Error(err))
+	}
+	commonotel.EnableRedisMetrics(cfg.RedisClient, m.Metrics)
+	if cfg.Replicas != nil {
+		cfg.Replicas.SetMetrics(m.Metrics)
+	}
 
 	// Rate limiting middleware
 	if cfg.RateLimitConfig.Enabled && cfg.RedisClient != nil {
@@ -66,7 +106,8 @@ func Setup(cfg Config) *gin.Engine {
 			WithBurstSize(cfg.RateLimitConfig.BurstSize).
 			WithKeyPrefix("rl:storage:")
 		limiter := ratelimit.NewRedisRateLimiter(cfg.RedisClient, rlConfig, cfg.Logger)
-		r.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, cfg.Logger))
+		ratelimit.WatchSettings(limiter, cfg.Runtime)
+		r.Use(ratelimit.PolicyMiddleware(limiter, ratelimit.NewPolicies(), cfg.Logger))
 		cfg.Logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimitConfig.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimitConfig.BurstSize))
@@ -75,10 +116,15 @@ func Setup(cfg Config) *gin.Engine {
 	}
 
 	// Prometheus metrics endpoint
-	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Health check routes (using common package)
-	healthChecker := commonhealth.NewHealthChecker(cfg.DB, cfg.RedisClient)
+	healthChecker := commonhealth.NewHealthChecker(cfg.DB, cfg.RedisClient).
+		AddDownstream("auth-service", cfg.AuthServiceURL, commonhealth.AuthServiceLivenessPath).
+		AddDownstream("user-service", cfg.UserServiceURL, commonhealth.LivenessPath)
+	if cfg.Replicas != nil {
+		healthChecker.AddCheck("db-replicas", cfg.Replicas.Check, commonhealth.NonCritical())
+	}
 	healthChecker.RegisterRoutes(r, cfg.BasePath)
 
 	// Initialize repositories
@@ -86,23 +132,64 @@ func Setup(cfg Config) *gin.Engine {
 	fileRepo := repository.NewFileRepository(cfg.DB)
 	shareRepo := repository.NewShareRepository(cfg.DB)
 	projectRepo := repository.NewProjectRepository(cfg.DB)
+	settingsRepo := repository.NewWorkspaceSettingsRepository(cfg.DB)
+	webhookRepo := repository.NewWebhookRepository(cfg.DB)
+	accessLogRepo := repository.NewAccessLogRepository(cfg.DB)
+	aclRepo := repository.NewFileACLRepository(cfg.DB)
+	tagRepo := repository.NewTagRepository(cfg.DB)
+	batchRepo := repository.NewBatchRepository(cfg.DB)
+	encryptionKeyRepo := repository.NewEncryptionKeyRepository(cfg.DB)
+	fileTextRepo := repository.NewFileTextRepository(cfg.DB)
 
 	// Initialize services
 	// 각 서비스에 필요한 의존성 주입
 	folderService := service.NewFolderService(folderRepo, fileRepo, cfg.Logger)
-	fileService := service.NewFileService(fileRepo, folderRepo, cfg.S3Client, cfg.Logger, m) // 메트릭 포함
+	webhookService := service.NewWebhookService(webhookRepo, projectRepo, cfg.S3Client, cfg.WebhookConfig.AllowPrivateNetworks, cfg.Logger)
+	privacyService := service.NewPrivacyService(settingsRepo, fileRepo, cfg.S3Client, cfg.Logger)
+	encryptionService := service.NewEncryptionService(encryptionKeyRepo, fileRepo, cfg.S3Client, cfg.Logger)
+	ocrService := service.NewOCRService(fileTextRepo, settingsRepo, fileRepo, cfg.S3Client, cfg.OCRClient, cfg.OCRConfig.BatchSize, cfg.OCRConfig.MaxFileSize, cfg.Logger)
+	// 업로드 후처리: 메타데이터 제거 후 OCR 대기열 등록
+	uploadProcessors := service.UploadProcessors{privacyService, ocrService}
+	// 파일 이벤트: 프로젝트 웹훅 + 플랫폼 이벤트 버스
+	var fileEvents service.FileEventPublisher = webhookService
+	if cfg.Events != nil {
+		fileEvents = service.FileEventPublishers{webhookService, service.NewFileDomainEvents(cfg.Events)}
+	}
+	// 워크스페이스 멤버십은 짧게 캐시하고 접근 검증(AccessService)도 같은 캐시를 사용
+	// (요청이 워크스페이스를 지정하면 다른 워크스페이스의 파일/폴더/프로젝트 접근은 거부됨)
+	userClient := cfg.UserClient
+	var membership *tenancy.CachedChecker
+	if userClient != nil {
+		membership = tenancy.NewCachedChecker(userClient, tenancy.DefaultCacheConfig())
+		userClient = client.WithMembershipCache(userClient, membership)
+	}
+	fileService := service.NewFileService(fileRepo, folderRepo, cfg.S3Client, cfg.Logger, m, fileEvents, uploadProcessors, encryptionService) // 메트릭, 파일 이벤트, 업로드 후처리, KMS 키 포함
 	shareService := service.NewShareService(shareRepo, fileRepo, folderRepo, cfg.Logger)
-	projectService := service.NewProjectService(projectRepo, cfg.UserClient, cfg.Logger)
-	accessService := service.NewAccessService(projectRepo, fileRepo, folderRepo, cfg.UserClient, cfg.Logger)
+	projectService := service.NewProjectService(projectRepo, userClient, cfg.Logger)
+	accessService := service.NewAccessService(projectRepo, fileRepo, folderRepo, aclRepo, userClient, cfg.Logger)
+	lifecycleService := service.NewLifecycleService(settingsRepo, fileRepo, cfg.S3Client, cfg.LifecycleConfig.BatchSize, cfg.Logger)
+	aclService := service.NewFileACLService(aclRepo, fileRepo, projectRepo, cfg.Logger)
+	tagService := service.NewTagService(tagRepo, fileRepo, cfg.Logger)
+	batchService := service.NewBatchService(batchRepo, fileRepo, folderRepo, cfg.Logger, m, fileEvents)
+	accessLogService := service.NewAccessLogService(accessLogRepo, fileRepo, settingsRepo, cfg.AccessLogConfig.RetentionDays, cfg.Logger)
 
 	// Initialize handlers
 	folderHandler := handler.NewFolderHandler(folderService, fileService, accessService)
-	fileHandler := handler.NewFileHandler(fileService, accessService)
-	shareHandler := handler.NewShareHandler(shareService)
+	fileHandler := handler.NewFileHandler(fileService, accessService, accessLogService)
+	shareHandler := handler.NewShareHandler(shareService, accessService, accessLogService)
 	projectHandler := handler.NewProjectHandler(projectService)
+	lifecycleHandler := handler.NewLifecycleHandler(lifecycleService, accessService)
+	webhookHandler := handler.NewWebhookHandler(webhookService, accessService)
+	accessLogHandler := handler.NewAccessLogHandler(accessLogService, accessService)
+	privacyHandler := handler.NewPrivacyHandler(privacyService, accessService)
+	aclHandler := handler.NewFileACLHandler(aclService, accessService)
+	tagHandler := handler.NewTagHandler(tagService, accessService)
+	batchHandler := handler.NewBatchHandler(batchService, accessService)
+	encryptionHandler := handler.NewEncryptionHandler(encryptionService, accessService)
+	ocrHandler := handler.NewOCRHandler(ocrService, accessService)
 
-	// API routes group
-	api := r.Group(cfg.BasePath)
+	// API routes group (/api와 /api/v1 모두 현재 v1 라우트로 처리)
+	api := apiversion.WithLegacy(r, cfg.BasePath, apiversion.V1)
 
 	// Auth middleware - check ISTIO_JWT_MODE first
 	var authMiddleware gin.HandlerFunc
@@ -128,6 +215,13 @@ func Setup(cfg Config) *gin.Engine {
 	// ============================================================
 	storage := api.Group("/storage")
 	storage.Use(authMiddleware)
+	if membership != nil {
+		storage.Use(tenancy.Middleware(membership, cfg.Logger))
+	}
+	// 재시도로 인한 업로드/폴더 중복 생성 방지 (Idempotency-Key 헤더, Redis 없으면 생략)
+	if cfg.RedisClient != nil {
+		storage.Use(idempotency.Middleware(idempotency.NewRedisStore(cfg.RedisClient), idempotency.DefaultConfig(), cfg.Logger))
+	}
 	{
 		// ============================================================
 		// Folder routes
@@ -135,7 +229,8 @@ func Setup(cfg Config) *gin.Engine {
 		folders := storage.Group("/folders")
 		{
 			folders.POST("", folderHandler.CreateFolder)
-			folders.GET("/contents", folderHandler.GetFolderContents)
+			folders.GET("/contents", dbreplica.ReadFromReplica(), folderHandler.GetFolderContents)
+			folders.GET("/tree", folderHandler.GetFolderTree)
 			folders.GET("/:folderId", folderHandler.GetFolder)
 			folders.PUT("/:folderId", folderHandler.UpdateFolder)
 			folders.DELETE("/:folderId", folderHandler.DeleteFolder)
@@ -153,8 +248,10 @@ func Setup(cfg Config) *gin.Engine {
 		{
 			files.POST("/upload-url", fileHandler.GenerateUploadURL)
 			files.POST("/confirm", fileHandler.ConfirmUpload)
+			files.POST("/batch", batchHandler.BatchFiles)
 			files.GET("/:fileId", fileHandler.GetFile)
 			files.GET("/:fileId/download", fileHandler.GetDownloadURL)
+			files.GET("/:fileId/preview", fileHandler.GetPreviewURL)
 			files.PUT("/:fileId", fileHandler.UpdateFile)
 			files.DELETE("/:fileId", fileHandler.DeleteFile)
 			files.POST("/:fileId/restore", fileHandler.RestoreFile)
@@ -162,6 +259,20 @@ func Setup(cfg Config) *gin.Engine {
 
 			// File shares
 			files.GET("/:fileId/shares", shareHandler.GetFileShares)
+
+			// File access audit log
+			files.GET("/:fileId/access-logs", accessLogHandler.GetFileAccessLogs)
+
+			// Per-file ACL
+			files.GET("/:fileId/acl", aclHandler.GetFileACL)
+			files.PUT("/:fileId/acl", aclHandler.SetFileACL)
+
+			// File tags
+			files.POST("/:fileId/tags", tagHandler.TagFile)
+			files.DELETE("/:fileId/tags/:tag", tagHandler.UntagFile)
+
+			// OCR extracted text
+			files.GET("/:fileId/text", ocrHandler.GetFileText)
 		}
 
 		// ============================================================
@@ -190,9 +301,16 @@ func Setup(cfg Config) *gin.Engine {
 
 			// Project members
 			projects.POST("/:projectId/members", projectHandler.AddMember)
-			projects.GET("/:projectId/members", projectHandler.GetMembers)
+			projects.GET("/:projectId/members", dbreplica.ReadFromReplica(), projectHandler.GetMembers)
 			projects.PUT("/:projectId/members/:userId", projectHandler.UpdateMember)
 			projects.DELETE("/:projectId/members/:userId", projectHandler.RemoveMember)
+
+			// Project webhooks (file events)
+			projects.POST("/:projectId/webhooks", webhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", webhookHandler.GetWebhooks)
+			projects.PUT("/:projectId/webhooks/:webhookId", webhookHandler.UpdateWebhook)
+			projects.DELETE("/:projectId/webhooks/:webhookId", webhookHandler.DeleteWebhook)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries", webhookHandler.GetDeliveries)
 		}
 
 		// ============================================================
@@ -203,17 +321,77 @@ func Setup(cfg Config) *gin.Engine {
 			// Projects in workspace
 			workspaces.GET("/:workspaceId/projects", projectHandler.GetWorkspaceProjects)
 
-			workspaces.GET("/:workspaceId/folders", folderHandler.GetWorkspaceFolders)
-			workspaces.GET("/:workspaceId/files", fileHandler.GetWorkspaceFiles)
-			workspaces.GET("/:workspaceId/files/search", fileHandler.SearchFiles)
+			workspaces.GET("/:workspaceId/folders", dbreplica.ReadFromReplica(), folderHandler.GetWorkspaceFolders)
+			workspaces.GET("/:workspaceId/files", dbreplica.ReadFromReplica(), commonmw.ETag(), fileHandler.GetWorkspaceFiles)
+			workspaces.GET("/:workspaceId/files/search", dbreplica.ReadFromReplica(), fileHandler.SearchFiles)
 			workspaces.GET("/:workspaceId/usage", fileHandler.GetStorageUsage)
 
+			// Workspace tags
+			workspaces.GET("/:workspaceId/tags", tagHandler.GetWorkspaceTags)
+			workspaces.POST("/:workspaceId/tags", tagHandler.CreateTag)
+			workspaces.PUT("/:workspaceId/tags/:tagId", tagHandler.UpdateTag)
+			workspaces.DELETE("/:workspaceId/tags/:tagId", tagHandler.DeleteTag)
+
+			// Lifecycle policy (storage class tiering)
+			workspaces.GET("/:workspaceId/lifecycle-policy", lifecycleHandler.GetLifecyclePolicy)
+			workspaces.PUT("/:workspaceId/lifecycle-policy", lifecycleHandler.UpdateLifecyclePolicy)
+
+			// File access audit log
+			workspaces.GET("/:workspaceId/access-logs", accessLogHandler.GetWorkspaceAccessLogs)
+			workspaces.GET("/:workspaceId/access-log-settings", accessLogHandler.GetAccessLogSettings)
+			workspaces.PUT("/:workspaceId/access-log-settings", accessLogHandler.UpdateAccessLogSettings)
+
+			// Privacy settings (image metadata stripping)
+			workspaces.GET("/:workspaceId/privacy-settings", privacyHandler.GetPrivacySettings)
+			workspaces.PUT("/:workspaceId/privacy-settings", privacyHandler.UpdatePrivacySettings)
+
+			// OCR settings (text extraction from images and scanned PDFs)
+			workspaces.GET("/:workspaceId/ocr-settings", ocrHandler.GetOCRSettings)
+			workspaces.PUT("/:workspaceId/ocr-settings", ocrHandler.UpdateOCRSettings)
+
+			// Per-workspace KMS encryption keys
+			workspaces.GET("/:workspaceId/encryption-keys", encryptionHandler.GetEncryptionKeys)
+			workspaces.POST("/:workspaceId/encryption-keys", encryptionHandler.RotateEncryptionKey)
+			workspaces.POST("/:workspaceId/encryption-keys/reencrypt", encryptionHandler.ReencryptFiles)
+			workspaces.POST("/:workspaceId/encryption-keys/:keyId/disable", encryptionHandler.DisableEncryptionKey)
+			workspaces.POST("/:workspaceId/encryption-keys/:keyId/enable", encryptionHandler.EnableEncryptionKey)
+
 			// Trash
 			workspaces.GET("/:workspaceId/trash/folders", folderHandler.GetTrashFolders)
 			workspaces.GET("/:workspaceId/trash/files", fileHandler.GetTrashFiles)
 		}
 	}
 
+	// ============================================================
+	// Internal routes (S3 bucket event notifications)
+	// ============================================================
+	if cfg.UploadEvents.Enabled && cfg.S3Client != nil {
+		uploadEventService := service.NewUploadEventService(fileService, cfg.S3Client.Bucket(), cfg.UploadEvents.SNSTopicARNs, cfg.Logger)
+		uploadEventHandler := handler.NewUploadEventHandler(uploadEventService)
+
+		internal := r.Group(cfg.BasePath + "/internal/storage") // S3 이벤트 수신 경로는 버전 없이 유지
+		internal.Use(middleware.EventAuthMiddleware(cfg.UploadEvents.AuthToken))
+		{
+			internal.POST("/s3-events", uploadEventHandler.HandleS3Event)
+		}
+		cfg.Logger.Info("S3 event-driven upload confirmation enabled")
+	}
+
+	// ============================================================
+	// Internal routes (user-service workspace export/import)
+	// ============================================================
+	if cfg.InternalAPIKey != "" {
+		backupHandler := handler.NewBackupHandler(service.NewBackupService(repository.NewBackupRepository(cfg.DB)))
+
+		backup := r.Group(cfg.BasePath + "/internal/storage/workspaces")
+		backup.Use(middleware.InternalAuthMiddleware(cfg.InternalAPIKey))
+		{
+			backup.GET("/:workspaceId/backup", backupHandler.ExportWorkspace)
+			// 파일 매니페스트는 기본 본문 제한(1MB)보다 클 수 있음
+			backup.POST("/:workspaceId/backup", commonmw.BodyLimit(maxBackupBodyBytes), backupHandler.ImportWorkspace)
+		}
+	}
+
 	// ============================================================
 	// Public routes (no auth required for shared links)
 	// ============================================================
@@ -0,0 +1,113 @@
+// Package seed writes the storage-service part of the development dataset.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"storage-service/internal/domain"
+)
+
+// ObjectUploader uploads seed file contents (implemented by *client.S3Client).
+type ObjectUploader interface {
+	PutObject(ctx context.Context, fileKey, contentType string, data []byte, kmsKeyID string) error
+}
+
+// Run inserts projects, project members, folders and files from data. Existing rows are left untouched.
+// When uploader is non-nil the file contents are uploaded too, so downloads and previews work.
+func Run(ctx context.Context, db *gorm.DB, uploader ObjectUploader, data *commonseed.Dataset) error {
+	now := time.Now().UTC()
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range data.Projects {
+			description := p.Description
+			if err := commonseed.Insert(tx, &domain.Project{
+				ID:                p.ID,
+				WorkspaceID:       p.WorkspaceID,
+				Name:              p.Name,
+				Description:       &description,
+				DefaultPermission: domain.ProjectPermissionViewer,
+				CreatedBy:         p.OwnerID,
+				CreatedAt:         now,
+				UpdatedAt:         now,
+			}); err != nil {
+				return err
+			}
+
+			for _, m := range p.Members {
+				permission := domain.ProjectPermissionEditor
+				if m.Role == commonseed.RoleOwner {
+					permission = domain.ProjectPermissionOwner
+				}
+				if err := commonseed.Insert(tx, &domain.ProjectMember{
+					ID:         commonseed.ID("storage-project-member", p.ID.String()+"/"+m.UserID.String()),
+					ProjectID:  p.ID,
+					UserID:     m.UserID,
+					Permission: permission,
+					AddedBy:    p.OwnerID,
+					CreatedAt:  now,
+					UpdatedAt:  now,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, f := range data.Folders {
+			if err := commonseed.Insert(tx, &domain.Folder{
+				ID:          f.ID,
+				WorkspaceID: f.WorkspaceID,
+				ProjectID:   f.ProjectID,
+				ParentID:    f.ParentID,
+				Name:        f.Name,
+				Path:        f.Path,
+				CreatedBy:   f.CreatedBy,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}); err != nil {
+				return err
+			}
+		}
+
+		for _, f := range data.Files {
+			if err := commonseed.Insert(tx, &domain.File{
+				ID:           f.ID,
+				WorkspaceID:  f.WorkspaceID,
+				ProjectID:    f.ProjectID,
+				FolderID:     f.FolderID,
+				Name:         f.Name,
+				OriginalName: f.Name,
+				FileKey:      FileKey(f),
+				FileSize:     int64(len(f.Content)),
+				ContentType:  f.ContentType,
+				Status:       domain.FileStatusActive,
+				Version:      1,
+				UploadedBy:   f.UploadedBy,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil || uploader == nil {
+		return err
+	}
+
+	for _, f := range data.Files {
+		if err := uploader.PutObject(ctx, FileKey(f), f.ContentType, f.Content, ""); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// FileKey returns the S3 key of a seed file, in the same layout as uploaded files.
+func FileKey(f commonseed.File) string {
+	return fmt.Sprintf("storage/%s/%s/%s", f.WorkspaceID, f.ID, f.Name)
+}
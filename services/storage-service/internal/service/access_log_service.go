@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// defaultAccessLogRetentionDays is used when no retention is configured
+const defaultAccessLogRetentionDays = 90
+
+// FileAccessContext describes who accessed a file and from where
+type FileAccessContext struct {
+	UserID    *uuid.UUID // nil for anonymous share link access
+	ShareID   *uuid.UUID
+	IPAddress string
+	UserAgent string
+}
+
+// AccessLogService handles the file access audit trail
+// 다운로드/미리보기/공유 이력을 기록하고 보안 검토용으로 조회합니다.
+type AccessLogService struct {
+	accessLogRepo        *repository.AccessLogRepository
+	fileRepo             *repository.FileRepository
+	settingsRepo         *repository.WorkspaceSettingsRepository
+	defaultRetentionDays int
+	logger               *zap.Logger
+}
+
+// NewAccessLogService creates a new AccessLogService
+func NewAccessLogService(
+	accessLogRepo *repository.AccessLogRepository,
+	fileRepo *repository.FileRepository,
+	settingsRepo *repository.WorkspaceSettingsRepository,
+	defaultRetentionDays int,
+	logger *zap.Logger,
+) *AccessLogService {
+	if defaultRetentionDays <= 0 {
+		defaultRetentionDays = defaultAccessLogRetentionDays
+	}
+	return &AccessLogService{
+		accessLogRepo:        accessLogRepo,
+		fileRepo:             fileRepo,
+		settingsRepo:         settingsRepo,
+		defaultRetentionDays: defaultRetentionDays,
+		logger:               logger,
+	}
+}
+
+// RecordFileAccess records an access to a file
+// 감사 로그 기록 실패는 요청을 실패시키지 않고 로그로만 남깁니다.
+func (s *AccessLogService) RecordFileAccess(ctx context.Context, fileID uuid.UUID, action domain.FileAccessAction, accessCtx FileAccessContext) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		s.logger.Warn("Failed to load file for access log",
+			zap.String("fileId", fileID.String()),
+			zap.String("action", string(action)),
+			zap.Error(err),
+		)
+		return
+	}
+
+	entry := &domain.FileAccessLog{
+		ID:          uuid.New(),
+		WorkspaceID: file.WorkspaceID,
+		ProjectID:   file.ProjectID,
+		FileID:      file.ID,
+		FileName:    file.Name,
+		UserID:      accessCtx.UserID,
+		Action:      action,
+		ShareID:     accessCtx.ShareID,
+		IPAddress:   truncate(accessCtx.IPAddress, 64),
+		UserAgent:   truncate(accessCtx.UserAgent, 512),
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.accessLogRepo.Create(ctx, entry); err != nil {
+		s.logger.Error("Failed to record file access",
+			zap.String("fileId", fileID.String()),
+			zap.String("action", string(action)),
+			zap.Error(err),
+		)
+	}
+}
+
+// GetFileAccessLogs gets the access log of a single file
+func (s *AccessLogService) GetFileAccessLogs(ctx context.Context, fileID uuid.UUID, filter domain.AccessLogFilter, page, pageSize int) (*domain.AccessLogListResponse, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	filter.FileID = &file.ID
+	return s.GetWorkspaceAccessLogs(ctx, file.WorkspaceID, filter, page, pageSize)
+}
+
+// GetWorkspaceAccessLogs gets the access log of a workspace
+func (s *AccessLogService) GetWorkspaceAccessLogs(ctx context.Context, workspaceID uuid.UUID, filter domain.AccessLogFilter, page, pageSize int) (*domain.AccessLogListResponse, error) {
+	if filter.Action != nil && !filter.Action.IsValid() {
+		return nil, response.NewValidationError("invalid access action", string(*filter.Action))
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	logs, total, err := s.accessLogRepo.FindByWorkspaceID(ctx, workspaceID, filter, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access logs: %w", err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &domain.AccessLogListResponse{
+		Logs:       logs,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetSettings gets the access log settings of a workspace
+func (s *AccessLogService) GetSettings(ctx context.Context, workspaceID uuid.UUID) (*domain.AccessLogSettingsResponse, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+	return s.toSettingsResponse(settings), nil
+}
+
+// UpdateSettings updates the access log retention of a workspace
+func (s *AccessLogService) UpdateSettings(ctx context.Context, workspaceID uuid.UUID, req domain.UpdateAccessLogSettingsRequest, userID uuid.UUID) (*domain.AccessLogSettingsResponse, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	retention := req.RetentionDays
+	now := time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+	settings.AccessLogRetentionDays = &retention
+	settings.UpdatedAt = now
+	settings.UpdatedBy = &userID
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save workspace settings: %w", err)
+	}
+
+	s.logger.Info("Access log retention updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Int("retentionDays", retention),
+		zap.String("userId", userID.String()),
+	)
+
+	return s.toSettingsResponse(settings), nil
+}
+
+// PurgeExpired deletes access logs past their workspace retention period
+func (s *AccessLogService) PurgeExpired(ctx context.Context) (int64, error) {
+	deleted, err := s.accessLogRepo.DeleteExpired(ctx, s.defaultRetentionDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge access logs: %w", err)
+	}
+	return deleted, nil
+}
+
+// toSettingsResponse converts workspace settings to AccessLogSettingsResponse
+func (s *AccessLogService) toSettingsResponse(settings *domain.WorkspaceSettings) *domain.AccessLogSettingsResponse {
+	resp := &domain.AccessLogSettingsResponse{
+		WorkspaceID:   settings.WorkspaceID,
+		RetentionDays: s.defaultRetentionDays,
+		IsDefault:     true,
+	}
+	if settings.AccessLogRetentionDays != nil {
+		resp.RetentionDays = *settings.AccessLogRetentionDays
+		resp.IsDefault = false
+	}
+	return resp
+}
+
+// truncate limits a string to max bytes
+func truncate(s string, max int) string {
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
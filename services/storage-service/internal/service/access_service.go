@@ -16,13 +16,20 @@ import (
 type AccessService interface {
 	// Workspace level
 	ValidateWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) error
+	// Workspace settings - owner or admin only
+	ValidateWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) error
+	IsWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
 
 	// Project level
 	ValidateProjectAccess(ctx context.Context, projectID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error
 	GetProjectPermission(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectPermission, error)
 
-	// File level - checks project permission if file belongs to a project
+	// File level - checks project permission if file belongs to a project, then the per-file ACL
 	ValidateFileAccess(ctx context.Context, fileID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error
+	ValidateFileRecordAccess(ctx context.Context, file *domain.File, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error
+
+	// File ACL management - uploader or project owner only
+	ValidateFileACLManage(ctx context.Context, fileID, userID uuid.UUID, token string) error
 
 	// Folder level - checks project permission if folder belongs to a project
 	ValidateFolderAccess(ctx context.Context, folderID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error
@@ -35,6 +42,7 @@ type accessService struct {
 	projectRepo repository.ProjectRepository
 	fileRepo    *repository.FileRepository
 	folderRepo  *repository.FolderRepository
+	aclRepo     *repository.FileACLRepository
 	userClient  client.UserClient
 	logger      *zap.Logger
 }
@@ -44,6 +52,7 @@ func NewAccessService(
 	projectRepo repository.ProjectRepository,
 	fileRepo *repository.FileRepository,
 	folderRepo *repository.FolderRepository,
+	aclRepo *repository.FileACLRepository,
 	userClient client.UserClient,
 	logger *zap.Logger,
 ) AccessService {
@@ -51,6 +60,7 @@ func NewAccessService(
 		projectRepo: projectRepo,
 		fileRepo:    fileRepo,
 		folderRepo:  folderRepo,
+		aclRepo:     aclRepo,
 		userClient:  userClient,
 		logger:      logger,
 	}
@@ -80,6 +90,40 @@ func (s *accessService) ValidateWorkspaceAccess(ctx context.Context, workspaceID
 	return nil
 }
 
+// ValidateWorkspaceAdmin validates that a user is an owner or admin of a workspace
+func (s *accessService) ValidateWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) error {
+	isAdmin, err := s.IsWorkspaceAdmin(ctx, workspaceID, userID, token)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return response.NewForbiddenError("workspace admin permission required", "")
+	}
+	return nil
+}
+
+// IsWorkspaceAdmin reports whether a workspace member is an owner or admin (non-members get ErrNotWorkspaceMember)
+func (s *accessService) IsWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	if s.userClient == nil {
+		s.logger.Warn("User client not configured, skipping workspace role validation")
+		return true, nil
+	}
+
+	role, err := s.userClient.GetWorkspaceRole(ctx, workspaceID, userID, token)
+	if err != nil {
+		s.logger.Error("Failed to verify workspace role",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return false, response.NewInternalError("failed to verify workspace role", "")
+	}
+	if role == "" {
+		return false, response.ErrNotWorkspaceMember
+	}
+	return role == client.WorkspaceRoleOwner || role == client.WorkspaceRoleAdmin, nil
+}
+
 // ValidateProjectAccess validates that a user has the required permission for a project
 func (s *accessService) ValidateProjectAccess(ctx context.Context, projectID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error {
 	project, err := s.projectRepo.GetByID(ctx, projectID)
@@ -129,6 +173,11 @@ func (s *accessService) ValidateFileAccess(ctx context.Context, fileID, userID u
 		return err
 	}
 
+	return s.ValidateFileRecordAccess(ctx, file, userID, token, requiredPermission)
+}
+
+// ValidateFileRecordAccess validates access to an already loaded file, including trashed files
+func (s *accessService) ValidateFileRecordAccess(ctx context.Context, file *domain.File, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error {
 	// Validate workspace membership
 	if err := s.ValidateWorkspaceAccess(ctx, file.WorkspaceID, userID, token); err != nil {
 		return err
@@ -136,12 +185,83 @@ func (s *accessService) ValidateFileAccess(ctx context.Context, fileID, userID u
 
 	// If file belongs to a project, validate project permission
 	if file.ProjectID != nil {
-		return s.ValidateProjectAccess(ctx, *file.ProjectID, userID, token, requiredPermission)
+		if err := s.ValidateProjectAccess(ctx, *file.ProjectID, userID, token, requiredPermission); err != nil {
+			return err
+		}
 	}
 
-	// File is at workspace level - workspace membership is sufficient for viewing
-	// For editing, we could add additional checks here if needed
-	return nil
+	// File is at workspace level - workspace membership is sufficient unless the file has an ACL
+	return s.checkFileACL(ctx, file, userID, requiredPermission)
+}
+
+// ValidateFileACLManage validates that a user can manage the ACL of a file
+// 업로더 또는 프로젝트 OWNER만 ACL을 변경할 수 있습니다.
+func (s *accessService) ValidateFileACLManage(ctx context.Context, fileID, userID uuid.UUID, token string) error {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ValidateWorkspaceAccess(ctx, file.WorkspaceID, userID, token); err != nil {
+		return err
+	}
+
+	if s.bypassesFileACL(ctx, file, userID) {
+		return nil
+	}
+	return response.ErrInsufficientPermission
+}
+
+// checkFileACL enforces the per-file ACL on top of project permissions
+// ACL 항목이 없으면 제한 없음, 있으면 요구 권한을 부여하는 항목이 있어야 합니다.
+func (s *accessService) checkFileACL(ctx context.Context, file *domain.File, userID uuid.UUID, requiredPermission domain.ProjectPermission) error {
+	if s.aclRepo == nil {
+		return nil
+	}
+
+	entries, err := s.aclRepo.FindByFileID(ctx, file.ID)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 || s.bypassesFileACL(ctx, file, userID) {
+		return nil
+	}
+
+	for _, entry := range entries {
+		if !entry.Permission.Allows(requiredPermission) {
+			continue
+		}
+		switch entry.PrincipalType {
+		case domain.ACLPrincipalUser:
+			if entry.PrincipalID == userID {
+				return nil
+			}
+		case domain.ACLPrincipalProject:
+			if perm, err := s.projectRepo.GetUserPermission(ctx, entry.PrincipalID, userID); err == nil && perm.CanView() {
+				return nil
+			}
+		}
+	}
+
+	s.logger.Debug("File access denied by ACL",
+		zap.String("file_id", file.ID.String()),
+		zap.String("user_id", userID.String()),
+		zap.String("required_permission", string(requiredPermission)),
+	)
+	return response.ErrInsufficientPermission
+}
+
+// bypassesFileACL returns true for the uploader and project owners, who are never locked out by an ACL
+func (s *accessService) bypassesFileACL(ctx context.Context, file *domain.File, userID uuid.UUID) bool {
+	if file.UploadedBy == userID {
+		return true
+	}
+	if file.ProjectID != nil {
+		if perm, err := s.projectRepo.GetUserPermission(ctx, *file.ProjectID, userID); err == nil && perm.CanManage() {
+			return true
+		}
+	}
+	return false
 }
 
 // ValidateFolderAccess validates access to a folder
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// BackupService exports and imports the storage section of a workspace archive.
+// It is called by user-service, which bundles every service's section into the archive.
+type BackupService struct {
+	backupRepo *repository.BackupRepository
+}
+
+// NewBackupService creates a new BackupService
+func NewBackupService(backupRepo *repository.BackupRepository) *BackupService {
+	return &BackupService{backupRepo: backupRepo}
+}
+
+// Export returns the folder/file manifest and tags of a workspace
+func (s *BackupService) Export(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceBackup, error) {
+	backup, err := s.backupRepo.LoadWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to load workspace files", err.Error())
+	}
+	backup.FormatVersion = domain.BackupFormatVersion
+	return backup, nil
+}
+
+// Import restores an exported manifest into workspaceID. Rows that already exist are kept as is,
+// so importing the same archive twice (e.g. a retried job) does not duplicate data.
+func (s *BackupService) Import(ctx context.Context, workspaceID uuid.UUID, backup *domain.WorkspaceBackup) (*domain.BackupImportResult, error) {
+	if backup.FormatVersion != domain.BackupFormatVersion {
+		return nil, response.NewValidationError(fmt.Sprintf("Unsupported backup format version: %d", backup.FormatVersion), "")
+	}
+	if backup.WorkspaceID != workspaceID {
+		return nil, response.NewValidationError("Backup belongs to a different workspace", "")
+	}
+	if err := validateBackup(backup); err != nil {
+		return nil, err
+	}
+
+	result, err := s.backupRepo.RestoreWorkspace(ctx, backup)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to restore workspace files", err.Error())
+	}
+	return result, nil
+}
+
+// validateBackup rejects rows outside the workspace or referencing a folder, file or tag
+// that is not part of the manifest (the archive would not restore cleanly)
+func validateBackup(backup *domain.WorkspaceBackup) error {
+	invalid := func(kind string, id interface{}) error {
+		return response.NewValidationError(fmt.Sprintf("Backup %s %v is outside the workspace or references a missing parent", kind, id), "")
+	}
+
+	folders := make(map[uuid.UUID]bool, len(backup.Folders))
+	for _, folder := range backup.Folders {
+		folders[folder.ID] = true
+	}
+	for _, folder := range backup.Folders {
+		if folder.WorkspaceID != backup.WorkspaceID || (folder.ParentID != nil && !folders[*folder.ParentID]) {
+			return invalid("folder", folder.ID)
+		}
+	}
+
+	files := make(map[uuid.UUID]bool, len(backup.Files))
+	for _, file := range backup.Files {
+		if file.WorkspaceID != backup.WorkspaceID || (file.FolderID != nil && !folders[*file.FolderID]) {
+			return invalid("file", file.ID)
+		}
+		files[file.ID] = true
+	}
+
+	tags := make(map[uuid.UUID]bool, len(backup.Tags))
+	for _, tag := range backup.Tags {
+		if tag.WorkspaceID != backup.WorkspaceID {
+			return invalid("tag", tag.ID)
+		}
+		tags[tag.ID] = true
+	}
+	for _, fileTag := range backup.FileTags {
+		if !files[fileTag.FileID] || !tags[fileTag.TagID] {
+			return invalid("file tag", fileTag.FileID)
+		}
+	}
+	return nil
+}
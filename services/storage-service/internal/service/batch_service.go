@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/metrics"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// BatchAuthorizer validates that the caller may modify a file in a batch
+type BatchAuthorizer func(ctx context.Context, file *domain.File) error
+
+// BatchService applies one operation to many files in a single request
+// 파일별 검증 결과를 반환하고, 통과한 파일은 하나의 트랜잭션으로 처리합니다.
+type BatchService struct {
+	batchRepo  *repository.BatchRepository
+	fileRepo   *repository.FileRepository
+	folderRepo *repository.FolderRepository
+	logger     *zap.Logger
+	metrics    *metrics.Metrics
+	events     FileEventPublisher
+}
+
+// NewBatchService creates a new BatchService
+func NewBatchService(
+	batchRepo *repository.BatchRepository,
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+	events FileEventPublisher,
+) *BatchService {
+	return &BatchService{
+		batchRepo:  batchRepo,
+		fileRepo:   fileRepo,
+		folderRepo: folderRepo,
+		logger:     logger,
+		metrics:    m,
+		events:     events,
+	}
+}
+
+// batchPlan holds the validated state of a batch request
+type batchPlan struct {
+	results  []domain.BatchItemResult
+	accepted []*domain.File
+	folderID *uuid.UUID
+	tags     []string
+}
+
+// reject records a per-item failure
+func (p *batchPlan) reject(fileID uuid.UUID, reason string) {
+	p.results = append(p.results, domain.BatchItemResult{FileID: fileID, Error: reason})
+}
+
+// accept records a file that passed validation
+func (p *batchPlan) accept(file *domain.File) {
+	p.results = append(p.results, domain.BatchItemResult{FileID: file.ID, Success: true})
+	p.accepted = append(p.accepted, file)
+}
+
+// ExecuteBatch validates each file and applies the operation to all valid files
+func (s *BatchService) ExecuteBatch(ctx context.Context, req domain.BatchFileRequest, userID uuid.UUID, authorize BatchAuthorizer) (*domain.BatchFileResponse, error) {
+	if !req.Operation.IsValid() {
+		return nil, response.NewValidationError("invalid batch operation", string(req.Operation))
+	}
+	if len(req.FileIDs) > domain.MaxBatchFiles {
+		return nil, response.NewValidationError(fmt.Sprintf("too many files (max %d)", domain.MaxBatchFiles), "")
+	}
+
+	plan := &batchPlan{}
+	if err := s.prepareTarget(ctx, req, plan); err != nil {
+		return nil, err
+	}
+
+	fileIDs := uniqueIDs(req.FileIDs)
+	files, err := s.fileRepo.FindByIDsWithDeleted(ctx, fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files: %w", err)
+	}
+	byID := make(map[uuid.UUID]*domain.File, len(files))
+	for i := range files {
+		byID[files[i].ID] = &files[i]
+	}
+
+	movedNames := make(map[string]bool)
+	for _, fileID := range fileIDs {
+		file, ok := byID[fileID]
+		if !ok || file.WorkspaceID != req.WorkspaceID {
+			plan.reject(fileID, "file not found")
+			continue
+		}
+		if reason := s.checkItem(ctx, req.Operation, file, plan, movedNames); reason != "" {
+			plan.reject(fileID, reason)
+			continue
+		}
+		if authorize != nil {
+			if err := authorize(ctx, file); err != nil {
+				plan.reject(fileID, "access denied")
+				continue
+			}
+		}
+		plan.accept(file)
+	}
+
+	batchID := uuid.New()
+	if err := s.apply(ctx, batchID, req, userID, plan); err != nil {
+		return nil, err
+	}
+
+	s.afterCommit(ctx, req.Operation, plan, userID)
+
+	succeeded := len(plan.accepted)
+	failed := len(plan.results) - succeeded
+
+	s.logger.Info("Batch file operation completed",
+		zap.String("batchId", batchID.String()),
+		zap.String("workspaceId", req.WorkspaceID.String()),
+		zap.String("operation", string(req.Operation)),
+		zap.Int("succeeded", succeeded),
+		zap.Int("failed", failed),
+		zap.String("userId", userID.String()),
+	)
+
+	return &domain.BatchFileResponse{
+		BatchID:   batchID,
+		Operation: req.Operation,
+		Succeeded: succeeded,
+		Failed:    failed,
+		Results:   plan.results,
+	}, nil
+}
+
+// prepareTarget validates the operation-wide parameters (destination folder, tags)
+func (s *BatchService) prepareTarget(ctx context.Context, req domain.BatchFileRequest, plan *batchPlan) error {
+	switch req.Operation {
+	case domain.BatchOperationMove:
+		if req.FolderID == nil {
+			return response.NewValidationError("folderId is required for MOVE", "")
+		}
+		if *req.FolderID == uuid.Nil {
+			return nil
+		}
+		folder, err := s.folderRepo.FindByID(ctx, *req.FolderID)
+		if err != nil || folder.WorkspaceID != req.WorkspaceID {
+			return response.NewNotFoundError("destination folder not found", req.FolderID.String())
+		}
+		plan.folderID = &folder.ID
+
+	case domain.BatchOperationTag:
+		if len(req.Tags) == 0 {
+			return response.NewValidationError("tags are required for TAG", "")
+		}
+		seen := make(map[string]bool, len(req.Tags))
+		for _, raw := range req.Tags {
+			name, err := NormalizeTagName(raw)
+			if err != nil {
+				return err
+			}
+			if !seen[name] {
+				seen[name] = true
+				plan.tags = append(plan.tags, name)
+			}
+		}
+	}
+	return nil
+}
+
+// checkItem returns why a file cannot take part in the operation, or "" if it can
+func (s *BatchService) checkItem(ctx context.Context, op domain.BatchOperation, file *domain.File, plan *batchPlan, movedNames map[string]bool) string {
+	switch op {
+	case domain.BatchOperationRestore:
+		if !file.IsDeleted() {
+			return "file is not deleted"
+		}
+		return ""
+	}
+
+	if file.IsDeleted() {
+		return "file not found"
+	}
+
+	switch op {
+	case domain.BatchOperationMove:
+		if sameFolder(file.FolderID, plan.folderID) {
+			return ""
+		}
+		// 대상 폴더 및 같은 배치 내 이름 중복 확인
+		if movedNames[file.Name] {
+			return "file with this name already exists"
+		}
+		exists, err := s.fileRepo.ExistsByNameInFolder(ctx, file.WorkspaceID, plan.folderID, file.Name)
+		if err != nil {
+			return "failed to check duplicate name"
+		}
+		if exists {
+			return "file with this name already exists"
+		}
+		movedNames[file.Name] = true
+
+	case domain.BatchOperationTag:
+		count := len(file.Tags)
+		for _, name := range plan.tags {
+			if !hasTag(file, name) {
+				count++
+			}
+		}
+		if count > maxTagsPerFile {
+			return fmt.Sprintf("too many tags on file (max %d)", maxTagsPerFile)
+		}
+	}
+	return ""
+}
+
+// apply stores the changes and the aggregated audit entry in one transaction
+func (s *BatchService) apply(ctx context.Context, batchID uuid.UUID, req domain.BatchFileRequest, userID uuid.UUID, plan *batchPlan) error {
+	ids := make([]uuid.UUID, 0, len(plan.accepted))
+	for _, file := range plan.accepted {
+		ids = append(ids, file.ID)
+	}
+
+	results, err := json.Marshal(plan.results)
+	if err != nil {
+		return fmt.Errorf("failed to encode batch results: %w", err)
+	}
+
+	audit := &domain.BatchOperationLog{
+		ID:          batchID,
+		WorkspaceID: req.WorkspaceID,
+		UserID:      userID,
+		Operation:   req.Operation,
+		Succeeded:   len(ids),
+		Failed:      len(plan.results) - len(ids),
+		Results:     string(results),
+		CreatedAt:   time.Now(),
+	}
+
+	switch req.Operation {
+	case domain.BatchOperationDelete:
+		err = s.batchRepo.SoftDelete(ctx, ids, audit)
+	case domain.BatchOperationRestore:
+		err = s.batchRepo.Restore(ctx, ids, audit)
+	case domain.BatchOperationMove:
+		err = s.batchRepo.Move(ctx, ids, plan.folderID, audit)
+	case domain.BatchOperationTag:
+		err = s.batchRepo.Tag(ctx, req.WorkspaceID, ids, plan.tags, audit)
+	default:
+		err = errors.New("unsupported batch operation")
+	}
+	if err != nil {
+		s.logger.Error("Batch file operation failed",
+			zap.String("batchId", batchID.String()),
+			zap.String("operation", string(req.Operation)),
+			zap.Int("files", len(ids)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to apply batch operation: %w", err)
+	}
+	return nil
+}
+
+// afterCommit records metrics and publishes file events for applied files
+func (s *BatchService) afterCommit(ctx context.Context, op domain.BatchOperation, plan *batchPlan, userID uuid.UUID) {
+	now := time.Now()
+	for _, file := range plan.accepted {
+		var event domain.FileEventType
+		switch op {
+		case domain.BatchOperationDelete:
+			file.DeletedAt = &now
+			file.Status = domain.FileStatusDeleted
+			event = domain.FileEventDeleted
+			if s.metrics != nil {
+				s.metrics.RecordFileDelete()
+			}
+		case domain.BatchOperationRestore:
+			file.DeletedAt = nil
+			file.Status = domain.FileStatusActive
+			event = domain.FileEventRestored
+		case domain.BatchOperationMove:
+			file.FolderID = plan.folderID
+			file.UpdatedAt = now
+			event = domain.FileEventUpdated
+		case domain.BatchOperationTag:
+			event = domain.FileEventUpdated
+		}
+		if s.events != nil {
+			s.events.PublishFileEvent(ctx, event, file, userID)
+		}
+	}
+}
+
+// sameFolder returns true if both folder IDs point to the same folder (nil is root)
+func sameFolder(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// uniqueIDs removes duplicate IDs while keeping order
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
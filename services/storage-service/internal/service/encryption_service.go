@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// defaultReencryptBatchSize is the number of files re-encrypted per request
+const defaultReencryptBatchSize = 50
+
+// EncryptionKeyProvider resolves workspace KMS keys for uploads and downloads
+type EncryptionKeyProvider interface {
+	// UploadKeyID returns the KMS key for new uploads, or "" for bucket default encryption
+	UploadKeyID(ctx context.Context, workspaceID uuid.UUID) (string, error)
+	// CheckDownloadKey denies access to files encrypted with a disabled key
+	CheckDownloadKey(ctx context.Context, file *domain.File) error
+}
+
+// EncryptionService manages per-workspace SSE-KMS keys
+// 워크스페이스별 KMS 키로 업로드를 암호화하고, 비활성화된 키의 파일 다운로드를 차단합니다.
+type EncryptionService struct {
+	keyRepo   *repository.EncryptionKeyRepository
+	fileRepo  *repository.FileRepository
+	s3Client  *client.S3Client
+	batchSize int
+	logger    *zap.Logger
+}
+
+// NewEncryptionService creates a new EncryptionService
+func NewEncryptionService(
+	keyRepo *repository.EncryptionKeyRepository,
+	fileRepo *repository.FileRepository,
+	s3Client *client.S3Client,
+	logger *zap.Logger,
+) *EncryptionService {
+	return &EncryptionService{
+		keyRepo:   keyRepo,
+		fileRepo:  fileRepo,
+		s3Client:  s3Client,
+		batchSize: defaultReencryptBatchSize,
+		logger:    logger,
+	}
+}
+
+// GetKeys gets all KMS keys of a workspace
+func (s *EncryptionService) GetKeys(ctx context.Context, workspaceID uuid.UUID) ([]domain.WorkspaceEncryptionKey, error) {
+	keys, err := s.keyRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get encryption keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RotateKey sets a new active KMS key for a workspace
+// 이전 활성 키는 RETIRED로 전환되어 기존 파일 복호화에 계속 사용됩니다.
+func (s *EncryptionService) RotateKey(ctx context.Context, workspaceID uuid.UUID, req domain.RotateEncryptionKeyRequest, userID uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	kmsKeyID := strings.TrimSpace(req.KMSKeyID)
+	if kmsKeyID == "" {
+		return nil, response.NewValidationError("KMS key ID is required", "")
+	}
+
+	key, err := s.keyRepo.FindByKMSKeyID(ctx, workspaceID, kmsKeyID)
+	switch {
+	case err == nil:
+		if key.Status == domain.EncryptionKeyActive {
+			return key, nil
+		}
+		if key.Status == domain.EncryptionKeyDisabled {
+			return nil, response.NewConflictError("encryption key is disabled", key.ID.String())
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		key = &domain.WorkspaceEncryptionKey{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			KMSKeyID:    kmsKeyID,
+			CreatedBy:   userID,
+			CreatedAt:   time.Now(),
+		}
+	default:
+		return nil, fmt.Errorf("failed to get encryption key: %w", err)
+	}
+
+	if err := s.keyRepo.Activate(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to activate encryption key: %w", err)
+	}
+
+	s.logger.Info("Workspace encryption key rotated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("keyId", key.ID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	return key, nil
+}
+
+// DisableKey disables a key; files encrypted with it can no longer be downloaded
+// 활성 키를 비활성화하면 새 키로 로테이션할 때까지 업로드도 차단됩니다.
+func (s *EncryptionService) DisableKey(ctx context.Context, workspaceID, keyID, userID uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	key, err := s.findWorkspaceKey(ctx, workspaceID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.Status == domain.EncryptionKeyDisabled {
+		return key, nil
+	}
+
+	now := time.Now()
+	key.Status = domain.EncryptionKeyDisabled
+	key.DisabledAt = &now
+	if err := s.keyRepo.Update(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to disable encryption key: %w", err)
+	}
+
+	s.logger.Warn("Workspace encryption key disabled",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("keyId", key.ID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	return key, nil
+}
+
+// EnableKey re-enables a disabled key
+// 활성 키가 없으면 다시 활성 키가 되고, 그렇지 않으면 RETIRED로 복구됩니다.
+func (s *EncryptionService) EnableKey(ctx context.Context, workspaceID, keyID, userID uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	key, err := s.findWorkspaceKey(ctx, workspaceID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.Status != domain.EncryptionKeyDisabled {
+		return key, nil
+	}
+
+	if _, err := s.keyRepo.FindActive(ctx, workspaceID); errors.Is(err, gorm.ErrRecordNotFound) {
+		if err := s.keyRepo.Activate(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to enable encryption key: %w", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get active encryption key: %w", err)
+	} else {
+		key.Status = domain.EncryptionKeyRetired
+		key.DisabledAt = nil
+		if err := s.keyRepo.Update(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to enable encryption key: %w", err)
+		}
+	}
+
+	s.logger.Info("Workspace encryption key enabled",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("keyId", key.ID.String()),
+		zap.String("status", string(key.Status)),
+		zap.String("userId", userID.String()),
+	)
+
+	return key, nil
+}
+
+// ReencryptFiles re-encrypts a batch of files with the active key
+// 클라이언트는 remaining이 0이 될 때까지 반복 호출합니다.
+func (s *EncryptionService) ReencryptFiles(ctx context.Context, workspaceID, userID uuid.UUID) (*domain.ReencryptFilesResponse, error) {
+	if s.s3Client == nil {
+		return nil, response.NewConflictError("object storage is not configured", "")
+	}
+
+	active, err := s.keyRepo.FindActive(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewConflictError("workspace has no active encryption key", workspaceID.String())
+		}
+		return nil, fmt.Errorf("failed to get active encryption key: %w", err)
+	}
+
+	files, err := s.fileRepo.FindNotEncryptedWith(ctx, workspaceID, active.KMSKeyID, s.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find files to re-encrypt: %w", err)
+	}
+
+	result := &domain.ReencryptFilesResponse{}
+	for _, file := range files {
+		if err := s.s3Client.ReencryptObject(ctx, file.FileKey, string(file.StorageClass), active.KMSKeyID); err != nil {
+			s.logger.Error("Failed to re-encrypt file",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		if err := s.fileRepo.UpdateKMSKeyID(ctx, file.ID, active.KMSKeyID); err != nil {
+			s.logger.Error("Failed to update file encryption key",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		result.Reencrypted++
+	}
+
+	remaining, err := s.fileRepo.CountNotEncryptedWith(ctx, workspaceID, active.KMSKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count files to re-encrypt: %w", err)
+	}
+	result.Remaining = remaining
+
+	s.logger.Info("Workspace files re-encrypted",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("keyId", active.ID.String()),
+		zap.Int("reencrypted", result.Reencrypted),
+		zap.Int("failed", result.Failed),
+		zap.Int64("remaining", remaining),
+		zap.String("userId", userID.String()),
+	)
+
+	return result, nil
+}
+
+// UploadKeyID returns the KMS key for new uploads, or "" for bucket default encryption
+func (s *EncryptionService) UploadKeyID(ctx context.Context, workspaceID uuid.UUID) (string, error) {
+	active, err := s.keyRepo.FindActive(ctx, workspaceID)
+	if err == nil {
+		return active.KMSKeyID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("failed to get active encryption key: %w", err)
+	}
+
+	// 키가 설정된 워크스페이스에서 활성 키가 없으면 암호화 없이 업로드하지 않음
+	count, err := s.keyRepo.CountByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to count encryption keys: %w", err)
+	}
+	if count > 0 {
+		return "", response.NewConflictError("workspace encryption key is disabled", workspaceID.String())
+	}
+	return "", nil
+}
+
+// CheckDownloadKey denies access to files encrypted with a disabled key
+func (s *EncryptionService) CheckDownloadKey(ctx context.Context, file *domain.File) error {
+	if file.KMSKeyID == nil {
+		return nil
+	}
+
+	key, err := s.keyRepo.FindByKMSKeyID(ctx, file.WorkspaceID, *file.KMSKeyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	if key.Status == domain.EncryptionKeyDisabled {
+		return response.NewForbiddenError("file encryption key is disabled", file.ID.String())
+	}
+	return nil
+}
+
+// findWorkspaceKey finds a key that belongs to the workspace
+func (s *EncryptionService) findWorkspaceKey(ctx context.Context, workspaceID, keyID uuid.UUID) (*domain.WorkspaceEncryptionKey, error) {
+	key, err := s.keyRepo.FindByID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("encryption key not found", keyID.String())
+		}
+		return nil, fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	if key.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("encryption key not found", keyID.String())
+	}
+	return key, nil
+}
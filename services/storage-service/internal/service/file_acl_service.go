@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// maxFileACLEntries limits the number of ACL entries per file
+const maxFileACLEntries = 100
+
+// FileACLService handles per-file access lists
+// 민감한 문서를 특정 사용자/팀(프로젝트)으로 제한합니다. 권한 검사는 AccessService에서 수행합니다.
+type FileACLService struct {
+	aclRepo     *repository.FileACLRepository
+	fileRepo    *repository.FileRepository
+	projectRepo repository.ProjectRepository
+	logger      *zap.Logger
+}
+
+// NewFileACLService creates a new FileACLService
+func NewFileACLService(
+	aclRepo *repository.FileACLRepository,
+	fileRepo *repository.FileRepository,
+	projectRepo repository.ProjectRepository,
+	logger *zap.Logger,
+) *FileACLService {
+	return &FileACLService{
+		aclRepo:     aclRepo,
+		fileRepo:    fileRepo,
+		projectRepo: projectRepo,
+		logger:      logger,
+	}
+}
+
+// GetFileACL gets the ACL of a file
+func (s *FileACLService) GetFileACL(ctx context.Context, fileID uuid.UUID) (*domain.FileACLResponse, error) {
+	if _, err := s.findFile(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.aclRepo.FindByFileID(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file ACL: %w", err)
+	}
+
+	return &domain.FileACLResponse{
+		FileID:     fileID,
+		Restricted: len(entries) > 0,
+		Entries:    entries,
+	}, nil
+}
+
+// SetFileACL replaces the ACL of a file
+// 빈 목록을 전달하면 ACL 제한이 해제됩니다.
+func (s *FileACLService) SetFileACL(ctx context.Context, fileID uuid.UUID, req domain.SetFileACLRequest, userID uuid.UUID) (*domain.FileACLResponse, error) {
+	file, err := s.findFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Entries) > maxFileACLEntries {
+		return nil, response.NewValidationError(fmt.Sprintf("too many ACL entries (max %d)", maxFileACLEntries), "")
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(req.Entries))
+	entries := make([]domain.FileACLEntry, 0, len(req.Entries))
+
+	for _, e := range req.Entries {
+		if err := s.validateEntry(ctx, file, e); err != nil {
+			return nil, err
+		}
+
+		key := string(e.PrincipalType) + ":" + e.PrincipalID.String()
+		if seen[key] {
+			return nil, response.NewValidationError("duplicate ACL principal", key)
+		}
+		seen[key] = true
+
+		entries = append(entries, domain.FileACLEntry{
+			ID:            uuid.New(),
+			FileID:        file.ID,
+			PrincipalType: e.PrincipalType,
+			PrincipalID:   e.PrincipalID,
+			Permission:    e.Permission,
+			CreatedBy:     userID,
+			CreatedAt:     now,
+		})
+	}
+
+	if err := s.aclRepo.ReplaceForFile(ctx, file.ID, entries); err != nil {
+		return nil, fmt.Errorf("failed to save file ACL: %w", err)
+	}
+
+	s.logger.Info("File ACL updated",
+		zap.String("fileId", file.ID.String()),
+		zap.Int("entries", len(entries)),
+		zap.String("userId", userID.String()),
+	)
+
+	return &domain.FileACLResponse{
+		FileID:     file.ID,
+		Restricted: len(entries) > 0,
+		Entries:    entries,
+	}, nil
+}
+
+// validateEntry validates a single ACL entry
+// 팀(PROJECT) 항목은 같은 워크스페이스의 프로젝트여야 합니다.
+func (s *FileACLService) validateEntry(ctx context.Context, file *domain.File, e domain.FileACLEntryRequest) error {
+	if !e.PrincipalType.IsValid() {
+		return response.NewValidationError("invalid principal type", string(e.PrincipalType))
+	}
+	if !e.Permission.IsValid() {
+		return response.NewValidationError("invalid ACL permission", string(e.Permission))
+	}
+	if e.PrincipalID == uuid.Nil {
+		return response.NewValidationError("principal ID is required", "")
+	}
+
+	if e.PrincipalType == domain.ACLPrincipalProject {
+		project, err := s.projectRepo.GetByID(ctx, e.PrincipalID)
+		if err != nil {
+			return response.NewNotFoundError("project not found", e.PrincipalID.String())
+		}
+		if project.WorkspaceID != file.WorkspaceID {
+			return response.NewValidationError("project belongs to a different workspace", e.PrincipalID.String())
+		}
+	}
+
+	return nil
+}
+
+// findFile finds a file by ID
+func (s *FileACLService) findFile(ctx context.Context, fileID uuid.UUID) (*domain.File, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	return file, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"storage-service/internal/domain"
+)
+
+// FileEventPublishers publishes a file event to several publishers in order
+type FileEventPublishers []FileEventPublisher
+
+// PublishFileEvent publishes the event to every publisher
+func (p FileEventPublishers) PublishFileEvent(ctx context.Context, event domain.FileEventType, file *domain.File, actorID uuid.UUID) {
+	for _, publisher := range p {
+		publisher.PublishFileEvent(ctx, event, file, actorID)
+	}
+}
+
+// fileDomainEventTypes maps file lifecycle events to platform domain event types
+var fileDomainEventTypes = map[domain.FileEventType]string{
+	domain.FileEventUploaded: messaging.EventFileUploaded,
+	domain.FileEventUpdated:  messaging.EventFileUpdated,
+	domain.FileEventDeleted:  messaging.EventFileDeleted,
+	domain.FileEventRestored: messaging.EventFileRestored,
+}
+
+// FileDomainEvents publishes file lifecycle events to the platform event bus
+// 웹훅과 달리 프로젝트에 속하지 않은 워크스페이스 파일 이벤트도 발행합니다.
+type FileDomainEvents struct {
+	emitter *messaging.Emitter
+}
+
+// NewFileDomainEvents creates a new FileDomainEvents
+func NewFileDomainEvents(emitter *messaging.Emitter) *FileDomainEvents {
+	return &FileDomainEvents{emitter: emitter}
+}
+
+// PublishFileEvent implements FileEventPublisher
+// 발행 실패는 Emitter가 로그로 남기며 요청 결과에는 영향을 주지 않습니다.
+func (e *FileDomainEvents) PublishFileEvent(ctx context.Context, event domain.FileEventType, file *domain.File, actorID uuid.UUID) {
+	eventType, ok := fileDomainEventTypes[event]
+	if !ok {
+		return
+	}
+
+	data := messaging.FileEventData{
+		FileID:      file.ID.String(),
+		Name:        file.Name,
+		ContentType: file.ContentType,
+		Size:        file.FileSize,
+	}
+	if file.ProjectID != nil {
+		data.ProjectID = file.ProjectID.String()
+	}
+	if file.FolderID != nil {
+		data.FolderID = file.FolderID.String()
+	}
+	_ = e.emitter.Emit(ctx, eventType, file.WorkspaceID.String(), actorID.String(), data)
+}
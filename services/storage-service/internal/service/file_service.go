@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"path/filepath"
 	"strings"
 	"time"
@@ -31,6 +32,9 @@ var (
 // MaxFileSize is the maximum allowed file size (100MB)
 const MaxFileSize = 100 * 1024 * 1024
 
+// archiveRestoreDays is how long a restored copy of an archived file stays available
+const archiveRestoreDays = 7
+
 // FileService handles file business logic
 // 파일 업로드, 다운로드, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
@@ -39,7 +43,10 @@ type FileService struct {
 	folderRepo *repository.FolderRepository
 	s3Client   *client.S3Client
 	logger     *zap.Logger
-	metrics    *metrics.Metrics // 메트릭 수집을 위한 필드
+	metrics    *metrics.Metrics      // 메트릭 수집을 위한 필드
+	events     FileEventPublisher    // 파일 이벤트 발행 (nil이면 발행하지 않음)
+	processor  UploadProcessor       // 업로드 확정 후처리 (nil이면 생략)
+	keys       EncryptionKeyProvider // 워크스페이스 KMS 키 (nil이면 버킷 기본 암호화)
 }
 
 // NewFileService creates a new FileService
@@ -50,6 +57,9 @@ func NewFileService(
 	s3Client *client.S3Client,
 	logger *zap.Logger,
 	m *metrics.Metrics,
+	events FileEventPublisher,
+	processor UploadProcessor,
+	keys EncryptionKeyProvider,
 ) *FileService {
 	return &FileService{
 		fileRepo:   fileRepo,
@@ -57,6 +67,16 @@ func NewFileService(
 		s3Client:   s3Client,
 		logger:     logger,
 		metrics:    m,
+		events:     events,
+		processor:  processor,
+		keys:       keys,
+	}
+}
+
+// publishEvent publishes a file event if a publisher is configured
+func (s *FileService) publishEvent(ctx context.Context, event domain.FileEventType, file *domain.File, actorID uuid.UUID) {
+	if s.events != nil {
+		s.events.PublishFileEvent(ctx, event, file, actorID)
 	}
 }
 
@@ -104,8 +124,18 @@ func (s *FileService) GenerateUploadURL(ctx context.Context, req domain.Generate
 		}
 	}
 
+	// 워크스페이스 KMS 키 (설정된 경우 SSE-KMS로 업로드)
+	var kmsKeyID string
+	if s.keys != nil {
+		keyID, err := s.keys.UploadKeyID(ctx, req.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		kmsKeyID = keyID
+	}
+
 	// Generate presigned URL
-	uploadURL, fileKey, err := s.s3Client.GeneratePresignedURL(ctx, req.WorkspaceID.String(), req.FileName, req.ContentType)
+	uploadURL, fileKey, uploadHeaders, err := s.s3Client.GeneratePresignedURL(ctx, req.WorkspaceID.String(), req.FileName, req.ContentType, kmsKeyID)
 	if err != nil {
 		s.logger.Error("Failed to generate presigned URL", zap.Error(err))
 		return nil, fmt.Errorf("failed to generate upload URL: %w", err)
@@ -128,6 +158,9 @@ func (s *FileService) GenerateUploadURL(ctx context.Context, req domain.Generate
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
+	if kmsKeyID != "" {
+		file.KMSKeyID = &kmsKeyID
+	}
 
 	if err := s.fileRepo.Create(ctx, file); err != nil {
 		s.logger.Error("Failed to create file record", zap.Error(err))
@@ -143,15 +176,17 @@ func (s *FileService) GenerateUploadURL(ctx context.Context, req domain.Generate
 	)
 
 	return &domain.GenerateUploadURLResponse{
-		UploadURL: uploadURL,
-		FileKey:   fileKey,
-		FileID:    file.ID,
-		ExpiresAt: expiresAt,
+		UploadURL:     uploadURL,
+		UploadHeaders: uploadHeaders,
+		FileKey:       fileKey,
+		FileID:        file.ID,
+		ExpiresAt:     expiresAt,
 	}, nil
 }
 
 // ConfirmUpload confirms that file upload is complete
-// 파일 업로드 확정: 상태를 UPLOADING에서 ACTIVE로 변경
+// 파일 업로드 확정: S3 객체를 검증한 뒤 상태를 UPLOADING에서 ACTIVE로 변경
+// 버킷 이벤트로 이미 활성화된 경우 그대로 반환합니다.
 func (s *FileService) ConfirmUpload(ctx context.Context, fileID, userID uuid.UUID) (*domain.File, error) {
 	file, err := s.fileRepo.FindByID(ctx, fileID)
 	if err != nil {
@@ -166,23 +201,139 @@ func (s *FileService) ConfirmUpload(ctx context.Context, fileID, userID uuid.UUI
 		return nil, response.NewForbiddenError("not authorized to confirm this upload", "")
 	}
 
+	if file.Status == domain.FileStatusActive {
+		return file, nil
+	}
+
 	// UPLOADING 상태에서만 확정 가능
 	if file.Status != domain.FileStatusUploading {
 		return nil, response.NewConflictError("file is not in uploading state", string(file.Status))
 	}
 
+	if err := s.verifyUploadedObject(ctx, file); err != nil {
+		return nil, err
+	}
+
+	if err := s.activateUpload(ctx, file, userID); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// ActivateUploadedObject activates the uploading file stored under an S3 key
+// 버킷 이벤트(ObjectCreated) 수신 시 호출됩니다. 대상 파일이 없거나 이미 활성화된 경우 false를 반환합니다.
+func (s *FileService) ActivateUploadedObject(ctx context.Context, fileKey string) (bool, error) {
+	file, err := s.fileRepo.FindByFileKey(ctx, fileKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to find file: %w", err)
+	}
+
+	if file.Status != domain.FileStatusUploading {
+		return false, nil
+	}
+
+	if err := s.verifyUploadedObject(ctx, file); err != nil {
+		return false, err
+	}
+
+	if err := s.activateUpload(ctx, file, file.UploadedBy); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// verifyUploadedObject checks that the uploaded S3 object exists and matches the declared size and content type
+// 클라이언트가 보낸 값을 신뢰하지 않고 S3 실제 객체 메타데이터로 검증합니다.
+func (s *FileService) verifyUploadedObject(ctx context.Context, file *domain.File) error {
+	if s.s3Client == nil {
+		return nil
+	}
+
+	info, err := s.s3Client.HeadObject(ctx, file.FileKey)
+	if err != nil {
+		if errors.Is(err, client.ErrObjectNotFound) {
+			return response.NewConflictError("uploaded object not found", file.FileKey)
+		}
+		return fmt.Errorf("failed to verify uploaded object: %w", err)
+	}
+
+	if info.Size != file.FileSize {
+		s.logger.Warn("Uploaded object size mismatch",
+			zap.String("fileId", file.ID.String()),
+			zap.Int64("declaredSize", file.FileSize),
+			zap.Int64("actualSize", info.Size),
+		)
+		return response.NewValidationError("uploaded object size does not match declared size",
+			fmt.Sprintf("declared=%d actual=%d", file.FileSize, info.Size))
+	}
+
+	if info.ContentType != "" && !sameContentType(info.ContentType, file.ContentType) {
+		s.logger.Warn("Uploaded object content type mismatch",
+			zap.String("fileId", file.ID.String()),
+			zap.String("declaredContentType", file.ContentType),
+			zap.String("actualContentType", info.ContentType),
+		)
+		return response.NewValidationError("uploaded object content type does not match declared content type",
+			fmt.Sprintf("declared=%s actual=%s", file.ContentType, info.ContentType))
+	}
+
+	// 워크스페이스 KMS 키로 암호화되지 않은 객체 거부
+	if file.KMSKeyID != nil && info.KMSKeyID == "" {
+		s.logger.Warn("Uploaded object is not KMS-encrypted",
+			zap.String("fileId", file.ID.String()),
+		)
+		return response.NewValidationError("uploaded object is not encrypted with the workspace key", file.FileKey)
+	}
+
+	return nil
+}
+
+// sameContentType compares media types ignoring case and parameters
+func sameContentType(a, b string) bool {
+	mediaType := func(ct string) string {
+		if i := strings.Index(ct, ";"); i >= 0 {
+			ct = ct[:i]
+		}
+		return strings.ToLower(strings.TrimSpace(ct))
+	}
+	return mediaType(a) == mediaType(b)
+}
+
+// activateUpload marks a verified upload as ACTIVE and runs post-processing
+func (s *FileService) activateUpload(ctx context.Context, file *domain.File, actorID uuid.UUID) error {
 	// Generate unique name if necessary
 	uniqueName, err := s.fileRepo.GenerateUniqueName(ctx, file.WorkspaceID, file.FolderID, file.Name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate unique name: %w", err)
+		return fmt.Errorf("failed to generate unique name: %w", err)
 	}
-	file.Name = uniqueName
 
+	activated, err := s.fileRepo.ActivateUpload(ctx, file.ID, uniqueName)
+	if err != nil {
+		return fmt.Errorf("failed to update file status: %w", err)
+	}
+
+	// 다른 경로에서 이미 활성화됨: 최신 상태만 반영하고 후처리/이벤트 중복 방지
+	if !activated {
+		current, err := s.fileRepo.FindByID(ctx, file.ID)
+		if err != nil {
+			return fmt.Errorf("failed to reload file: %w", err)
+		}
+		*file = *current
+		return nil
+	}
+
+	file.Name = uniqueName
 	file.Status = domain.FileStatusActive
 	file.UpdatedAt = time.Now()
 
-	if err := s.fileRepo.Update(ctx, file); err != nil {
-		return nil, fmt.Errorf("failed to update file status: %w", err)
+	// 후처리 (예: 이미지 메타데이터 제거) - 이벤트 발행 전에 완료
+	if s.processor != nil {
+		s.processor.ProcessUpload(ctx, file)
 	}
 
 	// 메트릭 기록: 파일 업로드 성공
@@ -192,11 +343,13 @@ func (s *FileService) ConfirmUpload(ctx context.Context, fileID, userID uuid.UUI
 
 	s.logger.Info("File upload confirmed",
 		zap.String("fileId", file.ID.String()),
-		zap.String("userId", userID.String()),
+		zap.String("userId", actorID.String()),
 		zap.Int64("fileSize", file.FileSize),
 	)
 
-	return file, nil
+	s.publishEvent(ctx, domain.FileEventUploaded, file, actorID)
+
+	return nil
 }
 
 // GetFile gets a file by ID
@@ -217,8 +370,8 @@ func (s *FileService) GetFileURL(fileKey string) string {
 	return s.s3Client.GetFileURL(fileKey)
 }
 
-// GetWorkspaceFiles gets all files in a workspace with pagination
-func (s *FileService) GetWorkspaceFiles(ctx context.Context, workspaceID uuid.UUID, page, pageSize int) (*domain.FileListResponse, error) {
+// GetWorkspaceFiles gets all files in a workspace with pagination, optionally filtered by tags
+func (s *FileService) GetWorkspaceFiles(ctx context.Context, workspaceID uuid.UUID, tags []string, page, pageSize int) (*domain.FileListResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -226,7 +379,7 @@ func (s *FileService) GetWorkspaceFiles(ctx context.Context, workspaceID uuid.UU
 		pageSize = 20
 	}
 
-	files, total, err := s.fileRepo.FindByWorkspaceID(ctx, workspaceID, page, pageSize)
+	files, total, err := s.fileRepo.FindByWorkspaceID(ctx, workspaceID, tags, page, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get files: %w", err)
 	}
@@ -320,6 +473,8 @@ func (s *FileService) UpdateFile(ctx context.Context, fileID uuid.UUID, req doma
 		zap.String("userId", userID.String()),
 	)
 
+	s.publishEvent(ctx, domain.FileEventUpdated, file, userID)
+
 	return file, nil
 }
 
@@ -357,6 +512,8 @@ func (s *FileService) DeleteFile(ctx context.Context, fileID uuid.UUID, userID u
 		zap.String("fileName", file.Name),
 	)
 
+	s.publishEvent(ctx, domain.FileEventDeleted, file, userID)
+
 	return nil
 }
 
@@ -385,6 +542,10 @@ func (s *FileService) RestoreFile(ctx context.Context, fileID uuid.UUID, userID
 		zap.String("userId", userID.String()),
 	)
 
+	file.DeletedAt = nil
+	file.Status = domain.FileStatusActive
+	s.publishEvent(ctx, domain.FileEventRestored, file, userID)
+
 	return nil
 }
 
@@ -422,8 +583,8 @@ func (s *FileService) GetTrashFiles(ctx context.Context, workspaceID uuid.UUID)
 	return s.fileRepo.FindDeleted(ctx, workspaceID)
 }
 
-// SearchFiles searches files by name
-func (s *FileService) SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, page, pageSize int) (*domain.FileListResponse, error) {
+// SearchFiles searches files by name and tags
+func (s *FileService) SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, tags []string, page, pageSize int) (*domain.FileListResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -431,7 +592,7 @@ func (s *FileService) SearchFiles(ctx context.Context, workspaceID uuid.UUID, qu
 		pageSize = 20
 	}
 
-	files, total, err := s.fileRepo.Search(ctx, workspaceID, query, page, pageSize)
+	files, total, err := s.fileRepo.Search(ctx, workspaceID, query, tags, page, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search files: %w", err)
 	}
@@ -503,23 +664,133 @@ func (s *FileService) CleanupOrphanedUploads(ctx context.Context) error {
 }
 
 // GenerateDownloadURL generates a presigned URL for file download
-// 다운로드 URL 생성: 다운로드 URL 생성 시 메트릭을 기록합니다.
-func (s *FileService) GenerateDownloadURL(ctx context.Context, fileID uuid.UUID) (string, error) {
+func (s *FileService) GenerateDownloadURL(ctx context.Context, fileID uuid.UUID, opts domain.DownloadURLOptions) (string, time.Time, error) {
+	return s.generateAccessURL(ctx, fileID, opts)
+}
+
+// GeneratePreviewURL generates a presigned URL for viewing a file inline
+func (s *FileService) GeneratePreviewURL(ctx context.Context, fileID uuid.UUID) (string, time.Time, error) {
+	return s.generateAccessURL(ctx, fileID, domain.DownloadURLOptions{Inline: true})
+}
+
+// resolveDownloadOptions validates caller options and applies defaults and limits
+func resolveDownloadOptions(file *domain.File, opts domain.DownloadURLOptions) (client.AccessURLOptions, error) {
+	resolved := client.AccessURLOptions{
+		FileName: strings.TrimSpace(opts.FileName),
+		Inline:   opts.Inline,
+		Expires:  opts.ExpiresIn,
+	}
+
+	if resolved.Expires == 0 {
+		resolved.Expires = domain.DefaultDownloadURLExpiry
+	}
+	if resolved.Expires < domain.MinDownloadURLExpiry || resolved.Expires > domain.MaxDownloadURLExpiry {
+		return resolved, response.NewValidationError(
+			fmt.Sprintf("expiry must be between %d and %d seconds",
+				int(domain.MinDownloadURLExpiry.Seconds()), int(domain.MaxDownloadURLExpiry.Seconds())), "")
+	}
+
+	if resolved.FileName == "" {
+		resolved.FileName = file.OriginalName
+	}
+	if len(resolved.FileName) > 255 {
+		return resolved, response.NewValidationError("file name too long (max 255 bytes)", "")
+	}
+
+	// 스크립트 실행 가능한 콘텐츠는 인라인 요청이어도 첨부 파일로 강제
+	if resolved.Inline {
+		if domain.IsSafeInlineContentType(file.ContentType) {
+			resolved.ContentType = file.ContentType
+		} else {
+			resolved.Inline = false
+		}
+	}
+
+	if opts.IPRange != "" {
+		ipRange, err := normalizeIPRange(opts.IPRange)
+		if err != nil {
+			return resolved, err
+		}
+		resolved.IPRange = ipRange
+	}
+
+	return resolved, nil
+}
+
+// normalizeIPRange parses an IP or CIDR and rejects overly broad ranges
+func normalizeIPRange(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "/") {
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			return "", response.NewValidationError("invalid IP range", raw)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(raw)
+	if err != nil {
+		return "", response.NewValidationError("invalid IP range", raw)
+	}
+	ones, bits := ipNet.Mask.Size()
+	if (bits == 32 && ones < 8) || (bits == 128 && ones < 16) {
+		return "", response.NewValidationError("IP range too broad", raw)
+	}
+	return ipNet.String(), nil
+}
+
+// generateAccessURL generates a presigned download (attachment) or preview (inline) URL
+func (s *FileService) generateAccessURL(ctx context.Context, fileID uuid.UUID, opts domain.DownloadURLOptions) (string, time.Time, error) {
 	file, err := s.fileRepo.FindByID(ctx, fileID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return "", response.NewNotFoundError("file not found", fileID.String())
+			return "", time.Time{}, response.NewNotFoundError("file not found", fileID.String())
 		}
-		return "", fmt.Errorf("failed to get file: %w", err)
+		return "", time.Time{}, fmt.Errorf("failed to get file: %w", err)
 	}
 
-	url, err := s.s3Client.GenerateDownloadURL(ctx, file.FileKey, file.OriginalName)
+	urlOpts, err := resolveDownloadOptions(file, opts)
 	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// 비활성화된 KMS 키로 암호화된 파일은 다운로드 차단
+	if s.keys != nil {
+		if err := s.keys.CheckDownloadKey(ctx, file); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+
+	// 아카이브 스토리지 클래스는 다운로드 전에 복원이 필요
+	if file.StorageClass.RequiresRestore() {
+		if err := s.ensureRestored(ctx, file); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+
+	url, err := s.s3Client.GenerateAccessURL(ctx, file.FileKey, urlOpts)
+	if err != nil {
+		if errors.Is(err, client.ErrIPRestrictionUnsupported) {
+			return "", time.Time{}, response.NewValidationError(err.Error(), "")
+		}
 		s.logger.Error("Failed to generate download URL",
+			zap.String("fileId", fileID.String()),
+			zap.Bool("inline", urlOpts.Inline),
+			zap.Error(err),
+		)
+		return "", time.Time{}, fmt.Errorf("failed to generate download URL: %w", err)
+	}
+	expiresAt := time.Now().Add(urlOpts.Expires)
+
+	// 라이프사이클 정책 판단을 위한 마지막 접근 시각 기록
+	if err := s.fileRepo.TouchLastAccessed(ctx, file.ID); err != nil {
+		s.logger.Warn("Failed to record file access time",
 			zap.String("fileId", fileID.String()),
 			zap.Error(err),
 		)
-		return "", fmt.Errorf("failed to generate download URL: %w", err)
 	}
 
 	// 메트릭 기록: 파일 다운로드 요청
@@ -530,7 +801,48 @@ func (s *FileService) GenerateDownloadURL(ctx context.Context, fileID uuid.UUID)
 	s.logger.Info("Download URL generated",
 		zap.String("fileId", fileID.String()),
 		zap.String("fileName", file.Name),
+		zap.Bool("inline", urlOpts.Inline),
+		zap.Duration("expiresIn", urlOpts.Expires),
+		zap.Bool("ipRestricted", urlOpts.IPRange != ""),
 	)
 
-	return url, nil
+	return url, expiresAt, nil
+}
+
+// ensureRestored checks the restore state of an archived file and starts a restore if needed
+// 복원이 완료되지 않았으면 ErrFileRestoreInProgress를 반환합니다.
+func (s *FileService) ensureRestored(ctx context.Context, file *domain.File) error {
+	restored, inProgress, err := s.s3Client.GetRestoreStatus(ctx, file.FileKey)
+	if err != nil {
+		s.logger.Error("Failed to get restore status",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to get restore status: %w", err)
+	}
+	if restored {
+		return nil
+	}
+
+	if !inProgress {
+		if err := s.s3Client.RestoreObject(ctx, file.FileKey, archiveRestoreDays); err != nil {
+			s.logger.Error("Failed to request archive restore",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to request restore: %w", err)
+		}
+		if err := s.fileRepo.MarkRestoreRequested(ctx, file.ID); err != nil {
+			s.logger.Warn("Failed to record restore request",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+		}
+		s.logger.Info("Archive restore requested",
+			zap.String("fileId", file.ID.String()),
+			zap.String("storageClass", string(file.StorageClass)),
+		)
+	}
+
+	return response.ErrFileRestoreInProgress
 }
@@ -183,6 +183,88 @@ func (s *FolderService) GetWorkspaceFolders(ctx context.Context, workspaceID uui
 	return s.folderRepo.FindByWorkspaceID(ctx, workspaceID)
 }
 
+// GetFolderTree gets the folder hierarchy of a workspace with recursive file statistics
+// folderID가 있으면 해당 폴더의 하위 트리만, depth가 0이면 전체 깊이를 반환합니다.
+func (s *FolderService) GetFolderTree(ctx context.Context, workspaceID uuid.UUID, folderID *uuid.UUID, depth int) (*domain.FolderTreeResponse, error) {
+	if depth < 0 || depth > domain.MaxFolderTreeDepth {
+		return nil, response.NewValidationError(fmt.Sprintf("depth must be between 0 and %d", domain.MaxFolderTreeDepth), "")
+	}
+
+	folders, err := s.folderRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folders: %w", err)
+	}
+
+	stats, err := s.folderRepo.FindTreeStats(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folder statistics: %w", err)
+	}
+
+	tree := &domain.FolderTreeResponse{
+		WorkspaceID: workspaceID,
+		FolderID:    folderID,
+		Depth:       depth,
+	}
+
+	rootID := uuid.Nil
+	if folderID != nil {
+		found := false
+		for i := range folders {
+			if folders[i].ID == *folderID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, response.NewNotFoundError("folder not found", folderID.String())
+		}
+		rootID = *folderID
+		tree.FileCount = stats[rootID].FileCount
+		tree.TotalSize = stats[rootID].TotalSize
+	} else {
+		if tree.FileCount, err = s.fileRepo.CountByWorkspaceID(ctx, workspaceID); err != nil {
+			return nil, fmt.Errorf("failed to count files: %w", err)
+		}
+		if tree.TotalSize, err = s.fileRepo.SumSizeByWorkspaceID(ctx, workspaceID); err != nil {
+			return nil, fmt.Errorf("failed to sum file sizes: %w", err)
+		}
+	}
+
+	tree.Folders = buildFolderTree(folders, stats, rootID, depth)
+	return tree, nil
+}
+
+// buildFolderTree assembles folder responses under rootID up to the given depth
+// 루트 폴더는 uuid.Nil로 표현합니다. 잘린 레벨도 FolderCount로 하위 폴더 존재 여부를 알 수 있습니다.
+func buildFolderTree(folders []domain.Folder, stats map[uuid.UUID]domain.FolderTreeStat, rootID uuid.UUID, depth int) []domain.FolderResponse {
+	children := make(map[uuid.UUID][]*domain.Folder, len(folders))
+	for i := range folders {
+		parentID := uuid.Nil
+		if folders[i].ParentID != nil {
+			parentID = *folders[i].ParentID
+		}
+		children[parentID] = append(children[parentID], &folders[i])
+	}
+
+	var build func(parentID uuid.UUID, level int) []domain.FolderResponse
+	build = func(parentID uuid.UUID, level int) []domain.FolderResponse {
+		nodes := make([]domain.FolderResponse, 0, len(children[parentID]))
+		for _, folder := range children[parentID] {
+			node := folder.ToResponse()
+			node.FolderCount = int64(len(children[folder.ID]))
+			node.FileCount = stats[folder.ID].FileCount
+			node.TotalSize = stats[folder.ID].TotalSize
+			if depth == 0 || level < depth {
+				node.Children = build(folder.ID, level+1)
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+
+	return build(rootID, 1)
+}
+
 // GetRootFolders gets all root folders in a workspace
 func (s *FolderService) GetRootFolders(ctx context.Context, workspaceID uuid.UUID) ([]domain.Folder, error) {
 	return s.folderRepo.FindRootFolders(ctx, workspaceID)
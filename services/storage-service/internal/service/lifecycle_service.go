@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// LifecycleService handles storage lifecycle tiering
+// 접근되지 않은 파일을 워크스페이스 정책에 따라 저비용 스토리지 클래스로 전환합니다.
+type LifecycleService struct {
+	settingsRepo *repository.WorkspaceSettingsRepository
+	fileRepo     *repository.FileRepository
+	s3Client     *client.S3Client
+	batchSize    int
+	logger       *zap.Logger
+}
+
+// NewLifecycleService creates a new LifecycleService
+func NewLifecycleService(
+	settingsRepo *repository.WorkspaceSettingsRepository,
+	fileRepo *repository.FileRepository,
+	s3Client *client.S3Client,
+	batchSize int,
+	logger *zap.Logger,
+) *LifecycleService {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &LifecycleService{
+		settingsRepo: settingsRepo,
+		fileRepo:     fileRepo,
+		s3Client:     s3Client,
+		batchSize:    batchSize,
+		logger:       logger,
+	}
+}
+
+// GetLifecyclePolicy gets the lifecycle policy of a workspace
+func (s *LifecycleService) GetLifecyclePolicy(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateLifecyclePolicy updates the lifecycle policy of a workspace
+// 아카이브 전환 일수는 IA 전환 일수보다 커야 합니다.
+func (s *LifecycleService) UpdateLifecyclePolicy(ctx context.Context, workspaceID uuid.UUID, req domain.UpdateLifecyclePolicyRequest, userID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	if req.Enabled != nil {
+		settings.LifecycleEnabled = *req.Enabled
+	}
+	if req.InfrequentAccessAfterDays != nil {
+		settings.InfrequentAccessAfterDays = *req.InfrequentAccessAfterDays
+	}
+	if req.ArchiveAfterDays != nil {
+		settings.ArchiveAfterDays = *req.ArchiveAfterDays
+	}
+	if req.ArchiveStorageClass != nil {
+		if !req.ArchiveStorageClass.IsArchive() {
+			return nil, response.NewValidationError("archive storage class must be GLACIER_IR, GLACIER or DEEP_ARCHIVE", string(*req.ArchiveStorageClass))
+		}
+		settings.ArchiveStorageClass = *req.ArchiveStorageClass
+	}
+
+	if settings.InfrequentAccessAfterDays < 0 || settings.ArchiveAfterDays < 0 {
+		return nil, response.NewValidationError("transition days cannot be negative", "")
+	}
+	if settings.InfrequentAccessAfterDays > 0 && settings.ArchiveAfterDays > 0 &&
+		settings.ArchiveAfterDays <= settings.InfrequentAccessAfterDays {
+		return nil, response.NewValidationError("archiveAfterDays must be greater than infrequentAccessAfterDays", "")
+	}
+
+	now := time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+	settings.UpdatedAt = now
+	settings.UpdatedBy = &userID
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save workspace settings: %w", err)
+	}
+
+	s.logger.Info("Lifecycle policy updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Bool("enabled", settings.LifecycleEnabled),
+		zap.Int("infrequentAccessAfterDays", settings.InfrequentAccessAfterDays),
+		zap.Int("archiveAfterDays", settings.ArchiveAfterDays),
+		zap.String("userId", userID.String()),
+	)
+
+	return settings, nil
+}
+
+// ApplyPolicies transitions cold files of every workspace with an enabled policy
+// 한 번 실행 시 워크스페이스/단계별로 batchSize 만큼만 처리합니다.
+func (s *LifecycleService) ApplyPolicies(ctx context.Context) error {
+	if s.s3Client == nil {
+		return fmt.Errorf("s3 client not configured")
+	}
+
+	policies, err := s.settingsRepo.FindLifecycleEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find lifecycle policies: %w", err)
+	}
+
+	for i := range policies {
+		policy := &policies[i]
+
+		// 아카이브 전환을 먼저 처리해 IA를 거치지 않고 바로 아카이브되는 파일도 처리
+		if policy.ArchiveAfterDays > 0 {
+			from := []domain.StorageClass{domain.StorageClassStandard, domain.StorageClassStandardIA}
+			s.transitionColdFiles(ctx, policy.WorkspaceID, from, policy.ArchiveStorageClass, policy.ArchiveAfterDays)
+		}
+		if policy.InfrequentAccessAfterDays > 0 {
+			from := []domain.StorageClass{domain.StorageClassStandard}
+			s.transitionColdFiles(ctx, policy.WorkspaceID, from, domain.StorageClassStandardIA, policy.InfrequentAccessAfterDays)
+		}
+	}
+
+	return nil
+}
+
+// transitionColdFiles transitions files not accessed for the given number of days
+func (s *LifecycleService) transitionColdFiles(ctx context.Context, workspaceID uuid.UUID, from []domain.StorageClass, to domain.StorageClass, afterDays int) {
+	cutoff := time.Now().AddDate(0, 0, -afterDays)
+
+	files, err := s.fileRepo.FindColdFiles(ctx, workspaceID, from, cutoff, s.batchSize)
+	if err != nil {
+		s.logger.Error("Failed to find cold files",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	transitioned := 0
+	for _, file := range files {
+		if err := s.s3Client.TransitionStorageClass(ctx, file.FileKey, string(to), file.KMSKey()); err != nil {
+			s.logger.Error("Failed to transition file storage class",
+				zap.String("fileId", file.ID.String()),
+				zap.String("storageClass", string(to)),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err := s.fileRepo.UpdateStorageClass(ctx, file.ID, to); err != nil {
+			s.logger.Error("Failed to update file storage class",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		transitioned++
+	}
+
+	if transitioned > 0 {
+		s.logger.Info("Transitioned cold files",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.String("storageClass", string(to)),
+			zap.Int("count", transitioned),
+		)
+	}
+}
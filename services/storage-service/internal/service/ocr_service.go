@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+const (
+	maxOCRAttempts      = 3                // Entries are marked FAILED after this many attempts
+	ocrStaleAfter       = 15 * time.Minute // PROCESSING entries older than this are reclaimed
+	maxOCRContentLength = 1024 * 1024      // Extracted text is truncated to this many bytes
+)
+
+// OCRService extracts text from uploaded images and scanned PDFs
+// 업로드 확정 시 PENDING으로 등록하고, OCR 잡이 비동기로 텍스트를 추출합니다.
+type OCRService struct {
+	textRepo     *repository.FileTextRepository
+	settingsRepo *repository.WorkspaceSettingsRepository
+	fileRepo     *repository.FileRepository
+	s3Client     *client.S3Client
+	ocrClient    client.OCRClient // nil이면 OCR 비활성화 (설정 API만 동작)
+	batchSize    int
+	maxFileSize  int64
+	logger       *zap.Logger
+}
+
+// NewOCRService creates a new OCRService
+func NewOCRService(
+	textRepo *repository.FileTextRepository,
+	settingsRepo *repository.WorkspaceSettingsRepository,
+	fileRepo *repository.FileRepository,
+	s3Client *client.S3Client,
+	ocrClient client.OCRClient,
+	batchSize int,
+	maxFileSize int64,
+	logger *zap.Logger,
+) *OCRService {
+	return &OCRService{
+		textRepo:     textRepo,
+		settingsRepo: settingsRepo,
+		fileRepo:     fileRepo,
+		s3Client:     s3Client,
+		ocrClient:    ocrClient,
+		batchSize:    batchSize,
+		maxFileSize:  maxFileSize,
+		logger:       logger,
+	}
+}
+
+// GetOCRSettings gets the OCR settings of a workspace
+func (s *OCRService) GetOCRSettings(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateOCRSettings updates the OCR settings of a workspace
+func (s *OCRService) UpdateOCRSettings(ctx context.Context, workspaceID uuid.UUID, req domain.UpdateOCRSettingsRequest, userID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	if req.Enabled != nil {
+		settings.OCREnabled = *req.Enabled
+	}
+	if req.Languages != nil {
+		codes, ok := domain.ParseOCRLanguages(*req.Languages)
+		if !ok {
+			return nil, response.NewValidationError("unsupported OCR language", *req.Languages)
+		}
+		settings.OCRLanguages = strings.Join(codes, "+")
+	}
+
+	now := time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+	settings.UpdatedAt = now
+	settings.UpdatedBy = &userID
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save workspace settings: %w", err)
+	}
+
+	s.logger.Info("OCR settings updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Bool("enabled", settings.OCREnabled),
+		zap.String("languages", settings.OCRLanguagesOrDefault()),
+		zap.String("userId", userID.String()),
+	)
+
+	return settings, nil
+}
+
+// GetFileText gets the OCR result of a file
+func (s *OCRService) GetFileText(ctx context.Context, fileID uuid.UUID) (*domain.FileText, error) {
+	text, err := s.textRepo.FindByFileID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("no extracted text for file", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file text: %w", err)
+	}
+	return text, nil
+}
+
+// ProcessUpload queues a confirmed upload for OCR when the workspace has OCR enabled
+// 실제 추출은 OCR 잡에서 수행하므로 업로드 확정 응답을 지연시키지 않습니다.
+func (s *OCRService) ProcessUpload(ctx context.Context, file *domain.File) {
+	if s.ocrClient == nil || !domain.SupportsOCR(file.ContentType) {
+		return
+	}
+
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, file.WorkspaceID)
+	if err != nil {
+		s.logger.Warn("Failed to load workspace settings for OCR",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	if !settings.OCREnabled {
+		return
+	}
+
+	if file.FileSize > s.maxFileSize {
+		s.logger.Info("File too large for OCR, skipping",
+			zap.String("fileId", file.ID.String()),
+			zap.Int64("fileSize", file.FileSize),
+		)
+		return
+	}
+
+	now := time.Now()
+	text := &domain.FileText{
+		FileID:      file.ID,
+		WorkspaceID: file.WorkspaceID,
+		Status:      domain.OCRStatusPending,
+		Languages:   settings.OCRLanguagesOrDefault(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.textRepo.Enqueue(ctx, text); err != nil {
+		s.logger.Error("Failed to queue file for OCR",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// ProcessPending extracts text for a batch of queued files and returns the number processed
+func (s *OCRService) ProcessPending(ctx context.Context) (int, error) {
+	if s.ocrClient == nil || s.s3Client == nil {
+		return 0, nil
+	}
+
+	texts, err := s.textRepo.ClaimPending(ctx, s.batchSize, ocrStaleAfter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim pending OCR entries: %w", err)
+	}
+
+	for i := range texts {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		s.extract(ctx, &texts[i])
+	}
+	return len(texts), nil
+}
+
+// extract runs OCR for a single queued file and records the result
+func (s *OCRService) extract(ctx context.Context, text *domain.FileText) {
+	content, err := s.runOCR(ctx, text)
+	if err != nil {
+		final := text.Attempts >= maxOCRAttempts || errors.Is(err, gorm.ErrRecordNotFound)
+		s.logger.Warn("OCR failed",
+			zap.String("fileId", text.FileID.String()),
+			zap.Int("attempts", text.Attempts),
+			zap.Bool("final", final),
+			zap.Error(err),
+		)
+		if err := s.textRepo.Fail(ctx, text.FileID, truncateUTF8(err.Error(), 1000), final); err != nil {
+			s.logger.Error("Failed to record OCR failure",
+				zap.String("fileId", text.FileID.String()),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+
+	if err := s.textRepo.Complete(ctx, text.FileID, content); err != nil {
+		s.logger.Error("Failed to save OCR result",
+			zap.String("fileId", text.FileID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	s.logger.Info("OCR completed",
+		zap.String("fileId", text.FileID.String()),
+		zap.String("languages", text.Languages),
+		zap.Int("length", len(content)),
+	)
+}
+
+// runOCR downloads the file object and sends it to the OCR server
+func (s *OCRService) runOCR(ctx context.Context, text *domain.FileText) (string, error) {
+	file, err := s.fileRepo.FindByID(ctx, text.FileID)
+	if err != nil {
+		return "", err
+	}
+
+	languages, ok := domain.ParseOCRLanguages(text.Languages)
+	if !ok {
+		languages, _ = domain.ParseOCRLanguages(domain.DefaultOCRLanguages)
+	}
+
+	data, err := s.s3Client.GetObject(ctx, file.FileKey, s.maxFileSize)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := s.ocrClient.ExtractText(ctx, file.ContentType, data, languages)
+	if err != nil {
+		return "", err
+	}
+
+	// PostgreSQL text 컬럼은 NUL 문자를 저장할 수 없음
+	content = strings.ReplaceAll(strings.ToValidUTF8(content, ""), "\x00", "")
+	return truncateUTF8(strings.TrimSpace(content), maxOCRContentLength), nil
+}
+
+// truncateUTF8 shortens s to at most n bytes without splitting a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/imagemeta"
+	"storage-service/internal/repository"
+)
+
+// maxMetadataStripSize is the largest image rewritten by the metadata stripping pipeline
+const maxMetadataStripSize = 50 * 1024 * 1024
+
+// UploadProcessor post-processes a file after its upload is confirmed
+type UploadProcessor interface {
+	ProcessUpload(ctx context.Context, file *domain.File)
+}
+
+// UploadProcessors runs several upload processors in order
+type UploadProcessors []UploadProcessor
+
+// ProcessUpload runs every processor in order
+func (p UploadProcessors) ProcessUpload(ctx context.Context, file *domain.File) {
+	for _, processor := range p {
+		processor.ProcessUpload(ctx, file)
+	}
+}
+
+// PrivacyService handles workspace privacy settings and image metadata stripping
+// 개인정보 보호가 필요한 워크스페이스는 업로드된 이미지의 EXIF/GPS 메타데이터를 제거합니다.
+type PrivacyService struct {
+	settingsRepo *repository.WorkspaceSettingsRepository
+	fileRepo     *repository.FileRepository
+	s3Client     *client.S3Client
+	logger       *zap.Logger
+}
+
+// NewPrivacyService creates a new PrivacyService
+func NewPrivacyService(
+	settingsRepo *repository.WorkspaceSettingsRepository,
+	fileRepo *repository.FileRepository,
+	s3Client *client.S3Client,
+	logger *zap.Logger,
+) *PrivacyService {
+	return &PrivacyService{
+		settingsRepo: settingsRepo,
+		fileRepo:     fileRepo,
+		s3Client:     s3Client,
+		logger:       logger,
+	}
+}
+
+// GetPrivacySettings gets the privacy settings of a workspace
+func (s *PrivacyService) GetPrivacySettings(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings updates the privacy settings of a workspace
+func (s *PrivacyService) UpdatePrivacySettings(ctx context.Context, workspaceID uuid.UUID, req domain.UpdatePrivacySettingsRequest, userID uuid.UUID) (*domain.WorkspaceSettings, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	if req.StripImageMetadata != nil {
+		settings.StripImageMetadata = *req.StripImageMetadata
+	}
+
+	now := time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+	settings.UpdatedAt = now
+	settings.UpdatedBy = &userID
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save workspace settings: %w", err)
+	}
+
+	s.logger.Info("Privacy settings updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Bool("stripImageMetadata", settings.StripImageMetadata),
+		zap.String("userId", userID.String()),
+	)
+
+	return settings, nil
+}
+
+// ProcessUpload strips image metadata of a confirmed upload when the workspace requires it
+// 실패해도 업로드 확정은 유지되며 에러는 로그로만 남깁니다.
+func (s *PrivacyService) ProcessUpload(ctx context.Context, file *domain.File) {
+	if s.s3Client == nil || !imagemeta.Supports(file.ContentType) {
+		return
+	}
+
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, file.WorkspaceID)
+	if err != nil {
+		s.logger.Warn("Failed to load workspace settings for upload processing",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	if !settings.StripImageMetadata {
+		return
+	}
+
+	if err := s.StripImageMetadata(ctx, file); err != nil {
+		s.logger.Error("Failed to strip image metadata",
+			zap.String("fileId", file.ID.String()),
+			zap.String("contentType", file.ContentType),
+			zap.Error(err),
+		)
+	}
+}
+
+// StripImageMetadata removes metadata from an image and rewrites the S3 object
+func (s *PrivacyService) StripImageMetadata(ctx context.Context, file *domain.File) error {
+	if file.FileSize > maxMetadataStripSize {
+		return fmt.Errorf("image too large for metadata stripping: %d bytes", file.FileSize)
+	}
+
+	data, err := s.s3Client.GetObject(ctx, file.FileKey, maxMetadataStripSize)
+	if err != nil {
+		return err
+	}
+
+	stripped, changed, err := imagemeta.Strip(file.ContentType, data)
+	if err != nil {
+		return fmt.Errorf("failed to parse image: %w", err)
+	}
+
+	if changed {
+		if err := s.s3Client.PutObject(ctx, file.FileKey, file.ContentType, stripped, file.KMSKey()); err != nil {
+			return err
+		}
+	}
+
+	newSize := int64(len(stripped))
+	if err := s.fileRepo.MarkMetadataStripped(ctx, file.ID, newSize); err != nil {
+		return fmt.Errorf("failed to update file: %w", err)
+	}
+
+	now := time.Now()
+	file.FileSize = newSize
+	file.MetadataStrippedAt = &now
+
+	s.logger.Info("Image metadata stripped",
+		zap.String("fileId", file.ID.String()),
+		zap.Bool("rewritten", changed),
+		zap.Int("originalSize", len(data)),
+		zap.Int64("newSize", newSize),
+	)
+
+	return nil
+}
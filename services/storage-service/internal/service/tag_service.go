@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+const (
+	maxTagNameLength = 50
+	maxTagsPerFile   = 20
+)
+
+var tagColorPattern = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// TagService handles file tagging and workspace tags
+// 자유 태그는 처음 사용될 때 생성되고, 관리 태그는 사용 여부와 관계없이 목록에 유지됩니다.
+type TagService struct {
+	tagRepo  *repository.TagRepository
+	fileRepo *repository.FileRepository
+	logger   *zap.Logger
+}
+
+// NewTagService creates a new TagService
+func NewTagService(tagRepo *repository.TagRepository, fileRepo *repository.FileRepository, logger *zap.Logger) *TagService {
+	return &TagService{
+		tagRepo:  tagRepo,
+		fileRepo: fileRepo,
+		logger:   logger,
+	}
+}
+
+// NormalizeTagName trims, lowercases, and collapses whitespace in a tag name
+func NormalizeTagName(name string) (string, error) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if normalized == "" {
+		return "", response.NewValidationError("tag name cannot be empty", "")
+	}
+	if utf8.RuneCountInString(normalized) > maxTagNameLength {
+		return "", response.NewValidationError(fmt.Sprintf("tag name too long (max %d characters)", maxTagNameLength), name)
+	}
+	if strings.Contains(normalized, ",") {
+		return "", response.NewValidationError("tag name cannot contain commas", name)
+	}
+	return normalized, nil
+}
+
+// ParseTagFilter parses a comma-separated tag filter, skipping invalid names
+func ParseTagFilter(raw string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(raw, ",") {
+		name, err := NormalizeTagName(part)
+		if err != nil || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+// GetWorkspaceTags gets the tags of a workspace with file counts
+func (s *TagService) GetWorkspaceTags(ctx context.Context, workspaceID uuid.UUID) ([]domain.TagResponse, error) {
+	tags, err := s.tagRepo.FindByWorkspaceIDWithCounts(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	responses := make([]domain.TagResponse, 0, len(tags))
+	for _, tag := range tags {
+		responses = append(responses, toTagResponse(&tag.Tag, tag.FileCount))
+	}
+	return responses, nil
+}
+
+// CreateTag creates a workspace-managed tag
+// 같은 이름의 자유 태그가 있으면 관리 태그로 전환합니다.
+func (s *TagService) CreateTag(ctx context.Context, workspaceID uuid.UUID, req domain.CreateTagRequest, userID uuid.UUID) (*domain.TagResponse, error) {
+	name, err := NormalizeTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if req.Color != "" && !tagColorPattern.MatchString(req.Color) {
+		return nil, response.NewValidationError("invalid tag color", req.Color)
+	}
+
+	now := time.Now()
+	tag, err := s.tagRepo.FindByName(ctx, workspaceID, name)
+	switch {
+	case err == nil:
+		if tag.Managed {
+			return nil, response.NewConflictError("tag already exists", name)
+		}
+		tag.Managed = true
+		tag.Color = req.Color
+		tag.UpdatedAt = now
+		if err := s.tagRepo.Update(ctx, tag); err != nil {
+			return nil, fmt.Errorf("failed to update tag: %w", err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		tag = &domain.Tag{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Name:        name,
+			Color:       req.Color,
+			Managed:     true,
+			CreatedBy:   userID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := s.tagRepo.Create(ctx, tag); err != nil {
+			return nil, fmt.Errorf("failed to create tag: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	s.logger.Info("Workspace tag created",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("tagId", tag.ID.String()),
+		zap.String("name", tag.Name),
+		zap.String("userId", userID.String()),
+	)
+
+	resp := toTagResponse(tag, 0)
+	return &resp, nil
+}
+
+// UpdateTag renames, recolors, or changes the managed flag of a tag
+func (s *TagService) UpdateTag(ctx context.Context, workspaceID, tagID uuid.UUID, req domain.UpdateTagRequest, userID uuid.UUID) (*domain.TagResponse, error) {
+	tag, err := s.findWorkspaceTag(ctx, workspaceID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name, err := NormalizeTagName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if name != tag.Name {
+			if _, err := s.tagRepo.FindByName(ctx, workspaceID, name); err == nil {
+				return nil, response.NewConflictError("tag already exists", name)
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("failed to get tag: %w", err)
+			}
+			tag.Name = name
+		}
+	}
+
+	if req.Color != nil {
+		if *req.Color != "" && !tagColorPattern.MatchString(*req.Color) {
+			return nil, response.NewValidationError("invalid tag color", *req.Color)
+		}
+		tag.Color = *req.Color
+	}
+
+	if req.Managed != nil {
+		tag.Managed = *req.Managed
+	}
+
+	tag.UpdatedAt = time.Now()
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	s.logger.Info("Workspace tag updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("tagId", tag.ID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	resp := toTagResponse(tag, 0)
+	return &resp, nil
+}
+
+// DeleteTag deletes a tag and removes it from all files
+func (s *TagService) DeleteTag(ctx context.Context, workspaceID, tagID, userID uuid.UUID) error {
+	tag, err := s.findWorkspaceTag(ctx, workspaceID, tagID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tagRepo.Delete(ctx, tag.ID); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	s.logger.Info("Workspace tag deleted",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.String("tagId", tag.ID.String()),
+		zap.String("name", tag.Name),
+		zap.String("userId", userID.String()),
+	)
+
+	return nil
+}
+
+// TagFile attaches tags to a file, creating free-form tags as needed
+func (s *TagService) TagFile(ctx context.Context, fileID uuid.UUID, req domain.TagFileRequest, userID uuid.UUID) (*domain.FileTagsResponse, error) {
+	file, err := s.findFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(req.Tags))
+	seen := make(map[string]bool, len(req.Tags))
+	for _, raw := range req.Tags {
+		name, err := NormalizeTagName(raw)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	// 이미 붙은 태그를 제외하고 파일당 태그 수 제한 확인
+	newCount := 0
+	for _, name := range names {
+		if !hasTag(file, name) {
+			newCount++
+		}
+	}
+	if len(file.Tags)+newCount > maxTagsPerFile {
+		return nil, response.NewValidationError(fmt.Sprintf("too many tags on file (max %d)", maxTagsPerFile), "")
+	}
+
+	tags, err := s.tagRepo.FindOrCreate(ctx, file.WorkspaceID, names, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tags: %w", err)
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	if err := s.tagRepo.AddToFile(ctx, file.ID, tagIDs, userID); err != nil {
+		return nil, fmt.Errorf("failed to tag file: %w", err)
+	}
+
+	s.logger.Info("File tagged",
+		zap.String("fileId", file.ID.String()),
+		zap.Strings("tags", names),
+		zap.String("userId", userID.String()),
+	)
+
+	return s.fileTags(ctx, file.ID)
+}
+
+// UntagFile removes a tag from a file
+func (s *TagService) UntagFile(ctx context.Context, fileID uuid.UUID, tagName string, userID uuid.UUID) (*domain.FileTagsResponse, error) {
+	file, err := s.findFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := NormalizeTagName(tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagID uuid.UUID
+	for _, tag := range file.Tags {
+		if tag.Name == name {
+			tagID = tag.ID
+		}
+	}
+	if tagID == uuid.Nil {
+		return nil, response.NewNotFoundError("tag not found on file", name)
+	}
+
+	if err := s.tagRepo.RemoveFromFile(ctx, file.ID, tagID); err != nil {
+		return nil, fmt.Errorf("failed to untag file: %w", err)
+	}
+
+	s.logger.Info("File untagged",
+		zap.String("fileId", file.ID.String()),
+		zap.String("tag", name),
+		zap.String("userId", userID.String()),
+	)
+
+	return s.fileTags(ctx, file.ID)
+}
+
+// fileTags reloads the tags of a file
+func (s *TagService) fileTags(ctx context.Context, fileID uuid.UUID) (*domain.FileTagsResponse, error) {
+	file, err := s.findFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.FileTagsResponse{
+		FileID: file.ID,
+		Tags:   file.TagNames(),
+	}, nil
+}
+
+// findWorkspaceTag finds a tag that belongs to the workspace
+func (s *TagService) findWorkspaceTag(ctx context.Context, workspaceID, tagID uuid.UUID) (*domain.Tag, error) {
+	tag, err := s.tagRepo.FindByID(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("tag not found", tagID.String())
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	if tag.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("tag not found", tagID.String())
+	}
+	return tag, nil
+}
+
+// findFile finds a file by ID
+func (s *TagService) findFile(ctx context.Context, fileID uuid.UUID) (*domain.File, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	return file, nil
+}
+
+// hasTag returns true if the file already carries the tag
+func hasTag(file *domain.File, name string) bool {
+	for _, tag := range file.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// toTagResponse converts a tag to TagResponse
+func toTagResponse(tag *domain.Tag, fileCount int64) domain.TagResponse {
+	return domain.TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		Managed:   tag.Managed,
+		FileCount: fileCount,
+		CreatedAt: tag.CreatedAt,
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/response"
+)
+
+// UploadEventService activates uploads from S3 bucket event notifications
+// 클라이언트의 ConfirmUpload 호출 없이도 ObjectCreated 이벤트로 업로드를 확정합니다.
+// 이벤트는 힌트로만 사용하고, 활성화 전에 항상 S3 객체를 다시 검증합니다.
+type UploadEventService struct {
+	fileService      *FileService
+	bucket           string
+	allowedTopicARNs map[string]bool
+	httpClient       *http.Client
+	logger           *zap.Logger
+}
+
+// NewUploadEventService creates a new UploadEventService
+// topicARNs가 비어 있으면 모든 SNS 토픽을 허용합니다.
+func NewUploadEventService(fileService *FileService, bucket string, topicARNs []string, logger *zap.Logger) *UploadEventService {
+	allowed := make(map[string]bool, len(topicARNs))
+	for _, arn := range topicARNs {
+		if arn = strings.TrimSpace(arn); arn != "" {
+			allowed[arn] = true
+		}
+	}
+	return &UploadEventService{
+		fileService:      fileService,
+		bucket:           bucket,
+		allowedTopicARNs: allowed,
+		httpClient:       &http.Client{Timeout: 10 * time.Second},
+		logger:           logger,
+	}
+}
+
+// HandleNotification processes an S3/MinIO bucket event notification
+func (s *UploadEventService) HandleNotification(ctx context.Context, notification *domain.S3EventNotification) domain.UploadEventResult {
+	result := domain.UploadEventResult{Received: len(notification.Records)}
+
+	for i := range notification.Records {
+		record := &notification.Records[i]
+
+		if !record.IsObjectCreated() || (s.bucket != "" && record.S3.Bucket.Name != s.bucket) {
+			result.Skipped++
+			continue
+		}
+
+		// 이벤트의 객체 키는 URL 인코딩되어 있음
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			s.logger.Warn("Invalid object key in bucket event",
+				zap.String("key", record.S3.Object.Key),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+
+		activated, err := s.fileService.ActivateUploadedObject(ctx, key)
+		if err != nil {
+			s.logger.Warn("Failed to activate upload from bucket event",
+				zap.String("key", key),
+				zap.String("event", record.EventName),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		if activated {
+			result.Activated++
+		} else {
+			result.Skipped++
+		}
+	}
+
+	if result.Activated > 0 || result.Failed > 0 {
+		s.logger.Info("Bucket event notification processed",
+			zap.Int("received", result.Received),
+			zap.Int("activated", result.Activated),
+			zap.Int("skipped", result.Skipped),
+			zap.Int("failed", result.Failed),
+		)
+	}
+
+	return result
+}
+
+// HandleSNSMessage processes an SNS delivery wrapping S3 bucket events
+func (s *UploadEventService) HandleSNSMessage(ctx context.Context, msg *domain.SNSMessage) (*domain.UploadEventResult, error) {
+	if len(s.allowedTopicARNs) > 0 && !s.allowedTopicARNs[msg.TopicArn] {
+		return nil, response.NewForbiddenError("SNS topic not allowed", msg.TopicArn)
+	}
+
+	switch msg.Type {
+	case domain.SNSTypeSubscriptionConfirmation:
+		if err := s.confirmSubscription(ctx, msg); err != nil {
+			return nil, err
+		}
+		return &domain.UploadEventResult{}, nil
+
+	case domain.SNSTypeNotification:
+		var notification domain.S3EventNotification
+		if err := json.Unmarshal([]byte(msg.Message), &notification); err != nil {
+			return nil, response.NewValidationError("invalid S3 event in SNS message", err.Error())
+		}
+		result := s.HandleNotification(ctx, &notification)
+		return &result, nil
+
+	default:
+		s.logger.Info("Ignoring SNS message",
+			zap.String("type", msg.Type),
+			zap.String("topicArn", msg.TopicArn),
+		)
+		return &domain.UploadEventResult{}, nil
+	}
+}
+
+// confirmSubscription confirms an SNS HTTP subscription by visiting its SubscribeURL
+// SSRF 방지를 위해 AWS SNS 엔드포인트만 허용합니다.
+func (s *UploadEventService) confirmSubscription(ctx context.Context, msg *domain.SNSMessage) error {
+	subscribeURL, err := url.Parse(msg.SubscribeURL)
+	if err != nil || subscribeURL.Scheme != "https" ||
+		!strings.HasPrefix(subscribeURL.Hostname(), "sns.") ||
+		!(strings.HasSuffix(subscribeURL.Hostname(), ".amazonaws.com") || strings.HasSuffix(subscribeURL.Hostname(), ".amazonaws.com.cn")) {
+		return response.NewValidationError("invalid SNS subscribe URL", msg.SubscribeURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create subscribe request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm SNS subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to confirm SNS subscription: status %d", resp.StatusCode)
+	}
+
+	s.logger.Info("SNS subscription confirmed",
+		zap.String("topicArn", msg.TopicArn),
+	)
+	return nil
+}
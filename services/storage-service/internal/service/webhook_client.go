@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"storage-service/internal/response"
+)
+
+// ErrWebhookPrivateAddress is returned when a webhook endpoint resolves to a loopback, private or link-local address
+var ErrWebhookPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// newWebhookHTTPClient creates the HTTP client used for webhook deliveries.
+// Redirects are not followed so an endpoint cannot bounce requests to another host.
+// allowPrivate가 false이면 연결 시점에 사설/루프백/링크 로컬 주소를 차단하여 SSRF를 막습니다.
+// (DNS 재바인딩도 실제 연결 주소로 검사하므로 우회할 수 없습니다)
+func newWebhookHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return ErrWebhookPrivateAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// isPrivateIP returns true for addresses that must not be reachable from webhooks
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// validateWebhookURL rejects endpoints the service must not call
+// 기본적으로 https와 공개 호스트만 허용하며, 사설망 허용 시 사내 도구용 http도 허용합니다.
+// 호스트 이름이 사설 주소로 해석되는 경우는 전송 시점에 HTTP 클라이언트가 차단합니다.
+func validateWebhookURL(endpoint string, allowPrivate bool) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" || u.User != nil {
+		return response.NewValidationError("invalid webhook url", "")
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && allowPrivate) {
+		return response.NewValidationError("webhook url must use https", "")
+	}
+	if allowPrivate {
+		return nil
+	}
+	if ip := net.ParseIP(u.Hostname()); (ip != nil && isPrivateIP(ip)) || u.Hostname() == "localhost" {
+		return response.NewValidationError("webhook url must be a public host", "")
+	}
+	return nil
+}
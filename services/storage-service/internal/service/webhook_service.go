@@ -0,0 +1,422 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// Webhook request headers
+const (
+	WebhookHeaderEvent     = "X-Wealist-Event"
+	WebhookHeaderDelivery  = "X-Wealist-Delivery"
+	WebhookHeaderTimestamp = "X-Wealist-Timestamp"
+	WebhookHeaderSignature = "X-Wealist-Signature"
+)
+
+// webhookRetryDelays defines the wait before each delivery attempt (first attempt is immediate)
+// 재시도는 전송 기록의 next_attempt_at으로 예약되므로 서비스가 재시작돼도 유실되지 않습니다.
+var webhookRetryDelays = []time.Duration{0, 5 * time.Second, 30 * time.Second, 2 * time.Minute, 10 * time.Minute}
+
+// webhookRetryBatchSize limits the retries sent per retry job run
+const webhookRetryBatchSize = 100
+
+// FileEventPublisher publishes file lifecycle events
+// FileService는 이 인터페이스를 통해 이벤트를 발행하며, nil이면 발행하지 않습니다.
+type FileEventPublisher interface {
+	PublishFileEvent(ctx context.Context, event domain.FileEventType, file *domain.File, actorID uuid.UUID)
+}
+
+// WebhookService handles project webhooks and event delivery
+type WebhookService struct {
+	webhookRepo *repository.WebhookRepository
+	projectRepo repository.ProjectRepository
+	s3Client    *client.S3Client
+	httpClient  *http.Client
+	logger      *zap.Logger
+
+	allowPrivateNetworks bool
+}
+
+// NewWebhookService creates a new WebhookService
+func NewWebhookService(
+	webhookRepo *repository.WebhookRepository,
+	projectRepo repository.ProjectRepository,
+	s3Client *client.S3Client,
+	allowPrivateNetworks bool,
+	logger *zap.Logger,
+) *WebhookService {
+	return &WebhookService{
+		webhookRepo:          webhookRepo,
+		projectRepo:          projectRepo,
+		s3Client:             s3Client,
+		httpClient:           newWebhookHTTPClient(10*time.Second, allowPrivateNetworks),
+		logger:               logger,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// generateWebhookSecret generates a random signing secret
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload computes the signature sent in the X-Wealist-Signature header
+// 서명 대상: "{timestamp}.{body}" 의 HMAC-SHA256
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// validateEvents validates a list of subscribed events
+func validateEvents(events []domain.FileEventType) error {
+	if len(events) == 0 {
+		return response.NewValidationError("at least one event is required", "")
+	}
+	for _, e := range events {
+		if !e.IsValid() {
+			return response.NewValidationError("unsupported event type", string(e))
+		}
+	}
+	return nil
+}
+
+// CreateWebhook registers a webhook on a project
+func (s *WebhookService) CreateWebhook(ctx context.Context, projectID uuid.UUID, req domain.CreateWebhookRequest, userID uuid.UUID) (*domain.WebhookResponse, error) {
+	if err := validateWebhookURL(req.URL, s.allowPrivateNetworks); err != nil {
+		return nil, err
+	}
+	if err := validateEvents(req.Events); err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	now := time.Now()
+	webhook := &domain.Webhook{
+		ID:          uuid.New(),
+		WorkspaceID: project.WorkspaceID,
+		ProjectID:   projectID,
+		URL:         req.URL,
+		Secret:      secret,
+		Events:      domain.JoinEvents(req.Events),
+		IsActive:    true,
+		CreatedBy:   userID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook created",
+		zap.String("webhookId", webhook.ID.String()),
+		zap.String("projectId", projectID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	// 시크릿은 생성 시에만 반환
+	resp := webhook.ToResponse()
+	resp.Secret = secret
+	return &resp, nil
+}
+
+// GetProjectWebhooks lists webhooks of a project
+func (s *WebhookService) GetProjectWebhooks(ctx context.Context, projectID uuid.UUID) ([]domain.WebhookResponse, error) {
+	webhooks, err := s.webhookRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	responses := make([]domain.WebhookResponse, 0, len(webhooks))
+	for i := range webhooks {
+		responses = append(responses, webhooks[i].ToResponse())
+	}
+	return responses, nil
+}
+
+// getProjectWebhook finds a webhook and verifies it belongs to the project
+func (s *WebhookService) getProjectWebhook(ctx context.Context, projectID, webhookID uuid.UUID) (*domain.Webhook, error) {
+	webhook, err := s.webhookRepo.FindByID(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("webhook not found", webhookID.String())
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if webhook.ProjectID != projectID {
+		return nil, response.NewNotFoundError("webhook not found", webhookID.String())
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook updates a webhook
+func (s *WebhookService) UpdateWebhook(ctx context.Context, projectID, webhookID uuid.UUID, req domain.UpdateWebhookRequest, userID uuid.UUID) (*domain.WebhookResponse, error) {
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL, s.allowPrivateNetworks); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Events != nil {
+		if err := validateEvents(req.Events); err != nil {
+			return nil, err
+		}
+		webhook.Events = domain.JoinEvents(req.Events)
+	}
+	if req.IsActive != nil {
+		webhook.IsActive = *req.IsActive
+	}
+	webhook.UpdatedAt = time.Now()
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook updated",
+		zap.String("webhookId", webhookID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	resp := webhook.ToResponse()
+	return &resp, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (s *WebhookService) DeleteWebhook(ctx context.Context, projectID, webhookID uuid.UUID, userID uuid.UUID) error {
+	if _, err := s.getProjectWebhook(ctx, projectID, webhookID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook deleted",
+		zap.String("webhookId", webhookID.String()),
+		zap.String("userId", userID.String()),
+	)
+	return nil
+}
+
+// GetDeliveries lists delivery attempts of a webhook
+func (s *WebhookService) GetDeliveries(ctx context.Context, projectID, webhookID uuid.UUID, page, pageSize int) (*domain.WebhookDeliveryListResponse, error) {
+	if _, err := s.getProjectWebhook(ctx, projectID, webhookID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	deliveries, total, err := s.webhookRepo.FindDeliveries(ctx, webhookID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries: %w", err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &domain.WebhookDeliveryListResponse{
+		Deliveries: deliveries,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// PublishFileEvent delivers a file event to all subscribed webhooks of the file's project
+// 전달은 비동기로 수행되며 요청 처리 흐름을 막지 않습니다.
+func (s *WebhookService) PublishFileEvent(ctx context.Context, event domain.FileEventType, file *domain.File, actorID uuid.UUID) {
+	if file.ProjectID == nil {
+		return
+	}
+
+	webhooks, err := s.webhookRepo.FindActiveByProjectID(ctx, *file.ProjectID)
+	if err != nil {
+		s.logger.Error("Failed to find webhooks for event",
+			zap.String("event", string(event)),
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	fileURL := ""
+	if s.s3Client != nil {
+		fileURL = s.s3Client.GetFileURL(file.FileKey)
+	}
+
+	payload := domain.FileEventPayload{
+		EventID:     uuid.New(),
+		Event:       event,
+		OccurredAt:  time.Now().UTC(),
+		WorkspaceID: file.WorkspaceID,
+		ProjectID:   file.ProjectID,
+		ActorID:     actorID,
+		File:        file.ToResponse(fileURL),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal webhook payload", zap.Error(err))
+		return
+	}
+
+	for i := range webhooks {
+		if !webhooks[i].Subscribes(event) {
+			continue
+		}
+		go s.deliver(context.Background(), webhooks[i], payload.EventID, event, body, 1)
+	}
+}
+
+// RetryDueDeliveries resends failed deliveries whose retry is due and returns how many were sent
+// 비활성화되었거나 삭제된 웹훅의 재시도는 전송하지 않습니다.
+func (s *WebhookService) RetryDueDeliveries(ctx context.Context) (int, error) {
+	deliveries, err := s.webhookRepo.ClaimDueRetries(ctx, webhookRetryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook retries: %w", err)
+	}
+
+	sent := 0
+	for _, d := range deliveries {
+		webhook, err := s.webhookRepo.FindByID(ctx, d.WebhookID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				s.logger.Warn("Failed to load webhook for retry",
+					zap.String("webhookId", d.WebhookID.String()),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+		if !webhook.IsActive {
+			continue
+		}
+
+		s.deliver(ctx, *webhook, d.EventID, d.EventType, []byte(d.Payload), d.Attempt+1)
+		sent++
+	}
+	return sent, nil
+}
+
+// deliver performs one delivery attempt and records it, scheduling the next retry on failure
+func (s *WebhookService) deliver(ctx context.Context, webhook domain.Webhook, eventID uuid.UUID, event domain.FileEventType, body []byte, attempt int) {
+	delivery := s.attempt(ctx, webhook, eventID, event, body, attempt)
+	if !delivery.Success {
+		if attempt < len(webhookRetryDelays) {
+			next := time.Now().Add(webhookRetryDelays[attempt])
+			delivery.NextAttemptAt = &next
+		} else {
+			s.logger.Warn("Webhook delivery failed after all retries",
+				zap.String("webhookId", webhook.ID.String()),
+				zap.String("eventId", eventID.String()),
+				zap.Int("attempts", attempt),
+			)
+		}
+	}
+
+	if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		s.logger.Warn("Failed to record webhook delivery",
+			zap.String("webhookId", webhook.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// attempt performs a single signed HTTP delivery
+func (s *WebhookService) attempt(ctx context.Context, webhook domain.Webhook, eventID uuid.UUID, event domain.FileEventType, body []byte, attempt int) *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		EventType: event,
+		Attempt:   attempt,
+		Payload:   string(body),
+		CreatedAt: time.Now(),
+	}
+
+	setError := func(msg string) {
+		if len(msg) > 1024 {
+			msg = msg[:1024]
+		}
+		delivery.Error = &msg
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		setError(err.Error())
+		return delivery
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "wealist-storage-webhook/1.0")
+	req.Header.Set(WebhookHeaderEvent, string(event))
+	req.Header.Set(WebhookHeaderDelivery, eventID.String())
+	req.Header.Set(WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	start := time.Now()
+	resp, err := s.httpClient.Do(req)
+	delivery.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		setError(err.Error())
+		return delivery
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	delivery.StatusCode = resp.StatusCode
+	delivery.Success = resp.StatusCode >= 200 && resp.StatusCode < 300
+	if !delivery.Success {
+		setError(fmt.Sprintf("unexpected status code %d", resp.StatusCode))
+	}
+	return delivery
+}
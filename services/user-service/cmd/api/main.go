@@ -23,6 +23,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -32,10 +33,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
 
 	_ "user-service/docs" // Swagger docs import
 
+	commonauth "github.com/OrangesCloud/wealist-advanced-go-pkg/auth"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/profiling"
 	"user-service/internal/client"
 	"user-service/internal/config"
 	"user-service/internal/database"
@@ -52,13 +61,20 @@ func main() {
 	}
 
 	// Initialize logger
-	logger, err := initLogger(cfg.Logger.Level)
+	logger, logLevel, err := initLogger(cfg.Logger.Level)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
 		os.Exit(1)
 	}
 	defer func() { _ = logger.Sync() }()
 
+	// Runtime settings hot-reload (RUNTIME_CONFIG_PATH, SIGHUP)
+	runtimeCfg := commonconfig.WatcherFromEnv(logger)
+	runtimeCfg.WatchLogLevel(logLevel)
+	runtimeCtx, stopRuntime := context.WithCancel(context.Background())
+	defer stopRuntime()
+	go runtimeCfg.Run(runtimeCtx)
+
 	// Initialize OpenTelemetry
 	ctx := context.Background()
 	otelCfg := otel.DefaultConfig("user-service")
@@ -98,6 +114,7 @@ func main() {
 		MaxOpenConns:    cfg.Database.MaxOpenConns,
 		MaxIdleConns:    cfg.Database.MaxIdleConns,
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		ReplicaDSNs:     cfg.Database.ReplicaDSNs,
 	}
 
 	// Retry up to 30 times (5s interval = ~2.5 minutes total wait)
@@ -117,6 +134,15 @@ func main() {
 		logger.Info("GORM OpenTelemetry tracing enabled")
 	}
 
+	// Route heavy list reads to read replicas (optional)
+	replicas, err := database.EnableReadReplicas(db, dbConfig, logger)
+	if err != nil {
+		logger.Warn("Failed to enable read replicas, all reads use primary", zap.Error(err))
+	} else if replicas != nil {
+		defer replicas.Close()
+		logger.Info("Read replica routing enabled", zap.Int("replicas", len(dbConfig.ReplicaDSNs)))
+	}
+
 	// Run auto migration (conditional based on DB_AUTO_MIGRATE env)
 	if cfg.Database.AutoMigrate {
 		logger.Info("Running database migrations (DB_AUTO_MIGRATE=true)")
@@ -175,24 +201,95 @@ func main() {
 		if jwtIssuer == "" {
 			jwtIssuer = "wealist-auth-service"
 		}
-		tokenValidator = middleware.NewSmartValidator(cfg.AuthAPI.BaseURL, jwtIssuer, logger)
+		smartValidator := middleware.NewSmartValidator(cfg.AuthAPI.BaseURL, jwtIssuer, logger)
+		// 로그아웃 등으로 폐기된 토큰 즉시 거부 (Redis 없으면 생략)
+		if redisClient := database.GetRedis(); redisClient != nil {
+			smartValidator.WithRevocation(commonauth.NewRedisRevocationStore(redisClient))
+		}
+		tokenValidator = smartValidator
 		logger.Info("SmartValidator initialized",
 			zap.String("auth_api_url", cfg.AuthAPI.BaseURL),
-			zap.String("jwt_issuer", jwtIssuer))
+			zap.String("jwt_issuer", jwtIssuer),
+			zap.Bool("revocation_check", database.GetRedis() != nil))
 	}
 
 	// Setup router
-	r := router.Setup(router.Config{
+	routerCfg := router.Config{
 		DB:              db,
+		Replicas:        replicas,
 		Logger:          logger,
 		JWTSecret:       cfg.JWT.Secret,
 		BasePath:        cfg.Server.BasePath,
 		S3Client:        s3Client,
 		TokenValidator:  tokenValidator,
+		AuthServiceURL:  cfg.AuthAPI.BaseURL,
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
 		ServiceName:     "user-service",
-	})
+		Runtime:         runtimeCfg,
+	}
+
+	// srv.Shutdown 이후 실행할 정리 단계
+	shutdown := lifecycle.NewShutdown(logger)
+	// pprof/런타임 통계 내부 포트 (PROFILING_ADDR가 비어 있으면 비활성화)
+	shutdown.Add("profiling", profiling.Start(profiling.ConfigFromEnv(), logger))
+
+	// Domain events (member changes) on the platform event bus
+	if cfg.Events.NATSURL != "" {
+		eventPublisher := messaging.NewEventPublisher(messaging.Config{URL: cfg.Events.NATSURL, Name: "user-service"}, logger)
+		defer eventPublisher.Close()
+
+		if cfg.Events.Outbox {
+			// 멤버 변경과 같은 트랜잭션에 이벤트를 기록하고 릴레이가 발행 (커밋 후 유실 없음)
+			if err := outbox.Migrate(db); err != nil {
+				logger.Fatal("Failed to migrate outbox table", zap.Error(err))
+			}
+			routerCfg.Outbox = outbox.New(db, "user-service")
+
+			relayCtx, stopRelay := context.WithCancel(context.Background())
+			defer stopRelay()
+			relay := outbox.NewRelay(db, eventPublisher, outbox.RelayConfig{}, logger)
+			go relay.Run(relayCtx)
+			// 마지막 요청까지 커밋된 아웃박스 이벤트를 종료 전에 한 번 더 발행
+			shutdown.Add("outbox", relay.Flush)
+			logger.Info("Domain event publishing enabled (transactional outbox)")
+		} else {
+			routerCfg.Events = messaging.NewEmitter(eventPublisher, "user-service", logger)
+			logger.Info("Domain event publishing enabled")
+		}
+	}
+
+	// Internal gRPC API (alongside REST, same services)
+	// REST /internal 그룹처럼 항상 users:read scope의 서비스 토큰 필요 (검증기가 없으면 gRPC 서버를 열지 않음)
+	var grpcServer *grpc.Server
+	if cfg.Server.GRPCPort != "" {
+		if serviceValidator, ok := tokenValidator.(middleware.ServiceTokenValidator); ok {
+			grpcServer = internalrpc.NewServer(internalrpc.ServerConfig{
+				Logger:           logger,
+				ServiceValidator: serviceValidator,
+				Scopes: map[string][]string{
+					internalrpc.UserServiceValidateMemberMethod: {"users:read"},
+					internalrpc.UserServiceGetProfileMethod:     {"users:read"},
+				},
+			})
+			routerCfg.GRPCServer = grpcServer
+		} else {
+			logger.Warn("gRPC server disabled: service token validation requires AUTH_SERVICE_URL")
+		}
+	}
+
+	// Workspace export/import: board/storage sections through their internal backup routes
+	routerCfg.Shutdown = shutdown
+	if cfg.Backup.BoardBaseURL != "" {
+		routerCfg.BackupSources = append(routerCfg.BackupSources,
+			client.NewBackupSource("board", cfg.Backup.BoardBaseURL, client.BoardBackupPath, cfg.Backup.InternalAPIKey, cfg.Backup.Timeout))
+	}
+	if cfg.Backup.StorageBaseURL != "" {
+		routerCfg.BackupSources = append(routerCfg.BackupSources,
+			client.NewBackupSource("storage", cfg.Backup.StorageBaseURL, client.StorageBackupPath, cfg.Backup.InternalAPIKey, cfg.Backup.Timeout))
+	}
+
+	r := router.Setup(routerCfg)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -213,6 +310,19 @@ func main() {
 		}
 	}()
 
+	if grpcServer != nil {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Server.GRPCPort))
+		if err != nil {
+			logger.Fatal("Failed to listen for gRPC", zap.Error(err))
+		}
+		go func() {
+			logger.Info("User Service gRPC server started", zap.String("address", lis.Addr().String()))
+			if err := grpcServer.Serve(lis); err != nil {
+				logger.Fatal("Failed to start gRPC server", zap.Error(err))
+			}
+		}()
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -226,11 +336,15 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
+	shutdown.Run(ctx)
 
 	logger.Info("Server exited gracefully")
 }
 
-func initLogger(level string) (*zap.Logger, error) {
+func initLogger(level string) (*zap.Logger, zap.AtomicLevel, error) {
 	var zapLevel zapcore.Level
 	switch level {
 	case "debug":
@@ -245,8 +359,9 @@ func initLogger(level string) (*zap.Logger, error) {
 		zapLevel = zapcore.InfoLevel
 	}
 
+	atomicLevel := zap.NewAtomicLevelAt(zapLevel)
 	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapLevel),
+		Level:            atomicLevel,
 		Development:      zapLevel == zapcore.DebugLevel,
 		Encoding:         "json",
 		EncoderConfig:    zap.NewProductionEncoderConfig(),
@@ -254,5 +369,6 @@ func initLogger(level string) (*zap.Logger, error) {
 		ErrorOutputPaths: []string{"stderr"},
 	}
 
-	return config.Build()
+	logger, err := config.Build()
+	return logger, atomicLevel, err
 }
@@ -0,0 +1,71 @@
+// Command seed fills the user-service database with the shared development dataset
+// (users, workspaces, members, profiles). Run it before the other services' seed commands.
+//
+//	go run ./cmd/seed [-migrate]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"user-service/internal/config"
+	"user-service/internal/database"
+	"user-service/internal/seed"
+)
+
+func main() {
+	migrate := flag.Bool("migrate", false, "run auto migration before seeding")
+	flag.Parse()
+
+	if err := commonseed.CheckEnvironment(os.Getenv("ENV")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load("configs/config.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	log, err := commonlogger.NewWithConfig(&commonlogger.Config{Level: "info", OutputPath: "stdout", Encoding: "console"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = log.Sync() }()
+
+	db, err := database.New(database.Config{
+		DSN:             cfg.Database.GetDSN(),
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+	})
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	if *migrate {
+		if err := database.AutoMigrate(db); err != nil {
+			log.Fatal("Failed to run database migrations", zap.Error(err))
+		}
+	}
+
+	data := commonseed.Default()
+	if err := seed.Run(context.Background(), db, data); err != nil {
+		log.Fatal("Failed to seed user-service", zap.Error(err))
+	}
+
+	log.Info("Seeded user-service",
+		zap.Int("users", len(data.Users)),
+		zap.Int("workspaces", len(data.Workspaces)))
+	for _, u := range data.Users {
+		log.Info("Seed user (sign in with a magic link)", zap.String("email", u.Email), zap.String("name", u.Name))
+	}
+}
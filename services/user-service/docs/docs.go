@@ -36,6 +36,7 @@ const docTemplate = `{
                     "Internal"
                 ],
                 "summary": "Find or create user for OAuth login (internal)",
+                "operationId": "oAuthLogin",
                 "parameters": [
                     {
                         "description": "OAuth login request",
@@ -81,6 +82,7 @@ const docTemplate = `{
                     "Internal"
                 ],
                 "summary": "Check if user exists (internal)",
+                "operationId": "userExists",
                 "parameters": [
                     {
                         "type": "string",
@@ -120,6 +122,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Create user profile",
+                "operationId": "createProfile",
                 "parameters": [
                     {
                         "description": "Create profile request",
@@ -161,6 +164,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Get all my profiles",
+                "operationId": "getAllMyProfiles",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -188,6 +192,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Get my profile for workspace",
+                "operationId": "getMyProfile",
                 "parameters": [
                     {
                         "type": "string",
@@ -228,6 +233,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Update my profile",
+                "operationId": "updateProfile",
                 "parameters": [
                     {
                         "type": "string",
@@ -279,6 +285,7 @@ const docTemplate = `{
                     "Profile Images"
                 ],
                 "summary": "Confirm profile image (link attachment to profile)",
+                "operationId": "confirmProfileImage",
                 "parameters": [
                     {
                         "type": "string",
@@ -330,6 +337,7 @@ const docTemplate = `{
                     "Profile Images"
                 ],
                 "summary": "Save attachment metadata after S3 upload",
+                "operationId": "saveAttachment",
                 "parameters": [
                     {
                         "description": "Save attachment request",
@@ -374,6 +382,7 @@ const docTemplate = `{
                     "Profile Images"
                 ],
                 "summary": "Generate presigned URL for profile image upload",
+                "operationId": "generatePresignedURL",
                 "parameters": [
                     {
                         "description": "Presigned URL request",
@@ -415,6 +424,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Delete my profile for workspace",
+                "operationId": "deleteProfile",
                 "parameters": [
                     {
                         "type": "string",
@@ -448,6 +458,7 @@ const docTemplate = `{
                     "Profiles"
                 ],
                 "summary": "Get user profile by workspace and user ID",
+                "operationId": "getUserProfile",
                 "parameters": [
                     {
                         "type": "string",
@@ -492,6 +503,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Create a new user",
+                "operationId": "createUser",
                 "parameters": [
                     {
                         "description": "Create user request",
@@ -533,6 +545,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Get current user",
+                "operationId": "getMe",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -561,6 +574,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Delete current user (soft delete)",
+                "operationId": "deleteMe",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -591,6 +605,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Get user by ID",
+                "operationId": "getUser",
                 "parameters": [
                     {
                         "type": "string",
@@ -631,6 +646,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Update user",
+                "operationId": "updateUser",
                 "parameters": [
                     {
                         "type": "string",
@@ -679,6 +695,7 @@ const docTemplate = `{
                     "Users"
                 ],
                 "summary": "Restore deleted user",
+                "operationId": "restoreUser",
                 "parameters": [
                     {
                         "type": "string",
@@ -718,6 +735,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Get all workspaces for current user",
+                "operationId": "getAllWorkspaces",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -748,6 +766,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Create a new workspace",
+                "operationId": "createWorkspace",
                 "parameters": [
                     {
                         "description": "Create workspace request",
@@ -792,6 +811,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Set default workspace for user",
+                "operationId": "setDefaultWorkspace",
                 "parameters": [
                     {
                         "description": "Workspace ID",
@@ -833,6 +853,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Update workspace",
+                "operationId": "updateWorkspace",
                 "parameters": [
                     {
                         "type": "string",
@@ -884,6 +905,7 @@ const docTemplate = `{
                     "Join Requests"
                 ],
                 "summary": "Create join request for workspace",
+                "operationId": "createJoinRequest",
                 "parameters": [
                     {
                         "description": "Join request",
@@ -914,6 +936,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Search public workspaces by name",
+                "operationId": "searchPublicWorkspaces",
                 "parameters": [
                     {
                         "type": "string",
@@ -950,6 +973,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Get workspace by ID",
+                "operationId": "getWorkspace",
                 "parameters": [
                     {
                         "type": "string",
@@ -987,6 +1011,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Delete workspace (soft delete)",
+                "operationId": "deleteWorkspace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1026,6 +1051,7 @@ const docTemplate = `{
                     "Join Requests"
                 ],
                 "summary": "Get join requests for workspace",
+                "operationId": "getJoinRequests",
                 "parameters": [
                     {
                         "type": "string",
@@ -1065,6 +1091,7 @@ const docTemplate = `{
                     "Join Requests"
                 ],
                 "summary": "Process join request (approve/reject)",
+                "operationId": "processJoinRequest",
                 "parameters": [
                     {
                         "type": "string",
@@ -1114,6 +1141,7 @@ const docTemplate = `{
                     "Workspace Members"
                 ],
                 "summary": "Get workspace members",
+                "operationId": "getMembers",
                 "parameters": [
                     {
                         "type": "string",
@@ -1153,6 +1181,7 @@ const docTemplate = `{
                     "Workspace Members"
                 ],
                 "summary": "Invite user to workspace",
+                "operationId": "inviteMember",
                 "parameters": [
                     {
                         "type": "string",
@@ -1201,6 +1230,7 @@ const docTemplate = `{
                     "Workspace Members"
                 ],
                 "summary": "Remove member from workspace",
+                "operationId": "removeMember",
                 "parameters": [
                     {
                         "type": "string",
@@ -1250,6 +1280,7 @@ const docTemplate = `{
                     "Workspace Members"
                 ],
                 "summary": "Update member role",
+                "operationId": "updateMemberRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -1305,6 +1336,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Get workspace settings",
+                "operationId": "getWorkspaceSettings",
                 "parameters": [
                     {
                         "type": "string",
@@ -1339,6 +1371,7 @@ const docTemplate = `{
                     "Workspaces"
                 ],
                 "summary": "Update workspace settings",
+                "operationId": "updateWorkspaceSettings",
                 "parameters": [
                     {
                         "type": "string",
@@ -1381,6 +1414,7 @@ const docTemplate = `{
                     "Workspace Members"
                 ],
                 "summary": "Validate user has access to workspace",
+                "operationId": "validateMember",
                 "parameters": [
                     {
                         "type": "string",
@@ -0,0 +1,170 @@
+// Package archive는 워크스페이스 백업 아카이브(tar.gz) 형식을 읽고 씁니다.
+//
+// 아카이브는 manifest.json과 서비스별 섹션 파일(<name>.json)로 구성됩니다.
+// manifest에는 형식 버전과 섹션별 SHA-256/크기가 기록되어, 읽을 때 손상되거나
+// 다른 버전으로 만든 아카이브를 복원 전에 거부합니다.
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// FormatVersion은 이 코드가 쓰는 아카이브 형식 버전입니다.
+	FormatVersion = 1
+	// ManifestFile은 아카이브의 첫 항목인 manifest 파일 이름입니다.
+	ManifestFile = "manifest.json"
+	// MaxSectionBytes는 섹션 하나의 최대 크기입니다 (압축 해제 기준, 손상된 아카이브 방어).
+	MaxSectionBytes = 512 << 20
+)
+
+var (
+	// ErrUnsupportedVersion은 다른 형식 버전으로 만든 아카이브입니다.
+	ErrUnsupportedVersion = errors.New("unsupported archive format version")
+	// ErrCorrupt는 manifest와 섹션 내용이 일치하지 않는 아카이브입니다.
+	ErrCorrupt = errors.New("corrupt archive")
+)
+
+// Manifest는 아카이브의 메타데이터입니다.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	WorkspaceID   uuid.UUID `json:"workspaceId"`
+	JobID         uuid.UUID `json:"jobId"`
+	ExportedAt    time.Time `json:"exportedAt"`
+	Sections      []Section `json:"sections"`
+}
+
+// Section은 서비스별 섹션 파일의 목록 항목입니다.
+type Section struct {
+	Name   string `json:"name"` // 섹션을 만든 서비스 (user, board, storage)
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+	Bytes  int64  `json:"bytes"`
+}
+
+// Write는 섹션을 이름순으로 담은 아카이브를 w에 씁니다.
+// manifest의 FormatVersion과 Sections는 여기서 채웁니다.
+func Write(w io.Writer, manifest Manifest, sections map[string]json.RawMessage) error {
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	manifest.FormatVersion = FormatVersion
+	manifest.Sections = make([]Section, len(names))
+	for i, name := range names {
+		sum := sha256.Sum256(sections[name])
+		manifest.Sections[i] = Section{
+			Name:   name,
+			File:   name + ".json",
+			SHA256: hex.EncodeToString(sum[:]),
+			Bytes:  int64(len(sections[name])),
+		}
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeFile(tw, ManifestFile, manifestData, manifest.ExportedAt); err != nil {
+		return err
+	}
+	for _, section := range manifest.Sections {
+		if err := writeFile(tw, section.File, sections[section.Name], manifest.ExportedAt); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return gz.Close()
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s header: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Read는 아카이브를 읽고 형식 버전과 섹션별 체크섬을 확인합니다.
+// 반환하는 섹션 맵의 키는 섹션 이름입니다.
+func Read(r io.Reader) (*Manifest, map[string]json.RawMessage, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > MaxSectionBytes {
+			return nil, nil, fmt.Errorf("%w: %s is too large", ErrCorrupt, header.Name)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, MaxSectionBytes))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		files[header.Name] = data
+	}
+
+	manifestData, ok := files[ManifestFile]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: missing %s", ErrCorrupt, ManifestFile)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid manifest: %v", ErrCorrupt, err)
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.FormatVersion)
+	}
+
+	sections := make(map[string]json.RawMessage, len(manifest.Sections))
+	for _, section := range manifest.Sections {
+		data, ok := files[section.File]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: missing section %s", ErrCorrupt, section.Name)
+		}
+		sum := sha256.Sum256(data)
+		if int64(len(data)) != section.Bytes || hex.EncodeToString(sum[:]) != section.SHA256 {
+			return nil, nil, fmt.Errorf("%w: checksum mismatch in section %s", ErrCorrupt, section.Name)
+		}
+		sections[section.Name] = data
+	}
+	return &manifest, sections, nil
+}
@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func writeTestArchive(t *testing.T, sections map[string]json.RawMessage) ([]byte, Manifest) {
+	t.Helper()
+	manifest := Manifest{
+		WorkspaceID: uuid.New(),
+		JobID:       uuid.New(),
+		ExportedAt:  time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	var buf bytes.Buffer
+	if err := Write(&buf, manifest, sections); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	return buf.Bytes(), manifest
+}
+
+// writeWithManifest writes the manifest as given, without recomputing the section checksums
+func writeWithManifest(buf *bytes.Buffer, manifest *Manifest, sections map[string]json.RawMessage) error {
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	if err := writeFile(tw, ManifestFile, manifestData, manifest.ExportedAt); err != nil {
+		return err
+	}
+	for _, section := range manifest.Sections {
+		if err := writeFile(tw, section.File, sections[section.Name], manifest.ExportedAt); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func TestWriteRead_RoundTrip(t *testing.T) {
+	sections := map[string]json.RawMessage{
+		"user":    json.RawMessage(`{"workspace":{"workspaceName":"wealist"}}`),
+		"board":   json.RawMessage(`{"projects":[]}`),
+		"storage": json.RawMessage(`{"files":[]}`),
+	}
+	data, written := writeTestArchive(t, sections)
+
+	manifest, got, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if manifest.FormatVersion != FormatVersion || manifest.WorkspaceID != written.WorkspaceID || manifest.JobID != written.JobID {
+		t.Errorf("unexpected manifest: %+v", manifest)
+	}
+	if len(manifest.Sections) != 3 || manifest.Sections[0].Name != "board" || manifest.Sections[2].Name != "user" {
+		t.Errorf("sections should be listed by name, got %+v", manifest.Sections)
+	}
+	for name, want := range sections {
+		if string(got[name]) != string(want) {
+			t.Errorf("section %s = %s, want %s", name, got[name], want)
+		}
+	}
+}
+
+func TestWrite_Deterministic(t *testing.T) {
+	sections := map[string]json.RawMessage{"a": json.RawMessage(`1`), "b": json.RawMessage(`2`), "c": json.RawMessage(`3`)}
+	manifest := Manifest{WorkspaceID: uuid.New(), JobID: uuid.New(), ExportedAt: time.Unix(0, 0).UTC()}
+
+	var first, second bytes.Buffer
+	if err := Write(&first, manifest, sections); err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(&second, manifest, sections); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("the same sections should produce the same archive")
+	}
+}
+
+func TestRead_Rejects(t *testing.T) {
+	valid, _ := writeTestArchive(t, map[string]json.RawMessage{"user": json.RawMessage(`{"members":[]}`)})
+
+	tests := []struct {
+		name    string
+		data    func() []byte
+		wantErr error
+	}{
+		{
+			name:    "gzip가 아님",
+			data:    func() []byte { return []byte("not an archive") },
+			wantErr: ErrCorrupt,
+		},
+		{
+			name: "섹션 내용 변경",
+			data: func() []byte {
+				manifest, sections, err := Read(bytes.NewReader(valid))
+				if err != nil {
+					t.Fatal(err)
+				}
+				// manifest는 그대로 두고 섹션만 바꿔 체크섬이 어긋나게 함
+				var buf bytes.Buffer
+				sections["user"] = json.RawMessage(`{"members":[{}]}`)
+				if err := writeWithManifest(&buf, manifest, sections); err != nil {
+					t.Fatal(err)
+				}
+				return buf.Bytes()
+			},
+			wantErr: ErrCorrupt,
+		},
+		{
+			name: "다른 형식 버전",
+			data: func() []byte {
+				manifest, sections, err := Read(bytes.NewReader(valid))
+				if err != nil {
+					t.Fatal(err)
+				}
+				manifest.FormatVersion = FormatVersion + 1
+				var buf bytes.Buffer
+				if err := writeWithManifest(&buf, manifest, sections); err != nil {
+					t.Fatal(err)
+				}
+				return buf.Bytes()
+			},
+			wantErr: ErrUnsupportedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Read(bytes.NewReader(tt.data()))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
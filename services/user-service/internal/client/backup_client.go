@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiclient"
+)
+
+// Backup section endpoints of the other services (default base paths: board "", storage "/api")
+const (
+	BoardBackupPath   = "/internal/workspaces/%s/backup"
+	StorageBackupPath = "/api/internal/storage/workspaces/%s/backup"
+)
+
+// BackupSource exports and imports one service's section of a workspace archive
+type BackupSource interface {
+	// Name is the section name in the archive (e.g. board, storage)
+	Name() string
+	Export(ctx context.Context, workspaceID uuid.UUID) (json.RawMessage, error)
+	Import(ctx context.Context, workspaceID uuid.UUID, section json.RawMessage) error
+}
+
+// httpBackupSource calls a service's internal backup endpoint with the shared internal API key
+type httpBackupSource struct {
+	name       string
+	pathFormat string
+	transport  *apiclient.Transport
+}
+
+// NewBackupSource creates a BackupSource for the service at baseURL.
+// pathFormat contains one %s for the workspace ID (see BoardBackupPath, StorageBackupPath).
+func NewBackupSource(name, baseURL, pathFormat, internalAPIKey string, timeout time.Duration) BackupSource {
+	return &httpBackupSource{
+		name:       name,
+		pathFormat: pathFormat,
+		transport: apiclient.NewTransport(baseURL, &http.Client{Timeout: timeout},
+			apiclient.WithHeader("x-internal-api-key", internalAPIKey)),
+	}
+}
+
+func (s *httpBackupSource) Name() string {
+	return s.name
+}
+
+func (s *httpBackupSource) Export(ctx context.Context, workspaceID uuid.UUID) (json.RawMessage, error) {
+	var section json.RawMessage
+	err := s.transport.Do(ctx, apiclient.Request{
+		Method: http.MethodGet,
+		Path:   fmt.Sprintf(s.pathFormat, apiclient.PathParam(workspaceID)),
+	}, &section)
+	if err != nil {
+		return nil, fmt.Errorf("%s export failed: %w", s.name, err)
+	}
+	return section, nil
+}
+
+func (s *httpBackupSource) Import(ctx context.Context, workspaceID uuid.UUID, section json.RawMessage) error {
+	err := s.transport.Do(ctx, apiclient.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf(s.pathFormat, apiclient.PathParam(workspaceID)),
+		Body:   section,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("%s import failed: %w", s.name, err)
+	}
+	return nil
+}
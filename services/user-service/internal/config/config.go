@@ -21,6 +21,25 @@ type Config struct {
 	CORS      CORSConfig      `yaml:"cors"`
 	S3        S3Config        `yaml:"s3"`
 	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Events    EventsConfig    `yaml:"events"`
+	Backup    BackupConfig    `yaml:"backup"`
+}
+
+// BackupConfig holds workspace export/import configuration
+// Archives are stored in the S3 bucket; board/storage sections are skipped when their URL is empty.
+type BackupConfig struct {
+	BoardBaseURL   string        `yaml:"board_base_url"`
+	StorageBaseURL string        `yaml:"storage_base_url"`
+	InternalAPIKey string        `yaml:"internal_api_key"` // Shared x-internal-api-key for the services' backup routes
+	Timeout        time.Duration `yaml:"timeout"`          // Per-service export/import request timeout
+}
+
+// EventsConfig holds domain event bus configuration
+type EventsConfig struct {
+	NATSURL string `yaml:"nats_url"` // Publishes member events when set (empty = disabled)
+	// Outbox records member events in the outbox_messages table within the member transaction
+	// and publishes them from a background relay (default: true)
+	Outbox bool `yaml:"outbox"`
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -33,7 +52,8 @@ type RateLimitConfig struct {
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port            string        `yaml:"port"`
-	Mode            string        `yaml:"mode"` // debug, release
+	GRPCPort        string        `yaml:"grpc_port"` // Internal gRPC API (empty = disabled)
+	Mode            string        `yaml:"mode"`      // debug, release
 	BasePath        string        `yaml:"base_path"`
 	ReadTimeout     time.Duration `yaml:"read_timeout"`
 	WriteTimeout    time.Duration `yaml:"write_timeout"`
@@ -52,6 +72,8 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	AutoMigrate     bool          `yaml:"auto_migrate"`
+	// ReplicaDSNs are read replica DSNs for heavy list endpoints (empty = primary only)
+	ReplicaDSNs []string `yaml:"replica_dsns"`
 }
 
 // LoggerConfig holds logger configuration
@@ -118,6 +140,7 @@ func getDefaultConfig() Config {
 	return Config{
 		Server: ServerConfig{
 			Port:            "8080",
+			GRPCPort:        "50051",
 			Mode:            "debug",
 			BasePath:        "/api",
 			ReadTimeout:     10 * time.Second,
@@ -150,6 +173,9 @@ func getDefaultConfig() Config {
 		CORS: CORSConfig{
 			AllowedOrigins: "*",
 		},
+		Events: EventsConfig{
+			Outbox: true,
+		},
 	}
 }
 
@@ -159,6 +185,16 @@ func (c *Config) overrideFromEnv() {
 	if port := os.Getenv("SERVER_PORT"); port != "" {
 		c.Server.Port = port
 	}
+	if port := os.Getenv("GRPC_PORT"); port != "" {
+		c.Server.GRPCPort = port
+	}
+	// NATS_URL - 도메인 이벤트(멤버 변경) 발행
+	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
+		c.Events.NATSURL = natsURL
+	}
+	if outboxEnabled := os.Getenv("EVENTS_OUTBOX"); outboxEnabled != "" {
+		c.Events.Outbox = outboxEnabled == "true"
+	}
 
 	if env := os.Getenv("ENV"); env != "" {
 		switch env {
@@ -214,6 +250,33 @@ func (c *Config) overrideFromEnv() {
 		c.Database.AutoMigrate = autoMigrate == "true"
 	}
 
+	// Connection pool tuning
+	if maxOpen := os.Getenv("DB_MAX_OPEN_CONNS"); maxOpen != "" {
+		if v, err := strconv.Atoi(maxOpen); err == nil {
+			c.Database.MaxOpenConns = v
+		}
+	}
+	if maxIdle := os.Getenv("DB_MAX_IDLE_CONNS"); maxIdle != "" {
+		if v, err := strconv.Atoi(maxIdle); err == nil {
+			c.Database.MaxIdleConns = v
+		}
+	}
+	if lifetime := os.Getenv("DB_CONN_MAX_LIFETIME"); lifetime != "" {
+		if d, err := time.ParseDuration(lifetime); err == nil {
+			c.Database.ConnMaxLifetime = d
+		}
+	}
+
+	// Read replicas (comma-separated DSNs)
+	if replicas := os.Getenv("DB_REPLICA_DSNS"); replicas != "" {
+		c.Database.ReplicaDSNs = nil
+		for _, dsn := range strings.Split(replicas, ",") {
+			if dsn = strings.TrimSpace(dsn); dsn != "" {
+				c.Database.ReplicaDSNs = append(c.Database.ReplicaDSNs, dsn)
+			}
+		}
+	}
+
 	// Logger
 	if level := os.Getenv("LOG_LEVEL"); level != "" {
 		c.Logger.Level = level
@@ -274,6 +337,25 @@ func (c *Config) overrideFromEnv() {
 	if c.RateLimit.RequestsPerMinute == 0 {
 		c.RateLimit.RequestsPerMinute = 60
 	}
+
+	// Workspace backup (export/import)
+	if boardURL := os.Getenv("BOARD_SERVICE_URL"); boardURL != "" {
+		c.Backup.BoardBaseURL = boardURL
+	}
+	if storageURL := os.Getenv("STORAGE_SERVICE_URL"); storageURL != "" {
+		c.Backup.StorageBaseURL = storageURL
+	}
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		c.Backup.InternalAPIKey = apiKey
+	}
+	if timeout := os.Getenv("BACKUP_TIMEOUT"); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			c.Backup.Timeout = d
+		}
+	}
+	if c.Backup.Timeout == 0 {
+		c.Backup.Timeout = 5 * time.Minute
+	}
 }
 
 // validate validates the configuration
@@ -24,6 +24,8 @@ type Config struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	// ReplicaDSNs are read replica DSNs used by EnableReadReplicas (optional)
+	ReplicaDSNs []string
 }
 
 // New creates a new database connection
@@ -50,14 +52,40 @@ func New(cfg Config) (*gorm.DB, error) {
 
 // AutoMigrate runs database migrations
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(
 		&domain.User{},
+		&domain.UserIdentity{},
 		&domain.Workspace{},
 		&domain.WorkspaceMember{},
 		&domain.UserProfile{},
 		&domain.WorkspaceJoinRequest{},
 		&domain.Attachment{},
-	)
+		&domain.WorkspaceSSOConfig{},
+		&domain.WorkspaceSSODomain{},
+		&domain.UserPasskey{},
+		&domain.UserMFASetting{},
+		&domain.UserTOTP{},
+		&domain.UserRecoveryCode{},
+		&domain.WorkspaceBackupJob{},
+	); err != nil {
+		return err
+	}
+	return migrateSSODomainVerification(db)
+}
+
+// migrateSSODomainVerification replaces the global unique index on SSO domains with one
+// that only covers verified domains, and issues verification tokens for existing domains.
+// 기존 도메인은 미확인 상태가 되므로 DNS TXT 레코드로 소유를 확인해야 SSO 로그인이 다시 동작합니다.
+func migrateSSODomainVerification(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if migrator.HasIndex(&domain.WorkspaceSSODomain{}, "idx_workspace_sso_domains_domain") {
+		if err := migrator.DropIndex(&domain.WorkspaceSSODomain{}, "idx_workspace_sso_domains_domain"); err != nil {
+			return fmt.Errorf("failed to drop sso domain index: %w", err)
+		}
+	}
+	return db.Exec(`UPDATE workspace_sso_domains
+		SET verification_token = replace(gen_random_uuid()::text, '-', '')
+		WHERE verification_token = ''`).Error
 }
 
 // SeedDefaultData creates required default data (system user, default workspace)
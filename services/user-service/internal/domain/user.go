@@ -43,10 +43,18 @@ type UpdateUserRequest struct {
 }
 
 // OAuthLoginRequest represents the request for OAuth login (internal API)
+// EmailVerified가 true인 경우에만 같은 이메일의 기존 사용자에 계정을 연결하거나 새 사용자를 만듭니다.
 type OAuthLoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Name     string `json:"name" binding:"required"`
-	Provider string `json:"provider" binding:"required"`
+	Email          string `json:"email" binding:"required,email"`
+	Name           string `json:"name" binding:"required"`
+	Provider       string `json:"provider" binding:"required,oneof=google github kakao"`
+	ProviderUserID string `json:"providerUserId" binding:"required"`
+	EmailVerified  bool   `json:"emailVerified"`
+}
+
+// MagicLinkLoginRequest represents the request to resolve a magic-link login (internal API)
+type MagicLinkLoginRequest struct {
+	Email string `json:"email" binding:"required,email"`
 }
 
 // UserResponse represents the user response
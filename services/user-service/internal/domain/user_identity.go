@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a social login account (google, github, kakao) to a user
+// 한 사용자는 여러 소셜 계정을 가질 수 있으며, 제공자 계정은 한 사용자에게만 연결됩니다.
+type UserIdentity struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"identityId"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;index" json:"userId"`
+	Provider       string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_user_identity_provider" json:"provider"`
+	ProviderUserID string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identity_provider" json:"providerUserId"`
+	Email          string    `gorm:"not null;default:''" json:"email"`
+	CreatedAt      time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt      time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserIdentity
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTOTP is the TOTP (authenticator app) second factor of a user
+// 등록/검증은 auth-service가 수행하고, user-service는 auth-service가 암호화한 secret만 저장합니다.
+type UserTOTP struct {
+	UserID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"userId"`
+	Secret       string    `gorm:"type:text;not null" json:"-"` // AES-GCM encrypted by auth-service
+	LastUsedStep int64     `gorm:"not null;default:0" json:"-"` // 마지막으로 사용한 30초 구간 (코드 재사용 방지)
+	CreatedAt    time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt    time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserTOTP
+func (UserTOTP) TableName() string {
+	return "user_totp"
+}
+
+// UserRecoveryCode is a single-use MFA recovery code (SHA-256 hash only)
+type UserRecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"-"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_recovery_code_user_hash" json:"-"`
+	CodeHash  string     `gorm:"type:varchar(64);not null;uniqueIndex:idx_recovery_code_user_hash" json:"-"`
+	UsedAt    *time.Time `json:"-"`
+	CreatedAt time.Time  `gorm:"not null" json:"-"`
+}
+
+// TableName specifies the table name for UserRecoveryCode
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
+
+// MFAStatusResponse summarizes the second factors of the current user
+type MFAStatusResponse struct {
+	TOTPEnabled            bool `json:"totpEnabled"`
+	PasskeyRequired        bool `json:"passkeyRequired"`
+	PasskeyCount           int  `json:"passkeyCount"`
+	RecoveryCodesRemaining int  `json:"recoveryCodesRemaining"`
+	// PolicyRequired is true when a workspace the user belongs to requires MFA for all members
+	PolicyRequired bool `json:"policyRequired"`
+}
+
+// UserMFAResponse is the MFA state auth-service needs at token issuance (internal API)
+type UserMFAResponse struct {
+	MFAStatusResponse
+	TOTPSecret       string      `json:"totpSecret,omitempty"`
+	TOTPLastUsedStep int64       `json:"totpLastUsedStep"`
+	PolicyWorkspaces []uuid.UUID `json:"policyWorkspaces"`
+}
+
+// TokenPolicyResponse is the shortest token lifetimes set by the workspaces of a user (internal API)
+// 0 means no workspace sets the value and auth-service uses its default.
+type TokenPolicyResponse struct {
+	AccessTokenTTLMinutes  int `json:"accessTokenTtlMinutes"`
+	RefreshTokenTTLMinutes int `json:"refreshTokenTtlMinutes"`
+}
+
+// EnableTOTPRequest stores a confirmed TOTP secret and its recovery codes (internal API)
+type EnableTOTPRequest struct {
+	Secret             string   `json:"secret" binding:"required"`
+	Step               int64    `json:"step" binding:"min=0"` // step of the code that confirmed enrollment
+	RecoveryCodeHashes []string `json:"recoveryCodeHashes" binding:"required,min=1,max=20,dive,len=64,hexadecimal"`
+}
+
+// RecordTOTPUseRequest records the time step of an accepted TOTP code (internal API)
+type RecordTOTPUseRequest struct {
+	Step int64 `json:"step" binding:"required,min=1"`
+}
+
+// ReplaceRecoveryCodesRequest replaces all recovery codes of a user (internal API)
+type ReplaceRecoveryCodesRequest struct {
+	CodeHashes []string `json:"codeHashes" binding:"required,min=1,max=20,dive,len=64,hexadecimal"`
+}
+
+// ConsumeRecoveryCodeRequest marks a recovery code as used (internal API)
+type ConsumeRecoveryCodeRequest struct {
+	CodeHash string `json:"codeHash" binding:"required,len=64,hexadecimal"`
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserPasskey is a WebAuthn credential (passkey) registered by a user
+// 등록/인증 ceremony 검증은 auth-service가 수행하고, user-service는 검증된 자격 증명만 저장합니다.
+type UserPasskey struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"passkeyId"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
+	CredentialID string     `gorm:"type:varchar(1024);not null;uniqueIndex" json:"credentialId"` // base64url
+	PublicKey    string     `gorm:"type:text;not null" json:"-"`                                 // base64url attested credential data
+	SignCount    int64      `gorm:"not null;default:0" json:"-"`
+	Transports   string     `gorm:"type:varchar(255);not null;default:''" json:"-"` // comma separated
+	AAGUID       string     `gorm:"column:aaguid;type:varchar(36);not null;default:''" json:"aaguid"`
+	Name         string     `gorm:"type:varchar(100);not null;default:''" json:"name"`
+	LastUsedAt   *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt    time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt    time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserPasskey
+func (UserPasskey) TableName() string {
+	return "user_passkeys"
+}
+
+// TransportList returns the authenticator transports (usb, nfc, ble, internal, hybrid)
+func (p *UserPasskey) TransportList() []string {
+	if p.Transports == "" {
+		return []string{}
+	}
+	return strings.Split(p.Transports, ",")
+}
+
+// UserMFASetting stores the second-factor requirement of a user
+// PasskeyRequired가 true면 소셜/매직 링크 로그인 후 패스키 인증을 한 번 더 거쳐야 토큰이 발급됩니다.
+type UserMFASetting struct {
+	UserID          uuid.UUID `gorm:"type:uuid;primaryKey" json:"userId"`
+	PasskeyRequired bool      `gorm:"not null;default:false" json:"passkeyRequired"`
+	UpdatedAt       time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserMFASetting
+func (UserMFASetting) TableName() string {
+	return "user_mfa_settings"
+}
+
+// PasskeyResponse represents a registered passkey shown to its owner
+type PasskeyResponse struct {
+	PasskeyID  uuid.UUID  `json:"passkeyId"`
+	Name       string     `json:"name"`
+	AAGUID     string     `json:"aaguid"`
+	Transports []string   `json:"transports"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// ToResponse converts UserPasskey to PasskeyResponse
+func (p *UserPasskey) ToResponse() PasskeyResponse {
+	return PasskeyResponse{
+		PasskeyID:  p.ID,
+		Name:       p.Name,
+		AAGUID:     p.AAGUID,
+		Transports: p.TransportList(),
+		LastUsedAt: p.LastUsedAt,
+		CreatedAt:  p.CreatedAt,
+	}
+}
+
+// PasskeyListResponse represents the passkeys and MFA setting of the current user
+type PasskeyListResponse struct {
+	Passkeys    []PasskeyResponse `json:"passkeys"`
+	MFARequired bool              `json:"mfaRequired"`
+}
+
+// RenamePasskeyRequest represents the request to rename a passkey
+type RenamePasskeyRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// UpdatePasskeyMFARequest represents the request to require a passkey as second factor
+type UpdatePasskeyMFARequest struct {
+	Required *bool `json:"required" binding:"required"`
+}
+
+// CreatePasskeyRequest represents a verified passkey registration forwarded by auth-service (internal API)
+type CreatePasskeyRequest struct {
+	UserID       uuid.UUID `json:"userId" binding:"required"`
+	CredentialID string    `json:"credentialId" binding:"required,max=1024"`
+	PublicKey    string    `json:"publicKey" binding:"required"`
+	SignCount    int64     `json:"signCount" binding:"min=0"`
+	Transports   []string  `json:"transports"`
+	AAGUID       string    `json:"aaguid"`
+	Name         string    `json:"name" binding:"max=100"`
+}
+
+// PasskeyCredentialResponse is the credential data auth-service needs to verify an assertion (internal API)
+type PasskeyCredentialResponse struct {
+	PasskeyID    uuid.UUID `json:"passkeyId"`
+	UserID       uuid.UUID `json:"userId"`
+	Email        string    `json:"email,omitempty"`
+	CredentialID string    `json:"credentialId"`
+	PublicKey    string    `json:"publicKey"`
+	SignCount    int64     `json:"signCount"`
+	Transports   []string  `json:"transports"`
+}
+
+// ToCredentialResponse converts UserPasskey to PasskeyCredentialResponse
+func (p *UserPasskey) ToCredentialResponse() PasskeyCredentialResponse {
+	return PasskeyCredentialResponse{
+		PasskeyID:    p.ID,
+		UserID:       p.UserID,
+		CredentialID: p.CredentialID,
+		PublicKey:    p.PublicKey,
+		SignCount:    p.SignCount,
+		Transports:   p.TransportList(),
+	}
+}
+
+// UserPasskeysResponse lists a user's credentials for exclude/allow lists and the MFA requirement (internal API)
+type UserPasskeysResponse struct {
+	Credentials []PasskeyCredentialResponse `json:"credentials"`
+	MFARequired bool                        `json:"mfaRequired"`
+}
+
+// RecordPasskeyUseRequest updates the signature counter after a successful assertion (internal API)
+type RecordPasskeyUseRequest struct {
+	SignCount int64 `json:"signCount" binding:"min=0"`
+}
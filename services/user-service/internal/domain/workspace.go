@@ -8,16 +8,20 @@ import (
 
 // Workspace represents a workspace
 type Workspace struct {
-	ID                   uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"workspaceId"`
-	OwnerID              uuid.UUID  `gorm:"type:uuid;not null;index" json:"ownerId"`
-	WorkspaceName        string     `gorm:"not null" json:"workspaceName"`
-	WorkspaceDescription *string    `json:"workspaceDescription,omitempty"`
-	IsPublic             bool       `gorm:"default:true" json:"isPublic"`
-	NeedApproved         bool       `gorm:"default:true" json:"needApproved"`
-	OnlyOwnerCanInvite   bool       `gorm:"default:true" json:"onlyOwnerCanInvite"`
-	IsActive             bool       `gorm:"default:true" json:"isActive"`
-	CreatedAt            time.Time  `gorm:"not null" json:"createdAt"`
-	DeletedAt            *time.Time `gorm:"index" json:"deletedAt,omitempty"`
+	ID                     uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"workspaceId"`
+	OwnerID                uuid.UUID  `gorm:"type:uuid;not null;index" json:"ownerId"`
+	WorkspaceName          string     `gorm:"not null" json:"workspaceName"`
+	WorkspaceDescription   *string    `json:"workspaceDescription,omitempty"`
+	IsPublic               bool       `gorm:"default:true" json:"isPublic"`
+	NeedApproved           bool       `gorm:"default:true" json:"needApproved"`
+	OnlyOwnerCanInvite     bool       `gorm:"default:true" json:"onlyOwnerCanInvite"`
+	RequireMFA             bool       `gorm:"default:false" json:"requireMfa"`         // 모든 멤버에게 MFA(TOTP/패스키) 요구
+	AccessTokenTTLMinutes  int        `gorm:"default:0" json:"accessTokenTtlMinutes"`  // 멤버 access token 수명 (분, 0이면 기본값, 여러 워크스페이스면 최솟값)
+	RefreshTokenTTLMinutes int        `gorm:"default:0" json:"refreshTokenTtlMinutes"` // 멤버 refresh token 수명 (분, 0이면 기본값, 여러 워크스페이스면 최솟값)
+	StepUpMaxAgeMinutes    int        `gorm:"default:0" json:"stepUpMaxAgeMinutes"`    // 민감한 작업에 필요한 최근 로그인 시간 (분, 0이면 확인 안 함)
+	IsActive               bool       `gorm:"default:true" json:"isActive"`
+	CreatedAt              time.Time  `gorm:"not null" json:"createdAt"`
+	DeletedAt              *time.Time `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relations
 	Owner        *User                  `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
@@ -102,12 +106,16 @@ type UserWorkspaceResponse struct {
 // WorkspaceSettingsResponse represents workspace settings
 // This is used in GET /api/workspaces/{workspaceId}/settings endpoint
 type WorkspaceSettingsResponse struct {
-	WorkspaceID          uuid.UUID `json:"workspaceId"`
-	WorkspaceName        string    `json:"workspaceName"`
-	WorkspaceDescription string    `json:"workspaceDescription"`
-	IsPublic             bool      `json:"isPublic"`
-	RequiresApproval     bool      `json:"requiresApproval"`
-	OnlyOwnerCanInvite   bool      `json:"onlyOwnerCanInvite"`
+	WorkspaceID            uuid.UUID `json:"workspaceId"`
+	WorkspaceName          string    `json:"workspaceName"`
+	WorkspaceDescription   string    `json:"workspaceDescription"`
+	IsPublic               bool      `json:"isPublic"`
+	RequiresApproval       bool      `json:"requiresApproval"`
+	OnlyOwnerCanInvite     bool      `json:"onlyOwnerCanInvite"`
+	RequireMFA             bool      `json:"requireMfa"`
+	AccessTokenTTLMinutes  int       `json:"accessTokenTtlMinutes"`
+	RefreshTokenTTLMinutes int       `json:"refreshTokenTtlMinutes"`
+	StepUpMaxAgeMinutes    int       `json:"stepUpMaxAgeMinutes"`
 }
 
 // ToSettingsResponse converts Workspace to WorkspaceSettingsResponse
@@ -117,12 +125,16 @@ func (w *Workspace) ToSettingsResponse() WorkspaceSettingsResponse {
 		description = *w.WorkspaceDescription
 	}
 	return WorkspaceSettingsResponse{
-		WorkspaceID:          w.ID,
-		WorkspaceName:        w.WorkspaceName,
-		WorkspaceDescription: description,
-		IsPublic:             w.IsPublic,
-		RequiresApproval:     w.NeedApproved,
-		OnlyOwnerCanInvite:   w.OnlyOwnerCanInvite,
+		WorkspaceID:            w.ID,
+		WorkspaceName:          w.WorkspaceName,
+		WorkspaceDescription:   description,
+		IsPublic:               w.IsPublic,
+		RequiresApproval:       w.NeedApproved,
+		OnlyOwnerCanInvite:     w.OnlyOwnerCanInvite,
+		RequireMFA:             w.RequireMFA,
+		AccessTokenTTLMinutes:  w.AccessTokenTTLMinutes,
+		RefreshTokenTTLMinutes: w.RefreshTokenTTLMinutes,
+		StepUpMaxAgeMinutes:    w.StepUpMaxAgeMinutes,
 	}
 }
 
@@ -133,4 +145,9 @@ type UpdateWorkspaceSettingsRequest struct {
 	IsPublic             *bool   `json:"isPublic,omitempty"`
 	RequiresApproval     *bool   `json:"requiresApproval,omitempty"`
 	OnlyOwnerCanInvite   *bool   `json:"onlyOwnerCanInvite,omitempty"`
+	RequireMFA           *bool   `json:"requireMfa,omitempty"`
+	// 0이면 기본값, 그 외에는 access 5~1440분, refresh 60~43200분(30일), step-up 1~1440분
+	AccessTokenTTLMinutes  *int `json:"accessTokenTtlMinutes,omitempty"`
+	RefreshTokenTTLMinutes *int `json:"refreshTokenTtlMinutes,omitempty"`
+	StepUpMaxAgeMinutes    *int `json:"stepUpMaxAgeMinutes,omitempty"`
 }
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackupJobKind represents the kind of a workspace backup job
+type BackupJobKind string
+
+const (
+	BackupJobExport BackupJobKind = "EXPORT"
+	BackupJobImport BackupJobKind = "IMPORT"
+)
+
+// BackupJobStatus represents the status of a workspace backup job
+type BackupJobStatus string
+
+const (
+	BackupJobPending   BackupJobStatus = "PENDING"
+	BackupJobRunning   BackupJobStatus = "RUNNING"
+	BackupJobCompleted BackupJobStatus = "COMPLETED"
+	BackupJobFailed    BackupJobStatus = "FAILED"
+)
+
+// WorkspaceBackupJob tracks a workspace export (archive written to S3) or import (archive restored from S3)
+type WorkspaceBackupJob struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"jobId"`
+	WorkspaceID   uuid.UUID       `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	Kind          BackupJobKind   `gorm:"type:varchar(20);not null" json:"kind"`
+	Status        BackupJobStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	RequestedBy   uuid.UUID       `gorm:"type:uuid;not null" json:"requestedBy"`
+	ArchiveKey    string          `gorm:"size:512" json:"archiveKey,omitempty"` // S3 object key of the archive
+	FormatVersion int             `gorm:"default:0" json:"formatVersion"`       // Archive format version
+	SizeBytes     int64           `gorm:"default:0" json:"sizeBytes"`           // Archive size
+	Error         string          `gorm:"type:text" json:"error,omitempty"`     // Failure reason
+	CreatedAt     time.Time       `gorm:"not null" json:"createdAt"`
+	StartedAt     *time.Time      `json:"startedAt,omitempty"`
+	CompletedAt   *time.Time      `json:"completedAt,omitempty"`
+	UpdatedAt     time.Time       `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for WorkspaceBackupJob
+func (WorkspaceBackupJob) TableName() string {
+	return "workspace_backup_jobs"
+}
+
+// IsActive reports whether the job has not finished yet
+func (j *WorkspaceBackupJob) IsActive() bool {
+	return j.Status == BackupJobPending || j.Status == BackupJobRunning
+}
+
+// StartImportRequest represents the request to restore a workspace from an archive
+type StartImportRequest struct {
+	ArchiveKey string `json:"archiveKey" binding:"required"`
+}
+
+// WorkspaceUserBackup is the user-service section of a workspace archive:
+// the workspace with its members, their user accounts and workspace profiles
+type WorkspaceUserBackup struct {
+	Workspace Workspace         `json:"workspace"`
+	Users     []User            `json:"users"`
+	Members   []WorkspaceMember `json:"members"`
+	Profiles  []UserProfile     `json:"profiles"`
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceSSOConfig represents the SAML SSO configuration of a workspace
+// 워크스페이스당 하나의 IdP를 연결합니다. SAML 프로토콜 처리는 auth-service가 담당합니다.
+type WorkspaceSSOConfig struct {
+	WorkspaceID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"workspaceId"`
+	Enabled             bool       `gorm:"default:false" json:"enabled"`
+	Enforced            bool       `gorm:"default:false" json:"enforced"`
+	JITProvisioning     bool       `gorm:"default:true" json:"jitProvisioning"`
+	IdPEntityID         string     `gorm:"column:idp_entity_id;not null;default:''" json:"idpEntityId"`
+	IdPSSOURL           string     `gorm:"column:idp_sso_url;not null;default:''" json:"idpSsoUrl"`
+	IdPSSOBinding       string     `gorm:"column:idp_sso_binding;not null;default:''" json:"idpSsoBinding"`
+	IdPCertificate      string     `gorm:"column:idp_certificate;type:text;not null;default:''" json:"-"`
+	CertificateNotAfter *time.Time `json:"certificateNotAfter,omitempty"`
+	EmailAttribute      string     `gorm:"not null;default:''" json:"emailAttribute"`
+	NameAttribute       string     `gorm:"not null;default:''" json:"nameAttribute"`
+	CreatedAt           time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt           time.Time  `gorm:"not null" json:"updatedAt"`
+
+	// Relations
+	Domains   []WorkspaceSSODomain `gorm:"foreignKey:WorkspaceID;references:WorkspaceID" json:"-"`
+	Workspace *Workspace           `gorm:"foreignKey:WorkspaceID" json:"-"`
+}
+
+// TableName specifies the table name for WorkspaceSSOConfig
+func (WorkspaceSSOConfig) TableName() string {
+	return "workspace_sso_configs"
+}
+
+// DNS TXT record that proves ownership of an SSO email domain
+// (예: _wealist-sso.acme.com TXT "wealist-sso-verification=<token>")
+const (
+	SSODomainVerificationRecordPrefix = "_wealist-sso."
+	SSODomainVerificationValuePrefix  = "wealist-sso-verification="
+)
+
+// WorkspaceSSODomain is an email domain whose users sign in through a workspace IdP
+// DNS TXT 레코드로 소유가 확인된(VerifiedAt) 도메인만 SSO 로그인에 사용되며,
+// 확인된 도메인은 하나의 워크스페이스에만 연결될 수 있습니다.
+type WorkspaceSSODomain struct {
+	ID                uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"-"`
+	WorkspaceID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"-"`
+	Domain            string     `gorm:"type:varchar(255);not null;index:idx_workspace_sso_domains_lookup;uniqueIndex:idx_workspace_sso_domains_verified,where:verified_at IS NOT NULL" json:"domain"`
+	VerificationToken string     `gorm:"type:varchar(64);not null;default:''" json:"-"`
+	VerifiedAt        *time.Time `json:"verifiedAt,omitempty"`
+	CreatedAt         time.Time  `gorm:"not null" json:"-"`
+}
+
+// TableName specifies the table name for WorkspaceSSODomain
+func (WorkspaceSSODomain) TableName() string {
+	return "workspace_sso_domains"
+}
+
+// DomainNames returns the configured email domains
+func (c *WorkspaceSSOConfig) DomainNames() []string {
+	domains := make([]string, 0, len(c.Domains))
+	for _, d := range c.Domains {
+		domains = append(domains, d.Domain)
+	}
+	return domains
+}
+
+// HasVerifiedDomain reports whether an email domain belongs to the configuration and its ownership is verified
+func (c *WorkspaceSSOConfig) HasVerifiedDomain(domain string) bool {
+	for _, d := range c.Domains {
+		if d.Domain == domain && d.VerifiedAt != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// VerificationRecord returns the name and value of the DNS TXT record that proves ownership of the domain
+func (d *WorkspaceSSODomain) VerificationRecord() (name, value string) {
+	return SSODomainVerificationRecordPrefix + d.Domain, SSODomainVerificationValuePrefix + d.VerificationToken
+}
+
+// UpdateWorkspaceSSORequest represents the request to update the SSO configuration
+// Domains를 보내면 기존 도메인 목록을 대체합니다.
+type UpdateWorkspaceSSORequest struct {
+	Enabled         *bool     `json:"enabled,omitempty"`
+	Enforced        *bool     `json:"enforced,omitempty"`
+	JITProvisioning *bool     `json:"jitProvisioning,omitempty"`
+	IdPEntityID     *string   `json:"idpEntityId,omitempty"`
+	IdPSSOURL       *string   `json:"idpSsoUrl,omitempty"`
+	IdPCertificate  *string   `json:"idpCertificate,omitempty"`
+	EmailAttribute  *string   `json:"emailAttribute,omitempty"`
+	NameAttribute   *string   `json:"nameAttribute,omitempty"`
+	Domains         *[]string `json:"domains,omitempty"`
+}
+
+// UploadSSOMetadataRequest represents the request to configure the IdP from its metadata XML
+type UploadSSOMetadataRequest struct {
+	MetadataXML string `json:"metadataXml" binding:"required"`
+}
+
+// WorkspaceSSOResponse represents the SSO configuration response
+type WorkspaceSSOResponse struct {
+	WorkspaceID         uuid.UUID  `json:"workspaceId"`
+	Enabled             bool       `json:"enabled"`
+	Enforced            bool       `json:"enforced"`
+	JITProvisioning     bool       `json:"jitProvisioning"`
+	IdPEntityID         string     `json:"idpEntityId"`
+	IdPSSOURL           string     `json:"idpSsoUrl"`
+	IdPSSOBinding       string     `json:"idpSsoBinding"`
+	HasCertificate      bool       `json:"hasCertificate"`
+	CertificateNotAfter *time.Time `json:"certificateNotAfter,omitempty"`
+	EmailAttribute      string     `json:"emailAttribute"`
+	NameAttribute       string     `json:"nameAttribute"`
+	Domains             []string   `json:"domains"`
+	// DomainVerifications는 도메인별 소유 확인 상태와 추가해야 할 DNS TXT 레코드입니다.
+	DomainVerifications []SSODomainVerification `json:"domainVerifications"`
+	UpdatedAt           time.Time               `json:"updatedAt"`
+}
+
+// SSODomainVerification is the ownership verification status of an SSO email domain
+type SSODomainVerification struct {
+	Domain      string     `json:"domain"`
+	Verified    bool       `json:"verified"`
+	VerifiedAt  *time.Time `json:"verifiedAt,omitempty"`
+	RecordName  string     `json:"recordName"`
+	RecordValue string     `json:"recordValue"`
+}
+
+// ToResponse converts WorkspaceSSOConfig to WorkspaceSSOResponse
+func (c *WorkspaceSSOConfig) ToResponse() WorkspaceSSOResponse {
+	return WorkspaceSSOResponse{
+		WorkspaceID:         c.WorkspaceID,
+		Enabled:             c.Enabled,
+		Enforced:            c.Enforced,
+		JITProvisioning:     c.JITProvisioning,
+		IdPEntityID:         c.IdPEntityID,
+		IdPSSOURL:           c.IdPSSOURL,
+		IdPSSOBinding:       c.IdPSSOBinding,
+		HasCertificate:      c.IdPCertificate != "",
+		CertificateNotAfter: c.CertificateNotAfter,
+		EmailAttribute:      c.EmailAttribute,
+		NameAttribute:       c.NameAttribute,
+		Domains:             c.DomainNames(),
+		DomainVerifications: c.domainVerifications(),
+		UpdatedAt:           c.UpdatedAt,
+	}
+}
+
+func (c *WorkspaceSSOConfig) domainVerifications() []SSODomainVerification {
+	verifications := make([]SSODomainVerification, 0, len(c.Domains))
+	for _, d := range c.Domains {
+		name, value := d.VerificationRecord()
+		verifications = append(verifications, SSODomainVerification{
+			Domain:      d.Domain,
+			Verified:    d.VerifiedAt != nil,
+			VerifiedAt:  d.VerifiedAt,
+			RecordName:  name,
+			RecordValue: value,
+		})
+	}
+	return verifications
+}
+
+// SSOConnectionResponse is the IdP configuration auth-service needs to run a SAML login (internal API)
+// IdPSSOBinding이 비어 있으면 HTTP-Redirect 바인딩을 사용합니다.
+type SSOConnectionResponse struct {
+	WorkspaceID    uuid.UUID `json:"workspaceId"`
+	IdPEntityID    string    `json:"idpEntityId"`
+	IdPSSOURL      string    `json:"idpSsoUrl"`
+	IdPSSOBinding  string    `json:"idpSsoBinding"`
+	IdPCertificate string    `json:"idpCertificate"`
+}
+
+// SSODiscoveryResponse tells a login page which workspace IdP handles an email (internal API)
+type SSODiscoveryResponse struct {
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	Enforced    bool      `json:"enforced"`
+}
+
+// SSOLoginRequest represents a verified SAML assertion forwarded by auth-service (internal API)
+type SSOLoginRequest struct {
+	WorkspaceID uuid.UUID           `json:"workspaceId" binding:"required"`
+	NameID      string              `json:"nameId" binding:"required"`
+	Attributes  map[string][]string `json:"attributes"`
+}
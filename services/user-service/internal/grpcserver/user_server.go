@@ -0,0 +1,78 @@
+// Package grpcserver implements user-service's internal gRPC API (see internalrpc.UserServiceServer).
+// REST 핸들러와 같은 서비스 계층을 사용합니다.
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"user-service/internal/service"
+)
+
+// UserServer implements internalrpc.UserServiceServer
+type UserServer struct {
+	workspaceService *service.WorkspaceService
+	profileService   *service.ProfileService
+}
+
+// NewUserServer creates a new UserServer
+func NewUserServer(workspaceService *service.WorkspaceService, profileService *service.ProfileService) *UserServer {
+	return &UserServer{
+		workspaceService: workspaceService,
+		profileService:   profileService,
+	}
+}
+
+// ValidateMember checks workspace membership (same as GET /workspaces/:id/validate-member/:userId)
+func (s *UserServer) ValidateMember(ctx context.Context, req *internalrpc.ValidateMemberRequest) (*internalrpc.ValidateMemberResponse, error) {
+	workspaceID, userID, err := parseIDs(req.WorkspaceID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.workspaceService.ValidateMemberAccess(workspaceID, userID)
+	if err != nil {
+		return nil, internalrpc.Error(err)
+	}
+	return &internalrpc.ValidateMemberResponse{IsMember: isMember}, nil
+}
+
+// GetProfile returns a user's workspace profile
+func (s *UserServer) GetProfile(ctx context.Context, req *internalrpc.GetProfileRequest) (*internalrpc.Profile, error) {
+	workspaceID, userID, err := parseIDs(req.WorkspaceID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileService.GetWorkspaceProfile(userID, workspaceID)
+	if err != nil {
+		return nil, internalrpc.Error(err)
+	}
+
+	result := &internalrpc.Profile{
+		UserID:      profile.UserID.String(),
+		WorkspaceID: profile.WorkspaceID.String(),
+		NickName:    profile.NickName,
+		Email:       profile.Email,
+	}
+	if profile.ProfileImageURL != nil {
+		result.ProfileImageURL = *profile.ProfileImageURL
+	}
+	return result, nil
+}
+
+func parseIDs(workspaceIDStr, userIDStr string) (uuid.UUID, uuid.UUID, error) {
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid workspace ID")
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	return workspaceID, userID, nil
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// MFAHandler handles TOTP and recovery code HTTP requests
+type MFAHandler struct {
+	mfaService *service.MFAService
+}
+
+// NewMFAHandler creates a new MFAHandler
+func NewMFAHandler(mfaService *service.MFAService) *MFAHandler {
+	return &MFAHandler{mfaService: mfaService}
+}
+
+// GetMyMFAStatus godoc
+// @Summary Get my MFA status
+// @ID getMyMFAStatus
+// @Description TOTP enrollment, verification and recovery codes are served by auth-service (/api/auth/mfa/...)
+// @Tags MFA
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.MFAStatusResponse
+// @Router /users/me/mfa [get]
+func (h *MFAHandler) GetMyMFAStatus(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	status, err := h.mfaService.GetMyStatus(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, status)
+}
+
+// GetUserMFA godoc
+// @Summary Get a user's MFA factors and workspace MFA policy (internal)
+// @ID getUserMFA
+// @Tags Internal
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} domain.UserMFAResponse
+// @Router /internal/mfa/users/{userId} [get]
+func (h *MFAHandler) GetUserMFA(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	status, err := h.mfaService.GetStatus(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, status)
+}
+
+// GetTokenPolicy godoc
+// @Summary Get the token lifetimes set by a user's workspaces (internal)
+// @ID getTokenPolicy
+// @Tags Internal
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} domain.TokenPolicyResponse
+// @Router /internal/mfa/users/{userId}/token-policy [get]
+func (h *MFAHandler) GetTokenPolicy(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	policy, err := h.mfaService.GetTokenPolicy(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, policy)
+}
+
+// EnableTOTP godoc
+// @Summary Store a TOTP secret confirmed by auth-service (internal)
+// @ID enableTOTP
+// @Tags Internal
+// @Accept json
+// @Param userId path string true "User ID"
+// @Param request body domain.EnableTOTPRequest true "Encrypted secret and recovery code hashes"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/mfa/users/{userId}/totp [put]
+func (h *MFAHandler) EnableTOTP(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req domain.EnableTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("EnableTOTP validation failed", zap.Error(err))
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.mfaService.EnableTOTP(userID, req); err != nil {
+		log.Warn("EnableTOTP failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// DisableTOTP godoc
+// @Summary Remove a user's TOTP factor and recovery codes (internal)
+// @ID disableTOTP
+// @Tags Internal
+// @Param userId path string true "User ID"
+// @Success 204
+// @Router /internal/mfa/users/{userId}/totp [delete]
+func (h *MFAHandler) DisableTOTP(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.mfaService.DisableTOTP(userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// RecordTOTPUse godoc
+// @Summary Record the time step of an accepted TOTP code (internal)
+// @ID recordTOTPUse
+// @Description Returns 409 if the code's time step was already used (replay)
+// @Tags Internal
+// @Accept json
+// @Param userId path string true "User ID"
+// @Param request body domain.RecordTOTPUseRequest true "Time step"
+// @Success 204
+// @Failure 409 {object} ErrorResponse
+// @Router /internal/mfa/users/{userId}/totp/use [post]
+func (h *MFAHandler) RecordTOTPUse(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req domain.RecordTOTPUseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.mfaService.RecordTOTPUse(userID, req.Step); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// ReplaceRecoveryCodes godoc
+// @Summary Replace a user's recovery codes (internal)
+// @ID replaceRecoveryCodes
+// @Tags Internal
+// @Accept json
+// @Param userId path string true "User ID"
+// @Param request body domain.ReplaceRecoveryCodesRequest true "Recovery code hashes"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Router /internal/mfa/users/{userId}/recovery-codes [put]
+func (h *MFAHandler) ReplaceRecoveryCodes(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req domain.ReplaceRecoveryCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.mfaService.ReplaceRecoveryCodes(userID, req.CodeHashes); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// ConsumeRecoveryCode godoc
+// @Summary Mark a recovery code as used (internal)
+// @ID consumeRecoveryCode
+// @Tags Internal
+// @Accept json
+// @Param userId path string true "User ID"
+// @Param request body domain.ConsumeRecoveryCodeRequest true "Recovery code hash"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/mfa/users/{userId}/recovery-codes/consume [post]
+func (h *MFAHandler) ConsumeRecoveryCode(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req domain.ConsumeRecoveryCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.mfaService.ConsumeRecoveryCode(userID, req.CodeHash); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func parseUserIDParam(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
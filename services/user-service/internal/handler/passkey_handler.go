@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// PasskeyHandler handles passkey (WebAuthn credential) HTTP requests
+type PasskeyHandler struct {
+	passkeyService *service.PasskeyService
+}
+
+// NewPasskeyHandler creates a new PasskeyHandler
+func NewPasskeyHandler(passkeyService *service.PasskeyService) *PasskeyHandler {
+	return &PasskeyHandler{passkeyService: passkeyService}
+}
+
+// ListPasskeys godoc
+// @Summary List my passkeys
+// @ID listPasskeys
+// @Description Registration and sign-in ceremonies are served by auth-service (/api/auth/passkeys/...)
+// @Tags Passkeys
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.PasskeyListResponse
+// @Router /users/me/passkeys [get]
+func (h *PasskeyHandler) ListPasskeys(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	passkeys, err := h.passkeyService.List(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, passkeys)
+}
+
+// RenamePasskey godoc
+// @Summary Rename a passkey
+// @ID renamePasskey
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param passkeyId path string true "Passkey ID"
+// @Param request body domain.RenamePasskeyRequest true "Rename request"
+// @Success 200 {object} domain.PasskeyResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/passkeys/{passkeyId} [patch]
+func (h *PasskeyHandler) RenamePasskey(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	passkeyID, err := uuid.Parse(c.Param("passkeyId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid passkey ID")
+		return
+	}
+
+	var req domain.RenamePasskeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	passkey, err := h.passkeyService.Rename(userID, passkeyID, req.Name)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, passkey.ToResponse())
+}
+
+// DeletePasskey godoc
+// @Summary Delete a passkey
+// @ID deletePasskey
+// @Tags Passkeys
+// @Security BearerAuth
+// @Param passkeyId path string true "Passkey ID"
+// @Success 204
+// @Failure 409 {object} ErrorResponse
+// @Router /users/me/passkeys/{passkeyId} [delete]
+func (h *PasskeyHandler) DeletePasskey(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	passkeyID, err := uuid.Parse(c.Param("passkeyId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid passkey ID")
+		return
+	}
+
+	if err := h.passkeyService.Delete(userID, passkeyID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// UpdatePasskeyMFA godoc
+// @Summary Require a passkey as second factor
+// @ID updatePasskeyMFA
+// @Description When enabled, social and magic-link logins must be confirmed with a passkey
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.UpdatePasskeyMFARequest true "MFA request"
+// @Success 200 {object} domain.PasskeyListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/passkeys/mfa [put]
+func (h *PasskeyHandler) UpdatePasskeyMFA(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.UpdatePasskeyMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.passkeyService.SetMFARequired(userID, *req.Required); err != nil {
+		log.Warn("UpdatePasskeyMFA failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	passkeys, err := h.passkeyService.List(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, passkeys)
+}
+
+// GetUserPasskeys godoc
+// @Summary List a user's passkey credentials and MFA requirement (internal)
+// @ID getUserPasskeys
+// @Tags Internal
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} domain.UserPasskeysResponse
+// @Router /internal/passkeys/users/{userId} [get]
+func (h *PasskeyHandler) GetUserPasskeys(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	credentials, err := h.passkeyService.GetUserCredentials(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, credentials)
+}
+
+// CreatePasskey godoc
+// @Summary Store a passkey verified by auth-service (internal)
+// @ID createPasskey
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param request body domain.CreatePasskeyRequest true "Create passkey request"
+// @Success 201 {object} domain.PasskeyResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /internal/passkeys [post]
+func (h *PasskeyHandler) CreatePasskey(c *gin.Context) {
+	log := getLogger(c)
+
+	var req domain.CreatePasskeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("CreatePasskey validation failed", zap.Error(err))
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	passkey, err := h.passkeyService.Register(req)
+	if err != nil {
+		log.Warn("CreatePasskey failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, passkey.ToResponse())
+}
+
+// GetPasskeyCredential godoc
+// @Summary Get a passkey credential by WebAuthn credential ID (internal)
+// @ID getPasskeyCredential
+// @Tags Internal
+// @Produce json
+// @Param credentialId path string true "Credential ID (base64url)"
+// @Success 200 {object} domain.PasskeyCredentialResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/passkeys/credentials/{credentialId} [get]
+func (h *PasskeyHandler) GetPasskeyCredential(c *gin.Context) {
+	credential, err := h.passkeyService.GetCredential(c.Param("credentialId"))
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, credential)
+}
+
+// RecordPasskeyUse godoc
+// @Summary Record a successful passkey assertion (internal)
+// @ID recordPasskeyUse
+// @Tags Internal
+// @Accept json
+// @Param credentialId path string true "Credential ID (base64url)"
+// @Param request body domain.RecordPasskeyUseRequest true "Sign count"
+// @Success 204
+// @Router /internal/passkeys/credentials/{credentialId}/use [post]
+func (h *PasskeyHandler) RecordPasskeyUse(c *gin.Context) {
+	var req domain.RecordPasskeyUseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	if err := h.passkeyService.RecordUse(c.Param("credentialId"), req.SignCount); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
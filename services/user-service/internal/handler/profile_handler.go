@@ -26,6 +26,7 @@ func NewProfileHandler(profileService *service.ProfileService, attachmentService
 
 // CreateProfile godoc
 // @Summary Create user profile
+// @ID createProfile
 // @Tags Profiles
 // @Accept json
 // @Produce json
@@ -59,6 +60,7 @@ func (h *ProfileHandler) CreateProfile(c *gin.Context) {
 
 // GetMyProfile godoc
 // @Summary Get my profile for workspace
+// @ID getMyProfile
 // @Tags Profiles
 // @Produce json
 // @Security BearerAuth
@@ -91,6 +93,7 @@ func (h *ProfileHandler) GetMyProfile(c *gin.Context) {
 
 // GetAllMyProfiles godoc
 // @Summary Get all my profiles
+// @ID getAllMyProfiles
 // @Tags Profiles
 // @Produce json
 // @Security BearerAuth
@@ -119,6 +122,7 @@ func (h *ProfileHandler) GetAllMyProfiles(c *gin.Context) {
 
 // GetUserProfile godoc
 // @Summary Get user profile by workspace and user ID
+// @ID getUserProfile
 // @Tags Profiles
 // @Produce json
 // @Security BearerAuth
@@ -160,6 +164,7 @@ func (h *ProfileHandler) GetUserProfile(c *gin.Context) {
 
 // UpdateProfile godoc
 // @Summary Update my profile
+// @ID updateProfile
 // @Tags Profiles
 // @Accept json
 // @Produce json
@@ -201,6 +206,7 @@ func (h *ProfileHandler) UpdateProfile(c *gin.Context) {
 
 // DeleteProfile godoc
 // @Summary Delete my profile for workspace
+// @ID deleteProfile
 // @Tags Profiles
 // @Produce json
 // @Security BearerAuth
@@ -231,6 +237,7 @@ func (h *ProfileHandler) DeleteProfile(c *gin.Context) {
 
 // GeneratePresignedURL godoc
 // @Summary Generate presigned URL for profile image upload
+// @ID generatePresignedURL
 // @Tags Profile Images
 // @Accept json
 // @Produce json
@@ -264,6 +271,7 @@ func (h *ProfileHandler) GeneratePresignedURL(c *gin.Context) {
 
 // SaveAttachment godoc
 // @Summary Save attachment metadata after S3 upload
+// @ID saveAttachment
 // @Tags Profile Images
 // @Accept json
 // @Produce json
@@ -297,6 +305,7 @@ func (h *ProfileHandler) SaveAttachment(c *gin.Context) {
 
 // ConfirmProfileImage godoc
 // @Summary Confirm profile image (link attachment to profile)
+// @ID confirmProfileImage
 // @Tags Profile Images
 // @Accept json
 // @Produce json
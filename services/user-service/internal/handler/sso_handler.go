@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// SSOHandler handles workspace SAML SSO HTTP requests
+type SSOHandler struct {
+	ssoService *service.SSOService
+}
+
+// NewSSOHandler creates a new SSOHandler
+func NewSSOHandler(ssoService *service.SSOService) *SSOHandler {
+	return &SSOHandler{ssoService: ssoService}
+}
+
+// GetSSOConfig godoc
+// @Summary Get workspace SSO configuration
+// @ID getSSOConfig
+// @Tags Workspace SSO
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.WorkspaceSSOResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/sso [get]
+func (h *SSOHandler) GetSSOConfig(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	config, err := h.ssoService.GetConfig(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, config.ToResponse())
+}
+
+// UpdateSSOConfig godoc
+// @Summary Update workspace SSO configuration
+// @ID updateSSOConfig
+// @Description IdP settings, attribute mapping, email domains and enforcement. Owner or admin only.
+// @Tags Workspace SSO
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdateWorkspaceSSORequest true "Update SSO request"
+// @Success 200 {object} domain.WorkspaceSSOResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/sso [put]
+func (h *SSOHandler) UpdateSSOConfig(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var req domain.UpdateWorkspaceSSORequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	config, err := h.ssoService.UpdateConfig(workspaceID, userID, req)
+	if err != nil {
+		log.Warn("UpdateSSOConfig failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, config.ToResponse())
+}
+
+// UploadSSOMetadata godoc
+// @Summary Configure the workspace IdP from SAML metadata XML
+// @ID uploadSSOMetadata
+// @Tags Workspace SSO
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UploadSSOMetadataRequest true "IdP metadata"
+// @Success 200 {object} domain.WorkspaceSSOResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/sso/metadata [put]
+func (h *SSOHandler) UploadSSOMetadata(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var req domain.UploadSSOMetadataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	config, err := h.ssoService.UploadMetadata(workspaceID, userID, req)
+	if err != nil {
+		log.Warn("UploadSSOMetadata failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, config.ToResponse())
+}
+
+// VerifySSODomain godoc
+// @Summary Verify ownership of a workspace SSO email domain
+// @Description Checks the DNS TXT record returned in domainVerifications. Only verified domains can be used for SSO login.
+// @ID verifySSODomain
+// @Tags Workspace SSO
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param domain path string true "Email domain"
+// @Success 200 {object} domain.WorkspaceSSOResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/sso/domains/{domain}/verify [post]
+func (h *SSOHandler) VerifySSODomain(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	config, err := h.ssoService.VerifyDomain(c.Request.Context(), workspaceID, userID, c.Param("domain"))
+	if err != nil {
+		log.Warn("VerifySSODomain failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, config.ToResponse())
+}
+
+// DeleteSSOConfig godoc
+// @Summary Delete workspace SSO configuration
+// @ID deleteSSOConfig
+// @Tags Workspace SSO
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 204
+// @Router /workspaces/{workspaceId}/sso [delete]
+func (h *SSOHandler) DeleteSSOConfig(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if err := h.ssoService.DeleteConfig(workspaceID, userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetSSOConnection godoc
+// @Summary Get the IdP configuration of a workspace (internal)
+// @ID getSSOConnection
+// @Tags Internal
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.SSOConnectionResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/sso/workspaces/{workspaceId} [get]
+func (h *SSOHandler) GetSSOConnection(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	config, err := h.ssoService.GetConnection(workspaceID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, domain.SSOConnectionResponse{
+		WorkspaceID:    config.WorkspaceID,
+		IdPEntityID:    config.IdPEntityID,
+		IdPSSOURL:      config.IdPSSOURL,
+		IdPSSOBinding:  config.IdPSSOBinding,
+		IdPCertificate: config.IdPCertificate,
+	})
+}
+
+// DiscoverSSO godoc
+// @Summary Find the workspace IdP for an email domain (internal)
+// @ID discoverSSO
+// @Tags Internal
+// @Produce json
+// @Param email query string true "Email"
+// @Success 200 {object} domain.SSODiscoveryResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/sso/discover [get]
+func (h *SSOHandler) DiscoverSSO(c *gin.Context) {
+	config, err := h.ssoService.Discover(c.Query("email"))
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, domain.SSODiscoveryResponse{
+		WorkspaceID: config.WorkspaceID,
+		Enforced:    config.Enforced,
+	})
+}
+
+// SSOLogin godoc
+// @Summary Find or provision a user from a verified SAML assertion (internal)
+// @ID sSOLogin
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param request body domain.SSOLoginRequest true "SSO login request"
+// @Success 200 {object} map[string]string
+// @Failure 403 {object} ErrorResponse
+// @Router /internal/sso/login [post]
+func (h *SSOHandler) SSOLogin(c *gin.Context) {
+	log := getLogger(c)
+
+	var req domain.SSOLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("SSOLogin validation failed", zap.Error(err))
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	user, err := h.ssoService.Login(req)
+	if err != nil {
+		log.Warn("SSOLogin failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	log.Info("SSO login successful", zap.String("enduser.id", user.ID.String()))
+	response.OK(c, gin.H{"userId": user.ID.String(), "email": user.Email})
+}
@@ -23,6 +23,7 @@ func NewUserHandler(userService *service.UserService) *UserHandler {
 
 // CreateUser godoc
 // @Summary Create a new user
+// @ID createUser
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -56,6 +57,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 
 // GetMe godoc
 // @Summary Get current user
+// @ID getMe
 // @Tags Users
 // @Produce json
 // @Security BearerAuth
@@ -88,6 +90,7 @@ func (h *UserHandler) GetMe(c *gin.Context) {
 
 // GetUser godoc
 // @Summary Get user by ID
+// @ID getUser
 // @Tags Users
 // @Produce json
 // @Security BearerAuth
@@ -122,6 +125,7 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 
 // UpdateUser godoc
 // @Summary Update user
+// @ID updateUser
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -175,6 +179,7 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 
 // DeleteMe godoc
 // @Summary Delete current user (soft delete)
+// @ID deleteMe
 // @Tags Users
 // @Produce json
 // @Security BearerAuth
@@ -206,6 +211,7 @@ func (h *UserHandler) DeleteMe(c *gin.Context) {
 
 // RestoreUser godoc
 // @Summary Restore deleted user
+// @ID restoreUser
 // @Tags Users
 // @Produce json
 // @Security BearerAuth
@@ -240,12 +246,14 @@ func (h *UserHandler) RestoreUser(c *gin.Context) {
 
 // OAuthLogin godoc
 // @Summary Find or create user for OAuth login (internal)
+// @ID oAuthLogin
 // @Tags Internal
 // @Accept json
 // @Produce json
 // @Param request body domain.OAuthLoginRequest true "OAuth login request"
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /internal/oauth/login [post]
 func (h *UserHandler) OAuthLogin(c *gin.Context) {
@@ -263,10 +271,10 @@ func (h *UserHandler) OAuthLogin(c *gin.Context) {
 		zap.String("user.email", req.Email),
 		zap.String("oauth.provider", req.Provider))
 
-	user, err := h.userService.FindOrCreateOAuthUser(c.Request.Context(), req.Email, req.Name, req.Provider)
+	user, err := h.userService.FindOrCreateOAuthUser(c.Request.Context(), req)
 	if err != nil {
 		log.Error("OAuthLogin service error", zap.Error(err))
-		response.InternalErrorWithDetails(c, "OAuth login failed", err)
+		response.HandleError(c, err)
 		return
 	}
 
@@ -274,8 +282,41 @@ func (h *UserHandler) OAuthLogin(c *gin.Context) {
 	response.OK(c, gin.H{"userId": user.ID.String()})
 }
 
+// MagicLinkLogin godoc
+// @Summary Find the user for a magic-link login (internal)
+// @ID magicLinkLogin
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param request body domain.MagicLinkLoginRequest true "Magic link login request"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /internal/magic-link/login [post]
+func (h *UserHandler) MagicLinkLogin(c *gin.Context) {
+	log := getLogger(c)
+
+	var req domain.MagicLinkLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("MagicLinkLogin validation failed", zap.Error(err))
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	user, err := h.userService.FindUserForMagicLink(c.Request.Context(), req.Email)
+	if err != nil {
+		log.Warn("MagicLinkLogin failed", zap.Error(err))
+		response.HandleError(c, err)
+		return
+	}
+
+	log.Info("Magic link login resolved", zap.String("enduser.id", user.ID.String()))
+	response.OK(c, gin.H{"userId": user.ID.String(), "email": user.Email})
+}
+
 // UserExists godoc
 // @Summary Check if user exists (internal)
+// @ID userExists
 // @Tags Internal
 // @Produce json
 // @Param userId path string true "User ID"
@@ -63,8 +63,8 @@ func (m *MockUserService) UserExists(id uuid.UUID) (bool, error) {
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserService) FindOrCreateOAuthUser(email, name, provider string) (*domain.User, error) {
-	args := m.Called(email, name, provider)
+func (m *MockUserService) FindOrCreateOAuthUser(req domain.OAuthLoginRequest) (*domain.User, error) {
+	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -83,7 +83,7 @@ type userServiceInterface interface {
 	DeleteUser(id uuid.UUID) error
 	RestoreUser(id uuid.UUID) (*domain.User, error)
 	UserExists(id uuid.UUID) (bool, error)
-	FindOrCreateOAuthUser(email, name, provider string) (*domain.User, error)
+	FindOrCreateOAuthUser(req domain.OAuthLoginRequest) (*domain.User, error)
 }
 
 func newUserHandlerTestable(svc userServiceInterface) *userHandlerTestable {
@@ -381,15 +381,7 @@ func TestUserHandler_UserExists(t *testing.T) {
 }
 
 func TestUserHandler_OAuthLogin(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		mockSvc := new(MockUserService)
-		router := setupRouter()
-
-		userID := uuid.New()
-		expectedUser := createTestUser(userID, "test@example.com")
-
-		mockSvc.On("FindOrCreateOAuthUser", "test@example.com", "Test User", "google").Return(expectedUser, nil)
-
+	setupOAuthRoute := func(router *gin.Engine, mockSvc *MockUserService) {
 		router.POST("/internal/oauth/login", func(c *gin.Context) {
 			handler := newUserHandlerTestable(mockSvc)
 			var req domain.OAuthLoginRequest
@@ -397,15 +389,32 @@ func TestUserHandler_OAuthLogin(t *testing.T) {
 				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 				return
 			}
-			user, err := handler.svc.FindOrCreateOAuthUser(req.Email, req.Name, req.Provider)
+			user, err := handler.svc.FindOrCreateOAuthUser(req)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 				return
 			}
 			c.JSON(http.StatusOK, gin.H{"userId": user.ID.String()})
 		})
+	}
 
-		body := []byte(`{"email":"test@example.com","name":"Test User","provider":"google"}`)
+	t.Run("success", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		router := setupRouter()
+		setupOAuthRoute(router, mockSvc)
+
+		userID := uuid.New()
+		expectedUser := createTestUser(userID, "test@example.com")
+
+		mockSvc.On("FindOrCreateOAuthUser", domain.OAuthLoginRequest{
+			Email:          "test@example.com",
+			Name:           "Test User",
+			Provider:       "github",
+			ProviderUserID: "12345",
+			EmailVerified:  true,
+		}).Return(expectedUser, nil)
+
+		body := []byte(`{"email":"test@example.com","name":"Test User","provider":"github","providerUserId":"12345","emailVerified":true}`)
 		w := httptest.NewRecorder()
 		httpReq, _ := http.NewRequest("POST", "/internal/oauth/login", bytes.NewBuffer(body))
 		httpReq.Header.Set("Content-Type", "application/json")
@@ -419,4 +428,19 @@ func TestUserHandler_OAuthLogin(t *testing.T) {
 		assert.Equal(t, userID.String(), resp["userId"])
 		mockSvc.AssertExpectations(t)
 	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		router := setupRouter()
+		setupOAuthRoute(router, mockSvc)
+
+		body := []byte(`{"email":"test@example.com","name":"Test User","provider":"facebook","providerUserId":"1"}`)
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest("POST", "/internal/oauth/login", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "FindOrCreateOAuthUser", mock.Anything)
+	})
 }
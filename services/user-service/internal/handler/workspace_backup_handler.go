@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// WorkspaceBackupHandler handles workspace export/import HTTP requests
+type WorkspaceBackupHandler struct {
+	backupService *service.WorkspaceBackupService
+}
+
+// NewWorkspaceBackupHandler creates a new WorkspaceBackupHandler
+func NewWorkspaceBackupHandler(backupService *service.WorkspaceBackupService) *WorkspaceBackupHandler {
+	return &WorkspaceBackupHandler{backupService: backupService}
+}
+
+// StartExport godoc
+// @Summary Export workspace data
+// @Description Starts a background job that bundles the workspace, members, projects, boards, comments and file manifest into a versioned archive in S3 (owner or admin)
+// @ID startWorkspaceExport
+// @Tags Workspace Backups
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 202 {object} domain.WorkspaceBackupJob
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Another backup job is running"
+// @Router /workspaces/{workspaceId}/backups/exports [post]
+func (h *WorkspaceBackupHandler) StartExport(c *gin.Context) {
+	userID, workspaceID, ok := backupRequestIDs(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.backupService.StartExport(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusAccepted, job)
+}
+
+// StartImport godoc
+// @Summary Import workspace data
+// @Description Starts a background job that restores the workspace from an archive under backups/workspaces/{workspaceId}/ (disaster recovery or tenant migration).
+// @Description Existing rows are kept, so a failed import can be retried with the same archive.
+// @ID startWorkspaceImport
+// @Tags Workspace Backups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.StartImportRequest true "Archive to restore"
+// @Success 202 {object} domain.WorkspaceBackupJob
+// @Failure 400 {object} ErrorResponse "Invalid or corrupt archive"
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Another backup job is running"
+// @Router /workspaces/{workspaceId}/backups/imports [post]
+func (h *WorkspaceBackupHandler) StartImport(c *gin.Context) {
+	userID, workspaceID, ok := backupRequestIDs(c)
+	if !ok {
+		return
+	}
+
+	var req domain.StartImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	job, err := h.backupService.StartImport(workspaceID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusAccepted, job)
+}
+
+// ListJobs godoc
+// @Summary List workspace backup jobs
+// @Description Lists the most recent export and import jobs of a workspace (owner or admin)
+// @ID listWorkspaceBackupJobs
+// @Tags Workspace Backups
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.WorkspaceBackupJob
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/backups [get]
+func (h *WorkspaceBackupHandler) ListJobs(c *gin.Context) {
+	userID, workspaceID, ok := backupRequestIDs(c)
+	if !ok {
+		return
+	}
+
+	jobs, err := h.backupService.ListJobs(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, jobs)
+}
+
+// GetJob godoc
+// @Summary Get workspace backup job
+// @Description Gets the status of an export or import job (owner or admin)
+// @ID getWorkspaceBackupJob
+// @Tags Workspace Backups
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param jobId path string true "Job ID"
+// @Success 200 {object} domain.WorkspaceBackupJob
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/backups/{jobId} [get]
+func (h *WorkspaceBackupHandler) GetJob(c *gin.Context) {
+	userID, workspaceID, ok := backupRequestIDs(c)
+	if !ok {
+		return
+	}
+	jobID, err := uuid.Parse(c.Param("jobId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid job ID")
+		return
+	}
+
+	job, err := h.backupService.GetJob(workspaceID, jobID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, job)
+}
+
+// backupRequestIDs extracts the authenticated user and the workspace path parameter
+func backupRequestIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, workspaceID, true
+}
@@ -22,6 +22,7 @@ func NewWorkspaceHandler(workspaceService *service.WorkspaceService) *WorkspaceH
 
 // CreateWorkspace godoc
 // @Summary Create a new workspace
+// @ID createWorkspace
 // @Tags Workspaces
 // @Accept json
 // @Produce json
@@ -55,6 +56,7 @@ func (h *WorkspaceHandler) CreateWorkspace(c *gin.Context) {
 
 // GetWorkspace godoc
 // @Summary Get workspace by ID
+// @ID getWorkspace
 // @Tags Workspaces
 // @Produce json
 // @Security BearerAuth
@@ -81,6 +83,7 @@ func (h *WorkspaceHandler) GetWorkspace(c *gin.Context) {
 
 // GetAllWorkspaces godoc
 // @Summary Get all workspaces for current user
+// @ID getAllWorkspaces
 // @Tags Workspaces
 // @Produce json
 // @Security BearerAuth
@@ -124,6 +127,7 @@ func (h *WorkspaceHandler) GetAllWorkspaces(c *gin.Context) {
 
 // SearchPublicWorkspaces godoc
 // @Summary Search public workspaces by name
+// @ID searchPublicWorkspaces
 // @Tags Workspaces
 // @Produce json
 // @Param workspaceName path string true "Workspace name"
@@ -148,6 +152,7 @@ func (h *WorkspaceHandler) SearchPublicWorkspaces(c *gin.Context) {
 
 // UpdateWorkspace godoc
 // @Summary Update workspace
+// @ID updateWorkspace
 // @Tags Workspaces
 // @Accept json
 // @Produce json
@@ -189,6 +194,7 @@ func (h *WorkspaceHandler) UpdateWorkspace(c *gin.Context) {
 
 // DeleteWorkspace godoc
 // @Summary Delete workspace (soft delete)
+// @ID deleteWorkspace
 // @Tags Workspaces
 // @Produce json
 // @Security BearerAuth
@@ -221,6 +227,7 @@ func (h *WorkspaceHandler) DeleteWorkspace(c *gin.Context) {
 
 // SetDefaultWorkspace godoc
 // @Summary Set default workspace for user
+// @ID setDefaultWorkspace
 // @Tags Workspaces
 // @Accept json
 // @Produce json
@@ -260,6 +267,7 @@ func (h *WorkspaceHandler) SetDefaultWorkspace(c *gin.Context) {
 
 // GetMembers godoc
 // @Summary Get workspace members
+// @ID getMembers
 // @Tags Workspace Members
 // @Produce json
 // @Security BearerAuth
@@ -286,6 +294,7 @@ func (h *WorkspaceHandler) GetMembers(c *gin.Context) {
 
 // InviteMember godoc
 // @Summary Invite user to workspace
+// @ID inviteMember
 // @Tags Workspace Members
 // @Accept json
 // @Produce json
@@ -327,6 +336,7 @@ func (h *WorkspaceHandler) InviteMember(c *gin.Context) {
 
 // UpdateMemberRole godoc
 // @Summary Update member role
+// @ID updateMemberRole
 // @Tags Workspace Members
 // @Accept json
 // @Produce json
@@ -376,6 +386,7 @@ func (h *WorkspaceHandler) UpdateMemberRole(c *gin.Context) {
 
 // RemoveMember godoc
 // @Summary Remove member from workspace
+// @ID removeMember
 // @Tags Workspace Members
 // @Produce json
 // @Security BearerAuth
@@ -416,6 +427,7 @@ func (h *WorkspaceHandler) RemoveMember(c *gin.Context) {
 
 // ValidateMember godoc
 // @Summary Validate user has access to workspace
+// @ID validateMember
 // @Tags Workspace Members
 // @Produce json
 // @Security BearerAuth
@@ -449,6 +461,7 @@ func (h *WorkspaceHandler) ValidateMember(c *gin.Context) {
 
 // CreateJoinRequest godoc
 // @Summary Create join request for workspace
+// @ID createJoinRequest
 // @Tags Join Requests
 // @Accept json
 // @Produce json
@@ -481,6 +494,7 @@ func (h *WorkspaceHandler) CreateJoinRequest(c *gin.Context) {
 
 // GetJoinRequests godoc
 // @Summary Get join requests for workspace
+// @ID getJoinRequests
 // @Tags Join Requests
 // @Produce json
 // @Security BearerAuth
@@ -518,6 +532,7 @@ func (h *WorkspaceHandler) GetJoinRequests(c *gin.Context) {
 
 // ProcessJoinRequest godoc
 // @Summary Process join request (approve/reject)
+// @ID processJoinRequest
 // @Tags Join Requests
 // @Accept json
 // @Produce json
@@ -566,6 +581,7 @@ func (h *WorkspaceHandler) ProcessJoinRequest(c *gin.Context) {
 
 // GetWorkspaceSettings godoc
 // @Summary Get workspace settings
+// @ID getWorkspaceSettings
 // @Tags Workspaces
 // @Produce json
 // @Security BearerAuth
@@ -591,6 +607,7 @@ func (h *WorkspaceHandler) GetWorkspaceSettings(c *gin.Context) {
 
 // UpdateWorkspaceSettings godoc
 // @Summary Update workspace settings
+// @ID updateWorkspaceSettings
 // @Tags Workspaces
 // @Accept json
 // @Produce json
@@ -28,6 +28,17 @@ type SmartValidator = commonauth.SmartValidator
 // Istio JWT 모드에서 사용: 검증 없이 파싱만 수행합니다.
 type JWTParser = commonauth.JWTParser
 
+// ServiceTokenValidator는 공통 모듈의 ServiceTokenValidator 타입 별칭입니다.
+// 서비스 간 호출용 client-credentials 토큰을 검증합니다 (SmartValidator, JWTParser 구현).
+type ServiceTokenValidator = commonauth.ServiceTokenValidator
+
+// ServiceClaims는 공통 모듈의 ServiceClaims 타입 별칭입니다.
+type ServiceClaims = commonauth.ServiceClaims
+
+// StepUpRequirement는 공통 모듈의 StepUpRequirement 타입 별칭입니다.
+// 민감한 작업에 필요한 최근 인증 조건(auth_time, acr)입니다.
+type StepUpRequirement = commonauth.StepUpRequirement
+
 // NewAuthServiceValidator는 새 AuthServiceValidator를 생성합니다.
 // Deprecated: NewSmartValidator 사용 권장
 func NewAuthServiceValidator(authServiceURL, secretKey string, logger *zap.Logger) *AuthServiceValidator {
@@ -59,6 +70,23 @@ func AuthWithValidator(validator TokenValidator) gin.HandlerFunc {
 	return commonauth.AuthMiddlewareWithValidator(validator)
 }
 
+// ServiceAuth는 서비스 토큰과 scope를 요구하는 미들웨어입니다.
+// scope가 부족하면 403을 반환합니다.
+func ServiceAuth(validator ServiceTokenValidator, logger *zap.Logger, scopes ...string) gin.HandlerFunc {
+	return commonauth.ServiceAuthMiddleware(validator, logger, scopes...)
+}
+
+// UserOrServiceAuth는 사용자 토큰(userAuth)과 scope를 가진 서비스 토큰을 모두 허용하는 미들웨어입니다.
+func UserOrServiceAuth(userAuth gin.HandlerFunc, validator ServiceTokenValidator, logger *zap.Logger, scopes ...string) gin.HandlerFunc {
+	return commonauth.UserOrServiceAuthMiddleware(userAuth, validator, logger, scopes...)
+}
+
+// StepUp은 policy가 반환한 조건으로 최근 인증을 요구하는 미들웨어입니다.
+// 조건을 만족하지 않으면 401 STEP_UP_REQUIRED를 반환합니다.
+func StepUp(policy func(c *gin.Context) (StepUpRequirement, error), logger *zap.Logger) gin.HandlerFunc {
+	return commonauth.StepUpMiddleware(policy, logger)
+}
+
 // Auth는 JWT 토큰을 로컬에서 검증하는 미들웨어입니다.
 // 공통 모듈의 JWTMiddleware를 사용합니다.
 func Auth(jwtSecret string) gin.HandlerFunc {
@@ -75,6 +103,11 @@ func GetJWTToken(c *gin.Context) (string, bool) {
 	return commonauth.GetJWTToken(c)
 }
 
+// GetServiceClient는 컨텍스트에서 서비스 토큰 클라이언트 정보를 추출합니다.
+func GetServiceClient(c *gin.Context) (*ServiceClaims, bool) {
+	return commonauth.GetServiceClient(c)
+}
+
 // ValidateTokenFromContext는 컨텍스트에서 토큰을 가져와 검증합니다.
 func ValidateTokenFromContext(ctx context.Context, validator TokenValidator, token string) (uuid.UUID, error) {
 	return validator.ValidateToken(ctx, token)
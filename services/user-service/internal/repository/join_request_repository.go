@@ -17,6 +17,11 @@ func NewJoinRequestRepository(db *gorm.DB) *JoinRequestRepository {
 	return &JoinRequestRepository{db: db}
 }
 
+// WithTx returns a repository that runs its queries in tx
+func (r *JoinRequestRepository) WithTx(tx *gorm.DB) *JoinRequestRepository {
+	return &JoinRequestRepository{db: tx}
+}
+
 // Create creates a new join request
 func (r *JoinRequestRepository) Create(request *domain.WorkspaceJoinRequest) error {
 	return r.db.Create(request).Error
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// UserIdentityRepository handles social login identity data access
+type UserIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewUserIdentityRepository creates a new UserIdentityRepository
+func NewUserIdentityRepository(db *gorm.DB) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db}
+}
+
+// Create creates a new identity
+func (r *UserIdentityRepository) Create(identity *domain.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+// FindByProvider finds an identity by provider and provider account ID
+func (r *UserIdentityRepository) FindByProvider(provider, providerUserID string) (*domain.UserIdentity, error) {
+	var identity domain.UserIdentity
+	err := r.db.Where("provider = ? AND provider_user_id = ?", provider, providerUserID).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// CreateUserWithIdentity creates a new user and its first identity in one transaction
+func (r *UserIdentityRepository) CreateUserWithIdentity(user *domain.User, identity *domain.UserIdentity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"user-service/internal/domain"
+)
+
+// UserMFARepository handles TOTP secret and recovery code data access
+type UserMFARepository struct {
+	db *gorm.DB
+}
+
+// NewUserMFARepository creates a new UserMFARepository
+func NewUserMFARepository(db *gorm.DB) *UserMFARepository {
+	return &UserMFARepository{db: db}
+}
+
+// FindTOTP finds the TOTP factor of a user (nil if not enrolled)
+func (r *UserMFARepository) FindTOTP(userID uuid.UUID) (*domain.UserTOTP, error) {
+	var totp []domain.UserTOTP
+	if err := r.db.Where("user_id = ?", userID).Limit(1).Find(&totp).Error; err != nil {
+		return nil, err
+	}
+	if len(totp) == 0 {
+		return nil, nil
+	}
+	return &totp[0], nil
+}
+
+// SaveTOTP creates or replaces the TOTP factor and recovery codes of a user
+func (r *UserMFARepository) SaveTOTP(totp *domain.UserTOTP, codeHashes []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"secret", "last_used_step", "updated_at"}),
+		}).Create(totp).Error; err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(tx, totp.UserID, codeHashes)
+	})
+}
+
+// DeleteTOTP deletes the TOTP factor and recovery codes of a user
+func (r *UserMFARepository) DeleteTOTP(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.UserTOTP{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&domain.UserRecoveryCode{}).Error
+	})
+}
+
+// RecordTOTPStep stores the time step of an accepted code.
+// Returns false if the step is not newer than the last used one (code replay).
+func (r *UserMFARepository) RecordTOTPStep(userID uuid.UUID, step int64) (bool, error) {
+	result := r.db.Model(&domain.UserTOTP{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Updates(map[string]interface{}{
+			"last_used_step": step,
+			"updated_at":     time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ReplaceRecoveryCodes replaces all recovery codes of a user
+func (r *UserMFARepository) ReplaceRecoveryCodes(userID uuid.UUID, codeHashes []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+// ConsumeRecoveryCode marks an unused recovery code as used.
+// Returns false if the code does not exist or was already used.
+func (r *UserMFARepository) ConsumeRecoveryCode(userID uuid.UUID, codeHash string) (bool, error) {
+	result := r.db.Model(&domain.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// CountRemainingRecoveryCodes counts the unused recovery codes of a user
+func (r *UserMFARepository) CountRemainingRecoveryCodes(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// FindWorkspacesRequiringMFA finds the active workspaces of a user that require MFA for all members
+func (r *UserMFARepository) FindWorkspacesRequiringMFA(userID uuid.UUID) ([]uuid.UUID, error) {
+	var workspaceIDs []uuid.UUID
+	err := r.db.Model(&domain.WorkspaceMember{}).
+		Joins("JOIN workspaces ON workspaces.id = workspace_members.workspace_id").
+		Where("workspace_members.user_id = ? AND workspace_members.is_active = ?", userID, true).
+		Where("workspaces.require_mfa = ? AND workspaces.is_active = ? AND workspaces.deleted_at IS NULL", true, true).
+		Pluck("workspace_members.workspace_id", &workspaceIDs).Error
+	return workspaceIDs, err
+}
+
+// FindTokenPolicy finds the shortest token lifetimes set by the active workspaces of a user (0 when unset)
+func (r *UserMFARepository) FindTokenPolicy(userID uuid.UUID) (*domain.TokenPolicyResponse, error) {
+	var policy domain.TokenPolicyResponse
+	err := r.db.Model(&domain.WorkspaceMember{}).
+		Select("COALESCE(MIN(NULLIF(workspaces.access_token_ttl_minutes, 0)), 0) AS access_token_ttl_minutes, "+
+			"COALESCE(MIN(NULLIF(workspaces.refresh_token_ttl_minutes, 0)), 0) AS refresh_token_ttl_minutes").
+		Joins("JOIN workspaces ON workspaces.id = workspace_members.workspace_id").
+		Where("workspace_members.user_id = ? AND workspace_members.is_active = ?", userID, true).
+		Where("workspaces.is_active = ? AND workspaces.deleted_at IS NULL", true).
+		Scan(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID uuid.UUID, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&domain.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	now := time.Now()
+	codes := make([]domain.UserRecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, domain.UserRecoveryCode{ID: uuid.New(), UserID: userID, CodeHash: hash, CreatedAt: now})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return tx.Create(&codes).Error
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"user-service/internal/domain"
+)
+
+// UserPasskeyRepository handles passkey (WebAuthn credential) and MFA setting data access
+type UserPasskeyRepository struct {
+	db *gorm.DB
+}
+
+// NewUserPasskeyRepository creates a new UserPasskeyRepository
+func NewUserPasskeyRepository(db *gorm.DB) *UserPasskeyRepository {
+	return &UserPasskeyRepository{db: db}
+}
+
+// Create creates a new passkey
+func (r *UserPasskeyRepository) Create(passkey *domain.UserPasskey) error {
+	return r.db.Create(passkey).Error
+}
+
+// FindByUser finds all passkeys of a user, oldest first
+func (r *UserPasskeyRepository) FindByUser(userID uuid.UUID) ([]domain.UserPasskey, error) {
+	var passkeys []domain.UserPasskey
+	err := r.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&passkeys).Error
+	return passkeys, err
+}
+
+// FindByID finds a passkey of a user by ID
+func (r *UserPasskeyRepository) FindByID(userID, passkeyID uuid.UUID) (*domain.UserPasskey, error) {
+	var passkey domain.UserPasskey
+	err := r.db.Where("id = ? AND user_id = ?", passkeyID, userID).First(&passkey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &passkey, nil
+}
+
+// FindByCredentialID finds a passkey by its WebAuthn credential ID
+func (r *UserPasskeyRepository) FindByCredentialID(credentialID string) (*domain.UserPasskey, error) {
+	var passkey domain.UserPasskey
+	err := r.db.Where("credential_id = ?", credentialID).First(&passkey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &passkey, nil
+}
+
+// CountByUser counts the passkeys of a user
+func (r *UserPasskeyRepository) CountByUser(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.UserPasskey{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// Rename updates the display name of a passkey
+func (r *UserPasskeyRepository) Rename(passkey *domain.UserPasskey, name string) error {
+	return r.db.Model(passkey).Updates(map[string]interface{}{
+		"name":       name,
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// RecordUse updates the signature counter and last use time
+func (r *UserPasskeyRepository) RecordUse(passkeyID uuid.UUID, signCount int64) error {
+	now := time.Now()
+	return r.db.Model(&domain.UserPasskey{}).Where("id = ?", passkeyID).Updates(map[string]interface{}{
+		"sign_count":   signCount,
+		"last_used_at": now,
+		"updated_at":   now,
+	}).Error
+}
+
+// Delete deletes a passkey of a user
+func (r *UserPasskeyRepository) Delete(userID, passkeyID uuid.UUID) error {
+	return r.db.Where("id = ? AND user_id = ?", passkeyID, userID).Delete(&domain.UserPasskey{}).Error
+}
+
+// IsMFARequired reports whether the user requires a passkey as second factor
+func (r *UserPasskeyRepository) IsMFARequired(userID uuid.UUID) (bool, error) {
+	var setting domain.UserMFASetting
+	err := r.db.Where("user_id = ?", userID).Limit(1).Find(&setting).Error
+	return setting.PasskeyRequired, err
+}
+
+// SetMFARequired creates or updates the MFA setting of a user
+func (r *UserPasskeyRepository) SetMFARequired(userID uuid.UUID, required bool) error {
+	setting := domain.UserMFASetting{UserID: userID, PasskeyRequired: required, UpdatedAt: time.Now()}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"passkey_required", "updated_at"}),
+	}).Create(&setting).Error
+}
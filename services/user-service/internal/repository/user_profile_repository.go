@@ -1,15 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
 	"user-service/internal/domain"
 )
 
 // UserProfileRepository handles user profile data access
 type UserProfileRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	profiles *cache.Cache[*domain.UserProfile] // optional, user/workspace profile lookups
 }
 
 // NewUserProfileRepository creates a new UserProfileRepository
@@ -17,6 +21,18 @@ func NewUserProfileRepository(db *gorm.DB) *UserProfileRepository {
 	return &UserProfileRepository{db: db}
 }
 
+// WithCache caches FindByUserAndWorkspace results in profiles
+// 다른 서비스가 작성자/담당자 표시를 위해 가장 자주 읽는 조회이며, 수정/삭제 시 바로 무효화합니다.
+func (r *UserProfileRepository) WithCache(profiles *cache.Cache[*domain.UserProfile]) *UserProfileRepository {
+	r.profiles = profiles
+	return r
+}
+
+// WithTx returns a repository that runs its queries in tx, sharing the profile cache
+func (r *UserProfileRepository) WithTx(tx *gorm.DB) *UserProfileRepository {
+	return &UserProfileRepository{db: tx, profiles: r.profiles}
+}
+
 // Create creates a new user profile
 func (r *UserProfileRepository) Create(profile *domain.UserProfile) error {
 	return r.db.Create(profile).Error
@@ -34,12 +50,14 @@ func (r *UserProfileRepository) FindByID(id uuid.UUID) (*domain.UserProfile, err
 
 // FindByUserAndWorkspace finds a profile by user and workspace
 func (r *UserProfileRepository) FindByUserAndWorkspace(userID, workspaceID uuid.UUID) (*domain.UserProfile, error) {
-	var profile domain.UserProfile
-	err := r.db.Where("user_id = ? AND workspace_id = ?", userID, workspaceID).First(&profile).Error
-	if err != nil {
-		return nil, err
-	}
-	return &profile, nil
+	return r.profiles.GetOrLoad(context.Background(), profileCacheKey(userID, workspaceID), func(context.Context) (*domain.UserProfile, error) {
+		var profile domain.UserProfile
+		err := r.db.Where("user_id = ? AND workspace_id = ?", userID, workspaceID).First(&profile).Error
+		if err != nil {
+			return nil, err
+		}
+		return &profile, nil
+	})
 }
 
 // FindByUser finds all profiles for a user
@@ -58,15 +76,43 @@ func (r *UserProfileRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain
 
 // Update updates a user profile
 func (r *UserProfileRepository) Update(profile *domain.UserProfile) error {
-	return r.db.Save(profile).Error
+	if err := r.db.Save(profile).Error; err != nil {
+		return err
+	}
+	r.invalidate(profile.UserID, profile.WorkspaceID)
+	return nil
 }
 
 // Delete deletes a user profile
 func (r *UserProfileRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&domain.UserProfile{}, "id = ?", id).Error
+	// 삭제 후에는 사용자/워크스페이스를 알 수 없으므로 캐시를 쓰면 먼저 조회
+	var existing *domain.UserProfile
+	if r.profiles != nil {
+		existing, _ = r.FindByID(id)
+	}
+	if err := r.db.Delete(&domain.UserProfile{}, "id = ?", id).Error; err != nil {
+		return err
+	}
+	if existing != nil {
+		r.invalidate(existing.UserID, existing.WorkspaceID)
+	}
+	return nil
 }
 
 // DeleteByUserAndWorkspace deletes a profile by user and workspace
 func (r *UserProfileRepository) DeleteByUserAndWorkspace(userID, workspaceID uuid.UUID) error {
-	return r.db.Delete(&domain.UserProfile{}, "user_id = ? AND workspace_id = ?", userID, workspaceID).Error
+	if err := r.db.Delete(&domain.UserProfile{}, "user_id = ? AND workspace_id = ?", userID, workspaceID).Error; err != nil {
+		return err
+	}
+	r.invalidate(userID, workspaceID)
+	return nil
+}
+
+// invalidate removes the cached profile of userID in workspaceID
+func (r *UserProfileRepository) invalidate(userID, workspaceID uuid.UUID) {
+	_ = r.profiles.Invalidate(context.Background(), profileCacheKey(userID, workspaceID))
+}
+
+func profileCacheKey(userID, workspaceID uuid.UUID) string {
+	return userID.String() + ":" + workspaceID.String()
 }
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"user-service/internal/domain"
+)
+
+// WorkspaceBackupRepository handles workspace backup jobs and the user-service backup section
+type WorkspaceBackupRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceBackupRepository creates a new WorkspaceBackupRepository
+func NewWorkspaceBackupRepository(db *gorm.DB) *WorkspaceBackupRepository {
+	return &WorkspaceBackupRepository{db: db}
+}
+
+// CreateJob creates a new backup job
+func (r *WorkspaceBackupRepository) CreateJob(job *domain.WorkspaceBackupJob) error {
+	return r.db.Create(job).Error
+}
+
+// UpdateJob saves a backup job
+func (r *WorkspaceBackupRepository) UpdateJob(job *domain.WorkspaceBackupJob) error {
+	return r.db.Save(job).Error
+}
+
+// FindJob finds a backup job of a workspace
+func (r *WorkspaceBackupRepository) FindJob(workspaceID, jobID uuid.UUID) (*domain.WorkspaceBackupJob, error) {
+	var job domain.WorkspaceBackupJob
+	err := r.db.Where("id = ? AND workspace_id = ?", jobID, workspaceID).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ListJobs lists the most recent backup jobs of a workspace
+func (r *WorkspaceBackupRepository) ListJobs(workspaceID uuid.UUID, limit int) ([]domain.WorkspaceBackupJob, error) {
+	var jobs []domain.WorkspaceBackupJob
+	err := r.db.Where("workspace_id = ?", workspaceID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// HasActiveJob checks whether the workspace has a pending or running job created after since.
+// Older unfinished jobs were interrupted (e.g. by a restart) and do not block new ones.
+func (r *WorkspaceBackupRepository) HasActiveJob(workspaceID uuid.UUID, since time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.WorkspaceBackupJob{}).
+		Where("workspace_id = ? AND status IN ? AND created_at > ?", workspaceID,
+			[]domain.BackupJobStatus{domain.BackupJobPending, domain.BackupJobRunning}, since).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// LoadUserSection loads the workspace (including an inactive one), all its members,
+// the members' user accounts and their workspace profiles
+func (r *WorkspaceBackupRepository) LoadUserSection(workspaceID uuid.UUID) (*domain.WorkspaceUserBackup, error) {
+	section := &domain.WorkspaceUserBackup{}
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", workspaceID).First(&section.Workspace).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Where("workspace_id = ?", workspaceID).Order("joined_at, id").Find(&section.Members).Error; err != nil {
+		return nil, err
+	}
+	memberUserIDs := r.db.Model(&domain.WorkspaceMember{}).Select("user_id").Where("workspace_id = ?", workspaceID)
+	err := r.db.Where("id IN (?) OR id = ?", memberUserIDs, section.Workspace.OwnerID).
+		Order("id").
+		Find(&section.Users).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.Where("workspace_id = ?", workspaceID).Order("id").Find(&section.Profiles).Error; err != nil {
+		return nil, err
+	}
+	return section, nil
+}
+
+// RestoreUserSection inserts the section in one transaction, skipping rows whose ID already exists.
+// A user whose email already belongs to a different account cannot be restored (members would
+// point at a missing user), so the whole restore is rolled back in that case.
+func (r *WorkspaceBackupRepository) RestoreUserSection(section *domain.WorkspaceUserBackup) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Select("*"): default:true 컬럼(isPublic, isActive 등)의 false 값도 그대로 저장
+		insert := tx.Select("*").Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Session(&gorm.Session{})
+
+		if len(section.Users) > 0 {
+			if err := insert.Create(&section.Users).Error; err != nil {
+				return err
+			}
+			userIDs := make([]uuid.UUID, len(section.Users))
+			for i, user := range section.Users {
+				userIDs[i] = user.ID
+			}
+			var existing int64
+			if err := tx.Model(&domain.User{}).Where("id IN ?", userIDs).Count(&existing).Error; err != nil {
+				return err
+			}
+			if existing != int64(len(userIDs)) {
+				return fmt.Errorf("%d archived users conflict with existing accounts (same email, different ID)", int64(len(userIDs))-existing)
+			}
+		}
+		if err := insert.Create(&section.Workspace).Error; err != nil {
+			return err
+		}
+		if len(section.Members) > 0 {
+			if err := insert.Create(&section.Members).Error; err != nil {
+				return err
+			}
+		}
+		if len(section.Profiles) > 0 {
+			if err := insert.Create(&section.Profiles).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
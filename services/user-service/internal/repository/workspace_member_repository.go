@@ -17,6 +17,11 @@ func NewWorkspaceMemberRepository(db *gorm.DB) *WorkspaceMemberRepository {
 	return &WorkspaceMemberRepository{db: db}
 }
 
+// WithTx returns a repository that runs its queries in tx
+func (r *WorkspaceMemberRepository) WithTx(tx *gorm.DB) *WorkspaceMemberRepository {
+	return &WorkspaceMemberRepository{db: tx}
+}
+
 // Create creates a new workspace member
 func (r *WorkspaceMemberRepository) Create(member *domain.WorkspaceMember) error {
 	return r.db.Create(member).Error
@@ -0,0 +1,137 @@
+//go:build integration
+
+package repository
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+	"user-service/internal/database"
+	"user-service/internal/domain"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+func setupPostgres(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := integration.Postgres(t)
+	if err := database.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+func createUser(t *testing.T, db *gorm.DB, email string) *domain.User {
+	t.Helper()
+	user := &domain.User{Email: email, Name: email, Provider: "google"}
+	if err := NewUserRepository(db).Create(user); err != nil {
+		t.Fatalf("failed to create user %s: %v", email, err)
+	}
+	return user
+}
+
+// createWorkspace creates a workspace owned by owner with owner as a member
+func createWorkspace(t *testing.T, db *gorm.DB, owner *domain.User, name string, isDefault bool) *domain.Workspace {
+	t.Helper()
+	workspace := &domain.Workspace{OwnerID: owner.ID, WorkspaceName: name}
+	if err := NewWorkspaceRepository(db).Create(workspace); err != nil {
+		t.Fatalf("failed to create workspace %s: %v", name, err)
+	}
+	member := &domain.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      owner.ID,
+		RoleName:    domain.RoleOwner,
+		IsDefault:   isDefault,
+		JoinedAt:    time.Now(),
+	}
+	if err := NewWorkspaceMemberRepository(db).Create(member); err != nil {
+		t.Fatalf("failed to create member: %v", err)
+	}
+	return workspace
+}
+
+func TestWorkspaceMemberRepository_ExcludesSoftDeletedWorkspaces(t *testing.T) {
+	db := setupPostgres(t)
+	members := NewWorkspaceMemberRepository(db)
+	user := createUser(t, db, "alice@example.com")
+
+	deleted := createWorkspace(t, db, user, "deleted", true)
+	active := createWorkspace(t, db, user, "active", false)
+
+	if err := NewWorkspaceRepository(db).SoftDelete(deleted.ID); err != nil {
+		t.Fatalf("SoftDelete() error = %v", err)
+	}
+
+	memberships, err := members.FindByUser(user.ID)
+	if err != nil {
+		t.Fatalf("FindByUser() error = %v", err)
+	}
+	if len(memberships) != 1 || memberships[0].WorkspaceID != active.ID {
+		t.Fatalf("FindByUser() returned %d memberships, want only the active workspace", len(memberships))
+	}
+	if memberships[0].Workspace == nil || memberships[0].Workspace.WorkspaceName != "active" {
+		t.Errorf("FindByUser() did not preload the active workspace")
+	}
+
+	// The default workspace was deleted, so there is no usable default until a new one is set
+	if _, err := members.FindDefaultWorkspace(user.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindDefaultWorkspace() error = %v, want ErrRecordNotFound", err)
+	}
+	if err := members.SetDefault(user.ID, active.ID); err != nil {
+		t.Fatalf("SetDefault() error = %v", err)
+	}
+	def, err := members.FindDefaultWorkspace(user.ID)
+	if err != nil {
+		t.Fatalf("FindDefaultWorkspace() after SetDefault error = %v", err)
+	}
+	if def.WorkspaceID != active.ID {
+		t.Errorf("default workspace = %s, want %s", def.WorkspaceID, active.ID)
+	}
+}
+
+func TestWorkspaceMemberRepository_DeleteDeactivatesMember(t *testing.T) {
+	db := setupPostgres(t)
+	members := NewWorkspaceMemberRepository(db)
+	owner := createUser(t, db, "owner@example.com")
+	workspace := createWorkspace(t, db, owner, "team", true)
+
+	user := createUser(t, db, "bob@example.com")
+	member := &domain.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      user.ID,
+		RoleName:    domain.RoleMember,
+		JoinedAt:    time.Now(),
+	}
+	if err := members.Create(member); err != nil {
+		t.Fatalf("failed to create member: %v", err)
+	}
+
+	if err := members.Delete(member.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	isMember, err := members.IsMember(workspace.ID, user.ID)
+	if err != nil {
+		t.Fatalf("IsMember() error = %v", err)
+	}
+	if isMember {
+		t.Error("IsMember() = true after Delete, want false")
+	}
+	if _, err := members.FindByWorkspaceAndUser(workspace.ID, user.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByWorkspaceAndUser() error = %v, want ErrRecordNotFound", err)
+	}
+	list, err := members.FindByWorkspace(workspace.ID)
+	if err != nil {
+		t.Fatalf("FindByWorkspace() error = %v", err)
+	}
+	if len(list) != 1 || list[0].UserID != owner.ID {
+		t.Errorf("FindByWorkspace() returned %d members, want only the owner", len(list))
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// WorkspaceSSORepository handles workspace SSO configuration data access
+type WorkspaceSSORepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceSSORepository creates a new WorkspaceSSORepository
+func NewWorkspaceSSORepository(db *gorm.DB) *WorkspaceSSORepository {
+	return &WorkspaceSSORepository{db: db}
+}
+
+// FindByWorkspace finds the SSO configuration of a workspace with its domains
+func (r *WorkspaceSSORepository) FindByWorkspace(workspaceID uuid.UUID) (*domain.WorkspaceSSOConfig, error) {
+	var config domain.WorkspaceSSOConfig
+	err := r.db.Preload("Domains").Where("workspace_id = ?", workspaceID).First(&config).Error
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// FindEnabledByDomain finds the enabled SSO configuration that owns a verified email domain
+func (r *WorkspaceSSORepository) FindEnabledByDomain(emailDomain string) (*domain.WorkspaceSSOConfig, error) {
+	var config domain.WorkspaceSSOConfig
+	err := r.db.Preload("Domains").Preload("Workspace").
+		Joins("JOIN workspace_sso_domains ON workspace_sso_domains.workspace_id = workspace_sso_configs.workspace_id").
+		Where("workspace_sso_domains.domain = ? AND workspace_sso_domains.verified_at IS NOT NULL AND workspace_sso_configs.enabled = true", emailDomain).
+		First(&config).Error
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// FindDomainOwner returns the workspace that verified ownership of an email domain
+func (r *WorkspaceSSORepository) FindDomainOwner(emailDomain string) (*domain.WorkspaceSSODomain, error) {
+	var d domain.WorkspaceSSODomain
+	err := r.db.Where("domain = ? AND verified_at IS NOT NULL", emailDomain).First(&d).Error
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Save creates or updates a configuration and replaces its domains in one transaction
+// Domains kept in the list keep their ID, so verification state survives the update.
+func (r *WorkspaceSSORepository) Save(config *domain.WorkspaceSSOConfig, domains []domain.WorkspaceSSODomain) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Domains", "Workspace").Save(config).Error; err != nil {
+			return err
+		}
+
+		keep := make([]uuid.UUID, 0, len(domains))
+		for i := range domains {
+			if domains[i].ID == uuid.Nil {
+				domains[i].ID = uuid.New()
+			}
+			domains[i].WorkspaceID = config.WorkspaceID
+			keep = append(keep, domains[i].ID)
+		}
+
+		deleteQuery := tx.Where("workspace_id = ?", config.WorkspaceID)
+		if len(keep) > 0 {
+			deleteQuery = deleteQuery.Where("id NOT IN ?", keep)
+		}
+		if err := deleteQuery.Delete(&domain.WorkspaceSSODomain{}).Error; err != nil {
+			return err
+		}
+
+		config.Domains = domains
+		if len(domains) == 0 {
+			return nil
+		}
+		return tx.Save(&config.Domains).Error
+	})
+}
+
+// MarkDomainVerified records that ownership of a workspace email domain was proven
+func (r *WorkspaceSSORepository) MarkDomainVerified(id uuid.UUID, verifiedAt time.Time) error {
+	return r.db.Model(&domain.WorkspaceSSODomain{}).Where("id = ?", id).Update("verified_at", verifiedAt).Error
+}
+
+// Delete removes the SSO configuration of a workspace and its domains
+func (r *WorkspaceSSORepository) Delete(workspaceID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_id = ?", workspaceID).Delete(&domain.WorkspaceSSODomain{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("workspace_id = ?", workspaceID).Delete(&domain.WorkspaceSSOConfig{}).Error
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	commonresponse "github.com/OrangesCloud/wealist-advanced-go-pkg/response"
 )
 
 // ============================================================
@@ -74,57 +75,47 @@ func NewConflictError(message, details string) *AppError {
 	return apperrors.Conflict(message, details)
 }
 
+// NewAlreadyMemberError는 이미 워크스페이스 멤버일 때 사용합니다. (409)
+func NewAlreadyMemberError(message, details string) *AppError {
+	return apperrors.AlreadyMember(message, details)
+}
+
+// NewPendingRequestExistsError는 대기 중인 참여 요청이 이미 있을 때 사용합니다. (409)
+func NewPendingRequestExistsError(message, details string) *AppError {
+	return apperrors.PendingRequestExists(message, details)
+}
+
 // NewAppError는 사용자 정의 에러 코드로 에러를 생성합니다.
 func NewAppError(code, message, details string) *AppError {
 	return apperrors.New(code, message, details)
 }
 
 // ============================================================
-// Handler 레이어용 응답 함수
+// Handler 레이어용 응답 함수 (공통 응답 봉투 사용)
 // ============================================================
 
 // Error sends an error response using the common AppError type.
 func Error(c *gin.Context, err *apperrors.AppError) {
-	status := apperrors.GetHTTPStatus(err.Code)
-	c.JSON(status, gin.H{
-		"error": gin.H{
-			"code":    err.Code,
-			"message": err.Message,
-		},
-	})
+	commonresponse.ErrorFrom(c, err)
 }
 
 // ErrorWithDetails sends an error response with additional details.
 func ErrorWithDetails(c *gin.Context, err *apperrors.AppError) {
-	status := apperrors.GetHTTPStatus(err.Code)
-	response := gin.H{
-		"code":    err.Code,
-		"message": err.Message,
-	}
-	if err.Details != "" {
-		response["details"] = err.Details
-	}
-	c.JSON(status, gin.H{"error": response})
+	commonresponse.ErrorFrom(c, err)
 }
 
 // HandleError converts a generic error to an appropriate HTTP response.
 // If the error is an AppError, it uses the error's code.
 // Otherwise, it returns a 500 Internal Server Error.
 func HandleError(c *gin.Context, err error) {
-	if appErr := apperrors.AsAppError(err); appErr != nil {
-		Error(c, appErr)
-		return
-	}
-
-	// Default to internal error
-	Error(c, apperrors.Internal("An unexpected error occurred", err.Error()))
+	commonresponse.HandleError(c, err)
 }
 
 // Common error response helpers
 
 // BadRequest sends a 400 Bad Request response.
 func BadRequest(c *gin.Context, message string) {
-	Error(c, apperrors.BadRequest(message, ""))
+	commonresponse.BadRequest(c, message)
 }
 
 // BadRequestWithDetails sends a 400 Bad Request response with details.
@@ -134,7 +125,7 @@ func BadRequestWithDetails(c *gin.Context, message, details string) {
 
 // ValidationError sends a 400 response for validation errors.
 func ValidationError(c *gin.Context, message string) {
-	Error(c, apperrors.Validation(message, ""))
+	commonresponse.ValidationError(c, message)
 }
 
 // ValidationErrorWithDetails sends a 400 response for validation errors with details.
@@ -144,30 +135,31 @@ func ValidationErrorWithDetails(c *gin.Context, message, details string) {
 
 // Unauthorized sends a 401 Unauthorized response.
 func Unauthorized(c *gin.Context, message string) {
-	Error(c, apperrors.Unauthorized(message, ""))
+	commonresponse.Unauthorized(c, message)
 }
 
 // Forbidden sends a 403 Forbidden response.
 func Forbidden(c *gin.Context, message string) {
-	Error(c, apperrors.Forbidden(message, ""))
+	commonresponse.Forbidden(c, message)
 }
 
 // NotFound sends a 404 Not Found response.
 func NotFound(c *gin.Context, message string) {
-	Error(c, apperrors.NotFound(message, ""))
+	commonresponse.NotFound(c, message)
 }
 
 // Conflict sends a 409 Conflict response.
 func Conflict(c *gin.Context, message string) {
-	Error(c, apperrors.Conflict(message, ""))
+	commonresponse.Conflict(c, message)
 }
 
 // InternalError sends a 500 Internal Server Error response.
 func InternalError(c *gin.Context, message string) {
-	Error(c, apperrors.Internal(message, ""))
+	commonresponse.InternalError(c, message)
 }
 
-// InternalErrorWithDetails sends a 500 response with error details.
+// InternalErrorWithDetails sends a 500 response.
+// The cause is not exposed to clients (common envelope hides 5xx details).
 func InternalErrorWithDetails(c *gin.Context, message string, err error) {
 	details := ""
 	if err != nil {
@@ -180,20 +172,20 @@ func InternalErrorWithDetails(c *gin.Context, message string, err error) {
 
 // Success sends a success response with a message.
 func Success(c *gin.Context, message string) {
-	c.JSON(http.StatusOK, gin.H{"message": message})
+	commonresponse.SuccessWithMessage(c, http.StatusOK, nil, message)
 }
 
 // Created sends a 201 Created response with data.
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, data)
+	commonresponse.Created(c, data)
 }
 
 // OK sends a 200 OK response with data.
 func OK(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, data)
+	commonresponse.OK(c, data)
 }
 
 // NoContent sends a 204 No Content response.
 func NoContent(c *gin.Context) {
-	c.Status(http.StatusNoContent)
+	commonresponse.NoContent(c)
 }
@@ -35,6 +35,7 @@ func TestError(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
 
+	assert.Equal(t, false, resp["success"])
 	errorData := resp["error"].(map[string]interface{})
 	assert.Equal(t, "NOT_FOUND", errorData["code"])
 	assert.Equal(t, "resource not found", errorData["message"])
@@ -166,7 +167,9 @@ func TestSuccess(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Equal(t, "operation completed", resp["message"])
+	assert.Equal(t, true, resp["success"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "operation completed", data["message"])
 }
 
 func TestCreated(t *testing.T) {
@@ -184,7 +187,8 @@ func TestCreated(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Equal(t, "123", resp["id"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "123", data["id"])
 }
 
 func TestOK(t *testing.T) {
@@ -202,5 +206,6 @@ func TestOK(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Equal(t, "healthy", resp["status"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "healthy", data["status"])
 }
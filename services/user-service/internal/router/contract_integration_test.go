@@ -0,0 +1,98 @@
+//go:build integration
+
+package router
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/contract"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil/integration"
+	"user-service/internal/database"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(integration.Run(m))
+}
+
+// acceptAllValidator accepts any bearer token as the given user
+type acceptAllValidator struct {
+	userID uuid.UUID
+}
+
+func (v acceptAllValidator) ValidateToken(ctx context.Context, token string) (uuid.UUID, error) {
+	return v.userID, nil
+}
+
+// TestProviderContract_BoardService는 board-service가 기대하는 응답을 실제 라우터가 돌려주는지 확인합니다.
+func TestProviderContract_BoardService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := integration.Postgres(t)
+	if err := database.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	r := Setup(Config{
+		DB:             db,
+		Logger:         zap.NewNop(),
+		BasePath:       "/api",
+		TokenValidator: acceptAllValidator{userID: uuid.New()},
+	})
+
+	contract.Verify(t, contract.Load(t, "board-service", "user-service"), r, contract.States{
+		"user is a member of the workspace": func(t *testing.T, params map[string]string) {
+			workspaceID := ensurePrivateWorkspace(t, db, params["workspaceId"])
+			userID := ensureUser(t, db, params["userId"])
+			member := &domain.WorkspaceMember{
+				WorkspaceID: workspaceID,
+				UserID:      userID,
+				RoleName:    domain.RoleMember,
+				JoinedAt:    time.Now(),
+			}
+			if err := repository.NewWorkspaceMemberRepository(db).Create(member); err != nil {
+				t.Fatalf("failed to create member: %v", err)
+			}
+		},
+		"user is not a member of the private workspace": func(t *testing.T, params map[string]string) {
+			ensurePrivateWorkspace(t, db, params["workspaceId"])
+			ensureUser(t, db, params["userId"])
+		},
+	})
+}
+
+func ensureUser(t *testing.T, db *gorm.DB, id string) uuid.UUID {
+	t.Helper()
+	user := domain.User{ID: uuid.MustParse(id)}
+	if err := db.Where(domain.User{ID: user.ID}).
+		Attrs(domain.User{Email: id + "@example.com", Name: "contract", Provider: "google"}).
+		FirstOrCreate(&user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user.ID
+}
+
+// ensurePrivateWorkspace creates the workspace once; later states reuse it
+func ensurePrivateWorkspace(t *testing.T, db *gorm.DB, id string) uuid.UUID {
+	t.Helper()
+	workspace := domain.Workspace{ID: uuid.MustParse(id)}
+	owner := ensureUser(t, db, uuid.NewSHA1(workspace.ID, []byte("owner")).String())
+	if err := db.Where(domain.Workspace{ID: workspace.ID}).
+		Attrs(domain.Workspace{OwnerID: owner, WorkspaceName: "contract"}).
+		FirstOrCreate(&workspace).Error; err != nil {
+		t.Fatalf("failed to create workspace: %v", err)
+	}
+	// is_public은 DB 기본값이 true라서 생성 후에 끕니다
+	if err := db.Model(&workspace).Update("is_public", false).Error; err != nil {
+		t.Fatalf("failed to make workspace private: %v", err)
+	}
+	return workspace.ID
+}
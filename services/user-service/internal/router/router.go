@@ -1,19 +1,35 @@
 package router
 
 import (
+	"errors"
 	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/apiversion"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/cache"
+	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/dbreplica"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/idempotency"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/internalrpc"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	commonmetrics "github.com/OrangesCloud/wealist-advanced-go-pkg/metrics"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	commonotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
 	"user-service/internal/client"
 	"user-service/internal/config"
+	"user-service/internal/domain"
+	"user-service/internal/grpcserver"
 	"user-service/internal/handler"
 	"user-service/internal/metrics"
 	"user-service/internal/middleware"
@@ -24,15 +40,29 @@ import (
 // Config holds router configuration
 type Config struct {
 	DB              *gorm.DB
+	Replicas        *dbreplica.Resolver // read replicas for member listings (optional)
 	Logger          *zap.Logger
 	JWTSecret       string
 	BasePath        string
 	S3Client        *client.S3Client
 	TokenValidator  middleware.TokenValidator // 공통 모듈의 TokenValidator 인터페이스 사용
+	AuthServiceURL  string                    // readiness에서 다운스트림으로 확인 (비어 있으면 생략)
 	Metrics         *metrics.Metrics
 	RedisClient     *redis.Client
 	RateLimitConfig config.RateLimitConfig
 	ServiceName     string // Service name for OTEL tracing
+	// GRPCServer가 있으면 같은 서비스 인스턴스로 내부 gRPC API를 등록합니다.
+	GRPCServer grpc.ServiceRegistrar
+	// Events가 있으면 멤버 변경 도메인 이벤트를 발행합니다.
+	Events *messaging.Emitter
+	// Outbox가 있으면 멤버 변경 이벤트를 같은 트랜잭션에서 아웃박스에 기록합니다 (Events보다 우선).
+	Outbox *outbox.Outbox
+	// Runtime이 있으면 CORS origin과 rate limit 설정을 재시작 없이 다시 적용합니다.
+	Runtime *commonconfig.Watcher
+	// BackupSources가 있으면 워크스페이스 아카이브에 해당 서비스 섹션을 포함합니다 (S3Client 필요).
+	BackupSources []client.BackupSource
+	// Shutdown이 있으면 실행 중인 워크스페이스 백업 작업을 기다리는 종료 단계를 등록합니다.
+	Shutdown *lifecycle.Shutdown
 }
 
 // Setup sets up the router with all routes
@@ -55,8 +85,23 @@ func Setup(cfg Config) *gin.Engine {
 	r.Use(commonmw.Recovery(cfg.Logger))
 	r.Use(commonmw.OTELTracing(serviceName))                   // OpenTelemetry HTTP tracing (otelgin)
 	r.Use(commonmw.LoggerWithTracing(cfg.Logger, serviceName)) // Request logging with trace context
-	r.Use(commonmw.DefaultCORS())
+	r.Use(commonmw.ReloadableCORS(commonmw.DefaultCORSConfig(), cfg.Runtime))
 	r.Use(metrics.HTTPMiddleware(m))
+	r.Use(commonmw.BodyLimit(commonmw.DefaultMaxBodyBytes)) // Request body size limit (1MB)
+	// Fault injection for resilience testing (runtime settings "chaos", disabled in production)
+	r.Use(commonmw.Chaos(commonmw.DefaultChaosConfig(), cfg.Runtime, cfg.Logger))
+
+	// DB/Redis 메트릭 (요청 trace를 exemplar로 연결)
+	if err := commonotel.EnableGORMMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable GORM metrics", zap.Error(err))
+	}
+	if err := commonotel.EnableGORMPoolMetrics(cfg.DB, m.Metrics); err != nil {
+		cfg.Logger.Warn("Failed to enable DB pool metrics", zap.Error(err))
+	}
+	commonotel.EnableRedisMetrics(cfg.RedisClient, m.Metrics)
+	if cfg.Replicas != nil {
+		cfg.Replicas.SetMetrics(m.Metrics)
+	}
 
 	// Rate limiting middleware
 	if cfg.RateLimitConfig.Enabled && cfg.RedisClient != nil {
@@ -65,7 +110,8 @@ func Setup(cfg Config) *gin.Engine {
 			WithBurstSize(cfg.RateLimitConfig.BurstSize).
 			WithKeyPrefix("rl:user:")
 		limiter := ratelimit.NewRedisRateLimiter(cfg.RedisClient, rlConfig, cfg.Logger)
-		r.Use(ratelimit.MiddlewareWithLogger(limiter, ratelimit.UserKey, rlConfig, cfg.Logger))
+		ratelimit.WatchSettings(limiter, cfg.Runtime)
+		r.Use(ratelimit.PolicyMiddleware(limiter, ratelimit.NewPolicies(), cfg.Logger))
 		cfg.Logger.Info("Rate limiting middleware enabled",
 			zap.Int("requests_per_minute", cfg.RateLimitConfig.RequestsPerMinute),
 			zap.Int("burst_size", cfg.RateLimitConfig.BurstSize))
@@ -74,10 +120,14 @@ func Setup(cfg Config) *gin.Engine {
 	}
 
 	// Prometheus metrics endpoint
-	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/metrics", gin.WrapH(commonmetrics.Handler()))
 
 	// Health check routes (using common package)
-	healthChecker := commonhealth.NewHealthChecker(cfg.DB, nil)
+	healthChecker := commonhealth.NewHealthChecker(cfg.DB, nil).
+		AddDownstream("auth-service", cfg.AuthServiceURL, commonhealth.AuthServiceLivenessPath)
+	if cfg.Replicas != nil {
+		healthChecker.AddCheck("db-replicas", cfg.Replicas.Check, commonhealth.NonCritical())
+	}
 	healthChecker.RegisterRoutes(r, cfg.BasePath)
 
 	// Swagger documentation (disabled for faster builds)
@@ -85,15 +135,23 @@ func Setup(cfg Config) *gin.Engine {
 
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(cfg.DB)
+	identityRepo := repository.NewUserIdentityRepository(cfg.DB)
+	ssoRepo := repository.NewWorkspaceSSORepository(cfg.DB)
+	passkeyRepo := repository.NewUserPasskeyRepository(cfg.DB)
+	mfaRepo := repository.NewUserMFARepository(cfg.DB)
 	workspaceRepo := repository.NewWorkspaceRepository(cfg.DB)
 	memberRepo := repository.NewWorkspaceMemberRepository(cfg.DB)
 	profileRepo := repository.NewUserProfileRepository(cfg.DB)
+	if cfg.RedisClient != nil {
+		// 프로필 조회는 Redis에 캐시하고 수정/삭제 시 무효화 (Redis 없으면 DB 직접 조회)
+		profileRepo.WithCache(cache.New[*domain.UserProfile](cfg.RedisClient, "user:profile", cache.Options{TTL: 10 * time.Minute, Logger: cfg.Logger}))
+	}
 	joinReqRepo := repository.NewJoinRequestRepository(cfg.DB)
 	attachmentRepo := repository.NewAttachmentRepository(cfg.DB)
 
 	// Initialize services
 	// 사용자 서비스 초기화 (메트릭 포함)
-	userService := service.NewUserService(userRepo, cfg.Logger, m)
+	userService := service.NewUserService(userRepo, identityRepo, ssoRepo, cfg.Logger, m)
 	// 워크스페이스 서비스 초기화 (메트릭 포함)
 	workspaceService := service.NewWorkspaceService(
 		workspaceRepo,
@@ -103,31 +161,48 @@ func Setup(cfg Config) *gin.Engine {
 		userRepo,
 		cfg.Logger,
 		m,
-	)
+	).WithEvents(cfg.Events).WithOutbox(cfg.DB, cfg.Outbox)
 	// 프로필 서비스 초기화 (메트릭 포함)
 	profileService := service.NewProfileService(profileRepo, memberRepo, userRepo, cfg.Logger, m)
 	attachmentService := service.NewAttachmentService(attachmentRepo, cfg.S3Client, cfg.Logger)
+	// SSO 서비스 초기화 (워크스페이스 SAML 설정, JIT 사용자 생성)
+	ssoService := service.NewSSOService(ssoRepo, workspaceRepo, memberRepo, profileRepo, userRepo, cfg.Logger).WithEvents(cfg.Events).WithOutbox(cfg.DB, cfg.Outbox)
+	// 패스키 서비스 초기화 (WebAuthn 자격 증명 저장, 패스키 MFA)
+	passkeyService := service.NewPasskeyService(passkeyRepo, userRepo, cfg.Logger)
+	// MFA 서비스 초기화 (TOTP secret/복구 코드 저장, 워크스페이스 MFA 정책)
+	mfaService := service.NewMFAService(mfaRepo, passkeyRepo, userRepo, cfg.Logger)
+
+	// Internal gRPC API (membership validation, profile lookup)
+	if cfg.GRPCServer != nil {
+		internalrpc.RegisterUserServiceServer(cfg.GRPCServer, grpcserver.NewUserServer(workspaceService, profileService))
+	}
 
 	// Initialize handlers
 	userHandler := handler.NewUserHandler(userService)
 	workspaceHandler := handler.NewWorkspaceHandler(workspaceService)
 	profileHandler := handler.NewProfileHandler(profileService, attachmentService)
+	ssoHandler := handler.NewSSOHandler(ssoService)
+	passkeyHandler := handler.NewPasskeyHandler(passkeyService)
+	mfaHandler := handler.NewMFAHandler(mfaService)
 
-	// API routes group
-	api := r.Group(cfg.BasePath)
+	// API routes group (/api와 /api/v1 모두 현재 v1 라우트로 처리)
+	api := apiversion.WithLegacy(r, cfg.BasePath, apiversion.V1)
 
 	// Auth middleware - check ISTIO_JWT_MODE first
 	var authMiddleware gin.HandlerFunc
+	var serviceValidator middleware.ServiceTokenValidator // 서비스 토큰 검증 (로컬 JWT 모드에서는 nil)
 	istioJWTMode := os.Getenv("ISTIO_JWT_MODE") == "true"
 
 	if istioJWTMode {
 		// K8s + Istio 환경: Istio가 JWT 검증, Go 서비스는 파싱만
 		parser := middleware.NewJWTParser(cfg.Logger)
 		authMiddleware = middleware.IstioAuthMiddleware(parser)
+		serviceValidator = parser
 		cfg.Logger.Info("Using Istio JWT mode (parse only)")
 	} else if cfg.TokenValidator != nil {
 		// Docker Compose / K8s without Istio: SmartValidator로 전체 검증
 		authMiddleware = middleware.AuthWithValidator(cfg.TokenValidator)
+		serviceValidator, _ = cfg.TokenValidator.(middleware.ServiceTokenValidator)
 		cfg.Logger.Info("Using SmartValidator mode (full validation)")
 	} else {
 		// Fallback: 로컬 JWT 검증
@@ -135,13 +210,47 @@ func Setup(cfg Config) *gin.Engine {
 		cfg.Logger.Info("Using local JWT validation")
 	}
 
+	// 사용자 조회는 users:read scope를 가진 서비스 토큰도 허용 (board-service 등)
+	userReadAuth := authMiddleware
+	if serviceValidator != nil {
+		userReadAuth = middleware.UserOrServiceAuth(authMiddleware, serviceValidator, cfg.Logger, "users:read")
+	}
+
 	// ============================================================
-	// Internal routes (no auth required for service-to-service)
+	// Internal routes (service-to-service)
+	// 항상 users:internal scope의 서비스 토큰 필요 (로그인, 패스키, MFA 등 계정 변경 포함)
+	// 서비스 토큰 검증기가 없는 로컬 JWT 모드에서는 모든 내부 호출을 거부
 	// ============================================================
-	internal := api.Group("/internal")
+	internal := r.Group(cfg.BasePath + "/internal") // 서비스 간 호출은 버전 없이 유지
+	internal.Use(middleware.ServiceAuth(serviceValidator, cfg.Logger, "users:internal"))
+	if serviceValidator == nil {
+		cfg.Logger.Warn("Internal routes disabled: no service token validator (local JWT mode)")
+	}
 	{
+		internal.GET("/users/:userId", userHandler.GetUser) // auth-service OIDC userinfo
 		internal.GET("/users/:userId/exists", userHandler.UserExists)
 		internal.POST("/oauth/login", userHandler.OAuthLogin)
+		internal.POST("/magic-link/login", userHandler.MagicLinkLogin)
+
+		// Workspace SAML SSO (auth-service)
+		internal.GET("/sso/discover", ssoHandler.DiscoverSSO)
+		internal.GET("/sso/workspaces/:workspaceId", ssoHandler.GetSSOConnection)
+		internal.POST("/sso/login", ssoHandler.SSOLogin)
+
+		// Passkeys (auth-service WebAuthn ceremonies)
+		internal.GET("/passkeys/users/:userId", passkeyHandler.GetUserPasskeys)
+		internal.POST("/passkeys", passkeyHandler.CreatePasskey)
+		internal.GET("/passkeys/credentials/:credentialId", passkeyHandler.GetPasskeyCredential)
+		internal.POST("/passkeys/credentials/:credentialId/use", passkeyHandler.RecordPasskeyUse)
+
+		// TOTP MFA (auth-service enrollment/verification, token issuance policy)
+		internal.GET("/mfa/users/:userId", mfaHandler.GetUserMFA)
+		internal.GET("/mfa/users/:userId/token-policy", mfaHandler.GetTokenPolicy)
+		internal.PUT("/mfa/users/:userId/totp", mfaHandler.EnableTOTP)
+		internal.DELETE("/mfa/users/:userId/totp", mfaHandler.DisableTOTP)
+		internal.POST("/mfa/users/:userId/totp/use", mfaHandler.RecordTOTPUse)
+		internal.PUT("/mfa/users/:userId/recovery-codes", mfaHandler.ReplaceRecoveryCodes)
+		internal.POST("/mfa/users/:userId/recovery-codes/consume", mfaHandler.ConsumeRecoveryCode)
 	}
 
 	// ============================================================
@@ -152,7 +261,12 @@ func Setup(cfg Config) *gin.Engine {
 		users.POST("", userHandler.CreateUser) // Public for OAuth callback
 		users.GET("/me", authMiddleware, userHandler.GetMe)
 		users.DELETE("/me", authMiddleware, userHandler.DeleteMe)
-		users.GET("/:userId", authMiddleware, userHandler.GetUser)
+		users.GET("/me/mfa", authMiddleware, mfaHandler.GetMyMFAStatus)
+		users.GET("/me/passkeys", authMiddleware, passkeyHandler.ListPasskeys)
+		users.PUT("/me/passkeys/mfa", authMiddleware, passkeyHandler.UpdatePasskeyMFA)
+		users.PATCH("/me/passkeys/:passkeyId", authMiddleware, passkeyHandler.RenamePasskey)
+		users.DELETE("/me/passkeys/:passkeyId", authMiddleware, passkeyHandler.DeletePasskey)
+		users.GET("/:userId", userReadAuth, userHandler.GetUser)
 		users.PUT("/:userId", authMiddleware, userHandler.UpdateUser)
 		users.PUT("/:userId/restore", authMiddleware, userHandler.RestoreUser)
 	}
@@ -160,26 +274,43 @@ func Setup(cfg Config) *gin.Engine {
 	// ============================================================
 	// Workspace routes
 	// ============================================================
+	// 민감한 워크스페이스 작업은 워크스페이스 설정(stepUpMaxAgeMinutes)에 따라 최근 로그인 요구
+	stepUp := middleware.StepUp(workspaceStepUpPolicy(workspaceService), cfg.Logger)
+
+	// 재시도로 인한 워크스페이스/프로필 중복 생성 방지 (Idempotency-Key 헤더, Redis 없으면 생략)
+	var idempotent []gin.HandlerFunc
+	if cfg.RedisClient != nil {
+		idempotent = append(idempotent, idempotency.Middleware(idempotency.NewRedisStore(cfg.RedisClient), idempotency.DefaultConfig(), cfg.Logger))
+	}
+
 	workspaces := api.Group("/workspaces")
 	workspaces.Use(authMiddleware)
+	workspaces.Use(idempotent...)
 	{
 		workspaces.POST("/create", workspaceHandler.CreateWorkspace)
 		workspaces.GET("/all", workspaceHandler.GetAllWorkspaces)
 		workspaces.GET("/public/:workspaceName", workspaceHandler.SearchPublicWorkspaces)
 		workspaces.GET("/:workspaceId", workspaceHandler.GetWorkspace)
 		workspaces.PUT("/ids/:workspaceId", workspaceHandler.UpdateWorkspace)
-		workspaces.DELETE("/:workspaceId", workspaceHandler.DeleteWorkspace)
+		workspaces.DELETE("/:workspaceId", stepUp, workspaceHandler.DeleteWorkspace)
 		workspaces.POST("/default", workspaceHandler.SetDefaultWorkspace)
 
 		// Workspace settings
 		workspaces.GET("/:workspaceId/settings", workspaceHandler.GetWorkspaceSettings)
-		workspaces.PUT("/:workspaceId/settings", workspaceHandler.UpdateWorkspaceSettings)
+		workspaces.PUT("/:workspaceId/settings", stepUp, workspaceHandler.UpdateWorkspaceSettings)
+
+		// Workspace SAML SSO
+		workspaces.GET("/:workspaceId/sso", ssoHandler.GetSSOConfig)
+		workspaces.PUT("/:workspaceId/sso", stepUp, ssoHandler.UpdateSSOConfig)
+		workspaces.PUT("/:workspaceId/sso/metadata", stepUp, ssoHandler.UploadSSOMetadata)
+		workspaces.POST("/:workspaceId/sso/domains/:domain/verify", stepUp, ssoHandler.VerifySSODomain)
+		workspaces.DELETE("/:workspaceId/sso", stepUp, ssoHandler.DeleteSSOConfig)
 
 		// Workspace members
-		workspaces.GET("/:workspaceId/members", workspaceHandler.GetMembers)
+		workspaces.GET("/:workspaceId/members", dbreplica.ReadFromReplica(), workspaceHandler.GetMembers)
 		workspaces.POST("/:workspaceId/members/invite", workspaceHandler.InviteMember)
-		workspaces.PUT("/:workspaceId/members/:memberId/role", workspaceHandler.UpdateMemberRole)
-		workspaces.DELETE("/:workspaceId/members/:memberId", workspaceHandler.RemoveMember)
+		workspaces.PUT("/:workspaceId/members/:memberId/role", stepUp, workspaceHandler.UpdateMemberRole)
+		workspaces.DELETE("/:workspaceId/members/:memberId", stepUp, workspaceHandler.RemoveMember)
 		workspaces.GET("/:workspaceId/validate-member/:userId", workspaceHandler.ValidateMember)
 
 		// Join requests
@@ -189,11 +320,31 @@ func Setup(cfg Config) *gin.Engine {
 		workspaces.PUT("/:workspaceId/joinRequests/:requestId", workspaceHandler.ProcessJoinRequest)
 	}
 
+	// Workspace export/import (archives in S3)
+	if cfg.S3Client != nil {
+		backupService := service.NewWorkspaceBackupService(
+			repository.NewWorkspaceBackupRepository(cfg.DB),
+			workspaceRepo,
+			memberRepo,
+			cfg.S3Client,
+			cfg.BackupSources,
+			cfg.Logger,
+		)
+		cfg.Shutdown.Add("workspace-backups", backupService.Wait)
+		backupHandler := handler.NewWorkspaceBackupHandler(backupService)
+
+		workspaces.GET("/:workspaceId/backups", backupHandler.ListJobs)
+		workspaces.GET("/:workspaceId/backups/:jobId", backupHandler.GetJob)
+		workspaces.POST("/:workspaceId/backups/exports", stepUp, backupHandler.StartExport)
+		workspaces.POST("/:workspaceId/backups/imports", stepUp, backupHandler.StartImport)
+	}
+
 	// ============================================================
 	// Profile routes
 	// ============================================================
 	profiles := api.Group("/profiles")
 	profiles.Use(authMiddleware)
+	profiles.Use(idempotent...)
 	{
 		profiles.POST("", profileHandler.CreateProfile)
 		profiles.GET("/me", profileHandler.GetMyProfile)
@@ -210,3 +361,24 @@ func Setup(cfg Config) *gin.Engine {
 
 	return r
 }
+
+// workspaceStepUpPolicy는 경로의 workspaceId로 워크스페이스의 재인증 조건을 조회합니다.
+// 워크스페이스가 없거나 ID가 잘못되면 조건 없이 통과시키고 핸들러가 오류를 반환합니다.
+func workspaceStepUpPolicy(workspaceService *service.WorkspaceService) func(c *gin.Context) (middleware.StepUpRequirement, error) {
+	return func(c *gin.Context) (middleware.StepUpRequirement, error) {
+		workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+		if err != nil {
+			return middleware.StepUpRequirement{}, nil
+		}
+		workspace, err := workspaceService.GetWorkspace(workspaceID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return middleware.StepUpRequirement{}, nil
+			}
+			return middleware.StepUpRequirement{}, err
+		}
+		return middleware.StepUpRequirement{
+			MaxAge: time.Duration(workspace.StepUpMaxAgeMinutes) * time.Minute,
+		}, nil
+	}
+}
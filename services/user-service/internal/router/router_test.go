@@ -0,0 +1,83 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/metrics"
+	"user-service/internal/middleware"
+)
+
+// userOnlyValidator는 사용자 토큰만 검증합니다 (서비스 토큰 검증기가 없는 경우).
+type userOnlyValidator struct{}
+
+func (userOnlyValidator) ValidateToken(ctx context.Context, token string) (uuid.UUID, error) {
+	return uuid.New(), nil
+}
+
+// scopedServiceValidator는 "read-only" 토큰을 users:read scope의 서비스 토큰으로 인정합니다.
+type scopedServiceValidator struct {
+	userOnlyValidator
+}
+
+func (scopedServiceValidator) ValidateServiceToken(ctx context.Context, token string) (*middleware.ServiceClaims, error) {
+	if token != "read-only" {
+		return nil, errors.New("not a service token")
+	}
+	return &middleware.ServiceClaims{ClientID: "board-service", Scopes: []string{"users:read"}}, nil
+}
+
+// 계정을 만들거나 인증 수단을 바꾸는 내부 API
+var accountInternalRoutes = []struct{ method, path string }{
+	{http.MethodPost, "/api/internal/oauth/login"},
+	{http.MethodPost, "/api/internal/magic-link/login"},
+	{http.MethodPost, "/api/internal/sso/login"},
+	{http.MethodPost, "/api/internal/passkeys"},
+	{http.MethodPost, "/api/internal/passkeys/credentials/cred-1/use"},
+	{http.MethodPut, "/api/internal/mfa/users/" + mfaUserID + "/totp"},
+	{http.MethodDelete, "/api/internal/mfa/users/" + mfaUserID + "/totp"},
+	{http.MethodPut, "/api/internal/mfa/users/" + mfaUserID + "/recovery-codes"},
+	{http.MethodPost, "/api/internal/mfa/users/" + mfaUserID + "/recovery-codes/consume"},
+}
+
+var mfaUserID = uuid.NewString()
+
+func TestSetup_InternalRoutesRequireServiceToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		validator middleware.TokenValidator
+		token     string
+		want      int
+	}{
+		{"no token", scopedServiceValidator{}, "", http.StatusUnauthorized},
+		{"user token", scopedServiceValidator{}, "user-token", http.StatusUnauthorized},
+		{"missing users:internal scope", scopedServiceValidator{}, "read-only", http.StatusForbidden},
+		{"no service token validator", userOnlyValidator{}, "read-only", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Setup(Config{Logger: zap.NewNop(), BasePath: "/api", TokenValidator: tt.validator, Metrics: metrics.NewForTest()})
+			for _, route := range accountInternalRoutes {
+				req := httptest.NewRequest(route.method, route.path, nil)
+				if tt.token != "" {
+					req.Header.Set("Authorization", "Bearer "+tt.token)
+				}
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				if w.Code != tt.want {
+					t.Errorf("%s %s = %d, want %d", route.method, route.path, w.Code, tt.want)
+				}
+			}
+		})
+	}
+}
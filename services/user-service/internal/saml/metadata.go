@@ -0,0 +1,151 @@
+// Package saml parses SAML 2.0 identity provider metadata for workspace SSO.
+//
+// SAML 프로토콜 처리(AuthnRequest, Assertion 검증)는 auth-service가 담당하고,
+// user-service는 워크스페이스별 IdP 설정을 저장하기 위해 메타데이터에서 필요한 값만 추출합니다.
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// BindingHTTPRedirect is the SAML HTTP-Redirect binding URI.
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	// BindingHTTPPost is the SAML HTTP-POST binding URI.
+	BindingHTTPPost = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	// MaxMetadataSize limits the size of uploaded metadata documents.
+	MaxMetadataSize = 1 << 20
+)
+
+// ErrInvalidMetadata is returned when a metadata document has no usable IdP descriptor.
+var ErrInvalidMetadata = errors.New("invalid SAML IdP metadata")
+
+// IdPMetadata holds the values of an identity provider needed to accept its assertions.
+type IdPMetadata struct {
+	EntityID    string
+	SSOURL      string
+	Binding     string
+	Certificate string // PEM
+	NotAfter    time.Time
+}
+
+type entitiesDescriptor struct {
+	XMLName           xml.Name           `xml:"EntitiesDescriptor"`
+	EntityDescriptors []entityDescriptor `xml:"EntityDescriptor"`
+}
+
+type entityDescriptor struct {
+	EntityID         string            `xml:"entityID,attr"`
+	IDPSSODescriptor *idpSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+type idpSSODescriptor struct {
+	KeyDescriptors       []keyDescriptor `xml:"KeyDescriptor"`
+	SingleSignOnServices []endpoint      `xml:"SingleSignOnService"`
+}
+
+type keyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseMetadata extracts the entity ID, single sign-on URL and signing certificate of an IdP.
+// EntitiesDescriptor로 여러 엔티티가 묶인 경우 첫 번째 IdP 엔티티를 사용합니다.
+// HTTP-Redirect 바인딩을 우선하고, 없으면 HTTP-POST 바인딩을 사용합니다.
+func ParseMetadata(data []byte) (*IdPMetadata, error) {
+	if len(data) == 0 || len(data) > MaxMetadataSize {
+		return nil, fmt.Errorf("%w: document size must be between 1 byte and 1MB", ErrInvalidMetadata)
+	}
+
+	var candidates []entityDescriptor
+	var single entityDescriptor
+	if err := xml.Unmarshal(data, &single); err == nil && single.IDPSSODescriptor != nil {
+		candidates = append(candidates, single)
+	} else {
+		var group entitiesDescriptor
+		if err := xml.Unmarshal(data, &group); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+		}
+		candidates = group.EntityDescriptors
+	}
+
+	for _, entity := range candidates {
+		if entity.IDPSSODescriptor == nil {
+			continue
+		}
+		return fromDescriptor(entity)
+	}
+	return nil, fmt.Errorf("%w: no IDPSSODescriptor found", ErrInvalidMetadata)
+}
+
+func fromDescriptor(entity entityDescriptor) (*IdPMetadata, error) {
+	if strings.TrimSpace(entity.EntityID) == "" {
+		return nil, fmt.Errorf("%w: entityID is missing", ErrInvalidMetadata)
+	}
+
+	meta := &IdPMetadata{EntityID: strings.TrimSpace(entity.EntityID)}
+	for _, binding := range []string{BindingHTTPRedirect, BindingHTTPPost} {
+		for _, sso := range entity.IDPSSODescriptor.SingleSignOnServices {
+			if sso.Binding == binding && sso.Location != "" {
+				meta.SSOURL = strings.TrimSpace(sso.Location)
+				meta.Binding = binding
+				break
+			}
+		}
+		if meta.SSOURL != "" {
+			break
+		}
+	}
+	if meta.SSOURL == "" {
+		return nil, fmt.Errorf("%w: no HTTP-Redirect or HTTP-POST SingleSignOnService", ErrInvalidMetadata)
+	}
+
+	// use 속성이 없는 키는 서명/암호화 겸용
+	for _, key := range entity.IDPSSODescriptor.KeyDescriptors {
+		if key.Use != "" && key.Use != "signing" {
+			continue
+		}
+		for _, raw := range key.Certificates {
+			cert, err := ParseCertificate(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+			}
+			meta.Certificate = EncodeCertificate(cert)
+			meta.NotAfter = cert.NotAfter
+			return meta, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no signing certificate", ErrInvalidMetadata)
+}
+
+// ParseCertificate parses a PEM encoded certificate or the base64 body of one (as found in metadata).
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	value = strings.TrimSpace(value)
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+	if err != nil {
+		return nil, fmt.Errorf("certificate is neither PEM nor base64: %w", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+// EncodeCertificate returns the PEM encoding of a certificate.
+func EncodeCertificate(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
@@ -0,0 +1,104 @@
+package saml
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func metadataXML(cert *x509.Certificate, keyUse string) string {
+	body := base64.StdEncoding.EncodeToString(cert.Raw)
+	return `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com/saml">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="` + keyUse + `">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+        ` + body + `
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+}
+
+func TestParseMetadata(t *testing.T) {
+	cert := testCertificate(t)
+
+	meta, err := ParseMetadata([]byte(metadataXML(cert, "signing")))
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/saml", meta.EntityID)
+	assert.Equal(t, "https://idp.example.com/sso/redirect", meta.SSOURL)
+	assert.Equal(t, BindingHTTPRedirect, meta.Binding)
+	assert.True(t, strings.HasPrefix(meta.Certificate, "-----BEGIN CERTIFICATE-----"))
+	assert.Equal(t, cert.NotAfter, meta.NotAfter)
+
+	// EntitiesDescriptor로 감싼 메타데이터도 허용
+	wrapped := `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+		strings.TrimPrefix(metadataXML(cert, ""), `<?xml version="1.0"?>`) + `</md:EntitiesDescriptor>`
+	meta, err = ParseMetadata([]byte(wrapped))
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/saml", meta.EntityID)
+}
+
+func TestParseMetadata_Invalid(t *testing.T) {
+	cert := testCertificate(t)
+
+	cases := map[string]string{
+		"empty":           "",
+		"not xml":         "not xml",
+		"no idp":          `<EntityDescriptor entityID="x"><SPSSODescriptor/></EntityDescriptor>`,
+		"encryption only": metadataXML(cert, "encryption"),
+		"no entity id":    strings.Replace(metadataXML(cert, "signing"), `entityID="https://idp.example.com/saml"`, "", 1),
+		"bad certificate": strings.Replace(metadataXML(cert, "signing"), base64.StdEncoding.EncodeToString(cert.Raw), "bm90IGEgY2VydA==", 1),
+	}
+	for name, doc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseMetadata([]byte(doc))
+			assert.ErrorIs(t, err, ErrInvalidMetadata)
+		})
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	cert := testCertificate(t)
+
+	fromPEM, err := ParseCertificate(EncodeCertificate(cert))
+	require.NoError(t, err)
+	assert.Equal(t, cert.Raw, fromPEM.Raw)
+
+	fromBase64, err := ParseCertificate(base64.StdEncoding.EncodeToString(cert.Raw))
+	require.NoError(t, err)
+	assert.Equal(t, cert.Raw, fromBase64.Raw)
+
+	_, err = ParseCertificate("garbage!")
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+// Package seed writes the user-service part of the development dataset.
+package seed
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	commonseed "github.com/OrangesCloud/wealist-advanced-go-pkg/seed"
+	"user-service/internal/domain"
+)
+
+// Run inserts users, workspaces, members and profiles from data. Existing rows are left untouched.
+func Run(ctx context.Context, db *gorm.DB, data *commonseed.Dataset) error {
+	now := time.Now().UTC()
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, u := range data.Users {
+			if err := commonseed.Insert(tx, &domain.User{
+				ID:        u.ID,
+				Email:     u.Email,
+				Name:      u.Name,
+				IsActive:  true,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}); err != nil {
+				return err
+			}
+		}
+
+		defaultSet := map[string]bool{}
+		for _, ws := range data.Workspaces {
+			description := ws.Description
+			if err := commonseed.Insert(tx, &domain.Workspace{
+				ID:                   ws.ID,
+				OwnerID:              ws.OwnerID,
+				WorkspaceName:        ws.Name,
+				WorkspaceDescription: &description,
+				IsPublic:             true,
+				NeedApproved:         true,
+				IsActive:             true,
+				CreatedAt:            now,
+			}); err != nil {
+				return err
+			}
+
+			for _, m := range ws.Members {
+				user, _ := data.User(m.UserID)
+				// The first seeded workspace of each user is their default
+				isDefault := !defaultSet[user.Email]
+				defaultSet[user.Email] = true
+
+				if err := commonseed.Insert(tx, &domain.WorkspaceMember{
+					ID:          commonseed.ID("workspace-member", ws.ID.String()+"/"+m.UserID.String()),
+					WorkspaceID: ws.ID,
+					UserID:      m.UserID,
+					RoleName:    domain.RoleName(m.Role),
+					IsDefault:   isDefault,
+					IsActive:    true,
+					JoinedAt:    now,
+					UpdatedAt:   now,
+				}); err != nil {
+					return err
+				}
+				if err := commonseed.Insert(tx, &domain.UserProfile{
+					ID:          commonseed.ID("profile", ws.ID.String()+"/"+m.UserID.String()),
+					UserID:      m.UserID,
+					WorkspaceID: ws.ID,
+					NickName:    user.Name,
+					Email:       user.Email,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
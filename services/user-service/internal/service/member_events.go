@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/uow"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+)
+
+// memberEvents는 워크스페이스 멤버 변경 도메인 이벤트를 기록합니다.
+// 아웃박스가 설정되면 멤버 변경과 같은 트랜잭션에 기록하고(커밋된 변경의 이벤트는 유실 없음),
+// 아니면 커밋 후 Emitter로 바로 발행합니다. 둘 다 없으면 이벤트를 남기지 않습니다.
+// db가 있으면 멤버 변경과 함께하는 다른 저장소 변경도 transaction으로 묶습니다.
+type memberEvents struct {
+	emitter *messaging.Emitter
+	outbox  *outbox.Outbox
+	db      *gorm.DB
+}
+
+// transaction은 fn을 하나의 트랜잭션(uow.Transaction)에서 실행합니다.
+// db가 없으면(테스트 등) 트랜잭션 없이 실행합니다.
+func (e *memberEvents) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if e.db == nil {
+		return fn(ctx)
+	}
+	return uow.Transaction(ctx, e.db, fn)
+}
+
+// bind는 repo가 ctx의 트랜잭션에서 쿼리하도록 withTx로 묶습니다. db가 없으면 repo를 그대로 반환합니다.
+func bind[R any](ctx context.Context, e *memberEvents, repo R, withTx func(R, *gorm.DB) R) R {
+	if e.db == nil || !uow.InTransaction(ctx) {
+		return repo
+	}
+	return withTx(repo, uow.DB(ctx, e.db))
+}
+
+// save는 write로 멤버를 저장하고 eventType 이벤트를 기록합니다.
+// ctx가 트랜잭션 안이면 그 트랜잭션에 참여하며, 아웃박스 기록이 실패하면 멤버 변경도 롤백됩니다.
+func (e *memberEvents) save(ctx context.Context, memberRepo *repository.WorkspaceMemberRepository, eventType string, actorID uuid.UUID, member *domain.WorkspaceMember, write func(repo *repository.WorkspaceMemberRepository) error) error {
+	return e.transaction(ctx, func(ctx context.Context) error {
+		if err := write(bind(ctx, e, memberRepo, (*repository.WorkspaceMemberRepository).WithTx)); err != nil {
+			return err
+		}
+		if e.outbox != nil {
+			return e.outbox.Emit(ctx, eventType, member.WorkspaceID.String(), actorID.String(), memberEventData(member))
+		}
+		// 롤백된 변경은 알리지 않도록 커밋 후 발행합니다.
+		// 발행 실패는 Emitter가 로그로 남기며 요청 결과에는 영향을 주지 않습니다.
+		uow.AfterCommit(ctx, func() {
+			_ = e.emitter.Emit(context.Background(), eventType, member.WorkspaceID.String(), actorID.String(), memberEventData(member))
+		})
+		return nil
+	})
+}
+
+// addMember는 멤버 추가와 워크스페이스 프로필 생성을 한 트랜잭션으로 처리합니다.
+// 프로필 생성이 실패하면 멤버 추가도 롤백되어, 프로필 없는 멤버가 남지 않습니다.
+// user가 nil이면 프로필은 만들지 않습니다.
+func (e *memberEvents) addMember(ctx context.Context, memberRepo *repository.WorkspaceMemberRepository, profileRepo *repository.UserProfileRepository, actorID uuid.UUID, member *domain.WorkspaceMember, user *domain.User) error {
+	return e.transaction(ctx, func(ctx context.Context) error {
+		if err := e.save(ctx, memberRepo, messaging.EventMemberAdded, actorID, member, func(repo *repository.WorkspaceMemberRepository) error {
+			return repo.Create(member)
+		}); err != nil {
+			return err
+		}
+		if user == nil {
+			return nil
+		}
+		return bind(ctx, e, profileRepo, (*repository.UserProfileRepository).WithTx).Create(newWorkspaceProfile(member.WorkspaceID, user))
+	})
+}
+
+// newWorkspaceProfile은 워크스페이스에 처음 참여한 사용자의 기본 프로필을 만듭니다.
+// 닉네임은 이름, 없으면 이메일입니다.
+func newWorkspaceProfile(workspaceID uuid.UUID, user *domain.User) *domain.UserProfile {
+	profile := &domain.UserProfile{
+		ID:          uuid.New(),
+		UserID:      user.ID,
+		WorkspaceID: workspaceID,
+		NickName:    user.Name,
+		Email:       user.Email,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if profile.NickName == "" {
+		profile.NickName = user.Email
+	}
+	return profile
+}
+
+func memberEventData(member *domain.WorkspaceMember) messaging.MemberEventData {
+	return messaging.MemberEventData{
+		MemberID: member.ID.String(),
+		UserID:   member.UserID.String(),
+		Role:     string(member.RoleName),
+	}
+}
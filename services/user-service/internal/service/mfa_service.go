@@ -0,0 +1,196 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 TOTP(인증 앱) secret과 복구 코드 저장, 워크스페이스 MFA 정책 조회를 처리합니다.
+// 코드 생성/검증과 secret 암호화는 auth-service가 수행하고, 여기서는 재사용 방지만 보장합니다.
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// MFAService는 TOTP/복구 코드와 MFA 정책 비즈니스 로직을 처리합니다.
+type MFAService struct {
+	mfaRepo     *repository.UserMFARepository
+	passkeyRepo *repository.UserPasskeyRepository
+	userRepo    *repository.UserRepository
+	logger      *zap.Logger
+}
+
+// NewMFAService는 새 MFAService를 생성합니다.
+func NewMFAService(
+	mfaRepo *repository.UserMFARepository,
+	passkeyRepo *repository.UserPasskeyRepository,
+	userRepo *repository.UserRepository,
+	logger *zap.Logger,
+) *MFAService {
+	return &MFAService{
+		mfaRepo:     mfaRepo,
+		passkeyRepo: passkeyRepo,
+		userRepo:    userRepo,
+		logger:      logger,
+	}
+}
+
+// GetStatus는 토큰 발급 시 auth-service가 확인할 MFA 상태를 반환합니다 (내부 API).
+// 암호화된 TOTP secret과 MFA를 요구하는 워크스페이스 목록을 포함합니다.
+func (s *MFAService) GetStatus(userID uuid.UUID) (*domain.UserMFAResponse, error) {
+	totp, err := s.mfaRepo.FindTOTP(userID)
+	if err != nil {
+		return nil, err
+	}
+	passkeyCount, err := s.passkeyRepo.CountByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	passkeyRequired, err := s.passkeyRepo.IsMFARequired(userID)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := s.mfaRepo.CountRemainingRecoveryCodes(userID)
+	if err != nil {
+		return nil, err
+	}
+	workspaceIDs, err := s.mfaRepo.FindWorkspacesRequiringMFA(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.UserMFAResponse{
+		MFAStatusResponse: domain.MFAStatusResponse{
+			TOTPEnabled:            totp != nil,
+			PasskeyRequired:        passkeyRequired && passkeyCount > 0,
+			PasskeyCount:           int(passkeyCount),
+			RecoveryCodesRemaining: int(remaining),
+			PolicyRequired:         len(workspaceIDs) > 0,
+		},
+		PolicyWorkspaces: workspaceIDs,
+	}
+	if totp != nil {
+		result.TOTPSecret = totp.Secret
+		result.TOTPLastUsedStep = totp.LastUsedStep
+	}
+	return result, nil
+}
+
+// GetTokenPolicy는 토큰 발급 시 auth-service가 적용할 워크스페이스 토큰 수명을 반환합니다 (내부 API).
+// 여러 워크스페이스에 속하면 가장 짧은 값을 사용합니다.
+func (s *MFAService) GetTokenPolicy(userID uuid.UUID) (*domain.TokenPolicyResponse, error) {
+	return s.mfaRepo.FindTokenPolicy(userID)
+}
+
+// GetMyStatus는 현재 사용자의 MFA 상태를 반환합니다 (secret 제외).
+func (s *MFAService) GetMyStatus(userID uuid.UUID) (*domain.MFAStatusResponse, error) {
+	status, err := s.GetStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &status.MFAStatusResponse, nil
+}
+
+// EnableTOTP는 auth-service가 확인 코드로 검증한 TOTP secret과 복구 코드를 저장합니다 (내부 API).
+// 이미 등록되어 있으면 새 secret으로 교체합니다.
+func (s *MFAService) EnableTOTP(userID uuid.UUID, req domain.EnableTOTPRequest) error {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("User not found", userID.String())
+		}
+		return err
+	}
+
+	now := time.Now()
+	totp := &domain.UserTOTP{
+		UserID:       userID,
+		Secret:       req.Secret,
+		LastUsedStep: req.Step,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.mfaRepo.SaveTOTP(totp, normalizeCodeHashes(req.RecoveryCodeHashes)); err != nil {
+		s.logger.Error("TOTP 저장 실패", zap.String("enduser.id", userID.String()), zap.Error(err))
+		return err
+	}
+	s.logger.Info("TOTP 등록 완료", zap.String("enduser.id", userID.String()))
+	return nil
+}
+
+// DisableTOTP는 TOTP와 복구 코드를 삭제합니다 (내부 API).
+func (s *MFAService) DisableTOTP(userID uuid.UUID) error {
+	if err := s.mfaRepo.DeleteTOTP(userID); err != nil {
+		return err
+	}
+	s.logger.Info("TOTP 해제 완료", zap.String("enduser.id", userID.String()))
+	return nil
+}
+
+// RecordTOTPUse는 사용된 코드의 시간 구간을 기록합니다 (내부 API).
+// 같거나 이전 구간의 코드는 재사용으로 보고 거부합니다.
+func (s *MFAService) RecordTOTPUse(userID uuid.UUID, step int64) error {
+	recorded, err := s.mfaRepo.RecordTOTPStep(userID, step)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		return nil
+	}
+
+	totp, err := s.mfaRepo.FindTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if totp == nil {
+		return response.NewNotFoundError("TOTP not enabled", userID.String())
+	}
+	s.logger.Warn("TOTP 코드 재사용 거부", zap.String("enduser.id", userID.String()))
+	return response.NewConflictError("TOTP code already used", userID.String())
+}
+
+// ReplaceRecoveryCodes는 복구 코드를 새로 발급한 코드로 교체합니다 (내부 API).
+func (s *MFAService) ReplaceRecoveryCodes(userID uuid.UUID, codeHashes []string) error {
+	totp, err := s.mfaRepo.FindTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if totp == nil {
+		return response.NewBadRequestError("Enable TOTP before generating recovery codes", userID.String())
+	}
+	return s.mfaRepo.ReplaceRecoveryCodes(userID, normalizeCodeHashes(codeHashes))
+}
+
+// ConsumeRecoveryCode는 복구 코드를 사용 처리합니다 (내부 API).
+// 코드가 없거나 이미 사용되었으면 NotFound를 반환합니다.
+func (s *MFAService) ConsumeRecoveryCode(userID uuid.UUID, codeHash string) error {
+	consumed, err := s.mfaRepo.ConsumeRecoveryCode(userID, strings.ToLower(codeHash))
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return response.NewNotFoundError("Recovery code not found", userID.String())
+	}
+	s.logger.Info("복구 코드 사용", zap.String("enduser.id", userID.String()))
+	return nil
+}
+
+// normalizeCodeHashes는 해시를 소문자로 맞추고 중복을 제거합니다.
+func normalizeCodeHashes(codeHashes []string) []string {
+	seen := make(map[string]struct{}, len(codeHashes))
+	result := make([]string, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		hash = strings.ToLower(hash)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		result = append(result, hash)
+	}
+	return result
+}
@@ -0,0 +1,243 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 패스키(WebAuthn 자격 증명) 저장/관리와 패스키 MFA 설정을 처리합니다.
+// challenge 생성과 attestation/assertion 검증은 auth-service가 수행합니다.
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// maxPasskeysPerUser limits the number of passkeys a user can register
+const maxPasskeysPerUser = 10
+
+// PasskeyService는 패스키 비즈니스 로직을 처리합니다.
+type PasskeyService struct {
+	passkeyRepo *repository.UserPasskeyRepository
+	userRepo    *repository.UserRepository
+	logger      *zap.Logger
+}
+
+// NewPasskeyService는 새 PasskeyService를 생성합니다.
+func NewPasskeyService(
+	passkeyRepo *repository.UserPasskeyRepository,
+	userRepo *repository.UserRepository,
+	logger *zap.Logger,
+) *PasskeyService {
+	return &PasskeyService{
+		passkeyRepo: passkeyRepo,
+		userRepo:    userRepo,
+		logger:      logger,
+	}
+}
+
+// List는 사용자의 패스키 목록과 MFA 설정을 반환합니다.
+func (s *PasskeyService) List(userID uuid.UUID) (*domain.PasskeyListResponse, error) {
+	passkeys, err := s.passkeyRepo.FindByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.passkeyRepo.IsMFARequired(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.PasskeyListResponse{
+		Passkeys:    make([]domain.PasskeyResponse, 0, len(passkeys)),
+		MFARequired: required,
+	}
+	for i := range passkeys {
+		result.Passkeys = append(result.Passkeys, passkeys[i].ToResponse())
+	}
+	return result, nil
+}
+
+// Rename은 패스키 표시 이름을 변경합니다.
+func (s *PasskeyService) Rename(userID, passkeyID uuid.UUID, name string) (*domain.UserPasskey, error) {
+	passkey, err := s.findOwned(userID, passkeyID)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if err := s.passkeyRepo.Rename(passkey, name); err != nil {
+		return nil, err
+	}
+	passkey.Name = name
+	return passkey, nil
+}
+
+// Delete는 패스키를 삭제합니다.
+// 패스키 MFA가 켜져 있으면 마지막 패스키는 삭제할 수 없습니다 (로그인 불가 방지).
+func (s *PasskeyService) Delete(userID, passkeyID uuid.UUID) error {
+	if _, err := s.findOwned(userID, passkeyID); err != nil {
+		return err
+	}
+
+	required, err := s.passkeyRepo.IsMFARequired(userID)
+	if err != nil {
+		return err
+	}
+	if required {
+		count, err := s.passkeyRepo.CountByUser(userID)
+		if err != nil {
+			return err
+		}
+		if count <= 1 {
+			return response.NewConflictError("Disable passkey MFA before removing the last passkey", passkeyID.String())
+		}
+	}
+
+	if err := s.passkeyRepo.Delete(userID, passkeyID); err != nil {
+		s.logger.Error("패스키 삭제 실패", zap.String("enduser.id", userID.String()), zap.Error(err))
+		return err
+	}
+	s.logger.Info("패스키 삭제 완료", zap.String("enduser.id", userID.String()), zap.String("passkey_id", passkeyID.String()))
+	return nil
+}
+
+// SetMFARequired는 패스키를 두 번째 인증 수단으로 요구할지 설정합니다.
+// 등록된 패스키가 없으면 켤 수 없습니다.
+func (s *PasskeyService) SetMFARequired(userID uuid.UUID, required bool) error {
+	if required {
+		count, err := s.passkeyRepo.CountByUser(userID)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return response.NewBadRequestError("Register a passkey before enabling passkey MFA", userID.String())
+		}
+	}
+
+	if err := s.passkeyRepo.SetMFARequired(userID, required); err != nil {
+		return err
+	}
+	s.logger.Info("패스키 MFA 설정 변경", zap.String("enduser.id", userID.String()), zap.Bool("required", required))
+	return nil
+}
+
+// Register는 auth-service가 검증한 패스키를 저장합니다 (내부 API).
+func (s *PasskeyService) Register(req domain.CreatePasskeyRequest) (*domain.UserPasskey, error) {
+	if _, err := s.userRepo.FindByID(req.UserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("User not found", req.UserID.String())
+		}
+		return nil, err
+	}
+
+	count, err := s.passkeyRepo.CountByUser(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxPasskeysPerUser {
+		return nil, response.NewBadRequestError("Too many passkeys", req.UserID.String())
+	}
+
+	if _, err := s.passkeyRepo.FindByCredentialID(req.CredentialID); err == nil {
+		return nil, response.NewAlreadyExistsError("Passkey already registered", req.CredentialID)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = "Passkey"
+	}
+	passkey := &domain.UserPasskey{
+		ID:           uuid.New(),
+		UserID:       req.UserID,
+		CredentialID: req.CredentialID,
+		PublicKey:    req.PublicKey,
+		SignCount:    req.SignCount,
+		Transports:   strings.Join(req.Transports, ","),
+		AAGUID:       req.AAGUID,
+		Name:         name,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if err := s.passkeyRepo.Create(passkey); err != nil {
+		s.logger.Error("패스키 저장 실패", zap.String("enduser.id", req.UserID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("패스키 등록 완료", zap.String("enduser.id", req.UserID.String()), zap.String("passkey_id", passkey.ID.String()))
+	return passkey, nil
+}
+
+// GetUserCredentials는 사용자의 자격 증명 목록과 MFA 설정을 반환합니다 (내부 API).
+// auth-service가 등록 시 excludeCredentials, 인증 시 allowCredentials로 사용합니다.
+func (s *PasskeyService) GetUserCredentials(userID uuid.UUID) (*domain.UserPasskeysResponse, error) {
+	passkeys, err := s.passkeyRepo.FindByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.passkeyRepo.IsMFARequired(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.UserPasskeysResponse{
+		Credentials: make([]domain.PasskeyCredentialResponse, 0, len(passkeys)),
+		MFARequired: required && len(passkeys) > 0,
+	}
+	for i := range passkeys {
+		result.Credentials = append(result.Credentials, passkeys[i].ToCredentialResponse())
+	}
+	return result, nil
+}
+
+// GetCredential은 assertion 검증에 필요한 자격 증명을 credential ID로 조회합니다 (내부 API).
+// 비활성/삭제된 사용자의 패스키는 찾지 못한 것으로 처리합니다.
+func (s *PasskeyService) GetCredential(credentialID string) (*domain.PasskeyCredentialResponse, error) {
+	passkey, err := s.passkeyRepo.FindByCredentialID(credentialID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Passkey not found", credentialID)
+		}
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(passkey.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Passkey not found", credentialID)
+		}
+		return nil, err
+	}
+
+	credential := passkey.ToCredentialResponse()
+	credential.Email = user.Email
+	return &credential, nil
+}
+
+// RecordUse는 인증 성공 후 서명 카운터와 마지막 사용 시각을 기록합니다 (내부 API).
+func (s *PasskeyService) RecordUse(credentialID string, signCount int64) error {
+	passkey, err := s.passkeyRepo.FindByCredentialID(credentialID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Passkey not found", credentialID)
+		}
+		return err
+	}
+	return s.passkeyRepo.RecordUse(passkey.ID, signCount)
+}
+
+func (s *PasskeyService) findOwned(userID, passkeyID uuid.UUID) (*domain.UserPasskey, error) {
+	passkey, err := s.passkeyRepo.FindByID(userID, passkeyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Passkey not found", passkeyID.String())
+		}
+		return nil, err
+	}
+	return passkey, nil
+}
@@ -1,10 +1,12 @@
 package service
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	"user-service/internal/domain"
 	"user-service/internal/metrics"
@@ -122,6 +124,16 @@ func (s *ProfileService) GetUserProfile(viewerID, targetUserID, workspaceID uuid
 	return s.profileRepo.FindByUserAndWorkspace(targetUserID, workspaceID)
 }
 
+// GetWorkspaceProfile gets a user's profile for a workspace without a viewer check
+// 서비스 간 내부 호출(gRPC)용 - 호출한 서비스가 이미 권한을 확인한 경우에만 사용
+func (s *ProfileService) GetWorkspaceProfile(userID, workspaceID uuid.UUID) (*domain.UserProfile, error) {
+	profile, err := s.profileRepo.FindByUserAndWorkspace(userID, workspaceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewNotFoundError("Profile not found", "")
+	}
+	return profile, err
+}
+
 // UpdateProfile updates a user profile (creates if not exists)
 func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain.UpdateProfileRequest) (*domain.UserProfile, error) {
 	profile, err := s.profileRepo.FindByUserAndWorkspace(userID, workspaceID)
@@ -0,0 +1,564 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 워크스페이스 SAML SSO 설정과 SSO 로그인 시 사용자 JIT 생성을 처리합니다.
+// SAML 응답의 서명 검증은 auth-service가 수행한 뒤 내부 API로 결과를 전달합니다.
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+	"user-service/internal/saml"
+)
+
+// maxSSODomains limits the number of email domains per workspace
+const maxSSODomains = 20
+
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// publicEmailDomains는 누구나 가입할 수 있는 메일 도메인으로, SSO 도메인으로 등록할 수 없습니다.
+// (등록되면 해당 도메인의 모든 사용자가 한 워크스페이스의 IdP로만 로그인하게 됨)
+var publicEmailDomains = map[string]bool{
+	"gmail.com": true, "googlemail.com": true, "naver.com": true, "daum.net": true,
+	"hanmail.net": true, "kakao.com": true, "nate.com": true, "outlook.com": true,
+	"hotmail.com": true, "live.com": true, "yahoo.com": true, "icloud.com": true,
+	"me.com": true, "proton.me": true, "protonmail.com": true,
+}
+
+// SSOService는 워크스페이스 SAML SSO 비즈니스 로직을 처리합니다.
+type SSOService struct {
+	ssoRepo       *repository.WorkspaceSSORepository
+	workspaceRepo *repository.WorkspaceRepository
+	memberRepo    *repository.WorkspaceMemberRepository
+	profileRepo   *repository.UserProfileRepository
+	userRepo      *repository.UserRepository
+	logger        *zap.Logger
+	events        memberEvents // 멤버 변경 도메인 이벤트 (미설정 시 비활성)
+	// lookupTXT는 도메인 소유 확인용 DNS TXT 조회 함수입니다 (테스트에서 교체).
+	lookupTXT func(ctx context.Context, name string) ([]string, error)
+}
+
+// NewSSOService는 새 SSOService를 생성합니다.
+func NewSSOService(
+	ssoRepo *repository.WorkspaceSSORepository,
+	workspaceRepo *repository.WorkspaceRepository,
+	memberRepo *repository.WorkspaceMemberRepository,
+	profileRepo *repository.UserProfileRepository,
+	userRepo *repository.UserRepository,
+	logger *zap.Logger,
+) *SSOService {
+	return &SSOService{
+		ssoRepo:       ssoRepo,
+		workspaceRepo: workspaceRepo,
+		memberRepo:    memberRepo,
+		profileRepo:   profileRepo,
+		userRepo:      userRepo,
+		logger:        logger,
+		lookupTXT:     net.DefaultResolver.LookupTXT,
+	}
+}
+
+// WithEvents는 SSO JIT 멤버 추가 시 도메인 이벤트를 발행하도록 설정합니다.
+func (s *SSOService) WithEvents(events *messaging.Emitter) *SSOService {
+	s.events.emitter = events
+	return s
+}
+
+// WithOutbox는 SSO JIT 멤버 추가 이벤트를 같은 트랜잭션에서 아웃박스에 기록하도록 설정합니다.
+// db는 ob가 nil이어도 멤버 추가와 프로필 생성 등 여러 저장소 변경을 한 트랜잭션으로 묶는 데 사용합니다.
+func (s *SSOService) WithOutbox(db *gorm.DB, ob *outbox.Outbox) *SSOService {
+	s.events.outbox = ob
+	s.events.db = db
+	return s
+}
+
+// GetConfig는 워크스페이스의 SSO 설정을 조회합니다.
+// 설정이 없으면 저장되지 않은 기본값(비활성)을 반환합니다.
+func (s *SSOService) GetConfig(workspaceID, userID uuid.UUID) (*domain.WorkspaceSSOConfig, error) {
+	if err := s.requireAdmin(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	return s.findOrDefault(workspaceID)
+}
+
+// UpdateConfig는 SSO 설정을 수정합니다. 소유자 또는 관리자만 변경할 수 있습니다.
+func (s *SSOService) UpdateConfig(workspaceID, userID uuid.UUID, req domain.UpdateWorkspaceSSORequest) (*domain.WorkspaceSSOConfig, error) {
+	if err := s.requireAdmin(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	config, err := s.findOrDefault(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IdPEntityID != nil {
+		config.IdPEntityID = strings.TrimSpace(*req.IdPEntityID)
+	}
+	if req.IdPSSOURL != nil {
+		// 직접 입력한 SSO URL은 HTTP-Redirect 바인딩으로 간주
+		config.IdPSSOURL = strings.TrimSpace(*req.IdPSSOURL)
+		config.IdPSSOBinding = saml.BindingHTTPRedirect
+	}
+	if req.IdPCertificate != nil {
+		cert, err := saml.ParseCertificate(*req.IdPCertificate)
+		if err != nil {
+			return nil, response.NewValidationError("Invalid IdP certificate", err.Error())
+		}
+		config.IdPCertificate = saml.EncodeCertificate(cert)
+		config.CertificateNotAfter = &cert.NotAfter
+	}
+	if req.EmailAttribute != nil {
+		config.EmailAttribute = strings.TrimSpace(*req.EmailAttribute)
+	}
+	if req.NameAttribute != nil {
+		config.NameAttribute = strings.TrimSpace(*req.NameAttribute)
+	}
+	if req.JITProvisioning != nil {
+		config.JITProvisioning = *req.JITProvisioning
+	}
+	if req.Enabled != nil {
+		config.Enabled = *req.Enabled
+	}
+	if req.Enforced != nil {
+		config.Enforced = *req.Enforced
+	}
+
+	domains := config.Domains
+	if req.Domains != nil {
+		names, err := s.normalizeDomains(workspaceID, *req.Domains)
+		if err != nil {
+			return nil, err
+		}
+		if domains, err = mergeDomains(config.Domains, names); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.save(config, domains)
+}
+
+// VerifyDomain은 DNS TXT 레코드로 SSO 이메일 도메인의 소유를 확인합니다.
+// 확인된 도메인만 SSO 활성화, 로그인 도메인 탐색, SSO 로그인에 사용됩니다.
+func (s *SSOService) VerifyDomain(ctx context.Context, workspaceID, userID uuid.UUID, domainName string) (*domain.WorkspaceSSOConfig, error) {
+	if err := s.requireAdmin(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	config, err := s.ssoRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("SSO is not configured for this workspace", workspaceID.String())
+		}
+		return nil, err
+	}
+
+	name := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domainName)), "@")
+	var target *domain.WorkspaceSSODomain
+	for i := range config.Domains {
+		if config.Domains[i].Domain == name {
+			target = &config.Domains[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, response.NewNotFoundError("Email domain is not registered for this workspace", name)
+	}
+	if target.VerifiedAt != nil {
+		return config, nil
+	}
+
+	recordName, _ := target.VerificationRecord()
+	records, err := s.lookupTXT(ctx, recordName)
+	if err != nil {
+		s.logger.Info("SSO 도메인 TXT 조회 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("domain", name),
+			zap.Error(err))
+	}
+	if !hasVerificationRecord(records, target.VerificationToken) {
+		return nil, response.NewValidationError("Domain verification TXT record not found", recordName)
+	}
+
+	owner, err := s.ssoRepo.FindDomainOwner(name)
+	if err == nil && owner.WorkspaceID != workspaceID {
+		return nil, response.NewConflictError("Email domain is already used by another workspace", name)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.ssoRepo.MarkDomainVerified(target.ID, now); err != nil {
+		s.logger.Error("SSO 도메인 확인 저장 실패", zap.String("workspace_id", workspaceID.String()), zap.Error(err))
+		return nil, err
+	}
+	target.VerifiedAt = &now
+
+	s.logger.Info("SSO 도메인 소유 확인 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("domain", name))
+	return config, nil
+}
+
+// UploadMetadata는 IdP 메타데이터 XML에서 엔티티 ID, SSO URL, 서명 인증서를 읽어 설정합니다.
+func (s *SSOService) UploadMetadata(workspaceID, userID uuid.UUID, req domain.UploadSSOMetadataRequest) (*domain.WorkspaceSSOConfig, error) {
+	if err := s.requireAdmin(workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	meta, err := saml.ParseMetadata([]byte(req.MetadataXML))
+	if err != nil {
+		return nil, response.NewValidationError("Invalid IdP metadata", err.Error())
+	}
+
+	config, err := s.findOrDefault(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	config.IdPEntityID = meta.EntityID
+	config.IdPSSOURL = meta.SSOURL
+	config.IdPSSOBinding = meta.Binding
+	config.IdPCertificate = meta.Certificate
+	config.CertificateNotAfter = &meta.NotAfter
+
+	return s.save(config, config.Domains)
+}
+
+// DeleteConfig는 SSO 설정을 삭제합니다.
+func (s *SSOService) DeleteConfig(workspaceID, userID uuid.UUID) error {
+	if err := s.requireAdmin(workspaceID, userID); err != nil {
+		return err
+	}
+	if err := s.ssoRepo.Delete(workspaceID); err != nil {
+		s.logger.Error("SSO 설정 삭제 실패", zap.String("workspace_id", workspaceID.String()), zap.Error(err))
+		return err
+	}
+	s.logger.Info("SSO 설정 삭제 완료", zap.String("workspace_id", workspaceID.String()))
+	return nil
+}
+
+// GetConnection은 auth-service가 SAML 로그인을 시작/검증할 때 필요한 IdP 설정을 반환합니다.
+func (s *SSOService) GetConnection(workspaceID uuid.UUID) (*domain.WorkspaceSSOConfig, error) {
+	config, err := s.ssoRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("SSO is not configured for this workspace", workspaceID.String())
+		}
+		return nil, err
+	}
+	if !config.Enabled {
+		return nil, response.NewNotFoundError("SSO is not enabled for this workspace", workspaceID.String())
+	}
+	return config, nil
+}
+
+// Discover는 이메일 도메인으로 로그인할 워크스페이스 IdP를 찾습니다.
+func (s *SSOService) Discover(email string) (*domain.WorkspaceSSOConfig, error) {
+	emailDomain := emailDomainOf(email)
+	if emailDomain == "" {
+		return nil, response.NewValidationError("Invalid email", email)
+	}
+
+	config, err := s.ssoRepo.FindEnabledByDomain(emailDomain)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("No SSO configured for this email domain", emailDomain)
+		}
+		return nil, err
+	}
+	return config, nil
+}
+
+// Login은 auth-service가 검증한 SAML Assertion으로 사용자를 찾거나 JIT 생성합니다.
+// IdP가 워크스페이스에 등록되지 않은 도메인의 이메일을 주장하면 거부합니다.
+// (다른 회사 사용자 계정을 가장하는 것을 방지)
+func (s *SSOService) Login(req domain.SSOLoginRequest) (*domain.User, error) {
+	config, err := s.GetConnection(req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.NameID))
+	if config.EmailAttribute != "" {
+		email = strings.ToLower(strings.TrimSpace(firstAttribute(req.Attributes, config.EmailAttribute)))
+	}
+	emailDomain := emailDomainOf(email)
+	if emailDomain == "" {
+		return nil, response.NewValidationError("SAML assertion has no valid email", config.EmailAttribute)
+	}
+	// 소유가 확인되지 않은 도메인을 허용하면 다른 회사 도메인을 등록해 기존 계정을 탈취할 수 있음
+	if !config.HasVerifiedDomain(emailDomain) {
+		s.logger.Warn("SSO 로그인 거부 - 워크스페이스의 확인된 도메인이 아님",
+			zap.String("workspace_id", req.WorkspaceID.String()),
+			zap.String("email_domain", emailDomain))
+		return nil, response.NewForbiddenError("Email domain is not managed by this workspace", emailDomain)
+	}
+
+	name := ""
+	if config.NameAttribute != "" {
+		name = strings.TrimSpace(firstAttribute(req.Attributes, config.NameAttribute))
+	}
+	if name == "" {
+		name = email[:strings.Index(email, "@")]
+	}
+
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if !config.JITProvisioning {
+			return nil, response.NewForbiddenError("User is not provisioned for this workspace", email)
+		}
+
+		user = &domain.User{
+			ID:        uuid.New(),
+			Email:     email,
+			Name:      name,
+			Provider:  "saml",
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		if err := s.userRepo.Create(user); err != nil {
+			s.logger.Error("SSO 사용자 생성 실패", zap.String("workspace_id", req.WorkspaceID.String()), zap.Error(err))
+			return nil, err
+		}
+		s.logger.Info("SSO 사용자 JIT 생성",
+			zap.String("workspace_id", req.WorkspaceID.String()),
+			zap.String("user_id", user.ID.String()))
+	}
+
+	if config.JITProvisioning {
+		if err := s.ensureMember(req.WorkspaceID, user); err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.Info("SSO 로그인 완료",
+		zap.String("workspace_id", req.WorkspaceID.String()),
+		zap.String("user_id", user.ID.String()))
+	return user, nil
+}
+
+// ensureMember는 SSO 사용자를 워크스페이스 멤버(MEMBER)로 추가하고 프로필을 생성합니다.
+func (s *SSOService) ensureMember(workspaceID uuid.UUID, user *domain.User) error {
+	isMember, err := s.memberRepo.IsMember(workspaceID, user.ID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return nil
+	}
+
+	member := &domain.WorkspaceMember{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		UserID:      user.ID,
+		RoleName:    domain.RoleMember,
+		IsDefault:   false,
+		IsActive:    true,
+		JoinedAt:    time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	// 멤버와 프로필을 함께 생성 (둘 중 하나라도 실패하면 모두 롤백)
+	if err := s.events.addMember(context.Background(), s.memberRepo, s.profileRepo, user.ID, member, user); err != nil {
+		s.logger.Error("SSO 멤버 생성 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("SSO 멤버 JIT 추가",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("user_id", user.ID.String()))
+	return nil
+}
+
+// requireAdmin은 워크스페이스 소유자 또는 ADMIN인지 확인합니다.
+func (s *SSOService) requireAdmin(workspaceID, userID uuid.UUID) error {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+	if workspace.OwnerID == userID {
+		return nil
+	}
+	role, err := s.memberRepo.GetRole(workspaceID, userID)
+	if err != nil || role != domain.RoleAdmin {
+		return response.NewForbiddenError("Only owner or admin can manage SSO settings", "")
+	}
+	return nil
+}
+
+func (s *SSOService) findOrDefault(workspaceID uuid.UUID) (*domain.WorkspaceSSOConfig, error) {
+	config, err := s.ssoRepo.FindByWorkspace(workspaceID)
+	if err == nil {
+		return config, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return &domain.WorkspaceSSOConfig{
+		WorkspaceID:     workspaceID,
+		JITProvisioning: true,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}, nil
+}
+
+// save는 활성화된 설정이 완전한지 검증한 뒤 저장합니다.
+func (s *SSOService) save(config *domain.WorkspaceSSOConfig, domains []domain.WorkspaceSSODomain) (*domain.WorkspaceSSOConfig, error) {
+	if config.Enabled {
+		if config.IdPEntityID == "" || config.IdPCertificate == "" {
+			return nil, response.NewValidationError("IdP entity ID and certificate are required to enable SSO", "")
+		}
+		if u, err := url.Parse(config.IdPSSOURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, response.NewValidationError("IdP SSO URL must be an https URL", config.IdPSSOURL)
+		}
+		if !hasVerifiedDomain(domains) {
+			return nil, response.NewValidationError("At least one verified email domain is required to enable SSO", "")
+		}
+	}
+	if config.Enforced && !config.Enabled {
+		return nil, response.NewValidationError("SSO must be enabled to be enforced", "")
+	}
+
+	config.UpdatedAt = time.Now()
+	if err := s.ssoRepo.Save(config, domains); err != nil {
+		s.logger.Error("SSO 설정 저장 실패", zap.String("workspace_id", config.WorkspaceID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("SSO 설정 저장 완료",
+		zap.String("workspace_id", config.WorkspaceID.String()),
+		zap.Bool("enabled", config.Enabled),
+		zap.Bool("enforced", config.Enforced))
+	return config, nil
+}
+
+// normalizeDomains는 도메인을 소문자로 정규화하고, 공용 메일 도메인과 다른 워크스페이스가 소유를 확인한 도메인을 거부합니다.
+func (s *SSOService) normalizeDomains(workspaceID uuid.UUID, input []string) ([]string, error) {
+	if len(input) > maxSSODomains {
+		return nil, response.NewValidationError("Too many SSO domains", "maximum is 20")
+	}
+
+	seen := make(map[string]bool, len(input))
+	domains := make([]string, 0, len(input))
+	for _, raw := range input {
+		name := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(raw)), "@")
+		if seen[name] {
+			continue
+		}
+		if !domainPattern.MatchString(name) {
+			return nil, response.NewValidationError("Invalid email domain", raw)
+		}
+		if publicEmailDomains[name] {
+			return nil, response.NewValidationError("Public email domains cannot be used for SSO", name)
+		}
+
+		owner, err := s.ssoRepo.FindDomainOwner(name)
+		if err == nil && owner.WorkspaceID != workspaceID {
+			return nil, response.NewConflictError("Email domain is already used by another workspace", name)
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		seen[name] = true
+		domains = append(domains, name)
+	}
+	return domains, nil
+}
+
+// mergeDomains는 요청한 도메인 목록에 맞춰 기존 도메인(확인 상태 포함)은 유지하고,
+// 새 도메인에는 확인 토큰을 발급합니다.
+func mergeDomains(existing []domain.WorkspaceSSODomain, names []string) ([]domain.WorkspaceSSODomain, error) {
+	byName := make(map[string]domain.WorkspaceSSODomain, len(existing))
+	for _, d := range existing {
+		byName[d.Domain] = d
+	}
+
+	domains := make([]domain.WorkspaceSSODomain, 0, len(names))
+	for _, name := range names {
+		if d, ok := byName[name]; ok {
+			domains = append(domains, d)
+			continue
+		}
+		token, err := newVerificationToken()
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, domain.WorkspaceSSODomain{
+			Domain:            name,
+			VerificationToken: token,
+			CreatedAt:         time.Now(),
+		})
+	}
+	return domains, nil
+}
+
+func newVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hasVerifiedDomain(domains []domain.WorkspaceSSODomain) bool {
+	for _, d := range domains {
+		if d.VerifiedAt != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVerificationRecord는 TXT 레코드 중 확인 토큰과 일치하는 값이 있는지 확인합니다.
+func hasVerificationRecord(records []string, token string) bool {
+	if token == "" {
+		return false
+	}
+	want := domain.SSODomainVerificationValuePrefix + token
+	for _, record := range records {
+		if strings.TrimSpace(record) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// emailDomainOf는 이메일의 도메인을 소문자로 반환합니다. 형식이 잘못되면 빈 문자열을 반환합니다.
+func emailDomainOf(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+func firstAttribute(attributes map[string][]string, name string) string {
+	if values := attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
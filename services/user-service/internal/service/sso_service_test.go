@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"user-service/internal/domain"
+)
+
+func TestHasVerificationRecord(t *testing.T) {
+	records := []string{"v=spf1 include:_spf.example.com ~all", " wealist-sso-verification=abc123 "}
+
+	assert.True(t, hasVerificationRecord(records, "abc123"))
+	assert.False(t, hasVerificationRecord(records, "other"))
+	assert.False(t, hasVerificationRecord([]string{"wealist-sso-verification="}, ""))
+	assert.False(t, hasVerificationRecord(nil, "abc123"))
+}
+
+func TestMergeDomains_KeepsVerificationOfExistingDomains(t *testing.T) {
+	verifiedAt := time.Now()
+	existing := []domain.WorkspaceSSODomain{
+		{Domain: "acme.com", VerificationToken: "token-acme", VerifiedAt: &verifiedAt},
+		{Domain: "old.acme.com", VerificationToken: "token-old"},
+	}
+
+	domains, err := mergeDomains(existing, []string{"acme.com", "new.acme.com"})
+	require.NoError(t, err)
+	require.Len(t, domains, 2)
+
+	assert.Equal(t, "token-acme", domains[0].VerificationToken)
+	assert.Equal(t, &verifiedAt, domains[0].VerifiedAt)
+
+	assert.Equal(t, "new.acme.com", domains[1].Domain)
+	assert.Nil(t, domains[1].VerifiedAt)
+	assert.Len(t, domains[1].VerificationToken, 32)
+
+	assert.True(t, hasVerifiedDomain(domains))
+	assert.False(t, hasVerifiedDomain(domains[1:]))
+}
+
+func TestWorkspaceSSOConfig_HasVerifiedDomain(t *testing.T) {
+	verifiedAt := time.Now()
+	config := &domain.WorkspaceSSOConfig{Domains: []domain.WorkspaceSSODomain{
+		{Domain: "acme.com", VerifiedAt: &verifiedAt},
+		{Domain: "victim.com"},
+	}}
+
+	assert.True(t, config.HasVerifiedDomain("acme.com"))
+	assert.False(t, config.HasVerifiedDomain("victim.com"))
+	assert.False(t, config.HasVerifiedDomain("unknown.com"))
+}
@@ -21,18 +21,28 @@ import (
 // 사용자 생성, 조회, 수정, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
 type UserService struct {
-	userRepo *repository.UserRepository
-	logger   *zap.Logger
-	metrics  *metrics.Metrics // 메트릭 수집을 위한 필드
+	userRepo     *repository.UserRepository
+	identityRepo *repository.UserIdentityRepository
+	ssoRepo      *repository.WorkspaceSSORepository
+	logger       *zap.Logger
+	metrics      *metrics.Metrics // 메트릭 수집을 위한 필드
 }
 
 // NewUserService creates a new UserService
 // metrics 파라미터가 nil인 경우에도 안전하게 동작합니다.
-func NewUserService(userRepo *repository.UserRepository, logger *zap.Logger, m *metrics.Metrics) *UserService {
+func NewUserService(
+	userRepo *repository.UserRepository,
+	identityRepo *repository.UserIdentityRepository,
+	ssoRepo *repository.WorkspaceSSORepository,
+	logger *zap.Logger,
+	m *metrics.Metrics,
+) *UserService {
 	return &UserService{
-		userRepo: userRepo,
-		logger:   logger,
-		metrics:  m,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		ssoRepo:      ssoRepo,
+		logger:       logger,
+		metrics:      m,
 	}
 }
 
@@ -224,24 +234,77 @@ func (s *UserService) FindOrCreateUser(ctx context.Context, email string, google
 }
 
 // FindOrCreateOAuthUser finds or creates a user for OAuth login (called by auth-service)
-func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, email, name, provider string) (*domain.User, error) {
+// 1) 이미 연결된 소셜 계정이면 해당 사용자를 반환합니다.
+// 2) 같은 이메일의 사용자가 있으면 제공자가 검증한 이메일인 경우에만 계정을 연결합니다.
+// 3) 없으면 새 사용자와 소셜 계정을 함께 생성합니다.
+// 검증되지 않은 이메일로 다른 사람의 계정을 가로채지 못하도록 EmailVerified가 false이면 거부합니다.
+func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, req domain.OAuthLoginRequest) (*domain.User, error) {
 	log := s.log(ctx)
 	log.Info("OAuth login attempt",
-		zap.String("user.email", email),
-		zap.String("oauth.provider", provider))
+		zap.String("user.email", req.Email),
+		zap.String("oauth.provider", req.Provider))
 
-	// Try to find by email
-	user, err := s.userRepo.FindByEmail(email)
+	// Try to find by linked identity
+	identity, err := s.identityRepo.FindByProvider(req.Provider, req.ProviderUserID)
 	if err == nil {
+		user, err := s.userRepo.FindByID(identity.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Warn("FindOrCreateOAuthUser identity linked to inactive user", zap.String("enduser.id", identity.UserID.String()))
+				return nil, response.NewForbiddenError("User is deactivated", identity.UserID.String())
+			}
+			log.Error("FindOrCreateOAuthUser failed to find linked user", zap.Error(err))
+			return nil, err
+		}
+		if err := s.requireSocialLoginAllowed(user, user.Email); err != nil {
+			return nil, err
+		}
+		log.Info("Existing user found for OAuth identity", zap.String("enduser.id", user.ID.String()))
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error("FindOrCreateOAuthUser failed to find identity", zap.Error(err))
+		return nil, err
+	}
+
+	if !req.EmailVerified {
+		log.Warn("FindOrCreateOAuthUser rejected unverified email",
+			zap.String("user.email", req.Email),
+			zap.String("oauth.provider", req.Provider))
+		return nil, response.NewForbiddenError("OAuth email is not verified", req.Provider)
+	}
+
+	newIdentity := &domain.UserIdentity{
+		ID:             uuid.New(),
+		Provider:       req.Provider,
+		ProviderUserID: req.ProviderUserID,
+		Email:          req.Email,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	// Try to link by verified email
+	user, err := s.userRepo.FindByEmail(req.Email)
+	if err == nil {
+		if err := s.requireSocialLoginAllowed(user, req.Email); err != nil {
+			return nil, err
+		}
+		newIdentity.UserID = user.ID
+		if err := s.identityRepo.Create(newIdentity); err != nil {
+			log.Error("FindOrCreateOAuthUser failed to link identity", zap.Error(err))
+			return nil, err
+		}
 		// Update name if it was empty (for existing users without name)
-		if user.Name == "" && name != "" {
-			user.Name = name
+		if user.Name == "" && req.Name != "" {
+			user.Name = req.Name
 			user.UpdatedAt = time.Now()
 			if err := s.userRepo.Update(user); err != nil {
 				log.Error("FindOrCreateOAuthUser failed to update user name", zap.Error(err))
 			}
 		}
-		log.Info("Existing user found for OAuth", zap.String("enduser.id", user.ID.String()))
+		log.Info("OAuth identity linked to existing user",
+			zap.String("enduser.id", user.ID.String()),
+			zap.String("oauth.provider", req.Provider))
 		return user, nil
 	}
 	if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -250,18 +313,24 @@ func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, email, name, pr
 	}
 
 	// Create new user
-	log.Debug("FindOrCreateOAuthUser creating new OAuth user", zap.String("user.email", email))
+	if err := s.requireSocialLoginAllowed(nil, req.Email); err != nil {
+		return nil, err
+	}
+	log.Debug("FindOrCreateOAuthUser creating new OAuth user", zap.String("user.email", req.Email))
 	newUser := &domain.User{
 		ID:        uuid.New(),
-		Email:     email,
-		Name:      name,
-		Provider:  provider,
+		Email:     req.Email,
+		Name:      req.Name,
+		Provider:  req.Provider,
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
+	if req.Provider == "google" {
+		newUser.GoogleID = &req.ProviderUserID
+	}
 
-	if err := s.userRepo.Create(newUser); err != nil {
+	if err := s.identityRepo.CreateUserWithIdentity(newUser, newIdentity); err != nil {
 		log.Error("FindOrCreateOAuthUser failed to create OAuth user", zap.Error(err))
 		return nil, err
 	}
@@ -273,6 +342,52 @@ func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, email, name, pr
 
 	log.Info("OAuth user created",
 		zap.String("enduser.id", newUser.ID.String()),
-		zap.String("user.email", email))
+		zap.String("user.email", req.Email),
+		zap.String("oauth.provider", req.Provider))
 	return newUser, nil
 }
+
+// FindUserForMagicLink finds the active user a magic-link login belongs to
+// 매직 링크는 기존 사용자 로그인에만 사용하며 사용자를 새로 만들지 않습니다.
+// 이메일 도메인이 SSO를 강제하면 소셜 로그인과 같이 409(Conflict)를 반환합니다.
+func (s *UserService) FindUserForMagicLink(ctx context.Context, email string) (*domain.User, error) {
+	log := s.log(ctx)
+
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Debug("FindUserForMagicLink user not found", zap.String("user.email", email))
+			return nil, response.NewNotFoundError("User not found", email)
+		}
+		log.Error("FindUserForMagicLink failed to find user by email", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.requireSocialLoginAllowed(user, email); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// requireSocialLoginAllowed rejects social login for email domains whose workspace enforces SSO
+// 워크스페이스 소유자는 IdP 장애 시 설정을 되돌릴 수 있도록 소셜 로그인을 허용합니다.
+// 이메일 도메인 강제 SSO는 409(Conflict)로 반환하여 auth-service가 다른 거부 사유와 구분합니다.
+func (s *UserService) requireSocialLoginAllowed(user *domain.User, email string) error {
+	if s.ssoRepo == nil {
+		return nil
+	}
+	config, err := s.ssoRepo.FindEnabledByDomain(emailDomainOf(email))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !config.Enforced {
+		return nil
+	}
+	if user != nil && config.Workspace != nil && config.Workspace.OwnerID == user.ID {
+		return nil
+	}
+	return response.NewConflictError("SSO login is required for this email domain", config.WorkspaceID.String())
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/lifecycle"
+	"user-service/internal/archive"
+	"user-service/internal/client"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+const (
+	// userSectionName은 user-service가 아카이브에 쓰는 섹션 이름입니다.
+	userSectionName = "user"
+	// backupKeyPrefix 아래의 아카이브만 가져올 수 있습니다 (프로필 이미지 등 다른 객체 접근 방지).
+	backupKeyPrefix = "backups/workspaces/"
+	// backupJobTimeout은 작업 하나의 최대 실행 시간입니다. 이보다 오래된 미완료 작업은 중단된 것으로 봅니다.
+	backupJobTimeout = 30 * time.Minute
+	// maxListedBackupJobs는 작업 목록에 반환하는 최근 작업 수입니다.
+	maxListedBackupJobs = 50
+)
+
+// ArchiveStore는 백업 아카이브를 저장하는 객체 저장소입니다 (S3Client).
+type ArchiveStore interface {
+	PutObject(ctx context.Context, key string, data []byte, contentType string) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+}
+
+// WorkspaceBackupService는 워크스페이스 내보내기/가져오기 작업을 관리합니다.
+// 내보내기는 user-service 섹션(워크스페이스, 멤버, 프로필)과 각 서비스의 섹션(board, storage)을
+// 하나의 아카이브로 묶어 S3에 저장하고, 가져오기는 아카이브를 같은 순서로 복원합니다.
+// 작업은 백그라운드에서 실행되며 상태는 workspace_backup_jobs에 기록됩니다.
+type WorkspaceBackupService struct {
+	backupRepo    *repository.WorkspaceBackupRepository
+	workspaceRepo *repository.WorkspaceRepository
+	memberRepo    *repository.WorkspaceMemberRepository
+	store         ArchiveStore
+	sources       []client.BackupSource
+	workers       *lifecycle.Workers
+	logger        *zap.Logger
+	now           func() time.Time
+}
+
+// NewWorkspaceBackupService는 새 WorkspaceBackupService를 생성합니다.
+// sources는 복원 순서대로 전달합니다 (board 다음 storage).
+func NewWorkspaceBackupService(
+	backupRepo *repository.WorkspaceBackupRepository,
+	workspaceRepo *repository.WorkspaceRepository,
+	memberRepo *repository.WorkspaceMemberRepository,
+	store ArchiveStore,
+	sources []client.BackupSource,
+	logger *zap.Logger,
+) *WorkspaceBackupService {
+	return &WorkspaceBackupService{
+		backupRepo:    backupRepo,
+		workspaceRepo: workspaceRepo,
+		memberRepo:    memberRepo,
+		store:         store,
+		sources:       sources,
+		workers:       lifecycle.NewWorkers(),
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Wait는 실행 중인 작업이 끝날 때까지 기다립니다 (종료 단계에 등록).
+func (s *WorkspaceBackupService) Wait(ctx context.Context) error {
+	return s.workers.Wait(ctx)
+}
+
+// StartExport는 워크스페이스 내보내기 작업을 시작합니다 (소유자 또는 ADMIN).
+func (s *WorkspaceBackupService) StartExport(workspaceID, userID uuid.UUID) (*domain.WorkspaceBackupJob, error) {
+	if err := s.authorize(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	job, err := s.createJob(workspaceID, userID, domain.BackupJobExport)
+	if err != nil {
+		return nil, err
+	}
+
+	// 응답으로 반환하는 job과 공유하지 않도록 복사본으로 실행
+	running := *job
+	s.workers.Go(func() { s.runExport(&running) })
+	return job, nil
+}
+
+// StartImport는 S3의 아카이브로 워크스페이스를 복원하는 작업을 시작합니다.
+// 아카이브는 요청 시점에 내려받아 검증하므로, 손상되었거나 다른 워크스페이스의 아카이브는 작업을 만들지 않고 거부합니다.
+// 워크스페이스가 이미 있으면 소유자 또는 ADMIN만, 없으면(재해 복구, 이전) 아카이브의 워크스페이스 소유자만 가져올 수 있습니다.
+func (s *WorkspaceBackupService) StartImport(workspaceID, userID uuid.UUID, req domain.StartImportRequest) (*domain.WorkspaceBackupJob, error) {
+	if !strings.HasPrefix(req.ArchiveKey, backupKeyPrefix+workspaceID.String()+"/") {
+		return nil, response.NewValidationError("Archive key must be under "+backupKeyPrefix+workspaceID.String()+"/", "")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), backupJobTimeout)
+	defer cancel()
+	data, err := s.store.GetObject(ctx, req.ArchiveKey)
+	if err != nil {
+		return nil, response.NewNotFoundError("Archive not found", err.Error())
+	}
+	manifest, sections, err := archive.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, response.NewValidationError("Invalid archive", err.Error())
+	}
+	if manifest.WorkspaceID != workspaceID {
+		return nil, response.NewValidationError("Archive belongs to a different workspace", "")
+	}
+	var userSection domain.WorkspaceUserBackup
+	if err := json.Unmarshal(sections[userSectionName], &userSection); err != nil || userSection.Workspace.ID != workspaceID {
+		return nil, response.NewValidationError("Archive has no valid user section", "")
+	}
+	for name := range sections {
+		if name != userSectionName && s.source(name) == nil {
+			return nil, response.NewValidationError("Archive section "+name+" cannot be restored by this deployment", "")
+		}
+	}
+
+	if _, err := s.workspaceRepo.FindByID(workspaceID); err == nil {
+		if err := s.authorize(workspaceID, userID); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if userSection.Workspace.OwnerID != userID {
+		return nil, response.NewForbiddenError("Only the archived workspace owner can restore a missing workspace", "")
+	}
+
+	job, err := s.createJob(workspaceID, userID, domain.BackupJobImport)
+	if err != nil {
+		return nil, err
+	}
+	job.ArchiveKey = req.ArchiveKey
+	job.FormatVersion = manifest.FormatVersion
+	job.SizeBytes = int64(len(data))
+	if err := s.backupRepo.UpdateJob(job); err != nil {
+		return nil, err
+	}
+
+	running := *job
+	s.workers.Go(func() { s.runImport(&running, &userSection, sections) })
+	return job, nil
+}
+
+// GetJob은 워크스페이스 백업 작업을 조회합니다 (소유자 또는 ADMIN).
+func (s *WorkspaceBackupService) GetJob(workspaceID, jobID, userID uuid.UUID) (*domain.WorkspaceBackupJob, error) {
+	if err := s.authorize(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	job, err := s.backupRepo.FindJob(workspaceID, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Backup job not found", "")
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// ListJobs는 워크스페이스의 최근 백업 작업을 조회합니다 (소유자 또는 ADMIN).
+func (s *WorkspaceBackupService) ListJobs(workspaceID, userID uuid.UUID) ([]domain.WorkspaceBackupJob, error) {
+	if err := s.authorize(workspaceID, userID); err != nil {
+		return nil, err
+	}
+	return s.backupRepo.ListJobs(workspaceID, maxListedBackupJobs)
+}
+
+// authorize는 워크스페이스 소유자 또는 ADMIN인지 확인합니다.
+func (s *WorkspaceBackupService) authorize(workspaceID, userID uuid.UUID) error {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Workspace not found", "")
+		}
+		return err
+	}
+	if workspace.OwnerID != userID {
+		role, err := s.memberRepo.GetRole(workspaceID, userID)
+		if err != nil || role != domain.RoleAdmin {
+			return response.NewForbiddenError("Only owner or admin can manage workspace backups", "")
+		}
+	}
+	return nil
+}
+
+// createJob은 진행 중인 작업이 없을 때 새 작업을 PENDING 상태로 만듭니다.
+func (s *WorkspaceBackupService) createJob(workspaceID, userID uuid.UUID, kind domain.BackupJobKind) (*domain.WorkspaceBackupJob, error) {
+	active, err := s.backupRepo.HasActiveJob(workspaceID, s.now().Add(-backupJobTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, response.NewConflictError("Another backup job is already running for this workspace", "")
+	}
+
+	job := &domain.WorkspaceBackupJob{
+		WorkspaceID: workspaceID,
+		Kind:        kind,
+		Status:      domain.BackupJobPending,
+		RequestedBy: userID,
+	}
+	if err := s.backupRepo.CreateJob(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// runExport는 모든 섹션을 모아 아카이브를 만들고 S3에 저장합니다.
+func (s *WorkspaceBackupService) runExport(job *domain.WorkspaceBackupJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), backupJobTimeout)
+	defer cancel()
+	s.markRunning(job)
+
+	sections := make(map[string]json.RawMessage, len(s.sources)+1)
+	userSection, err := s.backupRepo.LoadUserSection(job.WorkspaceID)
+	if err != nil {
+		s.finish(job, fmt.Errorf("user export failed: %w", err))
+		return
+	}
+	if sections[userSectionName], err = json.Marshal(userSection); err != nil {
+		s.finish(job, err)
+		return
+	}
+	for _, source := range s.sources {
+		section, err := source.Export(ctx, job.WorkspaceID)
+		if err != nil {
+			s.finish(job, err)
+			return
+		}
+		sections[source.Name()] = section
+	}
+
+	var buf bytes.Buffer
+	manifest := archive.Manifest{WorkspaceID: job.WorkspaceID, JobID: job.ID, ExportedAt: s.now().UTC()}
+	if err := archive.Write(&buf, manifest, sections); err != nil {
+		s.finish(job, err)
+		return
+	}
+	key := fmt.Sprintf("%s%s/%s.tar.gz", backupKeyPrefix, job.WorkspaceID, job.ID)
+	if err := s.store.PutObject(ctx, key, buf.Bytes(), "application/gzip"); err != nil {
+		s.finish(job, err)
+		return
+	}
+
+	job.ArchiveKey = key
+	job.FormatVersion = archive.FormatVersion
+	job.SizeBytes = int64(buf.Len())
+	s.finish(job, nil)
+}
+
+// runImport는 user 섹션(워크스페이스, 사용자, 멤버)을 먼저 복원한 뒤 나머지 섹션을 sources 순서로 복원합니다.
+// 각 단계는 이미 있는 행을 건너뛰므로 실패한 작업은 같은 아카이브로 다시 실행하면 됩니다.
+func (s *WorkspaceBackupService) runImport(job *domain.WorkspaceBackupJob, userSection *domain.WorkspaceUserBackup, sections map[string]json.RawMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), backupJobTimeout)
+	defer cancel()
+	s.markRunning(job)
+
+	if err := s.backupRepo.RestoreUserSection(userSection); err != nil {
+		s.finish(job, fmt.Errorf("user import failed: %w", err))
+		return
+	}
+	for _, source := range s.sources {
+		section, ok := sections[source.Name()]
+		if !ok {
+			continue
+		}
+		if err := source.Import(ctx, job.WorkspaceID, section); err != nil {
+			s.finish(job, err)
+			return
+		}
+	}
+	s.finish(job, nil)
+}
+
+func (s *WorkspaceBackupService) source(name string) client.BackupSource {
+	for _, source := range s.sources {
+		if source.Name() == name {
+			return source
+		}
+	}
+	return nil
+}
+
+func (s *WorkspaceBackupService) markRunning(job *domain.WorkspaceBackupJob) {
+	now := s.now()
+	job.Status = domain.BackupJobRunning
+	job.StartedAt = &now
+	if err := s.backupRepo.UpdateJob(job); err != nil {
+		s.logger.Warn("Failed to update backup job", zap.String("job_id", job.ID.String()), zap.Error(err))
+	}
+}
+
+// finish는 작업을 완료 또는 실패로 기록합니다.
+func (s *WorkspaceBackupService) finish(job *domain.WorkspaceBackupJob, jobErr error) {
+	now := s.now()
+	job.CompletedAt = &now
+	logger := s.logger.With(
+		zap.String("job_id", job.ID.String()),
+		zap.String("workspace_id", job.WorkspaceID.String()),
+		zap.String("kind", string(job.Kind)))
+	if jobErr != nil {
+		job.Status = domain.BackupJobFailed
+		job.Error = jobErr.Error()
+		logger.Error("Workspace backup job failed", zap.Error(jobErr))
+	} else {
+		job.Status = domain.BackupJobCompleted
+		logger.Info("Workspace backup job completed",
+			zap.String("archive_key", job.ArchiveKey),
+			zap.Int64("size_bytes", job.SizeBytes))
+	}
+	if err := s.backupRepo.UpdateJob(job); err != nil {
+		logger.Error("Failed to update backup job", zap.Error(err))
+	}
+}
@@ -5,12 +5,15 @@
 package service
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
 	"user-service/internal/domain"
+	"user-service/internal/repository"
 	"user-service/internal/response"
 )
 
@@ -146,7 +149,7 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 	// 이미 멤버인지 확인
 	isMember, _ := s.memberRepo.IsMember(workspaceID, user.ID)
 	if isMember {
-		return nil, response.NewAlreadyExistsError("User is already a member of this workspace", "")
+		return nil, response.NewAlreadyMemberError("User is already a member of this workspace", "")
 	}
 
 	// 역할 결정 (기본값: MEMBER)
@@ -184,7 +187,8 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 		JoinedAt:    time.Now(),
 		UpdatedAt:   time.Now(),
 	}
-	if err := s.memberRepo.Create(member); err != nil {
+	// 멤버와 프로필을 함께 생성 (둘 중 하나라도 실패하면 모두 롤백)
+	if err := s.events.addMember(context.Background(), s.memberRepo, s.profileRepo, inviterID, member, user); err != nil {
 		s.logger.Error("멤버 생성 실패",
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", user.ID.String()),
@@ -192,26 +196,6 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 		return nil, err
 	}
 
-	// 프로필 생성
-	profile := &domain.UserProfile{
-		ID:          uuid.New(),
-		UserID:      user.ID,
-		WorkspaceID: workspaceID,
-		NickName:    user.Name,
-		Email:       user.Email,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
-	if profile.NickName == "" {
-		profile.NickName = user.Email
-	}
-	if err := s.profileRepo.Create(profile); err != nil {
-		s.logger.Error("프로필 생성 실패",
-			zap.String("workspace_id", workspaceID.String()),
-			zap.String("user_id", user.ID.String()),
-			zap.Error(err))
-	}
-
 	// User 정보 포함
 	member.User = user
 
@@ -280,7 +264,9 @@ func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uui
 	member.RoleName = req.RoleName
 	member.UpdatedAt = time.Now()
 
-	if err := s.memberRepo.Update(member); err != nil {
+	if err := s.events.save(context.Background(), s.memberRepo, messaging.EventMemberRoleChanged, updaterID, member, func(repo *repository.WorkspaceMemberRepository) error {
+		return repo.Update(member)
+	}); err != nil {
 		s.logger.Error("멤버 역할 업데이트 실패",
 			zap.String("member_id", memberID.String()),
 			zap.Error(err))
@@ -331,7 +317,9 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 	}
 
 	// 멤버 제거 (soft delete)
-	if err := s.memberRepo.Delete(memberID); err != nil {
+	if err := s.events.save(context.Background(), s.memberRepo, messaging.EventMemberRemoved, removerID, member, func(repo *repository.WorkspaceMemberRepository) error {
+		return repo.Delete(memberID)
+	}); err != nil {
 		s.logger.Error("멤버 제거 실패",
 			zap.String("member_id", memberID.String()),
 			zap.Error(err))
@@ -410,13 +398,13 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 	// 이미 멤버인지 확인
 	isMember, _ := s.memberRepo.IsMember(workspaceID, userID)
 	if isMember {
-		return nil, response.NewAlreadyExistsError("Already a member of this workspace", "")
+		return nil, response.NewAlreadyMemberError("Already a member of this workspace", "")
 	}
 
 	// 대기 중인 요청이 있는지 확인
 	hasPending, _ := s.joinReqRepo.HasPendingRequest(workspaceID, userID)
 	if hasPending {
-		return nil, response.NewAlreadyExistsError("Already have a pending request", "")
+		return nil, response.NewPendingRequestExistsError("Already have a pending request", "")
 	}
 
 	// 승인 필요 없는 경우 바로 멤버로 추가
@@ -431,7 +419,9 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 			JoinedAt:    time.Now(),
 			UpdatedAt:   time.Now(),
 		}
-		if err := s.memberRepo.Create(member); err != nil {
+		// 멤버와 프로필을 함께 생성 (둘 중 하나라도 실패하면 모두 롤백)
+		user, _ := s.userRepo.FindByID(userID)
+		if err := s.events.addMember(context.Background(), s.memberRepo, s.profileRepo, userID, member, user); err != nil {
 			s.logger.Error("자동 참여 실패",
 				zap.String("workspace_id", workspaceID.String()),
 				zap.String("user_id", userID.String()),
@@ -439,24 +429,6 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 			return nil, err
 		}
 
-		// 프로필 생성
-		user, _ := s.userRepo.FindByID(userID)
-		if user != nil {
-			profile := &domain.UserProfile{
-				ID:          uuid.New(),
-				UserID:      userID,
-				WorkspaceID: workspaceID,
-				NickName:    user.Name,
-				Email:       user.Email,
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			}
-			if profile.NickName == "" {
-				profile.NickName = user.Email
-			}
-			_ = s.profileRepo.Create(profile)
-		}
-
 		s.logger.Info("자동 참여 완료 (승인 불필요)",
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", userID.String()))
@@ -553,20 +525,11 @@ func (s *WorkspaceService) ProcessJoinRequest(workspaceID, requestID, processorI
 		return nil, response.NewBadRequestError("Request does not belong to this workspace", "")
 	}
 
-	// 상태 업데이트
-	request.Status = req.Status
-	request.UpdatedAt = time.Now()
-
-	if err := s.joinReqRepo.Update(request); err != nil {
-		s.logger.Error("참여 요청 처리 실패",
-			zap.String("request_id", requestID.String()),
-			zap.Error(err))
-		return nil, err
-	}
-
-	// 승인된 경우 멤버로 추가
+	// 승인된 경우 추가할 멤버와, 프로필 기본값으로 쓸 사용자 (조회는 트랜잭션 밖에서)
+	var member *domain.WorkspaceMember
+	var user *domain.User
 	if req.Status == domain.JoinStatusApproved {
-		member := &domain.WorkspaceMember{
+		member = &domain.WorkspaceMember{
 			ID:          uuid.New(),
 			WorkspaceID: workspaceID,
 			UserID:      request.UserID,
@@ -576,32 +539,30 @@ func (s *WorkspaceService) ProcessJoinRequest(workspaceID, requestID, processorI
 			JoinedAt:    time.Now(),
 			UpdatedAt:   time.Now(),
 		}
-		if err := s.memberRepo.Create(member); err != nil {
-			s.logger.Error("멤버 생성 실패 (승인 후)",
-				zap.String("workspace_id", workspaceID.String()),
-				zap.String("user_id", request.UserID.String()),
-				zap.Error(err))
-			return nil, err
-		}
+		user, _ = s.userRepo.FindByID(request.UserID)
+	}
 
-		// 프로필 생성
-		user, _ := s.userRepo.FindByID(request.UserID)
-		if user != nil {
-			profile := &domain.UserProfile{
-				ID:          uuid.New(),
-				UserID:      request.UserID,
-				WorkspaceID: workspaceID,
-				NickName:    user.Name,
-				Email:       user.Email,
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			}
-			if profile.NickName == "" {
-				profile.NickName = user.Email
-			}
-			_ = s.profileRepo.Create(profile)
+	// 요청 상태 변경, 멤버 추가, 프로필 생성을 한 트랜잭션으로 처리
+	// (멤버 추가가 실패하면 요청도 대기 상태로 남아 다시 승인할 수 있음)
+	request.Status = req.Status
+	request.UpdatedAt = time.Now()
+	if err := s.events.transaction(context.Background(), func(ctx context.Context) error {
+		if err := bind(ctx, &s.events, s.joinReqRepo, (*repository.JoinRequestRepository).WithTx).Update(request); err != nil {
+			return err
 		}
+		if member == nil {
+			return nil
+		}
+		return s.events.addMember(ctx, s.memberRepo, s.profileRepo, processorID, member, user)
+	}); err != nil {
+		s.logger.Error("참여 요청 처리 실패",
+			zap.String("request_id", requestID.String()),
+			zap.String("user_id", request.UserID.String()),
+			zap.Error(err))
+		return nil, err
+	}
 
+	if req.Status == domain.JoinStatusApproved {
 		s.logger.Info("참여 요청 승인 완료",
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", request.UserID.String()),
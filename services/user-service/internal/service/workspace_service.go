@@ -5,11 +5,15 @@
 package service
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/messaging"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/outbox"
 	"user-service/internal/domain"
 	"user-service/internal/metrics"
 	"user-service/internal/repository"
@@ -27,6 +31,7 @@ type WorkspaceService struct {
 	userRepo      *repository.UserRepository
 	logger        *zap.Logger
 	metrics       *metrics.Metrics // 메트릭 수집을 위한 필드
+	events        memberEvents     // 멤버 변경 도메인 이벤트 (미설정 시 비활성)
 }
 
 // NewWorkspaceService는 새 WorkspaceService를 생성합니다.
@@ -51,6 +56,20 @@ func NewWorkspaceService(
 	}
 }
 
+// WithEvents는 멤버 변경 시 도메인 이벤트를 발행하도록 설정합니다.
+func (s *WorkspaceService) WithEvents(events *messaging.Emitter) *WorkspaceService {
+	s.events.emitter = events
+	return s
+}
+
+// WithOutbox는 멤버 변경 이벤트를 같은 트랜잭션에서 아웃박스에 기록하도록 설정합니다 (WithEvents보다 우선).
+// db는 ob가 nil이어도 멤버 추가와 프로필 생성 등 여러 저장소 변경을 한 트랜잭션으로 묶는 데 사용합니다.
+func (s *WorkspaceService) WithOutbox(db *gorm.DB, ob *outbox.Outbox) *WorkspaceService {
+	s.events.outbox = ob
+	s.events.db = db
+	return s
+}
+
 // CreateWorkspace는 새 워크스페이스를 생성합니다.
 // 사용자 존재 여부를 확인하고, 워크스페이스 생성 후 소유자를 멤버로 추가합니다.
 func (s *WorkspaceService) CreateWorkspace(ownerID uuid.UUID, req domain.CreateWorkspaceRequest) (*domain.Workspace, error) {
@@ -242,6 +261,29 @@ func (s *WorkspaceService) UpdateWorkspaceSettings(id uuid.UUID, userID uuid.UUI
 	if req.OnlyOwnerCanInvite != nil {
 		workspace.OnlyOwnerCanInvite = *req.OnlyOwnerCanInvite
 	}
+	if req.RequireMFA != nil {
+		// 다음 로그인(토큰 발급)부터 auth-service가 멤버에게 MFA를 요구
+		workspace.RequireMFA = *req.RequireMFA
+	}
+	// 토큰 수명은 다음 로그인/refresh부터 적용
+	if req.AccessTokenTTLMinutes != nil {
+		if err := validateMinutes("accessTokenTtlMinutes", *req.AccessTokenTTLMinutes, 5, 1440); err != nil {
+			return nil, err
+		}
+		workspace.AccessTokenTTLMinutes = *req.AccessTokenTTLMinutes
+	}
+	if req.RefreshTokenTTLMinutes != nil {
+		if err := validateMinutes("refreshTokenTtlMinutes", *req.RefreshTokenTTLMinutes, 60, 43200); err != nil {
+			return nil, err
+		}
+		workspace.RefreshTokenTTLMinutes = *req.RefreshTokenTTLMinutes
+	}
+	if req.StepUpMaxAgeMinutes != nil {
+		if err := validateMinutes("stepUpMaxAgeMinutes", *req.StepUpMaxAgeMinutes, 1, 1440); err != nil {
+			return nil, err
+		}
+		workspace.StepUpMaxAgeMinutes = *req.StepUpMaxAgeMinutes
+	}
 
 	if err := s.workspaceRepo.Update(workspace); err != nil {
 		s.logger.Error("워크스페이스 설정 업데이트 실패", zap.Error(err))
@@ -254,6 +296,15 @@ func (s *WorkspaceService) UpdateWorkspaceSettings(id uuid.UUID, userID uuid.UUI
 	return workspace, nil
 }
 
+// validateMinutes는 0(기본값) 또는 min~max 범위의 분 단위 설정인지 확인합니다.
+func validateMinutes(field string, value, min, max int) error {
+	if value != 0 && (value < min || value > max) {
+		return response.NewValidationError(
+			fmt.Sprintf("%s must be 0 or between %d and %d", field, min, max), "")
+	}
+	return nil
+}
+
 // DeleteWorkspace는 워크스페이스를 소프트 삭제합니다.
 // 소유자 또는 관리자만 삭제할 수 있습니다.
 func (s *WorkspaceService) DeleteWorkspace(id uuid.UUID, userID uuid.UUID) error {